@@ -0,0 +1,202 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/perlin-network/life/compiler"
+	"github.com/perlin-network/life/compiler/opcodes"
+	"github.com/perlin-network/life/exec"
+)
+
+// benchReport is the outcome of `life bench`.
+type benchReport struct {
+	Entry  string  `json:"entry"`
+	Runs   int     `json:"runs"`
+	MeanNS int64   `json:"mean_ns"`
+	MinNS  int64   `json:"min_ns"`
+	MaxNS  int64   `json:"max_ns"`
+	Gas    *uint64 `json:"gas,omitempty"` // gas used by one run, if gas is accounted for
+
+	// Pairs are the most frequent pairs of opcodes executed one after the
+	// other, if requested with -pairs.
+	Pairs []opcodePair `json:"pairs,omitempty"`
+}
+
+type opcodePair struct {
+	First  string `json:"first"`
+	Second string `json:"second"`
+	Count  uint64 `json:"count"`
+}
+
+// benchMain implements `life bench [flags] module.wasm [args...]`, which
+// times repeated runs of a function. The VM is reset between runs.
+func benchMain(args []string) {
+	fs := flag.NewFlagSet("bench", flag.ExitOnError)
+	vf := registerVMFlags(fs)
+	runsFlag := fs.Int("n", 0, "number of runs; 0 to run for -time")
+	timeFlag := fs.Duration("time", time.Second, "how long to keep running the function")
+	jsonFlag := fs.Bool("json", false, "print the report as JSON")
+	pairsFlag := fs.Int("pairs", 0, "after timing, run once more counting the opcode pairs executed, and report the `n` most frequent")
+	fs.Parse(args)
+
+	if fs.NArg() < 1 {
+		fmt.Fprintln(os.Stderr, "usage: life bench [-n runs] [-time d] [-pairs n] [-json] [run flags] module.wasm [args...]")
+		os.Exit(2)
+	}
+	if err := vf.check(); err != nil {
+		fmt.Fprintf(os.Stderr, "life bench: %v\n", err)
+		os.Exit(2)
+	}
+
+	path := fs.Arg(0)
+	args = fs.Args()[1:]
+	wasiArgs, args := vf.splitArgs(path, args)
+
+	vm, err := vf.instantiate(path, wasiArgs)
+	if err != nil {
+		panic(err)
+	}
+	entryID, entryName, err := vf.entryFunction(vm)
+	if err != nil {
+		panic(err)
+	}
+	params, err := parseArgs(args, vm.Module.FunctionSignature(entryID).ParamTypes)
+	if err != nil {
+		panic(err)
+	}
+
+	report := &benchReport{Entry: entryName}
+	var total time.Duration
+	for {
+		if report.Runs > 0 {
+			vm.Reset()
+		}
+
+		start := time.Now()
+		if _, err := runEntry(vm, entryID, params); err != nil {
+			fmt.Fprintf(os.Stderr, "life bench: run %d: %v\n", report.Runs, err)
+			os.Exit(1)
+		}
+		d := time.Since(start)
+
+		total += d
+		if report.Runs == 0 || int64(d) < report.MinNS {
+			report.MinNS = int64(d)
+		}
+		if int64(d) > report.MaxNS {
+			report.MaxNS = int64(d)
+		}
+		report.Runs++
+
+		if *runsFlag > 0 && report.Runs >= *runsFlag || *runsFlag <= 0 && total >= *timeFlag {
+			break
+		}
+	}
+	report.MeanNS = int64(total) / int64(report.Runs)
+	if vf.newGasPolicy() != nil {
+		gas := vm.Gas
+		report.Gas = &gas
+	}
+
+	var executed uint64
+	if *pairsFlag > 0 {
+		vm.Reset()
+		var counts *[256][256]uint64
+		counts, executed = countOpcodePairs(vm, func() {
+			if _, err := runEntry(vm, entryID, params); err != nil {
+				fmt.Fprintf(os.Stderr, "life bench: profiling run: %v\n", err)
+				os.Exit(1)
+			}
+		})
+		report.Pairs = topOpcodePairs(counts, *pairsFlag)
+	}
+
+	if *jsonFlag {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(report); err != nil {
+			panic(err)
+		}
+		return
+	}
+
+	fmt.Printf("%s: %d runs, mean %v, min %v, max %v\n", report.Entry, report.Runs,
+		time.Duration(report.MeanNS), time.Duration(report.MinNS), time.Duration(report.MaxNS))
+	if report.Gas != nil {
+		fmt.Printf("gas per run: %d\n", *report.Gas)
+	}
+	if len(report.Pairs) > 0 {
+		fmt.Printf("opcode pairs, of %d instructions executed:\n", executed)
+		for _, p := range report.Pairs {
+			fmt.Printf("  %-14s %-14s %12d  %5.2f%%\n", p.First, p.Second, p.Count, float64(p.Count)*100/float64(executed))
+		}
+	}
+}
+
+// countOpcodePairs calls run with a debug hook installed on vm that counts
+// how often each opcode is followed by each other opcode. Only instructions
+// that follow each other in the bytecode are counted as pairs, as those are
+// the only ones the compiler could fuse. Also returns the total number of
+// instructions executed.
+func countOpcodePairs(vm *exec.VirtualMachine, run func()) (*[256][256]uint64, uint64) {
+	counts := new([256][256]uint64)
+	var executed uint64
+
+	// Length of the instruction at each offset of each function, decoded on
+	// demand.
+	lengths := make([][]int, len(vm.FunctionCode))
+	// Offset the next instruction falls through to, and the opcode of the
+	// last one executed, in each frame, grown with the call stack.
+	var next []int
+	var last []opcodes.Opcode
+
+	vm.DebugHook = func(vm *exec.VirtualMachine, frame *exec.Frame) bool {
+		depth := vm.CurrentFrame
+		for depth >= len(next) {
+			next = append(next, 0)
+			last = append(last, 0)
+		}
+		if lengths[frame.FunctionID] == nil {
+			lengths[frame.FunctionID] = make([]int, len(frame.Code))
+		}
+		n := lengths[frame.FunctionID][frame.IP]
+		if n == 0 {
+			n = compiler.DecodeInstr(frame.Code, frame.IP).Len
+			lengths[frame.FunctionID][frame.IP] = n
+		}
+
+		op := opcodes.Opcode(frame.Code[frame.IP+4])
+		if frame.IP != 0 && frame.IP == next[depth] {
+			counts[last[depth]][op]++
+		}
+		next[depth], last[depth] = frame.IP+n, op
+		executed++
+		return false
+	}
+	defer func() { vm.DebugHook = nil }()
+
+	run()
+	return counts, executed
+}
+
+// topOpcodePairs returns the n most frequent pairs in counts.
+func topOpcodePairs(counts *[256][256]uint64, n int) []opcodePair {
+	var pairs []opcodePair
+	for a := range counts {
+		for b, count := range counts[a] {
+			if count > 0 {
+				pairs = append(pairs, opcodePair{opcodes.Opcode(a).String(), opcodes.Opcode(b).String(), count})
+			}
+		}
+	}
+	sort.SliceStable(pairs, func(i, j int) bool { return pairs[i].Count > pairs[j].Count })
+	if len(pairs) > n {
+		pairs = pairs[:n]
+	}
+	return pairs
+}
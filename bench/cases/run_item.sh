@@ -1,4 +1,4 @@
 #!/bin/bash
 
 echo "Running: $1"
-time ../../life -jit $1
+time ../../life run $1
@@ -0,0 +1,14 @@
+;; Port of bench/cases/fib_recursive.
+(module
+  (func $fib (param $n i32) (result i32)
+    (if (result i32)
+      (i32.or (i32.eq (get_local $n) (i32.const 1)) (i32.eq (get_local $n) (i32.const 2)))
+      (then (i32.const 1))
+      (else
+        (i32.add
+          (call $fib (i32.sub (get_local $n) (i32.const 1)))
+          (call $fib (i32.sub (get_local $n) (i32.const 2)))))))
+
+  (func (export "app_main") (result i32)
+    (call $fib (i32.const 35)))
+)
@@ -0,0 +1,208 @@
+;; Port of bench/cases/pollard_rho_128.
+;;
+;; i128 values are passed as a pair of i64 words. Functions returning one
+;; return the low word and leave the high word in $hi, the way the Rust
+;; compiler's 128-bit arithmetic helpers return through memory.
+(module
+  (global $hi (mut i64) (i64.const 0))
+
+  ;; a * b, modulo 2^128.
+  (func $mul128 (param $alo i64) (param $ahi i64) (param $blo i64) (param $bhi i64) (result i64)
+    (local $a0 i64) (local $a1 i64) (local $b0 i64) (local $b1 i64)
+    (local $p00 i64) (local $p01 i64) (local $p10 i64) (local $mid i64)
+    (set_local $a0 (i64.and (get_local $alo) (i64.const 0xffffffff)))
+    (set_local $a1 (i64.shr_u (get_local $alo) (i64.const 32)))
+    (set_local $b0 (i64.and (get_local $blo) (i64.const 0xffffffff)))
+    (set_local $b1 (i64.shr_u (get_local $blo) (i64.const 32)))
+    (set_local $p00 (i64.mul (get_local $a0) (get_local $b0)))
+    (set_local $p01 (i64.mul (get_local $a0) (get_local $b1)))
+    (set_local $p10 (i64.mul (get_local $a1) (get_local $b0)))
+    (set_local $mid
+      (i64.add
+        (i64.add (i64.shr_u (get_local $p00) (i64.const 32)) (i64.and (get_local $p01) (i64.const 0xffffffff)))
+        (i64.and (get_local $p10) (i64.const 0xffffffff))))
+    (set_global $hi
+      (i64.add
+        (i64.add
+          (i64.add (i64.mul (get_local $a1) (get_local $b1)) (i64.shr_u (get_local $p01) (i64.const 32)))
+          (i64.add (i64.shr_u (get_local $p10) (i64.const 32)) (i64.shr_u (get_local $mid) (i64.const 32))))
+        (i64.add (i64.mul (get_local $alo) (get_local $bhi)) (i64.mul (get_local $ahi) (get_local $blo)))))
+    (i64.or
+      (i64.and (get_local $p00) (i64.const 0xffffffff))
+      (i64.shl (get_local $mid) (i64.const 32))))
+
+  (func $clz128 (param $lo i64) (param $hi i64) (result i64)
+    (if (result i64) (i64.ne (get_local $hi) (i64.const 0))
+      (then (i64.clz (get_local $hi)))
+      (else (i64.add (i64.const 64) (i64.clz (get_local $lo))))))
+
+  ;; n % d, unsigned, by shifting and subtracting.
+  (func $urem128 (param $nlo i64) (param $nhi i64) (param $dlo i64) (param $dhi i64) (result i64)
+    (local $shift i64)
+    (if (i64.eqz (i64.or (get_local $nhi) (get_local $dhi)))
+      (then
+        (set_global $hi (i64.const 0))
+        (return (i64.rem_u (get_local $nlo) (get_local $dlo)))))
+
+    (set_local $shift
+      (i64.sub
+        (call $clz128 (get_local $dlo) (get_local $dhi))
+        (call $clz128 (get_local $nlo) (get_local $nhi))))
+    (if (i64.lt_s (get_local $shift) (i64.const 0))
+      (then
+        (set_global $hi (get_local $nhi))
+        (return (get_local $nlo))))
+
+    ;; d <<= shift
+    (if (i64.ge_u (get_local $shift) (i64.const 64))
+      (then
+        (set_local $dhi (i64.shl (get_local $dlo) (i64.sub (get_local $shift) (i64.const 64))))
+        (set_local $dlo (i64.const 0)))
+      (else
+        (if (i64.ne (get_local $shift) (i64.const 0))
+          (then
+            (set_local $dhi
+              (i64.or
+                (i64.shl (get_local $dhi) (get_local $shift))
+                (i64.shr_u (get_local $dlo) (i64.sub (i64.const 64) (get_local $shift)))))
+            (set_local $dlo (i64.shl (get_local $dlo) (get_local $shift)))))))
+
+    (block $done
+      (loop $next
+        ;; if n >= d { n -= d }
+        (if
+          (i32.or
+            (i64.gt_u (get_local $nhi) (get_local $dhi))
+            (i32.and
+              (i64.eq (get_local $nhi) (get_local $dhi))
+              (i64.ge_u (get_local $nlo) (get_local $dlo))))
+          (then
+            (set_local $nhi
+              (i64.sub
+                (i64.sub (get_local $nhi) (get_local $dhi))
+                (i64.extend_u/i32 (i64.lt_u (get_local $nlo) (get_local $dlo)))))
+            (set_local $nlo (i64.sub (get_local $nlo) (get_local $dlo)))))
+        (br_if $done (i64.eqz (get_local $shift)))
+        ;; d >>= 1
+        (set_local $dlo
+          (i64.or
+            (i64.shr_u (get_local $dlo) (i64.const 1))
+            (i64.shl (get_local $dhi) (i64.const 63))))
+        (set_local $dhi (i64.shr_u (get_local $dhi) (i64.const 1)))
+        (set_local $shift (i64.sub (get_local $shift) (i64.const 1)))
+        (br $next)))
+
+    (set_global $hi (get_local $nhi))
+    (get_local $nlo))
+
+  ;; -x, modulo 2^128.
+  (func $neg128 (param $lo i64) (param $hi i64) (result i64)
+    (set_global $hi
+      (i64.sub
+        (i64.sub (i64.const 0) (get_local $hi))
+        (i64.extend_u/i32 (i64.ne (get_local $lo) (i64.const 0)))))
+    (i64.sub (i64.const 0) (get_local $lo)))
+
+  ;; n % d, signed: the result has the sign of n.
+  (func $rem128 (param $nlo i64) (param $nhi i64) (param $dlo i64) (param $dhi i64) (result i64)
+    (local $neg i32) (local $lo i64)
+    (if (i64.lt_s (get_local $nhi) (i64.const 0))
+      (then
+        (set_local $neg (i32.const 1))
+        (set_local $nlo (call $neg128 (get_local $nlo) (get_local $nhi)))
+        (set_local $nhi (get_global $hi))))
+    (if (i64.lt_s (get_local $dhi) (i64.const 0))
+      (then
+        (set_local $dlo (call $neg128 (get_local $dlo) (get_local $dhi)))
+        (set_local $dhi (get_global $hi))))
+    (set_local $lo (call $urem128 (get_local $nlo) (get_local $nhi) (get_local $dlo) (get_local $dhi)))
+    (if (result i64) (get_local $neg)
+      (then (call $neg128 (get_local $lo) (get_global $hi)))
+      (else (get_local $lo))))
+
+  ;; (x * x + 1) % n
+  (func $g (param $xlo i64) (param $xhi i64) (param $nlo i64) (param $nhi i64) (result i64)
+    (local $lo i64) (local $hi i64)
+    (set_local $lo (call $mul128 (get_local $xlo) (get_local $xhi) (get_local $xlo) (get_local $xhi)))
+    (set_local $hi (get_global $hi))
+    (set_local $lo (i64.add (get_local $lo) (i64.const 1)))
+    (set_local $hi (i64.add (get_local $hi) (i64.extend_u/i32 (i64.eqz (get_local $lo)))))
+    (call $rem128 (get_local $lo) (get_local $hi) (get_local $nlo) (get_local $nhi)))
+
+  (func $gcd (param $mlo i64) (param $mhi i64) (param $nlo i64) (param $nhi i64) (result i64)
+    (local $oldlo i64) (local $oldhi i64)
+    (block $done
+      (loop $next
+        (br_if $done (i64.eqz (i64.or (get_local $mlo) (get_local $mhi))))
+        (set_local $oldlo (get_local $mlo))
+        (set_local $oldhi (get_local $mhi))
+        (set_local $mlo (call $rem128 (get_local $nlo) (get_local $nhi) (get_local $mlo) (get_local $mhi)))
+        (set_local $mhi (get_global $hi))
+        (set_local $nlo (get_local $oldlo))
+        (set_local $nhi (get_local $oldhi))
+        (br $next)))
+    ;; n.abs()
+    (if (result i64) (i64.lt_s (get_local $nhi) (i64.const 0))
+      (then (call $neg128 (get_local $nlo) (get_local $nhi)))
+      (else
+        (set_global $hi (get_local $nhi))
+        (get_local $nlo))))
+
+  ;; Returns the smaller factor of n, and leaves the larger one in $hi.
+  (func $pollard_rho_factor_i64 (param $n i64) (result i64)
+    (local $nhi i64)
+    (local $xlo i64) (local $xhi i64)
+    (local $ylo i64) (local $yhi i64)
+    (local $dlo i64) (local $dhi i64)
+    (local $tlo i64) (local $thi i64)
+    (set_local $nhi (i64.shr_s (get_local $n) (i64.const 63)))
+    (set_local $xlo (i64.const 5))
+    (set_local $ylo (i64.const 5))
+    (set_local $dlo (i64.const 1))
+
+    (block $done
+      (loop $next
+        (br_if $done
+          (i32.eqz (i32.and (i64.eq (get_local $dlo) (i64.const 1)) (i64.eqz (get_local $dhi)))))
+
+        (set_local $xlo (call $g (get_local $xlo) (get_local $xhi) (get_local $n) (get_local $nhi)))
+        (set_local $xhi (get_global $hi))
+        (set_local $ylo (call $g (get_local $ylo) (get_local $yhi) (get_local $n) (get_local $nhi)))
+        (set_local $yhi (get_global $hi))
+        (set_local $ylo (call $g (get_local $ylo) (get_local $yhi) (get_local $n) (get_local $nhi)))
+        (set_local $yhi (get_global $hi))
+
+        ;; t = (x - y).abs()
+        (set_local $tlo (i64.sub (get_local $xlo) (get_local $ylo)))
+        (set_local $thi
+          (i64.sub
+            (i64.sub (get_local $xhi) (get_local $yhi))
+            (i64.extend_u/i32 (i64.lt_u (get_local $xlo) (get_local $ylo)))))
+        (if (i64.lt_s (get_local $thi) (i64.const 0))
+          (then
+            (set_local $tlo (call $neg128 (get_local $tlo) (get_local $thi)))
+            (set_local $thi (get_global $hi))))
+
+        (set_local $dlo (call $gcd (get_local $tlo) (get_local $thi) (get_local $n) (get_local $nhi)))
+        (set_local $dhi (get_global $hi))
+        (br $next)))
+
+    (if (result i64) (i32.and (i64.eq (get_local $dlo) (get_local $n)) (i64.eq (get_local $dhi) (get_local $nhi)))
+      (then
+        (set_global $hi (get_local $n))
+        (i64.const 1))
+      (else
+        (set_global $hi (i64.div_s (get_local $n) (get_local $dlo)))
+        (get_local $dlo))))
+
+  (func (export "app_main") (result i64)
+    (local $r1 i64) (local $r2 i64) (local $t i64)
+    (set_local $r1 (call $pollard_rho_factor_i64 (i64.mul (i64.const 613676879) (i64.const 895640371))))
+    (set_local $r2 (get_global $hi))
+    (if (i64.gt_s (get_local $r1) (get_local $r2))
+      (then
+        (set_local $t (get_local $r1))
+        (set_local $r1 (get_local $r2))
+        (set_local $r2 (get_local $t))))
+    (i64.or (i64.shl (get_local $r1) (i64.const 32)) (get_local $r2)))
+)
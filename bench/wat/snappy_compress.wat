@@ -0,0 +1,213 @@
+;; Port of bench/cases/snappy_compress: compresses 8 MiB of zeroes with the
+;; Snappy block format, the way the snap crate does.
+;;
+;; Memory layout: the input at 0, the hash table at 8 MiB and the output
+;; after it.
+(module
+  (memory 300)
+
+  (func $hash (param $v i32) (result i32)
+    (i32.shr_u (i32.mul (get_local $v) (i32.const 0x1e35a7bd)) (i32.const 18)))
+
+  (func $memcpy (param $dst i32) (param $src i32) (param $len i32)
+    (block $done
+      (loop $words
+        (br_if $done (i32.lt_u (get_local $len) (i32.const 8)))
+        (i64.store (get_local $dst) (i64.load (get_local $src)))
+        (set_local $dst (i32.add (get_local $dst) (i32.const 8)))
+        (set_local $src (i32.add (get_local $src) (i32.const 8)))
+        (set_local $len (i32.sub (get_local $len) (i32.const 8)))
+        (br $words)))
+    (block $done
+      (loop $bytes
+        (br_if $done (i32.eqz (get_local $len)))
+        (i32.store8 (get_local $dst) (i32.load8_u (get_local $src)))
+        (set_local $dst (i32.add (get_local $dst) (i32.const 1)))
+        (set_local $src (i32.add (get_local $src) (i32.const 1)))
+        (set_local $len (i32.sub (get_local $len) (i32.const 1)))
+        (br $bytes))))
+
+  (func $emit_literal (param $dst i32) (param $src i32) (param $len i32) (result i32)
+    (local $n i32)
+    (set_local $n (i32.sub (get_local $len) (i32.const 1)))
+    (if (i32.lt_u (get_local $n) (i32.const 60))
+      (then
+        (i32.store8 (get_local $dst) (i32.shl (get_local $n) (i32.const 2)))
+        (set_local $dst (i32.add (get_local $dst) (i32.const 1))))
+      (else
+        (if (i32.lt_u (get_local $n) (i32.const 256))
+          (then
+            (i32.store8 (get_local $dst) (i32.const 240))
+            (i32.store8 offset=1 (get_local $dst) (get_local $n))
+            (set_local $dst (i32.add (get_local $dst) (i32.const 2))))
+          (else
+            (i32.store8 (get_local $dst) (i32.const 244))
+            (i32.store16 offset=1 (get_local $dst) (get_local $n))
+            (set_local $dst (i32.add (get_local $dst) (i32.const 3)))))))
+    (call $memcpy (get_local $dst) (get_local $src) (get_local $len))
+    (i32.add (get_local $dst) (get_local $len)))
+
+  (func $emit_copy2 (param $dst i32) (param $offset i32) (param $len i32) (result i32)
+    (i32.store8 (get_local $dst)
+      (i32.or (i32.shl (i32.sub (get_local $len) (i32.const 1)) (i32.const 2)) (i32.const 2)))
+    (i32.store16 offset=1 (get_local $dst) (get_local $offset))
+    (i32.add (get_local $dst) (i32.const 3)))
+
+  (func $emit_copy (param $dst i32) (param $offset i32) (param $len i32) (result i32)
+    (block $done
+      (loop $long
+        (br_if $done (i32.lt_u (get_local $len) (i32.const 68)))
+        (set_local $dst (call $emit_copy2 (get_local $dst) (get_local $offset) (i32.const 64)))
+        (set_local $len (i32.sub (get_local $len) (i32.const 64)))
+        (br $long)))
+    (if (i32.gt_u (get_local $len) (i32.const 64))
+      (then
+        (set_local $dst (call $emit_copy2 (get_local $dst) (get_local $offset) (i32.const 60)))
+        (set_local $len (i32.sub (get_local $len) (i32.const 60)))))
+    (if (result i32)
+      (i32.and (i32.le_u (get_local $len) (i32.const 11)) (i32.le_u (get_local $offset) (i32.const 2047)))
+      (then
+        (i32.store8 (get_local $dst)
+          (i32.or
+            (i32.or
+              (i32.shl (i32.shr_u (get_local $offset) (i32.const 8)) (i32.const 5))
+              (i32.shl (i32.sub (get_local $len) (i32.const 4)) (i32.const 2)))
+            (i32.const 1)))
+        (i32.store8 offset=1 (get_local $dst) (get_local $offset))
+        (i32.add (get_local $dst) (i32.const 2)))
+      (else
+        (call $emit_copy2 (get_local $dst) (get_local $offset) (get_local $len)))))
+
+  ;; Returns the position of the first byte from s on that differs from the
+  ;; byte as far behind it as cand is, or end.
+  (func $extend_match (param $cand i32) (param $s i32) (param $end i32) (result i32)
+    (local $x i64)
+    (block $done
+      (loop $words
+        (br_if $done (i32.gt_u (i32.add (get_local $s) (i32.const 8)) (get_local $end)))
+        (set_local $x (i64.xor (i64.load (get_local $s)) (i64.load (get_local $cand))))
+        (if (i64.ne (get_local $x) (i64.const 0))
+          (then
+            (return
+              (i32.add (get_local $s)
+                (i32.wrap/i64 (i64.shr_u (i64.ctz (get_local $x)) (i64.const 3)))))))
+        (set_local $s (i32.add (get_local $s) (i32.const 8)))
+        (set_local $cand (i32.add (get_local $cand) (i32.const 8)))
+        (br $words)))
+    (block $done
+      (loop $bytes
+        (br_if $done (i32.ge_u (get_local $s) (get_local $end)))
+        (br_if $done (i32.ne (i32.load8_u (get_local $s)) (i32.load8_u (get_local $cand))))
+        (set_local $s (i32.add (get_local $s) (i32.const 1)))
+        (set_local $cand (i32.add (get_local $cand) (i32.const 1)))
+        (br $bytes)))
+    (get_local $s))
+
+  (func $compress_block (param $src i32) (param $len i32) (param $dst i32) (result i32)
+    (local $table i32) (local $end i32) (local $limit i32)
+    (local $s i32) (local $next_s i32) (local $next_emit i32) (local $skip i32)
+    (local $candidate i32) (local $next_hash i32) (local $h i32) (local $base i32)
+    (set_local $table (i32.const 0x800000))
+    (set_local $end (i32.add (get_local $src) (get_local $len)))
+    (set_local $limit (i32.sub (get_local $end) (i32.const 15)))
+    (set_local $next_emit (get_local $src))
+
+    ;; Clear the table.
+    (set_local $h (i32.const 0))
+    (block $done
+      (loop $clear
+        (br_if $done (i32.ge_u (get_local $h) (i32.const 32768)))
+        (i64.store (i32.add (get_local $table) (get_local $h)) (i64.const 0))
+        (set_local $h (i32.add (get_local $h) (i32.const 8)))
+        (br $clear)))
+
+    (set_local $s (i32.add (get_local $src) (i32.const 1)))
+    (set_local $next_hash (call $hash (i32.load (get_local $s))))
+
+    (block $emit_remainder
+      (loop $outer
+        ;; Look for a match, checking every byte at first and skipping ahead
+        ;; faster the longer none is found.
+        (set_local $skip (i32.const 32))
+        (set_local $next_s (get_local $s))
+        (loop $find
+          (set_local $s (get_local $next_s))
+          (set_local $next_s (i32.add (get_local $s) (i32.shr_u (get_local $skip) (i32.const 5))))
+          (set_local $skip (i32.add (get_local $skip) (i32.shr_u (get_local $skip) (i32.const 5))))
+          (br_if $emit_remainder (i32.gt_u (get_local $next_s) (get_local $limit)))
+          (set_local $h (i32.add (get_local $table) (i32.shl (get_local $next_hash) (i32.const 1))))
+          (set_local $candidate (i32.add (get_local $src) (i32.load16_u (get_local $h))))
+          (i32.store16 (get_local $h) (i32.sub (get_local $s) (get_local $src)))
+          (set_local $next_hash (call $hash (i32.load (get_local $next_s))))
+          (br_if $find (i32.ne (i32.load (get_local $s)) (i32.load (get_local $candidate)))))
+
+        (set_local $dst
+          (call $emit_literal (get_local $dst) (get_local $next_emit) (i32.sub (get_local $s) (get_local $next_emit))))
+
+        ;; Emit copies for as long as the next bytes match as well.
+        (loop $copies
+          (set_local $base (get_local $s))
+          (set_local $s
+            (call $extend_match
+              (i32.add (get_local $candidate) (i32.const 4))
+              (i32.add (get_local $s) (i32.const 4))
+              (get_local $end)))
+          (set_local $dst
+            (call $emit_copy
+              (get_local $dst)
+              (i32.sub (get_local $base) (get_local $candidate))
+              (i32.sub (get_local $s) (get_local $base))))
+          (set_local $next_emit (get_local $s))
+          (br_if $emit_remainder (i32.ge_u (get_local $s) (get_local $limit)))
+
+          (set_local $h (i32.add (get_local $table)
+            (i32.shl (call $hash (i32.load (i32.sub (get_local $s) (i32.const 1)))) (i32.const 1))))
+          (i32.store16 (get_local $h) (i32.sub (i32.sub (get_local $s) (i32.const 1)) (get_local $src)))
+          (set_local $h (i32.add (get_local $table)
+            (i32.shl (call $hash (i32.load (get_local $s))) (i32.const 1))))
+          (set_local $candidate (i32.add (get_local $src) (i32.load16_u (get_local $h))))
+          (i32.store16 (get_local $h) (i32.sub (get_local $s) (get_local $src)))
+          (br_if $copies (i32.eq (i32.load (get_local $s)) (i32.load (get_local $candidate)))))
+
+        (set_local $s (i32.add (get_local $s) (i32.const 1)))
+        (set_local $next_hash (call $hash (i32.load (get_local $s))))
+        (br $outer)))
+
+    (if (result i32) (i32.lt_u (get_local $next_emit) (get_local $end))
+      (then
+        (call $emit_literal (get_local $dst) (get_local $next_emit)
+          (i32.sub (get_local $end) (get_local $next_emit))))
+      (else (get_local $dst))))
+
+  (func (export "app_main") (result i32)
+    (local $src i32) (local $n i32) (local $out i32) (local $dst i32) (local $v i32)
+    (set_local $out (i32.const 0x808000))
+    (set_local $dst (get_local $out))
+
+    ;; The uncompressed length, as a varint.
+    (set_local $v (i32.const 0x800000))
+    (block $done
+      (loop $varint
+        (br_if $done (i32.lt_u (get_local $v) (i32.const 0x80)))
+        (i32.store8 (get_local $dst) (i32.or (get_local $v) (i32.const 0x80)))
+        (set_local $dst (i32.add (get_local $dst) (i32.const 1)))
+        (set_local $v (i32.shr_u (get_local $v) (i32.const 7)))
+        (br $varint)))
+    (i32.store8 (get_local $dst) (get_local $v))
+    (set_local $dst (i32.add (get_local $dst) (i32.const 1)))
+
+    (block $done
+      (loop $blocks
+        (br_if $done (i32.ge_u (get_local $src) (i32.const 0x800000)))
+        (set_local $n (i32.sub (i32.const 0x800000) (get_local $src)))
+        (if (i32.gt_u (get_local $n) (i32.const 65536))
+          (then (set_local $n (i32.const 65536))))
+        (set_local $dst
+          (if (result i32) (i32.lt_u (get_local $n) (i32.const 17))
+            (then (call $emit_literal (get_local $dst) (get_local $src) (get_local $n)))
+            (else (call $compress_block (get_local $src) (get_local $n) (get_local $dst)))))
+        (set_local $src (i32.add (get_local $src) (get_local $n)))
+        (br $blocks)))
+
+    (i32.sub (get_local $dst) (get_local $out)))
+)
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/perlin-network/life/compiler"
+	"github.com/perlin-network/life/wat"
+)
+
+// compileReport is the outcome of `life compile`.
+type compileReport struct {
+	LoadNS     int64              `json:"load_ns"` // time to decode and validate the module
+	CompileNS  int64              `json:"compile_ns"`
+	CodeSize   int                `json:"code_size"` // bytes of interpreter bytecode
+	Functions  []compiledFunction `json:"functions"`
+	NumImports int                `json:"num_imports"`
+}
+
+type compiledFunction struct {
+	Index    int    `json:"index"`
+	Name     string `json:"name"`
+	NumRegs  int    `json:"num_regs"`
+	CodeSize int    `json:"code_size"`
+}
+
+// compileMain implements `life compile [flags] module.wasm`, which compiles a
+// module for the interpreter and reports how long it took and what it
+// produced.
+func compileMain(args []string) {
+	fs := flag.NewFlagSet("compile", flag.ExitOnError)
+	gasFlag := fs.Bool("gas", false, "insert gas counters")
+	coverageFlag := fs.Bool("coverage", false, "insert coverage counters")
+	noFloatFlag := fs.Bool("no-fp", false, "disable floating point")
+	detFloatFlag := fs.Bool("deterministic-fp", false, "canonicalize NaNs and trap on invalid float to integer conversions")
+	optFlag := fs.Int("O", 0, fmt.Sprintf("optimization level, 0 to %d", compiler.MaxOptLevel))
+	workersFlag := fs.Int("workers", 0, "number of functions compiled in parallel; 0 for one per CPU")
+	verboseFlag := fs.Bool("v", false, "list each function")
+	jsonFlag := fs.Bool("json", false, "print the report as JSON")
+	fs.Parse(args)
+
+	if fs.NArg() != 1 || *optFlag < 0 || *optFlag > compiler.MaxOptLevel || *workersFlag < 0 {
+		fmt.Fprintln(os.Stderr, "usage: life compile [-gas] [-coverage] [-no-fp] [-deterministic-fp] [-O level] [-workers n] [-v] [-json] module.wasm")
+		os.Exit(2)
+	}
+
+	input, err := ioutil.ReadFile(fs.Arg(0))
+	if err != nil {
+		panic(err)
+	}
+
+	start := time.Now()
+	if wat.IsText(input) {
+		if input, err = wat.Parse(input); err != nil {
+			panic(err)
+		}
+	}
+	m, err := compiler.LoadModule(input)
+	if err != nil {
+		panic(err)
+	}
+	report := &compileReport{LoadNS: int64(time.Since(start))}
+
+	m.DisableFloatingPoint = *noFloatFlag
+	m.DeterministicFloatingPoint = *detFloatFlag
+	m.EnableCoverage = *coverageFlag
+	m.OptLevel = *optFlag
+	var gp compiler.GasPolicy
+	if *gasFlag {
+		gp = &compiler.SimpleGasPolicy{GasPerInstruction: 1}
+	}
+
+	start = time.Now()
+	code, err := m.CompileForInterpreterContext(context.Background(), compiler.CompileOptions{
+		GasPolicy: gp,
+		Workers:   *workersFlag,
+	})
+	if err != nil {
+		if cerr, ok := err.(*compiler.CompileError); ok {
+			for _, ferr := range cerr.Functions {
+				fmt.Fprintf(os.Stderr, "life compile: %v\n", ferr)
+			}
+			os.Exit(1)
+		}
+		panic(err)
+	}
+	report.CompileNS = int64(time.Since(start))
+
+	report.Functions = make([]compiledFunction, 0, len(code))
+	report.NumImports = len(code) - len(m.Base.FunctionIndexSpace)
+	for id, c := range code[report.NumImports:] {
+		id += report.NumImports
+		report.CodeSize += len(c.Bytes)
+		report.Functions = append(report.Functions, compiledFunction{
+			Index:    id,
+			Name:     m.FunctionName(id),
+			NumRegs:  c.NumRegs,
+			CodeSize: len(c.Bytes),
+		})
+	}
+
+	if *jsonFlag {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(report); err != nil {
+			panic(err)
+		}
+		return
+	}
+
+	fmt.Printf("load: %v\n", time.Duration(report.LoadNS))
+	fmt.Printf("compile: %v\n", time.Duration(report.CompileNS))
+	fmt.Printf("functions: %d, %d bytes of bytecode\n", len(report.Functions), report.CodeSize)
+	if *verboseFlag {
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		for _, f := range report.Functions {
+			fmt.Fprintf(w, "  %d\t%s\t%d registers\t%d bytes\n", f.Index, f.Name, f.NumRegs, f.CodeSize)
+		}
+		w.Flush()
+	}
+}
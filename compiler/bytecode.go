@@ -0,0 +1,237 @@
+package compiler
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/perlin-network/life/compiler/opcodes"
+	"github.com/perlin-network/life/utils"
+)
+
+// BytecodeInstr is a single interpreter instruction decoded from the output of Serialize.
+type BytecodeInstr struct {
+	Offset int // offset of the instruction in the bytecode
+	Len    int // encoded length of the instruction in bytes
+
+	Target     uint32 // the register we are assigning to
+	Op         opcodes.Opcode
+	Immediates []int64  // immediate operands, including jump targets
+	Values     []uint32 // registers read by the instruction
+}
+
+// DecodeInstr decodes the instruction at the given bytecode offset.
+// Panics on malformed bytecode.
+func DecodeInstr(code []byte, ip int) BytecodeInstr {
+	le := binary.LittleEndian
+
+	ins := BytecodeInstr{
+		Offset: ip,
+		Target: le.Uint32(code[ip : ip+4]),
+		Op:     opcodes.Opcode(code[ip+4]),
+	}
+	pos := ip + 5
+
+	imm32 := func() {
+		ins.Immediates = append(ins.Immediates, int64(le.Uint32(code[pos:pos+4])))
+		pos += 4
+	}
+	imm64 := func() {
+		ins.Immediates = append(ins.Immediates, int64(le.Uint64(code[pos:pos+8])))
+		pos += 8
+	}
+	simm32 := func() {
+		ins.Immediates = append(ins.Immediates, int64(int32(le.Uint32(code[pos:pos+4]))))
+		pos += 4
+	}
+	reg := func() {
+		ins.Values = append(ins.Values, le.Uint32(code[pos:pos+4]))
+		pos += 4
+	}
+
+	switch ins.Op {
+	case opcodes.Nop, opcodes.Unreachable, opcodes.ReturnVoid, opcodes.CurrentMemory, opcodes.Phi, opcodes.FPDisabledError:
+
+	case opcodes.Select:
+		reg()
+		reg()
+		reg()
+
+	case opcodes.I32Const:
+		simm32()
+
+	case opcodes.I64Const, opcodes.AddGas:
+		imm64()
+
+	case opcodes.I32Add, opcodes.I32Sub, opcodes.I32Mul, opcodes.I32DivS, opcodes.I32DivU, opcodes.I32RemS, opcodes.I32RemU,
+		opcodes.I32And, opcodes.I32Or, opcodes.I32Xor, opcodes.I32Shl, opcodes.I32ShrS, opcodes.I32ShrU, opcodes.I32Rotl, opcodes.I32Rotr,
+		opcodes.I32Eq, opcodes.I32Ne, opcodes.I32LtS, opcodes.I32LtU, opcodes.I32LeS, opcodes.I32LeU,
+		opcodes.I32GtS, opcodes.I32GtU, opcodes.I32GeS, opcodes.I32GeU,
+		opcodes.I64Add, opcodes.I64Sub, opcodes.I64Mul, opcodes.I64DivS, opcodes.I64DivU, opcodes.I64RemS, opcodes.I64RemU,
+		opcodes.I64Rotl, opcodes.I64Rotr, opcodes.I64And, opcodes.I64Or, opcodes.I64Xor, opcodes.I64Shl, opcodes.I64ShrS, opcodes.I64ShrU,
+		opcodes.I64Eq, opcodes.I64Ne, opcodes.I64LtS, opcodes.I64LtU, opcodes.I64LeS, opcodes.I64LeU,
+		opcodes.I64GtS, opcodes.I64GtU, opcodes.I64GeS, opcodes.I64GeU,
+		opcodes.F32Add, opcodes.F32Sub, opcodes.F32Mul, opcodes.F32Div, opcodes.F32Min, opcodes.F32Max, opcodes.F32CopySign,
+		opcodes.F32Eq, opcodes.F32Ne, opcodes.F32Lt, opcodes.F32Le, opcodes.F32Gt, opcodes.F32Ge,
+		opcodes.F64Add, opcodes.F64Sub, opcodes.F64Mul, opcodes.F64Div, opcodes.F64Min, opcodes.F64Max, opcodes.F64CopySign,
+		opcodes.F64Eq, opcodes.F64Ne, opcodes.F64Lt, opcodes.F64Le, opcodes.F64Gt, opcodes.F64Ge,
+		opcodes.F32AddSoft, opcodes.F32SubSoft, opcodes.F32MulSoft, opcodes.F32DivSoft, opcodes.F32MinSoft, opcodes.F32MaxSoft,
+		opcodes.F64AddSoft, opcodes.F64SubSoft, opcodes.F64MulSoft, opcodes.F64DivSoft, opcodes.F64MinSoft, opcodes.F64MaxSoft:
+		reg()
+		reg()
+
+	case opcodes.I32Clz, opcodes.I32Ctz, opcodes.I32PopCnt, opcodes.I32EqZ,
+		opcodes.I64Clz, opcodes.I64Ctz, opcodes.I64PopCnt, opcodes.I64EqZ,
+		opcodes.F32Sqrt, opcodes.F32Ceil, opcodes.F32Floor, opcodes.F32Trunc, opcodes.F32Nearest, opcodes.F32Abs, opcodes.F32Neg,
+		opcodes.F64Sqrt, opcodes.F64Ceil, opcodes.F64Floor, opcodes.F64Trunc, opcodes.F64Nearest, opcodes.F64Abs, opcodes.F64Neg,
+		opcodes.I32WrapI64, opcodes.I32TruncUF32, opcodes.I32TruncUF64, opcodes.I32TruncSF32, opcodes.I32TruncSF64,
+		opcodes.I64TruncUF32, opcodes.I64TruncUF64, opcodes.I64TruncSF32, opcodes.I64TruncSF64,
+		opcodes.I64ExtendUI32, opcodes.I64ExtendSI32,
+		opcodes.F32DemoteF64, opcodes.F64PromoteF32,
+		opcodes.F32ConvertSI32, opcodes.F32ConvertSI64, opcodes.F32ConvertUI32, opcodes.F32ConvertUI64,
+		opcodes.F64ConvertSI32, opcodes.F64ConvertSI64, opcodes.F64ConvertUI32, opcodes.F64ConvertUI64,
+		opcodes.F32CanonicalizeNaN, opcodes.F64CanonicalizeNaN,
+		opcodes.I32TruncSCheckedF32, opcodes.I32TruncSCheckedF64, opcodes.I32TruncUCheckedF32, opcodes.I32TruncUCheckedF64,
+		opcodes.I64TruncSCheckedF32, opcodes.I64TruncSCheckedF64, opcodes.I64TruncUCheckedF32, opcodes.I64TruncUCheckedF64,
+		opcodes.F32SqrtSoft, opcodes.F32CeilSoft, opcodes.F32FloorSoft, opcodes.F32TruncSoft, opcodes.F32NearestSoft,
+		opcodes.F64SqrtSoft, opcodes.F64CeilSoft, opcodes.F64FloorSoft, opcodes.F64TruncSoft, opcodes.F64NearestSoft,
+		opcodes.I32TruncSSoftF32, opcodes.I32TruncSSoftF64, opcodes.I32TruncUSoftF32, opcodes.I32TruncUSoftF64,
+		opcodes.I64TruncSSoftF32, opcodes.I64TruncSSoftF64, opcodes.I64TruncUSoftF32, opcodes.I64TruncUSoftF64,
+		opcodes.F32ConvertSSoftI32, opcodes.F32ConvertSSoftI64, opcodes.F32ConvertUSoftI32, opcodes.F32ConvertUSoftI64,
+		opcodes.F64ConvertSSoftI32, opcodes.F64ConvertSSoftI64, opcodes.F64ConvertUSoftI32, opcodes.F64ConvertUSoftI64,
+		opcodes.F32DemoteSoftF64, opcodes.F64PromoteSoftF32,
+		opcodes.ReturnValue, opcodes.GrowMemory:
+		reg()
+
+	case opcodes.I32Load, opcodes.I64Load,
+		opcodes.I32Load8S, opcodes.I32Load16S, opcodes.I64Load8S, opcodes.I64Load16S, opcodes.I64Load32S,
+		opcodes.I32Load8U, opcodes.I32Load16U, opcodes.I64Load8U, opcodes.I64Load16U, opcodes.I64Load32U:
+		imm32() // alignment flags
+		imm32() // offset
+		reg()   // base address
+
+	case opcodes.I32Store, opcodes.I64Store, opcodes.I32Store8, opcodes.I32Store16,
+		opcodes.I64Store8, opcodes.I64Store16, opcodes.I64Store32:
+		imm32() // alignment flags
+		imm32() // offset
+		reg()   // base address
+		reg()   // value
+
+	case opcodes.Jmp:
+		imm32()
+		reg()
+
+	case opcodes.JmpIf:
+		imm32()
+		reg()
+		reg()
+
+	case opcodes.JmpEither:
+		imm32()
+		imm32()
+		reg()
+		reg()
+
+	case opcodes.JmpTable:
+		targetCount := int(le.Uint32(code[pos : pos+4]))
+		pos += 4
+		for i := 0; i <= targetCount; i++ {
+			imm32()
+		}
+		reg()
+		reg()
+
+	case opcodes.GetLocal, opcodes.GetGlobal, opcodes.InvokeImport, opcodes.CoverBlock:
+		imm32()
+
+	case opcodes.SetLocal, opcodes.SetGlobal:
+		imm32()
+		reg()
+
+	case opcodes.Call, opcodes.CallIndirect:
+		imm32()
+		argCount := int(le.Uint32(code[pos : pos+4]))
+		pos += 4
+		for i := 0; i < argCount; i++ {
+			reg()
+		}
+
+	case opcodes.I32AddImm, opcodes.I32EqImm, opcodes.I64ShrUImm, opcodes.I64ShlImm:
+		reg()
+		simm32()
+
+	case opcodes.I64AddImm:
+		reg()
+		imm64()
+
+	case opcodes.I32AddLocalImm, opcodes.I32EqLocalImm:
+		imm32() // local
+		simm32()
+
+	case opcodes.JmpIfI32GtU, opcodes.JmpIfI32GeU, opcodes.JmpIfI32Or, opcodes.JmpIfI64Ne:
+		imm32()
+		reg()
+		reg()
+		reg()
+
+	case opcodes.JmpIfI64EqZ:
+		imm32()
+		reg()
+		reg()
+
+	case opcodes.I32LoadAddImm, opcodes.I64LoadAddImm:
+		imm32()  // offset
+		simm32() // added to the base address
+		reg()    // base address
+
+	default:
+		panic(fmt.Errorf("unknown opcode %d at offset %d", ins.Op, ip))
+	}
+
+	ins.Len = pos - ip
+	return ins
+}
+
+// DecodeBytecode decodes all instructions of the given bytecode.
+func DecodeBytecode(code []byte) (_ret []BytecodeInstr, retErr error) {
+	defer utils.CatchPanic(&retErr)
+
+	ret := make([]BytecodeInstr, 0)
+	for ip := 0; ip < len(code); {
+		ins := DecodeInstr(code, ip)
+		ret = append(ret, ins)
+		ip += ins.Len
+	}
+	return ret, nil
+}
+
+// IsStore returns whether the instruction writes to linear memory, along with
+// the number of bytes written.
+func (ins *BytecodeInstr) IsStore() (bool, int) {
+	switch ins.Op {
+	case opcodes.I32Store8, opcodes.I64Store8:
+		return true, 1
+	case opcodes.I32Store16, opcodes.I64Store16:
+		return true, 2
+	case opcodes.I32Store, opcodes.I64Store32:
+		return true, 4
+	case opcodes.I64Store:
+		return true, 8
+	default:
+		return false, 0
+	}
+}
+
+func (ins BytecodeInstr) String() string {
+	s := ""
+	if ins.Target != 0 {
+		s = fmt.Sprintf("%%%d = ", ins.Target)
+	}
+	s += ins.Op.String()
+	for _, imm := range ins.Immediates {
+		s += fmt.Sprintf(" %d", imm)
+	}
+	for _, v := range ins.Values {
+		s += fmt.Sprintf(" %%%d", v)
+	}
+	return s
+}
@@ -1,5 +1,7 @@
 package compiler
 
+import "sort"
+
 type CFGraph struct {
 	Blocks []BasicBlock
 }
@@ -33,7 +35,7 @@ func (g *CFGraph) ToInsSeq() []Instr {
 		for _, op := range bb.Code {
 			out = append(out, op)
 		}
-		out = append(out, Instr{}) // jmp placeholder
+		out = append(out, Instr{WasmOffset: -1}) // jmp placeholder
 		blockEnds[i] = len(out)
 	}
 
@@ -70,33 +72,32 @@ func (g *CFGraph) ToInsSeq() []Instr {
 
 func (c *SSAFunctionCompiler) NewCFGraph() *CFGraph {
 	g := &CFGraph{}
-	insLabels := make(map[int]int)
-
-	insLabels[0] = 0
-	nextLabel := 1
 
+	// Blocks start at jump targets and after jumps, and are numbered in
+	// code order.
+	starts := map[int]struct{}{0: {}}
 	for i, ins := range c.Code {
 		switch ins.Op {
 		case "jmp", "jmp_if", "jmp_either", "jmp_table":
 			for _, target := range ins.Immediates {
-				if _, ok := insLabels[int(target)]; !ok {
-					insLabels[int(target)] = nextLabel
-					nextLabel++
-				}
-			}
-			if _, ok := insLabels[i+1]; !ok {
-				insLabels[i+1] = nextLabel
-				nextLabel++
+				starts[int(target)] = struct{}{}
 			}
+			starts[i+1] = struct{}{}
 		case "return":
-			if _, ok := insLabels[i+1]; !ok {
-				insLabels[i+1] = nextLabel
-				nextLabel++
-			}
+			starts[i+1] = struct{}{}
 		}
 	}
+	positions := make([]int, 0, len(starts))
+	for pos := range starts {
+		positions = append(positions, pos)
+	}
+	sort.Ints(positions)
+	insLabels := make(map[int]int)
+	for label, pos := range positions {
+		insLabels[pos] = label
+	}
 
-	g.Blocks = make([]BasicBlock, nextLabel)
+	g.Blocks = make([]BasicBlock, len(positions))
 	var currentBlock *BasicBlock
 
 	for i, ins := range c.Code {
@@ -0,0 +1,168 @@
+package compiler
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/perlin-network/life/utils"
+)
+
+// CompileOptions configures CompileForInterpreterContext.
+type CompileOptions struct {
+	GasPolicy GasPolicy
+
+	// Workers is the number of functions compiled in parallel; 0 for
+	// runtime.NumCPU().
+	Workers int
+
+	// Progress, if set, is called each time a function is compiled, with the
+	// number of functions compiled so far and the number of functions defined
+	// by the module. Calls are serialized.
+	Progress func(done, total int)
+}
+
+// FunctionError describes why a function failed to compile.
+type FunctionError struct {
+	FunctionIndex int    // index in the function index space
+	Name          string // see Module.FunctionName
+	Offset        int    // offset in the module binary of the failing instruction, or of the function body if unknown; -1 if the body is not found
+	Err           error
+}
+
+func (e *FunctionError) Error() string {
+	if e.Offset < 0 {
+		return fmt.Sprintf("cannot compile function %d (%s): %v", e.FunctionIndex, e.Name, e.Err)
+	}
+	return fmt.Sprintf("cannot compile function %d (%s) at 0x%x: %v", e.FunctionIndex, e.Name, e.Offset, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *FunctionError) Unwrap() error {
+	return e.Err
+}
+
+// CompileError holds the functions that failed to compile, by increasing
+// index. Compilation stops at the first failure, so functions compiled
+// concurrently with it are the only others that may be listed.
+type CompileError struct {
+	Functions []*FunctionError
+}
+
+func (e *CompileError) Error() string {
+	if len(e.Functions) == 1 {
+		return e.Functions[0].Error()
+	}
+	return fmt.Sprintf("%v (and %d more functions)", e.Functions[0], len(e.Functions)-1)
+}
+
+// CompileForInterpreterContext compiles all functions of the module for the
+// interpreter, on opts.Workers goroutines. It stops at the first function that
+// fails to compile, returning a *CompileError, or once ctx is done, returning
+// ctx.Err(). Functions being compiled at that point are completed first, and
+// no goroutine is left running on return.
+func (m *Module) CompileForInterpreterContext(ctx context.Context, opts CompileOptions) (_ []InterpreterCode, retErr error) {
+	defer utils.CatchPanic(&retErr)
+
+	env, err := m.funcEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]InterpreterCode, 0)
+	for i, tyID := range env.importTypeIDs {
+		ret = append(ret, importCode(&m.Base.Types.Entries[tyID], i))
+	}
+
+	numFuncImports := len(ret)
+	funcIndexSpaceLen := len(m.Base.FunctionIndexSpace)
+	ret = append(ret, make([]InterpreterCode, funcIndexSpaceLen)...)
+
+	n := opts.Workers
+	if n <= 0 {
+		n = runtime.NumCPU()
+	}
+	if n > funcIndexSpaceLen {
+		n = funcIndexSpaceLen
+	}
+
+	var (
+		next   int64      = -1 // last function taken by a worker
+		failed int32           // set on the first failure
+		mu     sync.Mutex      // guards errs, done and calls to opts.Progress
+		errs   []*FunctionError
+		done   int
+		wg     sync.WaitGroup
+	)
+	jobFn := func(i int) {
+		ic, err := m.tryCompileFunction(env, i, opts.GasPolicy)
+
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			errs = append(errs, err)
+			atomic.StoreInt32(&failed, 1)
+			return
+		}
+		ret[numFuncImports+i] = ic
+		done++
+		if opts.Progress != nil {
+			opts.Progress(done, funcIndexSpaceLen)
+		}
+	}
+
+	for g := 0; g < n; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for atomic.LoadInt32(&failed) == 0 && ctx.Err() == nil {
+				i := int(atomic.AddInt64(&next, 1))
+				if i >= funcIndexSpaceLen {
+					return
+				}
+				jobFn(i)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(errs) != 0 {
+		sort.Slice(errs, func(i, j int) bool {
+			return errs[i].FunctionIndex < errs[j].FunctionIndex
+		})
+		return nil, &CompileError{Functions: errs}
+	}
+	if done != funcIndexSpaceLen {
+		return nil, ctx.Err()
+	}
+	return ret, nil
+}
+
+// tryCompileFunction compiles the i-th function defined by the module,
+// recovering from a failure. It runs on a worker goroutine, where a panic
+// could not be recovered by the caller, so any other panic is reported as a
+// failure of the function too.
+func (m *Module) tryCompileFunction(env *funcEnv, i int, gp GasPolicy) (ic InterpreterCode, retErr *FunctionError) {
+	id := len(env.importTypeIDs) + i
+	offset := -1
+	if i < len(env.codeOffsets) {
+		offset = env.codeOffsets[i]
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			var ok bool
+			if retErr, ok = r.(*FunctionError); !ok {
+				retErr = &FunctionError{
+					FunctionIndex: id,
+					Name:          m.FunctionName(id),
+					Offset:        offset,
+					Err:           utils.UnifyError(r),
+				}
+			}
+		}
+	}()
+	return m.compileFunction(env, i, gp, nil), nil
+}
@@ -0,0 +1,38 @@
+package compiler
+
+// CoverageBlock is a basic block instrumented by InsertCoverageCounters.
+type CoverageBlock struct {
+	WasmOffsets []int // offsets of the wasm instructions in the block, in order
+}
+
+// InsertCoverageCounters prepends a cover_block instruction to each basic
+// block compiled from wasm code, and returns the instrumented blocks in the
+// order of their counter indices.
+func (c *SSAFunctionCompiler) InsertCoverageCounters() []CoverageBlock {
+	cfg := c.NewCFGraph()
+	blocks := make([]CoverageBlock, 0)
+
+	for i := range cfg.Blocks {
+		blk := &cfg.Blocks[i]
+		offsets := make([]int, 0)
+		for _, ins := range blk.Code {
+			if ins.WasmOffset < 0 {
+				continue
+			}
+			if len(offsets) == 0 || offsets[len(offsets)-1] != ins.WasmOffset {
+				offsets = append(offsets, ins.WasmOffset)
+			}
+		}
+		if len(offsets) == 0 {
+			continue
+		}
+
+		cover := buildInstr(0, "cover_block", []int64{int64(len(blocks))}, []TyValueID{})
+		cover.WasmOffset = offsets[0]
+		blk.Code = append([]Instr{cover}, blk.Code...)
+		blocks = append(blocks, CoverageBlock{WasmOffsets: offsets})
+	}
+	c.Code = cfg.ToInsSeq()
+
+	return blocks
+}
@@ -0,0 +1,153 @@
+package compiler
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+func (ins Instr) String() string {
+	s := ""
+	if ins.Target != 0 {
+		s = fmt.Sprintf("%%%d = ", ins.Target)
+	}
+	s += ins.Op
+	for _, imm := range ins.Immediates {
+		s += fmt.Sprintf(" %d", imm)
+	}
+	for _, v := range ins.Values {
+		s += fmt.Sprintf(" %%%d", v)
+	}
+	return s
+}
+
+// WriteInstrs writes a listing of code, one instruction per line, prefixed by
+// its index and followed by the offset of the wasm instruction it originates
+// from.
+func WriteInstrs(w io.Writer, code []Instr) error {
+	bw := bufio.NewWriter(w)
+	for i, ins := range code {
+		writeListingLine(bw, fmt.Sprintf("%5d: %s", i, ins), ins.WasmOffset)
+	}
+	return bw.Flush()
+}
+
+// WriteBytecode writes a listing of the interpreter bytecode of code, one
+// instruction per line, prefixed by its offset and followed by the offset of
+// the wasm instruction it was compiled from.
+func WriteBytecode(w io.Writer, code *InterpreterCode) error {
+	instrs, err := DecodeBytecode(code.Bytes)
+	if err != nil {
+		return err
+	}
+	bw := bufio.NewWriter(w)
+	for _, ins := range instrs {
+		wasmOffset := -1
+		if code.Offsets != nil {
+			wasmOffset = code.WasmOffset(ins.Offset)
+		}
+		writeListingLine(bw, fmt.Sprintf("%5d: %s", ins.Offset, ins), wasmOffset)
+	}
+	return bw.Flush()
+}
+
+func writeListingLine(w io.Writer, line string, wasmOffset int) {
+	if wasmOffset >= 0 {
+		fmt.Fprintf(w, "%-48s ; wasm 0x%x\n", line, wasmOffset)
+	} else {
+		fmt.Fprintln(w, line)
+	}
+}
+
+// Terminator describes how control leaves the block, e.g.
+// "jmp_either %3 -> b1, b2".
+func (bb *BasicBlock) Terminator() string {
+	var s string
+	switch bb.JmpKind {
+	case JmpUncond:
+		s = "jmp"
+	case JmpEither:
+		s = fmt.Sprintf("jmp_either %%%d", bb.JmpCond)
+	case JmpTable:
+		s = fmt.Sprintf("jmp_table %%%d", bb.JmpCond)
+	case JmpReturn:
+		s = "return"
+	default:
+		s = "<undefined jump>"
+	}
+	if len(bb.JmpTargets) > 0 {
+		targets := make([]string, len(bb.JmpTargets))
+		for i, t := range bb.JmpTargets {
+			targets[i] = fmt.Sprintf("b%d", t)
+		}
+		s += " -> " + strings.Join(targets, ", ")
+	}
+	if bb.YieldValue != 0 {
+		s += fmt.Sprintf(" yield %%%d", bb.YieldValue)
+	}
+	return s
+}
+
+// WriteText writes the blocks of the graph with their code and terminators.
+func (g *CFGraph) WriteText(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	for i := range g.Blocks {
+		bb := &g.Blocks[i]
+		fmt.Fprintf(bw, "b%d:\n", i)
+		for _, ins := range bb.Code {
+			writeListingLine(bw, "    "+ins.String(), ins.WasmOffset)
+		}
+		fmt.Fprintf(bw, "    %s\n", bb.Terminator())
+	}
+	return bw.Flush()
+}
+
+// WriteDot writes the graph in the Graphviz dot language as a cluster named
+// name, to be embedded in a digraph. Node identifiers are prefixed with
+// prefix so that the graphs of several functions can share one digraph.
+func (g *CFGraph) WriteDot(w io.Writer, name string, prefix string) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "  subgraph %s {\n", dotQuote("cluster_"+prefix))
+	fmt.Fprintf(bw, "    label=%s;\n", dotQuote(name))
+	for i := range g.Blocks {
+		bb := &g.Blocks[i]
+		label := fmt.Sprintf("b%d:\\l", i)
+		for _, ins := range bb.Code {
+			label += dotEscape(ins.String()) + "\\l"
+		}
+		label += dotEscape(bb.Terminator()) + "\\l"
+		fmt.Fprintf(bw, "    %s_b%d [shape=box, fontname=monospace, label=\"%s\"];\n", prefix, i, label)
+	}
+	for i := range g.Blocks {
+		bb := &g.Blocks[i]
+		for j, t := range bb.JmpTargets {
+			edgeLabel := ""
+			switch bb.JmpKind {
+			case JmpEither:
+				edgeLabel = [...]string{"true", "false"}[j]
+			case JmpTable:
+				if j == len(bb.JmpTargets)-1 {
+					edgeLabel = "default"
+				} else {
+					edgeLabel = fmt.Sprint(j)
+				}
+			}
+			fmt.Fprintf(bw, "    %s_b%d -> %s_b%d", prefix, i, prefix, t)
+			if edgeLabel != "" {
+				fmt.Fprintf(bw, " [label=%s]", dotQuote(edgeLabel))
+			}
+			fmt.Fprintln(bw, ";")
+		}
+	}
+	fmt.Fprintln(bw, "  }")
+	return bw.Flush()
+}
+
+func dotEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s)
+}
+
+func dotQuote(s string) string {
+	return `"` + dotEscape(s) + `"`
+}
@@ -0,0 +1,168 @@
+package compiler
+
+import (
+	"debug/dwarf"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// SourceLocation is a position in a source file, decoded from DWARF line info.
+type SourceLocation struct {
+	File   string
+	Line   int
+	Column int
+}
+
+func (l SourceLocation) String() string {
+	if l.Column != 0 {
+		return fmt.Sprintf("%s:%d:%d", l.File, l.Line, l.Column)
+	}
+	return fmt.Sprintf("%s:%d", l.File, l.Line)
+}
+
+type lineRow struct {
+	address     uint64
+	loc         SourceLocation
+	endSequence bool
+}
+
+// lineTable is the flattened line number program of all compilation units,
+// sorted by address. Addresses are offsets into the code section payload.
+type lineTable struct {
+	rows []lineRow
+}
+
+// SourceLocation returns the source location of the wasm instruction at the
+// given offset in the module binary. It is only available if the module
+// carries DWARF debug sections.
+func (m *Module) SourceLocation(wasmOffset int) (SourceLocation, bool) {
+	if wasmOffset < 0 || m.codeSectionOffset < 0 {
+		return SourceLocation{}, false
+	}
+
+	m.lineTableOnce.Do(func() {
+		m.lineTable, m.lineTableErr = m.loadLineTable()
+	})
+	if m.lineTable == nil {
+		return SourceLocation{}, false
+	}
+
+	addr := uint64(wasmOffset - m.codeSectionOffset)
+	rows := m.lineTable.rows
+	i := sort.Search(len(rows), func(i int) bool {
+		return rows[i].address > addr
+	})
+	if i == 0 || rows[i-1].endSequence || rows[i-1].loc.Line == 0 {
+		return SourceLocation{}, false
+	}
+	return rows[i-1].loc, true
+}
+
+// HasLineInfo returns whether the module carries DWARF line info.
+func (m *Module) HasLineInfo() bool {
+	m.lineTableOnce.Do(func() {
+		m.lineTable, m.lineTableErr = m.loadLineTable()
+	})
+	return m.lineTable != nil && len(m.lineTable.rows) != 0
+}
+
+// DebugInfoError returns the error encountered while decoding DWARF sections, if any.
+func (m *Module) DebugInfoError() error {
+	m.lineTableOnce.Do(func() {
+		m.lineTable, m.lineTableErr = m.loadLineTable()
+	})
+	return m.lineTableErr
+}
+
+func (m *Module) loadLineTable() (*lineTable, error) {
+	sections := make(map[string][]byte)
+	for _, sec := range m.Base.Customs {
+		if strings.HasPrefix(sec.Name, ".debug_") {
+			sections[sec.Name] = sec.Data
+		}
+	}
+	if sections[".debug_info"] == nil || sections[".debug_line"] == nil {
+		return nil, nil
+	}
+
+	d, err := dwarf.New(
+		sections[".debug_abbrev"],
+		sections[".debug_aranges"],
+		sections[".debug_frame"],
+		sections[".debug_info"],
+		sections[".debug_line"],
+		sections[".debug_pubnames"],
+		sections[".debug_ranges"],
+		sections[".debug_str"],
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range []string{".debug_addr", ".debug_line_str", ".debug_str_offsets", ".debug_rnglists"} {
+		if data, ok := sections[name]; ok {
+			if err := d.AddSection(name, data); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	table := &lineTable{}
+	r := d.Reader()
+	for {
+		entry, err := r.Next()
+		if err != nil {
+			return nil, err
+		}
+		if entry == nil {
+			break
+		}
+		if entry.Tag != dwarf.TagCompileUnit {
+			r.SkipChildren()
+			continue
+		}
+		lr, err := d.LineReader(entry)
+		if err != nil {
+			return nil, err
+		}
+		r.SkipChildren()
+		if lr == nil {
+			continue
+		}
+
+		var le dwarf.LineEntry
+		for {
+			if err := lr.Next(&le); err != nil {
+				if err == io.EOF {
+					break
+				}
+				return nil, err
+			}
+			row := lineRow{
+				address:     le.Address,
+				endSequence: le.EndSequence,
+			}
+			if !le.EndSequence && le.File != nil {
+				row.loc = SourceLocation{
+					File:   le.File.Name,
+					Line:   le.Line,
+					Column: le.Column,
+				}
+			}
+			table.rows = append(table.rows, row)
+		}
+	}
+
+	// Within an address, sequence ends sort first so that a sequence starting
+	// where another one ends takes precedence.
+	sort.SliceStable(table.rows, func(i, j int) bool {
+		a, b := &table.rows[i], &table.rows[j]
+		if a.address != b.address {
+			return a.address < b.address
+		}
+		return a.endSequence && !b.endSequence
+	})
+
+	return table, nil
+}
@@ -0,0 +1,148 @@
+package compiler
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+)
+
+// lineModule is a module with one function, followed by DWARF sections
+// mapping its instructions to lines of main.c:
+//
+//	i32.const 1  ; main.c:3:5, code section offset 3
+//	i32.const 2  ; main.c:3:5
+//	i32.add      ; main.c:4:7, offset 7
+//	end          ; main.c:4:7; the sequence ends at offset 9
+var lineModule = func() []byte {
+	var module bytes.Buffer
+	module.Write([]byte{
+		0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00,
+		0x01, 0x05, 0x01, 0x60, 0x00, 0x01, 0x7f, // type section: [] -> [i32]
+		0x03, 0x02, 0x01, 0x00, // function section
+		0x0a, 0x09, 0x01, 0x07, 0x00, 0x41, 0x01, 0x41, 0x02, 0x6a, 0x0b, // code section
+	})
+
+	abbrev := []byte{
+		0x01, 0x11, 0x00, // abbreviation 1: compile unit without children
+		0x03, 0x08, // DW_AT_name, DW_FORM_string
+		0x10, 0x17, // DW_AT_stmt_list, DW_FORM_sec_offset
+		0x00, 0x00,
+		0x00,
+	}
+
+	var info bytes.Buffer
+	info.Write([]byte{0x04, 0x00}) // version
+	info.Write([]byte{0, 0, 0, 0}) // abbreviation table offset
+	info.WriteByte(4)              // address size
+	info.WriteByte(0x01)
+	info.WriteString("main.c\x00")
+	info.Write([]byte{0, 0, 0, 0}) // line program offset
+
+	var header bytes.Buffer
+	header.Write([]byte{
+		1,                                  // minimum instruction length
+		1,                                  // maximum operations per instruction
+		1,                                  // default is_stmt
+		0xfb,                               // line base -5
+		14,                                 // line range
+		13,                                 // opcode base
+		0, 1, 1, 1, 1, 0, 0, 0, 1, 0, 0, 1, // standard opcode lengths
+		0, // no include directories
+	})
+	header.WriteString("main.c\x00")
+	header.Write([]byte{0, 0, 0, 0}) // directory, mtime, length; end of files
+
+	program := []byte{
+		0x00, 0x05, 0x02, 3, 0, 0, 0, // DW_LNE_set_address 3
+		0x03, 2, // DW_LNS_advance_line 2
+		0x05, 5, // DW_LNS_set_column 5
+		0x01,    // DW_LNS_copy
+		0x02, 4, // DW_LNS_advance_pc 4
+		0x03, 1, // DW_LNS_advance_line 1
+		0x05, 7, // DW_LNS_set_column 7
+		0x01,    // DW_LNS_copy
+		0x02, 2, // DW_LNS_advance_pc 2
+		0x00, 0x01, 0x01, // DW_LNE_end_sequence
+	}
+
+	var line bytes.Buffer
+	line.Write([]byte{0x04, 0x00}) // version
+	binary.Write(&line, binary.LittleEndian, uint32(header.Len()))
+	line.Write(header.Bytes())
+	line.Write(program)
+
+	withLength := func(b []byte) []byte {
+		return append(binary.LittleEndian.AppendUint32(nil, uint32(len(b))), b...)
+	}
+	custom := func(name string, data []byte) {
+		payload := append([]byte{byte(len(name))}, name...)
+		payload = append(payload, data...)
+		module.WriteByte(0)
+		module.WriteByte(byte(len(payload)))
+		module.Write(payload)
+	}
+	custom(".debug_abbrev", abbrev)
+	custom(".debug_info", withLength(info.Bytes()))
+	custom(".debug_line", withLength(line.Bytes()))
+	return module.Bytes()
+}()
+
+func TestSourceLocation(t *testing.T) {
+	m, err := LoadModule(lineModule)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := m.DebugInfoError(); err != nil {
+		t.Fatal(err)
+	}
+	if !m.HasLineInfo() {
+		t.Fatal("no line info")
+	}
+
+	offsets, err := m.functionCodeOffsets()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := offsets[0] - m.codeSectionOffset; got != 3 {
+		t.Fatalf("code starts at code section offset %d, want 3", got)
+	}
+
+	tests := []struct {
+		offset int // in the code section
+		want   string
+	}{
+		{2, ""}, // local declarations
+		{3, "main.c:3:5"},
+		{5, "main.c:3:5"},
+		{7, "main.c:4:7"},
+		{8, "main.c:4:7"},
+		{9, ""}, // end of the sequence
+		{100, ""},
+	}
+	for _, tt := range tests {
+		got := ""
+		if loc, ok := m.SourceLocation(m.codeSectionOffset + tt.offset); ok {
+			got = loc.String()
+		}
+		if got != tt.want {
+			t.Errorf("offset %d is at %q, want %q", tt.offset, got, tt.want)
+		}
+	}
+
+	if _, ok := m.SourceLocation(-1); ok {
+		t.Error("unknown offset has a source location")
+	}
+}
+
+func TestSourceLocationWithoutLineInfo(t *testing.T) {
+	m, err := LoadModule(lineModule[:30])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.HasLineInfo() {
+		t.Error("module without DWARF sections has line info")
+	}
+	if _, ok := m.SourceLocation(m.codeSectionOffset + 3); ok {
+		t.Error("module without DWARF sections has a source location")
+	}
+}
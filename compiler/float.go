@@ -0,0 +1,213 @@
+package compiler
+
+import (
+	"fmt"
+	"strings"
+
+	ops "github.com/go-interpreter/wagon/wasm/operators"
+	"github.com/perlin-network/life/utils"
+)
+
+// nanCanonicalizations maps the floating point operations that may produce a
+// NaN with a payload that depends on the host to the instruction
+// canonicalizing their result. These are the arithmetic operations of the
+// spec; abs, neg and copysign only change the sign bit, and conversions from
+// integers never produce a NaN.
+var nanCanonicalizations = map[string]string{
+	"f32.add": "f32.canonicalize_nan", "f32.sub": "f32.canonicalize_nan",
+	"f32.mul": "f32.canonicalize_nan", "f32.div": "f32.canonicalize_nan",
+	"f32.sqrt": "f32.canonicalize_nan", "f32.min": "f32.canonicalize_nan",
+	"f32.max": "f32.canonicalize_nan", "f32.ceil": "f32.canonicalize_nan",
+	"f32.floor": "f32.canonicalize_nan", "f32.trunc": "f32.canonicalize_nan",
+	"f32.nearest": "f32.canonicalize_nan", "f32.demote/f64": "f32.canonicalize_nan",
+
+	"f64.add": "f64.canonicalize_nan", "f64.sub": "f64.canonicalize_nan",
+	"f64.mul": "f64.canonicalize_nan", "f64.div": "f64.canonicalize_nan",
+	"f64.sqrt": "f64.canonicalize_nan", "f64.min": "f64.canonicalize_nan",
+	"f64.max": "f64.canonicalize_nan", "f64.ceil": "f64.canonicalize_nan",
+	"f64.floor": "f64.canonicalize_nan", "f64.trunc": "f64.canonicalize_nan",
+	"f64.nearest": "f64.canonicalize_nan", "f64.promote/f32": "f64.canonicalize_nan",
+}
+
+// deterministicFloatOps maps the operations that are replaced by another in
+// deterministic mode to their replacement. Reinterpretations canonicalize the
+// NaNs they move between integers and floats, and conversions to integers
+// trap on NaNs and on values out of range as the spec requires, instead of
+// returning a value that depends on the host.
+var deterministicFloatOps = map[string]string{
+	"i32.reinterpret/f32": "f32.canonicalize_nan",
+	"f32.reinterpret/i32": "f32.canonicalize_nan",
+	"i64.reinterpret/f64": "f64.canonicalize_nan",
+	"f64.reinterpret/i64": "f64.canonicalize_nan",
+
+	"i32.trunc_s/f32": "i32.trunc_s_checked/f32",
+	"i32.trunc_s/f64": "i32.trunc_s_checked/f64",
+	"i32.trunc_u/f32": "i32.trunc_u_checked/f32",
+	"i32.trunc_u/f64": "i32.trunc_u_checked/f64",
+	"i64.trunc_s/f32": "i64.trunc_s_checked/f32",
+	"i64.trunc_s/f64": "i64.trunc_s_checked/f64",
+	"i64.trunc_u/f32": "i64.trunc_u_checked/f32",
+	"i64.trunc_u/f64": "i64.trunc_u_checked/f64",
+}
+
+// MakeFloatingPointDeterministic rewrites the floating point operations of
+// the function so that their results are the same on every host: every NaN
+// produced by an arithmetic operation or a reinterpretation is replaced by the
+// positive canonical NaN, and conversions to integers follow the spec. It must
+// run after gas counters are inserted, so that it does not change the gas used.
+func (c *SSAFunctionCompiler) MakeFloatingPointDeterministic() {
+	cfg := c.NewCFGraph()
+	for i := range cfg.Blocks {
+		blk := &cfg.Blocks[i]
+		code := make([]Instr, 0, len(blk.Code))
+		for _, ins := range blk.Code {
+			if op, ok := deterministicFloatOps[ins.Op]; ok {
+				ins.Op = op
+			}
+			op, ok := nanCanonicalizations[ins.Op]
+			if !ok {
+				code = append(code, ins)
+				continue
+			}
+
+			// The operation now produces a new value, which the canonicalized
+			// result replaces for the instructions using it.
+			target := ins.Target
+			ins.Target = c.NextValueID()
+			canonicalize := buildInstr(target, op, nil, []TyValueID{ins.Target})
+			canonicalize.WasmOffset = ins.WasmOffset
+			code = append(code, ins, canonicalize)
+		}
+		blk.Code = code
+	}
+	c.Code = cfg.ToInsSeq()
+}
+
+// softFloatOps maps the floating point operations that round, or whose
+// results depend on the host, to the operations running them in software with
+// package softfloat. Those return the positive canonical NaN for every NaN,
+// and their conversions to integers trap on NaNs and on values out of range.
+var softFloatOps = map[string]string{
+	"f32.add": "f32.add_soft", "f32.sub": "f32.sub_soft",
+	"f32.mul": "f32.mul_soft", "f32.div": "f32.div_soft",
+	"f32.min": "f32.min_soft", "f32.max": "f32.max_soft",
+	"f32.sqrt": "f32.sqrt_soft", "f32.ceil": "f32.ceil_soft",
+	"f32.floor": "f32.floor_soft", "f32.trunc": "f32.trunc_soft",
+	"f32.nearest": "f32.nearest_soft",
+
+	"f64.add": "f64.add_soft", "f64.sub": "f64.sub_soft",
+	"f64.mul": "f64.mul_soft", "f64.div": "f64.div_soft",
+	"f64.min": "f64.min_soft", "f64.max": "f64.max_soft",
+	"f64.sqrt": "f64.sqrt_soft", "f64.ceil": "f64.ceil_soft",
+	"f64.floor": "f64.floor_soft", "f64.trunc": "f64.trunc_soft",
+	"f64.nearest": "f64.nearest_soft",
+
+	"i32.trunc_s/f32": "i32.trunc_s_soft/f32", "i32.trunc_s/f64": "i32.trunc_s_soft/f64",
+	"i32.trunc_u/f32": "i32.trunc_u_soft/f32", "i32.trunc_u/f64": "i32.trunc_u_soft/f64",
+	"i64.trunc_s/f32": "i64.trunc_s_soft/f32", "i64.trunc_s/f64": "i64.trunc_s_soft/f64",
+	"i64.trunc_u/f32": "i64.trunc_u_soft/f32", "i64.trunc_u/f64": "i64.trunc_u_soft/f64",
+
+	"f32.convert_s/i32": "f32.convert_s_soft/i32", "f32.convert_s/i64": "f32.convert_s_soft/i64",
+	"f32.convert_u/i32": "f32.convert_u_soft/i32", "f32.convert_u/i64": "f32.convert_u_soft/i64",
+	"f64.convert_s/i32": "f64.convert_s_soft/i32", "f64.convert_s/i64": "f64.convert_s_soft/i64",
+	"f64.convert_u/i32": "f64.convert_u_soft/i32", "f64.convert_u/i64": "f64.convert_u_soft/i64",
+
+	"f32.demote/f64": "f32.demote_soft/f64", "f64.promote/f32": "f64.promote_soft/f32",
+}
+
+// IsSoftFloatOp reports whether op runs a floating point operation in
+// software, see UseSoftFloat.
+func IsSoftFloatOp(op string) bool {
+	return strings.Contains(op, "_soft")
+}
+
+// UseSoftFloat replaces the floating point operations of the function that
+// would run on the floating point unit of the host by their software
+// implementation. It must run before gas counters are inserted, so that gas
+// policies can price the software operations by their own names.
+func (c *SSAFunctionCompiler) UseSoftFloat() {
+	for i, ins := range c.Code {
+		if op, ok := softFloatOps[ins.Op]; ok {
+			c.Code[i].Op = op
+		}
+	}
+}
+
+// FloatingPointUse is an instruction computing on floating point values, as
+// classified by IsFloatingPointOp.
+type FloatingPointUse struct {
+	FunctionIndex int    // index in the function index space
+	Name          string // see Module.FunctionName
+	Offset        int    // offset of the instruction in the module binary
+	Op            string
+}
+
+func (u FloatingPointUse) String() string {
+	return fmt.Sprintf("function %d (%s) at 0x%x: %s", u.FunctionIndex, u.Name, u.Offset, u.Op)
+}
+
+// FloatingPointError lists the floating point instructions of a module that
+// is required not to use floating point, see CheckNoFloatingPoint.
+type FloatingPointError struct {
+	Uses []FloatingPointUse
+}
+
+func (e *FloatingPointError) Error() string {
+	ret := "module uses floating point: " + e.Uses[0].String()
+	if len(e.Uses) > 1 {
+		ret += fmt.Sprintf(" (and %d more instructions)", len(e.Uses)-1)
+	}
+	return ret
+}
+
+// FloatingPointUses returns the instructions of the module that compute on
+// floating point values, in the order of the module binary. These are the
+// instructions FilterFloatingPoint makes trap, and the ones in unreachable
+// code.
+func (m *Module) FloatingPointUses() (_ []FloatingPointUse, retErr error) {
+	defer utils.CatchPanic(&retErr)
+
+	env, err := m.funcEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	var ret []FloatingPointUse
+	for i, f := range m.Base.FunctionIndexSpace {
+		offsets, err := InstrOffsets(f.Body.Code)
+		if err != nil {
+			return nil, err
+		}
+		for _, off := range offsets {
+			op, err := ops.New(f.Body.Code[off])
+			if err != nil {
+				return nil, err
+			}
+			if IsFloatingPointOp(op.Name) {
+				id := len(env.importTypeIDs) + i
+				ret = append(ret, FloatingPointUse{
+					FunctionIndex: id,
+					Name:          m.FunctionName(id),
+					Offset:        env.codeOffsets[i] + off,
+					Op:            op.Name,
+				})
+			}
+		}
+	}
+	return ret, nil
+}
+
+// CheckNoFloatingPoint returns a *FloatingPointError listing every floating
+// point instruction of the module, if it has any. Unlike DisableFloatingPoint,
+// which makes these instructions trap when they run, it lets modules using
+// floating point be rejected before they run.
+func (m *Module) CheckNoFloatingPoint() error {
+	uses, err := m.FloatingPointUses()
+	if err != nil {
+		return err
+	}
+	if len(uses) != 0 {
+		return &FloatingPointError{Uses: uses}
+	}
+	return nil
+}
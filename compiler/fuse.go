@@ -0,0 +1,205 @@
+// Superinstructions.
+
+package compiler
+
+import "strings"
+
+// Fuse replaces common instruction sequences with superinstructions, which
+// the interpreter decodes and dispatches once:
+//
+//   - i32.add, i32.sub, i32.eq, i64.add, i64.sub, i64.shr_u and i64.shl take a
+//     constant operand as an immediate (i32.add_imm, i32.eq_imm, i64.add_imm,
+//     i64.shr_u_imm, i64.shl_imm), with subtracting a constant turned into
+//     adding its negation;
+//   - i32.add_imm and i32.eq_imm read their operand straight from a local
+//     (i32.add_local_imm, i32.eq_local_imm);
+//   - i32.gt_u, i32.ge_u, i32.or, i64.ne and i64.eqz are fused with a jmp_if
+//     on their result (jmp_if.i32.gt_u and so on);
+//   - i32.load and i64.load add the immediate of an i32.add_imm computing
+//     their base address to it (i32.load_add_imm, i64.load_add_imm).
+//
+// The sequences were picked from the opcode pairs executed most often by the
+// programs in bench/wat, listed in bench/wat/pairs.txt. Those programs are
+// hand-written WAT ports of the bench/cases programs, not builds of them, so
+// the pairs reflect the code shapes of the ports. Averaged over the programs,
+// the pairs fused make up these shares of the instructions executed at -O 2:
+//
+//	GetLocal  I32Const  7.1%    I32Or     JmpIf     2.9%
+//	I32Const  I32Eq     4.4%    I64EqZ    JmpIf     1.0%
+//	I32Const  I32Add    3.5%    I64Ne     JmpIf     1.0%
+//	I32Const  I32Sub    2.5%    I32GtU    JmpIf     1.0%
+//	I64Const  I64ShrU   1.4%    I32GeU    JmpIf     0.5%
+//	I64Const  I64Sub    0.7%
+//	I64Const  I64Shl    0.7%
+//
+// Loads are fused with the add computing their address although it is not
+// among the most frequent pairs there, as compilers usually fold constant
+// offsets into the load themselves.
+//
+// Fuse must run on SSA form, before RegAlloc: an instruction is only fused
+// into the one using the value it defines if there is no other use, except
+// for get_local, which is repeated. Gas and coverage counters are left as
+// they are.
+func (c *SSAFunctionCompiler) Fuse() {
+	code := c.Code
+
+	def := make(map[TyValueID]int)
+	uses := make(map[TyValueID]int)
+	isLeader := make([]bool, len(code)+1)
+	for i, ins := range code {
+		if ins.Target != 0 {
+			def[ins.Target] = i
+		}
+		for _, v := range ins.Values {
+			uses[v]++
+		}
+		switch {
+		case isJump(ins.Op):
+			for _, target := range ins.Immediates {
+				if target >= 0 && int(target) <= len(code) {
+					isLeader[target] = true
+				}
+			}
+			isLeader[i+1] = true
+		case ins.Op == "return":
+			isLeader[i+1] = true
+		}
+	}
+	dead := make([]bool, len(code))
+
+	// constant returns the value of v, if it is a constant.
+	constant := func(v TyValueID) (int64, bool) {
+		d, ok := def[v]
+		if !ok {
+			return 0, false
+		}
+		switch code[d].Op {
+		case "i32.const":
+			return int64(int32(code[d].Immediates[0])), true
+		case "i64.const":
+			return code[d].Immediates[0], true
+		}
+		return 0, false
+	}
+	// dropUse records that v is no longer read by one of its users.
+	dropUse := func(v TyValueID) {
+		if uses[v]--; uses[v] == 0 {
+			dead[def[v]] = true
+		}
+	}
+	// producer returns the instruction defining v, if it is only used by the
+	// instruction at i.
+	producer := func(v TyValueID, i int) (*Instr, int, bool) {
+		d, ok := def[v]
+		if !ok || d >= i || uses[v] != 1 {
+			return nil, 0, false
+		}
+		return &code[d], d, true
+	}
+	// localUnchanged reports whether local is not set between positions d and
+	// i, which are in the same basic block.
+	localUnchanged := func(local int64, d, i int) bool {
+		for p := d + 1; p <= i; p++ {
+			if isLeader[p] {
+				return false
+			}
+			if code[p].Op == "set_local" && code[p].Immediates[0] == local {
+				return false
+			}
+		}
+		return true
+	}
+
+	for i := range code {
+		ins := &code[i]
+
+		switch ins.Op {
+		case "i32.add", "i32.eq", "i64.add":
+			if k, ok := constant(ins.Values[1]); ok {
+				dropUse(ins.Values[1])
+				ins.Op, ins.Values, ins.Immediates = ins.Op+"_imm", ins.Values[:1], []int64{k}
+			} else if k, ok := constant(ins.Values[0]); ok {
+				dropUse(ins.Values[0])
+				ins.Op, ins.Values, ins.Immediates = ins.Op+"_imm", ins.Values[1:], []int64{k}
+			}
+		case "i32.sub", "i64.sub":
+			if k, ok := constant(ins.Values[1]); ok {
+				dropUse(ins.Values[1])
+				ins.Op, ins.Values, ins.Immediates = ins.Op[:4]+"add_imm", ins.Values[:1], []int64{-k}
+			}
+		case "i64.shr_u", "i64.shl":
+			if k, ok := constant(ins.Values[1]); ok {
+				dropUse(ins.Values[1])
+				ins.Op, ins.Values, ins.Immediates = ins.Op+"_imm", ins.Values[:1], []int64{k}
+			}
+		}
+
+		switch ins.Op {
+		case "i32.load", "f32.load", "i64.load", "f64.load":
+			p, d, ok := producer(ins.Values[0], i)
+			if ok && p.Op == "i32.add_imm" {
+				dead[d] = true
+				ins.Op = "i" + ins.Op[1:] + "_add_imm"
+				ins.Values, ins.Immediates = p.Values, []int64{ins.Immediates[1], p.Immediates[0]}
+			}
+
+		case "jmp_if":
+			p, d, ok := producer(ins.Values[0], i)
+			if !ok {
+				break
+			}
+			switch p.Op {
+			case "i32.gt_u", "i32.ge_u", "i32.or", "i64.ne", "i64.eqz":
+				dead[d] = true
+				ins.Op = "jmp_if." + p.Op
+				ins.Values = append(append([]TyValueID{}, p.Values...), ins.Values[1])
+			}
+		}
+	}
+
+	// Adds left over after fusing loads read their operand from a local. The
+	// local may be read again by each user of the get_local.
+	for i := range code {
+		ins := &code[i]
+		if dead[i] || (ins.Op != "i32.add_imm" && ins.Op != "i32.eq_imm") {
+			continue
+		}
+		v := ins.Values[0]
+		d, ok := def[v]
+		if ok && d < i && code[d].Op == "get_local" && localUnchanged(code[d].Immediates[0], d, i) {
+			dropUse(v)
+			ins.Op = strings.TrimSuffix(ins.Op, "_imm") + "_local_imm"
+			ins.Values, ins.Immediates = nil, []int64{code[d].Immediates[0], ins.Immediates[0]}
+		}
+	}
+
+	relocs := make([]int64, len(code)+1)
+	out := make([]Instr, 0, len(code))
+	for i, ins := range code {
+		relocs[i] = int64(len(out))
+		if !dead[i] {
+			out = append(out, ins)
+		}
+	}
+	relocs[len(code)] = int64(len(out))
+
+	for i := range out {
+		if isJump(out[i].Op) {
+			for j, target := range out[i].Immediates {
+				out[i].Immediates[j] = relocs[target]
+			}
+		}
+	}
+	c.Code = out
+}
+
+// isJmpIf reports whether op is a jmp_if, possibly fused with the comparison
+// computing its condition.
+func isJmpIf(op string) bool {
+	return op == "jmp_if" || strings.HasPrefix(op, "jmp_if.")
+}
+
+// isJump reports whether op is a jump, whose immediates are all targets.
+func isJump(op string) bool {
+	return op == "jmp" || op == "jmp_either" || op == "jmp_table" || isJmpIf(op)
+}
@@ -14,9 +14,9 @@ func (c *SSAFunctionCompiler) InsertGasCounters(gp GasPolicy) {
 		}
 
 		if totalCost != 0 {
-			blk.Code = append([]Instr{
-				buildInstr(0, "add_gas", []int64{totalCost}, []TyValueID{}),
-			}, blk.Code...)
+			addGas := buildInstr(0, "add_gas", []int64{totalCost}, []TyValueID{})
+			addGas.WasmOffset = blk.Code[0].WasmOffset
+			blk.Code = append([]Instr{addGas}, blk.Code...)
 		}
 	}
 	c.Code = cfg.ToInsSeq()
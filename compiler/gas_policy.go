@@ -6,8 +6,15 @@ type GasPolicy interface {
 
 type SimpleGasPolicy struct {
 	GasPerInstruction int64
+
+	// GasPerSoftFloatInstruction is the cost of the floating point operations
+	// run in software, see UseSoftFloat; 0 for GasPerInstruction.
+	GasPerSoftFloatInstruction int64
 }
 
 func (p *SimpleGasPolicy) GetCost(key string) int64 {
+	if p.GasPerSoftFloatInstruction != 0 && IsSoftFloatOp(key) {
+		return p.GasPerSoftFloatInstruction
+	}
 	return p.GasPerInstruction
 }
@@ -0,0 +1,344 @@
+package compiler
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-interpreter/wagon/wasm"
+	"github.com/go-interpreter/wagon/wasm/leb128"
+	ops "github.com/go-interpreter/wagon/wasm/operators"
+	"github.com/perlin-network/life/utils"
+)
+
+// ModuleInfo summarizes the contents of a module, as reported by Inspect.
+type ModuleInfo struct {
+	Name              string         `json:"name,omitempty"`
+	Sections          []SectionInfo  `json:"sections"`
+	Imports           []ImportInfo   `json:"imports"`
+	Exports           []ExportInfo   `json:"exports"`
+	Tables            []LimitsInfo   `json:"tables"`
+	Memories          []LimitsInfo   `json:"memories"`
+	Globals           []GlobalInfo   `json:"globals"`
+	Elements          []SegmentInfo  `json:"elements"`
+	Data              []SegmentInfo  `json:"data"`
+	Customs           []CustomInfo   `json:"customs"`
+	Start             *int           `json:"start,omitempty"`
+	Functions         []FunctionInfo `json:"functions"`
+	UsesFloatingPoint bool           `json:"uses_floating_point"`
+}
+
+type SectionInfo struct {
+	ID     int    `json:"id"`
+	Name   string `json:"name"`
+	Offset int    `json:"offset"` // offset of the payload in the module binary
+	Size   int    `json:"size"`
+}
+
+type ImportInfo struct {
+	Module string `json:"module"`
+	Field  string `json:"field"`
+	Kind   string `json:"kind"`
+	Type   string `json:"type"` // signature of functions, limits of tables and memories, type of globals
+}
+
+type ExportInfo struct {
+	Name  string `json:"name"`
+	Kind  string `json:"kind"`
+	Index int    `json:"index"`
+}
+
+// LimitsInfo describes a table, in elements, or a memory, in pages.
+type LimitsInfo struct {
+	Import  string  `json:"import,omitempty"` // module.field if imported
+	Initial uint32  `json:"initial"`
+	Maximum *uint32 `json:"maximum,omitempty"`
+}
+
+type GlobalInfo struct {
+	Index   int    `json:"index"`
+	Type    string `json:"type"`
+	Mutable bool   `json:"mutable"`
+	Import  string `json:"import,omitempty"` // module.field if imported
+	Init    string `json:"init,omitempty"`   // initializer expression, e.g. "i32.const 5"
+}
+
+// SegmentInfo describes an element or data segment. Size is in elements or
+// bytes.
+type SegmentInfo struct {
+	Index  int    `json:"index"` // index of the table or memory
+	Offset string `json:"offset"`
+	Size   int    `json:"size"`
+}
+
+type CustomInfo struct {
+	Name string `json:"name"`
+	Size int    `json:"size"`
+}
+
+type FunctionInfo struct {
+	Index     int    `json:"index"` // index in the function index space
+	Name      string `json:"name,omitempty"`
+	Signature string `json:"signature"`
+	NumLocals int    `json:"num_locals"`
+	CodeSize  int    `json:"code_size"` // size of the body in the module binary
+	FloatOps  int    `json:"float_ops"` // number of floating point operations, see IsFloatingPointOp
+}
+
+// Inspect summarizes the contents of the module.
+func (m *Module) Inspect() (_ *ModuleInfo, retErr error) {
+	defer utils.CatchPanic(&retErr)
+
+	base := m.Base
+	info := &ModuleInfo{
+		Name:      m.ModuleName,
+		Sections:  make([]SectionInfo, 0),
+		Imports:   make([]ImportInfo, 0),
+		Exports:   make([]ExportInfo, 0),
+		Tables:    make([]LimitsInfo, 0),
+		Memories:  make([]LimitsInfo, 0),
+		Globals:   make([]GlobalInfo, 0),
+		Elements:  make([]SegmentInfo, 0),
+		Data:      make([]SegmentInfo, 0),
+		Customs:   make([]CustomInfo, 0),
+		Functions: make([]FunctionInfo, 0),
+	}
+
+	for _, h := range m.sections {
+		info.Sections = append(info.Sections, SectionInfo{
+			ID:     int(h.id),
+			Name:   h.id.String(),
+			Offset: h.offset,
+			Size:   h.size,
+		})
+	}
+
+	numFuncImports := 0
+	if base.Import != nil {
+		for _, e := range base.Import.Entries {
+			imp := ImportInfo{
+				Module: e.ModuleName,
+				Field:  e.FieldName,
+				Kind:   e.Type.Kind().String(),
+			}
+			name := e.ModuleName + "." + e.FieldName
+			switch t := e.Type.(type) {
+			case wasm.FuncImport:
+				imp.Type = formatSignature(&base.Types.Entries[t.Type])
+				numFuncImports++
+			case wasm.TableImport:
+				imp.Type = formatLimits(t.Type.Limits)
+				info.Tables = append(info.Tables, limitsInfo(name, t.Type.Limits))
+			case wasm.MemoryImport:
+				imp.Type = formatLimits(t.Type.Limits)
+				info.Memories = append(info.Memories, limitsInfo(name, t.Type.Limits))
+			case wasm.GlobalVarImport:
+				imp.Type = formatGlobalVar(t.Type)
+				info.Globals = append(info.Globals, GlobalInfo{
+					Index:   len(info.Globals),
+					Type:    t.Type.Type.String(),
+					Mutable: t.Type.Mutable,
+					Import:  name,
+				})
+			}
+			info.Imports = append(info.Imports, imp)
+		}
+	}
+
+	if base.Export != nil {
+		entries := make([]wasm.ExportEntry, 0, len(base.Export.Entries))
+		for _, e := range base.Export.Entries {
+			entries = append(entries, e)
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			a, b := entries[i], entries[j]
+			if a.Kind != b.Kind {
+				return a.Kind < b.Kind
+			}
+			if a.Index != b.Index {
+				return a.Index < b.Index
+			}
+			return a.FieldStr < b.FieldStr
+		})
+		for _, e := range entries {
+			info.Exports = append(info.Exports, ExportInfo{
+				Name:  e.FieldStr,
+				Kind:  e.Kind.String(),
+				Index: int(e.Index),
+			})
+		}
+	}
+
+	if base.Table != nil {
+		for _, t := range base.Table.Entries {
+			info.Tables = append(info.Tables, limitsInfo("", t.Limits))
+		}
+	}
+	if base.Memory != nil {
+		for _, mem := range base.Memory.Entries {
+			info.Memories = append(info.Memories, limitsInfo("", mem.Limits))
+		}
+	}
+	if base.Global != nil {
+		for _, g := range base.Global.Globals {
+			info.Globals = append(info.Globals, GlobalInfo{
+				Index:   len(info.Globals),
+				Type:    g.Type.Type.String(),
+				Mutable: g.Type.Mutable,
+				Init:    formatConstExpr(g.Init),
+			})
+		}
+	}
+
+	if base.Elements != nil {
+		for _, e := range base.Elements.Entries {
+			info.Elements = append(info.Elements, SegmentInfo{
+				Index:  int(e.Index),
+				Offset: formatConstExpr(e.Offset),
+				Size:   len(e.Elems),
+			})
+		}
+	}
+	if base.Data != nil {
+		for _, d := range base.Data.Entries {
+			info.Data = append(info.Data, SegmentInfo{
+				Index:  int(d.Index),
+				Offset: formatConstExpr(d.Offset),
+				Size:   len(d.Data),
+			})
+		}
+	}
+
+	for _, c := range base.Customs {
+		info.Customs = append(info.Customs, CustomInfo{
+			Name: c.Name,
+			Size: len(c.RawSection.Bytes),
+		})
+	}
+
+	if base.Start != nil {
+		start := int(base.Start.Index)
+		info.Start = &start
+	}
+
+	bodies, err := m.functionBodies()
+	if err != nil {
+		return nil, err
+	}
+	for i, f := range base.FunctionIndexSpace {
+		id := numFuncImports + i
+		fi := FunctionInfo{
+			Index:     id,
+			Name:      m.FunctionNames[id],
+			Signature: formatSignature(f.Sig),
+		}
+		for _, l := range f.Body.Locals {
+			fi.NumLocals += int(l.Count)
+		}
+		if i < len(bodies) {
+			fi.CodeSize = bodies[i].size
+		}
+
+		offsets, err := InstrOffsets(f.Body.Code)
+		if err != nil {
+			return nil, err
+		}
+		for _, off := range offsets {
+			op, err := ops.New(f.Body.Code[off])
+			if err != nil {
+				return nil, err
+			}
+			if IsFloatingPointOp(op.Name) {
+				fi.FloatOps++
+			}
+		}
+		if fi.FloatOps > 0 {
+			info.UsesFloatingPoint = true
+		}
+		info.Functions = append(info.Functions, fi)
+	}
+
+	return info, nil
+}
+
+func limitsInfo(importName string, l wasm.ResizableLimits) LimitsInfo {
+	ret := LimitsInfo{Import: importName, Initial: l.Initial}
+	if l.Flags&1 != 0 {
+		max := l.Maximum
+		ret.Maximum = &max
+	}
+	return ret
+}
+
+// formatSignature formats a function type as in "[i32 i64] -> [f64]".
+func formatSignature(sig *wasm.FunctionSig) string {
+	return formatValueTypes(sig.ParamTypes) + " -> " + formatValueTypes(sig.ReturnTypes)
+}
+
+func formatValueTypes(types []wasm.ValueType) string {
+	names := make([]string, len(types))
+	for i, t := range types {
+		names[i] = t.String()
+	}
+	return "[" + strings.Join(names, " ") + "]"
+}
+
+func formatLimits(l wasm.ResizableLimits) string {
+	if l.Flags&1 != 0 {
+		return fmt.Sprintf("%d..%d", l.Initial, l.Maximum)
+	}
+	return fmt.Sprintf("%d..", l.Initial)
+}
+
+func formatGlobalVar(g wasm.GlobalVar) string {
+	if g.Mutable {
+		return "mut " + g.Type.String()
+	}
+	return g.Type.String()
+}
+
+// formatConstExpr formats an initializer expression, e.g. "i32.const 5" or
+// "get_global 0".
+func formatConstExpr(expr []byte) string {
+	if len(expr) == 0 {
+		return ""
+	}
+	r := bytes.NewReader(expr[1:])
+	var err error
+	var s string
+	switch expr[0] {
+	case ops.I32Const:
+		var v int32
+		if v, err = leb128.ReadVarint32(r); err == nil {
+			s = fmt.Sprintf("i32.const %d", v)
+		}
+	case ops.I64Const:
+		var v int64
+		if v, err = leb128.ReadVarint64(r); err == nil {
+			s = fmt.Sprintf("i64.const %d", v)
+		}
+	case ops.F32Const:
+		var v uint32
+		if err = binary.Read(r, binary.LittleEndian, &v); err == nil {
+			s = "f32.const " + strconv.FormatFloat(float64(math.Float32frombits(v)), 'g', -1, 32)
+		}
+	case ops.F64Const:
+		var v uint64
+		if err = binary.Read(r, binary.LittleEndian, &v); err == nil {
+			s = "f64.const " + strconv.FormatFloat(math.Float64frombits(v), 'g', -1, 64)
+		}
+	case ops.GetGlobal:
+		var v uint32
+		if v, err = leb128.ReadVarUint32(r); err == nil {
+			s = fmt.Sprintf("get_global %d", v)
+		}
+	}
+	if s == "" || err != nil {
+		return fmt.Sprintf("<%x>", expr)
+	}
+	return s
+}
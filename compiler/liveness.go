@@ -2,46 +2,285 @@
 
 package compiler
 
-// FIXME: The current RegAlloc is based on wasm stack info and we probably
-// want a real one (in addition to this) with liveness analysis.
+import "sort"
+
+// liveRange is a range of program points. Instruction i reads its operands
+// at point 2*i and writes its target at point 2*i+1, so that an operand's last
+// use does not overlap the target.
+type liveRange struct {
+	start, end int
+}
+
+// liveInterval is the sorted list of ranges over which a value is live. The
+// gaps between them are the points where the value is not live, such as the
+// blocks between a branch to a return and the return itself.
+type liveInterval struct {
+	value  TyValueID
+	ranges []liveRange
+}
+
+// overlaps reports whether any of ranges, sorted, intersects iv.
+func (iv *liveInterval) overlaps(ranges []liveRange) bool {
+	for _, r := range iv.ranges {
+		i := sort.Search(len(ranges), func(i int) bool { return ranges[i].end >= r.start })
+		if i < len(ranges) && ranges[i].start <= r.end {
+			return true
+		}
+	}
+	return false
+}
+
+// RegAlloc assigns registers to values by a linear scan over their live
+// intervals: values that are never live at the same time share a register.
 // Returns the total number of registers used.
 func (c *SSAFunctionCompiler) RegAlloc() int {
-	regID := TyValueID(1)
+	intervals := c.liveIntervals()
+	sort.Slice(intervals, func(i, j int) bool {
+		a, b := intervals[i].ranges[0].start, intervals[j].ranges[0].start
+		if a != b {
+			return a < b
+		}
+		return intervals[i].value < intervals[j].value
+	})
+
 	valueRelocs := make(map[TyValueID]TyValueID)
-	for _, values := range c.StackValueSets {
-		for _, v := range values {
-			valueRelocs[v] = regID
+	occupied := [][]liveRange{nil} // ranges assigned to each register; register 0 is never written
+	lastEnd := []int{0}            // end of the last range in occupied
+
+	for i := range intervals {
+		iv := &intervals[i]
+		start, end := iv.ranges[0].start, iv.ranges[len(iv.ranges)-1].end
+
+		reg := 0
+		for r := 1; r < len(occupied); r++ {
+			if lastEnd[r] < start || !iv.overlaps(occupied[r]) {
+				reg = r
+				break
+			}
+		}
+		if reg == 0 {
+			reg = len(occupied)
+			occupied = append(occupied, nil)
+			lastEnd = append(lastEnd, 0)
 		}
-		regID++
+		valueRelocs[iv.value] = TyValueID(reg)
+
+		ranges := append(occupied[reg], iv.ranges...)
+		if end < lastEnd[reg] {
+			sort.Slice(ranges, func(i, j int) bool { return ranges[i].start < ranges[j].start })
+		} else {
+			lastEnd[reg] = end
+		}
+		occupied[reg] = ranges
 	}
+
 	for i := range c.Code {
 		ins := &c.Code[i]
+		if ins.Target != 0 {
+			ins.Target = valueRelocs[ins.Target]
+		}
+		values := make([]TyValueID, len(ins.Values))
+		for j, v := range ins.Values {
+			if v != 0 {
+				values[j] = valueRelocs[v]
+			}
+		}
+		ins.Values = values
+	}
+
+	return len(occupied)
+}
+
+// liveIntervals computes the live interval of each value in c.Code, as the
+// smallest range of program points covering all points where it is live.
+func (c *SSAFunctionCompiler) liveIntervals() []liveInterval {
+	code := c.Code
+
+	// Split the code into basic blocks.
+	isLeader := make([]bool, len(code)+1)
+	isLeader[0] = true
+	for i, ins := range code {
+		switch {
+		case isJump(ins.Op):
+			for _, target := range ins.Immediates {
+				if target >= 0 && int(target) <= len(code) {
+					isLeader[target] = true
+				}
+			}
+			isLeader[i+1] = true
+		case ins.Op == "return":
+			isLeader[i+1] = true
+		}
+	}
+	var starts []int // start of each block, followed by len(code)
+	blockOf := make([]int, len(code)+1)
+	for i := 0; i <= len(code); i++ {
+		if isLeader[i] {
+			starts = append(starts, i)
+		}
+		blockOf[i] = len(starts) - 1
+	}
+	if starts[len(starts)-1] != len(code) {
+		starts = append(starts, len(code))
+	}
+	numBlocks := len(starts) - 1
 
+	succs := make([][]int, numBlocks)
+	for b := 0; b < numBlocks; b++ {
+		end := starts[b+1]
+		if end == starts[b] {
+			continue
+		}
+		last := code[end-1]
+		var targets []int
+		switch {
+		case isJmpIf(last.Op):
+			targets = []int{int(last.Immediates[0]), end}
+		case isJump(last.Op):
+			for _, t := range last.Immediates {
+				targets = append(targets, int(t))
+			}
+		case last.Op == "return":
+		default:
+			targets = []int{end}
+		}
+		for _, t := range targets {
+			if t >= 0 && t < len(code) {
+				succs[b] = append(succs[b], blockOf[t])
+			}
+		}
+	}
+
+	// Values used outside of the block defining them, or before their
+	// definition, take part in the dataflow analysis; the others are only
+	// live within their block.
+	defPos := make(map[TyValueID]int)
+	for i, ins := range code {
 		if ins.Target != 0 {
-			if reg, ok := valueRelocs[ins.Target]; ok {
-				ins.Target = reg
-			} else {
-				panic("Register not found for target")
+			defPos[ins.Target] = i
+		}
+	}
+	globalIndex := make(map[TyValueID]int)
+	var globals []TyValueID
+	for i, ins := range code {
+		for _, v := range ins.Values {
+			if v == 0 {
+				continue
+			}
+			if _, seen := globalIndex[v]; seen {
+				continue
+			}
+			if d, ok := defPos[v]; !ok || d >= i || blockOf[d] != blockOf[i] {
+				globalIndex[v] = len(globals)
+				globals = append(globals, v)
 			}
 		}
+	}
 
-		for j, v := range ins.Values {
-			if v != 0 {
-				if reg, ok := valueRelocs[v]; ok {
-					ins.Values[j] = reg
-				} else {
-					panic("Register not found for value")
+	words := (len(globals) + 63) / 64
+	newSet := func() []uint64 { return make([]uint64, words) }
+	use := make([][]uint64, numBlocks)
+	def := make([][]uint64, numBlocks)
+	liveIn := make([][]uint64, numBlocks)
+	liveOut := make([][]uint64, numBlocks)
+	for b := 0; b < numBlocks; b++ {
+		use[b], def[b], liveIn[b], liveOut[b] = newSet(), newSet(), newSet(), newSet()
+		for i := starts[b]; i < starts[b+1]; i++ {
+			for _, v := range code[i].Values {
+				if g, ok := globalIndex[v]; ok && def[b][g/64]&(1<<uint(g%64)) == 0 {
+					use[b][g/64] |= 1 << uint(g%64)
+				}
+			}
+			if g, ok := globalIndex[code[i].Target]; ok {
+				def[b][g/64] |= 1 << uint(g%64)
+			}
+		}
+	}
+
+	for changed := true; changed; {
+		changed = false
+		for b := numBlocks - 1; b >= 0; b-- {
+			out := liveOut[b]
+			for _, s := range succs[b] {
+				for w, bits := range liveIn[s] {
+					out[w] |= bits
+				}
+			}
+			in := liveIn[b]
+			for w := range in {
+				bits := use[b][w] | out[w]&^def[b][w]
+				if bits != in[w] {
+					in[w] = bits
+					changed = true
 				}
 			}
 		}
 	}
 
-	return int(regID)
+	// Values are live over a single range within each block.
+	intervals := make(map[TyValueID]*liveInterval)
+	for b := 0; b < numBlocks; b++ {
+		ranges := make(map[TyValueID]*liveRange)
+		var order []TyValueID
+		extend := func(v TyValueID, point int) {
+			r, ok := ranges[v]
+			if !ok {
+				ranges[v] = &liveRange{point, point}
+				order = append(order, v)
+				return
+			}
+			if point < r.start {
+				r.start = point
+			}
+			if point > r.end {
+				r.end = point
+			}
+		}
+
+		for g, v := range globals {
+			if liveIn[b][g/64]&(1<<uint(g%64)) != 0 {
+				extend(v, 2*starts[b])
+			}
+			if liveOut[b][g/64]&(1<<uint(g%64)) != 0 {
+				extend(v, 2*starts[b+1]-1)
+			}
+		}
+		for i := starts[b]; i < starts[b+1]; i++ {
+			for _, v := range code[i].Values {
+				if v != 0 {
+					extend(v, 2*i)
+				}
+			}
+			if code[i].Target != 0 {
+				extend(code[i].Target, 2*i+1)
+			}
+		}
+
+		for _, v := range order {
+			r := *ranges[v]
+			iv, ok := intervals[v]
+			if !ok {
+				intervals[v] = &liveInterval{value: v, ranges: []liveRange{r}}
+				continue
+			}
+			if last := &iv.ranges[len(iv.ranges)-1]; last.end+1 == r.start {
+				last.end = r.end
+			} else {
+				iv.ranges = append(iv.ranges, r)
+			}
+		}
+	}
+
+	ret := make([]liveInterval, 0, len(intervals))
+	for _, iv := range intervals {
+		ret = append(ret, *iv)
+	}
+	return ret
 }
 
 func (ins *Instr) BranchTargets() []int {
-	switch ins.Op {
-	case "jmp", "jmp_if", "jmp_table":
+	switch {
+	case ins.Op == "jmp" || isJmpIf(ins.Op) || ins.Op == "jmp_table":
 		ret := make([]int, len(ins.Immediates))
 		for i, t := range ins.Immediates {
 			ret[i] = int(t)
@@ -2,22 +2,38 @@ package compiler
 
 import (
 	"bytes"
+	"context"
 	"encoding/binary"
-	"runtime"
+	"errors"
+	"fmt"
+	"sync"
 
-	// "fmt"
 	"github.com/go-interpreter/wagon/disasm"
 	"github.com/go-interpreter/wagon/wasm"
-	// "github.com/go-interpreter/wagon/validate"
-	"github.com/go-interpreter/wagon/wasm/leb128"
 	"github.com/perlin-network/life/compiler/opcodes"
 	"github.com/perlin-network/life/utils"
 )
 
 type Module struct {
-	Base                 *wasm.Module
-	FunctionNames        map[int]string
-	DisableFloatingPoint bool
+	Base                       *wasm.Module
+	ModuleName                 string
+	FunctionNames              map[int]string
+	LocalNames                 map[int]map[int]string // function index -> local index -> name
+	DisableFloatingPoint       bool
+	DeterministicFloatingPoint bool // see MakeFloatingPointDeterministic; ignored if DisableFloatingPoint is set
+	SoftFloat                  bool // see UseSoftFloat; ignored if DisableFloatingPoint is set
+	EnableCoverage             bool
+	OptLevel                   int // see MaxOptLevel
+
+	sections          []sectionHeader
+	sectionOffsets    map[wasm.SectionID]int // offset of the first section of each id
+	codeSectionOffset int
+	lineTableOnce     sync.Once
+	lineTable         *lineTable
+	lineTableErr      error
+	funcEnvOnce       sync.Once
+	env               *funcEnv
+	envErr            error
 }
 
 type InterpreterCode struct {
@@ -26,201 +42,364 @@ type InterpreterCode struct {
 	NumLocals  int
 	NumReturns int
 	Bytes      []byte
-	JITInfo    interface{}
-	JITDone    bool
+
+	// Offsets maps bytecode offsets to wasm offsets, sorted by bytecode offset.
+	Offsets []OffsetMapping
+
+	// CoverageBlocks are the blocks counted by cover_block instructions.
+	CoverageBlocks []CoverageBlock
 }
 
-func LoadModule(raw []byte) (*Module, error) {
+func LoadModule(raw []byte) (_ret *Module, retErr error) {
+	// wagon may panic on malformed input.
+	defer utils.CatchPanic(&retErr)
+
 	reader := bytes.NewReader(raw)
 
 	m, err := wasm.ReadModule(reader, nil)
 	if err != nil {
+		// DuplicateExportError.Error recurses infinitely.
+		if name, ok := err.(wasm.DuplicateExportError); ok {
+			return nil, &ValidationError{
+				Section:       wasm.SectionIDExport,
+				FunctionIndex: -1,
+				Offset:        -1,
+				Message:       fmt.Sprintf("duplicate export name %q", string(name)),
+			}
+		}
 		return nil, err
 	}
 
-	/*err = validate.VerifyModule(m)
+	if m.Version != 1 {
+		return nil, fmt.Errorf("unknown binary version %d", m.Version)
+	}
+
+	sections, err := readSectionHeaders(raw)
 	if err != nil {
 		return nil, err
-	}*/
+	}
+	if err := checkSectionSizes(m, sections); err != nil {
+		return nil, err
+	}
+	offsets := make(map[wasm.SectionID]int)
+	for _, h := range sections {
+		if _, ok := offsets[h.id]; !ok {
+			offsets[h.id] = h.offset
+		}
+	}
+	codeOffset, ok := offsets[wasm.SectionIDCode]
+	if !ok {
+		codeOffset = -1
+	}
 
-	functionNames := make(map[int]string)
+	ret := &Module{
+		Base:              m,
+		FunctionNames:     make(map[int]string),
+		LocalNames:        make(map[int]map[int]string),
+		sections:          sections,
+		sectionOffsets:    offsets,
+		codeSectionOffset: codeOffset,
+	}
 
+	if err := ret.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Malformed name sections are ignored, as they do not affect execution.
 	for _, sec := range m.Customs {
 		if sec.Name == "name" {
-			r := bytes.NewReader(sec.RawSection.Bytes)
-			for {
-				ty, err := leb128.ReadVarUint32(r)
-				if err != nil {
-					break
-				}
-				if ty != 1 {
-					continue
-				}
-				payloadLen, err := leb128.ReadVarUint32(r)
-				if err != nil {
-					panic(err)
-				}
-				data := make([]byte, int(payloadLen))
-				n, err := r.Read(data)
-				if err != nil {
-					panic(err)
-				}
-				if n != len(data) {
-					panic("len mismatch")
-				}
-				{
-					r := bytes.NewReader(data)
-					for {
-						count, err := leb128.ReadVarUint32(r)
-						if err != nil {
-							break
-						}
-						for i := 0; i < int(count); i++ {
-							index, err := leb128.ReadVarUint32(r)
-							if err != nil {
-								panic(err)
-							}
-							nameLen, err := leb128.ReadVarUint32(r)
-							if err != nil {
-								panic(err)
-							}
-							name := make([]byte, int(nameLen))
-							n, err := r.Read(name)
-							if err != nil {
-								panic(err)
-							}
-							if n != len(name) {
-								panic("len mismatch")
-							}
-							functionNames[int(index)] = string(name)
-							// fmt.Printf("%d -> %s\n", int(index), string(name))
-						}
-					}
-				}
+			names, err := DecodeNameSection(sec.Data)
+			if err != nil {
+				continue
 			}
-			// fmt.Printf("%d function names written\n", len(functionNames))
+			ret.ModuleName = names.ModuleName
+			ret.FunctionNames = names.FunctionNames
+			ret.LocalNames = names.LocalNames
 		}
 	}
 
-	return &Module{
-		Base:          m,
-		FunctionNames: functionNames,
-	}, nil
-}
+	if m.Import != nil {
+		functionID := 0
+		for _, e := range m.Import.Entries {
+			if e.Type.Kind() != wasm.ExternalFunction {
+				continue
+			}
+			if _, ok := ret.FunctionNames[functionID]; !ok {
+				ret.FunctionNames[functionID] = e.ModuleName + "." + e.FieldName
+			}
+			functionID++
+		}
+	}
 
-func (m *Module) CompileForInterpreter(gp GasPolicy) (_retCode []InterpreterCode, retErr error) {
-	defer utils.CatchPanic(&retErr)
+	return ret, nil
+}
 
-	ret := make([]InterpreterCode, 0)
-	importTypeIDs := make([]int, 0)
+// FunctionName returns the name of a function, or func[N] if it has none.
+func (m *Module) FunctionName(functionID int) string {
+	if name, ok := m.FunctionNames[functionID]; ok {
+		return name
+	}
+	return fmt.Sprintf("func[%d]", functionID)
+}
 
+// FunctionSignature returns the type of a function, given by its index in the
+// function index space, or nil if there is no such function.
+func (m *Module) FunctionSignature(functionID int) *wasm.FunctionSig {
+	if functionID < 0 {
+		return nil
+	}
 	if m.Base.Import != nil {
-		for i := 0; i < len(m.Base.Import.Entries); i++ {
-			e := &m.Base.Import.Entries[i]
+		for _, e := range m.Base.Import.Entries {
 			if e.Type.Kind() != wasm.ExternalFunction {
 				continue
 			}
-			tyID := e.Type.(wasm.FuncImport).Type
-			ty := &m.Base.Types.Entries[int(tyID)]
-
-			buf := bytes.NewBuffer(make([]byte, 0, 14))
+			if functionID == 0 {
+				return &m.Base.Types.Entries[e.Type.(wasm.FuncImport).Type]
+			}
+			functionID--
+		}
+	}
+	if functionID >= len(m.Base.FunctionIndexSpace) {
+		return nil
+	}
+	return m.Base.FunctionIndexSpace[functionID].Sig
+}
 
-			binary.Write(buf, binary.LittleEndian, uint32(1)) // value ID
-			binary.Write(buf, binary.LittleEndian, opcodes.InvokeImport)
-			binary.Write(buf, binary.LittleEndian, uint32(i))
+// LocalName returns the name of a local of a function, or an empty string if it has none.
+func (m *Module) LocalName(functionID int, index int) string {
+	return m.LocalNames[functionID][index]
+}
 
-			binary.Write(buf, binary.LittleEndian, uint32(0))
-			if len(ty.ReturnTypes) != 0 {
-				binary.Write(buf, binary.LittleEndian, opcodes.ReturnValue)
-				binary.Write(buf, binary.LittleEndian, uint32(1))
-			} else {
-				binary.Write(buf, binary.LittleEndian, opcodes.ReturnVoid)
-			}
+// CompileForInterpreter compiles all functions of the module for the
+// interpreter, in parallel. A function that fails to compile is reported in a
+// *CompileError.
+func (m *Module) CompileForInterpreter(gp GasPolicy) ([]InterpreterCode, error) {
+	return m.CompileForInterpreterContext(context.Background(), CompileOptions{GasPolicy: gp})
+}
 
-			code := buf.Bytes()
+// CompileFunction compiles a single function, given by its index in the
+// function index space, the same way as CompileForInterpreter does.
+func (m *Module) CompileFunction(id int, gp GasPolicy) (_ InterpreterCode, retErr error) {
+	defer utils.CatchPanic(&retErr)
 
-			ret = append(ret, InterpreterCode{
-				NumRegs:    2,
-				NumParams:  len(ty.ParamTypes),
-				NumLocals:  0,
-				NumReturns: len(ty.ReturnTypes),
-				Bytes:      code,
-			})
-			importTypeIDs = append(importTypeIDs, int(tyID))
-		}
+	env, err := m.funcEnv()
+	if err != nil {
+		return InterpreterCode{}, err
+	}
+	numFuncImports := len(env.importTypeIDs)
+	if id < 0 || id >= numFuncImports+len(m.Base.FunctionIndexSpace) {
+		return InterpreterCode{}, fmt.Errorf("function index %d out of range", id)
 	}
+	if id < numFuncImports {
+		return importCode(&m.Base.Types.Entries[env.importTypeIDs[id]], id), nil
+	}
+	return m.compileFunction(env, id-numFuncImports, gp, nil), nil
+}
 
-	numFuncImports := len(ret)
-	funcIndexSpaceLen := len(m.Base.FunctionIndexSpace)
-	ret = append(ret, make([]InterpreterCode, funcIndexSpaceLen)...)
+// importCode returns the code of an imported function of type ty, the
+// importID-th function import, which invokes the import.
+func importCode(ty *wasm.FunctionSig, importID int) InterpreterCode {
+	buf := bytes.NewBuffer(make([]byte, 0, 14))
 
-	type icResult struct {
-		i  int
-		ic InterpreterCode
+	binary.Write(buf, binary.LittleEndian, uint32(1)) // value ID
+	binary.Write(buf, binary.LittleEndian, opcodes.InvokeImport)
+	binary.Write(buf, binary.LittleEndian, uint32(importID)) // index among function imports
+
+	binary.Write(buf, binary.LittleEndian, uint32(0))
+	if len(ty.ReturnTypes) != 0 {
+		binary.Write(buf, binary.LittleEndian, opcodes.ReturnValue)
+		binary.Write(buf, binary.LittleEndian, uint32(1))
+	} else {
+		binary.Write(buf, binary.LittleEndian, opcodes.ReturnVoid)
 	}
 
-	type job struct {
-		i int
-		f wasm.Function
+	return InterpreterCode{
+		NumRegs:    2,
+		NumParams:  len(ty.ParamTypes),
+		NumLocals:  0,
+		NumReturns: len(ty.ReturnTypes),
+		Bytes:      buf.Bytes(),
 	}
+}
 
-	n := runtime.NumCPU()
-	rchan := make(chan icResult, funcIndexSpaceLen)
-	jobFn := func(i int, f wasm.Function) {
-		// fmt.Printf("Compiling function %d (%+v) with %d locals\n", i, f.Sig, len(f.Body.Locals))
-		d, err := disasm.Disassemble(f, m.Base)
-		if err != nil {
-			panic(err)
-		}
-		compiler := NewSSAFunctionCompiler(m.Base, d)
-		compiler.CallIndexOffset = numFuncImports
-		compiler.Compile(importTypeIDs)
-		if m.DisableFloatingPoint {
-			compiler.FilterFloatingPoint()
-		}
-		if gp != nil {
-			compiler.InsertGasCounters(gp)
-		}
-		// fmt.Println(compiler.Code)
-		// fmt.Printf("%+v\n", compiler.NewCFGraph())
-		numRegs := compiler.RegAlloc()
-		// fmt.Println(compiler.Code)
-		numLocals := 0
-		for _, v := range f.Body.Locals {
-			numLocals += int(v.Count)
-		}
-		rchan <- icResult{
-			i: i,
-			ic: InterpreterCode{
-				NumRegs:    numRegs,
-				NumParams:  len(f.Sig.ParamTypes),
-				NumLocals:  numLocals,
-				NumReturns: len(f.Sig.ReturnTypes),
-				Bytes:      compiler.Serialize(),
-			},
+// funcEnv holds the module-wide information needed to compile any single
+// function of a module.
+type funcEnv struct {
+	importTypeIDs []int // type of each function import
+	codeOffsets   []int // offset of the code of each function body in the module binary
+}
+
+// funcEnv returns the environment for compiling the functions of the
+// module, computing it on first use.
+func (m *Module) funcEnv() (*funcEnv, error) {
+	m.funcEnvOnce.Do(func() {
+		m.env, m.envErr = m.newFuncEnv()
+	})
+	return m.env, m.envErr
+}
+
+func (m *Module) newFuncEnv() (*funcEnv, error) {
+	env := &funcEnv{importTypeIDs: make([]int, 0)}
+	if m.Base.Import != nil {
+		for _, e := range m.Base.Import.Entries {
+			if e.Type.Kind() == wasm.ExternalFunction {
+				env.importTypeIDs = append(env.importTypeIDs, int(e.Type.(wasm.FuncImport).Type))
+			}
 		}
 	}
 
-	jobChan := make(chan job, funcIndexSpaceLen)
-	for g := 0; g < n; g++ {
-		go func() {
-			for job := range jobChan {
-				jobFn(job.i, job.f)
-			}
-		}()
+	codeOffsets, err := m.functionCodeOffsets()
+	if err != nil {
+		return nil, err
+	}
+	if len(codeOffsets) != len(m.Base.FunctionIndexSpace) {
+		// The code section could not be located in the module binary.
+		id := len(env.importTypeIDs) + len(codeOffsets)
+		return nil, &CompileError{Functions: []*FunctionError{{
+			FunctionIndex: id,
+			Name:          m.FunctionName(id),
+			Offset:        -1,
+			Err:           errors.New("function body not found in the code section"),
+		}}}
 	}
+	env.codeOffsets = codeOffsets
+	return env, nil
+}
 
-	go func() {
-		for i, f := range m.Base.FunctionIndexSpace {
-			jobChan <- job{i, f}
+// compileFunction compiles the i-th function defined by the module. If stages
+// is not nil, the intermediate forms of the function are recorded into it.
+// Panics with a *FunctionError on error.
+func (m *Module) compileFunction(env *funcEnv, i int, gp GasPolicy, stages *FunctionStages) InterpreterCode {
+	var compiler *SSAFunctionCompiler
+	compiling := false // whether the wasm instructions are being translated
+	codeOffset := env.codeOffsets[i]
+	defer func() {
+		if r := recover(); r != nil {
+			offset := codeOffset
+			if compiling {
+				if o := compiler.currentOffset(); o >= 0 {
+					offset = o
+				}
+			}
+			id := len(env.importTypeIDs) + i
+			panic(&FunctionError{
+				FunctionIndex: id,
+				Name:          m.FunctionName(id),
+				Offset:        offset,
+				Err:           utils.UnifyError(r),
+			})
 		}
-		close(jobChan)
 	}()
 
-	for i := 0; i < funcIndexSpaceLen; i++ {
-		r := <-rchan
-		ret[numFuncImports+r.i] = r.ic
+	f := m.Base.FunctionIndexSpace[i]
+	d, err := disasm.Disassemble(f, m.Base)
+	if err != nil {
+		panic(err)
+	}
+	offsets, err := InstrOffsets(f.Body.Code)
+	if err != nil {
+		panic(err)
+	}
+	offsets = append(offsets, len(f.Body.Code)) // the final end
+	for j := range offsets {
+		offsets[j] += codeOffset
+	}
+	compiler = NewSSAFunctionCompiler(m.Base, d)
+	compiler.CallIndexOffset = len(env.importTypeIDs)
+	compiler.NumReturns = len(f.Sig.ReturnTypes)
+	compiler.SourceOffsets = offsets
+	compiling = true
+	compiler.Compile(env.importTypeIDs)
+	compiling = false
+	if m.DisableFloatingPoint {
+		compiler.FilterFloatingPoint()
+	} else if m.SoftFloat {
+		compiler.UseSoftFloat()
 	}
+	if gp != nil {
+		compiler.InsertGasCounters(gp)
+	}
+	var coverageBlocks []CoverageBlock
+	if m.EnableCoverage {
+		coverageBlocks = compiler.InsertCoverageCounters()
+	}
+	if m.DeterministicFloatingPoint && !m.DisableFloatingPoint {
+		compiler.MakeFloatingPointDeterministic()
+	}
+	compiler.Optimize(m.OptLevel)
+	if stages != nil {
+		stages.SSA = copyInstrs(compiler.Code)
+		stages.CFG = compiler.NewCFGraph()
+		for j := range stages.CFG.Blocks {
+			stages.CFG.Blocks[j].Code = copyInstrs(stages.CFG.Blocks[j].Code)
+		}
+	}
+	if m.OptLevel > 0 {
+		compiler.Fuse()
+	}
+	numRegs := compiler.RegAlloc()
+	if stages != nil {
+		stages.RegAlloc = copyInstrs(compiler.Code)
+	}
+	code, offsetTable := compiler.SerializeWithOffsets()
+	numLocals := 0
+	for _, v := range f.Body.Locals {
+		numLocals += int(v.Count)
+	}
+	ic := InterpreterCode{
+		NumRegs:    numRegs,
+		NumParams:  len(f.Sig.ParamTypes),
+		NumLocals:  numLocals,
+		NumReturns: len(f.Sig.ReturnTypes),
+		Bytes:      code,
+		Offsets:    offsetTable,
 
-	return ret, nil
+		CoverageBlocks: coverageBlocks,
+	}
+	if stages != nil {
+		stages.Code = ic
+	}
+	return ic
+}
+
+// FunctionStages holds the intermediate forms a function goes through while
+// being compiled for the interpreter.
+type FunctionStages struct {
+	SSA      []Instr  // SSA form, after instrumentation and optimization
+	CFG      *CFGraph // control flow graph of the SSA form
+	RegAlloc []Instr  // SSA form with superinstructions, and values replaced by registers
+	Code     InterpreterCode
+}
+
+// CompileFunctionStages compiles a single function, given by its index in the
+// function index space, the same way as CompileForInterpreter does and
+// returns its intermediate forms.
+func (m *Module) CompileFunctionStages(id int, gp GasPolicy) (_ *FunctionStages, retErr error) {
+	defer utils.CatchPanic(&retErr)
+
+	env, err := m.funcEnv()
+	if err != nil {
+		return nil, err
+	}
+	i := id - len(env.importTypeIDs)
+	if id < 0 || i >= len(m.Base.FunctionIndexSpace) {
+		return nil, fmt.Errorf("function index %d out of range", id)
+	}
+	if i < 0 {
+		return nil, fmt.Errorf("function %d is imported", id)
+	}
+
+	stages := &FunctionStages{}
+	m.compileFunction(env, i, gp, stages)
+	return stages, nil
+}
+
+func copyInstrs(code []Instr) []Instr {
+	ret := make([]Instr, len(code))
+	for i, ins := range code {
+		ret[i] = ins
+		ret[i].Immediates = append([]int64(nil), ins.Immediates...)
+		ret[i].Values = append([]TyValueID(nil), ins.Values...)
+	}
+	return ret
 }
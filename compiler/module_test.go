@@ -0,0 +1,70 @@
+package compiler
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadModuleSectionSizes(t *testing.T) {
+	loop, err := ioutil.ReadFile(filepath.Join("..", "spec", "testdata", "loop.0.wasm"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadModule(loop); err != nil {
+		t.Fatal(err)
+	}
+	withTypeSize := func(size byte) []byte {
+		ret := append([]byte{}, loop...)
+		ret[9] = size // declared 0x13
+		return ret
+	}
+
+	header := []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+	tests := []struct {
+		name       string
+		module     []byte
+		validation bool // fails with a *ValidationError
+	}{
+		{"short type section", withTypeSize(0x12), false},
+		{"long type section", withTypeSize(0x14), false},
+		{"unknown section", append(append([]byte{}, loop...), 0x0c, 0x00), false},
+		{"section past the end", append(append([]byte{}, loop...), 0x00, 0x10, 0x01, 'x'), true},
+		{"padded type section", append(header,
+			0x01, 0x08, 0x01, 0x60, 0x00, 0x01, 0x7f, // type section [] -> [i32], declaring 3 more bytes
+			0x00, 0x01, 0x00, // which wagon reads as an empty custom section
+			0x03, 0x02, 0x01, 0x00, // function section
+			0x0a, 0x06, 0x01, 0x04, 0x00, 0x41, 0x01, 0x0b, // code section
+		), true},
+	}
+	for _, tt := range tests {
+		_, err := LoadModule(tt.module)
+		if err == nil {
+			t.Errorf("%s: module loaded", tt.name)
+			continue
+		}
+		if _, ok := err.(*ValidationError); tt.validation && !ok {
+			t.Errorf("%s: got %T %v, want a *ValidationError", tt.name, err, err)
+		}
+	}
+}
+
+func TestCompileWithoutFunctionBodies(t *testing.T) {
+	loop, err := ioutil.ReadFile(filepath.Join("..", "spec", "testdata", "loop.0.wasm"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := LoadModule(loop)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A wrong section size used to leave the code section where LoadModule
+	// did not find it; LoadModule now rejects such modules.
+	m.codeSectionOffset = -1
+
+	_, err = m.CompileForInterpreterContext(context.Background(), CompileOptions{})
+	if _, ok := err.(*CompileError); !ok {
+		t.Fatalf("got %T %v, want a *CompileError", err, err)
+	}
+}
@@ -0,0 +1,116 @@
+package compiler
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/go-interpreter/wagon/wasm/leb128"
+)
+
+// Name subsection IDs.
+const (
+	NameSubsectionModule   = 0
+	NameSubsectionFunction = 1
+	NameSubsectionLocal    = 2
+)
+
+// NameSection holds the decoded contents of the "name" custom section.
+type NameSection struct {
+	ModuleName    string
+	FunctionNames map[int]string
+	LocalNames    map[int]map[int]string // function index -> local index -> name
+}
+
+// DecodeNameSection decodes the payload of a "name" custom section.
+// Unknown subsections are skipped.
+func DecodeNameSection(data []byte) (*NameSection, error) {
+	ret := &NameSection{
+		FunctionNames: make(map[int]string),
+		LocalNames:    make(map[int]map[int]string),
+	}
+
+	r := bytes.NewReader(data)
+	for r.Len() > 0 {
+		id, err := leb128.ReadVarUint32(r)
+		if err != nil {
+			return nil, err
+		}
+		payload, err := readNameBytes(r)
+		if err != nil {
+			return nil, fmt.Errorf("name subsection %d: %v", id, err)
+		}
+
+		sr := bytes.NewReader(payload)
+		switch id {
+		case NameSubsectionModule:
+			name, err := readNameBytes(sr)
+			if err != nil {
+				return nil, fmt.Errorf("module name: %v", err)
+			}
+			ret.ModuleName = string(name)
+		case NameSubsectionFunction:
+			if err := readNameMap(sr, ret.FunctionNames); err != nil {
+				return nil, fmt.Errorf("function names: %v", err)
+			}
+		case NameSubsectionLocal:
+			count, err := leb128.ReadVarUint32(sr)
+			if err != nil {
+				return nil, fmt.Errorf("local names: %v", err)
+			}
+			for i := uint32(0); i < count; i++ {
+				functionID, err := leb128.ReadVarUint32(sr)
+				if err != nil {
+					return nil, fmt.Errorf("local names: %v", err)
+				}
+				names := make(map[int]string)
+				if err := readNameMap(sr, names); err != nil {
+					return nil, fmt.Errorf("local names of function %d: %v", functionID, err)
+				}
+				ret.LocalNames[int(functionID)] = names
+			}
+		default:
+			continue
+		}
+		if sr.Len() != 0 {
+			return nil, fmt.Errorf("name subsection %d: %d trailing bytes", id, sr.Len())
+		}
+	}
+
+	return ret, nil
+}
+
+func readNameMap(r *bytes.Reader, out map[int]string) error {
+	count, err := leb128.ReadVarUint32(r)
+	if err != nil {
+		return err
+	}
+	for i := uint32(0); i < count; i++ {
+		index, err := leb128.ReadVarUint32(r)
+		if err != nil {
+			return err
+		}
+		name, err := readNameBytes(r)
+		if err != nil {
+			return err
+		}
+		out[int(index)] = string(name)
+	}
+	return nil
+}
+
+// readNameBytes reads a length-prefixed byte string.
+func readNameBytes(r *bytes.Reader) ([]byte, error) {
+	n, err := leb128.ReadVarUint32(r)
+	if err != nil {
+		return nil, err
+	}
+	if int64(n) > int64(r.Len()) {
+		return nil, io.ErrUnexpectedEOF
+	}
+	ret := make([]byte, int(n))
+	if _, err := io.ReadFull(r, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
@@ -0,0 +1,239 @@
+package compiler
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/go-interpreter/wagon/wasm"
+	"github.com/go-interpreter/wagon/wasm/leb128"
+	ops "github.com/go-interpreter/wagon/wasm/operators"
+	"github.com/perlin-network/life/utils"
+)
+
+// OffsetMapping maps the start of an interpreter instruction to the wasm
+// instruction it was compiled from.
+type OffsetMapping struct {
+	BytecodeOffset int // offset of the instruction in InterpreterCode.Bytes
+	WasmOffset     int // offset of the wasm instruction in the module binary
+}
+
+// WasmOffset returns the offset in the module binary of the wasm instruction
+// that the bytecode at ip was compiled from, or -1 if it is unknown.
+func (c *InterpreterCode) WasmOffset(ip int) int {
+	i := sort.Search(len(c.Offsets), func(i int) bool {
+		return c.Offsets[i].BytecodeOffset > ip
+	})
+	if i == 0 {
+		return -1
+	}
+	return c.Offsets[i-1].WasmOffset
+}
+
+// InstrOffsets returns the offset of each instruction within the code of a
+// function body, in the order produced by disasm.Disassemble.
+func InstrOffsets(code []byte) (_ret []int, retErr error) {
+	defer utils.CatchPanic(&retErr)
+
+	r := bytes.NewReader(code)
+	ret := make([]int, 0)
+
+	varUint32 := func() {
+		if _, err := leb128.ReadVarUint32(r); err != nil {
+			panic(err)
+		}
+	}
+	skip := func(n int64) {
+		if _, err := r.Seek(n, io.SeekCurrent); err != nil {
+			panic(err)
+		}
+	}
+
+	for r.Len() > 0 {
+		ret = append(ret, len(code)-r.Len())
+		op, _ := r.ReadByte()
+
+		switch op {
+		case ops.Block, ops.Loop, ops.If:
+			if _, err := leb128.ReadVarint32(r); err != nil {
+				panic(err)
+			}
+		case ops.Br, ops.BrIf, ops.Call,
+			ops.GetLocal, ops.SetLocal, ops.TeeLocal, ops.GetGlobal, ops.SetGlobal,
+			ops.CurrentMemory, ops.GrowMemory:
+			varUint32()
+		case ops.BrTable:
+			targetCount, err := leb128.ReadVarUint32(r)
+			if err != nil {
+				panic(err)
+			}
+			for i := uint32(0); i <= targetCount; i++ {
+				varUint32()
+			}
+		case ops.CallIndirect:
+			varUint32()
+			varUint32()
+		case ops.I32Const:
+			if _, err := leb128.ReadVarint32(r); err != nil {
+				panic(err)
+			}
+		case ops.I64Const:
+			if _, err := leb128.ReadVarint64(r); err != nil {
+				panic(err)
+			}
+		case ops.F32Const:
+			skip(4)
+		case ops.F64Const:
+			skip(8)
+		case ops.I32Load, ops.I64Load, ops.F32Load, ops.F64Load,
+			ops.I32Load8s, ops.I32Load8u, ops.I32Load16s, ops.I32Load16u,
+			ops.I64Load8s, ops.I64Load8u, ops.I64Load16s, ops.I64Load16u, ops.I64Load32s, ops.I64Load32u,
+			ops.I32Store, ops.I64Store, ops.F32Store, ops.F64Store,
+			ops.I32Store8, ops.I32Store16, ops.I64Store8, ops.I64Store16, ops.I64Store32:
+			varUint32() // flags
+			varUint32() // offset
+		}
+	}
+
+	return ret, nil
+}
+
+// sectionHeader locates a section payload in the module binary.
+type sectionHeader struct {
+	id     wasm.SectionID
+	offset int
+	size   int
+}
+
+// readSectionHeaders returns the headers of the sections of the module binary
+// in order. wagon's RawSection.Start is not reliable for this as its position
+// tracking skips single byte reads.
+func readSectionHeaders(raw []byte) ([]sectionHeader, error) {
+	if len(raw) < 8 {
+		return nil, fmt.Errorf("module too short")
+	}
+	r := bytes.NewReader(raw[8:]) // magic and version
+
+	ret := make([]sectionHeader, 0)
+	for r.Len() > 0 {
+		start := len(raw) - r.Len()
+		id, err := leb128.ReadVarUint32(r)
+		if err != nil {
+			return nil, err
+		}
+		if id > uint32(wasm.SectionIDData) {
+			return nil, fmt.Errorf("unknown section id %d at 0x%x", id, start)
+		}
+		payloadLen, err := leb128.ReadVarUint32(r)
+		if err != nil {
+			return nil, err
+		}
+		if int64(payloadLen) > int64(r.Len()) {
+			return nil, &ValidationError{
+				Section:       wasm.SectionID(id),
+				FunctionIndex: -1,
+				Offset:        start,
+				Message:       fmt.Sprintf("section size %d exceeds the %d bytes left in the module", payloadLen, r.Len()),
+			}
+		}
+		ret = append(ret, sectionHeader{
+			id:     wasm.SectionID(id),
+			offset: len(raw) - r.Len(),
+			size:   int(payloadLen),
+		})
+		if _, err := r.Seek(int64(payloadLen), io.SeekCurrent); err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
+// checkSectionSizes checks that the contents of each section read by wagon
+// take up exactly the size declared in its header. wagon reads the next
+// section from wherever the contents of the previous one end, so a wrong size
+// would otherwise go unnoticed and leave headers pointing at the wrong bytes.
+func checkSectionSizes(m *wasm.Module, headers []sectionHeader) error {
+	for i, sec := range m.Sections {
+		raw := sec.GetRawSection()
+		if i >= len(headers) || headers[i].id != raw.ID {
+			return &ValidationError{
+				Section:       raw.ID,
+				FunctionIndex: -1,
+				Offset:        -1,
+				Message:       "section does not start where the size of the previous one says",
+			}
+		}
+		if h := headers[i]; len(raw.Bytes) != h.size {
+			return &ValidationError{
+				Section:       h.id,
+				FunctionIndex: -1,
+				Offset:        h.offset,
+				Message:       fmt.Sprintf("section size is %d, but its contents take %d bytes", h.size, len(raw.Bytes)),
+			}
+		}
+	}
+	if len(m.Sections) != len(headers) {
+		return fmt.Errorf("module has %d sections, read %d", len(headers), len(m.Sections))
+	}
+	return nil
+}
+
+// functionBody locates a function body in the module binary.
+type functionBody struct {
+	offset     int // offset of the body, starting with the local declarations
+	size       int
+	codeOffset int // offset of the code following the local declarations
+}
+
+// functionBodies locates the body of each function defined by the module.
+func (m *Module) functionBodies() ([]functionBody, error) {
+	if m.Base.Code == nil || m.codeSectionOffset < 0 {
+		return nil, nil
+	}
+
+	sec := m.Base.Code
+	r := bytes.NewReader(sec.RawSection.Bytes)
+
+	count, err := leb128.ReadVarUint32(r)
+	if err != nil {
+		return nil, err
+	}
+	if int(count) != len(sec.Bodies) {
+		return nil, fmt.Errorf("code section declares %d bodies, got %d", count, len(sec.Bodies))
+	}
+
+	ret := make([]functionBody, len(sec.Bodies))
+	for i := range sec.Bodies {
+		bodySize, err := leb128.ReadVarUint32(r)
+		if err != nil {
+			return nil, err
+		}
+
+		bodyStart := m.codeSectionOffset + len(sec.RawSection.Bytes) - r.Len()
+		ret[i] = functionBody{
+			offset: bodyStart,
+			size:   int(bodySize),
+			// wagon strips the final end opcode from the code.
+			codeOffset: bodyStart + int(bodySize) - 1 - len(sec.Bodies[i].Code),
+		}
+		if _, err := r.Seek(int64(bodySize), io.SeekCurrent); err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
+// functionCodeOffsets returns the offset in the module binary of the code
+// (following the local declarations) of each function body.
+func (m *Module) functionCodeOffsets() ([]int, error) {
+	bodies, err := m.functionBodies()
+	if err != nil || bodies == nil {
+		return nil, err
+	}
+	ret := make([]int, len(bodies))
+	for i, b := range bodies {
+		ret[i] = b.codeOffset
+	}
+	return ret, nil
+}
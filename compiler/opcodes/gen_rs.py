@@ -3,10 +3,12 @@ with open("opcodes.go") as f:
     lines = data.split("const (")[1].split(")")[0].strip().split("\n")
 
     out = "#[repr(u8)]\n#[derive(Copy, Clone, Eq, PartialEq)]\npub enum Opcode {\n"
-    for i, line in enumerate(lines):
-        line = line.strip().split(" ")[0]
+    i = 0
+    for line in lines:
+        line = line.split("//")[0].strip().split(" ")[0]
         if len(line) > 0:
             out += "    {0} = {1},\n".format(line, i)
+            i += 1
     out += "}\n"
     with open("opcodes.rs", "w") as outFile:
         outFile.write(out)
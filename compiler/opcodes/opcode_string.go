@@ -4,9 +4,9 @@ package opcodes
 
 import "strconv"
 
-const _Opcode_name = "NopUnreachableSelectI32ConstI32AddI32SubI32MulI32DivSI32DivUI32RemSI32RemUI32AndI32OrI32XorI32ShlI32ShrSI32ShrUI32RotlI32RotrI32ClzI32CtzI32PopCntI32EqZI32EqI32NeI32LtSI32LtUI32LeSI32LeUI32GtSI32GtUI32GeSI32GeUI64ConstI64AddI64SubI64MulI64DivSI64DivUI64RemSI64RemUI64RotlI64RotrI64ClzI64CtzI64PopCntI64EqZI64AndI64OrI64XorI64ShlI64ShrSI64ShrUI64EqI64NeI64LtSI64LtUI64LeSI64LeUI64GtSI64GtUI64GeSI64GeUF32AddF32SubF32MulF32DivF32SqrtF32MinF32MaxF32CeilF32FloorF32TruncF32NearestF32AbsF32NegF32CopySignF32EqF32NeF32LtF32LeF32GtF32GeF64AddF64SubF64MulF64DivF64SqrtF64MinF64MaxF64CeilF64FloorF64TruncF64NearestF64AbsF64NegF64CopySignF64EqF64NeF64LtF64LeF64GtF64GeI32WrapI64I32TruncUF32I32TruncUF64I32TruncSF32I32TruncSF64I64TruncUF32I64TruncUF64I64TruncSF32I64TruncSF64I64ExtendUI32I64ExtendSI32F32DemoteF64F64PromoteF32F32ConvertSI32F32ConvertSI64F32ConvertUI32F32ConvertUI64F64ConvertSI32F64ConvertSI64F64ConvertUI32F64ConvertUI64I32LoadI64LoadI32StoreI64StoreI32Load8SI32Load16SI64Load8SI64Load16SI64Load32SI32Load8UI32Load16UI64Load8UI64Load16UI64Load32UI32Store8I32Store16I64Store8I64Store16I64Store32JmpJmpIfJmpEitherJmpTableReturnValueReturnVoidGetLocalSetLocalGetGlobalSetGlobalCallCallIndirectInvokeImportCurrentMemoryGrowMemoryPhiAddGasFPDisabledErrorUnknown"
+const _Opcode_name = "NopUnreachableSelectI32ConstI32AddI32SubI32MulI32DivSI32DivUI32RemSI32RemUI32AndI32OrI32XorI32ShlI32ShrSI32ShrUI32RotlI32RotrI32ClzI32CtzI32PopCntI32EqZI32EqI32NeI32LtSI32LtUI32LeSI32LeUI32GtSI32GtUI32GeSI32GeUI64ConstI64AddI64SubI64MulI64DivSI64DivUI64RemSI64RemUI64RotlI64RotrI64ClzI64CtzI64PopCntI64EqZI64AndI64OrI64XorI64ShlI64ShrSI64ShrUI64EqI64NeI64LtSI64LtUI64LeSI64LeUI64GtSI64GtUI64GeSI64GeUF32AddF32SubF32MulF32DivF32SqrtF32MinF32MaxF32CeilF32FloorF32TruncF32NearestF32AbsF32NegF32CopySignF32EqF32NeF32LtF32LeF32GtF32GeF64AddF64SubF64MulF64DivF64SqrtF64MinF64MaxF64CeilF64FloorF64TruncF64NearestF64AbsF64NegF64CopySignF64EqF64NeF64LtF64LeF64GtF64GeI32WrapI64I32TruncUF32I32TruncUF64I32TruncSF32I32TruncSF64I64TruncUF32I64TruncUF64I64TruncSF32I64TruncSF64I64ExtendUI32I64ExtendSI32F32DemoteF64F64PromoteF32F32ConvertSI32F32ConvertSI64F32ConvertUI32F32ConvertUI64F64ConvertSI32F64ConvertSI64F64ConvertUI32F64ConvertUI64I32LoadI64LoadI32StoreI64StoreI32Load8SI32Load16SI64Load8SI64Load16SI64Load32SI32Load8UI32Load16UI64Load8UI64Load16UI64Load32UI32Store8I32Store16I64Store8I64Store16I64Store32JmpJmpIfJmpEitherJmpTableReturnValueReturnVoidGetLocalSetLocalGetGlobalSetGlobalCallCallIndirectInvokeImportCurrentMemoryGrowMemoryPhiAddGasCoverBlockFPDisabledErrorF32CanonicalizeNaNF64CanonicalizeNaNI32TruncSCheckedF32I32TruncSCheckedF64I32TruncUCheckedF32I32TruncUCheckedF64I64TruncSCheckedF32I64TruncSCheckedF64I64TruncUCheckedF32I64TruncUCheckedF64F32AddSoftF32SubSoftF32MulSoftF32DivSoftF32MinSoftF32MaxSoftF32SqrtSoftF32CeilSoftF32FloorSoftF32TruncSoftF32NearestSoftF64AddSoftF64SubSoftF64MulSoftF64DivSoftF64MinSoftF64MaxSoftF64SqrtSoftF64CeilSoftF64FloorSoftF64TruncSoftF64NearestSoftI32TruncSSoftF32I32TruncSSoftF64I32TruncUSoftF32I32TruncUSoftF64I64TruncSSoftF32I64TruncSSoftF64I64TruncUSoftF32I64TruncUSoftF64F32ConvertSSoftI32F32ConvertSSoftI64F32ConvertUSoftI32F32ConvertUSoftI64F64ConvertSSoftI32F64ConvertSSoftI64F64ConvertUSoftI32F64ConvertUSoftI64F32DemoteSoftF64F64PromoteSoftF32I32AddImmI32EqImmI64AddImmI64ShrUImmI64ShlImmI32AddLocalImmI32EqLocalImmJmpIfI32GtUJmpIfI32GeUJmpIfI64NeJmpIfI64EqZJmpIfI32OrI32LoadAddImmI64LoadAddImmUnknown"
 
-var _Opcode_index = [...]uint16{0, 3, 14, 20, 28, 34, 40, 46, 53, 60, 67, 74, 80, 85, 91, 97, 104, 111, 118, 125, 131, 137, 146, 152, 157, 162, 168, 174, 180, 186, 192, 198, 204, 210, 218, 224, 230, 236, 243, 250, 257, 264, 271, 278, 284, 290, 299, 305, 311, 316, 322, 328, 335, 342, 347, 352, 358, 364, 370, 376, 382, 388, 394, 400, 406, 412, 418, 424, 431, 437, 443, 450, 458, 466, 476, 482, 488, 499, 504, 509, 514, 519, 524, 529, 535, 541, 547, 553, 560, 566, 572, 579, 587, 595, 605, 611, 617, 628, 633, 638, 643, 648, 653, 658, 668, 680, 692, 704, 716, 728, 740, 752, 764, 777, 790, 802, 815, 829, 843, 857, 871, 885, 899, 913, 927, 934, 941, 949, 957, 966, 976, 985, 995, 1005, 1014, 1024, 1033, 1043, 1053, 1062, 1072, 1081, 1091, 1101, 1104, 1109, 1118, 1126, 1137, 1147, 1155, 1163, 1172, 1181, 1185, 1197, 1209, 1222, 1232, 1235, 1241, 1256, 1263}
+var _Opcode_index = [...]uint16{0, 3, 14, 20, 28, 34, 40, 46, 53, 60, 67, 74, 80, 85, 91, 97, 104, 111, 118, 125, 131, 137, 146, 152, 157, 162, 168, 174, 180, 186, 192, 198, 204, 210, 218, 224, 230, 236, 243, 250, 257, 264, 271, 278, 284, 290, 299, 305, 311, 316, 322, 328, 335, 342, 347, 352, 358, 364, 370, 376, 382, 388, 394, 400, 406, 412, 418, 424, 431, 437, 443, 450, 458, 466, 476, 482, 488, 499, 504, 509, 514, 519, 524, 529, 535, 541, 547, 553, 560, 566, 572, 579, 587, 595, 605, 611, 617, 628, 633, 638, 643, 648, 653, 658, 668, 680, 692, 704, 716, 728, 740, 752, 764, 777, 790, 802, 815, 829, 843, 857, 871, 885, 899, 913, 927, 934, 941, 949, 957, 966, 976, 985, 995, 1005, 1014, 1024, 1033, 1043, 1053, 1062, 1072, 1081, 1091, 1101, 1104, 1109, 1118, 1126, 1137, 1147, 1155, 1163, 1172, 1181, 1185, 1197, 1209, 1222, 1232, 1235, 1241, 1251, 1266, 1284, 1302, 1321, 1340, 1359, 1378, 1397, 1416, 1435, 1454, 1464, 1474, 1484, 1494, 1504, 1514, 1525, 1536, 1548, 1560, 1574, 1584, 1594, 1604, 1614, 1624, 1634, 1645, 1656, 1668, 1680, 1694, 1710, 1726, 1742, 1758, 1774, 1790, 1806, 1822, 1840, 1858, 1876, 1894, 1912, 1930, 1948, 1966, 1982, 1999, 2008, 2016, 2025, 2035, 2044, 2058, 2071, 2082, 2093, 2103, 2114, 2124, 2137, 2150, 2157}
 
 func (i Opcode) String() string {
 	if i >= Opcode(len(_Opcode_index)-1) {
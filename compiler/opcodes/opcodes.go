@@ -186,7 +186,79 @@ const (
 
 	AddGas
 
+	CoverBlock
+
 	FPDisabledError
 
+	// Deterministic floating point, see compiler/float.go.
+	F32CanonicalizeNaN
+	F64CanonicalizeNaN
+	I32TruncSCheckedF32
+	I32TruncSCheckedF64
+	I32TruncUCheckedF32
+	I32TruncUCheckedF64
+	I64TruncSCheckedF32
+	I64TruncSCheckedF64
+	I64TruncUCheckedF32
+	I64TruncUCheckedF64
+
+	// Floating point in software, see compiler/float.go.
+	F32AddSoft
+	F32SubSoft
+	F32MulSoft
+	F32DivSoft
+	F32MinSoft
+	F32MaxSoft
+	F32SqrtSoft
+	F32CeilSoft
+	F32FloorSoft
+	F32TruncSoft
+	F32NearestSoft
+	F64AddSoft
+	F64SubSoft
+	F64MulSoft
+	F64DivSoft
+	F64MinSoft
+	F64MaxSoft
+	F64SqrtSoft
+	F64CeilSoft
+	F64FloorSoft
+	F64TruncSoft
+	F64NearestSoft
+	I32TruncSSoftF32
+	I32TruncSSoftF64
+	I32TruncUSoftF32
+	I32TruncUSoftF64
+	I64TruncSSoftF32
+	I64TruncSSoftF64
+	I64TruncUSoftF32
+	I64TruncUSoftF64
+	F32ConvertSSoftI32
+	F32ConvertSSoftI64
+	F32ConvertUSoftI32
+	F32ConvertUSoftI64
+	F64ConvertSSoftI32
+	F64ConvertSSoftI64
+	F64ConvertUSoftI32
+	F64ConvertUSoftI64
+	F32DemoteSoftF64
+	F64PromoteSoftF32
+
+	// Superinstructions, see compiler/fuse.go.
+	I32AddImm
+	I32EqImm
+	I64AddImm
+	I64ShrUImm
+	I64ShlImm
+	I32AddLocalImm
+	I32EqLocalImm
+	JmpIfI32GtU
+	JmpIfI32GeU
+	JmpIfI64Ne
+	JmpIfI64EqZ
+	JmpIfI32Or
+	I32LoadAddImm
+	I64LoadAddImm
+
 	Unknown
 )
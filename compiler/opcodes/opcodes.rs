@@ -34,133 +34,198 @@ pub enum Opcode {
     I32GtU = 30,
     I32GeS = 31,
     I32GeU = 32,
-    I64Const = 34,
-    I64Add = 35,
-    I64Sub = 36,
-    I64Mul = 37,
-    I64DivS = 38,
-    I64DivU = 39,
-    I64RemS = 40,
-    I64RemU = 41,
-    I64Rotl = 42,
-    I64Rotr = 43,
-    I64Clz = 44,
-    I64Ctz = 45,
-    I64PopCnt = 46,
-    I64EqZ = 47,
-    I64And = 48,
-    I64Or = 49,
-    I64Xor = 50,
-    I64Shl = 51,
-    I64ShrS = 52,
-    I64ShrU = 53,
-    I64Eq = 54,
-    I64Ne = 55,
-    I64LtS = 56,
-    I64LtU = 57,
-    I64LeS = 58,
-    I64LeU = 59,
-    I64GtS = 60,
-    I64GtU = 61,
-    I64GeS = 62,
-    I64GeU = 63,
-    F32Add = 65,
-    F32Sub = 66,
-    F32Mul = 67,
-    F32Div = 68,
-    F32Sqrt = 69,
-    F32Min = 70,
-    F32Max = 71,
-    F32Ceil = 72,
-    F32Floor = 73,
-    F32Trunc = 74,
-    F32Nearest = 75,
-    F32Abs = 76,
-    F32Neg = 77,
-    F32CopySign = 78,
-    F32Eq = 79,
-    F32Ne = 80,
-    F32Lt = 81,
-    F32Le = 82,
-    F32Gt = 83,
-    F32Ge = 84,
-    F64Add = 86,
-    F64Sub = 87,
-    F64Mul = 88,
-    F64Div = 89,
-    F64Sqrt = 90,
-    F64Min = 91,
-    F64Max = 92,
-    F64Ceil = 93,
-    F64Floor = 94,
-    F64Trunc = 95,
-    F64Nearest = 96,
-    F64Abs = 97,
-    F64Neg = 98,
-    F64CopySign = 99,
-    F64Eq = 100,
-    F64Ne = 101,
-    F64Lt = 102,
-    F64Le = 103,
-    F64Gt = 104,
-    F64Ge = 105,
-    I32WrapI64 = 107,
-    I32TruncUF32 = 108,
-    I32TruncUF64 = 109,
-    I32TruncSF32 = 110,
-    I32TruncSF64 = 111,
-    I64TruncUF32 = 112,
-    I64TruncUF64 = 113,
-    I64TruncSF32 = 114,
-    I64TruncSF64 = 115,
-    I64ExtendUI32 = 116,
-    I64ExtendSI32 = 117,
-    F32DemoteF64 = 119,
-    F64PromoteF32 = 120,
-    F32ConvertSI32 = 121,
-    F32ConvertSI64 = 122,
-    F32ConvertUI32 = 123,
-    F32ConvertUI64 = 124,
-    F64ConvertSI32 = 125,
-    F64ConvertSI64 = 126,
-    F64ConvertUI32 = 127,
-    F64ConvertUI64 = 128,
-    I32Load = 130,
-    I64Load = 131,
-    I32Store = 133,
-    I64Store = 134,
-    I32Load8S = 136,
-    I32Load16S = 137,
-    I64Load8S = 138,
-    I64Load16S = 139,
-    I64Load32S = 140,
-    I32Load8U = 142,
-    I32Load16U = 143,
-    I64Load8U = 144,
-    I64Load16U = 145,
-    I64Load32U = 146,
-    I32Store8 = 148,
-    I32Store16 = 149,
-    I64Store8 = 150,
-    I64Store16 = 151,
-    I64Store32 = 152,
-    Jmp = 154,
-    JmpIf = 155,
-    JmpEither = 156,
-    JmpTable = 157,
-    ReturnValue = 158,
-    ReturnVoid = 159,
-    GetLocal = 161,
-    SetLocal = 162,
-    GetGlobal = 164,
-    SetGlobal = 165,
-    Call = 167,
-    CallIndirect = 168,
-    InvokeImport = 169,
-    CurrentMemory = 171,
-    GrowMemory = 172,
-    Phi = 174,
-    AddGas = 176,
-    FPDisabledError = 178,
-    Unknown = 180,
+    I64Const = 33,
+    I64Add = 34,
+    I64Sub = 35,
+    I64Mul = 36,
+    I64DivS = 37,
+    I64DivU = 38,
+    I64RemS = 39,
+    I64RemU = 40,
+    I64Rotl = 41,
+    I64Rotr = 42,
+    I64Clz = 43,
+    I64Ctz = 44,
+    I64PopCnt = 45,
+    I64EqZ = 46,
+    I64And = 47,
+    I64Or = 48,
+    I64Xor = 49,
+    I64Shl = 50,
+    I64ShrS = 51,
+    I64ShrU = 52,
+    I64Eq = 53,
+    I64Ne = 54,
+    I64LtS = 55,
+    I64LtU = 56,
+    I64LeS = 57,
+    I64LeU = 58,
+    I64GtS = 59,
+    I64GtU = 60,
+    I64GeS = 61,
+    I64GeU = 62,
+    F32Add = 63,
+    F32Sub = 64,
+    F32Mul = 65,
+    F32Div = 66,
+    F32Sqrt = 67,
+    F32Min = 68,
+    F32Max = 69,
+    F32Ceil = 70,
+    F32Floor = 71,
+    F32Trunc = 72,
+    F32Nearest = 73,
+    F32Abs = 74,
+    F32Neg = 75,
+    F32CopySign = 76,
+    F32Eq = 77,
+    F32Ne = 78,
+    F32Lt = 79,
+    F32Le = 80,
+    F32Gt = 81,
+    F32Ge = 82,
+    F64Add = 83,
+    F64Sub = 84,
+    F64Mul = 85,
+    F64Div = 86,
+    F64Sqrt = 87,
+    F64Min = 88,
+    F64Max = 89,
+    F64Ceil = 90,
+    F64Floor = 91,
+    F64Trunc = 92,
+    F64Nearest = 93,
+    F64Abs = 94,
+    F64Neg = 95,
+    F64CopySign = 96,
+    F64Eq = 97,
+    F64Ne = 98,
+    F64Lt = 99,
+    F64Le = 100,
+    F64Gt = 101,
+    F64Ge = 102,
+    I32WrapI64 = 103,
+    I32TruncUF32 = 104,
+    I32TruncUF64 = 105,
+    I32TruncSF32 = 106,
+    I32TruncSF64 = 107,
+    I64TruncUF32 = 108,
+    I64TruncUF64 = 109,
+    I64TruncSF32 = 110,
+    I64TruncSF64 = 111,
+    I64ExtendUI32 = 112,
+    I64ExtendSI32 = 113,
+    F32DemoteF64 = 114,
+    F64PromoteF32 = 115,
+    F32ConvertSI32 = 116,
+    F32ConvertSI64 = 117,
+    F32ConvertUI32 = 118,
+    F32ConvertUI64 = 119,
+    F64ConvertSI32 = 120,
+    F64ConvertSI64 = 121,
+    F64ConvertUI32 = 122,
+    F64ConvertUI64 = 123,
+    I32Load = 124,
+    I64Load = 125,
+    I32Store = 126,
+    I64Store = 127,
+    I32Load8S = 128,
+    I32Load16S = 129,
+    I64Load8S = 130,
+    I64Load16S = 131,
+    I64Load32S = 132,
+    I32Load8U = 133,
+    I32Load16U = 134,
+    I64Load8U = 135,
+    I64Load16U = 136,
+    I64Load32U = 137,
+    I32Store8 = 138,
+    I32Store16 = 139,
+    I64Store8 = 140,
+    I64Store16 = 141,
+    I64Store32 = 142,
+    Jmp = 143,
+    JmpIf = 144,
+    JmpEither = 145,
+    JmpTable = 146,
+    ReturnValue = 147,
+    ReturnVoid = 148,
+    GetLocal = 149,
+    SetLocal = 150,
+    GetGlobal = 151,
+    SetGlobal = 152,
+    Call = 153,
+    CallIndirect = 154,
+    InvokeImport = 155,
+    CurrentMemory = 156,
+    GrowMemory = 157,
+    Phi = 158,
+    AddGas = 159,
+    CoverBlock = 160,
+    FPDisabledError = 161,
+    F32CanonicalizeNaN = 162,
+    F64CanonicalizeNaN = 163,
+    I32TruncSCheckedF32 = 164,
+    I32TruncSCheckedF64 = 165,
+    I32TruncUCheckedF32 = 166,
+    I32TruncUCheckedF64 = 167,
+    I64TruncSCheckedF32 = 168,
+    I64TruncSCheckedF64 = 169,
+    I64TruncUCheckedF32 = 170,
+    I64TruncUCheckedF64 = 171,
+    F32AddSoft = 172,
+    F32SubSoft = 173,
+    F32MulSoft = 174,
+    F32DivSoft = 175,
+    F32MinSoft = 176,
+    F32MaxSoft = 177,
+    F32SqrtSoft = 178,
+    F32CeilSoft = 179,
+    F32FloorSoft = 180,
+    F32TruncSoft = 181,
+    F32NearestSoft = 182,
+    F64AddSoft = 183,
+    F64SubSoft = 184,
+    F64MulSoft = 185,
+    F64DivSoft = 186,
+    F64MinSoft = 187,
+    F64MaxSoft = 188,
+    F64SqrtSoft = 189,
+    F64CeilSoft = 190,
+    F64FloorSoft = 191,
+    F64TruncSoft = 192,
+    F64NearestSoft = 193,
+    I32TruncSSoftF32 = 194,
+    I32TruncSSoftF64 = 195,
+    I32TruncUSoftF32 = 196,
+    I32TruncUSoftF64 = 197,
+    I64TruncSSoftF32 = 198,
+    I64TruncSSoftF64 = 199,
+    I64TruncUSoftF32 = 200,
+    I64TruncUSoftF64 = 201,
+    F32ConvertSSoftI32 = 202,
+    F32ConvertSSoftI64 = 203,
+    F32ConvertUSoftI32 = 204,
+    F32ConvertUSoftI64 = 205,
+    F64ConvertSSoftI32 = 206,
+    F64ConvertSSoftI64 = 207,
+    F64ConvertUSoftI32 = 208,
+    F64ConvertUSoftI64 = 209,
+    F32DemoteSoftF64 = 210,
+    F64PromoteSoftF32 = 211,
+    I32AddImm = 212,
+    I32EqImm = 213,
+    I64AddImm = 214,
+    I64ShrUImm = 215,
+    I64ShlImm = 216,
+    I32AddLocalImm = 217,
+    I32EqLocalImm = 218,
+    JmpIfI32GtU = 219,
+    JmpIfI32GeU = 220,
+    JmpIfI64Ne = 221,
+    JmpIfI64EqZ = 222,
+    JmpIfI32Or = 223,
+    I32LoadAddImm = 224,
+    I64LoadAddImm = 225,
+    Unknown = 226,
 }
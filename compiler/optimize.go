@@ -0,0 +1,569 @@
+// SSA optimization passes.
+
+package compiler
+
+import (
+	"math/bits"
+	"strings"
+)
+
+// MaxOptLevel is the highest optimization level understood by Optimize.
+//
+// Level 0 leaves the code as it is. Level 1 folds integer constants,
+// simplifies branches on constant conditions and removes dead code and
+// unreachable blocks, and the code is compiled with superinstructions (see
+// Fuse). Level 2 additionally propagates copies through set_local/get_local
+// pairs.
+const MaxOptLevel = 2
+
+// Optimize runs the optimization passes selected by level until none of them
+// makes further progress.
+//
+// It must run after gas and coverage counters are inserted: the passes never
+// touch add_gas or cover_block instructions, and only remove blocks that can
+// not be reached, so the gas charged on every path through the function is
+// unchanged.
+func (c *SSAFunctionCompiler) Optimize(level int) {
+	if level <= 0 {
+		return
+	}
+
+	cfg := c.NewCFGraph()
+	for changed := true; changed; {
+		changed = cfg.FoldConstants()
+		changed = cfg.SimplifyBranches() || changed
+		if level >= 2 {
+			changed = cfg.PropagateCopies() || changed
+		}
+		changed = cfg.EliminateDeadCode() || changed
+	}
+	cfg.RemoveDeadBlocks()
+
+	c.Code = elideJumps(cfg.ToInsSeq())
+}
+
+// elideJumps removes jumps to the next instruction, and turns jmp_either
+// instructions whose second target is the next instruction into jmp_if, unless
+// the next instruction reads the value the jump yields.
+func elideJumps(code []Instr) []Instr {
+	readsYield := func(pos int) bool {
+		for ; pos < len(code); pos++ {
+			switch code[pos].Op {
+			case "add_gas", "cover_block":
+			case "phi":
+				return true
+			default:
+				return false
+			}
+		}
+		return false
+	}
+
+	relocs := make([]int64, len(code)+1)
+	out := make([]Instr, 0, len(code))
+	for i, ins := range code {
+		relocs[i] = int64(len(out))
+		switch {
+		case ins.Op == "jmp" && ins.Immediates[0] == int64(i+1) && !readsYield(i+1):
+			continue
+		case ins.Op == "jmp_either" && ins.Immediates[1] == int64(i+1) && !readsYield(i+1):
+			ins.Op = "jmp_if"
+			ins.Immediates = ins.Immediates[:1]
+		}
+		out = append(out, ins)
+	}
+	relocs[len(code)] = int64(len(out))
+
+	for i := range out {
+		switch out[i].Op {
+		case "jmp", "jmp_if", "jmp_either", "jmp_table":
+			for j, target := range out[i].Immediates {
+				out[i].Immediates[j] = relocs[target]
+			}
+		}
+	}
+	return out
+}
+
+// FoldConstants replaces integer operations on constants with the constant
+// they evaluate to. Operations that would trap are left alone.
+func (g *CFGraph) FoldConstants() bool {
+	consts := make(map[TyValueID]int64)
+	for _, bb := range g.Blocks {
+		for _, ins := range bb.Code {
+			if ins.Op == "i32.const" || ins.Op == "i64.const" {
+				consts[ins.Target] = ins.Immediates[0]
+			}
+		}
+	}
+
+	changed := false
+	for i := range g.Blocks {
+		bb := &g.Blocks[i]
+		for j := range bb.Code {
+			ins := &bb.Code[j]
+			if ins.Target == 0 || len(ins.Values) == 0 || len(ins.Values) > 2 {
+				continue
+			}
+
+			args := make([]uint64, 0, 2)
+			for _, v := range ins.Values {
+				imm, ok := consts[v]
+				if !ok {
+					break
+				}
+				args = append(args, uint64(imm))
+			}
+			if len(args) != len(ins.Values) {
+				continue
+			}
+
+			result, is64, ok := evalConstOp(ins.Op, args)
+			if !ok {
+				continue
+			}
+			if is64 {
+				ins.Op = "i64.const"
+				ins.Immediates = []int64{int64(result)}
+			} else {
+				ins.Op = "i32.const"
+				ins.Immediates = []int64{int64(int32(result))}
+			}
+			ins.Values = nil
+			consts[ins.Target] = ins.Immediates[0]
+			changed = true
+		}
+	}
+	return changed
+}
+
+// evalConstOp evaluates the integer operator op the way the interpreter does.
+// i32 operands are taken from the low 32 bits of args. It reports whether the
+// result is an i64, and false for operators it does not evaluate and operands
+// that make op trap.
+func evalConstOp(op string, args []uint64) (_ uint64, is64 bool, ok bool) {
+	switch op {
+	case "i32.wrap/i64":
+		return uint64(uint32(args[0])), false, true
+	case "i64.extend_s/i32":
+		return uint64(int64(int32(uint32(args[0])))), true, true
+	case "i64.extend_u/i32":
+		return uint64(uint32(args[0])), true, true
+	}
+
+	switch {
+	case strings.HasPrefix(op, "i32.") && len(args) == 1:
+		v, ok := evalConstUnary32(op[4:], uint32(args[0]))
+		return uint64(v), false, ok
+	case strings.HasPrefix(op, "i32.") && len(args) == 2:
+		v, ok := evalConstBinary32(op[4:], uint32(args[0]), uint32(args[1]))
+		return uint64(v), false, ok
+	case strings.HasPrefix(op, "i64.") && len(args) == 1:
+		v, ok := evalConstUnary64(op[4:], args[0])
+		return v, op != "i64.eqz", ok
+	case strings.HasPrefix(op, "i64.") && len(args) == 2:
+		v, ok := evalConstBinary64(op[4:], args[0], args[1])
+		return v, !isComparison(op[4:]), ok
+	}
+	return 0, false, false
+}
+
+func isComparison(name string) bool {
+	switch name {
+	case "eq", "ne", "lt_s", "lt_u", "le_s", "le_u", "gt_s", "gt_u", "ge_s", "ge_u":
+		return true
+	}
+	return false
+}
+
+func boolConst(b bool) uint64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func evalConstUnary32(name string, a uint32) (uint32, bool) {
+	switch name {
+	case "clz":
+		return uint32(bits.LeadingZeros32(a)), true
+	case "ctz":
+		return uint32(bits.TrailingZeros32(a)), true
+	case "popcnt":
+		return uint32(bits.OnesCount32(a)), true
+	case "eqz":
+		return uint32(boolConst(a == 0)), true
+	}
+	return 0, false
+}
+
+func evalConstBinary32(name string, a, b uint32) (uint32, bool) {
+	switch name {
+	case "add":
+		return a + b, true
+	case "sub":
+		return a - b, true
+	case "mul":
+		return a * b, true
+	case "div_s":
+		if b == 0 || int32(a) == -1<<31 && int32(b) == -1 {
+			return 0, false
+		}
+		return uint32(int32(a) / int32(b)), true
+	case "div_u":
+		if b == 0 {
+			return 0, false
+		}
+		return a / b, true
+	case "rem_s":
+		if b == 0 {
+			return 0, false
+		}
+		return uint32(int32(a) % int32(b)), true
+	case "rem_u":
+		if b == 0 {
+			return 0, false
+		}
+		return a % b, true
+	case "and":
+		return a & b, true
+	case "or":
+		return a | b, true
+	case "xor":
+		return a ^ b, true
+	case "shl":
+		return a << (b % 32), true
+	case "shr_s":
+		return uint32(int32(a) >> (b % 32)), true
+	case "shr_u":
+		return a >> (b % 32), true
+	case "rotl":
+		return bits.RotateLeft32(a, int(b)), true
+	case "rotr":
+		return bits.RotateLeft32(a, -int(b)), true
+	case "eq":
+		return uint32(boolConst(a == b)), true
+	case "ne":
+		return uint32(boolConst(a != b)), true
+	case "lt_s":
+		return uint32(boolConst(int32(a) < int32(b))), true
+	case "lt_u":
+		return uint32(boolConst(a < b)), true
+	case "le_s":
+		return uint32(boolConst(int32(a) <= int32(b))), true
+	case "le_u":
+		return uint32(boolConst(a <= b)), true
+	case "gt_s":
+		return uint32(boolConst(int32(a) > int32(b))), true
+	case "gt_u":
+		return uint32(boolConst(a > b)), true
+	case "ge_s":
+		return uint32(boolConst(int32(a) >= int32(b))), true
+	case "ge_u":
+		return uint32(boolConst(a >= b)), true
+	}
+	return 0, false
+}
+
+func evalConstUnary64(name string, a uint64) (uint64, bool) {
+	switch name {
+	case "clz":
+		return uint64(bits.LeadingZeros64(a)), true
+	case "ctz":
+		return uint64(bits.TrailingZeros64(a)), true
+	case "popcnt":
+		return uint64(bits.OnesCount64(a)), true
+	case "eqz":
+		return boolConst(a == 0), true
+	}
+	return 0, false
+}
+
+func evalConstBinary64(name string, a, b uint64) (uint64, bool) {
+	switch name {
+	case "add":
+		return a + b, true
+	case "sub":
+		return a - b, true
+	case "mul":
+		return a * b, true
+	case "div_s":
+		if b == 0 || int64(a) == -1<<63 && int64(b) == -1 {
+			return 0, false
+		}
+		return uint64(int64(a) / int64(b)), true
+	case "div_u":
+		if b == 0 {
+			return 0, false
+		}
+		return a / b, true
+	case "rem_s":
+		if b == 0 {
+			return 0, false
+		}
+		return uint64(int64(a) % int64(b)), true
+	case "rem_u":
+		if b == 0 {
+			return 0, false
+		}
+		return a % b, true
+	case "and":
+		return a & b, true
+	case "or":
+		return a | b, true
+	case "xor":
+		return a ^ b, true
+	case "shl":
+		return a << (b % 64), true
+	case "shr_s":
+		return uint64(int64(a) >> (b % 64)), true
+	case "shr_u":
+		return a >> (b % 64), true
+	case "rotl":
+		return bits.RotateLeft64(a, int(b)), true
+	case "rotr":
+		return bits.RotateLeft64(a, -int(b)), true
+	case "eq":
+		return boolConst(a == b), true
+	case "ne":
+		return boolConst(a != b), true
+	case "lt_s":
+		return boolConst(int64(a) < int64(b)), true
+	case "lt_u":
+		return boolConst(a < b), true
+	case "le_s":
+		return boolConst(int64(a) <= int64(b)), true
+	case "le_u":
+		return boolConst(a <= b), true
+	case "gt_s":
+		return boolConst(int64(a) > int64(b)), true
+	case "gt_u":
+		return boolConst(a > b), true
+	case "ge_s":
+		return boolConst(int64(a) >= int64(b)), true
+	case "ge_u":
+		return boolConst(a >= b), true
+	}
+	return 0, false
+}
+
+// SimplifyBranches turns conditional jumps whose condition is a constant, or
+// whose targets are all the same, into unconditional jumps.
+func (g *CFGraph) SimplifyBranches() bool {
+	consts := make(map[TyValueID]uint64)
+	for _, bb := range g.Blocks {
+		for _, ins := range bb.Code {
+			switch ins.Op {
+			case "i32.const":
+				consts[ins.Target] = uint64(uint32(ins.Immediates[0]))
+			case "i64.const":
+				consts[ins.Target] = uint64(ins.Immediates[0])
+			}
+		}
+	}
+
+	changed := false
+	for i := range g.Blocks {
+		bb := &g.Blocks[i]
+		if bb.JmpKind != JmpEither && bb.JmpKind != JmpTable {
+			continue
+		}
+
+		target := -1
+		if cond, ok := consts[bb.JmpCond]; ok {
+			switch bb.JmpKind {
+			case JmpEither:
+				if cond != 0 {
+					target = bb.JmpTargets[0]
+				} else {
+					target = bb.JmpTargets[1]
+				}
+			case JmpTable:
+				// As in the interpreter, out of range indices select the
+				// default target, which comes last.
+				index := int(cond)
+				if index < 0 || index >= len(bb.JmpTargets)-1 {
+					index = len(bb.JmpTargets) - 1
+				}
+				target = bb.JmpTargets[index]
+			}
+		} else {
+			target = bb.JmpTargets[0]
+			for _, t := range bb.JmpTargets[1:] {
+				if t != target {
+					target = -1
+					break
+				}
+			}
+		}
+		if target < 0 {
+			continue
+		}
+
+		bb.JmpKind = JmpUncond
+		bb.JmpTargets = []int{target}
+		bb.JmpCond = 0
+		changed = true
+	}
+	return changed
+}
+
+// PropagateCopies replaces the values loaded by get_local with the value the
+// local was last set to, or last loaded as, earlier in the same basic block.
+// The get_local instructions are left for EliminateDeadCode to remove.
+func (g *CFGraph) PropagateCopies() bool {
+	copies := make(map[TyValueID]TyValueID) // loaded value -> its replacement
+	for _, bb := range g.Blocks {
+		locals := make(map[int64]TyValueID)
+		for _, ins := range bb.Code {
+			switch ins.Op {
+			case "set_local":
+				locals[ins.Immediates[0]] = ins.Values[0]
+			case "get_local":
+				if v, ok := locals[ins.Immediates[0]]; ok && v != 0 {
+					if r, ok := copies[v]; ok {
+						v = r
+					}
+					copies[ins.Target] = v
+				} else {
+					locals[ins.Immediates[0]] = ins.Target
+				}
+			}
+		}
+	}
+	if len(copies) == 0 {
+		return false
+	}
+
+	// The replacement is defined before the loaded value in the same block,
+	// so it dominates every use of it.
+	changed := false
+	replace := func(v *TyValueID) {
+		if r, ok := copies[*v]; ok {
+			*v = r
+			changed = true
+		}
+	}
+	for i := range g.Blocks {
+		bb := &g.Blocks[i]
+		for p := range bb.Code {
+			for j := range bb.Code[p].Values {
+				replace(&bb.Code[p].Values[j])
+			}
+		}
+		if bb.JmpKind == JmpEither || bb.JmpKind == JmpTable {
+			replace(&bb.JmpCond)
+		}
+		replace(&bb.YieldValue)
+	}
+	return changed
+}
+
+// terminatorValues returns the values read by the jump ending the block.
+func (bb *BasicBlock) terminatorValues() []TyValueID {
+	switch bb.JmpKind {
+	case JmpEither, JmpTable:
+		return []TyValueID{bb.JmpCond, bb.YieldValue}
+	default:
+		return []TyValueID{bb.YieldValue}
+	}
+}
+
+// valueUses counts the reads of each value.
+func (g *CFGraph) valueUses() map[TyValueID]int {
+	uses := make(map[TyValueID]int)
+	for _, bb := range g.Blocks {
+		for _, ins := range bb.Code {
+			for _, v := range ins.Values {
+				uses[v]++
+			}
+		}
+		for _, v := range bb.terminatorValues() {
+			uses[v]++
+		}
+	}
+	return uses
+}
+
+// EliminateDeadCode removes instructions that neither have side effects nor
+// produce a value that is used.
+func (g *CFGraph) EliminateDeadCode() bool {
+	changed := false
+	for {
+		uses := g.valueUses()
+		removed := false
+		for i := range g.Blocks {
+			bb := &g.Blocks[i]
+			code := bb.Code[:0]
+			for _, ins := range bb.Code {
+				if ins.Target != 0 && uses[ins.Target] == 0 && isPureOp(ins.Op) {
+					removed = true
+					continue
+				}
+				code = append(code, ins)
+			}
+			bb.Code = code
+		}
+		if !removed {
+			return changed
+		}
+		changed = true
+	}
+}
+
+// isPureOp reports whether an instruction can be removed when the value it
+// produces is not used, i.e. whether it has no side effects and never traps.
+func isPureOp(op string) bool {
+	switch op {
+	case "get_local", "get_global", "current_memory", "select", "phi":
+		return true
+	}
+	if !strings.HasPrefix(op, "i32.") && !strings.HasPrefix(op, "i64.") &&
+		!strings.HasPrefix(op, "f32.") && !strings.HasPrefix(op, "f64.") {
+		return false
+	}
+	if strings.Contains(op, ".load") || strings.Contains(op, ".store") || strings.Contains(op, ".trunc_") {
+		return false
+	}
+	if op[0] == 'i' && (strings.Contains(op, ".div_") || strings.Contains(op, ".rem_")) {
+		return false
+	}
+	return true
+}
+
+// RemoveDeadBlocks removes the blocks that can not be reached from the entry
+// block.
+func (g *CFGraph) RemoveDeadBlocks() bool {
+	reachable := make([]bool, len(g.Blocks))
+	stack := []int{0}
+	reachable[0] = true
+	for len(stack) > 0 {
+		bb := &g.Blocks[stack[len(stack)-1]]
+		stack = stack[:len(stack)-1]
+		for _, t := range bb.JmpTargets {
+			if !reachable[t] {
+				reachable[t] = true
+				stack = append(stack, t)
+			}
+		}
+	}
+
+	relocs := make([]int, len(g.Blocks))
+	blocks := g.Blocks[:0]
+	for i, bb := range g.Blocks {
+		if reachable[i] {
+			relocs[i] = len(blocks)
+			blocks = append(blocks, bb)
+		}
+	}
+	if len(blocks) == len(g.Blocks) {
+		return false
+	}
+	for i := range blocks {
+		for j, t := range blocks[i].JmpTargets {
+			blocks[i].JmpTargets[j] = relocs[t]
+		}
+	}
+	g.Blocks = blocks
+	return true
+}
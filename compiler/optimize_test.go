@@ -0,0 +1,361 @@
+package compiler
+
+import (
+	"strings"
+	"testing"
+)
+
+type passTest struct {
+	name    string
+	code    []Instr
+	want    string // listing of the code after the pass; "" if unchanged
+	changed bool
+}
+
+// runPassTests builds the CFG of each test's code, runs pass over it and
+// compares the resulting instruction sequence with the expected listing.
+func runPassTests(t *testing.T, pass func(*CFGraph) bool, tests []passTest) {
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := listing((&SSAFunctionCompiler{Code: copyInstrs(tt.code)}).NewCFGraph())
+			g := (&SSAFunctionCompiler{Code: tt.code}).NewCFGraph()
+			changed := pass(g)
+			got := listing(g)
+
+			want := before
+			if tt.want != "" {
+				want = strings.TrimSpace(tt.want)
+			}
+			if got != want {
+				t.Errorf("got\n%s\nwant\n%s", got, want)
+			}
+			if changed != tt.changed {
+				t.Errorf("reported change %v, want %v", changed, tt.changed)
+			}
+		})
+	}
+}
+
+func listing(g *CFGraph) string {
+	var lines []string
+	for _, ins := range g.ToInsSeq() {
+		lines = append(lines, ins.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+func TestFoldConstants(t *testing.T) {
+	runPassTests(t, (*CFGraph).FoldConstants, []passTest{
+		{
+			name: "chain",
+			code: []Instr{
+				buildInstr(1, "i32.const", []int64{2}, nil),
+				buildInstr(2, "i32.const", []int64{3}, nil),
+				buildInstr(3, "i32.add", nil, []TyValueID{1, 2}),
+				buildInstr(4, "i32.mul", nil, []TyValueID{3, 3}),
+				buildInstr(0, "return", nil, []TyValueID{4}),
+			},
+			want: `
+%1 = i32.const 2
+%2 = i32.const 3
+%3 = i32.const 5
+%4 = i32.const 25
+return %4
+return`,
+			changed: true,
+		},
+		{
+			name: "i32 wraps around",
+			code: []Instr{
+				buildInstr(1, "i32.const", []int64{0x7fffffff}, nil),
+				buildInstr(2, "i32.const", []int64{1}, nil),
+				buildInstr(3, "i32.add", nil, []TyValueID{1, 2}),
+				buildInstr(0, "return", nil, []TyValueID{3}),
+			},
+			want: `
+%1 = i32.const 2147483647
+%2 = i32.const 1
+%3 = i32.const -2147483648
+return %3
+return`,
+			changed: true,
+		},
+		{
+			name: "i64 comparison yields i32",
+			code: []Instr{
+				buildInstr(1, "i64.const", []int64{-1}, nil),
+				buildInstr(2, "i64.const", []int64{1}, nil),
+				buildInstr(3, "i64.lt_s", nil, []TyValueID{1, 2}),
+				buildInstr(4, "i64.lt_u", nil, []TyValueID{1, 2}),
+				buildInstr(5, "i64.extend_u/i32", nil, []TyValueID{3}),
+				buildInstr(0, "return", nil, []TyValueID{5}),
+			},
+			want: `
+%1 = i64.const -1
+%2 = i64.const 1
+%3 = i32.const 1
+%4 = i32.const 0
+%5 = i64.const 1
+return %5
+return`,
+			changed: true,
+		},
+		{
+			name: "trapping operations are kept",
+			code: []Instr{
+				buildInstr(1, "i32.const", []int64{1}, nil),
+				buildInstr(2, "i32.const", []int64{0}, nil),
+				buildInstr(3, "i32.div_u", nil, []TyValueID{1, 2}),
+				buildInstr(4, "i32.const", []int64{-1 << 31}, nil),
+				buildInstr(5, "i32.const", []int64{-1}, nil),
+				buildInstr(6, "i32.div_s", nil, []TyValueID{4, 5}),
+				buildInstr(0, "return", nil, []TyValueID{3}),
+			},
+		},
+		{
+			name: "non-constant operands",
+			code: []Instr{
+				buildInstr(1, "get_local", []int64{0}, nil),
+				buildInstr(2, "i32.const", []int64{1}, nil),
+				buildInstr(3, "i32.add", nil, []TyValueID{1, 2}),
+				buildInstr(4, "f32.const", []int64{0x3f800000}, nil),
+				buildInstr(5, "f32.neg", nil, []TyValueID{4}),
+				buildInstr(0, "return", nil, []TyValueID{3}),
+			},
+		},
+	})
+}
+
+func TestSimplifyBranches(t *testing.T) {
+	runPassTests(t, (*CFGraph).SimplifyBranches, []passTest{
+		{
+			name: "constant condition",
+			code: []Instr{
+				buildInstr(1, "i32.const", []int64{0}, nil),
+				buildInstr(0, "jmp_if", []int64{3}, []TyValueID{1, 0}),
+				buildInstr(0, "return", nil, nil),
+				buildInstr(2, "i32.const", []int64{7}, nil),
+				buildInstr(0, "return", nil, []TyValueID{2}),
+			},
+			want: `
+%1 = i32.const 0
+jmp 2 %0
+return
+%2 = i32.const 7
+return %2
+return`,
+			changed: true,
+		},
+		{
+			name: "constant table index out of range",
+			code: []Instr{
+				buildInstr(1, "i32.const", []int64{-1}, nil),
+				buildInstr(0, "jmp_table", []int64{2, 3, 4}, []TyValueID{1, 0}),
+				buildInstr(0, "return", nil, nil),
+				buildInstr(0, "return", nil, nil),
+				buildInstr(0, "return", nil, nil),
+			},
+			want: `
+%1 = i32.const -1
+jmp 4 %0
+return
+return
+return
+return`,
+			changed: true,
+		},
+		{
+			name: "same targets",
+			code: []Instr{
+				buildInstr(1, "get_local", []int64{0}, nil),
+				buildInstr(0, "jmp_table", []int64{2, 2}, []TyValueID{1, 0}),
+				buildInstr(0, "return", nil, nil),
+			},
+			want: `
+%1 = get_local 0
+jmp 2 %0
+return
+return`,
+			changed: true,
+		},
+		{
+			name: "unknown condition",
+			code: []Instr{
+				buildInstr(1, "get_local", []int64{0}, nil),
+				buildInstr(0, "jmp_if", []int64{3}, []TyValueID{1, 0}),
+				buildInstr(0, "return", nil, nil),
+				buildInstr(0, "return", nil, nil),
+			},
+		},
+	})
+}
+
+func TestPropagateCopies(t *testing.T) {
+	runPassTests(t, (*CFGraph).PropagateCopies, []passTest{
+		{
+			name: "set then get",
+			code: []Instr{
+				buildInstr(1, "i32.const", []int64{1}, nil),
+				buildInstr(0, "set_local", []int64{0}, []TyValueID{1}),
+				buildInstr(2, "get_local", []int64{0}, nil),
+				buildInstr(3, "i32.add", nil, []TyValueID{2, 2}),
+				buildInstr(0, "return", nil, []TyValueID{3}),
+			},
+			want: `
+%1 = i32.const 1
+set_local 0 %1
+%2 = get_local 0
+%3 = i32.add %1 %1
+return %3
+return`,
+			changed: true,
+		},
+		{
+			name: "get then get",
+			code: []Instr{
+				buildInstr(1, "get_local", []int64{1}, nil),
+				buildInstr(2, "get_local", []int64{1}, nil),
+				buildInstr(3, "get_local", []int64{1}, nil),
+				buildInstr(0, "jmp_if", []int64{5}, []TyValueID{2, 3}),
+				buildInstr(0, "return", nil, nil),
+				buildInstr(4, "phi", nil, nil),
+				buildInstr(0, "return", nil, []TyValueID{4}),
+			},
+			want: `
+%1 = get_local 1
+%2 = get_local 1
+%3 = get_local 1
+jmp_either 5 4 %1 %1
+return
+%4 = phi
+return %4
+return`,
+			changed: true,
+		},
+		{
+			name: "chained copies",
+			code: []Instr{
+				buildInstr(1, "get_local", []int64{0}, nil),
+				buildInstr(2, "get_local", []int64{0}, nil),
+				buildInstr(0, "set_local", []int64{1}, []TyValueID{2}),
+				buildInstr(3, "get_local", []int64{1}, nil),
+				buildInstr(0, "return", nil, []TyValueID{3}),
+			},
+			want: `
+%1 = get_local 0
+%2 = get_local 0
+set_local 1 %1
+%3 = get_local 1
+return %1
+return`,
+			changed: true,
+		},
+		{
+			name: "not across blocks",
+			code: []Instr{
+				buildInstr(1, "i32.const", []int64{1}, nil),
+				buildInstr(0, "set_local", []int64{0}, []TyValueID{1}),
+				buildInstr(0, "jmp", []int64{3}, []TyValueID{0}),
+				buildInstr(2, "get_local", []int64{0}, nil),
+				buildInstr(0, "return", nil, []TyValueID{2}),
+			},
+		},
+		{
+			name: "set after get",
+			code: []Instr{
+				buildInstr(1, "get_local", []int64{0}, nil),
+				buildInstr(2, "i32.const", []int64{1}, nil),
+				buildInstr(0, "set_local", []int64{0}, []TyValueID{2}),
+				buildInstr(3, "i32.add", nil, []TyValueID{1, 2}),
+				buildInstr(0, "return", nil, []TyValueID{3}),
+			},
+		},
+	})
+}
+
+func TestEliminateDeadCode(t *testing.T) {
+	runPassTests(t, (*CFGraph).EliminateDeadCode, []passTest{
+		{
+			name: "unused chain",
+			code: []Instr{
+				buildInstr(1, "get_local", []int64{0}, nil),
+				buildInstr(2, "i32.const", []int64{1}, nil),
+				buildInstr(3, "i32.add", nil, []TyValueID{1, 2}),
+				buildInstr(4, "i32.eqz", nil, []TyValueID{3}),
+				buildInstr(5, "i32.const", []int64{2}, nil),
+				buildInstr(0, "return", nil, []TyValueID{5}),
+			},
+			want: `
+%5 = i32.const 2
+return %5
+return`,
+			changed: true,
+		},
+		{
+			name: "side effects and traps are kept",
+			code: []Instr{
+				buildInstr(1, "get_local", []int64{0}, nil),
+				buildInstr(2, "i32.load", []int64{0, 0}, []TyValueID{1}),
+				buildInstr(3, "i32.div_s", nil, []TyValueID{1, 1}),
+				buildInstr(4, "i64.trunc_s/f64", nil, []TyValueID{1}),
+				buildInstr(0, "set_global", []int64{0}, []TyValueID{1}),
+				buildInstr(5, "call", []int64{0}, nil),
+				buildInstr(0, "return", nil, nil),
+			},
+		},
+		{
+			name: "values read by jumps are used",
+			code: []Instr{
+				buildInstr(1, "get_local", []int64{0}, nil),
+				buildInstr(2, "get_local", []int64{1}, nil),
+				buildInstr(3, "get_local", []int64{2}, nil),
+				buildInstr(0, "jmp_if", []int64{5}, []TyValueID{1, 2}),
+				buildInstr(0, "return", nil, nil),
+				buildInstr(4, "phi", nil, nil),
+				buildInstr(0, "return", nil, []TyValueID{4}),
+			},
+			want: `
+%1 = get_local 0
+%2 = get_local 1
+jmp_either 4 3 %1 %2
+return
+%4 = phi
+return %4
+return`,
+			changed: true,
+		},
+	})
+}
+
+func TestRemoveDeadBlocks(t *testing.T) {
+	runPassTests(t, (*CFGraph).RemoveDeadBlocks, []passTest{
+		{
+			name: "after unconditional jump",
+			code: []Instr{
+				buildInstr(0, "jmp", []int64{3}, []TyValueID{0}),
+				buildInstr(1, "i32.const", []int64{1}, nil),
+				buildInstr(0, "return", nil, []TyValueID{1}),
+				buildInstr(2, "i32.const", []int64{2}, nil),
+				buildInstr(0, "jmp", []int64{6}, []TyValueID{2}),
+				buildInstr(0, "return", nil, nil),
+				buildInstr(3, "phi", nil, nil),
+				buildInstr(0, "return", nil, []TyValueID{3}),
+			},
+			want: `
+jmp 1 %0
+%2 = i32.const 2
+jmp 3 %2
+%3 = phi
+return %3`,
+			changed: true,
+		},
+		{
+			name: "all reachable",
+			code: []Instr{
+				buildInstr(1, "get_local", []int64{0}, nil),
+				buildInstr(0, "jmp_if", []int64{3}, []TyValueID{1, 0}),
+				buildInstr(0, "return", nil, nil),
+			},
+		},
+	})
+}
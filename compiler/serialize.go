@@ -16,6 +16,13 @@ import (
 // Types are erased in the generated code.
 // Example: float32/float64 are represented as uint32/uint64 respectively.
 func (c *SSAFunctionCompiler) Serialize() []byte {
+	ret, _ := c.SerializeWithOffsets()
+	return ret
+}
+
+// SerializeWithOffsets is like Serialize, but also returns a table mapping
+// bytecode offsets to the wasm offsets recorded on the instructions.
+func (c *SSAFunctionCompiler) SerializeWithOffsets() ([]byte, []OffsetMapping) {
 	buf := &bytes.Buffer{}
 	insRelocs := make([]int, len(c.Code))
 	reloc32Targets := make([]int, 0)
@@ -733,9 +740,313 @@ func (c *SSAFunctionCompiler) Serialize() []byte {
 			binary.Write(buf, binary.LittleEndian, opcodes.AddGas)
 			binary.Write(buf, binary.LittleEndian, uint64(ins.Immediates[0]))
 
+		case "cover_block":
+			binary.Write(buf, binary.LittleEndian, opcodes.CoverBlock)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Immediates[0]))
+
 		case "fp_disabled_error":
 			binary.Write(buf, binary.LittleEndian, opcodes.FPDisabledError)
 
+		case "f32.canonicalize_nan":
+			binary.Write(buf, binary.LittleEndian, opcodes.F32CanonicalizeNaN)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+
+		case "f64.canonicalize_nan":
+			binary.Write(buf, binary.LittleEndian, opcodes.F64CanonicalizeNaN)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+
+		case "i32.trunc_s_checked/f32":
+			binary.Write(buf, binary.LittleEndian, opcodes.I32TruncSCheckedF32)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+
+		case "i32.trunc_s_checked/f64":
+			binary.Write(buf, binary.LittleEndian, opcodes.I32TruncSCheckedF64)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+
+		case "i32.trunc_u_checked/f32":
+			binary.Write(buf, binary.LittleEndian, opcodes.I32TruncUCheckedF32)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+
+		case "i32.trunc_u_checked/f64":
+			binary.Write(buf, binary.LittleEndian, opcodes.I32TruncUCheckedF64)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+
+		case "i64.trunc_s_checked/f32":
+			binary.Write(buf, binary.LittleEndian, opcodes.I64TruncSCheckedF32)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+
+		case "i64.trunc_s_checked/f64":
+			binary.Write(buf, binary.LittleEndian, opcodes.I64TruncSCheckedF64)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+
+		case "i64.trunc_u_checked/f32":
+			binary.Write(buf, binary.LittleEndian, opcodes.I64TruncUCheckedF32)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+
+		case "i64.trunc_u_checked/f64":
+			binary.Write(buf, binary.LittleEndian, opcodes.I64TruncUCheckedF64)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+
+		case "f32.add_soft":
+			binary.Write(buf, binary.LittleEndian, opcodes.F32AddSoft)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[1]))
+
+		case "f32.sub_soft":
+			binary.Write(buf, binary.LittleEndian, opcodes.F32SubSoft)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[1]))
+
+		case "f32.mul_soft":
+			binary.Write(buf, binary.LittleEndian, opcodes.F32MulSoft)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[1]))
+
+		case "f32.div_soft":
+			binary.Write(buf, binary.LittleEndian, opcodes.F32DivSoft)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[1]))
+
+		case "f32.min_soft":
+			binary.Write(buf, binary.LittleEndian, opcodes.F32MinSoft)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[1]))
+
+		case "f32.max_soft":
+			binary.Write(buf, binary.LittleEndian, opcodes.F32MaxSoft)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[1]))
+
+		case "f32.sqrt_soft":
+			binary.Write(buf, binary.LittleEndian, opcodes.F32SqrtSoft)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+
+		case "f32.ceil_soft":
+			binary.Write(buf, binary.LittleEndian, opcodes.F32CeilSoft)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+
+		case "f32.floor_soft":
+			binary.Write(buf, binary.LittleEndian, opcodes.F32FloorSoft)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+
+		case "f32.trunc_soft":
+			binary.Write(buf, binary.LittleEndian, opcodes.F32TruncSoft)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+
+		case "f32.nearest_soft":
+			binary.Write(buf, binary.LittleEndian, opcodes.F32NearestSoft)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+
+		case "f64.add_soft":
+			binary.Write(buf, binary.LittleEndian, opcodes.F64AddSoft)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[1]))
+
+		case "f64.sub_soft":
+			binary.Write(buf, binary.LittleEndian, opcodes.F64SubSoft)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[1]))
+
+		case "f64.mul_soft":
+			binary.Write(buf, binary.LittleEndian, opcodes.F64MulSoft)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[1]))
+
+		case "f64.div_soft":
+			binary.Write(buf, binary.LittleEndian, opcodes.F64DivSoft)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[1]))
+
+		case "f64.min_soft":
+			binary.Write(buf, binary.LittleEndian, opcodes.F64MinSoft)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[1]))
+
+		case "f64.max_soft":
+			binary.Write(buf, binary.LittleEndian, opcodes.F64MaxSoft)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[1]))
+
+		case "f64.sqrt_soft":
+			binary.Write(buf, binary.LittleEndian, opcodes.F64SqrtSoft)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+
+		case "f64.ceil_soft":
+			binary.Write(buf, binary.LittleEndian, opcodes.F64CeilSoft)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+
+		case "f64.floor_soft":
+			binary.Write(buf, binary.LittleEndian, opcodes.F64FloorSoft)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+
+		case "f64.trunc_soft":
+			binary.Write(buf, binary.LittleEndian, opcodes.F64TruncSoft)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+
+		case "f64.nearest_soft":
+			binary.Write(buf, binary.LittleEndian, opcodes.F64NearestSoft)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+
+		case "i32.trunc_s_soft/f32":
+			binary.Write(buf, binary.LittleEndian, opcodes.I32TruncSSoftF32)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+
+		case "i32.trunc_s_soft/f64":
+			binary.Write(buf, binary.LittleEndian, opcodes.I32TruncSSoftF64)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+
+		case "i32.trunc_u_soft/f32":
+			binary.Write(buf, binary.LittleEndian, opcodes.I32TruncUSoftF32)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+
+		case "i32.trunc_u_soft/f64":
+			binary.Write(buf, binary.LittleEndian, opcodes.I32TruncUSoftF64)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+
+		case "i64.trunc_s_soft/f32":
+			binary.Write(buf, binary.LittleEndian, opcodes.I64TruncSSoftF32)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+
+		case "i64.trunc_s_soft/f64":
+			binary.Write(buf, binary.LittleEndian, opcodes.I64TruncSSoftF64)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+
+		case "i64.trunc_u_soft/f32":
+			binary.Write(buf, binary.LittleEndian, opcodes.I64TruncUSoftF32)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+
+		case "i64.trunc_u_soft/f64":
+			binary.Write(buf, binary.LittleEndian, opcodes.I64TruncUSoftF64)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+
+		case "f32.convert_s_soft/i32":
+			binary.Write(buf, binary.LittleEndian, opcodes.F32ConvertSSoftI32)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+
+		case "f32.convert_s_soft/i64":
+			binary.Write(buf, binary.LittleEndian, opcodes.F32ConvertSSoftI64)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+
+		case "f32.convert_u_soft/i32":
+			binary.Write(buf, binary.LittleEndian, opcodes.F32ConvertUSoftI32)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+
+		case "f32.convert_u_soft/i64":
+			binary.Write(buf, binary.LittleEndian, opcodes.F32ConvertUSoftI64)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+
+		case "f64.convert_s_soft/i32":
+			binary.Write(buf, binary.LittleEndian, opcodes.F64ConvertSSoftI32)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+
+		case "f64.convert_s_soft/i64":
+			binary.Write(buf, binary.LittleEndian, opcodes.F64ConvertSSoftI64)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+
+		case "f64.convert_u_soft/i32":
+			binary.Write(buf, binary.LittleEndian, opcodes.F64ConvertUSoftI32)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+
+		case "f64.convert_u_soft/i64":
+			binary.Write(buf, binary.LittleEndian, opcodes.F64ConvertUSoftI64)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+
+		case "f32.demote_soft/f64":
+			binary.Write(buf, binary.LittleEndian, opcodes.F32DemoteSoftF64)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+
+		case "f64.promote_soft/f32":
+			binary.Write(buf, binary.LittleEndian, opcodes.F64PromoteSoftF32)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+
+			// Superinstructions
+		case "i32.add_imm":
+			binary.Write(buf, binary.LittleEndian, opcodes.I32AddImm)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+			binary.Write(buf, binary.LittleEndian, int32(ins.Immediates[0]))
+		case "i32.eq_imm":
+			binary.Write(buf, binary.LittleEndian, opcodes.I32EqImm)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+			binary.Write(buf, binary.LittleEndian, int32(ins.Immediates[0]))
+		case "i64.add_imm":
+			binary.Write(buf, binary.LittleEndian, opcodes.I64AddImm)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+			binary.Write(buf, binary.LittleEndian, ins.Immediates[0])
+		case "i64.shr_u_imm":
+			binary.Write(buf, binary.LittleEndian, opcodes.I64ShrUImm)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+			binary.Write(buf, binary.LittleEndian, int32(ins.Immediates[0]))
+		case "i64.shl_imm":
+			binary.Write(buf, binary.LittleEndian, opcodes.I64ShlImm)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+			binary.Write(buf, binary.LittleEndian, int32(ins.Immediates[0]))
+		case "i32.add_local_imm":
+			binary.Write(buf, binary.LittleEndian, opcodes.I32AddLocalImm)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Immediates[0]))
+			binary.Write(buf, binary.LittleEndian, int32(ins.Immediates[1]))
+		case "i32.eq_local_imm":
+			binary.Write(buf, binary.LittleEndian, opcodes.I32EqLocalImm)
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Immediates[0]))
+			binary.Write(buf, binary.LittleEndian, int32(ins.Immediates[1]))
+
+		case "jmp_if.i32.gt_u":
+			binary.Write(buf, binary.LittleEndian, opcodes.JmpIfI32GtU)
+
+			reloc32Targets = append(reloc32Targets, buf.Len())
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Immediates[0]))
+
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[1]))
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[2]))
+		case "jmp_if.i32.ge_u":
+			binary.Write(buf, binary.LittleEndian, opcodes.JmpIfI32GeU)
+
+			reloc32Targets = append(reloc32Targets, buf.Len())
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Immediates[0]))
+
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[1]))
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[2]))
+		case "jmp_if.i64.ne":
+			binary.Write(buf, binary.LittleEndian, opcodes.JmpIfI64Ne)
+
+			reloc32Targets = append(reloc32Targets, buf.Len())
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Immediates[0]))
+
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[1]))
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[2]))
+		case "jmp_if.i32.or":
+			binary.Write(buf, binary.LittleEndian, opcodes.JmpIfI32Or)
+
+			reloc32Targets = append(reloc32Targets, buf.Len())
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Immediates[0]))
+
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[1]))
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[2]))
+		case "jmp_if.i64.eqz":
+			binary.Write(buf, binary.LittleEndian, opcodes.JmpIfI64EqZ)
+
+			reloc32Targets = append(reloc32Targets, buf.Len())
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Immediates[0]))
+
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[1]))
+
+		case "i32.load_add_imm":
+			binary.Write(buf, binary.LittleEndian, opcodes.I32LoadAddImm)
+
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Immediates[0])) // Memory offset
+			binary.Write(buf, binary.LittleEndian, int32(ins.Immediates[1]))  // Added to the base address
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))     // Memory base address
+		case "i64.load_add_imm":
+			binary.Write(buf, binary.LittleEndian, opcodes.I64LoadAddImm)
+
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Immediates[0])) // Memory offset
+			binary.Write(buf, binary.LittleEndian, int32(ins.Immediates[1]))  // Added to the base address
+			binary.Write(buf, binary.LittleEndian, uint32(ins.Values[0]))     // Memory base address
+
 		default:
 			panic(ins.Op)
 		}
@@ -748,5 +1059,19 @@ func (c *SSAFunctionCompiler) Serialize() []byte {
 		binary.LittleEndian.PutUint32(ret[t:t+4], uint32(insRelocs[insPos]))
 	}
 
-	return ret
+	offsets := make([]OffsetMapping, 0)
+	for i, ins := range c.Code {
+		if ins.WasmOffset < 0 {
+			continue
+		}
+		if len(offsets) > 0 && offsets[len(offsets)-1].WasmOffset == ins.WasmOffset {
+			continue
+		}
+		offsets = append(offsets, OffsetMapping{
+			BytecodeOffset: insRelocs[i],
+			WasmOffset:     ins.WasmOffset,
+		})
+	}
+
+	return ret, offsets
 }
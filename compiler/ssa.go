@@ -7,6 +7,7 @@ import (
 
 	"github.com/go-interpreter/wagon/disasm"
 	"github.com/go-interpreter/wagon/wasm"
+	ops "github.com/go-interpreter/wagon/wasm/operators"
 	"strings"
 )
 
@@ -23,6 +24,17 @@ type SSAFunctionCompiler struct {
 	Locations []*Location
 
 	CallIndexOffset int
+	NumReturns      int // number of values returned by the function
+
+	// returnFixups are branches out of the function body, which return
+	// the value on top of the stack at the branch.
+	returnFixups []returnFixup
+
+	// SourceOffsets, if set, holds the wasm offset of each instruction in Source,
+	// followed by that of the end of the function body.
+	SourceOffsets []int
+	numTagged     int
+	current       int // index in Source of the instruction being compiled
 
 	StackValueSets map[int][]TyValueID
 	UsedValueIDs   map[TyValueID]struct{}
@@ -46,6 +58,11 @@ type FixupInfo struct {
 	TablePos int
 }
 
+type returnFixup struct {
+	FixupInfo
+	value TyValueID
+}
+
 // Instr denotes a single instrution.
 type Instr struct {
 	Target TyValueID // the value id we are assigning to
@@ -53,11 +70,20 @@ type Instr struct {
 	Op         string
 	Immediates []int64
 	Values     []TyValueID
+
+	WasmOffset int // offset of the originating wasm instruction; -1 if unknown
 }
 
 // NewSSAFunctionCompiler instantiates a compiler which translates a WebAssembly modules
 // intepreted code into a Static-Single-Assignment-based intermediate representation.
 func NewSSAFunctionCompiler(m *wasm.Module, d *disasm.Disassembly) *SSAFunctionCompiler {
+	// Instructions are compiled by name, and wagon names opcode 0x81 (i64.rem_s)
+	// "i64.div_u".
+	for i := range d.Code {
+		if d.Code[i].Op.Code == ops.I64RemS {
+			d.Code[i].Op.Name = "i64.rem_s"
+		}
+	}
 	return &SSAFunctionCompiler{
 		Module:         m,
 		Source:         d,
@@ -127,14 +153,22 @@ func (c *SSAFunctionCompiler) FixupLocationRef(loc *Location, wasUnreachable boo
 	}
 }
 
+// IsFloatingPointOp reports whether the wasm operator named op computes on
+// floating point values. Constants and reinterpretations only move bits
+// around and are not considered floating point operations.
+func IsFloatingPointOp(op string) bool {
+	if strings.HasPrefix(op, "f32.") || strings.HasPrefix(op, "f64.") ||
+		strings.HasSuffix(op, "/f32") || strings.HasSuffix(op, "/f64") {
+		return !strings.Contains(op, ".reinterpret/") && !strings.HasSuffix(op, ".const")
+	}
+	return false
+}
+
 func (c *SSAFunctionCompiler) FilterFloatingPoint() {
 	for i, ins := range c.Code {
-		if strings.HasPrefix(ins.Op, "f32.") || strings.HasPrefix(ins.Op, "f64.") ||
-			strings.HasSuffix(ins.Op, "/f32") || strings.HasSuffix(ins.Op, "/f64") {
-			if strings.Contains(ins.Op, ".reinterpret/") || strings.HasSuffix(ins.Op, ".const") {
-				continue // whitelist
-			}
+		if IsFloatingPointOp(ins.Op) {
 			c.Code[i] = buildInstr(0, "fp_disabled_error", nil, nil)
+			c.Code[i].WasmOffset = ins.WasmOffset
 		}
 	}
 }
@@ -151,6 +185,8 @@ func (c *SSAFunctionCompiler) Compile(importTypeIDs []int) {
 
 	for i, ins := range c.Source.Code {
 		//fmt.Printf("%s %d\n", ins.Op.Name, len(c.Stack))
+		c.current = i
+		c.tagSourceOffset(i - 1)
 		wasUnreachable := false
 
 		if unreachableDepth != 0 {
@@ -348,6 +384,12 @@ func (c *SSAFunctionCompiler) Compile(importTypeIDs []int) {
 				CodePos: len(c.Code),
 			}
 
+			if c.returnsResult(loc) {
+				c.Code = append(c.Code, buildInstr(0, "return", nil, []TyValueID{c.Stack[len(c.Stack)-1]}))
+				unreachableDepth = 1
+				break
+			}
+
 			brValues := []TyValueID{0}
 			if loc.PreserveTop {
 				brValues[0] = c.Stack[len(c.Stack)-1]
@@ -363,10 +405,14 @@ func (c *SSAFunctionCompiler) Compile(importTypeIDs []int) {
 			fixupInfo := FixupInfo{
 				CodePos: len(c.Code),
 			}
-			if loc.PreserveTop {
-				brValues[1] = c.Stack[len(c.Stack)-1]
+			if c.returnsResult(loc) {
+				c.addReturnFixup(fixupInfo)
+			} else {
+				if loc.PreserveTop {
+					brValues[1] = c.Stack[len(c.Stack)-1]
+				}
+				loc.FixupList = append(loc.FixupList, fixupInfo)
 			}
-			loc.FixupList = append(loc.FixupList, fixupInfo)
 			c.Code = append(c.Code, buildInstr(0, "jmp_if", []int64{-1}, brValues))
 
 		case "br_table":
@@ -380,15 +426,18 @@ func (c *SSAFunctionCompiler) Compile(importTypeIDs []int) {
 				label := int(ins.Immediates[i+1].(uint32))
 				loc := c.Locations[len(c.Locations)-1-label]
 
-				if loc.PreserveTop {
-					preserveTop = true
-				}
-
 				fixupInfo := FixupInfo{
 					CodePos:  len(c.Code),
 					TablePos: i,
 				}
-				loc.FixupList = append(loc.FixupList, fixupInfo)
+				if c.returnsResult(loc) {
+					c.addReturnFixup(fixupInfo)
+				} else {
+					if loc.PreserveTop {
+						preserveTop = true
+					}
+					loc.FixupList = append(loc.FixupList, fixupInfo)
+				}
 				brTargets[i] = -1
 			}
 
@@ -410,6 +459,8 @@ func (c *SSAFunctionCompiler) Compile(importTypeIDs []int) {
 				c.Code = append(c.Code, buildInstr(0, "return", nil, nil))
 			}
 			if last {
+				c.emitReturnFixups()
+				c.tagSourceOffset(i)
 				return
 			}
 			unreachableDepth = 1
@@ -464,12 +515,69 @@ func (c *SSAFunctionCompiler) Compile(importTypeIDs []int) {
 		}
 	}
 
+	c.tagSourceOffset(len(c.Source.Code) - 1)
+
+	c.current = len(c.Source.Code) // the final end
 	c.FixupLocationRef(c.Locations[0], false)
 	if len(c.Stack) != 0 {
 		c.Code = append(c.Code, buildInstr(0, "return", nil, c.PopStack(1)))
 	} else {
 		c.Code = append(c.Code, buildInstr(0, "return", nil, nil))
 	}
+	c.emitReturnFixups()
+	c.tagSourceOffset(len(c.Source.Code))
+}
+
+// returnsResult reports whether a branch to loc leaves the function with the
+// value on top of the stack as its result.
+func (c *SSAFunctionCompiler) returnsResult(loc *Location) bool {
+	return loc == c.Locations[0] && c.NumReturns > 0
+}
+
+// addReturnFixup records a conditional branch out of the function body. Its
+// target is set by emitReturnFixups.
+func (c *SSAFunctionCompiler) addReturnFixup(info FixupInfo) {
+	c.returnFixups = append(c.returnFixups, returnFixup{
+		FixupInfo: info,
+		value:     c.Stack[len(c.Stack)-1],
+	})
+}
+
+// emitReturnFixups emits a return instruction for each value returned by a
+// conditional branch out of the function body, and points the branches at
+// them.
+func (c *SSAFunctionCompiler) emitReturnFixups() {
+	targets := make(map[TyValueID]int64)
+	for _, f := range c.returnFixups {
+		target, ok := targets[f.value]
+		if !ok {
+			target = int64(len(c.Code))
+			targets[f.value] = target
+			c.Code = append(c.Code, buildInstr(0, "return", nil, []TyValueID{f.value}))
+		}
+		c.Code[f.CodePos].Immediates[f.TablePos] = target
+	}
+	c.returnFixups = nil
+}
+
+// currentOffset returns the wasm offset of the instruction being compiled, or
+// -1 if SourceOffsets is not set.
+func (c *SSAFunctionCompiler) currentOffset() int {
+	if c.current < len(c.SourceOffsets) {
+		return c.SourceOffsets[c.current]
+	}
+	return -1
+}
+
+// tagSourceOffset assigns the wasm offset of the source instruction at
+// sourceIndex to all instructions emitted since the last call.
+func (c *SSAFunctionCompiler) tagSourceOffset(sourceIndex int) {
+	if sourceIndex >= 0 && sourceIndex < len(c.SourceOffsets) {
+		for j := c.numTagged; j < len(c.Code); j++ {
+			c.Code[j].WasmOffset = c.SourceOffsets[sourceIndex]
+		}
+	}
+	c.numTagged = len(c.Code)
 }
 
 func buildInstr(target TyValueID, op string, immediates []int64, values []TyValueID) Instr {
@@ -478,5 +586,6 @@ func buildInstr(target TyValueID, op string, immediates []int64, values []TyValu
 		Op:         op,
 		Immediates: immediates,
 		Values:     values,
+		WasmOffset: -1,
 	}
 }
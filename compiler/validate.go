@@ -0,0 +1,785 @@
+package compiler
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/go-interpreter/wagon/wasm"
+	"github.com/go-interpreter/wagon/wasm/leb128"
+	ops "github.com/go-interpreter/wagon/wasm/operators"
+	"github.com/perlin-network/life/utils"
+)
+
+const (
+	maxMemoryPages    = 65536
+	maxFunctionLocals = 50000
+)
+
+var sectionNames = map[wasm.SectionID]string{
+	wasm.SectionIDCustom:   "custom",
+	wasm.SectionIDType:     "type",
+	wasm.SectionIDImport:   "import",
+	wasm.SectionIDFunction: "function",
+	wasm.SectionIDTable:    "table",
+	wasm.SectionIDMemory:   "memory",
+	wasm.SectionIDGlobal:   "global",
+	wasm.SectionIDExport:   "export",
+	wasm.SectionIDStart:    "start",
+	wasm.SectionIDElement:  "element",
+	wasm.SectionIDCode:     "code",
+	wasm.SectionIDData:     "data",
+}
+
+// ValidationError describes why a module failed validation.
+type ValidationError struct {
+	Section       wasm.SectionID
+	FunctionIndex int // index in the function index space, or -1 if not in a function body
+	Offset        int // offset in the module binary, or -1 if unknown
+	Message       string
+}
+
+func (e *ValidationError) Error() string {
+	ret := fmt.Sprintf("invalid module: %s section", sectionNames[e.Section])
+	if e.FunctionIndex >= 0 {
+		ret += fmt.Sprintf(", function %d", e.FunctionIndex)
+	}
+	if e.Offset >= 0 {
+		ret += fmt.Sprintf(" at 0x%x", e.Offset)
+	}
+	return ret + ": " + e.Message
+}
+
+type moduleValidator struct {
+	m *Module
+
+	types       []wasm.FunctionSig
+	funcs       []uint32 // type index of each function in the function index space
+	globals     []wasm.GlobalVar
+	numImported struct {
+		funcs   int
+		globals int
+	}
+	numTables   int
+	numMemories int
+}
+
+// Validate checks the module against the validation rules of the WebAssembly
+// MVP. The first violation found is returned as a *ValidationError.
+func (m *Module) Validate() (retErr error) {
+	defer utils.CatchPanic(&retErr)
+
+	v := &moduleValidator{m: m}
+	v.validateTypes()
+	v.validateImports()
+	v.validateFunctions()
+	v.validateTables()
+	v.validateMemories()
+	v.validateGlobals()
+	v.validateExports()
+	v.validateStart()
+	v.validateElements()
+	v.validateData()
+	v.validateCode()
+	return nil
+}
+
+func (v *moduleValidator) fail(section wasm.SectionID, format string, args ...interface{}) {
+	offset, ok := v.m.sectionOffsets[section]
+	if !ok {
+		offset = -1
+	}
+	panic(&ValidationError{
+		Section:       section,
+		FunctionIndex: -1,
+		Offset:        offset,
+		Message:       fmt.Sprintf(format, args...),
+	})
+}
+
+func (v *moduleValidator) checkValueType(section wasm.SectionID, t wasm.ValueType) {
+	if !isValueType(t) {
+		v.fail(section, "invalid value type %d", int8(t))
+	}
+}
+
+func (v *moduleValidator) checkLimits(section wasm.SectionID, limits wasm.ResizableLimits, maxPages uint32) {
+	if maxPages != 0 {
+		if limits.Initial > maxPages || (limits.Flags&1 != 0 && limits.Maximum > maxPages) {
+			v.fail(section, "memory size must be at most %d pages (4GiB)", maxPages)
+		}
+	}
+	if limits.Flags&1 != 0 && limits.Initial > limits.Maximum {
+		v.fail(section, "size minimum must not be greater than maximum")
+	}
+}
+
+func (v *moduleValidator) checkTable(section wasm.SectionID, t wasm.Table) {
+	if t.ElementType != wasm.ElemTypeAnyFunc {
+		v.fail(section, "invalid table element type %d", int(t.ElementType))
+	}
+	v.checkLimits(section, t.Limits, 0)
+	v.numTables++
+	if v.numTables > 1 {
+		v.fail(section, "multiple tables")
+	}
+}
+
+func (v *moduleValidator) checkMemory(section wasm.SectionID, mem wasm.Memory) {
+	v.checkLimits(section, mem.Limits, maxMemoryPages)
+	v.numMemories++
+	if v.numMemories > 1 {
+		v.fail(section, "multiple memories")
+	}
+}
+
+func (v *moduleValidator) validateTypes() {
+	if v.m.Base.Types == nil {
+		return
+	}
+	for _, sig := range v.m.Base.Types.Entries {
+		for _, t := range sig.ParamTypes {
+			v.checkValueType(wasm.SectionIDType, t)
+		}
+		for _, t := range sig.ReturnTypes {
+			v.checkValueType(wasm.SectionIDType, t)
+		}
+		if len(sig.ReturnTypes) > 1 {
+			v.fail(wasm.SectionIDType, "invalid result arity")
+		}
+	}
+	v.types = v.m.Base.Types.Entries
+}
+
+func (v *moduleValidator) validateImports() {
+	if v.m.Base.Import == nil {
+		return
+	}
+	for _, e := range v.m.Base.Import.Entries {
+		switch ty := e.Type.(type) {
+		case wasm.FuncImport:
+			if int(ty.Type) >= len(v.types) {
+				v.fail(wasm.SectionIDImport, "unknown type %d", ty.Type)
+			}
+			v.funcs = append(v.funcs, ty.Type)
+			v.numImported.funcs++
+		case wasm.TableImport:
+			v.checkTable(wasm.SectionIDImport, ty.Type)
+		case wasm.MemoryImport:
+			v.checkMemory(wasm.SectionIDImport, ty.Type)
+		case wasm.GlobalVarImport:
+			v.checkValueType(wasm.SectionIDImport, ty.Type.Type)
+			if ty.Type.Mutable {
+				v.fail(wasm.SectionIDImport, "mutable globals cannot be imported")
+			}
+			v.globals = append(v.globals, ty.Type)
+			v.numImported.globals++
+		}
+	}
+}
+
+func (v *moduleValidator) validateFunctions() {
+	if v.m.Base.Function == nil {
+		return
+	}
+	for _, typeID := range v.m.Base.Function.Types {
+		if int(typeID) >= len(v.types) {
+			v.fail(wasm.SectionIDFunction, "unknown type %d", typeID)
+		}
+		v.funcs = append(v.funcs, typeID)
+	}
+}
+
+func (v *moduleValidator) validateTables() {
+	if v.m.Base.Table == nil {
+		return
+	}
+	for _, t := range v.m.Base.Table.Entries {
+		v.checkTable(wasm.SectionIDTable, t)
+	}
+}
+
+func (v *moduleValidator) validateMemories() {
+	if v.m.Base.Memory == nil {
+		return
+	}
+	for _, mem := range v.m.Base.Memory.Entries {
+		v.checkMemory(wasm.SectionIDMemory, mem)
+	}
+}
+
+func (v *moduleValidator) validateGlobals() {
+	if v.m.Base.Global == nil {
+		return
+	}
+	for _, g := range v.m.Base.Global.Globals {
+		v.checkValueType(wasm.SectionIDGlobal, g.Type.Type)
+		if t := v.constExprType(wasm.SectionIDGlobal, g.Init); t != g.Type.Type {
+			v.fail(wasm.SectionIDGlobal, "type mismatch: initializer of %s global has type %s", g.Type.Type, t)
+		}
+		v.globals = append(v.globals, g.Type)
+	}
+}
+
+func (v *moduleValidator) validateExports() {
+	if v.m.Base.Export == nil {
+		return
+	}
+
+	// Duplicate names are rejected while decoding the section.
+	names := make([]string, 0, len(v.m.Base.Export.Entries))
+	for name := range v.m.Base.Export.Entries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		e := v.m.Base.Export.Entries[name]
+		switch e.Kind {
+		case wasm.ExternalFunction:
+			if int(e.Index) >= len(v.funcs) {
+				v.fail(wasm.SectionIDExport, "export %q: unknown function %d", name, e.Index)
+			}
+		case wasm.ExternalTable:
+			if int(e.Index) >= v.numTables {
+				v.fail(wasm.SectionIDExport, "export %q: unknown table %d", name, e.Index)
+			}
+		case wasm.ExternalMemory:
+			if int(e.Index) >= v.numMemories {
+				v.fail(wasm.SectionIDExport, "export %q: unknown memory %d", name, e.Index)
+			}
+		case wasm.ExternalGlobal:
+			if int(e.Index) >= len(v.globals) {
+				v.fail(wasm.SectionIDExport, "export %q: unknown global %d", name, e.Index)
+			}
+			if v.globals[e.Index].Mutable {
+				v.fail(wasm.SectionIDExport, "export %q: mutable globals cannot be exported", name)
+			}
+		default:
+			v.fail(wasm.SectionIDExport, "export %q: invalid external kind %d", name, e.Kind)
+		}
+	}
+}
+
+func (v *moduleValidator) validateStart() {
+	if v.m.Base.Start == nil {
+		return
+	}
+	id := v.m.Base.Start.Index
+	if int(id) >= len(v.funcs) {
+		v.fail(wasm.SectionIDStart, "unknown function %d", id)
+	}
+	sig := &v.types[v.funcs[id]]
+	if len(sig.ParamTypes) != 0 || len(sig.ReturnTypes) != 0 {
+		v.fail(wasm.SectionIDStart, "start function must have type [] -> []")
+	}
+}
+
+func (v *moduleValidator) validateElements() {
+	if v.m.Base.Elements == nil {
+		return
+	}
+	for _, e := range v.m.Base.Elements.Entries {
+		if int(e.Index) >= v.numTables {
+			v.fail(wasm.SectionIDElement, "unknown table %d", e.Index)
+		}
+		if t := v.constExprType(wasm.SectionIDElement, e.Offset); t != wasm.ValueTypeI32 {
+			v.fail(wasm.SectionIDElement, "type mismatch: offset has type %s, expected i32", t)
+		}
+		for _, id := range e.Elems {
+			if int(id) >= len(v.funcs) {
+				v.fail(wasm.SectionIDElement, "unknown function %d", id)
+			}
+		}
+	}
+}
+
+func (v *moduleValidator) validateData() {
+	if v.m.Base.Data == nil {
+		return
+	}
+	for _, e := range v.m.Base.Data.Entries {
+		if int(e.Index) >= v.numMemories {
+			v.fail(wasm.SectionIDData, "unknown memory %d", e.Index)
+		}
+		if t := v.constExprType(wasm.SectionIDData, e.Offset); t != wasm.ValueTypeI32 {
+			v.fail(wasm.SectionIDData, "type mismatch: offset has type %s, expected i32", t)
+		}
+	}
+}
+
+// constExprType checks that expr is a constant expression and returns its type.
+// Only immutable imported globals may be referenced.
+func (v *moduleValidator) constExprType(section wasm.SectionID, expr []byte) wasm.ValueType {
+	r := bytes.NewReader(expr)
+	op, err := r.ReadByte()
+	if err != nil {
+		v.fail(section, "constant expression required")
+	}
+
+	var ret wasm.ValueType
+	switch op {
+	case ops.I32Const:
+		_, err = leb128.ReadVarint32(r)
+		ret = wasm.ValueTypeI32
+	case ops.I64Const:
+		_, err = leb128.ReadVarint64(r)
+		ret = wasm.ValueTypeI64
+	case ops.F32Const:
+		_, err = r.Seek(4, io.SeekCurrent)
+		ret = wasm.ValueTypeF32
+	case ops.F64Const:
+		_, err = r.Seek(8, io.SeekCurrent)
+		ret = wasm.ValueTypeF64
+	case ops.GetGlobal:
+		var id uint32
+		id, err = leb128.ReadVarUint32(r)
+		if err != nil {
+			break
+		}
+		if int(id) >= v.numImported.globals {
+			v.fail(section, "unknown global %d", id)
+		}
+		if v.globals[id].Mutable {
+			v.fail(section, "constant expression required")
+		}
+		ret = v.globals[id].Type
+	default:
+		v.fail(section, "constant expression required")
+	}
+	if err != nil {
+		v.fail(section, "malformed constant expression: %v", err)
+	}
+
+	if end, err := r.ReadByte(); err != nil || end != ops.End || r.Len() != 0 {
+		v.fail(section, "constant expression required")
+	}
+	return ret
+}
+
+func (v *moduleValidator) validateCode() {
+	numBodies := 0
+	if v.m.Base.Code != nil {
+		numBodies = len(v.m.Base.Code.Bodies)
+	}
+	if numBodies != len(v.funcs)-v.numImported.funcs {
+		v.fail(wasm.SectionIDCode, "function and code section have inconsistent lengths")
+	}
+	if numBodies == 0 {
+		return
+	}
+
+	codeOffsets, err := v.m.functionCodeOffsets()
+	if err != nil {
+		v.fail(wasm.SectionIDCode, "%v", err)
+	}
+
+	for i := range v.m.Base.Code.Bodies {
+		f := &functionValidator{
+			v:             v,
+			functionIndex: v.numImported.funcs + i,
+			body:          &v.m.Base.Code.Bodies[i],
+			codeOffset:    -1,
+		}
+		if codeOffsets != nil {
+			f.codeOffset = codeOffsets[i]
+		}
+		f.validate()
+	}
+}
+
+type controlFrame struct {
+	op          byte
+	labelTypes  []wasm.ValueType
+	endTypes    []wasm.ValueType
+	height      int
+	unreachable bool
+}
+
+// functionValidator type checks a function body with the algorithm described
+// in the validation appendix of the WebAssembly specification.
+type functionValidator struct {
+	v             *moduleValidator
+	functionIndex int
+	body          *wasm.FunctionBody
+	codeOffset    int // offset of the code in the module binary, or -1 if unknown
+
+	r       *bytes.Reader
+	pos     int // offset of the current instruction in the code
+	locals  []wasm.ValueType
+	results []wasm.ValueType
+	vals    []wasm.ValueType // 0 stands for a value of unknown type
+	ctrls   []controlFrame
+}
+
+func (f *functionValidator) fail(format string, args ...interface{}) {
+	offset := -1
+	if f.codeOffset >= 0 {
+		offset = f.codeOffset + f.pos
+	}
+	panic(&ValidationError{
+		Section:       wasm.SectionIDCode,
+		FunctionIndex: f.functionIndex,
+		Offset:        offset,
+		Message:       fmt.Sprintf(format, args...),
+	})
+}
+
+func (f *functionValidator) validate() {
+	sig := &f.v.types[f.v.funcs[f.functionIndex]]
+	f.results = sig.ReturnTypes
+
+	numLocals := uint64(len(sig.ParamTypes))
+	for _, e := range f.body.Locals {
+		if !isValueType(e.Type) {
+			f.fail("invalid value type %d", int8(e.Type))
+		}
+		numLocals += uint64(e.Count)
+		if numLocals > maxFunctionLocals {
+			f.fail("too many locals")
+		}
+	}
+	f.locals = make([]wasm.ValueType, 0, int(numLocals))
+	f.locals = append(f.locals, sig.ParamTypes...)
+	for _, e := range f.body.Locals {
+		for i := uint32(0); i < e.Count; i++ {
+			f.locals = append(f.locals, e.Type)
+		}
+	}
+
+	code := f.body.Code
+	f.r = bytes.NewReader(code)
+	f.pushCtrl(ops.Block, f.results, f.results)
+	for f.r.Len() > 0 {
+		f.pos = len(code) - f.r.Len()
+		op, _ := f.r.ReadByte()
+		f.step(op)
+	}
+
+	// The end opcode of the function body is stripped while decoding.
+	f.pos = len(code)
+	if len(f.ctrls) != 1 {
+		f.fail("unexpected end of function: %d blocks are not closed", len(f.ctrls)-1)
+	}
+	f.popCtrl()
+}
+
+func (f *functionValidator) step(op byte) {
+	switch op {
+	case ops.Unreachable:
+		f.setUnreachable()
+	case ops.Nop:
+	case ops.Block, ops.Loop, ops.If:
+		types := f.readBlockType()
+		switch op {
+		case ops.Block:
+			f.pushCtrl(op, types, types)
+		case ops.Loop:
+			f.pushCtrl(op, nil, types)
+		case ops.If:
+			f.popExpect(wasm.ValueTypeI32)
+			f.pushCtrl(op, types, types)
+		}
+	case ops.Else:
+		frame := f.popCtrl()
+		if frame.op != ops.If {
+			f.fail("else without matching if")
+		}
+		f.pushCtrl(ops.Else, frame.endTypes, frame.endTypes)
+	case ops.End:
+		if len(f.ctrls) == 1 {
+			f.fail("unexpected end of function body")
+		}
+		frame := f.popCtrl()
+		if frame.op == ops.If && len(frame.endTypes) != 0 {
+			f.fail("type mismatch: if without else must not yield a value")
+		}
+		f.pushVals(frame.endTypes)
+	case ops.Br:
+		f.popVals(f.label(f.readIndex()))
+		f.setUnreachable()
+	case ops.BrIf:
+		label := f.label(f.readIndex())
+		f.popExpect(wasm.ValueTypeI32)
+		f.popVals(label)
+		f.pushVals(label)
+	case ops.BrTable:
+		count := f.readIndex()
+		if int64(count) > int64(f.r.Len()) {
+			f.fail("unexpected end of br_table targets")
+		}
+		targets := make([]uint32, count)
+		for i := range targets {
+			targets[i] = f.readIndex()
+		}
+		defaultLabel := f.label(f.readIndex())
+		f.popExpect(wasm.ValueTypeI32)
+		for _, target := range targets {
+			if !sameTypes(f.label(target), defaultLabel) {
+				f.fail("type mismatch: br_table targets have different types")
+			}
+		}
+		f.popVals(defaultLabel)
+		f.setUnreachable()
+	case ops.Return:
+		f.popVals(f.results)
+		f.setUnreachable()
+	case ops.Call:
+		id := f.readIndex()
+		if int(id) >= len(f.v.funcs) {
+			f.fail("unknown function %d", id)
+		}
+		sig := &f.v.types[f.v.funcs[id]]
+		f.popVals(sig.ParamTypes)
+		f.pushVals(sig.ReturnTypes)
+	case ops.CallIndirect:
+		typeID := f.readIndex()
+		if f.readIndex() != 0 {
+			f.fail("zero flag expected")
+		}
+		if f.v.numTables == 0 {
+			f.fail("unknown table 0")
+		}
+		if int(typeID) >= len(f.v.types) {
+			f.fail("unknown type %d", typeID)
+		}
+		sig := &f.v.types[typeID]
+		f.popExpect(wasm.ValueTypeI32)
+		f.popVals(sig.ParamTypes)
+		f.pushVals(sig.ReturnTypes)
+	case ops.Drop:
+		f.pop()
+	case ops.Select:
+		f.popExpect(wasm.ValueTypeI32)
+		t := f.pop()
+		f.push(f.popExpect(t))
+	case ops.GetLocal:
+		f.push(f.local(f.readIndex()))
+	case ops.SetLocal:
+		f.popExpect(f.local(f.readIndex()))
+	case ops.TeeLocal:
+		t := f.local(f.readIndex())
+		f.popExpect(t)
+		f.push(t)
+	case ops.GetGlobal:
+		f.push(f.global(f.readIndex()).Type)
+	case ops.SetGlobal:
+		g := f.global(f.readIndex())
+		if !g.Mutable {
+			f.fail("global is immutable")
+		}
+		f.popExpect(g.Type)
+	case ops.CurrentMemory, ops.GrowMemory:
+		if f.readIndex() != 0 {
+			f.fail("zero flag expected")
+		}
+		f.checkMemory()
+		f.applyOp(op)
+	case ops.I32Const:
+		if _, err := leb128.ReadVarint32(f.r); err != nil {
+			f.fail("malformed i32 constant: %v", err)
+		}
+		f.push(wasm.ValueTypeI32)
+	case ops.I64Const:
+		if _, err := leb128.ReadVarint64(f.r); err != nil {
+			f.fail("malformed i64 constant: %v", err)
+		}
+		f.push(wasm.ValueTypeI64)
+	case ops.F32Const:
+		f.skip(4)
+		f.push(wasm.ValueTypeF32)
+	case ops.F64Const:
+		f.skip(8)
+		f.push(wasm.ValueTypeF64)
+	default:
+		if natural := naturalAlignment(op); natural != 0 {
+			align := f.readIndex()
+			f.readIndex() // offset
+			f.checkMemory()
+			if align >= 32 || 1<<align > natural {
+				f.fail("alignment must not be larger than natural")
+			}
+			if info, _ := ops.New(op); info.Returns == wasm.ValueType(wasm.BlockTypeEmpty) {
+				// wagon lists the operands of stores as (value, address).
+				f.popExpect(info.Args[0])
+				f.popExpect(wasm.ValueTypeI32)
+				return
+			}
+		}
+		f.applyOp(op)
+	}
+}
+
+// applyOp type checks an operator with a fixed signature.
+func (f *functionValidator) applyOp(op byte) {
+	info, err := ops.New(op)
+	if err != nil || info.Polymorphic {
+		f.fail("illegal opcode 0x%x", op)
+	}
+	for i := len(info.Args) - 1; i >= 0; i-- {
+		f.popExpect(info.Args[i])
+	}
+	if info.Returns != wasm.ValueType(wasm.BlockTypeEmpty) {
+		f.push(info.Returns)
+	}
+}
+
+func (f *functionValidator) push(t wasm.ValueType) {
+	f.vals = append(f.vals, t)
+}
+
+func (f *functionValidator) pushVals(types []wasm.ValueType) {
+	f.vals = append(f.vals, types...)
+}
+
+func (f *functionValidator) pop() wasm.ValueType {
+	top := &f.ctrls[len(f.ctrls)-1]
+	if len(f.vals) == top.height {
+		if top.unreachable {
+			return 0
+		}
+		f.fail("type mismatch: operand stack is empty")
+	}
+	ret := f.vals[len(f.vals)-1]
+	f.vals = f.vals[:len(f.vals)-1]
+	return ret
+}
+
+func (f *functionValidator) popExpect(expected wasm.ValueType) wasm.ValueType {
+	actual := f.pop()
+	if actual == 0 {
+		return expected
+	}
+	if expected == 0 {
+		return actual
+	}
+	if actual != expected {
+		f.fail("type mismatch: expected %s, got %s", expected, actual)
+	}
+	return actual
+}
+
+func (f *functionValidator) popVals(types []wasm.ValueType) {
+	for i := len(types) - 1; i >= 0; i-- {
+		f.popExpect(types[i])
+	}
+}
+
+func (f *functionValidator) pushCtrl(op byte, labelTypes, endTypes []wasm.ValueType) {
+	f.ctrls = append(f.ctrls, controlFrame{
+		op:         op,
+		labelTypes: labelTypes,
+		endTypes:   endTypes,
+		height:     len(f.vals),
+	})
+}
+
+func (f *functionValidator) popCtrl() controlFrame {
+	frame := f.ctrls[len(f.ctrls)-1]
+	f.popVals(frame.endTypes)
+	if len(f.vals) != frame.height {
+		f.fail("type mismatch: %d values remaining at end of block", len(f.vals)-frame.height)
+	}
+	f.ctrls = f.ctrls[:len(f.ctrls)-1]
+	return frame
+}
+
+func (f *functionValidator) setUnreachable() {
+	top := &f.ctrls[len(f.ctrls)-1]
+	f.vals = f.vals[:top.height]
+	top.unreachable = true
+}
+
+func (f *functionValidator) label(depth uint32) []wasm.ValueType {
+	if int(depth) >= len(f.ctrls) {
+		f.fail("unknown label %d", depth)
+	}
+	return f.ctrls[len(f.ctrls)-1-int(depth)].labelTypes
+}
+
+func (f *functionValidator) local(id uint32) wasm.ValueType {
+	if int(id) >= len(f.locals) {
+		f.fail("unknown local %d", id)
+	}
+	return f.locals[id]
+}
+
+func (f *functionValidator) global(id uint32) wasm.GlobalVar {
+	if int(id) >= len(f.v.globals) {
+		f.fail("unknown global %d", id)
+	}
+	return f.v.globals[id]
+}
+
+func (f *functionValidator) checkMemory() {
+	if f.v.numMemories == 0 {
+		f.fail("unknown memory 0")
+	}
+}
+
+func (f *functionValidator) readIndex() uint32 {
+	ret, err := leb128.ReadVarUint32(f.r)
+	if err != nil {
+		f.fail("malformed immediate: %v", err)
+	}
+	return ret
+}
+
+func (f *functionValidator) readBlockType() []wasm.ValueType {
+	bt, err := leb128.ReadVarint32(f.r)
+	if err != nil {
+		f.fail("malformed block type: %v", err)
+	}
+	if bt == int32(wasm.BlockTypeEmpty) {
+		return nil
+	}
+	if t := wasm.ValueType(bt); int32(t) == bt && isValueType(t) {
+		return []wasm.ValueType{t}
+	}
+	f.fail("invalid block type %d", bt)
+	return nil
+}
+
+func (f *functionValidator) skip(n int) {
+	if f.r.Len() < n {
+		f.fail("unexpected end of constant")
+	}
+	f.r.Seek(int64(n), io.SeekCurrent)
+}
+
+func isValueType(t wasm.ValueType) bool {
+	switch t {
+	case wasm.ValueTypeI32, wasm.ValueTypeI64, wasm.ValueTypeF32, wasm.ValueTypeF64:
+		return true
+	}
+	return false
+}
+
+func sameTypes(a, b []wasm.ValueType) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// naturalAlignment returns the access size in bytes of a memory operator, or
+// 0 if op does not access memory.
+func naturalAlignment(op byte) uint32 {
+	switch op {
+	case ops.I32Load8s, ops.I32Load8u, ops.I64Load8s, ops.I64Load8u, ops.I32Store8, ops.I64Store8:
+		return 1
+	case ops.I32Load16s, ops.I32Load16u, ops.I64Load16s, ops.I64Load16u, ops.I32Store16, ops.I64Store16:
+		return 2
+	case ops.I32Load, ops.F32Load, ops.I64Load32s, ops.I64Load32u, ops.I32Store, ops.F32Store, ops.I64Store32:
+		return 4
+	case ops.I64Load, ops.F64Load, ops.I64Store, ops.F64Store:
+		return 8
+	}
+	return 0
+}
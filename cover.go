@@ -0,0 +1,99 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/perlin-network/life/coverage"
+)
+
+// coverMain implements `life cover [flags] module.wasm profile...`. It merges
+// the profiles written by `life run -coverprofile` and reports the coverage of
+// the module; without an output flag, it prints a per-function summary.
+func coverMain(args []string) {
+	fs := flag.NewFlagSet("cover", flag.ExitOnError)
+	vf := registerVMFlags(fs)
+	offsetsFlag := fs.Bool("offsets", false, "print the execution count of every instrumented instruction")
+	lcovFlag := fs.String("lcov", "", "write the line coverage in the lcov format to `file`")
+	htmlFlag := fs.String("html", "", "write an HTML report to `file`")
+	testNameFlag := fs.String("test-name", "", "test name recorded in the lcov output")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: life cover [flags] module.wasm profile...")
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "The profiles are written by `life run -coverprofile`. The module must be")
+		fmt.Fprintln(os.Stderr, "instantiated as it was run, so -resolver and the compiler flags must match.")
+		fmt.Fprintln(os.Stderr)
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	if fs.NArg() < 2 {
+		fs.Usage()
+		os.Exit(2)
+	}
+	if err := vf.check(); err != nil {
+		fmt.Fprintf(os.Stderr, "life cover: %v\n", err)
+		os.Exit(2)
+	}
+
+	if err := cover(vf, fs.Arg(0), fs.Args()[1:], *offsetsFlag, *lcovFlag, *htmlFlag, *testNameFlag); err != nil {
+		fmt.Fprintf(os.Stderr, "life cover: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func cover(vf *vmFlags, path string, profiles []string, offsets bool, lcovPath, htmlPath, testName string) error {
+	vf.coverage = true
+	vm, err := vf.instantiate(path, []string{path})
+	if err != nil {
+		return err
+	}
+	p, err := coverage.NewProfile(vm)
+	if err != nil {
+		return err
+	}
+	for _, name := range profiles {
+		f, err := os.Open(name)
+		if err != nil {
+			return err
+		}
+		err = p.ReadProfile(f)
+		f.Close()
+		if err != nil {
+			return fmt.Errorf("%s: %v", name, err)
+		}
+	}
+
+	if lcovPath != "" {
+		if err := writeFile(lcovPath, func(w io.Writer) error { return p.WriteLCOV(w, testName) }); err != nil {
+			return err
+		}
+	}
+	if htmlPath != "" {
+		if err := writeFile(htmlPath, p.WriteHTML); err != nil {
+			return err
+		}
+	}
+	switch {
+	case offsets:
+		return p.WriteOffsets(os.Stdout)
+	case lcovPath == "" && htmlPath == "":
+		return p.WriteFunctions(os.Stdout)
+	}
+	return nil
+}
+
+// writeFile creates the file at path and writes it with write.
+func writeFile(path string, write func(io.Writer) error) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := write(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,158 @@
+package coverage
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+type htmlLine struct {
+	Number int
+	Text   string
+	Class  string // "hit", "miss" or "" for lines without code
+	Count  string
+}
+
+type htmlFile struct {
+	ID      int
+	Path    string
+	Covered int
+	Total   int
+	Percent string
+	Lines   []htmlLine
+	Missing bool // source not readable; only instrumented lines are listed
+}
+
+type htmlFunction struct {
+	ID      int
+	Name    string
+	Covered int
+	Total   int
+	Percent string
+}
+
+type htmlReport struct {
+	Covered   int
+	Total     int
+	Percent   string
+	Files     []htmlFile
+	Functions []htmlFunction
+}
+
+var htmlTemplate = template.Must(template.New("coverage").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Coverage report</title>
+<style>
+body { font-family: sans-serif; margin: 2em; }
+table { border-collapse: collapse; }
+td, th { padding: 2px 10px; text-align: left; }
+tr:nth-child(even) { background: #f4f4f4; }
+.source td { font-family: monospace; padding: 0 8px; white-space: pre; }
+.source .count { text-align: right; color: #666; }
+.hit { background: #d4f4d4; }
+.miss { background: #f8d0d0; }
+</style>
+</head>
+<body>
+<h1>Coverage report</h1>
+<p>Blocks covered: {{.Covered}}/{{.Total}} ({{.Percent}})</p>
+{{if .Files}}
+<h2>Files</h2>
+<table>
+<tr><th>File</th><th>Lines</th><th>Coverage</th></tr>
+{{range .Files}}<tr><td><a href="#file{{.ID}}">{{.Path}}</a></td><td>{{.Covered}}/{{.Total}}</td><td>{{.Percent}}</td></tr>
+{{end}}</table>
+{{end}}
+<h2>Functions</h2>
+<table>
+<tr><th>ID</th><th>Function</th><th>Blocks</th><th>Coverage</th></tr>
+{{range .Functions}}<tr><td>{{.ID}}</td><td>{{.Name}}</td><td>{{.Covered}}/{{.Total}}</td><td>{{.Percent}}</td></tr>
+{{end}}</table>
+{{range .Files}}
+<h2 id="file{{.ID}}">{{.Path}}</h2>
+{{if .Missing}}<p>Source file not found; showing instrumented lines only.</p>{{end}}
+<table class="source">
+{{range .Lines}}<tr class="{{.Class}}"><td class="count">{{.Number}}</td><td class="count">{{.Count}}</td><td>{{.Text}}</td></tr>
+{{end}}</table>
+{{end}}
+</body>
+</html>
+`))
+
+// WriteHTML writes an HTML report with per-function block coverage and, if
+// the module carries DWARF line info, annotated source files. Source files are
+// read from the paths recorded in the line info.
+func (p *Profile) WriteHTML(w io.Writer) error {
+	covered, total := p.Totals()
+	report := htmlReport{
+		Covered: covered,
+		Total:   total,
+		Percent: formatPercent(covered, total),
+	}
+
+	for i := range p.Functions {
+		f := &p.Functions[i]
+		if len(f.Blocks) == 0 {
+			continue
+		}
+		report.Functions = append(report.Functions, htmlFunction{
+			ID:      f.ID,
+			Name:    f.Name,
+			Covered: f.Covered(),
+			Total:   len(f.Blocks),
+			Percent: formatPercent(f.Covered(), len(f.Blocks)),
+		})
+	}
+
+	files, err := p.SourceCoverage()
+	if err != nil && err != ErrNoLineInfo {
+		return err
+	}
+	for i, fc := range files {
+		hf := htmlFile{
+			ID:      i,
+			Path:    fc.Path,
+			Covered: fc.Covered(),
+			Total:   len(fc.Lines),
+			Percent: formatPercent(fc.Covered(), len(fc.Lines)),
+		}
+
+		if src, err := ioutil.ReadFile(fc.Path); err == nil {
+			for j, text := range strings.Split(string(src), "\n") {
+				hf.Lines = append(hf.Lines, newHTMLLine(fc, j+1, text))
+			}
+		} else {
+			hf.Missing = true
+			for _, line := range sortedLines(fc.Lines) {
+				hf.Lines = append(hf.Lines, newHTMLLine(fc, line, ""))
+			}
+		}
+		report.Files = append(report.Files, hf)
+	}
+
+	return htmlTemplate.Execute(w, report)
+}
+
+func newHTMLLine(fc *FileCoverage, number int, text string) htmlLine {
+	line := htmlLine{
+		Number: number,
+		Text:   text,
+	}
+	if count, ok := fc.Lines[number]; ok {
+		line.Count = fmt.Sprint(count)
+		if count != 0 {
+			line.Class = "hit"
+		} else {
+			line.Class = "miss"
+		}
+	}
+	return line
+}
+
+func formatPercent(n, total int) string {
+	return fmt.Sprintf("%.1f%%", percent(n, total))
+}
@@ -0,0 +1,145 @@
+package coverage
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// ErrNoLineInfo is returned when a source level report is requested for a
+// module without DWARF line info.
+var ErrNoLineInfo = errors.New("module has no DWARF line info")
+
+// FileCoverage is the line coverage of a single source file.
+type FileCoverage struct {
+	Path      string
+	Lines     map[int]uint64 // execution count of each instrumented line
+	Functions []FunctionLine
+}
+
+// FunctionLine locates a function in a source file.
+type FunctionLine struct {
+	Name  string
+	Line  int
+	Count uint64 // execution count of the function's entry block
+}
+
+// Covered returns the number of instrumented lines that were executed.
+func (f *FileCoverage) Covered() int {
+	n := 0
+	for _, count := range f.Lines {
+		if count != 0 {
+			n++
+		}
+	}
+	return n
+}
+
+// SourceCoverage maps block counts to source lines using the module's DWARF
+// line info. A line's count is the highest count of the blocks it is part of.
+// Files are sorted by path.
+func (p *Profile) SourceCoverage() ([]*FileCoverage, error) {
+	if !p.Module.HasLineInfo() {
+		if err := p.Module.DebugInfoError(); err != nil {
+			return nil, err
+		}
+		return nil, ErrNoLineInfo
+	}
+
+	files := make(map[string]*FileCoverage)
+	file := func(path string) *FileCoverage {
+		fc, ok := files[path]
+		if !ok {
+			fc = &FileCoverage{
+				Path:  path,
+				Lines: make(map[int]uint64),
+			}
+			files[path] = fc
+		}
+		return fc
+	}
+
+	for _, f := range p.Functions {
+		entrySeen := false
+		for _, blk := range f.Blocks {
+			for _, off := range blk.WasmOffsets {
+				loc, ok := p.Module.SourceLocation(off)
+				if !ok {
+					continue
+				}
+				fc := file(loc.File)
+				if blk.Count > fc.Lines[loc.Line] {
+					fc.Lines[loc.Line] = blk.Count
+				} else if _, ok := fc.Lines[loc.Line]; !ok {
+					fc.Lines[loc.Line] = 0
+				}
+				if !entrySeen {
+					entrySeen = true
+					fc.Functions = append(fc.Functions, FunctionLine{
+						Name:  f.Name,
+						Line:  loc.Line,
+						Count: blk.Count,
+					})
+				}
+			}
+		}
+	}
+
+	ret := make([]*FileCoverage, 0, len(files))
+	for _, fc := range files {
+		sort.Slice(fc.Functions, func(i, j int) bool {
+			return fc.Functions[i].Line < fc.Functions[j].Line
+		})
+		ret = append(ret, fc)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Path < ret[j].Path
+	})
+	return ret, nil
+}
+
+// WriteLCOV writes the line coverage in the lcov tracefile format.
+func (p *Profile) WriteLCOV(w io.Writer, testName string) error {
+	files, err := p.SourceCoverage()
+	if err != nil {
+		return err
+	}
+
+	bw := bufio.NewWriter(w)
+	for _, fc := range files {
+		fmt.Fprintf(bw, "TN:%s\n", testName)
+		fmt.Fprintf(bw, "SF:%s\n", fc.Path)
+
+		hitFunctions := 0
+		for _, fn := range fc.Functions {
+			fmt.Fprintf(bw, "FN:%d,%s\n", fn.Line, fn.Name)
+		}
+		for _, fn := range fc.Functions {
+			fmt.Fprintf(bw, "FNDA:%d,%s\n", fn.Count, fn.Name)
+			if fn.Count != 0 {
+				hitFunctions++
+			}
+		}
+		fmt.Fprintf(bw, "FNF:%d\n", len(fc.Functions))
+		fmt.Fprintf(bw, "FNH:%d\n", hitFunctions)
+
+		for _, line := range sortedLines(fc.Lines) {
+			fmt.Fprintf(bw, "DA:%d,%d\n", line, fc.Lines[line])
+		}
+		fmt.Fprintf(bw, "LF:%d\n", len(fc.Lines))
+		fmt.Fprintf(bw, "LH:%d\n", fc.Covered())
+		fmt.Fprintln(bw, "end_of_record")
+	}
+	return bw.Flush()
+}
+
+func sortedLines(lines map[int]uint64) []int {
+	ret := make([]int, 0, len(lines))
+	for line := range lines {
+		ret = append(ret, line)
+	}
+	sort.Ints(ret)
+	return ret
+}
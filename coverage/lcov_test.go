@@ -0,0 +1,178 @@
+package coverage
+
+import (
+	"bytes"
+	"encoding/binary"
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/perlin-network/life/exec"
+	"github.com/perlin-network/life/wat"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+// absSource is a hand compiled abs.c; absLines maps the offsets of its
+// instructions in the code section to the lines of abs.c.
+//
+//	1 int abs(int x) {
+//	2     if (x < 0)
+//	3         return -x;
+//	4     return x;
+//	5 }
+//	6 int unused(void) { return 0; }
+const absSource = `
+(module
+    (func (export "abs") (param i32) (result i32)
+        get_local 0
+        i32.const 0
+        i32.lt_s
+        if (result i32)
+            i32.const 0
+            get_local 0
+            i32.sub
+        else
+            get_local 0
+        end
+    )
+    (func (export "unused") (result i32)
+        i32.const 0
+    )
+)`
+
+var absLines = []struct {
+	offset, line int
+}{
+	{3, 2},  // get_local 0
+	{10, 3}, // i32.const 0
+	{15, 4}, // else
+	{18, 5}, // end
+	{22, 6}, // i32.const 0 of unused
+}
+
+// absCodeSize is the size of the code section payload of absSource.
+const absCodeSize = 25
+
+// withLineInfo appends DWARF sections mapping the code of module to lines of
+// file to it.
+func withLineInfo(module []byte, file string) []byte {
+	abbrev := []byte{
+		0x01, 0x11, 0x00, // abbreviation 1: compile unit without children
+		0x03, 0x08, // DW_AT_name, DW_FORM_string
+		0x10, 0x17, // DW_AT_stmt_list, DW_FORM_sec_offset
+		0x00, 0x00,
+		0x00,
+	}
+
+	var info bytes.Buffer
+	info.Write([]byte{0x04, 0x00, 0, 0, 0, 0, 4}) // version, abbreviation table offset, address size
+	info.WriteByte(0x01)
+	info.WriteString(file + "\x00")
+	info.Write([]byte{0, 0, 0, 0}) // line program offset
+
+	var header bytes.Buffer
+	header.Write([]byte{1, 1, 1, 0xfb, 14, 13})              // line base -5, line range 14, opcode base 13
+	header.Write([]byte{0, 1, 1, 1, 1, 0, 0, 0, 1, 0, 0, 1}) // standard opcode lengths
+	header.WriteByte(0)                                      // no include directories
+	header.WriteString(file + "\x00")
+	header.Write([]byte{0, 0, 0, 0})
+
+	program := []byte{0x00, 0x05, 0x02, 0, 0, 0, 0} // DW_LNE_set_address 0
+	addr, line := 0, 1
+	for _, l := range absLines {
+		program = append(program, 0x02, byte(l.offset-addr)) // DW_LNS_advance_pc
+		program = append(program, 0x03, byte(l.line-line))   // DW_LNS_advance_line
+		program = append(program, 0x01)                      // DW_LNS_copy
+		addr, line = l.offset, l.line
+	}
+	program = append(program, 0x02, byte(absCodeSize-addr), 0x00, 0x01, 0x01) // DW_LNE_end_sequence
+
+	var debugLine bytes.Buffer
+	debugLine.Write([]byte{0x04, 0x00})
+	binary.Write(&debugLine, binary.LittleEndian, uint32(header.Len()))
+	debugLine.Write(header.Bytes())
+	debugLine.Write(program)
+
+	withLength := func(b []byte) []byte {
+		return append(binary.LittleEndian.AppendUint32(nil, uint32(len(b))), b...)
+	}
+	ret := append([]byte{}, module...)
+	for _, sec := range []struct {
+		name string
+		data []byte
+	}{
+		{".debug_abbrev", abbrev},
+		{".debug_info", withLength(info.Bytes())},
+		{".debug_line", withLength(debugLine.Bytes())},
+	} {
+		payload := append([]byte{byte(len(sec.name))}, sec.name...)
+		payload = append(payload, sec.data...)
+		ret = append(ret, 0, byte(len(payload)))
+		ret = append(ret, payload...)
+	}
+	return ret
+}
+
+func TestWriteLCOV(t *testing.T) {
+	module, err := wat.Parse([]byte(absSource))
+	if err != nil {
+		t.Fatal(err)
+	}
+	vm, err := exec.NewVirtualMachine(withLineInfo(module, "abs.c"), exec.VMConfig{EnableCoverage: true}, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !vm.Module.HasLineInfo() {
+		t.Fatalf("no line info: %v", vm.Module.DebugInfoError())
+	}
+
+	abs, _ := vm.GetFunctionExport("abs")
+	for _, x := range []int64{-5, -1, 3} {
+		if _, err := vm.Run(abs, x); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	p, err := NewProfile(vm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Add(vm); err != nil {
+		t.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	if err := p.WriteLCOV(&out, "abs"); err != nil {
+		t.Fatal(err)
+	}
+
+	golden := filepath.Join("testdata", "abs.lcov")
+	if *update {
+		if err := ioutil.WriteFile(golden, out.Bytes(), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := ioutil.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(out.Bytes(), want) {
+		t.Errorf("got\n%s\nwant\n%s", out.Bytes(), want)
+	}
+}
+
+func TestWriteLCOVWithoutLineInfo(t *testing.T) {
+	vm, err := exec.NewVirtualMachine([]byte(absSource), exec.VMConfig{EnableCoverage: true}, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := NewProfile(vm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := p.WriteLCOV(ioutil.Discard, "abs"); err != ErrNoLineInfo {
+		t.Errorf("got error %v, want %v", err, ErrNoLineInfo)
+	}
+}
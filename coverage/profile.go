@@ -0,0 +1,244 @@
+// Package coverage aggregates the basic block counters collected by virtual
+// machines created with EnableCoverage, and renders them as text, lcov and
+// HTML reports.
+package coverage
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/perlin-network/life/compiler"
+	"github.com/perlin-network/life/exec"
+)
+
+const profileHeader = "mode: life-blocks"
+
+// Profile accumulates basic block execution counts of a module across runs.
+type Profile struct {
+	Module    *compiler.Module
+	Functions []Function // indexed by function ID
+}
+
+// Function is the coverage of a single function.
+type Function struct {
+	ID     int
+	Name   string
+	Blocks []Block // imported functions have no blocks
+}
+
+// Block is the coverage of a single basic block.
+type Block struct {
+	WasmOffsets []int // offsets of the wasm instructions in the block, in order
+	Count       uint64
+}
+
+// OffsetCount is the execution count of the wasm instruction at an offset.
+type OffsetCount struct {
+	WasmOffset int
+	FunctionID int
+	Count      uint64
+}
+
+// NewProfile creates an empty profile for the module run by vm, which must
+// have been created with EnableCoverage.
+func NewProfile(vm *exec.VirtualMachine) (*Profile, error) {
+	if vm.Coverage == nil {
+		return nil, errors.New("coverage is not enabled for this virtual machine")
+	}
+	for i := range vm.FunctionCode {
+		// The blocks of functions compiled lazily are only known once they are.
+		if err := vm.CompileFunction(i); err != nil {
+			return nil, err
+		}
+	}
+
+	p := &Profile{
+		Module:    vm.Module,
+		Functions: make([]Function, len(vm.FunctionCode)),
+	}
+	for i, code := range vm.FunctionCode {
+		f := &p.Functions[i]
+		f.ID = i
+		f.Name = vm.Module.FunctionName(i)
+		f.Blocks = make([]Block, len(code.CoverageBlocks))
+		for j, blk := range code.CoverageBlocks {
+			f.Blocks[j].WasmOffsets = blk.WasmOffsets
+		}
+	}
+	return p, nil
+}
+
+// Add adds the counters collected by vm to the profile. vm must run the same
+// module as the profile was created for.
+func (p *Profile) Add(vm *exec.VirtualMachine) error {
+	if err := p.checkShape(vm.Coverage); err != nil {
+		return err
+	}
+	for i, counters := range vm.Coverage {
+		for j, n := range counters {
+			p.Functions[i].Blocks[j].Count += n
+		}
+	}
+	return nil
+}
+
+// Merge adds the counts of another profile of the same module.
+func (p *Profile) Merge(other *Profile) error {
+	counters := make([][]uint64, len(other.Functions))
+	for i, f := range other.Functions {
+		counters[i] = make([]uint64, len(f.Blocks))
+		for j, blk := range f.Blocks {
+			counters[i][j] = blk.Count
+		}
+	}
+	if err := p.checkShape(counters); err != nil {
+		return err
+	}
+	for i := range counters {
+		for j, n := range counters[i] {
+			p.Functions[i].Blocks[j].Count += n
+		}
+	}
+	return nil
+}
+
+func (p *Profile) checkShape(counters [][]uint64) error {
+	if len(counters) != len(p.Functions) {
+		return fmt.Errorf("function count mismatch: got %d, expected %d", len(counters), len(p.Functions))
+	}
+	for i := range counters {
+		// A virtual machine compiling lazily has no counters for the
+		// functions it has not run.
+		if counters[i] != nil && len(counters[i]) != len(p.Functions[i].Blocks) {
+			return fmt.Errorf("block count mismatch in function %d: got %d, expected %d", i, len(counters[i]), len(p.Functions[i].Blocks))
+		}
+	}
+	return nil
+}
+
+// Covered returns the number of blocks of the function that were executed.
+func (f *Function) Covered() int {
+	n := 0
+	for _, blk := range f.Blocks {
+		if blk.Count != 0 {
+			n++
+		}
+	}
+	return n
+}
+
+// Totals returns the number of executed blocks and the total number of blocks.
+func (p *Profile) Totals() (covered int, total int) {
+	for i := range p.Functions {
+		covered += p.Functions[i].Covered()
+		total += len(p.Functions[i].Blocks)
+	}
+	return
+}
+
+// OffsetCounts returns the execution count of every instrumented wasm
+// instruction, sorted by offset.
+func (p *Profile) OffsetCounts() []OffsetCount {
+	ret := make([]OffsetCount, 0)
+	for _, f := range p.Functions {
+		for _, blk := range f.Blocks {
+			for _, off := range blk.WasmOffsets {
+				ret = append(ret, OffsetCount{
+					WasmOffset: off,
+					FunctionID: f.ID,
+					Count:      blk.Count,
+				})
+			}
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].WasmOffset < ret[j].WasmOffset
+	})
+	return ret
+}
+
+// WriteFunctions writes a per-function summary of block coverage.
+func (p *Profile) WriteFunctions(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	for i := range p.Functions {
+		f := &p.Functions[i]
+		if len(f.Blocks) == 0 {
+			continue
+		}
+		fmt.Fprintf(bw, "%d\t%s\t%d/%d\t%.1f%%\n", f.ID, f.Name, f.Covered(), len(f.Blocks), percent(f.Covered(), len(f.Blocks)))
+	}
+	covered, total := p.Totals()
+	fmt.Fprintf(bw, "total:\t\t%d/%d\t%.1f%%\n", covered, total, percent(covered, total))
+	return bw.Flush()
+}
+
+// WriteOffsets writes the execution count of every instrumented wasm instruction.
+func (p *Profile) WriteOffsets(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	for _, oc := range p.OffsetCounts() {
+		fmt.Fprintf(bw, "0x%x\t%d\t%d\n", oc.WasmOffset, oc.FunctionID, oc.Count)
+	}
+	return bw.Flush()
+}
+
+// WriteTo serializes the block counts so that they can be merged later with ReadProfile.
+func (p *Profile) WriteTo(w io.Writer) (int64, error) {
+	bw := bufio.NewWriter(w)
+	cw := &countingWriter{w: bw}
+	fmt.Fprintln(cw, profileHeader)
+	for _, f := range p.Functions {
+		for j, blk := range f.Blocks {
+			fmt.Fprintf(cw, "%d %d %d\n", f.ID, j, blk.Count)
+		}
+	}
+	return cw.n, bw.Flush()
+}
+
+// ReadProfile reads block counts written by WriteTo and adds them to the profile.
+func (p *Profile) ReadProfile(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	if !scanner.Scan() || strings.TrimSpace(scanner.Text()) != profileHeader {
+		return errors.New("not a coverage profile")
+	}
+
+	line := 1
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		var functionID, blockID int
+		var count uint64
+		if _, err := fmt.Sscanf(text, "%d %d %d", &functionID, &blockID, &count); err != nil {
+			return fmt.Errorf("line %d: %v", line, err)
+		}
+		if functionID < 0 || functionID >= len(p.Functions) || blockID < 0 || blockID >= len(p.Functions[functionID].Blocks) {
+			return fmt.Errorf("line %d: block %d of function %d does not exist", line, blockID, functionID)
+		}
+		p.Functions[functionID].Blocks[blockID].Count += count
+	}
+	return scanner.Err()
+}
+
+func percent(n, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(n) * 100 / float64(total)
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	w.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/perlin-network/life/debugger/dap"
+	"github.com/perlin-network/life/exec"
+)
+
+// dapMain implements `life dap [flags]`.
+func dapMain(args []string) {
+	fs := flag.NewFlagSet("dap", flag.ExitOnError)
+	vf := registerVMFlags(fs)
+	listenFlag := fs.String("listen", "", "serve on a local TCP address (e.g. 127.0.0.1:4711) instead of stdio")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: life dap [flags]")
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Launched modules are instantiated as by life run; -entry picks the entry")
+		fmt.Fprintln(os.Stderr, "function of launch requests that do not name one.")
+		fmt.Fprintln(os.Stderr)
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	if fs.NArg() != 0 {
+		fs.Usage()
+		os.Exit(2)
+	}
+	if err := vf.check(); err != nil {
+		fmt.Fprintf(os.Stderr, "life dap: %v\n", err)
+		os.Exit(2)
+	}
+
+	server := &dap.Server{
+		Config:    vf.config(),
+		GasPolicy: vf.newGasPolicy(),
+		NewResolver: func() exec.ImportResolver {
+			return vf.newResolver(nil)
+		},
+		Entry: *vf.entry,
+	}
+
+	var err error
+	if *listenFlag != "" {
+		fmt.Fprintf(os.Stderr, "Listening on %s\n", *listenFlag)
+		err = server.ListenAndServe(*listenFlag)
+	} else {
+		err = server.Serve(os.Stdin, os.Stdout)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "life dap: %v\n", err)
+		os.Exit(1)
+	}
+}
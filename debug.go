@@ -0,0 +1,59 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/perlin-network/life/debugger"
+)
+
+// debugMain implements `life debug [flags] module.wasm [args...]`.
+func debugMain(args []string) {
+	fs := flag.NewFlagSet("debug", flag.ExitOnError)
+	vf := registerVMFlags(fs)
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: life debug [flags] module.wasm [args...]")
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "The module is instantiated as by life run, and its arguments are written")
+		fmt.Fprintln(os.Stderr, "the same way. Functions run in the interpreter while debugged.")
+		fmt.Fprintln(os.Stderr)
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	if fs.NArg() < 1 {
+		fs.Usage()
+		os.Exit(2)
+	}
+	if err := vf.check(); err != nil {
+		fmt.Fprintf(os.Stderr, "life debug: %v\n", err)
+		os.Exit(2)
+	}
+
+	if err := debug(vf, fs.Arg(0), fs.Args()[1:]); err != nil {
+		fmt.Fprintf(os.Stderr, "life debug: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// debug starts a debugger REPL on stdin and stdout, stopped before the entry
+// function of the module runs.
+func debug(vf *vmFlags, path string, args []string) error {
+	wasiArgs, args := vf.splitArgs(path, args)
+
+	vm, err := vf.instantiate(path, wasiArgs)
+	if err != nil {
+		return err
+	}
+	entryID, _, err := vf.entryFunction(vm)
+	if err != nil {
+		return err
+	}
+	params, err := parseArgs(args, vm.Module.FunctionSignature(entryID).ParamTypes)
+	if err != nil {
+		return err
+	}
+
+	return debugger.New(vm).REPL(os.Stdin, os.Stdout, entryID, params...)
+}
@@ -0,0 +1,809 @@
+// Package dap implements a Debug Adapter Protocol server for the debugger,
+// allowing DAP-capable editors to launch and debug WebAssembly modules.
+package dap
+
+import (
+	"bufio"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/perlin-network/life/compiler"
+	"github.com/perlin-network/life/debugger"
+	"github.com/perlin-network/life/exec"
+)
+
+const (
+	threadID = 1
+
+	// memoryReference is the memory reference of linear memory.
+	memoryReference = "memory"
+
+	globalsReference = 1
+)
+
+// Server serves Debug Adapter Protocol sessions.
+type Server struct {
+	Config    exec.VMConfig
+	GasPolicy compiler.GasPolicy
+
+	// NewResolver creates the import resolver for a launched module.
+	NewResolver func() exec.ImportResolver
+
+	// Entry is the entry function of launch requests that do not name one;
+	// app_main if empty.
+	Entry string
+}
+
+// ListenAndServe accepts TCP connections on addr and serves one session at a time.
+func (s *Server) ListenAndServe(addr string) error {
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	defer l.Close()
+
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return err
+		}
+		s.Serve(conn, conn)
+		conn.Close()
+	}
+}
+
+// Serve serves a single session, reading requests from r and writing
+// responses and events to w. Returns once the client disconnects.
+func (s *Server) Serve(r io.Reader, w io.Writer) error {
+	sess := &session{
+		server: s,
+		out:    w,
+	}
+	defer sess.shutdown()
+
+	reader := bufio.NewReader(r)
+	for {
+		raw, err := ReadMessage(reader)
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+
+		var req Request
+		if err := json.Unmarshal(raw, &req); err != nil {
+			return err
+		}
+		if req.Type != "request" {
+			continue
+		}
+
+		if done := sess.handle(&req); done {
+			return nil
+		}
+	}
+}
+
+type session struct {
+	server *Server
+
+	writeMu sync.Mutex
+	out     io.Writer
+	seq     int
+
+	mu          sync.Mutex
+	dbg         *debugger.Debugger
+	launch      LaunchArguments
+	entryID     int
+	configured  bool
+	running     bool
+	terminated  bool
+	functionBPs []int
+	insnBPs     []int
+}
+
+func (s *session) send(msg interface{}) {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	s.seq++
+	switch m := msg.(type) {
+	case *Response:
+		m.Seq = s.seq
+	case *Event:
+		m.Seq = s.seq
+	}
+	WriteMessage(s.out, msg)
+}
+
+func (s *session) respond(req *Request, body interface{}) {
+	s.send(&Response{
+		Type:       "response",
+		RequestSeq: req.Seq,
+		Success:    true,
+		Command:    req.Command,
+		Body:       body,
+	})
+}
+
+func (s *session) fail(req *Request, err error) {
+	s.send(&Response{
+		Type:       "response",
+		RequestSeq: req.Seq,
+		Success:    false,
+		Command:    req.Command,
+		Message:    err.Error(),
+	})
+}
+
+func (s *session) event(name string, body interface{}) {
+	s.send(&Event{
+		Type:  "event",
+		Event: name,
+		Body:  body,
+	})
+}
+
+func (s *session) output(category, text string) {
+	s.event("output", map[string]interface{}{
+		"category": category,
+		"output":   text,
+	})
+}
+
+func (s *session) shutdown() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.terminated = true
+	if s.dbg != nil && s.running {
+		s.dbg.Pause()
+	}
+}
+
+// handle handles a single request. Returns true if the session should end.
+func (s *session) handle(req *Request) bool {
+	var err error
+
+	switch req.Command {
+	case "initialize":
+		s.respond(req, Capabilities{
+			SupportsConfigurationDoneRequest: true,
+			SupportsFunctionBreakpoints:      true,
+			SupportsInstructionBreakpoints:   true,
+			SupportsReadMemoryRequest:        true,
+			SupportsDisassembleRequest:       true,
+			SupportsTerminateRequest:         true,
+		})
+		return false
+
+	case "launch":
+		err = s.handleLaunch(req)
+	case "setBreakpoints":
+		err = s.handleSetBreakpoints(req)
+	case "setFunctionBreakpoints":
+		err = s.handleSetFunctionBreakpoints(req)
+	case "setInstructionBreakpoints":
+		err = s.handleSetInstructionBreakpoints(req)
+	case "setExceptionBreakpoints":
+		s.respond(req, map[string]interface{}{"breakpoints": []Breakpoint{}})
+	case "configurationDone":
+		err = s.handleConfigurationDone(req)
+	case "threads":
+		s.respond(req, map[string]interface{}{
+			"threads": []Thread{{ID: threadID, Name: "main"}},
+		})
+	case "stackTrace":
+		err = s.handleStackTrace(req)
+	case "scopes":
+		err = s.handleScopes(req)
+	case "variables":
+		err = s.handleVariables(req)
+	case "readMemory":
+		err = s.handleReadMemory(req)
+	case "disassemble":
+		err = s.handleDisassemble(req)
+	case "continue", "next", "stepIn", "stepOut", "stepBack":
+		err = s.handleResume(req)
+	case "pause":
+		err = s.handlePause(req)
+	case "terminate":
+		s.shutdown()
+		s.respond(req, nil)
+		s.event("terminated", nil)
+	case "disconnect":
+		s.shutdown()
+		s.respond(req, nil)
+		return true
+	default:
+		err = fmt.Errorf("unsupported request: %s", req.Command)
+	}
+
+	if err != nil {
+		s.fail(req, err)
+	}
+	return false
+}
+
+func (s *session) handleLaunch(req *Request) error {
+	var args LaunchArguments
+	if err := json.Unmarshal(req.Arguments, &args); err != nil {
+		return err
+	}
+	if args.Program == "" {
+		return errors.New("missing program")
+	}
+	if args.Entry == "" {
+		args.Entry = s.server.Entry
+	}
+	if args.Entry == "" {
+		args.Entry = "app_main"
+	}
+
+	input, err := ioutil.ReadFile(args.Program)
+	if err != nil {
+		return err
+	}
+
+	var resolver exec.ImportResolver
+	if s.server.NewResolver != nil {
+		resolver = s.server.NewResolver()
+	} else {
+		resolver = &exec.NopResolver{}
+	}
+
+	vm, err := exec.NewVirtualMachine(input, s.server.Config, resolver, s.server.GasPolicy)
+	if err != nil {
+		return err
+	}
+
+	entryID, ok := vm.GetFunctionExport(args.Entry)
+	if !ok {
+		if entryID, ok = debugger.New(vm).LookupFunction(args.Entry); !ok {
+			return fmt.Errorf("entry function %s not found", args.Entry)
+		}
+	}
+
+	s.mu.Lock()
+	s.dbg = debugger.New(vm)
+	s.launch = args
+	s.entryID = entryID
+	s.mu.Unlock()
+
+	s.respond(req, nil)
+	s.event("process", map[string]interface{}{
+		"name":           programName(args.Program),
+		"isLocalProcess": true,
+		"startMethod":    "launch",
+	})
+	s.event("initialized", nil)
+	return nil
+}
+
+func (s *session) debugger() (*debugger.Debugger, error) {
+	if s.dbg == nil {
+		return nil, errors.New("no program launched")
+	}
+	return s.dbg, nil
+}
+
+func (s *session) stoppedDebugger() (*debugger.Debugger, error) {
+	d, err := s.debugger()
+	if err != nil {
+		return nil, err
+	}
+	if s.running {
+		return nil, errors.New("program is running")
+	}
+	if !d.Running() {
+		return nil, errors.New("program is not being run")
+	}
+	return d, nil
+}
+
+func (s *session) handleSetBreakpoints(req *Request) error {
+	var args SetBreakpointsArguments
+	if err := json.Unmarshal(req.Arguments, &args); err != nil {
+		return err
+	}
+
+	ret := make([]Breakpoint, len(args.Breakpoints))
+	for i, bp := range args.Breakpoints {
+		ret[i] = Breakpoint{
+			Verified: false,
+			Message:  "source breakpoints are not supported for this module",
+			Source:   &args.Source,
+			Line:     bp.Line,
+		}
+	}
+	s.respond(req, map[string]interface{}{"breakpoints": ret})
+	return nil
+}
+
+func (s *session) handleSetFunctionBreakpoints(req *Request) error {
+	var args SetFunctionBreakpointsArguments
+	if err := json.Unmarshal(req.Arguments, &args); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	d, err := s.debugger()
+	if err != nil {
+		return err
+	}
+
+	for _, id := range s.functionBPs {
+		d.Remove(id)
+	}
+	s.functionBPs = nil
+
+	ret := make([]Breakpoint, len(args.Breakpoints))
+	for i, fbp := range args.Breakpoints {
+		bp, err := d.AddFunctionBreakpoint(fbp.Name)
+		if err != nil {
+			ret[i] = Breakpoint{Verified: false, Message: err.Error()}
+			continue
+		}
+		s.functionBPs = append(s.functionBPs, bp.ID)
+		ret[i] = Breakpoint{
+			ID:                   bp.ID,
+			Verified:             true,
+			InstructionReference: formatInstructionReference(bp.Location),
+		}
+	}
+	s.respond(req, map[string]interface{}{"breakpoints": ret})
+	return nil
+}
+
+func (s *session) handleSetInstructionBreakpoints(req *Request) error {
+	var args SetInstructionBreakpointsArguments
+	if err := json.Unmarshal(req.Arguments, &args); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	d, err := s.debugger()
+	if err != nil {
+		return err
+	}
+
+	for _, id := range s.insnBPs {
+		d.Remove(id)
+	}
+	s.insnBPs = nil
+
+	ret := make([]Breakpoint, len(args.Breakpoints))
+	for i, ibp := range args.Breakpoints {
+		loc, err := parseInstructionReference(ibp.InstructionReference)
+		if err == nil {
+			var bp *debugger.Breakpoint
+			bp, err = d.AddBreakpoint(loc.FunctionID, loc.IP+ibp.Offset)
+			if err == nil {
+				s.insnBPs = append(s.insnBPs, bp.ID)
+				ret[i] = Breakpoint{
+					ID:                   bp.ID,
+					Verified:             true,
+					InstructionReference: formatInstructionReference(bp.Location),
+				}
+				continue
+			}
+		}
+		ret[i] = Breakpoint{Verified: false, Message: err.Error()}
+	}
+	s.respond(req, map[string]interface{}{"breakpoints": ret})
+	return nil
+}
+
+func (s *session) handleConfigurationDone(req *Request) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	d, err := s.debugger()
+	if err != nil {
+		return err
+	}
+	if s.configured {
+		return errors.New("already configured")
+	}
+	s.configured = true
+
+	if err := d.Start(s.entryID, s.launch.Args...); err != nil {
+		return err
+	}
+	s.respond(req, nil)
+
+	if s.launch.StopOnEntry {
+		// The first step of a freshly started execution stops before its first instruction.
+		s.execute(d.Step, "entry")
+	} else {
+		s.execute(d.Continue, "")
+	}
+	return nil
+}
+
+// execute runs fn on a separate goroutine and reports how execution stopped.
+// Must be called with s.mu held.
+func (s *session) execute(fn func() *debugger.Stop, reason string) {
+	s.running = true
+
+	go func() {
+		stop := fn()
+
+		s.mu.Lock()
+		s.running = false
+		terminated := s.terminated
+		s.mu.Unlock()
+
+		if terminated {
+			return
+		}
+
+		if stop.Reason == debugger.StopExited {
+			exitCode := 0
+			if stop.Err != nil {
+				exitCode = 1
+				s.output("stderr", fmt.Sprintf("Execution failed: %v\n", stop.Err))
+			} else {
+				s.output("console", fmt.Sprintf("Execution finished: return value = %d\n", stop.ReturnValue))
+			}
+			s.event("exited", map[string]interface{}{"exitCode": exitCode})
+			s.event("terminated", nil)
+			return
+		}
+
+		body := map[string]interface{}{
+			"threadId":          threadID,
+			"allThreadsStopped": true,
+		}
+		switch {
+		case reason != "":
+			body["reason"] = reason
+		case stop.Reason == debugger.StopBreakpoint:
+			body["reason"] = "breakpoint"
+			body["hitBreakpointIds"] = []int{stop.Breakpoint.ID}
+		case stop.Reason == debugger.StopWatchpoint:
+			body["reason"] = "data breakpoint"
+			body["description"] = fmt.Sprintf("%d bytes written at 0x%x", stop.Write.Size, stop.Write.Addr)
+		case stop.Reason == debugger.StopPause:
+			body["reason"] = "pause"
+		default:
+			body["reason"] = "step"
+		}
+		s.event("stopped", body)
+	}()
+}
+
+func (s *session) handleResume(req *Request) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	d, err := s.stoppedDebugger()
+	if err != nil {
+		return err
+	}
+
+	var fn func() *debugger.Stop
+	switch req.Command {
+	case "continue":
+		fn = d.Continue
+	case "next":
+		fn = d.StepOver
+	case "stepIn":
+		fn = d.Step
+	case "stepOut":
+		fn = d.StepOut
+	default:
+		return fmt.Errorf("%s is not supported", req.Command)
+	}
+
+	if req.Command == "continue" {
+		s.respond(req, map[string]interface{}{"allThreadsContinued": true})
+	} else {
+		s.respond(req, nil)
+	}
+	s.execute(fn, "")
+	return nil
+}
+
+func (s *session) handlePause(req *Request) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	d, err := s.debugger()
+	if err != nil {
+		return err
+	}
+	if s.running {
+		d.Pause()
+	}
+	s.respond(req, nil)
+	return nil
+}
+
+func (s *session) handleStackTrace(req *Request) error {
+	var args StackTraceArguments
+	if err := json.Unmarshal(req.Arguments, &args); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	d, err := s.stoppedDebugger()
+	if err != nil {
+		return err
+	}
+
+	stack := d.CallStack()
+	frames := make([]StackFrame, 0, len(stack))
+	for i, f := range stack {
+		if i < args.StartFrame {
+			continue
+		}
+		if args.Levels > 0 && len(frames) >= args.Levels {
+			break
+		}
+		loc := debugger.Location{FunctionID: f.FunctionID, IP: f.IP}
+		frame := StackFrame{
+			ID:                          f.Depth + 1,
+			Name:                        f.Name,
+			InstructionPointerReference: formatInstructionReference(loc),
+			PresentationHint:            "normal",
+		}
+		if f.Source != nil {
+			frame.Source = &Source{Name: filepath.Base(f.Source.File), Path: f.Source.File}
+			frame.Line = f.Source.Line
+			frame.Column = f.Source.Column
+			if frame.Column == 0 {
+				frame.Column = 1
+			}
+		}
+		frames = append(frames, frame)
+	}
+	s.respond(req, map[string]interface{}{
+		"stackFrames": frames,
+		"totalFrames": len(stack),
+	})
+	return nil
+}
+
+func (s *session) handleScopes(req *Request) error {
+	var args ScopesArguments
+	if err := json.Unmarshal(req.Arguments, &args); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	d, err := s.stoppedDebugger()
+	if err != nil {
+		return err
+	}
+	frame, err := d.Frame(args.FrameID - 1)
+	if err != nil {
+		return err
+	}
+
+	s.respond(req, map[string]interface{}{
+		"scopes": []Scope{
+			{
+				Name:               "Locals",
+				PresentationHint:   "locals",
+				VariablesReference: args.FrameID * 4,
+				NamedVariables:     len(frame.Locals),
+			},
+			{
+				Name:               "Registers",
+				PresentationHint:   "registers",
+				VariablesReference: args.FrameID*4 + 1,
+				NamedVariables:     len(frame.Regs),
+			},
+			{
+				Name:               "Globals",
+				VariablesReference: globalsReference,
+				NamedVariables:     len(d.Globals()),
+			},
+		},
+	})
+	return nil
+}
+
+func (s *session) handleVariables(req *Request) error {
+	var args VariablesArguments
+	if err := json.Unmarshal(req.Arguments, &args); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	d, err := s.stoppedDebugger()
+	if err != nil {
+		return err
+	}
+
+	var values []int64
+	var prefix string
+	var names func(i int) string
+
+	if args.VariablesReference == globalsReference {
+		values = d.Globals()
+		prefix = "global"
+	} else {
+		frame, err := d.Frame(args.VariablesReference/4 - 1)
+		if err != nil {
+			return err
+		}
+		switch args.VariablesReference % 4 {
+		case 0:
+			values = frame.Locals
+			prefix = "local"
+			functionID := frame.FunctionID
+			names = func(i int) string {
+				return d.VM.Module.LocalName(functionID, i)
+			}
+		case 1:
+			values = frame.Regs
+			prefix = "%"
+		default:
+			return fmt.Errorf("invalid variables reference %d", args.VariablesReference)
+		}
+	}
+
+	vars := make([]Variable, len(values))
+	for i, v := range values {
+		name := prefix + strconv.Itoa(i)
+		if names != nil && names(i) != "" {
+			name = names(i)
+		}
+		vars[i] = Variable{
+			Name:  name,
+			Value: fmt.Sprintf("%d (0x%x)", v, uint64(v)),
+			Type:  "i64",
+		}
+	}
+	s.respond(req, map[string]interface{}{"variables": vars})
+	return nil
+}
+
+func (s *session) handleReadMemory(req *Request) error {
+	var args ReadMemoryArguments
+	if err := json.Unmarshal(req.Arguments, &args); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	d, err := s.stoppedDebugger()
+	if err != nil {
+		return err
+	}
+
+	base := int64(0)
+	if args.MemoryReference != memoryReference {
+		if base, err = strconv.ParseInt(args.MemoryReference, 0, 64); err != nil {
+			return fmt.Errorf("invalid memory reference %s", args.MemoryReference)
+		}
+	}
+	addr := base + args.Offset
+	memSize := int64(len(d.VM.Memory))
+	if addr < 0 || addr > memSize {
+		s.respond(req, map[string]interface{}{
+			"address":         fmt.Sprintf("0x%x", addr),
+			"unreadableBytes": args.Count,
+		})
+		return nil
+	}
+
+	count := int64(args.Count)
+	unreadable := int64(0)
+	if addr+count > memSize {
+		unreadable = addr + count - memSize
+		count = memSize - addr
+	}
+	data, err := d.ReadMemory(uint32(addr), int(count))
+	if err != nil {
+		return err
+	}
+	s.respond(req, map[string]interface{}{
+		"address":         fmt.Sprintf("0x%x", addr),
+		"data":            base64.StdEncoding.EncodeToString(data),
+		"unreadableBytes": unreadable,
+	})
+	return nil
+}
+
+func (s *session) handleDisassemble(req *Request) error {
+	var args DisassembleArguments
+	if err := json.Unmarshal(req.Arguments, &args); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	d, err := s.debugger()
+	if err != nil {
+		return err
+	}
+	loc, err := parseInstructionReference(args.MemoryReference)
+	if err != nil {
+		return err
+	}
+	if loc.FunctionID < 0 || loc.FunctionID >= len(d.VM.FunctionCode) {
+		return fmt.Errorf("function %d does not exist", loc.FunctionID)
+	}
+	if err := d.VM.CompileFunction(loc.FunctionID); err != nil {
+		return err
+	}
+	insns, err := compiler.DecodeBytecode(d.VM.FunctionCode[loc.FunctionID].Bytes)
+	if err != nil {
+		return err
+	}
+
+	start := 0
+	for i, ins := range insns {
+		if ins.Offset <= loc.IP+args.Offset {
+			start = i
+		}
+	}
+	start += args.InstructionOffset
+
+	name := d.FunctionName(loc.FunctionID)
+	ret := make([]DisassembledInstruction, 0, args.InstructionCount)
+	for i := start; i < start+args.InstructionCount; i++ {
+		if i < 0 || i >= len(insns) {
+			ret = append(ret, DisassembledInstruction{
+				Address:     formatInstructionReference(debugger.Location{FunctionID: loc.FunctionID, IP: -1}),
+				Instruction: "??",
+			})
+			continue
+		}
+		ins := insns[i]
+		dis := DisassembledInstruction{
+			Address:     formatInstructionReference(debugger.Location{FunctionID: loc.FunctionID, IP: ins.Offset}),
+			Instruction: ins.String(),
+		}
+		if i == 0 {
+			dis.Symbol = name
+		}
+		ret = append(ret, dis)
+	}
+	s.respond(req, map[string]interface{}{"instructions": ret})
+	return nil
+}
+
+// formatInstructionReference encodes a location as an address-like string,
+// with the function ID in the upper 32 bits and the bytecode offset in the lower 32 bits.
+func formatInstructionReference(loc debugger.Location) string {
+	return fmt.Sprintf("0x%x", uint64(loc.FunctionID)<<32|uint64(uint32(loc.IP)))
+}
+
+func parseInstructionReference(ref string) (debugger.Location, error) {
+	v, err := strconv.ParseUint(strings.TrimSpace(ref), 0, 64)
+	if err != nil {
+		return debugger.Location{}, fmt.Errorf("invalid instruction reference %s", ref)
+	}
+	return debugger.Location{FunctionID: int(v >> 32), IP: int(uint32(v))}, nil
+}
+
+// programName returns a display name for the launched program.
+func programName(path string) string {
+	return filepath.Base(path)
+}
@@ -0,0 +1,185 @@
+package dap
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/perlin-network/life/wat"
+)
+
+// message holds the fields of any response or event.
+type message struct {
+	Seq        int             `json:"seq"`
+	Type       string          `json:"type"`
+	RequestSeq int             `json:"request_seq"`
+	Success    bool            `json:"success"`
+	Command    string          `json:"command"`
+	Message    string          `json:"message"`
+	Event      string          `json:"event"`
+	Body       json.RawMessage `json:"body"`
+}
+
+type client struct {
+	t   *testing.T
+	w   io.Writer
+	r   *bufio.Reader
+	seq int
+}
+
+func (c *client) send(command string, args interface{}) int {
+	c.t.Helper()
+	raw, err := json.Marshal(args)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	c.seq++
+	if err := WriteMessage(c.w, &Request{Seq: c.seq, Type: "request", Command: command, Arguments: raw}); err != nil {
+		c.t.Fatal(err)
+	}
+	return c.seq
+}
+
+func (c *client) read() *message {
+	c.t.Helper()
+	raw, err := ReadMessage(c.r)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	var msg message
+	if err := json.Unmarshal(raw, &msg); err != nil {
+		c.t.Fatal(err)
+	}
+	return &msg
+}
+
+// request sends a request and reads its response, which must be successful,
+// into body.
+func (c *client) request(command string, args interface{}, body interface{}) {
+	c.t.Helper()
+	seq := c.send(command, args)
+	msg := c.read()
+	if msg.Type != "response" || msg.RequestSeq != seq || msg.Command != command {
+		c.t.Fatalf("got %s %s%s for request %d, want the response to %s %d", msg.Type, msg.Command, msg.Event, msg.RequestSeq, command, seq)
+	}
+	if !msg.Success {
+		c.t.Fatalf("%s failed: %s", command, msg.Message)
+	}
+	if body != nil {
+		if err := json.Unmarshal(msg.Body, body); err != nil {
+			c.t.Fatal(err)
+		}
+	}
+}
+
+// expectEvent reads an event, which must be the named one, into body.
+func (c *client) expectEvent(name string, body interface{}) {
+	c.t.Helper()
+	msg := c.read()
+	if msg.Type != "event" || msg.Event != name {
+		c.t.Fatalf("got %s %s%s, want event %s", msg.Type, msg.Command, msg.Event, name)
+	}
+	if body != nil {
+		if err := json.Unmarshal(msg.Body, body); err != nil {
+			c.t.Fatal(err)
+		}
+	}
+}
+
+func TestSession(t *testing.T) {
+	src, err := ioutil.ReadFile(filepath.Join("..", "..", "tests", "fib.wat"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	input, err := wat.Parse(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	program := filepath.Join(t.TempDir(), "fib.wasm")
+	if err := ioutil.WriteFile(program, input, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	requests, requestWriter := io.Pipe()
+	responseReader, responses := io.Pipe()
+	done := make(chan error, 1)
+	go func() {
+		done <- (&Server{}).Serve(requests, responses)
+		responses.Close()
+	}()
+	c := &client{t: t, w: requestWriter, r: bufio.NewReader(responseReader)}
+
+	var caps Capabilities
+	c.request("initialize", map[string]interface{}{"adapterID": "life"}, &caps)
+	if !caps.SupportsConfigurationDoneRequest || !caps.SupportsFunctionBreakpoints {
+		t.Errorf("capabilities %+v lack configurationDone or function breakpoints", caps)
+	}
+
+	c.request("launch", LaunchArguments{Program: program, Entry: "1", Args: []int64{10}}, nil)
+	var process struct{ Name string }
+	c.expectEvent("process", &process)
+	if process.Name != "fib.wasm" {
+		t.Errorf("process is named %q, want fib.wasm", process.Name)
+	}
+	c.expectEvent("initialized", nil)
+
+	var bps struct{ Breakpoints []Breakpoint }
+	c.request("setFunctionBreakpoints", SetFunctionBreakpointsArguments{
+		Breakpoints: []FunctionBreakpoint{{Name: "1"}, {Name: "missing"}},
+	}, &bps)
+	if len(bps.Breakpoints) != 2 || !bps.Breakpoints[0].Verified || bps.Breakpoints[1].Verified {
+		t.Fatalf("breakpoints %+v, want only the first one verified", bps.Breakpoints)
+	}
+	if ref := bps.Breakpoints[0].InstructionReference; ref != "0x100000000" {
+		t.Errorf("breakpoint at %s, want 0x100000000", ref)
+	}
+
+	type stopped struct {
+		Reason           string
+		ThreadID         int
+		HitBreakpointIds []int
+	}
+	c.request("configurationDone", nil, nil)
+	var stop stopped
+	c.expectEvent("stopped", &stop)
+	if stop.Reason != "breakpoint" || stop.ThreadID != threadID || len(stop.HitBreakpointIds) != 1 || stop.HitBreakpointIds[0] != bps.Breakpoints[0].ID {
+		t.Fatalf("stopped %+v, want on breakpoint %d", stop, bps.Breakpoints[0].ID)
+	}
+
+	// The breakpoint is hit again in the first recursive call.
+	c.request("continue", map[string]interface{}{"threadId": threadID}, nil)
+	c.expectEvent("stopped", &stop)
+
+	var trace struct {
+		StackFrames []StackFrame
+		TotalFrames int
+	}
+	c.request("stackTrace", StackTraceArguments{ThreadID: threadID}, &trace)
+	if trace.TotalFrames != 2 || len(trace.StackFrames) != 2 {
+		t.Fatalf("stack trace %+v, want 2 frames", trace)
+	}
+	inner, outer := trace.StackFrames[0], trace.StackFrames[1]
+	if inner.ID != 2 || inner.InstructionPointerReference != "0x100000000" {
+		t.Errorf("innermost frame %+v, want ID 2 at 0x100000000", inner)
+	}
+	if outer.ID != 1 || outer.Name != inner.Name {
+		t.Errorf("outer frame %+v, want ID 1 in %s", outer, inner.Name)
+	}
+	if loc, err := parseInstructionReference(outer.InstructionPointerReference); err != nil || loc.FunctionID != 1 || loc.IP == 0 {
+		t.Errorf("outer frame at %s, want the call in function 1", outer.InstructionPointerReference)
+	}
+
+	c.request("stackTrace", StackTraceArguments{ThreadID: threadID, StartFrame: 1, Levels: 1}, &trace)
+	if trace.TotalFrames != 2 || len(trace.StackFrames) != 1 || trace.StackFrames[0].ID != 1 {
+		t.Errorf("stack trace from frame 1 %+v, want the outer frame only", trace)
+	}
+
+	c.request("disconnect", nil, nil)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	requestWriter.Close()
+}
@@ -0,0 +1,516 @@
+// Package debugger implements an interactive debugger on top of exec.VirtualMachine.
+package debugger
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/perlin-network/life/compiler"
+	"github.com/perlin-network/life/exec"
+	"github.com/perlin-network/life/utils"
+)
+
+// Location identifies an instruction by its function ID and bytecode offset.
+type Location struct {
+	FunctionID int
+	IP         int
+}
+
+// Breakpoint suspends execution before the instruction at its location is executed.
+type Breakpoint struct {
+	ID       int
+	Location Location
+	Hits     int
+}
+
+// Watchpoint suspends execution after a write to linear memory within [Start, End).
+type Watchpoint struct {
+	ID    int
+	Start uint32
+	End   uint32
+	Hits  int
+}
+
+// StopReason denotes why execution was suspended.
+type StopReason int
+
+const (
+	StopBreakpoint StopReason = iota
+	StopWatchpoint
+	StopStep
+	StopPause
+	StopExited
+)
+
+func (r StopReason) String() string {
+	switch r {
+	case StopBreakpoint:
+		return "breakpoint"
+	case StopWatchpoint:
+		return "watchpoint"
+	case StopStep:
+		return "step"
+	case StopPause:
+		return "pause"
+	case StopExited:
+		return "exited"
+	default:
+		return "unknown"
+	}
+}
+
+// Stop describes a suspension of execution.
+type Stop struct {
+	Reason   StopReason
+	Location Location
+
+	Breakpoint *Breakpoint
+	Watchpoint *Watchpoint
+	Write      *MemoryWrite
+
+	ReturnValue int64
+	Err         error
+	CallStack   []StackFrame // the call stack at the point of failure, if Err is set
+}
+
+// MemoryWrite describes a write which triggered a watchpoint.
+type MemoryWrite struct {
+	Addr     uint32
+	Size     int
+	Location Location
+	OldValue []byte
+	NewValue []byte
+}
+
+// StackFrame describes a single call frame.
+type StackFrame struct {
+	Depth int // 0 is the outermost frame
+	exec.FrameInfo
+}
+
+type stepMode int
+
+const (
+	stepNone stepMode = iota
+	stepInstr
+	stepOver
+	stepOut
+)
+
+// Debugger controls the execution of a VirtualMachine.
+type Debugger struct {
+	VM *exec.VirtualMachine
+
+	// breakpoints and watchpoints hold immutable snapshots, so that they can be
+	// modified from another goroutine while the VM is running.
+	breakpoints atomic.Value // []*Breakpoint
+	watchpoints atomic.Value // []*Watchpoint
+	editMu      sync.Mutex
+	nextID      int
+
+	running   bool
+	stop      *Stop
+	mode      stepMode
+	modeDepth int
+
+	skipBreak    bool
+	skipWatch    bool
+	pendingWrite *MemoryWrite
+	pendingWatch *Watchpoint
+
+	pauseRequested int32
+}
+
+// New creates a debugger for the given virtual machine.
+func New(vm *exec.VirtualMachine) *Debugger {
+	d := &Debugger{
+		VM:     vm,
+		nextID: 1,
+	}
+	d.breakpoints.Store([]*Breakpoint{})
+	d.watchpoints.Store([]*Watchpoint{})
+	return d
+}
+
+// LookupFunction resolves a function by name (from Module.FunctionNames),
+// export name or numeric index.
+func (d *Debugger) LookupFunction(name string) (int, bool) {
+	for id, n := range d.VM.Module.FunctionNames {
+		if n == name {
+			return id, true
+		}
+	}
+	if id, ok := d.VM.GetFunctionExport(name); ok {
+		return id, true
+	}
+	var id int
+	if _, err := fmt.Sscanf(name, "%d", &id); err == nil && id >= 0 && id < len(d.VM.FunctionCode) {
+		return id, true
+	}
+	return -1, false
+}
+
+// FunctionName returns a printable name for the given function ID.
+func (d *Debugger) FunctionName(functionID int) string {
+	return d.VM.Module.FunctionName(functionID)
+}
+
+// AddBreakpoint sets a breakpoint at the given bytecode offset of a function.
+func (d *Debugger) AddBreakpoint(functionID int, ip int) (*Breakpoint, error) {
+	if functionID < 0 || functionID >= len(d.VM.FunctionCode) {
+		return nil, fmt.Errorf("function %d does not exist", functionID)
+	}
+	if err := d.checkOffset(functionID, ip); err != nil {
+		return nil, err
+	}
+
+	d.editMu.Lock()
+	defer d.editMu.Unlock()
+
+	bp := &Breakpoint{
+		ID:       d.nextID,
+		Location: Location{FunctionID: functionID, IP: ip},
+	}
+	d.nextID++
+	old := d.Breakpoints()
+	d.breakpoints.Store(append(old[:len(old):len(old)], bp))
+	return bp, nil
+}
+
+// AddFunctionBreakpoint sets a breakpoint on the entry of the named function.
+func (d *Debugger) AddFunctionBreakpoint(name string) (*Breakpoint, error) {
+	functionID, ok := d.LookupFunction(name)
+	if !ok {
+		return nil, fmt.Errorf("function %s not found", name)
+	}
+	return d.AddBreakpoint(functionID, 0)
+}
+
+// AddWatchpoint sets a watchpoint on writes to linear memory within [addr, addr+size).
+func (d *Debugger) AddWatchpoint(addr uint32, size uint32) (*Watchpoint, error) {
+	if size == 0 {
+		return nil, errors.New("watchpoint size must be non-zero")
+	}
+	if uint64(addr)+uint64(size) > 1<<32 {
+		return nil, errors.New("watchpoint range out of bounds")
+	}
+
+	d.editMu.Lock()
+	defer d.editMu.Unlock()
+
+	wp := &Watchpoint{
+		ID:    d.nextID,
+		Start: addr,
+		End:   addr + size,
+	}
+	d.nextID++
+	old := d.Watchpoints()
+	d.watchpoints.Store(append(old[:len(old):len(old)], wp))
+	return wp, nil
+}
+
+// Remove removes the breakpoint or watchpoint with the given ID.
+func (d *Debugger) Remove(id int) bool {
+	d.editMu.Lock()
+	defer d.editMu.Unlock()
+
+	bps := d.Breakpoints()
+	for i, bp := range bps {
+		if bp.ID == id {
+			newBps := make([]*Breakpoint, 0, len(bps)-1)
+			newBps = append(append(newBps, bps[:i]...), bps[i+1:]...)
+			d.breakpoints.Store(newBps)
+			return true
+		}
+	}
+	wps := d.Watchpoints()
+	for i, wp := range wps {
+		if wp.ID == id {
+			newWps := make([]*Watchpoint, 0, len(wps)-1)
+			newWps = append(append(newWps, wps[:i]...), wps[i+1:]...)
+			d.watchpoints.Store(newWps)
+			return true
+		}
+	}
+	return false
+}
+
+// Breakpoints returns all breakpoints.
+func (d *Debugger) Breakpoints() []*Breakpoint {
+	return d.breakpoints.Load().([]*Breakpoint)
+}
+
+// Watchpoints returns all watchpoints.
+func (d *Debugger) Watchpoints() []*Watchpoint {
+	return d.watchpoints.Load().([]*Watchpoint)
+}
+
+func (d *Debugger) checkOffset(functionID int, ip int) error {
+	if err := d.VM.CompileFunction(functionID); err != nil {
+		return err
+	}
+	code := d.VM.FunctionCode[functionID].Bytes
+	insns, err := compiler.DecodeBytecode(code)
+	if err != nil {
+		return err
+	}
+	for _, ins := range insns {
+		if ins.Offset == ip {
+			return nil
+		}
+	}
+	return fmt.Errorf("offset %d is not an instruction boundary in function %d", ip, functionID)
+}
+
+// Running returns whether a function is currently being executed.
+func (d *Debugger) Running() bool {
+	return d.running
+}
+
+// Start prepares the execution of the given function. Execution begins
+// with the first call to Continue or one of the step methods.
+func (d *Debugger) Start(entryID int, params ...int64) (retErr error) {
+	defer utils.CatchPanic(&retErr)
+
+	if d.running {
+		return errors.New("already running")
+	}
+
+	vm := d.VM
+	if vm.ExitError != nil {
+		vm.Reset()
+	}
+	vm.Ignite(entryID, params...)
+	vm.DebugHook = d.hook
+
+	d.running = true
+	d.skipBreak = false
+	d.skipWatch = false
+	d.pendingWrite = nil
+	d.pendingWatch = nil
+	atomic.StoreInt32(&d.pauseRequested, 0)
+	return nil
+}
+
+// Continue resumes execution until a breakpoint or watchpoint is hit, or the function returns.
+func (d *Debugger) Continue() *Stop {
+	return d.resume(stepNone)
+}
+
+// Step executes a single instruction.
+func (d *Debugger) Step() *Stop {
+	return d.resume(stepInstr)
+}
+
+// StepOver executes a single instruction, running any call it makes to completion.
+func (d *Debugger) StepOver() *Stop {
+	return d.resume(stepOver)
+}
+
+// StepOut resumes execution until the current function returns.
+func (d *Debugger) StepOut() *Stop {
+	return d.resume(stepOut)
+}
+
+// Pause requests the suspension of a running execution before its next instruction.
+// It may be called from any goroutine.
+func (d *Debugger) Pause() {
+	atomic.StoreInt32(&d.pauseRequested, 1)
+}
+
+func (d *Debugger) resume(mode stepMode) *Stop {
+	vm := d.VM
+	if !d.running {
+		return &Stop{Reason: StopExited, Err: errors.New("not running")}
+	}
+
+	d.mode = mode
+	d.modeDepth = vm.CurrentFrame
+	d.stop = nil
+
+	for !vm.Exited {
+		vm.Execute()
+		if vm.Delegate != nil {
+			func() {
+				defer func() {
+					if err := recover(); err != nil {
+						vm.Exited = true
+						vm.ExitError = vm.NewTrap(err)
+					}
+				}()
+				vm.Delegate()
+			}()
+			vm.Delegate = nil
+		}
+		if d.stop != nil {
+			return d.stop
+		}
+		if vm.GasLimitExceeded {
+			vm.Exited = true
+			vm.ExitError = vm.NewTrap("gas limit exceeded")
+		}
+	}
+
+	d.running = false
+	vm.DebugHook = nil
+
+	stop := &Stop{
+		Reason:      StopExited,
+		Location:    Location{FunctionID: -1},
+		ReturnValue: vm.ReturnValue,
+	}
+	if vm.ExitError != nil {
+		stop.Err = utils.UnifyError(vm.ExitError)
+		stop.CallStack = d.CallStack()
+	}
+	return stop
+}
+
+func (d *Debugger) hook(vm *exec.VirtualMachine, frame *exec.Frame) bool {
+	loc := Location{FunctionID: frame.FunctionID, IP: frame.IP}
+
+	if d.pendingWrite != nil {
+		w, wp := d.pendingWrite, d.pendingWatch
+		d.pendingWrite, d.pendingWatch = nil, nil
+		w.NewValue = d.memorySlice(w.Addr, w.Size)
+		wp.Hits++
+		return d.suspend(&Stop{Reason: StopWatchpoint, Location: loc, Watchpoint: wp, Write: w})
+	}
+
+	if atomic.CompareAndSwapInt32(&d.pauseRequested, 1, 0) {
+		return d.suspend(&Stop{Reason: StopPause, Location: loc})
+	}
+
+	skipBreak, skipWatch := d.skipBreak, d.skipWatch
+	d.skipBreak, d.skipWatch = false, false
+
+	if !skipBreak {
+		switch d.mode {
+		case stepInstr:
+			return d.suspend(&Stop{Reason: StopStep, Location: loc})
+		case stepOver:
+			if vm.CurrentFrame <= d.modeDepth {
+				return d.suspend(&Stop{Reason: StopStep, Location: loc})
+			}
+		case stepOut:
+			if vm.CurrentFrame < d.modeDepth {
+				return d.suspend(&Stop{Reason: StopStep, Location: loc})
+			}
+		}
+
+		for _, bp := range d.Breakpoints() {
+			if bp.Location == loc {
+				bp.Hits++
+				return d.suspend(&Stop{Reason: StopBreakpoint, Location: loc, Breakpoint: bp})
+			}
+		}
+	}
+
+	if watchpoints := d.Watchpoints(); !skipWatch && len(watchpoints) > 0 {
+		ins := compiler.DecodeInstr(frame.Code, frame.IP)
+		if ok, size := ins.IsStore(); ok {
+			addr := uint64(uint32(frame.Regs[ins.Values[0]])) + uint64(ins.Immediates[1])
+			for _, wp := range watchpoints {
+				if addr < uint64(wp.End) && addr+uint64(size) > uint64(wp.Start) {
+					d.pendingWatch = wp
+					d.pendingWrite = &MemoryWrite{
+						Addr:     uint32(addr),
+						Size:     size,
+						Location: loc,
+						OldValue: d.memorySlice(uint32(addr), size),
+					}
+					break
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+func (d *Debugger) suspend(stop *Stop) bool {
+	d.stop = stop
+	d.skipBreak = true
+	d.skipWatch = stop.Reason == StopWatchpoint
+	return true
+}
+
+func (d *Debugger) memorySlice(addr uint32, size int) []byte {
+	end := int(addr) + size
+	if end > len(d.VM.Memory) {
+		return nil
+	}
+	ret := make([]byte, size)
+	copy(ret, d.VM.Memory[int(addr):end])
+	return ret
+}
+
+// CallStack returns the call stack, innermost frame first.
+func (d *Debugger) CallStack() []StackFrame {
+	trace := d.VM.StackTrace()
+	ret := make([]StackFrame, len(trace))
+	for i, f := range trace {
+		ret[i] = StackFrame{
+			Depth:     len(trace) - 1 - i,
+			FrameInfo: f,
+		}
+	}
+	return ret
+}
+
+// SourceLocation returns the wasm offset of the instruction at loc, and its
+// source location if the module carries DWARF line info.
+func (d *Debugger) SourceLocation(loc Location) (int, *compiler.SourceLocation) {
+	if loc.FunctionID < 0 || loc.FunctionID >= len(d.VM.FunctionCode) {
+		return -1, nil
+	}
+	wasmOffset := d.VM.FunctionCode[loc.FunctionID].WasmOffset(loc.IP)
+	if src, ok := d.VM.Module.SourceLocation(wasmOffset); ok {
+		return wasmOffset, &src
+	}
+	return wasmOffset, nil
+}
+
+// Frame returns the call frame at the given depth, where 0 is the outermost frame.
+func (d *Debugger) Frame(depth int) (*exec.Frame, error) {
+	if !d.running || depth < 0 || depth > d.VM.CurrentFrame {
+		return nil, fmt.Errorf("no frame at depth %d", depth)
+	}
+	return &d.VM.CallStack[depth], nil
+}
+
+// CurrentInstr decodes the next instruction to be executed in the given frame.
+func (d *Debugger) CurrentInstr(frame *exec.Frame) (compiler.BytecodeInstr, error) {
+	return decodeInstr(frame.Code, frame.IP)
+}
+
+func decodeInstr(code []byte, ip int) (_ins compiler.BytecodeInstr, retErr error) {
+	defer utils.CatchPanic(&retErr)
+	return compiler.DecodeInstr(code, ip), nil
+}
+
+// ReadMemory returns a copy of linear memory within [addr, addr+size).
+func (d *Debugger) ReadMemory(addr uint32, size int) ([]byte, error) {
+	if size < 0 || uint64(addr)+uint64(size) > uint64(len(d.VM.Memory)) {
+		return nil, fmt.Errorf("memory range [%d, %d) out of bounds (memory size %d)", addr, uint64(addr)+uint64(size), len(d.VM.Memory))
+	}
+	return d.memorySlice(addr, size), nil
+}
+
+// Globals returns the values of all globals.
+func (d *Debugger) Globals() []int64 {
+	return d.VM.Globals
+}
+
+// FunctionIDs returns the IDs of all named functions, sorted.
+func (d *Debugger) FunctionIDs() []int {
+	ret := make([]int, 0, len(d.VM.Module.FunctionNames))
+	for id := range d.VM.Module.FunctionNames {
+		ret = append(ret, id)
+	}
+	sort.Ints(ret)
+	return ret
+}
@@ -0,0 +1,202 @@
+package debugger
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/perlin-network/life/compiler"
+	"github.com/perlin-network/life/compiler/opcodes"
+	"github.com/perlin-network/life/exec"
+	"github.com/perlin-network/life/wat"
+)
+
+// fib is the ID of the recursive function of tests/fib.wat.
+const fib = 1
+
+func newDebugger(t *testing.T, src []byte) *Debugger {
+	t.Helper()
+	input, err := wat.Parse(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vm, err := exec.NewVirtualMachine(input, exec.VMConfig{}, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return New(vm)
+}
+
+func newFibDebugger(t *testing.T) *Debugger {
+	t.Helper()
+	src, err := ioutil.ReadFile(filepath.Join("..", "tests", "fib.wat"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return newDebugger(t, src)
+}
+
+func expectStop(t *testing.T, stop *Stop, reason StopReason, loc Location) {
+	t.Helper()
+	if stop.Err != nil {
+		t.Fatalf("stopped with error: %v", stop.Err)
+	}
+	if stop.Reason != reason || stop.Location != loc {
+		t.Fatalf("stopped on %s at %+v, want %s at %+v", stop.Reason, stop.Location, reason, loc)
+	}
+}
+
+func expectExit(t *testing.T, stop *Stop, ret int64) {
+	t.Helper()
+	if stop.Err != nil {
+		t.Fatalf("exited with error: %v", stop.Err)
+	}
+	if stop.Reason != StopExited || stop.ReturnValue != ret {
+		t.Fatalf("stopped on %s returning %d, want exit returning %d", stop.Reason, stop.ReturnValue, ret)
+	}
+}
+
+func TestBreakpoint(t *testing.T) {
+	d := newFibDebugger(t)
+	bp, err := d.AddFunctionBreakpoint("1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Start(0); err != nil {
+		t.Fatal(err)
+	}
+
+	// fib(35) calls fib(34), which calls fib(33).
+	for n := int64(35); n >= 33; n-- {
+		stop := d.Continue()
+		expectStop(t, stop, StopBreakpoint, Location{FunctionID: fib})
+		if stop.Breakpoint != bp {
+			t.Fatalf("hit breakpoint %d, want %d", stop.Breakpoint.ID, bp.ID)
+		}
+		stack := d.CallStack()
+		if depth := stack[0].Depth; depth != int(36-n) {
+			t.Errorf("fib(%d) is at depth %d, want %d", n, depth, 36-n)
+		}
+		frame, err := d.Frame(stack[0].Depth)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if frame.Locals[0] != n {
+			t.Errorf("stopped in fib(%d), want fib(%d)", frame.Locals[0], n)
+		}
+	}
+	if bp.Hits != 3 {
+		t.Errorf("breakpoint hit %d times, want 3", bp.Hits)
+	}
+
+	if _, err := d.AddBreakpoint(fib, 1); err == nil {
+		t.Error("breakpoint set inside an instruction")
+	}
+}
+
+func TestStep(t *testing.T) {
+	d := newFibDebugger(t)
+	if err := d.Start(fib, 10); err != nil {
+		t.Fatal(err)
+	}
+
+	insns, err := compiler.DecodeBytecode(d.VM.FunctionCode[fib].Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, ins := range insns[:4] {
+		expectStop(t, d.Step(), StopStep, Location{FunctionID: fib, IP: ins.Offset})
+	}
+
+	expectExit(t, d.Continue(), 55)
+	if d.Running() {
+		t.Error("still running after exit")
+	}
+}
+
+func TestFinish(t *testing.T) {
+	d := newFibDebugger(t)
+	bp, err := d.AddBreakpoint(fib, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Start(fib, 4); err != nil {
+		t.Fatal(err)
+	}
+
+	expectStop(t, d.Continue(), StopBreakpoint, Location{FunctionID: fib})
+	expectStop(t, d.Continue(), StopBreakpoint, Location{FunctionID: fib})
+	if d.VM.CurrentFrame != 1 {
+		t.Fatalf("stopped in frame %d, want 1", d.VM.CurrentFrame)
+	}
+	d.Remove(bp.ID)
+
+	// Finishing fib(3) returns to fib(4), right after the call.
+	stop := d.StepOut()
+	if stop.Reason != StopStep || stop.Location.FunctionID != fib || d.VM.CurrentFrame != 0 {
+		t.Fatalf("stopped on %s at %+v in frame %d, want a step in frame 0", stop.Reason, stop.Location, d.VM.CurrentFrame)
+	}
+	insns, err := compiler.DecodeBytecode(d.VM.FunctionCode[fib].Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	returnedFrom := false
+	for _, ins := range insns {
+		if ins.Offset+ins.Len == stop.Location.IP {
+			returnedFrom = ins.Op == opcodes.Call
+		}
+	}
+	if !returnedFrom {
+		t.Errorf("stopped at offset %d, which does not follow a call", stop.Location.IP)
+	}
+
+	expectExit(t, d.Continue(), 3)
+}
+
+func TestWatchpoint(t *testing.T) {
+	d := newDebugger(t, []byte(`
+(module
+    (memory 1)
+    (func (result i32)
+        i32.const 8
+        i32.const 1
+        i32.store
+        i32.const 16
+        i32.const 7
+        i32.store
+        i32.const 18
+        i32.const -1
+        i32.store8
+        i32.const 16
+        i32.load
+    )
+)`))
+	wp, err := d.AddWatchpoint(16, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Start(0); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []MemoryWrite{
+		{Addr: 16, Size: 4, OldValue: []byte{0, 0, 0, 0}, NewValue: []byte{7, 0, 0, 0}},
+		{Addr: 18, Size: 1, OldValue: []byte{0}, NewValue: []byte{0xff}},
+	} {
+		stop := d.Continue()
+		if stop.Reason != StopWatchpoint || stop.Watchpoint != wp {
+			t.Fatalf("stopped on %s, want watchpoint %d", stop.Reason, wp.ID)
+		}
+		w := stop.Write
+		if w.Addr != want.Addr || w.Size != want.Size || !bytes.Equal(w.OldValue, want.OldValue) || !bytes.Equal(w.NewValue, want.NewValue) {
+			t.Errorf("write of %d bytes at %d from %x to %x, want %d bytes at %d from %x to %x",
+				w.Size, w.Addr, w.OldValue, w.NewValue, want.Size, want.Addr, want.OldValue, want.NewValue)
+		}
+	}
+
+	expectExit(t, d.Continue(), 0xff0007)
+	if wp.Hits != 2 {
+		t.Errorf("watchpoint hit %d times, want 2", wp.Hits)
+	}
+}
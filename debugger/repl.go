@@ -0,0 +1,361 @@
+package debugger
+
+import (
+	"bufio"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const replHelp = `Commands:
+  run [args...]             start the entry function with integer arguments
+  break <func> [offset]     set a breakpoint on a function (name or index), optionally at a bytecode offset
+  watch <addr> [size]       break on writes to memory within [addr, addr+size)
+  delete <id>               remove a breakpoint or watchpoint
+  info                      list breakpoints and watchpoints
+  continue, c               continue execution
+  step, s                   execute a single instruction
+  next, n                   step over calls
+  finish                    run until the current function returns
+  backtrace, bt             show the call stack
+  frame <depth>             select a call frame
+  locals                    show locals of the selected frame
+  regs                      show registers of the selected frame
+  globals                   show globals
+  mem <addr> [size]         dump linear memory
+  disasm [count]            disassemble instructions at the current location
+  functions                 list named functions
+  quit, q                   exit the debugger
+`
+
+// REPL runs an interactive debugging session reading commands from in and
+// writing output to out. entryID and params are used by the `run` command
+// when no arguments are given.
+func (d *Debugger) REPL(in io.Reader, out io.Writer, entryID int, params ...int64) error {
+	scanner := bufio.NewScanner(in)
+	selected := -1
+	lastLine := ""
+
+	for {
+		fmt.Fprint(out, "(life) ")
+		if !scanner.Scan() {
+			fmt.Fprintln(out)
+			return scanner.Err()
+		}
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			line = lastLine
+		}
+		lastLine = line
+		args := strings.Fields(line)
+		if len(args) == 0 {
+			continue
+		}
+
+		var stop *Stop
+
+		switch args[0] {
+		case "help", "h":
+			fmt.Fprint(out, replHelp)
+
+		case "run", "r":
+			runParams := params
+			if len(args) > 1 {
+				runParams = make([]int64, 0, len(args)-1)
+				for _, arg := range args[1:] {
+					v, err := strconv.ParseInt(arg, 0, 64)
+					if err != nil {
+						fmt.Fprintf(out, "invalid argument %s: %v\n", arg, err)
+						runParams = nil
+						break
+					}
+					runParams = append(runParams, v)
+				}
+				if runParams == nil {
+					continue
+				}
+			}
+			if err := d.Start(entryID, runParams...); err != nil {
+				fmt.Fprintf(out, "cannot start: %v\n", err)
+				continue
+			}
+			selected = -1
+			stop = d.Continue()
+
+		case "break", "b":
+			if len(args) < 2 {
+				fmt.Fprintln(out, "usage: break <func> [offset]")
+				continue
+			}
+			var bp *Breakpoint
+			var err error
+			if len(args) > 2 {
+				functionID, ok := d.LookupFunction(args[1])
+				if !ok {
+					fmt.Fprintf(out, "function %s not found\n", args[1])
+					continue
+				}
+				ip, perr := strconv.ParseInt(args[2], 0, 64)
+				if perr != nil {
+					fmt.Fprintf(out, "invalid offset %s\n", args[2])
+					continue
+				}
+				bp, err = d.AddBreakpoint(functionID, int(ip))
+			} else {
+				bp, err = d.AddFunctionBreakpoint(args[1])
+			}
+			if err != nil {
+				fmt.Fprintln(out, err)
+				continue
+			}
+			fmt.Fprintf(out, "Breakpoint %d at %s\n", bp.ID, d.formatLocation(bp.Location))
+
+		case "watch", "w":
+			if len(args) < 2 {
+				fmt.Fprintln(out, "usage: watch <addr> [size]")
+				continue
+			}
+			addr, err := strconv.ParseUint(args[1], 0, 32)
+			if err != nil {
+				fmt.Fprintf(out, "invalid address %s\n", args[1])
+				continue
+			}
+			size := uint64(4)
+			if len(args) > 2 {
+				if size, err = strconv.ParseUint(args[2], 0, 32); err != nil {
+					fmt.Fprintf(out, "invalid size %s\n", args[2])
+					continue
+				}
+			}
+			wp, err := d.AddWatchpoint(uint32(addr), uint32(size))
+			if err != nil {
+				fmt.Fprintln(out, err)
+				continue
+			}
+			fmt.Fprintf(out, "Watchpoint %d on [0x%x, 0x%x)\n", wp.ID, wp.Start, wp.End)
+
+		case "delete", "d":
+			if len(args) < 2 {
+				fmt.Fprintln(out, "usage: delete <id>")
+				continue
+			}
+			id, err := strconv.Atoi(args[1])
+			if err != nil || !d.Remove(id) {
+				fmt.Fprintf(out, "no breakpoint or watchpoint %s\n", args[1])
+			}
+
+		case "info", "i":
+			for _, bp := range d.Breakpoints() {
+				fmt.Fprintf(out, "%d\tbreakpoint\t%s\thits=%d\n", bp.ID, d.formatLocation(bp.Location), bp.Hits)
+			}
+			for _, wp := range d.Watchpoints() {
+				fmt.Fprintf(out, "%d\twatchpoint\t[0x%x, 0x%x)\thits=%d\n", wp.ID, wp.Start, wp.End, wp.Hits)
+			}
+
+		case "continue", "c", "step", "s", "next", "n", "finish", "out":
+			if !d.Running() {
+				fmt.Fprintln(out, "the program is not being run")
+				continue
+			}
+			switch args[0] {
+			case "continue", "c":
+				stop = d.Continue()
+			case "step", "s":
+				stop = d.Step()
+			case "next", "n":
+				stop = d.StepOver()
+			case "finish", "out":
+				stop = d.StepOut()
+			}
+
+		case "backtrace", "bt":
+			for _, f := range d.CallStack() {
+				marker := " "
+				if f.Depth == d.selectedDepth(selected) {
+					marker = "*"
+				}
+				fmt.Fprintf(out, "%s#%d %s\n", marker, f.Depth, f.FrameInfo)
+			}
+
+		case "frame", "f":
+			if len(args) < 2 {
+				fmt.Fprintln(out, "usage: frame <depth>")
+				continue
+			}
+			depth, err := strconv.Atoi(args[1])
+			if err != nil {
+				fmt.Fprintf(out, "invalid depth %s\n", args[1])
+				continue
+			}
+			frame, err := d.Frame(depth)
+			if err != nil {
+				fmt.Fprintln(out, err)
+				continue
+			}
+			selected = depth
+			fmt.Fprintf(out, "#%d %s\n", depth, d.formatLocation(Location{frame.FunctionID, frame.IP}))
+
+		case "locals", "regs":
+			frame, err := d.Frame(d.selectedDepth(selected))
+			if err != nil {
+				fmt.Fprintln(out, err)
+				continue
+			}
+			values := frame.Locals
+			prefix := "local"
+			if args[0] == "regs" {
+				values = frame.Regs
+				prefix = "%"
+			}
+			for i, v := range values {
+				name := ""
+				if args[0] == "locals" {
+					if n := d.VM.Module.LocalName(frame.FunctionID, i); n != "" {
+						name = " (" + n + ")"
+					}
+				}
+				fmt.Fprintf(out, "%s%d%s = %d (0x%x)\n", prefix, i, name, v, uint64(v))
+			}
+
+		case "globals":
+			for i, v := range d.Globals() {
+				fmt.Fprintf(out, "global%d = %d (0x%x)\n", i, v, uint64(v))
+			}
+
+		case "mem", "x":
+			if len(args) < 2 {
+				fmt.Fprintln(out, "usage: mem <addr> [size]")
+				continue
+			}
+			addr, err := strconv.ParseUint(args[1], 0, 32)
+			if err != nil {
+				fmt.Fprintf(out, "invalid address %s\n", args[1])
+				continue
+			}
+			size := 64
+			if len(args) > 2 {
+				if size, err = strconv.Atoi(args[2]); err != nil {
+					fmt.Fprintf(out, "invalid size %s\n", args[2])
+					continue
+				}
+			}
+			data, err := d.ReadMemory(uint32(addr), size)
+			if err != nil {
+				fmt.Fprintln(out, err)
+				continue
+			}
+			d.dumpMemory(out, uint32(addr), data)
+
+		case "disasm", "l":
+			frame, err := d.Frame(d.selectedDepth(selected))
+			if err != nil {
+				fmt.Fprintln(out, err)
+				continue
+			}
+			count := 8
+			if len(args) > 1 {
+				if count, err = strconv.Atoi(args[1]); err != nil {
+					fmt.Fprintf(out, "invalid count %s\n", args[1])
+					continue
+				}
+			}
+			ip := frame.IP
+			for i := 0; i < count && ip < len(frame.Code); i++ {
+				ins, err := decodeInstr(frame.Code, ip)
+				if err != nil {
+					fmt.Fprintln(out, err)
+					break
+				}
+				fmt.Fprintf(out, "  %6d: %s\n", ip, ins)
+				ip += ins.Len
+			}
+
+		case "functions":
+			for _, id := range d.FunctionIDs() {
+				fmt.Fprintf(out, "%d\t%s\n", id, d.FunctionName(id))
+			}
+
+		case "quit", "q", "exit":
+			return nil
+
+		default:
+			fmt.Fprintf(out, "unknown command %s; type `help` for a list of commands\n", args[0])
+		}
+
+		if stop != nil {
+			selected = -1
+			d.printStop(out, stop)
+		}
+	}
+}
+
+func (d *Debugger) selectedDepth(selected int) int {
+	if selected >= 0 {
+		return selected
+	}
+	return d.VM.CurrentFrame
+}
+
+func (d *Debugger) formatLocation(loc Location) string {
+	return fmt.Sprintf("%s (func %d) +%d", d.FunctionName(loc.FunctionID), loc.FunctionID, loc.IP)
+}
+
+func (d *Debugger) printStop(out io.Writer, stop *Stop) {
+	switch stop.Reason {
+	case StopExited:
+		if stop.Err != nil {
+			fmt.Fprintf(out, "Execution failed: %v\n", stop.Err)
+			for _, f := range stop.CallStack {
+				fmt.Fprintf(out, "  #%d %s\n", f.Depth, f.FrameInfo)
+			}
+		} else {
+			fmt.Fprintf(out, "Execution finished: return value = %d\n", stop.ReturnValue)
+		}
+		return
+	case StopBreakpoint:
+		fmt.Fprintf(out, "Breakpoint %d hit at %s\n", stop.Breakpoint.ID, d.formatLocation(stop.Location))
+	case StopWatchpoint:
+		w := stop.Write
+		fmt.Fprintf(out, "Watchpoint %d hit: %d bytes written at 0x%x by %s\n", stop.Watchpoint.ID, w.Size, w.Addr, d.formatLocation(w.Location))
+		fmt.Fprintf(out, "  old: %s\n  new: %s\n", hex.EncodeToString(w.OldValue), hex.EncodeToString(w.NewValue))
+	case StopStep, StopPause:
+		fmt.Fprintf(out, "Stopped at %s\n", d.formatLocation(stop.Location))
+	}
+	if _, src := d.SourceLocation(stop.Location); src != nil {
+		fmt.Fprintf(out, "  at %s\n", src)
+	}
+
+	frame := &d.VM.CallStack[d.VM.CurrentFrame]
+	if ins, err := d.CurrentInstr(frame); err == nil {
+		fmt.Fprintf(out, "  %6d: %s\n", frame.IP, ins)
+	}
+}
+
+func (d *Debugger) dumpMemory(out io.Writer, addr uint32, data []byte) {
+	for i := 0; i < len(data); i += 16 {
+		end := i + 16
+		if end > len(data) {
+			end = len(data)
+		}
+		line := data[i:end]
+		ascii := make([]byte, len(line))
+		for j, b := range line {
+			if b >= 0x20 && b < 0x7f {
+				ascii[j] = b
+			} else {
+				ascii[j] = '.'
+			}
+		}
+		fmt.Fprintf(out, "%08x  %-47s  |%s|\n", int(addr)+i, spacedHex(line), ascii)
+	}
+}
+
+func spacedHex(b []byte) string {
+	parts := make([]string, len(b))
+	for i, x := range b {
+		parts[i] = hex.EncodeToString([]byte{x})
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strconv"
+
+	"github.com/go-interpreter/wagon/wasm"
+	"github.com/perlin-network/life/compiler"
+	"github.com/perlin-network/life/wat"
+)
+
+// disasmMain implements `life disasm [flags] module.wasm`, which dumps the
+// compiled form of functions at one stage of the compiler pipeline.
+func disasmMain(args []string) {
+	fs := flag.NewFlagSet("disasm", flag.ExitOnError)
+	stageFlag := fs.String("stage", "bytecode", "stage to dump: ssa, cfg, regalloc or bytecode")
+	formatFlag := fs.String("format", "text", "output format: text, or dot for the cfg stage")
+	funcFlag := fs.String("func", "", "function to dump, by index, name or export name (default all)")
+	gasFlag := fs.Bool("gas", false, "insert gas counters")
+	coverageFlag := fs.Bool("coverage", false, "insert coverage counters")
+	noFloatFlag := fs.Bool("no-fp", false, "disable floating point")
+	optFlag := fs.Int("O", 0, fmt.Sprintf("optimization level, 0 to %d", compiler.MaxOptLevel))
+	fs.Parse(args)
+
+	usage := func() {
+		fmt.Fprintln(os.Stderr, "usage: life disasm [-stage ssa|cfg|regalloc|bytecode] [-format text|dot] [-func f] [-gas] [-coverage] [-no-fp] [-O level] module.wasm")
+		os.Exit(2)
+	}
+	if fs.NArg() != 1 || *optFlag < 0 || *optFlag > compiler.MaxOptLevel {
+		usage()
+	}
+	switch *stageFlag {
+	case "ssa", "cfg", "regalloc", "bytecode":
+	default:
+		usage()
+	}
+	switch *formatFlag {
+	case "text":
+	case "dot":
+		if *stageFlag != "cfg" {
+			fmt.Fprintln(os.Stderr, "life disasm: the dot format is only available for the cfg stage")
+			os.Exit(2)
+		}
+	default:
+		usage()
+	}
+
+	input, err := ioutil.ReadFile(fs.Arg(0))
+	if err != nil {
+		panic(err)
+	}
+	if wat.IsText(input) {
+		if input, err = wat.Parse(input); err != nil {
+			panic(err)
+		}
+	}
+
+	m, err := compiler.LoadModule(input)
+	if err != nil {
+		panic(err)
+	}
+	m.DisableFloatingPoint = *noFloatFlag
+	m.EnableCoverage = *coverageFlag
+	m.OptLevel = *optFlag
+
+	var gp compiler.GasPolicy
+	if *gasFlag {
+		gp = &compiler.SimpleGasPolicy{GasPerInstruction: 1}
+	}
+
+	numFuncImports := 0
+	if m.Base.Import != nil {
+		for _, e := range m.Base.Import.Entries {
+			if e.Type.Kind() == wasm.ExternalFunction {
+				numFuncImports++
+			}
+		}
+	}
+
+	var ids []int
+	if *funcFlag != "" {
+		id, ok := lookupFunction(m, *funcFlag)
+		if !ok {
+			fmt.Fprintf(os.Stderr, "life disasm: function %s not found\n", *funcFlag)
+			os.Exit(1)
+		}
+		ids = []int{id}
+	} else {
+		for i := range m.Base.FunctionIndexSpace {
+			ids = append(ids, numFuncImports+i)
+		}
+	}
+
+	out := os.Stdout
+	if *formatFlag == "dot" {
+		fmt.Fprintln(out, "digraph cfg {")
+	}
+	for n, id := range ids {
+		stages, err := m.CompileFunctionStages(id, gp)
+		if err != nil {
+			panic(err)
+		}
+		name := m.FunctionName(id)
+
+		if *formatFlag == "dot" {
+			err = stages.CFG.WriteDot(out, name, fmt.Sprintf("f%d", id))
+		} else {
+			if n > 0 {
+				fmt.Fprintln(out)
+			}
+			err = writeStage(out, *stageFlag, id, name, stages)
+		}
+		if err != nil {
+			panic(err)
+		}
+	}
+	if *formatFlag == "dot" {
+		fmt.Fprintln(out, "}")
+	}
+}
+
+func writeStage(w io.Writer, stage string, id int, name string, stages *compiler.FunctionStages) error {
+	fmt.Fprintf(w, "func %d %s", id, name)
+	if stage == "regalloc" || stage == "bytecode" {
+		fmt.Fprintf(w, " (%d registers)", stages.Code.NumRegs)
+	}
+	fmt.Fprintln(w, ":")
+
+	switch stage {
+	case "ssa":
+		return compiler.WriteInstrs(w, stages.SSA)
+	case "cfg":
+		return stages.CFG.WriteText(w)
+	case "regalloc":
+		return compiler.WriteInstrs(w, stages.RegAlloc)
+	default:
+		return compiler.WriteBytecode(w, &stages.Code)
+	}
+}
+
+// lookupFunction finds a function by its index, its name or the name it is
+// exported as.
+func lookupFunction(m *compiler.Module, s string) (int, bool) {
+	if id, err := strconv.Atoi(s); err == nil {
+		return id, true
+	}
+	if m.Base.Export != nil {
+		if e, ok := m.Base.Export.Entries[s]; ok && e.Kind == wasm.ExternalFunction {
+			return int(e.Index), true
+		}
+	}
+	for id, name := range m.FunctionNames {
+		if name == s {
+			return id, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,109 @@
+package exec
+
+import (
+	"fmt"
+
+	"github.com/perlin-network/life/compiler"
+	"github.com/perlin-network/life/compiler/opcodes"
+)
+
+// decodedCode is the bytecode of a function decoded into 32-bit words once at
+// load time, which Execute runs without decoding or bounds-checking each
+// operand again. Each instruction is its opcode and target register followed
+// by its operands in the order of the bytecode, one word each and two for
+// 64-bit immediates (low word first); jump targets are word indices.
+//
+// The bytecode stays the portable form of the code, and Frame.IP keeps
+// referring to it.
+type decodedCode struct {
+	words []uint32
+	ips   []uint32 // bytecode offset of the instruction each word belongs to, followed by the length of the bytecode
+}
+
+// decodeCode decodes bytecode produced by the compiler. Panics on malformed
+// bytecode.
+func decodeCode(code []byte) decodedCode {
+	var ret decodedCode
+	pcs := make(map[int]int) // word index of the instruction at each bytecode offset
+
+	for ip := 0; ip < len(code); {
+		ins := compiler.DecodeInstr(code, ip)
+		pcs[ip] = len(ret.words)
+
+		ret.words = append(ret.words, uint32(ins.Op), ins.Target)
+		for pos := ip + 5; pos < ip+ins.Len; pos += 4 {
+			ret.words = append(ret.words, LE.Uint32(code[pos:pos+4]))
+		}
+		for len(ret.ips) < len(ret.words) {
+			ret.ips = append(ret.ips, uint32(ip))
+		}
+
+		ip += ins.Len
+	}
+	pcs[len(code)] = len(ret.words)
+	ret.ips = append(ret.ips, uint32(len(code)))
+
+	for pc := 0; pc < len(ret.words); pc = ret.next(pc) {
+		targets := jumpTargets(ret.words[pc:ret.next(pc)])
+		for i, target := range targets {
+			targetPC, ok := pcs[int(target)]
+			if !ok {
+				panic(fmt.Errorf("jump target %d is not an instruction", target))
+			}
+			targets[i] = uint32(targetPC)
+		}
+	}
+	return ret
+}
+
+// next returns the position of the instruction following the one at pc.
+func (c *decodedCode) next(pc int) int {
+	end := pc + 1
+	for end < len(c.words) && c.ips[end] == c.ips[pc] {
+		end++
+	}
+	return end
+}
+
+// leaders returns the positions of the instructions that start a basic block:
+// the first one, jump targets and the instructions following jumps, calls and
+// returns.
+func (c *decodedCode) leaders() []int {
+	isLeader := make([]bool, len(c.words)+1)
+	isLeader[0] = true
+	for pc := 0; pc < len(c.words); pc = c.next(pc) {
+		ins := c.words[pc:c.next(pc)]
+		for _, target := range jumpTargets(ins) {
+			isLeader[target] = true
+		}
+		switch opcodes.Opcode(ins[0]) {
+		case opcodes.Jmp, opcodes.JmpIf, opcodes.JmpEither, opcodes.JmpTable,
+			opcodes.JmpIfI32GtU, opcodes.JmpIfI32GeU, opcodes.JmpIfI32Or, opcodes.JmpIfI64Ne, opcodes.JmpIfI64EqZ,
+			opcodes.Call, opcodes.CallIndirect, opcodes.InvokeImport, opcodes.ReturnValue, opcodes.ReturnVoid:
+			isLeader[c.next(pc)] = true
+		}
+	}
+
+	var ret []int
+	for pc := 0; pc < len(c.words); pc = c.next(pc) {
+		if isLeader[pc] {
+			ret = append(ret, pc)
+		}
+	}
+	return ret
+}
+
+// jumpTargets returns the words of the instruction ins holding jump targets.
+func jumpTargets(ins []uint32) []uint32 {
+	switch opcodes.Opcode(ins[0]) {
+	case opcodes.Jmp, opcodes.JmpIf,
+		opcodes.JmpIfI32GtU, opcodes.JmpIfI32GeU, opcodes.JmpIfI32Or, opcodes.JmpIfI64Ne, opcodes.JmpIfI64EqZ:
+		return ins[2:3]
+	case opcodes.JmpEither:
+		return ins[2:4]
+	case opcodes.JmpTable:
+		// The target count, then the targets and the default target.
+		return ins[3 : 4+ins[2]]
+	}
+	return nil
+}
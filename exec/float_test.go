@@ -0,0 +1,507 @@
+package exec
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/perlin-network/life/compiler"
+)
+
+// The floating point instructions are run in deterministic mode by every
+// execution tier, and in software with package softfloat, and their results
+// compared bit for bit with each other and with a reference computed with
+// exact rational arithmetic.
+
+// refFormat is a binary interchange format, for the reference results.
+type refFormat struct {
+	mantBits, expBits uint
+	nan               uint64 // canonical NaN
+}
+
+var (
+	ref32 = refFormat{mantBits: 23, expBits: 8, nan: 0x7fc00000}
+	ref64 = refFormat{mantBits: 52, expBits: 11, nan: 0x7ff8000000000000}
+)
+
+func (f refFormat) signBit() uint64 { return 1 << (f.mantBits + f.expBits) }
+func (f refFormat) inf() uint64     { return (1<<f.expBits - 1) << f.mantBits }
+func (f refFormat) neg(a uint64) bool {
+	return a&f.signBit() != 0
+}
+func (f refFormat) isNaN(a uint64) bool  { return a&^f.signBit() > f.inf() }
+func (f refFormat) isInf(a uint64) bool  { return a&^f.signBit() == f.inf() }
+func (f refFormat) isZero(a uint64) bool { return a&^f.signBit() == 0 }
+
+// signed returns a with the sign bit set if neg is.
+func (f refFormat) signed(a uint64, neg bool) uint64 {
+	if neg {
+		return a | f.signBit()
+	}
+	return a
+}
+
+// unpack returns the magnitude of a finite value as m * 2^e.
+func (f refFormat) unpack(a uint64) (m uint64, e int) {
+	bias := 1<<(f.expBits-1) - 1
+	exp := int(a >> f.mantBits & (1<<f.expBits - 1))
+	m = a & (1<<f.mantBits - 1)
+	if exp == 0 {
+		return m, 1 - bias - int(f.mantBits)
+	}
+	return m | 1<<f.mantBits, exp - bias - int(f.mantBits)
+}
+
+// rat returns the value of a finite value.
+func (f refFormat) rat(a uint64) *big.Rat {
+	m, e := f.unpack(a)
+	r := scaled(new(big.Int).SetUint64(m), e)
+	if f.neg(a) {
+		r.Neg(r)
+	}
+	return r
+}
+
+// scaled returns m * 2^e.
+func scaled(m *big.Int, e int) *big.Rat {
+	if e >= 0 {
+		return new(big.Rat).SetInt(new(big.Int).Lsh(m, uint(e)))
+	}
+	return new(big.Rat).SetFrac(m, new(big.Int).Lsh(big.NewInt(1), uint(-e)))
+}
+
+// round rounds r to nearest, ties to even. A zero result is negative if neg is
+// set or r is negative.
+func (f refFormat) round(r *big.Rat, neg bool) uint64 {
+	var bits uint64
+	if f == ref32 {
+		v, _ := r.Float32()
+		bits = uint64(math.Float32bits(v))
+	} else {
+		v, _ := r.Float64()
+		bits = math.Float64bits(v)
+	}
+	if f.isZero(bits) {
+		return f.signed(0, neg || r.Sign() < 0)
+	}
+	return bits
+}
+
+func (f refFormat) add(a, b uint64) uint64 {
+	switch {
+	case f.isNaN(a) || f.isNaN(b):
+		return f.nan
+	case f.isInf(a) && f.isInf(b) && a != b:
+		return f.nan
+	case f.isInf(a):
+		return a
+	case f.isInf(b):
+		return b
+	}
+	return f.round(new(big.Rat).Add(f.rat(a), f.rat(b)), f.neg(a) && f.neg(b))
+}
+
+func (f refFormat) mul(a, b uint64) uint64 {
+	neg := f.neg(a) != f.neg(b)
+	switch {
+	case f.isNaN(a) || f.isNaN(b):
+		return f.nan
+	case f.isInf(a) || f.isInf(b):
+		if f.isZero(a) || f.isZero(b) {
+			return f.nan
+		}
+		return f.signed(f.inf(), neg)
+	}
+	return f.round(new(big.Rat).Mul(f.rat(a), f.rat(b)), neg)
+}
+
+func (f refFormat) div(a, b uint64) uint64 {
+	neg := f.neg(a) != f.neg(b)
+	switch {
+	case f.isNaN(a) || f.isNaN(b):
+		return f.nan
+	case f.isInf(a) && f.isInf(b), f.isZero(a) && f.isZero(b):
+		return f.nan
+	case f.isInf(a), f.isZero(b):
+		return f.signed(f.inf(), neg)
+	case f.isInf(b):
+		return f.signed(0, neg)
+	}
+	return f.round(new(big.Rat).Quo(f.rat(a), f.rat(b)), neg)
+}
+
+// less reports whether a < b, for values other than NaNs.
+func (f refFormat) less(a, b uint64) bool {
+	value := func(v uint64) float64 {
+		if f == ref32 {
+			return float64(math.Float32frombits(uint32(v)))
+		}
+		return math.Float64frombits(v)
+	}
+	return value(a) < value(b)
+}
+
+func (f refFormat) min(a, b uint64) uint64 {
+	switch {
+	case f.isNaN(a) || f.isNaN(b):
+		return f.nan
+	case f.isZero(a) && f.isZero(b):
+		return a | b
+	case f.less(b, a):
+		return b
+	}
+	return a
+}
+
+func (f refFormat) max(a, b uint64) uint64 {
+	switch {
+	case f.isNaN(a) || f.isNaN(b):
+		return f.nan
+	case f.isZero(a) && f.isZero(b):
+		return a & b
+	case f.less(a, b):
+		return b
+	}
+	return a
+}
+
+func (f refFormat) sqrt(a uint64) uint64 {
+	switch {
+	case f.isNaN(a):
+		return f.nan
+	case f.isZero(a):
+		return a
+	case f.neg(a):
+		return f.nan
+	case f.isInf(a):
+		return a
+	}
+
+	// sqrt(m * 2^e) = sqrt(m * 2^k) * 2^((e-k)/2), with m * 2^k large enough
+	// that rounding s + 1/4, for the integer square root s, rounds the exact
+	// root the same way.
+	m, e := f.unpack(a)
+	n := new(big.Int).SetUint64(m)
+	k := 256 - n.BitLen()
+	if (e-k)%2 != 0 {
+		k++
+	}
+	n.Lsh(n, uint(k))
+	s := new(big.Int).Sqrt(n)
+	r := new(big.Rat).SetInt(s)
+	if new(big.Int).Mul(s, s).Cmp(n) != 0 {
+		r.Add(r, big.NewRat(1, 4))
+	}
+	return f.round(r.Mul(r, scaled(big.NewInt(1), (e-k)/2)), false)
+}
+
+// Rounding directions of roundToInt.
+const (
+	refTrunc = iota
+	refFloor
+	refCeil
+	refNearest
+)
+
+func (f refFormat) roundToInt(a uint64, mode int) uint64 {
+	switch {
+	case f.isNaN(a):
+		return f.nan
+	case f.isInf(a) || f.isZero(a):
+		return a
+	}
+	r := f.rat(a)
+	floor := new(big.Int).Div(r.Num(), r.Denom()) // Euclidean, so toward -inf
+	frac := new(big.Rat).Sub(r, new(big.Rat).SetInt(floor))
+	up := false
+	switch mode {
+	case refTrunc:
+		up = r.Sign() < 0 && frac.Sign() != 0
+	case refCeil:
+		up = frac.Sign() != 0
+	case refNearest:
+		c := frac.Cmp(big.NewRat(1, 2))
+		up = c > 0 || c == 0 && floor.Bit(0) == 1
+	}
+	if up {
+		floor.Add(floor, big.NewInt(1))
+	}
+	return f.round(new(big.Rat).SetInt(floor), f.neg(a))
+}
+
+func (f refFormat) convert(from refFormat, a uint64) uint64 {
+	switch {
+	case from.isNaN(a):
+		return f.nan
+	case from.isInf(a):
+		return f.signed(f.inf(), from.neg(a))
+	}
+	return f.round(from.rat(a), from.neg(a))
+}
+
+// truncate rounds a toward zero to an integer in [lo, hi], or returns false.
+func (f refFormat) truncate(a uint64, lo, hi *big.Int) (*big.Int, bool) {
+	if f.isNaN(a) || f.isInf(a) {
+		return nil, false
+	}
+	r := f.rat(a)
+	i := new(big.Int).Quo(r.Num(), r.Denom())
+	return i, i.Cmp(lo) >= 0 && i.Cmp(hi) <= 0
+}
+
+// floatOp is an instruction under test. ref returns its result, or false if
+// it traps.
+type floatOp struct {
+	name   string
+	params []string
+	result string
+	ref    func(args []uint64) (uint64, bool)
+}
+
+func (op floatOp) export() string {
+	return strings.Replace(op.name, "/", "-", 1)
+}
+
+func floatOps() []floatOp {
+	var ops []floatOp
+	for _, t := range []struct {
+		name string
+		f    refFormat
+	}{{"f32", ref32}, {"f64", ref64}} {
+		f := t.f
+		binary := func(name string, ref func(a, b uint64) uint64) {
+			ops = append(ops, floatOp{t.name + "." + name, []string{t.name, t.name}, t.name, func(args []uint64) (uint64, bool) {
+				return ref(args[0], args[1]), true
+			}})
+		}
+		unary := func(name string, ref func(a uint64) uint64) {
+			ops = append(ops, floatOp{t.name + "." + name, []string{t.name}, t.name, func(args []uint64) (uint64, bool) {
+				return ref(args[0]), true
+			}})
+		}
+		binary("add", f.add)
+		binary("sub", func(a, b uint64) uint64 { return f.add(a, b^f.signBit()) })
+		binary("mul", f.mul)
+		binary("div", f.div)
+		binary("min", f.min)
+		binary("max", f.max)
+		unary("sqrt", f.sqrt)
+		unary("ceil", func(a uint64) uint64 { return f.roundToInt(a, refCeil) })
+		unary("floor", func(a uint64) uint64 { return f.roundToInt(a, refFloor) })
+		unary("trunc", func(a uint64) uint64 { return f.roundToInt(a, refTrunc) })
+		unary("nearest", func(a uint64) uint64 { return f.roundToInt(a, refNearest) })
+
+		for _, it := range []struct {
+			name   string
+			signed bool
+			lo, hi *big.Int
+		}{
+			{"i32.trunc_s", true, big.NewInt(math.MinInt32), big.NewInt(math.MaxInt32)},
+			{"i32.trunc_u", false, big.NewInt(0), big.NewInt(math.MaxUint32)},
+			{"i64.trunc_s", true, big.NewInt(math.MinInt64), big.NewInt(math.MaxInt64)},
+			{"i64.trunc_u", false, big.NewInt(0), new(big.Int).SetUint64(math.MaxUint64)},
+		} {
+			it := it
+			ops = append(ops, floatOp{it.name + "/" + t.name, []string{t.name}, it.name[:3], func(args []uint64) (uint64, bool) {
+				i, ok := f.truncate(args[0], it.lo, it.hi)
+				if !ok {
+					return 0, false
+				}
+				if it.signed {
+					return uint64(i.Int64()), true
+				}
+				return i.Uint64(), true
+			}})
+		}
+
+		for _, it := range []string{"i32", "i64"} {
+			it := it
+			for _, signed := range []bool{true, false} {
+				signed := signed
+				name := t.name + ".convert_u/" + it
+				if signed {
+					name = t.name + ".convert_s/" + it
+				}
+				ops = append(ops, floatOp{name, []string{it}, t.name, func(args []uint64) (uint64, bool) {
+					v := new(big.Int)
+					switch {
+					case it == "i32" && signed:
+						v.SetInt64(int64(int32(args[0])))
+					case it == "i32":
+						v.SetUint64(uint64(uint32(args[0])))
+					case signed:
+						v.SetInt64(int64(args[0]))
+					default:
+						v.SetUint64(args[0])
+					}
+					return f.round(new(big.Rat).SetInt(v), false), true
+				}})
+			}
+		}
+	}
+	ops = append(ops,
+		floatOp{"f32.demote/f64", []string{"f64"}, "f32", func(args []uint64) (uint64, bool) {
+			return ref32.convert(ref64, args[0]), true
+		}},
+		floatOp{"f64.promote/f32", []string{"f32"}, "f64", func(args []uint64) (uint64, bool) {
+			return ref64.convert(ref32, args[0]), true
+		}},
+	)
+	return ops
+}
+
+// floatTestModule exports a function running each of ops on its parameters.
+func floatTestModule(ops []floatOp) string {
+	var b strings.Builder
+	b.WriteString("(module\n")
+	for _, op := range ops {
+		fmt.Fprintf(&b, "  (func (export %q)", op.export())
+		for _, p := range op.params {
+			fmt.Fprintf(&b, " (param %s)", p)
+		}
+		fmt.Fprintf(&b, " (result %s) (%s", op.result, op.name)
+		for i := range op.params {
+			fmt.Fprintf(&b, " (get_local %d)", i)
+		}
+		b.WriteString("))\n")
+	}
+	b.WriteString(")\n")
+	return b.String()
+}
+
+// testValues returns the operands tried for values of type t: edge cases,
+// then random values.
+func testValues(t string, rnd *rand.Rand, n int) []uint64 {
+	var vals []uint64
+	width := uint(32)
+	switch t {
+	case "i32":
+		vals = []uint64{0, 1, 2, 1<<24 + 1, 1<<31 - 1, 1 << 31, 1<<31 + 1, 1<<32 - 1, 0x12345679}
+	case "i64":
+		width = 64
+		vals = []uint64{0, 1, 1<<24 + 1, 1<<53 + 1, 1<<63 - 1, 1 << 63, 1<<63 + 1, 1<<64 - 1, 1<<64 - 1<<10, 1<<64 - 1<<11 - 1}
+	default:
+		f := ref32
+		if t == "f64" {
+			f, width = ref64, 64
+		}
+		bias := uint64(1)<<(f.expBits-1) - 1
+		one := bias << f.mantBits
+		vals = []uint64{
+			0, 1, 1<<f.mantBits - 1, 1 << f.mantBits, one, one + 1, one - 1, (bias - 1) << f.mantBits,
+			(bias-1)<<f.mantBits | 1<<(f.mantBits-1), (bias+1)<<f.mantBits | 1<<(f.mantBits-1),
+			(bias+uint64(f.mantBits))<<f.mantBits | 1, (bias+uint64(f.mantBits)+1)<<f.mantBits | 1, (bias + 31) << f.mantBits,
+			(bias + 63) << f.mantBits, f.inf() - 1, f.inf(), f.nan, f.nan | 1, f.inf() | 1,
+		}
+		for _, v := range vals[:len(vals):len(vals)] {
+			vals = append(vals, v|f.signBit())
+		}
+		for i := 0; i < n; i++ {
+			if rnd.Intn(3) == 0 {
+				// Near 1, where results round most often.
+				exp := bias + uint64(rnd.Intn(61)) - 30
+				vals = append(vals, uint64(rnd.Intn(2))<<(width-1)|exp<<f.mantBits|rnd.Uint64()&(1<<f.mantBits-1))
+			} else {
+				vals = append(vals, rnd.Uint64()>>(64-width))
+			}
+		}
+		return vals
+	}
+	for _, v := range vals[:len(vals):len(vals)] {
+		vals = append(vals, -v)
+	}
+	for i := 0; i < n; i++ {
+		vals = append(vals, rnd.Uint64())
+	}
+	for i := range vals {
+		if width == 32 {
+			vals[i] &= 1<<32 - 1
+		}
+	}
+	return vals
+}
+
+func TestFloatingPointDifferential(t *testing.T) {
+	ops := floatOps()
+	module := []byte(floatTestModule(ops))
+	tiers := []struct {
+		name   string
+		config VMConfig
+	}{
+		{"interpreter", VMConfig{DeterministicFloatingPoint: true}},
+		{"-O 2", VMConfig{DeterministicFloatingPoint: true, OptLevel: compiler.MaxOptLevel}},
+		{"native", VMConfig{DeterministicFloatingPoint: true, EnableNative: true}},
+		{"lazy", VMConfig{DeterministicFloatingPoint: true, LazyCompilation: true}},
+		{"softfloat", VMConfig{SoftFloat: true}},
+		{"softfloat -O 2", VMConfig{SoftFloat: true, OptLevel: compiler.MaxOptLevel}},
+	}
+	var vms []*VirtualMachine
+	for _, tier := range tiers {
+		vm, err := NewVirtualMachine(module, tier.config, &NopResolver{}, nil)
+		if err != nil {
+			t.Fatalf("%s: %v", tier.name, err)
+		}
+		vms = append(vms, vm)
+	}
+
+	rnd := rand.New(rand.NewSource(48))
+	for _, op := range ops {
+		var cases [][]uint64
+		if len(op.params) == 1 {
+			for _, v := range testValues(op.params[0], rnd, 500) {
+				cases = append(cases, []uint64{v})
+			}
+		} else {
+			vals := testValues(op.params[0], rnd, 0)
+			for _, a := range vals {
+				for _, b := range vals {
+					cases = append(cases, []uint64{a, b})
+				}
+			}
+			for i := 0; i < 2000; i++ {
+				r := testValues(op.params[0], rnd, 2)
+				cases = append(cases, r[len(r)-2:])
+			}
+		}
+
+		failures := 0
+		for _, args := range cases {
+			want, wantOK := op.ref(args)
+			if op.result == "i32" || op.result == "f32" {
+				want &= 1<<32 - 1
+			}
+			params := make([]int64, len(args))
+			for i, a := range args {
+				params[i] = int64(a)
+			}
+			for i, vm := range vms {
+				id, _ := vm.GetFunctionExport(op.export())
+				ret, err := vm.Run(id, params...)
+				got := uint64(ret)
+				if op.result == "i32" || op.result == "f32" {
+					got &= 1<<32 - 1
+				}
+				if err != nil {
+					vm.ExitError = nil
+					vm.CurrentFrame = -1
+				}
+				switch {
+				case !wantOK && err == nil:
+					t.Errorf("%s: %s %#x = %#x, want a trap", tiers[i].name, op.name, args, got)
+				case wantOK && err != nil:
+					t.Errorf("%s: %s %#x: %v, want %#x", tiers[i].name, op.name, args, err, want)
+				case wantOK && got != want:
+					t.Errorf("%s: %s %#x = %#x, want %#x", tiers[i].name, op.name, args, got, want)
+				default:
+					continue
+				}
+				if failures++; failures == 10 {
+					t.Fatalf("too many failures")
+				}
+			}
+		}
+	}
+}
@@ -12,11 +12,10 @@ var _ ImportResolver = (*NopResolver)(nil)
 type NopResolver struct{}
 
 func (r *NopResolver) Reset() {
-	panic("implement me")
 }
 
 func (r *NopResolver) Clone() ImportResolver {
-	panic("implement me")
+	return r
 }
 
 func (r *NopResolver) ResolveFunc(module, field string) FunctionImport {
@@ -37,7 +36,7 @@ func (vm *VirtualMachine) RunWithGasLimit(entryID, limit int, params ...int64) (
 	for !vm.Exited {
 		vm.Execute()
 		if vm.Delegate != nil {
-			vm.Delegate()
+			vm.runDelegate()
 			vm.Delegate = nil
 		}
 		count++
@@ -60,7 +59,7 @@ func (vm *VirtualMachine) Run(entryID int, params ...int64) (int64, error) {
 	for !vm.Exited {
 		vm.Execute()
 		if vm.Delegate != nil {
-			vm.Delegate()
+			vm.runDelegate()
 			vm.Delegate = nil
 		}
 	}
@@ -70,3 +69,15 @@ func (vm *VirtualMachine) Run(entryID int, params ...int64) (int64, error) {
 	}
 	return vm.ReturnValue, nil
 }
+
+// runDelegate calls the pending import function. A panic raised by the import
+// ends execution with a Trap, like a fault in the module itself does.
+func (vm *VirtualMachine) runDelegate() {
+	defer func() {
+		if err := recover(); err != nil {
+			vm.Exited = true
+			vm.ExitError = vm.NewTrap(err)
+		}
+	}()
+	vm.Delegate()
+}
@@ -0,0 +1,124 @@
+package exec
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/go-interpreter/wagon/wasm"
+	"github.com/perlin-network/life/compiler"
+	"github.com/perlin-network/life/utils"
+)
+
+// lazyCode holds the code of a module whose functions are compiled on their
+// first call, shared by a virtual machine and its clones. Until a function is
+// compiled, its entry in functionCode only holds the numbers of parameters,
+// locals and results.
+type lazyCode struct {
+	module    *compiler.Module
+	gasPolicy compiler.GasPolicy
+
+	functionCode []compiler.InterpreterCode
+	decoded      []decodedCode
+	native       []*nativeCode // nil unless native code is enabled and supported
+
+	mu       sync.Mutex
+	compiled []uint32 // set atomically once a function is compiled and published
+}
+
+func newLazyCode(m *compiler.Module, gasPolicy compiler.GasPolicy, native bool) *lazyCode {
+	var funcImports []int
+	if m.Base.Import != nil {
+		for _, e := range m.Base.Import.Entries {
+			if e.Type.Kind() == wasm.ExternalFunction {
+				funcImports = append(funcImports, int(e.Type.(wasm.FuncImport).Type))
+			}
+		}
+	}
+
+	n := len(funcImports) + len(m.Base.FunctionIndexSpace)
+	c := &lazyCode{
+		module:       m,
+		gasPolicy:    gasPolicy,
+		functionCode: make([]compiler.InterpreterCode, n),
+		decoded:      make([]decodedCode, n),
+		compiled:     make([]uint32, n),
+	}
+	if native && nativeSupported {
+		c.native = make([]*nativeCode, n)
+	}
+	for i, tyID := range funcImports {
+		sig := &m.Base.Types.Entries[tyID]
+		c.functionCode[i].NumParams = len(sig.ParamTypes)
+		c.functionCode[i].NumReturns = len(sig.ReturnTypes)
+	}
+	for i, f := range m.Base.FunctionIndexSpace {
+		code := &c.functionCode[len(funcImports)+i]
+		code.NumParams = len(f.Sig.ParamTypes)
+		code.NumReturns = len(f.Sig.ReturnTypes)
+		for _, l := range f.Body.Locals {
+			code.NumLocals += int(l.Count)
+		}
+	}
+	return c
+}
+
+// isCompiled reports whether a function is compiled.
+func (c *lazyCode) isCompiled(functionID int) bool {
+	return atomic.LoadUint32(&c.compiled[functionID]) != 0
+}
+
+// compile compiles a function unless it already is, and publishes it. Panics
+// if the function fails to compile.
+func (c *lazyCode) compile(functionID int) {
+	if c.isCompiled(functionID) {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.compiled[functionID] != 0 {
+		return
+	}
+
+	code, err := c.module.CompileFunction(functionID, c.gasPolicy)
+	if err != nil {
+		panic(err)
+	}
+
+	// Other virtual machines may read the numbers of parameters, locals and
+	// results at any time, so only the fields set by compilation are written.
+	dst := &c.functionCode[functionID]
+	dst.NumRegs = code.NumRegs
+	dst.Bytes = code.Bytes
+	dst.Offsets = code.Offsets
+	dst.CoverageBlocks = code.CoverageBlocks
+	c.decoded[functionID] = decodeCode(code.Bytes)
+	if c.native != nil {
+		// Functions that fail to compile are interpreted.
+		c.native[functionID], _ = compileNative(&c.decoded[functionID])
+	}
+	atomic.StoreUint32(&c.compiled[functionID], 1)
+}
+
+// CompileFunction compiles a function of a virtual machine created with
+// LazyCompilation, unless it already is. Functions are otherwise compiled on
+// their first call; this gives access to their code beforehand. It does
+// nothing if LazyCompilation is not set.
+func (vm *VirtualMachine) CompileFunction(functionID int) (retErr error) {
+	defer utils.CatchPanic(&retErr)
+
+	vm.loadFunction(functionID)
+	return nil
+}
+
+// loadFunction makes sure that a function is compiled before it is run.
+// Panics if it fails to compile.
+func (vm *VirtualMachine) loadFunction(functionID int) {
+	if vm.lazy == nil {
+		return
+	}
+	vm.lazy.compile(functionID)
+	if vm.Coverage != nil && vm.Coverage[functionID] == nil {
+		vm.Coverage[functionID] = make([]uint64, len(vm.FunctionCode[functionID].CoverageBlocks))
+	}
+}
@@ -0,0 +1,33 @@
+package exec
+
+// nativeCode is a function compiled to machine code by compileNative. It has
+// an entry point at each block leader whose first instruction it implements,
+// and returns to the interpreter before any instruction it does not
+// implement, as well as before one that would trap, run out of gas or grow
+// memory, so that the interpreter executes it with its usual semantics. Calls
+// and returns end the machine code too; runNative makes the ones between
+// compiled functions itself.
+type nativeCode struct {
+	code    []byte  // executable mapping
+	entries []int32 // offset in code of the instruction at each position; -1 elsewhere
+}
+
+// nativeBudget is the number of backward jumps native code takes before
+// returning to the interpreter, which lets the Go scheduler preempt long
+// running loops.
+const nativeBudget = 1 << 16
+
+// compileNativeCode compiles decoded functions to machine code, leaving
+// nil the functions that fail to compile. Returns nil if native code is not
+// supported on this platform.
+func compileNativeCode(decoded []decodedCode) []*nativeCode {
+	if !nativeSupported {
+		return nil
+	}
+	ret := make([]*nativeCode, len(decoded))
+	for i := range decoded {
+		// Functions that fail to compile are interpreted.
+		ret[i], _ = compileNative(&decoded[i])
+	}
+	return ret
+}
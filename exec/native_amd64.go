@@ -0,0 +1,846 @@
+//go:build linux && amd64
+
+package exec
+
+import (
+	"math"
+	"runtime"
+	"syscall"
+	"unsafe"
+
+	"github.com/perlin-network/life/compiler/opcodes"
+	"github.com/perlin-network/life/utils"
+)
+
+const nativeSupported = true
+
+// nativeContext is the state native code runs on. callNative loads the first
+// five fields into registers: R8 points to the registers, R9 to the locals,
+// R10 to the memory, R11 holds its length and SI points to the globals. DI
+// points to the context throughout.
+type nativeContext struct {
+	regs      uintptr
+	locals    uintptr
+	memory    uintptr
+	memoryLen uintptr
+	globals   uintptr
+
+	gas      uint64
+	gasLimit uint64
+	yielded  int64
+	budget   int64  // backward jumps left
+	exitPC   uint64 // position of the instruction to continue with in the interpreter
+}
+
+// callNative runs native code from entry until it returns.
+//
+//go:noescape
+func callNative(entry uintptr, ctx *nativeContext)
+
+// runNative runs frame natively from the entry point at pc. Direct calls to
+// functions with an entry point at their start, and returns to callers with
+// one where they continue, are made here rather than in the interpreter, so
+// that execution stays in native code. runNative returns the current frame
+// and the position in it of the instruction the interpreter should execute
+// next. trapFrame and trapPC are kept up to date as in Execute.
+func (vm *VirtualMachine) runNative(frame *Frame, pc int, trapFrame, trapPC *int) (*Frame, int) {
+	ctx := nativeContext{
+		memory:    uintptr(unsafe.Pointer(unsafe.SliceData(vm.Memory))),
+		memoryLen: uintptr(len(vm.Memory)),
+		globals:   uintptr(unsafe.Pointer(unsafe.SliceData(vm.Globals))),
+		gas:       vm.Gas,
+		gasLimit:  vm.Config.GasLimit,
+		yielded:   vm.Yielded,
+	}
+	if ctx.gasLimit == 0 {
+		ctx.gasLimit = math.MaxUint64
+	}
+
+	for {
+		c := vm.nativeCode[frame.FunctionID]
+		ctx.regs = uintptr(unsafe.Pointer(unsafe.SliceData(frame.Regs)))
+		ctx.locals = uintptr(unsafe.Pointer(unsafe.SliceData(frame.Locals)))
+		ctx.budget = nativeBudget
+
+		callNative(uintptr(unsafe.Pointer(&c.code[c.entries[pc]])), &ctx)
+		runtime.KeepAlive(frame.Regs)
+		runtime.KeepAlive(frame.Locals)
+		vm.Gas, vm.Yielded = ctx.gas, ctx.yielded
+		pc = int(ctx.exitPC)
+
+		code := frame.code.words
+		switch opcodes.Opcode(code[pc]) {
+		case opcodes.Call:
+			functionID := int(code[pc+2])
+			argCount := int(code[pc+3])
+			next := pc + 4 + argCount
+
+			*trapPC = pc
+			vm.loadFunction(functionID)
+			if callee := vm.nativeCode[functionID]; callee == nil || callee.entries[0] < 0 {
+				return frame, pc
+			}
+			frame = vm.pushFrame(frame, int(code[pc+1]), next, functionID, code[pc+4:next])
+			pc = 0
+			*trapFrame = vm.CurrentFrame
+
+		case opcodes.ReturnValue, opcodes.ReturnVoid:
+			if vm.CurrentFrame == 0 {
+				return frame, pc
+			}
+			caller := &vm.CallStack[vm.CurrentFrame-1]
+			if callerCode := vm.nativeCode[caller.FunctionID]; callerCode == nil || callerCode.entries[caller.pc] < 0 {
+				return frame, pc
+			}
+			val := int64(0)
+			if opcodes.Opcode(code[pc]) == opcodes.ReturnValue {
+				val = frame.Regs[code[pc+2]]
+			}
+			frame = vm.popFrame(frame)
+			if opcodes.Opcode(code[pc]) == opcodes.ReturnValue {
+				frame.Regs[frame.ReturnReg] = val
+			}
+			pc = frame.pc
+			*trapFrame = vm.CurrentFrame
+
+		default:
+			return frame, pc
+		}
+	}
+}
+
+// Offsets of the fields of nativeContext used by native code.
+var (
+	ctxGas      = int32(unsafe.Offsetof(nativeContext{}.gas))
+	ctxGasLimit = int32(unsafe.Offsetof(nativeContext{}.gasLimit))
+	ctxYielded  = int32(unsafe.Offsetof(nativeContext{}.yielded))
+	ctxBudget   = int32(unsafe.Offsetof(nativeContext{}.budget))
+	ctxExitPC   = int32(unsafe.Offsetof(nativeContext{}.exitPC))
+)
+
+// compileNative compiles the decoded code of a function to machine code.
+func compileNative(code *decodedCode) (_ret *nativeCode, retErr error) {
+	defer utils.CatchPanic(&retErr)
+
+	words := code.words
+	a := &amd64Asm{}
+	offsets := make([]int32, len(words)+1) // of the code of each instruction
+	implemented := make([]bool, len(words)+1)
+
+	for pc := 0; pc < len(words); pc = code.next(pc) {
+		offsets[pc] = int32(len(a.code))
+		if implemented[pc] = a.instr(words[pc:code.next(pc)], pc); !implemented[pc] {
+			a.exit(pc)
+		}
+	}
+	offsets[len(words)] = int32(len(a.code))
+	a.exit(len(words))
+
+	for _, f := range a.fixups {
+		a.patch32(f.at, offsets[f.target]-int32(f.base))
+	}
+
+	// Native code is entered at the leaders of blocks it starts running.
+	entries := make([]int32, len(words)+1)
+	for i := range entries {
+		entries[i] = -1
+	}
+	for _, pc := range code.leaders() {
+		if implemented[pc] {
+			entries[pc] = offsets[pc]
+		}
+	}
+
+	mem, err := syscall.Mmap(-1, 0, len(a.code), syscall.PROT_READ|syscall.PROT_WRITE, syscall.MAP_PRIVATE|syscall.MAP_ANON)
+	if err != nil {
+		return nil, err
+	}
+	copy(mem, a.code)
+	if err := syscall.Mprotect(mem, syscall.PROT_READ|syscall.PROT_EXEC); err != nil {
+		syscall.Munmap(mem)
+		return nil, err
+	}
+
+	ret := &nativeCode{code: mem, entries: entries}
+	runtime.SetFinalizer(ret, func(c *nativeCode) {
+		syscall.Munmap(c.code)
+	})
+	return ret, nil
+}
+
+// x86-64 registers, by encoding.
+const (
+	rax = 0
+	rcx = 1
+	rdx = 2
+	rsi = 6
+	rdi = 7
+	r8  = 8
+	r9  = 9
+	r10 = 10
+	r11 = 11
+)
+
+// Condition codes.
+const (
+	ccB  = 0x2
+	ccAE = 0x3
+	ccE  = 0x4
+	ccNE = 0x5
+	ccBE = 0x6
+	ccA  = 0x7
+	ccL  = 0xc
+	ccGE = 0xd
+	ccLE = 0xe
+	ccG  = 0xf
+)
+
+// amd64Mem is a memory operand, base + index<<scale + disp.
+type amd64Mem struct {
+	base, index int // index is -1 if there is none
+	scale       byte
+	disp        int32
+}
+
+func slot(reg uint32) amd64Mem        { return amd64Mem{base: r8, index: -1, disp: int32(reg) * 8} }
+func local(id uint32) amd64Mem        { return amd64Mem{base: r9, index: -1, disp: int32(id) * 8} }
+func global(id uint32) amd64Mem       { return amd64Mem{base: rsi, index: -1, disp: int32(id) * 8} }
+func ctxField(off int32) amd64Mem     { return amd64Mem{base: rdi, index: -1, disp: off} }
+func linearMemory(index int) amd64Mem { return amd64Mem{base: r10, index: index} }
+
+// amd64Fixup is a 32-bit field at offset at, to be set to the offset of the
+// instruction at target relative to base.
+type amd64Fixup struct {
+	at, base int
+	target   int
+}
+
+// amd64Asm assembles the small subset of x86-64 native code is made of.
+type amd64Asm struct {
+	code   []byte
+	fixups []amd64Fixup
+}
+
+func (a *amd64Asm) emit(b ...byte) { a.code = append(a.code, b...) }
+
+func (a *amd64Asm) emit32(v uint32) {
+	a.code = append(a.code, byte(v), byte(v>>8), byte(v>>16), byte(v>>24))
+}
+
+func (a *amd64Asm) patch32(at int, v int32) {
+	a.code[at] = byte(v)
+	a.code[at+1] = byte(v >> 8)
+	a.code[at+2] = byte(v >> 16)
+	a.code[at+3] = byte(v >> 24)
+}
+
+func (a *amd64Asm) rex(w bool, reg, index, base int) {
+	rex := byte(0x40)
+	if w {
+		rex |= 8
+	}
+	if reg&8 != 0 {
+		rex |= 4
+	}
+	if index >= 0 && index&8 != 0 {
+		rex |= 2
+	}
+	if base&8 != 0 {
+		rex |= 1
+	}
+	if rex != 0x40 {
+		a.emit(rex)
+	}
+}
+
+// mem emits an instruction with a memory operand. reg is a register or an
+// opcode extension.
+func (a *amd64Asm) mem(w bool, op []byte, reg int, m amd64Mem) {
+	a.rex(w, reg, m.index, m.base)
+	a.emit(op...)
+	if m.index >= 0 {
+		a.emit(0x80|byte(reg&7)<<3|4, m.scale<<6|byte(m.index&7)<<3|byte(m.base&7))
+	} else if m.base&7 == 4 {
+		a.emit(0x80|byte(reg&7)<<3|4, 0x24)
+	} else {
+		a.emit(0x80 | byte(reg&7)<<3 | byte(m.base&7))
+	}
+	a.emit32(uint32(m.disp))
+}
+
+// reg emits an instruction with a register operand rm. reg is a register or
+// an opcode extension.
+func (a *amd64Asm) reg(w bool, op []byte, reg, rm int) {
+	a.rex(w, reg, -1, rm)
+	a.emit(op...)
+	a.emit(0xc0 | byte(reg&7)<<3 | byte(rm&7))
+}
+
+func (a *amd64Asm) load64(r int, m amd64Mem)  { a.mem(true, []byte{0x8b}, r, m) }
+func (a *amd64Asm) load32(r int, m amd64Mem)  { a.mem(false, []byte{0x8b}, r, m) }
+func (a *amd64Asm) store64(m amd64Mem, r int) { a.mem(true, []byte{0x89}, r, m) }
+
+// signExtend32 sign-extends the low 32 bits of r.
+func (a *amd64Asm) signExtend32(r int) { a.reg(true, []byte{0x63}, r, r) }
+
+// movImm32 sets r to imm, zero-extended.
+func (a *amd64Asm) movImm32(r int, imm uint32) {
+	a.rex(false, 0, -1, r)
+	a.emit(0xb8 + byte(r&7))
+	a.emit32(imm)
+}
+
+func (a *amd64Asm) movImm64(r int, imm uint64) {
+	a.rex(true, 0, -1, r)
+	a.emit(0xb8 + byte(r&7))
+	a.emit32(uint32(imm))
+	a.emit32(uint32(imm >> 32))
+}
+
+// setcc sets rax to 1 if the condition holds, and to 0 otherwise.
+func (a *amd64Asm) setcc(cc byte) {
+	a.emit(0x0f, 0x90+cc, 0xc0)
+	a.emit(0x0f, 0xb6, 0xc0)
+}
+
+// jcc emits a conditional jump to be bound later, and returns its position.
+func (a *amd64Asm) jcc(cc byte) int {
+	a.emit(0x0f, 0x80+cc)
+	a.emit32(0)
+	return len(a.code)
+}
+
+func (a *amd64Asm) jmp() int {
+	a.emit(0xe9)
+	a.emit32(0)
+	return len(a.code)
+}
+
+// bind makes the jump emitted at j continue here.
+func (a *amd64Asm) bind(j int) { a.patch32(j-4, int32(len(a.code)-j)) }
+
+// jmpTo jumps to the instruction at target.
+func (a *amd64Asm) jmpTo(target uint32) {
+	j := a.jmp()
+	a.fixups = append(a.fixups, amd64Fixup{at: j - 4, base: j, target: int(target)})
+}
+
+// exit returns to the interpreter at pc.
+func (a *amd64Asm) exit(pc int) {
+	a.mem(true, []byte{0xc7}, 0, ctxField(ctxExitPC))
+	a.emit32(uint32(pc))
+	a.emit(0xc3)
+}
+
+// exitIf returns to the interpreter at pc if the condition holds.
+func (a *amd64Asm) exitIf(cc byte, pc int) {
+	j := a.jcc(cc ^ 1)
+	a.exit(pc)
+	a.bind(j)
+}
+
+// jump jumps from the instruction at pc to the one at target, setting the
+// yielded value. Backward jumps use up the budget, and return to the
+// interpreter at target once it runs out.
+func (a *amd64Asm) jump(pc int, target, yielded uint32) {
+	a.load64(rax, slot(yielded))
+	a.store64(ctxField(ctxYielded), rax)
+	if int(target) <= pc {
+		a.mem(true, []byte{0x83}, 5, ctxField(ctxBudget)) // sub qword [budget], 1
+		a.emit(1)
+		a.exitIf(ccE, int(target))
+	}
+	a.jmpTo(target)
+}
+
+// effectiveAddress adds offset to the 32-bit base address in rax, returning
+// to the interpreter at pc unless size bytes there are in memory.
+func (a *amd64Asm) effectiveAddress(pc int, offset uint32, size int32) {
+	a.movImm32(rcx, offset)
+	a.reg(true, []byte{0x01}, rcx, rax)                                        // add rax, rcx
+	a.mem(true, []byte{0x8d}, rdx, amd64Mem{base: rax, index: -1, disp: size}) // lea rdx, [rax+size]
+	a.reg(true, []byte{0x39}, r11, rdx)                                        // cmp rdx, r11
+	a.exitIf(ccA, pc)
+}
+
+// Opcodes of two-operand ALU instructions, reg op= r/m.
+var (
+	aluAdd  = []byte{0x03}
+	aluSub  = []byte{0x2b}
+	aluAnd  = []byte{0x23}
+	aluOr   = []byte{0x0b}
+	aluXor  = []byte{0x33}
+	aluCmp  = []byte{0x3b}
+	aluImul = []byte{0x0f, 0xaf}
+)
+
+// Opcode extensions of shifts by cl.
+const (
+	shiftRol = 0
+	shiftRor = 1
+	shiftShl = 4
+	shiftShr = 5
+	shiftSar = 7
+)
+
+// instr emits the instruction ins at pc. Returns false if it is left to the
+// interpreter.
+func (a *amd64Asm) instr(ins []uint32, pc int) bool {
+	op, target := opcodes.Opcode(ins[0]), ins[1]
+
+	// i32 operations sign-extend their results, as the interpreter does,
+	// except where noted.
+	binary32 := func(alu []byte, signed bool) {
+		a.load32(rax, slot(ins[2]))
+		a.mem(false, alu, rax, slot(ins[3]))
+		if signed {
+			a.signExtend32(rax)
+		}
+		a.store64(slot(target), rax)
+	}
+	binary64 := func(alu []byte) {
+		a.load64(rax, slot(ins[2]))
+		a.mem(true, alu, rax, slot(ins[3]))
+		a.store64(slot(target), rax)
+	}
+	compare := func(w bool, cc byte) {
+		a.mem(w, []byte{0x8b}, rax, slot(ins[2]))
+		a.mem(w, aluCmp, rax, slot(ins[3]))
+		a.setcc(cc)
+		a.store64(slot(target), rax)
+	}
+	shift := func(w bool, ext int, signed bool) {
+		a.mem(w, []byte{0x8b}, rax, slot(ins[2]))
+		a.load32(rcx, slot(ins[3]))
+		a.reg(w, []byte{0xd3}, ext, rax)
+		if signed {
+			a.signExtend32(rax)
+		}
+		a.store64(slot(target), rax)
+	}
+	// divide divides rax by rcx into rax and rdx. Division by zero and
+	// overflow are left to the interpreter, and the remainder of dividing by
+	// -1 is 0, as in Go.
+	divide := func(w, signed, rem bool) {
+		a.mem(w, []byte{0x8b}, rcx, slot(ins[3]))
+		a.reg(w, []byte{0x85}, rcx, rcx) // test rcx, rcx
+		a.exitIf(ccE, pc)
+		a.mem(w, []byte{0x8b}, rax, slot(ins[2]))
+
+		done := -1
+		if signed {
+			a.reg(w, []byte{0x83}, 7, rcx) // cmp rcx, -1
+			a.emit(0xff)
+			notMinusOne := a.jcc(ccNE)
+			if rem {
+				a.reg(false, []byte{0x33}, rdx, rdx) // xor edx, edx
+				done = a.jmp()
+			} else {
+				if w {
+					a.movImm64(rdx, 1<<63)
+				} else {
+					a.movImm32(rdx, 1<<31)
+				}
+				a.reg(w, []byte{0x3b}, rax, rdx) // cmp rax, rdx
+				a.exitIf(ccE, pc)
+			}
+			a.bind(notMinusOne)
+			if w {
+				a.emit(0x48, 0x99) // cqo
+			} else {
+				a.emit(0x99) // cdq
+			}
+			a.reg(w, []byte{0xf7}, 7, rcx) // idiv rcx
+		} else {
+			a.reg(false, []byte{0x33}, rdx, rdx) // xor edx, edx
+			a.reg(w, []byte{0xf7}, 6, rcx)       // div rcx
+		}
+		if done >= 0 {
+			a.bind(done)
+		}
+
+		result := rax
+		if rem {
+			result = rdx
+		}
+		if signed && !w {
+			a.signExtend32(result)
+		}
+		a.store64(slot(target), result)
+	}
+	// bitScan counts leading (bsr) or trailing (bsf) zeros of a 32-bit or
+	// 64-bit value.
+	bitScan := func(w, leading bool) {
+		size := uint32(32)
+		if w {
+			size = 64
+		}
+		a.mem(w, []byte{0x8b}, rax, slot(ins[2]))
+		if leading {
+			a.movImm64(rdx, math.MaxUint64)
+			a.reg(w, []byte{0x0f, 0xbd}, rcx, rax) // bsr rcx, rax
+		} else {
+			a.movImm32(rdx, size)
+			a.reg(w, []byte{0x0f, 0xbc}, rcx, rax) // bsf rcx, rax
+		}
+		a.reg(w, []byte{0x0f, 0x40 + ccE}, rcx, rdx) // cmovz rcx, rdx
+		if leading {
+			a.movImm32(rax, size-1)
+			a.reg(w, []byte{0x2b}, rax, rcx) // sub rax, rcx
+			a.store64(slot(target), rax)
+		} else {
+			a.store64(slot(target), rcx)
+		}
+	}
+	// load loads from the base address in rax.
+	load := func(offset uint32, op []byte, w bool, size int32) {
+		a.effectiveAddress(pc, offset, size)
+		a.mem(w, op, rax, linearMemory(rax))
+		a.store64(slot(target), rax)
+	}
+	store := func(op []byte, prefix bool, w bool, size int32) {
+		a.load32(rax, slot(ins[4]))
+		a.effectiveAddress(pc, ins[3], size)
+		a.load64(rcx, slot(ins[5]))
+		if prefix {
+			a.emit(0x66)
+		}
+		a.mem(w, op, rcx, linearMemory(rax))
+	}
+
+	switch op {
+	case opcodes.Nop:
+
+	case opcodes.Select:
+		a.load64(rax, slot(ins[2]))
+		a.load64(rcx, slot(ins[3]))
+		a.mem(false, []byte{0x83}, 7, slot(ins[4])) // cmp dword [c], 0
+		a.emit(0)
+		a.reg(true, []byte{0x0f, 0x40 + ccE}, rax, rcx) // cmovz rax, rcx
+		a.store64(slot(target), rax)
+
+	case opcodes.I32Const:
+		a.movImm32(rax, ins[2])
+		a.store64(slot(target), rax)
+	case opcodes.I64Const:
+		a.movImm64(rax, uint64(ins[2])|uint64(ins[3])<<32)
+		a.store64(slot(target), rax)
+
+	case opcodes.I32Add:
+		binary32(aluAdd, true)
+	case opcodes.I32Sub:
+		binary32(aluSub, true)
+	case opcodes.I32Mul:
+		binary32(aluImul, true)
+	case opcodes.I32And:
+		binary32(aluAnd, true)
+	case opcodes.I32Or:
+		binary32(aluOr, true)
+	case opcodes.I32Xor:
+		binary32(aluXor, true)
+	case opcodes.I32DivS:
+		divide(false, true, false)
+	case opcodes.I32DivU:
+		divide(false, false, false)
+	case opcodes.I32RemS:
+		divide(false, true, true)
+	case opcodes.I32RemU:
+		divide(false, false, true)
+	case opcodes.I32Shl:
+		shift(false, shiftShl, true)
+	case opcodes.I32ShrS:
+		shift(false, shiftSar, true)
+	case opcodes.I32ShrU:
+		shift(false, shiftShr, false)
+	case opcodes.I32Rotl:
+		shift(false, shiftRol, false)
+	case opcodes.I32Rotr:
+		shift(false, shiftRor, false)
+	case opcodes.I32Clz:
+		bitScan(false, true)
+	case opcodes.I32Ctz:
+		bitScan(false, false)
+	case opcodes.I32EqZ:
+		a.mem(false, []byte{0x83}, 7, slot(ins[2])) // cmp dword [a], 0
+		a.emit(0)
+		a.setcc(ccE)
+		a.store64(slot(target), rax)
+	case opcodes.I32Eq:
+		compare(false, ccE)
+	case opcodes.I32Ne:
+		compare(false, ccNE)
+	case opcodes.I32LtS:
+		compare(false, ccL)
+	case opcodes.I32LtU:
+		compare(false, ccB)
+	case opcodes.I32LeS:
+		compare(false, ccLE)
+	case opcodes.I32LeU:
+		compare(false, ccBE)
+	case opcodes.I32GtS:
+		compare(false, ccG)
+	case opcodes.I32GtU:
+		compare(false, ccA)
+	case opcodes.I32GeS:
+		compare(false, ccGE)
+	case opcodes.I32GeU:
+		compare(false, ccAE)
+
+	case opcodes.I64Add:
+		binary64(aluAdd)
+	case opcodes.I64Sub:
+		binary64(aluSub)
+	case opcodes.I64Mul:
+		binary64(aluImul)
+	case opcodes.I64And:
+		binary64(aluAnd)
+	case opcodes.I64Or:
+		binary64(aluOr)
+	case opcodes.I64Xor:
+		binary64(aluXor)
+	case opcodes.I64DivS:
+		divide(true, true, false)
+	case opcodes.I64DivU:
+		divide(true, false, false)
+	case opcodes.I64RemS:
+		divide(true, true, true)
+	case opcodes.I64RemU:
+		divide(true, false, true)
+	case opcodes.I64Shl:
+		shift(true, shiftShl, false)
+	case opcodes.I64ShrS:
+		shift(true, shiftSar, false)
+	case opcodes.I64ShrU:
+		shift(true, shiftShr, false)
+	case opcodes.I64Rotl:
+		shift(true, shiftRol, false)
+	case opcodes.I64Rotr:
+		shift(true, shiftRor, false)
+	case opcodes.I64Clz:
+		bitScan(true, true)
+	case opcodes.I64Ctz:
+		bitScan(true, false)
+	case opcodes.I64EqZ:
+		a.mem(true, []byte{0x83}, 7, slot(ins[2])) // cmp qword [a], 0
+		a.emit(0)
+		a.setcc(ccE)
+		a.store64(slot(target), rax)
+	case opcodes.I64Eq:
+		compare(true, ccE)
+	case opcodes.I64Ne:
+		compare(true, ccNE)
+	case opcodes.I64LtS:
+		compare(true, ccL)
+	case opcodes.I64LtU:
+		compare(true, ccB)
+	case opcodes.I64LeS:
+		compare(true, ccLE)
+	case opcodes.I64LeU:
+		compare(true, ccBE)
+	case opcodes.I64GtS:
+		compare(true, ccG)
+	case opcodes.I64GtU:
+		compare(true, ccA)
+	case opcodes.I64GeS:
+		compare(true, ccGE)
+	case opcodes.I64GeU:
+		compare(true, ccAE)
+
+	case opcodes.I32WrapI64, opcodes.I64ExtendUI32:
+		a.load32(rax, slot(ins[2]))
+		a.store64(slot(target), rax)
+	case opcodes.I64ExtendSI32:
+		a.mem(true, []byte{0x63}, rax, slot(ins[2])) // movsxd rax, dword [a]
+		a.store64(slot(target), rax)
+
+	case opcodes.I32Load, opcodes.I64Load32U:
+		a.load32(rax, slot(ins[4]))
+		load(ins[3], []byte{0x8b}, false, 4)
+	case opcodes.I64Load32S:
+		a.load32(rax, slot(ins[4]))
+		load(ins[3], []byte{0x63}, true, 4)
+	case opcodes.I64Load:
+		a.load32(rax, slot(ins[4]))
+		load(ins[3], []byte{0x8b}, true, 8)
+	case opcodes.I32Load8S, opcodes.I64Load8S:
+		a.load32(rax, slot(ins[4]))
+		load(ins[3], []byte{0x0f, 0xbe}, true, 1)
+	case opcodes.I32Load8U, opcodes.I64Load8U:
+		a.load32(rax, slot(ins[4]))
+		load(ins[3], []byte{0x0f, 0xb6}, false, 1)
+	case opcodes.I32Load16S, opcodes.I64Load16S:
+		a.load32(rax, slot(ins[4]))
+		load(ins[3], []byte{0x0f, 0xbf}, true, 2)
+	case opcodes.I32Load16U, opcodes.I64Load16U:
+		a.load32(rax, slot(ins[4]))
+		load(ins[3], []byte{0x0f, 0xb7}, false, 2)
+	case opcodes.I32Store, opcodes.I64Store32:
+		store([]byte{0x89}, false, false, 4)
+	case opcodes.I64Store:
+		store([]byte{0x89}, false, true, 8)
+	case opcodes.I32Store8, opcodes.I64Store8:
+		store([]byte{0x88}, false, false, 1)
+	case opcodes.I32Store16, opcodes.I64Store16:
+		store([]byte{0x89}, true, false, 2)
+
+	case opcodes.I32LoadAddImm, opcodes.I64LoadAddImm:
+		// The add wraps around at 32 bits before the offset is added.
+		a.load32(rax, slot(ins[4]))
+		a.reg(false, []byte{0x81}, 0, rax) // add eax, imm32
+		a.emit32(ins[3])
+		if op == opcodes.I32LoadAddImm {
+			load(ins[2], []byte{0x8b}, false, 4)
+		} else {
+			load(ins[2], []byte{0x8b}, true, 8)
+		}
+
+	case opcodes.GetLocal:
+		a.load64(rax, local(ins[2]))
+		a.store64(slot(target), rax)
+	case opcodes.SetLocal:
+		a.load64(rax, slot(ins[3]))
+		a.store64(local(ins[2]), rax)
+	case opcodes.GetGlobal:
+		a.load64(rax, global(ins[2]))
+		a.store64(slot(target), rax)
+	case opcodes.SetGlobal:
+		a.load64(rax, slot(ins[3]))
+		a.store64(global(ins[2]), rax)
+
+	case opcodes.CurrentMemory:
+		a.reg(true, []byte{0x8b}, rax, r11) // mov rax, r11
+		a.reg(true, []byte{0xc1}, shiftShr, rax)
+		a.emit(16)
+		a.store64(slot(target), rax)
+
+	case opcodes.Phi:
+		a.load64(rax, ctxField(ctxYielded))
+		a.store64(slot(target), rax)
+
+	case opcodes.AddGas:
+		a.load64(rax, ctxField(ctxGas))
+		a.movImm64(rcx, uint64(ins[2])|uint64(ins[3])<<32)
+		a.reg(true, []byte{0x01}, rcx, rax) // add rax, rcx
+		a.exitIf(ccB, pc)
+		a.mem(true, aluCmp, rax, ctxField(ctxGasLimit))
+		a.exitIf(ccA, pc)
+		a.store64(ctxField(ctxGas), rax)
+
+	case opcodes.I32AddImm:
+		a.load32(rax, slot(ins[2]))
+		a.reg(false, []byte{0x81}, 0, rax) // add eax, imm32
+		a.emit32(ins[3])
+		a.signExtend32(rax)
+		a.store64(slot(target), rax)
+	case opcodes.I32EqImm:
+		a.load32(rax, slot(ins[2]))
+		a.reg(false, []byte{0x81}, 7, rax) // cmp eax, imm32
+		a.emit32(ins[3])
+		a.setcc(ccE)
+		a.store64(slot(target), rax)
+	case opcodes.I64AddImm:
+		a.load64(rax, slot(ins[2]))
+		a.movImm64(rcx, uint64(ins[3])|uint64(ins[4])<<32)
+		a.reg(true, []byte{0x01}, rcx, rax) // add rax, rcx
+		a.store64(slot(target), rax)
+	case opcodes.I64ShrUImm, opcodes.I64ShlImm:
+		ext := shiftShr
+		if op == opcodes.I64ShlImm {
+			ext = shiftShl
+		}
+		a.load64(rax, slot(ins[2]))
+		a.reg(true, []byte{0xc1}, ext, rax)
+		a.emit(byte(ins[3] % 64))
+		a.store64(slot(target), rax)
+	case opcodes.I32AddLocalImm:
+		a.load32(rax, local(ins[2]))
+		a.reg(false, []byte{0x81}, 0, rax) // add eax, imm32
+		a.emit32(ins[3])
+		a.signExtend32(rax)
+		a.store64(slot(target), rax)
+	case opcodes.I32EqLocalImm:
+		a.load32(rax, local(ins[2]))
+		a.reg(false, []byte{0x81}, 7, rax) // cmp eax, imm32
+		a.emit32(ins[3])
+		a.setcc(ccE)
+		a.store64(slot(target), rax)
+
+	case opcodes.Jmp:
+		a.jump(pc, ins[2], ins[3])
+	case opcodes.JmpIf:
+		a.mem(true, []byte{0x83}, 7, slot(ins[3])) // cmp qword [cond], 0
+		a.emit(0)
+		notTaken := a.jcc(ccE)
+		a.jump(pc, ins[2], ins[4])
+		a.bind(notTaken)
+	case opcodes.JmpEither:
+		a.mem(true, []byte{0x83}, 7, slot(ins[4])) // cmp qword [cond], 0
+		a.emit(0)
+		isZero := a.jcc(ccE)
+		a.jump(pc, ins[2], ins[5])
+		a.bind(isZero)
+		a.jump(pc, ins[3], ins[5])
+	case opcodes.JmpTable:
+		count := ins[2]
+		targets := ins[3 : 3+count]
+		defaultTarget, cond, yielded := ins[3+count], ins[4+count], ins[5+count]
+		a.load64(rax, slot(cond))
+		a.reg(true, []byte{0x81}, 7, rax) // cmp rax, count
+		a.emit32(count)
+		inRange := a.jcc(ccB)
+		a.jump(pc, defaultTarget, yielded)
+		a.bind(inRange)
+		a.tableJump(pc, targets, yielded)
+
+	case opcodes.JmpIfI32GtU, opcodes.JmpIfI32GeU, opcodes.JmpIfI64Ne:
+		w, cc := false, byte(ccBE)
+		switch op {
+		case opcodes.JmpIfI32GeU:
+			cc = ccB
+		case opcodes.JmpIfI64Ne:
+			w, cc = true, ccE
+		}
+		a.mem(w, []byte{0x8b}, rax, slot(ins[3]))
+		a.mem(w, aluCmp, rax, slot(ins[4]))
+		notTaken := a.jcc(cc)
+		a.jump(pc, ins[2], ins[5])
+		a.bind(notTaken)
+	case opcodes.JmpIfI32Or:
+		a.mem(false, []byte{0x8b}, rax, slot(ins[3]))
+		a.mem(false, aluOr, rax, slot(ins[4]))
+		notTaken := a.jcc(ccE)
+		a.jump(pc, ins[2], ins[5])
+		a.bind(notTaken)
+	case opcodes.JmpIfI64EqZ:
+		a.mem(true, []byte{0x83}, 7, slot(ins[3])) // cmp qword [a], 0
+		a.emit(0)
+		notTaken := a.jcc(ccNE)
+		a.jump(pc, ins[2], ins[4])
+		a.bind(notTaken)
+
+	default:
+		return false
+	}
+	return true
+}
+
+// tableJump jumps to targets[rax], rax being in range. It jumps into a
+// table of 5-byte jumps, each to a stub taking the jump to its target.
+func (a *amd64Asm) tableJump(pc int, targets []uint32, yielded uint32) {
+	a.emit(0x48, 0x8d, 0x0d) // lea rcx, [rip+table]
+	a.emit32(0)
+	leaEnd := len(a.code)
+	a.mem(true, []byte{0x8d}, rax, amd64Mem{base: rax, index: rax, scale: 2}) // lea rax, [rax+rax*4]
+	a.reg(true, []byte{0x01}, rcx, rax)                                       // add rax, rcx
+	a.reg(false, []byte{0xff}, 4, rax)                                        // jmp rax
+
+	a.patch32(leaEnd-4, int32(len(a.code)-leaEnd))
+	stubs := make([]int, len(targets))
+	for i := range targets {
+		stubs[i] = a.jmp()
+	}
+	for i, t := range targets {
+		a.bind(stubs[i])
+		a.jump(pc, t, yielded)
+	}
+}
@@ -0,0 +1,15 @@
+//go:build linux && amd64
+
+#include "textflag.h"
+
+// func callNative(entry uintptr, ctx *nativeContext)
+TEXT ·callNative(SB), NOSPLIT, $0-16
+	MOVQ ctx+8(FP), DI
+	MOVQ 0(DI), R8
+	MOVQ 8(DI), R9
+	MOVQ 16(DI), R10
+	MOVQ 24(DI), R11
+	MOVQ 32(DI), SI
+	MOVQ entry+0(FP), AX
+	CALL AX
+	RET
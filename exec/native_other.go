@@ -0,0 +1,15 @@
+//go:build !linux || !amd64
+
+package exec
+
+import "errors"
+
+const nativeSupported = false
+
+func compileNative(code *decodedCode) (*nativeCode, error) {
+	return nil, errors.New("native code is not supported on this platform")
+}
+
+func (vm *VirtualMachine) runNative(frame *Frame, pc int, trapFrame, trapPC *int) (*Frame, int) {
+	panic("native code is not supported on this platform")
+}
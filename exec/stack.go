@@ -0,0 +1,74 @@
+package exec
+
+const (
+	// initialCallStackSize is the number of frames a virtual machine starts
+	// with; the call stack doubles from there up to its maximum depth.
+	initialCallStackSize = 64
+
+	// valueStackChunkSize is the number of value slots of the first chunk of
+	// the value stack. Each further chunk is twice as large as the previous
+	// one, or as large as the frame that needs it.
+	valueStackChunkSize = 1024
+)
+
+// valueStack holds the registers and locals of the frames on the call stack.
+// Frames slice into chunks that are never moved, so that their slices stay
+// valid as the stack grows, and that are kept once allocated, so that calls
+// do not allocate once the stack has been as deep as it gets.
+type valueStack struct {
+	chunks [][]int64
+	chunk  int // index of the chunk in use
+	top    int // number of slots in use in that chunk
+}
+
+// alloc returns n zeroed slots on top of the stack, and the position of the
+// top before the call, to be restored by free.
+func (s *valueStack) alloc(n int) (values []int64, chunk int, top int) {
+	chunk, top = s.chunk, s.top
+	if s.chunks == nil {
+		s.chunks = [][]int64{make([]int64, max(valueStackChunkSize, n))}
+	} else if s.top+n > len(s.chunks[s.chunk]) {
+		s.chunk++
+		s.top = 0
+		size := max(2*len(s.chunks[s.chunk-1]), n)
+		if s.chunk == len(s.chunks) {
+			s.chunks = append(s.chunks, make([]int64, size))
+		} else if len(s.chunks[s.chunk]) < n {
+			// No frame uses chunks above the current one.
+			s.chunks[s.chunk] = make([]int64, size)
+		}
+	}
+
+	values = s.chunks[s.chunk][s.top : s.top+n : s.top+n]
+	for i := range values {
+		values[i] = 0
+	}
+	s.top += n
+	return values, chunk, top
+}
+
+// free pops the slots allocated since alloc returned chunk and top.
+func (s *valueStack) free(chunk int, top int) {
+	s.chunk, s.top = chunk, top
+}
+
+// growCallStack makes room for the frame at vm.CurrentFrame, or panics if the
+// call stack would exceed its maximum depth.
+func (vm *VirtualMachine) growCallStack() {
+	depth := maxCallStackDepth(vm.Config)
+	if vm.CurrentFrame >= depth {
+		panic("max call stack depth exceeded")
+	}
+
+	callStack := make([]Frame, min(max(2*len(vm.CallStack), initialCallStackSize), depth))
+	copy(callStack, vm.CallStack)
+	vm.CallStack = callStack
+}
+
+// maxCallStackDepth returns the maximum number of frames on the call stack.
+func maxCallStackDepth(config VMConfig) int {
+	if config.MaxCallStackDepth != 0 {
+		return config.MaxCallStackDepth
+	}
+	return DefaultCallStackSize
+}
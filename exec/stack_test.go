@@ -0,0 +1,77 @@
+package exec
+
+import (
+	"strings"
+	"testing"
+)
+
+const recursiveModule = `
+(module
+    (func $fib (export "fib") (param i32) (result i32)
+        get_local 0
+        i32.const 2
+        i32.lt_u
+        if (result i32)
+            get_local 0
+        else
+            get_local 0
+            i32.const 1
+            i32.sub
+            call $fib
+            get_local 0
+            i32.const 2
+            i32.sub
+            call $fib
+            i32.add
+        end
+    )
+    (func $down (export "down") (param i32) (result i32)
+        get_local 0
+        i32.const 1
+        i32.add
+        call $down
+    )
+)`
+
+func TestCallsDoNotAllocate(t *testing.T) {
+	for _, native := range []bool{false, true} {
+		vm, err := NewVirtualMachine([]byte(recursiveModule), VMConfig{EnableNative: native}, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fib, _ := vm.GetFunctionExport("fib")
+
+		// The first run grows the call stack and the value stack.
+		if ret, err := vm.Run(fib, 20); err != nil || ret != 6765 {
+			t.Fatalf("fib(20) = %d, %v; want 6765", ret, err)
+		}
+		allocs := testing.AllocsPerRun(10, func() {
+			vm.Run(fib, 20)
+		})
+		if allocs != 0 {
+			t.Errorf("native %v: %v allocations per run, want 0", native, allocs)
+		}
+	}
+}
+
+func TestMaxCallStackDepth(t *testing.T) {
+	for _, depth := range []int{0, 100, 1000} {
+		vm, err := NewVirtualMachine([]byte(recursiveModule), VMConfig{MaxCallStackDepth: depth}, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		down, _ := vm.GetFunctionExport("down")
+		_, err = vm.Run(down, 0)
+		trap, ok := err.(*Trap)
+		if !ok || !strings.Contains(trap.Err.Error(), "max call stack depth exceeded") {
+			t.Fatalf("depth %d: got %v, want the call stack depth to be exceeded", depth, err)
+		}
+		want := depth
+		if want == 0 {
+			want = DefaultCallStackSize
+		}
+		if len(trap.StackTrace) != want {
+			t.Errorf("depth %d: trapped with %d frames, want %d", depth, len(trap.StackTrace), want)
+		}
+	}
+}
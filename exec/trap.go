@@ -0,0 +1,187 @@
+package exec
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/perlin-network/life/compiler"
+	"github.com/perlin-network/life/utils"
+)
+
+// Trap is the error stored in ExitError when execution of a function aborts.
+// It records the instruction at which the fault occurred.
+type Trap struct {
+	Err        error
+	FunctionID int
+	IP         int                      // bytecode offset of the faulting instruction
+	WasmOffset int                      // offset of the faulting wasm instruction in the module binary; -1 if unknown
+	Source     *compiler.SourceLocation // nil if the module has no DWARF line info
+	StackTrace []FrameInfo              // innermost frame first
+}
+
+// FrameInfo describes a call frame in a stack trace.
+type FrameInfo struct {
+	FunctionID int
+	Name       string
+	IP         int                      // bytecode offset of the current instruction; the call instruction for callers
+	WasmOffset int                      // offset of the current wasm instruction in the module binary; -1 if unknown
+	Source     *compiler.SourceLocation // nil if the module has no DWARF line info
+	Locals     []LocalInfo              // parameters followed by locals
+}
+
+// LocalInfo is the value of a local in a call frame.
+type LocalInfo struct {
+	Name  string // empty if the name section does not name it
+	Value int64
+}
+
+func (f FrameInfo) String() string {
+	s := fmt.Sprintf("%s (func %d) +%d", f.Name, f.FunctionID, f.IP)
+	if f.WasmOffset >= 0 {
+		s += fmt.Sprintf(" @ 0x%x", f.WasmOffset)
+	}
+	if f.Source != nil {
+		s += " " + f.Source.String()
+	}
+	return s
+}
+
+func (t *Trap) Error() string {
+	if len(t.StackTrace) == 0 {
+		return t.Err.Error()
+	}
+	return fmt.Sprintf("%v at %s", t.Err, t.StackTrace[0])
+}
+
+// Unwrap returns the underlying error.
+func (t *Trap) Unwrap() error {
+	return t.Err
+}
+
+// NewTrap builds a Trap for an error raised outside of Execute, e.g. by an
+// import function. The fault is attributed to the last instruction executed
+// in the current frame.
+func (vm *VirtualMachine) NewTrap(err interface{}) *Trap {
+	if vm.CurrentFrame < 0 || vm.CurrentFrame >= len(vm.CallStack) {
+		return vm.newTrap(err, vm.CurrentFrame, -1)
+	}
+	frame := &vm.CallStack[vm.CurrentFrame]
+	return vm.newTrap(err, vm.CurrentFrame, instrStart(frame.Code, frame.IP-1))
+}
+
+func (vm *VirtualMachine) newTrap(err interface{}, frameIndex int, ip int) *Trap {
+	if t, ok := err.(*Trap); ok {
+		return t
+	}
+	if frameIndex < 0 || frameIndex >= len(vm.CallStack) {
+		return &Trap{Err: utils.UnifyError(err), FunctionID: -1, IP: -1, WasmOffset: -1}
+	}
+
+	trace := vm.stackTrace(frameIndex, ip)
+	return &Trap{
+		Err:        utils.UnifyError(err),
+		FunctionID: trace[0].FunctionID,
+		IP:         ip,
+		WasmOffset: trace[0].WasmOffset,
+		Source:     trace[0].Source,
+		StackTrace: trace,
+	}
+}
+
+// StackTrace returns the call stack, innermost frame first. After a trap,
+// it returns the stack at the time of the trap.
+func (vm *VirtualMachine) StackTrace() []FrameInfo {
+	if t, ok := vm.ExitError.(*Trap); ok {
+		return t.StackTrace
+	}
+	if vm.CurrentFrame < 0 || vm.CurrentFrame >= len(vm.CallStack) {
+		return nil
+	}
+
+	frame := &vm.CallStack[vm.CurrentFrame]
+	ip := frame.IP
+	if vm.Delegate != nil {
+		// An import call is in progress.
+		ip = instrStart(frame.Code, ip-1)
+	}
+	return vm.stackTrace(vm.CurrentFrame, ip)
+}
+
+// FprintStackTrace writes the call stack to w.
+func (vm *VirtualMachine) FprintStackTrace(w io.Writer) {
+	trace := vm.StackTrace()
+	fmt.Fprintln(w, "--- Begin stack trace ---")
+	for i, f := range trace {
+		line := fmt.Sprintf("<%d> [%d] %s", len(trace)-1-i, f.FunctionID, f.Name)
+		if f.WasmOffset >= 0 {
+			line += fmt.Sprintf(" @ 0x%x", f.WasmOffset)
+		}
+		if f.Source != nil {
+			line += " " + f.Source.String()
+		}
+		fmt.Fprintln(w, line)
+	}
+	fmt.Fprintln(w, "--- End stack trace ---")
+}
+
+// stackTrace builds the stack trace starting at the given frame, which is
+// executing the instruction at ip.
+func (vm *VirtualMachine) stackTrace(frameIndex int, ip int) []FrameInfo {
+	ret := make([]FrameInfo, 0, frameIndex+1)
+	for i := frameIndex; i >= 0; i-- {
+		frame := &vm.CallStack[i]
+		if i != frameIndex {
+			// Callers are suspended after their call instruction.
+			ip = instrStart(frame.Code, frame.IP-1)
+		}
+
+		info := FrameInfo{
+			FunctionID: frame.FunctionID,
+			Name:       vm.Module.FunctionName(frame.FunctionID),
+			IP:         ip,
+			WasmOffset: vm.wasmOffset(frame.FunctionID, ip),
+			Locals:     make([]LocalInfo, len(frame.Locals)),
+		}
+		if loc, ok := vm.Module.SourceLocation(info.WasmOffset); ok {
+			info.Source = &loc
+		}
+		for j, v := range frame.Locals {
+			info.Locals[j] = LocalInfo{
+				Name:  vm.Module.LocalName(frame.FunctionID, j),
+				Value: v,
+			}
+		}
+		ret = append(ret, info)
+	}
+	return ret
+}
+
+// instrStart returns the offset of the instruction containing the byte at ip.
+func instrStart(code []byte, ip int) (ret int) {
+	if ip < 0 {
+		return -1
+	}
+	defer func() {
+		if recover() != nil {
+			ret = ip
+		}
+	}()
+
+	for pos := 0; pos < len(code); {
+		next := pos + compiler.DecodeInstr(code, pos).Len
+		if next > ip {
+			return pos
+		}
+		pos = next
+	}
+	return ip
+}
+
+// wasmOffset returns the offset in the module binary of the wasm instruction
+// the bytecode at ip was compiled from, or -1 if it is unknown.
+func (vm *VirtualMachine) wasmOffset(functionID int, ip int) int {
+	if functionID < 0 || functionID >= len(vm.FunctionCode) {
+		return -1
+	}
+	return vm.FunctionCode[functionID].WasmOffset(ip)
+}
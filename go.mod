@@ -1,10 +1,11 @@
 module github.com/perlin-network/life
 
+go 1.27.1
+
 replace github.com/go-interpreter/wagon => github.com/perlin-network/wagon v0.3.1-0.20180825141017-f8cb99b55a39
 
-require (
-	github.com/go-interpreter/wagon v0.3.0
-	golang.org/x/tools v0.0.0-20180917221912-90fa682c2a6e // indirect
-)
+require github.com/go-interpreter/wagon v0.3.0
+
+require golang.org/x/tools v0.0.0-20180917221912-90fa682c2a6e // indirect
 
 replace golang.org/x/tools => github.com/golang/tools v0.0.0-20181023010539-40a48ad93fbe
@@ -48,7 +48,6 @@ func (vm *VirtualMachine) Init() (err error) {
 
 	// Instantiate a new WebAssembly VM with a few resolved imports.
 	vm.vm, err = exec.NewVirtualMachine(input, exec.VMConfig{
-		EnableJIT:          false,
 		DefaultMemoryPages: 128,
 		DefaultTableSize:   65536,
 	}, vm.resolver, nil)
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"text/tabwriter"
+
+	"github.com/perlin-network/life/compiler"
+	"github.com/perlin-network/life/wat"
+)
+
+// inspectMain implements `life inspect [-json] module.wasm`, which summarizes
+// the contents of a module.
+func inspectMain(args []string) {
+	fs := flag.NewFlagSet("inspect", flag.ExitOnError)
+	jsonFlag := fs.Bool("json", false, "print the summary as JSON")
+	fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, "usage: life inspect [-json] module.wasm")
+		os.Exit(2)
+	}
+
+	input, err := ioutil.ReadFile(fs.Arg(0))
+	if err != nil {
+		panic(err)
+	}
+	if wat.IsText(input) {
+		if input, err = wat.Parse(input); err != nil {
+			panic(err)
+		}
+	}
+
+	m, err := compiler.LoadModule(input)
+	if err != nil {
+		panic(err)
+	}
+	info, err := m.Inspect()
+	if err != nil {
+		panic(err)
+	}
+
+	if *jsonFlag {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(info); err != nil {
+			panic(err)
+		}
+		return
+	}
+	writeModuleInfo(os.Stdout, info)
+}
+
+func writeModuleInfo(out io.Writer, info *compiler.ModuleInfo) {
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	defer w.Flush()
+
+	if info.Name != "" {
+		fmt.Fprintf(w, "module %s\n\n", info.Name)
+	}
+
+	fmt.Fprintf(w, "sections (%d):\n", len(info.Sections))
+	for _, s := range info.Sections {
+		fmt.Fprintf(w, "  %d\t%s\toffset 0x%x\t%d bytes\n", s.ID, s.Name, s.Offset, s.Size)
+	}
+
+	fmt.Fprintf(w, "\nimports (%d):\n", len(info.Imports))
+	for _, imp := range info.Imports {
+		fmt.Fprintf(w, "  %s\t%s.%s\t%s\n", imp.Kind, imp.Module, imp.Field, imp.Type)
+	}
+
+	fmt.Fprintf(w, "\nexports (%d):\n", len(info.Exports))
+	for _, e := range info.Exports {
+		fmt.Fprintf(w, "  %s\t%d\t%q\n", e.Kind, e.Index, e.Name)
+	}
+
+	writeLimits := func(title, unit string, limits []compiler.LimitsInfo) {
+		fmt.Fprintf(w, "\n%s (%d):\n", title, len(limits))
+		for i, l := range limits {
+			max := "no maximum"
+			if l.Maximum != nil {
+				max = fmt.Sprintf("maximum %d", *l.Maximum)
+			}
+			fmt.Fprintf(w, "  %d\tinitial %d\t%s %s", i, l.Initial, max, unit)
+			if l.Import != "" {
+				fmt.Fprintf(w, "\timported from %s", l.Import)
+			}
+			fmt.Fprintln(w)
+		}
+	}
+	writeLimits("tables", "elements", info.Tables)
+	writeLimits("memories", "pages", info.Memories)
+
+	fmt.Fprintf(w, "\nglobals (%d):\n", len(info.Globals))
+	for _, g := range info.Globals {
+		mutability := "const"
+		if g.Mutable {
+			mutability = "mut"
+		}
+		fmt.Fprintf(w, "  %d\t%s %s\t", g.Index, mutability, g.Type)
+		if g.Import != "" {
+			fmt.Fprintf(w, "imported from %s\n", g.Import)
+		} else {
+			fmt.Fprintf(w, "= %s\n", g.Init)
+		}
+	}
+
+	fmt.Fprintf(w, "\nelement segments (%d):\n", len(info.Elements))
+	for i, s := range info.Elements {
+		fmt.Fprintf(w, "  %d\ttable %d\toffset %s\t%d elements\n", i, s.Index, s.Offset, s.Size)
+	}
+
+	fmt.Fprintf(w, "\ndata segments (%d):\n", len(info.Data))
+	for i, s := range info.Data {
+		fmt.Fprintf(w, "  %d\tmemory %d\toffset %s\t%d bytes\n", i, s.Index, s.Offset, s.Size)
+	}
+
+	fmt.Fprintf(w, "\ncustom sections (%d):\n", len(info.Customs))
+	for _, c := range info.Customs {
+		fmt.Fprintf(w, "  %q\t%d bytes\n", c.Name, c.Size)
+	}
+
+	if info.Start != nil {
+		fmt.Fprintf(w, "\nstart function: %d\n", *info.Start)
+	}
+
+	codeSize := 0
+	floatFuncs := 0
+	for _, f := range info.Functions {
+		codeSize += f.CodeSize
+		if f.FloatOps > 0 {
+			floatFuncs++
+		}
+	}
+	fmt.Fprintf(w, "\nfunctions (%d, %d bytes of code):\n", len(info.Functions), codeSize)
+	for _, f := range info.Functions {
+		name := f.Name
+		if name == "" {
+			name = "-"
+		}
+		fmt.Fprintf(w, "  %d\t%s\t%s\t%d locals\t%d bytes", f.Index, name, f.Signature, f.NumLocals, f.CodeSize)
+		if f.FloatOps > 0 {
+			fmt.Fprintf(w, "\t%d floating point ops", f.FloatOps)
+		}
+		fmt.Fprintln(w)
+	}
+
+	if info.UsesFloatingPoint {
+		fmt.Fprintf(w, "\nfloating point: used by %d functions\n", floatFuncs)
+	} else {
+		fmt.Fprintln(w, "\nfloating point: not used")
+	}
+}
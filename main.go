@@ -1,63 +1,75 @@
 package main
 
 import (
-	"flag"
 	"fmt"
-	"github.com/perlin-network/life/exec"
-	"github.com/perlin-network/life/gowasm"
-	"io/ioutil"
-	"time"
+	"os"
+	"strings"
 )
 
-func main() {
-	entryFunctionFlag := flag.String("entry", "app_main", "entry function id")
-	jitFlag := flag.Bool("jit", false, "enable jit")
-	flag.Parse()
-
-	// Read WebAssembly *.wasm file.
-	input, err := ioutil.ReadFile(flag.Arg(0))
-	if err != nil {
-		panic(err)
-	}
+type command struct {
+	name    string
+	summary string
+	main    func(args []string)
+}
 
-	// Instantiate a new WebAssembly VM with a few resolved imports.
-	vm, err := exec.NewVirtualMachine(input, exec.VMConfig{
-		EnableJIT:          *jitFlag,
-		DefaultMemoryPages: 128,
-		DefaultTableSize:   65536,
-	}, gowasm.NewResolver(), nil)
+var commands []command
 
-	if err != nil {
-		panic(err)
+func init() {
+	commands = []command{
+		{"run", "run a function of a module", runMain},
+		{"inspect", "summarize the contents of a module", inspectMain},
+		{"validate", "check that modules are valid", validateMain},
+		{"disasm", "dump functions at a stage of the compiler", disasmMain},
+		{"compile", "compile a module and report what was produced", compileMain},
+		{"transpile", "translate a module into a Go package", transpileMain},
+		{"bench", "time repeated runs of a function", benchMain},
+		{"print", "print a module in the text format (also wat2text)", printMain},
+		{"wat2text", "", printMain},
+		{"debug", "debug a function interactively", debugMain},
+		{"dap", "serve the Debug Adapter Protocol on stdin and stdout", dapMain},
+		{"cover", "report the coverage of profiles written by run -coverprofile", coverMain},
+		{"help", "show this help", func([]string) { usage(os.Stdout); os.Exit(0) }},
 	}
+}
 
-	// Get the function ID of the entry function to be executed.
-	entryID, ok := vm.GetFunctionExport(*entryFunctionFlag)
-	if !ok {
-		fmt.Printf("Entry function %s not found; starting from 0.\n", *entryFunctionFlag)
-		entryID = 0
+func usage(w *os.File) {
+	fmt.Fprintln(w, "usage: life <command> [flags] module.wasm [args...]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Commands:")
+	for _, c := range commands {
+		if c.summary != "" {
+			fmt.Fprintf(w, "  %-10s %s\n", c.name, c.summary)
+		}
 	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Modules may be in the binary (*.wasm) or text (*.wat) format. Run")
+	fmt.Fprintln(w, "`life <command> -h` for the flags of a command. Without a command, the")
+	fmt.Fprintln(w, "arguments are passed to run.")
+}
 
-	start := time.Now()
+func main() {
+	if len(os.Args) < 2 {
+		usage(os.Stderr)
+		os.Exit(2)
+	}
 
-	// If any function prior to the entry function was declared to be
-	// called by the module, run it first.
-	if vm.Module.Base.Start != nil {
-		startID := int(vm.Module.Base.Start.Index)
-		_, err := vm.Run(startID)
-		if err != nil {
-			vm.PrintStackTrace()
-			panic(err)
+	for _, c := range commands {
+		if os.Args[1] == c.name {
+			c.main(os.Args[2:])
+			return
 		}
 	}
 
-	// Run the WebAssembly module's entry function.
-	ret, err := vm.Run(entryID, 0, 0)
-	if err != nil {
-		vm.PrintStackTrace()
-		panic(err)
+	switch arg := os.Args[1]; {
+	case arg == "-h" || arg == "-help" || arg == "--help":
+		usage(os.Stdout)
+	case strings.HasPrefix(arg, "-") || strings.ContainsAny(arg, "./\\"):
+		// `life module.wasm` and `life -entry f module.wasm` predate the
+		// commands.
+		runMain(os.Args[1:])
+	default:
+		fmt.Fprintf(os.Stderr, "life: unknown command %q\n\n", arg)
+		usage(os.Stderr)
+		os.Exit(2)
 	}
-	end := time.Now()
-
-	fmt.Printf("return value = %d, duration = %v\n", ret, end.Sub(start))
-}
\ No newline at end of file
+}
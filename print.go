@@ -0,0 +1,52 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/perlin-network/life/compiler"
+	"github.com/perlin-network/life/wat"
+)
+
+// printMain implements `life print [flags] module.wasm`, also available as
+// `life wat2text`.
+func printMain(args []string) {
+	fs := flag.NewFlagSet("print", flag.ExitOnError)
+	dataLimitFlag := fs.Int("data-limit", 64, "bytes of each data segment to print; 0 prints all data")
+	outputFlag := fs.String("o", "", "output file (default stdout)")
+	fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, "usage: life print [-data-limit n] [-o file] module.wasm")
+		os.Exit(2)
+	}
+
+	input, err := ioutil.ReadFile(fs.Arg(0))
+	if err != nil {
+		panic(err)
+	}
+	if wat.IsText(input) {
+		if input, err = wat.Parse(input); err != nil {
+			panic(err)
+		}
+	}
+
+	m, err := compiler.LoadModule(input)
+	if err != nil {
+		panic(err)
+	}
+
+	out := os.Stdout
+	if *outputFlag != "" {
+		if out, err = os.Create(*outputFlag); err != nil {
+			panic(err)
+		}
+		defer out.Close()
+	}
+
+	if err := wat.Print(out, m, wat.PrintConfig{MaxDataBytes: *dataLimitFlag}); err != nil {
+		panic(err)
+	}
+}
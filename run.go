@@ -0,0 +1,473 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-interpreter/wagon/wasm"
+	"github.com/perlin-network/life/compiler"
+	"github.com/perlin-network/life/coverage"
+	"github.com/perlin-network/life/exec"
+	"github.com/perlin-network/life/gowasm"
+	"github.com/perlin-network/life/wasi"
+)
+
+// vmFlags are the flags shared by the commands that execute modules.
+type vmFlags struct {
+	entry          *string
+	resolver       *string
+	gasPolicy      *string
+	gasCost        *int64
+	softFloatCost  *int64
+	gasLimit       *uint64
+	memoryPages    *int
+	tableSize      *int
+	maxMemoryPages *int
+	maxTableSize   *int
+	maxCallDepth   *int
+	maxValueSlots  *int
+	noFloat        *bool
+	rejectFloat    *bool
+	detFloat       *bool
+	softFloat      *bool
+	optLevel       *int
+	native         *bool
+	lazy           *bool
+
+	// coverage counts the executions of each basic block; it is set by the
+	// commands that report coverage rather than by a flag.
+	coverage bool
+}
+
+func registerVMFlags(fs *flag.FlagSet) *vmFlags {
+	return &vmFlags{
+		entry:          fs.String("entry", "", "function to run, by export name or index (default app_main, or _start for WASI)"),
+		resolver:       fs.String("resolver", "gowasm", "imports available to the module: none, gowasm or wasi"),
+		gasPolicy:      fs.String("gas-policy", "none", "gas accounting: none, or simple to charge -gas-cost per instruction"),
+		gasCost:        fs.Int64("gas-cost", 1, "gas charged per instruction by the simple gas policy"),
+		softFloatCost:  fs.Int64("softfloat-gas-cost", 0, "gas charged per floating point instruction run in software by the simple gas policy; 0 for -gas-cost"),
+		gasLimit:       fs.Uint64("gas-limit", 0, "trap once more gas is used; 0 for no limit (implies -gas-policy simple)"),
+		memoryPages:    fs.Int("memory-pages", 128, "initial size of imported memories, in pages"),
+		tableSize:      fs.Int("table-size", 65536, "initial size of imported tables, in elements"),
+		maxMemoryPages: fs.Int("max-memory-pages", 0, "maximum size of memory, in pages; 0 for no limit"),
+		maxTableSize:   fs.Int("max-table-size", 0, "maximum size of the table, in elements; 0 for no limit"),
+		maxCallDepth:   fs.Int("max-call-depth", 0, fmt.Sprintf("maximum call stack depth; 0 for the default of %d", exec.DefaultCallStackSize)),
+		maxValueSlots:  fs.Int("max-value-slots", 0, "maximum number of registers and locals across the call stack; 0 for no limit"),
+		noFloat:        fs.Bool("no-fp", false, "reject floating point operations at run time"),
+		rejectFloat:    fs.Bool("reject-fp", false, "reject modules using floating point before running them"),
+		detFloat:       fs.Bool("deterministic-fp", false, "canonicalize NaNs and trap on invalid float to integer conversions"),
+		softFloat:      fs.Bool("softfloat", false, "run floating point arithmetic and conversions in software"),
+		optLevel:       fs.Int("O", 0, fmt.Sprintf("optimization level, 0 to %d", compiler.MaxOptLevel)),
+		native:         fs.Bool("native", false, "compile functions to machine code (linux/amd64 only); floating point and indirect calls are interpreted"),
+		lazy:           fs.Bool("lazy", false, "compile each function on its first call"),
+	}
+}
+
+func (f *vmFlags) config() exec.VMConfig {
+	return exec.VMConfig{
+		DefaultMemoryPages:         *f.memoryPages,
+		DefaultTableSize:           *f.tableSize,
+		MaxMemoryPages:             *f.maxMemoryPages,
+		MaxTableSize:               *f.maxTableSize,
+		MaxCallStackDepth:          *f.maxCallDepth,
+		MaxValueSlots:              *f.maxValueSlots,
+		GasLimit:                   *f.gasLimit,
+		DisableFloatingPoint:       *f.noFloat,
+		RejectFloatingPoint:        *f.rejectFloat,
+		DeterministicFloatingPoint: *f.detFloat,
+		SoftFloat:                  *f.softFloat,
+		OptLevel:                   *f.optLevel,
+		EnableNative:               *f.native,
+		LazyCompilation:            *f.lazy,
+		EnableCoverage:             f.coverage,
+	}
+}
+
+// check validates the flags, returning a usage error.
+func (f *vmFlags) check() error {
+	switch *f.resolver {
+	case "none", "gowasm", "wasi":
+	default:
+		return fmt.Errorf("unknown resolver %q", *f.resolver)
+	}
+	switch *f.gasPolicy {
+	case "none", "simple":
+	default:
+		return fmt.Errorf("unknown gas policy %q", *f.gasPolicy)
+	}
+	if *f.optLevel < 0 || *f.optLevel > compiler.MaxOptLevel {
+		return fmt.Errorf("optimization level %d out of range", *f.optLevel)
+	}
+	return nil
+}
+
+func (f *vmFlags) newGasPolicy() compiler.GasPolicy {
+	if *f.gasPolicy == "simple" || *f.gasLimit != 0 {
+		return &compiler.SimpleGasPolicy{
+			GasPerInstruction:          *f.gasCost,
+			GasPerSoftFloatInstruction: *f.softFloatCost,
+		}
+	}
+	return nil
+}
+
+// newResolver returns the import resolver. WASI modules get wasiArgs as their
+// command line.
+func (f *vmFlags) newResolver(wasiArgs []string) exec.ImportResolver {
+	switch *f.resolver {
+	case "gowasm":
+		return gowasm.NewResolver()
+	case "wasi":
+		return wasi.NewResolver(wasiArgs, os.Environ())
+	default:
+		return &exec.NopResolver{}
+	}
+}
+
+// splitArgs splits the arguments following the module path into the command
+// line of WASI modules and the arguments of the entry function. WASI modules
+// run from _start get the arguments as their command line.
+func (f *vmFlags) splitArgs(path string, args []string) (wasiArgs []string, funcArgs []string) {
+	if *f.resolver == "wasi" && *f.entry == "" {
+		return append([]string{path}, args...), nil
+	}
+	return []string{path}, args
+}
+
+// instantiate loads, compiles and instantiates the module at path.
+func (f *vmFlags) instantiate(path string, wasiArgs []string) (*exec.VirtualMachine, error) {
+	input, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return exec.NewVirtualMachine(input, f.config(), f.newResolver(wasiArgs), f.newGasPolicy())
+}
+
+// entryFunction returns the ID and name of the function to run. Without
+// -entry, app_main is run, or _start for WASI modules; if the module does not
+// export it, the first function is run instead.
+func (f *vmFlags) entryFunction(vm *exec.VirtualMachine) (int, string, error) {
+	if *f.entry != "" {
+		if id, ok := vm.GetFunctionExport(*f.entry); ok {
+			return id, *f.entry, nil
+		}
+		if id, err := strconv.Atoi(*f.entry); err == nil && id >= 0 && id < len(vm.FunctionCode) {
+			return id, vm.Module.FunctionName(id), nil
+		}
+		return 0, "", fmt.Errorf("entry function %s not found", *f.entry)
+	}
+
+	name := "app_main"
+	if *f.resolver == "wasi" {
+		name = "_start"
+	}
+	if id, ok := vm.GetFunctionExport(name); ok {
+		return id, name, nil
+	}
+	if len(vm.FunctionCode) == 0 {
+		return 0, "", errors.New("module has no functions")
+	}
+	fmt.Fprintf(os.Stderr, "Entry function %s not found; starting from 0.\n", name)
+	return 0, vm.Module.FunctionName(0), nil
+}
+
+// runMain implements `life run [flags] module.wasm [args...]`.
+func runMain(args []string) {
+	fs := flag.NewFlagSet("run", flag.ExitOnError)
+	vf := registerVMFlags(fs)
+	jsonFlag := fs.Bool("json", false, "print the result or trap as JSON")
+	coverFlag := fs.String("coverprofile", "", "write the execution count of each basic block to `file`, for life cover")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: life run [flags] module.wasm [args...]")
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Arguments are passed to the entry function and are written as type:value,")
+		fmt.Fprintln(os.Stderr, "e.g. i32:5 or f64:1.5; the type may be omitted. With -resolver wasi and no")
+		fmt.Fprintln(os.Stderr, "-entry, they are the command line of the module instead.")
+		fmt.Fprintln(os.Stderr)
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	if fs.NArg() < 1 {
+		fs.Usage()
+		os.Exit(2)
+	}
+	if err := vf.check(); err != nil {
+		fmt.Fprintf(os.Stderr, "life run: %v\n", err)
+		os.Exit(2)
+	}
+
+	vf.coverage = *coverFlag != ""
+
+	report := run(vf, fs.Arg(0), fs.Args()[1:], *coverFlag)
+
+	if *jsonFlag {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(report); err != nil {
+			panic(err)
+		}
+	} else {
+		report.write(os.Stdout)
+	}
+	os.Exit(report.exitStatus())
+}
+
+// runReport is the outcome of `life run`.
+type runReport struct {
+	Entry      string      `json:"entry,omitempty"`
+	Result     string      `json:"result,omitempty"`    // typed result, e.g. "i32:42"
+	ExitCode   *int        `json:"exit_code,omitempty"` // set if a WASI module called proc_exit
+	Gas        *uint64     `json:"gas,omitempty"`       // set if gas is accounted for
+	DurationNS int64       `json:"duration_ns"`
+	Trap       *trapReport `json:"trap,omitempty"`
+	Error      string      `json:"error,omitempty"` // error loading the module or preparing the call
+}
+
+// trapReport describes a trap and the call stack at the time it occurred.
+type trapReport struct {
+	Message    string        `json:"message"`
+	FunctionID int           `json:"function_id"`
+	WasmOffset int           `json:"wasm_offset"`
+	Source     string        `json:"source,omitempty"`
+	StackTrace []frameReport `json:"stack_trace"`
+}
+
+type frameReport struct {
+	FunctionID int    `json:"function_id"`
+	Function   string `json:"function"`
+	WasmOffset int    `json:"wasm_offset"`
+	Source     string `json:"source,omitempty"`
+}
+
+func newTrapReport(t *exec.Trap) *trapReport {
+	ret := &trapReport{
+		Message:    t.Err.Error(),
+		FunctionID: t.FunctionID,
+		WasmOffset: t.WasmOffset,
+		StackTrace: make([]frameReport, 0, len(t.StackTrace)),
+	}
+	if t.Source != nil {
+		ret.Source = t.Source.String()
+	}
+	for _, f := range t.StackTrace {
+		fr := frameReport{
+			FunctionID: f.FunctionID,
+			Function:   f.Name,
+			WasmOffset: f.WasmOffset,
+		}
+		if f.Source != nil {
+			fr.Source = f.Source.String()
+		}
+		ret.StackTrace = append(ret.StackTrace, fr)
+	}
+	return ret
+}
+
+func (r *runReport) exitStatus() int {
+	switch {
+	case r.Error != "" || r.Trap != nil:
+		return 1
+	case r.ExitCode != nil:
+		return *r.ExitCode
+	}
+	return 0
+}
+
+func (r *runReport) write(w io.Writer) {
+	if r.Error != "" {
+		fmt.Fprintf(w, "error: %s\n", r.Error)
+		return
+	}
+	if r.Trap != nil {
+		fmt.Fprintf(w, "trap: %s\n", r.Trap.Message)
+		for _, f := range r.Trap.StackTrace {
+			line := fmt.Sprintf("    at %s (func %d)", f.Function, f.FunctionID)
+			if f.WasmOffset >= 0 {
+				line += fmt.Sprintf(" @ 0x%x", f.WasmOffset)
+			}
+			if f.Source != "" {
+				line += " " + f.Source
+			}
+			fmt.Fprintln(w, line)
+		}
+	}
+	if r.Result != "" {
+		fmt.Fprintf(w, "result: %s\n", r.Result)
+	}
+	if r.ExitCode != nil {
+		fmt.Fprintf(w, "exit code: %d\n", *r.ExitCode)
+	}
+	if r.Gas != nil {
+		fmt.Fprintf(w, "gas: %d\n", *r.Gas)
+	}
+	fmt.Fprintf(w, "duration: %v\n", time.Duration(r.DurationNS))
+}
+
+// run runs the start function of the module, then its entry function. If
+// coverProfile is set, the block counts of the run are written to it.
+func run(vf *vmFlags, path string, args []string, coverProfile string) *runReport {
+	report := &runReport{}
+
+	wasiArgs, args := vf.splitArgs(path, args)
+
+	vm, err := vf.instantiate(path, wasiArgs)
+	if err != nil {
+		report.Error = err.Error()
+		return report
+	}
+
+	entryID, entryName, err := vf.entryFunction(vm)
+	if err != nil {
+		report.Error = err.Error()
+		return report
+	}
+	report.Entry = entryName
+
+	sig := vm.Module.FunctionSignature(entryID)
+	params, err := parseArgs(args, sig.ParamTypes)
+	if err != nil {
+		report.Error = err.Error()
+		return report
+	}
+
+	start := time.Now()
+	ret, err := runEntry(vm, entryID, params)
+	report.DurationNS = int64(time.Since(start))
+
+	if coverProfile != "" {
+		if err := writeCoverProfile(vm, coverProfile); err != nil {
+			report.Error = err.Error()
+			return report
+		}
+	}
+
+	if vf.newGasPolicy() != nil {
+		gas := vm.Gas
+		report.Gas = &gas
+	}
+
+	if err != nil {
+		t, ok := err.(*exec.Trap)
+		if !ok {
+			report.Error = err.Error()
+			return report
+		}
+		if exit, ok := t.Err.(*wasi.ExitError); ok {
+			report.ExitCode = &exit.Code
+			return report
+		}
+		report.Trap = newTrapReport(t)
+		return report
+	}
+
+	if len(sig.ReturnTypes) > 0 {
+		report.Result = formatValue(sig.ReturnTypes[0], ret)
+	}
+	return report
+}
+
+// writeCoverProfile writes the block counts collected by vm to path.
+func writeCoverProfile(vm *exec.VirtualMachine, path string) error {
+	p, err := coverage.NewProfile(vm)
+	if err != nil {
+		return err
+	}
+	if err := p.Add(vm); err != nil {
+		return err
+	}
+
+	return writeFile(path, func(w io.Writer) error {
+		_, err := p.WriteTo(w)
+		return err
+	})
+}
+
+// runEntry runs the start function of the module, if any, then the given
+// function.
+func runEntry(vm *exec.VirtualMachine, entryID int, params []int64) (int64, error) {
+	if vm.Module.Base.Start != nil {
+		if _, err := vm.Run(int(vm.Module.Base.Start.Index)); err != nil {
+			return 0, err
+		}
+	}
+	return vm.Run(entryID, params...)
+}
+
+// parseArgs converts arguments written as type:value, or just value, to the
+// parameters of a function with the given parameter types.
+func parseArgs(args []string, types []wasm.ValueType) ([]int64, error) {
+	if len(args) != len(types) {
+		return nil, fmt.Errorf("entry function takes %d arguments, got %d", len(types), len(args))
+	}
+
+	ret := make([]int64, len(args))
+	for i, arg := range args {
+		t := types[i]
+		value := arg
+		if j := strings.IndexByte(arg, ':'); j >= 0 {
+			if arg[:j] != t.String() {
+				return nil, fmt.Errorf("argument %d: expected %s, got %s", i, t, arg[:j])
+			}
+			value = arg[j+1:]
+		}
+
+		var err error
+		switch t {
+		case wasm.ValueTypeI32:
+			var v int64
+			if v, err = parseInt(value, 32); err == nil {
+				ret[i] = int64(uint32(v))
+			}
+		case wasm.ValueTypeI64:
+			ret[i], err = parseInt(value, 64)
+		case wasm.ValueTypeF32:
+			var v float64
+			if v, err = strconv.ParseFloat(value, 32); err == nil {
+				ret[i] = int64(math.Float32bits(float32(v)))
+			}
+		case wasm.ValueTypeF64:
+			var v float64
+			if v, err = strconv.ParseFloat(value, 64); err == nil {
+				ret[i] = int64(math.Float64bits(v))
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("argument %d: malformed %s %q", i, t, value)
+		}
+	}
+	return ret, nil
+}
+
+// parseInt parses a signed or unsigned integer of the given size.
+func parseInt(s string, bits int) (int64, error) {
+	if v, err := strconv.ParseInt(s, 0, bits); err == nil {
+		return v, nil
+	}
+	v, err := strconv.ParseUint(s, 0, bits)
+	return int64(v), err
+}
+
+// formatValue formats a value in the form accepted by parseArgs.
+func formatValue(t wasm.ValueType, v int64) string {
+	switch t {
+	case wasm.ValueTypeI32:
+		return fmt.Sprintf("i32:%d", int32(v))
+	case wasm.ValueTypeI64:
+		return fmt.Sprintf("i64:%d", v)
+	case wasm.ValueTypeF32:
+		return "f32:" + strconv.FormatFloat(float64(math.Float32frombits(uint32(v))), 'g', -1, 32)
+	case wasm.ValueTypeF64:
+		return "f64:" + strconv.FormatFloat(math.Float64frombits(uint64(v)), 'g', -1, 64)
+	}
+	return fmt.Sprintf("%s:%d", t, v)
+}
@@ -0,0 +1,100 @@
+package softfloat
+
+import "math"
+
+// F32IsNaN reports whether a is a NaN.
+func F32IsNaN(a uint32) bool { return f32.isNaN(uint64(a)) }
+
+func F32Add(a, b uint32) uint32 { return uint32(f32.add(uint64(a), uint64(b))) }
+func F32Sub(a, b uint32) uint32 { return uint32(f32.add(uint64(a), uint64(b^1<<31))) }
+func F32Mul(a, b uint32) uint32 { return uint32(f32.mul(uint64(a), uint64(b))) }
+func F32Div(a, b uint32) uint32 { return uint32(f32.div(uint64(a), uint64(b))) }
+func F32Min(a, b uint32) uint32 { return uint32(f32.min(uint64(a), uint64(b))) }
+func F32Max(a, b uint32) uint32 { return uint32(f32.max(uint64(a), uint64(b))) }
+func F32Sqrt(a uint32) uint32   { return uint32(f32.sqrt(uint64(a))) }
+func F32Ceil(a uint32) uint32   { return uint32(f32.roundToInt(uint64(a), roundCeil)) }
+func F32Floor(a uint32) uint32  { return uint32(f32.roundToInt(uint64(a), roundFloor)) }
+func F32Trunc(a uint32) uint32  { return uint32(f32.roundToInt(uint64(a), roundTrunc)) }
+
+// F32Nearest rounds a to an integer, ties to even.
+func F32Nearest(a uint32) uint32 { return uint32(f32.roundToInt(uint64(a), roundNearest)) }
+
+// F64IsNaN reports whether a is a NaN.
+func F64IsNaN(a uint64) bool { return f64.isNaN(a) }
+
+func F64Add(a, b uint64) uint64 { return f64.add(a, b) }
+func F64Sub(a, b uint64) uint64 { return f64.add(a, b^1<<63) }
+func F64Mul(a, b uint64) uint64 { return f64.mul(a, b) }
+func F64Div(a, b uint64) uint64 { return f64.div(a, b) }
+func F64Min(a, b uint64) uint64 { return f64.min(a, b) }
+func F64Max(a, b uint64) uint64 { return f64.max(a, b) }
+func F64Sqrt(a uint64) uint64   { return f64.sqrt(a) }
+func F64Ceil(a uint64) uint64   { return f64.roundToInt(a, roundCeil) }
+func F64Floor(a uint64) uint64  { return f64.roundToInt(a, roundFloor) }
+func F64Trunc(a uint64) uint64  { return f64.roundToInt(a, roundTrunc) }
+
+// F64Nearest rounds a to an integer, ties to even.
+func F64Nearest(a uint64) uint64 { return f64.roundToInt(a, roundNearest) }
+
+// F32ToF64 promotes a to f64, which is exact.
+func F32ToF64(a uint32) uint64 { return f64.convert(f32, uint64(a)) }
+
+// F64ToF32 demotes a to f32.
+func F64ToF32(a uint64) uint32 { return uint32(f32.convert(f64, a)) }
+
+func F32FromInt32(v int32) uint32   { return uint32(f32.fromInt(v < 0, uint64(abs(int64(v))))) }
+func F32FromUint32(v uint32) uint32 { return uint32(f32.fromInt(false, uint64(v))) }
+func F32FromInt64(v int64) uint32   { return uint32(f32.fromInt(v < 0, abs(v))) }
+func F32FromUint64(v uint64) uint32 { return uint32(f32.fromInt(false, v)) }
+func F64FromInt32(v int32) uint64   { return f64.fromInt(v < 0, abs(int64(v))) }
+func F64FromUint32(v uint32) uint64 { return f64.fromInt(false, uint64(v)) }
+func F64FromInt64(v int64) uint64   { return f64.fromInt(v < 0, abs(v)) }
+func F64FromUint64(v uint64) uint64 { return f64.fromInt(false, v) }
+
+// abs returns the magnitude of v, which is 2^63 for math.MinInt64.
+func abs(v int64) uint64 {
+	if v < 0 {
+		return -uint64(v)
+	}
+	return uint64(v)
+}
+
+// F32ToInt32 and the other conversions to integers round a toward zero. They
+// return false if a is a NaN or if the result is out of range.
+func F32ToInt32(a uint32) (int32, bool) {
+	v, ok := f32.toInt(uint64(a), -math.MinInt32, math.MaxInt32)
+	return int32(v), ok
+}
+
+func F32ToUint32(a uint32) (uint32, bool) {
+	v, ok := f32.toInt(uint64(a), 0, math.MaxUint32)
+	return uint32(v), ok
+}
+
+func F32ToInt64(a uint32) (int64, bool) {
+	v, ok := f32.toInt(uint64(a), -math.MinInt64, math.MaxInt64)
+	return int64(v), ok
+}
+
+func F32ToUint64(a uint32) (uint64, bool) {
+	return f32.toInt(uint64(a), 0, math.MaxUint64)
+}
+
+func F64ToInt32(a uint64) (int32, bool) {
+	v, ok := f64.toInt(a, -math.MinInt32, math.MaxInt32)
+	return int32(v), ok
+}
+
+func F64ToUint32(a uint64) (uint32, bool) {
+	v, ok := f64.toInt(a, 0, math.MaxUint32)
+	return uint32(v), ok
+}
+
+func F64ToInt64(a uint64) (int64, bool) {
+	v, ok := f64.toInt(a, -math.MinInt64, math.MaxInt64)
+	return int64(v), ok
+}
+
+func F64ToUint64(a uint64) (uint64, bool) {
+	return f64.toInt(a, 0, math.MaxUint64)
+}
@@ -0,0 +1,382 @@
+// Package softfloat implements the IEEE 754 operations of WebAssembly on the
+// bits of f32 and f64 values, with integer arithmetic only, so that results do
+// not depend on the floating point unit of the host.
+//
+// Results are rounded to nearest, ties to even. Every NaN produced is the
+// positive canonical NaN, whatever the NaNs of the operands.
+package softfloat
+
+import "math/bits"
+
+const (
+	// F32NaN and F64NaN are the canonical NaNs returned for every NaN result.
+	F32NaN uint32 = 0x7fc00000
+	F64NaN uint64 = 0x7ff8000000000000
+)
+
+// format describes a binary interchange format.
+type format struct {
+	mantBits uint // explicit mantissa bits
+	expBits  uint
+	bias     int
+}
+
+var (
+	f32 = format{mantBits: 23, expBits: 8, bias: 127}
+	f64 = format{mantBits: 52, expBits: 11, bias: 1023}
+)
+
+func (f format) signBit() uint64 { return 1 << (f.mantBits + f.expBits) }
+func (f format) maxExp() uint64  { return 1<<f.expBits - 1 }
+func (f format) minExp() int     { return 1 - f.bias }
+func (f format) inf() uint64     { return f.maxExp() << f.mantBits }
+
+func (f format) nan() uint64 {
+	return f.inf() | 1<<(f.mantBits-1)
+}
+
+func (f format) isNaN(a uint64) bool {
+	return a&^f.signBit() > f.inf()
+}
+
+func (f format) isInf(a uint64) bool {
+	return a&^f.signBit() == f.inf()
+}
+
+func (f format) isZero(a uint64) bool {
+	return a&^f.signBit() == 0
+}
+
+// unpack splits a finite value into its sign and an integer m and exponent e
+// such that its magnitude is m * 2^e.
+func (f format) unpack(a uint64) (sign bool, m uint64, e int) {
+	sign = a&f.signBit() != 0
+	exp := int(a >> f.mantBits & f.maxExp())
+	m = a & (1<<f.mantBits - 1)
+	if exp == 0 {
+		return sign, m, f.minExp() - int(f.mantBits)
+	}
+	return sign, m | 1<<f.mantBits, exp - f.bias - int(f.mantBits)
+}
+
+// pack rounds m * 2^e, plus a fraction of 2^e if sticky is set, to the
+// nearest value of the format. If sticky is set, m must have at least two
+// more significant bits than the mantissa of the format.
+func (f format) pack(sign bool, m uint64, e int, sticky bool) uint64 {
+	var s uint64
+	if sign {
+		s = f.signBit()
+	}
+	if m == 0 && !sticky {
+		return s
+	}
+
+	// The result is M * 2^q, with M an integer of mantBits + 1 bits if it is
+	// normal, or less if it is subnormal.
+	q := max(e+bits.Len64(m)-1, f.minExp()) - int(f.mantBits)
+	if shift := q - e; shift <= 0 {
+		m <<= uint(-shift)
+	} else {
+		var rem, half uint64
+		if shift >= 64 {
+			rem, half = m, 0
+			if shift == 64 {
+				half = 1 << 63
+			}
+			m = 0
+		} else {
+			rem, half = m&(1<<uint(shift)-1), 1<<uint(shift-1)
+			m >>= uint(shift)
+		}
+		if half != 0 && (rem > half || rem == half && (sticky || m&1 != 0)) {
+			m++
+		}
+		if m == 1<<(f.mantBits+1) {
+			m >>= 1
+			q++
+		}
+	}
+
+	if m < 1<<f.mantBits {
+		return s | m
+	}
+	exp := uint64(q + int(f.mantBits) + f.bias)
+	if exp >= f.maxExp() {
+		return s | f.inf()
+	}
+	return s | exp<<f.mantBits | m&(1<<f.mantBits-1)
+}
+
+// normalize shifts m left so that its most significant bit is bit msb.
+func normalize(m uint64, e int, msb int) (uint64, int) {
+	shift := msb + 1 - bits.Len64(m)
+	return m << uint(shift), e - shift
+}
+
+func (f format) add(a, b uint64) uint64 {
+	switch {
+	case f.isNaN(a) || f.isNaN(b):
+		return f.nan()
+	case f.isInf(a) && f.isInf(b) && a != b:
+		return f.nan()
+	case f.isInf(a):
+		return a
+	case f.isInf(b):
+		return b
+	case f.isZero(a) && f.isZero(b):
+		return a & b
+	case f.isZero(a):
+		return b
+	case f.isZero(b):
+		return a
+	}
+
+	sa, ma, ea := f.unpack(a)
+	sb, mb, eb := f.unpack(b)
+	ma, ea = normalize(ma, ea, 61)
+	mb, eb = normalize(mb, eb, 61)
+	if ea < eb || ea == eb && ma < mb {
+		sa, ma, ea, sb, mb, eb = sb, mb, eb, sa, ma, ea
+	}
+
+	sticky := false
+	if d := uint(ea - eb); d >= 64 {
+		mb, sticky = 0, true
+	} else if d > 0 {
+		sticky = mb&(1<<d-1) != 0
+		mb >>= d
+	}
+
+	if sa == sb {
+		return f.pack(sa, ma+mb, ea, sticky)
+	}
+	m := ma - mb
+	if sticky {
+		m--
+	}
+	if m == 0 && !sticky {
+		return 0
+	}
+	return f.pack(sa, m, ea, sticky)
+}
+
+func (f format) mul(a, b uint64) uint64 {
+	sign := (a^b)&f.signBit() != 0
+	switch {
+	case f.isNaN(a) || f.isNaN(b):
+		return f.nan()
+	case f.isInf(a) || f.isInf(b):
+		if f.isZero(a) || f.isZero(b) {
+			return f.nan()
+		}
+		return f.pack(sign, 0, 0, false) | f.inf()
+	case f.isZero(a) || f.isZero(b):
+		return f.pack(sign, 0, 0, false)
+	}
+
+	_, ma, ea := f.unpack(a)
+	_, mb, eb := f.unpack(b)
+	hi, lo := bits.Mul64(ma, mb)
+	e := ea + eb
+	if hi == 0 {
+		return f.pack(sign, lo, e, false)
+	}
+	s := uint(bits.Len64(hi))
+	return f.pack(sign, hi<<(64-s)|lo>>s, e+int(s), lo&(1<<s-1) != 0)
+}
+
+func (f format) div(a, b uint64) uint64 {
+	sign := (a^b)&f.signBit() != 0
+	switch {
+	case f.isNaN(a) || f.isNaN(b):
+		return f.nan()
+	case f.isInf(a) && f.isInf(b), f.isZero(a) && f.isZero(b):
+		return f.nan()
+	case f.isInf(a), f.isZero(b):
+		return f.pack(sign, 0, 0, false) | f.inf()
+	case f.isInf(b), f.isZero(a):
+		return f.pack(sign, 0, 0, false)
+	}
+
+	_, ma, ea := f.unpack(a)
+	_, mb, eb := f.unpack(b)
+	ma, ea = normalize(ma, ea, 62)
+	mb, eb = normalize(mb, eb, 63)
+	q, r := bits.Div64(ma, 0, mb)
+	return f.pack(sign, q, ea-eb-64, r != 0)
+}
+
+func (f format) sqrt(a uint64) uint64 {
+	switch {
+	case f.isNaN(a):
+		return f.nan()
+	case f.isZero(a):
+		return a
+	case a&f.signBit() != 0:
+		return f.nan()
+	case f.isInf(a):
+		return a
+	}
+
+	// sqrt(m * 2^e) = sqrt(m * 2^s) * 2^((e-s)/2), with m * 2^s a 125 or 126
+	// bit integer and e-s even, so that its square root has 63 bits.
+	_, m, e := f.unpack(a)
+	s := 126 - bits.Len64(m)
+	if (e-s)%2 != 0 {
+		s--
+	}
+	var hi, lo uint64
+	if s >= 64 {
+		hi = m << uint(s-64)
+	} else {
+		hi, lo = m>>uint(64-s), m<<uint(s)
+	}
+
+	// Newton's method on integers, decreasing from above the root.
+	r := uint64(1)<<63 - 1
+	for {
+		q, _ := bits.Div64(hi, lo, r)
+		next := (r + q) / 2
+		if next >= r {
+			break
+		}
+		r = next
+	}
+	rhi, rlo := bits.Mul64(r, r)
+	return f.pack(false, r, (e-s)/2, rhi != hi || rlo != lo)
+}
+
+func (f format) min(a, b uint64) uint64 {
+	switch {
+	case f.isNaN(a) || f.isNaN(b):
+		return f.nan()
+	case f.isZero(a) && f.isZero(b):
+		return a | b
+	case f.less(b, a):
+		return b
+	}
+	return a
+}
+
+func (f format) max(a, b uint64) uint64 {
+	switch {
+	case f.isNaN(a) || f.isNaN(b):
+		return f.nan()
+	case f.isZero(a) && f.isZero(b):
+		return a & b
+	case f.less(a, b):
+		return b
+	}
+	return a
+}
+
+// less reports whether a < b, for values other than NaNs.
+func (f format) less(a, b uint64) bool {
+	key := func(v uint64) int64 {
+		if v&f.signBit() != 0 {
+			return -int64(v &^ f.signBit())
+		}
+		return int64(v)
+	}
+	return key(a) < key(b)
+}
+
+// Rounding modes of roundToInt.
+const (
+	roundTrunc = iota
+	roundFloor
+	roundCeil
+	roundNearest
+)
+
+func (f format) roundToInt(a uint64, mode int) uint64 {
+	switch {
+	case f.isNaN(a):
+		return f.nan()
+	case f.isInf(a) || f.isZero(a):
+		return a
+	}
+
+	sign, m, e := f.unpack(a)
+	if e >= 0 {
+		return a
+	}
+	var i, frac, half uint64
+	if s := uint(-e); s < 64 {
+		i, frac, half = m>>s, m&(1<<s-1), 1<<(s-1)
+	} else {
+		frac, half = m, ^uint64(0)
+	}
+
+	switch mode {
+	case roundFloor:
+		if sign && frac != 0 {
+			i++
+		}
+	case roundCeil:
+		if !sign && frac != 0 {
+			i++
+		}
+	case roundNearest:
+		if frac > half || frac == half && i&1 != 0 {
+			i++
+		}
+	}
+	return f.pack(sign, i, 0, false)
+}
+
+// truncate returns the magnitude of a rounded toward zero, or false if it
+// does not fit in 64 bits. a must not be a NaN.
+func (f format) truncate(a uint64) (sign bool, i uint64, ok bool) {
+	if f.isInf(a) {
+		return a&f.signBit() != 0, 0, false
+	}
+	sign, m, e := f.unpack(a)
+	switch {
+	case m == 0:
+		return sign, 0, true
+	case e >= 0:
+		if bits.Len64(m)+e > 64 {
+			return sign, 0, false
+		}
+		return sign, m << uint(e), true
+	case -e >= 64:
+		return sign, 0, true
+	}
+	return sign, m >> uint(-e), true
+}
+
+// toInt rounds a toward zero to an integer in [-neg, pos], or returns false if
+// a is a NaN or out of range.
+func (f format) toInt(a uint64, neg, pos uint64) (uint64, bool) {
+	if f.isNaN(a) {
+		return 0, false
+	}
+	sign, i, ok := f.truncate(a)
+	switch {
+	case !ok:
+		return 0, false
+	case sign && i <= neg:
+		return -i, true
+	case !sign && i <= pos:
+		return i, true
+	}
+	return 0, false
+}
+
+// fromInt converts the integer of magnitude m to the format.
+func (f format) fromInt(sign bool, m uint64) uint64 {
+	return f.pack(sign, m, 0, false)
+}
+
+// convert converts a from format from to format f.
+func (f format) convert(from format, a uint64) uint64 {
+	switch {
+	case from.isNaN(a):
+		return f.nan()
+	case from.isInf(a):
+		return f.pack(a&from.signBit() != 0, 0, 0, false) | f.inf()
+	}
+	sign, m, e := from.unpack(a)
+	return f.pack(sign, m, e, false)
+}
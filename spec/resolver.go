@@ -0,0 +1,81 @@
+package spec
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/perlin-network/life/exec"
+)
+
+// resolver resolves imports from the spectest module and from the modules
+// registered by a script.
+type resolver struct {
+	registered map[string]*exec.VirtualMachine
+}
+
+func (r *resolver) ResolveFunc(module, field string) exec.FunctionImport {
+	if module == "spectest" {
+		switch field {
+		case "print", "print_i32", "print_i64", "print_f32", "print_f64", "print_i32_f32", "print_f64_f64":
+			return func(vm *exec.VirtualMachine) int64 { return 0 }
+		}
+		panic(fmt.Errorf("unknown import %s.%s", module, field))
+	}
+
+	target, ok := r.registered[module]
+	if !ok {
+		panic(fmt.Errorf("unknown module %q", module))
+	}
+	id, ok := target.GetFunctionExport(field)
+	if !ok {
+		panic(fmt.Errorf("unknown import %s.%s", module, field))
+	}
+	numParams := target.FunctionCode[id].NumParams
+	return func(vm *exec.VirtualMachine) int64 {
+		ret, err := target.Run(id, vm.GetCurrentFrame().Locals[:numParams]...)
+		if err != nil {
+			clearExit(target)
+			panic(err)
+		}
+		return ret
+	}
+}
+
+func (r *resolver) ResolveGlobal(module, field string) int64 {
+	if module == "spectest" {
+		switch field {
+		case "global_i32", "global_i64":
+			return 666
+		case "global_f32":
+			return int64(math.Float32bits(666))
+		case "global_f64":
+			return int64(math.Float64bits(666))
+		}
+		panic(fmt.Errorf("unknown import %s.%s", module, field))
+	}
+
+	target, ok := r.registered[module]
+	if !ok {
+		panic(fmt.Errorf("unknown module %q", module))
+	}
+	id, ok := target.GetGlobalExport(field)
+	if !ok {
+		panic(fmt.Errorf("unknown import %s.%s", module, field))
+	}
+	return target.Globals[id]
+}
+
+func (r *resolver) Clone() exec.ImportResolver {
+	return r
+}
+
+func (r *resolver) Reset() {}
+
+// clearExit makes a virtual machine that exited with an error runnable again
+// without resetting its memory and globals.
+func clearExit(vm *exec.VirtualMachine) {
+	vm.ExitError = nil
+	vm.Exited = true
+	vm.CurrentFrame = -1
+	vm.Delegate = nil
+}
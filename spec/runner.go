@@ -0,0 +1,352 @@
+package spec
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/perlin-network/life/compiler"
+	"github.com/perlin-network/life/exec"
+	"github.com/perlin-network/life/utils"
+	"github.com/perlin-network/life/wat"
+)
+
+var errSkipped = errors.New("skipped")
+
+// trapError is returned by actions that trapped.
+type trapError struct {
+	err error
+}
+
+func (e *trapError) Error() string {
+	return "trap: " + e.err.Error()
+}
+
+// KnownFailures maps "<script file>:<line>" to the reason the command at that
+// line is expected to fail.
+type KnownFailures map[string]string
+
+// LoadKnownFailures reads a known failures list. Each line holds a
+// <script file>:<line> key followed by the reason; # starts a comment.
+func LoadKnownFailures(filename string) (KnownFailures, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	ret := make(KnownFailures)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		fields := strings.SplitN(text, " ", 2)
+		reason := ""
+		if len(fields) == 2 {
+			reason = strings.TrimSpace(fields[1])
+		}
+		ret[fields[0]] = reason
+	}
+	return ret, scanner.Err()
+}
+
+// Failure is a command that did not behave as expected.
+type Failure struct {
+	Line    int
+	Command string
+	Message string
+	Known   bool
+}
+
+// Result summarizes the run of a script.
+type Result struct {
+	File     string
+	Passed   int
+	Failed   int // failures not in the known failures list
+	Known    int // failures in the known failures list
+	Skipped  int
+	Failures []Failure
+	Fixed    []int // lines of known failures that passed
+
+	// Gas maps the line of each invoke action to the gas it used, trapped or
+	// not.
+	Gas map[int]uint64
+}
+
+// Runner runs spec test scripts.
+type Runner struct {
+	Config        exec.VMConfig
+	GasPolicy     compiler.GasPolicy
+	KnownFailures KnownFailures
+}
+
+// NewRunner creates a runner with the configuration used by the conformance suite.
+func NewRunner() *Runner {
+	return &Runner{
+		Config: exec.VMConfig{
+			MaxMemoryPages:     1024,
+			DefaultMemoryPages: 1,
+			DefaultTableSize:   10,
+		},
+		GasPolicy: &compiler.SimpleGasPolicy{GasPerInstruction: 1},
+	}
+}
+
+// RunFile runs a script. Module files are resolved relative to the script.
+func (r *Runner) RunFile(filename string) (*Result, error) {
+	script, err := LoadScript(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &scriptRun{
+		runner: r,
+		dir:    filepath.Dir(filename),
+		named:  make(map[string]*exec.VirtualMachine),
+		resolver: &resolver{
+			registered: make(map[string]*exec.VirtualMachine),
+		},
+		result: &Result{
+			File: filepath.Base(filename),
+			Gas:  make(map[int]uint64),
+		},
+	}
+	for _, cmd := range script.Commands {
+		s.run(cmd)
+	}
+	return s.result, nil
+}
+
+// CompareGas runs a script with the baseline runner and records a failure in
+// result for each action whose gas usage differs.
+func CompareGas(baseline *Runner, filename string, result *Result) error {
+	expected, err := baseline.RunFile(filename)
+	if err != nil {
+		return err
+	}
+
+	lines := make([]int, 0, len(expected.Gas))
+	for line := range expected.Gas {
+		lines = append(lines, line)
+	}
+	sort.Ints(lines)
+
+	for _, line := range lines {
+		if gas, ok := result.Gas[line]; !ok || gas != expected.Gas[line] {
+			result.Failed++
+			result.Failures = append(result.Failures, Failure{
+				Line:    line,
+				Command: "gas",
+				Message: fmt.Sprintf("used %d gas, %d without optimizations", gas, expected.Gas[line]),
+			})
+		}
+	}
+	return nil
+}
+
+type scriptRun struct {
+	runner   *Runner
+	dir      string
+	current  *exec.VirtualMachine
+	named    map[string]*exec.VirtualMachine
+	resolver *resolver
+	result   *Result
+	line     int // line of the command being run
+}
+
+func (s *scriptRun) run(cmd Command) {
+	s.line = cmd.Line
+	err := s.exec(cmd)
+
+	key := s.result.File + ":" + strconv.Itoa(cmd.Line)
+	_, known := s.runner.KnownFailures[key]
+
+	switch {
+	case err == errSkipped:
+		s.result.Skipped++
+	case err == nil:
+		s.result.Passed++
+		if known {
+			s.result.Fixed = append(s.result.Fixed, cmd.Line)
+		}
+	default:
+		if known {
+			s.result.Known++
+		} else {
+			s.result.Failed++
+		}
+		s.result.Failures = append(s.result.Failures, Failure{
+			Line:    cmd.Line,
+			Command: cmd.Type,
+			Message: err.Error(),
+			Known:   known,
+		})
+	}
+}
+
+func (s *scriptRun) exec(cmd Command) (retErr error) {
+	defer utils.CatchPanic(&retErr)
+
+	switch cmd.Type {
+	case "module":
+		vm, err := s.instantiate(cmd.Filename)
+		if err != nil {
+			return err
+		}
+		s.current = vm
+		if cmd.Name != "" {
+			s.named[cmd.Name] = vm
+		}
+	case "register":
+		vm, err := s.module(cmd.Name)
+		if err != nil {
+			return err
+		}
+		s.resolver.registered[cmd.As] = vm
+	case "action":
+		_, _, err := s.perform(cmd.Action)
+		return err
+	case "assert_return":
+		ret, hasResult, err := s.perform(cmd.Action)
+		if err != nil {
+			return err
+		}
+		switch {
+		case len(cmd.Expected) == 0 && !hasResult:
+		case len(cmd.Expected) == 1 && hasResult:
+			return checkValue(cmd.Expected[0], ret)
+		default:
+			return fmt.Errorf("result count mismatch: expected %d", len(cmd.Expected))
+		}
+	case "assert_return_canonical_nan", "assert_return_arithmetic_nan":
+		ret, hasResult, err := s.perform(cmd.Action)
+		if err != nil {
+			return err
+		}
+		if !hasResult || len(cmd.Expected) != 1 {
+			return fmt.Errorf("result count mismatch: expected 1")
+		}
+		expected := Value{Type: cmd.Expected[0].Type, Value: canonicalNaN}
+		if cmd.Type == "assert_return_arithmetic_nan" {
+			expected.Value = arithmeticNaN
+		}
+		return checkValue(expected, ret)
+	case "assert_trap", "assert_exhaustion":
+		if cmd.Filename != "" {
+			if _, err := s.instantiate(cmd.Filename); err == nil {
+				return fmt.Errorf("module instantiated, expected trap %q", cmd.Text)
+			}
+			return nil
+		}
+		_, _, err := s.perform(cmd.Action)
+		if err == nil {
+			return fmt.Errorf("returned normally, expected trap %q", cmd.Text)
+		}
+		if _, ok := err.(*trapError); !ok {
+			return err
+		}
+	case "assert_invalid", "assert_malformed":
+		input, err := ioutil.ReadFile(filepath.Join(s.dir, cmd.Filename))
+		if err != nil {
+			return err
+		}
+		if cmd.ModuleType == "text" {
+			if input, err = wat.Parse(input); err != nil {
+				return nil
+			}
+		}
+		if _, err := compiler.LoadModule(input); err == nil {
+			return fmt.Errorf("module loaded, expected %q", cmd.Text)
+		}
+	case "assert_unlinkable", "assert_uninstantiable":
+		if _, err := s.instantiate(cmd.Filename); err == nil {
+			return fmt.Errorf("module instantiated, expected %q", cmd.Text)
+		}
+	default:
+		return fmt.Errorf("unknown command type %q", cmd.Type)
+	}
+	return nil
+}
+
+func (s *scriptRun) instantiate(filename string) (*exec.VirtualMachine, error) {
+	input, err := ioutil.ReadFile(filepath.Join(s.dir, filename))
+	if err != nil {
+		return nil, err
+	}
+	vm, err := exec.NewVirtualMachine(input, s.runner.Config, s.resolver, s.runner.GasPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	// Like other embedders, the runner runs the start function itself.
+	if vm.Module.Base.Start != nil {
+		if _, err := vm.Run(int(vm.Module.Base.Start.Index)); err != nil {
+			return nil, err
+		}
+	}
+	return vm, nil
+}
+
+func (s *scriptRun) module(name string) (*exec.VirtualMachine, error) {
+	if name == "" {
+		if s.current == nil {
+			return nil, errors.New("no module instantiated")
+		}
+		return s.current, nil
+	}
+	vm, ok := s.named[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown module %q", name)
+	}
+	return vm, nil
+}
+
+// perform runs an action and returns its result, if any.
+func (s *scriptRun) perform(action Action) (int64, bool, error) {
+	vm, err := s.module(action.Module)
+	if err != nil {
+		return 0, false, err
+	}
+
+	switch action.Type {
+	case "invoke":
+		id, ok := vm.GetFunctionExport(action.Field)
+		if !ok {
+			return 0, false, fmt.Errorf("unknown function export %q", action.Field)
+		}
+		code := &vm.FunctionCode[id]
+		if len(action.Args) != code.NumParams {
+			return 0, false, fmt.Errorf("%s takes %d arguments, got %d", action.Field, code.NumParams, len(action.Args))
+		}
+		args := make([]int64, len(action.Args))
+		for i, arg := range action.Args {
+			if args[i], err = parseValue(arg); err != nil {
+				return 0, false, err
+			}
+		}
+		gas := vm.Gas
+		ret, err := vm.Run(id, args...)
+		s.result.Gas[s.line] = vm.Gas - gas
+		if err != nil {
+			clearExit(vm)
+			return 0, false, &trapError{err}
+		}
+		return ret, code.NumReturns != 0, nil
+	case "get":
+		id, ok := vm.GetGlobalExport(action.Field)
+		if !ok {
+			return 0, false, fmt.Errorf("unknown global export %q", action.Field)
+		}
+		return vm.Globals[id], true, nil
+	default:
+		return 0, false, fmt.Errorf("unknown action type %q", action.Type)
+	}
+}
@@ -0,0 +1,58 @@
+// Package spec runs WebAssembly specification test scripts against Life.
+//
+// Scripts are in the JSON format produced by wast2json: a list of commands
+// referring to module binaries stored next to the script. The scripts and
+// modules of the conformance suite are checked in under spec/testdata.
+package spec
+
+import (
+	"encoding/json"
+	"io/ioutil"
+)
+
+// Script is a spec test script.
+type Script struct {
+	SourceFilename string    `json:"source_filename"`
+	Commands       []Command `json:"commands"`
+}
+
+// Command is a single command of a script.
+type Command struct {
+	Type       string  `json:"type"`
+	Line       int     `json:"line"`
+	Filename   string  `json:"filename"`
+	Name       string  `json:"name"`
+	As         string  `json:"as"`
+	Action     Action  `json:"action"`
+	Text       string  `json:"text"`
+	ModuleType string  `json:"module_type"`
+	Expected   []Value `json:"expected"`
+}
+
+// Action invokes an exported function or reads an exported global.
+type Action struct {
+	Type   string  `json:"type"`
+	Module string  `json:"module"`
+	Field  string  `json:"field"`
+	Args   []Value `json:"args"`
+}
+
+// Value is a typed value. Value holds the bit pattern of the value as an
+// unsigned decimal integer, or nan:canonical / nan:arithmetic in expectations.
+type Value struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// LoadScript reads a script from a JSON file.
+func LoadScript(filename string) (*Script, error) {
+	raw, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	var s Script
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
@@ -0,0 +1,111 @@
+package spec
+
+import (
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/perlin-network/life/compiler"
+	"github.com/perlin-network/life/exec"
+)
+
+// suite is a directory of scripts run under one configuration.
+type suite struct {
+	name   string
+	dir    string
+	known  bool // apply testdata/known_failures.txt
+	config func(*exec.VMConfig)
+
+	// compareGas checks the gas used by each action against a run with the
+	// same configuration and optimizations disabled.
+	compareGas bool
+}
+
+var suites = []suite{
+	{name: "interpreter", dir: "testdata", known: true, config: func(*exec.VMConfig) {}},
+	{name: "-O 2", dir: "testdata", known: true, compareGas: true, config: func(c *exec.VMConfig) {
+		c.OptLevel = compiler.MaxOptLevel
+	}},
+	{name: "native", dir: "testdata", known: true, config: func(c *exec.VMConfig) {
+		c.EnableNative = true
+	}},
+	{name: "lazy", dir: "testdata", known: true, config: func(c *exec.VMConfig) {
+		c.LazyCompilation = true
+	}},
+
+	// The mode specific suites check behavior that differs from the default
+	// one and have no known failures.
+	{name: "deterministic", dir: "testdata/deterministic", config: func(c *exec.VMConfig) {
+		c.DeterministicFloatingPoint = true
+	}},
+	{name: "deterministic -O 2", dir: "testdata/deterministic", compareGas: true, config: func(c *exec.VMConfig) {
+		c.DeterministicFloatingPoint = true
+		c.OptLevel = compiler.MaxOptLevel
+	}},
+	{name: "softfloat", dir: "testdata/softfloat", config: func(c *exec.VMConfig) {
+		c.SoftFloat = true
+	}},
+	{name: "softfloat -O 2", dir: "testdata/softfloat", compareGas: true, config: func(c *exec.VMConfig) {
+		c.SoftFloat = true
+		c.OptLevel = compiler.MaxOptLevel
+	}},
+	{name: "softfloat deterministic", dir: "testdata/softfloat", config: func(c *exec.VMConfig) {
+		c.SoftFloat = true
+		c.DeterministicFloatingPoint = true
+	}},
+}
+
+func TestSpec(t *testing.T) {
+	known, err := LoadKnownFailures(filepath.Join("testdata", "known_failures.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, s := range suites {
+		s := s
+		t.Run(s.name, func(t *testing.T) {
+			files, err := filepath.Glob(filepath.Join(s.dir, "*.json"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(files) == 0 {
+				t.Fatalf("no scripts in %s", s.dir)
+			}
+			sort.Strings(files)
+
+			runner := NewRunner()
+			s.config(&runner.Config)
+			if s.known {
+				runner.KnownFailures = known
+			}
+			var baseline *Runner
+			if s.compareGas {
+				baseline = NewRunner()
+				baseline.Config = runner.Config
+				baseline.Config.OptLevel = 0
+			}
+
+			for _, file := range files {
+				result, err := runner.RunFile(file)
+				if err != nil {
+					t.Errorf("%s: %v", file, err)
+					continue
+				}
+				if baseline != nil {
+					if err := CompareGas(baseline, file, result); err != nil {
+						t.Errorf("%s: %v", file, err)
+						continue
+					}
+				}
+				for _, f := range result.Failures {
+					if !f.Known {
+						t.Errorf("%s:%d %s: %s", result.File, f.Line, f.Command, f.Message)
+					}
+				}
+				for _, line := range result.Fixed {
+					t.Errorf("%s:%d is listed as a known failure but passed", result.File, line)
+				}
+			}
+		})
+	}
+}
@@ -1,186 +1,110 @@
 package main
 
 import (
-	"encoding/json"
+	"flag"
 	"fmt"
-	"github.com/perlin-network/life/compiler"
-	"github.com/perlin-network/life/exec"
-	"io/ioutil"
 	"os"
-	"path"
 	"path/filepath"
+	"sort"
+
+	"github.com/perlin-network/life/spec"
 )
 
-type Resolver struct{}
+func main() {
+	knownPath := flag.String("known", "spec/testdata/known_failures.txt", "known failures list")
+	verbose := flag.Bool("v", false, "print known failures as well")
+	optLevel := flag.Int("O", 0, "optimization level; above 0, the gas used by each action is also checked against an unoptimized run")
+	native := flag.Bool("native", false, "compile functions to machine code")
+	lazy := flag.Bool("lazy", false, "compile each function on its first call")
+	detFloat := flag.Bool("deterministic-fp", false, "canonicalize NaNs and trap on invalid float to integer conversions")
+	softFloat := flag.Bool("softfloat", false, "run floating point arithmetic and conversions in software")
+	flag.Parse()
 
-func (r *Resolver) ResolveFunc(module, field string) exec.FunctionImport {
-	if module != "spectest" {
-		panic("module != spectest")
-	}
-	switch field {
-	case "print_i32":
-		return func(vm *exec.VirtualMachine) int64 { return 0 }
-	default:
-		panic(fmt.Errorf("func %s not found", field))
+	paths := flag.Args()
+	if len(paths) == 0 {
+		paths = []string{"spec/testdata"}
 	}
-}
 
-func (r *Resolver) ResolveGlobal(module, field string) int64 {
-	if module != "spectest" {
-		panic("module != spectest")
-	}
-	switch field {
-	case "global_i32":
-		return 0
-	default:
-		panic(fmt.Errorf("global %s not found", field))
+	var files []string
+	for _, p := range paths {
+		info, err := os.Stat(p)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		}
+		if !info.IsDir() {
+			files = append(files, p)
+			continue
+		}
+		matches, err := filepath.Glob(filepath.Join(p, "*.json"))
+		if err != nil {
+			panic(err)
+		}
+		sort.Strings(matches)
+		files = append(files, matches...)
 	}
-}
-
-type Config struct {
-	SourceFilename string    `json:"source_filename"`
-	Commands       []Command `json:"commands"`
-}
-
-type Command struct {
-	Type       string      `json:"type"`
-	Line       int         `json:"line"`
-	Filename   string      `json:"filename"`
-	Name       string      `json:"name"`
-	Action     CmdAction   `json:"action"`
-	Text       string      `json:"text"`
-	ModuleType string      `json:"module_type"`
-	Expected   []ValueInfo `json:"expected"`
-}
-
-type CmdAction struct {
-	Type     string      `json:"type"`
-	Module   string      `json:"module"`
-	Field    string      `json:"field"`
-	Args     []ValueInfo `json:"args"`
-	Expected []ValueInfo `json:"expected"`
-}
-
-type ValueInfo struct {
-	Type  string `json:"type"`
-	Value string `json:"value"`
-}
 
-func LoadConfigFromFile(filename string) *Config {
-	raw, err := ioutil.ReadFile(filename)
-	if err != nil {
-		panic(err)
-	}
-	var cfg Config
-	err = json.Unmarshal(raw, &cfg)
-	if err != nil {
-		panic(err)
+	runner := spec.NewRunner()
+	runner.Config.EnableNative = *native
+	runner.Config.LazyCompilation = *lazy
+	runner.Config.DeterministicFloatingPoint = *detFloat
+	runner.Config.SoftFloat = *softFloat
+	if *knownPath != "" {
+		known, err := spec.LoadKnownFailures(*knownPath)
+		if err != nil && !os.IsNotExist(err) {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		}
+		runner.KnownFailures = known
 	}
-	return &cfg
-}
-
-func (c *Config) Run(cfgPath string) error {
-	var vm *exec.VirtualMachine
-	namedVMs := make(map[string]*exec.VirtualMachine)
 
-	dir, _ := filepath.Split(cfgPath)
+	var baseline *spec.Runner
+	if *optLevel > 0 {
+		baseline = spec.NewRunner()
+		baseline.Config = runner.Config
+		runner.Config.OptLevel = *optLevel
+	}
 
-	for _, cmd := range c.Commands {
-		switch cmd.Type {
-		case "module":
-			input, err := ioutil.ReadFile(path.Join(dir, cmd.Filename))
-			if err != nil {
-				panic(err)
-			}
-			localVM, err := exec.NewVirtualMachine(input, exec.VMConfig{
-				//EnableJIT:      true,
-				MaxMemoryPages:       1024, // for memory trap tests
-				GasLimit:             0,    // unlimited
-				DisableFloatingPoint: false,
-			}, &Resolver{}, &compiler.SimpleGasPolicy{
-				GasPerInstruction: 1,
-			})
-			if err != nil {
-				panic(err)
-			}
-			vm = localVM
-			if cmd.Name != "" {
-				namedVMs[cmd.Name] = localVM
-			}
-		case "assert_return", "action":
-			localVM := vm
-			if cmd.Action.Module != "" {
-				if target, ok := namedVMs[cmd.Action.Module]; ok {
-					localVM = target
-				} else {
-					panic("named module not found")
-				}
+	var passed, failed, known, skipped, fixed int
+	for _, file := range files {
+		result, err := runner.RunFile(file)
+		if err != nil {
+			fmt.Printf("%s: %v\n", file, err)
+			failed++
+			continue
+		}
+		if baseline != nil {
+			if err := spec.CompareGas(baseline, file, result); err != nil {
+				fmt.Printf("%s: %v\n", file, err)
+				failed++
+				continue
 			}
+		}
 
-			switch cmd.Action.Type {
-			case "invoke":
-				entryID, ok := localVM.GetFunctionExport(cmd.Action.Field)
-				if !ok {
-					panic("export not found (func)")
-				}
-				args := make([]int64, 0)
-				for _, arg := range cmd.Action.Args {
-					var val uint64
-					fmt.Sscanf(arg.Value, "%d", &val)
-					args = append(args, int64(val))
-				}
-				fmt.Printf("Entry = %d\n", entryID)
-				ret, err := localVM.Run(entryID, args...)
-				if err != nil {
-					panic(err)
-				}
-				if len(cmd.Action.Expected) != 0 {
-					var _exp uint64
-					fmt.Sscanf(cmd.Action.Expected[0].Value, "%d", &_exp)
-					exp := int64(_exp)
-					if cmd.Action.Expected[0].Type == "i32" || cmd.Action.Expected[0].Type == "f32" {
-						ret = int64(uint32(ret))
-						exp = int64(uint32(exp))
-					}
-					if ret != exp {
-						panic(fmt.Errorf("ret mismatch: got %d, expected %d\n", ret, exp))
-					}
-				}
-			case "get":
-				globalID, ok := localVM.GetGlobalExport(cmd.Action.Field)
-				if !ok {
-					panic("export not found (global)")
-				}
-				val := localVM.Globals[globalID]
-				var _exp uint64
-				fmt.Sscanf(cmd.Expected[0].Value, "%d", &_exp)
-				exp := int64(_exp)
-				if cmd.Expected[0].Type == "i32" || cmd.Expected[0].Type == "f32" {
-					val = int64(uint32(val))
-					exp = int64(uint32(exp))
-				}
-				if val != exp {
-					panic(fmt.Errorf("val mismatch: got %d, expected %d\n", val, exp))
-				}
-			default:
-				panic(cmd.Action.Type)
+		fmt.Printf("%-28s %5d passed %5d failed %5d known %5d skipped\n", result.File, result.Passed, result.Failed, result.Known, result.Skipped)
+		for _, f := range result.Failures {
+			if f.Known && !*verbose {
+				continue
 			}
-		case "assert_trap", "assert_malformed", "assert_invalid", "assert_exhaustion", "assert_unlinkable",
-			"assert_return_canonical_nan", "assert_return_arithmetic_nan":
-			fmt.Printf("skipping %s\n", cmd.Type)
-		default:
-			panic(cmd.Type)
+			tag := "FAIL"
+			if f.Known {
+				tag = "KNOWN"
+			}
+			fmt.Printf("  %s %s:%d %s: %s\n", tag, result.File, f.Line, f.Command, f.Message)
+		}
+		for _, line := range result.Fixed {
+			fmt.Printf("  FIXED %s:%d is listed as a known failure but passed\n", result.File, line)
 		}
-		fmt.Printf("PASS L%d\n", cmd.Line)
-	}
 
-	return nil
-}
+		passed += result.Passed
+		failed += result.Failed
+		known += result.Known
+		skipped += result.Skipped
+		fixed += len(result.Fixed)
+	}
 
-func main() {
-	cfg := LoadConfigFromFile(os.Args[1])
-	err := cfg.Run(os.Args[1])
-	if err != nil {
-		panic(err)
+	fmt.Printf("total: %d passed, %d failed, %d known failures, %d skipped\n", passed, failed, known, skipped)
+	if failed != 0 || fixed != 0 {
+		os.Exit(1)
 	}
 }
@@ -0,0 +1,10 @@
+(module
+  (func (export "f32.min") (param f32 f32) (result f32) (f32.min (get_local 0) (get_local 1)))
+  (func (export "f32.max") (param f32 f32) (result f32) (f32.max (get_local 0) (get_local 1)))
+  (func (export "f64.min") (param f64 f64) (result f64) (f64.min (get_local 0) (get_local 1)))
+  (func (export "f64.max") (param f64 f64) (result f64) (f64.max (get_local 0) (get_local 1)))
+  (func (export "f32.nearest") (param f32) (result f32) (f32.nearest (get_local 0)))
+  (func (export "f64.nearest") (param f64) (result f64) (f64.nearest (get_local 0)))
+  (func (export "f32.add") (param f32 f32) (result f32) (f32.add (get_local 0) (get_local 1)))
+  (func (export "f64.div") (param f64 f64) (result f64) (f64.div (get_local 0) (get_local 1)))
+)
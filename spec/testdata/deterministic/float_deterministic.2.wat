@@ -0,0 +1,12 @@
+(module
+  (func (export "i32.reinterpret") (param f32) (result i32) (i32.reinterpret/f32 (get_local 0)))
+  (func (export "f32.reinterpret") (param i32) (result f32) (f32.reinterpret/i32 (get_local 0)))
+  (func (export "i64.reinterpret") (param f64) (result i64) (i64.reinterpret/f64 (get_local 0)))
+  (func (export "f64.reinterpret") (param i64) (result f64) (f64.reinterpret/i64 (get_local 0)))
+  (func (export "f32.neg") (param f32) (result f32) (f32.neg (get_local 0)))
+  (func (export "f32.abs") (param f32) (result f32) (f32.abs (get_local 0)))
+  (func (export "f32.copysign") (param f32 f32) (result f32) (f32.copysign (get_local 0) (get_local 1)))
+  (func (export "f64.neg") (param f64) (result f64) (f64.neg (get_local 0)))
+  (func (export "f64.abs") (param f64) (result f64) (f64.abs (get_local 0)))
+  (func (export "f64.copysign") (param f64 f64) (result f64) (f64.copysign (get_local 0) (get_local 1)))
+)
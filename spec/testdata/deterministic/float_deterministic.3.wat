@@ -0,0 +1,4 @@
+(module
+  (func (export "f64.convert_s/i32") (param i32) (result f64) (f64.convert_s/i32 (get_local 0)))
+  (func (export "f64.convert_u/i32") (param i32) (result f64) (f64.convert_u/i32 (get_local 0)))
+)
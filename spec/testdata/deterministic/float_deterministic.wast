@@ -0,0 +1,338 @@
+;; Every NaN produced by an arithmetic operation is the positive canonical
+;; NaN, whatever the NaNs of the operands and the host.
+(module
+  (func (export "f32.add") (param f32) (param f32) (result f32) (f32.add (get_local 0) (get_local 1)))
+  (func (export "f32.sub") (param f32) (param f32) (result f32) (f32.sub (get_local 0) (get_local 1)))
+  (func (export "f32.mul") (param f32) (param f32) (result f32) (f32.mul (get_local 0) (get_local 1)))
+  (func (export "f32.div") (param f32) (param f32) (result f32) (f32.div (get_local 0) (get_local 1)))
+  (func (export "f32.min") (param f32) (param f32) (result f32) (f32.min (get_local 0) (get_local 1)))
+  (func (export "f32.max") (param f32) (param f32) (result f32) (f32.max (get_local 0) (get_local 1)))
+  (func (export "f32.sqrt") (param f32) (result f32) (f32.sqrt (get_local 0)))
+  (func (export "f32.ceil") (param f32) (result f32) (f32.ceil (get_local 0)))
+  (func (export "f32.floor") (param f32) (result f32) (f32.floor (get_local 0)))
+  (func (export "f32.trunc") (param f32) (result f32) (f32.trunc (get_local 0)))
+  (func (export "f32.nearest") (param f32) (result f32) (f32.nearest (get_local 0)))
+  (func (export "f64.add") (param f64) (param f64) (result f64) (f64.add (get_local 0) (get_local 1)))
+  (func (export "f64.sub") (param f64) (param f64) (result f64) (f64.sub (get_local 0) (get_local 1)))
+  (func (export "f64.mul") (param f64) (param f64) (result f64) (f64.mul (get_local 0) (get_local 1)))
+  (func (export "f64.div") (param f64) (param f64) (result f64) (f64.div (get_local 0) (get_local 1)))
+  (func (export "f64.min") (param f64) (param f64) (result f64) (f64.min (get_local 0) (get_local 1)))
+  (func (export "f64.max") (param f64) (param f64) (result f64) (f64.max (get_local 0) (get_local 1)))
+  (func (export "f64.sqrt") (param f64) (result f64) (f64.sqrt (get_local 0)))
+  (func (export "f64.ceil") (param f64) (result f64) (f64.ceil (get_local 0)))
+  (func (export "f64.floor") (param f64) (result f64) (f64.floor (get_local 0)))
+  (func (export "f64.trunc") (param f64) (result f64) (f64.trunc (get_local 0)))
+  (func (export "f64.nearest") (param f64) (result f64) (f64.nearest (get_local 0)))
+  (func (export "f32.demote") (param f64) (result f32) (f32.demote/f64 (get_local 0)))
+  (func (export "f64.promote") (param f32) (result f64) (f64.promote/f32 (get_local 0)))
+  (func (export "f32.div-zero") (result f32) (f32.div (f32.const 0) (f32.const 0)))
+  (func (export "f64.div-zero") (result f64) (f64.div (f64.const 0) (f64.const 0)))
+  (func (export "f32.sqrt-neg") (result f32) (f32.sqrt (f32.const -1)))
+  (func (export "f64.sqrt-neg") (result f64) (f64.sqrt (f64.const -1)))
+  (func (export "f32.inf-sub") (param f32) (result f32) (f32.sub (get_local 0) (get_local 0)))
+  (func (export "f64.inf-mul") (param f64) (result f64) (f64.mul (get_local 0) (f64.const 0)))
+)
+(assert_return (invoke "f32.add" (f32.const 2141192193) (f32.const 1065353216)) (f32.const 2143289344))
+(assert_return (invoke "f32.add" (f32.const 4290772992) (f32.const 1065353216)) (f32.const 2143289344))
+(assert_return (invoke "f32.add" (f32.const 4286578689) (f32.const 1065353216)) (f32.const 2143289344))
+(assert_return (invoke "f32.add" (f32.const 2147483647) (f32.const 1065353216)) (f32.const 2143289344))
+(assert_return (invoke "f32.add" (f32.const 1065353216) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.sub" (f32.const 2141192193) (f32.const 1065353216)) (f32.const 2143289344))
+(assert_return (invoke "f32.sub" (f32.const 4290772992) (f32.const 1065353216)) (f32.const 2143289344))
+(assert_return (invoke "f32.sub" (f32.const 4286578689) (f32.const 1065353216)) (f32.const 2143289344))
+(assert_return (invoke "f32.sub" (f32.const 2147483647) (f32.const 1065353216)) (f32.const 2143289344))
+(assert_return (invoke "f32.sub" (f32.const 1065353216) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.mul" (f32.const 2141192193) (f32.const 1065353216)) (f32.const 2143289344))
+(assert_return (invoke "f32.mul" (f32.const 4290772992) (f32.const 1065353216)) (f32.const 2143289344))
+(assert_return (invoke "f32.mul" (f32.const 4286578689) (f32.const 1065353216)) (f32.const 2143289344))
+(assert_return (invoke "f32.mul" (f32.const 2147483647) (f32.const 1065353216)) (f32.const 2143289344))
+(assert_return (invoke "f32.mul" (f32.const 1065353216) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.div" (f32.const 2141192193) (f32.const 1065353216)) (f32.const 2143289344))
+(assert_return (invoke "f32.div" (f32.const 4290772992) (f32.const 1065353216)) (f32.const 2143289344))
+(assert_return (invoke "f32.div" (f32.const 4286578689) (f32.const 1065353216)) (f32.const 2143289344))
+(assert_return (invoke "f32.div" (f32.const 2147483647) (f32.const 1065353216)) (f32.const 2143289344))
+(assert_return (invoke "f32.div" (f32.const 1065353216) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.min" (f32.const 2141192193) (f32.const 1065353216)) (f32.const 2143289344))
+(assert_return (invoke "f32.min" (f32.const 4290772992) (f32.const 1065353216)) (f32.const 2143289344))
+(assert_return (invoke "f32.min" (f32.const 4286578689) (f32.const 1065353216)) (f32.const 2143289344))
+(assert_return (invoke "f32.min" (f32.const 2147483647) (f32.const 1065353216)) (f32.const 2143289344))
+(assert_return (invoke "f32.min" (f32.const 1065353216) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.max" (f32.const 2141192193) (f32.const 1065353216)) (f32.const 2143289344))
+(assert_return (invoke "f32.max" (f32.const 4290772992) (f32.const 1065353216)) (f32.const 2143289344))
+(assert_return (invoke "f32.max" (f32.const 4286578689) (f32.const 1065353216)) (f32.const 2143289344))
+(assert_return (invoke "f32.max" (f32.const 2147483647) (f32.const 1065353216)) (f32.const 2143289344))
+(assert_return (invoke "f32.max" (f32.const 1065353216) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.sqrt" (f32.const 2141192193)) (f32.const 2143289344))
+(assert_return (invoke "f32.sqrt" (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.sqrt" (f32.const 4286578689)) (f32.const 2143289344))
+(assert_return (invoke "f32.sqrt" (f32.const 2147483647)) (f32.const 2143289344))
+(assert_return (invoke "f32.ceil" (f32.const 2141192193)) (f32.const 2143289344))
+(assert_return (invoke "f32.ceil" (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.ceil" (f32.const 4286578689)) (f32.const 2143289344))
+(assert_return (invoke "f32.ceil" (f32.const 2147483647)) (f32.const 2143289344))
+(assert_return (invoke "f32.floor" (f32.const 2141192193)) (f32.const 2143289344))
+(assert_return (invoke "f32.floor" (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.floor" (f32.const 4286578689)) (f32.const 2143289344))
+(assert_return (invoke "f32.floor" (f32.const 2147483647)) (f32.const 2143289344))
+(assert_return (invoke "f32.trunc" (f32.const 2141192193)) (f32.const 2143289344))
+(assert_return (invoke "f32.trunc" (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.trunc" (f32.const 4286578689)) (f32.const 2143289344))
+(assert_return (invoke "f32.trunc" (f32.const 2147483647)) (f32.const 2143289344))
+(assert_return (invoke "f32.nearest" (f32.const 2141192193)) (f32.const 2143289344))
+(assert_return (invoke "f32.nearest" (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.nearest" (f32.const 4286578689)) (f32.const 2143289344))
+(assert_return (invoke "f32.nearest" (f32.const 2147483647)) (f32.const 2143289344))
+(assert_return (invoke "f32.min" (f32.const 2143289344) (f32.const 2139095040)) (f32.const 2143289344))
+(assert_return (invoke "f32.min" (f32.const 2139095040) (f32.const 2141192193)) (f32.const 2143289344))
+(assert_return (invoke "f32.min" (f32.const 2143289344) (f32.const 4286578688)) (f32.const 2143289344))
+(assert_return (invoke "f32.min" (f32.const 4286578688) (f32.const 2141192193)) (f32.const 2143289344))
+(assert_return (invoke "f32.max" (f32.const 2143289344) (f32.const 2139095040)) (f32.const 2143289344))
+(assert_return (invoke "f32.max" (f32.const 2139095040) (f32.const 2141192193)) (f32.const 2143289344))
+(assert_return (invoke "f32.max" (f32.const 2143289344) (f32.const 4286578688)) (f32.const 2143289344))
+(assert_return (invoke "f32.max" (f32.const 4286578688) (f32.const 2141192193)) (f32.const 2143289344))
+(assert_return (invoke "f64.add" (f64.const 9219994337134247937) (f64.const 4607182418800017408)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.add" (f64.const 18444492273895866368) (f64.const 4607182418800017408)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.add" (f64.const 18442240474082181121) (f64.const 4607182418800017408)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.add" (f64.const 9223372036854775807) (f64.const 4607182418800017408)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.add" (f64.const 4607182418800017408) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sub" (f64.const 9219994337134247937) (f64.const 4607182418800017408)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sub" (f64.const 18444492273895866368) (f64.const 4607182418800017408)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sub" (f64.const 18442240474082181121) (f64.const 4607182418800017408)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sub" (f64.const 9223372036854775807) (f64.const 4607182418800017408)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sub" (f64.const 4607182418800017408) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.mul" (f64.const 9219994337134247937) (f64.const 4607182418800017408)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.mul" (f64.const 18444492273895866368) (f64.const 4607182418800017408)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.mul" (f64.const 18442240474082181121) (f64.const 4607182418800017408)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.mul" (f64.const 9223372036854775807) (f64.const 4607182418800017408)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.mul" (f64.const 4607182418800017408) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.div" (f64.const 9219994337134247937) (f64.const 4607182418800017408)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.div" (f64.const 18444492273895866368) (f64.const 4607182418800017408)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.div" (f64.const 18442240474082181121) (f64.const 4607182418800017408)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.div" (f64.const 9223372036854775807) (f64.const 4607182418800017408)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.div" (f64.const 4607182418800017408) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.min" (f64.const 9219994337134247937) (f64.const 4607182418800017408)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.min" (f64.const 18444492273895866368) (f64.const 4607182418800017408)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.min" (f64.const 18442240474082181121) (f64.const 4607182418800017408)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.min" (f64.const 9223372036854775807) (f64.const 4607182418800017408)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.min" (f64.const 4607182418800017408) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.max" (f64.const 9219994337134247937) (f64.const 4607182418800017408)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.max" (f64.const 18444492273895866368) (f64.const 4607182418800017408)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.max" (f64.const 18442240474082181121) (f64.const 4607182418800017408)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.max" (f64.const 9223372036854775807) (f64.const 4607182418800017408)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.max" (f64.const 4607182418800017408) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sqrt" (f64.const 9219994337134247937)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sqrt" (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sqrt" (f64.const 18442240474082181121)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sqrt" (f64.const 9223372036854775807)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.ceil" (f64.const 9219994337134247937)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.ceil" (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.ceil" (f64.const 18442240474082181121)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.ceil" (f64.const 9223372036854775807)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.floor" (f64.const 9219994337134247937)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.floor" (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.floor" (f64.const 18442240474082181121)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.floor" (f64.const 9223372036854775807)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.trunc" (f64.const 9219994337134247937)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.trunc" (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.trunc" (f64.const 18442240474082181121)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.trunc" (f64.const 9223372036854775807)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.nearest" (f64.const 9219994337134247937)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.nearest" (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.nearest" (f64.const 18442240474082181121)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.nearest" (f64.const 9223372036854775807)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.min" (f64.const 9221120237041090560) (f64.const 9218868437227405312)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.min" (f64.const 9218868437227405312) (f64.const 9219994337134247937)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.min" (f64.const 9221120237041090560) (f64.const 18442240474082181120)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.min" (f64.const 18442240474082181120) (f64.const 9219994337134247937)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.max" (f64.const 9221120237041090560) (f64.const 9218868437227405312)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.max" (f64.const 9218868437227405312) (f64.const 9219994337134247937)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.max" (f64.const 9221120237041090560) (f64.const 18442240474082181120)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.max" (f64.const 18442240474082181120) (f64.const 9219994337134247937)) (f64.const 9221120237041090560))
+(assert_return (invoke "f32.demote" (f64.const 9219994337134247937)) (f32.const 2143289344))
+(assert_return (invoke "f32.demote" (f64.const 18444492273895866368)) (f32.const 2143289344))
+(assert_return (invoke "f32.demote" (f64.const 18442240474082181121)) (f32.const 2143289344))
+(assert_return (invoke "f32.demote" (f64.const 9223372036854775807)) (f32.const 2143289344))
+(assert_return (invoke "f64.promote" (f32.const 2141192193)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.promote" (f32.const 4290772992)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.promote" (f32.const 4286578689)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.promote" (f32.const 2147483647)) (f64.const 9221120237041090560))
+(assert_return (invoke "f32.div-zero") (f32.const 2143289344))
+(assert_return (invoke "f64.div-zero") (f64.const 9221120237041090560))
+(assert_return (invoke "f32.sqrt-neg") (f32.const 2143289344))
+(assert_return (invoke "f64.sqrt-neg") (f64.const 9221120237041090560))
+(assert_return (invoke "f32.inf-sub" (f32.const 2139095040)) (f32.const 2143289344))
+(assert_return (invoke "f64.inf-mul" (f64.const 18442240474082181120)) (f64.const 9221120237041090560))
+;; Results that are not NaNs are left alone: zeros keep their sign in min and
+;; max, and nearest rounds ties to even.
+(module
+  (func (export "f32.min") (param f32 f32) (result f32) (f32.min (get_local 0) (get_local 1)))
+  (func (export "f32.max") (param f32 f32) (result f32) (f32.max (get_local 0) (get_local 1)))
+  (func (export "f64.min") (param f64 f64) (result f64) (f64.min (get_local 0) (get_local 1)))
+  (func (export "f64.max") (param f64 f64) (result f64) (f64.max (get_local 0) (get_local 1)))
+  (func (export "f32.nearest") (param f32) (result f32) (f32.nearest (get_local 0)))
+  (func (export "f64.nearest") (param f64) (result f64) (f64.nearest (get_local 0)))
+  (func (export "f32.add") (param f32 f32) (result f32) (f32.add (get_local 0) (get_local 1)))
+  (func (export "f64.div") (param f64 f64) (result f64) (f64.div (get_local 0) (get_local 1)))
+)
+(assert_return (invoke "f32.min" (f32.const 0) (f32.const 2147483648)) (f32.const 2147483648))
+(assert_return (invoke "f32.min" (f32.const 2147483648) (f32.const 0)) (f32.const 2147483648))
+(assert_return (invoke "f32.max" (f32.const 0) (f32.const 2147483648)) (f32.const 0))
+(assert_return (invoke "f32.max" (f32.const 2147483648) (f32.const 0)) (f32.const 0))
+(assert_return (invoke "f32.min" (f32.const 4286578688) (f32.const 1080033280)) (f32.const 4286578688))
+(assert_return (invoke "f32.max" (f32.const 4286578688) (f32.const 1080033280)) (f32.const 1080033280))
+(assert_return (invoke "f32.nearest" (f32.const 1056964608)) (f32.const 0))
+(assert_return (invoke "f32.nearest" (f32.const 1069547520)) (f32.const 1073741824))
+(assert_return (invoke "f32.nearest" (f32.const 1075838976)) (f32.const 1073741824))
+(assert_return (invoke "f32.nearest" (f32.const 3204448256)) (f32.const 2147483648))
+(assert_return (invoke "f32.nearest" (f32.const 3227516928)) (f32.const 3229614080))
+(assert_return (invoke "f32.nearest" (f32.const 1082969293)) (f32.const 1082130432))
+(assert_return (invoke "f64.min" (f64.const 0) (f64.const 9223372036854775808)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.min" (f64.const 9223372036854775808) (f64.const 0)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.max" (f64.const 0) (f64.const 9223372036854775808)) (f64.const 0))
+(assert_return (invoke "f64.max" (f64.const 9223372036854775808) (f64.const 0)) (f64.const 0))
+(assert_return (invoke "f64.min" (f64.const 18442240474082181120) (f64.const 4615063718147915776)) (f64.const 18442240474082181120))
+(assert_return (invoke "f64.max" (f64.const 18442240474082181120) (f64.const 4615063718147915776)) (f64.const 4615063718147915776))
+(assert_return (invoke "f64.nearest" (f64.const 4602678819172646912)) (f64.const 0))
+(assert_return (invoke "f64.nearest" (f64.const 4609434218613702656)) (f64.const 4611686018427387904))
+(assert_return (invoke "f64.nearest" (f64.const 4612811918334230528)) (f64.const 4611686018427387904))
+(assert_return (invoke "f64.nearest" (f64.const 13826050856027422720)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.nearest" (f64.const 13838435755002691584)) (f64.const 13839561654909534208))
+(assert_return (invoke "f64.nearest" (f64.const 4616639978017495450)) (f64.const 4616189618054758400))
+(assert_return (invoke "f32.add" (f32.const 1036831949) (f32.const 1045220557)) (f32.const 1050253722))
+(assert_return (invoke "f32.add" (f32.const 2139095040) (f32.const 1065353216)) (f32.const 2139095040))
+(assert_return (invoke "f64.div" (f64.const 4607182418800017408) (f64.const 9223372036854775808)) (f64.const 18442240474082181120))
+(assert_return (invoke "f64.div" (f64.const 4607182418800017408) (f64.const 4613937818241073152)) (f64.const 4599676419421066581))
+;; Reinterpretations canonicalize the NaNs they move between floats and
+;; integers, and leave other values alone. abs, neg and copysign only change
+;; the sign bit, NaN or not.
+(module
+  (func (export "i32.reinterpret") (param f32) (result i32) (i32.reinterpret/f32 (get_local 0)))
+  (func (export "f32.reinterpret") (param i32) (result f32) (f32.reinterpret/i32 (get_local 0)))
+  (func (export "i64.reinterpret") (param f64) (result i64) (i64.reinterpret/f64 (get_local 0)))
+  (func (export "f64.reinterpret") (param i64) (result f64) (f64.reinterpret/i64 (get_local 0)))
+  (func (export "f32.neg") (param f32) (result f32) (f32.neg (get_local 0)))
+  (func (export "f32.abs") (param f32) (result f32) (f32.abs (get_local 0)))
+  (func (export "f32.copysign") (param f32 f32) (result f32) (f32.copysign (get_local 0) (get_local 1)))
+  (func (export "f64.neg") (param f64) (result f64) (f64.neg (get_local 0)))
+  (func (export "f64.abs") (param f64) (result f64) (f64.abs (get_local 0)))
+  (func (export "f64.copysign") (param f64 f64) (result f64) (f64.copysign (get_local 0) (get_local 1)))
+)
+(assert_return (invoke "i32.reinterpret" (f32.const 2141192193)) (i32.const 2143289344))
+(assert_return (invoke "f32.reinterpret" (i32.const 2141192193)) (f32.const 2143289344))
+(assert_return (invoke "i32.reinterpret" (f32.const 4290772992)) (i32.const 2143289344))
+(assert_return (invoke "f32.reinterpret" (i32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "i32.reinterpret" (f32.const 4286578689)) (i32.const 2143289344))
+(assert_return (invoke "f32.reinterpret" (i32.const 4286578689)) (f32.const 2143289344))
+(assert_return (invoke "i32.reinterpret" (f32.const 2147483647)) (i32.const 2143289344))
+(assert_return (invoke "f32.reinterpret" (i32.const 2147483647)) (f32.const 2143289344))
+(assert_return (invoke "i64.reinterpret" (f64.const 9219994337134247937)) (i64.const 9221120237041090560))
+(assert_return (invoke "f64.reinterpret" (i64.const 9219994337134247937)) (f64.const 9221120237041090560))
+(assert_return (invoke "i64.reinterpret" (f64.const 18444492273895866368)) (i64.const 9221120237041090560))
+(assert_return (invoke "f64.reinterpret" (i64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "i64.reinterpret" (f64.const 18442240474082181121)) (i64.const 9221120237041090560))
+(assert_return (invoke "f64.reinterpret" (i64.const 18442240474082181121)) (f64.const 9221120237041090560))
+(assert_return (invoke "i64.reinterpret" (f64.const 9223372036854775807)) (i64.const 9221120237041090560))
+(assert_return (invoke "f64.reinterpret" (i64.const 9223372036854775807)) (f64.const 9221120237041090560))
+(assert_return (invoke "i32.reinterpret" (f32.const 2147483648)) (i32.const 2147483648))
+(assert_return (invoke "f32.reinterpret" (i32.const 2147483648)) (f32.const 2147483648))
+(assert_return (invoke "i32.reinterpret" (f32.const 2139095040)) (i32.const 2139095040))
+(assert_return (invoke "f32.reinterpret" (i32.const 2139095040)) (f32.const 2139095040))
+(assert_return (invoke "i32.reinterpret" (f32.const 4286578688)) (i32.const 4286578688))
+(assert_return (invoke "f32.reinterpret" (i32.const 4286578688)) (f32.const 4286578688))
+(assert_return (invoke "i32.reinterpret" (f32.const 1065353216)) (i32.const 1065353216))
+(assert_return (invoke "f32.reinterpret" (i32.const 1065353216)) (f32.const 1065353216))
+(assert_return (invoke "i32.reinterpret" (f32.const 1)) (i32.const 1))
+(assert_return (invoke "f32.reinterpret" (i32.const 1)) (f32.const 1))
+(assert_return (invoke "i64.reinterpret" (f64.const 9223372036854775808)) (i64.const 9223372036854775808))
+(assert_return (invoke "f64.reinterpret" (i64.const 9223372036854775808)) (f64.const 9223372036854775808))
+(assert_return (invoke "i64.reinterpret" (f64.const 9218868437227405312)) (i64.const 9218868437227405312))
+(assert_return (invoke "f64.reinterpret" (i64.const 9218868437227405312)) (f64.const 9218868437227405312))
+(assert_return (invoke "i64.reinterpret" (f64.const 4607182418800017408)) (i64.const 4607182418800017408))
+(assert_return (invoke "f64.reinterpret" (i64.const 4607182418800017408)) (f64.const 4607182418800017408))
+(assert_return (invoke "i64.reinterpret" (f64.const 1)) (i64.const 1))
+(assert_return (invoke "f64.reinterpret" (i64.const 1)) (f64.const 1))
+(assert_return (invoke "f32.neg" (f32.const 2141192193)) (f32.const 4288675841))
+(assert_return (invoke "f32.abs" (f32.const 4288675841)) (f32.const 2141192193))
+(assert_return (invoke "f32.copysign" (f32.const 2141192193) (f32.const 3212836864)) (f32.const 4288675841))
+(assert_return (invoke "f64.neg" (f64.const 9219994337134247937)) (f64.const 18443366373989023745))
+(assert_return (invoke "f64.abs" (f64.const 18443366373989023745)) (f64.const 9219994337134247937))
+(assert_return (invoke "f64.copysign" (f64.const 18443366373989023745) (f64.const 4607182418800017408)) (f64.const 9219994337134247937))
+;; Conversions from i32 are exact in f64, and keep every bit of their result.
+(module
+  (func (export "f64.convert_s/i32") (param i32) (result f64) (f64.convert_s/i32 (get_local 0)))
+  (func (export "f64.convert_u/i32") (param i32) (result f64) (f64.convert_u/i32 (get_local 0)))
+)
+(assert_return (invoke "f64.convert_s/i32" (i32.const 0)) (f64.const 0))
+(assert_return (invoke "f64.convert_s/i32" (i32.const 1)) (f64.const 4607182418800017408))
+(assert_return (invoke "f64.convert_s/i32" (i32.const 4294967295)) (f64.const 13830554455654793216))
+(assert_return (invoke "f64.convert_s/i32" (i32.const 16777217)) (f64.const 4715268810125344768))
+(assert_return (invoke "f64.convert_s/i32" (i32.const 2147483647)) (f64.const 4746794007244308480))
+(assert_return (invoke "f64.convert_s/i32" (i32.const 2147483648)) (f64.const 13970166044103278592))
+(assert_return (invoke "f64.convert_s/i32" (i32.const 305419897)) (f64.const 4733903704321687552))
+(assert_return (invoke "f64.convert_u/i32" (i32.const 0)) (f64.const 0))
+(assert_return (invoke "f64.convert_u/i32" (i32.const 1)) (f64.const 4607182418800017408))
+(assert_return (invoke "f64.convert_u/i32" (i32.const 16777217)) (f64.const 4715268810125344768))
+(assert_return (invoke "f64.convert_u/i32" (i32.const 2147483649)) (f64.const 4746794007250599936))
+(assert_return (invoke "f64.convert_u/i32" (i32.const 4294967295)) (f64.const 4751297606873776128))
+;; Conversions to integers trap on NaNs and on values out of range.
+(module
+  (func (export "i32.trunc_s/f32") (param f32) (result i32) (i32.trunc_s/f32 (get_local 0)))
+  (func (export "i32.trunc_s/f64") (param f64) (result i32) (i32.trunc_s/f64 (get_local 0)))
+  (func (export "i32.trunc_u/f32") (param f32) (result i32) (i32.trunc_u/f32 (get_local 0)))
+  (func (export "i32.trunc_u/f64") (param f64) (result i32) (i32.trunc_u/f64 (get_local 0)))
+  (func (export "i64.trunc_s/f32") (param f32) (result i64) (i64.trunc_s/f32 (get_local 0)))
+  (func (export "i64.trunc_s/f64") (param f64) (result i64) (i64.trunc_s/f64 (get_local 0)))
+  (func (export "i64.trunc_u/f32") (param f32) (result i64) (i64.trunc_u/f32 (get_local 0)))
+  (func (export "i64.trunc_u/f64") (param f64) (result i64) (i64.trunc_u/f64 (get_local 0)))
+)
+(assert_return (invoke "i32.trunc_s/f32" (f32.const 3472883712)) (i32.const 2147483648))
+(assert_return (invoke "i32.trunc_s/f32" (f32.const 1325400063)) (i32.const 2147483520))
+(assert_return (invoke "i32.trunc_s/f32" (f32.const 3220386611)) (i32.const 4294967295))
+(assert_trap (invoke "i32.trunc_s/f32" (f32.const 1325400064)) "integer overflow")
+(assert_trap (invoke "i32.trunc_s/f32" (f32.const 3472883713)) "integer overflow")
+(assert_trap (invoke "i32.trunc_s/f32" (f32.const 2139095040)) "integer overflow")
+(assert_trap (invoke "i32.trunc_s/f32" (f32.const 2141192193)) "invalid conversion to integer")
+(assert_trap (invoke "i32.trunc_s/f32" (f32.const 4290772992)) "invalid conversion to integer")
+(assert_return (invoke "i32.trunc_u/f32" (f32.const 1333788671)) (i32.const 4294967040))
+(assert_return (invoke "i32.trunc_u/f32" (f32.const 3211159142)) (i32.const 0))
+(assert_trap (invoke "i32.trunc_u/f32" (f32.const 1333788672)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u/f32" (f32.const 3212836864)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u/f32" (f32.const 4286578688)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u/f32" (f32.const 2141192193)) "invalid conversion to integer")
+(assert_trap (invoke "i32.trunc_u/f32" (f32.const 4290772992)) "invalid conversion to integer")
+(assert_return (invoke "i32.trunc_s/f64" (f64.const 4746794007248083354)) (i32.const 2147483647))
+(assert_return (invoke "i32.trunc_s/f64" (f64.const 13970166044105166029)) (i32.const 2147483648))
+(assert_trap (invoke "i32.trunc_s/f64" (f64.const 4746794007248502784)) "integer overflow")
+(assert_trap (invoke "i32.trunc_s/f64" (f64.const 13970166044105375744)) "integer overflow")
+(assert_trap (invoke "i32.trunc_s/f64" (f64.const 9219994337134247937)) "invalid conversion to integer")
+(assert_trap (invoke "i32.trunc_s/f64" (f64.const 18444492273895866368)) "invalid conversion to integer")
+(assert_return (invoke "i32.trunc_u/f64" (f64.const 4751297606875663565)) (i32.const 4294967295))
+(assert_return (invoke "i32.trunc_u/f64" (f64.const 13829653735729319117)) (i32.const 0))
+(assert_trap (invoke "i32.trunc_u/f64" (f64.const 4751297606875873280)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u/f64" (f64.const 13830554455654793216)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u/f64" (f64.const 9219994337134247937)) "invalid conversion to integer")
+(assert_trap (invoke "i32.trunc_u/f64" (f64.const 18444492273895866368)) "invalid conversion to integer")
+(assert_return (invoke "i64.trunc_s/f32" (f32.const 3741319168)) (i64.const 9223372036854775808))
+(assert_return (invoke "i64.trunc_s/f32" (f32.const 1069547520)) (i64.const 1))
+(assert_trap (invoke "i64.trunc_s/f32" (f32.const 1593835520)) "integer overflow")
+(assert_trap (invoke "i64.trunc_s/f32" (f32.const 4286578688)) "integer overflow")
+(assert_trap (invoke "i64.trunc_s/f32" (f32.const 2141192193)) "invalid conversion to integer")
+(assert_trap (invoke "i64.trunc_s/f32" (f32.const 4290772992)) "invalid conversion to integer")
+(assert_return (invoke "i64.trunc_u/f32" (f32.const 1602224127)) (i64.const 18446742974197923840))
+(assert_return (invoke "i64.trunc_u/f32" (f32.const 3211159142)) (i64.const 0))
+(assert_trap (invoke "i64.trunc_u/f32" (f32.const 1602224128)) "integer overflow")
+(assert_trap (invoke "i64.trunc_u/f32" (f32.const 3212836864)) "integer overflow")
+(assert_trap (invoke "i64.trunc_u/f32" (f32.const 2141192193)) "invalid conversion to integer")
+(assert_trap (invoke "i64.trunc_u/f32" (f32.const 4290772992)) "invalid conversion to integer")
+(assert_return (invoke "i64.trunc_s/f64" (f64.const 14114281232179134464)) (i64.const 9223372036854775808))
+(assert_return (invoke "i64.trunc_s/f64" (f64.const 4890909195324358655)) (i64.const 9223372036854774784))
+(assert_trap (invoke "i64.trunc_s/f64" (f64.const 4890909195324358656)) "integer overflow")
+(assert_trap (invoke "i64.trunc_s/f64" (f64.const 14114281232179134465)) "integer overflow")
+(assert_trap (invoke "i64.trunc_s/f64" (f64.const 9219994337134247937)) "invalid conversion to integer")
+(assert_trap (invoke "i64.trunc_s/f64" (f64.const 18444492273895866368)) "invalid conversion to integer")
+(assert_return (invoke "i64.trunc_u/f64" (f64.const 4895412794951729151)) (i64.const 18446744073709549568))
+(assert_return (invoke "i64.trunc_u/f64" (f64.const 13829653735729319117)) (i64.const 0))
+(assert_trap (invoke "i64.trunc_u/f64" (f64.const 4895412794951729152)) "integer overflow")
+(assert_trap (invoke "i64.trunc_u/f64" (f64.const 13830554455654793216)) "integer overflow")
+(assert_trap (invoke "i64.trunc_u/f64" (f64.const 9219994337134247937)) "invalid conversion to integer")
+(assert_trap (invoke "i64.trunc_u/f64" (f64.const 18444492273895866368)) "invalid conversion to integer")
@@ -0,0 +1,20 @@
+(module
+  (func (export "f32.div") (param f32 f32) (result f32) (f32.div (get_local 0) (get_local 1)))
+  (func (export "f64.div") (param f64 f64) (result f64) (f64.div (get_local 0) (get_local 1)))
+  (func (export "f32.add") (param f32 f32) (result f32) (f32.add (get_local 0) (get_local 1)))
+  (func (export "f64.sqrt") (param f64) (result f64) (f64.sqrt (get_local 0)))
+  (func (export "f32.neg") (param f32) (result f32) (f32.neg (get_local 0)))
+  (func (export "f64.neg") (param f64) (result f64) (f64.neg (get_local 0)))
+  (func (export "f32.min") (param f32 f32) (result f32) (f32.min (get_local 0) (get_local 1)))
+)
+
+(assert_return_canonical_nan (invoke "f32.div" (f32.const 0) (f32.const 0)))
+(assert_return_canonical_nan (invoke "f64.div" (f64.const 0) (f64.const 0)))
+(assert_return_arithmetic_nan (invoke "f32.add" (f32.const nan:0x200000) (f32.const 1)))
+(assert_return_canonical_nan (invoke "f64.sqrt" (f64.const -1)))
+(assert_return (invoke "f32.neg" (f32.const 0)) (f32.const -0))
+(assert_return (invoke "f64.neg" (f64.const -0)) (f64.const 0))
+(assert_return (invoke "f32.min" (f32.const 0) (f32.const -0)) (f32.const -0))
+(assert_return (invoke "f32.neg" (f32.const nan:0x200000)) (f32.const -nan:0x200000))
+(assert_return (invoke "f32.div" (f32.const 1) (f32.const 3)) (f32.const 0x1.555556p-2))
+(assert_return (invoke "f64.div" (f64.const 1) (f64.const 3)) (f64.const 0x1.5555555555555p-2))
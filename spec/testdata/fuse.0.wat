@@ -0,0 +1,18 @@
+(module
+  (func (export "add") (param i32) (result i32) (i32.add (get_local 0) (i32.const 5)))
+  (func (export "add-left") (param i32) (result i32) (i32.add (i32.const -3) (get_local 0)))
+  (func (export "add-register") (param i32) (result i32)
+    (i32.add (i32.mul (get_local 0) (get_local 0)) (i32.const 1)))
+  (func (export "sub-min") (param i32) (result i32) (i32.sub (get_local 0) (i32.const 0x80000000)))
+  (func (export "eq") (param i32) (result i32) (i32.eq (get_local 0) (i32.const -1)))
+  (func (export "eq-register") (param i32) (result i32)
+    (i32.eq (i32.add (get_local 0) (get_local 0)) (i32.const -2)))
+  (func (export "shared-constant") (param i32) (result i32)
+    (local i32)
+    (i32.add (i32.add (get_local 0) (tee_local 1 (i32.const 7))) (get_local 1)))
+  (func (export "i64.add") (param i64) (result i64) (i64.add (get_local 0) (i64.const 0x100000000)))
+  (func (export "i64.sub-min") (param i64) (result i64) (i64.sub (get_local 0) (i64.const 0x8000000000000000)))
+  (func (export "i64.shr_u") (param i64) (result i64) (i64.shr_u (get_local 0) (i64.const 65)))
+  (func (export "i64.shr_u-negative") (param i64) (result i64) (i64.shr_u (get_local 0) (i64.const -1)))
+  (func (export "i64.shl") (param i64) (result i64) (i64.shl (get_local 0) (i64.const 0x100000004)))
+)
@@ -0,0 +1,20 @@
+(module
+  (func (export "set-between") (param i32) (result i32)
+    (get_local 0)
+    (set_local 0 (i32.const 100))
+    (i32.add (i32.const 1)))
+  (func (export "set-in-block") (param i32) (result i32)
+    (get_local 0)
+    (block (br_if 0 (get_local 0)) (set_local 0 (i32.const 100)))
+    (i32.add (i32.const 1)))
+  (func (export "set-in-loop") (param i32) (result i32)
+    (local i32 i32)
+    (set_local 2 (i32.const -1))
+    (loop
+      (set_local 1 (i32.add (get_local 2) (i32.const 1)))
+      (set_local 2 (get_local 0))
+      (br_if 0 (i32.eqz (get_local 1))))
+    (get_local 1))
+  (func (export "two-uses") (param i32) (result i32)
+    (i32.or (i32.eq (get_local 0) (i32.const 1)) (i32.eq (get_local 0) (i32.const 2))))
+)
@@ -0,0 +1,37 @@
+(module
+  (func (export "gt_u") (param i32 i32) (result i32)
+    (block (br_if 0 (i32.gt_u (get_local 0) (get_local 1))) (return (i32.const 0)))
+    (i32.const 1))
+  (func (export "ge_u-value") (param i32 i32) (result i32)
+    (block (result i32)
+      (drop (br_if 0 (i32.const 7) (i32.ge_u (get_local 0) (get_local 1))))
+      (i32.const 9)))
+  (func (export "i64.ne") (param i64 i64) (result i32)
+    (block (br_if 0 (i64.ne (get_local 0) (get_local 1))) (return (i32.const 0)))
+    (i32.const 1))
+  (func (export "i64.eqz") (param i64) (result i32)
+    (block (br_if 0 (i64.eqz (get_local 0))) (return (i32.const 0)))
+    (i32.const 1))
+  (func (export "i32.or") (param i32 i32) (result i32)
+    (block (br_if 0 (i32.or (get_local 0) (get_local 1))) (return (i32.const 0)))
+    (i32.const 1))
+  (func (export "i32.or-wrapped") (param i32 i32 i32) (result i32)
+    (block (br_if 0 (i32.or (i32.add (get_local 0) (get_local 1)) (get_local 2))) (return (i32.const 0)))
+    (i32.const 1))
+  (func (export "if-or") (param i32) (result i32)
+    (if (result i32)
+      (i32.or (i32.eq (get_local 0) (i32.const 1)) (i32.eq (get_local 0) (i32.const 2)))
+      (then (i32.const 1))
+      (else (i32.const 0))))
+  (func (export "condition-reused") (param i32 i32) (result i32)
+    (local i32)
+    (block (br_if 0 (tee_local 2 (i32.gt_u (get_local 0) (get_local 1)))))
+    (get_local 2))
+  (func (export "count-down") (param i32) (result i32)
+    (local i32)
+    (loop
+      (set_local 1 (i32.add (get_local 1) (i32.const 1)))
+      (set_local 0 (i32.sub (get_local 0) (i32.const 1)))
+      (br_if 0 (i32.gt_u (get_local 0) (i32.const 0))))
+    (get_local 1))
+)
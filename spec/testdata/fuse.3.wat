@@ -0,0 +1,14 @@
+(module
+  (memory 1)
+  (data (i32.const 0) "\01\02\03\04\05\06\07\08\09\0a\0b\0c")
+  (func (export "i32.load") (param i32) (result i32)
+    (i32.load offset=2 (i32.add (get_local 0) (i32.const 1))))
+  (func (export "i32.load-wrap") (param i32) (result i32)
+    (i32.load (i32.add (get_local 0) (i32.const 8))))
+  (func (export "i32.load-negative") (param i32) (result i32)
+    (i32.load (i32.add (get_local 0) (i32.const -4))))
+  (func (export "i64.load") (param i32) (result i64)
+    (i64.load offset=1 (i32.add (get_local 0) (i32.const 2))))
+  (func (export "f64.load") (param i32) (result i64)
+    (i64.reinterpret/f64 (f64.load (i32.add (get_local 0) (i32.const 1)))))
+)
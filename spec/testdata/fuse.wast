@@ -0,0 +1,142 @@
+;; Constant operands taken as immediates.
+(module
+  (func (export "add") (param i32) (result i32) (i32.add (get_local 0) (i32.const 5)))
+  (func (export "add-left") (param i32) (result i32) (i32.add (i32.const -3) (get_local 0)))
+  (func (export "add-register") (param i32) (result i32)
+    (i32.add (i32.mul (get_local 0) (get_local 0)) (i32.const 1)))
+  (func (export "sub-min") (param i32) (result i32) (i32.sub (get_local 0) (i32.const 0x80000000)))
+  (func (export "eq") (param i32) (result i32) (i32.eq (get_local 0) (i32.const -1)))
+  (func (export "eq-register") (param i32) (result i32)
+    (i32.eq (i32.add (get_local 0) (get_local 0)) (i32.const -2)))
+  (func (export "shared-constant") (param i32) (result i32)
+    (local i32)
+    (i32.add (i32.add (get_local 0) (tee_local 1 (i32.const 7))) (get_local 1)))
+  (func (export "i64.add") (param i64) (result i64) (i64.add (get_local 0) (i64.const 0x100000000)))
+  (func (export "i64.sub-min") (param i64) (result i64) (i64.sub (get_local 0) (i64.const 0x8000000000000000)))
+  (func (export "i64.shr_u") (param i64) (result i64) (i64.shr_u (get_local 0) (i64.const 65)))
+  (func (export "i64.shr_u-negative") (param i64) (result i64) (i64.shr_u (get_local 0) (i64.const -1)))
+  (func (export "i64.shl") (param i64) (result i64) (i64.shl (get_local 0) (i64.const 0x100000004)))
+)
+(assert_return (invoke "add" (i32.const 1)) (i32.const 6))
+(assert_return (invoke "add" (i32.const 2147483647)) (i32.const 2147483652))
+(assert_return (invoke "add-left" (i32.const 1)) (i32.const 4294967294))
+(assert_return (invoke "add-register" (i32.const 3)) (i32.const 10))
+(assert_return (invoke "sub-min" (i32.const 1)) (i32.const 2147483649))
+(assert_return (invoke "eq" (i32.const 4294967295)) (i32.const 1))
+(assert_return (invoke "eq" (i32.const 0)) (i32.const 0))
+(assert_return (invoke "eq-register" (i32.const 4294967295)) (i32.const 1))
+(assert_return (invoke "eq-register" (i32.const 1)) (i32.const 0))
+(assert_return (invoke "shared-constant" (i32.const 1)) (i32.const 15))
+(assert_return (invoke "i64.add" (i64.const 18446744073709551615)) (i64.const 4294967295))
+(assert_return (invoke "i64.sub-min" (i64.const 1)) (i64.const 9223372036854775809))
+(assert_return (invoke "i64.shr_u" (i64.const 18446744073709551615)) (i64.const 9223372036854775807))
+(assert_return (invoke "i64.shr_u-negative" (i64.const 18446744073709551615)) (i64.const 1))
+(assert_return (invoke "i64.shl" (i64.const 3)) (i64.const 48))
+;; Operands read straight from locals, which must hold the value they had at
+;; the get_local.
+(module
+  (func (export "set-between") (param i32) (result i32)
+    (get_local 0)
+    (set_local 0 (i32.const 100))
+    (i32.add (i32.const 1)))
+  (func (export "set-in-block") (param i32) (result i32)
+    (get_local 0)
+    (block (br_if 0 (get_local 0)) (set_local 0 (i32.const 100)))
+    (i32.add (i32.const 1)))
+  (func (export "set-in-loop") (param i32) (result i32)
+    (local i32 i32)
+    (set_local 2 (i32.const -1))
+    (loop
+      (set_local 1 (i32.add (get_local 2) (i32.const 1)))
+      (set_local 2 (get_local 0))
+      (br_if 0 (i32.eqz (get_local 1))))
+    (get_local 1))
+  (func (export "two-uses") (param i32) (result i32)
+    (i32.or (i32.eq (get_local 0) (i32.const 1)) (i32.eq (get_local 0) (i32.const 2))))
+)
+(assert_return (invoke "set-between" (i32.const 5)) (i32.const 6))
+(assert_return (invoke "set-in-block" (i32.const 0)) (i32.const 1))
+(assert_return (invoke "set-in-block" (i32.const 5)) (i32.const 6))
+(assert_return (invoke "set-in-loop" (i32.const 41)) (i32.const 42))
+(assert_return (invoke "set-in-loop" (i32.const 0)) (i32.const 1))
+(assert_return (invoke "two-uses" (i32.const 2)) (i32.const 1))
+(assert_return (invoke "two-uses" (i32.const 3)) (i32.const 0))
+;; Comparisons fused with the branch on their result.
+(module
+  (func (export "gt_u") (param i32 i32) (result i32)
+    (block (br_if 0 (i32.gt_u (get_local 0) (get_local 1))) (return (i32.const 0)))
+    (i32.const 1))
+  (func (export "ge_u-value") (param i32 i32) (result i32)
+    (block (result i32)
+      (drop (br_if 0 (i32.const 7) (i32.ge_u (get_local 0) (get_local 1))))
+      (i32.const 9)))
+  (func (export "i64.ne") (param i64 i64) (result i32)
+    (block (br_if 0 (i64.ne (get_local 0) (get_local 1))) (return (i32.const 0)))
+    (i32.const 1))
+  (func (export "i64.eqz") (param i64) (result i32)
+    (block (br_if 0 (i64.eqz (get_local 0))) (return (i32.const 0)))
+    (i32.const 1))
+  (func (export "i32.or") (param i32 i32) (result i32)
+    (block (br_if 0 (i32.or (get_local 0) (get_local 1))) (return (i32.const 0)))
+    (i32.const 1))
+  (func (export "i32.or-wrapped") (param i32 i32 i32) (result i32)
+    (block (br_if 0 (i32.or (i32.add (get_local 0) (get_local 1)) (get_local 2))) (return (i32.const 0)))
+    (i32.const 1))
+  (func (export "if-or") (param i32) (result i32)
+    (if (result i32)
+      (i32.or (i32.eq (get_local 0) (i32.const 1)) (i32.eq (get_local 0) (i32.const 2)))
+      (then (i32.const 1))
+      (else (i32.const 0))))
+  (func (export "condition-reused") (param i32 i32) (result i32)
+    (local i32)
+    (block (br_if 0 (tee_local 2 (i32.gt_u (get_local 0) (get_local 1)))))
+    (get_local 2))
+  (func (export "count-down") (param i32) (result i32)
+    (local i32)
+    (loop
+      (set_local 1 (i32.add (get_local 1) (i32.const 1)))
+      (set_local 0 (i32.sub (get_local 0) (i32.const 1)))
+      (br_if 0 (i32.gt_u (get_local 0) (i32.const 0))))
+    (get_local 1))
+)
+(assert_return (invoke "gt_u" (i32.const 4294967295) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 1) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "ge_u-value" (i32.const 1) (i32.const 1)) (i32.const 7))
+(assert_return (invoke "ge_u-value" (i32.const 1) (i32.const 4294967295)) (i32.const 9))
+(assert_return (invoke "i64.ne" (i64.const 4294967296) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "i64.ne" (i64.const 18446744073709551615) (i64.const 18446744073709551615)) (i32.const 0))
+(assert_return (invoke "i64.eqz" (i64.const 0)) (i32.const 1))
+(assert_return (invoke "i64.eqz" (i64.const 4294967296)) (i32.const 0))
+(assert_return (invoke "i32.or" (i32.const 0) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "i32.or" (i32.const 2147483648) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "i32.or" (i32.const 0) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "i32.or-wrapped" (i32.const 4294967295) (i32.const 1) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "i32.or-wrapped" (i32.const 4294967295) (i32.const 2) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "if-or" (i32.const 1)) (i32.const 1))
+(assert_return (invoke "if-or" (i32.const 2)) (i32.const 1))
+(assert_return (invoke "if-or" (i32.const 3)) (i32.const 0))
+(assert_return (invoke "condition-reused" (i32.const 2) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "condition-reused" (i32.const 1) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "count-down" (i32.const 10)) (i32.const 10))
+;; Loads adding a constant to their base address. The sum wraps around at 32
+;; bits before the offset is added.
+(module
+  (memory 1)
+  (data (i32.const 0) "\01\02\03\04\05\06\07\08\09\0a\0b\0c")
+  (func (export "i32.load") (param i32) (result i32)
+    (i32.load offset=2 (i32.add (get_local 0) (i32.const 1))))
+  (func (export "i32.load-wrap") (param i32) (result i32)
+    (i32.load (i32.add (get_local 0) (i32.const 8))))
+  (func (export "i32.load-negative") (param i32) (result i32)
+    (i32.load (i32.add (get_local 0) (i32.const -4))))
+  (func (export "i64.load") (param i32) (result i64)
+    (i64.load offset=1 (i32.add (get_local 0) (i32.const 2))))
+  (func (export "f64.load") (param i32) (result i64)
+    (i64.reinterpret/f64 (f64.load (i32.add (get_local 0) (i32.const 1)))))
+)
+(assert_return (invoke "i32.load" (i32.const 0)) (i32.const 117835012))
+(assert_return (invoke "i32.load-wrap" (i32.const 4294967292)) (i32.const 134678021))
+(assert_return (invoke "i32.load-negative" (i32.const 4)) (i32.const 67305985))
+(assert_trap (invoke "i32.load-negative" (i32.const 0)) "out of bounds memory access")
+(assert_return (invoke "i64.load" (i32.const 0)) (i64.const 795458214266537220))
+(assert_return (invoke "f64.load" (i32.const 0)) (i64.const 650777868590383874))
@@ -0,0 +1,535 @@
+;; Conversions between integer and float types.
+(module
+  (func (export "i64.extend_s_i32") (param $x i32) (result i64) (i64.extend_s/i32 (get_local $x)))
+  (func (export "i64.extend_u_i32") (param $x i32) (result i64) (i64.extend_u/i32 (get_local $x)))
+  (func (export "i32.wrap_i64") (param $x i64) (result i32) (i32.wrap/i64 (get_local $x)))
+  (func (export "i32.trunc_s_f32") (param $x f32) (result i32) (i32.trunc_s/f32 (get_local $x)))
+  (func (export "i32.trunc_s_f64") (param $x f64) (result i32) (i32.trunc_s/f64 (get_local $x)))
+  (func (export "i32.trunc_u_f32") (param $x f32) (result i32) (i32.trunc_u/f32 (get_local $x)))
+  (func (export "i32.trunc_u_f64") (param $x f64) (result i32) (i32.trunc_u/f64 (get_local $x)))
+  (func (export "i64.trunc_s_f32") (param $x f32) (result i64) (i64.trunc_s/f32 (get_local $x)))
+  (func (export "i64.trunc_s_f64") (param $x f64) (result i64) (i64.trunc_s/f64 (get_local $x)))
+  (func (export "i64.trunc_u_f32") (param $x f32) (result i64) (i64.trunc_u/f32 (get_local $x)))
+  (func (export "i64.trunc_u_f64") (param $x f64) (result i64) (i64.trunc_u/f64 (get_local $x)))
+  (func (export "f32.convert_s_i32") (param $x i32) (result f32) (f32.convert_s/i32 (get_local $x)))
+  (func (export "f32.convert_s_i64") (param $x i64) (result f32) (f32.convert_s/i64 (get_local $x)))
+  (func (export "f32.convert_u_i32") (param $x i32) (result f32) (f32.convert_u/i32 (get_local $x)))
+  (func (export "f32.convert_u_i64") (param $x i64) (result f32) (f32.convert_u/i64 (get_local $x)))
+  (func (export "f64.convert_s_i32") (param $x i32) (result f64) (f64.convert_s/i32 (get_local $x)))
+  (func (export "f64.convert_s_i64") (param $x i64) (result f64) (f64.convert_s/i64 (get_local $x)))
+  (func (export "f64.convert_u_i32") (param $x i32) (result f64) (f64.convert_u/i32 (get_local $x)))
+  (func (export "f64.convert_u_i64") (param $x i64) (result f64) (f64.convert_u/i64 (get_local $x)))
+  (func (export "f64.promote_f32") (param $x f32) (result f64) (f64.promote/f32 (get_local $x)))
+  (func (export "f32.demote_f64") (param $x f64) (result f32) (f32.demote/f64 (get_local $x)))
+  (func (export "f32.reinterpret_i32") (param $x i32) (result f32) (f32.reinterpret/i32 (get_local $x)))
+  (func (export "i32.reinterpret_f32") (param $x f32) (result i32) (i32.reinterpret/f32 (get_local $x)))
+  (func (export "f64.reinterpret_i64") (param $x i64) (result f64) (f64.reinterpret/i64 (get_local $x)))
+  (func (export "i64.reinterpret_f64") (param $x f64) (result i64) (i64.reinterpret/f64 (get_local $x)))
+)
+
+(assert_return (invoke "i64.extend_s_i32" (i32.const 0)) (i64.const 0))
+(assert_return (invoke "i64.extend_s_i32" (i32.const 1)) (i64.const 1))
+(assert_return (invoke "i64.extend_s_i32" (i32.const -1)) (i64.const -1))
+(assert_return (invoke "i64.extend_s_i32" (i32.const 0x7fffffff)) (i64.const 0x7fffffff))
+(assert_return (invoke "i64.extend_s_i32" (i32.const 0x80000000)) (i64.const 0xffffffff80000000))
+(assert_return (invoke "i64.extend_s_i32" (i32.const 0x80000001)) (i64.const 0xffffffff80000001))
+(assert_return (invoke "i64.extend_s_i32" (i32.const 0x12345678)) (i64.const 0x12345678))
+(assert_return (invoke "i64.extend_s_i32" (i32.const 0x9abcdef0)) (i64.const 0xffffffff9abcdef0))
+(assert_return (invoke "i64.extend_s_i32" (i32.const 0x1000001)) (i64.const 0x1000001))
+(assert_return (invoke "i64.extend_s_i32" (i32.const 0x1000003)) (i64.const 0x1000003))
+(assert_return (invoke "i64.extend_u_i32" (i32.const 0)) (i64.const 0))
+(assert_return (invoke "i64.extend_u_i32" (i32.const 1)) (i64.const 1))
+(assert_return (invoke "i64.extend_u_i32" (i32.const -1)) (i64.const 0xffffffff))
+(assert_return (invoke "i64.extend_u_i32" (i32.const 0x7fffffff)) (i64.const 0x7fffffff))
+(assert_return (invoke "i64.extend_u_i32" (i32.const 0x80000000)) (i64.const 0x80000000))
+(assert_return (invoke "i64.extend_u_i32" (i32.const 0x80000001)) (i64.const 0x80000001))
+(assert_return (invoke "i64.extend_u_i32" (i32.const 0x12345678)) (i64.const 0x12345678))
+(assert_return (invoke "i64.extend_u_i32" (i32.const 0x9abcdef0)) (i64.const 0x9abcdef0))
+(assert_return (invoke "i64.extend_u_i32" (i32.const 0x1000001)) (i64.const 0x1000001))
+(assert_return (invoke "i64.extend_u_i32" (i32.const 0x1000003)) (i64.const 0x1000003))
+(assert_return (invoke "i32.wrap_i64" (i64.const 0)) (i32.const 0))
+(assert_return (invoke "i32.wrap_i64" (i64.const 1)) (i32.const 1))
+(assert_return (invoke "i32.wrap_i64" (i64.const -1)) (i32.const -1))
+(assert_return (invoke "i32.wrap_i64" (i64.const 0x7fffffffffffffff)) (i32.const -1))
+(assert_return (invoke "i32.wrap_i64" (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "i32.wrap_i64" (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "i32.wrap_i64" (i64.const 0x123456789abcdef)) (i32.const 0x89abcdef))
+(assert_return (invoke "i32.wrap_i64" (i64.const 0xfedcba9876543210)) (i32.const 0x76543210))
+(assert_return (invoke "i32.wrap_i64" (i64.const 0x20000000000001)) (i32.const 1))
+(assert_return (invoke "i32.wrap_i64" (i64.const 0x20000000000003)) (i32.const 3))
+(assert_return (invoke "i32.wrap_i64" (i64.const 0x20000020000001)) (i32.const 0x20000001))
+(assert_return (invoke "i32.wrap_i64" (i64.const 0x7fffff4000000001)) (i32.const 1))
+(assert_return (invoke "i32.wrap_i64" (i64.const 0x8000008000000001)) (i32.const 1))
+(assert_return (invoke "i32.wrap_i64" (i64.const -3072)) (i32.const -3072))
+(assert_return (invoke "i32.wrap_i64" (i64.const -1025)) (i32.const -1025))
+
+(assert_return (invoke "i32.trunc_s_f32" (f32.const -0x0p+0)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s_f32" (f32.const 0x0p+0)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s_f32" (f32.const -0x1p-149)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s_f32" (f32.const 0x1p-149)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s_f32" (f32.const -0x1p-126)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s_f32" (f32.const 0x1p-126)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s_f32" (f32.const -0x1p-1)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s_f32" (f32.const 0x1p-1)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s_f32" (f32.const -0x1p+0)) (i32.const -1))
+(assert_return (invoke "i32.trunc_s_f32" (f32.const 0x1p+0)) (i32.const 1))
+(assert_return (invoke "i32.trunc_s_f32" (f32.const -0x1.921fb6p+2)) (i32.const -6))
+(assert_return (invoke "i32.trunc_s_f32" (f32.const 0x1.921fb6p+2)) (i32.const 6))
+(assert_trap (invoke "i32.trunc_s_f32" (f32.const -0x1.fffffep+127)) "integer overflow")
+(assert_trap (invoke "i32.trunc_s_f32" (f32.const 0x1.fffffep+127)) "integer overflow")
+(assert_trap (invoke "i32.trunc_s_f32" (f32.const -inf)) "integer overflow")
+(assert_trap (invoke "i32.trunc_s_f32" (f32.const inf)) "integer overflow")
+(assert_trap (invoke "i32.trunc_s_f32" (f32.const -nan)) "invalid conversion to integer")
+(assert_trap (invoke "i32.trunc_s_f32" (f32.const nan)) "invalid conversion to integer")
+(assert_trap (invoke "i32.trunc_s_f32" (f32.const nan:0x600000)) "invalid conversion to integer")
+(assert_return (invoke "i32.trunc_s_f32" (f32.const 0x1.b0e27p+12)) (i32.const 6926))
+(assert_trap (invoke "i32.trunc_s_f32" (f32.const 0x1.c94296p+58)) "integer overflow")
+(assert_return (invoke "i32.trunc_s_f32" (f32.const -0x1p+31)) (i32.const 0x80000000))
+(assert_trap (invoke "i32.trunc_s_f32" (f32.const 0x1p+31)) "integer overflow")
+(assert_return (invoke "i32.trunc_s_f32" (f32.const -0x1.8p+0)) (i32.const -1))
+(assert_return (invoke "i32.trunc_s_f32" (f32.const -0x1.ccccccp-1)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s_f32" (f32.const 0x1.ccccccp-1)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s_f32" (f32.const 0x1.8p+0)) (i32.const 1))
+(assert_trap (invoke "i32.trunc_s_f32" (f32.const -0x1.4p+31)) "integer overflow")
+(assert_trap (invoke "i32.trunc_s_f32" (f32.const 0x1.4p+31)) "integer overflow")
+(assert_trap (invoke "i32.trunc_s_f32" (f32.const 0x1.8p+31)) "integer overflow")
+(assert_trap (invoke "i32.trunc_s_f32" (f32.const 0x1.cp+31)) "integer overflow")
+
+(assert_return (invoke "i32.trunc_s_f64" (f64.const -0x0p+0)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s_f64" (f64.const 0x0p+0)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s_f64" (f64.const -0x1p-1074)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s_f64" (f64.const 0x1p-1074)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s_f64" (f64.const -0x1p-1022)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s_f64" (f64.const 0x1p-1022)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s_f64" (f64.const -0x1p-1)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s_f64" (f64.const 0x1p-1)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s_f64" (f64.const -0x1p+0)) (i32.const -1))
+(assert_return (invoke "i32.trunc_s_f64" (f64.const 0x1p+0)) (i32.const 1))
+(assert_return (invoke "i32.trunc_s_f64" (f64.const -0x1.921fb54442d18p+2)) (i32.const -6))
+(assert_return (invoke "i32.trunc_s_f64" (f64.const 0x1.921fb54442d18p+2)) (i32.const 6))
+(assert_trap (invoke "i32.trunc_s_f64" (f64.const -0x1.fffffffffffffp+1023)) "integer overflow")
+(assert_trap (invoke "i32.trunc_s_f64" (f64.const 0x1.fffffffffffffp+1023)) "integer overflow")
+(assert_trap (invoke "i32.trunc_s_f64" (f64.const -inf)) "integer overflow")
+(assert_trap (invoke "i32.trunc_s_f64" (f64.const inf)) "integer overflow")
+(assert_trap (invoke "i32.trunc_s_f64" (f64.const -nan)) "invalid conversion to integer")
+(assert_trap (invoke "i32.trunc_s_f64" (f64.const nan)) "invalid conversion to integer")
+(assert_trap (invoke "i32.trunc_s_f64" (f64.const nan:0xc000000000000)) "invalid conversion to integer")
+(assert_return (invoke "i32.trunc_s_f64" (f64.const 0x1.a09e643ce8769p-8)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s_f64" (f64.const 0x1.dc9e4c96d8372p-878)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s_f64" (f64.const -0x1.00000001p+31)) (i32.const 0x80000000))
+(assert_return (invoke "i32.trunc_s_f64" (f64.const -0x1p+31)) (i32.const 0x80000000))
+(assert_return (invoke "i32.trunc_s_f64" (f64.const -0x1.fffffffep+30)) (i32.const 0x80000001))
+(assert_trap (invoke "i32.trunc_s_f64" (f64.const -0x1.00000002p+31)) "integer overflow")
+(assert_return (invoke "i32.trunc_s_f64" (f64.const -0x1.fffffffcp+30)) (i32.const 0x80000001))
+(assert_return (invoke "i32.trunc_s_f64" (f64.const 0x1.fffffffap+30)) (i32.const 0x7ffffffe))
+(assert_return (invoke "i32.trunc_s_f64" (f64.const 0x1.fffffffcp+30)) (i32.const 0x7fffffff))
+(assert_return (invoke "i32.trunc_s_f64" (f64.const 0x1.fffffffep+30)) (i32.const 0x7fffffff))
+(assert_return (invoke "i32.trunc_s_f64" (f64.const 0x1.fffffff8p+30)) (i32.const 0x7ffffffe))
+(assert_trap (invoke "i32.trunc_s_f64" (f64.const 0x1p+31)) "integer overflow")
+(assert_trap (invoke "i32.trunc_s_f64" (f64.const 0x1.00000001p+31)) "integer overflow")
+(assert_trap (invoke "i32.trunc_s_f64" (f64.const 0x1.00000002p+31)) "integer overflow")
+(assert_trap (invoke "i32.trunc_s_f64" (f64.const -0x1.00000003p+31)) "integer overflow")
+(assert_trap (invoke "i32.trunc_s_f64" (f64.const -0x1.00000004p+31)) "integer overflow")
+(assert_return (invoke "i32.trunc_s_f64" (f64.const -0x1.8p+0)) (i32.const -1))
+(assert_return (invoke "i32.trunc_s_f64" (f64.const -0x1.ccccccccccccdp-1)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s_f64" (f64.const 0x1.ccccccccccccdp-1)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s_f64" (f64.const 0x1.8p+0)) (i32.const 1))
+(assert_trap (invoke "i32.trunc_s_f64" (f64.const -0x1.4p+31)) "integer overflow")
+(assert_trap (invoke "i32.trunc_s_f64" (f64.const 0x1.4p+31)) "integer overflow")
+(assert_trap (invoke "i32.trunc_s_f64" (f64.const 0x1.8p+31)) "integer overflow")
+(assert_trap (invoke "i32.trunc_s_f64" (f64.const 0x1.cp+31)) "integer overflow")
+
+(assert_return (invoke "i32.trunc_u_f32" (f32.const -0x0p+0)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u_f32" (f32.const 0x0p+0)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u_f32" (f32.const -0x1p-149)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u_f32" (f32.const 0x1p-149)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u_f32" (f32.const -0x1p-126)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u_f32" (f32.const 0x1p-126)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u_f32" (f32.const -0x1p-1)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u_f32" (f32.const 0x1p-1)) (i32.const 0))
+(assert_trap (invoke "i32.trunc_u_f32" (f32.const -0x1p+0)) "integer overflow")
+(assert_return (invoke "i32.trunc_u_f32" (f32.const 0x1p+0)) (i32.const 1))
+(assert_trap (invoke "i32.trunc_u_f32" (f32.const -0x1.921fb6p+2)) "integer overflow")
+(assert_return (invoke "i32.trunc_u_f32" (f32.const 0x1.921fb6p+2)) (i32.const 6))
+(assert_trap (invoke "i32.trunc_u_f32" (f32.const -0x1.fffffep+127)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u_f32" (f32.const 0x1.fffffep+127)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u_f32" (f32.const -inf)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u_f32" (f32.const inf)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u_f32" (f32.const -nan)) "invalid conversion to integer")
+(assert_trap (invoke "i32.trunc_u_f32" (f32.const nan)) "invalid conversion to integer")
+(assert_trap (invoke "i32.trunc_u_f32" (f32.const nan:0x600000)) "invalid conversion to integer")
+(assert_return (invoke "i32.trunc_u_f32" (f32.const 0x1.ff9af4p+10)) (i32.const 2046))
+(assert_trap (invoke "i32.trunc_u_f32" (f32.const 0x1.e18cb6p+43)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u_f32" (f32.const 0x1p+32)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u_f32" (f32.const -0x1.8p+0)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u_f32" (f32.const -0x1p+1)) "integer overflow")
+(assert_return (invoke "i32.trunc_u_f32" (f32.const -0x1.ccccccp-1)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u_f32" (f32.const 0x1.ccccccp-1)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u_f32" (f32.const 0x1.8p+0)) (i32.const 1))
+(assert_trap (invoke "i32.trunc_u_f32" (f32.const -0x1.4p+31)) "integer overflow")
+(assert_return (invoke "i32.trunc_u_f32" (f32.const 0x1.4p+31)) (i32.const 0xa0000000))
+(assert_return (invoke "i32.trunc_u_f32" (f32.const 0x1.8p+31)) (i32.const 0xc0000000))
+(assert_return (invoke "i32.trunc_u_f32" (f32.const 0x1.cp+31)) (i32.const 0xe0000000))
+
+(assert_return (invoke "i32.trunc_u_f64" (f64.const -0x0p+0)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u_f64" (f64.const 0x0p+0)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u_f64" (f64.const -0x1p-1074)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u_f64" (f64.const 0x1p-1074)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u_f64" (f64.const -0x1p-1022)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u_f64" (f64.const 0x1p-1022)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u_f64" (f64.const -0x1p-1)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u_f64" (f64.const 0x1p-1)) (i32.const 0))
+(assert_trap (invoke "i32.trunc_u_f64" (f64.const -0x1p+0)) "integer overflow")
+(assert_return (invoke "i32.trunc_u_f64" (f64.const 0x1p+0)) (i32.const 1))
+(assert_trap (invoke "i32.trunc_u_f64" (f64.const -0x1.921fb54442d18p+2)) "integer overflow")
+(assert_return (invoke "i32.trunc_u_f64" (f64.const 0x1.921fb54442d18p+2)) (i32.const 6))
+(assert_trap (invoke "i32.trunc_u_f64" (f64.const -0x1.fffffffffffffp+1023)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u_f64" (f64.const 0x1.fffffffffffffp+1023)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u_f64" (f64.const -inf)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u_f64" (f64.const inf)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u_f64" (f64.const -nan)) "invalid conversion to integer")
+(assert_trap (invoke "i32.trunc_u_f64" (f64.const nan)) "invalid conversion to integer")
+(assert_trap (invoke "i32.trunc_u_f64" (f64.const nan:0xc000000000000)) "invalid conversion to integer")
+(assert_return (invoke "i32.trunc_u_f64" (f64.const -0x1.025ec6eedc9dep-858)) (i32.const 0))
+(assert_trap (invoke "i32.trunc_u_f64" (f64.const 0x1.a1ac5e0ebfa1fp+168)) "integer overflow")
+(assert_return (invoke "i32.trunc_u_f64" (f64.const 0x1.fffffffdp+31)) (i32.const -2))
+(assert_return (invoke "i32.trunc_u_f64" (f64.const 0x1.fffffffep+31)) (i32.const -1))
+(assert_return (invoke "i32.trunc_u_f64" (f64.const 0x1.ffffffffp+31)) (i32.const -1))
+(assert_return (invoke "i32.trunc_u_f64" (f64.const 0x1.fffffffcp+31)) (i32.const -2))
+(assert_trap (invoke "i32.trunc_u_f64" (f64.const 0x1p+32)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u_f64" (f64.const 0x1.000000008p+32)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u_f64" (f64.const 0x1.00000001p+32)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u_f64" (f64.const -0x1.8p+0)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u_f64" (f64.const -0x1p+1)) "integer overflow")
+(assert_return (invoke "i32.trunc_u_f64" (f64.const -0x1.ccccccccccccdp-1)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u_f64" (f64.const 0x1.ccccccccccccdp-1)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u_f64" (f64.const 0x1.8p+0)) (i32.const 1))
+(assert_trap (invoke "i32.trunc_u_f64" (f64.const -0x1.4p+31)) "integer overflow")
+(assert_return (invoke "i32.trunc_u_f64" (f64.const 0x1.4p+31)) (i32.const 0xa0000000))
+(assert_return (invoke "i32.trunc_u_f64" (f64.const 0x1.8p+31)) (i32.const 0xc0000000))
+(assert_return (invoke "i32.trunc_u_f64" (f64.const 0x1.cp+31)) (i32.const 0xe0000000))
+
+(assert_return (invoke "i64.trunc_s_f32" (f32.const -0x0p+0)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s_f32" (f32.const 0x0p+0)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s_f32" (f32.const -0x1p-149)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s_f32" (f32.const 0x1p-149)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s_f32" (f32.const -0x1p-126)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s_f32" (f32.const 0x1p-126)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s_f32" (f32.const -0x1p-1)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s_f32" (f32.const 0x1p-1)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s_f32" (f32.const -0x1p+0)) (i64.const -1))
+(assert_return (invoke "i64.trunc_s_f32" (f32.const 0x1p+0)) (i64.const 1))
+(assert_return (invoke "i64.trunc_s_f32" (f32.const -0x1.921fb6p+2)) (i64.const -6))
+(assert_return (invoke "i64.trunc_s_f32" (f32.const 0x1.921fb6p+2)) (i64.const 6))
+(assert_trap (invoke "i64.trunc_s_f32" (f32.const -0x1.fffffep+127)) "integer overflow")
+(assert_trap (invoke "i64.trunc_s_f32" (f32.const 0x1.fffffep+127)) "integer overflow")
+(assert_trap (invoke "i64.trunc_s_f32" (f32.const -inf)) "integer overflow")
+(assert_trap (invoke "i64.trunc_s_f32" (f32.const inf)) "integer overflow")
+(assert_trap (invoke "i64.trunc_s_f32" (f32.const -nan)) "invalid conversion to integer")
+(assert_trap (invoke "i64.trunc_s_f32" (f32.const nan)) "invalid conversion to integer")
+(assert_trap (invoke "i64.trunc_s_f32" (f32.const nan:0x600000)) "invalid conversion to integer")
+(assert_return (invoke "i64.trunc_s_f32" (f32.const 0x1.e393b4p+9)) (i64.const 967))
+(assert_return (invoke "i64.trunc_s_f32" (f32.const -0x1.28f944p+1)) (i64.const -2))
+(assert_return (invoke "i64.trunc_s_f32" (f32.const -0x1p+63)) (i64.const 0x8000000000000000))
+(assert_trap (invoke "i64.trunc_s_f32" (f32.const 0x1p+63)) "integer overflow")
+(assert_return (invoke "i64.trunc_s_f32" (f32.const -0x1.8p+0)) (i64.const -1))
+(assert_return (invoke "i64.trunc_s_f32" (f32.const -0x1.ccccccp-1)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s_f32" (f32.const 0x1.ccccccp-1)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s_f32" (f32.const 0x1.8p+0)) (i64.const 1))
+(assert_trap (invoke "i64.trunc_s_f32" (f32.const -0x1.4p+63)) "integer overflow")
+(assert_trap (invoke "i64.trunc_s_f32" (f32.const 0x1.4p+63)) "integer overflow")
+(assert_trap (invoke "i64.trunc_s_f32" (f32.const 0x1.8p+63)) "integer overflow")
+(assert_trap (invoke "i64.trunc_s_f32" (f32.const 0x1.cp+63)) "integer overflow")
+
+(assert_return (invoke "i64.trunc_s_f64" (f64.const -0x0p+0)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s_f64" (f64.const 0x0p+0)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s_f64" (f64.const -0x1p-1074)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s_f64" (f64.const 0x1p-1074)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s_f64" (f64.const -0x1p-1022)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s_f64" (f64.const 0x1p-1022)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s_f64" (f64.const -0x1p-1)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s_f64" (f64.const 0x1p-1)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s_f64" (f64.const -0x1p+0)) (i64.const -1))
+(assert_return (invoke "i64.trunc_s_f64" (f64.const 0x1p+0)) (i64.const 1))
+(assert_return (invoke "i64.trunc_s_f64" (f64.const -0x1.921fb54442d18p+2)) (i64.const -6))
+(assert_return (invoke "i64.trunc_s_f64" (f64.const 0x1.921fb54442d18p+2)) (i64.const 6))
+(assert_trap (invoke "i64.trunc_s_f64" (f64.const -0x1.fffffffffffffp+1023)) "integer overflow")
+(assert_trap (invoke "i64.trunc_s_f64" (f64.const 0x1.fffffffffffffp+1023)) "integer overflow")
+(assert_trap (invoke "i64.trunc_s_f64" (f64.const -inf)) "integer overflow")
+(assert_trap (invoke "i64.trunc_s_f64" (f64.const inf)) "integer overflow")
+(assert_trap (invoke "i64.trunc_s_f64" (f64.const -nan)) "invalid conversion to integer")
+(assert_trap (invoke "i64.trunc_s_f64" (f64.const nan)) "invalid conversion to integer")
+(assert_trap (invoke "i64.trunc_s_f64" (f64.const nan:0xc000000000000)) "invalid conversion to integer")
+(assert_return (invoke "i64.trunc_s_f64" (f64.const 0x1.c9112a1d25287p-305)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s_f64" (f64.const 0x1.eda8b6fece41fp-36)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s_f64" (f64.const -0x1p+63)) (i64.const 0x8000000000000000))
+(assert_trap (invoke "i64.trunc_s_f64" (f64.const 0x1p+63)) "integer overflow")
+(assert_return (invoke "i64.trunc_s_f64" (f64.const -0x1.8p+0)) (i64.const -1))
+(assert_return (invoke "i64.trunc_s_f64" (f64.const -0x1.ccccccccccccdp-1)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s_f64" (f64.const 0x1.ccccccccccccdp-1)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s_f64" (f64.const 0x1.8p+0)) (i64.const 1))
+(assert_trap (invoke "i64.trunc_s_f64" (f64.const -0x1.4p+63)) "integer overflow")
+(assert_trap (invoke "i64.trunc_s_f64" (f64.const 0x1.4p+63)) "integer overflow")
+(assert_trap (invoke "i64.trunc_s_f64" (f64.const 0x1.8p+63)) "integer overflow")
+(assert_trap (invoke "i64.trunc_s_f64" (f64.const 0x1.cp+63)) "integer overflow")
+
+(assert_return (invoke "i64.trunc_u_f32" (f32.const -0x0p+0)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u_f32" (f32.const 0x0p+0)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u_f32" (f32.const -0x1p-149)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u_f32" (f32.const 0x1p-149)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u_f32" (f32.const -0x1p-126)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u_f32" (f32.const 0x1p-126)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u_f32" (f32.const -0x1p-1)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u_f32" (f32.const 0x1p-1)) (i64.const 0))
+(assert_trap (invoke "i64.trunc_u_f32" (f32.const -0x1p+0)) "integer overflow")
+(assert_return (invoke "i64.trunc_u_f32" (f32.const 0x1p+0)) (i64.const 1))
+(assert_trap (invoke "i64.trunc_u_f32" (f32.const -0x1.921fb6p+2)) "integer overflow")
+(assert_return (invoke "i64.trunc_u_f32" (f32.const 0x1.921fb6p+2)) (i64.const 6))
+(assert_trap (invoke "i64.trunc_u_f32" (f32.const -0x1.fffffep+127)) "integer overflow")
+(assert_trap (invoke "i64.trunc_u_f32" (f32.const 0x1.fffffep+127)) "integer overflow")
+(assert_trap (invoke "i64.trunc_u_f32" (f32.const -inf)) "integer overflow")
+(assert_trap (invoke "i64.trunc_u_f32" (f32.const inf)) "integer overflow")
+(assert_trap (invoke "i64.trunc_u_f32" (f32.const -nan)) "invalid conversion to integer")
+(assert_trap (invoke "i64.trunc_u_f32" (f32.const nan)) "invalid conversion to integer")
+(assert_trap (invoke "i64.trunc_u_f32" (f32.const nan:0x600000)) "invalid conversion to integer")
+(assert_return (invoke "i64.trunc_u_f32" (f32.const -0x1.3eb492p-123)) (i64.const 0))
+(assert_trap (invoke "i64.trunc_u_f32" (f32.const -0x1.610828p+13)) "integer overflow")
+(assert_trap (invoke "i64.trunc_u_f32" (f32.const 0x1p+64)) "integer overflow")
+(assert_trap (invoke "i64.trunc_u_f32" (f32.const -0x1.8p+0)) "integer overflow")
+(assert_trap (invoke "i64.trunc_u_f32" (f32.const -0x1p+1)) "integer overflow")
+(assert_return (invoke "i64.trunc_u_f32" (f32.const -0x1.ccccccp-1)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u_f32" (f32.const 0x1.ccccccp-1)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u_f32" (f32.const 0x1.8p+0)) (i64.const 1))
+(assert_trap (invoke "i64.trunc_u_f32" (f32.const -0x1.4p+63)) "integer overflow")
+(assert_return (invoke "i64.trunc_u_f32" (f32.const 0x1.4p+63)) (i64.const 0xa000000000000000))
+(assert_return (invoke "i64.trunc_u_f32" (f32.const 0x1.8p+63)) (i64.const 0xc000000000000000))
+(assert_return (invoke "i64.trunc_u_f32" (f32.const 0x1.cp+63)) (i64.const 0xe000000000000000))
+
+(assert_return (invoke "i64.trunc_u_f64" (f64.const -0x0p+0)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u_f64" (f64.const 0x0p+0)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u_f64" (f64.const -0x1p-1074)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u_f64" (f64.const 0x1p-1074)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u_f64" (f64.const -0x1p-1022)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u_f64" (f64.const 0x1p-1022)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u_f64" (f64.const -0x1p-1)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u_f64" (f64.const 0x1p-1)) (i64.const 0))
+(assert_trap (invoke "i64.trunc_u_f64" (f64.const -0x1p+0)) "integer overflow")
+(assert_return (invoke "i64.trunc_u_f64" (f64.const 0x1p+0)) (i64.const 1))
+(assert_trap (invoke "i64.trunc_u_f64" (f64.const -0x1.921fb54442d18p+2)) "integer overflow")
+(assert_return (invoke "i64.trunc_u_f64" (f64.const 0x1.921fb54442d18p+2)) (i64.const 6))
+(assert_trap (invoke "i64.trunc_u_f64" (f64.const -0x1.fffffffffffffp+1023)) "integer overflow")
+(assert_trap (invoke "i64.trunc_u_f64" (f64.const 0x1.fffffffffffffp+1023)) "integer overflow")
+(assert_trap (invoke "i64.trunc_u_f64" (f64.const -inf)) "integer overflow")
+(assert_trap (invoke "i64.trunc_u_f64" (f64.const inf)) "integer overflow")
+(assert_trap (invoke "i64.trunc_u_f64" (f64.const -nan)) "invalid conversion to integer")
+(assert_trap (invoke "i64.trunc_u_f64" (f64.const nan)) "invalid conversion to integer")
+(assert_trap (invoke "i64.trunc_u_f64" (f64.const nan:0xc000000000000)) "invalid conversion to integer")
+(assert_trap (invoke "i64.trunc_u_f64" (f64.const 0x1.2d8d442a6c526p+841)) "integer overflow")
+(assert_return (invoke "i64.trunc_u_f64" (f64.const 0x1.f67fc30876fdcp-210)) (i64.const 0))
+(assert_trap (invoke "i64.trunc_u_f64" (f64.const 0x1p+64)) "integer overflow")
+(assert_trap (invoke "i64.trunc_u_f64" (f64.const -0x1.8p+0)) "integer overflow")
+(assert_trap (invoke "i64.trunc_u_f64" (f64.const -0x1p+1)) "integer overflow")
+(assert_return (invoke "i64.trunc_u_f64" (f64.const -0x1.ccccccccccccdp-1)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u_f64" (f64.const 0x1.ccccccccccccdp-1)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u_f64" (f64.const 0x1.8p+0)) (i64.const 1))
+(assert_trap (invoke "i64.trunc_u_f64" (f64.const -0x1.4p+63)) "integer overflow")
+(assert_return (invoke "i64.trunc_u_f64" (f64.const 0x1.4p+63)) (i64.const 0xa000000000000000))
+(assert_return (invoke "i64.trunc_u_f64" (f64.const 0x1.8p+63)) (i64.const 0xc000000000000000))
+(assert_return (invoke "i64.trunc_u_f64" (f64.const 0x1.cp+63)) (i64.const 0xe000000000000000))
+
+(assert_return (invoke "f32.convert_s_i32" (i32.const 0)) (f32.const 0x0p+0))
+(assert_return (invoke "f32.convert_s_i32" (i32.const 1)) (f32.const 0x1p+0))
+(assert_return (invoke "f32.convert_s_i32" (i32.const -1)) (f32.const -0x1p+0))
+(assert_return (invoke "f32.convert_s_i32" (i32.const 0x7fffffff)) (f32.const 0x1p+31))
+(assert_return (invoke "f32.convert_s_i32" (i32.const 0x80000000)) (f32.const -0x1p+31))
+(assert_return (invoke "f32.convert_s_i32" (i32.const 0x80000001)) (f32.const -0x1p+31))
+(assert_return (invoke "f32.convert_s_i32" (i32.const 0x12345678)) (f32.const 0x1.234568p+28))
+(assert_return (invoke "f32.convert_s_i32" (i32.const 0x9abcdef0)) (f32.const -0x1.950c84p+30))
+(assert_return (invoke "f32.convert_s_i32" (i32.const 0x1000001)) (f32.const 0x1p+24))
+(assert_return (invoke "f32.convert_s_i32" (i32.const 0x1000003)) (f32.const 0x1.000004p+24))
+
+(assert_return (invoke "f32.convert_s_i64" (i64.const 0)) (f32.const 0x0p+0))
+(assert_return (invoke "f32.convert_s_i64" (i64.const 1)) (f32.const 0x1p+0))
+(assert_return (invoke "f32.convert_s_i64" (i64.const -1)) (f32.const -0x1p+0))
+(assert_return (invoke "f32.convert_s_i64" (i64.const 0x7fffffffffffffff)) (f32.const 0x1p+63))
+(assert_return (invoke "f32.convert_s_i64" (i64.const 0x8000000000000000)) (f32.const -0x1p+63))
+(assert_return (invoke "f32.convert_s_i64" (i64.const 0x8000000000000001)) (f32.const -0x1p+63))
+(assert_return (invoke "f32.convert_s_i64" (i64.const 0x123456789abcdef)) (f32.const 0x1.234568p+56))
+(assert_return (invoke "f32.convert_s_i64" (i64.const 0xfedcba9876543210)) (f32.const -0x1.234568p+56))
+(assert_return (invoke "f32.convert_s_i64" (i64.const 0x20000000000001)) (f32.const 0x1p+53))
+(assert_return (invoke "f32.convert_s_i64" (i64.const 0x20000000000003)) (f32.const 0x1p+53))
+(assert_return (invoke "f32.convert_s_i64" (i64.const 0x20000020000001)) (f32.const 0x1.000002p+53))
+(assert_return (invoke "f32.convert_s_i64" (i64.const 0x7fffff4000000001)) (f32.const 0x1.fffffep+62))
+(assert_return (invoke "f32.convert_s_i64" (i64.const 0x8000008000000001)) (f32.const -0x1.fffffep+62))
+(assert_return (invoke "f32.convert_s_i64" (i64.const -3072)) (f32.const -0x1.8p+11))
+(assert_return (invoke "f32.convert_s_i64" (i64.const -1025)) (f32.const -0x1.004p+10))
+
+(assert_return (invoke "f32.convert_u_i32" (i32.const 0)) (f32.const 0x0p+0))
+(assert_return (invoke "f32.convert_u_i32" (i32.const 1)) (f32.const 0x1p+0))
+(assert_return (invoke "f32.convert_u_i32" (i32.const -1)) (f32.const 0x1p+32))
+(assert_return (invoke "f32.convert_u_i32" (i32.const 0x7fffffff)) (f32.const 0x1p+31))
+(assert_return (invoke "f32.convert_u_i32" (i32.const 0x80000000)) (f32.const 0x1p+31))
+(assert_return (invoke "f32.convert_u_i32" (i32.const 0x80000001)) (f32.const 0x1p+31))
+(assert_return (invoke "f32.convert_u_i32" (i32.const 0x12345678)) (f32.const 0x1.234568p+28))
+(assert_return (invoke "f32.convert_u_i32" (i32.const 0x9abcdef0)) (f32.const 0x1.3579bep+31))
+(assert_return (invoke "f32.convert_u_i32" (i32.const 0x1000001)) (f32.const 0x1p+24))
+(assert_return (invoke "f32.convert_u_i32" (i32.const 0x1000003)) (f32.const 0x1.000004p+24))
+
+(assert_return (invoke "f32.convert_u_i64" (i64.const 0)) (f32.const 0x0p+0))
+(assert_return (invoke "f32.convert_u_i64" (i64.const 1)) (f32.const 0x1p+0))
+(assert_return (invoke "f32.convert_u_i64" (i64.const -1)) (f32.const 0x1p+64))
+(assert_return (invoke "f32.convert_u_i64" (i64.const 0x7fffffffffffffff)) (f32.const 0x1p+63))
+(assert_return (invoke "f32.convert_u_i64" (i64.const 0x8000000000000000)) (f32.const 0x1p+63))
+(assert_return (invoke "f32.convert_u_i64" (i64.const 0x8000000000000001)) (f32.const 0x1p+63))
+(assert_return (invoke "f32.convert_u_i64" (i64.const 0x123456789abcdef)) (f32.const 0x1.234568p+56))
+(assert_return (invoke "f32.convert_u_i64" (i64.const 0xfedcba9876543210)) (f32.const 0x1.fdb976p+63))
+(assert_return (invoke "f32.convert_u_i64" (i64.const 0x20000000000001)) (f32.const 0x1p+53))
+(assert_return (invoke "f32.convert_u_i64" (i64.const 0x20000000000003)) (f32.const 0x1p+53))
+(assert_return (invoke "f32.convert_u_i64" (i64.const 0x20000020000001)) (f32.const 0x1.000002p+53))
+(assert_return (invoke "f32.convert_u_i64" (i64.const 0x7fffff4000000001)) (f32.const 0x1.fffffep+62))
+(assert_return (invoke "f32.convert_u_i64" (i64.const 0x8000008000000001)) (f32.const 0x1.000002p+63))
+(assert_return (invoke "f32.convert_u_i64" (i64.const -3072)) (f32.const 0x1p+64))
+(assert_return (invoke "f32.convert_u_i64" (i64.const -1025)) (f32.const 0x1p+64))
+
+(assert_return (invoke "f64.convert_s_i32" (i32.const 0)) (f64.const 0x0p+0))
+(assert_return (invoke "f64.convert_s_i32" (i32.const 1)) (f64.const 0x1p+0))
+(assert_return (invoke "f64.convert_s_i32" (i32.const -1)) (f64.const -0x1p+0))
+(assert_return (invoke "f64.convert_s_i32" (i32.const 0x7fffffff)) (f64.const 0x1.fffffffcp+30))
+(assert_return (invoke "f64.convert_s_i32" (i32.const 0x80000000)) (f64.const -0x1p+31))
+(assert_return (invoke "f64.convert_s_i32" (i32.const 0x80000001)) (f64.const -0x1.fffffffcp+30))
+(assert_return (invoke "f64.convert_s_i32" (i32.const 0x12345678)) (f64.const 0x1.2345678p+28))
+(assert_return (invoke "f64.convert_s_i32" (i32.const 0x9abcdef0)) (f64.const -0x1.950c844p+30))
+(assert_return (invoke "f64.convert_s_i32" (i32.const 0x1000001)) (f64.const 0x1.000001p+24))
+(assert_return (invoke "f64.convert_s_i32" (i32.const 0x1000003)) (f64.const 0x1.000003p+24))
+
+(assert_return (invoke "f64.convert_s_i64" (i64.const 0)) (f64.const 0x0p+0))
+(assert_return (invoke "f64.convert_s_i64" (i64.const 1)) (f64.const 0x1p+0))
+(assert_return (invoke "f64.convert_s_i64" (i64.const -1)) (f64.const -0x1p+0))
+(assert_return (invoke "f64.convert_s_i64" (i64.const 0x7fffffffffffffff)) (f64.const 0x1p+63))
+(assert_return (invoke "f64.convert_s_i64" (i64.const 0x8000000000000000)) (f64.const -0x1p+63))
+(assert_return (invoke "f64.convert_s_i64" (i64.const 0x8000000000000001)) (f64.const -0x1p+63))
+(assert_return (invoke "f64.convert_s_i64" (i64.const 0x123456789abcdef)) (f64.const 0x1.23456789abcdfp+56))
+(assert_return (invoke "f64.convert_s_i64" (i64.const 0xfedcba9876543210)) (f64.const -0x1.23456789abcdfp+56))
+(assert_return (invoke "f64.convert_s_i64" (i64.const 0x20000000000001)) (f64.const 0x1p+53))
+(assert_return (invoke "f64.convert_s_i64" (i64.const 0x20000000000003)) (f64.const 0x1.0000000000002p+53))
+(assert_return (invoke "f64.convert_s_i64" (i64.const 0x20000020000001)) (f64.const 0x1.000001p+53))
+(assert_return (invoke "f64.convert_s_i64" (i64.const 0x7fffff4000000001)) (f64.const 0x1.fffffdp+62))
+(assert_return (invoke "f64.convert_s_i64" (i64.const 0x8000008000000001)) (f64.const -0x1.fffffep+62))
+(assert_return (invoke "f64.convert_s_i64" (i64.const -3072)) (f64.const -0x1.8p+11))
+(assert_return (invoke "f64.convert_s_i64" (i64.const -1025)) (f64.const -0x1.004p+10))
+
+(assert_return (invoke "f64.convert_u_i32" (i32.const 0)) (f64.const 0x0p+0))
+(assert_return (invoke "f64.convert_u_i32" (i32.const 1)) (f64.const 0x1p+0))
+(assert_return (invoke "f64.convert_u_i32" (i32.const -1)) (f64.const 0x1.fffffffep+31))
+(assert_return (invoke "f64.convert_u_i32" (i32.const 0x7fffffff)) (f64.const 0x1.fffffffcp+30))
+(assert_return (invoke "f64.convert_u_i32" (i32.const 0x80000000)) (f64.const 0x1p+31))
+(assert_return (invoke "f64.convert_u_i32" (i32.const 0x80000001)) (f64.const 0x1.00000002p+31))
+(assert_return (invoke "f64.convert_u_i32" (i32.const 0x12345678)) (f64.const 0x1.2345678p+28))
+(assert_return (invoke "f64.convert_u_i32" (i32.const 0x9abcdef0)) (f64.const 0x1.3579bdep+31))
+(assert_return (invoke "f64.convert_u_i32" (i32.const 0x1000001)) (f64.const 0x1.000001p+24))
+(assert_return (invoke "f64.convert_u_i32" (i32.const 0x1000003)) (f64.const 0x1.000003p+24))
+
+(assert_return (invoke "f64.convert_u_i64" (i64.const 0)) (f64.const 0x0p+0))
+(assert_return (invoke "f64.convert_u_i64" (i64.const 1)) (f64.const 0x1p+0))
+(assert_return (invoke "f64.convert_u_i64" (i64.const -1)) (f64.const 0x1p+64))
+(assert_return (invoke "f64.convert_u_i64" (i64.const 0x7fffffffffffffff)) (f64.const 0x1p+63))
+(assert_return (invoke "f64.convert_u_i64" (i64.const 0x8000000000000000)) (f64.const 0x1p+63))
+(assert_return (invoke "f64.convert_u_i64" (i64.const 0x8000000000000001)) (f64.const 0x1p+63))
+(assert_return (invoke "f64.convert_u_i64" (i64.const 0x123456789abcdef)) (f64.const 0x1.23456789abcdfp+56))
+(assert_return (invoke "f64.convert_u_i64" (i64.const 0xfedcba9876543210)) (f64.const 0x1.fdb97530eca86p+63))
+(assert_return (invoke "f64.convert_u_i64" (i64.const 0x20000000000001)) (f64.const 0x1p+53))
+(assert_return (invoke "f64.convert_u_i64" (i64.const 0x20000000000003)) (f64.const 0x1.0000000000002p+53))
+(assert_return (invoke "f64.convert_u_i64" (i64.const 0x20000020000001)) (f64.const 0x1.000001p+53))
+(assert_return (invoke "f64.convert_u_i64" (i64.const 0x7fffff4000000001)) (f64.const 0x1.fffffdp+62))
+(assert_return (invoke "f64.convert_u_i64" (i64.const 0x8000008000000001)) (f64.const 0x1.000001p+63))
+(assert_return (invoke "f64.convert_u_i64" (i64.const -3072)) (f64.const 0x1.ffffffffffffep+63))
+(assert_return (invoke "f64.convert_u_i64" (i64.const -1025)) (f64.const 0x1.fffffffffffffp+63))
+
+(assert_return (invoke "f64.promote_f32" (f32.const -0x0p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "f64.promote_f32" (f32.const 0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "f64.promote_f32" (f32.const -0x1p-149)) (f64.const -0x1p-149))
+(assert_return (invoke "f64.promote_f32" (f32.const 0x1p-149)) (f64.const 0x1p-149))
+(assert_return (invoke "f64.promote_f32" (f32.const -0x1p-126)) (f64.const -0x1p-126))
+(assert_return (invoke "f64.promote_f32" (f32.const 0x1p-126)) (f64.const 0x1p-126))
+(assert_return (invoke "f64.promote_f32" (f32.const -0x1p-1)) (f64.const -0x1p-1))
+(assert_return (invoke "f64.promote_f32" (f32.const 0x1p-1)) (f64.const 0x1p-1))
+(assert_return (invoke "f64.promote_f32" (f32.const -0x1p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "f64.promote_f32" (f32.const 0x1p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "f64.promote_f32" (f32.const -0x1.921fb6p+2)) (f64.const -0x1.921fb6p+2))
+(assert_return (invoke "f64.promote_f32" (f32.const 0x1.921fb6p+2)) (f64.const 0x1.921fb6p+2))
+(assert_return (invoke "f64.promote_f32" (f32.const -0x1.fffffep+127)) (f64.const -0x1.fffffep+127))
+(assert_return (invoke "f64.promote_f32" (f32.const 0x1.fffffep+127)) (f64.const 0x1.fffffep+127))
+(assert_return (invoke "f64.promote_f32" (f32.const -inf)) (f64.const -inf))
+(assert_return (invoke "f64.promote_f32" (f32.const inf)) (f64.const inf))
+(assert_return_canonical_nan (invoke "f64.promote_f32" (f32.const -nan)))
+(assert_return_canonical_nan (invoke "f64.promote_f32" (f32.const nan)))
+(assert_return_arithmetic_nan (invoke "f64.promote_f32" (f32.const nan:0x200000)))
+(assert_return_arithmetic_nan (invoke "f64.promote_f32" (f32.const -nan:0x200000)))
+(assert_return (invoke "f64.promote_f32" (f32.const 0x1.99999ap-4)) (f64.const 0x1.99999ap-4))
+
+(assert_return (invoke "f32.demote_f64" (f64.const -0x0p+0)) (f32.const -0x0p+0))
+(assert_return (invoke "f32.demote_f64" (f64.const 0x0p+0)) (f32.const 0x0p+0))
+(assert_return (invoke "f32.demote_f64" (f64.const -0x1p-1074)) (f32.const -0x0p+0))
+(assert_return (invoke "f32.demote_f64" (f64.const 0x1p-1074)) (f32.const 0x0p+0))
+(assert_return (invoke "f32.demote_f64" (f64.const -0x1p-1022)) (f32.const -0x0p+0))
+(assert_return (invoke "f32.demote_f64" (f64.const 0x1p-1022)) (f32.const 0x0p+0))
+(assert_return (invoke "f32.demote_f64" (f64.const -0x1p-1)) (f32.const -0x1p-1))
+(assert_return (invoke "f32.demote_f64" (f64.const 0x1p-1)) (f32.const 0x1p-1))
+(assert_return (invoke "f32.demote_f64" (f64.const -0x1p+0)) (f32.const -0x1p+0))
+(assert_return (invoke "f32.demote_f64" (f64.const 0x1p+0)) (f32.const 0x1p+0))
+(assert_return (invoke "f32.demote_f64" (f64.const -0x1.921fb54442d18p+2)) (f32.const -0x1.921fb6p+2))
+(assert_return (invoke "f32.demote_f64" (f64.const 0x1.921fb54442d18p+2)) (f32.const 0x1.921fb6p+2))
+(assert_return (invoke "f32.demote_f64" (f64.const -0x1.fffffffffffffp+1023)) (f32.const -inf))
+(assert_return (invoke "f32.demote_f64" (f64.const 0x1.fffffffffffffp+1023)) (f32.const inf))
+(assert_return (invoke "f32.demote_f64" (f64.const -inf)) (f32.const -inf))
+(assert_return (invoke "f32.demote_f64" (f64.const inf)) (f32.const inf))
+(assert_return_canonical_nan (invoke "f32.demote_f64" (f64.const -nan)))
+(assert_return_canonical_nan (invoke "f32.demote_f64" (f64.const nan)))
+(assert_return_arithmetic_nan (invoke "f32.demote_f64" (f64.const nan:0x4000000000000)))
+(assert_return (invoke "f32.demote_f64" (f64.const 0x1.000001p+0)) (f32.const 0x1p+0))
+(assert_return (invoke "f32.demote_f64" (f64.const 0x1.0000010000001p+0)) (f32.const 0x1.000002p+0))
+(assert_return (invoke "f32.demote_f64" (f64.const 0x1.000003p+0)) (f32.const 0x1.000004p+0))
+(assert_return (invoke "f32.demote_f64" (f64.const 0x1.fffffep+127)) (f32.const 0x1.fffffep+127))
+(assert_return (invoke "f32.demote_f64" (f64.const 0x1.fffffdfffffffp+127)) (f32.const 0x1.fffffep+127))
+(assert_return (invoke "f32.demote_f64" (f64.const 0x1p-149)) (f32.const 0x1p-149))
+(assert_return (invoke "f32.demote_f64" (f64.const 0x1.0000000000001p-149)) (f32.const 0x1p-149))
+(assert_return (invoke "f32.demote_f64" (f64.const 0x1p-150)) (f32.const 0x0p+0))
+(assert_return (invoke "f32.demote_f64" (f64.const 0x1.fffffep-127)) (f32.const 0x1p-126))
+(assert_return (invoke "f32.demote_f64" (f64.const 0x1.999999999999ap-4)) (f32.const 0x1.99999ap-4))
+
+(assert_return (invoke "f32.reinterpret_i32" (i32.const 0)) (f32.const 0x0p+0))
+(assert_return (invoke "i32.reinterpret_f32" (f32.const 0x0p+0)) (i32.const 0))
+(assert_return (invoke "f32.reinterpret_i32" (i32.const 0x80000000)) (f32.const -0x0p+0))
+(assert_return (invoke "i32.reinterpret_f32" (f32.const -0x0p+0)) (i32.const 0x80000000))
+(assert_return (invoke "f32.reinterpret_i32" (i32.const 1)) (f32.const 0x1p-149))
+(assert_return (invoke "i32.reinterpret_f32" (f32.const 0x1p-149)) (i32.const 1))
+(assert_return (invoke "f32.reinterpret_i32" (i32.const 0x7f800000)) (f32.const inf))
+(assert_return (invoke "i32.reinterpret_f32" (f32.const inf)) (i32.const 0x7f800000))
+(assert_return (invoke "f32.reinterpret_i32" (i32.const 0xff800000)) (f32.const -inf))
+(assert_return (invoke "i32.reinterpret_f32" (f32.const -inf)) (i32.const 0xff800000))
+(assert_return (invoke "f32.reinterpret_i32" (i32.const 0x7fc00000)) (f32.const nan))
+(assert_return (invoke "i32.reinterpret_f32" (f32.const nan)) (i32.const 0x7fc00000))
+(assert_return (invoke "f32.reinterpret_i32" (i32.const 0x7fa00000)) (f32.const nan:0x200000))
+(assert_return (invoke "i32.reinterpret_f32" (f32.const nan:0x200000)) (i32.const 0x7fa00000))
+(assert_return (invoke "f32.reinterpret_i32" (i32.const 0x3f800000)) (f32.const 0x1p+0))
+(assert_return (invoke "i32.reinterpret_f32" (f32.const 0x1p+0)) (i32.const 0x3f800000))
+(assert_return (invoke "f32.reinterpret_i32" (i32.const 0x12345678)) (f32.const 0x1.68acfp-91))
+(assert_return (invoke "i32.reinterpret_f32" (f32.const 0x1.68acfp-91)) (i32.const 0x12345678))
+(assert_return (invoke "f64.reinterpret_i64" (i64.const 0)) (f64.const 0x0p+0))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const 0x0p+0)) (i64.const 0))
+(assert_return (invoke "f64.reinterpret_i64" (i64.const 0x8000000000000000)) (f64.const -0x0p+0))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const -0x0p+0)) (i64.const 0x8000000000000000))
+(assert_return (invoke "f64.reinterpret_i64" (i64.const 1)) (f64.const 0x1p-1074))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const 0x1p-1074)) (i64.const 1))
+(assert_return (invoke "f64.reinterpret_i64" (i64.const 0x7ff0000000000000)) (f64.const inf))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const inf)) (i64.const 0x7ff0000000000000))
+(assert_return (invoke "f64.reinterpret_i64" (i64.const 0xfff0000000000000)) (f64.const -inf))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const -inf)) (i64.const 0xfff0000000000000))
+(assert_return (invoke "f64.reinterpret_i64" (i64.const 0x7ff8000000000000)) (f64.const nan))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const nan)) (i64.const 0x7ff8000000000000))
+(assert_return (invoke "f64.reinterpret_i64" (i64.const 0x7ff4000000000000)) (f64.const nan:0x4000000000000))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const nan:0x4000000000000)) (i64.const 0x7ff4000000000000))
+(assert_return (invoke "f64.reinterpret_i64" (i64.const 0x3ff0000000000000)) (f64.const 0x1p+0))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const 0x1p+0)) (i64.const 0x3ff0000000000000))
+(assert_return (invoke "f64.reinterpret_i64" (i64.const 0x123456789abcdef)) (f64.const 0x1.3456789abcdefp-1005))
+(assert_return (invoke "i64.reinterpret_f64" (f64.const 0x1.3456789abcdefp-1005)) (i64.const 0x123456789abcdef))
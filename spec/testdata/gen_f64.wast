@@ -0,0 +1,2355 @@
+;; f64 arithmetic: every pair of the values of the upstream suite, NaN with a
+;; payload, and values that round.
+(module
+  (func (export "add") (param $x f64) (param $y f64) (result f64) (f64.add (get_local $x) (get_local $y)))
+  (func (export "sub") (param $x f64) (param $y f64) (result f64) (f64.sub (get_local $x) (get_local $y)))
+  (func (export "mul") (param $x f64) (param $y f64) (result f64) (f64.mul (get_local $x) (get_local $y)))
+  (func (export "div") (param $x f64) (param $y f64) (result f64) (f64.div (get_local $x) (get_local $y)))
+  (func (export "min") (param $x f64) (param $y f64) (result f64) (f64.min (get_local $x) (get_local $y)))
+  (func (export "max") (param $x f64) (param $y f64) (result f64) (f64.max (get_local $x) (get_local $y)))
+  (func (export "sqrt") (param $x f64) (result f64) (f64.sqrt (get_local $x)))
+  (func (export "floor") (param $x f64) (result f64) (f64.floor (get_local $x)))
+  (func (export "ceil") (param $x f64) (result f64) (f64.ceil (get_local $x)))
+  (func (export "trunc") (param $x f64) (result f64) (f64.trunc (get_local $x)))
+  (func (export "nearest") (param $x f64) (result f64) (f64.nearest (get_local $x)))
+)
+
+(assert_return (invoke "add" (f64.const -0x0p+0) (f64.const -0x0p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "add" (f64.const -0x0p+0) (f64.const 0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "add" (f64.const -0x0p+0) (f64.const -0x1p-1074)) (f64.const -0x1p-1074))
+(assert_return (invoke "add" (f64.const -0x0p+0) (f64.const 0x1p-1074)) (f64.const 0x1p-1074))
+(assert_return (invoke "add" (f64.const -0x0p+0) (f64.const -0x1p-1022)) (f64.const -0x1p-1022))
+(assert_return (invoke "add" (f64.const -0x0p+0) (f64.const 0x1p-1022)) (f64.const 0x1p-1022))
+(assert_return (invoke "add" (f64.const -0x0p+0) (f64.const -0x1p-1)) (f64.const -0x1p-1))
+(assert_return (invoke "add" (f64.const -0x0p+0) (f64.const 0x1p-1)) (f64.const 0x1p-1))
+(assert_return (invoke "add" (f64.const -0x0p+0) (f64.const -0x1p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "add" (f64.const -0x0p+0) (f64.const 0x1p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "add" (f64.const -0x0p+0) (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "add" (f64.const -0x0p+0) (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "add" (f64.const -0x0p+0) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "add" (f64.const -0x0p+0) (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "add" (f64.const -0x0p+0) (f64.const -inf)) (f64.const -inf))
+(assert_return (invoke "add" (f64.const -0x0p+0) (f64.const inf)) (f64.const inf))
+(assert_return_canonical_nan (invoke "add" (f64.const -0x0p+0) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "add" (f64.const -0x0p+0) (f64.const nan)))
+(assert_return (invoke "add" (f64.const 0x0p+0) (f64.const -0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "add" (f64.const 0x0p+0) (f64.const 0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "add" (f64.const 0x0p+0) (f64.const -0x1p-1074)) (f64.const -0x1p-1074))
+(assert_return (invoke "add" (f64.const 0x0p+0) (f64.const 0x1p-1074)) (f64.const 0x1p-1074))
+(assert_return (invoke "add" (f64.const 0x0p+0) (f64.const -0x1p-1022)) (f64.const -0x1p-1022))
+(assert_return (invoke "add" (f64.const 0x0p+0) (f64.const 0x1p-1022)) (f64.const 0x1p-1022))
+(assert_return (invoke "add" (f64.const 0x0p+0) (f64.const -0x1p-1)) (f64.const -0x1p-1))
+(assert_return (invoke "add" (f64.const 0x0p+0) (f64.const 0x1p-1)) (f64.const 0x1p-1))
+(assert_return (invoke "add" (f64.const 0x0p+0) (f64.const -0x1p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "add" (f64.const 0x0p+0) (f64.const 0x1p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "add" (f64.const 0x0p+0) (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "add" (f64.const 0x0p+0) (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "add" (f64.const 0x0p+0) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "add" (f64.const 0x0p+0) (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "add" (f64.const 0x0p+0) (f64.const -inf)) (f64.const -inf))
+(assert_return (invoke "add" (f64.const 0x0p+0) (f64.const inf)) (f64.const inf))
+(assert_return_canonical_nan (invoke "add" (f64.const 0x0p+0) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "add" (f64.const 0x0p+0) (f64.const nan)))
+(assert_return (invoke "add" (f64.const -0x1p-1074) (f64.const -0x0p+0)) (f64.const -0x1p-1074))
+(assert_return (invoke "add" (f64.const -0x1p-1074) (f64.const 0x0p+0)) (f64.const -0x1p-1074))
+(assert_return (invoke "add" (f64.const -0x1p-1074) (f64.const -0x1p-1074)) (f64.const -0x1p-1073))
+(assert_return (invoke "add" (f64.const -0x1p-1074) (f64.const 0x1p-1074)) (f64.const 0x0p+0))
+(assert_return (invoke "add" (f64.const -0x1p-1074) (f64.const -0x1p-1022)) (f64.const -0x1.0000000000001p-1022))
+(assert_return (invoke "add" (f64.const -0x1p-1074) (f64.const 0x1p-1022)) (f64.const 0x1.ffffffffffffep-1023))
+(assert_return (invoke "add" (f64.const -0x1p-1074) (f64.const -0x1p-1)) (f64.const -0x1p-1))
+(assert_return (invoke "add" (f64.const -0x1p-1074) (f64.const 0x1p-1)) (f64.const 0x1p-1))
+(assert_return (invoke "add" (f64.const -0x1p-1074) (f64.const -0x1p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "add" (f64.const -0x1p-1074) (f64.const 0x1p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "add" (f64.const -0x1p-1074) (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "add" (f64.const -0x1p-1074) (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "add" (f64.const -0x1p-1074) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "add" (f64.const -0x1p-1074) (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "add" (f64.const -0x1p-1074) (f64.const -inf)) (f64.const -inf))
+(assert_return (invoke "add" (f64.const -0x1p-1074) (f64.const inf)) (f64.const inf))
+(assert_return_canonical_nan (invoke "add" (f64.const -0x1p-1074) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "add" (f64.const -0x1p-1074) (f64.const nan)))
+(assert_return (invoke "add" (f64.const 0x1p-1074) (f64.const -0x0p+0)) (f64.const 0x1p-1074))
+(assert_return (invoke "add" (f64.const 0x1p-1074) (f64.const 0x0p+0)) (f64.const 0x1p-1074))
+(assert_return (invoke "add" (f64.const 0x1p-1074) (f64.const -0x1p-1074)) (f64.const 0x0p+0))
+(assert_return (invoke "add" (f64.const 0x1p-1074) (f64.const 0x1p-1074)) (f64.const 0x1p-1073))
+(assert_return (invoke "add" (f64.const 0x1p-1074) (f64.const -0x1p-1022)) (f64.const -0x1.ffffffffffffep-1023))
+(assert_return (invoke "add" (f64.const 0x1p-1074) (f64.const 0x1p-1022)) (f64.const 0x1.0000000000001p-1022))
+(assert_return (invoke "add" (f64.const 0x1p-1074) (f64.const -0x1p-1)) (f64.const -0x1p-1))
+(assert_return (invoke "add" (f64.const 0x1p-1074) (f64.const 0x1p-1)) (f64.const 0x1p-1))
+(assert_return (invoke "add" (f64.const 0x1p-1074) (f64.const -0x1p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "add" (f64.const 0x1p-1074) (f64.const 0x1p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "add" (f64.const 0x1p-1074) (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "add" (f64.const 0x1p-1074) (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "add" (f64.const 0x1p-1074) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "add" (f64.const 0x1p-1074) (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "add" (f64.const 0x1p-1074) (f64.const -inf)) (f64.const -inf))
+(assert_return (invoke "add" (f64.const 0x1p-1074) (f64.const inf)) (f64.const inf))
+(assert_return_canonical_nan (invoke "add" (f64.const 0x1p-1074) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "add" (f64.const 0x1p-1074) (f64.const nan)))
+(assert_return (invoke "add" (f64.const -0x1p-1022) (f64.const -0x0p+0)) (f64.const -0x1p-1022))
+(assert_return (invoke "add" (f64.const -0x1p-1022) (f64.const 0x0p+0)) (f64.const -0x1p-1022))
+(assert_return (invoke "add" (f64.const -0x1p-1022) (f64.const -0x1p-1074)) (f64.const -0x1.0000000000001p-1022))
+(assert_return (invoke "add" (f64.const -0x1p-1022) (f64.const 0x1p-1074)) (f64.const -0x1.ffffffffffffep-1023))
+(assert_return (invoke "add" (f64.const -0x1p-1022) (f64.const -0x1p-1022)) (f64.const -0x1p-1021))
+(assert_return (invoke "add" (f64.const -0x1p-1022) (f64.const 0x1p-1022)) (f64.const 0x0p+0))
+(assert_return (invoke "add" (f64.const -0x1p-1022) (f64.const -0x1p-1)) (f64.const -0x1p-1))
+(assert_return (invoke "add" (f64.const -0x1p-1022) (f64.const 0x1p-1)) (f64.const 0x1p-1))
+(assert_return (invoke "add" (f64.const -0x1p-1022) (f64.const -0x1p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "add" (f64.const -0x1p-1022) (f64.const 0x1p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "add" (f64.const -0x1p-1022) (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "add" (f64.const -0x1p-1022) (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "add" (f64.const -0x1p-1022) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "add" (f64.const -0x1p-1022) (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "add" (f64.const -0x1p-1022) (f64.const -inf)) (f64.const -inf))
+(assert_return (invoke "add" (f64.const -0x1p-1022) (f64.const inf)) (f64.const inf))
+(assert_return_canonical_nan (invoke "add" (f64.const -0x1p-1022) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "add" (f64.const -0x1p-1022) (f64.const nan)))
+(assert_return (invoke "add" (f64.const 0x1p-1022) (f64.const -0x0p+0)) (f64.const 0x1p-1022))
+(assert_return (invoke "add" (f64.const 0x1p-1022) (f64.const 0x0p+0)) (f64.const 0x1p-1022))
+(assert_return (invoke "add" (f64.const 0x1p-1022) (f64.const -0x1p-1074)) (f64.const 0x1.ffffffffffffep-1023))
+(assert_return (invoke "add" (f64.const 0x1p-1022) (f64.const 0x1p-1074)) (f64.const 0x1.0000000000001p-1022))
+(assert_return (invoke "add" (f64.const 0x1p-1022) (f64.const -0x1p-1022)) (f64.const 0x0p+0))
+(assert_return (invoke "add" (f64.const 0x1p-1022) (f64.const 0x1p-1022)) (f64.const 0x1p-1021))
+(assert_return (invoke "add" (f64.const 0x1p-1022) (f64.const -0x1p-1)) (f64.const -0x1p-1))
+(assert_return (invoke "add" (f64.const 0x1p-1022) (f64.const 0x1p-1)) (f64.const 0x1p-1))
+(assert_return (invoke "add" (f64.const 0x1p-1022) (f64.const -0x1p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "add" (f64.const 0x1p-1022) (f64.const 0x1p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "add" (f64.const 0x1p-1022) (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "add" (f64.const 0x1p-1022) (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "add" (f64.const 0x1p-1022) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "add" (f64.const 0x1p-1022) (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "add" (f64.const 0x1p-1022) (f64.const -inf)) (f64.const -inf))
+(assert_return (invoke "add" (f64.const 0x1p-1022) (f64.const inf)) (f64.const inf))
+(assert_return_canonical_nan (invoke "add" (f64.const 0x1p-1022) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "add" (f64.const 0x1p-1022) (f64.const nan)))
+(assert_return (invoke "add" (f64.const -0x1p-1) (f64.const -0x0p+0)) (f64.const -0x1p-1))
+(assert_return (invoke "add" (f64.const -0x1p-1) (f64.const 0x0p+0)) (f64.const -0x1p-1))
+(assert_return (invoke "add" (f64.const -0x1p-1) (f64.const -0x1p-1074)) (f64.const -0x1p-1))
+(assert_return (invoke "add" (f64.const -0x1p-1) (f64.const 0x1p-1074)) (f64.const -0x1p-1))
+(assert_return (invoke "add" (f64.const -0x1p-1) (f64.const -0x1p-1022)) (f64.const -0x1p-1))
+(assert_return (invoke "add" (f64.const -0x1p-1) (f64.const 0x1p-1022)) (f64.const -0x1p-1))
+(assert_return (invoke "add" (f64.const -0x1p-1) (f64.const -0x1p-1)) (f64.const -0x1p+0))
+(assert_return (invoke "add" (f64.const -0x1p-1) (f64.const 0x1p-1)) (f64.const 0x0p+0))
+(assert_return (invoke "add" (f64.const -0x1p-1) (f64.const -0x1p+0)) (f64.const -0x1.8p+0))
+(assert_return (invoke "add" (f64.const -0x1p-1) (f64.const 0x1p+0)) (f64.const 0x1p-1))
+(assert_return (invoke "add" (f64.const -0x1p-1) (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x1.b21fb54442d18p+2))
+(assert_return (invoke "add" (f64.const -0x1p-1) (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x1.721fb54442d18p+2))
+(assert_return (invoke "add" (f64.const -0x1p-1) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "add" (f64.const -0x1p-1) (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "add" (f64.const -0x1p-1) (f64.const -inf)) (f64.const -inf))
+(assert_return (invoke "add" (f64.const -0x1p-1) (f64.const inf)) (f64.const inf))
+(assert_return_canonical_nan (invoke "add" (f64.const -0x1p-1) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "add" (f64.const -0x1p-1) (f64.const nan)))
+(assert_return (invoke "add" (f64.const 0x1p-1) (f64.const -0x0p+0)) (f64.const 0x1p-1))
+(assert_return (invoke "add" (f64.const 0x1p-1) (f64.const 0x0p+0)) (f64.const 0x1p-1))
+(assert_return (invoke "add" (f64.const 0x1p-1) (f64.const -0x1p-1074)) (f64.const 0x1p-1))
+(assert_return (invoke "add" (f64.const 0x1p-1) (f64.const 0x1p-1074)) (f64.const 0x1p-1))
+(assert_return (invoke "add" (f64.const 0x1p-1) (f64.const -0x1p-1022)) (f64.const 0x1p-1))
+(assert_return (invoke "add" (f64.const 0x1p-1) (f64.const 0x1p-1022)) (f64.const 0x1p-1))
+(assert_return (invoke "add" (f64.const 0x1p-1) (f64.const -0x1p-1)) (f64.const 0x0p+0))
+(assert_return (invoke "add" (f64.const 0x1p-1) (f64.const 0x1p-1)) (f64.const 0x1p+0))
+(assert_return (invoke "add" (f64.const 0x1p-1) (f64.const -0x1p+0)) (f64.const -0x1p-1))
+(assert_return (invoke "add" (f64.const 0x1p-1) (f64.const 0x1p+0)) (f64.const 0x1.8p+0))
+(assert_return (invoke "add" (f64.const 0x1p-1) (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x1.721fb54442d18p+2))
+(assert_return (invoke "add" (f64.const 0x1p-1) (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x1.b21fb54442d18p+2))
+(assert_return (invoke "add" (f64.const 0x1p-1) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "add" (f64.const 0x1p-1) (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "add" (f64.const 0x1p-1) (f64.const -inf)) (f64.const -inf))
+(assert_return (invoke "add" (f64.const 0x1p-1) (f64.const inf)) (f64.const inf))
+(assert_return_canonical_nan (invoke "add" (f64.const 0x1p-1) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "add" (f64.const 0x1p-1) (f64.const nan)))
+(assert_return (invoke "add" (f64.const -0x1p+0) (f64.const -0x0p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "add" (f64.const -0x1p+0) (f64.const 0x0p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "add" (f64.const -0x1p+0) (f64.const -0x1p-1074)) (f64.const -0x1p+0))
+(assert_return (invoke "add" (f64.const -0x1p+0) (f64.const 0x1p-1074)) (f64.const -0x1p+0))
+(assert_return (invoke "add" (f64.const -0x1p+0) (f64.const -0x1p-1022)) (f64.const -0x1p+0))
+(assert_return (invoke "add" (f64.const -0x1p+0) (f64.const 0x1p-1022)) (f64.const -0x1p+0))
+(assert_return (invoke "add" (f64.const -0x1p+0) (f64.const -0x1p-1)) (f64.const -0x1.8p+0))
+(assert_return (invoke "add" (f64.const -0x1p+0) (f64.const 0x1p-1)) (f64.const -0x1p-1))
+(assert_return (invoke "add" (f64.const -0x1p+0) (f64.const -0x1p+0)) (f64.const -0x1p+1))
+(assert_return (invoke "add" (f64.const -0x1p+0) (f64.const 0x1p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "add" (f64.const -0x1p+0) (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x1.d21fb54442d18p+2))
+(assert_return (invoke "add" (f64.const -0x1p+0) (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x1.521fb54442d18p+2))
+(assert_return (invoke "add" (f64.const -0x1p+0) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "add" (f64.const -0x1p+0) (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "add" (f64.const -0x1p+0) (f64.const -inf)) (f64.const -inf))
+(assert_return (invoke "add" (f64.const -0x1p+0) (f64.const inf)) (f64.const inf))
+(assert_return_canonical_nan (invoke "add" (f64.const -0x1p+0) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "add" (f64.const -0x1p+0) (f64.const nan)))
+(assert_return (invoke "add" (f64.const 0x1p+0) (f64.const -0x0p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "add" (f64.const 0x1p+0) (f64.const 0x0p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "add" (f64.const 0x1p+0) (f64.const -0x1p-1074)) (f64.const 0x1p+0))
+(assert_return (invoke "add" (f64.const 0x1p+0) (f64.const 0x1p-1074)) (f64.const 0x1p+0))
+(assert_return (invoke "add" (f64.const 0x1p+0) (f64.const -0x1p-1022)) (f64.const 0x1p+0))
+(assert_return (invoke "add" (f64.const 0x1p+0) (f64.const 0x1p-1022)) (f64.const 0x1p+0))
+(assert_return (invoke "add" (f64.const 0x1p+0) (f64.const -0x1p-1)) (f64.const 0x1p-1))
+(assert_return (invoke "add" (f64.const 0x1p+0) (f64.const 0x1p-1)) (f64.const 0x1.8p+0))
+(assert_return (invoke "add" (f64.const 0x1p+0) (f64.const -0x1p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "add" (f64.const 0x1p+0) (f64.const 0x1p+0)) (f64.const 0x1p+1))
+(assert_return (invoke "add" (f64.const 0x1p+0) (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x1.521fb54442d18p+2))
+(assert_return (invoke "add" (f64.const 0x1p+0) (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x1.d21fb54442d18p+2))
+(assert_return (invoke "add" (f64.const 0x1p+0) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "add" (f64.const 0x1p+0) (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "add" (f64.const 0x1p+0) (f64.const -inf)) (f64.const -inf))
+(assert_return (invoke "add" (f64.const 0x1p+0) (f64.const inf)) (f64.const inf))
+(assert_return_canonical_nan (invoke "add" (f64.const 0x1p+0) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "add" (f64.const 0x1p+0) (f64.const nan)))
+(assert_return (invoke "add" (f64.const -0x1.921fb54442d18p+2) (f64.const -0x0p+0)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "add" (f64.const -0x1.921fb54442d18p+2) (f64.const 0x0p+0)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "add" (f64.const -0x1.921fb54442d18p+2) (f64.const -0x1p-1074)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "add" (f64.const -0x1.921fb54442d18p+2) (f64.const 0x1p-1074)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "add" (f64.const -0x1.921fb54442d18p+2) (f64.const -0x1p-1022)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "add" (f64.const -0x1.921fb54442d18p+2) (f64.const 0x1p-1022)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "add" (f64.const -0x1.921fb54442d18p+2) (f64.const -0x1p-1)) (f64.const -0x1.b21fb54442d18p+2))
+(assert_return (invoke "add" (f64.const -0x1.921fb54442d18p+2) (f64.const 0x1p-1)) (f64.const -0x1.721fb54442d18p+2))
+(assert_return (invoke "add" (f64.const -0x1.921fb54442d18p+2) (f64.const -0x1p+0)) (f64.const -0x1.d21fb54442d18p+2))
+(assert_return (invoke "add" (f64.const -0x1.921fb54442d18p+2) (f64.const 0x1p+0)) (f64.const -0x1.521fb54442d18p+2))
+(assert_return (invoke "add" (f64.const -0x1.921fb54442d18p+2) (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x1.921fb54442d18p+3))
+(assert_return (invoke "add" (f64.const -0x1.921fb54442d18p+2) (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x0p+0))
+(assert_return (invoke "add" (f64.const -0x1.921fb54442d18p+2) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "add" (f64.const -0x1.921fb54442d18p+2) (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "add" (f64.const -0x1.921fb54442d18p+2) (f64.const -inf)) (f64.const -inf))
+(assert_return (invoke "add" (f64.const -0x1.921fb54442d18p+2) (f64.const inf)) (f64.const inf))
+(assert_return_canonical_nan (invoke "add" (f64.const -0x1.921fb54442d18p+2) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "add" (f64.const -0x1.921fb54442d18p+2) (f64.const nan)))
+(assert_return (invoke "add" (f64.const 0x1.921fb54442d18p+2) (f64.const -0x0p+0)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "add" (f64.const 0x1.921fb54442d18p+2) (f64.const 0x0p+0)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "add" (f64.const 0x1.921fb54442d18p+2) (f64.const -0x1p-1074)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "add" (f64.const 0x1.921fb54442d18p+2) (f64.const 0x1p-1074)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "add" (f64.const 0x1.921fb54442d18p+2) (f64.const -0x1p-1022)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "add" (f64.const 0x1.921fb54442d18p+2) (f64.const 0x1p-1022)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "add" (f64.const 0x1.921fb54442d18p+2) (f64.const -0x1p-1)) (f64.const 0x1.721fb54442d18p+2))
+(assert_return (invoke "add" (f64.const 0x1.921fb54442d18p+2) (f64.const 0x1p-1)) (f64.const 0x1.b21fb54442d18p+2))
+(assert_return (invoke "add" (f64.const 0x1.921fb54442d18p+2) (f64.const -0x1p+0)) (f64.const 0x1.521fb54442d18p+2))
+(assert_return (invoke "add" (f64.const 0x1.921fb54442d18p+2) (f64.const 0x1p+0)) (f64.const 0x1.d21fb54442d18p+2))
+(assert_return (invoke "add" (f64.const 0x1.921fb54442d18p+2) (f64.const -0x1.921fb54442d18p+2)) (f64.const 0x0p+0))
+(assert_return (invoke "add" (f64.const 0x1.921fb54442d18p+2) (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x1.921fb54442d18p+3))
+(assert_return (invoke "add" (f64.const 0x1.921fb54442d18p+2) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "add" (f64.const 0x1.921fb54442d18p+2) (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "add" (f64.const 0x1.921fb54442d18p+2) (f64.const -inf)) (f64.const -inf))
+(assert_return (invoke "add" (f64.const 0x1.921fb54442d18p+2) (f64.const inf)) (f64.const inf))
+(assert_return_canonical_nan (invoke "add" (f64.const 0x1.921fb54442d18p+2) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "add" (f64.const 0x1.921fb54442d18p+2) (f64.const nan)))
+(assert_return (invoke "add" (f64.const -0x1.fffffffffffffp+1023) (f64.const -0x0p+0)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "add" (f64.const -0x1.fffffffffffffp+1023) (f64.const 0x0p+0)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "add" (f64.const -0x1.fffffffffffffp+1023) (f64.const -0x1p-1074)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "add" (f64.const -0x1.fffffffffffffp+1023) (f64.const 0x1p-1074)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "add" (f64.const -0x1.fffffffffffffp+1023) (f64.const -0x1p-1022)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "add" (f64.const -0x1.fffffffffffffp+1023) (f64.const 0x1p-1022)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "add" (f64.const -0x1.fffffffffffffp+1023) (f64.const -0x1p-1)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "add" (f64.const -0x1.fffffffffffffp+1023) (f64.const 0x1p-1)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "add" (f64.const -0x1.fffffffffffffp+1023) (f64.const -0x1p+0)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "add" (f64.const -0x1.fffffffffffffp+1023) (f64.const 0x1p+0)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "add" (f64.const -0x1.fffffffffffffp+1023) (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "add" (f64.const -0x1.fffffffffffffp+1023) (f64.const 0x1.921fb54442d18p+2)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "add" (f64.const -0x1.fffffffffffffp+1023) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -inf))
+(assert_return (invoke "add" (f64.const -0x1.fffffffffffffp+1023) (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x0p+0))
+(assert_return (invoke "add" (f64.const -0x1.fffffffffffffp+1023) (f64.const -inf)) (f64.const -inf))
+(assert_return (invoke "add" (f64.const -0x1.fffffffffffffp+1023) (f64.const inf)) (f64.const inf))
+(assert_return_canonical_nan (invoke "add" (f64.const -0x1.fffffffffffffp+1023) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "add" (f64.const -0x1.fffffffffffffp+1023) (f64.const nan)))
+(assert_return (invoke "add" (f64.const 0x1.fffffffffffffp+1023) (f64.const -0x0p+0)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "add" (f64.const 0x1.fffffffffffffp+1023) (f64.const 0x0p+0)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "add" (f64.const 0x1.fffffffffffffp+1023) (f64.const -0x1p-1074)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "add" (f64.const 0x1.fffffffffffffp+1023) (f64.const 0x1p-1074)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "add" (f64.const 0x1.fffffffffffffp+1023) (f64.const -0x1p-1022)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "add" (f64.const 0x1.fffffffffffffp+1023) (f64.const 0x1p-1022)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "add" (f64.const 0x1.fffffffffffffp+1023) (f64.const -0x1p-1)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "add" (f64.const 0x1.fffffffffffffp+1023) (f64.const 0x1p-1)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "add" (f64.const 0x1.fffffffffffffp+1023) (f64.const -0x1p+0)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "add" (f64.const 0x1.fffffffffffffp+1023) (f64.const 0x1p+0)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "add" (f64.const 0x1.fffffffffffffp+1023) (f64.const -0x1.921fb54442d18p+2)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "add" (f64.const 0x1.fffffffffffffp+1023) (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "add" (f64.const 0x1.fffffffffffffp+1023) (f64.const -0x1.fffffffffffffp+1023)) (f64.const 0x0p+0))
+(assert_return (invoke "add" (f64.const 0x1.fffffffffffffp+1023) (f64.const 0x1.fffffffffffffp+1023)) (f64.const inf))
+(assert_return (invoke "add" (f64.const 0x1.fffffffffffffp+1023) (f64.const -inf)) (f64.const -inf))
+(assert_return (invoke "add" (f64.const 0x1.fffffffffffffp+1023) (f64.const inf)) (f64.const inf))
+(assert_return_canonical_nan (invoke "add" (f64.const 0x1.fffffffffffffp+1023) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "add" (f64.const 0x1.fffffffffffffp+1023) (f64.const nan)))
+(assert_return (invoke "add" (f64.const -inf) (f64.const -0x0p+0)) (f64.const -inf))
+(assert_return (invoke "add" (f64.const -inf) (f64.const 0x0p+0)) (f64.const -inf))
+(assert_return (invoke "add" (f64.const -inf) (f64.const -0x1p-1074)) (f64.const -inf))
+(assert_return (invoke "add" (f64.const -inf) (f64.const 0x1p-1074)) (f64.const -inf))
+(assert_return (invoke "add" (f64.const -inf) (f64.const -0x1p-1022)) (f64.const -inf))
+(assert_return (invoke "add" (f64.const -inf) (f64.const 0x1p-1022)) (f64.const -inf))
+(assert_return (invoke "add" (f64.const -inf) (f64.const -0x1p-1)) (f64.const -inf))
+(assert_return (invoke "add" (f64.const -inf) (f64.const 0x1p-1)) (f64.const -inf))
+(assert_return (invoke "add" (f64.const -inf) (f64.const -0x1p+0)) (f64.const -inf))
+(assert_return (invoke "add" (f64.const -inf) (f64.const 0x1p+0)) (f64.const -inf))
+(assert_return (invoke "add" (f64.const -inf) (f64.const -0x1.921fb54442d18p+2)) (f64.const -inf))
+(assert_return (invoke "add" (f64.const -inf) (f64.const 0x1.921fb54442d18p+2)) (f64.const -inf))
+(assert_return (invoke "add" (f64.const -inf) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -inf))
+(assert_return (invoke "add" (f64.const -inf) (f64.const 0x1.fffffffffffffp+1023)) (f64.const -inf))
+(assert_return (invoke "add" (f64.const -inf) (f64.const -inf)) (f64.const -inf))
+(assert_return_canonical_nan (invoke "add" (f64.const -inf) (f64.const inf)))
+(assert_return_canonical_nan (invoke "add" (f64.const -inf) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "add" (f64.const -inf) (f64.const nan)))
+(assert_return (invoke "add" (f64.const inf) (f64.const -0x0p+0)) (f64.const inf))
+(assert_return (invoke "add" (f64.const inf) (f64.const 0x0p+0)) (f64.const inf))
+(assert_return (invoke "add" (f64.const inf) (f64.const -0x1p-1074)) (f64.const inf))
+(assert_return (invoke "add" (f64.const inf) (f64.const 0x1p-1074)) (f64.const inf))
+(assert_return (invoke "add" (f64.const inf) (f64.const -0x1p-1022)) (f64.const inf))
+(assert_return (invoke "add" (f64.const inf) (f64.const 0x1p-1022)) (f64.const inf))
+(assert_return (invoke "add" (f64.const inf) (f64.const -0x1p-1)) (f64.const inf))
+(assert_return (invoke "add" (f64.const inf) (f64.const 0x1p-1)) (f64.const inf))
+(assert_return (invoke "add" (f64.const inf) (f64.const -0x1p+0)) (f64.const inf))
+(assert_return (invoke "add" (f64.const inf) (f64.const 0x1p+0)) (f64.const inf))
+(assert_return (invoke "add" (f64.const inf) (f64.const -0x1.921fb54442d18p+2)) (f64.const inf))
+(assert_return (invoke "add" (f64.const inf) (f64.const 0x1.921fb54442d18p+2)) (f64.const inf))
+(assert_return (invoke "add" (f64.const inf) (f64.const -0x1.fffffffffffffp+1023)) (f64.const inf))
+(assert_return (invoke "add" (f64.const inf) (f64.const 0x1.fffffffffffffp+1023)) (f64.const inf))
+(assert_return_canonical_nan (invoke "add" (f64.const inf) (f64.const -inf)))
+(assert_return (invoke "add" (f64.const inf) (f64.const inf)) (f64.const inf))
+(assert_return_canonical_nan (invoke "add" (f64.const inf) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "add" (f64.const inf) (f64.const nan)))
+(assert_return_canonical_nan (invoke "add" (f64.const -nan) (f64.const -0x0p+0)))
+(assert_return_canonical_nan (invoke "add" (f64.const -nan) (f64.const 0x0p+0)))
+(assert_return_canonical_nan (invoke "add" (f64.const -nan) (f64.const -0x1p-1074)))
+(assert_return_canonical_nan (invoke "add" (f64.const -nan) (f64.const 0x1p-1074)))
+(assert_return_canonical_nan (invoke "add" (f64.const -nan) (f64.const -0x1p-1022)))
+(assert_return_canonical_nan (invoke "add" (f64.const -nan) (f64.const 0x1p-1022)))
+(assert_return_canonical_nan (invoke "add" (f64.const -nan) (f64.const -0x1p-1)))
+(assert_return_canonical_nan (invoke "add" (f64.const -nan) (f64.const 0x1p-1)))
+(assert_return_canonical_nan (invoke "add" (f64.const -nan) (f64.const -0x1p+0)))
+(assert_return_canonical_nan (invoke "add" (f64.const -nan) (f64.const 0x1p+0)))
+(assert_return_canonical_nan (invoke "add" (f64.const -nan) (f64.const -0x1.921fb54442d18p+2)))
+(assert_return_canonical_nan (invoke "add" (f64.const -nan) (f64.const 0x1.921fb54442d18p+2)))
+(assert_return_canonical_nan (invoke "add" (f64.const -nan) (f64.const -0x1.fffffffffffffp+1023)))
+(assert_return_canonical_nan (invoke "add" (f64.const -nan) (f64.const 0x1.fffffffffffffp+1023)))
+(assert_return_canonical_nan (invoke "add" (f64.const -nan) (f64.const -inf)))
+(assert_return_canonical_nan (invoke "add" (f64.const -nan) (f64.const inf)))
+(assert_return_canonical_nan (invoke "add" (f64.const -nan) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "add" (f64.const -nan) (f64.const nan)))
+(assert_return_canonical_nan (invoke "add" (f64.const nan) (f64.const -0x0p+0)))
+(assert_return_canonical_nan (invoke "add" (f64.const nan) (f64.const 0x0p+0)))
+(assert_return_canonical_nan (invoke "add" (f64.const nan) (f64.const -0x1p-1074)))
+(assert_return_canonical_nan (invoke "add" (f64.const nan) (f64.const 0x1p-1074)))
+(assert_return_canonical_nan (invoke "add" (f64.const nan) (f64.const -0x1p-1022)))
+(assert_return_canonical_nan (invoke "add" (f64.const nan) (f64.const 0x1p-1022)))
+(assert_return_canonical_nan (invoke "add" (f64.const nan) (f64.const -0x1p-1)))
+(assert_return_canonical_nan (invoke "add" (f64.const nan) (f64.const 0x1p-1)))
+(assert_return_canonical_nan (invoke "add" (f64.const nan) (f64.const -0x1p+0)))
+(assert_return_canonical_nan (invoke "add" (f64.const nan) (f64.const 0x1p+0)))
+(assert_return_canonical_nan (invoke "add" (f64.const nan) (f64.const -0x1.921fb54442d18p+2)))
+(assert_return_canonical_nan (invoke "add" (f64.const nan) (f64.const 0x1.921fb54442d18p+2)))
+(assert_return_canonical_nan (invoke "add" (f64.const nan) (f64.const -0x1.fffffffffffffp+1023)))
+(assert_return_canonical_nan (invoke "add" (f64.const nan) (f64.const 0x1.fffffffffffffp+1023)))
+(assert_return_canonical_nan (invoke "add" (f64.const nan) (f64.const -inf)))
+(assert_return_canonical_nan (invoke "add" (f64.const nan) (f64.const inf)))
+(assert_return_canonical_nan (invoke "add" (f64.const nan) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "add" (f64.const nan) (f64.const nan)))
+(assert_return_arithmetic_nan (invoke "add" (f64.const nan:0xc000000000000) (f64.const 0x1p+0)))
+(assert_return_arithmetic_nan (invoke "add" (f64.const 0x1p+0) (f64.const nan:0xc000000000000)))
+(assert_return_arithmetic_nan (invoke "add" (f64.const -nan:0xc000000000000) (f64.const 0x1p+0)))
+(assert_return_arithmetic_nan (invoke "add" (f64.const 0x1p+0) (f64.const -nan:0xc000000000000)))
+(assert_return (invoke "add" (f64.const -0x1.f65dd918320c7p-902) (f64.const -0x1.bab754cdacd92p+11)) (f64.const -0x1.bab754cdacd92p+11))
+(assert_return (invoke "add" (f64.const -0x1.46c2d3ac7c88fp-745) (f64.const 0x1.9224dc7000ebcp+623)) (f64.const 0x1.9224dc7000ebcp+623))
+(assert_return (invoke "add" (f64.const 0x1.c1c169f551b8ap-23) (f64.const -0x1.4bb09b70143bap+11)) (f64.const -0x1.4bb09b6fa3cb4p+11))
+(assert_return (invoke "add" (f64.const 0x1.8907add61852fp-656) (f64.const 0x1.3a206a527e34cp+5)) (f64.const 0x1.3a206a527e34cp+5))
+(assert_return (invoke "add" (f64.const 0x1.8204ada32ea35p-12) (f64.const -0x1.cda8629163265p+9)) (f64.const -0x1.cda856813db93p+9))
+(assert_return (invoke "add" (f64.const -0x1.a53d213927dedp-71) (f64.const 0x1.318fa88a80567p+25)) (f64.const 0x1.318fa88a80567p+25))
+(assert_return (invoke "add" (f64.const -0x1.724e45a691f4fp+47) (f64.const 0x1.4bad845b3a08bp-29)) (f64.const -0x1.724e45a691f4fp+47))
+(assert_return (invoke "add" (f64.const 0x1.4804451d84e2cp-28) (f64.const -0x1.c7d4cedad5053p-29)) (f64.const 0x1.906776c06980ap-30))
+(assert_return (invoke "add" (f64.const 0x1.bd411129634b3p+721) (f64.const 0x1.dbf0556e8c55fp-11)) (f64.const 0x1.bd411129634b3p+721))
+(assert_return (invoke "add" (f64.const -0x1.2b5b8754d12ffp-123) (f64.const -0x1.f7375c8a9d61fp-965)) (f64.const -0x1.2b5b8754d12ffp-123))
+(assert_return (invoke "add" (f64.const -0x1.6677d1e789b9fp+18) (f64.const 0x1.bfbad9d0d0a44p-77)) (f64.const -0x1.6677d1e789b9fp+18))
+(assert_return (invoke "add" (f64.const 0x1.4e5aaa2e4e45ep+3) (f64.const -0x1.f0700991947e3p+458)) (f64.const -0x1.f0700991947e3p+458))
+(assert_return (invoke "add" (f64.const -0x1.998f00bb6710dp+956) (f64.const -0x1.656ba3efe4da4p+3)) (f64.const -0x1.998f00bb6710dp+956))
+(assert_return (invoke "add" (f64.const -0x1.66b5465ab11eap+811) (f64.const 0x1.0910795a49431p+143)) (f64.const -0x1.66b5465ab11eap+811))
+(assert_return (invoke "add" (f64.const -0x1.ae90486d049e7p-23) (f64.const 0x1.47dae7736e617p-618)) (f64.const -0x1.ae90486d049e7p-23))
+(assert_return (invoke "add" (f64.const 0x1.89d19c3a95a4dp+167) (f64.const 0x1.f76d3d06bc53dp-14)) (f64.const 0x1.89d19c3a95a4dp+167))
+(assert_return (invoke "add" (f64.const 0x1.20c2c863eed81p+505) (f64.const -0x1.daf56f554e702p+938)) (f64.const -0x1.daf56f554e702p+938))
+(assert_return (invoke "add" (f64.const -0x1.87cdbd386d949p+21) (f64.const -0x1.b80e51a4d765bp-22)) (f64.const -0x1.87cdbd386dcb9p+21))
+(assert_return (invoke "add" (f64.const 0x1.785687e471818p+0) (f64.const 0x1.3fe837101dc37p-8)) (f64.const 0x1.7996701b819f4p+0))
+(assert_return (invoke "add" (f64.const -0x1.7e37505c25025p+1) (f64.const 0x1.95dcca3d91727p-30)) (f64.const -0x1.7e375058f948cp+1))
+(assert_return (invoke "add" (f64.const 0x1.f5189305d630fp-564) (f64.const -0x1.40d43509fa2a4p-399)) (f64.const -0x1.40d43509fa2a4p-399))
+(assert_return (invoke "add" (f64.const -0x1.ad67662a18e8p+1000) (f64.const 0x1.9936a4a5ba3c8p-764)) (f64.const -0x1.ad67662a18e8p+1000))
+(assert_return (invoke "add" (f64.const -0x1.a8dbfce7188c2p+6) (f64.const 0x1.a096dee48b956p-22)) (f64.const -0x1.a8dbfccd0f1e3p+6))
+(assert_return (invoke "add" (f64.const -0x1.49745ecdd8e4ap-12) (f64.const -0x1.42c02294bf2bcp+329)) (f64.const -0x1.42c02294bf2bcp+329))
+
+(assert_return (invoke "sub" (f64.const -0x0p+0) (f64.const -0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "sub" (f64.const -0x0p+0) (f64.const 0x0p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "sub" (f64.const -0x0p+0) (f64.const -0x1p-1074)) (f64.const 0x1p-1074))
+(assert_return (invoke "sub" (f64.const -0x0p+0) (f64.const 0x1p-1074)) (f64.const -0x1p-1074))
+(assert_return (invoke "sub" (f64.const -0x0p+0) (f64.const -0x1p-1022)) (f64.const 0x1p-1022))
+(assert_return (invoke "sub" (f64.const -0x0p+0) (f64.const 0x1p-1022)) (f64.const -0x1p-1022))
+(assert_return (invoke "sub" (f64.const -0x0p+0) (f64.const -0x1p-1)) (f64.const 0x1p-1))
+(assert_return (invoke "sub" (f64.const -0x0p+0) (f64.const 0x1p-1)) (f64.const -0x1p-1))
+(assert_return (invoke "sub" (f64.const -0x0p+0) (f64.const -0x1p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "sub" (f64.const -0x0p+0) (f64.const 0x1p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "sub" (f64.const -0x0p+0) (f64.const -0x1.921fb54442d18p+2)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "sub" (f64.const -0x0p+0) (f64.const 0x1.921fb54442d18p+2)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "sub" (f64.const -0x0p+0) (f64.const -0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "sub" (f64.const -0x0p+0) (f64.const 0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "sub" (f64.const -0x0p+0) (f64.const -inf)) (f64.const inf))
+(assert_return (invoke "sub" (f64.const -0x0p+0) (f64.const inf)) (f64.const -inf))
+(assert_return_canonical_nan (invoke "sub" (f64.const -0x0p+0) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "sub" (f64.const -0x0p+0) (f64.const nan)))
+(assert_return (invoke "sub" (f64.const 0x0p+0) (f64.const -0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "sub" (f64.const 0x0p+0) (f64.const 0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "sub" (f64.const 0x0p+0) (f64.const -0x1p-1074)) (f64.const 0x1p-1074))
+(assert_return (invoke "sub" (f64.const 0x0p+0) (f64.const 0x1p-1074)) (f64.const -0x1p-1074))
+(assert_return (invoke "sub" (f64.const 0x0p+0) (f64.const -0x1p-1022)) (f64.const 0x1p-1022))
+(assert_return (invoke "sub" (f64.const 0x0p+0) (f64.const 0x1p-1022)) (f64.const -0x1p-1022))
+(assert_return (invoke "sub" (f64.const 0x0p+0) (f64.const -0x1p-1)) (f64.const 0x1p-1))
+(assert_return (invoke "sub" (f64.const 0x0p+0) (f64.const 0x1p-1)) (f64.const -0x1p-1))
+(assert_return (invoke "sub" (f64.const 0x0p+0) (f64.const -0x1p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "sub" (f64.const 0x0p+0) (f64.const 0x1p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "sub" (f64.const 0x0p+0) (f64.const -0x1.921fb54442d18p+2)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "sub" (f64.const 0x0p+0) (f64.const 0x1.921fb54442d18p+2)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "sub" (f64.const 0x0p+0) (f64.const -0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "sub" (f64.const 0x0p+0) (f64.const 0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "sub" (f64.const 0x0p+0) (f64.const -inf)) (f64.const inf))
+(assert_return (invoke "sub" (f64.const 0x0p+0) (f64.const inf)) (f64.const -inf))
+(assert_return_canonical_nan (invoke "sub" (f64.const 0x0p+0) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "sub" (f64.const 0x0p+0) (f64.const nan)))
+(assert_return (invoke "sub" (f64.const -0x1p-1074) (f64.const -0x0p+0)) (f64.const -0x1p-1074))
+(assert_return (invoke "sub" (f64.const -0x1p-1074) (f64.const 0x0p+0)) (f64.const -0x1p-1074))
+(assert_return (invoke "sub" (f64.const -0x1p-1074) (f64.const -0x1p-1074)) (f64.const 0x0p+0))
+(assert_return (invoke "sub" (f64.const -0x1p-1074) (f64.const 0x1p-1074)) (f64.const -0x1p-1073))
+(assert_return (invoke "sub" (f64.const -0x1p-1074) (f64.const -0x1p-1022)) (f64.const 0x1.ffffffffffffep-1023))
+(assert_return (invoke "sub" (f64.const -0x1p-1074) (f64.const 0x1p-1022)) (f64.const -0x1.0000000000001p-1022))
+(assert_return (invoke "sub" (f64.const -0x1p-1074) (f64.const -0x1p-1)) (f64.const 0x1p-1))
+(assert_return (invoke "sub" (f64.const -0x1p-1074) (f64.const 0x1p-1)) (f64.const -0x1p-1))
+(assert_return (invoke "sub" (f64.const -0x1p-1074) (f64.const -0x1p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "sub" (f64.const -0x1p-1074) (f64.const 0x1p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "sub" (f64.const -0x1p-1074) (f64.const -0x1.921fb54442d18p+2)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "sub" (f64.const -0x1p-1074) (f64.const 0x1.921fb54442d18p+2)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "sub" (f64.const -0x1p-1074) (f64.const -0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "sub" (f64.const -0x1p-1074) (f64.const 0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "sub" (f64.const -0x1p-1074) (f64.const -inf)) (f64.const inf))
+(assert_return (invoke "sub" (f64.const -0x1p-1074) (f64.const inf)) (f64.const -inf))
+(assert_return_canonical_nan (invoke "sub" (f64.const -0x1p-1074) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "sub" (f64.const -0x1p-1074) (f64.const nan)))
+(assert_return (invoke "sub" (f64.const 0x1p-1074) (f64.const -0x0p+0)) (f64.const 0x1p-1074))
+(assert_return (invoke "sub" (f64.const 0x1p-1074) (f64.const 0x0p+0)) (f64.const 0x1p-1074))
+(assert_return (invoke "sub" (f64.const 0x1p-1074) (f64.const -0x1p-1074)) (f64.const 0x1p-1073))
+(assert_return (invoke "sub" (f64.const 0x1p-1074) (f64.const 0x1p-1074)) (f64.const 0x0p+0))
+(assert_return (invoke "sub" (f64.const 0x1p-1074) (f64.const -0x1p-1022)) (f64.const 0x1.0000000000001p-1022))
+(assert_return (invoke "sub" (f64.const 0x1p-1074) (f64.const 0x1p-1022)) (f64.const -0x1.ffffffffffffep-1023))
+(assert_return (invoke "sub" (f64.const 0x1p-1074) (f64.const -0x1p-1)) (f64.const 0x1p-1))
+(assert_return (invoke "sub" (f64.const 0x1p-1074) (f64.const 0x1p-1)) (f64.const -0x1p-1))
+(assert_return (invoke "sub" (f64.const 0x1p-1074) (f64.const -0x1p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "sub" (f64.const 0x1p-1074) (f64.const 0x1p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "sub" (f64.const 0x1p-1074) (f64.const -0x1.921fb54442d18p+2)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "sub" (f64.const 0x1p-1074) (f64.const 0x1.921fb54442d18p+2)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "sub" (f64.const 0x1p-1074) (f64.const -0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "sub" (f64.const 0x1p-1074) (f64.const 0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "sub" (f64.const 0x1p-1074) (f64.const -inf)) (f64.const inf))
+(assert_return (invoke "sub" (f64.const 0x1p-1074) (f64.const inf)) (f64.const -inf))
+(assert_return_canonical_nan (invoke "sub" (f64.const 0x1p-1074) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "sub" (f64.const 0x1p-1074) (f64.const nan)))
+(assert_return (invoke "sub" (f64.const -0x1p-1022) (f64.const -0x0p+0)) (f64.const -0x1p-1022))
+(assert_return (invoke "sub" (f64.const -0x1p-1022) (f64.const 0x0p+0)) (f64.const -0x1p-1022))
+(assert_return (invoke "sub" (f64.const -0x1p-1022) (f64.const -0x1p-1074)) (f64.const -0x1.ffffffffffffep-1023))
+(assert_return (invoke "sub" (f64.const -0x1p-1022) (f64.const 0x1p-1074)) (f64.const -0x1.0000000000001p-1022))
+(assert_return (invoke "sub" (f64.const -0x1p-1022) (f64.const -0x1p-1022)) (f64.const 0x0p+0))
+(assert_return (invoke "sub" (f64.const -0x1p-1022) (f64.const 0x1p-1022)) (f64.const -0x1p-1021))
+(assert_return (invoke "sub" (f64.const -0x1p-1022) (f64.const -0x1p-1)) (f64.const 0x1p-1))
+(assert_return (invoke "sub" (f64.const -0x1p-1022) (f64.const 0x1p-1)) (f64.const -0x1p-1))
+(assert_return (invoke "sub" (f64.const -0x1p-1022) (f64.const -0x1p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "sub" (f64.const -0x1p-1022) (f64.const 0x1p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "sub" (f64.const -0x1p-1022) (f64.const -0x1.921fb54442d18p+2)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "sub" (f64.const -0x1p-1022) (f64.const 0x1.921fb54442d18p+2)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "sub" (f64.const -0x1p-1022) (f64.const -0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "sub" (f64.const -0x1p-1022) (f64.const 0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "sub" (f64.const -0x1p-1022) (f64.const -inf)) (f64.const inf))
+(assert_return (invoke "sub" (f64.const -0x1p-1022) (f64.const inf)) (f64.const -inf))
+(assert_return_canonical_nan (invoke "sub" (f64.const -0x1p-1022) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "sub" (f64.const -0x1p-1022) (f64.const nan)))
+(assert_return (invoke "sub" (f64.const 0x1p-1022) (f64.const -0x0p+0)) (f64.const 0x1p-1022))
+(assert_return (invoke "sub" (f64.const 0x1p-1022) (f64.const 0x0p+0)) (f64.const 0x1p-1022))
+(assert_return (invoke "sub" (f64.const 0x1p-1022) (f64.const -0x1p-1074)) (f64.const 0x1.0000000000001p-1022))
+(assert_return (invoke "sub" (f64.const 0x1p-1022) (f64.const 0x1p-1074)) (f64.const 0x1.ffffffffffffep-1023))
+(assert_return (invoke "sub" (f64.const 0x1p-1022) (f64.const -0x1p-1022)) (f64.const 0x1p-1021))
+(assert_return (invoke "sub" (f64.const 0x1p-1022) (f64.const 0x1p-1022)) (f64.const 0x0p+0))
+(assert_return (invoke "sub" (f64.const 0x1p-1022) (f64.const -0x1p-1)) (f64.const 0x1p-1))
+(assert_return (invoke "sub" (f64.const 0x1p-1022) (f64.const 0x1p-1)) (f64.const -0x1p-1))
+(assert_return (invoke "sub" (f64.const 0x1p-1022) (f64.const -0x1p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "sub" (f64.const 0x1p-1022) (f64.const 0x1p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "sub" (f64.const 0x1p-1022) (f64.const -0x1.921fb54442d18p+2)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "sub" (f64.const 0x1p-1022) (f64.const 0x1.921fb54442d18p+2)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "sub" (f64.const 0x1p-1022) (f64.const -0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "sub" (f64.const 0x1p-1022) (f64.const 0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "sub" (f64.const 0x1p-1022) (f64.const -inf)) (f64.const inf))
+(assert_return (invoke "sub" (f64.const 0x1p-1022) (f64.const inf)) (f64.const -inf))
+(assert_return_canonical_nan (invoke "sub" (f64.const 0x1p-1022) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "sub" (f64.const 0x1p-1022) (f64.const nan)))
+(assert_return (invoke "sub" (f64.const -0x1p-1) (f64.const -0x0p+0)) (f64.const -0x1p-1))
+(assert_return (invoke "sub" (f64.const -0x1p-1) (f64.const 0x0p+0)) (f64.const -0x1p-1))
+(assert_return (invoke "sub" (f64.const -0x1p-1) (f64.const -0x1p-1074)) (f64.const -0x1p-1))
+(assert_return (invoke "sub" (f64.const -0x1p-1) (f64.const 0x1p-1074)) (f64.const -0x1p-1))
+(assert_return (invoke "sub" (f64.const -0x1p-1) (f64.const -0x1p-1022)) (f64.const -0x1p-1))
+(assert_return (invoke "sub" (f64.const -0x1p-1) (f64.const 0x1p-1022)) (f64.const -0x1p-1))
+(assert_return (invoke "sub" (f64.const -0x1p-1) (f64.const -0x1p-1)) (f64.const 0x0p+0))
+(assert_return (invoke "sub" (f64.const -0x1p-1) (f64.const 0x1p-1)) (f64.const -0x1p+0))
+(assert_return (invoke "sub" (f64.const -0x1p-1) (f64.const -0x1p+0)) (f64.const 0x1p-1))
+(assert_return (invoke "sub" (f64.const -0x1p-1) (f64.const 0x1p+0)) (f64.const -0x1.8p+0))
+(assert_return (invoke "sub" (f64.const -0x1p-1) (f64.const -0x1.921fb54442d18p+2)) (f64.const 0x1.721fb54442d18p+2))
+(assert_return (invoke "sub" (f64.const -0x1p-1) (f64.const 0x1.921fb54442d18p+2)) (f64.const -0x1.b21fb54442d18p+2))
+(assert_return (invoke "sub" (f64.const -0x1p-1) (f64.const -0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "sub" (f64.const -0x1p-1) (f64.const 0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "sub" (f64.const -0x1p-1) (f64.const -inf)) (f64.const inf))
+(assert_return (invoke "sub" (f64.const -0x1p-1) (f64.const inf)) (f64.const -inf))
+(assert_return_canonical_nan (invoke "sub" (f64.const -0x1p-1) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "sub" (f64.const -0x1p-1) (f64.const nan)))
+(assert_return (invoke "sub" (f64.const 0x1p-1) (f64.const -0x0p+0)) (f64.const 0x1p-1))
+(assert_return (invoke "sub" (f64.const 0x1p-1) (f64.const 0x0p+0)) (f64.const 0x1p-1))
+(assert_return (invoke "sub" (f64.const 0x1p-1) (f64.const -0x1p-1074)) (f64.const 0x1p-1))
+(assert_return (invoke "sub" (f64.const 0x1p-1) (f64.const 0x1p-1074)) (f64.const 0x1p-1))
+(assert_return (invoke "sub" (f64.const 0x1p-1) (f64.const -0x1p-1022)) (f64.const 0x1p-1))
+(assert_return (invoke "sub" (f64.const 0x1p-1) (f64.const 0x1p-1022)) (f64.const 0x1p-1))
+(assert_return (invoke "sub" (f64.const 0x1p-1) (f64.const -0x1p-1)) (f64.const 0x1p+0))
+(assert_return (invoke "sub" (f64.const 0x1p-1) (f64.const 0x1p-1)) (f64.const 0x0p+0))
+(assert_return (invoke "sub" (f64.const 0x1p-1) (f64.const -0x1p+0)) (f64.const 0x1.8p+0))
+(assert_return (invoke "sub" (f64.const 0x1p-1) (f64.const 0x1p+0)) (f64.const -0x1p-1))
+(assert_return (invoke "sub" (f64.const 0x1p-1) (f64.const -0x1.921fb54442d18p+2)) (f64.const 0x1.b21fb54442d18p+2))
+(assert_return (invoke "sub" (f64.const 0x1p-1) (f64.const 0x1.921fb54442d18p+2)) (f64.const -0x1.721fb54442d18p+2))
+(assert_return (invoke "sub" (f64.const 0x1p-1) (f64.const -0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "sub" (f64.const 0x1p-1) (f64.const 0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "sub" (f64.const 0x1p-1) (f64.const -inf)) (f64.const inf))
+(assert_return (invoke "sub" (f64.const 0x1p-1) (f64.const inf)) (f64.const -inf))
+(assert_return_canonical_nan (invoke "sub" (f64.const 0x1p-1) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "sub" (f64.const 0x1p-1) (f64.const nan)))
+(assert_return (invoke "sub" (f64.const -0x1p+0) (f64.const -0x0p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "sub" (f64.const -0x1p+0) (f64.const 0x0p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "sub" (f64.const -0x1p+0) (f64.const -0x1p-1074)) (f64.const -0x1p+0))
+(assert_return (invoke "sub" (f64.const -0x1p+0) (f64.const 0x1p-1074)) (f64.const -0x1p+0))
+(assert_return (invoke "sub" (f64.const -0x1p+0) (f64.const -0x1p-1022)) (f64.const -0x1p+0))
+(assert_return (invoke "sub" (f64.const -0x1p+0) (f64.const 0x1p-1022)) (f64.const -0x1p+0))
+(assert_return (invoke "sub" (f64.const -0x1p+0) (f64.const -0x1p-1)) (f64.const -0x1p-1))
+(assert_return (invoke "sub" (f64.const -0x1p+0) (f64.const 0x1p-1)) (f64.const -0x1.8p+0))
+(assert_return (invoke "sub" (f64.const -0x1p+0) (f64.const -0x1p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "sub" (f64.const -0x1p+0) (f64.const 0x1p+0)) (f64.const -0x1p+1))
+(assert_return (invoke "sub" (f64.const -0x1p+0) (f64.const -0x1.921fb54442d18p+2)) (f64.const 0x1.521fb54442d18p+2))
+(assert_return (invoke "sub" (f64.const -0x1p+0) (f64.const 0x1.921fb54442d18p+2)) (f64.const -0x1.d21fb54442d18p+2))
+(assert_return (invoke "sub" (f64.const -0x1p+0) (f64.const -0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "sub" (f64.const -0x1p+0) (f64.const 0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "sub" (f64.const -0x1p+0) (f64.const -inf)) (f64.const inf))
+(assert_return (invoke "sub" (f64.const -0x1p+0) (f64.const inf)) (f64.const -inf))
+(assert_return_canonical_nan (invoke "sub" (f64.const -0x1p+0) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "sub" (f64.const -0x1p+0) (f64.const nan)))
+(assert_return (invoke "sub" (f64.const 0x1p+0) (f64.const -0x0p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "sub" (f64.const 0x1p+0) (f64.const 0x0p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "sub" (f64.const 0x1p+0) (f64.const -0x1p-1074)) (f64.const 0x1p+0))
+(assert_return (invoke "sub" (f64.const 0x1p+0) (f64.const 0x1p-1074)) (f64.const 0x1p+0))
+(assert_return (invoke "sub" (f64.const 0x1p+0) (f64.const -0x1p-1022)) (f64.const 0x1p+0))
+(assert_return (invoke "sub" (f64.const 0x1p+0) (f64.const 0x1p-1022)) (f64.const 0x1p+0))
+(assert_return (invoke "sub" (f64.const 0x1p+0) (f64.const -0x1p-1)) (f64.const 0x1.8p+0))
+(assert_return (invoke "sub" (f64.const 0x1p+0) (f64.const 0x1p-1)) (f64.const 0x1p-1))
+(assert_return (invoke "sub" (f64.const 0x1p+0) (f64.const -0x1p+0)) (f64.const 0x1p+1))
+(assert_return (invoke "sub" (f64.const 0x1p+0) (f64.const 0x1p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "sub" (f64.const 0x1p+0) (f64.const -0x1.921fb54442d18p+2)) (f64.const 0x1.d21fb54442d18p+2))
+(assert_return (invoke "sub" (f64.const 0x1p+0) (f64.const 0x1.921fb54442d18p+2)) (f64.const -0x1.521fb54442d18p+2))
+(assert_return (invoke "sub" (f64.const 0x1p+0) (f64.const -0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "sub" (f64.const 0x1p+0) (f64.const 0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "sub" (f64.const 0x1p+0) (f64.const -inf)) (f64.const inf))
+(assert_return (invoke "sub" (f64.const 0x1p+0) (f64.const inf)) (f64.const -inf))
+(assert_return_canonical_nan (invoke "sub" (f64.const 0x1p+0) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "sub" (f64.const 0x1p+0) (f64.const nan)))
+(assert_return (invoke "sub" (f64.const -0x1.921fb54442d18p+2) (f64.const -0x0p+0)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "sub" (f64.const -0x1.921fb54442d18p+2) (f64.const 0x0p+0)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "sub" (f64.const -0x1.921fb54442d18p+2) (f64.const -0x1p-1074)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "sub" (f64.const -0x1.921fb54442d18p+2) (f64.const 0x1p-1074)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "sub" (f64.const -0x1.921fb54442d18p+2) (f64.const -0x1p-1022)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "sub" (f64.const -0x1.921fb54442d18p+2) (f64.const 0x1p-1022)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "sub" (f64.const -0x1.921fb54442d18p+2) (f64.const -0x1p-1)) (f64.const -0x1.721fb54442d18p+2))
+(assert_return (invoke "sub" (f64.const -0x1.921fb54442d18p+2) (f64.const 0x1p-1)) (f64.const -0x1.b21fb54442d18p+2))
+(assert_return (invoke "sub" (f64.const -0x1.921fb54442d18p+2) (f64.const -0x1p+0)) (f64.const -0x1.521fb54442d18p+2))
+(assert_return (invoke "sub" (f64.const -0x1.921fb54442d18p+2) (f64.const 0x1p+0)) (f64.const -0x1.d21fb54442d18p+2))
+(assert_return (invoke "sub" (f64.const -0x1.921fb54442d18p+2) (f64.const -0x1.921fb54442d18p+2)) (f64.const 0x0p+0))
+(assert_return (invoke "sub" (f64.const -0x1.921fb54442d18p+2) (f64.const 0x1.921fb54442d18p+2)) (f64.const -0x1.921fb54442d18p+3))
+(assert_return (invoke "sub" (f64.const -0x1.921fb54442d18p+2) (f64.const -0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "sub" (f64.const -0x1.921fb54442d18p+2) (f64.const 0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "sub" (f64.const -0x1.921fb54442d18p+2) (f64.const -inf)) (f64.const inf))
+(assert_return (invoke "sub" (f64.const -0x1.921fb54442d18p+2) (f64.const inf)) (f64.const -inf))
+(assert_return_canonical_nan (invoke "sub" (f64.const -0x1.921fb54442d18p+2) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "sub" (f64.const -0x1.921fb54442d18p+2) (f64.const nan)))
+(assert_return (invoke "sub" (f64.const 0x1.921fb54442d18p+2) (f64.const -0x0p+0)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "sub" (f64.const 0x1.921fb54442d18p+2) (f64.const 0x0p+0)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "sub" (f64.const 0x1.921fb54442d18p+2) (f64.const -0x1p-1074)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "sub" (f64.const 0x1.921fb54442d18p+2) (f64.const 0x1p-1074)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "sub" (f64.const 0x1.921fb54442d18p+2) (f64.const -0x1p-1022)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "sub" (f64.const 0x1.921fb54442d18p+2) (f64.const 0x1p-1022)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "sub" (f64.const 0x1.921fb54442d18p+2) (f64.const -0x1p-1)) (f64.const 0x1.b21fb54442d18p+2))
+(assert_return (invoke "sub" (f64.const 0x1.921fb54442d18p+2) (f64.const 0x1p-1)) (f64.const 0x1.721fb54442d18p+2))
+(assert_return (invoke "sub" (f64.const 0x1.921fb54442d18p+2) (f64.const -0x1p+0)) (f64.const 0x1.d21fb54442d18p+2))
+(assert_return (invoke "sub" (f64.const 0x1.921fb54442d18p+2) (f64.const 0x1p+0)) (f64.const 0x1.521fb54442d18p+2))
+(assert_return (invoke "sub" (f64.const 0x1.921fb54442d18p+2) (f64.const -0x1.921fb54442d18p+2)) (f64.const 0x1.921fb54442d18p+3))
+(assert_return (invoke "sub" (f64.const 0x1.921fb54442d18p+2) (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x0p+0))
+(assert_return (invoke "sub" (f64.const 0x1.921fb54442d18p+2) (f64.const -0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "sub" (f64.const 0x1.921fb54442d18p+2) (f64.const 0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "sub" (f64.const 0x1.921fb54442d18p+2) (f64.const -inf)) (f64.const inf))
+(assert_return (invoke "sub" (f64.const 0x1.921fb54442d18p+2) (f64.const inf)) (f64.const -inf))
+(assert_return_canonical_nan (invoke "sub" (f64.const 0x1.921fb54442d18p+2) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "sub" (f64.const 0x1.921fb54442d18p+2) (f64.const nan)))
+(assert_return (invoke "sub" (f64.const -0x1.fffffffffffffp+1023) (f64.const -0x0p+0)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "sub" (f64.const -0x1.fffffffffffffp+1023) (f64.const 0x0p+0)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "sub" (f64.const -0x1.fffffffffffffp+1023) (f64.const -0x1p-1074)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "sub" (f64.const -0x1.fffffffffffffp+1023) (f64.const 0x1p-1074)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "sub" (f64.const -0x1.fffffffffffffp+1023) (f64.const -0x1p-1022)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "sub" (f64.const -0x1.fffffffffffffp+1023) (f64.const 0x1p-1022)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "sub" (f64.const -0x1.fffffffffffffp+1023) (f64.const -0x1p-1)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "sub" (f64.const -0x1.fffffffffffffp+1023) (f64.const 0x1p-1)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "sub" (f64.const -0x1.fffffffffffffp+1023) (f64.const -0x1p+0)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "sub" (f64.const -0x1.fffffffffffffp+1023) (f64.const 0x1p+0)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "sub" (f64.const -0x1.fffffffffffffp+1023) (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "sub" (f64.const -0x1.fffffffffffffp+1023) (f64.const 0x1.921fb54442d18p+2)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "sub" (f64.const -0x1.fffffffffffffp+1023) (f64.const -0x1.fffffffffffffp+1023)) (f64.const 0x0p+0))
+(assert_return (invoke "sub" (f64.const -0x1.fffffffffffffp+1023) (f64.const 0x1.fffffffffffffp+1023)) (f64.const -inf))
+(assert_return (invoke "sub" (f64.const -0x1.fffffffffffffp+1023) (f64.const -inf)) (f64.const inf))
+(assert_return (invoke "sub" (f64.const -0x1.fffffffffffffp+1023) (f64.const inf)) (f64.const -inf))
+(assert_return_canonical_nan (invoke "sub" (f64.const -0x1.fffffffffffffp+1023) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "sub" (f64.const -0x1.fffffffffffffp+1023) (f64.const nan)))
+(assert_return (invoke "sub" (f64.const 0x1.fffffffffffffp+1023) (f64.const -0x0p+0)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "sub" (f64.const 0x1.fffffffffffffp+1023) (f64.const 0x0p+0)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "sub" (f64.const 0x1.fffffffffffffp+1023) (f64.const -0x1p-1074)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "sub" (f64.const 0x1.fffffffffffffp+1023) (f64.const 0x1p-1074)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "sub" (f64.const 0x1.fffffffffffffp+1023) (f64.const -0x1p-1022)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "sub" (f64.const 0x1.fffffffffffffp+1023) (f64.const 0x1p-1022)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "sub" (f64.const 0x1.fffffffffffffp+1023) (f64.const -0x1p-1)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "sub" (f64.const 0x1.fffffffffffffp+1023) (f64.const 0x1p-1)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "sub" (f64.const 0x1.fffffffffffffp+1023) (f64.const -0x1p+0)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "sub" (f64.const 0x1.fffffffffffffp+1023) (f64.const 0x1p+0)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "sub" (f64.const 0x1.fffffffffffffp+1023) (f64.const -0x1.921fb54442d18p+2)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "sub" (f64.const 0x1.fffffffffffffp+1023) (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "sub" (f64.const 0x1.fffffffffffffp+1023) (f64.const -0x1.fffffffffffffp+1023)) (f64.const inf))
+(assert_return (invoke "sub" (f64.const 0x1.fffffffffffffp+1023) (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x0p+0))
+(assert_return (invoke "sub" (f64.const 0x1.fffffffffffffp+1023) (f64.const -inf)) (f64.const inf))
+(assert_return (invoke "sub" (f64.const 0x1.fffffffffffffp+1023) (f64.const inf)) (f64.const -inf))
+(assert_return_canonical_nan (invoke "sub" (f64.const 0x1.fffffffffffffp+1023) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "sub" (f64.const 0x1.fffffffffffffp+1023) (f64.const nan)))
+(assert_return (invoke "sub" (f64.const -inf) (f64.const -0x0p+0)) (f64.const -inf))
+(assert_return (invoke "sub" (f64.const -inf) (f64.const 0x0p+0)) (f64.const -inf))
+(assert_return (invoke "sub" (f64.const -inf) (f64.const -0x1p-1074)) (f64.const -inf))
+(assert_return (invoke "sub" (f64.const -inf) (f64.const 0x1p-1074)) (f64.const -inf))
+(assert_return (invoke "sub" (f64.const -inf) (f64.const -0x1p-1022)) (f64.const -inf))
+(assert_return (invoke "sub" (f64.const -inf) (f64.const 0x1p-1022)) (f64.const -inf))
+(assert_return (invoke "sub" (f64.const -inf) (f64.const -0x1p-1)) (f64.const -inf))
+(assert_return (invoke "sub" (f64.const -inf) (f64.const 0x1p-1)) (f64.const -inf))
+(assert_return (invoke "sub" (f64.const -inf) (f64.const -0x1p+0)) (f64.const -inf))
+(assert_return (invoke "sub" (f64.const -inf) (f64.const 0x1p+0)) (f64.const -inf))
+(assert_return (invoke "sub" (f64.const -inf) (f64.const -0x1.921fb54442d18p+2)) (f64.const -inf))
+(assert_return (invoke "sub" (f64.const -inf) (f64.const 0x1.921fb54442d18p+2)) (f64.const -inf))
+(assert_return (invoke "sub" (f64.const -inf) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -inf))
+(assert_return (invoke "sub" (f64.const -inf) (f64.const 0x1.fffffffffffffp+1023)) (f64.const -inf))
+(assert_return_canonical_nan (invoke "sub" (f64.const -inf) (f64.const -inf)))
+(assert_return (invoke "sub" (f64.const -inf) (f64.const inf)) (f64.const -inf))
+(assert_return_canonical_nan (invoke "sub" (f64.const -inf) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "sub" (f64.const -inf) (f64.const nan)))
+(assert_return (invoke "sub" (f64.const inf) (f64.const -0x0p+0)) (f64.const inf))
+(assert_return (invoke "sub" (f64.const inf) (f64.const 0x0p+0)) (f64.const inf))
+(assert_return (invoke "sub" (f64.const inf) (f64.const -0x1p-1074)) (f64.const inf))
+(assert_return (invoke "sub" (f64.const inf) (f64.const 0x1p-1074)) (f64.const inf))
+(assert_return (invoke "sub" (f64.const inf) (f64.const -0x1p-1022)) (f64.const inf))
+(assert_return (invoke "sub" (f64.const inf) (f64.const 0x1p-1022)) (f64.const inf))
+(assert_return (invoke "sub" (f64.const inf) (f64.const -0x1p-1)) (f64.const inf))
+(assert_return (invoke "sub" (f64.const inf) (f64.const 0x1p-1)) (f64.const inf))
+(assert_return (invoke "sub" (f64.const inf) (f64.const -0x1p+0)) (f64.const inf))
+(assert_return (invoke "sub" (f64.const inf) (f64.const 0x1p+0)) (f64.const inf))
+(assert_return (invoke "sub" (f64.const inf) (f64.const -0x1.921fb54442d18p+2)) (f64.const inf))
+(assert_return (invoke "sub" (f64.const inf) (f64.const 0x1.921fb54442d18p+2)) (f64.const inf))
+(assert_return (invoke "sub" (f64.const inf) (f64.const -0x1.fffffffffffffp+1023)) (f64.const inf))
+(assert_return (invoke "sub" (f64.const inf) (f64.const 0x1.fffffffffffffp+1023)) (f64.const inf))
+(assert_return (invoke "sub" (f64.const inf) (f64.const -inf)) (f64.const inf))
+(assert_return_canonical_nan (invoke "sub" (f64.const inf) (f64.const inf)))
+(assert_return_canonical_nan (invoke "sub" (f64.const inf) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "sub" (f64.const inf) (f64.const nan)))
+(assert_return_canonical_nan (invoke "sub" (f64.const -nan) (f64.const -0x0p+0)))
+(assert_return_canonical_nan (invoke "sub" (f64.const -nan) (f64.const 0x0p+0)))
+(assert_return_canonical_nan (invoke "sub" (f64.const -nan) (f64.const -0x1p-1074)))
+(assert_return_canonical_nan (invoke "sub" (f64.const -nan) (f64.const 0x1p-1074)))
+(assert_return_canonical_nan (invoke "sub" (f64.const -nan) (f64.const -0x1p-1022)))
+(assert_return_canonical_nan (invoke "sub" (f64.const -nan) (f64.const 0x1p-1022)))
+(assert_return_canonical_nan (invoke "sub" (f64.const -nan) (f64.const -0x1p-1)))
+(assert_return_canonical_nan (invoke "sub" (f64.const -nan) (f64.const 0x1p-1)))
+(assert_return_canonical_nan (invoke "sub" (f64.const -nan) (f64.const -0x1p+0)))
+(assert_return_canonical_nan (invoke "sub" (f64.const -nan) (f64.const 0x1p+0)))
+(assert_return_canonical_nan (invoke "sub" (f64.const -nan) (f64.const -0x1.921fb54442d18p+2)))
+(assert_return_canonical_nan (invoke "sub" (f64.const -nan) (f64.const 0x1.921fb54442d18p+2)))
+(assert_return_canonical_nan (invoke "sub" (f64.const -nan) (f64.const -0x1.fffffffffffffp+1023)))
+(assert_return_canonical_nan (invoke "sub" (f64.const -nan) (f64.const 0x1.fffffffffffffp+1023)))
+(assert_return_canonical_nan (invoke "sub" (f64.const -nan) (f64.const -inf)))
+(assert_return_canonical_nan (invoke "sub" (f64.const -nan) (f64.const inf)))
+(assert_return_canonical_nan (invoke "sub" (f64.const -nan) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "sub" (f64.const -nan) (f64.const nan)))
+(assert_return_canonical_nan (invoke "sub" (f64.const nan) (f64.const -0x0p+0)))
+(assert_return_canonical_nan (invoke "sub" (f64.const nan) (f64.const 0x0p+0)))
+(assert_return_canonical_nan (invoke "sub" (f64.const nan) (f64.const -0x1p-1074)))
+(assert_return_canonical_nan (invoke "sub" (f64.const nan) (f64.const 0x1p-1074)))
+(assert_return_canonical_nan (invoke "sub" (f64.const nan) (f64.const -0x1p-1022)))
+(assert_return_canonical_nan (invoke "sub" (f64.const nan) (f64.const 0x1p-1022)))
+(assert_return_canonical_nan (invoke "sub" (f64.const nan) (f64.const -0x1p-1)))
+(assert_return_canonical_nan (invoke "sub" (f64.const nan) (f64.const 0x1p-1)))
+(assert_return_canonical_nan (invoke "sub" (f64.const nan) (f64.const -0x1p+0)))
+(assert_return_canonical_nan (invoke "sub" (f64.const nan) (f64.const 0x1p+0)))
+(assert_return_canonical_nan (invoke "sub" (f64.const nan) (f64.const -0x1.921fb54442d18p+2)))
+(assert_return_canonical_nan (invoke "sub" (f64.const nan) (f64.const 0x1.921fb54442d18p+2)))
+(assert_return_canonical_nan (invoke "sub" (f64.const nan) (f64.const -0x1.fffffffffffffp+1023)))
+(assert_return_canonical_nan (invoke "sub" (f64.const nan) (f64.const 0x1.fffffffffffffp+1023)))
+(assert_return_canonical_nan (invoke "sub" (f64.const nan) (f64.const -inf)))
+(assert_return_canonical_nan (invoke "sub" (f64.const nan) (f64.const inf)))
+(assert_return_canonical_nan (invoke "sub" (f64.const nan) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "sub" (f64.const nan) (f64.const nan)))
+(assert_return_arithmetic_nan (invoke "sub" (f64.const nan:0xc000000000000) (f64.const 0x1p+0)))
+(assert_return_arithmetic_nan (invoke "sub" (f64.const 0x1p+0) (f64.const nan:0xc000000000000)))
+(assert_return_arithmetic_nan (invoke "sub" (f64.const -nan:0xc000000000000) (f64.const 0x1p+0)))
+(assert_return_arithmetic_nan (invoke "sub" (f64.const 0x1p+0) (f64.const -nan:0xc000000000000)))
+(assert_return (invoke "sub" (f64.const 0x1.30e8689bb59b4p-85) (f64.const -0x1.b312b4ad44028p+8)) (f64.const 0x1.b312b4ad44028p+8))
+(assert_return (invoke "sub" (f64.const -0x1.576406a5fda18p-13) (f64.const 0x1.0e0bedcd3e7f2p+186)) (f64.const -0x1.0e0bedcd3e7f2p+186))
+(assert_return (invoke "sub" (f64.const -0x1.504210e886286p+976) (f64.const -0x1.65092c8305dbbp+16)) (f64.const -0x1.504210e886286p+976))
+(assert_return (invoke "sub" (f64.const 0x1.075578bee643ap-891) (f64.const -0x1.c589a74ae61aep+21)) (f64.const 0x1.c589a74ae61aep+21))
+(assert_return (invoke "sub" (f64.const 0x1.07894f37ca0adp+145) (f64.const 0x1.f99b355b7b27dp-727)) (f64.const 0x1.07894f37ca0adp+145))
+(assert_return (invoke "sub" (f64.const 0x1.7059f6f1ddc1cp+860) (f64.const 0x1.e7aebe4fb6a34p-347)) (f64.const 0x1.7059f6f1ddc1cp+860))
+(assert_return (invoke "sub" (f64.const -0x1.d93916f1734b2p-391) (f64.const 0x1.693ac8462966dp+260)) (f64.const -0x1.693ac8462966dp+260))
+(assert_return (invoke "sub" (f64.const -0x1.cffdf44301c93p+302) (f64.const 0x1.5a04b4e012dd7p-974)) (f64.const -0x1.cffdf44301c93p+302))
+(assert_return (invoke "sub" (f64.const 0x1.d63fc0c62140cp+24) (f64.const 0x1.8d39b5c76fe2cp-797)) (f64.const 0x1.d63fc0c62140cp+24))
+(assert_return (invoke "sub" (f64.const -0x1.6b4265181e13cp+10) (f64.const -0x1.99a87c28fdc13p+717)) (f64.const 0x1.99a87c28fdc13p+717))
+(assert_return (invoke "sub" (f64.const 0x1.b9c9ca5dcfc44p-204) (f64.const -0x1.94fb5d5ff6f34p+9)) (f64.const 0x1.94fb5d5ff6f34p+9))
+(assert_return (invoke "sub" (f64.const 0x1.2549418ce0dd9p-10) (f64.const 0x1.41e23c4de5b09p-21)) (f64.const 0x1.252105455720ep-10))
+(assert_return (invoke "sub" (f64.const -0x1.5fa0361638772p-581) (f64.const 0x1.801ae36c99b9bp-7)) (f64.const -0x1.801ae36c99b9bp-7))
+(assert_return (invoke "sub" (f64.const 0x1.8b57985c68c59p+1018) (f64.const -0x1.11bbbbcc106efp-512)) (f64.const 0x1.8b57985c68c59p+1018))
+(assert_return (invoke "sub" (f64.const 0x1.1011d3a9695efp+634) (f64.const -0x1.670a074fbc152p+163)) (f64.const 0x1.1011d3a9695efp+634))
+(assert_return (invoke "sub" (f64.const -0x1.3d01c2ac349d4p+307) (f64.const -0x1.eb3b680439fc8p+990)) (f64.const 0x1.eb3b680439fc8p+990))
+(assert_return (invoke "sub" (f64.const -0x1.91d159fa1209dp+231) (f64.const -0x1.42cec25bcb667p-3)) (f64.const -0x1.91d159fa1209dp+231))
+(assert_return (invoke "sub" (f64.const -0x1.133cd772ff741p-9) (f64.const 0x1.12acc1a37014cp+7)) (f64.const -0x1.12add4e04787cp+7))
+(assert_return (invoke "sub" (f64.const -0x1.34ceb66881283p+174) (f64.const -0x1.2d20b1530ce89p-773)) (f64.const -0x1.34ceb66881283p+174))
+(assert_return (invoke "sub" (f64.const 0x1.797748f97a1adp+27) (f64.const 0x1.1cee2e29067bp+4)) (f64.const 0x1.797746bf9dbe8p+27))
+(assert_return (invoke "sub" (f64.const -0x1.32cfb43f61d87p-807) (f64.const 0x1.ad314e279667ap-28)) (f64.const -0x1.ad314e279667ap-28))
+(assert_return (invoke "sub" (f64.const -0x1.435326bb103eap+183) (f64.const -0x1.8f2d7c444243cp+1)) (f64.const -0x1.435326bb103eap+183))
+(assert_return (invoke "sub" (f64.const 0x1.20008ccd3d129p+869) (f64.const 0x1.b5e35ba63e8eep+30)) (f64.const 0x1.20008ccd3d129p+869))
+(assert_return (invoke "sub" (f64.const -0x1.01692c894d89p-603) (f64.const -0x1.4c28edb06e5aep-643)) (f64.const -0x1.01692c894c3cdp-603))
+
+(assert_return (invoke "mul" (f64.const -0x0p+0) (f64.const -0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "mul" (f64.const -0x0p+0) (f64.const 0x0p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "mul" (f64.const -0x0p+0) (f64.const -0x1p-1074)) (f64.const 0x0p+0))
+(assert_return (invoke "mul" (f64.const -0x0p+0) (f64.const 0x1p-1074)) (f64.const -0x0p+0))
+(assert_return (invoke "mul" (f64.const -0x0p+0) (f64.const -0x1p-1022)) (f64.const 0x0p+0))
+(assert_return (invoke "mul" (f64.const -0x0p+0) (f64.const 0x1p-1022)) (f64.const -0x0p+0))
+(assert_return (invoke "mul" (f64.const -0x0p+0) (f64.const -0x1p-1)) (f64.const 0x0p+0))
+(assert_return (invoke "mul" (f64.const -0x0p+0) (f64.const 0x1p-1)) (f64.const -0x0p+0))
+(assert_return (invoke "mul" (f64.const -0x0p+0) (f64.const -0x1p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "mul" (f64.const -0x0p+0) (f64.const 0x1p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "mul" (f64.const -0x0p+0) (f64.const -0x1.921fb54442d18p+2)) (f64.const 0x0p+0))
+(assert_return (invoke "mul" (f64.const -0x0p+0) (f64.const 0x1.921fb54442d18p+2)) (f64.const -0x0p+0))
+(assert_return (invoke "mul" (f64.const -0x0p+0) (f64.const -0x1.fffffffffffffp+1023)) (f64.const 0x0p+0))
+(assert_return (invoke "mul" (f64.const -0x0p+0) (f64.const 0x1.fffffffffffffp+1023)) (f64.const -0x0p+0))
+(assert_return_canonical_nan (invoke "mul" (f64.const -0x0p+0) (f64.const -inf)))
+(assert_return_canonical_nan (invoke "mul" (f64.const -0x0p+0) (f64.const inf)))
+(assert_return_canonical_nan (invoke "mul" (f64.const -0x0p+0) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "mul" (f64.const -0x0p+0) (f64.const nan)))
+(assert_return (invoke "mul" (f64.const 0x0p+0) (f64.const -0x0p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "mul" (f64.const 0x0p+0) (f64.const 0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "mul" (f64.const 0x0p+0) (f64.const -0x1p-1074)) (f64.const -0x0p+0))
+(assert_return (invoke "mul" (f64.const 0x0p+0) (f64.const 0x1p-1074)) (f64.const 0x0p+0))
+(assert_return (invoke "mul" (f64.const 0x0p+0) (f64.const -0x1p-1022)) (f64.const -0x0p+0))
+(assert_return (invoke "mul" (f64.const 0x0p+0) (f64.const 0x1p-1022)) (f64.const 0x0p+0))
+(assert_return (invoke "mul" (f64.const 0x0p+0) (f64.const -0x1p-1)) (f64.const -0x0p+0))
+(assert_return (invoke "mul" (f64.const 0x0p+0) (f64.const 0x1p-1)) (f64.const 0x0p+0))
+(assert_return (invoke "mul" (f64.const 0x0p+0) (f64.const -0x1p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "mul" (f64.const 0x0p+0) (f64.const 0x1p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "mul" (f64.const 0x0p+0) (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x0p+0))
+(assert_return (invoke "mul" (f64.const 0x0p+0) (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x0p+0))
+(assert_return (invoke "mul" (f64.const 0x0p+0) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -0x0p+0))
+(assert_return (invoke "mul" (f64.const 0x0p+0) (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x0p+0))
+(assert_return_canonical_nan (invoke "mul" (f64.const 0x0p+0) (f64.const -inf)))
+(assert_return_canonical_nan (invoke "mul" (f64.const 0x0p+0) (f64.const inf)))
+(assert_return_canonical_nan (invoke "mul" (f64.const 0x0p+0) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "mul" (f64.const 0x0p+0) (f64.const nan)))
+(assert_return (invoke "mul" (f64.const -0x1p-1074) (f64.const -0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "mul" (f64.const -0x1p-1074) (f64.const 0x0p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "mul" (f64.const -0x1p-1074) (f64.const -0x1p-1074)) (f64.const 0x0p+0))
+(assert_return (invoke "mul" (f64.const -0x1p-1074) (f64.const 0x1p-1074)) (f64.const -0x0p+0))
+(assert_return (invoke "mul" (f64.const -0x1p-1074) (f64.const -0x1p-1022)) (f64.const 0x0p+0))
+(assert_return (invoke "mul" (f64.const -0x1p-1074) (f64.const 0x1p-1022)) (f64.const -0x0p+0))
+(assert_return (invoke "mul" (f64.const -0x1p-1074) (f64.const -0x1p-1)) (f64.const 0x0p+0))
+(assert_return (invoke "mul" (f64.const -0x1p-1074) (f64.const 0x1p-1)) (f64.const -0x0p+0))
+(assert_return (invoke "mul" (f64.const -0x1p-1074) (f64.const -0x1p+0)) (f64.const 0x1p-1074))
+(assert_return (invoke "mul" (f64.const -0x1p-1074) (f64.const 0x1p+0)) (f64.const -0x1p-1074))
+(assert_return (invoke "mul" (f64.const -0x1p-1074) (f64.const -0x1.921fb54442d18p+2)) (f64.const 0x1.8p-1072))
+(assert_return (invoke "mul" (f64.const -0x1p-1074) (f64.const 0x1.921fb54442d18p+2)) (f64.const -0x1.8p-1072))
+(assert_return (invoke "mul" (f64.const -0x1p-1074) (f64.const -0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp-51))
+(assert_return (invoke "mul" (f64.const -0x1p-1074) (f64.const 0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp-51))
+(assert_return (invoke "mul" (f64.const -0x1p-1074) (f64.const -inf)) (f64.const inf))
+(assert_return (invoke "mul" (f64.const -0x1p-1074) (f64.const inf)) (f64.const -inf))
+(assert_return_canonical_nan (invoke "mul" (f64.const -0x1p-1074) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "mul" (f64.const -0x1p-1074) (f64.const nan)))
+(assert_return (invoke "mul" (f64.const 0x1p-1074) (f64.const -0x0p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "mul" (f64.const 0x1p-1074) (f64.const 0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "mul" (f64.const 0x1p-1074) (f64.const -0x1p-1074)) (f64.const -0x0p+0))
+(assert_return (invoke "mul" (f64.const 0x1p-1074) (f64.const 0x1p-1074)) (f64.const 0x0p+0))
+(assert_return (invoke "mul" (f64.const 0x1p-1074) (f64.const -0x1p-1022)) (f64.const -0x0p+0))
+(assert_return (invoke "mul" (f64.const 0x1p-1074) (f64.const 0x1p-1022)) (f64.const 0x0p+0))
+(assert_return (invoke "mul" (f64.const 0x1p-1074) (f64.const -0x1p-1)) (f64.const -0x0p+0))
+(assert_return (invoke "mul" (f64.const 0x1p-1074) (f64.const 0x1p-1)) (f64.const 0x0p+0))
+(assert_return (invoke "mul" (f64.const 0x1p-1074) (f64.const -0x1p+0)) (f64.const -0x1p-1074))
+(assert_return (invoke "mul" (f64.const 0x1p-1074) (f64.const 0x1p+0)) (f64.const 0x1p-1074))
+(assert_return (invoke "mul" (f64.const 0x1p-1074) (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x1.8p-1072))
+(assert_return (invoke "mul" (f64.const 0x1p-1074) (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x1.8p-1072))
+(assert_return (invoke "mul" (f64.const 0x1p-1074) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp-51))
+(assert_return (invoke "mul" (f64.const 0x1p-1074) (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp-51))
+(assert_return (invoke "mul" (f64.const 0x1p-1074) (f64.const -inf)) (f64.const -inf))
+(assert_return (invoke "mul" (f64.const 0x1p-1074) (f64.const inf)) (f64.const inf))
+(assert_return_canonical_nan (invoke "mul" (f64.const 0x1p-1074) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "mul" (f64.const 0x1p-1074) (f64.const nan)))
+(assert_return (invoke "mul" (f64.const -0x1p-1022) (f64.const -0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "mul" (f64.const -0x1p-1022) (f64.const 0x0p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "mul" (f64.const -0x1p-1022) (f64.const -0x1p-1074)) (f64.const 0x0p+0))
+(assert_return (invoke "mul" (f64.const -0x1p-1022) (f64.const 0x1p-1074)) (f64.const -0x0p+0))
+(assert_return (invoke "mul" (f64.const -0x1p-1022) (f64.const -0x1p-1022)) (f64.const 0x0p+0))
+(assert_return (invoke "mul" (f64.const -0x1p-1022) (f64.const 0x1p-1022)) (f64.const -0x0p+0))
+(assert_return (invoke "mul" (f64.const -0x1p-1022) (f64.const -0x1p-1)) (f64.const 0x1p-1023))
+(assert_return (invoke "mul" (f64.const -0x1p-1022) (f64.const 0x1p-1)) (f64.const -0x1p-1023))
+(assert_return (invoke "mul" (f64.const -0x1p-1022) (f64.const -0x1p+0)) (f64.const 0x1p-1022))
+(assert_return (invoke "mul" (f64.const -0x1p-1022) (f64.const 0x1p+0)) (f64.const -0x1p-1022))
+(assert_return (invoke "mul" (f64.const -0x1p-1022) (f64.const -0x1.921fb54442d18p+2)) (f64.const 0x1.921fb54442d18p-1020))
+(assert_return (invoke "mul" (f64.const -0x1p-1022) (f64.const 0x1.921fb54442d18p+2)) (f64.const -0x1.921fb54442d18p-1020))
+(assert_return (invoke "mul" (f64.const -0x1p-1022) (f64.const -0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+1))
+(assert_return (invoke "mul" (f64.const -0x1p-1022) (f64.const 0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp+1))
+(assert_return (invoke "mul" (f64.const -0x1p-1022) (f64.const -inf)) (f64.const inf))
+(assert_return (invoke "mul" (f64.const -0x1p-1022) (f64.const inf)) (f64.const -inf))
+(assert_return_canonical_nan (invoke "mul" (f64.const -0x1p-1022) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "mul" (f64.const -0x1p-1022) (f64.const nan)))
+(assert_return (invoke "mul" (f64.const 0x1p-1022) (f64.const -0x0p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "mul" (f64.const 0x1p-1022) (f64.const 0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "mul" (f64.const 0x1p-1022) (f64.const -0x1p-1074)) (f64.const -0x0p+0))
+(assert_return (invoke "mul" (f64.const 0x1p-1022) (f64.const 0x1p-1074)) (f64.const 0x0p+0))
+(assert_return (invoke "mul" (f64.const 0x1p-1022) (f64.const -0x1p-1022)) (f64.const -0x0p+0))
+(assert_return (invoke "mul" (f64.const 0x1p-1022) (f64.const 0x1p-1022)) (f64.const 0x0p+0))
+(assert_return (invoke "mul" (f64.const 0x1p-1022) (f64.const -0x1p-1)) (f64.const -0x1p-1023))
+(assert_return (invoke "mul" (f64.const 0x1p-1022) (f64.const 0x1p-1)) (f64.const 0x1p-1023))
+(assert_return (invoke "mul" (f64.const 0x1p-1022) (f64.const -0x1p+0)) (f64.const -0x1p-1022))
+(assert_return (invoke "mul" (f64.const 0x1p-1022) (f64.const 0x1p+0)) (f64.const 0x1p-1022))
+(assert_return (invoke "mul" (f64.const 0x1p-1022) (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x1.921fb54442d18p-1020))
+(assert_return (invoke "mul" (f64.const 0x1p-1022) (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x1.921fb54442d18p-1020))
+(assert_return (invoke "mul" (f64.const 0x1p-1022) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp+1))
+(assert_return (invoke "mul" (f64.const 0x1p-1022) (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+1))
+(assert_return (invoke "mul" (f64.const 0x1p-1022) (f64.const -inf)) (f64.const -inf))
+(assert_return (invoke "mul" (f64.const 0x1p-1022) (f64.const inf)) (f64.const inf))
+(assert_return_canonical_nan (invoke "mul" (f64.const 0x1p-1022) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "mul" (f64.const 0x1p-1022) (f64.const nan)))
+(assert_return (invoke "mul" (f64.const -0x1p-1) (f64.const -0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "mul" (f64.const -0x1p-1) (f64.const 0x0p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "mul" (f64.const -0x1p-1) (f64.const -0x1p-1074)) (f64.const 0x0p+0))
+(assert_return (invoke "mul" (f64.const -0x1p-1) (f64.const 0x1p-1074)) (f64.const -0x0p+0))
+(assert_return (invoke "mul" (f64.const -0x1p-1) (f64.const -0x1p-1022)) (f64.const 0x1p-1023))
+(assert_return (invoke "mul" (f64.const -0x1p-1) (f64.const 0x1p-1022)) (f64.const -0x1p-1023))
+(assert_return (invoke "mul" (f64.const -0x1p-1) (f64.const -0x1p-1)) (f64.const 0x1p-2))
+(assert_return (invoke "mul" (f64.const -0x1p-1) (f64.const 0x1p-1)) (f64.const -0x1p-2))
+(assert_return (invoke "mul" (f64.const -0x1p-1) (f64.const -0x1p+0)) (f64.const 0x1p-1))
+(assert_return (invoke "mul" (f64.const -0x1p-1) (f64.const 0x1p+0)) (f64.const -0x1p-1))
+(assert_return (invoke "mul" (f64.const -0x1p-1) (f64.const -0x1.921fb54442d18p+2)) (f64.const 0x1.921fb54442d18p+1))
+(assert_return (invoke "mul" (f64.const -0x1p-1) (f64.const 0x1.921fb54442d18p+2)) (f64.const -0x1.921fb54442d18p+1))
+(assert_return (invoke "mul" (f64.const -0x1p-1) (f64.const -0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+1022))
+(assert_return (invoke "mul" (f64.const -0x1p-1) (f64.const 0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp+1022))
+(assert_return (invoke "mul" (f64.const -0x1p-1) (f64.const -inf)) (f64.const inf))
+(assert_return (invoke "mul" (f64.const -0x1p-1) (f64.const inf)) (f64.const -inf))
+(assert_return_canonical_nan (invoke "mul" (f64.const -0x1p-1) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "mul" (f64.const -0x1p-1) (f64.const nan)))
+(assert_return (invoke "mul" (f64.const 0x1p-1) (f64.const -0x0p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "mul" (f64.const 0x1p-1) (f64.const 0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "mul" (f64.const 0x1p-1) (f64.const -0x1p-1074)) (f64.const -0x0p+0))
+(assert_return (invoke "mul" (f64.const 0x1p-1) (f64.const 0x1p-1074)) (f64.const 0x0p+0))
+(assert_return (invoke "mul" (f64.const 0x1p-1) (f64.const -0x1p-1022)) (f64.const -0x1p-1023))
+(assert_return (invoke "mul" (f64.const 0x1p-1) (f64.const 0x1p-1022)) (f64.const 0x1p-1023))
+(assert_return (invoke "mul" (f64.const 0x1p-1) (f64.const -0x1p-1)) (f64.const -0x1p-2))
+(assert_return (invoke "mul" (f64.const 0x1p-1) (f64.const 0x1p-1)) (f64.const 0x1p-2))
+(assert_return (invoke "mul" (f64.const 0x1p-1) (f64.const -0x1p+0)) (f64.const -0x1p-1))
+(assert_return (invoke "mul" (f64.const 0x1p-1) (f64.const 0x1p+0)) (f64.const 0x1p-1))
+(assert_return (invoke "mul" (f64.const 0x1p-1) (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x1.921fb54442d18p+1))
+(assert_return (invoke "mul" (f64.const 0x1p-1) (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x1.921fb54442d18p+1))
+(assert_return (invoke "mul" (f64.const 0x1p-1) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp+1022))
+(assert_return (invoke "mul" (f64.const 0x1p-1) (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+1022))
+(assert_return (invoke "mul" (f64.const 0x1p-1) (f64.const -inf)) (f64.const -inf))
+(assert_return (invoke "mul" (f64.const 0x1p-1) (f64.const inf)) (f64.const inf))
+(assert_return_canonical_nan (invoke "mul" (f64.const 0x1p-1) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "mul" (f64.const 0x1p-1) (f64.const nan)))
+(assert_return (invoke "mul" (f64.const -0x1p+0) (f64.const -0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "mul" (f64.const -0x1p+0) (f64.const 0x0p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "mul" (f64.const -0x1p+0) (f64.const -0x1p-1074)) (f64.const 0x1p-1074))
+(assert_return (invoke "mul" (f64.const -0x1p+0) (f64.const 0x1p-1074)) (f64.const -0x1p-1074))
+(assert_return (invoke "mul" (f64.const -0x1p+0) (f64.const -0x1p-1022)) (f64.const 0x1p-1022))
+(assert_return (invoke "mul" (f64.const -0x1p+0) (f64.const 0x1p-1022)) (f64.const -0x1p-1022))
+(assert_return (invoke "mul" (f64.const -0x1p+0) (f64.const -0x1p-1)) (f64.const 0x1p-1))
+(assert_return (invoke "mul" (f64.const -0x1p+0) (f64.const 0x1p-1)) (f64.const -0x1p-1))
+(assert_return (invoke "mul" (f64.const -0x1p+0) (f64.const -0x1p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "mul" (f64.const -0x1p+0) (f64.const 0x1p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "mul" (f64.const -0x1p+0) (f64.const -0x1.921fb54442d18p+2)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "mul" (f64.const -0x1p+0) (f64.const 0x1.921fb54442d18p+2)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "mul" (f64.const -0x1p+0) (f64.const -0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "mul" (f64.const -0x1p+0) (f64.const 0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "mul" (f64.const -0x1p+0) (f64.const -inf)) (f64.const inf))
+(assert_return (invoke "mul" (f64.const -0x1p+0) (f64.const inf)) (f64.const -inf))
+(assert_return_canonical_nan (invoke "mul" (f64.const -0x1p+0) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "mul" (f64.const -0x1p+0) (f64.const nan)))
+(assert_return (invoke "mul" (f64.const 0x1p+0) (f64.const -0x0p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "mul" (f64.const 0x1p+0) (f64.const 0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "mul" (f64.const 0x1p+0) (f64.const -0x1p-1074)) (f64.const -0x1p-1074))
+(assert_return (invoke "mul" (f64.const 0x1p+0) (f64.const 0x1p-1074)) (f64.const 0x1p-1074))
+(assert_return (invoke "mul" (f64.const 0x1p+0) (f64.const -0x1p-1022)) (f64.const -0x1p-1022))
+(assert_return (invoke "mul" (f64.const 0x1p+0) (f64.const 0x1p-1022)) (f64.const 0x1p-1022))
+(assert_return (invoke "mul" (f64.const 0x1p+0) (f64.const -0x1p-1)) (f64.const -0x1p-1))
+(assert_return (invoke "mul" (f64.const 0x1p+0) (f64.const 0x1p-1)) (f64.const 0x1p-1))
+(assert_return (invoke "mul" (f64.const 0x1p+0) (f64.const -0x1p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "mul" (f64.const 0x1p+0) (f64.const 0x1p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "mul" (f64.const 0x1p+0) (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "mul" (f64.const 0x1p+0) (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "mul" (f64.const 0x1p+0) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "mul" (f64.const 0x1p+0) (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "mul" (f64.const 0x1p+0) (f64.const -inf)) (f64.const -inf))
+(assert_return (invoke "mul" (f64.const 0x1p+0) (f64.const inf)) (f64.const inf))
+(assert_return_canonical_nan (invoke "mul" (f64.const 0x1p+0) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "mul" (f64.const 0x1p+0) (f64.const nan)))
+(assert_return (invoke "mul" (f64.const -0x1.921fb54442d18p+2) (f64.const -0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "mul" (f64.const -0x1.921fb54442d18p+2) (f64.const 0x0p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "mul" (f64.const -0x1.921fb54442d18p+2) (f64.const -0x1p-1074)) (f64.const 0x1.8p-1072))
+(assert_return (invoke "mul" (f64.const -0x1.921fb54442d18p+2) (f64.const 0x1p-1074)) (f64.const -0x1.8p-1072))
+(assert_return (invoke "mul" (f64.const -0x1.921fb54442d18p+2) (f64.const -0x1p-1022)) (f64.const 0x1.921fb54442d18p-1020))
+(assert_return (invoke "mul" (f64.const -0x1.921fb54442d18p+2) (f64.const 0x1p-1022)) (f64.const -0x1.921fb54442d18p-1020))
+(assert_return (invoke "mul" (f64.const -0x1.921fb54442d18p+2) (f64.const -0x1p-1)) (f64.const 0x1.921fb54442d18p+1))
+(assert_return (invoke "mul" (f64.const -0x1.921fb54442d18p+2) (f64.const 0x1p-1)) (f64.const -0x1.921fb54442d18p+1))
+(assert_return (invoke "mul" (f64.const -0x1.921fb54442d18p+2) (f64.const -0x1p+0)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "mul" (f64.const -0x1.921fb54442d18p+2) (f64.const 0x1p+0)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "mul" (f64.const -0x1.921fb54442d18p+2) (f64.const -0x1.921fb54442d18p+2)) (f64.const 0x1.3bd3cc9be45dep+5))
+(assert_return (invoke "mul" (f64.const -0x1.921fb54442d18p+2) (f64.const 0x1.921fb54442d18p+2)) (f64.const -0x1.3bd3cc9be45dep+5))
+(assert_return (invoke "mul" (f64.const -0x1.921fb54442d18p+2) (f64.const -0x1.fffffffffffffp+1023)) (f64.const inf))
+(assert_return (invoke "mul" (f64.const -0x1.921fb54442d18p+2) (f64.const 0x1.fffffffffffffp+1023)) (f64.const -inf))
+(assert_return (invoke "mul" (f64.const -0x1.921fb54442d18p+2) (f64.const -inf)) (f64.const inf))
+(assert_return (invoke "mul" (f64.const -0x1.921fb54442d18p+2) (f64.const inf)) (f64.const -inf))
+(assert_return_canonical_nan (invoke "mul" (f64.const -0x1.921fb54442d18p+2) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "mul" (f64.const -0x1.921fb54442d18p+2) (f64.const nan)))
+(assert_return (invoke "mul" (f64.const 0x1.921fb54442d18p+2) (f64.const -0x0p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "mul" (f64.const 0x1.921fb54442d18p+2) (f64.const 0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "mul" (f64.const 0x1.921fb54442d18p+2) (f64.const -0x1p-1074)) (f64.const -0x1.8p-1072))
+(assert_return (invoke "mul" (f64.const 0x1.921fb54442d18p+2) (f64.const 0x1p-1074)) (f64.const 0x1.8p-1072))
+(assert_return (invoke "mul" (f64.const 0x1.921fb54442d18p+2) (f64.const -0x1p-1022)) (f64.const -0x1.921fb54442d18p-1020))
+(assert_return (invoke "mul" (f64.const 0x1.921fb54442d18p+2) (f64.const 0x1p-1022)) (f64.const 0x1.921fb54442d18p-1020))
+(assert_return (invoke "mul" (f64.const 0x1.921fb54442d18p+2) (f64.const -0x1p-1)) (f64.const -0x1.921fb54442d18p+1))
+(assert_return (invoke "mul" (f64.const 0x1.921fb54442d18p+2) (f64.const 0x1p-1)) (f64.const 0x1.921fb54442d18p+1))
+(assert_return (invoke "mul" (f64.const 0x1.921fb54442d18p+2) (f64.const -0x1p+0)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "mul" (f64.const 0x1.921fb54442d18p+2) (f64.const 0x1p+0)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "mul" (f64.const 0x1.921fb54442d18p+2) (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x1.3bd3cc9be45dep+5))
+(assert_return (invoke "mul" (f64.const 0x1.921fb54442d18p+2) (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x1.3bd3cc9be45dep+5))
+(assert_return (invoke "mul" (f64.const 0x1.921fb54442d18p+2) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -inf))
+(assert_return (invoke "mul" (f64.const 0x1.921fb54442d18p+2) (f64.const 0x1.fffffffffffffp+1023)) (f64.const inf))
+(assert_return (invoke "mul" (f64.const 0x1.921fb54442d18p+2) (f64.const -inf)) (f64.const -inf))
+(assert_return (invoke "mul" (f64.const 0x1.921fb54442d18p+2) (f64.const inf)) (f64.const inf))
+(assert_return_canonical_nan (invoke "mul" (f64.const 0x1.921fb54442d18p+2) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "mul" (f64.const 0x1.921fb54442d18p+2) (f64.const nan)))
+(assert_return (invoke "mul" (f64.const -0x1.fffffffffffffp+1023) (f64.const -0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "mul" (f64.const -0x1.fffffffffffffp+1023) (f64.const 0x0p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "mul" (f64.const -0x1.fffffffffffffp+1023) (f64.const -0x1p-1074)) (f64.const 0x1.fffffffffffffp-51))
+(assert_return (invoke "mul" (f64.const -0x1.fffffffffffffp+1023) (f64.const 0x1p-1074)) (f64.const -0x1.fffffffffffffp-51))
+(assert_return (invoke "mul" (f64.const -0x1.fffffffffffffp+1023) (f64.const -0x1p-1022)) (f64.const 0x1.fffffffffffffp+1))
+(assert_return (invoke "mul" (f64.const -0x1.fffffffffffffp+1023) (f64.const 0x1p-1022)) (f64.const -0x1.fffffffffffffp+1))
+(assert_return (invoke "mul" (f64.const -0x1.fffffffffffffp+1023) (f64.const -0x1p-1)) (f64.const 0x1.fffffffffffffp+1022))
+(assert_return (invoke "mul" (f64.const -0x1.fffffffffffffp+1023) (f64.const 0x1p-1)) (f64.const -0x1.fffffffffffffp+1022))
+(assert_return (invoke "mul" (f64.const -0x1.fffffffffffffp+1023) (f64.const -0x1p+0)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "mul" (f64.const -0x1.fffffffffffffp+1023) (f64.const 0x1p+0)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "mul" (f64.const -0x1.fffffffffffffp+1023) (f64.const -0x1.921fb54442d18p+2)) (f64.const inf))
+(assert_return (invoke "mul" (f64.const -0x1.fffffffffffffp+1023) (f64.const 0x1.921fb54442d18p+2)) (f64.const -inf))
+(assert_return (invoke "mul" (f64.const -0x1.fffffffffffffp+1023) (f64.const -0x1.fffffffffffffp+1023)) (f64.const inf))
+(assert_return (invoke "mul" (f64.const -0x1.fffffffffffffp+1023) (f64.const 0x1.fffffffffffffp+1023)) (f64.const -inf))
+(assert_return (invoke "mul" (f64.const -0x1.fffffffffffffp+1023) (f64.const -inf)) (f64.const inf))
+(assert_return (invoke "mul" (f64.const -0x1.fffffffffffffp+1023) (f64.const inf)) (f64.const -inf))
+(assert_return_canonical_nan (invoke "mul" (f64.const -0x1.fffffffffffffp+1023) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "mul" (f64.const -0x1.fffffffffffffp+1023) (f64.const nan)))
+(assert_return (invoke "mul" (f64.const 0x1.fffffffffffffp+1023) (f64.const -0x0p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "mul" (f64.const 0x1.fffffffffffffp+1023) (f64.const 0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "mul" (f64.const 0x1.fffffffffffffp+1023) (f64.const -0x1p-1074)) (f64.const -0x1.fffffffffffffp-51))
+(assert_return (invoke "mul" (f64.const 0x1.fffffffffffffp+1023) (f64.const 0x1p-1074)) (f64.const 0x1.fffffffffffffp-51))
+(assert_return (invoke "mul" (f64.const 0x1.fffffffffffffp+1023) (f64.const -0x1p-1022)) (f64.const -0x1.fffffffffffffp+1))
+(assert_return (invoke "mul" (f64.const 0x1.fffffffffffffp+1023) (f64.const 0x1p-1022)) (f64.const 0x1.fffffffffffffp+1))
+(assert_return (invoke "mul" (f64.const 0x1.fffffffffffffp+1023) (f64.const -0x1p-1)) (f64.const -0x1.fffffffffffffp+1022))
+(assert_return (invoke "mul" (f64.const 0x1.fffffffffffffp+1023) (f64.const 0x1p-1)) (f64.const 0x1.fffffffffffffp+1022))
+(assert_return (invoke "mul" (f64.const 0x1.fffffffffffffp+1023) (f64.const -0x1p+0)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "mul" (f64.const 0x1.fffffffffffffp+1023) (f64.const 0x1p+0)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "mul" (f64.const 0x1.fffffffffffffp+1023) (f64.const -0x1.921fb54442d18p+2)) (f64.const -inf))
+(assert_return (invoke "mul" (f64.const 0x1.fffffffffffffp+1023) (f64.const 0x1.921fb54442d18p+2)) (f64.const inf))
+(assert_return (invoke "mul" (f64.const 0x1.fffffffffffffp+1023) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -inf))
+(assert_return (invoke "mul" (f64.const 0x1.fffffffffffffp+1023) (f64.const 0x1.fffffffffffffp+1023)) (f64.const inf))
+(assert_return (invoke "mul" (f64.const 0x1.fffffffffffffp+1023) (f64.const -inf)) (f64.const -inf))
+(assert_return (invoke "mul" (f64.const 0x1.fffffffffffffp+1023) (f64.const inf)) (f64.const inf))
+(assert_return_canonical_nan (invoke "mul" (f64.const 0x1.fffffffffffffp+1023) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "mul" (f64.const 0x1.fffffffffffffp+1023) (f64.const nan)))
+(assert_return_canonical_nan (invoke "mul" (f64.const -inf) (f64.const -0x0p+0)))
+(assert_return_canonical_nan (invoke "mul" (f64.const -inf) (f64.const 0x0p+0)))
+(assert_return (invoke "mul" (f64.const -inf) (f64.const -0x1p-1074)) (f64.const inf))
+(assert_return (invoke "mul" (f64.const -inf) (f64.const 0x1p-1074)) (f64.const -inf))
+(assert_return (invoke "mul" (f64.const -inf) (f64.const -0x1p-1022)) (f64.const inf))
+(assert_return (invoke "mul" (f64.const -inf) (f64.const 0x1p-1022)) (f64.const -inf))
+(assert_return (invoke "mul" (f64.const -inf) (f64.const -0x1p-1)) (f64.const inf))
+(assert_return (invoke "mul" (f64.const -inf) (f64.const 0x1p-1)) (f64.const -inf))
+(assert_return (invoke "mul" (f64.const -inf) (f64.const -0x1p+0)) (f64.const inf))
+(assert_return (invoke "mul" (f64.const -inf) (f64.const 0x1p+0)) (f64.const -inf))
+(assert_return (invoke "mul" (f64.const -inf) (f64.const -0x1.921fb54442d18p+2)) (f64.const inf))
+(assert_return (invoke "mul" (f64.const -inf) (f64.const 0x1.921fb54442d18p+2)) (f64.const -inf))
+(assert_return (invoke "mul" (f64.const -inf) (f64.const -0x1.fffffffffffffp+1023)) (f64.const inf))
+(assert_return (invoke "mul" (f64.const -inf) (f64.const 0x1.fffffffffffffp+1023)) (f64.const -inf))
+(assert_return (invoke "mul" (f64.const -inf) (f64.const -inf)) (f64.const inf))
+(assert_return (invoke "mul" (f64.const -inf) (f64.const inf)) (f64.const -inf))
+(assert_return_canonical_nan (invoke "mul" (f64.const -inf) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "mul" (f64.const -inf) (f64.const nan)))
+(assert_return_canonical_nan (invoke "mul" (f64.const inf) (f64.const -0x0p+0)))
+(assert_return_canonical_nan (invoke "mul" (f64.const inf) (f64.const 0x0p+0)))
+(assert_return (invoke "mul" (f64.const inf) (f64.const -0x1p-1074)) (f64.const -inf))
+(assert_return (invoke "mul" (f64.const inf) (f64.const 0x1p-1074)) (f64.const inf))
+(assert_return (invoke "mul" (f64.const inf) (f64.const -0x1p-1022)) (f64.const -inf))
+(assert_return (invoke "mul" (f64.const inf) (f64.const 0x1p-1022)) (f64.const inf))
+(assert_return (invoke "mul" (f64.const inf) (f64.const -0x1p-1)) (f64.const -inf))
+(assert_return (invoke "mul" (f64.const inf) (f64.const 0x1p-1)) (f64.const inf))
+(assert_return (invoke "mul" (f64.const inf) (f64.const -0x1p+0)) (f64.const -inf))
+(assert_return (invoke "mul" (f64.const inf) (f64.const 0x1p+0)) (f64.const inf))
+(assert_return (invoke "mul" (f64.const inf) (f64.const -0x1.921fb54442d18p+2)) (f64.const -inf))
+(assert_return (invoke "mul" (f64.const inf) (f64.const 0x1.921fb54442d18p+2)) (f64.const inf))
+(assert_return (invoke "mul" (f64.const inf) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -inf))
+(assert_return (invoke "mul" (f64.const inf) (f64.const 0x1.fffffffffffffp+1023)) (f64.const inf))
+(assert_return (invoke "mul" (f64.const inf) (f64.const -inf)) (f64.const -inf))
+(assert_return (invoke "mul" (f64.const inf) (f64.const inf)) (f64.const inf))
+(assert_return_canonical_nan (invoke "mul" (f64.const inf) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "mul" (f64.const inf) (f64.const nan)))
+(assert_return_canonical_nan (invoke "mul" (f64.const -nan) (f64.const -0x0p+0)))
+(assert_return_canonical_nan (invoke "mul" (f64.const -nan) (f64.const 0x0p+0)))
+(assert_return_canonical_nan (invoke "mul" (f64.const -nan) (f64.const -0x1p-1074)))
+(assert_return_canonical_nan (invoke "mul" (f64.const -nan) (f64.const 0x1p-1074)))
+(assert_return_canonical_nan (invoke "mul" (f64.const -nan) (f64.const -0x1p-1022)))
+(assert_return_canonical_nan (invoke "mul" (f64.const -nan) (f64.const 0x1p-1022)))
+(assert_return_canonical_nan (invoke "mul" (f64.const -nan) (f64.const -0x1p-1)))
+(assert_return_canonical_nan (invoke "mul" (f64.const -nan) (f64.const 0x1p-1)))
+(assert_return_canonical_nan (invoke "mul" (f64.const -nan) (f64.const -0x1p+0)))
+(assert_return_canonical_nan (invoke "mul" (f64.const -nan) (f64.const 0x1p+0)))
+(assert_return_canonical_nan (invoke "mul" (f64.const -nan) (f64.const -0x1.921fb54442d18p+2)))
+(assert_return_canonical_nan (invoke "mul" (f64.const -nan) (f64.const 0x1.921fb54442d18p+2)))
+(assert_return_canonical_nan (invoke "mul" (f64.const -nan) (f64.const -0x1.fffffffffffffp+1023)))
+(assert_return_canonical_nan (invoke "mul" (f64.const -nan) (f64.const 0x1.fffffffffffffp+1023)))
+(assert_return_canonical_nan (invoke "mul" (f64.const -nan) (f64.const -inf)))
+(assert_return_canonical_nan (invoke "mul" (f64.const -nan) (f64.const inf)))
+(assert_return_canonical_nan (invoke "mul" (f64.const -nan) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "mul" (f64.const -nan) (f64.const nan)))
+(assert_return_canonical_nan (invoke "mul" (f64.const nan) (f64.const -0x0p+0)))
+(assert_return_canonical_nan (invoke "mul" (f64.const nan) (f64.const 0x0p+0)))
+(assert_return_canonical_nan (invoke "mul" (f64.const nan) (f64.const -0x1p-1074)))
+(assert_return_canonical_nan (invoke "mul" (f64.const nan) (f64.const 0x1p-1074)))
+(assert_return_canonical_nan (invoke "mul" (f64.const nan) (f64.const -0x1p-1022)))
+(assert_return_canonical_nan (invoke "mul" (f64.const nan) (f64.const 0x1p-1022)))
+(assert_return_canonical_nan (invoke "mul" (f64.const nan) (f64.const -0x1p-1)))
+(assert_return_canonical_nan (invoke "mul" (f64.const nan) (f64.const 0x1p-1)))
+(assert_return_canonical_nan (invoke "mul" (f64.const nan) (f64.const -0x1p+0)))
+(assert_return_canonical_nan (invoke "mul" (f64.const nan) (f64.const 0x1p+0)))
+(assert_return_canonical_nan (invoke "mul" (f64.const nan) (f64.const -0x1.921fb54442d18p+2)))
+(assert_return_canonical_nan (invoke "mul" (f64.const nan) (f64.const 0x1.921fb54442d18p+2)))
+(assert_return_canonical_nan (invoke "mul" (f64.const nan) (f64.const -0x1.fffffffffffffp+1023)))
+(assert_return_canonical_nan (invoke "mul" (f64.const nan) (f64.const 0x1.fffffffffffffp+1023)))
+(assert_return_canonical_nan (invoke "mul" (f64.const nan) (f64.const -inf)))
+(assert_return_canonical_nan (invoke "mul" (f64.const nan) (f64.const inf)))
+(assert_return_canonical_nan (invoke "mul" (f64.const nan) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "mul" (f64.const nan) (f64.const nan)))
+(assert_return_arithmetic_nan (invoke "mul" (f64.const nan:0xc000000000000) (f64.const 0x1p+0)))
+(assert_return_arithmetic_nan (invoke "mul" (f64.const 0x1p+0) (f64.const nan:0xc000000000000)))
+(assert_return_arithmetic_nan (invoke "mul" (f64.const -nan:0xc000000000000) (f64.const 0x1p+0)))
+(assert_return_arithmetic_nan (invoke "mul" (f64.const 0x1p+0) (f64.const -nan:0xc000000000000)))
+(assert_return (invoke "mul" (f64.const -0x1.0ce0c0d171aaep+29) (f64.const -0x1.53bfe90b9fb48p+6)) (f64.const 0x1.64d72fc9faad3p+35))
+(assert_return (invoke "mul" (f64.const 0x1.e6aec237c3863p+227) (f64.const -0x1.abe29b3ca4102p-452)) (f64.const -0x1.96ba25acc867bp-224))
+(assert_return (invoke "mul" (f64.const -0x1.f90e4ead2d683p-824) (f64.const 0x1.c10180ed2c43ap+89)) (f64.const -0x1.baea87b38e1d2p-734))
+(assert_return (invoke "mul" (f64.const -0x1.d4d3bb85bd7b2p+295) (f64.const 0x1.ab482367a23f3p-27)) (f64.const -0x1.8740a315c6cf9p+269))
+(assert_return (invoke "mul" (f64.const 0x1.40c2c93f8f5d8p-843) (f64.const 0x1.4ed3218f13644p+867)) (f64.const 0x1.a386ad292d7adp+24))
+(assert_return (invoke "mul" (f64.const -0x1.0f48410acbbabp+29) (f64.const -0x1.61cdc18213f0ap+11)) (f64.const 0x1.76ecab9b496d1p+40))
+(assert_return (invoke "mul" (f64.const 0x1.3b3523feb618fp+12) (f64.const -0x1.144a0525c7e2fp-1)) (f64.const -0x1.54306e8192737p+11))
+(assert_return (invoke "mul" (f64.const -0x1.df0b9abd71f1p+17) (f64.const -0x1.d4b767eff00e9p-4)) (f64.const 0x1.b68c35564d037p+14))
+(assert_return (invoke "mul" (f64.const 0x1.14dd46eb1c458p+5) (f64.const -0x1.2c680d81a581p+899)) (f64.const -0x1.44e3d79bc7bdcp+904))
+(assert_return (invoke "mul" (f64.const -0x1.9cb462d93a23dp+413) (f64.const 0x1.e026515600497p+19)) (f64.const -0x1.8307ff9ed8cc1p+433))
+(assert_return (invoke "mul" (f64.const -0x1.5e0fa95a263eep-51) (f64.const 0x1.c2e62c7038861p-16)) (f64.const -0x1.3449234361bcap-66))
+(assert_return (invoke "mul" (f64.const -0x1.09be08c591308p-83) (f64.const 0x1.e41603a71c2f8p+17)) (f64.const -0x1.f68222b4f9ce5p-66))
+(assert_return (invoke "mul" (f64.const 0x1.f064785fe458dp+29) (f64.const -0x1.4299d575f3852p-12)) (f64.const -0x1.38c454ae6e65cp+18))
+(assert_return (invoke "mul" (f64.const 0x1.ec76af7327839p-999) (f64.const -0x1.4a02c0be32241p+764)) (f64.const -0x1.3d6b24ef59fb8p-234))
+(assert_return (invoke "mul" (f64.const 0x1.1dfae49241e63p+832) (f64.const 0x1.1dc797d1c57bdp+688)) (f64.const inf))
+(assert_return (invoke "mul" (f64.const 0x1.d2f8eb4af3cbep-22) (f64.const -0x1.6614dfcec338dp+978)) (f64.const -0x1.4697165e98148p+957))
+(assert_return (invoke "mul" (f64.const -0x1.ed87440d6c6f8p+178) (f64.const 0x1.c84a683923e24p+27)) (f64.const -0x1.b7d4319f587cap+206))
+(assert_return (invoke "mul" (f64.const -0x1.7aeff70a2d0afp+998) (f64.const 0x1.83eddf308f3ddp-580)) (f64.const -0x1.1f1c6e7d7fdd8p+419))
+(assert_return (invoke "mul" (f64.const -0x1.e3835c1de2e6ep+827) (f64.const -0x1.d8db81ee94f91p+115)) (f64.const 0x1.be8c644f5b69fp+943))
+(assert_return (invoke "mul" (f64.const -0x1.b838743b50be4p-21) (f64.const 0x1.630923d09ec69p-24)) (f64.const -0x1.3143006066ec4p-44))
+(assert_return (invoke "mul" (f64.const -0x1.ee125adf2edcep-11) (f64.const -0x1.0fae9eace6f72p+2)) (f64.const 0x1.062b3874863cfp-8))
+(assert_return (invoke "mul" (f64.const 0x1.2399c236aef83p+29) (f64.const 0x1.fef6c021e39c7p+1)) (f64.const 0x1.2302b0c1be6e9p+31))
+(assert_return (invoke "mul" (f64.const 0x1.53f589013c3d8p+614) (f64.const 0x1.5ada902d0fc22p-499)) (f64.const 0x1.cc9c19b84a9f6p+115))
+(assert_return (invoke "mul" (f64.const -0x1.8c3391eef6a9p-29) (f64.const -0x1.9e8667c71affcp+22)) (f64.const 0x1.40c5b4cdddc88p-6))
+
+(assert_return_canonical_nan (invoke "div" (f64.const -0x0p+0) (f64.const -0x0p+0)))
+(assert_return_canonical_nan (invoke "div" (f64.const -0x0p+0) (f64.const 0x0p+0)))
+(assert_return (invoke "div" (f64.const -0x0p+0) (f64.const -0x1p-1074)) (f64.const 0x0p+0))
+(assert_return (invoke "div" (f64.const -0x0p+0) (f64.const 0x1p-1074)) (f64.const -0x0p+0))
+(assert_return (invoke "div" (f64.const -0x0p+0) (f64.const -0x1p-1022)) (f64.const 0x0p+0))
+(assert_return (invoke "div" (f64.const -0x0p+0) (f64.const 0x1p-1022)) (f64.const -0x0p+0))
+(assert_return (invoke "div" (f64.const -0x0p+0) (f64.const -0x1p-1)) (f64.const 0x0p+0))
+(assert_return (invoke "div" (f64.const -0x0p+0) (f64.const 0x1p-1)) (f64.const -0x0p+0))
+(assert_return (invoke "div" (f64.const -0x0p+0) (f64.const -0x1p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "div" (f64.const -0x0p+0) (f64.const 0x1p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "div" (f64.const -0x0p+0) (f64.const -0x1.921fb54442d18p+2)) (f64.const 0x0p+0))
+(assert_return (invoke "div" (f64.const -0x0p+0) (f64.const 0x1.921fb54442d18p+2)) (f64.const -0x0p+0))
+(assert_return (invoke "div" (f64.const -0x0p+0) (f64.const -0x1.fffffffffffffp+1023)) (f64.const 0x0p+0))
+(assert_return (invoke "div" (f64.const -0x0p+0) (f64.const 0x1.fffffffffffffp+1023)) (f64.const -0x0p+0))
+(assert_return (invoke "div" (f64.const -0x0p+0) (f64.const -inf)) (f64.const 0x0p+0))
+(assert_return (invoke "div" (f64.const -0x0p+0) (f64.const inf)) (f64.const -0x0p+0))
+(assert_return_canonical_nan (invoke "div" (f64.const -0x0p+0) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "div" (f64.const -0x0p+0) (f64.const nan)))
+(assert_return_canonical_nan (invoke "div" (f64.const 0x0p+0) (f64.const -0x0p+0)))
+(assert_return_canonical_nan (invoke "div" (f64.const 0x0p+0) (f64.const 0x0p+0)))
+(assert_return (invoke "div" (f64.const 0x0p+0) (f64.const -0x1p-1074)) (f64.const -0x0p+0))
+(assert_return (invoke "div" (f64.const 0x0p+0) (f64.const 0x1p-1074)) (f64.const 0x0p+0))
+(assert_return (invoke "div" (f64.const 0x0p+0) (f64.const -0x1p-1022)) (f64.const -0x0p+0))
+(assert_return (invoke "div" (f64.const 0x0p+0) (f64.const 0x1p-1022)) (f64.const 0x0p+0))
+(assert_return (invoke "div" (f64.const 0x0p+0) (f64.const -0x1p-1)) (f64.const -0x0p+0))
+(assert_return (invoke "div" (f64.const 0x0p+0) (f64.const 0x1p-1)) (f64.const 0x0p+0))
+(assert_return (invoke "div" (f64.const 0x0p+0) (f64.const -0x1p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "div" (f64.const 0x0p+0) (f64.const 0x1p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "div" (f64.const 0x0p+0) (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x0p+0))
+(assert_return (invoke "div" (f64.const 0x0p+0) (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x0p+0))
+(assert_return (invoke "div" (f64.const 0x0p+0) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -0x0p+0))
+(assert_return (invoke "div" (f64.const 0x0p+0) (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x0p+0))
+(assert_return (invoke "div" (f64.const 0x0p+0) (f64.const -inf)) (f64.const -0x0p+0))
+(assert_return (invoke "div" (f64.const 0x0p+0) (f64.const inf)) (f64.const 0x0p+0))
+(assert_return_canonical_nan (invoke "div" (f64.const 0x0p+0) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "div" (f64.const 0x0p+0) (f64.const nan)))
+(assert_return (invoke "div" (f64.const -0x1p-1074) (f64.const -0x0p+0)) (f64.const inf))
+(assert_return (invoke "div" (f64.const -0x1p-1074) (f64.const 0x0p+0)) (f64.const -inf))
+(assert_return (invoke "div" (f64.const -0x1p-1074) (f64.const -0x1p-1074)) (f64.const 0x1p+0))
+(assert_return (invoke "div" (f64.const -0x1p-1074) (f64.const 0x1p-1074)) (f64.const -0x1p+0))
+(assert_return (invoke "div" (f64.const -0x1p-1074) (f64.const -0x1p-1022)) (f64.const 0x1p-52))
+(assert_return (invoke "div" (f64.const -0x1p-1074) (f64.const 0x1p-1022)) (f64.const -0x1p-52))
+(assert_return (invoke "div" (f64.const -0x1p-1074) (f64.const -0x1p-1)) (f64.const 0x1p-1073))
+(assert_return (invoke "div" (f64.const -0x1p-1074) (f64.const 0x1p-1)) (f64.const -0x1p-1073))
+(assert_return (invoke "div" (f64.const -0x1p-1074) (f64.const -0x1p+0)) (f64.const 0x1p-1074))
+(assert_return (invoke "div" (f64.const -0x1p-1074) (f64.const 0x1p+0)) (f64.const -0x1p-1074))
+(assert_return (invoke "div" (f64.const -0x1p-1074) (f64.const -0x1.921fb54442d18p+2)) (f64.const 0x0p+0))
+(assert_return (invoke "div" (f64.const -0x1p-1074) (f64.const 0x1.921fb54442d18p+2)) (f64.const -0x0p+0))
+(assert_return (invoke "div" (f64.const -0x1p-1074) (f64.const -0x1.fffffffffffffp+1023)) (f64.const 0x0p+0))
+(assert_return (invoke "div" (f64.const -0x1p-1074) (f64.const 0x1.fffffffffffffp+1023)) (f64.const -0x0p+0))
+(assert_return (invoke "div" (f64.const -0x1p-1074) (f64.const -inf)) (f64.const 0x0p+0))
+(assert_return (invoke "div" (f64.const -0x1p-1074) (f64.const inf)) (f64.const -0x0p+0))
+(assert_return_canonical_nan (invoke "div" (f64.const -0x1p-1074) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "div" (f64.const -0x1p-1074) (f64.const nan)))
+(assert_return (invoke "div" (f64.const 0x1p-1074) (f64.const -0x0p+0)) (f64.const -inf))
+(assert_return (invoke "div" (f64.const 0x1p-1074) (f64.const 0x0p+0)) (f64.const inf))
+(assert_return (invoke "div" (f64.const 0x1p-1074) (f64.const -0x1p-1074)) (f64.const -0x1p+0))
+(assert_return (invoke "div" (f64.const 0x1p-1074) (f64.const 0x1p-1074)) (f64.const 0x1p+0))
+(assert_return (invoke "div" (f64.const 0x1p-1074) (f64.const -0x1p-1022)) (f64.const -0x1p-52))
+(assert_return (invoke "div" (f64.const 0x1p-1074) (f64.const 0x1p-1022)) (f64.const 0x1p-52))
+(assert_return (invoke "div" (f64.const 0x1p-1074) (f64.const -0x1p-1)) (f64.const -0x1p-1073))
+(assert_return (invoke "div" (f64.const 0x1p-1074) (f64.const 0x1p-1)) (f64.const 0x1p-1073))
+(assert_return (invoke "div" (f64.const 0x1p-1074) (f64.const -0x1p+0)) (f64.const -0x1p-1074))
+(assert_return (invoke "div" (f64.const 0x1p-1074) (f64.const 0x1p+0)) (f64.const 0x1p-1074))
+(assert_return (invoke "div" (f64.const 0x1p-1074) (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x0p+0))
+(assert_return (invoke "div" (f64.const 0x1p-1074) (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x0p+0))
+(assert_return (invoke "div" (f64.const 0x1p-1074) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -0x0p+0))
+(assert_return (invoke "div" (f64.const 0x1p-1074) (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x0p+0))
+(assert_return (invoke "div" (f64.const 0x1p-1074) (f64.const -inf)) (f64.const -0x0p+0))
+(assert_return (invoke "div" (f64.const 0x1p-1074) (f64.const inf)) (f64.const 0x0p+0))
+(assert_return_canonical_nan (invoke "div" (f64.const 0x1p-1074) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "div" (f64.const 0x1p-1074) (f64.const nan)))
+(assert_return (invoke "div" (f64.const -0x1p-1022) (f64.const -0x0p+0)) (f64.const inf))
+(assert_return (invoke "div" (f64.const -0x1p-1022) (f64.const 0x0p+0)) (f64.const -inf))
+(assert_return (invoke "div" (f64.const -0x1p-1022) (f64.const -0x1p-1074)) (f64.const 0x1p+52))
+(assert_return (invoke "div" (f64.const -0x1p-1022) (f64.const 0x1p-1074)) (f64.const -0x1p+52))
+(assert_return (invoke "div" (f64.const -0x1p-1022) (f64.const -0x1p-1022)) (f64.const 0x1p+0))
+(assert_return (invoke "div" (f64.const -0x1p-1022) (f64.const 0x1p-1022)) (f64.const -0x1p+0))
+(assert_return (invoke "div" (f64.const -0x1p-1022) (f64.const -0x1p-1)) (f64.const 0x1p-1021))
+(assert_return (invoke "div" (f64.const -0x1p-1022) (f64.const 0x1p-1)) (f64.const -0x1p-1021))
+(assert_return (invoke "div" (f64.const -0x1p-1022) (f64.const -0x1p+0)) (f64.const 0x1p-1022))
+(assert_return (invoke "div" (f64.const -0x1p-1022) (f64.const 0x1p+0)) (f64.const -0x1p-1022))
+(assert_return (invoke "div" (f64.const -0x1p-1022) (f64.const -0x1.921fb54442d18p+2)) (f64.const 0x1.45f306dc9c88p-1025))
+(assert_return (invoke "div" (f64.const -0x1p-1022) (f64.const 0x1.921fb54442d18p+2)) (f64.const -0x1.45f306dc9c88p-1025))
+(assert_return (invoke "div" (f64.const -0x1p-1022) (f64.const -0x1.fffffffffffffp+1023)) (f64.const 0x0p+0))
+(assert_return (invoke "div" (f64.const -0x1p-1022) (f64.const 0x1.fffffffffffffp+1023)) (f64.const -0x0p+0))
+(assert_return (invoke "div" (f64.const -0x1p-1022) (f64.const -inf)) (f64.const 0x0p+0))
+(assert_return (invoke "div" (f64.const -0x1p-1022) (f64.const inf)) (f64.const -0x0p+0))
+(assert_return_canonical_nan (invoke "div" (f64.const -0x1p-1022) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "div" (f64.const -0x1p-1022) (f64.const nan)))
+(assert_return (invoke "div" (f64.const 0x1p-1022) (f64.const -0x0p+0)) (f64.const -inf))
+(assert_return (invoke "div" (f64.const 0x1p-1022) (f64.const 0x0p+0)) (f64.const inf))
+(assert_return (invoke "div" (f64.const 0x1p-1022) (f64.const -0x1p-1074)) (f64.const -0x1p+52))
+(assert_return (invoke "div" (f64.const 0x1p-1022) (f64.const 0x1p-1074)) (f64.const 0x1p+52))
+(assert_return (invoke "div" (f64.const 0x1p-1022) (f64.const -0x1p-1022)) (f64.const -0x1p+0))
+(assert_return (invoke "div" (f64.const 0x1p-1022) (f64.const 0x1p-1022)) (f64.const 0x1p+0))
+(assert_return (invoke "div" (f64.const 0x1p-1022) (f64.const -0x1p-1)) (f64.const -0x1p-1021))
+(assert_return (invoke "div" (f64.const 0x1p-1022) (f64.const 0x1p-1)) (f64.const 0x1p-1021))
+(assert_return (invoke "div" (f64.const 0x1p-1022) (f64.const -0x1p+0)) (f64.const -0x1p-1022))
+(assert_return (invoke "div" (f64.const 0x1p-1022) (f64.const 0x1p+0)) (f64.const 0x1p-1022))
+(assert_return (invoke "div" (f64.const 0x1p-1022) (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x1.45f306dc9c88p-1025))
+(assert_return (invoke "div" (f64.const 0x1p-1022) (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x1.45f306dc9c88p-1025))
+(assert_return (invoke "div" (f64.const 0x1p-1022) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -0x0p+0))
+(assert_return (invoke "div" (f64.const 0x1p-1022) (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x0p+0))
+(assert_return (invoke "div" (f64.const 0x1p-1022) (f64.const -inf)) (f64.const -0x0p+0))
+(assert_return (invoke "div" (f64.const 0x1p-1022) (f64.const inf)) (f64.const 0x0p+0))
+(assert_return_canonical_nan (invoke "div" (f64.const 0x1p-1022) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "div" (f64.const 0x1p-1022) (f64.const nan)))
+(assert_return (invoke "div" (f64.const -0x1p-1) (f64.const -0x0p+0)) (f64.const inf))
+(assert_return (invoke "div" (f64.const -0x1p-1) (f64.const 0x0p+0)) (f64.const -inf))
+(assert_return (invoke "div" (f64.const -0x1p-1) (f64.const -0x1p-1074)) (f64.const inf))
+(assert_return (invoke "div" (f64.const -0x1p-1) (f64.const 0x1p-1074)) (f64.const -inf))
+(assert_return (invoke "div" (f64.const -0x1p-1) (f64.const -0x1p-1022)) (f64.const 0x1p+1021))
+(assert_return (invoke "div" (f64.const -0x1p-1) (f64.const 0x1p-1022)) (f64.const -0x1p+1021))
+(assert_return (invoke "div" (f64.const -0x1p-1) (f64.const -0x1p-1)) (f64.const 0x1p+0))
+(assert_return (invoke "div" (f64.const -0x1p-1) (f64.const 0x1p-1)) (f64.const -0x1p+0))
+(assert_return (invoke "div" (f64.const -0x1p-1) (f64.const -0x1p+0)) (f64.const 0x1p-1))
+(assert_return (invoke "div" (f64.const -0x1p-1) (f64.const 0x1p+0)) (f64.const -0x1p-1))
+(assert_return (invoke "div" (f64.const -0x1p-1) (f64.const -0x1.921fb54442d18p+2)) (f64.const 0x1.45f306dc9c883p-4))
+(assert_return (invoke "div" (f64.const -0x1p-1) (f64.const 0x1.921fb54442d18p+2)) (f64.const -0x1.45f306dc9c883p-4))
+(assert_return (invoke "div" (f64.const -0x1p-1) (f64.const -0x1.fffffffffffffp+1023)) (f64.const 0x1p-1025))
+(assert_return (invoke "div" (f64.const -0x1p-1) (f64.const 0x1.fffffffffffffp+1023)) (f64.const -0x1p-1025))
+(assert_return (invoke "div" (f64.const -0x1p-1) (f64.const -inf)) (f64.const 0x0p+0))
+(assert_return (invoke "div" (f64.const -0x1p-1) (f64.const inf)) (f64.const -0x0p+0))
+(assert_return_canonical_nan (invoke "div" (f64.const -0x1p-1) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "div" (f64.const -0x1p-1) (f64.const nan)))
+(assert_return (invoke "div" (f64.const 0x1p-1) (f64.const -0x0p+0)) (f64.const -inf))
+(assert_return (invoke "div" (f64.const 0x1p-1) (f64.const 0x0p+0)) (f64.const inf))
+(assert_return (invoke "div" (f64.const 0x1p-1) (f64.const -0x1p-1074)) (f64.const -inf))
+(assert_return (invoke "div" (f64.const 0x1p-1) (f64.const 0x1p-1074)) (f64.const inf))
+(assert_return (invoke "div" (f64.const 0x1p-1) (f64.const -0x1p-1022)) (f64.const -0x1p+1021))
+(assert_return (invoke "div" (f64.const 0x1p-1) (f64.const 0x1p-1022)) (f64.const 0x1p+1021))
+(assert_return (invoke "div" (f64.const 0x1p-1) (f64.const -0x1p-1)) (f64.const -0x1p+0))
+(assert_return (invoke "div" (f64.const 0x1p-1) (f64.const 0x1p-1)) (f64.const 0x1p+0))
+(assert_return (invoke "div" (f64.const 0x1p-1) (f64.const -0x1p+0)) (f64.const -0x1p-1))
+(assert_return (invoke "div" (f64.const 0x1p-1) (f64.const 0x1p+0)) (f64.const 0x1p-1))
+(assert_return (invoke "div" (f64.const 0x1p-1) (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x1.45f306dc9c883p-4))
+(assert_return (invoke "div" (f64.const 0x1p-1) (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x1.45f306dc9c883p-4))
+(assert_return (invoke "div" (f64.const 0x1p-1) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -0x1p-1025))
+(assert_return (invoke "div" (f64.const 0x1p-1) (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x1p-1025))
+(assert_return (invoke "div" (f64.const 0x1p-1) (f64.const -inf)) (f64.const -0x0p+0))
+(assert_return (invoke "div" (f64.const 0x1p-1) (f64.const inf)) (f64.const 0x0p+0))
+(assert_return_canonical_nan (invoke "div" (f64.const 0x1p-1) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "div" (f64.const 0x1p-1) (f64.const nan)))
+(assert_return (invoke "div" (f64.const -0x1p+0) (f64.const -0x0p+0)) (f64.const inf))
+(assert_return (invoke "div" (f64.const -0x1p+0) (f64.const 0x0p+0)) (f64.const -inf))
+(assert_return (invoke "div" (f64.const -0x1p+0) (f64.const -0x1p-1074)) (f64.const inf))
+(assert_return (invoke "div" (f64.const -0x1p+0) (f64.const 0x1p-1074)) (f64.const -inf))
+(assert_return (invoke "div" (f64.const -0x1p+0) (f64.const -0x1p-1022)) (f64.const 0x1p+1022))
+(assert_return (invoke "div" (f64.const -0x1p+0) (f64.const 0x1p-1022)) (f64.const -0x1p+1022))
+(assert_return (invoke "div" (f64.const -0x1p+0) (f64.const -0x1p-1)) (f64.const 0x1p+1))
+(assert_return (invoke "div" (f64.const -0x1p+0) (f64.const 0x1p-1)) (f64.const -0x1p+1))
+(assert_return (invoke "div" (f64.const -0x1p+0) (f64.const -0x1p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "div" (f64.const -0x1p+0) (f64.const 0x1p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "div" (f64.const -0x1p+0) (f64.const -0x1.921fb54442d18p+2)) (f64.const 0x1.45f306dc9c883p-3))
+(assert_return (invoke "div" (f64.const -0x1p+0) (f64.const 0x1.921fb54442d18p+2)) (f64.const -0x1.45f306dc9c883p-3))
+(assert_return (invoke "div" (f64.const -0x1p+0) (f64.const -0x1.fffffffffffffp+1023)) (f64.const 0x1p-1024))
+(assert_return (invoke "div" (f64.const -0x1p+0) (f64.const 0x1.fffffffffffffp+1023)) (f64.const -0x1p-1024))
+(assert_return (invoke "div" (f64.const -0x1p+0) (f64.const -inf)) (f64.const 0x0p+0))
+(assert_return (invoke "div" (f64.const -0x1p+0) (f64.const inf)) (f64.const -0x0p+0))
+(assert_return_canonical_nan (invoke "div" (f64.const -0x1p+0) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "div" (f64.const -0x1p+0) (f64.const nan)))
+(assert_return (invoke "div" (f64.const 0x1p+0) (f64.const -0x0p+0)) (f64.const -inf))
+(assert_return (invoke "div" (f64.const 0x1p+0) (f64.const 0x0p+0)) (f64.const inf))
+(assert_return (invoke "div" (f64.const 0x1p+0) (f64.const -0x1p-1074)) (f64.const -inf))
+(assert_return (invoke "div" (f64.const 0x1p+0) (f64.const 0x1p-1074)) (f64.const inf))
+(assert_return (invoke "div" (f64.const 0x1p+0) (f64.const -0x1p-1022)) (f64.const -0x1p+1022))
+(assert_return (invoke "div" (f64.const 0x1p+0) (f64.const 0x1p-1022)) (f64.const 0x1p+1022))
+(assert_return (invoke "div" (f64.const 0x1p+0) (f64.const -0x1p-1)) (f64.const -0x1p+1))
+(assert_return (invoke "div" (f64.const 0x1p+0) (f64.const 0x1p-1)) (f64.const 0x1p+1))
+(assert_return (invoke "div" (f64.const 0x1p+0) (f64.const -0x1p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "div" (f64.const 0x1p+0) (f64.const 0x1p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "div" (f64.const 0x1p+0) (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x1.45f306dc9c883p-3))
+(assert_return (invoke "div" (f64.const 0x1p+0) (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x1.45f306dc9c883p-3))
+(assert_return (invoke "div" (f64.const 0x1p+0) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -0x1p-1024))
+(assert_return (invoke "div" (f64.const 0x1p+0) (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x1p-1024))
+(assert_return (invoke "div" (f64.const 0x1p+0) (f64.const -inf)) (f64.const -0x0p+0))
+(assert_return (invoke "div" (f64.const 0x1p+0) (f64.const inf)) (f64.const 0x0p+0))
+(assert_return_canonical_nan (invoke "div" (f64.const 0x1p+0) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "div" (f64.const 0x1p+0) (f64.const nan)))
+(assert_return (invoke "div" (f64.const -0x1.921fb54442d18p+2) (f64.const -0x0p+0)) (f64.const inf))
+(assert_return (invoke "div" (f64.const -0x1.921fb54442d18p+2) (f64.const 0x0p+0)) (f64.const -inf))
+(assert_return (invoke "div" (f64.const -0x1.921fb54442d18p+2) (f64.const -0x1p-1074)) (f64.const inf))
+(assert_return (invoke "div" (f64.const -0x1.921fb54442d18p+2) (f64.const 0x1p-1074)) (f64.const -inf))
+(assert_return (invoke "div" (f64.const -0x1.921fb54442d18p+2) (f64.const -0x1p-1022)) (f64.const inf))
+(assert_return (invoke "div" (f64.const -0x1.921fb54442d18p+2) (f64.const 0x1p-1022)) (f64.const -inf))
+(assert_return (invoke "div" (f64.const -0x1.921fb54442d18p+2) (f64.const -0x1p-1)) (f64.const 0x1.921fb54442d18p+3))
+(assert_return (invoke "div" (f64.const -0x1.921fb54442d18p+2) (f64.const 0x1p-1)) (f64.const -0x1.921fb54442d18p+3))
+(assert_return (invoke "div" (f64.const -0x1.921fb54442d18p+2) (f64.const -0x1p+0)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "div" (f64.const -0x1.921fb54442d18p+2) (f64.const 0x1p+0)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "div" (f64.const -0x1.921fb54442d18p+2) (f64.const -0x1.921fb54442d18p+2)) (f64.const 0x1p+0))
+(assert_return (invoke "div" (f64.const -0x1.921fb54442d18p+2) (f64.const 0x1.921fb54442d18p+2)) (f64.const -0x1p+0))
+(assert_return (invoke "div" (f64.const -0x1.921fb54442d18p+2) (f64.const -0x1.fffffffffffffp+1023)) (f64.const 0x1.921fb54442d19p-1022))
+(assert_return (invoke "div" (f64.const -0x1.921fb54442d18p+2) (f64.const 0x1.fffffffffffffp+1023)) (f64.const -0x1.921fb54442d19p-1022))
+(assert_return (invoke "div" (f64.const -0x1.921fb54442d18p+2) (f64.const -inf)) (f64.const 0x0p+0))
+(assert_return (invoke "div" (f64.const -0x1.921fb54442d18p+2) (f64.const inf)) (f64.const -0x0p+0))
+(assert_return_canonical_nan (invoke "div" (f64.const -0x1.921fb54442d18p+2) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "div" (f64.const -0x1.921fb54442d18p+2) (f64.const nan)))
+(assert_return (invoke "div" (f64.const 0x1.921fb54442d18p+2) (f64.const -0x0p+0)) (f64.const -inf))
+(assert_return (invoke "div" (f64.const 0x1.921fb54442d18p+2) (f64.const 0x0p+0)) (f64.const inf))
+(assert_return (invoke "div" (f64.const 0x1.921fb54442d18p+2) (f64.const -0x1p-1074)) (f64.const -inf))
+(assert_return (invoke "div" (f64.const 0x1.921fb54442d18p+2) (f64.const 0x1p-1074)) (f64.const inf))
+(assert_return (invoke "div" (f64.const 0x1.921fb54442d18p+2) (f64.const -0x1p-1022)) (f64.const -inf))
+(assert_return (invoke "div" (f64.const 0x1.921fb54442d18p+2) (f64.const 0x1p-1022)) (f64.const inf))
+(assert_return (invoke "div" (f64.const 0x1.921fb54442d18p+2) (f64.const -0x1p-1)) (f64.const -0x1.921fb54442d18p+3))
+(assert_return (invoke "div" (f64.const 0x1.921fb54442d18p+2) (f64.const 0x1p-1)) (f64.const 0x1.921fb54442d18p+3))
+(assert_return (invoke "div" (f64.const 0x1.921fb54442d18p+2) (f64.const -0x1p+0)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "div" (f64.const 0x1.921fb54442d18p+2) (f64.const 0x1p+0)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "div" (f64.const 0x1.921fb54442d18p+2) (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x1p+0))
+(assert_return (invoke "div" (f64.const 0x1.921fb54442d18p+2) (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x1p+0))
+(assert_return (invoke "div" (f64.const 0x1.921fb54442d18p+2) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -0x1.921fb54442d19p-1022))
+(assert_return (invoke "div" (f64.const 0x1.921fb54442d18p+2) (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x1.921fb54442d19p-1022))
+(assert_return (invoke "div" (f64.const 0x1.921fb54442d18p+2) (f64.const -inf)) (f64.const -0x0p+0))
+(assert_return (invoke "div" (f64.const 0x1.921fb54442d18p+2) (f64.const inf)) (f64.const 0x0p+0))
+(assert_return_canonical_nan (invoke "div" (f64.const 0x1.921fb54442d18p+2) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "div" (f64.const 0x1.921fb54442d18p+2) (f64.const nan)))
+(assert_return (invoke "div" (f64.const -0x1.fffffffffffffp+1023) (f64.const -0x0p+0)) (f64.const inf))
+(assert_return (invoke "div" (f64.const -0x1.fffffffffffffp+1023) (f64.const 0x0p+0)) (f64.const -inf))
+(assert_return (invoke "div" (f64.const -0x1.fffffffffffffp+1023) (f64.const -0x1p-1074)) (f64.const inf))
+(assert_return (invoke "div" (f64.const -0x1.fffffffffffffp+1023) (f64.const 0x1p-1074)) (f64.const -inf))
+(assert_return (invoke "div" (f64.const -0x1.fffffffffffffp+1023) (f64.const -0x1p-1022)) (f64.const inf))
+(assert_return (invoke "div" (f64.const -0x1.fffffffffffffp+1023) (f64.const 0x1p-1022)) (f64.const -inf))
+(assert_return (invoke "div" (f64.const -0x1.fffffffffffffp+1023) (f64.const -0x1p-1)) (f64.const inf))
+(assert_return (invoke "div" (f64.const -0x1.fffffffffffffp+1023) (f64.const 0x1p-1)) (f64.const -inf))
+(assert_return (invoke "div" (f64.const -0x1.fffffffffffffp+1023) (f64.const -0x1p+0)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "div" (f64.const -0x1.fffffffffffffp+1023) (f64.const 0x1p+0)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "div" (f64.const -0x1.fffffffffffffp+1023) (f64.const -0x1.921fb54442d18p+2)) (f64.const 0x1.45f306dc9c882p+1021))
+(assert_return (invoke "div" (f64.const -0x1.fffffffffffffp+1023) (f64.const 0x1.921fb54442d18p+2)) (f64.const -0x1.45f306dc9c882p+1021))
+(assert_return (invoke "div" (f64.const -0x1.fffffffffffffp+1023) (f64.const -0x1.fffffffffffffp+1023)) (f64.const 0x1p+0))
+(assert_return (invoke "div" (f64.const -0x1.fffffffffffffp+1023) (f64.const 0x1.fffffffffffffp+1023)) (f64.const -0x1p+0))
+(assert_return (invoke "div" (f64.const -0x1.fffffffffffffp+1023) (f64.const -inf)) (f64.const 0x0p+0))
+(assert_return (invoke "div" (f64.const -0x1.fffffffffffffp+1023) (f64.const inf)) (f64.const -0x0p+0))
+(assert_return_canonical_nan (invoke "div" (f64.const -0x1.fffffffffffffp+1023) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "div" (f64.const -0x1.fffffffffffffp+1023) (f64.const nan)))
+(assert_return (invoke "div" (f64.const 0x1.fffffffffffffp+1023) (f64.const -0x0p+0)) (f64.const -inf))
+(assert_return (invoke "div" (f64.const 0x1.fffffffffffffp+1023) (f64.const 0x0p+0)) (f64.const inf))
+(assert_return (invoke "div" (f64.const 0x1.fffffffffffffp+1023) (f64.const -0x1p-1074)) (f64.const -inf))
+(assert_return (invoke "div" (f64.const 0x1.fffffffffffffp+1023) (f64.const 0x1p-1074)) (f64.const inf))
+(assert_return (invoke "div" (f64.const 0x1.fffffffffffffp+1023) (f64.const -0x1p-1022)) (f64.const -inf))
+(assert_return (invoke "div" (f64.const 0x1.fffffffffffffp+1023) (f64.const 0x1p-1022)) (f64.const inf))
+(assert_return (invoke "div" (f64.const 0x1.fffffffffffffp+1023) (f64.const -0x1p-1)) (f64.const -inf))
+(assert_return (invoke "div" (f64.const 0x1.fffffffffffffp+1023) (f64.const 0x1p-1)) (f64.const inf))
+(assert_return (invoke "div" (f64.const 0x1.fffffffffffffp+1023) (f64.const -0x1p+0)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "div" (f64.const 0x1.fffffffffffffp+1023) (f64.const 0x1p+0)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "div" (f64.const 0x1.fffffffffffffp+1023) (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x1.45f306dc9c882p+1021))
+(assert_return (invoke "div" (f64.const 0x1.fffffffffffffp+1023) (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x1.45f306dc9c882p+1021))
+(assert_return (invoke "div" (f64.const 0x1.fffffffffffffp+1023) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -0x1p+0))
+(assert_return (invoke "div" (f64.const 0x1.fffffffffffffp+1023) (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x1p+0))
+(assert_return (invoke "div" (f64.const 0x1.fffffffffffffp+1023) (f64.const -inf)) (f64.const -0x0p+0))
+(assert_return (invoke "div" (f64.const 0x1.fffffffffffffp+1023) (f64.const inf)) (f64.const 0x0p+0))
+(assert_return_canonical_nan (invoke "div" (f64.const 0x1.fffffffffffffp+1023) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "div" (f64.const 0x1.fffffffffffffp+1023) (f64.const nan)))
+(assert_return (invoke "div" (f64.const -inf) (f64.const -0x0p+0)) (f64.const inf))
+(assert_return (invoke "div" (f64.const -inf) (f64.const 0x0p+0)) (f64.const -inf))
+(assert_return (invoke "div" (f64.const -inf) (f64.const -0x1p-1074)) (f64.const inf))
+(assert_return (invoke "div" (f64.const -inf) (f64.const 0x1p-1074)) (f64.const -inf))
+(assert_return (invoke "div" (f64.const -inf) (f64.const -0x1p-1022)) (f64.const inf))
+(assert_return (invoke "div" (f64.const -inf) (f64.const 0x1p-1022)) (f64.const -inf))
+(assert_return (invoke "div" (f64.const -inf) (f64.const -0x1p-1)) (f64.const inf))
+(assert_return (invoke "div" (f64.const -inf) (f64.const 0x1p-1)) (f64.const -inf))
+(assert_return (invoke "div" (f64.const -inf) (f64.const -0x1p+0)) (f64.const inf))
+(assert_return (invoke "div" (f64.const -inf) (f64.const 0x1p+0)) (f64.const -inf))
+(assert_return (invoke "div" (f64.const -inf) (f64.const -0x1.921fb54442d18p+2)) (f64.const inf))
+(assert_return (invoke "div" (f64.const -inf) (f64.const 0x1.921fb54442d18p+2)) (f64.const -inf))
+(assert_return (invoke "div" (f64.const -inf) (f64.const -0x1.fffffffffffffp+1023)) (f64.const inf))
+(assert_return (invoke "div" (f64.const -inf) (f64.const 0x1.fffffffffffffp+1023)) (f64.const -inf))
+(assert_return_canonical_nan (invoke "div" (f64.const -inf) (f64.const -inf)))
+(assert_return_canonical_nan (invoke "div" (f64.const -inf) (f64.const inf)))
+(assert_return_canonical_nan (invoke "div" (f64.const -inf) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "div" (f64.const -inf) (f64.const nan)))
+(assert_return (invoke "div" (f64.const inf) (f64.const -0x0p+0)) (f64.const -inf))
+(assert_return (invoke "div" (f64.const inf) (f64.const 0x0p+0)) (f64.const inf))
+(assert_return (invoke "div" (f64.const inf) (f64.const -0x1p-1074)) (f64.const -inf))
+(assert_return (invoke "div" (f64.const inf) (f64.const 0x1p-1074)) (f64.const inf))
+(assert_return (invoke "div" (f64.const inf) (f64.const -0x1p-1022)) (f64.const -inf))
+(assert_return (invoke "div" (f64.const inf) (f64.const 0x1p-1022)) (f64.const inf))
+(assert_return (invoke "div" (f64.const inf) (f64.const -0x1p-1)) (f64.const -inf))
+(assert_return (invoke "div" (f64.const inf) (f64.const 0x1p-1)) (f64.const inf))
+(assert_return (invoke "div" (f64.const inf) (f64.const -0x1p+0)) (f64.const -inf))
+(assert_return (invoke "div" (f64.const inf) (f64.const 0x1p+0)) (f64.const inf))
+(assert_return (invoke "div" (f64.const inf) (f64.const -0x1.921fb54442d18p+2)) (f64.const -inf))
+(assert_return (invoke "div" (f64.const inf) (f64.const 0x1.921fb54442d18p+2)) (f64.const inf))
+(assert_return (invoke "div" (f64.const inf) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -inf))
+(assert_return (invoke "div" (f64.const inf) (f64.const 0x1.fffffffffffffp+1023)) (f64.const inf))
+(assert_return_canonical_nan (invoke "div" (f64.const inf) (f64.const -inf)))
+(assert_return_canonical_nan (invoke "div" (f64.const inf) (f64.const inf)))
+(assert_return_canonical_nan (invoke "div" (f64.const inf) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "div" (f64.const inf) (f64.const nan)))
+(assert_return_canonical_nan (invoke "div" (f64.const -nan) (f64.const -0x0p+0)))
+(assert_return_canonical_nan (invoke "div" (f64.const -nan) (f64.const 0x0p+0)))
+(assert_return_canonical_nan (invoke "div" (f64.const -nan) (f64.const -0x1p-1074)))
+(assert_return_canonical_nan (invoke "div" (f64.const -nan) (f64.const 0x1p-1074)))
+(assert_return_canonical_nan (invoke "div" (f64.const -nan) (f64.const -0x1p-1022)))
+(assert_return_canonical_nan (invoke "div" (f64.const -nan) (f64.const 0x1p-1022)))
+(assert_return_canonical_nan (invoke "div" (f64.const -nan) (f64.const -0x1p-1)))
+(assert_return_canonical_nan (invoke "div" (f64.const -nan) (f64.const 0x1p-1)))
+(assert_return_canonical_nan (invoke "div" (f64.const -nan) (f64.const -0x1p+0)))
+(assert_return_canonical_nan (invoke "div" (f64.const -nan) (f64.const 0x1p+0)))
+(assert_return_canonical_nan (invoke "div" (f64.const -nan) (f64.const -0x1.921fb54442d18p+2)))
+(assert_return_canonical_nan (invoke "div" (f64.const -nan) (f64.const 0x1.921fb54442d18p+2)))
+(assert_return_canonical_nan (invoke "div" (f64.const -nan) (f64.const -0x1.fffffffffffffp+1023)))
+(assert_return_canonical_nan (invoke "div" (f64.const -nan) (f64.const 0x1.fffffffffffffp+1023)))
+(assert_return_canonical_nan (invoke "div" (f64.const -nan) (f64.const -inf)))
+(assert_return_canonical_nan (invoke "div" (f64.const -nan) (f64.const inf)))
+(assert_return_canonical_nan (invoke "div" (f64.const -nan) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "div" (f64.const -nan) (f64.const nan)))
+(assert_return_canonical_nan (invoke "div" (f64.const nan) (f64.const -0x0p+0)))
+(assert_return_canonical_nan (invoke "div" (f64.const nan) (f64.const 0x0p+0)))
+(assert_return_canonical_nan (invoke "div" (f64.const nan) (f64.const -0x1p-1074)))
+(assert_return_canonical_nan (invoke "div" (f64.const nan) (f64.const 0x1p-1074)))
+(assert_return_canonical_nan (invoke "div" (f64.const nan) (f64.const -0x1p-1022)))
+(assert_return_canonical_nan (invoke "div" (f64.const nan) (f64.const 0x1p-1022)))
+(assert_return_canonical_nan (invoke "div" (f64.const nan) (f64.const -0x1p-1)))
+(assert_return_canonical_nan (invoke "div" (f64.const nan) (f64.const 0x1p-1)))
+(assert_return_canonical_nan (invoke "div" (f64.const nan) (f64.const -0x1p+0)))
+(assert_return_canonical_nan (invoke "div" (f64.const nan) (f64.const 0x1p+0)))
+(assert_return_canonical_nan (invoke "div" (f64.const nan) (f64.const -0x1.921fb54442d18p+2)))
+(assert_return_canonical_nan (invoke "div" (f64.const nan) (f64.const 0x1.921fb54442d18p+2)))
+(assert_return_canonical_nan (invoke "div" (f64.const nan) (f64.const -0x1.fffffffffffffp+1023)))
+(assert_return_canonical_nan (invoke "div" (f64.const nan) (f64.const 0x1.fffffffffffffp+1023)))
+(assert_return_canonical_nan (invoke "div" (f64.const nan) (f64.const -inf)))
+(assert_return_canonical_nan (invoke "div" (f64.const nan) (f64.const inf)))
+(assert_return_canonical_nan (invoke "div" (f64.const nan) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "div" (f64.const nan) (f64.const nan)))
+(assert_return_arithmetic_nan (invoke "div" (f64.const nan:0xc000000000000) (f64.const 0x1p+0)))
+(assert_return_arithmetic_nan (invoke "div" (f64.const 0x1p+0) (f64.const nan:0xc000000000000)))
+(assert_return_arithmetic_nan (invoke "div" (f64.const -nan:0xc000000000000) (f64.const 0x1p+0)))
+(assert_return_arithmetic_nan (invoke "div" (f64.const 0x1p+0) (f64.const -nan:0xc000000000000)))
+(assert_return (invoke "div" (f64.const 0x1.dc45b8b5a834dp-514) (f64.const -0x1.e36953ded6bfbp-368)) (f64.const -0x1.f8704f8d14402p-147))
+(assert_return (invoke "div" (f64.const 0x1.fcb5725ca8d9ep+376) (f64.const -0x1.6b52b76fcdf16p+25)) (f64.const -0x1.667093b84b803p+351))
+(assert_return (invoke "div" (f64.const -0x1.bbc6c6cb49ba4p-23) (f64.const -0x1.cd188ec3d69c6p+3)) (f64.const 0x1.ecc4bcfec23cdp-27))
+(assert_return (invoke "div" (f64.const 0x1.53852b852f683p+539) (f64.const 0x1.e7c4931e85673p-4)) (f64.const 0x1.64632ef0399dp+542))
+(assert_return (invoke "div" (f64.const -0x1.e47edf57951cfp-930) (f64.const -0x1.8c94b7c979119p+464)) (f64.const 0x0p+0))
+(assert_return (invoke "div" (f64.const -0x1.baee950da090fp+16) (f64.const 0x1.d40b6ef43bfcep+12)) (f64.const -0x1.e48765e68793cp+3))
+(assert_return (invoke "div" (f64.const 0x1.96e449261a31cp-264) (f64.const -0x1.ba94988381783p-16)) (f64.const -0x1.d6b6a4a617cbdp-249))
+(assert_return (invoke "div" (f64.const -0x1.f0704d44e5797p-14) (f64.const -0x1.11848371ac396p+11)) (f64.const 0x1.d0a4d7656d576p-25))
+(assert_return (invoke "div" (f64.const -0x1.956405859b8fep-838) (f64.const 0x1.dbe2136ea28bep-8)) (f64.const -0x1.b42859a28b6dp-831))
+(assert_return (invoke "div" (f64.const 0x1.eabd4279de4d9p-23) (f64.const 0x1.2ec917a8903c6p+868)) (f64.const 0x1.9ee96676e3055p-891))
+(assert_return (invoke "div" (f64.const -0x1.a51eec37a2edcp+168) (f64.const 0x1.eabd479333232p-616)) (f64.const -0x1.b75d836c7feaep+783))
+(assert_return (invoke "div" (f64.const -0x1.462c4241e522bp+8) (f64.const -0x1.c28d14757b3a8p-4)) (f64.const 0x1.72a8889260723p+11))
+(assert_return (invoke "div" (f64.const -0x1.6f19881e0211bp+22) (f64.const 0x1.a9de63da64183p+8)) (f64.const -0x1.b95857a10ab98p+13))
+(assert_return (invoke "div" (f64.const 0x1.158e8afcd82b8p+18) (f64.const -0x1.d5f8248a04723p+15)) (f64.const -0x1.2e612722fbcf4p+2))
+(assert_return (invoke "div" (f64.const -0x1.c50e7f86dfe8p+727) (f64.const 0x1.d4bb7c9fe3506p-20)) (f64.const -0x1.eee094d5f89f1p+746))
+(assert_return (invoke "div" (f64.const 0x1.d85b5ac7a07f1p+773) (f64.const -0x1.9545aeecf0ba3p-29)) (f64.const -0x1.2a602a5667295p+802))
+(assert_return (invoke "div" (f64.const 0x1.c0c8fbb267165p+9) (f64.const -0x1.2b8c2c1123916p-6)) (f64.const -0x1.7f8aae8517cf4p+15))
+(assert_return (invoke "div" (f64.const 0x1.7553e74591f6p+701) (f64.const 0x1.1b5651e998ea4p+58)) (f64.const 0x1.514ed9e0032f5p+643))
+(assert_return (invoke "div" (f64.const 0x1.a7abe2da0a174p-700) (f64.const -0x1.361cb16bb02e3p+6)) (f64.const -0x1.5dbe80843ff55p-706))
+(assert_return (invoke "div" (f64.const -0x1.23e639857e82p+395) (f64.const 0x1.2810b6eb27844p+7)) (f64.const -0x1.f8cbaaeb166ccp+387))
+(assert_return (invoke "div" (f64.const -0x1.385bf9449a67p-14) (f64.const -0x1.b67312774cd85p+492)) (f64.const 0x1.6cc209f875f2ap-507))
+(assert_return (invoke "div" (f64.const 0x1.dce42690952bdp-184) (f64.const 0x1.9dff9f8844bf1p-892)) (f64.const 0x1.26e3eac854a1p+708))
+(assert_return (invoke "div" (f64.const 0x1.dd6127f4c3dbbp+411) (f64.const 0x1.39d04b51704e2p-883)) (f64.const inf))
+(assert_return (invoke "div" (f64.const 0x1.c5ee4585f8ebcp-11) (f64.const -0x1.4a61d790d04c7p-3)) (f64.const -0x1.5fbb9c20cd4cep-8))
+
+(assert_return (invoke "min" (f64.const -0x0p+0) (f64.const -0x0p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "min" (f64.const -0x0p+0) (f64.const 0x0p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "min" (f64.const -0x0p+0) (f64.const -0x1p-1074)) (f64.const -0x1p-1074))
+(assert_return (invoke "min" (f64.const -0x0p+0) (f64.const 0x1p-1074)) (f64.const -0x0p+0))
+(assert_return (invoke "min" (f64.const -0x0p+0) (f64.const -0x1p-1022)) (f64.const -0x1p-1022))
+(assert_return (invoke "min" (f64.const -0x0p+0) (f64.const 0x1p-1022)) (f64.const -0x0p+0))
+(assert_return (invoke "min" (f64.const -0x0p+0) (f64.const -0x1p-1)) (f64.const -0x1p-1))
+(assert_return (invoke "min" (f64.const -0x0p+0) (f64.const 0x1p-1)) (f64.const -0x0p+0))
+(assert_return (invoke "min" (f64.const -0x0p+0) (f64.const -0x1p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "min" (f64.const -0x0p+0) (f64.const 0x1p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "min" (f64.const -0x0p+0) (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "min" (f64.const -0x0p+0) (f64.const 0x1.921fb54442d18p+2)) (f64.const -0x0p+0))
+(assert_return (invoke "min" (f64.const -0x0p+0) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "min" (f64.const -0x0p+0) (f64.const 0x1.fffffffffffffp+1023)) (f64.const -0x0p+0))
+(assert_return (invoke "min" (f64.const -0x0p+0) (f64.const -inf)) (f64.const -inf))
+(assert_return (invoke "min" (f64.const -0x0p+0) (f64.const inf)) (f64.const -0x0p+0))
+(assert_return_canonical_nan (invoke "min" (f64.const -0x0p+0) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "min" (f64.const -0x0p+0) (f64.const nan)))
+(assert_return (invoke "min" (f64.const 0x0p+0) (f64.const -0x0p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "min" (f64.const 0x0p+0) (f64.const 0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "min" (f64.const 0x0p+0) (f64.const -0x1p-1074)) (f64.const -0x1p-1074))
+(assert_return (invoke "min" (f64.const 0x0p+0) (f64.const 0x1p-1074)) (f64.const 0x0p+0))
+(assert_return (invoke "min" (f64.const 0x0p+0) (f64.const -0x1p-1022)) (f64.const -0x1p-1022))
+(assert_return (invoke "min" (f64.const 0x0p+0) (f64.const 0x1p-1022)) (f64.const 0x0p+0))
+(assert_return (invoke "min" (f64.const 0x0p+0) (f64.const -0x1p-1)) (f64.const -0x1p-1))
+(assert_return (invoke "min" (f64.const 0x0p+0) (f64.const 0x1p-1)) (f64.const 0x0p+0))
+(assert_return (invoke "min" (f64.const 0x0p+0) (f64.const -0x1p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "min" (f64.const 0x0p+0) (f64.const 0x1p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "min" (f64.const 0x0p+0) (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "min" (f64.const 0x0p+0) (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x0p+0))
+(assert_return (invoke "min" (f64.const 0x0p+0) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "min" (f64.const 0x0p+0) (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x0p+0))
+(assert_return (invoke "min" (f64.const 0x0p+0) (f64.const -inf)) (f64.const -inf))
+(assert_return (invoke "min" (f64.const 0x0p+0) (f64.const inf)) (f64.const 0x0p+0))
+(assert_return_canonical_nan (invoke "min" (f64.const 0x0p+0) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "min" (f64.const 0x0p+0) (f64.const nan)))
+(assert_return (invoke "min" (f64.const -0x1p-1074) (f64.const -0x0p+0)) (f64.const -0x1p-1074))
+(assert_return (invoke "min" (f64.const -0x1p-1074) (f64.const 0x0p+0)) (f64.const -0x1p-1074))
+(assert_return (invoke "min" (f64.const -0x1p-1074) (f64.const -0x1p-1074)) (f64.const -0x1p-1074))
+(assert_return (invoke "min" (f64.const -0x1p-1074) (f64.const 0x1p-1074)) (f64.const -0x1p-1074))
+(assert_return (invoke "min" (f64.const -0x1p-1074) (f64.const -0x1p-1022)) (f64.const -0x1p-1022))
+(assert_return (invoke "min" (f64.const -0x1p-1074) (f64.const 0x1p-1022)) (f64.const -0x1p-1074))
+(assert_return (invoke "min" (f64.const -0x1p-1074) (f64.const -0x1p-1)) (f64.const -0x1p-1))
+(assert_return (invoke "min" (f64.const -0x1p-1074) (f64.const 0x1p-1)) (f64.const -0x1p-1074))
+(assert_return (invoke "min" (f64.const -0x1p-1074) (f64.const -0x1p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "min" (f64.const -0x1p-1074) (f64.const 0x1p+0)) (f64.const -0x1p-1074))
+(assert_return (invoke "min" (f64.const -0x1p-1074) (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "min" (f64.const -0x1p-1074) (f64.const 0x1.921fb54442d18p+2)) (f64.const -0x1p-1074))
+(assert_return (invoke "min" (f64.const -0x1p-1074) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "min" (f64.const -0x1p-1074) (f64.const 0x1.fffffffffffffp+1023)) (f64.const -0x1p-1074))
+(assert_return (invoke "min" (f64.const -0x1p-1074) (f64.const -inf)) (f64.const -inf))
+(assert_return (invoke "min" (f64.const -0x1p-1074) (f64.const inf)) (f64.const -0x1p-1074))
+(assert_return_canonical_nan (invoke "min" (f64.const -0x1p-1074) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "min" (f64.const -0x1p-1074) (f64.const nan)))
+(assert_return (invoke "min" (f64.const 0x1p-1074) (f64.const -0x0p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "min" (f64.const 0x1p-1074) (f64.const 0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "min" (f64.const 0x1p-1074) (f64.const -0x1p-1074)) (f64.const -0x1p-1074))
+(assert_return (invoke "min" (f64.const 0x1p-1074) (f64.const 0x1p-1074)) (f64.const 0x1p-1074))
+(assert_return (invoke "min" (f64.const 0x1p-1074) (f64.const -0x1p-1022)) (f64.const -0x1p-1022))
+(assert_return (invoke "min" (f64.const 0x1p-1074) (f64.const 0x1p-1022)) (f64.const 0x1p-1074))
+(assert_return (invoke "min" (f64.const 0x1p-1074) (f64.const -0x1p-1)) (f64.const -0x1p-1))
+(assert_return (invoke "min" (f64.const 0x1p-1074) (f64.const 0x1p-1)) (f64.const 0x1p-1074))
+(assert_return (invoke "min" (f64.const 0x1p-1074) (f64.const -0x1p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "min" (f64.const 0x1p-1074) (f64.const 0x1p+0)) (f64.const 0x1p-1074))
+(assert_return (invoke "min" (f64.const 0x1p-1074) (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "min" (f64.const 0x1p-1074) (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x1p-1074))
+(assert_return (invoke "min" (f64.const 0x1p-1074) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "min" (f64.const 0x1p-1074) (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x1p-1074))
+(assert_return (invoke "min" (f64.const 0x1p-1074) (f64.const -inf)) (f64.const -inf))
+(assert_return (invoke "min" (f64.const 0x1p-1074) (f64.const inf)) (f64.const 0x1p-1074))
+(assert_return_canonical_nan (invoke "min" (f64.const 0x1p-1074) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "min" (f64.const 0x1p-1074) (f64.const nan)))
+(assert_return (invoke "min" (f64.const -0x1p-1022) (f64.const -0x0p+0)) (f64.const -0x1p-1022))
+(assert_return (invoke "min" (f64.const -0x1p-1022) (f64.const 0x0p+0)) (f64.const -0x1p-1022))
+(assert_return (invoke "min" (f64.const -0x1p-1022) (f64.const -0x1p-1074)) (f64.const -0x1p-1022))
+(assert_return (invoke "min" (f64.const -0x1p-1022) (f64.const 0x1p-1074)) (f64.const -0x1p-1022))
+(assert_return (invoke "min" (f64.const -0x1p-1022) (f64.const -0x1p-1022)) (f64.const -0x1p-1022))
+(assert_return (invoke "min" (f64.const -0x1p-1022) (f64.const 0x1p-1022)) (f64.const -0x1p-1022))
+(assert_return (invoke "min" (f64.const -0x1p-1022) (f64.const -0x1p-1)) (f64.const -0x1p-1))
+(assert_return (invoke "min" (f64.const -0x1p-1022) (f64.const 0x1p-1)) (f64.const -0x1p-1022))
+(assert_return (invoke "min" (f64.const -0x1p-1022) (f64.const -0x1p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "min" (f64.const -0x1p-1022) (f64.const 0x1p+0)) (f64.const -0x1p-1022))
+(assert_return (invoke "min" (f64.const -0x1p-1022) (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "min" (f64.const -0x1p-1022) (f64.const 0x1.921fb54442d18p+2)) (f64.const -0x1p-1022))
+(assert_return (invoke "min" (f64.const -0x1p-1022) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "min" (f64.const -0x1p-1022) (f64.const 0x1.fffffffffffffp+1023)) (f64.const -0x1p-1022))
+(assert_return (invoke "min" (f64.const -0x1p-1022) (f64.const -inf)) (f64.const -inf))
+(assert_return (invoke "min" (f64.const -0x1p-1022) (f64.const inf)) (f64.const -0x1p-1022))
+(assert_return_canonical_nan (invoke "min" (f64.const -0x1p-1022) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "min" (f64.const -0x1p-1022) (f64.const nan)))
+(assert_return (invoke "min" (f64.const 0x1p-1022) (f64.const -0x0p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "min" (f64.const 0x1p-1022) (f64.const 0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "min" (f64.const 0x1p-1022) (f64.const -0x1p-1074)) (f64.const -0x1p-1074))
+(assert_return (invoke "min" (f64.const 0x1p-1022) (f64.const 0x1p-1074)) (f64.const 0x1p-1074))
+(assert_return (invoke "min" (f64.const 0x1p-1022) (f64.const -0x1p-1022)) (f64.const -0x1p-1022))
+(assert_return (invoke "min" (f64.const 0x1p-1022) (f64.const 0x1p-1022)) (f64.const 0x1p-1022))
+(assert_return (invoke "min" (f64.const 0x1p-1022) (f64.const -0x1p-1)) (f64.const -0x1p-1))
+(assert_return (invoke "min" (f64.const 0x1p-1022) (f64.const 0x1p-1)) (f64.const 0x1p-1022))
+(assert_return (invoke "min" (f64.const 0x1p-1022) (f64.const -0x1p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "min" (f64.const 0x1p-1022) (f64.const 0x1p+0)) (f64.const 0x1p-1022))
+(assert_return (invoke "min" (f64.const 0x1p-1022) (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "min" (f64.const 0x1p-1022) (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x1p-1022))
+(assert_return (invoke "min" (f64.const 0x1p-1022) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "min" (f64.const 0x1p-1022) (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x1p-1022))
+(assert_return (invoke "min" (f64.const 0x1p-1022) (f64.const -inf)) (f64.const -inf))
+(assert_return (invoke "min" (f64.const 0x1p-1022) (f64.const inf)) (f64.const 0x1p-1022))
+(assert_return_canonical_nan (invoke "min" (f64.const 0x1p-1022) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "min" (f64.const 0x1p-1022) (f64.const nan)))
+(assert_return (invoke "min" (f64.const -0x1p-1) (f64.const -0x0p+0)) (f64.const -0x1p-1))
+(assert_return (invoke "min" (f64.const -0x1p-1) (f64.const 0x0p+0)) (f64.const -0x1p-1))
+(assert_return (invoke "min" (f64.const -0x1p-1) (f64.const -0x1p-1074)) (f64.const -0x1p-1))
+(assert_return (invoke "min" (f64.const -0x1p-1) (f64.const 0x1p-1074)) (f64.const -0x1p-1))
+(assert_return (invoke "min" (f64.const -0x1p-1) (f64.const -0x1p-1022)) (f64.const -0x1p-1))
+(assert_return (invoke "min" (f64.const -0x1p-1) (f64.const 0x1p-1022)) (f64.const -0x1p-1))
+(assert_return (invoke "min" (f64.const -0x1p-1) (f64.const -0x1p-1)) (f64.const -0x1p-1))
+(assert_return (invoke "min" (f64.const -0x1p-1) (f64.const 0x1p-1)) (f64.const -0x1p-1))
+(assert_return (invoke "min" (f64.const -0x1p-1) (f64.const -0x1p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "min" (f64.const -0x1p-1) (f64.const 0x1p+0)) (f64.const -0x1p-1))
+(assert_return (invoke "min" (f64.const -0x1p-1) (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "min" (f64.const -0x1p-1) (f64.const 0x1.921fb54442d18p+2)) (f64.const -0x1p-1))
+(assert_return (invoke "min" (f64.const -0x1p-1) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "min" (f64.const -0x1p-1) (f64.const 0x1.fffffffffffffp+1023)) (f64.const -0x1p-1))
+(assert_return (invoke "min" (f64.const -0x1p-1) (f64.const -inf)) (f64.const -inf))
+(assert_return (invoke "min" (f64.const -0x1p-1) (f64.const inf)) (f64.const -0x1p-1))
+(assert_return_canonical_nan (invoke "min" (f64.const -0x1p-1) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "min" (f64.const -0x1p-1) (f64.const nan)))
+(assert_return (invoke "min" (f64.const 0x1p-1) (f64.const -0x0p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "min" (f64.const 0x1p-1) (f64.const 0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "min" (f64.const 0x1p-1) (f64.const -0x1p-1074)) (f64.const -0x1p-1074))
+(assert_return (invoke "min" (f64.const 0x1p-1) (f64.const 0x1p-1074)) (f64.const 0x1p-1074))
+(assert_return (invoke "min" (f64.const 0x1p-1) (f64.const -0x1p-1022)) (f64.const -0x1p-1022))
+(assert_return (invoke "min" (f64.const 0x1p-1) (f64.const 0x1p-1022)) (f64.const 0x1p-1022))
+(assert_return (invoke "min" (f64.const 0x1p-1) (f64.const -0x1p-1)) (f64.const -0x1p-1))
+(assert_return (invoke "min" (f64.const 0x1p-1) (f64.const 0x1p-1)) (f64.const 0x1p-1))
+(assert_return (invoke "min" (f64.const 0x1p-1) (f64.const -0x1p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "min" (f64.const 0x1p-1) (f64.const 0x1p+0)) (f64.const 0x1p-1))
+(assert_return (invoke "min" (f64.const 0x1p-1) (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "min" (f64.const 0x1p-1) (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x1p-1))
+(assert_return (invoke "min" (f64.const 0x1p-1) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "min" (f64.const 0x1p-1) (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x1p-1))
+(assert_return (invoke "min" (f64.const 0x1p-1) (f64.const -inf)) (f64.const -inf))
+(assert_return (invoke "min" (f64.const 0x1p-1) (f64.const inf)) (f64.const 0x1p-1))
+(assert_return_canonical_nan (invoke "min" (f64.const 0x1p-1) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "min" (f64.const 0x1p-1) (f64.const nan)))
+(assert_return (invoke "min" (f64.const -0x1p+0) (f64.const -0x0p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "min" (f64.const -0x1p+0) (f64.const 0x0p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "min" (f64.const -0x1p+0) (f64.const -0x1p-1074)) (f64.const -0x1p+0))
+(assert_return (invoke "min" (f64.const -0x1p+0) (f64.const 0x1p-1074)) (f64.const -0x1p+0))
+(assert_return (invoke "min" (f64.const -0x1p+0) (f64.const -0x1p-1022)) (f64.const -0x1p+0))
+(assert_return (invoke "min" (f64.const -0x1p+0) (f64.const 0x1p-1022)) (f64.const -0x1p+0))
+(assert_return (invoke "min" (f64.const -0x1p+0) (f64.const -0x1p-1)) (f64.const -0x1p+0))
+(assert_return (invoke "min" (f64.const -0x1p+0) (f64.const 0x1p-1)) (f64.const -0x1p+0))
+(assert_return (invoke "min" (f64.const -0x1p+0) (f64.const -0x1p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "min" (f64.const -0x1p+0) (f64.const 0x1p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "min" (f64.const -0x1p+0) (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "min" (f64.const -0x1p+0) (f64.const 0x1.921fb54442d18p+2)) (f64.const -0x1p+0))
+(assert_return (invoke "min" (f64.const -0x1p+0) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "min" (f64.const -0x1p+0) (f64.const 0x1.fffffffffffffp+1023)) (f64.const -0x1p+0))
+(assert_return (invoke "min" (f64.const -0x1p+0) (f64.const -inf)) (f64.const -inf))
+(assert_return (invoke "min" (f64.const -0x1p+0) (f64.const inf)) (f64.const -0x1p+0))
+(assert_return_canonical_nan (invoke "min" (f64.const -0x1p+0) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "min" (f64.const -0x1p+0) (f64.const nan)))
+(assert_return (invoke "min" (f64.const 0x1p+0) (f64.const -0x0p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "min" (f64.const 0x1p+0) (f64.const 0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "min" (f64.const 0x1p+0) (f64.const -0x1p-1074)) (f64.const -0x1p-1074))
+(assert_return (invoke "min" (f64.const 0x1p+0) (f64.const 0x1p-1074)) (f64.const 0x1p-1074))
+(assert_return (invoke "min" (f64.const 0x1p+0) (f64.const -0x1p-1022)) (f64.const -0x1p-1022))
+(assert_return (invoke "min" (f64.const 0x1p+0) (f64.const 0x1p-1022)) (f64.const 0x1p-1022))
+(assert_return (invoke "min" (f64.const 0x1p+0) (f64.const -0x1p-1)) (f64.const -0x1p-1))
+(assert_return (invoke "min" (f64.const 0x1p+0) (f64.const 0x1p-1)) (f64.const 0x1p-1))
+(assert_return (invoke "min" (f64.const 0x1p+0) (f64.const -0x1p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "min" (f64.const 0x1p+0) (f64.const 0x1p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "min" (f64.const 0x1p+0) (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "min" (f64.const 0x1p+0) (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x1p+0))
+(assert_return (invoke "min" (f64.const 0x1p+0) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "min" (f64.const 0x1p+0) (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x1p+0))
+(assert_return (invoke "min" (f64.const 0x1p+0) (f64.const -inf)) (f64.const -inf))
+(assert_return (invoke "min" (f64.const 0x1p+0) (f64.const inf)) (f64.const 0x1p+0))
+(assert_return_canonical_nan (invoke "min" (f64.const 0x1p+0) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "min" (f64.const 0x1p+0) (f64.const nan)))
+(assert_return (invoke "min" (f64.const -0x1.921fb54442d18p+2) (f64.const -0x0p+0)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "min" (f64.const -0x1.921fb54442d18p+2) (f64.const 0x0p+0)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "min" (f64.const -0x1.921fb54442d18p+2) (f64.const -0x1p-1074)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "min" (f64.const -0x1.921fb54442d18p+2) (f64.const 0x1p-1074)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "min" (f64.const -0x1.921fb54442d18p+2) (f64.const -0x1p-1022)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "min" (f64.const -0x1.921fb54442d18p+2) (f64.const 0x1p-1022)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "min" (f64.const -0x1.921fb54442d18p+2) (f64.const -0x1p-1)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "min" (f64.const -0x1.921fb54442d18p+2) (f64.const 0x1p-1)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "min" (f64.const -0x1.921fb54442d18p+2) (f64.const -0x1p+0)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "min" (f64.const -0x1.921fb54442d18p+2) (f64.const 0x1p+0)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "min" (f64.const -0x1.921fb54442d18p+2) (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "min" (f64.const -0x1.921fb54442d18p+2) (f64.const 0x1.921fb54442d18p+2)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "min" (f64.const -0x1.921fb54442d18p+2) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "min" (f64.const -0x1.921fb54442d18p+2) (f64.const 0x1.fffffffffffffp+1023)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "min" (f64.const -0x1.921fb54442d18p+2) (f64.const -inf)) (f64.const -inf))
+(assert_return (invoke "min" (f64.const -0x1.921fb54442d18p+2) (f64.const inf)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return_canonical_nan (invoke "min" (f64.const -0x1.921fb54442d18p+2) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "min" (f64.const -0x1.921fb54442d18p+2) (f64.const nan)))
+(assert_return (invoke "min" (f64.const 0x1.921fb54442d18p+2) (f64.const -0x0p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "min" (f64.const 0x1.921fb54442d18p+2) (f64.const 0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "min" (f64.const 0x1.921fb54442d18p+2) (f64.const -0x1p-1074)) (f64.const -0x1p-1074))
+(assert_return (invoke "min" (f64.const 0x1.921fb54442d18p+2) (f64.const 0x1p-1074)) (f64.const 0x1p-1074))
+(assert_return (invoke "min" (f64.const 0x1.921fb54442d18p+2) (f64.const -0x1p-1022)) (f64.const -0x1p-1022))
+(assert_return (invoke "min" (f64.const 0x1.921fb54442d18p+2) (f64.const 0x1p-1022)) (f64.const 0x1p-1022))
+(assert_return (invoke "min" (f64.const 0x1.921fb54442d18p+2) (f64.const -0x1p-1)) (f64.const -0x1p-1))
+(assert_return (invoke "min" (f64.const 0x1.921fb54442d18p+2) (f64.const 0x1p-1)) (f64.const 0x1p-1))
+(assert_return (invoke "min" (f64.const 0x1.921fb54442d18p+2) (f64.const -0x1p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "min" (f64.const 0x1.921fb54442d18p+2) (f64.const 0x1p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "min" (f64.const 0x1.921fb54442d18p+2) (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "min" (f64.const 0x1.921fb54442d18p+2) (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "min" (f64.const 0x1.921fb54442d18p+2) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "min" (f64.const 0x1.921fb54442d18p+2) (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "min" (f64.const 0x1.921fb54442d18p+2) (f64.const -inf)) (f64.const -inf))
+(assert_return (invoke "min" (f64.const 0x1.921fb54442d18p+2) (f64.const inf)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return_canonical_nan (invoke "min" (f64.const 0x1.921fb54442d18p+2) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "min" (f64.const 0x1.921fb54442d18p+2) (f64.const nan)))
+(assert_return (invoke "min" (f64.const -0x1.fffffffffffffp+1023) (f64.const -0x0p+0)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "min" (f64.const -0x1.fffffffffffffp+1023) (f64.const 0x0p+0)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "min" (f64.const -0x1.fffffffffffffp+1023) (f64.const -0x1p-1074)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "min" (f64.const -0x1.fffffffffffffp+1023) (f64.const 0x1p-1074)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "min" (f64.const -0x1.fffffffffffffp+1023) (f64.const -0x1p-1022)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "min" (f64.const -0x1.fffffffffffffp+1023) (f64.const 0x1p-1022)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "min" (f64.const -0x1.fffffffffffffp+1023) (f64.const -0x1p-1)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "min" (f64.const -0x1.fffffffffffffp+1023) (f64.const 0x1p-1)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "min" (f64.const -0x1.fffffffffffffp+1023) (f64.const -0x1p+0)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "min" (f64.const -0x1.fffffffffffffp+1023) (f64.const 0x1p+0)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "min" (f64.const -0x1.fffffffffffffp+1023) (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "min" (f64.const -0x1.fffffffffffffp+1023) (f64.const 0x1.921fb54442d18p+2)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "min" (f64.const -0x1.fffffffffffffp+1023) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "min" (f64.const -0x1.fffffffffffffp+1023) (f64.const 0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "min" (f64.const -0x1.fffffffffffffp+1023) (f64.const -inf)) (f64.const -inf))
+(assert_return (invoke "min" (f64.const -0x1.fffffffffffffp+1023) (f64.const inf)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return_canonical_nan (invoke "min" (f64.const -0x1.fffffffffffffp+1023) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "min" (f64.const -0x1.fffffffffffffp+1023) (f64.const nan)))
+(assert_return (invoke "min" (f64.const 0x1.fffffffffffffp+1023) (f64.const -0x0p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "min" (f64.const 0x1.fffffffffffffp+1023) (f64.const 0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "min" (f64.const 0x1.fffffffffffffp+1023) (f64.const -0x1p-1074)) (f64.const -0x1p-1074))
+(assert_return (invoke "min" (f64.const 0x1.fffffffffffffp+1023) (f64.const 0x1p-1074)) (f64.const 0x1p-1074))
+(assert_return (invoke "min" (f64.const 0x1.fffffffffffffp+1023) (f64.const -0x1p-1022)) (f64.const -0x1p-1022))
+(assert_return (invoke "min" (f64.const 0x1.fffffffffffffp+1023) (f64.const 0x1p-1022)) (f64.const 0x1p-1022))
+(assert_return (invoke "min" (f64.const 0x1.fffffffffffffp+1023) (f64.const -0x1p-1)) (f64.const -0x1p-1))
+(assert_return (invoke "min" (f64.const 0x1.fffffffffffffp+1023) (f64.const 0x1p-1)) (f64.const 0x1p-1))
+(assert_return (invoke "min" (f64.const 0x1.fffffffffffffp+1023) (f64.const -0x1p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "min" (f64.const 0x1.fffffffffffffp+1023) (f64.const 0x1p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "min" (f64.const 0x1.fffffffffffffp+1023) (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "min" (f64.const 0x1.fffffffffffffp+1023) (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "min" (f64.const 0x1.fffffffffffffp+1023) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "min" (f64.const 0x1.fffffffffffffp+1023) (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "min" (f64.const 0x1.fffffffffffffp+1023) (f64.const -inf)) (f64.const -inf))
+(assert_return (invoke "min" (f64.const 0x1.fffffffffffffp+1023) (f64.const inf)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return_canonical_nan (invoke "min" (f64.const 0x1.fffffffffffffp+1023) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "min" (f64.const 0x1.fffffffffffffp+1023) (f64.const nan)))
+(assert_return (invoke "min" (f64.const -inf) (f64.const -0x0p+0)) (f64.const -inf))
+(assert_return (invoke "min" (f64.const -inf) (f64.const 0x0p+0)) (f64.const -inf))
+(assert_return (invoke "min" (f64.const -inf) (f64.const -0x1p-1074)) (f64.const -inf))
+(assert_return (invoke "min" (f64.const -inf) (f64.const 0x1p-1074)) (f64.const -inf))
+(assert_return (invoke "min" (f64.const -inf) (f64.const -0x1p-1022)) (f64.const -inf))
+(assert_return (invoke "min" (f64.const -inf) (f64.const 0x1p-1022)) (f64.const -inf))
+(assert_return (invoke "min" (f64.const -inf) (f64.const -0x1p-1)) (f64.const -inf))
+(assert_return (invoke "min" (f64.const -inf) (f64.const 0x1p-1)) (f64.const -inf))
+(assert_return (invoke "min" (f64.const -inf) (f64.const -0x1p+0)) (f64.const -inf))
+(assert_return (invoke "min" (f64.const -inf) (f64.const 0x1p+0)) (f64.const -inf))
+(assert_return (invoke "min" (f64.const -inf) (f64.const -0x1.921fb54442d18p+2)) (f64.const -inf))
+(assert_return (invoke "min" (f64.const -inf) (f64.const 0x1.921fb54442d18p+2)) (f64.const -inf))
+(assert_return (invoke "min" (f64.const -inf) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -inf))
+(assert_return (invoke "min" (f64.const -inf) (f64.const 0x1.fffffffffffffp+1023)) (f64.const -inf))
+(assert_return (invoke "min" (f64.const -inf) (f64.const -inf)) (f64.const -inf))
+(assert_return (invoke "min" (f64.const -inf) (f64.const inf)) (f64.const -inf))
+(assert_return_canonical_nan (invoke "min" (f64.const -inf) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "min" (f64.const -inf) (f64.const nan)))
+(assert_return (invoke "min" (f64.const inf) (f64.const -0x0p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "min" (f64.const inf) (f64.const 0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "min" (f64.const inf) (f64.const -0x1p-1074)) (f64.const -0x1p-1074))
+(assert_return (invoke "min" (f64.const inf) (f64.const 0x1p-1074)) (f64.const 0x1p-1074))
+(assert_return (invoke "min" (f64.const inf) (f64.const -0x1p-1022)) (f64.const -0x1p-1022))
+(assert_return (invoke "min" (f64.const inf) (f64.const 0x1p-1022)) (f64.const 0x1p-1022))
+(assert_return (invoke "min" (f64.const inf) (f64.const -0x1p-1)) (f64.const -0x1p-1))
+(assert_return (invoke "min" (f64.const inf) (f64.const 0x1p-1)) (f64.const 0x1p-1))
+(assert_return (invoke "min" (f64.const inf) (f64.const -0x1p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "min" (f64.const inf) (f64.const 0x1p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "min" (f64.const inf) (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "min" (f64.const inf) (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "min" (f64.const inf) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "min" (f64.const inf) (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "min" (f64.const inf) (f64.const -inf)) (f64.const -inf))
+(assert_return (invoke "min" (f64.const inf) (f64.const inf)) (f64.const inf))
+(assert_return_canonical_nan (invoke "min" (f64.const inf) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "min" (f64.const inf) (f64.const nan)))
+(assert_return_canonical_nan (invoke "min" (f64.const -nan) (f64.const -0x0p+0)))
+(assert_return_canonical_nan (invoke "min" (f64.const -nan) (f64.const 0x0p+0)))
+(assert_return_canonical_nan (invoke "min" (f64.const -nan) (f64.const -0x1p-1074)))
+(assert_return_canonical_nan (invoke "min" (f64.const -nan) (f64.const 0x1p-1074)))
+(assert_return_canonical_nan (invoke "min" (f64.const -nan) (f64.const -0x1p-1022)))
+(assert_return_canonical_nan (invoke "min" (f64.const -nan) (f64.const 0x1p-1022)))
+(assert_return_canonical_nan (invoke "min" (f64.const -nan) (f64.const -0x1p-1)))
+(assert_return_canonical_nan (invoke "min" (f64.const -nan) (f64.const 0x1p-1)))
+(assert_return_canonical_nan (invoke "min" (f64.const -nan) (f64.const -0x1p+0)))
+(assert_return_canonical_nan (invoke "min" (f64.const -nan) (f64.const 0x1p+0)))
+(assert_return_canonical_nan (invoke "min" (f64.const -nan) (f64.const -0x1.921fb54442d18p+2)))
+(assert_return_canonical_nan (invoke "min" (f64.const -nan) (f64.const 0x1.921fb54442d18p+2)))
+(assert_return_canonical_nan (invoke "min" (f64.const -nan) (f64.const -0x1.fffffffffffffp+1023)))
+(assert_return_canonical_nan (invoke "min" (f64.const -nan) (f64.const 0x1.fffffffffffffp+1023)))
+(assert_return_canonical_nan (invoke "min" (f64.const -nan) (f64.const -inf)))
+(assert_return_canonical_nan (invoke "min" (f64.const -nan) (f64.const inf)))
+(assert_return_canonical_nan (invoke "min" (f64.const -nan) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "min" (f64.const -nan) (f64.const nan)))
+(assert_return_canonical_nan (invoke "min" (f64.const nan) (f64.const -0x0p+0)))
+(assert_return_canonical_nan (invoke "min" (f64.const nan) (f64.const 0x0p+0)))
+(assert_return_canonical_nan (invoke "min" (f64.const nan) (f64.const -0x1p-1074)))
+(assert_return_canonical_nan (invoke "min" (f64.const nan) (f64.const 0x1p-1074)))
+(assert_return_canonical_nan (invoke "min" (f64.const nan) (f64.const -0x1p-1022)))
+(assert_return_canonical_nan (invoke "min" (f64.const nan) (f64.const 0x1p-1022)))
+(assert_return_canonical_nan (invoke "min" (f64.const nan) (f64.const -0x1p-1)))
+(assert_return_canonical_nan (invoke "min" (f64.const nan) (f64.const 0x1p-1)))
+(assert_return_canonical_nan (invoke "min" (f64.const nan) (f64.const -0x1p+0)))
+(assert_return_canonical_nan (invoke "min" (f64.const nan) (f64.const 0x1p+0)))
+(assert_return_canonical_nan (invoke "min" (f64.const nan) (f64.const -0x1.921fb54442d18p+2)))
+(assert_return_canonical_nan (invoke "min" (f64.const nan) (f64.const 0x1.921fb54442d18p+2)))
+(assert_return_canonical_nan (invoke "min" (f64.const nan) (f64.const -0x1.fffffffffffffp+1023)))
+(assert_return_canonical_nan (invoke "min" (f64.const nan) (f64.const 0x1.fffffffffffffp+1023)))
+(assert_return_canonical_nan (invoke "min" (f64.const nan) (f64.const -inf)))
+(assert_return_canonical_nan (invoke "min" (f64.const nan) (f64.const inf)))
+(assert_return_canonical_nan (invoke "min" (f64.const nan) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "min" (f64.const nan) (f64.const nan)))
+(assert_return_arithmetic_nan (invoke "min" (f64.const nan:0xc000000000000) (f64.const 0x1p+0)))
+(assert_return_arithmetic_nan (invoke "min" (f64.const 0x1p+0) (f64.const nan:0xc000000000000)))
+(assert_return_arithmetic_nan (invoke "min" (f64.const -nan:0xc000000000000) (f64.const 0x1p+0)))
+(assert_return_arithmetic_nan (invoke "min" (f64.const 0x1p+0) (f64.const -nan:0xc000000000000)))
+(assert_return (invoke "min" (f64.const -0x1.30f1ac3e9b638p+25) (f64.const 0x1.0554af673dea5p+21)) (f64.const -0x1.30f1ac3e9b638p+25))
+(assert_return (invoke "min" (f64.const -0x1.25592aa9cd2d4p-824) (f64.const 0x1.b1e19bfd8d63cp-25)) (f64.const -0x1.25592aa9cd2d4p-824))
+(assert_return (invoke "min" (f64.const -0x1.faf08d4a42808p-18) (f64.const -0x1.c3a2b42cee6b7p+14)) (f64.const -0x1.c3a2b42cee6b7p+14))
+(assert_return (invoke "min" (f64.const 0x1.e9ab8583d8abbp+19) (f64.const -0x1.02c56ae76b84bp+8)) (f64.const -0x1.02c56ae76b84bp+8))
+(assert_return (invoke "min" (f64.const -0x1.8ac38bb1055d5p+22) (f64.const 0x1.a84e9232332fap+4)) (f64.const -0x1.8ac38bb1055d5p+22))
+(assert_return (invoke "min" (f64.const 0x1.4bb29bf7d4b4bp-17) (f64.const 0x1.d36c24b59e85ap-13)) (f64.const 0x1.4bb29bf7d4b4bp-17))
+(assert_return (invoke "min" (f64.const -0x1.0d9ae42ef86e3p+17) (f64.const 0x1.b21aeeded44b9p+683)) (f64.const -0x1.0d9ae42ef86e3p+17))
+(assert_return (invoke "min" (f64.const -0x1.e92fb969d7bccp+21) (f64.const 0x1.1ac7441c8a0b6p-185)) (f64.const -0x1.e92fb969d7bccp+21))
+(assert_return (invoke "min" (f64.const -0x1.1e2763553c59bp+15) (f64.const -0x1.d9f91e75d3d91p-23)) (f64.const -0x1.1e2763553c59bp+15))
+(assert_return (invoke "min" (f64.const -0x1.ee6e82e7436bep-744) (f64.const 0x1.24c05da5c2673p-30)) (f64.const -0x1.ee6e82e7436bep-744))
+(assert_return (invoke "min" (f64.const 0x1.d36dbe3bebab6p+762) (f64.const -0x1.92cce66e50b43p+755)) (f64.const -0x1.92cce66e50b43p+755))
+(assert_return (invoke "min" (f64.const 0x1.5a80d6222a8dcp-22) (f64.const -0x1.a1e932f6f7e8p+738)) (f64.const -0x1.a1e932f6f7e8p+738))
+(assert_return (invoke "min" (f64.const -0x1.6d82f64fc6255p-23) (f64.const 0x1.480a7fe8613b7p-581)) (f64.const -0x1.6d82f64fc6255p-23))
+(assert_return (invoke "min" (f64.const -0x1.318940c6369bcp-315) (f64.const 0x1.ca0138843d514p-606)) (f64.const -0x1.318940c6369bcp-315))
+(assert_return (invoke "min" (f64.const -0x1.01cadcaef5021p+13) (f64.const -0x1.f3b235cfe06fdp+23)) (f64.const -0x1.f3b235cfe06fdp+23))
+(assert_return (invoke "min" (f64.const -0x1.069b5b4ec444cp-297) (f64.const -0x1.1e7772e8ececep+20)) (f64.const -0x1.1e7772e8ececep+20))
+(assert_return (invoke "min" (f64.const -0x1.b7507107d3797p-28) (f64.const -0x1.fc81832ee29b8p-381)) (f64.const -0x1.b7507107d3797p-28))
+(assert_return (invoke "min" (f64.const -0x1.a85edcd655e6ep+713) (f64.const -0x1.ceecd0fa1fcc4p-307)) (f64.const -0x1.a85edcd655e6ep+713))
+(assert_return (invoke "min" (f64.const -0x1.87a1d5ea3ebep+370) (f64.const 0x1.62fff7f241efap+4)) (f64.const -0x1.87a1d5ea3ebep+370))
+(assert_return (invoke "min" (f64.const 0x1.8c81cbd7a8ba8p+1) (f64.const -0x1.2578b59fa34a8p-13)) (f64.const -0x1.2578b59fa34a8p-13))
+(assert_return (invoke "min" (f64.const 0x1.94224261024a7p+1) (f64.const 0x1.2652828baa814p+20)) (f64.const 0x1.94224261024a7p+1))
+(assert_return (invoke "min" (f64.const -0x1.3f1441d5a556bp-9) (f64.const 0x1.11d9bb99a2046p-491)) (f64.const -0x1.3f1441d5a556bp-9))
+(assert_return (invoke "min" (f64.const -0x1.c15047ecf07acp-914) (f64.const 0x1.5212790f4ec75p+5)) (f64.const -0x1.c15047ecf07acp-914))
+(assert_return (invoke "min" (f64.const 0x1.0100f3d3c6e8bp+14) (f64.const -0x1.60e474c1ab1b4p-22)) (f64.const -0x1.60e474c1ab1b4p-22))
+
+(assert_return (invoke "max" (f64.const -0x0p+0) (f64.const -0x0p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "max" (f64.const -0x0p+0) (f64.const 0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "max" (f64.const -0x0p+0) (f64.const -0x1p-1074)) (f64.const -0x0p+0))
+(assert_return (invoke "max" (f64.const -0x0p+0) (f64.const 0x1p-1074)) (f64.const 0x1p-1074))
+(assert_return (invoke "max" (f64.const -0x0p+0) (f64.const -0x1p-1022)) (f64.const -0x0p+0))
+(assert_return (invoke "max" (f64.const -0x0p+0) (f64.const 0x1p-1022)) (f64.const 0x1p-1022))
+(assert_return (invoke "max" (f64.const -0x0p+0) (f64.const -0x1p-1)) (f64.const -0x0p+0))
+(assert_return (invoke "max" (f64.const -0x0p+0) (f64.const 0x1p-1)) (f64.const 0x1p-1))
+(assert_return (invoke "max" (f64.const -0x0p+0) (f64.const -0x1p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "max" (f64.const -0x0p+0) (f64.const 0x1p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "max" (f64.const -0x0p+0) (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x0p+0))
+(assert_return (invoke "max" (f64.const -0x0p+0) (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "max" (f64.const -0x0p+0) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -0x0p+0))
+(assert_return (invoke "max" (f64.const -0x0p+0) (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "max" (f64.const -0x0p+0) (f64.const -inf)) (f64.const -0x0p+0))
+(assert_return (invoke "max" (f64.const -0x0p+0) (f64.const inf)) (f64.const inf))
+(assert_return_canonical_nan (invoke "max" (f64.const -0x0p+0) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "max" (f64.const -0x0p+0) (f64.const nan)))
+(assert_return (invoke "max" (f64.const 0x0p+0) (f64.const -0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "max" (f64.const 0x0p+0) (f64.const 0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "max" (f64.const 0x0p+0) (f64.const -0x1p-1074)) (f64.const 0x0p+0))
+(assert_return (invoke "max" (f64.const 0x0p+0) (f64.const 0x1p-1074)) (f64.const 0x1p-1074))
+(assert_return (invoke "max" (f64.const 0x0p+0) (f64.const -0x1p-1022)) (f64.const 0x0p+0))
+(assert_return (invoke "max" (f64.const 0x0p+0) (f64.const 0x1p-1022)) (f64.const 0x1p-1022))
+(assert_return (invoke "max" (f64.const 0x0p+0) (f64.const -0x1p-1)) (f64.const 0x0p+0))
+(assert_return (invoke "max" (f64.const 0x0p+0) (f64.const 0x1p-1)) (f64.const 0x1p-1))
+(assert_return (invoke "max" (f64.const 0x0p+0) (f64.const -0x1p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "max" (f64.const 0x0p+0) (f64.const 0x1p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "max" (f64.const 0x0p+0) (f64.const -0x1.921fb54442d18p+2)) (f64.const 0x0p+0))
+(assert_return (invoke "max" (f64.const 0x0p+0) (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "max" (f64.const 0x0p+0) (f64.const -0x1.fffffffffffffp+1023)) (f64.const 0x0p+0))
+(assert_return (invoke "max" (f64.const 0x0p+0) (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "max" (f64.const 0x0p+0) (f64.const -inf)) (f64.const 0x0p+0))
+(assert_return (invoke "max" (f64.const 0x0p+0) (f64.const inf)) (f64.const inf))
+(assert_return_canonical_nan (invoke "max" (f64.const 0x0p+0) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "max" (f64.const 0x0p+0) (f64.const nan)))
+(assert_return (invoke "max" (f64.const -0x1p-1074) (f64.const -0x0p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "max" (f64.const -0x1p-1074) (f64.const 0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "max" (f64.const -0x1p-1074) (f64.const -0x1p-1074)) (f64.const -0x1p-1074))
+(assert_return (invoke "max" (f64.const -0x1p-1074) (f64.const 0x1p-1074)) (f64.const 0x1p-1074))
+(assert_return (invoke "max" (f64.const -0x1p-1074) (f64.const -0x1p-1022)) (f64.const -0x1p-1074))
+(assert_return (invoke "max" (f64.const -0x1p-1074) (f64.const 0x1p-1022)) (f64.const 0x1p-1022))
+(assert_return (invoke "max" (f64.const -0x1p-1074) (f64.const -0x1p-1)) (f64.const -0x1p-1074))
+(assert_return (invoke "max" (f64.const -0x1p-1074) (f64.const 0x1p-1)) (f64.const 0x1p-1))
+(assert_return (invoke "max" (f64.const -0x1p-1074) (f64.const -0x1p+0)) (f64.const -0x1p-1074))
+(assert_return (invoke "max" (f64.const -0x1p-1074) (f64.const 0x1p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "max" (f64.const -0x1p-1074) (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x1p-1074))
+(assert_return (invoke "max" (f64.const -0x1p-1074) (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "max" (f64.const -0x1p-1074) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -0x1p-1074))
+(assert_return (invoke "max" (f64.const -0x1p-1074) (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "max" (f64.const -0x1p-1074) (f64.const -inf)) (f64.const -0x1p-1074))
+(assert_return (invoke "max" (f64.const -0x1p-1074) (f64.const inf)) (f64.const inf))
+(assert_return_canonical_nan (invoke "max" (f64.const -0x1p-1074) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "max" (f64.const -0x1p-1074) (f64.const nan)))
+(assert_return (invoke "max" (f64.const 0x1p-1074) (f64.const -0x0p+0)) (f64.const 0x1p-1074))
+(assert_return (invoke "max" (f64.const 0x1p-1074) (f64.const 0x0p+0)) (f64.const 0x1p-1074))
+(assert_return (invoke "max" (f64.const 0x1p-1074) (f64.const -0x1p-1074)) (f64.const 0x1p-1074))
+(assert_return (invoke "max" (f64.const 0x1p-1074) (f64.const 0x1p-1074)) (f64.const 0x1p-1074))
+(assert_return (invoke "max" (f64.const 0x1p-1074) (f64.const -0x1p-1022)) (f64.const 0x1p-1074))
+(assert_return (invoke "max" (f64.const 0x1p-1074) (f64.const 0x1p-1022)) (f64.const 0x1p-1022))
+(assert_return (invoke "max" (f64.const 0x1p-1074) (f64.const -0x1p-1)) (f64.const 0x1p-1074))
+(assert_return (invoke "max" (f64.const 0x1p-1074) (f64.const 0x1p-1)) (f64.const 0x1p-1))
+(assert_return (invoke "max" (f64.const 0x1p-1074) (f64.const -0x1p+0)) (f64.const 0x1p-1074))
+(assert_return (invoke "max" (f64.const 0x1p-1074) (f64.const 0x1p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "max" (f64.const 0x1p-1074) (f64.const -0x1.921fb54442d18p+2)) (f64.const 0x1p-1074))
+(assert_return (invoke "max" (f64.const 0x1p-1074) (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "max" (f64.const 0x1p-1074) (f64.const -0x1.fffffffffffffp+1023)) (f64.const 0x1p-1074))
+(assert_return (invoke "max" (f64.const 0x1p-1074) (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "max" (f64.const 0x1p-1074) (f64.const -inf)) (f64.const 0x1p-1074))
+(assert_return (invoke "max" (f64.const 0x1p-1074) (f64.const inf)) (f64.const inf))
+(assert_return_canonical_nan (invoke "max" (f64.const 0x1p-1074) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "max" (f64.const 0x1p-1074) (f64.const nan)))
+(assert_return (invoke "max" (f64.const -0x1p-1022) (f64.const -0x0p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "max" (f64.const -0x1p-1022) (f64.const 0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "max" (f64.const -0x1p-1022) (f64.const -0x1p-1074)) (f64.const -0x1p-1074))
+(assert_return (invoke "max" (f64.const -0x1p-1022) (f64.const 0x1p-1074)) (f64.const 0x1p-1074))
+(assert_return (invoke "max" (f64.const -0x1p-1022) (f64.const -0x1p-1022)) (f64.const -0x1p-1022))
+(assert_return (invoke "max" (f64.const -0x1p-1022) (f64.const 0x1p-1022)) (f64.const 0x1p-1022))
+(assert_return (invoke "max" (f64.const -0x1p-1022) (f64.const -0x1p-1)) (f64.const -0x1p-1022))
+(assert_return (invoke "max" (f64.const -0x1p-1022) (f64.const 0x1p-1)) (f64.const 0x1p-1))
+(assert_return (invoke "max" (f64.const -0x1p-1022) (f64.const -0x1p+0)) (f64.const -0x1p-1022))
+(assert_return (invoke "max" (f64.const -0x1p-1022) (f64.const 0x1p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "max" (f64.const -0x1p-1022) (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x1p-1022))
+(assert_return (invoke "max" (f64.const -0x1p-1022) (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "max" (f64.const -0x1p-1022) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -0x1p-1022))
+(assert_return (invoke "max" (f64.const -0x1p-1022) (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "max" (f64.const -0x1p-1022) (f64.const -inf)) (f64.const -0x1p-1022))
+(assert_return (invoke "max" (f64.const -0x1p-1022) (f64.const inf)) (f64.const inf))
+(assert_return_canonical_nan (invoke "max" (f64.const -0x1p-1022) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "max" (f64.const -0x1p-1022) (f64.const nan)))
+(assert_return (invoke "max" (f64.const 0x1p-1022) (f64.const -0x0p+0)) (f64.const 0x1p-1022))
+(assert_return (invoke "max" (f64.const 0x1p-1022) (f64.const 0x0p+0)) (f64.const 0x1p-1022))
+(assert_return (invoke "max" (f64.const 0x1p-1022) (f64.const -0x1p-1074)) (f64.const 0x1p-1022))
+(assert_return (invoke "max" (f64.const 0x1p-1022) (f64.const 0x1p-1074)) (f64.const 0x1p-1022))
+(assert_return (invoke "max" (f64.const 0x1p-1022) (f64.const -0x1p-1022)) (f64.const 0x1p-1022))
+(assert_return (invoke "max" (f64.const 0x1p-1022) (f64.const 0x1p-1022)) (f64.const 0x1p-1022))
+(assert_return (invoke "max" (f64.const 0x1p-1022) (f64.const -0x1p-1)) (f64.const 0x1p-1022))
+(assert_return (invoke "max" (f64.const 0x1p-1022) (f64.const 0x1p-1)) (f64.const 0x1p-1))
+(assert_return (invoke "max" (f64.const 0x1p-1022) (f64.const -0x1p+0)) (f64.const 0x1p-1022))
+(assert_return (invoke "max" (f64.const 0x1p-1022) (f64.const 0x1p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "max" (f64.const 0x1p-1022) (f64.const -0x1.921fb54442d18p+2)) (f64.const 0x1p-1022))
+(assert_return (invoke "max" (f64.const 0x1p-1022) (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "max" (f64.const 0x1p-1022) (f64.const -0x1.fffffffffffffp+1023)) (f64.const 0x1p-1022))
+(assert_return (invoke "max" (f64.const 0x1p-1022) (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "max" (f64.const 0x1p-1022) (f64.const -inf)) (f64.const 0x1p-1022))
+(assert_return (invoke "max" (f64.const 0x1p-1022) (f64.const inf)) (f64.const inf))
+(assert_return_canonical_nan (invoke "max" (f64.const 0x1p-1022) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "max" (f64.const 0x1p-1022) (f64.const nan)))
+(assert_return (invoke "max" (f64.const -0x1p-1) (f64.const -0x0p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "max" (f64.const -0x1p-1) (f64.const 0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "max" (f64.const -0x1p-1) (f64.const -0x1p-1074)) (f64.const -0x1p-1074))
+(assert_return (invoke "max" (f64.const -0x1p-1) (f64.const 0x1p-1074)) (f64.const 0x1p-1074))
+(assert_return (invoke "max" (f64.const -0x1p-1) (f64.const -0x1p-1022)) (f64.const -0x1p-1022))
+(assert_return (invoke "max" (f64.const -0x1p-1) (f64.const 0x1p-1022)) (f64.const 0x1p-1022))
+(assert_return (invoke "max" (f64.const -0x1p-1) (f64.const -0x1p-1)) (f64.const -0x1p-1))
+(assert_return (invoke "max" (f64.const -0x1p-1) (f64.const 0x1p-1)) (f64.const 0x1p-1))
+(assert_return (invoke "max" (f64.const -0x1p-1) (f64.const -0x1p+0)) (f64.const -0x1p-1))
+(assert_return (invoke "max" (f64.const -0x1p-1) (f64.const 0x1p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "max" (f64.const -0x1p-1) (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x1p-1))
+(assert_return (invoke "max" (f64.const -0x1p-1) (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "max" (f64.const -0x1p-1) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -0x1p-1))
+(assert_return (invoke "max" (f64.const -0x1p-1) (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "max" (f64.const -0x1p-1) (f64.const -inf)) (f64.const -0x1p-1))
+(assert_return (invoke "max" (f64.const -0x1p-1) (f64.const inf)) (f64.const inf))
+(assert_return_canonical_nan (invoke "max" (f64.const -0x1p-1) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "max" (f64.const -0x1p-1) (f64.const nan)))
+(assert_return (invoke "max" (f64.const 0x1p-1) (f64.const -0x0p+0)) (f64.const 0x1p-1))
+(assert_return (invoke "max" (f64.const 0x1p-1) (f64.const 0x0p+0)) (f64.const 0x1p-1))
+(assert_return (invoke "max" (f64.const 0x1p-1) (f64.const -0x1p-1074)) (f64.const 0x1p-1))
+(assert_return (invoke "max" (f64.const 0x1p-1) (f64.const 0x1p-1074)) (f64.const 0x1p-1))
+(assert_return (invoke "max" (f64.const 0x1p-1) (f64.const -0x1p-1022)) (f64.const 0x1p-1))
+(assert_return (invoke "max" (f64.const 0x1p-1) (f64.const 0x1p-1022)) (f64.const 0x1p-1))
+(assert_return (invoke "max" (f64.const 0x1p-1) (f64.const -0x1p-1)) (f64.const 0x1p-1))
+(assert_return (invoke "max" (f64.const 0x1p-1) (f64.const 0x1p-1)) (f64.const 0x1p-1))
+(assert_return (invoke "max" (f64.const 0x1p-1) (f64.const -0x1p+0)) (f64.const 0x1p-1))
+(assert_return (invoke "max" (f64.const 0x1p-1) (f64.const 0x1p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "max" (f64.const 0x1p-1) (f64.const -0x1.921fb54442d18p+2)) (f64.const 0x1p-1))
+(assert_return (invoke "max" (f64.const 0x1p-1) (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "max" (f64.const 0x1p-1) (f64.const -0x1.fffffffffffffp+1023)) (f64.const 0x1p-1))
+(assert_return (invoke "max" (f64.const 0x1p-1) (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "max" (f64.const 0x1p-1) (f64.const -inf)) (f64.const 0x1p-1))
+(assert_return (invoke "max" (f64.const 0x1p-1) (f64.const inf)) (f64.const inf))
+(assert_return_canonical_nan (invoke "max" (f64.const 0x1p-1) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "max" (f64.const 0x1p-1) (f64.const nan)))
+(assert_return (invoke "max" (f64.const -0x1p+0) (f64.const -0x0p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "max" (f64.const -0x1p+0) (f64.const 0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "max" (f64.const -0x1p+0) (f64.const -0x1p-1074)) (f64.const -0x1p-1074))
+(assert_return (invoke "max" (f64.const -0x1p+0) (f64.const 0x1p-1074)) (f64.const 0x1p-1074))
+(assert_return (invoke "max" (f64.const -0x1p+0) (f64.const -0x1p-1022)) (f64.const -0x1p-1022))
+(assert_return (invoke "max" (f64.const -0x1p+0) (f64.const 0x1p-1022)) (f64.const 0x1p-1022))
+(assert_return (invoke "max" (f64.const -0x1p+0) (f64.const -0x1p-1)) (f64.const -0x1p-1))
+(assert_return (invoke "max" (f64.const -0x1p+0) (f64.const 0x1p-1)) (f64.const 0x1p-1))
+(assert_return (invoke "max" (f64.const -0x1p+0) (f64.const -0x1p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "max" (f64.const -0x1p+0) (f64.const 0x1p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "max" (f64.const -0x1p+0) (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x1p+0))
+(assert_return (invoke "max" (f64.const -0x1p+0) (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "max" (f64.const -0x1p+0) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -0x1p+0))
+(assert_return (invoke "max" (f64.const -0x1p+0) (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "max" (f64.const -0x1p+0) (f64.const -inf)) (f64.const -0x1p+0))
+(assert_return (invoke "max" (f64.const -0x1p+0) (f64.const inf)) (f64.const inf))
+(assert_return_canonical_nan (invoke "max" (f64.const -0x1p+0) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "max" (f64.const -0x1p+0) (f64.const nan)))
+(assert_return (invoke "max" (f64.const 0x1p+0) (f64.const -0x0p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "max" (f64.const 0x1p+0) (f64.const 0x0p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "max" (f64.const 0x1p+0) (f64.const -0x1p-1074)) (f64.const 0x1p+0))
+(assert_return (invoke "max" (f64.const 0x1p+0) (f64.const 0x1p-1074)) (f64.const 0x1p+0))
+(assert_return (invoke "max" (f64.const 0x1p+0) (f64.const -0x1p-1022)) (f64.const 0x1p+0))
+(assert_return (invoke "max" (f64.const 0x1p+0) (f64.const 0x1p-1022)) (f64.const 0x1p+0))
+(assert_return (invoke "max" (f64.const 0x1p+0) (f64.const -0x1p-1)) (f64.const 0x1p+0))
+(assert_return (invoke "max" (f64.const 0x1p+0) (f64.const 0x1p-1)) (f64.const 0x1p+0))
+(assert_return (invoke "max" (f64.const 0x1p+0) (f64.const -0x1p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "max" (f64.const 0x1p+0) (f64.const 0x1p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "max" (f64.const 0x1p+0) (f64.const -0x1.921fb54442d18p+2)) (f64.const 0x1p+0))
+(assert_return (invoke "max" (f64.const 0x1p+0) (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "max" (f64.const 0x1p+0) (f64.const -0x1.fffffffffffffp+1023)) (f64.const 0x1p+0))
+(assert_return (invoke "max" (f64.const 0x1p+0) (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "max" (f64.const 0x1p+0) (f64.const -inf)) (f64.const 0x1p+0))
+(assert_return (invoke "max" (f64.const 0x1p+0) (f64.const inf)) (f64.const inf))
+(assert_return_canonical_nan (invoke "max" (f64.const 0x1p+0) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "max" (f64.const 0x1p+0) (f64.const nan)))
+(assert_return (invoke "max" (f64.const -0x1.921fb54442d18p+2) (f64.const -0x0p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "max" (f64.const -0x1.921fb54442d18p+2) (f64.const 0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "max" (f64.const -0x1.921fb54442d18p+2) (f64.const -0x1p-1074)) (f64.const -0x1p-1074))
+(assert_return (invoke "max" (f64.const -0x1.921fb54442d18p+2) (f64.const 0x1p-1074)) (f64.const 0x1p-1074))
+(assert_return (invoke "max" (f64.const -0x1.921fb54442d18p+2) (f64.const -0x1p-1022)) (f64.const -0x1p-1022))
+(assert_return (invoke "max" (f64.const -0x1.921fb54442d18p+2) (f64.const 0x1p-1022)) (f64.const 0x1p-1022))
+(assert_return (invoke "max" (f64.const -0x1.921fb54442d18p+2) (f64.const -0x1p-1)) (f64.const -0x1p-1))
+(assert_return (invoke "max" (f64.const -0x1.921fb54442d18p+2) (f64.const 0x1p-1)) (f64.const 0x1p-1))
+(assert_return (invoke "max" (f64.const -0x1.921fb54442d18p+2) (f64.const -0x1p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "max" (f64.const -0x1.921fb54442d18p+2) (f64.const 0x1p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "max" (f64.const -0x1.921fb54442d18p+2) (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "max" (f64.const -0x1.921fb54442d18p+2) (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "max" (f64.const -0x1.921fb54442d18p+2) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "max" (f64.const -0x1.921fb54442d18p+2) (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "max" (f64.const -0x1.921fb54442d18p+2) (f64.const -inf)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "max" (f64.const -0x1.921fb54442d18p+2) (f64.const inf)) (f64.const inf))
+(assert_return_canonical_nan (invoke "max" (f64.const -0x1.921fb54442d18p+2) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "max" (f64.const -0x1.921fb54442d18p+2) (f64.const nan)))
+(assert_return (invoke "max" (f64.const 0x1.921fb54442d18p+2) (f64.const -0x0p+0)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "max" (f64.const 0x1.921fb54442d18p+2) (f64.const 0x0p+0)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "max" (f64.const 0x1.921fb54442d18p+2) (f64.const -0x1p-1074)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "max" (f64.const 0x1.921fb54442d18p+2) (f64.const 0x1p-1074)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "max" (f64.const 0x1.921fb54442d18p+2) (f64.const -0x1p-1022)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "max" (f64.const 0x1.921fb54442d18p+2) (f64.const 0x1p-1022)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "max" (f64.const 0x1.921fb54442d18p+2) (f64.const -0x1p-1)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "max" (f64.const 0x1.921fb54442d18p+2) (f64.const 0x1p-1)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "max" (f64.const 0x1.921fb54442d18p+2) (f64.const -0x1p+0)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "max" (f64.const 0x1.921fb54442d18p+2) (f64.const 0x1p+0)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "max" (f64.const 0x1.921fb54442d18p+2) (f64.const -0x1.921fb54442d18p+2)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "max" (f64.const 0x1.921fb54442d18p+2) (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "max" (f64.const 0x1.921fb54442d18p+2) (f64.const -0x1.fffffffffffffp+1023)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "max" (f64.const 0x1.921fb54442d18p+2) (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "max" (f64.const 0x1.921fb54442d18p+2) (f64.const -inf)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "max" (f64.const 0x1.921fb54442d18p+2) (f64.const inf)) (f64.const inf))
+(assert_return_canonical_nan (invoke "max" (f64.const 0x1.921fb54442d18p+2) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "max" (f64.const 0x1.921fb54442d18p+2) (f64.const nan)))
+(assert_return (invoke "max" (f64.const -0x1.fffffffffffffp+1023) (f64.const -0x0p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "max" (f64.const -0x1.fffffffffffffp+1023) (f64.const 0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "max" (f64.const -0x1.fffffffffffffp+1023) (f64.const -0x1p-1074)) (f64.const -0x1p-1074))
+(assert_return (invoke "max" (f64.const -0x1.fffffffffffffp+1023) (f64.const 0x1p-1074)) (f64.const 0x1p-1074))
+(assert_return (invoke "max" (f64.const -0x1.fffffffffffffp+1023) (f64.const -0x1p-1022)) (f64.const -0x1p-1022))
+(assert_return (invoke "max" (f64.const -0x1.fffffffffffffp+1023) (f64.const 0x1p-1022)) (f64.const 0x1p-1022))
+(assert_return (invoke "max" (f64.const -0x1.fffffffffffffp+1023) (f64.const -0x1p-1)) (f64.const -0x1p-1))
+(assert_return (invoke "max" (f64.const -0x1.fffffffffffffp+1023) (f64.const 0x1p-1)) (f64.const 0x1p-1))
+(assert_return (invoke "max" (f64.const -0x1.fffffffffffffp+1023) (f64.const -0x1p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "max" (f64.const -0x1.fffffffffffffp+1023) (f64.const 0x1p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "max" (f64.const -0x1.fffffffffffffp+1023) (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "max" (f64.const -0x1.fffffffffffffp+1023) (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "max" (f64.const -0x1.fffffffffffffp+1023) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "max" (f64.const -0x1.fffffffffffffp+1023) (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "max" (f64.const -0x1.fffffffffffffp+1023) (f64.const -inf)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "max" (f64.const -0x1.fffffffffffffp+1023) (f64.const inf)) (f64.const inf))
+(assert_return_canonical_nan (invoke "max" (f64.const -0x1.fffffffffffffp+1023) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "max" (f64.const -0x1.fffffffffffffp+1023) (f64.const nan)))
+(assert_return (invoke "max" (f64.const 0x1.fffffffffffffp+1023) (f64.const -0x0p+0)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "max" (f64.const 0x1.fffffffffffffp+1023) (f64.const 0x0p+0)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "max" (f64.const 0x1.fffffffffffffp+1023) (f64.const -0x1p-1074)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "max" (f64.const 0x1.fffffffffffffp+1023) (f64.const 0x1p-1074)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "max" (f64.const 0x1.fffffffffffffp+1023) (f64.const -0x1p-1022)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "max" (f64.const 0x1.fffffffffffffp+1023) (f64.const 0x1p-1022)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "max" (f64.const 0x1.fffffffffffffp+1023) (f64.const -0x1p-1)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "max" (f64.const 0x1.fffffffffffffp+1023) (f64.const 0x1p-1)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "max" (f64.const 0x1.fffffffffffffp+1023) (f64.const -0x1p+0)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "max" (f64.const 0x1.fffffffffffffp+1023) (f64.const 0x1p+0)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "max" (f64.const 0x1.fffffffffffffp+1023) (f64.const -0x1.921fb54442d18p+2)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "max" (f64.const 0x1.fffffffffffffp+1023) (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "max" (f64.const 0x1.fffffffffffffp+1023) (f64.const -0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "max" (f64.const 0x1.fffffffffffffp+1023) (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "max" (f64.const 0x1.fffffffffffffp+1023) (f64.const -inf)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "max" (f64.const 0x1.fffffffffffffp+1023) (f64.const inf)) (f64.const inf))
+(assert_return_canonical_nan (invoke "max" (f64.const 0x1.fffffffffffffp+1023) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "max" (f64.const 0x1.fffffffffffffp+1023) (f64.const nan)))
+(assert_return (invoke "max" (f64.const -inf) (f64.const -0x0p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "max" (f64.const -inf) (f64.const 0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "max" (f64.const -inf) (f64.const -0x1p-1074)) (f64.const -0x1p-1074))
+(assert_return (invoke "max" (f64.const -inf) (f64.const 0x1p-1074)) (f64.const 0x1p-1074))
+(assert_return (invoke "max" (f64.const -inf) (f64.const -0x1p-1022)) (f64.const -0x1p-1022))
+(assert_return (invoke "max" (f64.const -inf) (f64.const 0x1p-1022)) (f64.const 0x1p-1022))
+(assert_return (invoke "max" (f64.const -inf) (f64.const -0x1p-1)) (f64.const -0x1p-1))
+(assert_return (invoke "max" (f64.const -inf) (f64.const 0x1p-1)) (f64.const 0x1p-1))
+(assert_return (invoke "max" (f64.const -inf) (f64.const -0x1p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "max" (f64.const -inf) (f64.const 0x1p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "max" (f64.const -inf) (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x1.921fb54442d18p+2))
+(assert_return (invoke "max" (f64.const -inf) (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x1.921fb54442d18p+2))
+(assert_return (invoke "max" (f64.const -inf) (f64.const -0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "max" (f64.const -inf) (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "max" (f64.const -inf) (f64.const -inf)) (f64.const -inf))
+(assert_return (invoke "max" (f64.const -inf) (f64.const inf)) (f64.const inf))
+(assert_return_canonical_nan (invoke "max" (f64.const -inf) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "max" (f64.const -inf) (f64.const nan)))
+(assert_return (invoke "max" (f64.const inf) (f64.const -0x0p+0)) (f64.const inf))
+(assert_return (invoke "max" (f64.const inf) (f64.const 0x0p+0)) (f64.const inf))
+(assert_return (invoke "max" (f64.const inf) (f64.const -0x1p-1074)) (f64.const inf))
+(assert_return (invoke "max" (f64.const inf) (f64.const 0x1p-1074)) (f64.const inf))
+(assert_return (invoke "max" (f64.const inf) (f64.const -0x1p-1022)) (f64.const inf))
+(assert_return (invoke "max" (f64.const inf) (f64.const 0x1p-1022)) (f64.const inf))
+(assert_return (invoke "max" (f64.const inf) (f64.const -0x1p-1)) (f64.const inf))
+(assert_return (invoke "max" (f64.const inf) (f64.const 0x1p-1)) (f64.const inf))
+(assert_return (invoke "max" (f64.const inf) (f64.const -0x1p+0)) (f64.const inf))
+(assert_return (invoke "max" (f64.const inf) (f64.const 0x1p+0)) (f64.const inf))
+(assert_return (invoke "max" (f64.const inf) (f64.const -0x1.921fb54442d18p+2)) (f64.const inf))
+(assert_return (invoke "max" (f64.const inf) (f64.const 0x1.921fb54442d18p+2)) (f64.const inf))
+(assert_return (invoke "max" (f64.const inf) (f64.const -0x1.fffffffffffffp+1023)) (f64.const inf))
+(assert_return (invoke "max" (f64.const inf) (f64.const 0x1.fffffffffffffp+1023)) (f64.const inf))
+(assert_return (invoke "max" (f64.const inf) (f64.const -inf)) (f64.const inf))
+(assert_return (invoke "max" (f64.const inf) (f64.const inf)) (f64.const inf))
+(assert_return_canonical_nan (invoke "max" (f64.const inf) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "max" (f64.const inf) (f64.const nan)))
+(assert_return_canonical_nan (invoke "max" (f64.const -nan) (f64.const -0x0p+0)))
+(assert_return_canonical_nan (invoke "max" (f64.const -nan) (f64.const 0x0p+0)))
+(assert_return_canonical_nan (invoke "max" (f64.const -nan) (f64.const -0x1p-1074)))
+(assert_return_canonical_nan (invoke "max" (f64.const -nan) (f64.const 0x1p-1074)))
+(assert_return_canonical_nan (invoke "max" (f64.const -nan) (f64.const -0x1p-1022)))
+(assert_return_canonical_nan (invoke "max" (f64.const -nan) (f64.const 0x1p-1022)))
+(assert_return_canonical_nan (invoke "max" (f64.const -nan) (f64.const -0x1p-1)))
+(assert_return_canonical_nan (invoke "max" (f64.const -nan) (f64.const 0x1p-1)))
+(assert_return_canonical_nan (invoke "max" (f64.const -nan) (f64.const -0x1p+0)))
+(assert_return_canonical_nan (invoke "max" (f64.const -nan) (f64.const 0x1p+0)))
+(assert_return_canonical_nan (invoke "max" (f64.const -nan) (f64.const -0x1.921fb54442d18p+2)))
+(assert_return_canonical_nan (invoke "max" (f64.const -nan) (f64.const 0x1.921fb54442d18p+2)))
+(assert_return_canonical_nan (invoke "max" (f64.const -nan) (f64.const -0x1.fffffffffffffp+1023)))
+(assert_return_canonical_nan (invoke "max" (f64.const -nan) (f64.const 0x1.fffffffffffffp+1023)))
+(assert_return_canonical_nan (invoke "max" (f64.const -nan) (f64.const -inf)))
+(assert_return_canonical_nan (invoke "max" (f64.const -nan) (f64.const inf)))
+(assert_return_canonical_nan (invoke "max" (f64.const -nan) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "max" (f64.const -nan) (f64.const nan)))
+(assert_return_canonical_nan (invoke "max" (f64.const nan) (f64.const -0x0p+0)))
+(assert_return_canonical_nan (invoke "max" (f64.const nan) (f64.const 0x0p+0)))
+(assert_return_canonical_nan (invoke "max" (f64.const nan) (f64.const -0x1p-1074)))
+(assert_return_canonical_nan (invoke "max" (f64.const nan) (f64.const 0x1p-1074)))
+(assert_return_canonical_nan (invoke "max" (f64.const nan) (f64.const -0x1p-1022)))
+(assert_return_canonical_nan (invoke "max" (f64.const nan) (f64.const 0x1p-1022)))
+(assert_return_canonical_nan (invoke "max" (f64.const nan) (f64.const -0x1p-1)))
+(assert_return_canonical_nan (invoke "max" (f64.const nan) (f64.const 0x1p-1)))
+(assert_return_canonical_nan (invoke "max" (f64.const nan) (f64.const -0x1p+0)))
+(assert_return_canonical_nan (invoke "max" (f64.const nan) (f64.const 0x1p+0)))
+(assert_return_canonical_nan (invoke "max" (f64.const nan) (f64.const -0x1.921fb54442d18p+2)))
+(assert_return_canonical_nan (invoke "max" (f64.const nan) (f64.const 0x1.921fb54442d18p+2)))
+(assert_return_canonical_nan (invoke "max" (f64.const nan) (f64.const -0x1.fffffffffffffp+1023)))
+(assert_return_canonical_nan (invoke "max" (f64.const nan) (f64.const 0x1.fffffffffffffp+1023)))
+(assert_return_canonical_nan (invoke "max" (f64.const nan) (f64.const -inf)))
+(assert_return_canonical_nan (invoke "max" (f64.const nan) (f64.const inf)))
+(assert_return_canonical_nan (invoke "max" (f64.const nan) (f64.const -nan)))
+(assert_return_canonical_nan (invoke "max" (f64.const nan) (f64.const nan)))
+(assert_return_arithmetic_nan (invoke "max" (f64.const nan:0xc000000000000) (f64.const 0x1p+0)))
+(assert_return_arithmetic_nan (invoke "max" (f64.const 0x1p+0) (f64.const nan:0xc000000000000)))
+(assert_return_arithmetic_nan (invoke "max" (f64.const -nan:0xc000000000000) (f64.const 0x1p+0)))
+(assert_return_arithmetic_nan (invoke "max" (f64.const 0x1p+0) (f64.const -nan:0xc000000000000)))
+(assert_return (invoke "max" (f64.const -0x1.585efca798115p+23) (f64.const -0x1.5b86790722c45p-29)) (f64.const -0x1.5b86790722c45p-29))
+(assert_return (invoke "max" (f64.const 0x1.53c2a8c9bce3p+23) (f64.const 0x1.37cfd8d24df7cp-456)) (f64.const 0x1.53c2a8c9bce3p+23))
+(assert_return (invoke "max" (f64.const -0x1.9b376b07cf18p+11) (f64.const 0x1.e53f44e6a5849p-88)) (f64.const 0x1.e53f44e6a5849p-88))
+(assert_return (invoke "max" (f64.const 0x1.d2fa51b8bcff5p+332) (f64.const 0x1.dcf3cdf7935cfp+438)) (f64.const 0x1.dcf3cdf7935cfp+438))
+(assert_return (invoke "max" (f64.const 0x1.2af1c0b3c572cp+43) (f64.const -0x1.427f166041cf6p-881)) (f64.const 0x1.2af1c0b3c572cp+43))
+(assert_return (invoke "max" (f64.const -0x1.1fb061100454ap+45) (f64.const 0x1.111cdb7f13e6fp+480)) (f64.const 0x1.111cdb7f13e6fp+480))
+(assert_return (invoke "max" (f64.const 0x1.338f244c13666p-30) (f64.const 0x1.4e0ef4d2fe149p-1)) (f64.const 0x1.4e0ef4d2fe149p-1))
+(assert_return (invoke "max" (f64.const 0x1.18d3d7c3e6a6p+185) (f64.const -0x1.8be91b7075cfep-13)) (f64.const 0x1.18d3d7c3e6a6p+185))
+(assert_return (invoke "max" (f64.const -0x1.63710682c96e1p+663) (f64.const 0x1.cda6fe73d6f59p-610)) (f64.const 0x1.cda6fe73d6f59p-610))
+(assert_return (invoke "max" (f64.const -0x1.03b5dc3156396p+469) (f64.const 0x1.1d371976818f2p-16)) (f64.const 0x1.1d371976818f2p-16))
+(assert_return (invoke "max" (f64.const -0x1.7d2551a9f0444p-402) (f64.const -0x1.3d2482d68a375p+711)) (f64.const -0x1.7d2551a9f0444p-402))
+(assert_return (invoke "max" (f64.const -0x1.6e6c593d7352ep-176) (f64.const -0x1.5bbdf2bb08d93p+4)) (f64.const -0x1.6e6c593d7352ep-176))
+(assert_return (invoke "max" (f64.const -0x1.b4cea4bce650ap+15) (f64.const 0x1.f508d2b0ee268p-15)) (f64.const 0x1.f508d2b0ee268p-15))
+(assert_return (invoke "max" (f64.const 0x1.6b3fc323d424ep-670) (f64.const -0x1.03ddcc7825b54p-206)) (f64.const 0x1.6b3fc323d424ep-670))
+(assert_return (invoke "max" (f64.const 0x1.f1fd29dfb8aecp-10) (f64.const 0x1.6e09590970ac5p-27)) (f64.const 0x1.f1fd29dfb8aecp-10))
+(assert_return (invoke "max" (f64.const -0x1.15c2055b5bce4p-4) (f64.const 0x1.6e3e97f10534cp-20)) (f64.const 0x1.6e3e97f10534cp-20))
+(assert_return (invoke "max" (f64.const 0x1.4398c78c2664fp-4) (f64.const -0x1.a153b0af0212cp-20)) (f64.const 0x1.4398c78c2664fp-4))
+(assert_return (invoke "max" (f64.const 0x1.7e69261a5371ep-4) (f64.const 0x1.618f695beb1abp-763)) (f64.const 0x1.7e69261a5371ep-4))
+(assert_return (invoke "max" (f64.const -0x1.2e2c737926dbep+26) (f64.const 0x1.ff86b3cf9e43fp-209)) (f64.const 0x1.ff86b3cf9e43fp-209))
+(assert_return (invoke "max" (f64.const -0x1.f0748cd9204bbp+722) (f64.const 0x1.a4078530a832dp-685)) (f64.const 0x1.a4078530a832dp-685))
+(assert_return (invoke "max" (f64.const 0x1.9d74a2f423b25p-7) (f64.const -0x1.e8b23b4f7070ep+839)) (f64.const 0x1.9d74a2f423b25p-7))
+(assert_return (invoke "max" (f64.const -0x1.76ac282e049f9p-8) (f64.const -0x1.8b2571f7dba75p-11)) (f64.const -0x1.8b2571f7dba75p-11))
+(assert_return (invoke "max" (f64.const 0x1.30396eb67a13ep+27) (f64.const 0x1.33743f2d8d124p-316)) (f64.const 0x1.30396eb67a13ep+27))
+(assert_return (invoke "max" (f64.const 0x1.32d65bb3a908ap-7) (f64.const 0x1.65a40da52b024p+18)) (f64.const 0x1.65a40da52b024p+18))
+
+(assert_return (invoke "sqrt" (f64.const -0x0p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "sqrt" (f64.const 0x0p+0)) (f64.const 0x0p+0))
+(assert_return_canonical_nan (invoke "sqrt" (f64.const -0x1p-1074)))
+(assert_return (invoke "sqrt" (f64.const 0x1p-1074)) (f64.const 0x1p-537))
+(assert_return_canonical_nan (invoke "sqrt" (f64.const -0x1p-1022)))
+(assert_return (invoke "sqrt" (f64.const 0x1p-1022)) (f64.const 0x1p-511))
+(assert_return_canonical_nan (invoke "sqrt" (f64.const -0x1p-1)))
+(assert_return (invoke "sqrt" (f64.const 0x1p-1)) (f64.const 0x1.6a09e667f3bcdp-1))
+(assert_return_canonical_nan (invoke "sqrt" (f64.const -0x1p+0)))
+(assert_return (invoke "sqrt" (f64.const 0x1p+0)) (f64.const 0x1p+0))
+(assert_return_canonical_nan (invoke "sqrt" (f64.const -0x1.921fb54442d18p+2)))
+(assert_return (invoke "sqrt" (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x1.40d931ff62705p+1))
+(assert_return_canonical_nan (invoke "sqrt" (f64.const -0x1.fffffffffffffp+1023)))
+(assert_return (invoke "sqrt" (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+511))
+(assert_return_canonical_nan (invoke "sqrt" (f64.const -inf)))
+(assert_return (invoke "sqrt" (f64.const inf)) (f64.const inf))
+(assert_return_canonical_nan (invoke "sqrt" (f64.const -nan)))
+(assert_return_canonical_nan (invoke "sqrt" (f64.const nan)))
+(assert_return_arithmetic_nan (invoke "sqrt" (f64.const nan:0xc000000000000)))
+(assert_return_arithmetic_nan (invoke "sqrt" (f64.const -nan:0xc000000000000)))
+(assert_return (invoke "sqrt" (f64.const 0x1p+1)) (f64.const 0x1.6a09e667f3bcdp+0))
+(assert_return (invoke "sqrt" (f64.const 0x1p+2)) (f64.const 0x1p+1))
+(assert_return (invoke "sqrt" (f64.const 0x1.2p+3)) (f64.const 0x1.8p+1))
+(assert_return (invoke "sqrt" (f64.const 0x1.4p+3)) (f64.const 0x1.94c583ada5b53p+1))
+(assert_return (invoke "sqrt" (f64.const 0x1.445b8726c326bp+1)) (f64.const 0x1.9784a68c0f926p+0))
+(assert_return_canonical_nan (invoke "sqrt" (f64.const -0x1.c04e5c7c57a7p-4)))
+(assert_return (invoke "sqrt" (f64.const 0x1.0dccbec1864c7p-513)) (f64.const 0x1.73ab2442ca423p-257))
+(assert_return_canonical_nan (invoke "sqrt" (f64.const -0x1.82e5c7f19d0f5p+108)))
+(assert_return_canonical_nan (invoke "sqrt" (f64.const -0x1.e4b86677b8ffbp+567)))
+(assert_return (invoke "sqrt" (f64.const 0x1.c5a9d7221ee5bp+19)) (f64.const 0x1.e1f32aebb4314p+9))
+(assert_return (invoke "sqrt" (f64.const 0x1.c02444208a354p-26)) (f64.const 0x1.52b5af6827aa4p-13))
+(assert_return_canonical_nan (invoke "sqrt" (f64.const -0x1.8f272ed138921p+0)))
+
+(assert_return (invoke "floor" (f64.const -0x0p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "floor" (f64.const 0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "floor" (f64.const -0x1p-1074)) (f64.const -0x1p+0))
+(assert_return (invoke "floor" (f64.const 0x1p-1074)) (f64.const 0x0p+0))
+(assert_return (invoke "floor" (f64.const -0x1p-1022)) (f64.const -0x1p+0))
+(assert_return (invoke "floor" (f64.const 0x1p-1022)) (f64.const 0x0p+0))
+(assert_return (invoke "floor" (f64.const -0x1p-1)) (f64.const -0x1p+0))
+(assert_return (invoke "floor" (f64.const 0x1p-1)) (f64.const 0x0p+0))
+(assert_return (invoke "floor" (f64.const -0x1p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "floor" (f64.const 0x1p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "floor" (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x1.cp+2))
+(assert_return (invoke "floor" (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x1.8p+2))
+(assert_return (invoke "floor" (f64.const -0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "floor" (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "floor" (f64.const -inf)) (f64.const -inf))
+(assert_return (invoke "floor" (f64.const inf)) (f64.const inf))
+(assert_return_canonical_nan (invoke "floor" (f64.const -nan)))
+(assert_return_canonical_nan (invoke "floor" (f64.const nan)))
+(assert_return_arithmetic_nan (invoke "floor" (f64.const nan:0xc000000000000)))
+(assert_return_arithmetic_nan (invoke "floor" (f64.const -nan:0xc000000000000)))
+(assert_return (invoke "floor" (f64.const -0x1.cp+1)) (f64.const -0x1p+2))
+(assert_return (invoke "floor" (f64.const -0x1.8p+1)) (f64.const -0x1.8p+1))
+(assert_return (invoke "floor" (f64.const -0x1.4p+1)) (f64.const -0x1.8p+1))
+(assert_return (invoke "floor" (f64.const -0x1p+1)) (f64.const -0x1p+1))
+(assert_return (invoke "floor" (f64.const -0x1.8p+0)) (f64.const -0x1p+1))
+(assert_return (invoke "floor" (f64.const -0x1p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "floor" (f64.const -0x1p-1)) (f64.const -0x1p+0))
+(assert_return (invoke "floor" (f64.const 0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "floor" (f64.const 0x1p-1)) (f64.const 0x0p+0))
+(assert_return (invoke "floor" (f64.const 0x1p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "floor" (f64.const 0x1.8p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "floor" (f64.const 0x1p+1)) (f64.const 0x1p+1))
+(assert_return (invoke "floor" (f64.const 0x1.4p+1)) (f64.const 0x1p+1))
+(assert_return (invoke "floor" (f64.const 0x1.8p+1)) (f64.const 0x1.8p+1))
+(assert_return (invoke "floor" (f64.const 0x1.cp+1)) (f64.const 0x1.8p+1))
+(assert_return (invoke "floor" (f64.const 0x1.ffffffffffffep+50)) (f64.const 0x1.ffffffffffffcp+50))
+(assert_return (invoke "floor" (f64.const 0x1.0000000000001p+51)) (f64.const 0x1p+51))
+(assert_return (invoke "floor" (f64.const 0x1.0000000000003p+51)) (f64.const 0x1.0000000000002p+51))
+(assert_return (invoke "floor" (f64.const 0x1.a5fb4bcae7367p+4)) (f64.const 0x1.ap+4))
+(assert_return (invoke "floor" (f64.const -0x1.f968ad127164ap+460)) (f64.const -0x1.f968ad127164ap+460))
+(assert_return (invoke "floor" (f64.const 0x1.cf603509c9402p+11)) (f64.const 0x1.cf6p+11))
+(assert_return (invoke "floor" (f64.const -0x1.86a794f93ad0ap-326)) (f64.const -0x1p+0))
+(assert_return (invoke "floor" (f64.const -0x1.3119607d9daeap+348)) (f64.const -0x1.3119607d9daeap+348))
+(assert_return (invoke "floor" (f64.const -0x1.0e568faf5faabp-7)) (f64.const -0x1p+0))
+(assert_return (invoke "floor" (f64.const 0x1.1703ca0ae28ap+169)) (f64.const 0x1.1703ca0ae28ap+169))
+(assert_return (invoke "floor" (f64.const -0x1.590bf177840bap-26)) (f64.const -0x1p+0))
+
+(assert_return (invoke "ceil" (f64.const -0x0p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "ceil" (f64.const 0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "ceil" (f64.const -0x1p-1074)) (f64.const -0x0p+0))
+(assert_return (invoke "ceil" (f64.const 0x1p-1074)) (f64.const 0x1p+0))
+(assert_return (invoke "ceil" (f64.const -0x1p-1022)) (f64.const -0x0p+0))
+(assert_return (invoke "ceil" (f64.const 0x1p-1022)) (f64.const 0x1p+0))
+(assert_return (invoke "ceil" (f64.const -0x1p-1)) (f64.const -0x0p+0))
+(assert_return (invoke "ceil" (f64.const 0x1p-1)) (f64.const 0x1p+0))
+(assert_return (invoke "ceil" (f64.const -0x1p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "ceil" (f64.const 0x1p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "ceil" (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x1.8p+2))
+(assert_return (invoke "ceil" (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x1.cp+2))
+(assert_return (invoke "ceil" (f64.const -0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "ceil" (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "ceil" (f64.const -inf)) (f64.const -inf))
+(assert_return (invoke "ceil" (f64.const inf)) (f64.const inf))
+(assert_return_canonical_nan (invoke "ceil" (f64.const -nan)))
+(assert_return_canonical_nan (invoke "ceil" (f64.const nan)))
+(assert_return_arithmetic_nan (invoke "ceil" (f64.const nan:0xc000000000000)))
+(assert_return_arithmetic_nan (invoke "ceil" (f64.const -nan:0xc000000000000)))
+(assert_return (invoke "ceil" (f64.const -0x1.cp+1)) (f64.const -0x1.8p+1))
+(assert_return (invoke "ceil" (f64.const -0x1.8p+1)) (f64.const -0x1.8p+1))
+(assert_return (invoke "ceil" (f64.const -0x1.4p+1)) (f64.const -0x1p+1))
+(assert_return (invoke "ceil" (f64.const -0x1p+1)) (f64.const -0x1p+1))
+(assert_return (invoke "ceil" (f64.const -0x1.8p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "ceil" (f64.const -0x1p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "ceil" (f64.const -0x1p-1)) (f64.const -0x0p+0))
+(assert_return (invoke "ceil" (f64.const 0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "ceil" (f64.const 0x1p-1)) (f64.const 0x1p+0))
+(assert_return (invoke "ceil" (f64.const 0x1p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "ceil" (f64.const 0x1.8p+0)) (f64.const 0x1p+1))
+(assert_return (invoke "ceil" (f64.const 0x1p+1)) (f64.const 0x1p+1))
+(assert_return (invoke "ceil" (f64.const 0x1.4p+1)) (f64.const 0x1.8p+1))
+(assert_return (invoke "ceil" (f64.const 0x1.8p+1)) (f64.const 0x1.8p+1))
+(assert_return (invoke "ceil" (f64.const 0x1.cp+1)) (f64.const 0x1p+2))
+(assert_return (invoke "ceil" (f64.const 0x1.ffffffffffffep+50)) (f64.const 0x1p+51))
+(assert_return (invoke "ceil" (f64.const 0x1.0000000000001p+51)) (f64.const 0x1.0000000000002p+51))
+(assert_return (invoke "ceil" (f64.const 0x1.0000000000003p+51)) (f64.const 0x1.0000000000004p+51))
+(assert_return (invoke "ceil" (f64.const 0x1.c9547e69fc6ddp-6)) (f64.const 0x1p+0))
+(assert_return (invoke "ceil" (f64.const 0x1.4f3bad20398b5p-3)) (f64.const 0x1p+0))
+(assert_return (invoke "ceil" (f64.const 0x1.00bdef72b004fp+102)) (f64.const 0x1.00bdef72b004fp+102))
+(assert_return (invoke "ceil" (f64.const 0x1.42c82dbc1ec68p-1022)) (f64.const 0x1p+0))
+(assert_return (invoke "ceil" (f64.const -0x1.c84bafa69fdb8p-804)) (f64.const -0x0p+0))
+(assert_return (invoke "ceil" (f64.const -0x1.76c33a8d7d38cp-165)) (f64.const -0x0p+0))
+(assert_return (invoke "ceil" (f64.const -0x1.e5162bbf0e611p-951)) (f64.const -0x0p+0))
+(assert_return (invoke "ceil" (f64.const 0x1.300235e6f3105p-844)) (f64.const 0x1p+0))
+
+(assert_return (invoke "trunc" (f64.const -0x0p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "trunc" (f64.const 0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "trunc" (f64.const -0x1p-1074)) (f64.const -0x0p+0))
+(assert_return (invoke "trunc" (f64.const 0x1p-1074)) (f64.const 0x0p+0))
+(assert_return (invoke "trunc" (f64.const -0x1p-1022)) (f64.const -0x0p+0))
+(assert_return (invoke "trunc" (f64.const 0x1p-1022)) (f64.const 0x0p+0))
+(assert_return (invoke "trunc" (f64.const -0x1p-1)) (f64.const -0x0p+0))
+(assert_return (invoke "trunc" (f64.const 0x1p-1)) (f64.const 0x0p+0))
+(assert_return (invoke "trunc" (f64.const -0x1p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "trunc" (f64.const 0x1p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "trunc" (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x1.8p+2))
+(assert_return (invoke "trunc" (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x1.8p+2))
+(assert_return (invoke "trunc" (f64.const -0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "trunc" (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "trunc" (f64.const -inf)) (f64.const -inf))
+(assert_return (invoke "trunc" (f64.const inf)) (f64.const inf))
+(assert_return_canonical_nan (invoke "trunc" (f64.const -nan)))
+(assert_return_canonical_nan (invoke "trunc" (f64.const nan)))
+(assert_return_arithmetic_nan (invoke "trunc" (f64.const nan:0xc000000000000)))
+(assert_return_arithmetic_nan (invoke "trunc" (f64.const -nan:0xc000000000000)))
+(assert_return (invoke "trunc" (f64.const -0x1.cp+1)) (f64.const -0x1.8p+1))
+(assert_return (invoke "trunc" (f64.const -0x1.8p+1)) (f64.const -0x1.8p+1))
+(assert_return (invoke "trunc" (f64.const -0x1.4p+1)) (f64.const -0x1p+1))
+(assert_return (invoke "trunc" (f64.const -0x1p+1)) (f64.const -0x1p+1))
+(assert_return (invoke "trunc" (f64.const -0x1.8p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "trunc" (f64.const -0x1p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "trunc" (f64.const -0x1p-1)) (f64.const -0x0p+0))
+(assert_return (invoke "trunc" (f64.const 0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "trunc" (f64.const 0x1p-1)) (f64.const 0x0p+0))
+(assert_return (invoke "trunc" (f64.const 0x1p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "trunc" (f64.const 0x1.8p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "trunc" (f64.const 0x1p+1)) (f64.const 0x1p+1))
+(assert_return (invoke "trunc" (f64.const 0x1.4p+1)) (f64.const 0x1p+1))
+(assert_return (invoke "trunc" (f64.const 0x1.8p+1)) (f64.const 0x1.8p+1))
+(assert_return (invoke "trunc" (f64.const 0x1.cp+1)) (f64.const 0x1.8p+1))
+(assert_return (invoke "trunc" (f64.const 0x1.ffffffffffffep+50)) (f64.const 0x1.ffffffffffffcp+50))
+(assert_return (invoke "trunc" (f64.const 0x1.0000000000001p+51)) (f64.const 0x1p+51))
+(assert_return (invoke "trunc" (f64.const 0x1.0000000000003p+51)) (f64.const 0x1.0000000000002p+51))
+(assert_return (invoke "trunc" (f64.const -0x1.6dcd650b6afecp+10)) (f64.const -0x1.6dcp+10))
+(assert_return (invoke "trunc" (f64.const -0x1.9c38a3321915cp+11)) (f64.const -0x1.9c2p+11))
+(assert_return (invoke "trunc" (f64.const -0x1.d1c663e448a22p+15)) (f64.const -0x1.d1c6p+15))
+(assert_return (invoke "trunc" (f64.const -0x1.390653dce1807p-492)) (f64.const -0x0p+0))
+(assert_return (invoke "trunc" (f64.const -0x1.3ffe9e4ec3f65p-221)) (f64.const -0x0p+0))
+(assert_return (invoke "trunc" (f64.const 0x1.66132ddb9020fp-805)) (f64.const 0x0p+0))
+(assert_return (invoke "trunc" (f64.const -0x1.37ed4a27a2784p-13)) (f64.const -0x0p+0))
+(assert_return (invoke "trunc" (f64.const -0x1.b123d85f7bd9ep-4)) (f64.const -0x0p+0))
+
+(assert_return (invoke "nearest" (f64.const -0x0p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "nearest" (f64.const 0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "nearest" (f64.const -0x1p-1074)) (f64.const -0x0p+0))
+(assert_return (invoke "nearest" (f64.const 0x1p-1074)) (f64.const 0x0p+0))
+(assert_return (invoke "nearest" (f64.const -0x1p-1022)) (f64.const -0x0p+0))
+(assert_return (invoke "nearest" (f64.const 0x1p-1022)) (f64.const 0x0p+0))
+(assert_return (invoke "nearest" (f64.const -0x1p-1)) (f64.const -0x0p+0))
+(assert_return (invoke "nearest" (f64.const 0x1p-1)) (f64.const 0x0p+0))
+(assert_return (invoke "nearest" (f64.const -0x1p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "nearest" (f64.const 0x1p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "nearest" (f64.const -0x1.921fb54442d18p+2)) (f64.const -0x1.8p+2))
+(assert_return (invoke "nearest" (f64.const 0x1.921fb54442d18p+2)) (f64.const 0x1.8p+2))
+(assert_return (invoke "nearest" (f64.const -0x1.fffffffffffffp+1023)) (f64.const -0x1.fffffffffffffp+1023))
+(assert_return (invoke "nearest" (f64.const 0x1.fffffffffffffp+1023)) (f64.const 0x1.fffffffffffffp+1023))
+(assert_return (invoke "nearest" (f64.const -inf)) (f64.const -inf))
+(assert_return (invoke "nearest" (f64.const inf)) (f64.const inf))
+(assert_return_canonical_nan (invoke "nearest" (f64.const -nan)))
+(assert_return_canonical_nan (invoke "nearest" (f64.const nan)))
+(assert_return_arithmetic_nan (invoke "nearest" (f64.const nan:0xc000000000000)))
+(assert_return_arithmetic_nan (invoke "nearest" (f64.const -nan:0xc000000000000)))
+(assert_return (invoke "nearest" (f64.const -0x1.cp+1)) (f64.const -0x1p+2))
+(assert_return (invoke "nearest" (f64.const -0x1.8p+1)) (f64.const -0x1.8p+1))
+(assert_return (invoke "nearest" (f64.const -0x1.4p+1)) (f64.const -0x1p+1))
+(assert_return (invoke "nearest" (f64.const -0x1p+1)) (f64.const -0x1p+1))
+(assert_return (invoke "nearest" (f64.const -0x1.8p+0)) (f64.const -0x1p+1))
+(assert_return (invoke "nearest" (f64.const -0x1p+0)) (f64.const -0x1p+0))
+(assert_return (invoke "nearest" (f64.const -0x1p-1)) (f64.const -0x0p+0))
+(assert_return (invoke "nearest" (f64.const 0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "nearest" (f64.const 0x1p-1)) (f64.const 0x0p+0))
+(assert_return (invoke "nearest" (f64.const 0x1p+0)) (f64.const 0x1p+0))
+(assert_return (invoke "nearest" (f64.const 0x1.8p+0)) (f64.const 0x1p+1))
+(assert_return (invoke "nearest" (f64.const 0x1p+1)) (f64.const 0x1p+1))
+(assert_return (invoke "nearest" (f64.const 0x1.4p+1)) (f64.const 0x1p+1))
+(assert_return (invoke "nearest" (f64.const 0x1.8p+1)) (f64.const 0x1.8p+1))
+(assert_return (invoke "nearest" (f64.const 0x1.cp+1)) (f64.const 0x1p+2))
+(assert_return (invoke "nearest" (f64.const 0x1.ffffffffffffep+50)) (f64.const 0x1p+51))
+(assert_return (invoke "nearest" (f64.const 0x1.0000000000001p+51)) (f64.const 0x1p+51))
+(assert_return (invoke "nearest" (f64.const 0x1.0000000000003p+51)) (f64.const 0x1.0000000000004p+51))
+(assert_return (invoke "nearest" (f64.const 0x1.6b062975e7481p-943)) (f64.const 0x0p+0))
+(assert_return (invoke "nearest" (f64.const -0x1.ad61ba1b3b06p+539)) (f64.const -0x1.ad61ba1b3b06p+539))
+(assert_return (invoke "nearest" (f64.const 0x1.4d96c287d5528p-352)) (f64.const 0x0p+0))
+(assert_return (invoke "nearest" (f64.const 0x1.64c9e56987e69p+330)) (f64.const 0x1.64c9e56987e69p+330))
+(assert_return (invoke "nearest" (f64.const -0x1.ca8dd9ab4cfa7p-296)) (f64.const -0x0p+0))
+(assert_return (invoke "nearest" (f64.const 0x1.9afebe515ef4fp+472)) (f64.const 0x1.9afebe515ef4fp+472))
+(assert_return (invoke "nearest" (f64.const 0x1.364c6c44a75dbp-852)) (f64.const 0x0p+0))
+(assert_return (invoke "nearest" (f64.const 0x1.d3db603c9d185p-1)) (f64.const 0x1p+0))
+
@@ -0,0 +1,6067 @@
+;; i32 operations
+(module
+  (func (export "add") (param $x i32) (param $y i32) (result i32) (i32.add (get_local $x) (get_local $y)))
+  (func (export "sub") (param $x i32) (param $y i32) (result i32) (i32.sub (get_local $x) (get_local $y)))
+  (func (export "mul") (param $x i32) (param $y i32) (result i32) (i32.mul (get_local $x) (get_local $y)))
+  (func (export "div_s") (param $x i32) (param $y i32) (result i32) (i32.div_s (get_local $x) (get_local $y)))
+  (func (export "div_u") (param $x i32) (param $y i32) (result i32) (i32.div_u (get_local $x) (get_local $y)))
+  (func (export "rem_s") (param $x i32) (param $y i32) (result i32) (i32.rem_s (get_local $x) (get_local $y)))
+  (func (export "rem_u") (param $x i32) (param $y i32) (result i32) (i32.rem_u (get_local $x) (get_local $y)))
+  (func (export "and") (param $x i32) (param $y i32) (result i32) (i32.and (get_local $x) (get_local $y)))
+  (func (export "or") (param $x i32) (param $y i32) (result i32) (i32.or (get_local $x) (get_local $y)))
+  (func (export "xor") (param $x i32) (param $y i32) (result i32) (i32.xor (get_local $x) (get_local $y)))
+  (func (export "shl") (param $x i32) (param $y i32) (result i32) (i32.shl (get_local $x) (get_local $y)))
+  (func (export "shr_s") (param $x i32) (param $y i32) (result i32) (i32.shr_s (get_local $x) (get_local $y)))
+  (func (export "shr_u") (param $x i32) (param $y i32) (result i32) (i32.shr_u (get_local $x) (get_local $y)))
+  (func (export "rotl") (param $x i32) (param $y i32) (result i32) (i32.rotl (get_local $x) (get_local $y)))
+  (func (export "rotr") (param $x i32) (param $y i32) (result i32) (i32.rotr (get_local $x) (get_local $y)))
+  (func (export "clz") (param $x i32) (result i32) (i32.clz (get_local $x)))
+  (func (export "ctz") (param $x i32) (result i32) (i32.ctz (get_local $x)))
+  (func (export "popcnt") (param $x i32) (result i32) (i32.popcnt (get_local $x)))
+  (func (export "eqz") (param $x i32) (result i32) (i32.eqz (get_local $x)))
+  (func (export "eq") (param $x i32) (param $y i32) (result i32) (i32.eq (get_local $x) (get_local $y)))
+  (func (export "ne") (param $x i32) (param $y i32) (result i32) (i32.ne (get_local $x) (get_local $y)))
+  (func (export "lt_s") (param $x i32) (param $y i32) (result i32) (i32.lt_s (get_local $x) (get_local $y)))
+  (func (export "lt_u") (param $x i32) (param $y i32) (result i32) (i32.lt_u (get_local $x) (get_local $y)))
+  (func (export "le_s") (param $x i32) (param $y i32) (result i32) (i32.le_s (get_local $x) (get_local $y)))
+  (func (export "le_u") (param $x i32) (param $y i32) (result i32) (i32.le_u (get_local $x) (get_local $y)))
+  (func (export "gt_s") (param $x i32) (param $y i32) (result i32) (i32.gt_s (get_local $x) (get_local $y)))
+  (func (export "gt_u") (param $x i32) (param $y i32) (result i32) (i32.gt_u (get_local $x) (get_local $y)))
+  (func (export "ge_s") (param $x i32) (param $y i32) (result i32) (i32.ge_s (get_local $x) (get_local $y)))
+  (func (export "ge_u") (param $x i32) (param $y i32) (result i32) (i32.ge_u (get_local $x) (get_local $y)))
+)
+
+(assert_return (invoke "add" (i32.const 0) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "add" (i32.const 0) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "add" (i32.const 0) (i32.const -1)) (i32.const -1))
+(assert_return (invoke "add" (i32.const 0) (i32.const 2)) (i32.const 2))
+(assert_return (invoke "add" (i32.const 0) (i32.const 3)) (i32.const 3))
+(assert_return (invoke "add" (i32.const 0) (i32.const 0x7fffffff)) (i32.const 0x7fffffff))
+(assert_return (invoke "add" (i32.const 0) (i32.const 0x80000000)) (i32.const 0x80000000))
+(assert_return (invoke "add" (i32.const 0) (i32.const 0x80000001)) (i32.const 0x80000001))
+(assert_return (invoke "add" (i32.const 0) (i32.const 0x40000000)) (i32.const 0x40000000))
+(assert_return (invoke "add" (i32.const 0) (i32.const 0x12345678)) (i32.const 0x12345678))
+(assert_return (invoke "add" (i32.const 0) (i32.const 0xedcba988)) (i32.const 0xedcba988))
+(assert_return (invoke "add" (i32.const 0) (i32.const 31)) (i32.const 31))
+(assert_return (invoke "add" (i32.const 0) (i32.const 32)) (i32.const 32))
+(assert_return (invoke "add" (i32.const 0) (i32.const 33)) (i32.const 33))
+(assert_return (invoke "add" (i32.const 0) (i32.const 0xfff5a5a)) (i32.const 0xfff5a5a))
+(assert_return (invoke "add" (i32.const 1) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "add" (i32.const 1) (i32.const 1)) (i32.const 2))
+(assert_return (invoke "add" (i32.const 1) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "add" (i32.const 1) (i32.const 2)) (i32.const 3))
+(assert_return (invoke "add" (i32.const 1) (i32.const 3)) (i32.const 4))
+(assert_return (invoke "add" (i32.const 1) (i32.const 0x7fffffff)) (i32.const 0x80000000))
+(assert_return (invoke "add" (i32.const 1) (i32.const 0x80000000)) (i32.const 0x80000001))
+(assert_return (invoke "add" (i32.const 1) (i32.const 0x80000001)) (i32.const 0x80000002))
+(assert_return (invoke "add" (i32.const 1) (i32.const 0x40000000)) (i32.const 0x40000001))
+(assert_return (invoke "add" (i32.const 1) (i32.const 0x12345678)) (i32.const 0x12345679))
+(assert_return (invoke "add" (i32.const 1) (i32.const 0xedcba988)) (i32.const 0xedcba989))
+(assert_return (invoke "add" (i32.const 1) (i32.const 31)) (i32.const 32))
+(assert_return (invoke "add" (i32.const 1) (i32.const 32)) (i32.const 33))
+(assert_return (invoke "add" (i32.const 1) (i32.const 33)) (i32.const 34))
+(assert_return (invoke "add" (i32.const 1) (i32.const 0xfff5a5a)) (i32.const 0xfff5a5b))
+(assert_return (invoke "add" (i32.const -1) (i32.const 0)) (i32.const -1))
+(assert_return (invoke "add" (i32.const -1) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "add" (i32.const -1) (i32.const -1)) (i32.const -2))
+(assert_return (invoke "add" (i32.const -1) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "add" (i32.const -1) (i32.const 3)) (i32.const 2))
+(assert_return (invoke "add" (i32.const -1) (i32.const 0x7fffffff)) (i32.const 0x7ffffffe))
+(assert_return (invoke "add" (i32.const -1) (i32.const 0x80000000)) (i32.const 0x7fffffff))
+(assert_return (invoke "add" (i32.const -1) (i32.const 0x80000001)) (i32.const 0x80000000))
+(assert_return (invoke "add" (i32.const -1) (i32.const 0x40000000)) (i32.const 0x3fffffff))
+(assert_return (invoke "add" (i32.const -1) (i32.const 0x12345678)) (i32.const 0x12345677))
+(assert_return (invoke "add" (i32.const -1) (i32.const 0xedcba988)) (i32.const 0xedcba987))
+(assert_return (invoke "add" (i32.const -1) (i32.const 31)) (i32.const 30))
+(assert_return (invoke "add" (i32.const -1) (i32.const 32)) (i32.const 31))
+(assert_return (invoke "add" (i32.const -1) (i32.const 33)) (i32.const 32))
+(assert_return (invoke "add" (i32.const -1) (i32.const 0xfff5a5a)) (i32.const 0xfff5a59))
+(assert_return (invoke "add" (i32.const 2) (i32.const 0)) (i32.const 2))
+(assert_return (invoke "add" (i32.const 2) (i32.const 1)) (i32.const 3))
+(assert_return (invoke "add" (i32.const 2) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "add" (i32.const 2) (i32.const 2)) (i32.const 4))
+(assert_return (invoke "add" (i32.const 2) (i32.const 3)) (i32.const 5))
+(assert_return (invoke "add" (i32.const 2) (i32.const 0x7fffffff)) (i32.const 0x80000001))
+(assert_return (invoke "add" (i32.const 2) (i32.const 0x80000000)) (i32.const 0x80000002))
+(assert_return (invoke "add" (i32.const 2) (i32.const 0x80000001)) (i32.const 0x80000003))
+(assert_return (invoke "add" (i32.const 2) (i32.const 0x40000000)) (i32.const 0x40000002))
+(assert_return (invoke "add" (i32.const 2) (i32.const 0x12345678)) (i32.const 0x1234567a))
+(assert_return (invoke "add" (i32.const 2) (i32.const 0xedcba988)) (i32.const 0xedcba98a))
+(assert_return (invoke "add" (i32.const 2) (i32.const 31)) (i32.const 33))
+(assert_return (invoke "add" (i32.const 2) (i32.const 32)) (i32.const 34))
+(assert_return (invoke "add" (i32.const 2) (i32.const 33)) (i32.const 35))
+(assert_return (invoke "add" (i32.const 2) (i32.const 0xfff5a5a)) (i32.const 0xfff5a5c))
+(assert_return (invoke "add" (i32.const 3) (i32.const 0)) (i32.const 3))
+(assert_return (invoke "add" (i32.const 3) (i32.const 1)) (i32.const 4))
+(assert_return (invoke "add" (i32.const 3) (i32.const -1)) (i32.const 2))
+(assert_return (invoke "add" (i32.const 3) (i32.const 2)) (i32.const 5))
+(assert_return (invoke "add" (i32.const 3) (i32.const 3)) (i32.const 6))
+(assert_return (invoke "add" (i32.const 3) (i32.const 0x7fffffff)) (i32.const 0x80000002))
+(assert_return (invoke "add" (i32.const 3) (i32.const 0x80000000)) (i32.const 0x80000003))
+(assert_return (invoke "add" (i32.const 3) (i32.const 0x80000001)) (i32.const 0x80000004))
+(assert_return (invoke "add" (i32.const 3) (i32.const 0x40000000)) (i32.const 0x40000003))
+(assert_return (invoke "add" (i32.const 3) (i32.const 0x12345678)) (i32.const 0x1234567b))
+(assert_return (invoke "add" (i32.const 3) (i32.const 0xedcba988)) (i32.const 0xedcba98b))
+(assert_return (invoke "add" (i32.const 3) (i32.const 31)) (i32.const 34))
+(assert_return (invoke "add" (i32.const 3) (i32.const 32)) (i32.const 35))
+(assert_return (invoke "add" (i32.const 3) (i32.const 33)) (i32.const 36))
+(assert_return (invoke "add" (i32.const 3) (i32.const 0xfff5a5a)) (i32.const 0xfff5a5d))
+(assert_return (invoke "add" (i32.const 0x7fffffff) (i32.const 0)) (i32.const 0x7fffffff))
+(assert_return (invoke "add" (i32.const 0x7fffffff) (i32.const 1)) (i32.const 0x80000000))
+(assert_return (invoke "add" (i32.const 0x7fffffff) (i32.const -1)) (i32.const 0x7ffffffe))
+(assert_return (invoke "add" (i32.const 0x7fffffff) (i32.const 2)) (i32.const 0x80000001))
+(assert_return (invoke "add" (i32.const 0x7fffffff) (i32.const 3)) (i32.const 0x80000002))
+(assert_return (invoke "add" (i32.const 0x7fffffff) (i32.const 0x7fffffff)) (i32.const -2))
+(assert_return (invoke "add" (i32.const 0x7fffffff) (i32.const 0x80000000)) (i32.const -1))
+(assert_return (invoke "add" (i32.const 0x7fffffff) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "add" (i32.const 0x7fffffff) (i32.const 0x40000000)) (i32.const 0xbfffffff))
+(assert_return (invoke "add" (i32.const 0x7fffffff) (i32.const 0x12345678)) (i32.const 0x92345677))
+(assert_return (invoke "add" (i32.const 0x7fffffff) (i32.const 0xedcba988)) (i32.const 0x6dcba987))
+(assert_return (invoke "add" (i32.const 0x7fffffff) (i32.const 31)) (i32.const 0x8000001e))
+(assert_return (invoke "add" (i32.const 0x7fffffff) (i32.const 32)) (i32.const 0x8000001f))
+(assert_return (invoke "add" (i32.const 0x7fffffff) (i32.const 33)) (i32.const 0x80000020))
+(assert_return (invoke "add" (i32.const 0x7fffffff) (i32.const 0xfff5a5a)) (i32.const 0x8fff5a59))
+(assert_return (invoke "add" (i32.const 0x80000000) (i32.const 0)) (i32.const 0x80000000))
+(assert_return (invoke "add" (i32.const 0x80000000) (i32.const 1)) (i32.const 0x80000001))
+(assert_return (invoke "add" (i32.const 0x80000000) (i32.const -1)) (i32.const 0x7fffffff))
+(assert_return (invoke "add" (i32.const 0x80000000) (i32.const 2)) (i32.const 0x80000002))
+(assert_return (invoke "add" (i32.const 0x80000000) (i32.const 3)) (i32.const 0x80000003))
+(assert_return (invoke "add" (i32.const 0x80000000) (i32.const 0x7fffffff)) (i32.const -1))
+(assert_return (invoke "add" (i32.const 0x80000000) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "add" (i32.const 0x80000000) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "add" (i32.const 0x80000000) (i32.const 0x40000000)) (i32.const 0xc0000000))
+(assert_return (invoke "add" (i32.const 0x80000000) (i32.const 0x12345678)) (i32.const 0x92345678))
+(assert_return (invoke "add" (i32.const 0x80000000) (i32.const 0xedcba988)) (i32.const 0x6dcba988))
+(assert_return (invoke "add" (i32.const 0x80000000) (i32.const 31)) (i32.const 0x8000001f))
+(assert_return (invoke "add" (i32.const 0x80000000) (i32.const 32)) (i32.const 0x80000020))
+(assert_return (invoke "add" (i32.const 0x80000000) (i32.const 33)) (i32.const 0x80000021))
+(assert_return (invoke "add" (i32.const 0x80000000) (i32.const 0xfff5a5a)) (i32.const 0x8fff5a5a))
+(assert_return (invoke "add" (i32.const 0x80000001) (i32.const 0)) (i32.const 0x80000001))
+(assert_return (invoke "add" (i32.const 0x80000001) (i32.const 1)) (i32.const 0x80000002))
+(assert_return (invoke "add" (i32.const 0x80000001) (i32.const -1)) (i32.const 0x80000000))
+(assert_return (invoke "add" (i32.const 0x80000001) (i32.const 2)) (i32.const 0x80000003))
+(assert_return (invoke "add" (i32.const 0x80000001) (i32.const 3)) (i32.const 0x80000004))
+(assert_return (invoke "add" (i32.const 0x80000001) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "add" (i32.const 0x80000001) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "add" (i32.const 0x80000001) (i32.const 0x80000001)) (i32.const 2))
+(assert_return (invoke "add" (i32.const 0x80000001) (i32.const 0x40000000)) (i32.const 0xc0000001))
+(assert_return (invoke "add" (i32.const 0x80000001) (i32.const 0x12345678)) (i32.const 0x92345679))
+(assert_return (invoke "add" (i32.const 0x80000001) (i32.const 0xedcba988)) (i32.const 0x6dcba989))
+(assert_return (invoke "add" (i32.const 0x80000001) (i32.const 31)) (i32.const 0x80000020))
+(assert_return (invoke "add" (i32.const 0x80000001) (i32.const 32)) (i32.const 0x80000021))
+(assert_return (invoke "add" (i32.const 0x80000001) (i32.const 33)) (i32.const 0x80000022))
+(assert_return (invoke "add" (i32.const 0x80000001) (i32.const 0xfff5a5a)) (i32.const 0x8fff5a5b))
+(assert_return (invoke "add" (i32.const 0x40000000) (i32.const 0)) (i32.const 0x40000000))
+(assert_return (invoke "add" (i32.const 0x40000000) (i32.const 1)) (i32.const 0x40000001))
+(assert_return (invoke "add" (i32.const 0x40000000) (i32.const -1)) (i32.const 0x3fffffff))
+(assert_return (invoke "add" (i32.const 0x40000000) (i32.const 2)) (i32.const 0x40000002))
+(assert_return (invoke "add" (i32.const 0x40000000) (i32.const 3)) (i32.const 0x40000003))
+(assert_return (invoke "add" (i32.const 0x40000000) (i32.const 0x7fffffff)) (i32.const 0xbfffffff))
+(assert_return (invoke "add" (i32.const 0x40000000) (i32.const 0x80000000)) (i32.const 0xc0000000))
+(assert_return (invoke "add" (i32.const 0x40000000) (i32.const 0x80000001)) (i32.const 0xc0000001))
+(assert_return (invoke "add" (i32.const 0x40000000) (i32.const 0x40000000)) (i32.const 0x80000000))
+(assert_return (invoke "add" (i32.const 0x40000000) (i32.const 0x12345678)) (i32.const 0x52345678))
+(assert_return (invoke "add" (i32.const 0x40000000) (i32.const 0xedcba988)) (i32.const 0x2dcba988))
+(assert_return (invoke "add" (i32.const 0x40000000) (i32.const 31)) (i32.const 0x4000001f))
+(assert_return (invoke "add" (i32.const 0x40000000) (i32.const 32)) (i32.const 0x40000020))
+(assert_return (invoke "add" (i32.const 0x40000000) (i32.const 33)) (i32.const 0x40000021))
+(assert_return (invoke "add" (i32.const 0x40000000) (i32.const 0xfff5a5a)) (i32.const 0x4fff5a5a))
+(assert_return (invoke "add" (i32.const 0x12345678) (i32.const 0)) (i32.const 0x12345678))
+(assert_return (invoke "add" (i32.const 0x12345678) (i32.const 1)) (i32.const 0x12345679))
+(assert_return (invoke "add" (i32.const 0x12345678) (i32.const -1)) (i32.const 0x12345677))
+(assert_return (invoke "add" (i32.const 0x12345678) (i32.const 2)) (i32.const 0x1234567a))
+(assert_return (invoke "add" (i32.const 0x12345678) (i32.const 3)) (i32.const 0x1234567b))
+(assert_return (invoke "add" (i32.const 0x12345678) (i32.const 0x7fffffff)) (i32.const 0x92345677))
+(assert_return (invoke "add" (i32.const 0x12345678) (i32.const 0x80000000)) (i32.const 0x92345678))
+(assert_return (invoke "add" (i32.const 0x12345678) (i32.const 0x80000001)) (i32.const 0x92345679))
+(assert_return (invoke "add" (i32.const 0x12345678) (i32.const 0x40000000)) (i32.const 0x52345678))
+(assert_return (invoke "add" (i32.const 0x12345678) (i32.const 0x12345678)) (i32.const 0x2468acf0))
+(assert_return (invoke "add" (i32.const 0x12345678) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "add" (i32.const 0x12345678) (i32.const 31)) (i32.const 0x12345697))
+(assert_return (invoke "add" (i32.const 0x12345678) (i32.const 32)) (i32.const 0x12345698))
+(assert_return (invoke "add" (i32.const 0x12345678) (i32.const 33)) (i32.const 0x12345699))
+(assert_return (invoke "add" (i32.const 0x12345678) (i32.const 0xfff5a5a)) (i32.const 0x2233b0d2))
+(assert_return (invoke "add" (i32.const 0xedcba988) (i32.const 0)) (i32.const 0xedcba988))
+(assert_return (invoke "add" (i32.const 0xedcba988) (i32.const 1)) (i32.const 0xedcba989))
+(assert_return (invoke "add" (i32.const 0xedcba988) (i32.const -1)) (i32.const 0xedcba987))
+(assert_return (invoke "add" (i32.const 0xedcba988) (i32.const 2)) (i32.const 0xedcba98a))
+(assert_return (invoke "add" (i32.const 0xedcba988) (i32.const 3)) (i32.const 0xedcba98b))
+(assert_return (invoke "add" (i32.const 0xedcba988) (i32.const 0x7fffffff)) (i32.const 0x6dcba987))
+(assert_return (invoke "add" (i32.const 0xedcba988) (i32.const 0x80000000)) (i32.const 0x6dcba988))
+(assert_return (invoke "add" (i32.const 0xedcba988) (i32.const 0x80000001)) (i32.const 0x6dcba989))
+(assert_return (invoke "add" (i32.const 0xedcba988) (i32.const 0x40000000)) (i32.const 0x2dcba988))
+(assert_return (invoke "add" (i32.const 0xedcba988) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "add" (i32.const 0xedcba988) (i32.const 0xedcba988)) (i32.const 0xdb975310))
+(assert_return (invoke "add" (i32.const 0xedcba988) (i32.const 31)) (i32.const 0xedcba9a7))
+(assert_return (invoke "add" (i32.const 0xedcba988) (i32.const 32)) (i32.const 0xedcba9a8))
+(assert_return (invoke "add" (i32.const 0xedcba988) (i32.const 33)) (i32.const 0xedcba9a9))
+(assert_return (invoke "add" (i32.const 0xedcba988) (i32.const 0xfff5a5a)) (i32.const 0xfdcb03e2))
+(assert_return (invoke "add" (i32.const 31) (i32.const 0)) (i32.const 31))
+(assert_return (invoke "add" (i32.const 31) (i32.const 1)) (i32.const 32))
+(assert_return (invoke "add" (i32.const 31) (i32.const -1)) (i32.const 30))
+(assert_return (invoke "add" (i32.const 31) (i32.const 2)) (i32.const 33))
+(assert_return (invoke "add" (i32.const 31) (i32.const 3)) (i32.const 34))
+(assert_return (invoke "add" (i32.const 31) (i32.const 0x7fffffff)) (i32.const 0x8000001e))
+(assert_return (invoke "add" (i32.const 31) (i32.const 0x80000000)) (i32.const 0x8000001f))
+(assert_return (invoke "add" (i32.const 31) (i32.const 0x80000001)) (i32.const 0x80000020))
+(assert_return (invoke "add" (i32.const 31) (i32.const 0x40000000)) (i32.const 0x4000001f))
+(assert_return (invoke "add" (i32.const 31) (i32.const 0x12345678)) (i32.const 0x12345697))
+(assert_return (invoke "add" (i32.const 31) (i32.const 0xedcba988)) (i32.const 0xedcba9a7))
+(assert_return (invoke "add" (i32.const 31) (i32.const 31)) (i32.const 62))
+(assert_return (invoke "add" (i32.const 31) (i32.const 32)) (i32.const 63))
+(assert_return (invoke "add" (i32.const 31) (i32.const 33)) (i32.const 64))
+(assert_return (invoke "add" (i32.const 31) (i32.const 0xfff5a5a)) (i32.const 0xfff5a79))
+(assert_return (invoke "add" (i32.const 32) (i32.const 0)) (i32.const 32))
+(assert_return (invoke "add" (i32.const 32) (i32.const 1)) (i32.const 33))
+(assert_return (invoke "add" (i32.const 32) (i32.const -1)) (i32.const 31))
+(assert_return (invoke "add" (i32.const 32) (i32.const 2)) (i32.const 34))
+(assert_return (invoke "add" (i32.const 32) (i32.const 3)) (i32.const 35))
+(assert_return (invoke "add" (i32.const 32) (i32.const 0x7fffffff)) (i32.const 0x8000001f))
+(assert_return (invoke "add" (i32.const 32) (i32.const 0x80000000)) (i32.const 0x80000020))
+(assert_return (invoke "add" (i32.const 32) (i32.const 0x80000001)) (i32.const 0x80000021))
+(assert_return (invoke "add" (i32.const 32) (i32.const 0x40000000)) (i32.const 0x40000020))
+(assert_return (invoke "add" (i32.const 32) (i32.const 0x12345678)) (i32.const 0x12345698))
+(assert_return (invoke "add" (i32.const 32) (i32.const 0xedcba988)) (i32.const 0xedcba9a8))
+(assert_return (invoke "add" (i32.const 32) (i32.const 31)) (i32.const 63))
+(assert_return (invoke "add" (i32.const 32) (i32.const 32)) (i32.const 64))
+(assert_return (invoke "add" (i32.const 32) (i32.const 33)) (i32.const 65))
+(assert_return (invoke "add" (i32.const 32) (i32.const 0xfff5a5a)) (i32.const 0xfff5a7a))
+(assert_return (invoke "add" (i32.const 33) (i32.const 0)) (i32.const 33))
+(assert_return (invoke "add" (i32.const 33) (i32.const 1)) (i32.const 34))
+(assert_return (invoke "add" (i32.const 33) (i32.const -1)) (i32.const 32))
+(assert_return (invoke "add" (i32.const 33) (i32.const 2)) (i32.const 35))
+(assert_return (invoke "add" (i32.const 33) (i32.const 3)) (i32.const 36))
+(assert_return (invoke "add" (i32.const 33) (i32.const 0x7fffffff)) (i32.const 0x80000020))
+(assert_return (invoke "add" (i32.const 33) (i32.const 0x80000000)) (i32.const 0x80000021))
+(assert_return (invoke "add" (i32.const 33) (i32.const 0x80000001)) (i32.const 0x80000022))
+(assert_return (invoke "add" (i32.const 33) (i32.const 0x40000000)) (i32.const 0x40000021))
+(assert_return (invoke "add" (i32.const 33) (i32.const 0x12345678)) (i32.const 0x12345699))
+(assert_return (invoke "add" (i32.const 33) (i32.const 0xedcba988)) (i32.const 0xedcba9a9))
+(assert_return (invoke "add" (i32.const 33) (i32.const 31)) (i32.const 64))
+(assert_return (invoke "add" (i32.const 33) (i32.const 32)) (i32.const 65))
+(assert_return (invoke "add" (i32.const 33) (i32.const 33)) (i32.const 66))
+(assert_return (invoke "add" (i32.const 33) (i32.const 0xfff5a5a)) (i32.const 0xfff5a7b))
+(assert_return (invoke "add" (i32.const 0xfff5a5a) (i32.const 0)) (i32.const 0xfff5a5a))
+(assert_return (invoke "add" (i32.const 0xfff5a5a) (i32.const 1)) (i32.const 0xfff5a5b))
+(assert_return (invoke "add" (i32.const 0xfff5a5a) (i32.const -1)) (i32.const 0xfff5a59))
+(assert_return (invoke "add" (i32.const 0xfff5a5a) (i32.const 2)) (i32.const 0xfff5a5c))
+(assert_return (invoke "add" (i32.const 0xfff5a5a) (i32.const 3)) (i32.const 0xfff5a5d))
+(assert_return (invoke "add" (i32.const 0xfff5a5a) (i32.const 0x7fffffff)) (i32.const 0x8fff5a59))
+(assert_return (invoke "add" (i32.const 0xfff5a5a) (i32.const 0x80000000)) (i32.const 0x8fff5a5a))
+(assert_return (invoke "add" (i32.const 0xfff5a5a) (i32.const 0x80000001)) (i32.const 0x8fff5a5b))
+(assert_return (invoke "add" (i32.const 0xfff5a5a) (i32.const 0x40000000)) (i32.const 0x4fff5a5a))
+(assert_return (invoke "add" (i32.const 0xfff5a5a) (i32.const 0x12345678)) (i32.const 0x2233b0d2))
+(assert_return (invoke "add" (i32.const 0xfff5a5a) (i32.const 0xedcba988)) (i32.const 0xfdcb03e2))
+(assert_return (invoke "add" (i32.const 0xfff5a5a) (i32.const 31)) (i32.const 0xfff5a79))
+(assert_return (invoke "add" (i32.const 0xfff5a5a) (i32.const 32)) (i32.const 0xfff5a7a))
+(assert_return (invoke "add" (i32.const 0xfff5a5a) (i32.const 33)) (i32.const 0xfff5a7b))
+(assert_return (invoke "add" (i32.const 0xfff5a5a) (i32.const 0xfff5a5a)) (i32.const 0x1ffeb4b4))
+(assert_return (invoke "add" (i32.const 0x13d1e9e3) (i32.const 0xed2ef1c1)) (i32.const 0x100dba4))
+(assert_return (invoke "add" (i32.const 0x36af971e) (i32.const 0x2507759b)) (i32.const 0x5bb70cb9))
+(assert_return (invoke "add" (i32.const 0x4d99d19c) (i32.const 0xb2c75357)) (i32.const 0x6124f3))
+(assert_return (invoke "add" (i32.const 0xe6746772) (i32.const 0x3ce0216c)) (i32.const 0x235488de))
+(assert_return (invoke "add" (i32.const 0x7f0a674d) (i32.const 0x639f08b)) (i32.const 0x854457d8))
+(assert_return (invoke "add" (i32.const 0xb861afb7) (i32.const 0x9de6e53)) (i32.const 0xc2401e0a))
+(assert_return (invoke "add" (i32.const 0x19a90675) (i32.const 0x5320dff0)) (i32.const 0x6cc9e665))
+(assert_return (invoke "add" (i32.const 0x82490b3b) (i32.const 0x54913be5)) (i32.const 0xd6da4720))
+(assert_return (invoke "add" (i32.const 0xd7d3a0ae) (i32.const 0xe9ba56d)) (i32.const 0xe66f461b))
+(assert_return (invoke "add" (i32.const 0x85d62cf3) (i32.const 0xb0c9049e)) (i32.const 0x369f3191))
+(assert_return (invoke "add" (i32.const 0xf1d7e893) (i32.const 0xfe1f014e)) (i32.const 0xeff6e9e1))
+(assert_return (invoke "add" (i32.const 0xe1bda755) (i32.const 0x78015f97)) (i32.const 0x59bf06ec))
+(assert_return (invoke "add" (i32.const 0x5ef74752) (i32.const 0x99e90c3b)) (i32.const 0xf8e0538d))
+(assert_return (invoke "add" (i32.const 0x30e565) (i32.const 0x8adb5a90)) (i32.const 0x8b0c3ff5))
+(assert_return (invoke "add" (i32.const 0xc15930b6) (i32.const 0xc812ab06)) (i32.const 0x896bdbbc))
+(assert_return (invoke "add" (i32.const 0x205c5a84) (i32.const 0x86502637)) (i32.const 0xa6ac80bb))
+
+(assert_return (invoke "sub" (i32.const 0) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "sub" (i32.const 0) (i32.const 1)) (i32.const -1))
+(assert_return (invoke "sub" (i32.const 0) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "sub" (i32.const 0) (i32.const 2)) (i32.const -2))
+(assert_return (invoke "sub" (i32.const 0) (i32.const 3)) (i32.const -3))
+(assert_return (invoke "sub" (i32.const 0) (i32.const 0x7fffffff)) (i32.const 0x80000001))
+(assert_return (invoke "sub" (i32.const 0) (i32.const 0x80000000)) (i32.const 0x80000000))
+(assert_return (invoke "sub" (i32.const 0) (i32.const 0x80000001)) (i32.const 0x7fffffff))
+(assert_return (invoke "sub" (i32.const 0) (i32.const 0x40000000)) (i32.const 0xc0000000))
+(assert_return (invoke "sub" (i32.const 0) (i32.const 0x12345678)) (i32.const 0xedcba988))
+(assert_return (invoke "sub" (i32.const 0) (i32.const 0xedcba988)) (i32.const 0x12345678))
+(assert_return (invoke "sub" (i32.const 0) (i32.const 31)) (i32.const -31))
+(assert_return (invoke "sub" (i32.const 0) (i32.const 32)) (i32.const -32))
+(assert_return (invoke "sub" (i32.const 0) (i32.const 33)) (i32.const -33))
+(assert_return (invoke "sub" (i32.const 0) (i32.const 0xfff5a5a)) (i32.const 0xf000a5a6))
+(assert_return (invoke "sub" (i32.const 1) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "sub" (i32.const 1) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "sub" (i32.const 1) (i32.const -1)) (i32.const 2))
+(assert_return (invoke "sub" (i32.const 1) (i32.const 2)) (i32.const -1))
+(assert_return (invoke "sub" (i32.const 1) (i32.const 3)) (i32.const -2))
+(assert_return (invoke "sub" (i32.const 1) (i32.const 0x7fffffff)) (i32.const 0x80000002))
+(assert_return (invoke "sub" (i32.const 1) (i32.const 0x80000000)) (i32.const 0x80000001))
+(assert_return (invoke "sub" (i32.const 1) (i32.const 0x80000001)) (i32.const 0x80000000))
+(assert_return (invoke "sub" (i32.const 1) (i32.const 0x40000000)) (i32.const 0xc0000001))
+(assert_return (invoke "sub" (i32.const 1) (i32.const 0x12345678)) (i32.const 0xedcba989))
+(assert_return (invoke "sub" (i32.const 1) (i32.const 0xedcba988)) (i32.const 0x12345679))
+(assert_return (invoke "sub" (i32.const 1) (i32.const 31)) (i32.const -30))
+(assert_return (invoke "sub" (i32.const 1) (i32.const 32)) (i32.const -31))
+(assert_return (invoke "sub" (i32.const 1) (i32.const 33)) (i32.const -32))
+(assert_return (invoke "sub" (i32.const 1) (i32.const 0xfff5a5a)) (i32.const 0xf000a5a7))
+(assert_return (invoke "sub" (i32.const -1) (i32.const 0)) (i32.const -1))
+(assert_return (invoke "sub" (i32.const -1) (i32.const 1)) (i32.const -2))
+(assert_return (invoke "sub" (i32.const -1) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "sub" (i32.const -1) (i32.const 2)) (i32.const -3))
+(assert_return (invoke "sub" (i32.const -1) (i32.const 3)) (i32.const -4))
+(assert_return (invoke "sub" (i32.const -1) (i32.const 0x7fffffff)) (i32.const 0x80000000))
+(assert_return (invoke "sub" (i32.const -1) (i32.const 0x80000000)) (i32.const 0x7fffffff))
+(assert_return (invoke "sub" (i32.const -1) (i32.const 0x80000001)) (i32.const 0x7ffffffe))
+(assert_return (invoke "sub" (i32.const -1) (i32.const 0x40000000)) (i32.const 0xbfffffff))
+(assert_return (invoke "sub" (i32.const -1) (i32.const 0x12345678)) (i32.const 0xedcba987))
+(assert_return (invoke "sub" (i32.const -1) (i32.const 0xedcba988)) (i32.const 0x12345677))
+(assert_return (invoke "sub" (i32.const -1) (i32.const 31)) (i32.const -32))
+(assert_return (invoke "sub" (i32.const -1) (i32.const 32)) (i32.const -33))
+(assert_return (invoke "sub" (i32.const -1) (i32.const 33)) (i32.const -34))
+(assert_return (invoke "sub" (i32.const -1) (i32.const 0xfff5a5a)) (i32.const 0xf000a5a5))
+(assert_return (invoke "sub" (i32.const 2) (i32.const 0)) (i32.const 2))
+(assert_return (invoke "sub" (i32.const 2) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "sub" (i32.const 2) (i32.const -1)) (i32.const 3))
+(assert_return (invoke "sub" (i32.const 2) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "sub" (i32.const 2) (i32.const 3)) (i32.const -1))
+(assert_return (invoke "sub" (i32.const 2) (i32.const 0x7fffffff)) (i32.const 0x80000003))
+(assert_return (invoke "sub" (i32.const 2) (i32.const 0x80000000)) (i32.const 0x80000002))
+(assert_return (invoke "sub" (i32.const 2) (i32.const 0x80000001)) (i32.const 0x80000001))
+(assert_return (invoke "sub" (i32.const 2) (i32.const 0x40000000)) (i32.const 0xc0000002))
+(assert_return (invoke "sub" (i32.const 2) (i32.const 0x12345678)) (i32.const 0xedcba98a))
+(assert_return (invoke "sub" (i32.const 2) (i32.const 0xedcba988)) (i32.const 0x1234567a))
+(assert_return (invoke "sub" (i32.const 2) (i32.const 31)) (i32.const -29))
+(assert_return (invoke "sub" (i32.const 2) (i32.const 32)) (i32.const -30))
+(assert_return (invoke "sub" (i32.const 2) (i32.const 33)) (i32.const -31))
+(assert_return (invoke "sub" (i32.const 2) (i32.const 0xfff5a5a)) (i32.const 0xf000a5a8))
+(assert_return (invoke "sub" (i32.const 3) (i32.const 0)) (i32.const 3))
+(assert_return (invoke "sub" (i32.const 3) (i32.const 1)) (i32.const 2))
+(assert_return (invoke "sub" (i32.const 3) (i32.const -1)) (i32.const 4))
+(assert_return (invoke "sub" (i32.const 3) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "sub" (i32.const 3) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "sub" (i32.const 3) (i32.const 0x7fffffff)) (i32.const 0x80000004))
+(assert_return (invoke "sub" (i32.const 3) (i32.const 0x80000000)) (i32.const 0x80000003))
+(assert_return (invoke "sub" (i32.const 3) (i32.const 0x80000001)) (i32.const 0x80000002))
+(assert_return (invoke "sub" (i32.const 3) (i32.const 0x40000000)) (i32.const 0xc0000003))
+(assert_return (invoke "sub" (i32.const 3) (i32.const 0x12345678)) (i32.const 0xedcba98b))
+(assert_return (invoke "sub" (i32.const 3) (i32.const 0xedcba988)) (i32.const 0x1234567b))
+(assert_return (invoke "sub" (i32.const 3) (i32.const 31)) (i32.const -28))
+(assert_return (invoke "sub" (i32.const 3) (i32.const 32)) (i32.const -29))
+(assert_return (invoke "sub" (i32.const 3) (i32.const 33)) (i32.const -30))
+(assert_return (invoke "sub" (i32.const 3) (i32.const 0xfff5a5a)) (i32.const 0xf000a5a9))
+(assert_return (invoke "sub" (i32.const 0x7fffffff) (i32.const 0)) (i32.const 0x7fffffff))
+(assert_return (invoke "sub" (i32.const 0x7fffffff) (i32.const 1)) (i32.const 0x7ffffffe))
+(assert_return (invoke "sub" (i32.const 0x7fffffff) (i32.const -1)) (i32.const 0x80000000))
+(assert_return (invoke "sub" (i32.const 0x7fffffff) (i32.const 2)) (i32.const 0x7ffffffd))
+(assert_return (invoke "sub" (i32.const 0x7fffffff) (i32.const 3)) (i32.const 0x7ffffffc))
+(assert_return (invoke "sub" (i32.const 0x7fffffff) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "sub" (i32.const 0x7fffffff) (i32.const 0x80000000)) (i32.const -1))
+(assert_return (invoke "sub" (i32.const 0x7fffffff) (i32.const 0x80000001)) (i32.const -2))
+(assert_return (invoke "sub" (i32.const 0x7fffffff) (i32.const 0x40000000)) (i32.const 0x3fffffff))
+(assert_return (invoke "sub" (i32.const 0x7fffffff) (i32.const 0x12345678)) (i32.const 0x6dcba987))
+(assert_return (invoke "sub" (i32.const 0x7fffffff) (i32.const 0xedcba988)) (i32.const 0x92345677))
+(assert_return (invoke "sub" (i32.const 0x7fffffff) (i32.const 31)) (i32.const 0x7fffffe0))
+(assert_return (invoke "sub" (i32.const 0x7fffffff) (i32.const 32)) (i32.const 0x7fffffdf))
+(assert_return (invoke "sub" (i32.const 0x7fffffff) (i32.const 33)) (i32.const 0x7fffffde))
+(assert_return (invoke "sub" (i32.const 0x7fffffff) (i32.const 0xfff5a5a)) (i32.const 0x7000a5a5))
+(assert_return (invoke "sub" (i32.const 0x80000000) (i32.const 0)) (i32.const 0x80000000))
+(assert_return (invoke "sub" (i32.const 0x80000000) (i32.const 1)) (i32.const 0x7fffffff))
+(assert_return (invoke "sub" (i32.const 0x80000000) (i32.const -1)) (i32.const 0x80000001))
+(assert_return (invoke "sub" (i32.const 0x80000000) (i32.const 2)) (i32.const 0x7ffffffe))
+(assert_return (invoke "sub" (i32.const 0x80000000) (i32.const 3)) (i32.const 0x7ffffffd))
+(assert_return (invoke "sub" (i32.const 0x80000000) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "sub" (i32.const 0x80000000) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "sub" (i32.const 0x80000000) (i32.const 0x80000001)) (i32.const -1))
+(assert_return (invoke "sub" (i32.const 0x80000000) (i32.const 0x40000000)) (i32.const 0x40000000))
+(assert_return (invoke "sub" (i32.const 0x80000000) (i32.const 0x12345678)) (i32.const 0x6dcba988))
+(assert_return (invoke "sub" (i32.const 0x80000000) (i32.const 0xedcba988)) (i32.const 0x92345678))
+(assert_return (invoke "sub" (i32.const 0x80000000) (i32.const 31)) (i32.const 0x7fffffe1))
+(assert_return (invoke "sub" (i32.const 0x80000000) (i32.const 32)) (i32.const 0x7fffffe0))
+(assert_return (invoke "sub" (i32.const 0x80000000) (i32.const 33)) (i32.const 0x7fffffdf))
+(assert_return (invoke "sub" (i32.const 0x80000000) (i32.const 0xfff5a5a)) (i32.const 0x7000a5a6))
+(assert_return (invoke "sub" (i32.const 0x80000001) (i32.const 0)) (i32.const 0x80000001))
+(assert_return (invoke "sub" (i32.const 0x80000001) (i32.const 1)) (i32.const 0x80000000))
+(assert_return (invoke "sub" (i32.const 0x80000001) (i32.const -1)) (i32.const 0x80000002))
+(assert_return (invoke "sub" (i32.const 0x80000001) (i32.const 2)) (i32.const 0x7fffffff))
+(assert_return (invoke "sub" (i32.const 0x80000001) (i32.const 3)) (i32.const 0x7ffffffe))
+(assert_return (invoke "sub" (i32.const 0x80000001) (i32.const 0x7fffffff)) (i32.const 2))
+(assert_return (invoke "sub" (i32.const 0x80000001) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "sub" (i32.const 0x80000001) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "sub" (i32.const 0x80000001) (i32.const 0x40000000)) (i32.const 0x40000001))
+(assert_return (invoke "sub" (i32.const 0x80000001) (i32.const 0x12345678)) (i32.const 0x6dcba989))
+(assert_return (invoke "sub" (i32.const 0x80000001) (i32.const 0xedcba988)) (i32.const 0x92345679))
+(assert_return (invoke "sub" (i32.const 0x80000001) (i32.const 31)) (i32.const 0x7fffffe2))
+(assert_return (invoke "sub" (i32.const 0x80000001) (i32.const 32)) (i32.const 0x7fffffe1))
+(assert_return (invoke "sub" (i32.const 0x80000001) (i32.const 33)) (i32.const 0x7fffffe0))
+(assert_return (invoke "sub" (i32.const 0x80000001) (i32.const 0xfff5a5a)) (i32.const 0x7000a5a7))
+(assert_return (invoke "sub" (i32.const 0x40000000) (i32.const 0)) (i32.const 0x40000000))
+(assert_return (invoke "sub" (i32.const 0x40000000) (i32.const 1)) (i32.const 0x3fffffff))
+(assert_return (invoke "sub" (i32.const 0x40000000) (i32.const -1)) (i32.const 0x40000001))
+(assert_return (invoke "sub" (i32.const 0x40000000) (i32.const 2)) (i32.const 0x3ffffffe))
+(assert_return (invoke "sub" (i32.const 0x40000000) (i32.const 3)) (i32.const 0x3ffffffd))
+(assert_return (invoke "sub" (i32.const 0x40000000) (i32.const 0x7fffffff)) (i32.const 0xc0000001))
+(assert_return (invoke "sub" (i32.const 0x40000000) (i32.const 0x80000000)) (i32.const 0xc0000000))
+(assert_return (invoke "sub" (i32.const 0x40000000) (i32.const 0x80000001)) (i32.const 0xbfffffff))
+(assert_return (invoke "sub" (i32.const 0x40000000) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "sub" (i32.const 0x40000000) (i32.const 0x12345678)) (i32.const 0x2dcba988))
+(assert_return (invoke "sub" (i32.const 0x40000000) (i32.const 0xedcba988)) (i32.const 0x52345678))
+(assert_return (invoke "sub" (i32.const 0x40000000) (i32.const 31)) (i32.const 0x3fffffe1))
+(assert_return (invoke "sub" (i32.const 0x40000000) (i32.const 32)) (i32.const 0x3fffffe0))
+(assert_return (invoke "sub" (i32.const 0x40000000) (i32.const 33)) (i32.const 0x3fffffdf))
+(assert_return (invoke "sub" (i32.const 0x40000000) (i32.const 0xfff5a5a)) (i32.const 0x3000a5a6))
+(assert_return (invoke "sub" (i32.const 0x12345678) (i32.const 0)) (i32.const 0x12345678))
+(assert_return (invoke "sub" (i32.const 0x12345678) (i32.const 1)) (i32.const 0x12345677))
+(assert_return (invoke "sub" (i32.const 0x12345678) (i32.const -1)) (i32.const 0x12345679))
+(assert_return (invoke "sub" (i32.const 0x12345678) (i32.const 2)) (i32.const 0x12345676))
+(assert_return (invoke "sub" (i32.const 0x12345678) (i32.const 3)) (i32.const 0x12345675))
+(assert_return (invoke "sub" (i32.const 0x12345678) (i32.const 0x7fffffff)) (i32.const 0x92345679))
+(assert_return (invoke "sub" (i32.const 0x12345678) (i32.const 0x80000000)) (i32.const 0x92345678))
+(assert_return (invoke "sub" (i32.const 0x12345678) (i32.const 0x80000001)) (i32.const 0x92345677))
+(assert_return (invoke "sub" (i32.const 0x12345678) (i32.const 0x40000000)) (i32.const 0xd2345678))
+(assert_return (invoke "sub" (i32.const 0x12345678) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "sub" (i32.const 0x12345678) (i32.const 0xedcba988)) (i32.const 0x2468acf0))
+(assert_return (invoke "sub" (i32.const 0x12345678) (i32.const 31)) (i32.const 0x12345659))
+(assert_return (invoke "sub" (i32.const 0x12345678) (i32.const 32)) (i32.const 0x12345658))
+(assert_return (invoke "sub" (i32.const 0x12345678) (i32.const 33)) (i32.const 0x12345657))
+(assert_return (invoke "sub" (i32.const 0x12345678) (i32.const 0xfff5a5a)) (i32.const 0x234fc1e))
+(assert_return (invoke "sub" (i32.const 0xedcba988) (i32.const 0)) (i32.const 0xedcba988))
+(assert_return (invoke "sub" (i32.const 0xedcba988) (i32.const 1)) (i32.const 0xedcba987))
+(assert_return (invoke "sub" (i32.const 0xedcba988) (i32.const -1)) (i32.const 0xedcba989))
+(assert_return (invoke "sub" (i32.const 0xedcba988) (i32.const 2)) (i32.const 0xedcba986))
+(assert_return (invoke "sub" (i32.const 0xedcba988) (i32.const 3)) (i32.const 0xedcba985))
+(assert_return (invoke "sub" (i32.const 0xedcba988) (i32.const 0x7fffffff)) (i32.const 0x6dcba989))
+(assert_return (invoke "sub" (i32.const 0xedcba988) (i32.const 0x80000000)) (i32.const 0x6dcba988))
+(assert_return (invoke "sub" (i32.const 0xedcba988) (i32.const 0x80000001)) (i32.const 0x6dcba987))
+(assert_return (invoke "sub" (i32.const 0xedcba988) (i32.const 0x40000000)) (i32.const 0xadcba988))
+(assert_return (invoke "sub" (i32.const 0xedcba988) (i32.const 0x12345678)) (i32.const 0xdb975310))
+(assert_return (invoke "sub" (i32.const 0xedcba988) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "sub" (i32.const 0xedcba988) (i32.const 31)) (i32.const 0xedcba969))
+(assert_return (invoke "sub" (i32.const 0xedcba988) (i32.const 32)) (i32.const 0xedcba968))
+(assert_return (invoke "sub" (i32.const 0xedcba988) (i32.const 33)) (i32.const 0xedcba967))
+(assert_return (invoke "sub" (i32.const 0xedcba988) (i32.const 0xfff5a5a)) (i32.const 0xddcc4f2e))
+(assert_return (invoke "sub" (i32.const 31) (i32.const 0)) (i32.const 31))
+(assert_return (invoke "sub" (i32.const 31) (i32.const 1)) (i32.const 30))
+(assert_return (invoke "sub" (i32.const 31) (i32.const -1)) (i32.const 32))
+(assert_return (invoke "sub" (i32.const 31) (i32.const 2)) (i32.const 29))
+(assert_return (invoke "sub" (i32.const 31) (i32.const 3)) (i32.const 28))
+(assert_return (invoke "sub" (i32.const 31) (i32.const 0x7fffffff)) (i32.const 0x80000020))
+(assert_return (invoke "sub" (i32.const 31) (i32.const 0x80000000)) (i32.const 0x8000001f))
+(assert_return (invoke "sub" (i32.const 31) (i32.const 0x80000001)) (i32.const 0x8000001e))
+(assert_return (invoke "sub" (i32.const 31) (i32.const 0x40000000)) (i32.const 0xc000001f))
+(assert_return (invoke "sub" (i32.const 31) (i32.const 0x12345678)) (i32.const 0xedcba9a7))
+(assert_return (invoke "sub" (i32.const 31) (i32.const 0xedcba988)) (i32.const 0x12345697))
+(assert_return (invoke "sub" (i32.const 31) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "sub" (i32.const 31) (i32.const 32)) (i32.const -1))
+(assert_return (invoke "sub" (i32.const 31) (i32.const 33)) (i32.const -2))
+(assert_return (invoke "sub" (i32.const 31) (i32.const 0xfff5a5a)) (i32.const 0xf000a5c5))
+(assert_return (invoke "sub" (i32.const 32) (i32.const 0)) (i32.const 32))
+(assert_return (invoke "sub" (i32.const 32) (i32.const 1)) (i32.const 31))
+(assert_return (invoke "sub" (i32.const 32) (i32.const -1)) (i32.const 33))
+(assert_return (invoke "sub" (i32.const 32) (i32.const 2)) (i32.const 30))
+(assert_return (invoke "sub" (i32.const 32) (i32.const 3)) (i32.const 29))
+(assert_return (invoke "sub" (i32.const 32) (i32.const 0x7fffffff)) (i32.const 0x80000021))
+(assert_return (invoke "sub" (i32.const 32) (i32.const 0x80000000)) (i32.const 0x80000020))
+(assert_return (invoke "sub" (i32.const 32) (i32.const 0x80000001)) (i32.const 0x8000001f))
+(assert_return (invoke "sub" (i32.const 32) (i32.const 0x40000000)) (i32.const 0xc0000020))
+(assert_return (invoke "sub" (i32.const 32) (i32.const 0x12345678)) (i32.const 0xedcba9a8))
+(assert_return (invoke "sub" (i32.const 32) (i32.const 0xedcba988)) (i32.const 0x12345698))
+(assert_return (invoke "sub" (i32.const 32) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "sub" (i32.const 32) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "sub" (i32.const 32) (i32.const 33)) (i32.const -1))
+(assert_return (invoke "sub" (i32.const 32) (i32.const 0xfff5a5a)) (i32.const 0xf000a5c6))
+(assert_return (invoke "sub" (i32.const 33) (i32.const 0)) (i32.const 33))
+(assert_return (invoke "sub" (i32.const 33) (i32.const 1)) (i32.const 32))
+(assert_return (invoke "sub" (i32.const 33) (i32.const -1)) (i32.const 34))
+(assert_return (invoke "sub" (i32.const 33) (i32.const 2)) (i32.const 31))
+(assert_return (invoke "sub" (i32.const 33) (i32.const 3)) (i32.const 30))
+(assert_return (invoke "sub" (i32.const 33) (i32.const 0x7fffffff)) (i32.const 0x80000022))
+(assert_return (invoke "sub" (i32.const 33) (i32.const 0x80000000)) (i32.const 0x80000021))
+(assert_return (invoke "sub" (i32.const 33) (i32.const 0x80000001)) (i32.const 0x80000020))
+(assert_return (invoke "sub" (i32.const 33) (i32.const 0x40000000)) (i32.const 0xc0000021))
+(assert_return (invoke "sub" (i32.const 33) (i32.const 0x12345678)) (i32.const 0xedcba9a9))
+(assert_return (invoke "sub" (i32.const 33) (i32.const 0xedcba988)) (i32.const 0x12345699))
+(assert_return (invoke "sub" (i32.const 33) (i32.const 31)) (i32.const 2))
+(assert_return (invoke "sub" (i32.const 33) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "sub" (i32.const 33) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "sub" (i32.const 33) (i32.const 0xfff5a5a)) (i32.const 0xf000a5c7))
+(assert_return (invoke "sub" (i32.const 0xfff5a5a) (i32.const 0)) (i32.const 0xfff5a5a))
+(assert_return (invoke "sub" (i32.const 0xfff5a5a) (i32.const 1)) (i32.const 0xfff5a59))
+(assert_return (invoke "sub" (i32.const 0xfff5a5a) (i32.const -1)) (i32.const 0xfff5a5b))
+(assert_return (invoke "sub" (i32.const 0xfff5a5a) (i32.const 2)) (i32.const 0xfff5a58))
+(assert_return (invoke "sub" (i32.const 0xfff5a5a) (i32.const 3)) (i32.const 0xfff5a57))
+(assert_return (invoke "sub" (i32.const 0xfff5a5a) (i32.const 0x7fffffff)) (i32.const 0x8fff5a5b))
+(assert_return (invoke "sub" (i32.const 0xfff5a5a) (i32.const 0x80000000)) (i32.const 0x8fff5a5a))
+(assert_return (invoke "sub" (i32.const 0xfff5a5a) (i32.const 0x80000001)) (i32.const 0x8fff5a59))
+(assert_return (invoke "sub" (i32.const 0xfff5a5a) (i32.const 0x40000000)) (i32.const 0xcfff5a5a))
+(assert_return (invoke "sub" (i32.const 0xfff5a5a) (i32.const 0x12345678)) (i32.const 0xfdcb03e2))
+(assert_return (invoke "sub" (i32.const 0xfff5a5a) (i32.const 0xedcba988)) (i32.const 0x2233b0d2))
+(assert_return (invoke "sub" (i32.const 0xfff5a5a) (i32.const 31)) (i32.const 0xfff5a3b))
+(assert_return (invoke "sub" (i32.const 0xfff5a5a) (i32.const 32)) (i32.const 0xfff5a3a))
+(assert_return (invoke "sub" (i32.const 0xfff5a5a) (i32.const 33)) (i32.const 0xfff5a39))
+(assert_return (invoke "sub" (i32.const 0xfff5a5a) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "sub" (i32.const 0x268bfa9) (i32.const 0x7c47ba50)) (i32.const 0x86210559))
+(assert_return (invoke "sub" (i32.const 0xbb0e1dc5) (i32.const 0xc5e2ec79)) (i32.const 0xf52b314c))
+(assert_return (invoke "sub" (i32.const 0xd7c47d97) (i32.const 0x33f58438)) (i32.const 0xa3cef95f))
+(assert_return (invoke "sub" (i32.const 0xd629f1f0) (i32.const 0x49af3aa5)) (i32.const 0x8c7ab74b))
+(assert_return (invoke "sub" (i32.const 0xf414602b) (i32.const 0xecd28f49)) (i32.const 0x741d0e2))
+(assert_return (invoke "sub" (i32.const 0x33a0a95d) (i32.const 0x723deaa9)) (i32.const 0xc162beb4))
+(assert_return (invoke "sub" (i32.const 0x14dd3bf2) (i32.const 0x558d8582)) (i32.const 0xbf4fb670))
+(assert_return (invoke "sub" (i32.const 0x1ee979f5) (i32.const 0x9002529)) (i32.const 0x15e954cc))
+(assert_return (invoke "sub" (i32.const 0xfa4d8a6f) (i32.const 0x8684d6ec)) (i32.const 0x73c8b383))
+(assert_return (invoke "sub" (i32.const 0x1ca72486) (i32.const 0xba48faaa)) (i32.const 0x625e29dc))
+(assert_return (invoke "sub" (i32.const 0x28d7c5d4) (i32.const 0x7058f101)) (i32.const 0xb87ed4d3))
+(assert_return (invoke "sub" (i32.const 0x5253f30) (i32.const 0x6e6f79d6)) (i32.const 0x96b5c55a))
+(assert_return (invoke "sub" (i32.const 0xca109de) (i32.const 0xebfba18f)) (i32.const 0x20a5684f))
+(assert_return (invoke "sub" (i32.const 0x9cbd6c1) (i32.const 0x8e38b526)) (i32.const 0x7b93219b))
+(assert_return (invoke "sub" (i32.const 0x5a605483) (i32.const 0x3a0eb1b3)) (i32.const 0x2051a2d0))
+(assert_return (invoke "sub" (i32.const 0x29cbf3f6) (i32.const 0x77cf2f4c)) (i32.const 0xb1fcc4aa))
+
+(assert_return (invoke "mul" (i32.const 0) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "mul" (i32.const 0) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "mul" (i32.const 0) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "mul" (i32.const 0) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "mul" (i32.const 0) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "mul" (i32.const 0) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "mul" (i32.const 0) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "mul" (i32.const 0) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "mul" (i32.const 0) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "mul" (i32.const 0) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "mul" (i32.const 0) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "mul" (i32.const 0) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "mul" (i32.const 0) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "mul" (i32.const 0) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "mul" (i32.const 0) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "mul" (i32.const 1) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "mul" (i32.const 1) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "mul" (i32.const 1) (i32.const -1)) (i32.const -1))
+(assert_return (invoke "mul" (i32.const 1) (i32.const 2)) (i32.const 2))
+(assert_return (invoke "mul" (i32.const 1) (i32.const 3)) (i32.const 3))
+(assert_return (invoke "mul" (i32.const 1) (i32.const 0x7fffffff)) (i32.const 0x7fffffff))
+(assert_return (invoke "mul" (i32.const 1) (i32.const 0x80000000)) (i32.const 0x80000000))
+(assert_return (invoke "mul" (i32.const 1) (i32.const 0x80000001)) (i32.const 0x80000001))
+(assert_return (invoke "mul" (i32.const 1) (i32.const 0x40000000)) (i32.const 0x40000000))
+(assert_return (invoke "mul" (i32.const 1) (i32.const 0x12345678)) (i32.const 0x12345678))
+(assert_return (invoke "mul" (i32.const 1) (i32.const 0xedcba988)) (i32.const 0xedcba988))
+(assert_return (invoke "mul" (i32.const 1) (i32.const 31)) (i32.const 31))
+(assert_return (invoke "mul" (i32.const 1) (i32.const 32)) (i32.const 32))
+(assert_return (invoke "mul" (i32.const 1) (i32.const 33)) (i32.const 33))
+(assert_return (invoke "mul" (i32.const 1) (i32.const 0xfff5a5a)) (i32.const 0xfff5a5a))
+(assert_return (invoke "mul" (i32.const -1) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "mul" (i32.const -1) (i32.const 1)) (i32.const -1))
+(assert_return (invoke "mul" (i32.const -1) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "mul" (i32.const -1) (i32.const 2)) (i32.const -2))
+(assert_return (invoke "mul" (i32.const -1) (i32.const 3)) (i32.const -3))
+(assert_return (invoke "mul" (i32.const -1) (i32.const 0x7fffffff)) (i32.const 0x80000001))
+(assert_return (invoke "mul" (i32.const -1) (i32.const 0x80000000)) (i32.const 0x80000000))
+(assert_return (invoke "mul" (i32.const -1) (i32.const 0x80000001)) (i32.const 0x7fffffff))
+(assert_return (invoke "mul" (i32.const -1) (i32.const 0x40000000)) (i32.const 0xc0000000))
+(assert_return (invoke "mul" (i32.const -1) (i32.const 0x12345678)) (i32.const 0xedcba988))
+(assert_return (invoke "mul" (i32.const -1) (i32.const 0xedcba988)) (i32.const 0x12345678))
+(assert_return (invoke "mul" (i32.const -1) (i32.const 31)) (i32.const -31))
+(assert_return (invoke "mul" (i32.const -1) (i32.const 32)) (i32.const -32))
+(assert_return (invoke "mul" (i32.const -1) (i32.const 33)) (i32.const -33))
+(assert_return (invoke "mul" (i32.const -1) (i32.const 0xfff5a5a)) (i32.const 0xf000a5a6))
+(assert_return (invoke "mul" (i32.const 2) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "mul" (i32.const 2) (i32.const 1)) (i32.const 2))
+(assert_return (invoke "mul" (i32.const 2) (i32.const -1)) (i32.const -2))
+(assert_return (invoke "mul" (i32.const 2) (i32.const 2)) (i32.const 4))
+(assert_return (invoke "mul" (i32.const 2) (i32.const 3)) (i32.const 6))
+(assert_return (invoke "mul" (i32.const 2) (i32.const 0x7fffffff)) (i32.const -2))
+(assert_return (invoke "mul" (i32.const 2) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "mul" (i32.const 2) (i32.const 0x80000001)) (i32.const 2))
+(assert_return (invoke "mul" (i32.const 2) (i32.const 0x40000000)) (i32.const 0x80000000))
+(assert_return (invoke "mul" (i32.const 2) (i32.const 0x12345678)) (i32.const 0x2468acf0))
+(assert_return (invoke "mul" (i32.const 2) (i32.const 0xedcba988)) (i32.const 0xdb975310))
+(assert_return (invoke "mul" (i32.const 2) (i32.const 31)) (i32.const 62))
+(assert_return (invoke "mul" (i32.const 2) (i32.const 32)) (i32.const 64))
+(assert_return (invoke "mul" (i32.const 2) (i32.const 33)) (i32.const 66))
+(assert_return (invoke "mul" (i32.const 2) (i32.const 0xfff5a5a)) (i32.const 0x1ffeb4b4))
+(assert_return (invoke "mul" (i32.const 3) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "mul" (i32.const 3) (i32.const 1)) (i32.const 3))
+(assert_return (invoke "mul" (i32.const 3) (i32.const -1)) (i32.const -3))
+(assert_return (invoke "mul" (i32.const 3) (i32.const 2)) (i32.const 6))
+(assert_return (invoke "mul" (i32.const 3) (i32.const 3)) (i32.const 9))
+(assert_return (invoke "mul" (i32.const 3) (i32.const 0x7fffffff)) (i32.const 0x7ffffffd))
+(assert_return (invoke "mul" (i32.const 3) (i32.const 0x80000000)) (i32.const 0x80000000))
+(assert_return (invoke "mul" (i32.const 3) (i32.const 0x80000001)) (i32.const 0x80000003))
+(assert_return (invoke "mul" (i32.const 3) (i32.const 0x40000000)) (i32.const 0xc0000000))
+(assert_return (invoke "mul" (i32.const 3) (i32.const 0x12345678)) (i32.const 0x369d0368))
+(assert_return (invoke "mul" (i32.const 3) (i32.const 0xedcba988)) (i32.const 0xc962fc98))
+(assert_return (invoke "mul" (i32.const 3) (i32.const 31)) (i32.const 93))
+(assert_return (invoke "mul" (i32.const 3) (i32.const 32)) (i32.const 96))
+(assert_return (invoke "mul" (i32.const 3) (i32.const 33)) (i32.const 99))
+(assert_return (invoke "mul" (i32.const 3) (i32.const 0xfff5a5a)) (i32.const 0x2ffe0f0e))
+(assert_return (invoke "mul" (i32.const 0x7fffffff) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "mul" (i32.const 0x7fffffff) (i32.const 1)) (i32.const 0x7fffffff))
+(assert_return (invoke "mul" (i32.const 0x7fffffff) (i32.const -1)) (i32.const 0x80000001))
+(assert_return (invoke "mul" (i32.const 0x7fffffff) (i32.const 2)) (i32.const -2))
+(assert_return (invoke "mul" (i32.const 0x7fffffff) (i32.const 3)) (i32.const 0x7ffffffd))
+(assert_return (invoke "mul" (i32.const 0x7fffffff) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "mul" (i32.const 0x7fffffff) (i32.const 0x80000000)) (i32.const 0x80000000))
+(assert_return (invoke "mul" (i32.const 0x7fffffff) (i32.const 0x80000001)) (i32.const -1))
+(assert_return (invoke "mul" (i32.const 0x7fffffff) (i32.const 0x40000000)) (i32.const 0xc0000000))
+(assert_return (invoke "mul" (i32.const 0x7fffffff) (i32.const 0x12345678)) (i32.const 0xedcba988))
+(assert_return (invoke "mul" (i32.const 0x7fffffff) (i32.const 0xedcba988)) (i32.const 0x12345678))
+(assert_return (invoke "mul" (i32.const 0x7fffffff) (i32.const 31)) (i32.const 0x7fffffe1))
+(assert_return (invoke "mul" (i32.const 0x7fffffff) (i32.const 32)) (i32.const -32))
+(assert_return (invoke "mul" (i32.const 0x7fffffff) (i32.const 33)) (i32.const 0x7fffffdf))
+(assert_return (invoke "mul" (i32.const 0x7fffffff) (i32.const 0xfff5a5a)) (i32.const 0xf000a5a6))
+(assert_return (invoke "mul" (i32.const 0x80000000) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "mul" (i32.const 0x80000000) (i32.const 1)) (i32.const 0x80000000))
+(assert_return (invoke "mul" (i32.const 0x80000000) (i32.const -1)) (i32.const 0x80000000))
+(assert_return (invoke "mul" (i32.const 0x80000000) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "mul" (i32.const 0x80000000) (i32.const 3)) (i32.const 0x80000000))
+(assert_return (invoke "mul" (i32.const 0x80000000) (i32.const 0x7fffffff)) (i32.const 0x80000000))
+(assert_return (invoke "mul" (i32.const 0x80000000) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "mul" (i32.const 0x80000000) (i32.const 0x80000001)) (i32.const 0x80000000))
+(assert_return (invoke "mul" (i32.const 0x80000000) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "mul" (i32.const 0x80000000) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "mul" (i32.const 0x80000000) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "mul" (i32.const 0x80000000) (i32.const 31)) (i32.const 0x80000000))
+(assert_return (invoke "mul" (i32.const 0x80000000) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "mul" (i32.const 0x80000000) (i32.const 33)) (i32.const 0x80000000))
+(assert_return (invoke "mul" (i32.const 0x80000000) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "mul" (i32.const 0x80000001) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "mul" (i32.const 0x80000001) (i32.const 1)) (i32.const 0x80000001))
+(assert_return (invoke "mul" (i32.const 0x80000001) (i32.const -1)) (i32.const 0x7fffffff))
+(assert_return (invoke "mul" (i32.const 0x80000001) (i32.const 2)) (i32.const 2))
+(assert_return (invoke "mul" (i32.const 0x80000001) (i32.const 3)) (i32.const 0x80000003))
+(assert_return (invoke "mul" (i32.const 0x80000001) (i32.const 0x7fffffff)) (i32.const -1))
+(assert_return (invoke "mul" (i32.const 0x80000001) (i32.const 0x80000000)) (i32.const 0x80000000))
+(assert_return (invoke "mul" (i32.const 0x80000001) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "mul" (i32.const 0x80000001) (i32.const 0x40000000)) (i32.const 0x40000000))
+(assert_return (invoke "mul" (i32.const 0x80000001) (i32.const 0x12345678)) (i32.const 0x12345678))
+(assert_return (invoke "mul" (i32.const 0x80000001) (i32.const 0xedcba988)) (i32.const 0xedcba988))
+(assert_return (invoke "mul" (i32.const 0x80000001) (i32.const 31)) (i32.const 0x8000001f))
+(assert_return (invoke "mul" (i32.const 0x80000001) (i32.const 32)) (i32.const 32))
+(assert_return (invoke "mul" (i32.const 0x80000001) (i32.const 33)) (i32.const 0x80000021))
+(assert_return (invoke "mul" (i32.const 0x80000001) (i32.const 0xfff5a5a)) (i32.const 0xfff5a5a))
+(assert_return (invoke "mul" (i32.const 0x40000000) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "mul" (i32.const 0x40000000) (i32.const 1)) (i32.const 0x40000000))
+(assert_return (invoke "mul" (i32.const 0x40000000) (i32.const -1)) (i32.const 0xc0000000))
+(assert_return (invoke "mul" (i32.const 0x40000000) (i32.const 2)) (i32.const 0x80000000))
+(assert_return (invoke "mul" (i32.const 0x40000000) (i32.const 3)) (i32.const 0xc0000000))
+(assert_return (invoke "mul" (i32.const 0x40000000) (i32.const 0x7fffffff)) (i32.const 0xc0000000))
+(assert_return (invoke "mul" (i32.const 0x40000000) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "mul" (i32.const 0x40000000) (i32.const 0x80000001)) (i32.const 0x40000000))
+(assert_return (invoke "mul" (i32.const 0x40000000) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "mul" (i32.const 0x40000000) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "mul" (i32.const 0x40000000) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "mul" (i32.const 0x40000000) (i32.const 31)) (i32.const 0xc0000000))
+(assert_return (invoke "mul" (i32.const 0x40000000) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "mul" (i32.const 0x40000000) (i32.const 33)) (i32.const 0x40000000))
+(assert_return (invoke "mul" (i32.const 0x40000000) (i32.const 0xfff5a5a)) (i32.const 0x80000000))
+(assert_return (invoke "mul" (i32.const 0x12345678) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "mul" (i32.const 0x12345678) (i32.const 1)) (i32.const 0x12345678))
+(assert_return (invoke "mul" (i32.const 0x12345678) (i32.const -1)) (i32.const 0xedcba988))
+(assert_return (invoke "mul" (i32.const 0x12345678) (i32.const 2)) (i32.const 0x2468acf0))
+(assert_return (invoke "mul" (i32.const 0x12345678) (i32.const 3)) (i32.const 0x369d0368))
+(assert_return (invoke "mul" (i32.const 0x12345678) (i32.const 0x7fffffff)) (i32.const 0xedcba988))
+(assert_return (invoke "mul" (i32.const 0x12345678) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "mul" (i32.const 0x12345678) (i32.const 0x80000001)) (i32.const 0x12345678))
+(assert_return (invoke "mul" (i32.const 0x12345678) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "mul" (i32.const 0x12345678) (i32.const 0x12345678)) (i32.const 0x1df4d840))
+(assert_return (invoke "mul" (i32.const 0x12345678) (i32.const 0xedcba988)) (i32.const 0xe20b27c0))
+(assert_return (invoke "mul" (i32.const 0x12345678) (i32.const 31)) (i32.const 0x34567888))
+(assert_return (invoke "mul" (i32.const 0x12345678) (i32.const 32)) (i32.const 0x468acf00))
+(assert_return (invoke "mul" (i32.const 0x12345678) (i32.const 33)) (i32.const 0x58bf2578))
+(assert_return (invoke "mul" (i32.const 0x12345678) (i32.const 0xfff5a5a)) (i32.const 0xf6549630))
+(assert_return (invoke "mul" (i32.const 0xedcba988) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "mul" (i32.const 0xedcba988) (i32.const 1)) (i32.const 0xedcba988))
+(assert_return (invoke "mul" (i32.const 0xedcba988) (i32.const -1)) (i32.const 0x12345678))
+(assert_return (invoke "mul" (i32.const 0xedcba988) (i32.const 2)) (i32.const 0xdb975310))
+(assert_return (invoke "mul" (i32.const 0xedcba988) (i32.const 3)) (i32.const 0xc962fc98))
+(assert_return (invoke "mul" (i32.const 0xedcba988) (i32.const 0x7fffffff)) (i32.const 0x12345678))
+(assert_return (invoke "mul" (i32.const 0xedcba988) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "mul" (i32.const 0xedcba988) (i32.const 0x80000001)) (i32.const 0xedcba988))
+(assert_return (invoke "mul" (i32.const 0xedcba988) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "mul" (i32.const 0xedcba988) (i32.const 0x12345678)) (i32.const 0xe20b27c0))
+(assert_return (invoke "mul" (i32.const 0xedcba988) (i32.const 0xedcba988)) (i32.const 0x1df4d840))
+(assert_return (invoke "mul" (i32.const 0xedcba988) (i32.const 31)) (i32.const 0xcba98778))
+(assert_return (invoke "mul" (i32.const 0xedcba988) (i32.const 32)) (i32.const 0xb9753100))
+(assert_return (invoke "mul" (i32.const 0xedcba988) (i32.const 33)) (i32.const 0xa740da88))
+(assert_return (invoke "mul" (i32.const 0xedcba988) (i32.const 0xfff5a5a)) (i32.const 0x9ab69d0))
+(assert_return (invoke "mul" (i32.const 31) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "mul" (i32.const 31) (i32.const 1)) (i32.const 31))
+(assert_return (invoke "mul" (i32.const 31) (i32.const -1)) (i32.const -31))
+(assert_return (invoke "mul" (i32.const 31) (i32.const 2)) (i32.const 62))
+(assert_return (invoke "mul" (i32.const 31) (i32.const 3)) (i32.const 93))
+(assert_return (invoke "mul" (i32.const 31) (i32.const 0x7fffffff)) (i32.const 0x7fffffe1))
+(assert_return (invoke "mul" (i32.const 31) (i32.const 0x80000000)) (i32.const 0x80000000))
+(assert_return (invoke "mul" (i32.const 31) (i32.const 0x80000001)) (i32.const 0x8000001f))
+(assert_return (invoke "mul" (i32.const 31) (i32.const 0x40000000)) (i32.const 0xc0000000))
+(assert_return (invoke "mul" (i32.const 31) (i32.const 0x12345678)) (i32.const 0x34567888))
+(assert_return (invoke "mul" (i32.const 31) (i32.const 0xedcba988)) (i32.const 0xcba98778))
+(assert_return (invoke "mul" (i32.const 31) (i32.const 31)) (i32.const 961))
+(assert_return (invoke "mul" (i32.const 31) (i32.const 32)) (i32.const 992))
+(assert_return (invoke "mul" (i32.const 31) (i32.const 33)) (i32.const 1023))
+(assert_return (invoke "mul" (i32.const 31) (i32.const 0xfff5a5a)) (i32.const 0xefebf0e6))
+(assert_return (invoke "mul" (i32.const 32) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "mul" (i32.const 32) (i32.const 1)) (i32.const 32))
+(assert_return (invoke "mul" (i32.const 32) (i32.const -1)) (i32.const -32))
+(assert_return (invoke "mul" (i32.const 32) (i32.const 2)) (i32.const 64))
+(assert_return (invoke "mul" (i32.const 32) (i32.const 3)) (i32.const 96))
+(assert_return (invoke "mul" (i32.const 32) (i32.const 0x7fffffff)) (i32.const -32))
+(assert_return (invoke "mul" (i32.const 32) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "mul" (i32.const 32) (i32.const 0x80000001)) (i32.const 32))
+(assert_return (invoke "mul" (i32.const 32) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "mul" (i32.const 32) (i32.const 0x12345678)) (i32.const 0x468acf00))
+(assert_return (invoke "mul" (i32.const 32) (i32.const 0xedcba988)) (i32.const 0xb9753100))
+(assert_return (invoke "mul" (i32.const 32) (i32.const 31)) (i32.const 992))
+(assert_return (invoke "mul" (i32.const 32) (i32.const 32)) (i32.const 1024))
+(assert_return (invoke "mul" (i32.const 32) (i32.const 33)) (i32.const 1056))
+(assert_return (invoke "mul" (i32.const 32) (i32.const 0xfff5a5a)) (i32.const 0xffeb4b40))
+(assert_return (invoke "mul" (i32.const 33) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "mul" (i32.const 33) (i32.const 1)) (i32.const 33))
+(assert_return (invoke "mul" (i32.const 33) (i32.const -1)) (i32.const -33))
+(assert_return (invoke "mul" (i32.const 33) (i32.const 2)) (i32.const 66))
+(assert_return (invoke "mul" (i32.const 33) (i32.const 3)) (i32.const 99))
+(assert_return (invoke "mul" (i32.const 33) (i32.const 0x7fffffff)) (i32.const 0x7fffffdf))
+(assert_return (invoke "mul" (i32.const 33) (i32.const 0x80000000)) (i32.const 0x80000000))
+(assert_return (invoke "mul" (i32.const 33) (i32.const 0x80000001)) (i32.const 0x80000021))
+(assert_return (invoke "mul" (i32.const 33) (i32.const 0x40000000)) (i32.const 0x40000000))
+(assert_return (invoke "mul" (i32.const 33) (i32.const 0x12345678)) (i32.const 0x58bf2578))
+(assert_return (invoke "mul" (i32.const 33) (i32.const 0xedcba988)) (i32.const 0xa740da88))
+(assert_return (invoke "mul" (i32.const 33) (i32.const 31)) (i32.const 1023))
+(assert_return (invoke "mul" (i32.const 33) (i32.const 32)) (i32.const 1056))
+(assert_return (invoke "mul" (i32.const 33) (i32.const 33)) (i32.const 1089))
+(assert_return (invoke "mul" (i32.const 33) (i32.const 0xfff5a5a)) (i32.const 0xfeaa59a))
+(assert_return (invoke "mul" (i32.const 0xfff5a5a) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "mul" (i32.const 0xfff5a5a) (i32.const 1)) (i32.const 0xfff5a5a))
+(assert_return (invoke "mul" (i32.const 0xfff5a5a) (i32.const -1)) (i32.const 0xf000a5a6))
+(assert_return (invoke "mul" (i32.const 0xfff5a5a) (i32.const 2)) (i32.const 0x1ffeb4b4))
+(assert_return (invoke "mul" (i32.const 0xfff5a5a) (i32.const 3)) (i32.const 0x2ffe0f0e))
+(assert_return (invoke "mul" (i32.const 0xfff5a5a) (i32.const 0x7fffffff)) (i32.const 0xf000a5a6))
+(assert_return (invoke "mul" (i32.const 0xfff5a5a) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "mul" (i32.const 0xfff5a5a) (i32.const 0x80000001)) (i32.const 0xfff5a5a))
+(assert_return (invoke "mul" (i32.const 0xfff5a5a) (i32.const 0x40000000)) (i32.const 0x80000000))
+(assert_return (invoke "mul" (i32.const 0xfff5a5a) (i32.const 0x12345678)) (i32.const 0xf6549630))
+(assert_return (invoke "mul" (i32.const 0xfff5a5a) (i32.const 0xedcba988)) (i32.const 0x9ab69d0))
+(assert_return (invoke "mul" (i32.const 0xfff5a5a) (i32.const 31)) (i32.const 0xefebf0e6))
+(assert_return (invoke "mul" (i32.const 0xfff5a5a) (i32.const 32)) (i32.const 0xffeb4b40))
+(assert_return (invoke "mul" (i32.const 0xfff5a5a) (i32.const 33)) (i32.const 0xfeaa59a))
+(assert_return (invoke "mul" (i32.const 0xfff5a5a) (i32.const 0xfff5a5a)) (i32.const 0xab2f67a4))
+(assert_return (invoke "mul" (i32.const 0x4f46d734) (i32.const 0xba7a644a)) (i32.const 0xed528508))
+(assert_return (invoke "mul" (i32.const 0xedc68176) (i32.const 0x7e0d74a7)) (i32.const 0xb625ebfa))
+(assert_return (invoke "mul" (i32.const 0x2ae01d36) (i32.const 0x88de46e0)) (i32.const 0xd0ea5340))
+(assert_return (invoke "mul" (i32.const 0xd079a78d) (i32.const 0xd002c03d)) (i32.const 0xc9c0ac99))
+(assert_return (invoke "mul" (i32.const 0xbd04fe04) (i32.const 0x4c9e299c)) (i32.const 0xf2316e70))
+(assert_return (invoke "mul" (i32.const 0x918aa931) (i32.const 0x9372b5c2)) (i32.const 0xcf85dc22))
+(assert_return (invoke "mul" (i32.const 0x9360be92) (i32.const 0x1517469c)) (i32.const 0x5c2e0cf8))
+(assert_return (invoke "mul" (i32.const 0xc5282ed5) (i32.const 0x87bff0b)) (i32.const 0xa7b72e27))
+(assert_return (invoke "mul" (i32.const 0x964bc8f9) (i32.const 0x4a4b5db1)) (i32.const 0x4d5b6929))
+(assert_return (invoke "mul" (i32.const 0x3ab31bbc) (i32.const 0x49d53744)) (i32.const 0xc1f4c1f0))
+(assert_return (invoke "mul" (i32.const 0x5458af07) (i32.const 0x14247d23)) (i32.const 0xfe9258f5))
+(assert_return (invoke "mul" (i32.const 0x3e77a684) (i32.const 0xa9b6f136)) (i32.const 0x56d763d8))
+(assert_return (invoke "mul" (i32.const 0x66909591) (i32.const 0xd762da4d)) (i32.const 0x15c769d))
+(assert_return (invoke "mul" (i32.const 0xfcbd2f6e) (i32.const 0x4a11096f)) (i32.const 0x2f006eb2))
+(assert_return (invoke "mul" (i32.const 0x6d3f9d43) (i32.const 0xb256dbba)) (i32.const 0x364293ae))
+(assert_return (invoke "mul" (i32.const 0x11d3fd94) (i32.const 0xcb1634d7)) (i32.const 0x2f44074c))
+
+(assert_trap (invoke "div_s" (i32.const 0) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "div_s" (i32.const 0) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 0) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 0) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 0) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 0) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 0) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 0) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 0) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 0) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 0) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 0) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 0) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 0) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 0) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_trap (invoke "div_s" (i32.const 1) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "div_s" (i32.const 1) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "div_s" (i32.const 1) (i32.const -1)) (i32.const -1))
+(assert_return (invoke "div_s" (i32.const 1) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 1) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 1) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 1) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 1) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 1) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 1) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 1) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 1) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 1) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 1) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 1) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_trap (invoke "div_s" (i32.const -1) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "div_s" (i32.const -1) (i32.const 1)) (i32.const -1))
+(assert_return (invoke "div_s" (i32.const -1) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "div_s" (i32.const -1) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const -1) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const -1) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const -1) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const -1) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const -1) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const -1) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const -1) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const -1) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const -1) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const -1) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const -1) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_trap (invoke "div_s" (i32.const 2) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "div_s" (i32.const 2) (i32.const 1)) (i32.const 2))
+(assert_return (invoke "div_s" (i32.const 2) (i32.const -1)) (i32.const -2))
+(assert_return (invoke "div_s" (i32.const 2) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "div_s" (i32.const 2) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 2) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 2) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 2) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 2) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 2) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 2) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 2) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 2) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 2) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 2) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_trap (invoke "div_s" (i32.const 3) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "div_s" (i32.const 3) (i32.const 1)) (i32.const 3))
+(assert_return (invoke "div_s" (i32.const 3) (i32.const -1)) (i32.const -3))
+(assert_return (invoke "div_s" (i32.const 3) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "div_s" (i32.const 3) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "div_s" (i32.const 3) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 3) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 3) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 3) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 3) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 3) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 3) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 3) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 3) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 3) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_trap (invoke "div_s" (i32.const 0x7fffffff) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "div_s" (i32.const 0x7fffffff) (i32.const 1)) (i32.const 0x7fffffff))
+(assert_return (invoke "div_s" (i32.const 0x7fffffff) (i32.const -1)) (i32.const 0x80000001))
+(assert_return (invoke "div_s" (i32.const 0x7fffffff) (i32.const 2)) (i32.const 0x3fffffff))
+(assert_return (invoke "div_s" (i32.const 0x7fffffff) (i32.const 3)) (i32.const 0x2aaaaaaa))
+(assert_return (invoke "div_s" (i32.const 0x7fffffff) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "div_s" (i32.const 0x7fffffff) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 0x7fffffff) (i32.const 0x80000001)) (i32.const -1))
+(assert_return (invoke "div_s" (i32.const 0x7fffffff) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "div_s" (i32.const 0x7fffffff) (i32.const 0x12345678)) (i32.const 7))
+(assert_return (invoke "div_s" (i32.const 0x7fffffff) (i32.const 0xedcba988)) (i32.const -7))
+(assert_return (invoke "div_s" (i32.const 0x7fffffff) (i32.const 31)) (i32.const 0x4210842))
+(assert_return (invoke "div_s" (i32.const 0x7fffffff) (i32.const 32)) (i32.const 0x3ffffff))
+(assert_return (invoke "div_s" (i32.const 0x7fffffff) (i32.const 33)) (i32.const 0x3e0f83e))
+(assert_return (invoke "div_s" (i32.const 0x7fffffff) (i32.const 0xfff5a5a)) (i32.const 8))
+(assert_trap (invoke "div_s" (i32.const 0x80000000) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "div_s" (i32.const 0x80000000) (i32.const 1)) (i32.const 0x80000000))
+(assert_trap (invoke "div_s" (i32.const 0x80000000) (i32.const -1)) "integer overflow")
+(assert_return (invoke "div_s" (i32.const 0x80000000) (i32.const 2)) (i32.const 0xc0000000))
+(assert_return (invoke "div_s" (i32.const 0x80000000) (i32.const 3)) (i32.const 0xd5555556))
+(assert_return (invoke "div_s" (i32.const 0x80000000) (i32.const 0x7fffffff)) (i32.const -1))
+(assert_return (invoke "div_s" (i32.const 0x80000000) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "div_s" (i32.const 0x80000000) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "div_s" (i32.const 0x80000000) (i32.const 0x40000000)) (i32.const -2))
+(assert_return (invoke "div_s" (i32.const 0x80000000) (i32.const 0x12345678)) (i32.const -7))
+(assert_return (invoke "div_s" (i32.const 0x80000000) (i32.const 0xedcba988)) (i32.const 7))
+(assert_return (invoke "div_s" (i32.const 0x80000000) (i32.const 31)) (i32.const 0xfbdef7be))
+(assert_return (invoke "div_s" (i32.const 0x80000000) (i32.const 32)) (i32.const 0xfc000000))
+(assert_return (invoke "div_s" (i32.const 0x80000000) (i32.const 33)) (i32.const 0xfc1f07c2))
+(assert_return (invoke "div_s" (i32.const 0x80000000) (i32.const 0xfff5a5a)) (i32.const -8))
+(assert_trap (invoke "div_s" (i32.const 0x80000001) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "div_s" (i32.const 0x80000001) (i32.const 1)) (i32.const 0x80000001))
+(assert_return (invoke "div_s" (i32.const 0x80000001) (i32.const -1)) (i32.const 0x7fffffff))
+(assert_return (invoke "div_s" (i32.const 0x80000001) (i32.const 2)) (i32.const 0xc0000001))
+(assert_return (invoke "div_s" (i32.const 0x80000001) (i32.const 3)) (i32.const 0xd5555556))
+(assert_return (invoke "div_s" (i32.const 0x80000001) (i32.const 0x7fffffff)) (i32.const -1))
+(assert_return (invoke "div_s" (i32.const 0x80000001) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 0x80000001) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "div_s" (i32.const 0x80000001) (i32.const 0x40000000)) (i32.const -1))
+(assert_return (invoke "div_s" (i32.const 0x80000001) (i32.const 0x12345678)) (i32.const -7))
+(assert_return (invoke "div_s" (i32.const 0x80000001) (i32.const 0xedcba988)) (i32.const 7))
+(assert_return (invoke "div_s" (i32.const 0x80000001) (i32.const 31)) (i32.const 0xfbdef7be))
+(assert_return (invoke "div_s" (i32.const 0x80000001) (i32.const 32)) (i32.const 0xfc000001))
+(assert_return (invoke "div_s" (i32.const 0x80000001) (i32.const 33)) (i32.const 0xfc1f07c2))
+(assert_return (invoke "div_s" (i32.const 0x80000001) (i32.const 0xfff5a5a)) (i32.const -8))
+(assert_trap (invoke "div_s" (i32.const 0x40000000) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "div_s" (i32.const 0x40000000) (i32.const 1)) (i32.const 0x40000000))
+(assert_return (invoke "div_s" (i32.const 0x40000000) (i32.const -1)) (i32.const 0xc0000000))
+(assert_return (invoke "div_s" (i32.const 0x40000000) (i32.const 2)) (i32.const 0x20000000))
+(assert_return (invoke "div_s" (i32.const 0x40000000) (i32.const 3)) (i32.const 0x15555555))
+(assert_return (invoke "div_s" (i32.const 0x40000000) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 0x40000000) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 0x40000000) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 0x40000000) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "div_s" (i32.const 0x40000000) (i32.const 0x12345678)) (i32.const 3))
+(assert_return (invoke "div_s" (i32.const 0x40000000) (i32.const 0xedcba988)) (i32.const -3))
+(assert_return (invoke "div_s" (i32.const 0x40000000) (i32.const 31)) (i32.const 0x2108421))
+(assert_return (invoke "div_s" (i32.const 0x40000000) (i32.const 32)) (i32.const 0x2000000))
+(assert_return (invoke "div_s" (i32.const 0x40000000) (i32.const 33)) (i32.const 0x1f07c1f))
+(assert_return (invoke "div_s" (i32.const 0x40000000) (i32.const 0xfff5a5a)) (i32.const 4))
+(assert_trap (invoke "div_s" (i32.const 0x12345678) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "div_s" (i32.const 0x12345678) (i32.const 1)) (i32.const 0x12345678))
+(assert_return (invoke "div_s" (i32.const 0x12345678) (i32.const -1)) (i32.const 0xedcba988))
+(assert_return (invoke "div_s" (i32.const 0x12345678) (i32.const 2)) (i32.const 0x91a2b3c))
+(assert_return (invoke "div_s" (i32.const 0x12345678) (i32.const 3)) (i32.const 0x6117228))
+(assert_return (invoke "div_s" (i32.const 0x12345678) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 0x12345678) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 0x12345678) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 0x12345678) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 0x12345678) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "div_s" (i32.const 0x12345678) (i32.const 0xedcba988)) (i32.const -1))
+(assert_return (invoke "div_s" (i32.const 0x12345678) (i32.const 31)) (i32.const 0x96555e))
+(assert_return (invoke "div_s" (i32.const 0x12345678) (i32.const 32)) (i32.const 0x91a2b3))
+(assert_return (invoke "div_s" (i32.const 0x12345678) (i32.const 33)) (i32.const 0x8d38ec))
+(assert_return (invoke "div_s" (i32.const 0x12345678) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_trap (invoke "div_s" (i32.const 0xedcba988) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "div_s" (i32.const 0xedcba988) (i32.const 1)) (i32.const 0xedcba988))
+(assert_return (invoke "div_s" (i32.const 0xedcba988) (i32.const -1)) (i32.const 0x12345678))
+(assert_return (invoke "div_s" (i32.const 0xedcba988) (i32.const 2)) (i32.const 0xf6e5d4c4))
+(assert_return (invoke "div_s" (i32.const 0xedcba988) (i32.const 3)) (i32.const 0xf9ee8dd8))
+(assert_return (invoke "div_s" (i32.const 0xedcba988) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 0xedcba988) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 0xedcba988) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 0xedcba988) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 0xedcba988) (i32.const 0x12345678)) (i32.const -1))
+(assert_return (invoke "div_s" (i32.const 0xedcba988) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "div_s" (i32.const 0xedcba988) (i32.const 31)) (i32.const 0xff69aaa2))
+(assert_return (invoke "div_s" (i32.const 0xedcba988) (i32.const 32)) (i32.const 0xff6e5d4d))
+(assert_return (invoke "div_s" (i32.const 0xedcba988) (i32.const 33)) (i32.const 0xff72c714))
+(assert_return (invoke "div_s" (i32.const 0xedcba988) (i32.const 0xfff5a5a)) (i32.const -1))
+(assert_trap (invoke "div_s" (i32.const 31) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "div_s" (i32.const 31) (i32.const 1)) (i32.const 31))
+(assert_return (invoke "div_s" (i32.const 31) (i32.const -1)) (i32.const -31))
+(assert_return (invoke "div_s" (i32.const 31) (i32.const 2)) (i32.const 15))
+(assert_return (invoke "div_s" (i32.const 31) (i32.const 3)) (i32.const 10))
+(assert_return (invoke "div_s" (i32.const 31) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 31) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 31) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 31) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 31) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 31) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 31) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "div_s" (i32.const 31) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 31) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 31) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_trap (invoke "div_s" (i32.const 32) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "div_s" (i32.const 32) (i32.const 1)) (i32.const 32))
+(assert_return (invoke "div_s" (i32.const 32) (i32.const -1)) (i32.const -32))
+(assert_return (invoke "div_s" (i32.const 32) (i32.const 2)) (i32.const 16))
+(assert_return (invoke "div_s" (i32.const 32) (i32.const 3)) (i32.const 10))
+(assert_return (invoke "div_s" (i32.const 32) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 32) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 32) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 32) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 32) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 32) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 32) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "div_s" (i32.const 32) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "div_s" (i32.const 32) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 32) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_trap (invoke "div_s" (i32.const 33) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "div_s" (i32.const 33) (i32.const 1)) (i32.const 33))
+(assert_return (invoke "div_s" (i32.const 33) (i32.const -1)) (i32.const -33))
+(assert_return (invoke "div_s" (i32.const 33) (i32.const 2)) (i32.const 16))
+(assert_return (invoke "div_s" (i32.const 33) (i32.const 3)) (i32.const 11))
+(assert_return (invoke "div_s" (i32.const 33) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 33) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 33) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 33) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 33) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 33) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 33) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "div_s" (i32.const 33) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "div_s" (i32.const 33) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "div_s" (i32.const 33) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_trap (invoke "div_s" (i32.const 0xfff5a5a) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "div_s" (i32.const 0xfff5a5a) (i32.const 1)) (i32.const 0xfff5a5a))
+(assert_return (invoke "div_s" (i32.const 0xfff5a5a) (i32.const -1)) (i32.const 0xf000a5a6))
+(assert_return (invoke "div_s" (i32.const 0xfff5a5a) (i32.const 2)) (i32.const 0x7ffad2d))
+(assert_return (invoke "div_s" (i32.const 0xfff5a5a) (i32.const 3)) (i32.const 0x5551e1e))
+(assert_return (invoke "div_s" (i32.const 0xfff5a5a) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 0xfff5a5a) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 0xfff5a5a) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 0xfff5a5a) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 0xfff5a5a) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 0xfff5a5a) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 0xfff5a5a) (i32.const 31)) (i32.const 0x841bb0))
+(assert_return (invoke "div_s" (i32.const 0xfff5a5a) (i32.const 32)) (i32.const 0x7ffad2))
+(assert_return (invoke "div_s" (i32.const 0xfff5a5a) (i32.const 33)) (i32.const 0x7c1a02))
+(assert_return (invoke "div_s" (i32.const 0xfff5a5a) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "div_s" (i32.const 0x7a0c0387) (i32.const 0x2557ba7)) (i32.const 52))
+(assert_return (invoke "div_s" (i32.const 0xd00c4cd8) (i32.const 0x2e1da738)) (i32.const -1))
+(assert_return (invoke "div_s" (i32.const 0x720f9b45) (i32.const 0x29b425c3)) (i32.const 2))
+(assert_return (invoke "div_s" (i32.const 0xb3ca0784) (i32.const 0xfdcafd6e)) (i32.const 34))
+(assert_return (invoke "div_s" (i32.const 0x17458fd4) (i32.const 0xdd04baf1)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 0xff9b828) (i32.const 0x21ddfb62)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 0xa96825be) (i32.const 0x428881dd)) (i32.const -1))
+(assert_return (invoke "div_s" (i32.const 0xc5b23e8) (i32.const 0x8d4fbee7)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 0x4d5063c7) (i32.const 0x2542efa5)) (i32.const 2))
+(assert_return (invoke "div_s" (i32.const 0x6276a3cf) (i32.const 0xe518523)) (i32.const 6))
+(assert_return (invoke "div_s" (i32.const 0x318a1c83) (i32.const 0x96e5c092)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 0x94f32e2) (i32.const 0x7d5d7eef)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 0x1b99c53) (i32.const 0x811e1811)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 0xf39df7d0) (i32.const 0x86466ea9)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 0xb04eb8a9) (i32.const 0x53cae6f9)) (i32.const 0))
+(assert_return (invoke "div_s" (i32.const 0xf3a8aa33) (i32.const 0x5c4dd5d3)) (i32.const 0))
+
+(assert_trap (invoke "div_u" (i32.const 0) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "div_u" (i32.const 0) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 0) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 0) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 0) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 0) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 0) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 0) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 0) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 0) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 0) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 0) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 0) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 0) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 0) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_trap (invoke "div_u" (i32.const 1) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "div_u" (i32.const 1) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "div_u" (i32.const 1) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 1) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 1) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 1) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 1) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 1) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 1) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 1) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 1) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 1) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 1) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 1) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 1) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_trap (invoke "div_u" (i32.const -1) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "div_u" (i32.const -1) (i32.const 1)) (i32.const -1))
+(assert_return (invoke "div_u" (i32.const -1) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "div_u" (i32.const -1) (i32.const 2)) (i32.const 0x7fffffff))
+(assert_return (invoke "div_u" (i32.const -1) (i32.const 3)) (i32.const 0x55555555))
+(assert_return (invoke "div_u" (i32.const -1) (i32.const 0x7fffffff)) (i32.const 2))
+(assert_return (invoke "div_u" (i32.const -1) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "div_u" (i32.const -1) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "div_u" (i32.const -1) (i32.const 0x40000000)) (i32.const 3))
+(assert_return (invoke "div_u" (i32.const -1) (i32.const 0x12345678)) (i32.const 14))
+(assert_return (invoke "div_u" (i32.const -1) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "div_u" (i32.const -1) (i32.const 31)) (i32.const 0x8421084))
+(assert_return (invoke "div_u" (i32.const -1) (i32.const 32)) (i32.const 0x7ffffff))
+(assert_return (invoke "div_u" (i32.const -1) (i32.const 33)) (i32.const 0x7c1f07c))
+(assert_return (invoke "div_u" (i32.const -1) (i32.const 0xfff5a5a)) (i32.const 16))
+(assert_trap (invoke "div_u" (i32.const 2) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "div_u" (i32.const 2) (i32.const 1)) (i32.const 2))
+(assert_return (invoke "div_u" (i32.const 2) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 2) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "div_u" (i32.const 2) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 2) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 2) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 2) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 2) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 2) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 2) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 2) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 2) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 2) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 2) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_trap (invoke "div_u" (i32.const 3) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "div_u" (i32.const 3) (i32.const 1)) (i32.const 3))
+(assert_return (invoke "div_u" (i32.const 3) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 3) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "div_u" (i32.const 3) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "div_u" (i32.const 3) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 3) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 3) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 3) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 3) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 3) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 3) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 3) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 3) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 3) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_trap (invoke "div_u" (i32.const 0x7fffffff) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "div_u" (i32.const 0x7fffffff) (i32.const 1)) (i32.const 0x7fffffff))
+(assert_return (invoke "div_u" (i32.const 0x7fffffff) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 0x7fffffff) (i32.const 2)) (i32.const 0x3fffffff))
+(assert_return (invoke "div_u" (i32.const 0x7fffffff) (i32.const 3)) (i32.const 0x2aaaaaaa))
+(assert_return (invoke "div_u" (i32.const 0x7fffffff) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "div_u" (i32.const 0x7fffffff) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 0x7fffffff) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 0x7fffffff) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "div_u" (i32.const 0x7fffffff) (i32.const 0x12345678)) (i32.const 7))
+(assert_return (invoke "div_u" (i32.const 0x7fffffff) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 0x7fffffff) (i32.const 31)) (i32.const 0x4210842))
+(assert_return (invoke "div_u" (i32.const 0x7fffffff) (i32.const 32)) (i32.const 0x3ffffff))
+(assert_return (invoke "div_u" (i32.const 0x7fffffff) (i32.const 33)) (i32.const 0x3e0f83e))
+(assert_return (invoke "div_u" (i32.const 0x7fffffff) (i32.const 0xfff5a5a)) (i32.const 8))
+(assert_trap (invoke "div_u" (i32.const 0x80000000) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "div_u" (i32.const 0x80000000) (i32.const 1)) (i32.const 0x80000000))
+(assert_return (invoke "div_u" (i32.const 0x80000000) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 0x80000000) (i32.const 2)) (i32.const 0x40000000))
+(assert_return (invoke "div_u" (i32.const 0x80000000) (i32.const 3)) (i32.const 0x2aaaaaaa))
+(assert_return (invoke "div_u" (i32.const 0x80000000) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "div_u" (i32.const 0x80000000) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "div_u" (i32.const 0x80000000) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 0x80000000) (i32.const 0x40000000)) (i32.const 2))
+(assert_return (invoke "div_u" (i32.const 0x80000000) (i32.const 0x12345678)) (i32.const 7))
+(assert_return (invoke "div_u" (i32.const 0x80000000) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 0x80000000) (i32.const 31)) (i32.const 0x4210842))
+(assert_return (invoke "div_u" (i32.const 0x80000000) (i32.const 32)) (i32.const 0x4000000))
+(assert_return (invoke "div_u" (i32.const 0x80000000) (i32.const 33)) (i32.const 0x3e0f83e))
+(assert_return (invoke "div_u" (i32.const 0x80000000) (i32.const 0xfff5a5a)) (i32.const 8))
+(assert_trap (invoke "div_u" (i32.const 0x80000001) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "div_u" (i32.const 0x80000001) (i32.const 1)) (i32.const 0x80000001))
+(assert_return (invoke "div_u" (i32.const 0x80000001) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 0x80000001) (i32.const 2)) (i32.const 0x40000000))
+(assert_return (invoke "div_u" (i32.const 0x80000001) (i32.const 3)) (i32.const 0x2aaaaaab))
+(assert_return (invoke "div_u" (i32.const 0x80000001) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "div_u" (i32.const 0x80000001) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "div_u" (i32.const 0x80000001) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "div_u" (i32.const 0x80000001) (i32.const 0x40000000)) (i32.const 2))
+(assert_return (invoke "div_u" (i32.const 0x80000001) (i32.const 0x12345678)) (i32.const 7))
+(assert_return (invoke "div_u" (i32.const 0x80000001) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 0x80000001) (i32.const 31)) (i32.const 0x4210842))
+(assert_return (invoke "div_u" (i32.const 0x80000001) (i32.const 32)) (i32.const 0x4000000))
+(assert_return (invoke "div_u" (i32.const 0x80000001) (i32.const 33)) (i32.const 0x3e0f83e))
+(assert_return (invoke "div_u" (i32.const 0x80000001) (i32.const 0xfff5a5a)) (i32.const 8))
+(assert_trap (invoke "div_u" (i32.const 0x40000000) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "div_u" (i32.const 0x40000000) (i32.const 1)) (i32.const 0x40000000))
+(assert_return (invoke "div_u" (i32.const 0x40000000) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 0x40000000) (i32.const 2)) (i32.const 0x20000000))
+(assert_return (invoke "div_u" (i32.const 0x40000000) (i32.const 3)) (i32.const 0x15555555))
+(assert_return (invoke "div_u" (i32.const 0x40000000) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 0x40000000) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 0x40000000) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 0x40000000) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "div_u" (i32.const 0x40000000) (i32.const 0x12345678)) (i32.const 3))
+(assert_return (invoke "div_u" (i32.const 0x40000000) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 0x40000000) (i32.const 31)) (i32.const 0x2108421))
+(assert_return (invoke "div_u" (i32.const 0x40000000) (i32.const 32)) (i32.const 0x2000000))
+(assert_return (invoke "div_u" (i32.const 0x40000000) (i32.const 33)) (i32.const 0x1f07c1f))
+(assert_return (invoke "div_u" (i32.const 0x40000000) (i32.const 0xfff5a5a)) (i32.const 4))
+(assert_trap (invoke "div_u" (i32.const 0x12345678) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "div_u" (i32.const 0x12345678) (i32.const 1)) (i32.const 0x12345678))
+(assert_return (invoke "div_u" (i32.const 0x12345678) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 0x12345678) (i32.const 2)) (i32.const 0x91a2b3c))
+(assert_return (invoke "div_u" (i32.const 0x12345678) (i32.const 3)) (i32.const 0x6117228))
+(assert_return (invoke "div_u" (i32.const 0x12345678) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 0x12345678) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 0x12345678) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 0x12345678) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 0x12345678) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "div_u" (i32.const 0x12345678) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 0x12345678) (i32.const 31)) (i32.const 0x96555e))
+(assert_return (invoke "div_u" (i32.const 0x12345678) (i32.const 32)) (i32.const 0x91a2b3))
+(assert_return (invoke "div_u" (i32.const 0x12345678) (i32.const 33)) (i32.const 0x8d38ec))
+(assert_return (invoke "div_u" (i32.const 0x12345678) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_trap (invoke "div_u" (i32.const 0xedcba988) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "div_u" (i32.const 0xedcba988) (i32.const 1)) (i32.const 0xedcba988))
+(assert_return (invoke "div_u" (i32.const 0xedcba988) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 0xedcba988) (i32.const 2)) (i32.const 0x76e5d4c4))
+(assert_return (invoke "div_u" (i32.const 0xedcba988) (i32.const 3)) (i32.const 0x4f43e32d))
+(assert_return (invoke "div_u" (i32.const 0xedcba988) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "div_u" (i32.const 0xedcba988) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "div_u" (i32.const 0xedcba988) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "div_u" (i32.const 0xedcba988) (i32.const 0x40000000)) (i32.const 3))
+(assert_return (invoke "div_u" (i32.const 0xedcba988) (i32.const 0x12345678)) (i32.const 13))
+(assert_return (invoke "div_u" (i32.const 0xedcba988) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "div_u" (i32.const 0xedcba988) (i32.const 31)) (i32.const 0x7abbb25))
+(assert_return (invoke "div_u" (i32.const 0xedcba988) (i32.const 32)) (i32.const 0x76e5d4c))
+(assert_return (invoke "div_u" (i32.const 0xedcba988) (i32.const 33)) (i32.const 0x734b78f))
+(assert_return (invoke "div_u" (i32.const 0xedcba988) (i32.const 0xfff5a5a)) (i32.const 14))
+(assert_trap (invoke "div_u" (i32.const 31) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "div_u" (i32.const 31) (i32.const 1)) (i32.const 31))
+(assert_return (invoke "div_u" (i32.const 31) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 31) (i32.const 2)) (i32.const 15))
+(assert_return (invoke "div_u" (i32.const 31) (i32.const 3)) (i32.const 10))
+(assert_return (invoke "div_u" (i32.const 31) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 31) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 31) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 31) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 31) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 31) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 31) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "div_u" (i32.const 31) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 31) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 31) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_trap (invoke "div_u" (i32.const 32) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "div_u" (i32.const 32) (i32.const 1)) (i32.const 32))
+(assert_return (invoke "div_u" (i32.const 32) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 32) (i32.const 2)) (i32.const 16))
+(assert_return (invoke "div_u" (i32.const 32) (i32.const 3)) (i32.const 10))
+(assert_return (invoke "div_u" (i32.const 32) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 32) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 32) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 32) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 32) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 32) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 32) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "div_u" (i32.const 32) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "div_u" (i32.const 32) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 32) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_trap (invoke "div_u" (i32.const 33) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "div_u" (i32.const 33) (i32.const 1)) (i32.const 33))
+(assert_return (invoke "div_u" (i32.const 33) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 33) (i32.const 2)) (i32.const 16))
+(assert_return (invoke "div_u" (i32.const 33) (i32.const 3)) (i32.const 11))
+(assert_return (invoke "div_u" (i32.const 33) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 33) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 33) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 33) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 33) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 33) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 33) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "div_u" (i32.const 33) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "div_u" (i32.const 33) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "div_u" (i32.const 33) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_trap (invoke "div_u" (i32.const 0xfff5a5a) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "div_u" (i32.const 0xfff5a5a) (i32.const 1)) (i32.const 0xfff5a5a))
+(assert_return (invoke "div_u" (i32.const 0xfff5a5a) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 0xfff5a5a) (i32.const 2)) (i32.const 0x7ffad2d))
+(assert_return (invoke "div_u" (i32.const 0xfff5a5a) (i32.const 3)) (i32.const 0x5551e1e))
+(assert_return (invoke "div_u" (i32.const 0xfff5a5a) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 0xfff5a5a) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 0xfff5a5a) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 0xfff5a5a) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 0xfff5a5a) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 0xfff5a5a) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 0xfff5a5a) (i32.const 31)) (i32.const 0x841bb0))
+(assert_return (invoke "div_u" (i32.const 0xfff5a5a) (i32.const 32)) (i32.const 0x7ffad2))
+(assert_return (invoke "div_u" (i32.const 0xfff5a5a) (i32.const 33)) (i32.const 0x7c1a02))
+(assert_return (invoke "div_u" (i32.const 0xfff5a5a) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "div_u" (i32.const 0x7de077fb) (i32.const 0x7a8947e3)) (i32.const 1))
+(assert_return (invoke "div_u" (i32.const 0xf454815f) (i32.const 0xef9fe8b8)) (i32.const 1))
+(assert_return (invoke "div_u" (i32.const 0xdccfe4fc) (i32.const 0x3feab344)) (i32.const 3))
+(assert_return (invoke "div_u" (i32.const 0x9e373270) (i32.const 0x52a71e87)) (i32.const 1))
+(assert_return (invoke "div_u" (i32.const 0x8670e9d1) (i32.const 0x92324a96)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 0x8238248a) (i32.const 0x3bb0820d)) (i32.const 2))
+(assert_return (invoke "div_u" (i32.const 0x39b7f8d9) (i32.const 0x622d95b7)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 0x604f736e) (i32.const 0x94a1500e)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 0xb910260d) (i32.const 0x5eea5732)) (i32.const 1))
+(assert_return (invoke "div_u" (i32.const 0x31fb411b) (i32.const 0xd405f928)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 0xd9943e06) (i32.const 0xf542138f)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 0x82847a4f) (i32.const 0x20ed67b8)) (i32.const 3))
+(assert_return (invoke "div_u" (i32.const 0x9aaacb7a) (i32.const 0xaf30c678)) (i32.const 0))
+(assert_return (invoke "div_u" (i32.const 0xd263e3fe) (i32.const 0x59d40bc9)) (i32.const 2))
+(assert_return (invoke "div_u" (i32.const 0xa56cedbe) (i32.const 0x5ed8949c)) (i32.const 1))
+(assert_return (invoke "div_u" (i32.const 0x2341c7d4) (i32.const 0x9f0693fe)) (i32.const 0))
+
+(assert_trap (invoke "rem_s" (i32.const 0) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_s" (i32.const 0) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 0) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 0) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 0) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 0) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 0) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 0) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 0) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 0) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 0) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 0) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 0) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 0) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 0) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_trap (invoke "rem_s" (i32.const 1) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_s" (i32.const 1) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 1) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 1) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "rem_s" (i32.const 1) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "rem_s" (i32.const 1) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "rem_s" (i32.const 1) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "rem_s" (i32.const 1) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "rem_s" (i32.const 1) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "rem_s" (i32.const 1) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "rem_s" (i32.const 1) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "rem_s" (i32.const 1) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "rem_s" (i32.const 1) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "rem_s" (i32.const 1) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "rem_s" (i32.const 1) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_trap (invoke "rem_s" (i32.const -1) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_s" (i32.const -1) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const -1) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const -1) (i32.const 2)) (i32.const -1))
+(assert_return (invoke "rem_s" (i32.const -1) (i32.const 3)) (i32.const -1))
+(assert_return (invoke "rem_s" (i32.const -1) (i32.const 0x7fffffff)) (i32.const -1))
+(assert_return (invoke "rem_s" (i32.const -1) (i32.const 0x80000000)) (i32.const -1))
+(assert_return (invoke "rem_s" (i32.const -1) (i32.const 0x80000001)) (i32.const -1))
+(assert_return (invoke "rem_s" (i32.const -1) (i32.const 0x40000000)) (i32.const -1))
+(assert_return (invoke "rem_s" (i32.const -1) (i32.const 0x12345678)) (i32.const -1))
+(assert_return (invoke "rem_s" (i32.const -1) (i32.const 0xedcba988)) (i32.const -1))
+(assert_return (invoke "rem_s" (i32.const -1) (i32.const 31)) (i32.const -1))
+(assert_return (invoke "rem_s" (i32.const -1) (i32.const 32)) (i32.const -1))
+(assert_return (invoke "rem_s" (i32.const -1) (i32.const 33)) (i32.const -1))
+(assert_return (invoke "rem_s" (i32.const -1) (i32.const 0xfff5a5a)) (i32.const -1))
+(assert_trap (invoke "rem_s" (i32.const 2) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_s" (i32.const 2) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 2) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 2) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 2) (i32.const 3)) (i32.const 2))
+(assert_return (invoke "rem_s" (i32.const 2) (i32.const 0x7fffffff)) (i32.const 2))
+(assert_return (invoke "rem_s" (i32.const 2) (i32.const 0x80000000)) (i32.const 2))
+(assert_return (invoke "rem_s" (i32.const 2) (i32.const 0x80000001)) (i32.const 2))
+(assert_return (invoke "rem_s" (i32.const 2) (i32.const 0x40000000)) (i32.const 2))
+(assert_return (invoke "rem_s" (i32.const 2) (i32.const 0x12345678)) (i32.const 2))
+(assert_return (invoke "rem_s" (i32.const 2) (i32.const 0xedcba988)) (i32.const 2))
+(assert_return (invoke "rem_s" (i32.const 2) (i32.const 31)) (i32.const 2))
+(assert_return (invoke "rem_s" (i32.const 2) (i32.const 32)) (i32.const 2))
+(assert_return (invoke "rem_s" (i32.const 2) (i32.const 33)) (i32.const 2))
+(assert_return (invoke "rem_s" (i32.const 2) (i32.const 0xfff5a5a)) (i32.const 2))
+(assert_trap (invoke "rem_s" (i32.const 3) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_s" (i32.const 3) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 3) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 3) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "rem_s" (i32.const 3) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 3) (i32.const 0x7fffffff)) (i32.const 3))
+(assert_return (invoke "rem_s" (i32.const 3) (i32.const 0x80000000)) (i32.const 3))
+(assert_return (invoke "rem_s" (i32.const 3) (i32.const 0x80000001)) (i32.const 3))
+(assert_return (invoke "rem_s" (i32.const 3) (i32.const 0x40000000)) (i32.const 3))
+(assert_return (invoke "rem_s" (i32.const 3) (i32.const 0x12345678)) (i32.const 3))
+(assert_return (invoke "rem_s" (i32.const 3) (i32.const 0xedcba988)) (i32.const 3))
+(assert_return (invoke "rem_s" (i32.const 3) (i32.const 31)) (i32.const 3))
+(assert_return (invoke "rem_s" (i32.const 3) (i32.const 32)) (i32.const 3))
+(assert_return (invoke "rem_s" (i32.const 3) (i32.const 33)) (i32.const 3))
+(assert_return (invoke "rem_s" (i32.const 3) (i32.const 0xfff5a5a)) (i32.const 3))
+(assert_trap (invoke "rem_s" (i32.const 0x7fffffff) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_s" (i32.const 0x7fffffff) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 0x7fffffff) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 0x7fffffff) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "rem_s" (i32.const 0x7fffffff) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "rem_s" (i32.const 0x7fffffff) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 0x7fffffff) (i32.const 0x80000000)) (i32.const 0x7fffffff))
+(assert_return (invoke "rem_s" (i32.const 0x7fffffff) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 0x7fffffff) (i32.const 0x40000000)) (i32.const 0x3fffffff))
+(assert_return (invoke "rem_s" (i32.const 0x7fffffff) (i32.const 0x12345678)) (i32.const 0x91a2b7))
+(assert_return (invoke "rem_s" (i32.const 0x7fffffff) (i32.const 0xedcba988)) (i32.const 0x91a2b7))
+(assert_return (invoke "rem_s" (i32.const 0x7fffffff) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "rem_s" (i32.const 0x7fffffff) (i32.const 32)) (i32.const 31))
+(assert_return (invoke "rem_s" (i32.const 0x7fffffff) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "rem_s" (i32.const 0x7fffffff) (i32.const 0xfff5a5a)) (i32.const 0x52d2f))
+(assert_trap (invoke "rem_s" (i32.const 0x80000000) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_s" (i32.const 0x80000000) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 0x80000000) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 0x80000000) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 0x80000000) (i32.const 3)) (i32.const -2))
+(assert_return (invoke "rem_s" (i32.const 0x80000000) (i32.const 0x7fffffff)) (i32.const -1))
+(assert_return (invoke "rem_s" (i32.const 0x80000000) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 0x80000000) (i32.const 0x80000001)) (i32.const -1))
+(assert_return (invoke "rem_s" (i32.const 0x80000000) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 0x80000000) (i32.const 0x12345678)) (i32.const 0xff6e5d48))
+(assert_return (invoke "rem_s" (i32.const 0x80000000) (i32.const 0xedcba988)) (i32.const 0xff6e5d48))
+(assert_return (invoke "rem_s" (i32.const 0x80000000) (i32.const 31)) (i32.const -2))
+(assert_return (invoke "rem_s" (i32.const 0x80000000) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 0x80000000) (i32.const 33)) (i32.const -2))
+(assert_return (invoke "rem_s" (i32.const 0x80000000) (i32.const 0xfff5a5a)) (i32.const 0xfffad2d0))
+(assert_trap (invoke "rem_s" (i32.const 0x80000001) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_s" (i32.const 0x80000001) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 0x80000001) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 0x80000001) (i32.const 2)) (i32.const -1))
+(assert_return (invoke "rem_s" (i32.const 0x80000001) (i32.const 3)) (i32.const -1))
+(assert_return (invoke "rem_s" (i32.const 0x80000001) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 0x80000001) (i32.const 0x80000000)) (i32.const 0x80000001))
+(assert_return (invoke "rem_s" (i32.const 0x80000001) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 0x80000001) (i32.const 0x40000000)) (i32.const 0xc0000001))
+(assert_return (invoke "rem_s" (i32.const 0x80000001) (i32.const 0x12345678)) (i32.const 0xff6e5d49))
+(assert_return (invoke "rem_s" (i32.const 0x80000001) (i32.const 0xedcba988)) (i32.const 0xff6e5d49))
+(assert_return (invoke "rem_s" (i32.const 0x80000001) (i32.const 31)) (i32.const -1))
+(assert_return (invoke "rem_s" (i32.const 0x80000001) (i32.const 32)) (i32.const -31))
+(assert_return (invoke "rem_s" (i32.const 0x80000001) (i32.const 33)) (i32.const -1))
+(assert_return (invoke "rem_s" (i32.const 0x80000001) (i32.const 0xfff5a5a)) (i32.const 0xfffad2d1))
+(assert_trap (invoke "rem_s" (i32.const 0x40000000) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_s" (i32.const 0x40000000) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 0x40000000) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 0x40000000) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 0x40000000) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "rem_s" (i32.const 0x40000000) (i32.const 0x7fffffff)) (i32.const 0x40000000))
+(assert_return (invoke "rem_s" (i32.const 0x40000000) (i32.const 0x80000000)) (i32.const 0x40000000))
+(assert_return (invoke "rem_s" (i32.const 0x40000000) (i32.const 0x80000001)) (i32.const 0x40000000))
+(assert_return (invoke "rem_s" (i32.const 0x40000000) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 0x40000000) (i32.const 0x12345678)) (i32.const 0x962fc98))
+(assert_return (invoke "rem_s" (i32.const 0x40000000) (i32.const 0xedcba988)) (i32.const 0x962fc98))
+(assert_return (invoke "rem_s" (i32.const 0x40000000) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "rem_s" (i32.const 0x40000000) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 0x40000000) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "rem_s" (i32.const 0x40000000) (i32.const 0xfff5a5a)) (i32.const 0x29698))
+(assert_trap (invoke "rem_s" (i32.const 0x12345678) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_s" (i32.const 0x12345678) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 0x12345678) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 0x12345678) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 0x12345678) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 0x12345678) (i32.const 0x7fffffff)) (i32.const 0x12345678))
+(assert_return (invoke "rem_s" (i32.const 0x12345678) (i32.const 0x80000000)) (i32.const 0x12345678))
+(assert_return (invoke "rem_s" (i32.const 0x12345678) (i32.const 0x80000001)) (i32.const 0x12345678))
+(assert_return (invoke "rem_s" (i32.const 0x12345678) (i32.const 0x40000000)) (i32.const 0x12345678))
+(assert_return (invoke "rem_s" (i32.const 0x12345678) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 0x12345678) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 0x12345678) (i32.const 31)) (i32.const 22))
+(assert_return (invoke "rem_s" (i32.const 0x12345678) (i32.const 32)) (i32.const 24))
+(assert_return (invoke "rem_s" (i32.const 0x12345678) (i32.const 33)) (i32.const 12))
+(assert_return (invoke "rem_s" (i32.const 0x12345678) (i32.const 0xfff5a5a)) (i32.const 0x234fc1e))
+(assert_trap (invoke "rem_s" (i32.const 0xedcba988) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_s" (i32.const 0xedcba988) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 0xedcba988) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 0xedcba988) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 0xedcba988) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 0xedcba988) (i32.const 0x7fffffff)) (i32.const 0xedcba988))
+(assert_return (invoke "rem_s" (i32.const 0xedcba988) (i32.const 0x80000000)) (i32.const 0xedcba988))
+(assert_return (invoke "rem_s" (i32.const 0xedcba988) (i32.const 0x80000001)) (i32.const 0xedcba988))
+(assert_return (invoke "rem_s" (i32.const 0xedcba988) (i32.const 0x40000000)) (i32.const 0xedcba988))
+(assert_return (invoke "rem_s" (i32.const 0xedcba988) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 0xedcba988) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 0xedcba988) (i32.const 31)) (i32.const -22))
+(assert_return (invoke "rem_s" (i32.const 0xedcba988) (i32.const 32)) (i32.const -24))
+(assert_return (invoke "rem_s" (i32.const 0xedcba988) (i32.const 33)) (i32.const -12))
+(assert_return (invoke "rem_s" (i32.const 0xedcba988) (i32.const 0xfff5a5a)) (i32.const 0xfdcb03e2))
+(assert_trap (invoke "rem_s" (i32.const 31) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_s" (i32.const 31) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 31) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 31) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "rem_s" (i32.const 31) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "rem_s" (i32.const 31) (i32.const 0x7fffffff)) (i32.const 31))
+(assert_return (invoke "rem_s" (i32.const 31) (i32.const 0x80000000)) (i32.const 31))
+(assert_return (invoke "rem_s" (i32.const 31) (i32.const 0x80000001)) (i32.const 31))
+(assert_return (invoke "rem_s" (i32.const 31) (i32.const 0x40000000)) (i32.const 31))
+(assert_return (invoke "rem_s" (i32.const 31) (i32.const 0x12345678)) (i32.const 31))
+(assert_return (invoke "rem_s" (i32.const 31) (i32.const 0xedcba988)) (i32.const 31))
+(assert_return (invoke "rem_s" (i32.const 31) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 31) (i32.const 32)) (i32.const 31))
+(assert_return (invoke "rem_s" (i32.const 31) (i32.const 33)) (i32.const 31))
+(assert_return (invoke "rem_s" (i32.const 31) (i32.const 0xfff5a5a)) (i32.const 31))
+(assert_trap (invoke "rem_s" (i32.const 32) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_s" (i32.const 32) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 32) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 32) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 32) (i32.const 3)) (i32.const 2))
+(assert_return (invoke "rem_s" (i32.const 32) (i32.const 0x7fffffff)) (i32.const 32))
+(assert_return (invoke "rem_s" (i32.const 32) (i32.const 0x80000000)) (i32.const 32))
+(assert_return (invoke "rem_s" (i32.const 32) (i32.const 0x80000001)) (i32.const 32))
+(assert_return (invoke "rem_s" (i32.const 32) (i32.const 0x40000000)) (i32.const 32))
+(assert_return (invoke "rem_s" (i32.const 32) (i32.const 0x12345678)) (i32.const 32))
+(assert_return (invoke "rem_s" (i32.const 32) (i32.const 0xedcba988)) (i32.const 32))
+(assert_return (invoke "rem_s" (i32.const 32) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "rem_s" (i32.const 32) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 32) (i32.const 33)) (i32.const 32))
+(assert_return (invoke "rem_s" (i32.const 32) (i32.const 0xfff5a5a)) (i32.const 32))
+(assert_trap (invoke "rem_s" (i32.const 33) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_s" (i32.const 33) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 33) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 33) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "rem_s" (i32.const 33) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 33) (i32.const 0x7fffffff)) (i32.const 33))
+(assert_return (invoke "rem_s" (i32.const 33) (i32.const 0x80000000)) (i32.const 33))
+(assert_return (invoke "rem_s" (i32.const 33) (i32.const 0x80000001)) (i32.const 33))
+(assert_return (invoke "rem_s" (i32.const 33) (i32.const 0x40000000)) (i32.const 33))
+(assert_return (invoke "rem_s" (i32.const 33) (i32.const 0x12345678)) (i32.const 33))
+(assert_return (invoke "rem_s" (i32.const 33) (i32.const 0xedcba988)) (i32.const 33))
+(assert_return (invoke "rem_s" (i32.const 33) (i32.const 31)) (i32.const 2))
+(assert_return (invoke "rem_s" (i32.const 33) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "rem_s" (i32.const 33) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 33) (i32.const 0xfff5a5a)) (i32.const 33))
+(assert_trap (invoke "rem_s" (i32.const 0xfff5a5a) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_s" (i32.const 0xfff5a5a) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 0xfff5a5a) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 0xfff5a5a) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 0xfff5a5a) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 0xfff5a5a) (i32.const 0x7fffffff)) (i32.const 0xfff5a5a))
+(assert_return (invoke "rem_s" (i32.const 0xfff5a5a) (i32.const 0x80000000)) (i32.const 0xfff5a5a))
+(assert_return (invoke "rem_s" (i32.const 0xfff5a5a) (i32.const 0x80000001)) (i32.const 0xfff5a5a))
+(assert_return (invoke "rem_s" (i32.const 0xfff5a5a) (i32.const 0x40000000)) (i32.const 0xfff5a5a))
+(assert_return (invoke "rem_s" (i32.const 0xfff5a5a) (i32.const 0x12345678)) (i32.const 0xfff5a5a))
+(assert_return (invoke "rem_s" (i32.const 0xfff5a5a) (i32.const 0xedcba988)) (i32.const 0xfff5a5a))
+(assert_return (invoke "rem_s" (i32.const 0xfff5a5a) (i32.const 31)) (i32.const 10))
+(assert_return (invoke "rem_s" (i32.const 0xfff5a5a) (i32.const 32)) (i32.const 26))
+(assert_return (invoke "rem_s" (i32.const 0xfff5a5a) (i32.const 33)) (i32.const 24))
+(assert_return (invoke "rem_s" (i32.const 0xfff5a5a) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "rem_s" (i32.const 0x3f6caa39) (i32.const 0xf7bf440d)) (i32.const 0x5a78694))
+(assert_return (invoke "rem_s" (i32.const 0x454586) (i32.const 0x7fcbd0d9)) (i32.const 0x454586))
+(assert_return (invoke "rem_s" (i32.const 0x702ceb9) (i32.const 0x1b9243f8)) (i32.const 0x702ceb9))
+(assert_return (invoke "rem_s" (i32.const 0xf39439fa) (i32.const 0x4b5851)) (i32.const 0xfff0b744))
+(assert_return (invoke "rem_s" (i32.const 0x97ce2a35) (i32.const 0xdacffb26)) (i32.const 0xe22e33e9))
+(assert_return (invoke "rem_s" (i32.const 0xf80232cd) (i32.const 0x2ab86fed)) (i32.const 0xf80232cd))
+(assert_return (invoke "rem_s" (i32.const 0xc7d35a28) (i32.const 0xdac29d82)) (i32.const 0xed10bca6))
+(assert_return (invoke "rem_s" (i32.const 0x50d91c58) (i32.const 0xc716469d)) (i32.const 0x17ef62f5))
+(assert_return (invoke "rem_s" (i32.const 0xf79850f9) (i32.const 0x6ab495ba)) (i32.const 0xf79850f9))
+(assert_return (invoke "rem_s" (i32.const 0xec2cf9b8) (i32.const 0x39f5af65)) (i32.const 0xec2cf9b8))
+(assert_return (invoke "rem_s" (i32.const 0x74d19986) (i32.const 0x6691329b)) (i32.const 0xe4066eb))
+(assert_return (invoke "rem_s" (i32.const 0x1550a9d6) (i32.const 0xfa6777a2)) (i32.const 0x48710bc))
+(assert_return (invoke "rem_s" (i32.const 0x5184fc94) (i32.const 0x6f1d867d)) (i32.const 0x5184fc94))
+(assert_return (invoke "rem_s" (i32.const 0x2a928dfc) (i32.const 0xf0b06f2c)) (i32.const 0xbf36c54))
+(assert_return (invoke "rem_s" (i32.const 0x5d96e442) (i32.const 0x4d8a3083)) (i32.const 0x100cb3bf))
+(assert_return (invoke "rem_s" (i32.const 0x15200cf6) (i32.const 0xeabf5ece)) (i32.const 0x15200cf6))
+
+(assert_trap (invoke "rem_u" (i32.const 0) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_u" (i32.const 0) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const 0) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const 0) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const 0) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const 0) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const 0) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const 0) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const 0) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const 0) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const 0) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const 0) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const 0) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const 0) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const 0) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_trap (invoke "rem_u" (i32.const 1) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_u" (i32.const 1) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const 1) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "rem_u" (i32.const 1) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "rem_u" (i32.const 1) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "rem_u" (i32.const 1) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "rem_u" (i32.const 1) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "rem_u" (i32.const 1) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "rem_u" (i32.const 1) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "rem_u" (i32.const 1) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "rem_u" (i32.const 1) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "rem_u" (i32.const 1) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "rem_u" (i32.const 1) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "rem_u" (i32.const 1) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "rem_u" (i32.const 1) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_trap (invoke "rem_u" (i32.const -1) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_u" (i32.const -1) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const -1) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const -1) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "rem_u" (i32.const -1) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const -1) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "rem_u" (i32.const -1) (i32.const 0x80000000)) (i32.const 0x7fffffff))
+(assert_return (invoke "rem_u" (i32.const -1) (i32.const 0x80000001)) (i32.const 0x7ffffffe))
+(assert_return (invoke "rem_u" (i32.const -1) (i32.const 0x40000000)) (i32.const 0x3fffffff))
+(assert_return (invoke "rem_u" (i32.const -1) (i32.const 0x12345678)) (i32.const 0x123456f))
+(assert_return (invoke "rem_u" (i32.const -1) (i32.const 0xedcba988)) (i32.const 0x12345677))
+(assert_return (invoke "rem_u" (i32.const -1) (i32.const 31)) (i32.const 3))
+(assert_return (invoke "rem_u" (i32.const -1) (i32.const 32)) (i32.const 31))
+(assert_return (invoke "rem_u" (i32.const -1) (i32.const 33)) (i32.const 3))
+(assert_return (invoke "rem_u" (i32.const -1) (i32.const 0xfff5a5a)) (i32.const 0xa5a5f))
+(assert_trap (invoke "rem_u" (i32.const 2) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_u" (i32.const 2) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const 2) (i32.const -1)) (i32.const 2))
+(assert_return (invoke "rem_u" (i32.const 2) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const 2) (i32.const 3)) (i32.const 2))
+(assert_return (invoke "rem_u" (i32.const 2) (i32.const 0x7fffffff)) (i32.const 2))
+(assert_return (invoke "rem_u" (i32.const 2) (i32.const 0x80000000)) (i32.const 2))
+(assert_return (invoke "rem_u" (i32.const 2) (i32.const 0x80000001)) (i32.const 2))
+(assert_return (invoke "rem_u" (i32.const 2) (i32.const 0x40000000)) (i32.const 2))
+(assert_return (invoke "rem_u" (i32.const 2) (i32.const 0x12345678)) (i32.const 2))
+(assert_return (invoke "rem_u" (i32.const 2) (i32.const 0xedcba988)) (i32.const 2))
+(assert_return (invoke "rem_u" (i32.const 2) (i32.const 31)) (i32.const 2))
+(assert_return (invoke "rem_u" (i32.const 2) (i32.const 32)) (i32.const 2))
+(assert_return (invoke "rem_u" (i32.const 2) (i32.const 33)) (i32.const 2))
+(assert_return (invoke "rem_u" (i32.const 2) (i32.const 0xfff5a5a)) (i32.const 2))
+(assert_trap (invoke "rem_u" (i32.const 3) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_u" (i32.const 3) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const 3) (i32.const -1)) (i32.const 3))
+(assert_return (invoke "rem_u" (i32.const 3) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "rem_u" (i32.const 3) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const 3) (i32.const 0x7fffffff)) (i32.const 3))
+(assert_return (invoke "rem_u" (i32.const 3) (i32.const 0x80000000)) (i32.const 3))
+(assert_return (invoke "rem_u" (i32.const 3) (i32.const 0x80000001)) (i32.const 3))
+(assert_return (invoke "rem_u" (i32.const 3) (i32.const 0x40000000)) (i32.const 3))
+(assert_return (invoke "rem_u" (i32.const 3) (i32.const 0x12345678)) (i32.const 3))
+(assert_return (invoke "rem_u" (i32.const 3) (i32.const 0xedcba988)) (i32.const 3))
+(assert_return (invoke "rem_u" (i32.const 3) (i32.const 31)) (i32.const 3))
+(assert_return (invoke "rem_u" (i32.const 3) (i32.const 32)) (i32.const 3))
+(assert_return (invoke "rem_u" (i32.const 3) (i32.const 33)) (i32.const 3))
+(assert_return (invoke "rem_u" (i32.const 3) (i32.const 0xfff5a5a)) (i32.const 3))
+(assert_trap (invoke "rem_u" (i32.const 0x7fffffff) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_u" (i32.const 0x7fffffff) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const 0x7fffffff) (i32.const -1)) (i32.const 0x7fffffff))
+(assert_return (invoke "rem_u" (i32.const 0x7fffffff) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "rem_u" (i32.const 0x7fffffff) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "rem_u" (i32.const 0x7fffffff) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const 0x7fffffff) (i32.const 0x80000000)) (i32.const 0x7fffffff))
+(assert_return (invoke "rem_u" (i32.const 0x7fffffff) (i32.const 0x80000001)) (i32.const 0x7fffffff))
+(assert_return (invoke "rem_u" (i32.const 0x7fffffff) (i32.const 0x40000000)) (i32.const 0x3fffffff))
+(assert_return (invoke "rem_u" (i32.const 0x7fffffff) (i32.const 0x12345678)) (i32.const 0x91a2b7))
+(assert_return (invoke "rem_u" (i32.const 0x7fffffff) (i32.const 0xedcba988)) (i32.const 0x7fffffff))
+(assert_return (invoke "rem_u" (i32.const 0x7fffffff) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "rem_u" (i32.const 0x7fffffff) (i32.const 32)) (i32.const 31))
+(assert_return (invoke "rem_u" (i32.const 0x7fffffff) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "rem_u" (i32.const 0x7fffffff) (i32.const 0xfff5a5a)) (i32.const 0x52d2f))
+(assert_trap (invoke "rem_u" (i32.const 0x80000000) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_u" (i32.const 0x80000000) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const 0x80000000) (i32.const -1)) (i32.const 0x80000000))
+(assert_return (invoke "rem_u" (i32.const 0x80000000) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const 0x80000000) (i32.const 3)) (i32.const 2))
+(assert_return (invoke "rem_u" (i32.const 0x80000000) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "rem_u" (i32.const 0x80000000) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const 0x80000000) (i32.const 0x80000001)) (i32.const 0x80000000))
+(assert_return (invoke "rem_u" (i32.const 0x80000000) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const 0x80000000) (i32.const 0x12345678)) (i32.const 0x91a2b8))
+(assert_return (invoke "rem_u" (i32.const 0x80000000) (i32.const 0xedcba988)) (i32.const 0x80000000))
+(assert_return (invoke "rem_u" (i32.const 0x80000000) (i32.const 31)) (i32.const 2))
+(assert_return (invoke "rem_u" (i32.const 0x80000000) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const 0x80000000) (i32.const 33)) (i32.const 2))
+(assert_return (invoke "rem_u" (i32.const 0x80000000) (i32.const 0xfff5a5a)) (i32.const 0x52d30))
+(assert_trap (invoke "rem_u" (i32.const 0x80000001) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_u" (i32.const 0x80000001) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const 0x80000001) (i32.const -1)) (i32.const 0x80000001))
+(assert_return (invoke "rem_u" (i32.const 0x80000001) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "rem_u" (i32.const 0x80000001) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const 0x80000001) (i32.const 0x7fffffff)) (i32.const 2))
+(assert_return (invoke "rem_u" (i32.const 0x80000001) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "rem_u" (i32.const 0x80000001) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const 0x80000001) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "rem_u" (i32.const 0x80000001) (i32.const 0x12345678)) (i32.const 0x91a2b9))
+(assert_return (invoke "rem_u" (i32.const 0x80000001) (i32.const 0xedcba988)) (i32.const 0x80000001))
+(assert_return (invoke "rem_u" (i32.const 0x80000001) (i32.const 31)) (i32.const 3))
+(assert_return (invoke "rem_u" (i32.const 0x80000001) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "rem_u" (i32.const 0x80000001) (i32.const 33)) (i32.const 3))
+(assert_return (invoke "rem_u" (i32.const 0x80000001) (i32.const 0xfff5a5a)) (i32.const 0x52d31))
+(assert_trap (invoke "rem_u" (i32.const 0x40000000) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_u" (i32.const 0x40000000) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const 0x40000000) (i32.const -1)) (i32.const 0x40000000))
+(assert_return (invoke "rem_u" (i32.const 0x40000000) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const 0x40000000) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "rem_u" (i32.const 0x40000000) (i32.const 0x7fffffff)) (i32.const 0x40000000))
+(assert_return (invoke "rem_u" (i32.const 0x40000000) (i32.const 0x80000000)) (i32.const 0x40000000))
+(assert_return (invoke "rem_u" (i32.const 0x40000000) (i32.const 0x80000001)) (i32.const 0x40000000))
+(assert_return (invoke "rem_u" (i32.const 0x40000000) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const 0x40000000) (i32.const 0x12345678)) (i32.const 0x962fc98))
+(assert_return (invoke "rem_u" (i32.const 0x40000000) (i32.const 0xedcba988)) (i32.const 0x40000000))
+(assert_return (invoke "rem_u" (i32.const 0x40000000) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "rem_u" (i32.const 0x40000000) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const 0x40000000) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "rem_u" (i32.const 0x40000000) (i32.const 0xfff5a5a)) (i32.const 0x29698))
+(assert_trap (invoke "rem_u" (i32.const 0x12345678) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_u" (i32.const 0x12345678) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const 0x12345678) (i32.const -1)) (i32.const 0x12345678))
+(assert_return (invoke "rem_u" (i32.const 0x12345678) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const 0x12345678) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const 0x12345678) (i32.const 0x7fffffff)) (i32.const 0x12345678))
+(assert_return (invoke "rem_u" (i32.const 0x12345678) (i32.const 0x80000000)) (i32.const 0x12345678))
+(assert_return (invoke "rem_u" (i32.const 0x12345678) (i32.const 0x80000001)) (i32.const 0x12345678))
+(assert_return (invoke "rem_u" (i32.const 0x12345678) (i32.const 0x40000000)) (i32.const 0x12345678))
+(assert_return (invoke "rem_u" (i32.const 0x12345678) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const 0x12345678) (i32.const 0xedcba988)) (i32.const 0x12345678))
+(assert_return (invoke "rem_u" (i32.const 0x12345678) (i32.const 31)) (i32.const 22))
+(assert_return (invoke "rem_u" (i32.const 0x12345678) (i32.const 32)) (i32.const 24))
+(assert_return (invoke "rem_u" (i32.const 0x12345678) (i32.const 33)) (i32.const 12))
+(assert_return (invoke "rem_u" (i32.const 0x12345678) (i32.const 0xfff5a5a)) (i32.const 0x234fc1e))
+(assert_trap (invoke "rem_u" (i32.const 0xedcba988) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_u" (i32.const 0xedcba988) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const 0xedcba988) (i32.const -1)) (i32.const 0xedcba988))
+(assert_return (invoke "rem_u" (i32.const 0xedcba988) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const 0xedcba988) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "rem_u" (i32.const 0xedcba988) (i32.const 0x7fffffff)) (i32.const 0x6dcba989))
+(assert_return (invoke "rem_u" (i32.const 0xedcba988) (i32.const 0x80000000)) (i32.const 0x6dcba988))
+(assert_return (invoke "rem_u" (i32.const 0xedcba988) (i32.const 0x80000001)) (i32.const 0x6dcba987))
+(assert_return (invoke "rem_u" (i32.const 0xedcba988) (i32.const 0x40000000)) (i32.const 0x2dcba988))
+(assert_return (invoke "rem_u" (i32.const 0xedcba988) (i32.const 0x12345678)) (i32.const 0x1234570))
+(assert_return (invoke "rem_u" (i32.const 0xedcba988) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const 0xedcba988) (i32.const 31)) (i32.const 13))
+(assert_return (invoke "rem_u" (i32.const 0xedcba988) (i32.const 32)) (i32.const 8))
+(assert_return (invoke "rem_u" (i32.const 0xedcba988) (i32.const 33)) (i32.const 25))
+(assert_return (invoke "rem_u" (i32.const 0xedcba988) (i32.const 0xfff5a5a)) (i32.const 0xdd4b89c))
+(assert_trap (invoke "rem_u" (i32.const 31) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_u" (i32.const 31) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const 31) (i32.const -1)) (i32.const 31))
+(assert_return (invoke "rem_u" (i32.const 31) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "rem_u" (i32.const 31) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "rem_u" (i32.const 31) (i32.const 0x7fffffff)) (i32.const 31))
+(assert_return (invoke "rem_u" (i32.const 31) (i32.const 0x80000000)) (i32.const 31))
+(assert_return (invoke "rem_u" (i32.const 31) (i32.const 0x80000001)) (i32.const 31))
+(assert_return (invoke "rem_u" (i32.const 31) (i32.const 0x40000000)) (i32.const 31))
+(assert_return (invoke "rem_u" (i32.const 31) (i32.const 0x12345678)) (i32.const 31))
+(assert_return (invoke "rem_u" (i32.const 31) (i32.const 0xedcba988)) (i32.const 31))
+(assert_return (invoke "rem_u" (i32.const 31) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const 31) (i32.const 32)) (i32.const 31))
+(assert_return (invoke "rem_u" (i32.const 31) (i32.const 33)) (i32.const 31))
+(assert_return (invoke "rem_u" (i32.const 31) (i32.const 0xfff5a5a)) (i32.const 31))
+(assert_trap (invoke "rem_u" (i32.const 32) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_u" (i32.const 32) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const 32) (i32.const -1)) (i32.const 32))
+(assert_return (invoke "rem_u" (i32.const 32) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const 32) (i32.const 3)) (i32.const 2))
+(assert_return (invoke "rem_u" (i32.const 32) (i32.const 0x7fffffff)) (i32.const 32))
+(assert_return (invoke "rem_u" (i32.const 32) (i32.const 0x80000000)) (i32.const 32))
+(assert_return (invoke "rem_u" (i32.const 32) (i32.const 0x80000001)) (i32.const 32))
+(assert_return (invoke "rem_u" (i32.const 32) (i32.const 0x40000000)) (i32.const 32))
+(assert_return (invoke "rem_u" (i32.const 32) (i32.const 0x12345678)) (i32.const 32))
+(assert_return (invoke "rem_u" (i32.const 32) (i32.const 0xedcba988)) (i32.const 32))
+(assert_return (invoke "rem_u" (i32.const 32) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "rem_u" (i32.const 32) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const 32) (i32.const 33)) (i32.const 32))
+(assert_return (invoke "rem_u" (i32.const 32) (i32.const 0xfff5a5a)) (i32.const 32))
+(assert_trap (invoke "rem_u" (i32.const 33) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_u" (i32.const 33) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const 33) (i32.const -1)) (i32.const 33))
+(assert_return (invoke "rem_u" (i32.const 33) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "rem_u" (i32.const 33) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const 33) (i32.const 0x7fffffff)) (i32.const 33))
+(assert_return (invoke "rem_u" (i32.const 33) (i32.const 0x80000000)) (i32.const 33))
+(assert_return (invoke "rem_u" (i32.const 33) (i32.const 0x80000001)) (i32.const 33))
+(assert_return (invoke "rem_u" (i32.const 33) (i32.const 0x40000000)) (i32.const 33))
+(assert_return (invoke "rem_u" (i32.const 33) (i32.const 0x12345678)) (i32.const 33))
+(assert_return (invoke "rem_u" (i32.const 33) (i32.const 0xedcba988)) (i32.const 33))
+(assert_return (invoke "rem_u" (i32.const 33) (i32.const 31)) (i32.const 2))
+(assert_return (invoke "rem_u" (i32.const 33) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "rem_u" (i32.const 33) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const 33) (i32.const 0xfff5a5a)) (i32.const 33))
+(assert_trap (invoke "rem_u" (i32.const 0xfff5a5a) (i32.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_u" (i32.const 0xfff5a5a) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const 0xfff5a5a) (i32.const -1)) (i32.const 0xfff5a5a))
+(assert_return (invoke "rem_u" (i32.const 0xfff5a5a) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const 0xfff5a5a) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const 0xfff5a5a) (i32.const 0x7fffffff)) (i32.const 0xfff5a5a))
+(assert_return (invoke "rem_u" (i32.const 0xfff5a5a) (i32.const 0x80000000)) (i32.const 0xfff5a5a))
+(assert_return (invoke "rem_u" (i32.const 0xfff5a5a) (i32.const 0x80000001)) (i32.const 0xfff5a5a))
+(assert_return (invoke "rem_u" (i32.const 0xfff5a5a) (i32.const 0x40000000)) (i32.const 0xfff5a5a))
+(assert_return (invoke "rem_u" (i32.const 0xfff5a5a) (i32.const 0x12345678)) (i32.const 0xfff5a5a))
+(assert_return (invoke "rem_u" (i32.const 0xfff5a5a) (i32.const 0xedcba988)) (i32.const 0xfff5a5a))
+(assert_return (invoke "rem_u" (i32.const 0xfff5a5a) (i32.const 31)) (i32.const 10))
+(assert_return (invoke "rem_u" (i32.const 0xfff5a5a) (i32.const 32)) (i32.const 26))
+(assert_return (invoke "rem_u" (i32.const 0xfff5a5a) (i32.const 33)) (i32.const 24))
+(assert_return (invoke "rem_u" (i32.const 0xfff5a5a) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "rem_u" (i32.const 0xb6972131) (i32.const 0x80929090)) (i32.const 0x360490a1))
+(assert_return (invoke "rem_u" (i32.const 0xfac43f13) (i32.const 0xc7f9df6c)) (i32.const 0x32ca5fa7))
+(assert_return (invoke "rem_u" (i32.const 0x35f470d7) (i32.const 0x2139b536)) (i32.const 0x14babba1))
+(assert_return (invoke "rem_u" (i32.const 0xc7b05ad4) (i32.const 0xb1186afa)) (i32.const 0x1697efda))
+(assert_return (invoke "rem_u" (i32.const 0x959c682a) (i32.const 0x4316727a)) (i32.const 0xf6f8336))
+(assert_return (invoke "rem_u" (i32.const 0x7b8eaae2) (i32.const 0xae2ed39b)) (i32.const 0x7b8eaae2))
+(assert_return (invoke "rem_u" (i32.const 0xe844ffb4) (i32.const 0x5dd21569)) (i32.const 0x2ca0d4e2))
+(assert_return (invoke "rem_u" (i32.const 0x6097b02b) (i32.const 0x17d414a1)) (i32.const 0x1475da7))
+(assert_return (invoke "rem_u" (i32.const 0xd23235a5) (i32.const 0xc451470c)) (i32.const 0xde0ee99))
+(assert_return (invoke "rem_u" (i32.const 0x8e5f0de) (i32.const 0x9b8a7a8e)) (i32.const 0x8e5f0de))
+(assert_return (invoke "rem_u" (i32.const 0xf7216b9d) (i32.const 0xdf216086)) (i32.const 0x18000b17))
+(assert_return (invoke "rem_u" (i32.const 0x2ebf0d69) (i32.const 0xe23dff30)) (i32.const 0x2ebf0d69))
+(assert_return (invoke "rem_u" (i32.const 0xf513e3) (i32.const 0xc5b3c35)) (i32.const 0xf513e3))
+(assert_return (invoke "rem_u" (i32.const 0xe19e5a59) (i32.const 0x9913a789)) (i32.const 0x488ab2d0))
+(assert_return (invoke "rem_u" (i32.const 0xb8af886f) (i32.const 0x32bc4385)) (i32.const 0x207abde0))
+(assert_return (invoke "rem_u" (i32.const 0xacc0407e) (i32.const 0x87a83bf8)) (i32.const 0x25180486))
+
+(assert_return (invoke "and" (i32.const 0) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 1) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 1) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "and" (i32.const 1) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "and" (i32.const 1) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 1) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "and" (i32.const 1) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "and" (i32.const 1) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 1) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "and" (i32.const 1) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 1) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 1) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 1) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "and" (i32.const 1) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 1) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "and" (i32.const 1) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "and" (i32.const -1) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "and" (i32.const -1) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "and" (i32.const -1) (i32.const -1)) (i32.const -1))
+(assert_return (invoke "and" (i32.const -1) (i32.const 2)) (i32.const 2))
+(assert_return (invoke "and" (i32.const -1) (i32.const 3)) (i32.const 3))
+(assert_return (invoke "and" (i32.const -1) (i32.const 0x7fffffff)) (i32.const 0x7fffffff))
+(assert_return (invoke "and" (i32.const -1) (i32.const 0x80000000)) (i32.const 0x80000000))
+(assert_return (invoke "and" (i32.const -1) (i32.const 0x80000001)) (i32.const 0x80000001))
+(assert_return (invoke "and" (i32.const -1) (i32.const 0x40000000)) (i32.const 0x40000000))
+(assert_return (invoke "and" (i32.const -1) (i32.const 0x12345678)) (i32.const 0x12345678))
+(assert_return (invoke "and" (i32.const -1) (i32.const 0xedcba988)) (i32.const 0xedcba988))
+(assert_return (invoke "and" (i32.const -1) (i32.const 31)) (i32.const 31))
+(assert_return (invoke "and" (i32.const -1) (i32.const 32)) (i32.const 32))
+(assert_return (invoke "and" (i32.const -1) (i32.const 33)) (i32.const 33))
+(assert_return (invoke "and" (i32.const -1) (i32.const 0xfff5a5a)) (i32.const 0xfff5a5a))
+(assert_return (invoke "and" (i32.const 2) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 2) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 2) (i32.const -1)) (i32.const 2))
+(assert_return (invoke "and" (i32.const 2) (i32.const 2)) (i32.const 2))
+(assert_return (invoke "and" (i32.const 2) (i32.const 3)) (i32.const 2))
+(assert_return (invoke "and" (i32.const 2) (i32.const 0x7fffffff)) (i32.const 2))
+(assert_return (invoke "and" (i32.const 2) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 2) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 2) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 2) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 2) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 2) (i32.const 31)) (i32.const 2))
+(assert_return (invoke "and" (i32.const 2) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 2) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 2) (i32.const 0xfff5a5a)) (i32.const 2))
+(assert_return (invoke "and" (i32.const 3) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 3) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "and" (i32.const 3) (i32.const -1)) (i32.const 3))
+(assert_return (invoke "and" (i32.const 3) (i32.const 2)) (i32.const 2))
+(assert_return (invoke "and" (i32.const 3) (i32.const 3)) (i32.const 3))
+(assert_return (invoke "and" (i32.const 3) (i32.const 0x7fffffff)) (i32.const 3))
+(assert_return (invoke "and" (i32.const 3) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 3) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "and" (i32.const 3) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 3) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 3) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 3) (i32.const 31)) (i32.const 3))
+(assert_return (invoke "and" (i32.const 3) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 3) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "and" (i32.const 3) (i32.const 0xfff5a5a)) (i32.const 2))
+(assert_return (invoke "and" (i32.const 0x7fffffff) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0x7fffffff) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "and" (i32.const 0x7fffffff) (i32.const -1)) (i32.const 0x7fffffff))
+(assert_return (invoke "and" (i32.const 0x7fffffff) (i32.const 2)) (i32.const 2))
+(assert_return (invoke "and" (i32.const 0x7fffffff) (i32.const 3)) (i32.const 3))
+(assert_return (invoke "and" (i32.const 0x7fffffff) (i32.const 0x7fffffff)) (i32.const 0x7fffffff))
+(assert_return (invoke "and" (i32.const 0x7fffffff) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0x7fffffff) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "and" (i32.const 0x7fffffff) (i32.const 0x40000000)) (i32.const 0x40000000))
+(assert_return (invoke "and" (i32.const 0x7fffffff) (i32.const 0x12345678)) (i32.const 0x12345678))
+(assert_return (invoke "and" (i32.const 0x7fffffff) (i32.const 0xedcba988)) (i32.const 0x6dcba988))
+(assert_return (invoke "and" (i32.const 0x7fffffff) (i32.const 31)) (i32.const 31))
+(assert_return (invoke "and" (i32.const 0x7fffffff) (i32.const 32)) (i32.const 32))
+(assert_return (invoke "and" (i32.const 0x7fffffff) (i32.const 33)) (i32.const 33))
+(assert_return (invoke "and" (i32.const 0x7fffffff) (i32.const 0xfff5a5a)) (i32.const 0xfff5a5a))
+(assert_return (invoke "and" (i32.const 0x80000000) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0x80000000) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0x80000000) (i32.const -1)) (i32.const 0x80000000))
+(assert_return (invoke "and" (i32.const 0x80000000) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0x80000000) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0x80000000) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0x80000000) (i32.const 0x80000000)) (i32.const 0x80000000))
+(assert_return (invoke "and" (i32.const 0x80000000) (i32.const 0x80000001)) (i32.const 0x80000000))
+(assert_return (invoke "and" (i32.const 0x80000000) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0x80000000) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0x80000000) (i32.const 0xedcba988)) (i32.const 0x80000000))
+(assert_return (invoke "and" (i32.const 0x80000000) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0x80000000) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0x80000000) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0x80000000) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0x80000001) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0x80000001) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "and" (i32.const 0x80000001) (i32.const -1)) (i32.const 0x80000001))
+(assert_return (invoke "and" (i32.const 0x80000001) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0x80000001) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "and" (i32.const 0x80000001) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "and" (i32.const 0x80000001) (i32.const 0x80000000)) (i32.const 0x80000000))
+(assert_return (invoke "and" (i32.const 0x80000001) (i32.const 0x80000001)) (i32.const 0x80000001))
+(assert_return (invoke "and" (i32.const 0x80000001) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0x80000001) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0x80000001) (i32.const 0xedcba988)) (i32.const 0x80000000))
+(assert_return (invoke "and" (i32.const 0x80000001) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "and" (i32.const 0x80000001) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0x80000001) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "and" (i32.const 0x80000001) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0x40000000) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0x40000000) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0x40000000) (i32.const -1)) (i32.const 0x40000000))
+(assert_return (invoke "and" (i32.const 0x40000000) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0x40000000) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0x40000000) (i32.const 0x7fffffff)) (i32.const 0x40000000))
+(assert_return (invoke "and" (i32.const 0x40000000) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0x40000000) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0x40000000) (i32.const 0x40000000)) (i32.const 0x40000000))
+(assert_return (invoke "and" (i32.const 0x40000000) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0x40000000) (i32.const 0xedcba988)) (i32.const 0x40000000))
+(assert_return (invoke "and" (i32.const 0x40000000) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0x40000000) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0x40000000) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0x40000000) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0x12345678) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0x12345678) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0x12345678) (i32.const -1)) (i32.const 0x12345678))
+(assert_return (invoke "and" (i32.const 0x12345678) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0x12345678) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0x12345678) (i32.const 0x7fffffff)) (i32.const 0x12345678))
+(assert_return (invoke "and" (i32.const 0x12345678) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0x12345678) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0x12345678) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0x12345678) (i32.const 0x12345678)) (i32.const 0x12345678))
+(assert_return (invoke "and" (i32.const 0x12345678) (i32.const 0xedcba988)) (i32.const 8))
+(assert_return (invoke "and" (i32.const 0x12345678) (i32.const 31)) (i32.const 24))
+(assert_return (invoke "and" (i32.const 0x12345678) (i32.const 32)) (i32.const 32))
+(assert_return (invoke "and" (i32.const 0x12345678) (i32.const 33)) (i32.const 32))
+(assert_return (invoke "and" (i32.const 0x12345678) (i32.const 0xfff5a5a)) (i32.const 0x2345258))
+(assert_return (invoke "and" (i32.const 0xedcba988) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0xedcba988) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0xedcba988) (i32.const -1)) (i32.const 0xedcba988))
+(assert_return (invoke "and" (i32.const 0xedcba988) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0xedcba988) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0xedcba988) (i32.const 0x7fffffff)) (i32.const 0x6dcba988))
+(assert_return (invoke "and" (i32.const 0xedcba988) (i32.const 0x80000000)) (i32.const 0x80000000))
+(assert_return (invoke "and" (i32.const 0xedcba988) (i32.const 0x80000001)) (i32.const 0x80000000))
+(assert_return (invoke "and" (i32.const 0xedcba988) (i32.const 0x40000000)) (i32.const 0x40000000))
+(assert_return (invoke "and" (i32.const 0xedcba988) (i32.const 0x12345678)) (i32.const 8))
+(assert_return (invoke "and" (i32.const 0xedcba988) (i32.const 0xedcba988)) (i32.const 0xedcba988))
+(assert_return (invoke "and" (i32.const 0xedcba988) (i32.const 31)) (i32.const 8))
+(assert_return (invoke "and" (i32.const 0xedcba988) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0xedcba988) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0xedcba988) (i32.const 0xfff5a5a)) (i32.const 0xdcb0808))
+(assert_return (invoke "and" (i32.const 31) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 31) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "and" (i32.const 31) (i32.const -1)) (i32.const 31))
+(assert_return (invoke "and" (i32.const 31) (i32.const 2)) (i32.const 2))
+(assert_return (invoke "and" (i32.const 31) (i32.const 3)) (i32.const 3))
+(assert_return (invoke "and" (i32.const 31) (i32.const 0x7fffffff)) (i32.const 31))
+(assert_return (invoke "and" (i32.const 31) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 31) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "and" (i32.const 31) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 31) (i32.const 0x12345678)) (i32.const 24))
+(assert_return (invoke "and" (i32.const 31) (i32.const 0xedcba988)) (i32.const 8))
+(assert_return (invoke "and" (i32.const 31) (i32.const 31)) (i32.const 31))
+(assert_return (invoke "and" (i32.const 31) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 31) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "and" (i32.const 31) (i32.const 0xfff5a5a)) (i32.const 26))
+(assert_return (invoke "and" (i32.const 32) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 32) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 32) (i32.const -1)) (i32.const 32))
+(assert_return (invoke "and" (i32.const 32) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 32) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 32) (i32.const 0x7fffffff)) (i32.const 32))
+(assert_return (invoke "and" (i32.const 32) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 32) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 32) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 32) (i32.const 0x12345678)) (i32.const 32))
+(assert_return (invoke "and" (i32.const 32) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 32) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 32) (i32.const 32)) (i32.const 32))
+(assert_return (invoke "and" (i32.const 32) (i32.const 33)) (i32.const 32))
+(assert_return (invoke "and" (i32.const 32) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 33) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 33) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "and" (i32.const 33) (i32.const -1)) (i32.const 33))
+(assert_return (invoke "and" (i32.const 33) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 33) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "and" (i32.const 33) (i32.const 0x7fffffff)) (i32.const 33))
+(assert_return (invoke "and" (i32.const 33) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 33) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "and" (i32.const 33) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 33) (i32.const 0x12345678)) (i32.const 32))
+(assert_return (invoke "and" (i32.const 33) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 33) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "and" (i32.const 33) (i32.const 32)) (i32.const 32))
+(assert_return (invoke "and" (i32.const 33) (i32.const 33)) (i32.const 33))
+(assert_return (invoke "and" (i32.const 33) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0xfff5a5a) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0xfff5a5a) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0xfff5a5a) (i32.const -1)) (i32.const 0xfff5a5a))
+(assert_return (invoke "and" (i32.const 0xfff5a5a) (i32.const 2)) (i32.const 2))
+(assert_return (invoke "and" (i32.const 0xfff5a5a) (i32.const 3)) (i32.const 2))
+(assert_return (invoke "and" (i32.const 0xfff5a5a) (i32.const 0x7fffffff)) (i32.const 0xfff5a5a))
+(assert_return (invoke "and" (i32.const 0xfff5a5a) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0xfff5a5a) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0xfff5a5a) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0xfff5a5a) (i32.const 0x12345678)) (i32.const 0x2345258))
+(assert_return (invoke "and" (i32.const 0xfff5a5a) (i32.const 0xedcba988)) (i32.const 0xdcb0808))
+(assert_return (invoke "and" (i32.const 0xfff5a5a) (i32.const 31)) (i32.const 26))
+(assert_return (invoke "and" (i32.const 0xfff5a5a) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0xfff5a5a) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "and" (i32.const 0xfff5a5a) (i32.const 0xfff5a5a)) (i32.const 0xfff5a5a))
+(assert_return (invoke "and" (i32.const 0xc415aaa4) (i32.const 0x5260e894)) (i32.const 0x4000a884))
+(assert_return (invoke "and" (i32.const 0x609acb5c) (i32.const 0x387b59d0)) (i32.const 0x201a4950))
+(assert_return (invoke "and" (i32.const 0x1d4a523f) (i32.const 0x6677a203)) (i32.const 0x4420203))
+(assert_return (invoke "and" (i32.const 0x517529a6) (i32.const 0x30c5ff42)) (i32.const 0x10452902))
+(assert_return (invoke "and" (i32.const 0xf422fdb1) (i32.const 0xa7b266c6)) (i32.const 0xa4226480))
+(assert_return (invoke "and" (i32.const 0xfb40d288) (i32.const 0x27f0781a)) (i32.const 0x23405008))
+(assert_return (invoke "and" (i32.const 0x3ae2abee) (i32.const 0x9ea42067)) (i32.const 0x1aa02066))
+(assert_return (invoke "and" (i32.const 0x71357700) (i32.const 0xed706c0)) (i32.const 0x150600))
+(assert_return (invoke "and" (i32.const 0x735b39b2) (i32.const 0xae3e9f56)) (i32.const 0x221a1912))
+(assert_return (invoke "and" (i32.const 0xe77acdca) (i32.const 0x6a8e770)) (i32.const 0x628c540))
+(assert_return (invoke "and" (i32.const 0x16a362b) (i32.const 0x76ee4254)) (i32.const 0x6a0200))
+(assert_return (invoke "and" (i32.const 0xd4476f9e) (i32.const 0xd89c682e)) (i32.const 0xd004680e))
+(assert_return (invoke "and" (i32.const 0x40e1c6d3) (i32.const 0x41d9c53)) (i32.const 0x18453))
+(assert_return (invoke "and" (i32.const 0x8c944ff9) (i32.const 0x11848cfa)) (i32.const 0x840cf8))
+(assert_return (invoke "and" (i32.const 0x210ca15b) (i32.const 0x590ba9e4)) (i32.const 0x108a140))
+(assert_return (invoke "and" (i32.const 0x15861188) (i32.const 0xba223fbc)) (i32.const 0x10021188))
+
+(assert_return (invoke "or" (i32.const 0) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "or" (i32.const 0) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "or" (i32.const 0) (i32.const -1)) (i32.const -1))
+(assert_return (invoke "or" (i32.const 0) (i32.const 2)) (i32.const 2))
+(assert_return (invoke "or" (i32.const 0) (i32.const 3)) (i32.const 3))
+(assert_return (invoke "or" (i32.const 0) (i32.const 0x7fffffff)) (i32.const 0x7fffffff))
+(assert_return (invoke "or" (i32.const 0) (i32.const 0x80000000)) (i32.const 0x80000000))
+(assert_return (invoke "or" (i32.const 0) (i32.const 0x80000001)) (i32.const 0x80000001))
+(assert_return (invoke "or" (i32.const 0) (i32.const 0x40000000)) (i32.const 0x40000000))
+(assert_return (invoke "or" (i32.const 0) (i32.const 0x12345678)) (i32.const 0x12345678))
+(assert_return (invoke "or" (i32.const 0) (i32.const 0xedcba988)) (i32.const 0xedcba988))
+(assert_return (invoke "or" (i32.const 0) (i32.const 31)) (i32.const 31))
+(assert_return (invoke "or" (i32.const 0) (i32.const 32)) (i32.const 32))
+(assert_return (invoke "or" (i32.const 0) (i32.const 33)) (i32.const 33))
+(assert_return (invoke "or" (i32.const 0) (i32.const 0xfff5a5a)) (i32.const 0xfff5a5a))
+(assert_return (invoke "or" (i32.const 1) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "or" (i32.const 1) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "or" (i32.const 1) (i32.const -1)) (i32.const -1))
+(assert_return (invoke "or" (i32.const 1) (i32.const 2)) (i32.const 3))
+(assert_return (invoke "or" (i32.const 1) (i32.const 3)) (i32.const 3))
+(assert_return (invoke "or" (i32.const 1) (i32.const 0x7fffffff)) (i32.const 0x7fffffff))
+(assert_return (invoke "or" (i32.const 1) (i32.const 0x80000000)) (i32.const 0x80000001))
+(assert_return (invoke "or" (i32.const 1) (i32.const 0x80000001)) (i32.const 0x80000001))
+(assert_return (invoke "or" (i32.const 1) (i32.const 0x40000000)) (i32.const 0x40000001))
+(assert_return (invoke "or" (i32.const 1) (i32.const 0x12345678)) (i32.const 0x12345679))
+(assert_return (invoke "or" (i32.const 1) (i32.const 0xedcba988)) (i32.const 0xedcba989))
+(assert_return (invoke "or" (i32.const 1) (i32.const 31)) (i32.const 31))
+(assert_return (invoke "or" (i32.const 1) (i32.const 32)) (i32.const 33))
+(assert_return (invoke "or" (i32.const 1) (i32.const 33)) (i32.const 33))
+(assert_return (invoke "or" (i32.const 1) (i32.const 0xfff5a5a)) (i32.const 0xfff5a5b))
+(assert_return (invoke "or" (i32.const -1) (i32.const 0)) (i32.const -1))
+(assert_return (invoke "or" (i32.const -1) (i32.const 1)) (i32.const -1))
+(assert_return (invoke "or" (i32.const -1) (i32.const -1)) (i32.const -1))
+(assert_return (invoke "or" (i32.const -1) (i32.const 2)) (i32.const -1))
+(assert_return (invoke "or" (i32.const -1) (i32.const 3)) (i32.const -1))
+(assert_return (invoke "or" (i32.const -1) (i32.const 0x7fffffff)) (i32.const -1))
+(assert_return (invoke "or" (i32.const -1) (i32.const 0x80000000)) (i32.const -1))
+(assert_return (invoke "or" (i32.const -1) (i32.const 0x80000001)) (i32.const -1))
+(assert_return (invoke "or" (i32.const -1) (i32.const 0x40000000)) (i32.const -1))
+(assert_return (invoke "or" (i32.const -1) (i32.const 0x12345678)) (i32.const -1))
+(assert_return (invoke "or" (i32.const -1) (i32.const 0xedcba988)) (i32.const -1))
+(assert_return (invoke "or" (i32.const -1) (i32.const 31)) (i32.const -1))
+(assert_return (invoke "or" (i32.const -1) (i32.const 32)) (i32.const -1))
+(assert_return (invoke "or" (i32.const -1) (i32.const 33)) (i32.const -1))
+(assert_return (invoke "or" (i32.const -1) (i32.const 0xfff5a5a)) (i32.const -1))
+(assert_return (invoke "or" (i32.const 2) (i32.const 0)) (i32.const 2))
+(assert_return (invoke "or" (i32.const 2) (i32.const 1)) (i32.const 3))
+(assert_return (invoke "or" (i32.const 2) (i32.const -1)) (i32.const -1))
+(assert_return (invoke "or" (i32.const 2) (i32.const 2)) (i32.const 2))
+(assert_return (invoke "or" (i32.const 2) (i32.const 3)) (i32.const 3))
+(assert_return (invoke "or" (i32.const 2) (i32.const 0x7fffffff)) (i32.const 0x7fffffff))
+(assert_return (invoke "or" (i32.const 2) (i32.const 0x80000000)) (i32.const 0x80000002))
+(assert_return (invoke "or" (i32.const 2) (i32.const 0x80000001)) (i32.const 0x80000003))
+(assert_return (invoke "or" (i32.const 2) (i32.const 0x40000000)) (i32.const 0x40000002))
+(assert_return (invoke "or" (i32.const 2) (i32.const 0x12345678)) (i32.const 0x1234567a))
+(assert_return (invoke "or" (i32.const 2) (i32.const 0xedcba988)) (i32.const 0xedcba98a))
+(assert_return (invoke "or" (i32.const 2) (i32.const 31)) (i32.const 31))
+(assert_return (invoke "or" (i32.const 2) (i32.const 32)) (i32.const 34))
+(assert_return (invoke "or" (i32.const 2) (i32.const 33)) (i32.const 35))
+(assert_return (invoke "or" (i32.const 2) (i32.const 0xfff5a5a)) (i32.const 0xfff5a5a))
+(assert_return (invoke "or" (i32.const 3) (i32.const 0)) (i32.const 3))
+(assert_return (invoke "or" (i32.const 3) (i32.const 1)) (i32.const 3))
+(assert_return (invoke "or" (i32.const 3) (i32.const -1)) (i32.const -1))
+(assert_return (invoke "or" (i32.const 3) (i32.const 2)) (i32.const 3))
+(assert_return (invoke "or" (i32.const 3) (i32.const 3)) (i32.const 3))
+(assert_return (invoke "or" (i32.const 3) (i32.const 0x7fffffff)) (i32.const 0x7fffffff))
+(assert_return (invoke "or" (i32.const 3) (i32.const 0x80000000)) (i32.const 0x80000003))
+(assert_return (invoke "or" (i32.const 3) (i32.const 0x80000001)) (i32.const 0x80000003))
+(assert_return (invoke "or" (i32.const 3) (i32.const 0x40000000)) (i32.const 0x40000003))
+(assert_return (invoke "or" (i32.const 3) (i32.const 0x12345678)) (i32.const 0x1234567b))
+(assert_return (invoke "or" (i32.const 3) (i32.const 0xedcba988)) (i32.const 0xedcba98b))
+(assert_return (invoke "or" (i32.const 3) (i32.const 31)) (i32.const 31))
+(assert_return (invoke "or" (i32.const 3) (i32.const 32)) (i32.const 35))
+(assert_return (invoke "or" (i32.const 3) (i32.const 33)) (i32.const 35))
+(assert_return (invoke "or" (i32.const 3) (i32.const 0xfff5a5a)) (i32.const 0xfff5a5b))
+(assert_return (invoke "or" (i32.const 0x7fffffff) (i32.const 0)) (i32.const 0x7fffffff))
+(assert_return (invoke "or" (i32.const 0x7fffffff) (i32.const 1)) (i32.const 0x7fffffff))
+(assert_return (invoke "or" (i32.const 0x7fffffff) (i32.const -1)) (i32.const -1))
+(assert_return (invoke "or" (i32.const 0x7fffffff) (i32.const 2)) (i32.const 0x7fffffff))
+(assert_return (invoke "or" (i32.const 0x7fffffff) (i32.const 3)) (i32.const 0x7fffffff))
+(assert_return (invoke "or" (i32.const 0x7fffffff) (i32.const 0x7fffffff)) (i32.const 0x7fffffff))
+(assert_return (invoke "or" (i32.const 0x7fffffff) (i32.const 0x80000000)) (i32.const -1))
+(assert_return (invoke "or" (i32.const 0x7fffffff) (i32.const 0x80000001)) (i32.const -1))
+(assert_return (invoke "or" (i32.const 0x7fffffff) (i32.const 0x40000000)) (i32.const 0x7fffffff))
+(assert_return (invoke "or" (i32.const 0x7fffffff) (i32.const 0x12345678)) (i32.const 0x7fffffff))
+(assert_return (invoke "or" (i32.const 0x7fffffff) (i32.const 0xedcba988)) (i32.const -1))
+(assert_return (invoke "or" (i32.const 0x7fffffff) (i32.const 31)) (i32.const 0x7fffffff))
+(assert_return (invoke "or" (i32.const 0x7fffffff) (i32.const 32)) (i32.const 0x7fffffff))
+(assert_return (invoke "or" (i32.const 0x7fffffff) (i32.const 33)) (i32.const 0x7fffffff))
+(assert_return (invoke "or" (i32.const 0x7fffffff) (i32.const 0xfff5a5a)) (i32.const 0x7fffffff))
+(assert_return (invoke "or" (i32.const 0x80000000) (i32.const 0)) (i32.const 0x80000000))
+(assert_return (invoke "or" (i32.const 0x80000000) (i32.const 1)) (i32.const 0x80000001))
+(assert_return (invoke "or" (i32.const 0x80000000) (i32.const -1)) (i32.const -1))
+(assert_return (invoke "or" (i32.const 0x80000000) (i32.const 2)) (i32.const 0x80000002))
+(assert_return (invoke "or" (i32.const 0x80000000) (i32.const 3)) (i32.const 0x80000003))
+(assert_return (invoke "or" (i32.const 0x80000000) (i32.const 0x7fffffff)) (i32.const -1))
+(assert_return (invoke "or" (i32.const 0x80000000) (i32.const 0x80000000)) (i32.const 0x80000000))
+(assert_return (invoke "or" (i32.const 0x80000000) (i32.const 0x80000001)) (i32.const 0x80000001))
+(assert_return (invoke "or" (i32.const 0x80000000) (i32.const 0x40000000)) (i32.const 0xc0000000))
+(assert_return (invoke "or" (i32.const 0x80000000) (i32.const 0x12345678)) (i32.const 0x92345678))
+(assert_return (invoke "or" (i32.const 0x80000000) (i32.const 0xedcba988)) (i32.const 0xedcba988))
+(assert_return (invoke "or" (i32.const 0x80000000) (i32.const 31)) (i32.const 0x8000001f))
+(assert_return (invoke "or" (i32.const 0x80000000) (i32.const 32)) (i32.const 0x80000020))
+(assert_return (invoke "or" (i32.const 0x80000000) (i32.const 33)) (i32.const 0x80000021))
+(assert_return (invoke "or" (i32.const 0x80000000) (i32.const 0xfff5a5a)) (i32.const 0x8fff5a5a))
+(assert_return (invoke "or" (i32.const 0x80000001) (i32.const 0)) (i32.const 0x80000001))
+(assert_return (invoke "or" (i32.const 0x80000001) (i32.const 1)) (i32.const 0x80000001))
+(assert_return (invoke "or" (i32.const 0x80000001) (i32.const -1)) (i32.const -1))
+(assert_return (invoke "or" (i32.const 0x80000001) (i32.const 2)) (i32.const 0x80000003))
+(assert_return (invoke "or" (i32.const 0x80000001) (i32.const 3)) (i32.const 0x80000003))
+(assert_return (invoke "or" (i32.const 0x80000001) (i32.const 0x7fffffff)) (i32.const -1))
+(assert_return (invoke "or" (i32.const 0x80000001) (i32.const 0x80000000)) (i32.const 0x80000001))
+(assert_return (invoke "or" (i32.const 0x80000001) (i32.const 0x80000001)) (i32.const 0x80000001))
+(assert_return (invoke "or" (i32.const 0x80000001) (i32.const 0x40000000)) (i32.const 0xc0000001))
+(assert_return (invoke "or" (i32.const 0x80000001) (i32.const 0x12345678)) (i32.const 0x92345679))
+(assert_return (invoke "or" (i32.const 0x80000001) (i32.const 0xedcba988)) (i32.const 0xedcba989))
+(assert_return (invoke "or" (i32.const 0x80000001) (i32.const 31)) (i32.const 0x8000001f))
+(assert_return (invoke "or" (i32.const 0x80000001) (i32.const 32)) (i32.const 0x80000021))
+(assert_return (invoke "or" (i32.const 0x80000001) (i32.const 33)) (i32.const 0x80000021))
+(assert_return (invoke "or" (i32.const 0x80000001) (i32.const 0xfff5a5a)) (i32.const 0x8fff5a5b))
+(assert_return (invoke "or" (i32.const 0x40000000) (i32.const 0)) (i32.const 0x40000000))
+(assert_return (invoke "or" (i32.const 0x40000000) (i32.const 1)) (i32.const 0x40000001))
+(assert_return (invoke "or" (i32.const 0x40000000) (i32.const -1)) (i32.const -1))
+(assert_return (invoke "or" (i32.const 0x40000000) (i32.const 2)) (i32.const 0x40000002))
+(assert_return (invoke "or" (i32.const 0x40000000) (i32.const 3)) (i32.const 0x40000003))
+(assert_return (invoke "or" (i32.const 0x40000000) (i32.const 0x7fffffff)) (i32.const 0x7fffffff))
+(assert_return (invoke "or" (i32.const 0x40000000) (i32.const 0x80000000)) (i32.const 0xc0000000))
+(assert_return (invoke "or" (i32.const 0x40000000) (i32.const 0x80000001)) (i32.const 0xc0000001))
+(assert_return (invoke "or" (i32.const 0x40000000) (i32.const 0x40000000)) (i32.const 0x40000000))
+(assert_return (invoke "or" (i32.const 0x40000000) (i32.const 0x12345678)) (i32.const 0x52345678))
+(assert_return (invoke "or" (i32.const 0x40000000) (i32.const 0xedcba988)) (i32.const 0xedcba988))
+(assert_return (invoke "or" (i32.const 0x40000000) (i32.const 31)) (i32.const 0x4000001f))
+(assert_return (invoke "or" (i32.const 0x40000000) (i32.const 32)) (i32.const 0x40000020))
+(assert_return (invoke "or" (i32.const 0x40000000) (i32.const 33)) (i32.const 0x40000021))
+(assert_return (invoke "or" (i32.const 0x40000000) (i32.const 0xfff5a5a)) (i32.const 0x4fff5a5a))
+(assert_return (invoke "or" (i32.const 0x12345678) (i32.const 0)) (i32.const 0x12345678))
+(assert_return (invoke "or" (i32.const 0x12345678) (i32.const 1)) (i32.const 0x12345679))
+(assert_return (invoke "or" (i32.const 0x12345678) (i32.const -1)) (i32.const -1))
+(assert_return (invoke "or" (i32.const 0x12345678) (i32.const 2)) (i32.const 0x1234567a))
+(assert_return (invoke "or" (i32.const 0x12345678) (i32.const 3)) (i32.const 0x1234567b))
+(assert_return (invoke "or" (i32.const 0x12345678) (i32.const 0x7fffffff)) (i32.const 0x7fffffff))
+(assert_return (invoke "or" (i32.const 0x12345678) (i32.const 0x80000000)) (i32.const 0x92345678))
+(assert_return (invoke "or" (i32.const 0x12345678) (i32.const 0x80000001)) (i32.const 0x92345679))
+(assert_return (invoke "or" (i32.const 0x12345678) (i32.const 0x40000000)) (i32.const 0x52345678))
+(assert_return (invoke "or" (i32.const 0x12345678) (i32.const 0x12345678)) (i32.const 0x12345678))
+(assert_return (invoke "or" (i32.const 0x12345678) (i32.const 0xedcba988)) (i32.const -8))
+(assert_return (invoke "or" (i32.const 0x12345678) (i32.const 31)) (i32.const 0x1234567f))
+(assert_return (invoke "or" (i32.const 0x12345678) (i32.const 32)) (i32.const 0x12345678))
+(assert_return (invoke "or" (i32.const 0x12345678) (i32.const 33)) (i32.const 0x12345679))
+(assert_return (invoke "or" (i32.const 0x12345678) (i32.const 0xfff5a5a)) (i32.const 0x1fff5e7a))
+(assert_return (invoke "or" (i32.const 0xedcba988) (i32.const 0)) (i32.const 0xedcba988))
+(assert_return (invoke "or" (i32.const 0xedcba988) (i32.const 1)) (i32.const 0xedcba989))
+(assert_return (invoke "or" (i32.const 0xedcba988) (i32.const -1)) (i32.const -1))
+(assert_return (invoke "or" (i32.const 0xedcba988) (i32.const 2)) (i32.const 0xedcba98a))
+(assert_return (invoke "or" (i32.const 0xedcba988) (i32.const 3)) (i32.const 0xedcba98b))
+(assert_return (invoke "or" (i32.const 0xedcba988) (i32.const 0x7fffffff)) (i32.const -1))
+(assert_return (invoke "or" (i32.const 0xedcba988) (i32.const 0x80000000)) (i32.const 0xedcba988))
+(assert_return (invoke "or" (i32.const 0xedcba988) (i32.const 0x80000001)) (i32.const 0xedcba989))
+(assert_return (invoke "or" (i32.const 0xedcba988) (i32.const 0x40000000)) (i32.const 0xedcba988))
+(assert_return (invoke "or" (i32.const 0xedcba988) (i32.const 0x12345678)) (i32.const -8))
+(assert_return (invoke "or" (i32.const 0xedcba988) (i32.const 0xedcba988)) (i32.const 0xedcba988))
+(assert_return (invoke "or" (i32.const 0xedcba988) (i32.const 31)) (i32.const 0xedcba99f))
+(assert_return (invoke "or" (i32.const 0xedcba988) (i32.const 32)) (i32.const 0xedcba9a8))
+(assert_return (invoke "or" (i32.const 0xedcba988) (i32.const 33)) (i32.const 0xedcba9a9))
+(assert_return (invoke "or" (i32.const 0xedcba988) (i32.const 0xfff5a5a)) (i32.const 0xeffffbda))
+(assert_return (invoke "or" (i32.const 31) (i32.const 0)) (i32.const 31))
+(assert_return (invoke "or" (i32.const 31) (i32.const 1)) (i32.const 31))
+(assert_return (invoke "or" (i32.const 31) (i32.const -1)) (i32.const -1))
+(assert_return (invoke "or" (i32.const 31) (i32.const 2)) (i32.const 31))
+(assert_return (invoke "or" (i32.const 31) (i32.const 3)) (i32.const 31))
+(assert_return (invoke "or" (i32.const 31) (i32.const 0x7fffffff)) (i32.const 0x7fffffff))
+(assert_return (invoke "or" (i32.const 31) (i32.const 0x80000000)) (i32.const 0x8000001f))
+(assert_return (invoke "or" (i32.const 31) (i32.const 0x80000001)) (i32.const 0x8000001f))
+(assert_return (invoke "or" (i32.const 31) (i32.const 0x40000000)) (i32.const 0x4000001f))
+(assert_return (invoke "or" (i32.const 31) (i32.const 0x12345678)) (i32.const 0x1234567f))
+(assert_return (invoke "or" (i32.const 31) (i32.const 0xedcba988)) (i32.const 0xedcba99f))
+(assert_return (invoke "or" (i32.const 31) (i32.const 31)) (i32.const 31))
+(assert_return (invoke "or" (i32.const 31) (i32.const 32)) (i32.const 63))
+(assert_return (invoke "or" (i32.const 31) (i32.const 33)) (i32.const 63))
+(assert_return (invoke "or" (i32.const 31) (i32.const 0xfff5a5a)) (i32.const 0xfff5a5f))
+(assert_return (invoke "or" (i32.const 32) (i32.const 0)) (i32.const 32))
+(assert_return (invoke "or" (i32.const 32) (i32.const 1)) (i32.const 33))
+(assert_return (invoke "or" (i32.const 32) (i32.const -1)) (i32.const -1))
+(assert_return (invoke "or" (i32.const 32) (i32.const 2)) (i32.const 34))
+(assert_return (invoke "or" (i32.const 32) (i32.const 3)) (i32.const 35))
+(assert_return (invoke "or" (i32.const 32) (i32.const 0x7fffffff)) (i32.const 0x7fffffff))
+(assert_return (invoke "or" (i32.const 32) (i32.const 0x80000000)) (i32.const 0x80000020))
+(assert_return (invoke "or" (i32.const 32) (i32.const 0x80000001)) (i32.const 0x80000021))
+(assert_return (invoke "or" (i32.const 32) (i32.const 0x40000000)) (i32.const 0x40000020))
+(assert_return (invoke "or" (i32.const 32) (i32.const 0x12345678)) (i32.const 0x12345678))
+(assert_return (invoke "or" (i32.const 32) (i32.const 0xedcba988)) (i32.const 0xedcba9a8))
+(assert_return (invoke "or" (i32.const 32) (i32.const 31)) (i32.const 63))
+(assert_return (invoke "or" (i32.const 32) (i32.const 32)) (i32.const 32))
+(assert_return (invoke "or" (i32.const 32) (i32.const 33)) (i32.const 33))
+(assert_return (invoke "or" (i32.const 32) (i32.const 0xfff5a5a)) (i32.const 0xfff5a7a))
+(assert_return (invoke "or" (i32.const 33) (i32.const 0)) (i32.const 33))
+(assert_return (invoke "or" (i32.const 33) (i32.const 1)) (i32.const 33))
+(assert_return (invoke "or" (i32.const 33) (i32.const -1)) (i32.const -1))
+(assert_return (invoke "or" (i32.const 33) (i32.const 2)) (i32.const 35))
+(assert_return (invoke "or" (i32.const 33) (i32.const 3)) (i32.const 35))
+(assert_return (invoke "or" (i32.const 33) (i32.const 0x7fffffff)) (i32.const 0x7fffffff))
+(assert_return (invoke "or" (i32.const 33) (i32.const 0x80000000)) (i32.const 0x80000021))
+(assert_return (invoke "or" (i32.const 33) (i32.const 0x80000001)) (i32.const 0x80000021))
+(assert_return (invoke "or" (i32.const 33) (i32.const 0x40000000)) (i32.const 0x40000021))
+(assert_return (invoke "or" (i32.const 33) (i32.const 0x12345678)) (i32.const 0x12345679))
+(assert_return (invoke "or" (i32.const 33) (i32.const 0xedcba988)) (i32.const 0xedcba9a9))
+(assert_return (invoke "or" (i32.const 33) (i32.const 31)) (i32.const 63))
+(assert_return (invoke "or" (i32.const 33) (i32.const 32)) (i32.const 33))
+(assert_return (invoke "or" (i32.const 33) (i32.const 33)) (i32.const 33))
+(assert_return (invoke "or" (i32.const 33) (i32.const 0xfff5a5a)) (i32.const 0xfff5a7b))
+(assert_return (invoke "or" (i32.const 0xfff5a5a) (i32.const 0)) (i32.const 0xfff5a5a))
+(assert_return (invoke "or" (i32.const 0xfff5a5a) (i32.const 1)) (i32.const 0xfff5a5b))
+(assert_return (invoke "or" (i32.const 0xfff5a5a) (i32.const -1)) (i32.const -1))
+(assert_return (invoke "or" (i32.const 0xfff5a5a) (i32.const 2)) (i32.const 0xfff5a5a))
+(assert_return (invoke "or" (i32.const 0xfff5a5a) (i32.const 3)) (i32.const 0xfff5a5b))
+(assert_return (invoke "or" (i32.const 0xfff5a5a) (i32.const 0x7fffffff)) (i32.const 0x7fffffff))
+(assert_return (invoke "or" (i32.const 0xfff5a5a) (i32.const 0x80000000)) (i32.const 0x8fff5a5a))
+(assert_return (invoke "or" (i32.const 0xfff5a5a) (i32.const 0x80000001)) (i32.const 0x8fff5a5b))
+(assert_return (invoke "or" (i32.const 0xfff5a5a) (i32.const 0x40000000)) (i32.const 0x4fff5a5a))
+(assert_return (invoke "or" (i32.const 0xfff5a5a) (i32.const 0x12345678)) (i32.const 0x1fff5e7a))
+(assert_return (invoke "or" (i32.const 0xfff5a5a) (i32.const 0xedcba988)) (i32.const 0xeffffbda))
+(assert_return (invoke "or" (i32.const 0xfff5a5a) (i32.const 31)) (i32.const 0xfff5a5f))
+(assert_return (invoke "or" (i32.const 0xfff5a5a) (i32.const 32)) (i32.const 0xfff5a7a))
+(assert_return (invoke "or" (i32.const 0xfff5a5a) (i32.const 33)) (i32.const 0xfff5a7b))
+(assert_return (invoke "or" (i32.const 0xfff5a5a) (i32.const 0xfff5a5a)) (i32.const 0xfff5a5a))
+(assert_return (invoke "or" (i32.const 0xe211b73a) (i32.const 0x1edf9de5)) (i32.const 0xfedfbfff))
+(assert_return (invoke "or" (i32.const 0x80bfcefc) (i32.const 0x38eb0bad)) (i32.const 0xb8ffcffd))
+(assert_return (invoke "or" (i32.const 0xf8c9198f) (i32.const 0xad83cc8a)) (i32.const 0xfdcbdd8f))
+(assert_return (invoke "or" (i32.const 0xdd66d5ca) (i32.const 0x569fa8bb)) (i32.const 0xdffffdfb))
+(assert_return (invoke "or" (i32.const 0xc09fa3e5) (i32.const 0xa384cfea)) (i32.const 0xe39fefef))
+(assert_return (invoke "or" (i32.const 0x131be0e6) (i32.const 0x4e160d86)) (i32.const 0x5f1fede6))
+(assert_return (invoke "or" (i32.const 0x96dc7068) (i32.const 0x2e796fbd)) (i32.const 0xbefd7ffd))
+(assert_return (invoke "or" (i32.const 0xfe035eb6) (i32.const 0x3b62d42d)) (i32.const 0xff63debf))
+(assert_return (invoke "or" (i32.const 0x80c52950) (i32.const 0xcc017fc7)) (i32.const 0xccc57fd7))
+(assert_return (invoke "or" (i32.const 0x10de969f) (i32.const 0x19ca0f33)) (i32.const 0x19de9fbf))
+(assert_return (invoke "or" (i32.const 0x2f494fec) (i32.const 0xf4853d8a)) (i32.const 0xffcd7fee))
+(assert_return (invoke "or" (i32.const 0xfcea6266) (i32.const 0x4f5773f)) (i32.const 0xfcff777f))
+(assert_return (invoke "or" (i32.const 0xfa12461c) (i32.const 0x44ca504)) (i32.const 0xfe5ee71c))
+(assert_return (invoke "or" (i32.const 0x3f3e0a20) (i32.const 0xf9d78da1)) (i32.const -28767))
+(assert_return (invoke "or" (i32.const 0x5185edb7) (i32.const 0x5d5e5f0e)) (i32.const 0x5ddfffbf))
+(assert_return (invoke "or" (i32.const 0xb70862d0) (i32.const 0xa564d8ec)) (i32.const 0xb76cfafc))
+
+(assert_return (invoke "xor" (i32.const 0) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "xor" (i32.const 0) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "xor" (i32.const 0) (i32.const -1)) (i32.const -1))
+(assert_return (invoke "xor" (i32.const 0) (i32.const 2)) (i32.const 2))
+(assert_return (invoke "xor" (i32.const 0) (i32.const 3)) (i32.const 3))
+(assert_return (invoke "xor" (i32.const 0) (i32.const 0x7fffffff)) (i32.const 0x7fffffff))
+(assert_return (invoke "xor" (i32.const 0) (i32.const 0x80000000)) (i32.const 0x80000000))
+(assert_return (invoke "xor" (i32.const 0) (i32.const 0x80000001)) (i32.const 0x80000001))
+(assert_return (invoke "xor" (i32.const 0) (i32.const 0x40000000)) (i32.const 0x40000000))
+(assert_return (invoke "xor" (i32.const 0) (i32.const 0x12345678)) (i32.const 0x12345678))
+(assert_return (invoke "xor" (i32.const 0) (i32.const 0xedcba988)) (i32.const 0xedcba988))
+(assert_return (invoke "xor" (i32.const 0) (i32.const 31)) (i32.const 31))
+(assert_return (invoke "xor" (i32.const 0) (i32.const 32)) (i32.const 32))
+(assert_return (invoke "xor" (i32.const 0) (i32.const 33)) (i32.const 33))
+(assert_return (invoke "xor" (i32.const 0) (i32.const 0xfff5a5a)) (i32.const 0xfff5a5a))
+(assert_return (invoke "xor" (i32.const 1) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "xor" (i32.const 1) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "xor" (i32.const 1) (i32.const -1)) (i32.const -2))
+(assert_return (invoke "xor" (i32.const 1) (i32.const 2)) (i32.const 3))
+(assert_return (invoke "xor" (i32.const 1) (i32.const 3)) (i32.const 2))
+(assert_return (invoke "xor" (i32.const 1) (i32.const 0x7fffffff)) (i32.const 0x7ffffffe))
+(assert_return (invoke "xor" (i32.const 1) (i32.const 0x80000000)) (i32.const 0x80000001))
+(assert_return (invoke "xor" (i32.const 1) (i32.const 0x80000001)) (i32.const 0x80000000))
+(assert_return (invoke "xor" (i32.const 1) (i32.const 0x40000000)) (i32.const 0x40000001))
+(assert_return (invoke "xor" (i32.const 1) (i32.const 0x12345678)) (i32.const 0x12345679))
+(assert_return (invoke "xor" (i32.const 1) (i32.const 0xedcba988)) (i32.const 0xedcba989))
+(assert_return (invoke "xor" (i32.const 1) (i32.const 31)) (i32.const 30))
+(assert_return (invoke "xor" (i32.const 1) (i32.const 32)) (i32.const 33))
+(assert_return (invoke "xor" (i32.const 1) (i32.const 33)) (i32.const 32))
+(assert_return (invoke "xor" (i32.const 1) (i32.const 0xfff5a5a)) (i32.const 0xfff5a5b))
+(assert_return (invoke "xor" (i32.const -1) (i32.const 0)) (i32.const -1))
+(assert_return (invoke "xor" (i32.const -1) (i32.const 1)) (i32.const -2))
+(assert_return (invoke "xor" (i32.const -1) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "xor" (i32.const -1) (i32.const 2)) (i32.const -3))
+(assert_return (invoke "xor" (i32.const -1) (i32.const 3)) (i32.const -4))
+(assert_return (invoke "xor" (i32.const -1) (i32.const 0x7fffffff)) (i32.const 0x80000000))
+(assert_return (invoke "xor" (i32.const -1) (i32.const 0x80000000)) (i32.const 0x7fffffff))
+(assert_return (invoke "xor" (i32.const -1) (i32.const 0x80000001)) (i32.const 0x7ffffffe))
+(assert_return (invoke "xor" (i32.const -1) (i32.const 0x40000000)) (i32.const 0xbfffffff))
+(assert_return (invoke "xor" (i32.const -1) (i32.const 0x12345678)) (i32.const 0xedcba987))
+(assert_return (invoke "xor" (i32.const -1) (i32.const 0xedcba988)) (i32.const 0x12345677))
+(assert_return (invoke "xor" (i32.const -1) (i32.const 31)) (i32.const -32))
+(assert_return (invoke "xor" (i32.const -1) (i32.const 32)) (i32.const -33))
+(assert_return (invoke "xor" (i32.const -1) (i32.const 33)) (i32.const -34))
+(assert_return (invoke "xor" (i32.const -1) (i32.const 0xfff5a5a)) (i32.const 0xf000a5a5))
+(assert_return (invoke "xor" (i32.const 2) (i32.const 0)) (i32.const 2))
+(assert_return (invoke "xor" (i32.const 2) (i32.const 1)) (i32.const 3))
+(assert_return (invoke "xor" (i32.const 2) (i32.const -1)) (i32.const -3))
+(assert_return (invoke "xor" (i32.const 2) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "xor" (i32.const 2) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "xor" (i32.const 2) (i32.const 0x7fffffff)) (i32.const 0x7ffffffd))
+(assert_return (invoke "xor" (i32.const 2) (i32.const 0x80000000)) (i32.const 0x80000002))
+(assert_return (invoke "xor" (i32.const 2) (i32.const 0x80000001)) (i32.const 0x80000003))
+(assert_return (invoke "xor" (i32.const 2) (i32.const 0x40000000)) (i32.const 0x40000002))
+(assert_return (invoke "xor" (i32.const 2) (i32.const 0x12345678)) (i32.const 0x1234567a))
+(assert_return (invoke "xor" (i32.const 2) (i32.const 0xedcba988)) (i32.const 0xedcba98a))
+(assert_return (invoke "xor" (i32.const 2) (i32.const 31)) (i32.const 29))
+(assert_return (invoke "xor" (i32.const 2) (i32.const 32)) (i32.const 34))
+(assert_return (invoke "xor" (i32.const 2) (i32.const 33)) (i32.const 35))
+(assert_return (invoke "xor" (i32.const 2) (i32.const 0xfff5a5a)) (i32.const 0xfff5a58))
+(assert_return (invoke "xor" (i32.const 3) (i32.const 0)) (i32.const 3))
+(assert_return (invoke "xor" (i32.const 3) (i32.const 1)) (i32.const 2))
+(assert_return (invoke "xor" (i32.const 3) (i32.const -1)) (i32.const -4))
+(assert_return (invoke "xor" (i32.const 3) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "xor" (i32.const 3) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "xor" (i32.const 3) (i32.const 0x7fffffff)) (i32.const 0x7ffffffc))
+(assert_return (invoke "xor" (i32.const 3) (i32.const 0x80000000)) (i32.const 0x80000003))
+(assert_return (invoke "xor" (i32.const 3) (i32.const 0x80000001)) (i32.const 0x80000002))
+(assert_return (invoke "xor" (i32.const 3) (i32.const 0x40000000)) (i32.const 0x40000003))
+(assert_return (invoke "xor" (i32.const 3) (i32.const 0x12345678)) (i32.const 0x1234567b))
+(assert_return (invoke "xor" (i32.const 3) (i32.const 0xedcba988)) (i32.const 0xedcba98b))
+(assert_return (invoke "xor" (i32.const 3) (i32.const 31)) (i32.const 28))
+(assert_return (invoke "xor" (i32.const 3) (i32.const 32)) (i32.const 35))
+(assert_return (invoke "xor" (i32.const 3) (i32.const 33)) (i32.const 34))
+(assert_return (invoke "xor" (i32.const 3) (i32.const 0xfff5a5a)) (i32.const 0xfff5a59))
+(assert_return (invoke "xor" (i32.const 0x7fffffff) (i32.const 0)) (i32.const 0x7fffffff))
+(assert_return (invoke "xor" (i32.const 0x7fffffff) (i32.const 1)) (i32.const 0x7ffffffe))
+(assert_return (invoke "xor" (i32.const 0x7fffffff) (i32.const -1)) (i32.const 0x80000000))
+(assert_return (invoke "xor" (i32.const 0x7fffffff) (i32.const 2)) (i32.const 0x7ffffffd))
+(assert_return (invoke "xor" (i32.const 0x7fffffff) (i32.const 3)) (i32.const 0x7ffffffc))
+(assert_return (invoke "xor" (i32.const 0x7fffffff) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "xor" (i32.const 0x7fffffff) (i32.const 0x80000000)) (i32.const -1))
+(assert_return (invoke "xor" (i32.const 0x7fffffff) (i32.const 0x80000001)) (i32.const -2))
+(assert_return (invoke "xor" (i32.const 0x7fffffff) (i32.const 0x40000000)) (i32.const 0x3fffffff))
+(assert_return (invoke "xor" (i32.const 0x7fffffff) (i32.const 0x12345678)) (i32.const 0x6dcba987))
+(assert_return (invoke "xor" (i32.const 0x7fffffff) (i32.const 0xedcba988)) (i32.const 0x92345677))
+(assert_return (invoke "xor" (i32.const 0x7fffffff) (i32.const 31)) (i32.const 0x7fffffe0))
+(assert_return (invoke "xor" (i32.const 0x7fffffff) (i32.const 32)) (i32.const 0x7fffffdf))
+(assert_return (invoke "xor" (i32.const 0x7fffffff) (i32.const 33)) (i32.const 0x7fffffde))
+(assert_return (invoke "xor" (i32.const 0x7fffffff) (i32.const 0xfff5a5a)) (i32.const 0x7000a5a5))
+(assert_return (invoke "xor" (i32.const 0x80000000) (i32.const 0)) (i32.const 0x80000000))
+(assert_return (invoke "xor" (i32.const 0x80000000) (i32.const 1)) (i32.const 0x80000001))
+(assert_return (invoke "xor" (i32.const 0x80000000) (i32.const -1)) (i32.const 0x7fffffff))
+(assert_return (invoke "xor" (i32.const 0x80000000) (i32.const 2)) (i32.const 0x80000002))
+(assert_return (invoke "xor" (i32.const 0x80000000) (i32.const 3)) (i32.const 0x80000003))
+(assert_return (invoke "xor" (i32.const 0x80000000) (i32.const 0x7fffffff)) (i32.const -1))
+(assert_return (invoke "xor" (i32.const 0x80000000) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "xor" (i32.const 0x80000000) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "xor" (i32.const 0x80000000) (i32.const 0x40000000)) (i32.const 0xc0000000))
+(assert_return (invoke "xor" (i32.const 0x80000000) (i32.const 0x12345678)) (i32.const 0x92345678))
+(assert_return (invoke "xor" (i32.const 0x80000000) (i32.const 0xedcba988)) (i32.const 0x6dcba988))
+(assert_return (invoke "xor" (i32.const 0x80000000) (i32.const 31)) (i32.const 0x8000001f))
+(assert_return (invoke "xor" (i32.const 0x80000000) (i32.const 32)) (i32.const 0x80000020))
+(assert_return (invoke "xor" (i32.const 0x80000000) (i32.const 33)) (i32.const 0x80000021))
+(assert_return (invoke "xor" (i32.const 0x80000000) (i32.const 0xfff5a5a)) (i32.const 0x8fff5a5a))
+(assert_return (invoke "xor" (i32.const 0x80000001) (i32.const 0)) (i32.const 0x80000001))
+(assert_return (invoke "xor" (i32.const 0x80000001) (i32.const 1)) (i32.const 0x80000000))
+(assert_return (invoke "xor" (i32.const 0x80000001) (i32.const -1)) (i32.const 0x7ffffffe))
+(assert_return (invoke "xor" (i32.const 0x80000001) (i32.const 2)) (i32.const 0x80000003))
+(assert_return (invoke "xor" (i32.const 0x80000001) (i32.const 3)) (i32.const 0x80000002))
+(assert_return (invoke "xor" (i32.const 0x80000001) (i32.const 0x7fffffff)) (i32.const -2))
+(assert_return (invoke "xor" (i32.const 0x80000001) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "xor" (i32.const 0x80000001) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "xor" (i32.const 0x80000001) (i32.const 0x40000000)) (i32.const 0xc0000001))
+(assert_return (invoke "xor" (i32.const 0x80000001) (i32.const 0x12345678)) (i32.const 0x92345679))
+(assert_return (invoke "xor" (i32.const 0x80000001) (i32.const 0xedcba988)) (i32.const 0x6dcba989))
+(assert_return (invoke "xor" (i32.const 0x80000001) (i32.const 31)) (i32.const 0x8000001e))
+(assert_return (invoke "xor" (i32.const 0x80000001) (i32.const 32)) (i32.const 0x80000021))
+(assert_return (invoke "xor" (i32.const 0x80000001) (i32.const 33)) (i32.const 0x80000020))
+(assert_return (invoke "xor" (i32.const 0x80000001) (i32.const 0xfff5a5a)) (i32.const 0x8fff5a5b))
+(assert_return (invoke "xor" (i32.const 0x40000000) (i32.const 0)) (i32.const 0x40000000))
+(assert_return (invoke "xor" (i32.const 0x40000000) (i32.const 1)) (i32.const 0x40000001))
+(assert_return (invoke "xor" (i32.const 0x40000000) (i32.const -1)) (i32.const 0xbfffffff))
+(assert_return (invoke "xor" (i32.const 0x40000000) (i32.const 2)) (i32.const 0x40000002))
+(assert_return (invoke "xor" (i32.const 0x40000000) (i32.const 3)) (i32.const 0x40000003))
+(assert_return (invoke "xor" (i32.const 0x40000000) (i32.const 0x7fffffff)) (i32.const 0x3fffffff))
+(assert_return (invoke "xor" (i32.const 0x40000000) (i32.const 0x80000000)) (i32.const 0xc0000000))
+(assert_return (invoke "xor" (i32.const 0x40000000) (i32.const 0x80000001)) (i32.const 0xc0000001))
+(assert_return (invoke "xor" (i32.const 0x40000000) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "xor" (i32.const 0x40000000) (i32.const 0x12345678)) (i32.const 0x52345678))
+(assert_return (invoke "xor" (i32.const 0x40000000) (i32.const 0xedcba988)) (i32.const 0xadcba988))
+(assert_return (invoke "xor" (i32.const 0x40000000) (i32.const 31)) (i32.const 0x4000001f))
+(assert_return (invoke "xor" (i32.const 0x40000000) (i32.const 32)) (i32.const 0x40000020))
+(assert_return (invoke "xor" (i32.const 0x40000000) (i32.const 33)) (i32.const 0x40000021))
+(assert_return (invoke "xor" (i32.const 0x40000000) (i32.const 0xfff5a5a)) (i32.const 0x4fff5a5a))
+(assert_return (invoke "xor" (i32.const 0x12345678) (i32.const 0)) (i32.const 0x12345678))
+(assert_return (invoke "xor" (i32.const 0x12345678) (i32.const 1)) (i32.const 0x12345679))
+(assert_return (invoke "xor" (i32.const 0x12345678) (i32.const -1)) (i32.const 0xedcba987))
+(assert_return (invoke "xor" (i32.const 0x12345678) (i32.const 2)) (i32.const 0x1234567a))
+(assert_return (invoke "xor" (i32.const 0x12345678) (i32.const 3)) (i32.const 0x1234567b))
+(assert_return (invoke "xor" (i32.const 0x12345678) (i32.const 0x7fffffff)) (i32.const 0x6dcba987))
+(assert_return (invoke "xor" (i32.const 0x12345678) (i32.const 0x80000000)) (i32.const 0x92345678))
+(assert_return (invoke "xor" (i32.const 0x12345678) (i32.const 0x80000001)) (i32.const 0x92345679))
+(assert_return (invoke "xor" (i32.const 0x12345678) (i32.const 0x40000000)) (i32.const 0x52345678))
+(assert_return (invoke "xor" (i32.const 0x12345678) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "xor" (i32.const 0x12345678) (i32.const 0xedcba988)) (i32.const -16))
+(assert_return (invoke "xor" (i32.const 0x12345678) (i32.const 31)) (i32.const 0x12345667))
+(assert_return (invoke "xor" (i32.const 0x12345678) (i32.const 32)) (i32.const 0x12345658))
+(assert_return (invoke "xor" (i32.const 0x12345678) (i32.const 33)) (i32.const 0x12345659))
+(assert_return (invoke "xor" (i32.const 0x12345678) (i32.const 0xfff5a5a)) (i32.const 0x1dcb0c22))
+(assert_return (invoke "xor" (i32.const 0xedcba988) (i32.const 0)) (i32.const 0xedcba988))
+(assert_return (invoke "xor" (i32.const 0xedcba988) (i32.const 1)) (i32.const 0xedcba989))
+(assert_return (invoke "xor" (i32.const 0xedcba988) (i32.const -1)) (i32.const 0x12345677))
+(assert_return (invoke "xor" (i32.const 0xedcba988) (i32.const 2)) (i32.const 0xedcba98a))
+(assert_return (invoke "xor" (i32.const 0xedcba988) (i32.const 3)) (i32.const 0xedcba98b))
+(assert_return (invoke "xor" (i32.const 0xedcba988) (i32.const 0x7fffffff)) (i32.const 0x92345677))
+(assert_return (invoke "xor" (i32.const 0xedcba988) (i32.const 0x80000000)) (i32.const 0x6dcba988))
+(assert_return (invoke "xor" (i32.const 0xedcba988) (i32.const 0x80000001)) (i32.const 0x6dcba989))
+(assert_return (invoke "xor" (i32.const 0xedcba988) (i32.const 0x40000000)) (i32.const 0xadcba988))
+(assert_return (invoke "xor" (i32.const 0xedcba988) (i32.const 0x12345678)) (i32.const -16))
+(assert_return (invoke "xor" (i32.const 0xedcba988) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "xor" (i32.const 0xedcba988) (i32.const 31)) (i32.const 0xedcba997))
+(assert_return (invoke "xor" (i32.const 0xedcba988) (i32.const 32)) (i32.const 0xedcba9a8))
+(assert_return (invoke "xor" (i32.const 0xedcba988) (i32.const 33)) (i32.const 0xedcba9a9))
+(assert_return (invoke "xor" (i32.const 0xedcba988) (i32.const 0xfff5a5a)) (i32.const 0xe234f3d2))
+(assert_return (invoke "xor" (i32.const 31) (i32.const 0)) (i32.const 31))
+(assert_return (invoke "xor" (i32.const 31) (i32.const 1)) (i32.const 30))
+(assert_return (invoke "xor" (i32.const 31) (i32.const -1)) (i32.const -32))
+(assert_return (invoke "xor" (i32.const 31) (i32.const 2)) (i32.const 29))
+(assert_return (invoke "xor" (i32.const 31) (i32.const 3)) (i32.const 28))
+(assert_return (invoke "xor" (i32.const 31) (i32.const 0x7fffffff)) (i32.const 0x7fffffe0))
+(assert_return (invoke "xor" (i32.const 31) (i32.const 0x80000000)) (i32.const 0x8000001f))
+(assert_return (invoke "xor" (i32.const 31) (i32.const 0x80000001)) (i32.const 0x8000001e))
+(assert_return (invoke "xor" (i32.const 31) (i32.const 0x40000000)) (i32.const 0x4000001f))
+(assert_return (invoke "xor" (i32.const 31) (i32.const 0x12345678)) (i32.const 0x12345667))
+(assert_return (invoke "xor" (i32.const 31) (i32.const 0xedcba988)) (i32.const 0xedcba997))
+(assert_return (invoke "xor" (i32.const 31) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "xor" (i32.const 31) (i32.const 32)) (i32.const 63))
+(assert_return (invoke "xor" (i32.const 31) (i32.const 33)) (i32.const 62))
+(assert_return (invoke "xor" (i32.const 31) (i32.const 0xfff5a5a)) (i32.const 0xfff5a45))
+(assert_return (invoke "xor" (i32.const 32) (i32.const 0)) (i32.const 32))
+(assert_return (invoke "xor" (i32.const 32) (i32.const 1)) (i32.const 33))
+(assert_return (invoke "xor" (i32.const 32) (i32.const -1)) (i32.const -33))
+(assert_return (invoke "xor" (i32.const 32) (i32.const 2)) (i32.const 34))
+(assert_return (invoke "xor" (i32.const 32) (i32.const 3)) (i32.const 35))
+(assert_return (invoke "xor" (i32.const 32) (i32.const 0x7fffffff)) (i32.const 0x7fffffdf))
+(assert_return (invoke "xor" (i32.const 32) (i32.const 0x80000000)) (i32.const 0x80000020))
+(assert_return (invoke "xor" (i32.const 32) (i32.const 0x80000001)) (i32.const 0x80000021))
+(assert_return (invoke "xor" (i32.const 32) (i32.const 0x40000000)) (i32.const 0x40000020))
+(assert_return (invoke "xor" (i32.const 32) (i32.const 0x12345678)) (i32.const 0x12345658))
+(assert_return (invoke "xor" (i32.const 32) (i32.const 0xedcba988)) (i32.const 0xedcba9a8))
+(assert_return (invoke "xor" (i32.const 32) (i32.const 31)) (i32.const 63))
+(assert_return (invoke "xor" (i32.const 32) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "xor" (i32.const 32) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "xor" (i32.const 32) (i32.const 0xfff5a5a)) (i32.const 0xfff5a7a))
+(assert_return (invoke "xor" (i32.const 33) (i32.const 0)) (i32.const 33))
+(assert_return (invoke "xor" (i32.const 33) (i32.const 1)) (i32.const 32))
+(assert_return (invoke "xor" (i32.const 33) (i32.const -1)) (i32.const -34))
+(assert_return (invoke "xor" (i32.const 33) (i32.const 2)) (i32.const 35))
+(assert_return (invoke "xor" (i32.const 33) (i32.const 3)) (i32.const 34))
+(assert_return (invoke "xor" (i32.const 33) (i32.const 0x7fffffff)) (i32.const 0x7fffffde))
+(assert_return (invoke "xor" (i32.const 33) (i32.const 0x80000000)) (i32.const 0x80000021))
+(assert_return (invoke "xor" (i32.const 33) (i32.const 0x80000001)) (i32.const 0x80000020))
+(assert_return (invoke "xor" (i32.const 33) (i32.const 0x40000000)) (i32.const 0x40000021))
+(assert_return (invoke "xor" (i32.const 33) (i32.const 0x12345678)) (i32.const 0x12345659))
+(assert_return (invoke "xor" (i32.const 33) (i32.const 0xedcba988)) (i32.const 0xedcba9a9))
+(assert_return (invoke "xor" (i32.const 33) (i32.const 31)) (i32.const 62))
+(assert_return (invoke "xor" (i32.const 33) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "xor" (i32.const 33) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "xor" (i32.const 33) (i32.const 0xfff5a5a)) (i32.const 0xfff5a7b))
+(assert_return (invoke "xor" (i32.const 0xfff5a5a) (i32.const 0)) (i32.const 0xfff5a5a))
+(assert_return (invoke "xor" (i32.const 0xfff5a5a) (i32.const 1)) (i32.const 0xfff5a5b))
+(assert_return (invoke "xor" (i32.const 0xfff5a5a) (i32.const -1)) (i32.const 0xf000a5a5))
+(assert_return (invoke "xor" (i32.const 0xfff5a5a) (i32.const 2)) (i32.const 0xfff5a58))
+(assert_return (invoke "xor" (i32.const 0xfff5a5a) (i32.const 3)) (i32.const 0xfff5a59))
+(assert_return (invoke "xor" (i32.const 0xfff5a5a) (i32.const 0x7fffffff)) (i32.const 0x7000a5a5))
+(assert_return (invoke "xor" (i32.const 0xfff5a5a) (i32.const 0x80000000)) (i32.const 0x8fff5a5a))
+(assert_return (invoke "xor" (i32.const 0xfff5a5a) (i32.const 0x80000001)) (i32.const 0x8fff5a5b))
+(assert_return (invoke "xor" (i32.const 0xfff5a5a) (i32.const 0x40000000)) (i32.const 0x4fff5a5a))
+(assert_return (invoke "xor" (i32.const 0xfff5a5a) (i32.const 0x12345678)) (i32.const 0x1dcb0c22))
+(assert_return (invoke "xor" (i32.const 0xfff5a5a) (i32.const 0xedcba988)) (i32.const 0xe234f3d2))
+(assert_return (invoke "xor" (i32.const 0xfff5a5a) (i32.const 31)) (i32.const 0xfff5a45))
+(assert_return (invoke "xor" (i32.const 0xfff5a5a) (i32.const 32)) (i32.const 0xfff5a7a))
+(assert_return (invoke "xor" (i32.const 0xfff5a5a) (i32.const 33)) (i32.const 0xfff5a7b))
+(assert_return (invoke "xor" (i32.const 0xfff5a5a) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "xor" (i32.const 0x81f4f45e) (i32.const 0x59468142)) (i32.const 0xd8b2751c))
+(assert_return (invoke "xor" (i32.const 0x2f7d96d4) (i32.const 0x9da56d86)) (i32.const 0xb2d8fb52))
+(assert_return (invoke "xor" (i32.const 0xfc0630d3) (i32.const 0x257161d)) (i32.const 0xfe5126ce))
+(assert_return (invoke "xor" (i32.const 0x9ee6d59b) (i32.const 0xb0d6a820)) (i32.const 0x2e307dbb))
+(assert_return (invoke "xor" (i32.const 0x5d7ae457) (i32.const 0x2efcce6)) (i32.const 0x5f9528b1))
+(assert_return (invoke "xor" (i32.const 0x5a0cc7f) (i32.const 0x8fb80165)) (i32.const 0x8a18cd1a))
+(assert_return (invoke "xor" (i32.const 0x82a803ce) (i32.const 0x6b9650e)) (i32.const 0x841166c0))
+(assert_return (invoke "xor" (i32.const 0xc081f538) (i32.const 0xf13e9577)) (i32.const 0x31bf604f))
+(assert_return (invoke "xor" (i32.const 0x2d88396c) (i32.const 0x1b905fe9)) (i32.const 0x36186685))
+(assert_return (invoke "xor" (i32.const 0x1655b8b5) (i32.const 0xae7c7fe6)) (i32.const 0xb829c753))
+(assert_return (invoke "xor" (i32.const 0xb95f3fd2) (i32.const 0xa38a4d0f)) (i32.const 0x1ad572dd))
+(assert_return (invoke "xor" (i32.const 0xeb9ea58b) (i32.const 0x39f0ed71)) (i32.const 0xd26e48fa))
+(assert_return (invoke "xor" (i32.const 0x607e60dd) (i32.const 0x914d6edb)) (i32.const 0xf1330e06))
+(assert_return (invoke "xor" (i32.const 0x7914ecd4) (i32.const 0xd9afed3)) (i32.const 0x748e1207))
+(assert_return (invoke "xor" (i32.const 0xa2ec70c9) (i32.const 0xa4921f51)) (i32.const 0x67e6f98))
+(assert_return (invoke "xor" (i32.const 0x851d3be0) (i32.const 0xe2e4b9c3)) (i32.const 0x67f98223))
+
+(assert_return (invoke "shl" (i32.const 0) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "shl" (i32.const 0) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "shl" (i32.const 0) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "shl" (i32.const 0) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "shl" (i32.const 0) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "shl" (i32.const 0) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "shl" (i32.const 0) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "shl" (i32.const 0) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "shl" (i32.const 0) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "shl" (i32.const 0) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "shl" (i32.const 0) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "shl" (i32.const 0) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "shl" (i32.const 0) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "shl" (i32.const 0) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "shl" (i32.const 0) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "shl" (i32.const 1) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "shl" (i32.const 1) (i32.const 1)) (i32.const 2))
+(assert_return (invoke "shl" (i32.const 1) (i32.const -1)) (i32.const 0x80000000))
+(assert_return (invoke "shl" (i32.const 1) (i32.const 2)) (i32.const 4))
+(assert_return (invoke "shl" (i32.const 1) (i32.const 3)) (i32.const 8))
+(assert_return (invoke "shl" (i32.const 1) (i32.const 0x7fffffff)) (i32.const 0x80000000))
+(assert_return (invoke "shl" (i32.const 1) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "shl" (i32.const 1) (i32.const 0x80000001)) (i32.const 2))
+(assert_return (invoke "shl" (i32.const 1) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "shl" (i32.const 1) (i32.const 0x12345678)) (i32.const 0x1000000))
+(assert_return (invoke "shl" (i32.const 1) (i32.const 0xedcba988)) (i32.const 256))
+(assert_return (invoke "shl" (i32.const 1) (i32.const 31)) (i32.const 0x80000000))
+(assert_return (invoke "shl" (i32.const 1) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "shl" (i32.const 1) (i32.const 33)) (i32.const 2))
+(assert_return (invoke "shl" (i32.const 1) (i32.const 0xfff5a5a)) (i32.const 0x4000000))
+(assert_return (invoke "shl" (i32.const -1) (i32.const 0)) (i32.const -1))
+(assert_return (invoke "shl" (i32.const -1) (i32.const 1)) (i32.const -2))
+(assert_return (invoke "shl" (i32.const -1) (i32.const -1)) (i32.const 0x80000000))
+(assert_return (invoke "shl" (i32.const -1) (i32.const 2)) (i32.const -4))
+(assert_return (invoke "shl" (i32.const -1) (i32.const 3)) (i32.const -8))
+(assert_return (invoke "shl" (i32.const -1) (i32.const 0x7fffffff)) (i32.const 0x80000000))
+(assert_return (invoke "shl" (i32.const -1) (i32.const 0x80000000)) (i32.const -1))
+(assert_return (invoke "shl" (i32.const -1) (i32.const 0x80000001)) (i32.const -2))
+(assert_return (invoke "shl" (i32.const -1) (i32.const 0x40000000)) (i32.const -1))
+(assert_return (invoke "shl" (i32.const -1) (i32.const 0x12345678)) (i32.const 0xff000000))
+(assert_return (invoke "shl" (i32.const -1) (i32.const 0xedcba988)) (i32.const -256))
+(assert_return (invoke "shl" (i32.const -1) (i32.const 31)) (i32.const 0x80000000))
+(assert_return (invoke "shl" (i32.const -1) (i32.const 32)) (i32.const -1))
+(assert_return (invoke "shl" (i32.const -1) (i32.const 33)) (i32.const -2))
+(assert_return (invoke "shl" (i32.const -1) (i32.const 0xfff5a5a)) (i32.const 0xfc000000))
+(assert_return (invoke "shl" (i32.const 2) (i32.const 0)) (i32.const 2))
+(assert_return (invoke "shl" (i32.const 2) (i32.const 1)) (i32.const 4))
+(assert_return (invoke "shl" (i32.const 2) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "shl" (i32.const 2) (i32.const 2)) (i32.const 8))
+(assert_return (invoke "shl" (i32.const 2) (i32.const 3)) (i32.const 16))
+(assert_return (invoke "shl" (i32.const 2) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "shl" (i32.const 2) (i32.const 0x80000000)) (i32.const 2))
+(assert_return (invoke "shl" (i32.const 2) (i32.const 0x80000001)) (i32.const 4))
+(assert_return (invoke "shl" (i32.const 2) (i32.const 0x40000000)) (i32.const 2))
+(assert_return (invoke "shl" (i32.const 2) (i32.const 0x12345678)) (i32.const 0x2000000))
+(assert_return (invoke "shl" (i32.const 2) (i32.const 0xedcba988)) (i32.const 512))
+(assert_return (invoke "shl" (i32.const 2) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "shl" (i32.const 2) (i32.const 32)) (i32.const 2))
+(assert_return (invoke "shl" (i32.const 2) (i32.const 33)) (i32.const 4))
+(assert_return (invoke "shl" (i32.const 2) (i32.const 0xfff5a5a)) (i32.const 0x8000000))
+(assert_return (invoke "shl" (i32.const 3) (i32.const 0)) (i32.const 3))
+(assert_return (invoke "shl" (i32.const 3) (i32.const 1)) (i32.const 6))
+(assert_return (invoke "shl" (i32.const 3) (i32.const -1)) (i32.const 0x80000000))
+(assert_return (invoke "shl" (i32.const 3) (i32.const 2)) (i32.const 12))
+(assert_return (invoke "shl" (i32.const 3) (i32.const 3)) (i32.const 24))
+(assert_return (invoke "shl" (i32.const 3) (i32.const 0x7fffffff)) (i32.const 0x80000000))
+(assert_return (invoke "shl" (i32.const 3) (i32.const 0x80000000)) (i32.const 3))
+(assert_return (invoke "shl" (i32.const 3) (i32.const 0x80000001)) (i32.const 6))
+(assert_return (invoke "shl" (i32.const 3) (i32.const 0x40000000)) (i32.const 3))
+(assert_return (invoke "shl" (i32.const 3) (i32.const 0x12345678)) (i32.const 0x3000000))
+(assert_return (invoke "shl" (i32.const 3) (i32.const 0xedcba988)) (i32.const 768))
+(assert_return (invoke "shl" (i32.const 3) (i32.const 31)) (i32.const 0x80000000))
+(assert_return (invoke "shl" (i32.const 3) (i32.const 32)) (i32.const 3))
+(assert_return (invoke "shl" (i32.const 3) (i32.const 33)) (i32.const 6))
+(assert_return (invoke "shl" (i32.const 3) (i32.const 0xfff5a5a)) (i32.const 0xc000000))
+(assert_return (invoke "shl" (i32.const 0x7fffffff) (i32.const 0)) (i32.const 0x7fffffff))
+(assert_return (invoke "shl" (i32.const 0x7fffffff) (i32.const 1)) (i32.const -2))
+(assert_return (invoke "shl" (i32.const 0x7fffffff) (i32.const -1)) (i32.const 0x80000000))
+(assert_return (invoke "shl" (i32.const 0x7fffffff) (i32.const 2)) (i32.const -4))
+(assert_return (invoke "shl" (i32.const 0x7fffffff) (i32.const 3)) (i32.const -8))
+(assert_return (invoke "shl" (i32.const 0x7fffffff) (i32.const 0x7fffffff)) (i32.const 0x80000000))
+(assert_return (invoke "shl" (i32.const 0x7fffffff) (i32.const 0x80000000)) (i32.const 0x7fffffff))
+(assert_return (invoke "shl" (i32.const 0x7fffffff) (i32.const 0x80000001)) (i32.const -2))
+(assert_return (invoke "shl" (i32.const 0x7fffffff) (i32.const 0x40000000)) (i32.const 0x7fffffff))
+(assert_return (invoke "shl" (i32.const 0x7fffffff) (i32.const 0x12345678)) (i32.const 0xff000000))
+(assert_return (invoke "shl" (i32.const 0x7fffffff) (i32.const 0xedcba988)) (i32.const -256))
+(assert_return (invoke "shl" (i32.const 0x7fffffff) (i32.const 31)) (i32.const 0x80000000))
+(assert_return (invoke "shl" (i32.const 0x7fffffff) (i32.const 32)) (i32.const 0x7fffffff))
+(assert_return (invoke "shl" (i32.const 0x7fffffff) (i32.const 33)) (i32.const -2))
+(assert_return (invoke "shl" (i32.const 0x7fffffff) (i32.const 0xfff5a5a)) (i32.const 0xfc000000))
+(assert_return (invoke "shl" (i32.const 0x80000000) (i32.const 0)) (i32.const 0x80000000))
+(assert_return (invoke "shl" (i32.const 0x80000000) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "shl" (i32.const 0x80000000) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "shl" (i32.const 0x80000000) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "shl" (i32.const 0x80000000) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "shl" (i32.const 0x80000000) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "shl" (i32.const 0x80000000) (i32.const 0x80000000)) (i32.const 0x80000000))
+(assert_return (invoke "shl" (i32.const 0x80000000) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "shl" (i32.const 0x80000000) (i32.const 0x40000000)) (i32.const 0x80000000))
+(assert_return (invoke "shl" (i32.const 0x80000000) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "shl" (i32.const 0x80000000) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "shl" (i32.const 0x80000000) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "shl" (i32.const 0x80000000) (i32.const 32)) (i32.const 0x80000000))
+(assert_return (invoke "shl" (i32.const 0x80000000) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "shl" (i32.const 0x80000000) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "shl" (i32.const 0x80000001) (i32.const 0)) (i32.const 0x80000001))
+(assert_return (invoke "shl" (i32.const 0x80000001) (i32.const 1)) (i32.const 2))
+(assert_return (invoke "shl" (i32.const 0x80000001) (i32.const -1)) (i32.const 0x80000000))
+(assert_return (invoke "shl" (i32.const 0x80000001) (i32.const 2)) (i32.const 4))
+(assert_return (invoke "shl" (i32.const 0x80000001) (i32.const 3)) (i32.const 8))
+(assert_return (invoke "shl" (i32.const 0x80000001) (i32.const 0x7fffffff)) (i32.const 0x80000000))
+(assert_return (invoke "shl" (i32.const 0x80000001) (i32.const 0x80000000)) (i32.const 0x80000001))
+(assert_return (invoke "shl" (i32.const 0x80000001) (i32.const 0x80000001)) (i32.const 2))
+(assert_return (invoke "shl" (i32.const 0x80000001) (i32.const 0x40000000)) (i32.const 0x80000001))
+(assert_return (invoke "shl" (i32.const 0x80000001) (i32.const 0x12345678)) (i32.const 0x1000000))
+(assert_return (invoke "shl" (i32.const 0x80000001) (i32.const 0xedcba988)) (i32.const 256))
+(assert_return (invoke "shl" (i32.const 0x80000001) (i32.const 31)) (i32.const 0x80000000))
+(assert_return (invoke "shl" (i32.const 0x80000001) (i32.const 32)) (i32.const 0x80000001))
+(assert_return (invoke "shl" (i32.const 0x80000001) (i32.const 33)) (i32.const 2))
+(assert_return (invoke "shl" (i32.const 0x80000001) (i32.const 0xfff5a5a)) (i32.const 0x4000000))
+(assert_return (invoke "shl" (i32.const 0x40000000) (i32.const 0)) (i32.const 0x40000000))
+(assert_return (invoke "shl" (i32.const 0x40000000) (i32.const 1)) (i32.const 0x80000000))
+(assert_return (invoke "shl" (i32.const 0x40000000) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "shl" (i32.const 0x40000000) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "shl" (i32.const 0x40000000) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "shl" (i32.const 0x40000000) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "shl" (i32.const 0x40000000) (i32.const 0x80000000)) (i32.const 0x40000000))
+(assert_return (invoke "shl" (i32.const 0x40000000) (i32.const 0x80000001)) (i32.const 0x80000000))
+(assert_return (invoke "shl" (i32.const 0x40000000) (i32.const 0x40000000)) (i32.const 0x40000000))
+(assert_return (invoke "shl" (i32.const 0x40000000) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "shl" (i32.const 0x40000000) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "shl" (i32.const 0x40000000) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "shl" (i32.const 0x40000000) (i32.const 32)) (i32.const 0x40000000))
+(assert_return (invoke "shl" (i32.const 0x40000000) (i32.const 33)) (i32.const 0x80000000))
+(assert_return (invoke "shl" (i32.const 0x40000000) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "shl" (i32.const 0x12345678) (i32.const 0)) (i32.const 0x12345678))
+(assert_return (invoke "shl" (i32.const 0x12345678) (i32.const 1)) (i32.const 0x2468acf0))
+(assert_return (invoke "shl" (i32.const 0x12345678) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "shl" (i32.const 0x12345678) (i32.const 2)) (i32.const 0x48d159e0))
+(assert_return (invoke "shl" (i32.const 0x12345678) (i32.const 3)) (i32.const 0x91a2b3c0))
+(assert_return (invoke "shl" (i32.const 0x12345678) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "shl" (i32.const 0x12345678) (i32.const 0x80000000)) (i32.const 0x12345678))
+(assert_return (invoke "shl" (i32.const 0x12345678) (i32.const 0x80000001)) (i32.const 0x2468acf0))
+(assert_return (invoke "shl" (i32.const 0x12345678) (i32.const 0x40000000)) (i32.const 0x12345678))
+(assert_return (invoke "shl" (i32.const 0x12345678) (i32.const 0x12345678)) (i32.const 0x78000000))
+(assert_return (invoke "shl" (i32.const 0x12345678) (i32.const 0xedcba988)) (i32.const 0x34567800))
+(assert_return (invoke "shl" (i32.const 0x12345678) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "shl" (i32.const 0x12345678) (i32.const 32)) (i32.const 0x12345678))
+(assert_return (invoke "shl" (i32.const 0x12345678) (i32.const 33)) (i32.const 0x2468acf0))
+(assert_return (invoke "shl" (i32.const 0x12345678) (i32.const 0xfff5a5a)) (i32.const 0xe0000000))
+(assert_return (invoke "shl" (i32.const 0xedcba988) (i32.const 0)) (i32.const 0xedcba988))
+(assert_return (invoke "shl" (i32.const 0xedcba988) (i32.const 1)) (i32.const 0xdb975310))
+(assert_return (invoke "shl" (i32.const 0xedcba988) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "shl" (i32.const 0xedcba988) (i32.const 2)) (i32.const 0xb72ea620))
+(assert_return (invoke "shl" (i32.const 0xedcba988) (i32.const 3)) (i32.const 0x6e5d4c40))
+(assert_return (invoke "shl" (i32.const 0xedcba988) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "shl" (i32.const 0xedcba988) (i32.const 0x80000000)) (i32.const 0xedcba988))
+(assert_return (invoke "shl" (i32.const 0xedcba988) (i32.const 0x80000001)) (i32.const 0xdb975310))
+(assert_return (invoke "shl" (i32.const 0xedcba988) (i32.const 0x40000000)) (i32.const 0xedcba988))
+(assert_return (invoke "shl" (i32.const 0xedcba988) (i32.const 0x12345678)) (i32.const 0x88000000))
+(assert_return (invoke "shl" (i32.const 0xedcba988) (i32.const 0xedcba988)) (i32.const 0xcba98800))
+(assert_return (invoke "shl" (i32.const 0xedcba988) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "shl" (i32.const 0xedcba988) (i32.const 32)) (i32.const 0xedcba988))
+(assert_return (invoke "shl" (i32.const 0xedcba988) (i32.const 33)) (i32.const 0xdb975310))
+(assert_return (invoke "shl" (i32.const 0xedcba988) (i32.const 0xfff5a5a)) (i32.const 0x20000000))
+(assert_return (invoke "shl" (i32.const 31) (i32.const 0)) (i32.const 31))
+(assert_return (invoke "shl" (i32.const 31) (i32.const 1)) (i32.const 62))
+(assert_return (invoke "shl" (i32.const 31) (i32.const -1)) (i32.const 0x80000000))
+(assert_return (invoke "shl" (i32.const 31) (i32.const 2)) (i32.const 124))
+(assert_return (invoke "shl" (i32.const 31) (i32.const 3)) (i32.const 248))
+(assert_return (invoke "shl" (i32.const 31) (i32.const 0x7fffffff)) (i32.const 0x80000000))
+(assert_return (invoke "shl" (i32.const 31) (i32.const 0x80000000)) (i32.const 31))
+(assert_return (invoke "shl" (i32.const 31) (i32.const 0x80000001)) (i32.const 62))
+(assert_return (invoke "shl" (i32.const 31) (i32.const 0x40000000)) (i32.const 31))
+(assert_return (invoke "shl" (i32.const 31) (i32.const 0x12345678)) (i32.const 0x1f000000))
+(assert_return (invoke "shl" (i32.const 31) (i32.const 0xedcba988)) (i32.const 7936))
+(assert_return (invoke "shl" (i32.const 31) (i32.const 31)) (i32.const 0x80000000))
+(assert_return (invoke "shl" (i32.const 31) (i32.const 32)) (i32.const 31))
+(assert_return (invoke "shl" (i32.const 31) (i32.const 33)) (i32.const 62))
+(assert_return (invoke "shl" (i32.const 31) (i32.const 0xfff5a5a)) (i32.const 0x7c000000))
+(assert_return (invoke "shl" (i32.const 32) (i32.const 0)) (i32.const 32))
+(assert_return (invoke "shl" (i32.const 32) (i32.const 1)) (i32.const 64))
+(assert_return (invoke "shl" (i32.const 32) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "shl" (i32.const 32) (i32.const 2)) (i32.const 128))
+(assert_return (invoke "shl" (i32.const 32) (i32.const 3)) (i32.const 256))
+(assert_return (invoke "shl" (i32.const 32) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "shl" (i32.const 32) (i32.const 0x80000000)) (i32.const 32))
+(assert_return (invoke "shl" (i32.const 32) (i32.const 0x80000001)) (i32.const 64))
+(assert_return (invoke "shl" (i32.const 32) (i32.const 0x40000000)) (i32.const 32))
+(assert_return (invoke "shl" (i32.const 32) (i32.const 0x12345678)) (i32.const 0x20000000))
+(assert_return (invoke "shl" (i32.const 32) (i32.const 0xedcba988)) (i32.const 8192))
+(assert_return (invoke "shl" (i32.const 32) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "shl" (i32.const 32) (i32.const 32)) (i32.const 32))
+(assert_return (invoke "shl" (i32.const 32) (i32.const 33)) (i32.const 64))
+(assert_return (invoke "shl" (i32.const 32) (i32.const 0xfff5a5a)) (i32.const 0x80000000))
+(assert_return (invoke "shl" (i32.const 33) (i32.const 0)) (i32.const 33))
+(assert_return (invoke "shl" (i32.const 33) (i32.const 1)) (i32.const 66))
+(assert_return (invoke "shl" (i32.const 33) (i32.const -1)) (i32.const 0x80000000))
+(assert_return (invoke "shl" (i32.const 33) (i32.const 2)) (i32.const 132))
+(assert_return (invoke "shl" (i32.const 33) (i32.const 3)) (i32.const 264))
+(assert_return (invoke "shl" (i32.const 33) (i32.const 0x7fffffff)) (i32.const 0x80000000))
+(assert_return (invoke "shl" (i32.const 33) (i32.const 0x80000000)) (i32.const 33))
+(assert_return (invoke "shl" (i32.const 33) (i32.const 0x80000001)) (i32.const 66))
+(assert_return (invoke "shl" (i32.const 33) (i32.const 0x40000000)) (i32.const 33))
+(assert_return (invoke "shl" (i32.const 33) (i32.const 0x12345678)) (i32.const 0x21000000))
+(assert_return (invoke "shl" (i32.const 33) (i32.const 0xedcba988)) (i32.const 8448))
+(assert_return (invoke "shl" (i32.const 33) (i32.const 31)) (i32.const 0x80000000))
+(assert_return (invoke "shl" (i32.const 33) (i32.const 32)) (i32.const 33))
+(assert_return (invoke "shl" (i32.const 33) (i32.const 33)) (i32.const 66))
+(assert_return (invoke "shl" (i32.const 33) (i32.const 0xfff5a5a)) (i32.const 0x84000000))
+(assert_return (invoke "shl" (i32.const 0xfff5a5a) (i32.const 0)) (i32.const 0xfff5a5a))
+(assert_return (invoke "shl" (i32.const 0xfff5a5a) (i32.const 1)) (i32.const 0x1ffeb4b4))
+(assert_return (invoke "shl" (i32.const 0xfff5a5a) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "shl" (i32.const 0xfff5a5a) (i32.const 2)) (i32.const 0x3ffd6968))
+(assert_return (invoke "shl" (i32.const 0xfff5a5a) (i32.const 3)) (i32.const 0x7ffad2d0))
+(assert_return (invoke "shl" (i32.const 0xfff5a5a) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "shl" (i32.const 0xfff5a5a) (i32.const 0x80000000)) (i32.const 0xfff5a5a))
+(assert_return (invoke "shl" (i32.const 0xfff5a5a) (i32.const 0x80000001)) (i32.const 0x1ffeb4b4))
+(assert_return (invoke "shl" (i32.const 0xfff5a5a) (i32.const 0x40000000)) (i32.const 0xfff5a5a))
+(assert_return (invoke "shl" (i32.const 0xfff5a5a) (i32.const 0x12345678)) (i32.const 0x5a000000))
+(assert_return (invoke "shl" (i32.const 0xfff5a5a) (i32.const 0xedcba988)) (i32.const 0xff5a5a00))
+(assert_return (invoke "shl" (i32.const 0xfff5a5a) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "shl" (i32.const 0xfff5a5a) (i32.const 32)) (i32.const 0xfff5a5a))
+(assert_return (invoke "shl" (i32.const 0xfff5a5a) (i32.const 33)) (i32.const 0x1ffeb4b4))
+(assert_return (invoke "shl" (i32.const 0xfff5a5a) (i32.const 0xfff5a5a)) (i32.const 0x68000000))
+(assert_return (invoke "shl" (i32.const 0x20dbeb0a) (i32.const 0x72818d9a)) (i32.const 0x28000000))
+(assert_return (invoke "shl" (i32.const 0x46612dc7) (i32.const 0xb444714e)) (i32.const 0x4b71c000))
+(assert_return (invoke "shl" (i32.const 0x34c27c65) (i32.const 0xde7fdf0f)) (i32.const 0x3e328000))
+(assert_return (invoke "shl" (i32.const 0x327c3bc6) (i32.const 0x4dea4811)) (i32.const 0x778c0000))
+(assert_return (invoke "shl" (i32.const 0x1693bd96) (i32.const 0xa84b83fa)) (i32.const 0x58000000))
+(assert_return (invoke "shl" (i32.const 0x642543fc) (i32.const 0xc1bb8723)) (i32.const 0x212a1fe0))
+(assert_return (invoke "shl" (i32.const 0x382096b9) (i32.const 0xd70843f6)) (i32.const 0xae400000))
+(assert_return (invoke "shl" (i32.const 0xd8f94206) (i32.const 0x9fa2e7e)) (i32.const 0x80000000))
+(assert_return (invoke "shl" (i32.const 0x526f8e0d) (i32.const 0x721e7ea0)) (i32.const 0x526f8e0d))
+(assert_return (invoke "shl" (i32.const 0xf693372b) (i32.const 0x8db8eb44)) (i32.const 0x693372b0))
+(assert_return (invoke "shl" (i32.const 0xcfa1786a) (i32.const 0xe010cac2)) (i32.const 0x3e85e1a8))
+(assert_return (invoke "shl" (i32.const 0x44eca05e) (i32.const 0xa658588d)) (i32.const 0x940bc000))
+(assert_return (invoke "shl" (i32.const 0x27755d7b) (i32.const 0xd780f969)) (i32.const 0xeabaf600))
+(assert_return (invoke "shl" (i32.const 0x92759626) (i32.const 0x36cd144b)) (i32.const 0xacb13000))
+(assert_return (invoke "shl" (i32.const 0x79583b0e) (i32.const 0x4d5c6002)) (i32.const 0xe560ec38))
+(assert_return (invoke "shl" (i32.const 0x88f5fe8d) (i32.const 0xf11d051e)) (i32.const 0x40000000))
+
+(assert_return (invoke "shr_s" (i32.const 0) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 0) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 0) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 0) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 0) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 0) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 0) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 0) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 0) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 0) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 0) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 0) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 0) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 0) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 0) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 1) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "shr_s" (i32.const 1) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 1) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 1) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 1) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 1) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 1) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "shr_s" (i32.const 1) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 1) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "shr_s" (i32.const 1) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 1) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 1) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 1) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "shr_s" (i32.const 1) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 1) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const -1) (i32.const 0)) (i32.const -1))
+(assert_return (invoke "shr_s" (i32.const -1) (i32.const 1)) (i32.const -1))
+(assert_return (invoke "shr_s" (i32.const -1) (i32.const -1)) (i32.const -1))
+(assert_return (invoke "shr_s" (i32.const -1) (i32.const 2)) (i32.const -1))
+(assert_return (invoke "shr_s" (i32.const -1) (i32.const 3)) (i32.const -1))
+(assert_return (invoke "shr_s" (i32.const -1) (i32.const 0x7fffffff)) (i32.const -1))
+(assert_return (invoke "shr_s" (i32.const -1) (i32.const 0x80000000)) (i32.const -1))
+(assert_return (invoke "shr_s" (i32.const -1) (i32.const 0x80000001)) (i32.const -1))
+(assert_return (invoke "shr_s" (i32.const -1) (i32.const 0x40000000)) (i32.const -1))
+(assert_return (invoke "shr_s" (i32.const -1) (i32.const 0x12345678)) (i32.const -1))
+(assert_return (invoke "shr_s" (i32.const -1) (i32.const 0xedcba988)) (i32.const -1))
+(assert_return (invoke "shr_s" (i32.const -1) (i32.const 31)) (i32.const -1))
+(assert_return (invoke "shr_s" (i32.const -1) (i32.const 32)) (i32.const -1))
+(assert_return (invoke "shr_s" (i32.const -1) (i32.const 33)) (i32.const -1))
+(assert_return (invoke "shr_s" (i32.const -1) (i32.const 0xfff5a5a)) (i32.const -1))
+(assert_return (invoke "shr_s" (i32.const 2) (i32.const 0)) (i32.const 2))
+(assert_return (invoke "shr_s" (i32.const 2) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "shr_s" (i32.const 2) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 2) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 2) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 2) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 2) (i32.const 0x80000000)) (i32.const 2))
+(assert_return (invoke "shr_s" (i32.const 2) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "shr_s" (i32.const 2) (i32.const 0x40000000)) (i32.const 2))
+(assert_return (invoke "shr_s" (i32.const 2) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 2) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 2) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 2) (i32.const 32)) (i32.const 2))
+(assert_return (invoke "shr_s" (i32.const 2) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "shr_s" (i32.const 2) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 3) (i32.const 0)) (i32.const 3))
+(assert_return (invoke "shr_s" (i32.const 3) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "shr_s" (i32.const 3) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 3) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 3) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 3) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 3) (i32.const 0x80000000)) (i32.const 3))
+(assert_return (invoke "shr_s" (i32.const 3) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "shr_s" (i32.const 3) (i32.const 0x40000000)) (i32.const 3))
+(assert_return (invoke "shr_s" (i32.const 3) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 3) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 3) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 3) (i32.const 32)) (i32.const 3))
+(assert_return (invoke "shr_s" (i32.const 3) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "shr_s" (i32.const 3) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 0x7fffffff) (i32.const 0)) (i32.const 0x7fffffff))
+(assert_return (invoke "shr_s" (i32.const 0x7fffffff) (i32.const 1)) (i32.const 0x3fffffff))
+(assert_return (invoke "shr_s" (i32.const 0x7fffffff) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 0x7fffffff) (i32.const 2)) (i32.const 0x1fffffff))
+(assert_return (invoke "shr_s" (i32.const 0x7fffffff) (i32.const 3)) (i32.const 0xfffffff))
+(assert_return (invoke "shr_s" (i32.const 0x7fffffff) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 0x7fffffff) (i32.const 0x80000000)) (i32.const 0x7fffffff))
+(assert_return (invoke "shr_s" (i32.const 0x7fffffff) (i32.const 0x80000001)) (i32.const 0x3fffffff))
+(assert_return (invoke "shr_s" (i32.const 0x7fffffff) (i32.const 0x40000000)) (i32.const 0x7fffffff))
+(assert_return (invoke "shr_s" (i32.const 0x7fffffff) (i32.const 0x12345678)) (i32.const 127))
+(assert_return (invoke "shr_s" (i32.const 0x7fffffff) (i32.const 0xedcba988)) (i32.const 0x7fffff))
+(assert_return (invoke "shr_s" (i32.const 0x7fffffff) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 0x7fffffff) (i32.const 32)) (i32.const 0x7fffffff))
+(assert_return (invoke "shr_s" (i32.const 0x7fffffff) (i32.const 33)) (i32.const 0x3fffffff))
+(assert_return (invoke "shr_s" (i32.const 0x7fffffff) (i32.const 0xfff5a5a)) (i32.const 31))
+(assert_return (invoke "shr_s" (i32.const 0x80000000) (i32.const 0)) (i32.const 0x80000000))
+(assert_return (invoke "shr_s" (i32.const 0x80000000) (i32.const 1)) (i32.const 0xc0000000))
+(assert_return (invoke "shr_s" (i32.const 0x80000000) (i32.const -1)) (i32.const -1))
+(assert_return (invoke "shr_s" (i32.const 0x80000000) (i32.const 2)) (i32.const 0xe0000000))
+(assert_return (invoke "shr_s" (i32.const 0x80000000) (i32.const 3)) (i32.const 0xf0000000))
+(assert_return (invoke "shr_s" (i32.const 0x80000000) (i32.const 0x7fffffff)) (i32.const -1))
+(assert_return (invoke "shr_s" (i32.const 0x80000000) (i32.const 0x80000000)) (i32.const 0x80000000))
+(assert_return (invoke "shr_s" (i32.const 0x80000000) (i32.const 0x80000001)) (i32.const 0xc0000000))
+(assert_return (invoke "shr_s" (i32.const 0x80000000) (i32.const 0x40000000)) (i32.const 0x80000000))
+(assert_return (invoke "shr_s" (i32.const 0x80000000) (i32.const 0x12345678)) (i32.const -128))
+(assert_return (invoke "shr_s" (i32.const 0x80000000) (i32.const 0xedcba988)) (i32.const 0xff800000))
+(assert_return (invoke "shr_s" (i32.const 0x80000000) (i32.const 31)) (i32.const -1))
+(assert_return (invoke "shr_s" (i32.const 0x80000000) (i32.const 32)) (i32.const 0x80000000))
+(assert_return (invoke "shr_s" (i32.const 0x80000000) (i32.const 33)) (i32.const 0xc0000000))
+(assert_return (invoke "shr_s" (i32.const 0x80000000) (i32.const 0xfff5a5a)) (i32.const -32))
+(assert_return (invoke "shr_s" (i32.const 0x80000001) (i32.const 0)) (i32.const 0x80000001))
+(assert_return (invoke "shr_s" (i32.const 0x80000001) (i32.const 1)) (i32.const 0xc0000000))
+(assert_return (invoke "shr_s" (i32.const 0x80000001) (i32.const -1)) (i32.const -1))
+(assert_return (invoke "shr_s" (i32.const 0x80000001) (i32.const 2)) (i32.const 0xe0000000))
+(assert_return (invoke "shr_s" (i32.const 0x80000001) (i32.const 3)) (i32.const 0xf0000000))
+(assert_return (invoke "shr_s" (i32.const 0x80000001) (i32.const 0x7fffffff)) (i32.const -1))
+(assert_return (invoke "shr_s" (i32.const 0x80000001) (i32.const 0x80000000)) (i32.const 0x80000001))
+(assert_return (invoke "shr_s" (i32.const 0x80000001) (i32.const 0x80000001)) (i32.const 0xc0000000))
+(assert_return (invoke "shr_s" (i32.const 0x80000001) (i32.const 0x40000000)) (i32.const 0x80000001))
+(assert_return (invoke "shr_s" (i32.const 0x80000001) (i32.const 0x12345678)) (i32.const -128))
+(assert_return (invoke "shr_s" (i32.const 0x80000001) (i32.const 0xedcba988)) (i32.const 0xff800000))
+(assert_return (invoke "shr_s" (i32.const 0x80000001) (i32.const 31)) (i32.const -1))
+(assert_return (invoke "shr_s" (i32.const 0x80000001) (i32.const 32)) (i32.const 0x80000001))
+(assert_return (invoke "shr_s" (i32.const 0x80000001) (i32.const 33)) (i32.const 0xc0000000))
+(assert_return (invoke "shr_s" (i32.const 0x80000001) (i32.const 0xfff5a5a)) (i32.const -32))
+(assert_return (invoke "shr_s" (i32.const 0x40000000) (i32.const 0)) (i32.const 0x40000000))
+(assert_return (invoke "shr_s" (i32.const 0x40000000) (i32.const 1)) (i32.const 0x20000000))
+(assert_return (invoke "shr_s" (i32.const 0x40000000) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 0x40000000) (i32.const 2)) (i32.const 0x10000000))
+(assert_return (invoke "shr_s" (i32.const 0x40000000) (i32.const 3)) (i32.const 0x8000000))
+(assert_return (invoke "shr_s" (i32.const 0x40000000) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 0x40000000) (i32.const 0x80000000)) (i32.const 0x40000000))
+(assert_return (invoke "shr_s" (i32.const 0x40000000) (i32.const 0x80000001)) (i32.const 0x20000000))
+(assert_return (invoke "shr_s" (i32.const 0x40000000) (i32.const 0x40000000)) (i32.const 0x40000000))
+(assert_return (invoke "shr_s" (i32.const 0x40000000) (i32.const 0x12345678)) (i32.const 64))
+(assert_return (invoke "shr_s" (i32.const 0x40000000) (i32.const 0xedcba988)) (i32.const 0x400000))
+(assert_return (invoke "shr_s" (i32.const 0x40000000) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 0x40000000) (i32.const 32)) (i32.const 0x40000000))
+(assert_return (invoke "shr_s" (i32.const 0x40000000) (i32.const 33)) (i32.const 0x20000000))
+(assert_return (invoke "shr_s" (i32.const 0x40000000) (i32.const 0xfff5a5a)) (i32.const 16))
+(assert_return (invoke "shr_s" (i32.const 0x12345678) (i32.const 0)) (i32.const 0x12345678))
+(assert_return (invoke "shr_s" (i32.const 0x12345678) (i32.const 1)) (i32.const 0x91a2b3c))
+(assert_return (invoke "shr_s" (i32.const 0x12345678) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 0x12345678) (i32.const 2)) (i32.const 0x48d159e))
+(assert_return (invoke "shr_s" (i32.const 0x12345678) (i32.const 3)) (i32.const 0x2468acf))
+(assert_return (invoke "shr_s" (i32.const 0x12345678) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 0x12345678) (i32.const 0x80000000)) (i32.const 0x12345678))
+(assert_return (invoke "shr_s" (i32.const 0x12345678) (i32.const 0x80000001)) (i32.const 0x91a2b3c))
+(assert_return (invoke "shr_s" (i32.const 0x12345678) (i32.const 0x40000000)) (i32.const 0x12345678))
+(assert_return (invoke "shr_s" (i32.const 0x12345678) (i32.const 0x12345678)) (i32.const 18))
+(assert_return (invoke "shr_s" (i32.const 0x12345678) (i32.const 0xedcba988)) (i32.const 0x123456))
+(assert_return (invoke "shr_s" (i32.const 0x12345678) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 0x12345678) (i32.const 32)) (i32.const 0x12345678))
+(assert_return (invoke "shr_s" (i32.const 0x12345678) (i32.const 33)) (i32.const 0x91a2b3c))
+(assert_return (invoke "shr_s" (i32.const 0x12345678) (i32.const 0xfff5a5a)) (i32.const 4))
+(assert_return (invoke "shr_s" (i32.const 0xedcba988) (i32.const 0)) (i32.const 0xedcba988))
+(assert_return (invoke "shr_s" (i32.const 0xedcba988) (i32.const 1)) (i32.const 0xf6e5d4c4))
+(assert_return (invoke "shr_s" (i32.const 0xedcba988) (i32.const -1)) (i32.const -1))
+(assert_return (invoke "shr_s" (i32.const 0xedcba988) (i32.const 2)) (i32.const 0xfb72ea62))
+(assert_return (invoke "shr_s" (i32.const 0xedcba988) (i32.const 3)) (i32.const 0xfdb97531))
+(assert_return (invoke "shr_s" (i32.const 0xedcba988) (i32.const 0x7fffffff)) (i32.const -1))
+(assert_return (invoke "shr_s" (i32.const 0xedcba988) (i32.const 0x80000000)) (i32.const 0xedcba988))
+(assert_return (invoke "shr_s" (i32.const 0xedcba988) (i32.const 0x80000001)) (i32.const 0xf6e5d4c4))
+(assert_return (invoke "shr_s" (i32.const 0xedcba988) (i32.const 0x40000000)) (i32.const 0xedcba988))
+(assert_return (invoke "shr_s" (i32.const 0xedcba988) (i32.const 0x12345678)) (i32.const -19))
+(assert_return (invoke "shr_s" (i32.const 0xedcba988) (i32.const 0xedcba988)) (i32.const 0xffedcba9))
+(assert_return (invoke "shr_s" (i32.const 0xedcba988) (i32.const 31)) (i32.const -1))
+(assert_return (invoke "shr_s" (i32.const 0xedcba988) (i32.const 32)) (i32.const 0xedcba988))
+(assert_return (invoke "shr_s" (i32.const 0xedcba988) (i32.const 33)) (i32.const 0xf6e5d4c4))
+(assert_return (invoke "shr_s" (i32.const 0xedcba988) (i32.const 0xfff5a5a)) (i32.const -5))
+(assert_return (invoke "shr_s" (i32.const 31) (i32.const 0)) (i32.const 31))
+(assert_return (invoke "shr_s" (i32.const 31) (i32.const 1)) (i32.const 15))
+(assert_return (invoke "shr_s" (i32.const 31) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 31) (i32.const 2)) (i32.const 7))
+(assert_return (invoke "shr_s" (i32.const 31) (i32.const 3)) (i32.const 3))
+(assert_return (invoke "shr_s" (i32.const 31) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 31) (i32.const 0x80000000)) (i32.const 31))
+(assert_return (invoke "shr_s" (i32.const 31) (i32.const 0x80000001)) (i32.const 15))
+(assert_return (invoke "shr_s" (i32.const 31) (i32.const 0x40000000)) (i32.const 31))
+(assert_return (invoke "shr_s" (i32.const 31) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 31) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 31) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 31) (i32.const 32)) (i32.const 31))
+(assert_return (invoke "shr_s" (i32.const 31) (i32.const 33)) (i32.const 15))
+(assert_return (invoke "shr_s" (i32.const 31) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 32) (i32.const 0)) (i32.const 32))
+(assert_return (invoke "shr_s" (i32.const 32) (i32.const 1)) (i32.const 16))
+(assert_return (invoke "shr_s" (i32.const 32) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 32) (i32.const 2)) (i32.const 8))
+(assert_return (invoke "shr_s" (i32.const 32) (i32.const 3)) (i32.const 4))
+(assert_return (invoke "shr_s" (i32.const 32) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 32) (i32.const 0x80000000)) (i32.const 32))
+(assert_return (invoke "shr_s" (i32.const 32) (i32.const 0x80000001)) (i32.const 16))
+(assert_return (invoke "shr_s" (i32.const 32) (i32.const 0x40000000)) (i32.const 32))
+(assert_return (invoke "shr_s" (i32.const 32) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 32) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 32) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 32) (i32.const 32)) (i32.const 32))
+(assert_return (invoke "shr_s" (i32.const 32) (i32.const 33)) (i32.const 16))
+(assert_return (invoke "shr_s" (i32.const 32) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 33) (i32.const 0)) (i32.const 33))
+(assert_return (invoke "shr_s" (i32.const 33) (i32.const 1)) (i32.const 16))
+(assert_return (invoke "shr_s" (i32.const 33) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 33) (i32.const 2)) (i32.const 8))
+(assert_return (invoke "shr_s" (i32.const 33) (i32.const 3)) (i32.const 4))
+(assert_return (invoke "shr_s" (i32.const 33) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 33) (i32.const 0x80000000)) (i32.const 33))
+(assert_return (invoke "shr_s" (i32.const 33) (i32.const 0x80000001)) (i32.const 16))
+(assert_return (invoke "shr_s" (i32.const 33) (i32.const 0x40000000)) (i32.const 33))
+(assert_return (invoke "shr_s" (i32.const 33) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 33) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 33) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 33) (i32.const 32)) (i32.const 33))
+(assert_return (invoke "shr_s" (i32.const 33) (i32.const 33)) (i32.const 16))
+(assert_return (invoke "shr_s" (i32.const 33) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 0xfff5a5a) (i32.const 0)) (i32.const 0xfff5a5a))
+(assert_return (invoke "shr_s" (i32.const 0xfff5a5a) (i32.const 1)) (i32.const 0x7ffad2d))
+(assert_return (invoke "shr_s" (i32.const 0xfff5a5a) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 0xfff5a5a) (i32.const 2)) (i32.const 0x3ffd696))
+(assert_return (invoke "shr_s" (i32.const 0xfff5a5a) (i32.const 3)) (i32.const 0x1ffeb4b))
+(assert_return (invoke "shr_s" (i32.const 0xfff5a5a) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 0xfff5a5a) (i32.const 0x80000000)) (i32.const 0xfff5a5a))
+(assert_return (invoke "shr_s" (i32.const 0xfff5a5a) (i32.const 0x80000001)) (i32.const 0x7ffad2d))
+(assert_return (invoke "shr_s" (i32.const 0xfff5a5a) (i32.const 0x40000000)) (i32.const 0xfff5a5a))
+(assert_return (invoke "shr_s" (i32.const 0xfff5a5a) (i32.const 0x12345678)) (i32.const 15))
+(assert_return (invoke "shr_s" (i32.const 0xfff5a5a) (i32.const 0xedcba988)) (i32.const 0xfff5a))
+(assert_return (invoke "shr_s" (i32.const 0xfff5a5a) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 0xfff5a5a) (i32.const 32)) (i32.const 0xfff5a5a))
+(assert_return (invoke "shr_s" (i32.const 0xfff5a5a) (i32.const 33)) (i32.const 0x7ffad2d))
+(assert_return (invoke "shr_s" (i32.const 0xfff5a5a) (i32.const 0xfff5a5a)) (i32.const 3))
+(assert_return (invoke "shr_s" (i32.const 0x1f8a3d97) (i32.const 0x6f502c9)) (i32.const 0xfc51e))
+(assert_return (invoke "shr_s" (i32.const 0x87f9ac3e) (i32.const 0x5ec9485e)) (i32.const -2))
+(assert_return (invoke "shr_s" (i32.const 0xc260b713) (i32.const 0xc7d2d552)) (i32.const -3944))
+(assert_return (invoke "shr_s" (i32.const 0x71f52f69) (i32.const 0x236063ed)) (i32.const 0x38fa9))
+(assert_return (invoke "shr_s" (i32.const 0x41562b46) (i32.const 0xf7341206)) (i32.const 0x10558ad))
+(assert_return (invoke "shr_s" (i32.const 0xec1bb7cb) (i32.const 0x4cba5d0e)) (i32.const -20370))
+(assert_return (invoke "shr_s" (i32.const 0x4bcb51ec) (i32.const 0xf4b8a52f)) (i32.const 38806))
+(assert_return (invoke "shr_s" (i32.const 0xfecad621) (i32.const 0xb25e7f3b)) (i32.const -1))
+(assert_return (invoke "shr_s" (i32.const 0xb050d179) (i32.const 0x3cd5055b)) (i32.const -10))
+(assert_return (invoke "shr_s" (i32.const 0x986133) (i32.const 0xb2570c3d)) (i32.const 0))
+(assert_return (invoke "shr_s" (i32.const 0x728b6d74) (i32.const 0x272a1e72)) (i32.const 7330))
+(assert_return (invoke "shr_s" (i32.const 0x26303b1f) (i32.const 0xc7c2928)) (i32.const 0x26303b))
+(assert_return (invoke "shr_s" (i32.const 0xc94e69bd) (i32.const 0x3b20bb98)) (i32.const -55))
+(assert_return (invoke "shr_s" (i32.const 0x6bc6796a) (i32.const 0x3e5f4372)) (i32.const 6897))
+(assert_return (invoke "shr_s" (i32.const 0x15e5ba73) (i32.const 0x9c0caeca)) (i32.const 0x5796e))
+(assert_return (invoke "shr_s" (i32.const 0xf3bb08e8) (i32.const 0x13e32e0)) (i32.const 0xf3bb08e8))
+
+(assert_return (invoke "shr_u" (i32.const 0) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 0) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 0) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 0) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 0) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 0) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 0) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 0) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 0) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 0) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 0) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 0) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 0) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 0) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 0) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 1) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "shr_u" (i32.const 1) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 1) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 1) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 1) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 1) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 1) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "shr_u" (i32.const 1) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 1) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "shr_u" (i32.const 1) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 1) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 1) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 1) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "shr_u" (i32.const 1) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 1) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const -1) (i32.const 0)) (i32.const -1))
+(assert_return (invoke "shr_u" (i32.const -1) (i32.const 1)) (i32.const 0x7fffffff))
+(assert_return (invoke "shr_u" (i32.const -1) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "shr_u" (i32.const -1) (i32.const 2)) (i32.const 0x3fffffff))
+(assert_return (invoke "shr_u" (i32.const -1) (i32.const 3)) (i32.const 0x1fffffff))
+(assert_return (invoke "shr_u" (i32.const -1) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "shr_u" (i32.const -1) (i32.const 0x80000000)) (i32.const -1))
+(assert_return (invoke "shr_u" (i32.const -1) (i32.const 0x80000001)) (i32.const 0x7fffffff))
+(assert_return (invoke "shr_u" (i32.const -1) (i32.const 0x40000000)) (i32.const -1))
+(assert_return (invoke "shr_u" (i32.const -1) (i32.const 0x12345678)) (i32.const 255))
+(assert_return (invoke "shr_u" (i32.const -1) (i32.const 0xedcba988)) (i32.const 0xffffff))
+(assert_return (invoke "shr_u" (i32.const -1) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "shr_u" (i32.const -1) (i32.const 32)) (i32.const -1))
+(assert_return (invoke "shr_u" (i32.const -1) (i32.const 33)) (i32.const 0x7fffffff))
+(assert_return (invoke "shr_u" (i32.const -1) (i32.const 0xfff5a5a)) (i32.const 63))
+(assert_return (invoke "shr_u" (i32.const 2) (i32.const 0)) (i32.const 2))
+(assert_return (invoke "shr_u" (i32.const 2) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "shr_u" (i32.const 2) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 2) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 2) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 2) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 2) (i32.const 0x80000000)) (i32.const 2))
+(assert_return (invoke "shr_u" (i32.const 2) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "shr_u" (i32.const 2) (i32.const 0x40000000)) (i32.const 2))
+(assert_return (invoke "shr_u" (i32.const 2) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 2) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 2) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 2) (i32.const 32)) (i32.const 2))
+(assert_return (invoke "shr_u" (i32.const 2) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "shr_u" (i32.const 2) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 3) (i32.const 0)) (i32.const 3))
+(assert_return (invoke "shr_u" (i32.const 3) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "shr_u" (i32.const 3) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 3) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 3) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 3) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 3) (i32.const 0x80000000)) (i32.const 3))
+(assert_return (invoke "shr_u" (i32.const 3) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "shr_u" (i32.const 3) (i32.const 0x40000000)) (i32.const 3))
+(assert_return (invoke "shr_u" (i32.const 3) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 3) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 3) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 3) (i32.const 32)) (i32.const 3))
+(assert_return (invoke "shr_u" (i32.const 3) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "shr_u" (i32.const 3) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 0x7fffffff) (i32.const 0)) (i32.const 0x7fffffff))
+(assert_return (invoke "shr_u" (i32.const 0x7fffffff) (i32.const 1)) (i32.const 0x3fffffff))
+(assert_return (invoke "shr_u" (i32.const 0x7fffffff) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 0x7fffffff) (i32.const 2)) (i32.const 0x1fffffff))
+(assert_return (invoke "shr_u" (i32.const 0x7fffffff) (i32.const 3)) (i32.const 0xfffffff))
+(assert_return (invoke "shr_u" (i32.const 0x7fffffff) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 0x7fffffff) (i32.const 0x80000000)) (i32.const 0x7fffffff))
+(assert_return (invoke "shr_u" (i32.const 0x7fffffff) (i32.const 0x80000001)) (i32.const 0x3fffffff))
+(assert_return (invoke "shr_u" (i32.const 0x7fffffff) (i32.const 0x40000000)) (i32.const 0x7fffffff))
+(assert_return (invoke "shr_u" (i32.const 0x7fffffff) (i32.const 0x12345678)) (i32.const 127))
+(assert_return (invoke "shr_u" (i32.const 0x7fffffff) (i32.const 0xedcba988)) (i32.const 0x7fffff))
+(assert_return (invoke "shr_u" (i32.const 0x7fffffff) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 0x7fffffff) (i32.const 32)) (i32.const 0x7fffffff))
+(assert_return (invoke "shr_u" (i32.const 0x7fffffff) (i32.const 33)) (i32.const 0x3fffffff))
+(assert_return (invoke "shr_u" (i32.const 0x7fffffff) (i32.const 0xfff5a5a)) (i32.const 31))
+(assert_return (invoke "shr_u" (i32.const 0x80000000) (i32.const 0)) (i32.const 0x80000000))
+(assert_return (invoke "shr_u" (i32.const 0x80000000) (i32.const 1)) (i32.const 0x40000000))
+(assert_return (invoke "shr_u" (i32.const 0x80000000) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "shr_u" (i32.const 0x80000000) (i32.const 2)) (i32.const 0x20000000))
+(assert_return (invoke "shr_u" (i32.const 0x80000000) (i32.const 3)) (i32.const 0x10000000))
+(assert_return (invoke "shr_u" (i32.const 0x80000000) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "shr_u" (i32.const 0x80000000) (i32.const 0x80000000)) (i32.const 0x80000000))
+(assert_return (invoke "shr_u" (i32.const 0x80000000) (i32.const 0x80000001)) (i32.const 0x40000000))
+(assert_return (invoke "shr_u" (i32.const 0x80000000) (i32.const 0x40000000)) (i32.const 0x80000000))
+(assert_return (invoke "shr_u" (i32.const 0x80000000) (i32.const 0x12345678)) (i32.const 128))
+(assert_return (invoke "shr_u" (i32.const 0x80000000) (i32.const 0xedcba988)) (i32.const 0x800000))
+(assert_return (invoke "shr_u" (i32.const 0x80000000) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "shr_u" (i32.const 0x80000000) (i32.const 32)) (i32.const 0x80000000))
+(assert_return (invoke "shr_u" (i32.const 0x80000000) (i32.const 33)) (i32.const 0x40000000))
+(assert_return (invoke "shr_u" (i32.const 0x80000000) (i32.const 0xfff5a5a)) (i32.const 32))
+(assert_return (invoke "shr_u" (i32.const 0x80000001) (i32.const 0)) (i32.const 0x80000001))
+(assert_return (invoke "shr_u" (i32.const 0x80000001) (i32.const 1)) (i32.const 0x40000000))
+(assert_return (invoke "shr_u" (i32.const 0x80000001) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "shr_u" (i32.const 0x80000001) (i32.const 2)) (i32.const 0x20000000))
+(assert_return (invoke "shr_u" (i32.const 0x80000001) (i32.const 3)) (i32.const 0x10000000))
+(assert_return (invoke "shr_u" (i32.const 0x80000001) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "shr_u" (i32.const 0x80000001) (i32.const 0x80000000)) (i32.const 0x80000001))
+(assert_return (invoke "shr_u" (i32.const 0x80000001) (i32.const 0x80000001)) (i32.const 0x40000000))
+(assert_return (invoke "shr_u" (i32.const 0x80000001) (i32.const 0x40000000)) (i32.const 0x80000001))
+(assert_return (invoke "shr_u" (i32.const 0x80000001) (i32.const 0x12345678)) (i32.const 128))
+(assert_return (invoke "shr_u" (i32.const 0x80000001) (i32.const 0xedcba988)) (i32.const 0x800000))
+(assert_return (invoke "shr_u" (i32.const 0x80000001) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "shr_u" (i32.const 0x80000001) (i32.const 32)) (i32.const 0x80000001))
+(assert_return (invoke "shr_u" (i32.const 0x80000001) (i32.const 33)) (i32.const 0x40000000))
+(assert_return (invoke "shr_u" (i32.const 0x80000001) (i32.const 0xfff5a5a)) (i32.const 32))
+(assert_return (invoke "shr_u" (i32.const 0x40000000) (i32.const 0)) (i32.const 0x40000000))
+(assert_return (invoke "shr_u" (i32.const 0x40000000) (i32.const 1)) (i32.const 0x20000000))
+(assert_return (invoke "shr_u" (i32.const 0x40000000) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 0x40000000) (i32.const 2)) (i32.const 0x10000000))
+(assert_return (invoke "shr_u" (i32.const 0x40000000) (i32.const 3)) (i32.const 0x8000000))
+(assert_return (invoke "shr_u" (i32.const 0x40000000) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 0x40000000) (i32.const 0x80000000)) (i32.const 0x40000000))
+(assert_return (invoke "shr_u" (i32.const 0x40000000) (i32.const 0x80000001)) (i32.const 0x20000000))
+(assert_return (invoke "shr_u" (i32.const 0x40000000) (i32.const 0x40000000)) (i32.const 0x40000000))
+(assert_return (invoke "shr_u" (i32.const 0x40000000) (i32.const 0x12345678)) (i32.const 64))
+(assert_return (invoke "shr_u" (i32.const 0x40000000) (i32.const 0xedcba988)) (i32.const 0x400000))
+(assert_return (invoke "shr_u" (i32.const 0x40000000) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 0x40000000) (i32.const 32)) (i32.const 0x40000000))
+(assert_return (invoke "shr_u" (i32.const 0x40000000) (i32.const 33)) (i32.const 0x20000000))
+(assert_return (invoke "shr_u" (i32.const 0x40000000) (i32.const 0xfff5a5a)) (i32.const 16))
+(assert_return (invoke "shr_u" (i32.const 0x12345678) (i32.const 0)) (i32.const 0x12345678))
+(assert_return (invoke "shr_u" (i32.const 0x12345678) (i32.const 1)) (i32.const 0x91a2b3c))
+(assert_return (invoke "shr_u" (i32.const 0x12345678) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 0x12345678) (i32.const 2)) (i32.const 0x48d159e))
+(assert_return (invoke "shr_u" (i32.const 0x12345678) (i32.const 3)) (i32.const 0x2468acf))
+(assert_return (invoke "shr_u" (i32.const 0x12345678) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 0x12345678) (i32.const 0x80000000)) (i32.const 0x12345678))
+(assert_return (invoke "shr_u" (i32.const 0x12345678) (i32.const 0x80000001)) (i32.const 0x91a2b3c))
+(assert_return (invoke "shr_u" (i32.const 0x12345678) (i32.const 0x40000000)) (i32.const 0x12345678))
+(assert_return (invoke "shr_u" (i32.const 0x12345678) (i32.const 0x12345678)) (i32.const 18))
+(assert_return (invoke "shr_u" (i32.const 0x12345678) (i32.const 0xedcba988)) (i32.const 0x123456))
+(assert_return (invoke "shr_u" (i32.const 0x12345678) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 0x12345678) (i32.const 32)) (i32.const 0x12345678))
+(assert_return (invoke "shr_u" (i32.const 0x12345678) (i32.const 33)) (i32.const 0x91a2b3c))
+(assert_return (invoke "shr_u" (i32.const 0x12345678) (i32.const 0xfff5a5a)) (i32.const 4))
+(assert_return (invoke "shr_u" (i32.const 0xedcba988) (i32.const 0)) (i32.const 0xedcba988))
+(assert_return (invoke "shr_u" (i32.const 0xedcba988) (i32.const 1)) (i32.const 0x76e5d4c4))
+(assert_return (invoke "shr_u" (i32.const 0xedcba988) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "shr_u" (i32.const 0xedcba988) (i32.const 2)) (i32.const 0x3b72ea62))
+(assert_return (invoke "shr_u" (i32.const 0xedcba988) (i32.const 3)) (i32.const 0x1db97531))
+(assert_return (invoke "shr_u" (i32.const 0xedcba988) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "shr_u" (i32.const 0xedcba988) (i32.const 0x80000000)) (i32.const 0xedcba988))
+(assert_return (invoke "shr_u" (i32.const 0xedcba988) (i32.const 0x80000001)) (i32.const 0x76e5d4c4))
+(assert_return (invoke "shr_u" (i32.const 0xedcba988) (i32.const 0x40000000)) (i32.const 0xedcba988))
+(assert_return (invoke "shr_u" (i32.const 0xedcba988) (i32.const 0x12345678)) (i32.const 237))
+(assert_return (invoke "shr_u" (i32.const 0xedcba988) (i32.const 0xedcba988)) (i32.const 0xedcba9))
+(assert_return (invoke "shr_u" (i32.const 0xedcba988) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "shr_u" (i32.const 0xedcba988) (i32.const 32)) (i32.const 0xedcba988))
+(assert_return (invoke "shr_u" (i32.const 0xedcba988) (i32.const 33)) (i32.const 0x76e5d4c4))
+(assert_return (invoke "shr_u" (i32.const 0xedcba988) (i32.const 0xfff5a5a)) (i32.const 59))
+(assert_return (invoke "shr_u" (i32.const 31) (i32.const 0)) (i32.const 31))
+(assert_return (invoke "shr_u" (i32.const 31) (i32.const 1)) (i32.const 15))
+(assert_return (invoke "shr_u" (i32.const 31) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 31) (i32.const 2)) (i32.const 7))
+(assert_return (invoke "shr_u" (i32.const 31) (i32.const 3)) (i32.const 3))
+(assert_return (invoke "shr_u" (i32.const 31) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 31) (i32.const 0x80000000)) (i32.const 31))
+(assert_return (invoke "shr_u" (i32.const 31) (i32.const 0x80000001)) (i32.const 15))
+(assert_return (invoke "shr_u" (i32.const 31) (i32.const 0x40000000)) (i32.const 31))
+(assert_return (invoke "shr_u" (i32.const 31) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 31) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 31) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 31) (i32.const 32)) (i32.const 31))
+(assert_return (invoke "shr_u" (i32.const 31) (i32.const 33)) (i32.const 15))
+(assert_return (invoke "shr_u" (i32.const 31) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 32) (i32.const 0)) (i32.const 32))
+(assert_return (invoke "shr_u" (i32.const 32) (i32.const 1)) (i32.const 16))
+(assert_return (invoke "shr_u" (i32.const 32) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 32) (i32.const 2)) (i32.const 8))
+(assert_return (invoke "shr_u" (i32.const 32) (i32.const 3)) (i32.const 4))
+(assert_return (invoke "shr_u" (i32.const 32) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 32) (i32.const 0x80000000)) (i32.const 32))
+(assert_return (invoke "shr_u" (i32.const 32) (i32.const 0x80000001)) (i32.const 16))
+(assert_return (invoke "shr_u" (i32.const 32) (i32.const 0x40000000)) (i32.const 32))
+(assert_return (invoke "shr_u" (i32.const 32) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 32) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 32) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 32) (i32.const 32)) (i32.const 32))
+(assert_return (invoke "shr_u" (i32.const 32) (i32.const 33)) (i32.const 16))
+(assert_return (invoke "shr_u" (i32.const 32) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 33) (i32.const 0)) (i32.const 33))
+(assert_return (invoke "shr_u" (i32.const 33) (i32.const 1)) (i32.const 16))
+(assert_return (invoke "shr_u" (i32.const 33) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 33) (i32.const 2)) (i32.const 8))
+(assert_return (invoke "shr_u" (i32.const 33) (i32.const 3)) (i32.const 4))
+(assert_return (invoke "shr_u" (i32.const 33) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 33) (i32.const 0x80000000)) (i32.const 33))
+(assert_return (invoke "shr_u" (i32.const 33) (i32.const 0x80000001)) (i32.const 16))
+(assert_return (invoke "shr_u" (i32.const 33) (i32.const 0x40000000)) (i32.const 33))
+(assert_return (invoke "shr_u" (i32.const 33) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 33) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 33) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 33) (i32.const 32)) (i32.const 33))
+(assert_return (invoke "shr_u" (i32.const 33) (i32.const 33)) (i32.const 16))
+(assert_return (invoke "shr_u" (i32.const 33) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 0xfff5a5a) (i32.const 0)) (i32.const 0xfff5a5a))
+(assert_return (invoke "shr_u" (i32.const 0xfff5a5a) (i32.const 1)) (i32.const 0x7ffad2d))
+(assert_return (invoke "shr_u" (i32.const 0xfff5a5a) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 0xfff5a5a) (i32.const 2)) (i32.const 0x3ffd696))
+(assert_return (invoke "shr_u" (i32.const 0xfff5a5a) (i32.const 3)) (i32.const 0x1ffeb4b))
+(assert_return (invoke "shr_u" (i32.const 0xfff5a5a) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 0xfff5a5a) (i32.const 0x80000000)) (i32.const 0xfff5a5a))
+(assert_return (invoke "shr_u" (i32.const 0xfff5a5a) (i32.const 0x80000001)) (i32.const 0x7ffad2d))
+(assert_return (invoke "shr_u" (i32.const 0xfff5a5a) (i32.const 0x40000000)) (i32.const 0xfff5a5a))
+(assert_return (invoke "shr_u" (i32.const 0xfff5a5a) (i32.const 0x12345678)) (i32.const 15))
+(assert_return (invoke "shr_u" (i32.const 0xfff5a5a) (i32.const 0xedcba988)) (i32.const 0xfff5a))
+(assert_return (invoke "shr_u" (i32.const 0xfff5a5a) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 0xfff5a5a) (i32.const 32)) (i32.const 0xfff5a5a))
+(assert_return (invoke "shr_u" (i32.const 0xfff5a5a) (i32.const 33)) (i32.const 0x7ffad2d))
+(assert_return (invoke "shr_u" (i32.const 0xfff5a5a) (i32.const 0xfff5a5a)) (i32.const 3))
+(assert_return (invoke "shr_u" (i32.const 0xcc9ca611) (i32.const 0xd326bef0)) (i32.const 52380))
+(assert_return (invoke "shr_u" (i32.const 0x9b930df5) (i32.const 0xaf1777c1)) (i32.const 0x4dc986fa))
+(assert_return (invoke "shr_u" (i32.const 0x914cbe2a) (i32.const 0xc462c209)) (i32.const 0x48a65f))
+(assert_return (invoke "shr_u" (i32.const 0x1304880d) (i32.const 0x481210d6)) (i32.const 76))
+(assert_return (invoke "shr_u" (i32.const 0xfb85cb2e) (i32.const 0x21f0c2c1)) (i32.const 0x7dc2e597))
+(assert_return (invoke "shr_u" (i32.const 0x4d3e86b7) (i32.const 0x602e4400)) (i32.const 0x4d3e86b7))
+(assert_return (invoke "shr_u" (i32.const 0x8621d14a) (i32.const 0xe453da37)) (i32.const 268))
+(assert_return (invoke "shr_u" (i32.const 0x7d71bdbf) (i32.const 0x5d1f5d45)) (i32.const 0x3eb8ded))
+(assert_return (invoke "shr_u" (i32.const 0x78ecc102) (i32.const 0x914f374d)) (i32.const 0x3c766))
+(assert_return (invoke "shr_u" (i32.const 0x4a7d12f) (i32.const 0x90409318)) (i32.const 4))
+(assert_return (invoke "shr_u" (i32.const 0xe4a85b19) (i32.const 0xced960cd)) (i32.const 0x72542))
+(assert_return (invoke "shr_u" (i32.const 0x7e1458af) (i32.const 0xee28eebf)) (i32.const 0))
+(assert_return (invoke "shr_u" (i32.const 0x64b6a5d8) (i32.const 0x417f7ce5)) (i32.const 0x325b52e))
+(assert_return (invoke "shr_u" (i32.const 0xdc54b942) (i32.const 0xb6245592)) (i32.const 14101))
+(assert_return (invoke "shr_u" (i32.const 0xdc2f8d93) (i32.const 0xe736f623)) (i32.const 0x1b85f1b2))
+(assert_return (invoke "shr_u" (i32.const 0xb80fc5d4) (i32.const 0x25ee3bb0)) (i32.const 47119))
+
+(assert_return (invoke "rotl" (i32.const 0) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "rotl" (i32.const 0) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "rotl" (i32.const 0) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "rotl" (i32.const 0) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "rotl" (i32.const 0) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "rotl" (i32.const 0) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "rotl" (i32.const 0) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "rotl" (i32.const 0) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "rotl" (i32.const 0) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "rotl" (i32.const 0) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "rotl" (i32.const 0) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "rotl" (i32.const 0) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "rotl" (i32.const 0) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "rotl" (i32.const 0) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "rotl" (i32.const 0) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "rotl" (i32.const 1) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "rotl" (i32.const 1) (i32.const 1)) (i32.const 2))
+(assert_return (invoke "rotl" (i32.const 1) (i32.const -1)) (i32.const 0x80000000))
+(assert_return (invoke "rotl" (i32.const 1) (i32.const 2)) (i32.const 4))
+(assert_return (invoke "rotl" (i32.const 1) (i32.const 3)) (i32.const 8))
+(assert_return (invoke "rotl" (i32.const 1) (i32.const 0x7fffffff)) (i32.const 0x80000000))
+(assert_return (invoke "rotl" (i32.const 1) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "rotl" (i32.const 1) (i32.const 0x80000001)) (i32.const 2))
+(assert_return (invoke "rotl" (i32.const 1) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "rotl" (i32.const 1) (i32.const 0x12345678)) (i32.const 0x1000000))
+(assert_return (invoke "rotl" (i32.const 1) (i32.const 0xedcba988)) (i32.const 256))
+(assert_return (invoke "rotl" (i32.const 1) (i32.const 31)) (i32.const 0x80000000))
+(assert_return (invoke "rotl" (i32.const 1) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "rotl" (i32.const 1) (i32.const 33)) (i32.const 2))
+(assert_return (invoke "rotl" (i32.const 1) (i32.const 0xfff5a5a)) (i32.const 0x4000000))
+(assert_return (invoke "rotl" (i32.const -1) (i32.const 0)) (i32.const -1))
+(assert_return (invoke "rotl" (i32.const -1) (i32.const 1)) (i32.const -1))
+(assert_return (invoke "rotl" (i32.const -1) (i32.const -1)) (i32.const -1))
+(assert_return (invoke "rotl" (i32.const -1) (i32.const 2)) (i32.const -1))
+(assert_return (invoke "rotl" (i32.const -1) (i32.const 3)) (i32.const -1))
+(assert_return (invoke "rotl" (i32.const -1) (i32.const 0x7fffffff)) (i32.const -1))
+(assert_return (invoke "rotl" (i32.const -1) (i32.const 0x80000000)) (i32.const -1))
+(assert_return (invoke "rotl" (i32.const -1) (i32.const 0x80000001)) (i32.const -1))
+(assert_return (invoke "rotl" (i32.const -1) (i32.const 0x40000000)) (i32.const -1))
+(assert_return (invoke "rotl" (i32.const -1) (i32.const 0x12345678)) (i32.const -1))
+(assert_return (invoke "rotl" (i32.const -1) (i32.const 0xedcba988)) (i32.const -1))
+(assert_return (invoke "rotl" (i32.const -1) (i32.const 31)) (i32.const -1))
+(assert_return (invoke "rotl" (i32.const -1) (i32.const 32)) (i32.const -1))
+(assert_return (invoke "rotl" (i32.const -1) (i32.const 33)) (i32.const -1))
+(assert_return (invoke "rotl" (i32.const -1) (i32.const 0xfff5a5a)) (i32.const -1))
+(assert_return (invoke "rotl" (i32.const 2) (i32.const 0)) (i32.const 2))
+(assert_return (invoke "rotl" (i32.const 2) (i32.const 1)) (i32.const 4))
+(assert_return (invoke "rotl" (i32.const 2) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "rotl" (i32.const 2) (i32.const 2)) (i32.const 8))
+(assert_return (invoke "rotl" (i32.const 2) (i32.const 3)) (i32.const 16))
+(assert_return (invoke "rotl" (i32.const 2) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "rotl" (i32.const 2) (i32.const 0x80000000)) (i32.const 2))
+(assert_return (invoke "rotl" (i32.const 2) (i32.const 0x80000001)) (i32.const 4))
+(assert_return (invoke "rotl" (i32.const 2) (i32.const 0x40000000)) (i32.const 2))
+(assert_return (invoke "rotl" (i32.const 2) (i32.const 0x12345678)) (i32.const 0x2000000))
+(assert_return (invoke "rotl" (i32.const 2) (i32.const 0xedcba988)) (i32.const 512))
+(assert_return (invoke "rotl" (i32.const 2) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "rotl" (i32.const 2) (i32.const 32)) (i32.const 2))
+(assert_return (invoke "rotl" (i32.const 2) (i32.const 33)) (i32.const 4))
+(assert_return (invoke "rotl" (i32.const 2) (i32.const 0xfff5a5a)) (i32.const 0x8000000))
+(assert_return (invoke "rotl" (i32.const 3) (i32.const 0)) (i32.const 3))
+(assert_return (invoke "rotl" (i32.const 3) (i32.const 1)) (i32.const 6))
+(assert_return (invoke "rotl" (i32.const 3) (i32.const -1)) (i32.const 0x80000001))
+(assert_return (invoke "rotl" (i32.const 3) (i32.const 2)) (i32.const 12))
+(assert_return (invoke "rotl" (i32.const 3) (i32.const 3)) (i32.const 24))
+(assert_return (invoke "rotl" (i32.const 3) (i32.const 0x7fffffff)) (i32.const 0x80000001))
+(assert_return (invoke "rotl" (i32.const 3) (i32.const 0x80000000)) (i32.const 3))
+(assert_return (invoke "rotl" (i32.const 3) (i32.const 0x80000001)) (i32.const 6))
+(assert_return (invoke "rotl" (i32.const 3) (i32.const 0x40000000)) (i32.const 3))
+(assert_return (invoke "rotl" (i32.const 3) (i32.const 0x12345678)) (i32.const 0x3000000))
+(assert_return (invoke "rotl" (i32.const 3) (i32.const 0xedcba988)) (i32.const 768))
+(assert_return (invoke "rotl" (i32.const 3) (i32.const 31)) (i32.const 0x80000001))
+(assert_return (invoke "rotl" (i32.const 3) (i32.const 32)) (i32.const 3))
+(assert_return (invoke "rotl" (i32.const 3) (i32.const 33)) (i32.const 6))
+(assert_return (invoke "rotl" (i32.const 3) (i32.const 0xfff5a5a)) (i32.const 0xc000000))
+(assert_return (invoke "rotl" (i32.const 0x7fffffff) (i32.const 0)) (i32.const 0x7fffffff))
+(assert_return (invoke "rotl" (i32.const 0x7fffffff) (i32.const 1)) (i32.const -2))
+(assert_return (invoke "rotl" (i32.const 0x7fffffff) (i32.const -1)) (i32.const 0xbfffffff))
+(assert_return (invoke "rotl" (i32.const 0x7fffffff) (i32.const 2)) (i32.const -3))
+(assert_return (invoke "rotl" (i32.const 0x7fffffff) (i32.const 3)) (i32.const -5))
+(assert_return (invoke "rotl" (i32.const 0x7fffffff) (i32.const 0x7fffffff)) (i32.const 0xbfffffff))
+(assert_return (invoke "rotl" (i32.const 0x7fffffff) (i32.const 0x80000000)) (i32.const 0x7fffffff))
+(assert_return (invoke "rotl" (i32.const 0x7fffffff) (i32.const 0x80000001)) (i32.const -2))
+(assert_return (invoke "rotl" (i32.const 0x7fffffff) (i32.const 0x40000000)) (i32.const 0x7fffffff))
+(assert_return (invoke "rotl" (i32.const 0x7fffffff) (i32.const 0x12345678)) (i32.const 0xff7fffff))
+(assert_return (invoke "rotl" (i32.const 0x7fffffff) (i32.const 0xedcba988)) (i32.const -129))
+(assert_return (invoke "rotl" (i32.const 0x7fffffff) (i32.const 31)) (i32.const 0xbfffffff))
+(assert_return (invoke "rotl" (i32.const 0x7fffffff) (i32.const 32)) (i32.const 0x7fffffff))
+(assert_return (invoke "rotl" (i32.const 0x7fffffff) (i32.const 33)) (i32.const -2))
+(assert_return (invoke "rotl" (i32.const 0x7fffffff) (i32.const 0xfff5a5a)) (i32.const 0xfdffffff))
+(assert_return (invoke "rotl" (i32.const 0x80000000) (i32.const 0)) (i32.const 0x80000000))
+(assert_return (invoke "rotl" (i32.const 0x80000000) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "rotl" (i32.const 0x80000000) (i32.const -1)) (i32.const 0x40000000))
+(assert_return (invoke "rotl" (i32.const 0x80000000) (i32.const 2)) (i32.const 2))
+(assert_return (invoke "rotl" (i32.const 0x80000000) (i32.const 3)) (i32.const 4))
+(assert_return (invoke "rotl" (i32.const 0x80000000) (i32.const 0x7fffffff)) (i32.const 0x40000000))
+(assert_return (invoke "rotl" (i32.const 0x80000000) (i32.const 0x80000000)) (i32.const 0x80000000))
+(assert_return (invoke "rotl" (i32.const 0x80000000) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "rotl" (i32.const 0x80000000) (i32.const 0x40000000)) (i32.const 0x80000000))
+(assert_return (invoke "rotl" (i32.const 0x80000000) (i32.const 0x12345678)) (i32.const 0x800000))
+(assert_return (invoke "rotl" (i32.const 0x80000000) (i32.const 0xedcba988)) (i32.const 128))
+(assert_return (invoke "rotl" (i32.const 0x80000000) (i32.const 31)) (i32.const 0x40000000))
+(assert_return (invoke "rotl" (i32.const 0x80000000) (i32.const 32)) (i32.const 0x80000000))
+(assert_return (invoke "rotl" (i32.const 0x80000000) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "rotl" (i32.const 0x80000000) (i32.const 0xfff5a5a)) (i32.const 0x2000000))
+(assert_return (invoke "rotl" (i32.const 0x80000001) (i32.const 0)) (i32.const 0x80000001))
+(assert_return (invoke "rotl" (i32.const 0x80000001) (i32.const 1)) (i32.const 3))
+(assert_return (invoke "rotl" (i32.const 0x80000001) (i32.const -1)) (i32.const 0xc0000000))
+(assert_return (invoke "rotl" (i32.const 0x80000001) (i32.const 2)) (i32.const 6))
+(assert_return (invoke "rotl" (i32.const 0x80000001) (i32.const 3)) (i32.const 12))
+(assert_return (invoke "rotl" (i32.const 0x80000001) (i32.const 0x7fffffff)) (i32.const 0xc0000000))
+(assert_return (invoke "rotl" (i32.const 0x80000001) (i32.const 0x80000000)) (i32.const 0x80000001))
+(assert_return (invoke "rotl" (i32.const 0x80000001) (i32.const 0x80000001)) (i32.const 3))
+(assert_return (invoke "rotl" (i32.const 0x80000001) (i32.const 0x40000000)) (i32.const 0x80000001))
+(assert_return (invoke "rotl" (i32.const 0x80000001) (i32.const 0x12345678)) (i32.const 0x1800000))
+(assert_return (invoke "rotl" (i32.const 0x80000001) (i32.const 0xedcba988)) (i32.const 384))
+(assert_return (invoke "rotl" (i32.const 0x80000001) (i32.const 31)) (i32.const 0xc0000000))
+(assert_return (invoke "rotl" (i32.const 0x80000001) (i32.const 32)) (i32.const 0x80000001))
+(assert_return (invoke "rotl" (i32.const 0x80000001) (i32.const 33)) (i32.const 3))
+(assert_return (invoke "rotl" (i32.const 0x80000001) (i32.const 0xfff5a5a)) (i32.const 0x6000000))
+(assert_return (invoke "rotl" (i32.const 0x40000000) (i32.const 0)) (i32.const 0x40000000))
+(assert_return (invoke "rotl" (i32.const 0x40000000) (i32.const 1)) (i32.const 0x80000000))
+(assert_return (invoke "rotl" (i32.const 0x40000000) (i32.const -1)) (i32.const 0x20000000))
+(assert_return (invoke "rotl" (i32.const 0x40000000) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "rotl" (i32.const 0x40000000) (i32.const 3)) (i32.const 2))
+(assert_return (invoke "rotl" (i32.const 0x40000000) (i32.const 0x7fffffff)) (i32.const 0x20000000))
+(assert_return (invoke "rotl" (i32.const 0x40000000) (i32.const 0x80000000)) (i32.const 0x40000000))
+(assert_return (invoke "rotl" (i32.const 0x40000000) (i32.const 0x80000001)) (i32.const 0x80000000))
+(assert_return (invoke "rotl" (i32.const 0x40000000) (i32.const 0x40000000)) (i32.const 0x40000000))
+(assert_return (invoke "rotl" (i32.const 0x40000000) (i32.const 0x12345678)) (i32.const 0x400000))
+(assert_return (invoke "rotl" (i32.const 0x40000000) (i32.const 0xedcba988)) (i32.const 64))
+(assert_return (invoke "rotl" (i32.const 0x40000000) (i32.const 31)) (i32.const 0x20000000))
+(assert_return (invoke "rotl" (i32.const 0x40000000) (i32.const 32)) (i32.const 0x40000000))
+(assert_return (invoke "rotl" (i32.const 0x40000000) (i32.const 33)) (i32.const 0x80000000))
+(assert_return (invoke "rotl" (i32.const 0x40000000) (i32.const 0xfff5a5a)) (i32.const 0x1000000))
+(assert_return (invoke "rotl" (i32.const 0x12345678) (i32.const 0)) (i32.const 0x12345678))
+(assert_return (invoke "rotl" (i32.const 0x12345678) (i32.const 1)) (i32.const 0x2468acf0))
+(assert_return (invoke "rotl" (i32.const 0x12345678) (i32.const -1)) (i32.const 0x91a2b3c))
+(assert_return (invoke "rotl" (i32.const 0x12345678) (i32.const 2)) (i32.const 0x48d159e0))
+(assert_return (invoke "rotl" (i32.const 0x12345678) (i32.const 3)) (i32.const 0x91a2b3c0))
+(assert_return (invoke "rotl" (i32.const 0x12345678) (i32.const 0x7fffffff)) (i32.const 0x91a2b3c))
+(assert_return (invoke "rotl" (i32.const 0x12345678) (i32.const 0x80000000)) (i32.const 0x12345678))
+(assert_return (invoke "rotl" (i32.const 0x12345678) (i32.const 0x80000001)) (i32.const 0x2468acf0))
+(assert_return (invoke "rotl" (i32.const 0x12345678) (i32.const 0x40000000)) (i32.const 0x12345678))
+(assert_return (invoke "rotl" (i32.const 0x12345678) (i32.const 0x12345678)) (i32.const 0x78123456))
+(assert_return (invoke "rotl" (i32.const 0x12345678) (i32.const 0xedcba988)) (i32.const 0x34567812))
+(assert_return (invoke "rotl" (i32.const 0x12345678) (i32.const 31)) (i32.const 0x91a2b3c))
+(assert_return (invoke "rotl" (i32.const 0x12345678) (i32.const 32)) (i32.const 0x12345678))
+(assert_return (invoke "rotl" (i32.const 0x12345678) (i32.const 33)) (i32.const 0x2468acf0))
+(assert_return (invoke "rotl" (i32.const 0x12345678) (i32.const 0xfff5a5a)) (i32.const 0xe048d159))
+(assert_return (invoke "rotl" (i32.const 0xedcba988) (i32.const 0)) (i32.const 0xedcba988))
+(assert_return (invoke "rotl" (i32.const 0xedcba988) (i32.const 1)) (i32.const 0xdb975311))
+(assert_return (invoke "rotl" (i32.const 0xedcba988) (i32.const -1)) (i32.const 0x76e5d4c4))
+(assert_return (invoke "rotl" (i32.const 0xedcba988) (i32.const 2)) (i32.const 0xb72ea623))
+(assert_return (invoke "rotl" (i32.const 0xedcba988) (i32.const 3)) (i32.const 0x6e5d4c47))
+(assert_return (invoke "rotl" (i32.const 0xedcba988) (i32.const 0x7fffffff)) (i32.const 0x76e5d4c4))
+(assert_return (invoke "rotl" (i32.const 0xedcba988) (i32.const 0x80000000)) (i32.const 0xedcba988))
+(assert_return (invoke "rotl" (i32.const 0xedcba988) (i32.const 0x80000001)) (i32.const 0xdb975311))
+(assert_return (invoke "rotl" (i32.const 0xedcba988) (i32.const 0x40000000)) (i32.const 0xedcba988))
+(assert_return (invoke "rotl" (i32.const 0xedcba988) (i32.const 0x12345678)) (i32.const 0x88edcba9))
+(assert_return (invoke "rotl" (i32.const 0xedcba988) (i32.const 0xedcba988)) (i32.const 0xcba988ed))
+(assert_return (invoke "rotl" (i32.const 0xedcba988) (i32.const 31)) (i32.const 0x76e5d4c4))
+(assert_return (invoke "rotl" (i32.const 0xedcba988) (i32.const 32)) (i32.const 0xedcba988))
+(assert_return (invoke "rotl" (i32.const 0xedcba988) (i32.const 33)) (i32.const 0xdb975311))
+(assert_return (invoke "rotl" (i32.const 0xedcba988) (i32.const 0xfff5a5a)) (i32.const 0x23b72ea6))
+(assert_return (invoke "rotl" (i32.const 31) (i32.const 0)) (i32.const 31))
+(assert_return (invoke "rotl" (i32.const 31) (i32.const 1)) (i32.const 62))
+(assert_return (invoke "rotl" (i32.const 31) (i32.const -1)) (i32.const 0x8000000f))
+(assert_return (invoke "rotl" (i32.const 31) (i32.const 2)) (i32.const 124))
+(assert_return (invoke "rotl" (i32.const 31) (i32.const 3)) (i32.const 248))
+(assert_return (invoke "rotl" (i32.const 31) (i32.const 0x7fffffff)) (i32.const 0x8000000f))
+(assert_return (invoke "rotl" (i32.const 31) (i32.const 0x80000000)) (i32.const 31))
+(assert_return (invoke "rotl" (i32.const 31) (i32.const 0x80000001)) (i32.const 62))
+(assert_return (invoke "rotl" (i32.const 31) (i32.const 0x40000000)) (i32.const 31))
+(assert_return (invoke "rotl" (i32.const 31) (i32.const 0x12345678)) (i32.const 0x1f000000))
+(assert_return (invoke "rotl" (i32.const 31) (i32.const 0xedcba988)) (i32.const 7936))
+(assert_return (invoke "rotl" (i32.const 31) (i32.const 31)) (i32.const 0x8000000f))
+(assert_return (invoke "rotl" (i32.const 31) (i32.const 32)) (i32.const 31))
+(assert_return (invoke "rotl" (i32.const 31) (i32.const 33)) (i32.const 62))
+(assert_return (invoke "rotl" (i32.const 31) (i32.const 0xfff5a5a)) (i32.const 0x7c000000))
+(assert_return (invoke "rotl" (i32.const 32) (i32.const 0)) (i32.const 32))
+(assert_return (invoke "rotl" (i32.const 32) (i32.const 1)) (i32.const 64))
+(assert_return (invoke "rotl" (i32.const 32) (i32.const -1)) (i32.const 16))
+(assert_return (invoke "rotl" (i32.const 32) (i32.const 2)) (i32.const 128))
+(assert_return (invoke "rotl" (i32.const 32) (i32.const 3)) (i32.const 256))
+(assert_return (invoke "rotl" (i32.const 32) (i32.const 0x7fffffff)) (i32.const 16))
+(assert_return (invoke "rotl" (i32.const 32) (i32.const 0x80000000)) (i32.const 32))
+(assert_return (invoke "rotl" (i32.const 32) (i32.const 0x80000001)) (i32.const 64))
+(assert_return (invoke "rotl" (i32.const 32) (i32.const 0x40000000)) (i32.const 32))
+(assert_return (invoke "rotl" (i32.const 32) (i32.const 0x12345678)) (i32.const 0x20000000))
+(assert_return (invoke "rotl" (i32.const 32) (i32.const 0xedcba988)) (i32.const 8192))
+(assert_return (invoke "rotl" (i32.const 32) (i32.const 31)) (i32.const 16))
+(assert_return (invoke "rotl" (i32.const 32) (i32.const 32)) (i32.const 32))
+(assert_return (invoke "rotl" (i32.const 32) (i32.const 33)) (i32.const 64))
+(assert_return (invoke "rotl" (i32.const 32) (i32.const 0xfff5a5a)) (i32.const 0x80000000))
+(assert_return (invoke "rotl" (i32.const 33) (i32.const 0)) (i32.const 33))
+(assert_return (invoke "rotl" (i32.const 33) (i32.const 1)) (i32.const 66))
+(assert_return (invoke "rotl" (i32.const 33) (i32.const -1)) (i32.const 0x80000010))
+(assert_return (invoke "rotl" (i32.const 33) (i32.const 2)) (i32.const 132))
+(assert_return (invoke "rotl" (i32.const 33) (i32.const 3)) (i32.const 264))
+(assert_return (invoke "rotl" (i32.const 33) (i32.const 0x7fffffff)) (i32.const 0x80000010))
+(assert_return (invoke "rotl" (i32.const 33) (i32.const 0x80000000)) (i32.const 33))
+(assert_return (invoke "rotl" (i32.const 33) (i32.const 0x80000001)) (i32.const 66))
+(assert_return (invoke "rotl" (i32.const 33) (i32.const 0x40000000)) (i32.const 33))
+(assert_return (invoke "rotl" (i32.const 33) (i32.const 0x12345678)) (i32.const 0x21000000))
+(assert_return (invoke "rotl" (i32.const 33) (i32.const 0xedcba988)) (i32.const 8448))
+(assert_return (invoke "rotl" (i32.const 33) (i32.const 31)) (i32.const 0x80000010))
+(assert_return (invoke "rotl" (i32.const 33) (i32.const 32)) (i32.const 33))
+(assert_return (invoke "rotl" (i32.const 33) (i32.const 33)) (i32.const 66))
+(assert_return (invoke "rotl" (i32.const 33) (i32.const 0xfff5a5a)) (i32.const 0x84000000))
+(assert_return (invoke "rotl" (i32.const 0xfff5a5a) (i32.const 0)) (i32.const 0xfff5a5a))
+(assert_return (invoke "rotl" (i32.const 0xfff5a5a) (i32.const 1)) (i32.const 0x1ffeb4b4))
+(assert_return (invoke "rotl" (i32.const 0xfff5a5a) (i32.const -1)) (i32.const 0x7ffad2d))
+(assert_return (invoke "rotl" (i32.const 0xfff5a5a) (i32.const 2)) (i32.const 0x3ffd6968))
+(assert_return (invoke "rotl" (i32.const 0xfff5a5a) (i32.const 3)) (i32.const 0x7ffad2d0))
+(assert_return (invoke "rotl" (i32.const 0xfff5a5a) (i32.const 0x7fffffff)) (i32.const 0x7ffad2d))
+(assert_return (invoke "rotl" (i32.const 0xfff5a5a) (i32.const 0x80000000)) (i32.const 0xfff5a5a))
+(assert_return (invoke "rotl" (i32.const 0xfff5a5a) (i32.const 0x80000001)) (i32.const 0x1ffeb4b4))
+(assert_return (invoke "rotl" (i32.const 0xfff5a5a) (i32.const 0x40000000)) (i32.const 0xfff5a5a))
+(assert_return (invoke "rotl" (i32.const 0xfff5a5a) (i32.const 0x12345678)) (i32.const 0x5a0fff5a))
+(assert_return (invoke "rotl" (i32.const 0xfff5a5a) (i32.const 0xedcba988)) (i32.const 0xff5a5a0f))
+(assert_return (invoke "rotl" (i32.const 0xfff5a5a) (i32.const 31)) (i32.const 0x7ffad2d))
+(assert_return (invoke "rotl" (i32.const 0xfff5a5a) (i32.const 32)) (i32.const 0xfff5a5a))
+(assert_return (invoke "rotl" (i32.const 0xfff5a5a) (i32.const 33)) (i32.const 0x1ffeb4b4))
+(assert_return (invoke "rotl" (i32.const 0xfff5a5a) (i32.const 0xfff5a5a)) (i32.const 0x683ffd69))
+(assert_return (invoke "rotl" (i32.const 0xe85112ec) (i32.const 0x58b9705b)) (i32.const 0x67428897))
+(assert_return (invoke "rotl" (i32.const 0x597e1949) (i32.const 0x8669fee3)) (i32.const 0xcbf0ca4a))
+(assert_return (invoke "rotl" (i32.const 0x1ad2f430) (i32.const 0xe41ab7eb)) (i32.const 0x97a180d6))
+(assert_return (invoke "rotl" (i32.const 0x5908b758) (i32.const 0x611d6e8a)) (i32.const 0x22dd6164))
+(assert_return (invoke "rotl" (i32.const 0x9a0459f6) (i32.const 0x329cb53f)) (i32.const 0x4d022cfb))
+(assert_return (invoke "rotl" (i32.const 0xc9708645) (i32.const 0xaf4a8ff1)) (i32.const 0xc8b92e1))
+(assert_return (invoke "rotl" (i32.const 0xbc011e57) (i32.const 0x180af782)) (i32.const 0xf004795e))
+(assert_return (invoke "rotl" (i32.const 0xa132358b) (i32.const 0xfae361c4)) (i32.const 0x132358ba))
+(assert_return (invoke "rotl" (i32.const 0x99d1def7) (i32.const 0x759ae0e)) (i32.const 0x77bde674))
+(assert_return (invoke "rotl" (i32.const 0xaa82d138) (i32.const 0x5d3cbe3f)) (i32.const 0x5541689c))
+(assert_return (invoke "rotl" (i32.const 0x93278303) (i32.const 0x947e5942)) (i32.const 0x4c9e0c0e))
+(assert_return (invoke "rotl" (i32.const 0x3e6f063) (i32.const 0xd9d58df7)) (i32.const 0x3181f378))
+(assert_return (invoke "rotl" (i32.const 0x8bf02aa7) (i32.const 0x93a3ec41)) (i32.const 0x17e0554f))
+(assert_return (invoke "rotl" (i32.const 0x4687940e) (i32.const 0x47a094e3)) (i32.const 0x343ca072))
+(assert_return (invoke "rotl" (i32.const 0x1280fe91) (i32.const 0x3aec78ef)) (i32.const 0x7f488940))
+(assert_return (invoke "rotl" (i32.const 0x9cc2f35f) (i32.const 0xe19771a2)) (i32.const 0x730bcd7e))
+
+(assert_return (invoke "rotr" (i32.const 0) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "rotr" (i32.const 0) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "rotr" (i32.const 0) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "rotr" (i32.const 0) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "rotr" (i32.const 0) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "rotr" (i32.const 0) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "rotr" (i32.const 0) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "rotr" (i32.const 0) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "rotr" (i32.const 0) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "rotr" (i32.const 0) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "rotr" (i32.const 0) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "rotr" (i32.const 0) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "rotr" (i32.const 0) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "rotr" (i32.const 0) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "rotr" (i32.const 0) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "rotr" (i32.const 1) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "rotr" (i32.const 1) (i32.const 1)) (i32.const 0x80000000))
+(assert_return (invoke "rotr" (i32.const 1) (i32.const -1)) (i32.const 2))
+(assert_return (invoke "rotr" (i32.const 1) (i32.const 2)) (i32.const 0x40000000))
+(assert_return (invoke "rotr" (i32.const 1) (i32.const 3)) (i32.const 0x20000000))
+(assert_return (invoke "rotr" (i32.const 1) (i32.const 0x7fffffff)) (i32.const 2))
+(assert_return (invoke "rotr" (i32.const 1) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "rotr" (i32.const 1) (i32.const 0x80000001)) (i32.const 0x80000000))
+(assert_return (invoke "rotr" (i32.const 1) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "rotr" (i32.const 1) (i32.const 0x12345678)) (i32.const 256))
+(assert_return (invoke "rotr" (i32.const 1) (i32.const 0xedcba988)) (i32.const 0x1000000))
+(assert_return (invoke "rotr" (i32.const 1) (i32.const 31)) (i32.const 2))
+(assert_return (invoke "rotr" (i32.const 1) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "rotr" (i32.const 1) (i32.const 33)) (i32.const 0x80000000))
+(assert_return (invoke "rotr" (i32.const 1) (i32.const 0xfff5a5a)) (i32.const 64))
+(assert_return (invoke "rotr" (i32.const -1) (i32.const 0)) (i32.const -1))
+(assert_return (invoke "rotr" (i32.const -1) (i32.const 1)) (i32.const -1))
+(assert_return (invoke "rotr" (i32.const -1) (i32.const -1)) (i32.const -1))
+(assert_return (invoke "rotr" (i32.const -1) (i32.const 2)) (i32.const -1))
+(assert_return (invoke "rotr" (i32.const -1) (i32.const 3)) (i32.const -1))
+(assert_return (invoke "rotr" (i32.const -1) (i32.const 0x7fffffff)) (i32.const -1))
+(assert_return (invoke "rotr" (i32.const -1) (i32.const 0x80000000)) (i32.const -1))
+(assert_return (invoke "rotr" (i32.const -1) (i32.const 0x80000001)) (i32.const -1))
+(assert_return (invoke "rotr" (i32.const -1) (i32.const 0x40000000)) (i32.const -1))
+(assert_return (invoke "rotr" (i32.const -1) (i32.const 0x12345678)) (i32.const -1))
+(assert_return (invoke "rotr" (i32.const -1) (i32.const 0xedcba988)) (i32.const -1))
+(assert_return (invoke "rotr" (i32.const -1) (i32.const 31)) (i32.const -1))
+(assert_return (invoke "rotr" (i32.const -1) (i32.const 32)) (i32.const -1))
+(assert_return (invoke "rotr" (i32.const -1) (i32.const 33)) (i32.const -1))
+(assert_return (invoke "rotr" (i32.const -1) (i32.const 0xfff5a5a)) (i32.const -1))
+(assert_return (invoke "rotr" (i32.const 2) (i32.const 0)) (i32.const 2))
+(assert_return (invoke "rotr" (i32.const 2) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "rotr" (i32.const 2) (i32.const -1)) (i32.const 4))
+(assert_return (invoke "rotr" (i32.const 2) (i32.const 2)) (i32.const 0x80000000))
+(assert_return (invoke "rotr" (i32.const 2) (i32.const 3)) (i32.const 0x40000000))
+(assert_return (invoke "rotr" (i32.const 2) (i32.const 0x7fffffff)) (i32.const 4))
+(assert_return (invoke "rotr" (i32.const 2) (i32.const 0x80000000)) (i32.const 2))
+(assert_return (invoke "rotr" (i32.const 2) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "rotr" (i32.const 2) (i32.const 0x40000000)) (i32.const 2))
+(assert_return (invoke "rotr" (i32.const 2) (i32.const 0x12345678)) (i32.const 512))
+(assert_return (invoke "rotr" (i32.const 2) (i32.const 0xedcba988)) (i32.const 0x2000000))
+(assert_return (invoke "rotr" (i32.const 2) (i32.const 31)) (i32.const 4))
+(assert_return (invoke "rotr" (i32.const 2) (i32.const 32)) (i32.const 2))
+(assert_return (invoke "rotr" (i32.const 2) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "rotr" (i32.const 2) (i32.const 0xfff5a5a)) (i32.const 128))
+(assert_return (invoke "rotr" (i32.const 3) (i32.const 0)) (i32.const 3))
+(assert_return (invoke "rotr" (i32.const 3) (i32.const 1)) (i32.const 0x80000001))
+(assert_return (invoke "rotr" (i32.const 3) (i32.const -1)) (i32.const 6))
+(assert_return (invoke "rotr" (i32.const 3) (i32.const 2)) (i32.const 0xc0000000))
+(assert_return (invoke "rotr" (i32.const 3) (i32.const 3)) (i32.const 0x60000000))
+(assert_return (invoke "rotr" (i32.const 3) (i32.const 0x7fffffff)) (i32.const 6))
+(assert_return (invoke "rotr" (i32.const 3) (i32.const 0x80000000)) (i32.const 3))
+(assert_return (invoke "rotr" (i32.const 3) (i32.const 0x80000001)) (i32.const 0x80000001))
+(assert_return (invoke "rotr" (i32.const 3) (i32.const 0x40000000)) (i32.const 3))
+(assert_return (invoke "rotr" (i32.const 3) (i32.const 0x12345678)) (i32.const 768))
+(assert_return (invoke "rotr" (i32.const 3) (i32.const 0xedcba988)) (i32.const 0x3000000))
+(assert_return (invoke "rotr" (i32.const 3) (i32.const 31)) (i32.const 6))
+(assert_return (invoke "rotr" (i32.const 3) (i32.const 32)) (i32.const 3))
+(assert_return (invoke "rotr" (i32.const 3) (i32.const 33)) (i32.const 0x80000001))
+(assert_return (invoke "rotr" (i32.const 3) (i32.const 0xfff5a5a)) (i32.const 192))
+(assert_return (invoke "rotr" (i32.const 0x7fffffff) (i32.const 0)) (i32.const 0x7fffffff))
+(assert_return (invoke "rotr" (i32.const 0x7fffffff) (i32.const 1)) (i32.const 0xbfffffff))
+(assert_return (invoke "rotr" (i32.const 0x7fffffff) (i32.const -1)) (i32.const -2))
+(assert_return (invoke "rotr" (i32.const 0x7fffffff) (i32.const 2)) (i32.const 0xdfffffff))
+(assert_return (invoke "rotr" (i32.const 0x7fffffff) (i32.const 3)) (i32.const 0xefffffff))
+(assert_return (invoke "rotr" (i32.const 0x7fffffff) (i32.const 0x7fffffff)) (i32.const -2))
+(assert_return (invoke "rotr" (i32.const 0x7fffffff) (i32.const 0x80000000)) (i32.const 0x7fffffff))
+(assert_return (invoke "rotr" (i32.const 0x7fffffff) (i32.const 0x80000001)) (i32.const 0xbfffffff))
+(assert_return (invoke "rotr" (i32.const 0x7fffffff) (i32.const 0x40000000)) (i32.const 0x7fffffff))
+(assert_return (invoke "rotr" (i32.const 0x7fffffff) (i32.const 0x12345678)) (i32.const -129))
+(assert_return (invoke "rotr" (i32.const 0x7fffffff) (i32.const 0xedcba988)) (i32.const 0xff7fffff))
+(assert_return (invoke "rotr" (i32.const 0x7fffffff) (i32.const 31)) (i32.const -2))
+(assert_return (invoke "rotr" (i32.const 0x7fffffff) (i32.const 32)) (i32.const 0x7fffffff))
+(assert_return (invoke "rotr" (i32.const 0x7fffffff) (i32.const 33)) (i32.const 0xbfffffff))
+(assert_return (invoke "rotr" (i32.const 0x7fffffff) (i32.const 0xfff5a5a)) (i32.const -33))
+(assert_return (invoke "rotr" (i32.const 0x80000000) (i32.const 0)) (i32.const 0x80000000))
+(assert_return (invoke "rotr" (i32.const 0x80000000) (i32.const 1)) (i32.const 0x40000000))
+(assert_return (invoke "rotr" (i32.const 0x80000000) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "rotr" (i32.const 0x80000000) (i32.const 2)) (i32.const 0x20000000))
+(assert_return (invoke "rotr" (i32.const 0x80000000) (i32.const 3)) (i32.const 0x10000000))
+(assert_return (invoke "rotr" (i32.const 0x80000000) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "rotr" (i32.const 0x80000000) (i32.const 0x80000000)) (i32.const 0x80000000))
+(assert_return (invoke "rotr" (i32.const 0x80000000) (i32.const 0x80000001)) (i32.const 0x40000000))
+(assert_return (invoke "rotr" (i32.const 0x80000000) (i32.const 0x40000000)) (i32.const 0x80000000))
+(assert_return (invoke "rotr" (i32.const 0x80000000) (i32.const 0x12345678)) (i32.const 128))
+(assert_return (invoke "rotr" (i32.const 0x80000000) (i32.const 0xedcba988)) (i32.const 0x800000))
+(assert_return (invoke "rotr" (i32.const 0x80000000) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "rotr" (i32.const 0x80000000) (i32.const 32)) (i32.const 0x80000000))
+(assert_return (invoke "rotr" (i32.const 0x80000000) (i32.const 33)) (i32.const 0x40000000))
+(assert_return (invoke "rotr" (i32.const 0x80000000) (i32.const 0xfff5a5a)) (i32.const 32))
+(assert_return (invoke "rotr" (i32.const 0x80000001) (i32.const 0)) (i32.const 0x80000001))
+(assert_return (invoke "rotr" (i32.const 0x80000001) (i32.const 1)) (i32.const 0xc0000000))
+(assert_return (invoke "rotr" (i32.const 0x80000001) (i32.const -1)) (i32.const 3))
+(assert_return (invoke "rotr" (i32.const 0x80000001) (i32.const 2)) (i32.const 0x60000000))
+(assert_return (invoke "rotr" (i32.const 0x80000001) (i32.const 3)) (i32.const 0x30000000))
+(assert_return (invoke "rotr" (i32.const 0x80000001) (i32.const 0x7fffffff)) (i32.const 3))
+(assert_return (invoke "rotr" (i32.const 0x80000001) (i32.const 0x80000000)) (i32.const 0x80000001))
+(assert_return (invoke "rotr" (i32.const 0x80000001) (i32.const 0x80000001)) (i32.const 0xc0000000))
+(assert_return (invoke "rotr" (i32.const 0x80000001) (i32.const 0x40000000)) (i32.const 0x80000001))
+(assert_return (invoke "rotr" (i32.const 0x80000001) (i32.const 0x12345678)) (i32.const 384))
+(assert_return (invoke "rotr" (i32.const 0x80000001) (i32.const 0xedcba988)) (i32.const 0x1800000))
+(assert_return (invoke "rotr" (i32.const 0x80000001) (i32.const 31)) (i32.const 3))
+(assert_return (invoke "rotr" (i32.const 0x80000001) (i32.const 32)) (i32.const 0x80000001))
+(assert_return (invoke "rotr" (i32.const 0x80000001) (i32.const 33)) (i32.const 0xc0000000))
+(assert_return (invoke "rotr" (i32.const 0x80000001) (i32.const 0xfff5a5a)) (i32.const 96))
+(assert_return (invoke "rotr" (i32.const 0x40000000) (i32.const 0)) (i32.const 0x40000000))
+(assert_return (invoke "rotr" (i32.const 0x40000000) (i32.const 1)) (i32.const 0x20000000))
+(assert_return (invoke "rotr" (i32.const 0x40000000) (i32.const -1)) (i32.const 0x80000000))
+(assert_return (invoke "rotr" (i32.const 0x40000000) (i32.const 2)) (i32.const 0x10000000))
+(assert_return (invoke "rotr" (i32.const 0x40000000) (i32.const 3)) (i32.const 0x8000000))
+(assert_return (invoke "rotr" (i32.const 0x40000000) (i32.const 0x7fffffff)) (i32.const 0x80000000))
+(assert_return (invoke "rotr" (i32.const 0x40000000) (i32.const 0x80000000)) (i32.const 0x40000000))
+(assert_return (invoke "rotr" (i32.const 0x40000000) (i32.const 0x80000001)) (i32.const 0x20000000))
+(assert_return (invoke "rotr" (i32.const 0x40000000) (i32.const 0x40000000)) (i32.const 0x40000000))
+(assert_return (invoke "rotr" (i32.const 0x40000000) (i32.const 0x12345678)) (i32.const 64))
+(assert_return (invoke "rotr" (i32.const 0x40000000) (i32.const 0xedcba988)) (i32.const 0x400000))
+(assert_return (invoke "rotr" (i32.const 0x40000000) (i32.const 31)) (i32.const 0x80000000))
+(assert_return (invoke "rotr" (i32.const 0x40000000) (i32.const 32)) (i32.const 0x40000000))
+(assert_return (invoke "rotr" (i32.const 0x40000000) (i32.const 33)) (i32.const 0x20000000))
+(assert_return (invoke "rotr" (i32.const 0x40000000) (i32.const 0xfff5a5a)) (i32.const 16))
+(assert_return (invoke "rotr" (i32.const 0x12345678) (i32.const 0)) (i32.const 0x12345678))
+(assert_return (invoke "rotr" (i32.const 0x12345678) (i32.const 1)) (i32.const 0x91a2b3c))
+(assert_return (invoke "rotr" (i32.const 0x12345678) (i32.const -1)) (i32.const 0x2468acf0))
+(assert_return (invoke "rotr" (i32.const 0x12345678) (i32.const 2)) (i32.const 0x48d159e))
+(assert_return (invoke "rotr" (i32.const 0x12345678) (i32.const 3)) (i32.const 0x2468acf))
+(assert_return (invoke "rotr" (i32.const 0x12345678) (i32.const 0x7fffffff)) (i32.const 0x2468acf0))
+(assert_return (invoke "rotr" (i32.const 0x12345678) (i32.const 0x80000000)) (i32.const 0x12345678))
+(assert_return (invoke "rotr" (i32.const 0x12345678) (i32.const 0x80000001)) (i32.const 0x91a2b3c))
+(assert_return (invoke "rotr" (i32.const 0x12345678) (i32.const 0x40000000)) (i32.const 0x12345678))
+(assert_return (invoke "rotr" (i32.const 0x12345678) (i32.const 0x12345678)) (i32.const 0x34567812))
+(assert_return (invoke "rotr" (i32.const 0x12345678) (i32.const 0xedcba988)) (i32.const 0x78123456))
+(assert_return (invoke "rotr" (i32.const 0x12345678) (i32.const 31)) (i32.const 0x2468acf0))
+(assert_return (invoke "rotr" (i32.const 0x12345678) (i32.const 32)) (i32.const 0x12345678))
+(assert_return (invoke "rotr" (i32.const 0x12345678) (i32.const 33)) (i32.const 0x91a2b3c))
+(assert_return (invoke "rotr" (i32.const 0x12345678) (i32.const 0xfff5a5a)) (i32.const 0x8d159e04))
+(assert_return (invoke "rotr" (i32.const 0xedcba988) (i32.const 0)) (i32.const 0xedcba988))
+(assert_return (invoke "rotr" (i32.const 0xedcba988) (i32.const 1)) (i32.const 0x76e5d4c4))
+(assert_return (invoke "rotr" (i32.const 0xedcba988) (i32.const -1)) (i32.const 0xdb975311))
+(assert_return (invoke "rotr" (i32.const 0xedcba988) (i32.const 2)) (i32.const 0x3b72ea62))
+(assert_return (invoke "rotr" (i32.const 0xedcba988) (i32.const 3)) (i32.const 0x1db97531))
+(assert_return (invoke "rotr" (i32.const 0xedcba988) (i32.const 0x7fffffff)) (i32.const 0xdb975311))
+(assert_return (invoke "rotr" (i32.const 0xedcba988) (i32.const 0x80000000)) (i32.const 0xedcba988))
+(assert_return (invoke "rotr" (i32.const 0xedcba988) (i32.const 0x80000001)) (i32.const 0x76e5d4c4))
+(assert_return (invoke "rotr" (i32.const 0xedcba988) (i32.const 0x40000000)) (i32.const 0xedcba988))
+(assert_return (invoke "rotr" (i32.const 0xedcba988) (i32.const 0x12345678)) (i32.const 0xcba988ed))
+(assert_return (invoke "rotr" (i32.const 0xedcba988) (i32.const 0xedcba988)) (i32.const 0x88edcba9))
+(assert_return (invoke "rotr" (i32.const 0xedcba988) (i32.const 31)) (i32.const 0xdb975311))
+(assert_return (invoke "rotr" (i32.const 0xedcba988) (i32.const 32)) (i32.const 0xedcba988))
+(assert_return (invoke "rotr" (i32.const 0xedcba988) (i32.const 33)) (i32.const 0x76e5d4c4))
+(assert_return (invoke "rotr" (i32.const 0xedcba988) (i32.const 0xfff5a5a)) (i32.const 0x72ea623b))
+(assert_return (invoke "rotr" (i32.const 31) (i32.const 0)) (i32.const 31))
+(assert_return (invoke "rotr" (i32.const 31) (i32.const 1)) (i32.const 0x8000000f))
+(assert_return (invoke "rotr" (i32.const 31) (i32.const -1)) (i32.const 62))
+(assert_return (invoke "rotr" (i32.const 31) (i32.const 2)) (i32.const 0xc0000007))
+(assert_return (invoke "rotr" (i32.const 31) (i32.const 3)) (i32.const 0xe0000003))
+(assert_return (invoke "rotr" (i32.const 31) (i32.const 0x7fffffff)) (i32.const 62))
+(assert_return (invoke "rotr" (i32.const 31) (i32.const 0x80000000)) (i32.const 31))
+(assert_return (invoke "rotr" (i32.const 31) (i32.const 0x80000001)) (i32.const 0x8000000f))
+(assert_return (invoke "rotr" (i32.const 31) (i32.const 0x40000000)) (i32.const 31))
+(assert_return (invoke "rotr" (i32.const 31) (i32.const 0x12345678)) (i32.const 7936))
+(assert_return (invoke "rotr" (i32.const 31) (i32.const 0xedcba988)) (i32.const 0x1f000000))
+(assert_return (invoke "rotr" (i32.const 31) (i32.const 31)) (i32.const 62))
+(assert_return (invoke "rotr" (i32.const 31) (i32.const 32)) (i32.const 31))
+(assert_return (invoke "rotr" (i32.const 31) (i32.const 33)) (i32.const 0x8000000f))
+(assert_return (invoke "rotr" (i32.const 31) (i32.const 0xfff5a5a)) (i32.const 1984))
+(assert_return (invoke "rotr" (i32.const 32) (i32.const 0)) (i32.const 32))
+(assert_return (invoke "rotr" (i32.const 32) (i32.const 1)) (i32.const 16))
+(assert_return (invoke "rotr" (i32.const 32) (i32.const -1)) (i32.const 64))
+(assert_return (invoke "rotr" (i32.const 32) (i32.const 2)) (i32.const 8))
+(assert_return (invoke "rotr" (i32.const 32) (i32.const 3)) (i32.const 4))
+(assert_return (invoke "rotr" (i32.const 32) (i32.const 0x7fffffff)) (i32.const 64))
+(assert_return (invoke "rotr" (i32.const 32) (i32.const 0x80000000)) (i32.const 32))
+(assert_return (invoke "rotr" (i32.const 32) (i32.const 0x80000001)) (i32.const 16))
+(assert_return (invoke "rotr" (i32.const 32) (i32.const 0x40000000)) (i32.const 32))
+(assert_return (invoke "rotr" (i32.const 32) (i32.const 0x12345678)) (i32.const 8192))
+(assert_return (invoke "rotr" (i32.const 32) (i32.const 0xedcba988)) (i32.const 0x20000000))
+(assert_return (invoke "rotr" (i32.const 32) (i32.const 31)) (i32.const 64))
+(assert_return (invoke "rotr" (i32.const 32) (i32.const 32)) (i32.const 32))
+(assert_return (invoke "rotr" (i32.const 32) (i32.const 33)) (i32.const 16))
+(assert_return (invoke "rotr" (i32.const 32) (i32.const 0xfff5a5a)) (i32.const 2048))
+(assert_return (invoke "rotr" (i32.const 33) (i32.const 0)) (i32.const 33))
+(assert_return (invoke "rotr" (i32.const 33) (i32.const 1)) (i32.const 0x80000010))
+(assert_return (invoke "rotr" (i32.const 33) (i32.const -1)) (i32.const 66))
+(assert_return (invoke "rotr" (i32.const 33) (i32.const 2)) (i32.const 0x40000008))
+(assert_return (invoke "rotr" (i32.const 33) (i32.const 3)) (i32.const 0x20000004))
+(assert_return (invoke "rotr" (i32.const 33) (i32.const 0x7fffffff)) (i32.const 66))
+(assert_return (invoke "rotr" (i32.const 33) (i32.const 0x80000000)) (i32.const 33))
+(assert_return (invoke "rotr" (i32.const 33) (i32.const 0x80000001)) (i32.const 0x80000010))
+(assert_return (invoke "rotr" (i32.const 33) (i32.const 0x40000000)) (i32.const 33))
+(assert_return (invoke "rotr" (i32.const 33) (i32.const 0x12345678)) (i32.const 8448))
+(assert_return (invoke "rotr" (i32.const 33) (i32.const 0xedcba988)) (i32.const 0x21000000))
+(assert_return (invoke "rotr" (i32.const 33) (i32.const 31)) (i32.const 66))
+(assert_return (invoke "rotr" (i32.const 33) (i32.const 32)) (i32.const 33))
+(assert_return (invoke "rotr" (i32.const 33) (i32.const 33)) (i32.const 0x80000010))
+(assert_return (invoke "rotr" (i32.const 33) (i32.const 0xfff5a5a)) (i32.const 2112))
+(assert_return (invoke "rotr" (i32.const 0xfff5a5a) (i32.const 0)) (i32.const 0xfff5a5a))
+(assert_return (invoke "rotr" (i32.const 0xfff5a5a) (i32.const 1)) (i32.const 0x7ffad2d))
+(assert_return (invoke "rotr" (i32.const 0xfff5a5a) (i32.const -1)) (i32.const 0x1ffeb4b4))
+(assert_return (invoke "rotr" (i32.const 0xfff5a5a) (i32.const 2)) (i32.const 0x83ffd696))
+(assert_return (invoke "rotr" (i32.const 0xfff5a5a) (i32.const 3)) (i32.const 0x41ffeb4b))
+(assert_return (invoke "rotr" (i32.const 0xfff5a5a) (i32.const 0x7fffffff)) (i32.const 0x1ffeb4b4))
+(assert_return (invoke "rotr" (i32.const 0xfff5a5a) (i32.const 0x80000000)) (i32.const 0xfff5a5a))
+(assert_return (invoke "rotr" (i32.const 0xfff5a5a) (i32.const 0x80000001)) (i32.const 0x7ffad2d))
+(assert_return (invoke "rotr" (i32.const 0xfff5a5a) (i32.const 0x40000000)) (i32.const 0xfff5a5a))
+(assert_return (invoke "rotr" (i32.const 0xfff5a5a) (i32.const 0x12345678)) (i32.const 0xff5a5a0f))
+(assert_return (invoke "rotr" (i32.const 0xfff5a5a) (i32.const 0xedcba988)) (i32.const 0x5a0fff5a))
+(assert_return (invoke "rotr" (i32.const 0xfff5a5a) (i32.const 31)) (i32.const 0x1ffeb4b4))
+(assert_return (invoke "rotr" (i32.const 0xfff5a5a) (i32.const 32)) (i32.const 0xfff5a5a))
+(assert_return (invoke "rotr" (i32.const 0xfff5a5a) (i32.const 33)) (i32.const 0x7ffad2d))
+(assert_return (invoke "rotr" (i32.const 0xfff5a5a) (i32.const 0xfff5a5a)) (i32.const 0xffd69683))
+(assert_return (invoke "rotr" (i32.const 0xd744ac66) (i32.const 0x725249b5)) (i32.const 0x256336ba))
+(assert_return (invoke "rotr" (i32.const 0x485c2466) (i32.const 0x6b395c3b)) (i32.const 0xb848cc9))
+(assert_return (invoke "rotr" (i32.const 0xab88eccb) (i32.const 0x46b84fc)) (i32.const 0xb88eccba))
+(assert_return (invoke "rotr" (i32.const 0xa02c7d36) (i32.const 0x1f3681d6)) (i32.const 0xb1f4da80))
+(assert_return (invoke "rotr" (i32.const 0xae6ff0eb) (i32.const 0x65f7d161)) (i32.const 0xd737f875))
+(assert_return (invoke "rotr" (i32.const 0xdf37e9c0) (i32.const 0x5e69dcd2)) (i32.const 0xfa7037cd))
+(assert_return (invoke "rotr" (i32.const 0xb217ece4) (i32.const 0xfe3674d)) (i32.const 0x672590bf))
+(assert_return (invoke "rotr" (i32.const 0x145cc2c7) (i32.const 0x8d3f74bc)) (i32.const 0x45cc2c71))
+(assert_return (invoke "rotr" (i32.const 0x9df9c2e5) (i32.const 0x4020128)) (i32.const 0xe59df9c2))
+(assert_return (invoke "rotr" (i32.const 0x59314316) (i32.const 0xf9364fbc)) (i32.const 0x93143165))
+(assert_return (invoke "rotr" (i32.const 0x6aab00fd) (i32.const 0x327c3d3f)) (i32.const 0xd55601fa))
+(assert_return (invoke "rotr" (i32.const 0xacc5c54b) (i32.const 0xb0308afa)) (i32.const 0x317152eb))
+(assert_return (invoke "rotr" (i32.const 0x2c755b17) (i32.const 0x1f25acb2)) (i32.const 0x56c5cb1d))
+(assert_return (invoke "rotr" (i32.const 0xa086520f) (i32.const 0xbd848e26)) (i32.const 0x3e821948))
+(assert_return (invoke "rotr" (i32.const 0xf030f1ee) (i32.const 0xe3778a8f)) (i32.const 0xe3dde061))
+(assert_return (invoke "rotr" (i32.const 0x13931d2d) (i32.const 0xd33b80fb)) (i32.const 0x7263a5a2))
+
+(assert_return (invoke "clz" (i32.const 0)) (i32.const 32))
+(assert_return (invoke "clz" (i32.const 1)) (i32.const 31))
+(assert_return (invoke "clz" (i32.const -1)) (i32.const 0))
+(assert_return (invoke "clz" (i32.const 2)) (i32.const 30))
+(assert_return (invoke "clz" (i32.const 3)) (i32.const 30))
+(assert_return (invoke "clz" (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "clz" (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "clz" (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "clz" (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "clz" (i32.const 0x12345678)) (i32.const 3))
+(assert_return (invoke "clz" (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "clz" (i32.const 31)) (i32.const 27))
+(assert_return (invoke "clz" (i32.const 32)) (i32.const 26))
+(assert_return (invoke "clz" (i32.const 33)) (i32.const 26))
+(assert_return (invoke "clz" (i32.const 0xfff5a5a)) (i32.const 4))
+(assert_return (invoke "clz" (i32.const 1)) (i32.const 31))
+(assert_return (invoke "clz" (i32.const 128)) (i32.const 24))
+(assert_return (invoke "clz" (i32.const 16384)) (i32.const 17))
+(assert_return (invoke "clz" (i32.const 0x200000)) (i32.const 10))
+(assert_return (invoke "clz" (i32.const 0x10000000)) (i32.const 3))
+(assert_return (invoke "clz" (i32.const -1)) (i32.const 0))
+(assert_return (invoke "clz" (i32.const 0x7ffffff)) (i32.const 5))
+(assert_return (invoke "clz" (i32.const 0x3fffff)) (i32.const 10))
+(assert_return (invoke "clz" (i32.const 0x1ffff)) (i32.const 15))
+(assert_return (invoke "clz" (i32.const 4095)) (i32.const 20))
+(assert_return (invoke "clz" (i32.const 127)) (i32.const 25))
+(assert_return (invoke "clz" (i32.const 3)) (i32.const 30))
+(assert_return (invoke "clz" (i32.const 0xd9f9796e)) (i32.const 0))
+(assert_return (invoke "clz" (i32.const 0x884d9d68)) (i32.const 0))
+(assert_return (invoke "clz" (i32.const 0xb53e1838)) (i32.const 0))
+(assert_return (invoke "clz" (i32.const 0x29948b00)) (i32.const 2))
+(assert_return (invoke "clz" (i32.const 0x677853f0)) (i32.const 1))
+(assert_return (invoke "clz" (i32.const 0xca528a9a)) (i32.const 0))
+(assert_return (invoke "clz" (i32.const 0xc4088632)) (i32.const 0))
+(assert_return (invoke "clz" (i32.const 0xd1997e9e)) (i32.const 0))
+
+(assert_return (invoke "ctz" (i32.const 0)) (i32.const 32))
+(assert_return (invoke "ctz" (i32.const 1)) (i32.const 0))
+(assert_return (invoke "ctz" (i32.const -1)) (i32.const 0))
+(assert_return (invoke "ctz" (i32.const 2)) (i32.const 1))
+(assert_return (invoke "ctz" (i32.const 3)) (i32.const 0))
+(assert_return (invoke "ctz" (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "ctz" (i32.const 0x80000000)) (i32.const 31))
+(assert_return (invoke "ctz" (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "ctz" (i32.const 0x40000000)) (i32.const 30))
+(assert_return (invoke "ctz" (i32.const 0x12345678)) (i32.const 3))
+(assert_return (invoke "ctz" (i32.const 0xedcba988)) (i32.const 3))
+(assert_return (invoke "ctz" (i32.const 31)) (i32.const 0))
+(assert_return (invoke "ctz" (i32.const 32)) (i32.const 5))
+(assert_return (invoke "ctz" (i32.const 33)) (i32.const 0))
+(assert_return (invoke "ctz" (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "ctz" (i32.const 1)) (i32.const 0))
+(assert_return (invoke "ctz" (i32.const 128)) (i32.const 7))
+(assert_return (invoke "ctz" (i32.const 16384)) (i32.const 14))
+(assert_return (invoke "ctz" (i32.const 0x200000)) (i32.const 21))
+(assert_return (invoke "ctz" (i32.const 0x10000000)) (i32.const 28))
+(assert_return (invoke "ctz" (i32.const -1)) (i32.const 0))
+(assert_return (invoke "ctz" (i32.const 0x7ffffff)) (i32.const 0))
+(assert_return (invoke "ctz" (i32.const 0x3fffff)) (i32.const 0))
+(assert_return (invoke "ctz" (i32.const 0x1ffff)) (i32.const 0))
+(assert_return (invoke "ctz" (i32.const 4095)) (i32.const 0))
+(assert_return (invoke "ctz" (i32.const 127)) (i32.const 0))
+(assert_return (invoke "ctz" (i32.const 3)) (i32.const 0))
+(assert_return (invoke "ctz" (i32.const 0xd9f9796e)) (i32.const 1))
+(assert_return (invoke "ctz" (i32.const 0x884d9d68)) (i32.const 3))
+(assert_return (invoke "ctz" (i32.const 0xb53e1838)) (i32.const 3))
+(assert_return (invoke "ctz" (i32.const 0x29948b00)) (i32.const 8))
+(assert_return (invoke "ctz" (i32.const 0x677853f0)) (i32.const 4))
+(assert_return (invoke "ctz" (i32.const 0xca528a9a)) (i32.const 1))
+(assert_return (invoke "ctz" (i32.const 0xc4088632)) (i32.const 1))
+(assert_return (invoke "ctz" (i32.const 0xd1997e9e)) (i32.const 1))
+
+(assert_return (invoke "popcnt" (i32.const 0)) (i32.const 0))
+(assert_return (invoke "popcnt" (i32.const 1)) (i32.const 1))
+(assert_return (invoke "popcnt" (i32.const -1)) (i32.const 32))
+(assert_return (invoke "popcnt" (i32.const 2)) (i32.const 1))
+(assert_return (invoke "popcnt" (i32.const 3)) (i32.const 2))
+(assert_return (invoke "popcnt" (i32.const 0x7fffffff)) (i32.const 31))
+(assert_return (invoke "popcnt" (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "popcnt" (i32.const 0x80000001)) (i32.const 2))
+(assert_return (invoke "popcnt" (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "popcnt" (i32.const 0x12345678)) (i32.const 13))
+(assert_return (invoke "popcnt" (i32.const 0xedcba988)) (i32.const 17))
+(assert_return (invoke "popcnt" (i32.const 31)) (i32.const 5))
+(assert_return (invoke "popcnt" (i32.const 32)) (i32.const 1))
+(assert_return (invoke "popcnt" (i32.const 33)) (i32.const 2))
+(assert_return (invoke "popcnt" (i32.const 0xfff5a5a)) (i32.const 20))
+(assert_return (invoke "popcnt" (i32.const 1)) (i32.const 1))
+(assert_return (invoke "popcnt" (i32.const 128)) (i32.const 1))
+(assert_return (invoke "popcnt" (i32.const 16384)) (i32.const 1))
+(assert_return (invoke "popcnt" (i32.const 0x200000)) (i32.const 1))
+(assert_return (invoke "popcnt" (i32.const 0x10000000)) (i32.const 1))
+(assert_return (invoke "popcnt" (i32.const -1)) (i32.const 32))
+(assert_return (invoke "popcnt" (i32.const 0x7ffffff)) (i32.const 27))
+(assert_return (invoke "popcnt" (i32.const 0x3fffff)) (i32.const 22))
+(assert_return (invoke "popcnt" (i32.const 0x1ffff)) (i32.const 17))
+(assert_return (invoke "popcnt" (i32.const 4095)) (i32.const 12))
+(assert_return (invoke "popcnt" (i32.const 127)) (i32.const 7))
+(assert_return (invoke "popcnt" (i32.const 3)) (i32.const 2))
+(assert_return (invoke "popcnt" (i32.const 0xd9f9796e)) (i32.const 21))
+(assert_return (invoke "popcnt" (i32.const 0x884d9d68)) (i32.const 14))
+(assert_return (invoke "popcnt" (i32.const 0xb53e1838)) (i32.const 15))
+(assert_return (invoke "popcnt" (i32.const 0x29948b00)) (i32.const 10))
+(assert_return (invoke "popcnt" (i32.const 0x677853f0)) (i32.const 17))
+(assert_return (invoke "popcnt" (i32.const 0xca528a9a)) (i32.const 14))
+(assert_return (invoke "popcnt" (i32.const 0xc4088632)) (i32.const 10))
+(assert_return (invoke "popcnt" (i32.const 0xd1997e9e)) (i32.const 19))
+
+(assert_return (invoke "eqz" (i32.const 0)) (i32.const 1))
+(assert_return (invoke "eqz" (i32.const 1)) (i32.const 0))
+(assert_return (invoke "eqz" (i32.const -1)) (i32.const 0))
+(assert_return (invoke "eqz" (i32.const 2)) (i32.const 0))
+(assert_return (invoke "eqz" (i32.const 3)) (i32.const 0))
+(assert_return (invoke "eqz" (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "eqz" (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "eqz" (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "eqz" (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "eqz" (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "eqz" (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "eqz" (i32.const 31)) (i32.const 0))
+(assert_return (invoke "eqz" (i32.const 32)) (i32.const 0))
+(assert_return (invoke "eqz" (i32.const 33)) (i32.const 0))
+(assert_return (invoke "eqz" (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "eqz" (i32.const 1)) (i32.const 0))
+(assert_return (invoke "eqz" (i32.const 128)) (i32.const 0))
+(assert_return (invoke "eqz" (i32.const 16384)) (i32.const 0))
+(assert_return (invoke "eqz" (i32.const 0x200000)) (i32.const 0))
+(assert_return (invoke "eqz" (i32.const 0x10000000)) (i32.const 0))
+(assert_return (invoke "eqz" (i32.const -1)) (i32.const 0))
+(assert_return (invoke "eqz" (i32.const 0x7ffffff)) (i32.const 0))
+(assert_return (invoke "eqz" (i32.const 0x3fffff)) (i32.const 0))
+(assert_return (invoke "eqz" (i32.const 0x1ffff)) (i32.const 0))
+(assert_return (invoke "eqz" (i32.const 4095)) (i32.const 0))
+(assert_return (invoke "eqz" (i32.const 127)) (i32.const 0))
+(assert_return (invoke "eqz" (i32.const 3)) (i32.const 0))
+(assert_return (invoke "eqz" (i32.const 0xd9f9796e)) (i32.const 0))
+(assert_return (invoke "eqz" (i32.const 0x884d9d68)) (i32.const 0))
+(assert_return (invoke "eqz" (i32.const 0xb53e1838)) (i32.const 0))
+(assert_return (invoke "eqz" (i32.const 0x29948b00)) (i32.const 0))
+(assert_return (invoke "eqz" (i32.const 0x677853f0)) (i32.const 0))
+(assert_return (invoke "eqz" (i32.const 0xca528a9a)) (i32.const 0))
+(assert_return (invoke "eqz" (i32.const 0xc4088632)) (i32.const 0))
+(assert_return (invoke "eqz" (i32.const 0xd1997e9e)) (i32.const 0))
+
+(assert_return (invoke "eq" (i32.const 0) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "eq" (i32.const 0) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 1) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 1) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "eq" (i32.const 1) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 1) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 1) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 1) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 1) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 1) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 1) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 1) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 1) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 1) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 1) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 1) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 1) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const -1) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const -1) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const -1) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "eq" (i32.const -1) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const -1) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const -1) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const -1) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const -1) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const -1) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const -1) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const -1) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const -1) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const -1) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const -1) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const -1) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 2) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 2) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 2) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 2) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "eq" (i32.const 2) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 2) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 2) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 2) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 2) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 2) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 2) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 2) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 2) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 2) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 2) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 3) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 3) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 3) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 3) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 3) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "eq" (i32.const 3) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 3) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 3) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 3) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 3) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 3) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 3) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 3) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 3) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 3) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x7fffffff) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x7fffffff) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x7fffffff) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x7fffffff) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x7fffffff) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x7fffffff) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "eq" (i32.const 0x7fffffff) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x7fffffff) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x7fffffff) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x7fffffff) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x7fffffff) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x7fffffff) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x7fffffff) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x7fffffff) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x7fffffff) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x80000000) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x80000000) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x80000000) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x80000000) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x80000000) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x80000000) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x80000000) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "eq" (i32.const 0x80000000) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x80000000) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x80000000) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x80000000) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x80000000) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x80000000) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x80000000) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x80000000) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x80000001) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x80000001) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x80000001) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x80000001) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x80000001) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x80000001) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x80000001) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x80000001) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "eq" (i32.const 0x80000001) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x80000001) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x80000001) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x80000001) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x80000001) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x80000001) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x80000001) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x40000000) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x40000000) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x40000000) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x40000000) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x40000000) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x40000000) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x40000000) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x40000000) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x40000000) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "eq" (i32.const 0x40000000) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x40000000) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x40000000) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x40000000) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x40000000) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x40000000) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x12345678) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x12345678) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x12345678) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x12345678) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x12345678) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x12345678) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x12345678) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x12345678) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x12345678) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x12345678) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "eq" (i32.const 0x12345678) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x12345678) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x12345678) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x12345678) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0x12345678) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0xedcba988) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0xedcba988) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0xedcba988) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0xedcba988) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0xedcba988) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0xedcba988) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0xedcba988) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0xedcba988) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0xedcba988) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0xedcba988) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0xedcba988) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "eq" (i32.const 0xedcba988) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0xedcba988) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0xedcba988) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0xedcba988) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 31) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 31) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 31) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 31) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 31) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 31) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 31) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 31) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 31) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 31) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 31) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 31) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "eq" (i32.const 31) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 31) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 31) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 32) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 32) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 32) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 32) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 32) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 32) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 32) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 32) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 32) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 32) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 32) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 32) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 32) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "eq" (i32.const 32) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 32) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 33) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 33) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 33) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 33) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 33) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 33) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 33) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 33) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 33) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 33) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 33) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 33) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 33) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 33) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "eq" (i32.const 33) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0xfff5a5a) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0xfff5a5a) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0xfff5a5a) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0xfff5a5a) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0xfff5a5a) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0xfff5a5a) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0xfff5a5a) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0xfff5a5a) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0xfff5a5a) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0xfff5a5a) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0xfff5a5a) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0xfff5a5a) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0xfff5a5a) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0xfff5a5a) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "eq" (i32.const 0xfff5a5a) (i32.const 0xfff5a5a)) (i32.const 1))
+
+(assert_return (invoke "ne" (i32.const 0) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "ne" (i32.const 0) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 1) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 1) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "ne" (i32.const 1) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 1) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 1) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 1) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 1) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 1) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 1) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 1) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 1) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 1) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 1) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 1) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 1) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const -1) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const -1) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const -1) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "ne" (i32.const -1) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const -1) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const -1) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const -1) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const -1) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const -1) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const -1) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const -1) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const -1) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const -1) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const -1) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const -1) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 2) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 2) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 2) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 2) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "ne" (i32.const 2) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 2) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 2) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 2) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 2) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 2) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 2) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 2) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 2) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 2) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 2) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 3) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 3) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 3) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 3) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 3) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "ne" (i32.const 3) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 3) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 3) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 3) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 3) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 3) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 3) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 3) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 3) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 3) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x7fffffff) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x7fffffff) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x7fffffff) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x7fffffff) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x7fffffff) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x7fffffff) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "ne" (i32.const 0x7fffffff) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x7fffffff) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x7fffffff) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x7fffffff) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x7fffffff) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x7fffffff) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x7fffffff) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x7fffffff) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x7fffffff) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x80000000) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x80000000) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x80000000) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x80000000) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x80000000) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x80000000) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x80000000) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "ne" (i32.const 0x80000000) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x80000000) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x80000000) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x80000000) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x80000000) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x80000000) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x80000000) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x80000000) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x80000001) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x80000001) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x80000001) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x80000001) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x80000001) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x80000001) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x80000001) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x80000001) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "ne" (i32.const 0x80000001) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x80000001) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x80000001) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x80000001) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x80000001) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x80000001) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x80000001) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x40000000) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x40000000) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x40000000) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x40000000) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x40000000) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x40000000) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x40000000) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x40000000) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x40000000) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "ne" (i32.const 0x40000000) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x40000000) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x40000000) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x40000000) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x40000000) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x40000000) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x12345678) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x12345678) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x12345678) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x12345678) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x12345678) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x12345678) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x12345678) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x12345678) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x12345678) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x12345678) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "ne" (i32.const 0x12345678) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x12345678) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x12345678) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x12345678) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0x12345678) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0xedcba988) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0xedcba988) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0xedcba988) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0xedcba988) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0xedcba988) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0xedcba988) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0xedcba988) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0xedcba988) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0xedcba988) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0xedcba988) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0xedcba988) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "ne" (i32.const 0xedcba988) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0xedcba988) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0xedcba988) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0xedcba988) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 31) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 31) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 31) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 31) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 31) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 31) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 31) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 31) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 31) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 31) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 31) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 31) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "ne" (i32.const 31) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 31) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 31) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 32) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 32) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 32) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 32) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 32) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 32) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 32) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 32) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 32) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 32) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 32) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 32) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 32) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "ne" (i32.const 32) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 32) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 33) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 33) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 33) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 33) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 33) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 33) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 33) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 33) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 33) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 33) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 33) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 33) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 33) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 33) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "ne" (i32.const 33) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0xfff5a5a) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0xfff5a5a) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0xfff5a5a) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0xfff5a5a) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0xfff5a5a) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0xfff5a5a) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0xfff5a5a) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0xfff5a5a) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0xfff5a5a) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0xfff5a5a) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0xfff5a5a) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0xfff5a5a) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0xfff5a5a) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0xfff5a5a) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "ne" (i32.const 0xfff5a5a) (i32.const 0xfff5a5a)) (i32.const 0))
+
+(assert_return (invoke "lt_s" (i32.const 0) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 1) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 1) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 1) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 1) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 1) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 1) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 1) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 1) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 1) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 1) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 1) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 1) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 1) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 1) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 1) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const -1) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const -1) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const -1) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const -1) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const -1) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const -1) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const -1) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const -1) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const -1) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const -1) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const -1) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const -1) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const -1) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const -1) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const -1) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 2) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 2) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 2) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 2) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 2) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 2) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 2) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 2) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 2) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 2) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 2) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 2) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 2) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 2) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 2) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 3) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 3) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 3) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 3) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 3) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 3) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 3) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 3) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 3) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 3) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 3) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 3) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 3) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 3) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 3) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0x7fffffff) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0x7fffffff) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0x7fffffff) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0x7fffffff) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0x7fffffff) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0x7fffffff) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0x7fffffff) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0x7fffffff) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0x7fffffff) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0x7fffffff) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0x7fffffff) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0x7fffffff) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0x7fffffff) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0x7fffffff) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0x7fffffff) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0x80000000) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0x80000000) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0x80000000) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0x80000000) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0x80000000) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0x80000000) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0x80000000) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0x80000000) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0x80000000) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0x80000000) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0x80000000) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0x80000000) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0x80000000) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0x80000000) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0x80000000) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0x80000001) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0x80000001) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0x80000001) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0x80000001) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0x80000001) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0x80000001) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0x80000001) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0x80000001) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0x80000001) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0x80000001) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0x80000001) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0x80000001) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0x80000001) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0x80000001) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0x80000001) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0x40000000) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0x40000000) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0x40000000) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0x40000000) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0x40000000) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0x40000000) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0x40000000) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0x40000000) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0x40000000) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0x40000000) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0x40000000) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0x40000000) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0x40000000) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0x40000000) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0x40000000) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0x12345678) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0x12345678) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0x12345678) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0x12345678) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0x12345678) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0x12345678) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0x12345678) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0x12345678) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0x12345678) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0x12345678) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0x12345678) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0x12345678) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0x12345678) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0x12345678) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0x12345678) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0xedcba988) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0xedcba988) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0xedcba988) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0xedcba988) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0xedcba988) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0xedcba988) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0xedcba988) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0xedcba988) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0xedcba988) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0xedcba988) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0xedcba988) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0xedcba988) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0xedcba988) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0xedcba988) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0xedcba988) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 31) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 31) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 31) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 31) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 31) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 31) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 31) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 31) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 31) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 31) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 31) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 31) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 31) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 31) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 31) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 32) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 32) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 32) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 32) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 32) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 32) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 32) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 32) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 32) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 32) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 32) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 32) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 32) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 32) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 32) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 33) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 33) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 33) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 33) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 33) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 33) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 33) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 33) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 33) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 33) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 33) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 33) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 33) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 33) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 33) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0xfff5a5a) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0xfff5a5a) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0xfff5a5a) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0xfff5a5a) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0xfff5a5a) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0xfff5a5a) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0xfff5a5a) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0xfff5a5a) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0xfff5a5a) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0xfff5a5a) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "lt_s" (i32.const 0xfff5a5a) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0xfff5a5a) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0xfff5a5a) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0xfff5a5a) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "lt_s" (i32.const 0xfff5a5a) (i32.const 0xfff5a5a)) (i32.const 0))
+
+(assert_return (invoke "lt_u" (i32.const 0) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 0) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 0) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 0) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 0) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 0) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 0) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 0) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 0) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 0) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 0) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 0) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 0) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 0) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 1) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 1) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 1) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 1) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 1) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 1) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 1) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 1) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 1) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 1) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 1) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 1) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 1) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 1) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 1) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const -1) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const -1) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const -1) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const -1) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const -1) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const -1) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const -1) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const -1) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const -1) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const -1) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const -1) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const -1) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const -1) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const -1) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const -1) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 2) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 2) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 2) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 2) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 2) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 2) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 2) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 2) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 2) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 2) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 2) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 2) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 2) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 2) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 2) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 3) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 3) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 3) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 3) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 3) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 3) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 3) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 3) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 3) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 3) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 3) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 3) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 3) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 3) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 3) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 0x7fffffff) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x7fffffff) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x7fffffff) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 0x7fffffff) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x7fffffff) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x7fffffff) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x7fffffff) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 0x7fffffff) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 0x7fffffff) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x7fffffff) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x7fffffff) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 0x7fffffff) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x7fffffff) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x7fffffff) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x7fffffff) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x80000000) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x80000000) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x80000000) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 0x80000000) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x80000000) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x80000000) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x80000000) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x80000000) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 0x80000000) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x80000000) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x80000000) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 0x80000000) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x80000000) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x80000000) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x80000000) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x80000001) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x80000001) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x80000001) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 0x80000001) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x80000001) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x80000001) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x80000001) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x80000001) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x80000001) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x80000001) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x80000001) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 0x80000001) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x80000001) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x80000001) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x80000001) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x40000000) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x40000000) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x40000000) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 0x40000000) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x40000000) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x40000000) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 0x40000000) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 0x40000000) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 0x40000000) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x40000000) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x40000000) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 0x40000000) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x40000000) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x40000000) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x40000000) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x12345678) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x12345678) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x12345678) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 0x12345678) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x12345678) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x12345678) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 0x12345678) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 0x12345678) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 0x12345678) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 0x12345678) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x12345678) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 0x12345678) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x12345678) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x12345678) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0x12345678) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0xedcba988) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0xedcba988) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0xedcba988) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 0xedcba988) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0xedcba988) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0xedcba988) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0xedcba988) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0xedcba988) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0xedcba988) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0xedcba988) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0xedcba988) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0xedcba988) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0xedcba988) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0xedcba988) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0xedcba988) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 31) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 31) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 31) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 31) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 31) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 31) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 31) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 31) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 31) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 31) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 31) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 31) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 31) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 31) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 31) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 32) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 32) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 32) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 32) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 32) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 32) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 32) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 32) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 32) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 32) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 32) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 32) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 32) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 32) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 32) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 33) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 33) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 33) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 33) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 33) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 33) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 33) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 33) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 33) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 33) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 33) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 33) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 33) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 33) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 33) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 0xfff5a5a) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0xfff5a5a) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0xfff5a5a) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 0xfff5a5a) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0xfff5a5a) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0xfff5a5a) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 0xfff5a5a) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 0xfff5a5a) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 0xfff5a5a) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 0xfff5a5a) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 0xfff5a5a) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "lt_u" (i32.const 0xfff5a5a) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0xfff5a5a) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0xfff5a5a) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "lt_u" (i32.const 0xfff5a5a) (i32.const 0xfff5a5a)) (i32.const 0))
+
+(assert_return (invoke "le_s" (i32.const 0) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 1) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 1) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 1) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 1) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 1) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 1) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 1) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 1) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 1) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 1) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 1) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 1) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 1) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 1) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 1) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const -1) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const -1) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const -1) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const -1) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const -1) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const -1) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const -1) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const -1) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const -1) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const -1) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const -1) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const -1) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const -1) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const -1) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const -1) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 2) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 2) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 2) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 2) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 2) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 2) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 2) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 2) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 2) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 2) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 2) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 2) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 2) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 2) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 2) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 3) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 3) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 3) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 3) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 3) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 3) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 3) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 3) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 3) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 3) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 3) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 3) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 3) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 3) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 3) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0x7fffffff) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0x7fffffff) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0x7fffffff) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0x7fffffff) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0x7fffffff) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0x7fffffff) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0x7fffffff) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0x7fffffff) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0x7fffffff) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0x7fffffff) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0x7fffffff) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0x7fffffff) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0x7fffffff) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0x7fffffff) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0x7fffffff) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0x80000000) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0x80000000) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0x80000000) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0x80000000) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0x80000000) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0x80000000) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0x80000000) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0x80000000) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0x80000000) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0x80000000) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0x80000000) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0x80000000) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0x80000000) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0x80000000) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0x80000000) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0x80000001) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0x80000001) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0x80000001) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0x80000001) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0x80000001) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0x80000001) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0x80000001) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0x80000001) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0x80000001) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0x80000001) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0x80000001) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0x80000001) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0x80000001) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0x80000001) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0x80000001) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0x40000000) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0x40000000) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0x40000000) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0x40000000) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0x40000000) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0x40000000) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0x40000000) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0x40000000) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0x40000000) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0x40000000) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0x40000000) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0x40000000) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0x40000000) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0x40000000) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0x40000000) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0x12345678) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0x12345678) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0x12345678) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0x12345678) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0x12345678) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0x12345678) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0x12345678) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0x12345678) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0x12345678) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0x12345678) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0x12345678) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0x12345678) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0x12345678) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0x12345678) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0x12345678) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0xedcba988) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0xedcba988) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0xedcba988) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0xedcba988) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0xedcba988) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0xedcba988) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0xedcba988) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0xedcba988) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0xedcba988) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0xedcba988) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0xedcba988) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0xedcba988) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0xedcba988) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0xedcba988) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0xedcba988) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 31) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 31) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 31) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 31) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 31) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 31) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 31) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 31) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 31) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 31) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 31) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 31) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 31) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 31) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 31) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 32) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 32) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 32) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 32) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 32) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 32) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 32) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 32) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 32) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 32) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 32) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 32) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 32) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 32) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 32) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 33) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 33) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 33) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 33) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 33) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 33) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 33) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 33) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 33) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 33) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 33) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 33) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 33) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 33) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 33) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0xfff5a5a) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0xfff5a5a) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0xfff5a5a) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0xfff5a5a) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0xfff5a5a) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0xfff5a5a) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0xfff5a5a) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0xfff5a5a) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0xfff5a5a) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0xfff5a5a) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "le_s" (i32.const 0xfff5a5a) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0xfff5a5a) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0xfff5a5a) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0xfff5a5a) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "le_s" (i32.const 0xfff5a5a) (i32.const 0xfff5a5a)) (i32.const 1))
+
+(assert_return (invoke "le_u" (i32.const 0) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 0) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 0) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 0) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 0) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 0) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 0) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 0) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 0) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 0) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 0) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 0) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 0) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 0) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 0) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 1) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 1) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 1) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 1) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 1) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 1) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 1) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 1) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 1) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 1) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 1) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 1) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 1) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 1) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 1) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const -1) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const -1) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const -1) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const -1) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const -1) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const -1) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const -1) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const -1) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const -1) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const -1) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const -1) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const -1) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const -1) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const -1) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const -1) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 2) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 2) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 2) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 2) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 2) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 2) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 2) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 2) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 2) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 2) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 2) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 2) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 2) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 2) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 2) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 3) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 3) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 3) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 3) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 3) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 3) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 3) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 3) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 3) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 3) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 3) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 3) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 3) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 3) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 3) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 0x7fffffff) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0x7fffffff) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0x7fffffff) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 0x7fffffff) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0x7fffffff) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0x7fffffff) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 0x7fffffff) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 0x7fffffff) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 0x7fffffff) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0x7fffffff) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0x7fffffff) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 0x7fffffff) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0x7fffffff) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0x7fffffff) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0x7fffffff) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0x80000000) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0x80000000) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0x80000000) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 0x80000000) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0x80000000) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0x80000000) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0x80000000) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 0x80000000) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 0x80000000) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0x80000000) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0x80000000) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 0x80000000) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0x80000000) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0x80000000) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0x80000000) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0x80000001) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0x80000001) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0x80000001) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 0x80000001) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0x80000001) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0x80000001) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0x80000001) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0x80000001) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 0x80000001) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0x80000001) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0x80000001) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 0x80000001) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0x80000001) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0x80000001) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0x80000001) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0x40000000) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0x40000000) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0x40000000) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 0x40000000) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0x40000000) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0x40000000) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 0x40000000) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 0x40000000) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 0x40000000) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 0x40000000) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0x40000000) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 0x40000000) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0x40000000) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0x40000000) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0x40000000) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0x12345678) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0x12345678) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0x12345678) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 0x12345678) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0x12345678) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0x12345678) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 0x12345678) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 0x12345678) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 0x12345678) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 0x12345678) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 0x12345678) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 0x12345678) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0x12345678) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0x12345678) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0x12345678) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0xedcba988) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0xedcba988) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0xedcba988) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 0xedcba988) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0xedcba988) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0xedcba988) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0xedcba988) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0xedcba988) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0xedcba988) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0xedcba988) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0xedcba988) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 0xedcba988) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0xedcba988) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0xedcba988) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0xedcba988) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 31) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 31) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 31) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 31) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 31) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 31) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 31) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 31) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 31) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 31) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 31) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 31) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 31) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 31) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 31) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 32) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 32) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 32) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 32) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 32) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 32) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 32) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 32) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 32) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 32) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 32) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 32) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 32) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 32) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 32) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 33) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 33) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 33) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 33) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 33) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 33) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 33) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 33) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 33) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 33) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 33) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 33) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 33) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 33) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 33) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 0xfff5a5a) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0xfff5a5a) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0xfff5a5a) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 0xfff5a5a) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0xfff5a5a) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0xfff5a5a) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 0xfff5a5a) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 0xfff5a5a) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 0xfff5a5a) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 0xfff5a5a) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 0xfff5a5a) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "le_u" (i32.const 0xfff5a5a) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0xfff5a5a) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0xfff5a5a) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "le_u" (i32.const 0xfff5a5a) (i32.const 0xfff5a5a)) (i32.const 1))
+
+(assert_return (invoke "gt_s" (i32.const 0) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 1) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 1) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 1) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 1) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 1) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 1) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 1) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 1) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 1) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 1) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 1) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 1) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 1) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 1) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 1) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const -1) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const -1) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const -1) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const -1) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const -1) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const -1) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const -1) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const -1) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const -1) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const -1) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const -1) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const -1) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const -1) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const -1) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const -1) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 2) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 2) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 2) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 2) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 2) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 2) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 2) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 2) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 2) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 2) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 2) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 2) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 2) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 2) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 2) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 3) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 3) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 3) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 3) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 3) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 3) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 3) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 3) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 3) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 3) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 3) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 3) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 3) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 3) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 3) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0x7fffffff) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0x7fffffff) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0x7fffffff) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0x7fffffff) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0x7fffffff) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0x7fffffff) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0x7fffffff) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0x7fffffff) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0x7fffffff) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0x7fffffff) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0x7fffffff) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0x7fffffff) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0x7fffffff) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0x7fffffff) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0x7fffffff) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0x80000000) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0x80000000) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0x80000000) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0x80000000) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0x80000000) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0x80000000) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0x80000000) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0x80000000) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0x80000000) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0x80000000) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0x80000000) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0x80000000) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0x80000000) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0x80000000) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0x80000000) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0x80000001) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0x80000001) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0x80000001) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0x80000001) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0x80000001) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0x80000001) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0x80000001) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0x80000001) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0x80000001) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0x80000001) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0x80000001) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0x80000001) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0x80000001) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0x80000001) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0x80000001) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0x40000000) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0x40000000) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0x40000000) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0x40000000) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0x40000000) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0x40000000) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0x40000000) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0x40000000) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0x40000000) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0x40000000) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0x40000000) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0x40000000) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0x40000000) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0x40000000) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0x40000000) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0x12345678) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0x12345678) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0x12345678) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0x12345678) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0x12345678) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0x12345678) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0x12345678) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0x12345678) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0x12345678) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0x12345678) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0x12345678) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0x12345678) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0x12345678) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0x12345678) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0x12345678) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0xedcba988) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0xedcba988) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0xedcba988) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0xedcba988) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0xedcba988) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0xedcba988) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0xedcba988) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0xedcba988) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0xedcba988) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0xedcba988) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0xedcba988) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0xedcba988) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0xedcba988) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0xedcba988) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0xedcba988) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 31) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 31) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 31) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 31) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 31) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 31) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 31) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 31) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 31) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 31) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 31) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 31) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 31) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 31) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 31) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 32) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 32) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 32) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 32) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 32) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 32) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 32) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 32) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 32) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 32) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 32) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 32) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 32) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 32) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 32) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 33) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 33) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 33) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 33) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 33) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 33) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 33) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 33) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 33) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 33) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 33) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 33) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 33) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 33) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 33) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0xfff5a5a) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0xfff5a5a) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0xfff5a5a) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0xfff5a5a) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0xfff5a5a) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0xfff5a5a) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0xfff5a5a) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0xfff5a5a) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0xfff5a5a) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0xfff5a5a) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "gt_s" (i32.const 0xfff5a5a) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0xfff5a5a) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0xfff5a5a) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0xfff5a5a) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "gt_s" (i32.const 0xfff5a5a) (i32.const 0xfff5a5a)) (i32.const 0))
+
+(assert_return (invoke "gt_u" (i32.const 0) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 0) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 0) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 0) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 0) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 0) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 0) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 0) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 0) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 0) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 0) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 0) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 0) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 0) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 0) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 1) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 1) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 1) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 1) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 1) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 1) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 1) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 1) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 1) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 1) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 1) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 1) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 1) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 1) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 1) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const -1) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const -1) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const -1) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const -1) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const -1) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const -1) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const -1) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const -1) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const -1) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const -1) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const -1) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const -1) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const -1) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const -1) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const -1) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 2) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 2) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 2) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 2) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 2) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 2) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 2) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 2) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 2) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 2) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 2) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 2) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 2) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 2) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 2) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 3) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 3) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 3) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 3) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 3) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 3) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 3) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 3) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 3) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 3) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 3) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 3) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 3) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 3) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 3) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 0x7fffffff) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0x7fffffff) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0x7fffffff) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 0x7fffffff) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0x7fffffff) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0x7fffffff) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 0x7fffffff) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 0x7fffffff) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 0x7fffffff) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0x7fffffff) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0x7fffffff) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 0x7fffffff) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0x7fffffff) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0x7fffffff) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0x7fffffff) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0x80000000) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0x80000000) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0x80000000) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 0x80000000) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0x80000000) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0x80000000) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0x80000000) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 0x80000000) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 0x80000000) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0x80000000) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0x80000000) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 0x80000000) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0x80000000) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0x80000000) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0x80000000) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0x80000001) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0x80000001) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0x80000001) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 0x80000001) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0x80000001) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0x80000001) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0x80000001) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0x80000001) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 0x80000001) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0x80000001) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0x80000001) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 0x80000001) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0x80000001) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0x80000001) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0x80000001) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0x40000000) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0x40000000) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0x40000000) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 0x40000000) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0x40000000) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0x40000000) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 0x40000000) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 0x40000000) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 0x40000000) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 0x40000000) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0x40000000) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 0x40000000) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0x40000000) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0x40000000) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0x40000000) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0x12345678) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0x12345678) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0x12345678) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 0x12345678) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0x12345678) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0x12345678) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 0x12345678) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 0x12345678) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 0x12345678) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 0x12345678) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 0x12345678) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 0x12345678) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0x12345678) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0x12345678) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0x12345678) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0xedcba988) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0xedcba988) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0xedcba988) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 0xedcba988) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0xedcba988) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0xedcba988) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0xedcba988) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0xedcba988) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0xedcba988) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0xedcba988) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0xedcba988) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 0xedcba988) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0xedcba988) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0xedcba988) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0xedcba988) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 31) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 31) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 31) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 31) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 31) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 31) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 31) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 31) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 31) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 31) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 31) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 31) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 31) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 31) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 31) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 32) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 32) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 32) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 32) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 32) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 32) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 32) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 32) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 32) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 32) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 32) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 32) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 32) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 32) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 32) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 33) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 33) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 33) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 33) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 33) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 33) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 33) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 33) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 33) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 33) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 33) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 33) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 33) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 33) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 33) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 0xfff5a5a) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0xfff5a5a) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0xfff5a5a) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 0xfff5a5a) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0xfff5a5a) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0xfff5a5a) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 0xfff5a5a) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 0xfff5a5a) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 0xfff5a5a) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 0xfff5a5a) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 0xfff5a5a) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "gt_u" (i32.const 0xfff5a5a) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0xfff5a5a) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0xfff5a5a) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "gt_u" (i32.const 0xfff5a5a) (i32.const 0xfff5a5a)) (i32.const 0))
+
+(assert_return (invoke "ge_s" (i32.const 0) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 1) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 1) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 1) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 1) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 1) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 1) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 1) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 1) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 1) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 1) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 1) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 1) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 1) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 1) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 1) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const -1) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const -1) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const -1) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const -1) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const -1) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const -1) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const -1) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const -1) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const -1) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const -1) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const -1) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const -1) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const -1) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const -1) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const -1) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 2) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 2) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 2) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 2) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 2) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 2) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 2) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 2) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 2) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 2) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 2) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 2) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 2) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 2) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 2) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 3) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 3) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 3) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 3) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 3) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 3) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 3) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 3) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 3) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 3) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 3) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 3) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 3) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 3) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 3) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0x7fffffff) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0x7fffffff) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0x7fffffff) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0x7fffffff) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0x7fffffff) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0x7fffffff) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0x7fffffff) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0x7fffffff) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0x7fffffff) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0x7fffffff) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0x7fffffff) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0x7fffffff) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0x7fffffff) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0x7fffffff) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0x7fffffff) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0x80000000) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0x80000000) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0x80000000) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0x80000000) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0x80000000) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0x80000000) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0x80000000) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0x80000000) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0x80000000) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0x80000000) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0x80000000) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0x80000000) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0x80000000) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0x80000000) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0x80000000) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0x80000001) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0x80000001) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0x80000001) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0x80000001) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0x80000001) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0x80000001) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0x80000001) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0x80000001) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0x80000001) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0x80000001) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0x80000001) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0x80000001) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0x80000001) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0x80000001) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0x80000001) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0x40000000) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0x40000000) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0x40000000) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0x40000000) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0x40000000) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0x40000000) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0x40000000) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0x40000000) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0x40000000) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0x40000000) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0x40000000) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0x40000000) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0x40000000) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0x40000000) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0x40000000) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0x12345678) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0x12345678) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0x12345678) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0x12345678) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0x12345678) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0x12345678) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0x12345678) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0x12345678) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0x12345678) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0x12345678) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0x12345678) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0x12345678) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0x12345678) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0x12345678) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0x12345678) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0xedcba988) (i32.const 0)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0xedcba988) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0xedcba988) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0xedcba988) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0xedcba988) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0xedcba988) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0xedcba988) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0xedcba988) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0xedcba988) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0xedcba988) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0xedcba988) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0xedcba988) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0xedcba988) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0xedcba988) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0xedcba988) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 31) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 31) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 31) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 31) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 31) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 31) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 31) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 31) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 31) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 31) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 31) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 31) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 31) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 31) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 31) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 32) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 32) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 32) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 32) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 32) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 32) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 32) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 32) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 32) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 32) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 32) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 32) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 32) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 32) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 32) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 33) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 33) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 33) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 33) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 33) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 33) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 33) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 33) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 33) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 33) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 33) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 33) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 33) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 33) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 33) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0xfff5a5a) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0xfff5a5a) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0xfff5a5a) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0xfff5a5a) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0xfff5a5a) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0xfff5a5a) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0xfff5a5a) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0xfff5a5a) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0xfff5a5a) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0xfff5a5a) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "ge_s" (i32.const 0xfff5a5a) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0xfff5a5a) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0xfff5a5a) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0xfff5a5a) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "ge_s" (i32.const 0xfff5a5a) (i32.const 0xfff5a5a)) (i32.const 1))
+
+(assert_return (invoke "ge_u" (i32.const 0) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 0) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 0) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 0) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 0) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 0) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 0) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 0) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 0) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 0) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 0) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 0) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 0) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 0) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 1) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 1) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 1) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 1) (i32.const 2)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 1) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 1) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 1) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 1) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 1) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 1) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 1) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 1) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 1) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 1) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 1) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const -1) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const -1) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const -1) (i32.const -1)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const -1) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const -1) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const -1) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const -1) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const -1) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const -1) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const -1) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const -1) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const -1) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const -1) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const -1) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const -1) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 2) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 2) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 2) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 2) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 2) (i32.const 3)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 2) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 2) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 2) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 2) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 2) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 2) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 2) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 2) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 2) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 2) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 3) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 3) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 3) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 3) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 3) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 3) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 3) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 3) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 3) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 3) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 3) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 3) (i32.const 31)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 3) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 3) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 3) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 0x7fffffff) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x7fffffff) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x7fffffff) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 0x7fffffff) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x7fffffff) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x7fffffff) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x7fffffff) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 0x7fffffff) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 0x7fffffff) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x7fffffff) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x7fffffff) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 0x7fffffff) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x7fffffff) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x7fffffff) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x7fffffff) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x80000000) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x80000000) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x80000000) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 0x80000000) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x80000000) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x80000000) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x80000000) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x80000000) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 0x80000000) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x80000000) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x80000000) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 0x80000000) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x80000000) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x80000000) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x80000000) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x80000001) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x80000001) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x80000001) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 0x80000001) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x80000001) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x80000001) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x80000001) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x80000001) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x80000001) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x80000001) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x80000001) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 0x80000001) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x80000001) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x80000001) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x80000001) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x40000000) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x40000000) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x40000000) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 0x40000000) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x40000000) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x40000000) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 0x40000000) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 0x40000000) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 0x40000000) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x40000000) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x40000000) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 0x40000000) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x40000000) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x40000000) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x40000000) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x12345678) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x12345678) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x12345678) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 0x12345678) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x12345678) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x12345678) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 0x12345678) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 0x12345678) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 0x12345678) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 0x12345678) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x12345678) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 0x12345678) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x12345678) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x12345678) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0x12345678) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0xedcba988) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0xedcba988) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0xedcba988) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 0xedcba988) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0xedcba988) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0xedcba988) (i32.const 0x7fffffff)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0xedcba988) (i32.const 0x80000000)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0xedcba988) (i32.const 0x80000001)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0xedcba988) (i32.const 0x40000000)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0xedcba988) (i32.const 0x12345678)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0xedcba988) (i32.const 0xedcba988)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0xedcba988) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0xedcba988) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0xedcba988) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0xedcba988) (i32.const 0xfff5a5a)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 31) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 31) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 31) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 31) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 31) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 31) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 31) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 31) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 31) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 31) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 31) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 31) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 31) (i32.const 32)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 31) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 31) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 32) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 32) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 32) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 32) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 32) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 32) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 32) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 32) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 32) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 32) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 32) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 32) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 32) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 32) (i32.const 33)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 32) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 33) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 33) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 33) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 33) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 33) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 33) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 33) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 33) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 33) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 33) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 33) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 33) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 33) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 33) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 33) (i32.const 0xfff5a5a)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 0xfff5a5a) (i32.const 0)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0xfff5a5a) (i32.const 1)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0xfff5a5a) (i32.const -1)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 0xfff5a5a) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0xfff5a5a) (i32.const 3)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0xfff5a5a) (i32.const 0x7fffffff)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 0xfff5a5a) (i32.const 0x80000000)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 0xfff5a5a) (i32.const 0x80000001)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 0xfff5a5a) (i32.const 0x40000000)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 0xfff5a5a) (i32.const 0x12345678)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 0xfff5a5a) (i32.const 0xedcba988)) (i32.const 0))
+(assert_return (invoke "ge_u" (i32.const 0xfff5a5a) (i32.const 31)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0xfff5a5a) (i32.const 32)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0xfff5a5a) (i32.const 33)) (i32.const 1))
+(assert_return (invoke "ge_u" (i32.const 0xfff5a5a) (i32.const 0xfff5a5a)) (i32.const 1))
+
@@ -0,0 +1,6111 @@
+;; i64 operations
+(module
+  (func (export "add") (param $x i64) (param $y i64) (result i64) (i64.add (get_local $x) (get_local $y)))
+  (func (export "sub") (param $x i64) (param $y i64) (result i64) (i64.sub (get_local $x) (get_local $y)))
+  (func (export "mul") (param $x i64) (param $y i64) (result i64) (i64.mul (get_local $x) (get_local $y)))
+  (func (export "div_s") (param $x i64) (param $y i64) (result i64) (i64.div_s (get_local $x) (get_local $y)))
+  (func (export "div_u") (param $x i64) (param $y i64) (result i64) (i64.div_u (get_local $x) (get_local $y)))
+  (func (export "rem_s") (param $x i64) (param $y i64) (result i64) (i64.rem_s (get_local $x) (get_local $y)))
+  (func (export "rem_u") (param $x i64) (param $y i64) (result i64) (i64.rem_u (get_local $x) (get_local $y)))
+  (func (export "and") (param $x i64) (param $y i64) (result i64) (i64.and (get_local $x) (get_local $y)))
+  (func (export "or") (param $x i64) (param $y i64) (result i64) (i64.or (get_local $x) (get_local $y)))
+  (func (export "xor") (param $x i64) (param $y i64) (result i64) (i64.xor (get_local $x) (get_local $y)))
+  (func (export "shl") (param $x i64) (param $y i64) (result i64) (i64.shl (get_local $x) (get_local $y)))
+  (func (export "shr_s") (param $x i64) (param $y i64) (result i64) (i64.shr_s (get_local $x) (get_local $y)))
+  (func (export "shr_u") (param $x i64) (param $y i64) (result i64) (i64.shr_u (get_local $x) (get_local $y)))
+  (func (export "rotl") (param $x i64) (param $y i64) (result i64) (i64.rotl (get_local $x) (get_local $y)))
+  (func (export "rotr") (param $x i64) (param $y i64) (result i64) (i64.rotr (get_local $x) (get_local $y)))
+  (func (export "clz") (param $x i64) (result i64) (i64.clz (get_local $x)))
+  (func (export "ctz") (param $x i64) (result i64) (i64.ctz (get_local $x)))
+  (func (export "popcnt") (param $x i64) (result i64) (i64.popcnt (get_local $x)))
+  (func (export "eqz") (param $x i64) (result i32) (i64.eqz (get_local $x)))
+  (func (export "eq") (param $x i64) (param $y i64) (result i32) (i64.eq (get_local $x) (get_local $y)))
+  (func (export "ne") (param $x i64) (param $y i64) (result i32) (i64.ne (get_local $x) (get_local $y)))
+  (func (export "lt_s") (param $x i64) (param $y i64) (result i32) (i64.lt_s (get_local $x) (get_local $y)))
+  (func (export "lt_u") (param $x i64) (param $y i64) (result i32) (i64.lt_u (get_local $x) (get_local $y)))
+  (func (export "le_s") (param $x i64) (param $y i64) (result i32) (i64.le_s (get_local $x) (get_local $y)))
+  (func (export "le_u") (param $x i64) (param $y i64) (result i32) (i64.le_u (get_local $x) (get_local $y)))
+  (func (export "gt_s") (param $x i64) (param $y i64) (result i32) (i64.gt_s (get_local $x) (get_local $y)))
+  (func (export "gt_u") (param $x i64) (param $y i64) (result i32) (i64.gt_u (get_local $x) (get_local $y)))
+  (func (export "ge_s") (param $x i64) (param $y i64) (result i32) (i64.ge_s (get_local $x) (get_local $y)))
+  (func (export "ge_u") (param $x i64) (param $y i64) (result i32) (i64.ge_u (get_local $x) (get_local $y)))
+)
+
+(assert_return (invoke "add" (i64.const 0) (i64.const 0)) (i64.const 0))
+(assert_return (invoke "add" (i64.const 0) (i64.const 1)) (i64.const 1))
+(assert_return (invoke "add" (i64.const 0) (i64.const -1)) (i64.const -1))
+(assert_return (invoke "add" (i64.const 0) (i64.const 2)) (i64.const 2))
+(assert_return (invoke "add" (i64.const 0) (i64.const 3)) (i64.const 3))
+(assert_return (invoke "add" (i64.const 0) (i64.const 0x7fffffffffffffff)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "add" (i64.const 0) (i64.const 0x8000000000000000)) (i64.const 0x8000000000000000))
+(assert_return (invoke "add" (i64.const 0) (i64.const 0x8000000000000001)) (i64.const 0x8000000000000001))
+(assert_return (invoke "add" (i64.const 0) (i64.const 0x4000000000000000)) (i64.const 0x4000000000000000))
+(assert_return (invoke "add" (i64.const 0) (i64.const 0x123456789abcdef)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "add" (i64.const 0) (i64.const 0xfedcba9876543210)) (i64.const 0xfedcba9876543210))
+(assert_return (invoke "add" (i64.const 0) (i64.const 63)) (i64.const 63))
+(assert_return (invoke "add" (i64.const 0) (i64.const 64)) (i64.const 64))
+(assert_return (invoke "add" (i64.const 0) (i64.const 65)) (i64.const 65))
+(assert_return (invoke "add" (i64.const 0) (i64.const 0xfffffffffff5a5a)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "add" (i64.const 1) (i64.const 0)) (i64.const 1))
+(assert_return (invoke "add" (i64.const 1) (i64.const 1)) (i64.const 2))
+(assert_return (invoke "add" (i64.const 1) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "add" (i64.const 1) (i64.const 2)) (i64.const 3))
+(assert_return (invoke "add" (i64.const 1) (i64.const 3)) (i64.const 4))
+(assert_return (invoke "add" (i64.const 1) (i64.const 0x7fffffffffffffff)) (i64.const 0x8000000000000000))
+(assert_return (invoke "add" (i64.const 1) (i64.const 0x8000000000000000)) (i64.const 0x8000000000000001))
+(assert_return (invoke "add" (i64.const 1) (i64.const 0x8000000000000001)) (i64.const 0x8000000000000002))
+(assert_return (invoke "add" (i64.const 1) (i64.const 0x4000000000000000)) (i64.const 0x4000000000000001))
+(assert_return (invoke "add" (i64.const 1) (i64.const 0x123456789abcdef)) (i64.const 0x123456789abcdf0))
+(assert_return (invoke "add" (i64.const 1) (i64.const 0xfedcba9876543210)) (i64.const 0xfedcba9876543211))
+(assert_return (invoke "add" (i64.const 1) (i64.const 63)) (i64.const 64))
+(assert_return (invoke "add" (i64.const 1) (i64.const 64)) (i64.const 65))
+(assert_return (invoke "add" (i64.const 1) (i64.const 65)) (i64.const 66))
+(assert_return (invoke "add" (i64.const 1) (i64.const 0xfffffffffff5a5a)) (i64.const 0xfffffffffff5a5b))
+(assert_return (invoke "add" (i64.const -1) (i64.const 0)) (i64.const -1))
+(assert_return (invoke "add" (i64.const -1) (i64.const 1)) (i64.const 0))
+(assert_return (invoke "add" (i64.const -1) (i64.const -1)) (i64.const -2))
+(assert_return (invoke "add" (i64.const -1) (i64.const 2)) (i64.const 1))
+(assert_return (invoke "add" (i64.const -1) (i64.const 3)) (i64.const 2))
+(assert_return (invoke "add" (i64.const -1) (i64.const 0x7fffffffffffffff)) (i64.const 0x7ffffffffffffffe))
+(assert_return (invoke "add" (i64.const -1) (i64.const 0x8000000000000000)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "add" (i64.const -1) (i64.const 0x8000000000000001)) (i64.const 0x8000000000000000))
+(assert_return (invoke "add" (i64.const -1) (i64.const 0x4000000000000000)) (i64.const 0x3fffffffffffffff))
+(assert_return (invoke "add" (i64.const -1) (i64.const 0x123456789abcdef)) (i64.const 0x123456789abcdee))
+(assert_return (invoke "add" (i64.const -1) (i64.const 0xfedcba9876543210)) (i64.const 0xfedcba987654320f))
+(assert_return (invoke "add" (i64.const -1) (i64.const 63)) (i64.const 62))
+(assert_return (invoke "add" (i64.const -1) (i64.const 64)) (i64.const 63))
+(assert_return (invoke "add" (i64.const -1) (i64.const 65)) (i64.const 64))
+(assert_return (invoke "add" (i64.const -1) (i64.const 0xfffffffffff5a5a)) (i64.const 0xfffffffffff5a59))
+(assert_return (invoke "add" (i64.const 2) (i64.const 0)) (i64.const 2))
+(assert_return (invoke "add" (i64.const 2) (i64.const 1)) (i64.const 3))
+(assert_return (invoke "add" (i64.const 2) (i64.const -1)) (i64.const 1))
+(assert_return (invoke "add" (i64.const 2) (i64.const 2)) (i64.const 4))
+(assert_return (invoke "add" (i64.const 2) (i64.const 3)) (i64.const 5))
+(assert_return (invoke "add" (i64.const 2) (i64.const 0x7fffffffffffffff)) (i64.const 0x8000000000000001))
+(assert_return (invoke "add" (i64.const 2) (i64.const 0x8000000000000000)) (i64.const 0x8000000000000002))
+(assert_return (invoke "add" (i64.const 2) (i64.const 0x8000000000000001)) (i64.const 0x8000000000000003))
+(assert_return (invoke "add" (i64.const 2) (i64.const 0x4000000000000000)) (i64.const 0x4000000000000002))
+(assert_return (invoke "add" (i64.const 2) (i64.const 0x123456789abcdef)) (i64.const 0x123456789abcdf1))
+(assert_return (invoke "add" (i64.const 2) (i64.const 0xfedcba9876543210)) (i64.const 0xfedcba9876543212))
+(assert_return (invoke "add" (i64.const 2) (i64.const 63)) (i64.const 65))
+(assert_return (invoke "add" (i64.const 2) (i64.const 64)) (i64.const 66))
+(assert_return (invoke "add" (i64.const 2) (i64.const 65)) (i64.const 67))
+(assert_return (invoke "add" (i64.const 2) (i64.const 0xfffffffffff5a5a)) (i64.const 0xfffffffffff5a5c))
+(assert_return (invoke "add" (i64.const 3) (i64.const 0)) (i64.const 3))
+(assert_return (invoke "add" (i64.const 3) (i64.const 1)) (i64.const 4))
+(assert_return (invoke "add" (i64.const 3) (i64.const -1)) (i64.const 2))
+(assert_return (invoke "add" (i64.const 3) (i64.const 2)) (i64.const 5))
+(assert_return (invoke "add" (i64.const 3) (i64.const 3)) (i64.const 6))
+(assert_return (invoke "add" (i64.const 3) (i64.const 0x7fffffffffffffff)) (i64.const 0x8000000000000002))
+(assert_return (invoke "add" (i64.const 3) (i64.const 0x8000000000000000)) (i64.const 0x8000000000000003))
+(assert_return (invoke "add" (i64.const 3) (i64.const 0x8000000000000001)) (i64.const 0x8000000000000004))
+(assert_return (invoke "add" (i64.const 3) (i64.const 0x4000000000000000)) (i64.const 0x4000000000000003))
+(assert_return (invoke "add" (i64.const 3) (i64.const 0x123456789abcdef)) (i64.const 0x123456789abcdf2))
+(assert_return (invoke "add" (i64.const 3) (i64.const 0xfedcba9876543210)) (i64.const 0xfedcba9876543213))
+(assert_return (invoke "add" (i64.const 3) (i64.const 63)) (i64.const 66))
+(assert_return (invoke "add" (i64.const 3) (i64.const 64)) (i64.const 67))
+(assert_return (invoke "add" (i64.const 3) (i64.const 65)) (i64.const 68))
+(assert_return (invoke "add" (i64.const 3) (i64.const 0xfffffffffff5a5a)) (i64.const 0xfffffffffff5a5d))
+(assert_return (invoke "add" (i64.const 0x7fffffffffffffff) (i64.const 0)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "add" (i64.const 0x7fffffffffffffff) (i64.const 1)) (i64.const 0x8000000000000000))
+(assert_return (invoke "add" (i64.const 0x7fffffffffffffff) (i64.const -1)) (i64.const 0x7ffffffffffffffe))
+(assert_return (invoke "add" (i64.const 0x7fffffffffffffff) (i64.const 2)) (i64.const 0x8000000000000001))
+(assert_return (invoke "add" (i64.const 0x7fffffffffffffff) (i64.const 3)) (i64.const 0x8000000000000002))
+(assert_return (invoke "add" (i64.const 0x7fffffffffffffff) (i64.const 0x7fffffffffffffff)) (i64.const -2))
+(assert_return (invoke "add" (i64.const 0x7fffffffffffffff) (i64.const 0x8000000000000000)) (i64.const -1))
+(assert_return (invoke "add" (i64.const 0x7fffffffffffffff) (i64.const 0x8000000000000001)) (i64.const 0))
+(assert_return (invoke "add" (i64.const 0x7fffffffffffffff) (i64.const 0x4000000000000000)) (i64.const 0xbfffffffffffffff))
+(assert_return (invoke "add" (i64.const 0x7fffffffffffffff) (i64.const 0x123456789abcdef)) (i64.const 0x8123456789abcdee))
+(assert_return (invoke "add" (i64.const 0x7fffffffffffffff) (i64.const 0xfedcba9876543210)) (i64.const 0x7edcba987654320f))
+(assert_return (invoke "add" (i64.const 0x7fffffffffffffff) (i64.const 63)) (i64.const 0x800000000000003e))
+(assert_return (invoke "add" (i64.const 0x7fffffffffffffff) (i64.const 64)) (i64.const 0x800000000000003f))
+(assert_return (invoke "add" (i64.const 0x7fffffffffffffff) (i64.const 65)) (i64.const 0x8000000000000040))
+(assert_return (invoke "add" (i64.const 0x7fffffffffffffff) (i64.const 0xfffffffffff5a5a)) (i64.const 0x8fffffffffff5a59))
+(assert_return (invoke "add" (i64.const 0x8000000000000000) (i64.const 0)) (i64.const 0x8000000000000000))
+(assert_return (invoke "add" (i64.const 0x8000000000000000) (i64.const 1)) (i64.const 0x8000000000000001))
+(assert_return (invoke "add" (i64.const 0x8000000000000000) (i64.const -1)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "add" (i64.const 0x8000000000000000) (i64.const 2)) (i64.const 0x8000000000000002))
+(assert_return (invoke "add" (i64.const 0x8000000000000000) (i64.const 3)) (i64.const 0x8000000000000003))
+(assert_return (invoke "add" (i64.const 0x8000000000000000) (i64.const 0x7fffffffffffffff)) (i64.const -1))
+(assert_return (invoke "add" (i64.const 0x8000000000000000) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "add" (i64.const 0x8000000000000000) (i64.const 0x8000000000000001)) (i64.const 1))
+(assert_return (invoke "add" (i64.const 0x8000000000000000) (i64.const 0x4000000000000000)) (i64.const 0xc000000000000000))
+(assert_return (invoke "add" (i64.const 0x8000000000000000) (i64.const 0x123456789abcdef)) (i64.const 0x8123456789abcdef))
+(assert_return (invoke "add" (i64.const 0x8000000000000000) (i64.const 0xfedcba9876543210)) (i64.const 0x7edcba9876543210))
+(assert_return (invoke "add" (i64.const 0x8000000000000000) (i64.const 63)) (i64.const 0x800000000000003f))
+(assert_return (invoke "add" (i64.const 0x8000000000000000) (i64.const 64)) (i64.const 0x8000000000000040))
+(assert_return (invoke "add" (i64.const 0x8000000000000000) (i64.const 65)) (i64.const 0x8000000000000041))
+(assert_return (invoke "add" (i64.const 0x8000000000000000) (i64.const 0xfffffffffff5a5a)) (i64.const 0x8fffffffffff5a5a))
+(assert_return (invoke "add" (i64.const 0x8000000000000001) (i64.const 0)) (i64.const 0x8000000000000001))
+(assert_return (invoke "add" (i64.const 0x8000000000000001) (i64.const 1)) (i64.const 0x8000000000000002))
+(assert_return (invoke "add" (i64.const 0x8000000000000001) (i64.const -1)) (i64.const 0x8000000000000000))
+(assert_return (invoke "add" (i64.const 0x8000000000000001) (i64.const 2)) (i64.const 0x8000000000000003))
+(assert_return (invoke "add" (i64.const 0x8000000000000001) (i64.const 3)) (i64.const 0x8000000000000004))
+(assert_return (invoke "add" (i64.const 0x8000000000000001) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "add" (i64.const 0x8000000000000001) (i64.const 0x8000000000000000)) (i64.const 1))
+(assert_return (invoke "add" (i64.const 0x8000000000000001) (i64.const 0x8000000000000001)) (i64.const 2))
+(assert_return (invoke "add" (i64.const 0x8000000000000001) (i64.const 0x4000000000000000)) (i64.const 0xc000000000000001))
+(assert_return (invoke "add" (i64.const 0x8000000000000001) (i64.const 0x123456789abcdef)) (i64.const 0x8123456789abcdf0))
+(assert_return (invoke "add" (i64.const 0x8000000000000001) (i64.const 0xfedcba9876543210)) (i64.const 0x7edcba9876543211))
+(assert_return (invoke "add" (i64.const 0x8000000000000001) (i64.const 63)) (i64.const 0x8000000000000040))
+(assert_return (invoke "add" (i64.const 0x8000000000000001) (i64.const 64)) (i64.const 0x8000000000000041))
+(assert_return (invoke "add" (i64.const 0x8000000000000001) (i64.const 65)) (i64.const 0x8000000000000042))
+(assert_return (invoke "add" (i64.const 0x8000000000000001) (i64.const 0xfffffffffff5a5a)) (i64.const 0x8fffffffffff5a5b))
+(assert_return (invoke "add" (i64.const 0x4000000000000000) (i64.const 0)) (i64.const 0x4000000000000000))
+(assert_return (invoke "add" (i64.const 0x4000000000000000) (i64.const 1)) (i64.const 0x4000000000000001))
+(assert_return (invoke "add" (i64.const 0x4000000000000000) (i64.const -1)) (i64.const 0x3fffffffffffffff))
+(assert_return (invoke "add" (i64.const 0x4000000000000000) (i64.const 2)) (i64.const 0x4000000000000002))
+(assert_return (invoke "add" (i64.const 0x4000000000000000) (i64.const 3)) (i64.const 0x4000000000000003))
+(assert_return (invoke "add" (i64.const 0x4000000000000000) (i64.const 0x7fffffffffffffff)) (i64.const 0xbfffffffffffffff))
+(assert_return (invoke "add" (i64.const 0x4000000000000000) (i64.const 0x8000000000000000)) (i64.const 0xc000000000000000))
+(assert_return (invoke "add" (i64.const 0x4000000000000000) (i64.const 0x8000000000000001)) (i64.const 0xc000000000000001))
+(assert_return (invoke "add" (i64.const 0x4000000000000000) (i64.const 0x4000000000000000)) (i64.const 0x8000000000000000))
+(assert_return (invoke "add" (i64.const 0x4000000000000000) (i64.const 0x123456789abcdef)) (i64.const 0x4123456789abcdef))
+(assert_return (invoke "add" (i64.const 0x4000000000000000) (i64.const 0xfedcba9876543210)) (i64.const 0x3edcba9876543210))
+(assert_return (invoke "add" (i64.const 0x4000000000000000) (i64.const 63)) (i64.const 0x400000000000003f))
+(assert_return (invoke "add" (i64.const 0x4000000000000000) (i64.const 64)) (i64.const 0x4000000000000040))
+(assert_return (invoke "add" (i64.const 0x4000000000000000) (i64.const 65)) (i64.const 0x4000000000000041))
+(assert_return (invoke "add" (i64.const 0x4000000000000000) (i64.const 0xfffffffffff5a5a)) (i64.const 0x4fffffffffff5a5a))
+(assert_return (invoke "add" (i64.const 0x123456789abcdef) (i64.const 0)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "add" (i64.const 0x123456789abcdef) (i64.const 1)) (i64.const 0x123456789abcdf0))
+(assert_return (invoke "add" (i64.const 0x123456789abcdef) (i64.const -1)) (i64.const 0x123456789abcdee))
+(assert_return (invoke "add" (i64.const 0x123456789abcdef) (i64.const 2)) (i64.const 0x123456789abcdf1))
+(assert_return (invoke "add" (i64.const 0x123456789abcdef) (i64.const 3)) (i64.const 0x123456789abcdf2))
+(assert_return (invoke "add" (i64.const 0x123456789abcdef) (i64.const 0x7fffffffffffffff)) (i64.const 0x8123456789abcdee))
+(assert_return (invoke "add" (i64.const 0x123456789abcdef) (i64.const 0x8000000000000000)) (i64.const 0x8123456789abcdef))
+(assert_return (invoke "add" (i64.const 0x123456789abcdef) (i64.const 0x8000000000000001)) (i64.const 0x8123456789abcdf0))
+(assert_return (invoke "add" (i64.const 0x123456789abcdef) (i64.const 0x4000000000000000)) (i64.const 0x4123456789abcdef))
+(assert_return (invoke "add" (i64.const 0x123456789abcdef) (i64.const 0x123456789abcdef)) (i64.const 0x2468acf13579bde))
+(assert_return (invoke "add" (i64.const 0x123456789abcdef) (i64.const 0xfedcba9876543210)) (i64.const -1))
+(assert_return (invoke "add" (i64.const 0x123456789abcdef) (i64.const 63)) (i64.const 0x123456789abce2e))
+(assert_return (invoke "add" (i64.const 0x123456789abcdef) (i64.const 64)) (i64.const 0x123456789abce2f))
+(assert_return (invoke "add" (i64.const 0x123456789abcdef) (i64.const 65)) (i64.const 0x123456789abce30))
+(assert_return (invoke "add" (i64.const 0x123456789abcdef) (i64.const 0xfffffffffff5a5a)) (i64.const 0x1123456789ab2849))
+(assert_return (invoke "add" (i64.const 0xfedcba9876543210) (i64.const 0)) (i64.const 0xfedcba9876543210))
+(assert_return (invoke "add" (i64.const 0xfedcba9876543210) (i64.const 1)) (i64.const 0xfedcba9876543211))
+(assert_return (invoke "add" (i64.const 0xfedcba9876543210) (i64.const -1)) (i64.const 0xfedcba987654320f))
+(assert_return (invoke "add" (i64.const 0xfedcba9876543210) (i64.const 2)) (i64.const 0xfedcba9876543212))
+(assert_return (invoke "add" (i64.const 0xfedcba9876543210) (i64.const 3)) (i64.const 0xfedcba9876543213))
+(assert_return (invoke "add" (i64.const 0xfedcba9876543210) (i64.const 0x7fffffffffffffff)) (i64.const 0x7edcba987654320f))
+(assert_return (invoke "add" (i64.const 0xfedcba9876543210) (i64.const 0x8000000000000000)) (i64.const 0x7edcba9876543210))
+(assert_return (invoke "add" (i64.const 0xfedcba9876543210) (i64.const 0x8000000000000001)) (i64.const 0x7edcba9876543211))
+(assert_return (invoke "add" (i64.const 0xfedcba9876543210) (i64.const 0x4000000000000000)) (i64.const 0x3edcba9876543210))
+(assert_return (invoke "add" (i64.const 0xfedcba9876543210) (i64.const 0x123456789abcdef)) (i64.const -1))
+(assert_return (invoke "add" (i64.const 0xfedcba9876543210) (i64.const 0xfedcba9876543210)) (i64.const 0xfdb97530eca86420))
+(assert_return (invoke "add" (i64.const 0xfedcba9876543210) (i64.const 63)) (i64.const 0xfedcba987654324f))
+(assert_return (invoke "add" (i64.const 0xfedcba9876543210) (i64.const 64)) (i64.const 0xfedcba9876543250))
+(assert_return (invoke "add" (i64.const 0xfedcba9876543210) (i64.const 65)) (i64.const 0xfedcba9876543251))
+(assert_return (invoke "add" (i64.const 0xfedcba9876543210) (i64.const 0xfffffffffff5a5a)) (i64.const 0xedcba9876538c6a))
+(assert_return (invoke "add" (i64.const 63) (i64.const 0)) (i64.const 63))
+(assert_return (invoke "add" (i64.const 63) (i64.const 1)) (i64.const 64))
+(assert_return (invoke "add" (i64.const 63) (i64.const -1)) (i64.const 62))
+(assert_return (invoke "add" (i64.const 63) (i64.const 2)) (i64.const 65))
+(assert_return (invoke "add" (i64.const 63) (i64.const 3)) (i64.const 66))
+(assert_return (invoke "add" (i64.const 63) (i64.const 0x7fffffffffffffff)) (i64.const 0x800000000000003e))
+(assert_return (invoke "add" (i64.const 63) (i64.const 0x8000000000000000)) (i64.const 0x800000000000003f))
+(assert_return (invoke "add" (i64.const 63) (i64.const 0x8000000000000001)) (i64.const 0x8000000000000040))
+(assert_return (invoke "add" (i64.const 63) (i64.const 0x4000000000000000)) (i64.const 0x400000000000003f))
+(assert_return (invoke "add" (i64.const 63) (i64.const 0x123456789abcdef)) (i64.const 0x123456789abce2e))
+(assert_return (invoke "add" (i64.const 63) (i64.const 0xfedcba9876543210)) (i64.const 0xfedcba987654324f))
+(assert_return (invoke "add" (i64.const 63) (i64.const 63)) (i64.const 126))
+(assert_return (invoke "add" (i64.const 63) (i64.const 64)) (i64.const 127))
+(assert_return (invoke "add" (i64.const 63) (i64.const 65)) (i64.const 128))
+(assert_return (invoke "add" (i64.const 63) (i64.const 0xfffffffffff5a5a)) (i64.const 0xfffffffffff5a99))
+(assert_return (invoke "add" (i64.const 64) (i64.const 0)) (i64.const 64))
+(assert_return (invoke "add" (i64.const 64) (i64.const 1)) (i64.const 65))
+(assert_return (invoke "add" (i64.const 64) (i64.const -1)) (i64.const 63))
+(assert_return (invoke "add" (i64.const 64) (i64.const 2)) (i64.const 66))
+(assert_return (invoke "add" (i64.const 64) (i64.const 3)) (i64.const 67))
+(assert_return (invoke "add" (i64.const 64) (i64.const 0x7fffffffffffffff)) (i64.const 0x800000000000003f))
+(assert_return (invoke "add" (i64.const 64) (i64.const 0x8000000000000000)) (i64.const 0x8000000000000040))
+(assert_return (invoke "add" (i64.const 64) (i64.const 0x8000000000000001)) (i64.const 0x8000000000000041))
+(assert_return (invoke "add" (i64.const 64) (i64.const 0x4000000000000000)) (i64.const 0x4000000000000040))
+(assert_return (invoke "add" (i64.const 64) (i64.const 0x123456789abcdef)) (i64.const 0x123456789abce2f))
+(assert_return (invoke "add" (i64.const 64) (i64.const 0xfedcba9876543210)) (i64.const 0xfedcba9876543250))
+(assert_return (invoke "add" (i64.const 64) (i64.const 63)) (i64.const 127))
+(assert_return (invoke "add" (i64.const 64) (i64.const 64)) (i64.const 128))
+(assert_return (invoke "add" (i64.const 64) (i64.const 65)) (i64.const 129))
+(assert_return (invoke "add" (i64.const 64) (i64.const 0xfffffffffff5a5a)) (i64.const 0xfffffffffff5a9a))
+(assert_return (invoke "add" (i64.const 65) (i64.const 0)) (i64.const 65))
+(assert_return (invoke "add" (i64.const 65) (i64.const 1)) (i64.const 66))
+(assert_return (invoke "add" (i64.const 65) (i64.const -1)) (i64.const 64))
+(assert_return (invoke "add" (i64.const 65) (i64.const 2)) (i64.const 67))
+(assert_return (invoke "add" (i64.const 65) (i64.const 3)) (i64.const 68))
+(assert_return (invoke "add" (i64.const 65) (i64.const 0x7fffffffffffffff)) (i64.const 0x8000000000000040))
+(assert_return (invoke "add" (i64.const 65) (i64.const 0x8000000000000000)) (i64.const 0x8000000000000041))
+(assert_return (invoke "add" (i64.const 65) (i64.const 0x8000000000000001)) (i64.const 0x8000000000000042))
+(assert_return (invoke "add" (i64.const 65) (i64.const 0x4000000000000000)) (i64.const 0x4000000000000041))
+(assert_return (invoke "add" (i64.const 65) (i64.const 0x123456789abcdef)) (i64.const 0x123456789abce30))
+(assert_return (invoke "add" (i64.const 65) (i64.const 0xfedcba9876543210)) (i64.const 0xfedcba9876543251))
+(assert_return (invoke "add" (i64.const 65) (i64.const 63)) (i64.const 128))
+(assert_return (invoke "add" (i64.const 65) (i64.const 64)) (i64.const 129))
+(assert_return (invoke "add" (i64.const 65) (i64.const 65)) (i64.const 130))
+(assert_return (invoke "add" (i64.const 65) (i64.const 0xfffffffffff5a5a)) (i64.const 0xfffffffffff5a9b))
+(assert_return (invoke "add" (i64.const 0xfffffffffff5a5a) (i64.const 0)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "add" (i64.const 0xfffffffffff5a5a) (i64.const 1)) (i64.const 0xfffffffffff5a5b))
+(assert_return (invoke "add" (i64.const 0xfffffffffff5a5a) (i64.const -1)) (i64.const 0xfffffffffff5a59))
+(assert_return (invoke "add" (i64.const 0xfffffffffff5a5a) (i64.const 2)) (i64.const 0xfffffffffff5a5c))
+(assert_return (invoke "add" (i64.const 0xfffffffffff5a5a) (i64.const 3)) (i64.const 0xfffffffffff5a5d))
+(assert_return (invoke "add" (i64.const 0xfffffffffff5a5a) (i64.const 0x7fffffffffffffff)) (i64.const 0x8fffffffffff5a59))
+(assert_return (invoke "add" (i64.const 0xfffffffffff5a5a) (i64.const 0x8000000000000000)) (i64.const 0x8fffffffffff5a5a))
+(assert_return (invoke "add" (i64.const 0xfffffffffff5a5a) (i64.const 0x8000000000000001)) (i64.const 0x8fffffffffff5a5b))
+(assert_return (invoke "add" (i64.const 0xfffffffffff5a5a) (i64.const 0x4000000000000000)) (i64.const 0x4fffffffffff5a5a))
+(assert_return (invoke "add" (i64.const 0xfffffffffff5a5a) (i64.const 0x123456789abcdef)) (i64.const 0x1123456789ab2849))
+(assert_return (invoke "add" (i64.const 0xfffffffffff5a5a) (i64.const 0xfedcba9876543210)) (i64.const 0xedcba9876538c6a))
+(assert_return (invoke "add" (i64.const 0xfffffffffff5a5a) (i64.const 63)) (i64.const 0xfffffffffff5a99))
+(assert_return (invoke "add" (i64.const 0xfffffffffff5a5a) (i64.const 64)) (i64.const 0xfffffffffff5a9a))
+(assert_return (invoke "add" (i64.const 0xfffffffffff5a5a) (i64.const 65)) (i64.const 0xfffffffffff5a9b))
+(assert_return (invoke "add" (i64.const 0xfffffffffff5a5a) (i64.const 0xfffffffffff5a5a)) (i64.const 0x1ffffffffffeb4b4))
+(assert_return (invoke "add" (i64.const 0xb8ef2c5014513732) (i64.const 0xd53bef7e29307edf)) (i64.const 0x8e2b1bce3d81b611))
+(assert_return (invoke "add" (i64.const 0x8e928cc8ff62c8cf) (i64.const 0xb4d5c72e9f35b216)) (i64.const 0x436853f79e987ae5))
+(assert_return (invoke "add" (i64.const 0x2d69552c2ae8a3ed) (i64.const 0x68b47ce52120a934)) (i64.const 0x961dd2114c094d21))
+(assert_return (invoke "add" (i64.const 0x8ca525d67263ecc5) (i64.const 0x629495398bddf4de)) (i64.const 0xef39bb0ffe41e1a3))
+(assert_return (invoke "add" (i64.const 0x2ed11ab1fd63f470) (i64.const 0x4920890a2fa52730)) (i64.const 0x77f1a3bc2d091ba0))
+(assert_return (invoke "add" (i64.const 0x26b6dd21a2bc10fb) (i64.const 0x9847965670c24aae)) (i64.const 0xbefe7378137e5ba9))
+(assert_return (invoke "add" (i64.const 0xdd5274c88ea42907) (i64.const 0x629cecef116f0ff)) (i64.const 0xe37c43977fbb1a06))
+(assert_return (invoke "add" (i64.const 0xcf33af9e1e4961d3) (i64.const 0x2d89c3bbd5d2488a)) (i64.const 0xfcbd7359f41baa5d))
+(assert_return (invoke "add" (i64.const 0xe2a64ca1bb44db2a) (i64.const 0x5582761a448534be)) (i64.const 0x3828c2bbffca0fe8))
+(assert_return (invoke "add" (i64.const 0x8652faf51eb92b46) (i64.const 0xe990fe31bf30007c)) (i64.const 0x6fe3f926dde92bc2))
+(assert_return (invoke "add" (i64.const 0x24e9c993da714fa3) (i64.const 0x7c03347358712405)) (i64.const 0xa0ecfe0732e273a8))
+(assert_return (invoke "add" (i64.const 0xf1c4a5db23598196) (i64.const 0x6dd5561d8e191390)) (i64.const 0x5f99fbf8b1729526))
+(assert_return (invoke "add" (i64.const 0x17980018abac51e8) (i64.const 0xfea069595bcb8c24)) (i64.const 0x163869720777de0c))
+(assert_return (invoke "add" (i64.const 0xb71cf5c4692e304b) (i64.const 0x38ac38b51a3590c2)) (i64.const 0xefc92e798363c10d))
+(assert_return (invoke "add" (i64.const 0x76cd697f2423a000) (i64.const 0xab94560438ee13c9)) (i64.const 0x2261bf835d11b3c9))
+(assert_return (invoke "add" (i64.const 0x7655c39bc200de0f) (i64.const 0x8f786c6e1f27ac3d)) (i64.const 0x5ce3009e1288a4c))
+
+(assert_return (invoke "sub" (i64.const 0) (i64.const 0)) (i64.const 0))
+(assert_return (invoke "sub" (i64.const 0) (i64.const 1)) (i64.const -1))
+(assert_return (invoke "sub" (i64.const 0) (i64.const -1)) (i64.const 1))
+(assert_return (invoke "sub" (i64.const 0) (i64.const 2)) (i64.const -2))
+(assert_return (invoke "sub" (i64.const 0) (i64.const 3)) (i64.const -3))
+(assert_return (invoke "sub" (i64.const 0) (i64.const 0x7fffffffffffffff)) (i64.const 0x8000000000000001))
+(assert_return (invoke "sub" (i64.const 0) (i64.const 0x8000000000000000)) (i64.const 0x8000000000000000))
+(assert_return (invoke "sub" (i64.const 0) (i64.const 0x8000000000000001)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "sub" (i64.const 0) (i64.const 0x4000000000000000)) (i64.const 0xc000000000000000))
+(assert_return (invoke "sub" (i64.const 0) (i64.const 0x123456789abcdef)) (i64.const 0xfedcba9876543211))
+(assert_return (invoke "sub" (i64.const 0) (i64.const 0xfedcba9876543210)) (i64.const 0x123456789abcdf0))
+(assert_return (invoke "sub" (i64.const 0) (i64.const 63)) (i64.const -63))
+(assert_return (invoke "sub" (i64.const 0) (i64.const 64)) (i64.const -64))
+(assert_return (invoke "sub" (i64.const 0) (i64.const 65)) (i64.const -65))
+(assert_return (invoke "sub" (i64.const 0) (i64.const 0xfffffffffff5a5a)) (i64.const 0xf00000000000a5a6))
+(assert_return (invoke "sub" (i64.const 1) (i64.const 0)) (i64.const 1))
+(assert_return (invoke "sub" (i64.const 1) (i64.const 1)) (i64.const 0))
+(assert_return (invoke "sub" (i64.const 1) (i64.const -1)) (i64.const 2))
+(assert_return (invoke "sub" (i64.const 1) (i64.const 2)) (i64.const -1))
+(assert_return (invoke "sub" (i64.const 1) (i64.const 3)) (i64.const -2))
+(assert_return (invoke "sub" (i64.const 1) (i64.const 0x7fffffffffffffff)) (i64.const 0x8000000000000002))
+(assert_return (invoke "sub" (i64.const 1) (i64.const 0x8000000000000000)) (i64.const 0x8000000000000001))
+(assert_return (invoke "sub" (i64.const 1) (i64.const 0x8000000000000001)) (i64.const 0x8000000000000000))
+(assert_return (invoke "sub" (i64.const 1) (i64.const 0x4000000000000000)) (i64.const 0xc000000000000001))
+(assert_return (invoke "sub" (i64.const 1) (i64.const 0x123456789abcdef)) (i64.const 0xfedcba9876543212))
+(assert_return (invoke "sub" (i64.const 1) (i64.const 0xfedcba9876543210)) (i64.const 0x123456789abcdf1))
+(assert_return (invoke "sub" (i64.const 1) (i64.const 63)) (i64.const -62))
+(assert_return (invoke "sub" (i64.const 1) (i64.const 64)) (i64.const -63))
+(assert_return (invoke "sub" (i64.const 1) (i64.const 65)) (i64.const -64))
+(assert_return (invoke "sub" (i64.const 1) (i64.const 0xfffffffffff5a5a)) (i64.const 0xf00000000000a5a7))
+(assert_return (invoke "sub" (i64.const -1) (i64.const 0)) (i64.const -1))
+(assert_return (invoke "sub" (i64.const -1) (i64.const 1)) (i64.const -2))
+(assert_return (invoke "sub" (i64.const -1) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "sub" (i64.const -1) (i64.const 2)) (i64.const -3))
+(assert_return (invoke "sub" (i64.const -1) (i64.const 3)) (i64.const -4))
+(assert_return (invoke "sub" (i64.const -1) (i64.const 0x7fffffffffffffff)) (i64.const 0x8000000000000000))
+(assert_return (invoke "sub" (i64.const -1) (i64.const 0x8000000000000000)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "sub" (i64.const -1) (i64.const 0x8000000000000001)) (i64.const 0x7ffffffffffffffe))
+(assert_return (invoke "sub" (i64.const -1) (i64.const 0x4000000000000000)) (i64.const 0xbfffffffffffffff))
+(assert_return (invoke "sub" (i64.const -1) (i64.const 0x123456789abcdef)) (i64.const 0xfedcba9876543210))
+(assert_return (invoke "sub" (i64.const -1) (i64.const 0xfedcba9876543210)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "sub" (i64.const -1) (i64.const 63)) (i64.const -64))
+(assert_return (invoke "sub" (i64.const -1) (i64.const 64)) (i64.const -65))
+(assert_return (invoke "sub" (i64.const -1) (i64.const 65)) (i64.const -66))
+(assert_return (invoke "sub" (i64.const -1) (i64.const 0xfffffffffff5a5a)) (i64.const 0xf00000000000a5a5))
+(assert_return (invoke "sub" (i64.const 2) (i64.const 0)) (i64.const 2))
+(assert_return (invoke "sub" (i64.const 2) (i64.const 1)) (i64.const 1))
+(assert_return (invoke "sub" (i64.const 2) (i64.const -1)) (i64.const 3))
+(assert_return (invoke "sub" (i64.const 2) (i64.const 2)) (i64.const 0))
+(assert_return (invoke "sub" (i64.const 2) (i64.const 3)) (i64.const -1))
+(assert_return (invoke "sub" (i64.const 2) (i64.const 0x7fffffffffffffff)) (i64.const 0x8000000000000003))
+(assert_return (invoke "sub" (i64.const 2) (i64.const 0x8000000000000000)) (i64.const 0x8000000000000002))
+(assert_return (invoke "sub" (i64.const 2) (i64.const 0x8000000000000001)) (i64.const 0x8000000000000001))
+(assert_return (invoke "sub" (i64.const 2) (i64.const 0x4000000000000000)) (i64.const 0xc000000000000002))
+(assert_return (invoke "sub" (i64.const 2) (i64.const 0x123456789abcdef)) (i64.const 0xfedcba9876543213))
+(assert_return (invoke "sub" (i64.const 2) (i64.const 0xfedcba9876543210)) (i64.const 0x123456789abcdf2))
+(assert_return (invoke "sub" (i64.const 2) (i64.const 63)) (i64.const -61))
+(assert_return (invoke "sub" (i64.const 2) (i64.const 64)) (i64.const -62))
+(assert_return (invoke "sub" (i64.const 2) (i64.const 65)) (i64.const -63))
+(assert_return (invoke "sub" (i64.const 2) (i64.const 0xfffffffffff5a5a)) (i64.const 0xf00000000000a5a8))
+(assert_return (invoke "sub" (i64.const 3) (i64.const 0)) (i64.const 3))
+(assert_return (invoke "sub" (i64.const 3) (i64.const 1)) (i64.const 2))
+(assert_return (invoke "sub" (i64.const 3) (i64.const -1)) (i64.const 4))
+(assert_return (invoke "sub" (i64.const 3) (i64.const 2)) (i64.const 1))
+(assert_return (invoke "sub" (i64.const 3) (i64.const 3)) (i64.const 0))
+(assert_return (invoke "sub" (i64.const 3) (i64.const 0x7fffffffffffffff)) (i64.const 0x8000000000000004))
+(assert_return (invoke "sub" (i64.const 3) (i64.const 0x8000000000000000)) (i64.const 0x8000000000000003))
+(assert_return (invoke "sub" (i64.const 3) (i64.const 0x8000000000000001)) (i64.const 0x8000000000000002))
+(assert_return (invoke "sub" (i64.const 3) (i64.const 0x4000000000000000)) (i64.const 0xc000000000000003))
+(assert_return (invoke "sub" (i64.const 3) (i64.const 0x123456789abcdef)) (i64.const 0xfedcba9876543214))
+(assert_return (invoke "sub" (i64.const 3) (i64.const 0xfedcba9876543210)) (i64.const 0x123456789abcdf3))
+(assert_return (invoke "sub" (i64.const 3) (i64.const 63)) (i64.const -60))
+(assert_return (invoke "sub" (i64.const 3) (i64.const 64)) (i64.const -61))
+(assert_return (invoke "sub" (i64.const 3) (i64.const 65)) (i64.const -62))
+(assert_return (invoke "sub" (i64.const 3) (i64.const 0xfffffffffff5a5a)) (i64.const 0xf00000000000a5a9))
+(assert_return (invoke "sub" (i64.const 0x7fffffffffffffff) (i64.const 0)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "sub" (i64.const 0x7fffffffffffffff) (i64.const 1)) (i64.const 0x7ffffffffffffffe))
+(assert_return (invoke "sub" (i64.const 0x7fffffffffffffff) (i64.const -1)) (i64.const 0x8000000000000000))
+(assert_return (invoke "sub" (i64.const 0x7fffffffffffffff) (i64.const 2)) (i64.const 0x7ffffffffffffffd))
+(assert_return (invoke "sub" (i64.const 0x7fffffffffffffff) (i64.const 3)) (i64.const 0x7ffffffffffffffc))
+(assert_return (invoke "sub" (i64.const 0x7fffffffffffffff) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "sub" (i64.const 0x7fffffffffffffff) (i64.const 0x8000000000000000)) (i64.const -1))
+(assert_return (invoke "sub" (i64.const 0x7fffffffffffffff) (i64.const 0x8000000000000001)) (i64.const -2))
+(assert_return (invoke "sub" (i64.const 0x7fffffffffffffff) (i64.const 0x4000000000000000)) (i64.const 0x3fffffffffffffff))
+(assert_return (invoke "sub" (i64.const 0x7fffffffffffffff) (i64.const 0x123456789abcdef)) (i64.const 0x7edcba9876543210))
+(assert_return (invoke "sub" (i64.const 0x7fffffffffffffff) (i64.const 0xfedcba9876543210)) (i64.const 0x8123456789abcdef))
+(assert_return (invoke "sub" (i64.const 0x7fffffffffffffff) (i64.const 63)) (i64.const 0x7fffffffffffffc0))
+(assert_return (invoke "sub" (i64.const 0x7fffffffffffffff) (i64.const 64)) (i64.const 0x7fffffffffffffbf))
+(assert_return (invoke "sub" (i64.const 0x7fffffffffffffff) (i64.const 65)) (i64.const 0x7fffffffffffffbe))
+(assert_return (invoke "sub" (i64.const 0x7fffffffffffffff) (i64.const 0xfffffffffff5a5a)) (i64.const 0x700000000000a5a5))
+(assert_return (invoke "sub" (i64.const 0x8000000000000000) (i64.const 0)) (i64.const 0x8000000000000000))
+(assert_return (invoke "sub" (i64.const 0x8000000000000000) (i64.const 1)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "sub" (i64.const 0x8000000000000000) (i64.const -1)) (i64.const 0x8000000000000001))
+(assert_return (invoke "sub" (i64.const 0x8000000000000000) (i64.const 2)) (i64.const 0x7ffffffffffffffe))
+(assert_return (invoke "sub" (i64.const 0x8000000000000000) (i64.const 3)) (i64.const 0x7ffffffffffffffd))
+(assert_return (invoke "sub" (i64.const 0x8000000000000000) (i64.const 0x7fffffffffffffff)) (i64.const 1))
+(assert_return (invoke "sub" (i64.const 0x8000000000000000) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "sub" (i64.const 0x8000000000000000) (i64.const 0x8000000000000001)) (i64.const -1))
+(assert_return (invoke "sub" (i64.const 0x8000000000000000) (i64.const 0x4000000000000000)) (i64.const 0x4000000000000000))
+(assert_return (invoke "sub" (i64.const 0x8000000000000000) (i64.const 0x123456789abcdef)) (i64.const 0x7edcba9876543211))
+(assert_return (invoke "sub" (i64.const 0x8000000000000000) (i64.const 0xfedcba9876543210)) (i64.const 0x8123456789abcdf0))
+(assert_return (invoke "sub" (i64.const 0x8000000000000000) (i64.const 63)) (i64.const 0x7fffffffffffffc1))
+(assert_return (invoke "sub" (i64.const 0x8000000000000000) (i64.const 64)) (i64.const 0x7fffffffffffffc0))
+(assert_return (invoke "sub" (i64.const 0x8000000000000000) (i64.const 65)) (i64.const 0x7fffffffffffffbf))
+(assert_return (invoke "sub" (i64.const 0x8000000000000000) (i64.const 0xfffffffffff5a5a)) (i64.const 0x700000000000a5a6))
+(assert_return (invoke "sub" (i64.const 0x8000000000000001) (i64.const 0)) (i64.const 0x8000000000000001))
+(assert_return (invoke "sub" (i64.const 0x8000000000000001) (i64.const 1)) (i64.const 0x8000000000000000))
+(assert_return (invoke "sub" (i64.const 0x8000000000000001) (i64.const -1)) (i64.const 0x8000000000000002))
+(assert_return (invoke "sub" (i64.const 0x8000000000000001) (i64.const 2)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "sub" (i64.const 0x8000000000000001) (i64.const 3)) (i64.const 0x7ffffffffffffffe))
+(assert_return (invoke "sub" (i64.const 0x8000000000000001) (i64.const 0x7fffffffffffffff)) (i64.const 2))
+(assert_return (invoke "sub" (i64.const 0x8000000000000001) (i64.const 0x8000000000000000)) (i64.const 1))
+(assert_return (invoke "sub" (i64.const 0x8000000000000001) (i64.const 0x8000000000000001)) (i64.const 0))
+(assert_return (invoke "sub" (i64.const 0x8000000000000001) (i64.const 0x4000000000000000)) (i64.const 0x4000000000000001))
+(assert_return (invoke "sub" (i64.const 0x8000000000000001) (i64.const 0x123456789abcdef)) (i64.const 0x7edcba9876543212))
+(assert_return (invoke "sub" (i64.const 0x8000000000000001) (i64.const 0xfedcba9876543210)) (i64.const 0x8123456789abcdf1))
+(assert_return (invoke "sub" (i64.const 0x8000000000000001) (i64.const 63)) (i64.const 0x7fffffffffffffc2))
+(assert_return (invoke "sub" (i64.const 0x8000000000000001) (i64.const 64)) (i64.const 0x7fffffffffffffc1))
+(assert_return (invoke "sub" (i64.const 0x8000000000000001) (i64.const 65)) (i64.const 0x7fffffffffffffc0))
+(assert_return (invoke "sub" (i64.const 0x8000000000000001) (i64.const 0xfffffffffff5a5a)) (i64.const 0x700000000000a5a7))
+(assert_return (invoke "sub" (i64.const 0x4000000000000000) (i64.const 0)) (i64.const 0x4000000000000000))
+(assert_return (invoke "sub" (i64.const 0x4000000000000000) (i64.const 1)) (i64.const 0x3fffffffffffffff))
+(assert_return (invoke "sub" (i64.const 0x4000000000000000) (i64.const -1)) (i64.const 0x4000000000000001))
+(assert_return (invoke "sub" (i64.const 0x4000000000000000) (i64.const 2)) (i64.const 0x3ffffffffffffffe))
+(assert_return (invoke "sub" (i64.const 0x4000000000000000) (i64.const 3)) (i64.const 0x3ffffffffffffffd))
+(assert_return (invoke "sub" (i64.const 0x4000000000000000) (i64.const 0x7fffffffffffffff)) (i64.const 0xc000000000000001))
+(assert_return (invoke "sub" (i64.const 0x4000000000000000) (i64.const 0x8000000000000000)) (i64.const 0xc000000000000000))
+(assert_return (invoke "sub" (i64.const 0x4000000000000000) (i64.const 0x8000000000000001)) (i64.const 0xbfffffffffffffff))
+(assert_return (invoke "sub" (i64.const 0x4000000000000000) (i64.const 0x4000000000000000)) (i64.const 0))
+(assert_return (invoke "sub" (i64.const 0x4000000000000000) (i64.const 0x123456789abcdef)) (i64.const 0x3edcba9876543211))
+(assert_return (invoke "sub" (i64.const 0x4000000000000000) (i64.const 0xfedcba9876543210)) (i64.const 0x4123456789abcdf0))
+(assert_return (invoke "sub" (i64.const 0x4000000000000000) (i64.const 63)) (i64.const 0x3fffffffffffffc1))
+(assert_return (invoke "sub" (i64.const 0x4000000000000000) (i64.const 64)) (i64.const 0x3fffffffffffffc0))
+(assert_return (invoke "sub" (i64.const 0x4000000000000000) (i64.const 65)) (i64.const 0x3fffffffffffffbf))
+(assert_return (invoke "sub" (i64.const 0x4000000000000000) (i64.const 0xfffffffffff5a5a)) (i64.const 0x300000000000a5a6))
+(assert_return (invoke "sub" (i64.const 0x123456789abcdef) (i64.const 0)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "sub" (i64.const 0x123456789abcdef) (i64.const 1)) (i64.const 0x123456789abcdee))
+(assert_return (invoke "sub" (i64.const 0x123456789abcdef) (i64.const -1)) (i64.const 0x123456789abcdf0))
+(assert_return (invoke "sub" (i64.const 0x123456789abcdef) (i64.const 2)) (i64.const 0x123456789abcded))
+(assert_return (invoke "sub" (i64.const 0x123456789abcdef) (i64.const 3)) (i64.const 0x123456789abcdec))
+(assert_return (invoke "sub" (i64.const 0x123456789abcdef) (i64.const 0x7fffffffffffffff)) (i64.const 0x8123456789abcdf0))
+(assert_return (invoke "sub" (i64.const 0x123456789abcdef) (i64.const 0x8000000000000000)) (i64.const 0x8123456789abcdef))
+(assert_return (invoke "sub" (i64.const 0x123456789abcdef) (i64.const 0x8000000000000001)) (i64.const 0x8123456789abcdee))
+(assert_return (invoke "sub" (i64.const 0x123456789abcdef) (i64.const 0x4000000000000000)) (i64.const 0xc123456789abcdef))
+(assert_return (invoke "sub" (i64.const 0x123456789abcdef) (i64.const 0x123456789abcdef)) (i64.const 0))
+(assert_return (invoke "sub" (i64.const 0x123456789abcdef) (i64.const 0xfedcba9876543210)) (i64.const 0x2468acf13579bdf))
+(assert_return (invoke "sub" (i64.const 0x123456789abcdef) (i64.const 63)) (i64.const 0x123456789abcdb0))
+(assert_return (invoke "sub" (i64.const 0x123456789abcdef) (i64.const 64)) (i64.const 0x123456789abcdaf))
+(assert_return (invoke "sub" (i64.const 0x123456789abcdef) (i64.const 65)) (i64.const 0x123456789abcdae))
+(assert_return (invoke "sub" (i64.const 0x123456789abcdef) (i64.const 0xfffffffffff5a5a)) (i64.const 0xf123456789ac7395))
+(assert_return (invoke "sub" (i64.const 0xfedcba9876543210) (i64.const 0)) (i64.const 0xfedcba9876543210))
+(assert_return (invoke "sub" (i64.const 0xfedcba9876543210) (i64.const 1)) (i64.const 0xfedcba987654320f))
+(assert_return (invoke "sub" (i64.const 0xfedcba9876543210) (i64.const -1)) (i64.const 0xfedcba9876543211))
+(assert_return (invoke "sub" (i64.const 0xfedcba9876543210) (i64.const 2)) (i64.const 0xfedcba987654320e))
+(assert_return (invoke "sub" (i64.const 0xfedcba9876543210) (i64.const 3)) (i64.const 0xfedcba987654320d))
+(assert_return (invoke "sub" (i64.const 0xfedcba9876543210) (i64.const 0x7fffffffffffffff)) (i64.const 0x7edcba9876543211))
+(assert_return (invoke "sub" (i64.const 0xfedcba9876543210) (i64.const 0x8000000000000000)) (i64.const 0x7edcba9876543210))
+(assert_return (invoke "sub" (i64.const 0xfedcba9876543210) (i64.const 0x8000000000000001)) (i64.const 0x7edcba987654320f))
+(assert_return (invoke "sub" (i64.const 0xfedcba9876543210) (i64.const 0x4000000000000000)) (i64.const 0xbedcba9876543210))
+(assert_return (invoke "sub" (i64.const 0xfedcba9876543210) (i64.const 0x123456789abcdef)) (i64.const 0xfdb97530eca86421))
+(assert_return (invoke "sub" (i64.const 0xfedcba9876543210) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "sub" (i64.const 0xfedcba9876543210) (i64.const 63)) (i64.const 0xfedcba98765431d1))
+(assert_return (invoke "sub" (i64.const 0xfedcba9876543210) (i64.const 64)) (i64.const 0xfedcba98765431d0))
+(assert_return (invoke "sub" (i64.const 0xfedcba9876543210) (i64.const 65)) (i64.const 0xfedcba98765431cf))
+(assert_return (invoke "sub" (i64.const 0xfedcba9876543210) (i64.const 0xfffffffffff5a5a)) (i64.const 0xeedcba987654d7b6))
+(assert_return (invoke "sub" (i64.const 63) (i64.const 0)) (i64.const 63))
+(assert_return (invoke "sub" (i64.const 63) (i64.const 1)) (i64.const 62))
+(assert_return (invoke "sub" (i64.const 63) (i64.const -1)) (i64.const 64))
+(assert_return (invoke "sub" (i64.const 63) (i64.const 2)) (i64.const 61))
+(assert_return (invoke "sub" (i64.const 63) (i64.const 3)) (i64.const 60))
+(assert_return (invoke "sub" (i64.const 63) (i64.const 0x7fffffffffffffff)) (i64.const 0x8000000000000040))
+(assert_return (invoke "sub" (i64.const 63) (i64.const 0x8000000000000000)) (i64.const 0x800000000000003f))
+(assert_return (invoke "sub" (i64.const 63) (i64.const 0x8000000000000001)) (i64.const 0x800000000000003e))
+(assert_return (invoke "sub" (i64.const 63) (i64.const 0x4000000000000000)) (i64.const 0xc00000000000003f))
+(assert_return (invoke "sub" (i64.const 63) (i64.const 0x123456789abcdef)) (i64.const 0xfedcba9876543250))
+(assert_return (invoke "sub" (i64.const 63) (i64.const 0xfedcba9876543210)) (i64.const 0x123456789abce2f))
+(assert_return (invoke "sub" (i64.const 63) (i64.const 63)) (i64.const 0))
+(assert_return (invoke "sub" (i64.const 63) (i64.const 64)) (i64.const -1))
+(assert_return (invoke "sub" (i64.const 63) (i64.const 65)) (i64.const -2))
+(assert_return (invoke "sub" (i64.const 63) (i64.const 0xfffffffffff5a5a)) (i64.const 0xf00000000000a5e5))
+(assert_return (invoke "sub" (i64.const 64) (i64.const 0)) (i64.const 64))
+(assert_return (invoke "sub" (i64.const 64) (i64.const 1)) (i64.const 63))
+(assert_return (invoke "sub" (i64.const 64) (i64.const -1)) (i64.const 65))
+(assert_return (invoke "sub" (i64.const 64) (i64.const 2)) (i64.const 62))
+(assert_return (invoke "sub" (i64.const 64) (i64.const 3)) (i64.const 61))
+(assert_return (invoke "sub" (i64.const 64) (i64.const 0x7fffffffffffffff)) (i64.const 0x8000000000000041))
+(assert_return (invoke "sub" (i64.const 64) (i64.const 0x8000000000000000)) (i64.const 0x8000000000000040))
+(assert_return (invoke "sub" (i64.const 64) (i64.const 0x8000000000000001)) (i64.const 0x800000000000003f))
+(assert_return (invoke "sub" (i64.const 64) (i64.const 0x4000000000000000)) (i64.const 0xc000000000000040))
+(assert_return (invoke "sub" (i64.const 64) (i64.const 0x123456789abcdef)) (i64.const 0xfedcba9876543251))
+(assert_return (invoke "sub" (i64.const 64) (i64.const 0xfedcba9876543210)) (i64.const 0x123456789abce30))
+(assert_return (invoke "sub" (i64.const 64) (i64.const 63)) (i64.const 1))
+(assert_return (invoke "sub" (i64.const 64) (i64.const 64)) (i64.const 0))
+(assert_return (invoke "sub" (i64.const 64) (i64.const 65)) (i64.const -1))
+(assert_return (invoke "sub" (i64.const 64) (i64.const 0xfffffffffff5a5a)) (i64.const 0xf00000000000a5e6))
+(assert_return (invoke "sub" (i64.const 65) (i64.const 0)) (i64.const 65))
+(assert_return (invoke "sub" (i64.const 65) (i64.const 1)) (i64.const 64))
+(assert_return (invoke "sub" (i64.const 65) (i64.const -1)) (i64.const 66))
+(assert_return (invoke "sub" (i64.const 65) (i64.const 2)) (i64.const 63))
+(assert_return (invoke "sub" (i64.const 65) (i64.const 3)) (i64.const 62))
+(assert_return (invoke "sub" (i64.const 65) (i64.const 0x7fffffffffffffff)) (i64.const 0x8000000000000042))
+(assert_return (invoke "sub" (i64.const 65) (i64.const 0x8000000000000000)) (i64.const 0x8000000000000041))
+(assert_return (invoke "sub" (i64.const 65) (i64.const 0x8000000000000001)) (i64.const 0x8000000000000040))
+(assert_return (invoke "sub" (i64.const 65) (i64.const 0x4000000000000000)) (i64.const 0xc000000000000041))
+(assert_return (invoke "sub" (i64.const 65) (i64.const 0x123456789abcdef)) (i64.const 0xfedcba9876543252))
+(assert_return (invoke "sub" (i64.const 65) (i64.const 0xfedcba9876543210)) (i64.const 0x123456789abce31))
+(assert_return (invoke "sub" (i64.const 65) (i64.const 63)) (i64.const 2))
+(assert_return (invoke "sub" (i64.const 65) (i64.const 64)) (i64.const 1))
+(assert_return (invoke "sub" (i64.const 65) (i64.const 65)) (i64.const 0))
+(assert_return (invoke "sub" (i64.const 65) (i64.const 0xfffffffffff5a5a)) (i64.const 0xf00000000000a5e7))
+(assert_return (invoke "sub" (i64.const 0xfffffffffff5a5a) (i64.const 0)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "sub" (i64.const 0xfffffffffff5a5a) (i64.const 1)) (i64.const 0xfffffffffff5a59))
+(assert_return (invoke "sub" (i64.const 0xfffffffffff5a5a) (i64.const -1)) (i64.const 0xfffffffffff5a5b))
+(assert_return (invoke "sub" (i64.const 0xfffffffffff5a5a) (i64.const 2)) (i64.const 0xfffffffffff5a58))
+(assert_return (invoke "sub" (i64.const 0xfffffffffff5a5a) (i64.const 3)) (i64.const 0xfffffffffff5a57))
+(assert_return (invoke "sub" (i64.const 0xfffffffffff5a5a) (i64.const 0x7fffffffffffffff)) (i64.const 0x8fffffffffff5a5b))
+(assert_return (invoke "sub" (i64.const 0xfffffffffff5a5a) (i64.const 0x8000000000000000)) (i64.const 0x8fffffffffff5a5a))
+(assert_return (invoke "sub" (i64.const 0xfffffffffff5a5a) (i64.const 0x8000000000000001)) (i64.const 0x8fffffffffff5a59))
+(assert_return (invoke "sub" (i64.const 0xfffffffffff5a5a) (i64.const 0x4000000000000000)) (i64.const 0xcfffffffffff5a5a))
+(assert_return (invoke "sub" (i64.const 0xfffffffffff5a5a) (i64.const 0x123456789abcdef)) (i64.const 0xedcba9876538c6b))
+(assert_return (invoke "sub" (i64.const 0xfffffffffff5a5a) (i64.const 0xfedcba9876543210)) (i64.const 0x1123456789ab284a))
+(assert_return (invoke "sub" (i64.const 0xfffffffffff5a5a) (i64.const 63)) (i64.const 0xfffffffffff5a1b))
+(assert_return (invoke "sub" (i64.const 0xfffffffffff5a5a) (i64.const 64)) (i64.const 0xfffffffffff5a1a))
+(assert_return (invoke "sub" (i64.const 0xfffffffffff5a5a) (i64.const 65)) (i64.const 0xfffffffffff5a19))
+(assert_return (invoke "sub" (i64.const 0xfffffffffff5a5a) (i64.const 0xfffffffffff5a5a)) (i64.const 0))
+(assert_return (invoke "sub" (i64.const 0x489cf5d232b066d5) (i64.const 0xc845537f76db02ae)) (i64.const 0x8057a252bbd56427))
+(assert_return (invoke "sub" (i64.const 0x57d0a45e35cb2fa0) (i64.const 0x406258cb3d5f19fc)) (i64.const 0x176e4b92f86c15a4))
+(assert_return (invoke "sub" (i64.const 0xa6902a3bc9db37c) (i64.const 0x6849502d3bde2de5)) (i64.const 0xa21fb27680bf8597))
+(assert_return (invoke "sub" (i64.const 0x52b40f168fc22200) (i64.const 0xe9606451159e9f76)) (i64.const 0x6953aac57a23828a))
+(assert_return (invoke "sub" (i64.const 0x7652c2d68487339c) (i64.const 0x14b9c845b2bc57e7)) (i64.const 0x6198fa90d1cadbb5))
+(assert_return (invoke "sub" (i64.const 0xc28fc794729182cf) (i64.const 0x17417f7530a2447d)) (i64.const 0xab4e481f41ef3e52))
+(assert_return (invoke "sub" (i64.const 0xf8ae160d07ba9ca1) (i64.const 0x5b4b9bae466aed70)) (i64.const 0x9d627a5ec14faf31))
+(assert_return (invoke "sub" (i64.const 0xcc740d06689b2804) (i64.const 0xc40ac63119d17add)) (i64.const 0x86946d54ec9ad27))
+(assert_return (invoke "sub" (i64.const 0xf758d5ca6d27be12) (i64.const 0x370c41d28225bbe3)) (i64.const 0xc04c93f7eb02022f))
+(assert_return (invoke "sub" (i64.const 0x788e73d010677940) (i64.const 0xfea0f6a7aa0e3544)) (i64.const 0x79ed7d28665943fc))
+(assert_return (invoke "sub" (i64.const 0xbde6e11f4ac0a72) (i64.const 0x61e419e8b3dc9c23)) (i64.const 0xa9fa542940cf6e4f))
+(assert_return (invoke "sub" (i64.const 0x6b1a64047317a056) (i64.const 0xdf8af0e9d5325c4c)) (i64.const 0x8b8f731a9de5440a))
+(assert_return (invoke "sub" (i64.const 0xaf2c439f1e83df2) (i64.const 0x339a48adf323f6d2)) (i64.const 0xd7587b8bfec44720))
+(assert_return (invoke "sub" (i64.const 0xb7dac08ef397abdb) (i64.const 0x4fcc3378c40386cf)) (i64.const 0x680e8d162f94250c))
+(assert_return (invoke "sub" (i64.const 0x29ebd644f176d3f2) (i64.const 0x9fb842acc302600f)) (i64.const 0x8a3393982e7473e3))
+(assert_return (invoke "sub" (i64.const 0x74b67aef532fc3ab) (i64.const 0xd4bfd5ba226c855e)) (i64.const 0x9ff6a53530c33e4d))
+
+(assert_return (invoke "mul" (i64.const 0) (i64.const 0)) (i64.const 0))
+(assert_return (invoke "mul" (i64.const 0) (i64.const 1)) (i64.const 0))
+(assert_return (invoke "mul" (i64.const 0) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "mul" (i64.const 0) (i64.const 2)) (i64.const 0))
+(assert_return (invoke "mul" (i64.const 0) (i64.const 3)) (i64.const 0))
+(assert_return (invoke "mul" (i64.const 0) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "mul" (i64.const 0) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "mul" (i64.const 0) (i64.const 0x8000000000000001)) (i64.const 0))
+(assert_return (invoke "mul" (i64.const 0) (i64.const 0x4000000000000000)) (i64.const 0))
+(assert_return (invoke "mul" (i64.const 0) (i64.const 0x123456789abcdef)) (i64.const 0))
+(assert_return (invoke "mul" (i64.const 0) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "mul" (i64.const 0) (i64.const 63)) (i64.const 0))
+(assert_return (invoke "mul" (i64.const 0) (i64.const 64)) (i64.const 0))
+(assert_return (invoke "mul" (i64.const 0) (i64.const 65)) (i64.const 0))
+(assert_return (invoke "mul" (i64.const 0) (i64.const 0xfffffffffff5a5a)) (i64.const 0))
+(assert_return (invoke "mul" (i64.const 1) (i64.const 0)) (i64.const 0))
+(assert_return (invoke "mul" (i64.const 1) (i64.const 1)) (i64.const 1))
+(assert_return (invoke "mul" (i64.const 1) (i64.const -1)) (i64.const -1))
+(assert_return (invoke "mul" (i64.const 1) (i64.const 2)) (i64.const 2))
+(assert_return (invoke "mul" (i64.const 1) (i64.const 3)) (i64.const 3))
+(assert_return (invoke "mul" (i64.const 1) (i64.const 0x7fffffffffffffff)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "mul" (i64.const 1) (i64.const 0x8000000000000000)) (i64.const 0x8000000000000000))
+(assert_return (invoke "mul" (i64.const 1) (i64.const 0x8000000000000001)) (i64.const 0x8000000000000001))
+(assert_return (invoke "mul" (i64.const 1) (i64.const 0x4000000000000000)) (i64.const 0x4000000000000000))
+(assert_return (invoke "mul" (i64.const 1) (i64.const 0x123456789abcdef)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "mul" (i64.const 1) (i64.const 0xfedcba9876543210)) (i64.const 0xfedcba9876543210))
+(assert_return (invoke "mul" (i64.const 1) (i64.const 63)) (i64.const 63))
+(assert_return (invoke "mul" (i64.const 1) (i64.const 64)) (i64.const 64))
+(assert_return (invoke "mul" (i64.const 1) (i64.const 65)) (i64.const 65))
+(assert_return (invoke "mul" (i64.const 1) (i64.const 0xfffffffffff5a5a)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "mul" (i64.const -1) (i64.const 0)) (i64.const 0))
+(assert_return (invoke "mul" (i64.const -1) (i64.const 1)) (i64.const -1))
+(assert_return (invoke "mul" (i64.const -1) (i64.const -1)) (i64.const 1))
+(assert_return (invoke "mul" (i64.const -1) (i64.const 2)) (i64.const -2))
+(assert_return (invoke "mul" (i64.const -1) (i64.const 3)) (i64.const -3))
+(assert_return (invoke "mul" (i64.const -1) (i64.const 0x7fffffffffffffff)) (i64.const 0x8000000000000001))
+(assert_return (invoke "mul" (i64.const -1) (i64.const 0x8000000000000000)) (i64.const 0x8000000000000000))
+(assert_return (invoke "mul" (i64.const -1) (i64.const 0x8000000000000001)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "mul" (i64.const -1) (i64.const 0x4000000000000000)) (i64.const 0xc000000000000000))
+(assert_return (invoke "mul" (i64.const -1) (i64.const 0x123456789abcdef)) (i64.const 0xfedcba9876543211))
+(assert_return (invoke "mul" (i64.const -1) (i64.const 0xfedcba9876543210)) (i64.const 0x123456789abcdf0))
+(assert_return (invoke "mul" (i64.const -1) (i64.const 63)) (i64.const -63))
+(assert_return (invoke "mul" (i64.const -1) (i64.const 64)) (i64.const -64))
+(assert_return (invoke "mul" (i64.const -1) (i64.const 65)) (i64.const -65))
+(assert_return (invoke "mul" (i64.const -1) (i64.const 0xfffffffffff5a5a)) (i64.const 0xf00000000000a5a6))
+(assert_return (invoke "mul" (i64.const 2) (i64.const 0)) (i64.const 0))
+(assert_return (invoke "mul" (i64.const 2) (i64.const 1)) (i64.const 2))
+(assert_return (invoke "mul" (i64.const 2) (i64.const -1)) (i64.const -2))
+(assert_return (invoke "mul" (i64.const 2) (i64.const 2)) (i64.const 4))
+(assert_return (invoke "mul" (i64.const 2) (i64.const 3)) (i64.const 6))
+(assert_return (invoke "mul" (i64.const 2) (i64.const 0x7fffffffffffffff)) (i64.const -2))
+(assert_return (invoke "mul" (i64.const 2) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "mul" (i64.const 2) (i64.const 0x8000000000000001)) (i64.const 2))
+(assert_return (invoke "mul" (i64.const 2) (i64.const 0x4000000000000000)) (i64.const 0x8000000000000000))
+(assert_return (invoke "mul" (i64.const 2) (i64.const 0x123456789abcdef)) (i64.const 0x2468acf13579bde))
+(assert_return (invoke "mul" (i64.const 2) (i64.const 0xfedcba9876543210)) (i64.const 0xfdb97530eca86420))
+(assert_return (invoke "mul" (i64.const 2) (i64.const 63)) (i64.const 126))
+(assert_return (invoke "mul" (i64.const 2) (i64.const 64)) (i64.const 128))
+(assert_return (invoke "mul" (i64.const 2) (i64.const 65)) (i64.const 130))
+(assert_return (invoke "mul" (i64.const 2) (i64.const 0xfffffffffff5a5a)) (i64.const 0x1ffffffffffeb4b4))
+(assert_return (invoke "mul" (i64.const 3) (i64.const 0)) (i64.const 0))
+(assert_return (invoke "mul" (i64.const 3) (i64.const 1)) (i64.const 3))
+(assert_return (invoke "mul" (i64.const 3) (i64.const -1)) (i64.const -3))
+(assert_return (invoke "mul" (i64.const 3) (i64.const 2)) (i64.const 6))
+(assert_return (invoke "mul" (i64.const 3) (i64.const 3)) (i64.const 9))
+(assert_return (invoke "mul" (i64.const 3) (i64.const 0x7fffffffffffffff)) (i64.const 0x7ffffffffffffffd))
+(assert_return (invoke "mul" (i64.const 3) (i64.const 0x8000000000000000)) (i64.const 0x8000000000000000))
+(assert_return (invoke "mul" (i64.const 3) (i64.const 0x8000000000000001)) (i64.const 0x8000000000000003))
+(assert_return (invoke "mul" (i64.const 3) (i64.const 0x4000000000000000)) (i64.const 0xc000000000000000))
+(assert_return (invoke "mul" (i64.const 3) (i64.const 0x123456789abcdef)) (i64.const 0x369d0369d0369cd))
+(assert_return (invoke "mul" (i64.const 3) (i64.const 0xfedcba9876543210)) (i64.const 0xfc962fc962fc9630))
+(assert_return (invoke "mul" (i64.const 3) (i64.const 63)) (i64.const 189))
+(assert_return (invoke "mul" (i64.const 3) (i64.const 64)) (i64.const 192))
+(assert_return (invoke "mul" (i64.const 3) (i64.const 65)) (i64.const 195))
+(assert_return (invoke "mul" (i64.const 3) (i64.const 0xfffffffffff5a5a)) (i64.const 0x2ffffffffffe0f0e))
+(assert_return (invoke "mul" (i64.const 0x7fffffffffffffff) (i64.const 0)) (i64.const 0))
+(assert_return (invoke "mul" (i64.const 0x7fffffffffffffff) (i64.const 1)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "mul" (i64.const 0x7fffffffffffffff) (i64.const -1)) (i64.const 0x8000000000000001))
+(assert_return (invoke "mul" (i64.const 0x7fffffffffffffff) (i64.const 2)) (i64.const -2))
+(assert_return (invoke "mul" (i64.const 0x7fffffffffffffff) (i64.const 3)) (i64.const 0x7ffffffffffffffd))
+(assert_return (invoke "mul" (i64.const 0x7fffffffffffffff) (i64.const 0x7fffffffffffffff)) (i64.const 1))
+(assert_return (invoke "mul" (i64.const 0x7fffffffffffffff) (i64.const 0x8000000000000000)) (i64.const 0x8000000000000000))
+(assert_return (invoke "mul" (i64.const 0x7fffffffffffffff) (i64.const 0x8000000000000001)) (i64.const -1))
+(assert_return (invoke "mul" (i64.const 0x7fffffffffffffff) (i64.const 0x4000000000000000)) (i64.const 0xc000000000000000))
+(assert_return (invoke "mul" (i64.const 0x7fffffffffffffff) (i64.const 0x123456789abcdef)) (i64.const 0x7edcba9876543211))
+(assert_return (invoke "mul" (i64.const 0x7fffffffffffffff) (i64.const 0xfedcba9876543210)) (i64.const 0x123456789abcdf0))
+(assert_return (invoke "mul" (i64.const 0x7fffffffffffffff) (i64.const 63)) (i64.const 0x7fffffffffffffc1))
+(assert_return (invoke "mul" (i64.const 0x7fffffffffffffff) (i64.const 64)) (i64.const -64))
+(assert_return (invoke "mul" (i64.const 0x7fffffffffffffff) (i64.const 65)) (i64.const 0x7fffffffffffffbf))
+(assert_return (invoke "mul" (i64.const 0x7fffffffffffffff) (i64.const 0xfffffffffff5a5a)) (i64.const 0xf00000000000a5a6))
+(assert_return (invoke "mul" (i64.const 0x8000000000000000) (i64.const 0)) (i64.const 0))
+(assert_return (invoke "mul" (i64.const 0x8000000000000000) (i64.const 1)) (i64.const 0x8000000000000000))
+(assert_return (invoke "mul" (i64.const 0x8000000000000000) (i64.const -1)) (i64.const 0x8000000000000000))
+(assert_return (invoke "mul" (i64.const 0x8000000000000000) (i64.const 2)) (i64.const 0))
+(assert_return (invoke "mul" (i64.const 0x8000000000000000) (i64.const 3)) (i64.const 0x8000000000000000))
+(assert_return (invoke "mul" (i64.const 0x8000000000000000) (i64.const 0x7fffffffffffffff)) (i64.const 0x8000000000000000))
+(assert_return (invoke "mul" (i64.const 0x8000000000000000) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "mul" (i64.const 0x8000000000000000) (i64.const 0x8000000000000001)) (i64.const 0x8000000000000000))
+(assert_return (invoke "mul" (i64.const 0x8000000000000000) (i64.const 0x4000000000000000)) (i64.const 0))
+(assert_return (invoke "mul" (i64.const 0x8000000000000000) (i64.const 0x123456789abcdef)) (i64.const 0x8000000000000000))
+(assert_return (invoke "mul" (i64.const 0x8000000000000000) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "mul" (i64.const 0x8000000000000000) (i64.const 63)) (i64.const 0x8000000000000000))
+(assert_return (invoke "mul" (i64.const 0x8000000000000000) (i64.const 64)) (i64.const 0))
+(assert_return (invoke "mul" (i64.const 0x8000000000000000) (i64.const 65)) (i64.const 0x8000000000000000))
+(assert_return (invoke "mul" (i64.const 0x8000000000000000) (i64.const 0xfffffffffff5a5a)) (i64.const 0))
+(assert_return (invoke "mul" (i64.const 0x8000000000000001) (i64.const 0)) (i64.const 0))
+(assert_return (invoke "mul" (i64.const 0x8000000000000001) (i64.const 1)) (i64.const 0x8000000000000001))
+(assert_return (invoke "mul" (i64.const 0x8000000000000001) (i64.const -1)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "mul" (i64.const 0x8000000000000001) (i64.const 2)) (i64.const 2))
+(assert_return (invoke "mul" (i64.const 0x8000000000000001) (i64.const 3)) (i64.const 0x8000000000000003))
+(assert_return (invoke "mul" (i64.const 0x8000000000000001) (i64.const 0x7fffffffffffffff)) (i64.const -1))
+(assert_return (invoke "mul" (i64.const 0x8000000000000001) (i64.const 0x8000000000000000)) (i64.const 0x8000000000000000))
+(assert_return (invoke "mul" (i64.const 0x8000000000000001) (i64.const 0x8000000000000001)) (i64.const 1))
+(assert_return (invoke "mul" (i64.const 0x8000000000000001) (i64.const 0x4000000000000000)) (i64.const 0x4000000000000000))
+(assert_return (invoke "mul" (i64.const 0x8000000000000001) (i64.const 0x123456789abcdef)) (i64.const 0x8123456789abcdef))
+(assert_return (invoke "mul" (i64.const 0x8000000000000001) (i64.const 0xfedcba9876543210)) (i64.const 0xfedcba9876543210))
+(assert_return (invoke "mul" (i64.const 0x8000000000000001) (i64.const 63)) (i64.const 0x800000000000003f))
+(assert_return (invoke "mul" (i64.const 0x8000000000000001) (i64.const 64)) (i64.const 64))
+(assert_return (invoke "mul" (i64.const 0x8000000000000001) (i64.const 65)) (i64.const 0x8000000000000041))
+(assert_return (invoke "mul" (i64.const 0x8000000000000001) (i64.const 0xfffffffffff5a5a)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "mul" (i64.const 0x4000000000000000) (i64.const 0)) (i64.const 0))
+(assert_return (invoke "mul" (i64.const 0x4000000000000000) (i64.const 1)) (i64.const 0x4000000000000000))
+(assert_return (invoke "mul" (i64.const 0x4000000000000000) (i64.const -1)) (i64.const 0xc000000000000000))
+(assert_return (invoke "mul" (i64.const 0x4000000000000000) (i64.const 2)) (i64.const 0x8000000000000000))
+(assert_return (invoke "mul" (i64.const 0x4000000000000000) (i64.const 3)) (i64.const 0xc000000000000000))
+(assert_return (invoke "mul" (i64.const 0x4000000000000000) (i64.const 0x7fffffffffffffff)) (i64.const 0xc000000000000000))
+(assert_return (invoke "mul" (i64.const 0x4000000000000000) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "mul" (i64.const 0x4000000000000000) (i64.const 0x8000000000000001)) (i64.const 0x4000000000000000))
+(assert_return (invoke "mul" (i64.const 0x4000000000000000) (i64.const 0x4000000000000000)) (i64.const 0))
+(assert_return (invoke "mul" (i64.const 0x4000000000000000) (i64.const 0x123456789abcdef)) (i64.const 0xc000000000000000))
+(assert_return (invoke "mul" (i64.const 0x4000000000000000) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "mul" (i64.const 0x4000000000000000) (i64.const 63)) (i64.const 0xc000000000000000))
+(assert_return (invoke "mul" (i64.const 0x4000000000000000) (i64.const 64)) (i64.const 0))
+(assert_return (invoke "mul" (i64.const 0x4000000000000000) (i64.const 65)) (i64.const 0x4000000000000000))
+(assert_return (invoke "mul" (i64.const 0x4000000000000000) (i64.const 0xfffffffffff5a5a)) (i64.const 0x8000000000000000))
+(assert_return (invoke "mul" (i64.const 0x123456789abcdef) (i64.const 0)) (i64.const 0))
+(assert_return (invoke "mul" (i64.const 0x123456789abcdef) (i64.const 1)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "mul" (i64.const 0x123456789abcdef) (i64.const -1)) (i64.const 0xfedcba9876543211))
+(assert_return (invoke "mul" (i64.const 0x123456789abcdef) (i64.const 2)) (i64.const 0x2468acf13579bde))
+(assert_return (invoke "mul" (i64.const 0x123456789abcdef) (i64.const 3)) (i64.const 0x369d0369d0369cd))
+(assert_return (invoke "mul" (i64.const 0x123456789abcdef) (i64.const 0x7fffffffffffffff)) (i64.const 0x7edcba9876543211))
+(assert_return (invoke "mul" (i64.const 0x123456789abcdef) (i64.const 0x8000000000000000)) (i64.const 0x8000000000000000))
+(assert_return (invoke "mul" (i64.const 0x123456789abcdef) (i64.const 0x8000000000000001)) (i64.const 0x8123456789abcdef))
+(assert_return (invoke "mul" (i64.const 0x123456789abcdef) (i64.const 0x4000000000000000)) (i64.const 0xc000000000000000))
+(assert_return (invoke "mul" (i64.const 0x123456789abcdef) (i64.const 0x123456789abcdef)) (i64.const 0xdca5e20890f2a521))
+(assert_return (invoke "mul" (i64.const 0x123456789abcdef) (i64.const 0xfedcba9876543210)) (i64.const 0x2236d88fe5618cf0))
+(assert_return (invoke "mul" (i64.const 0x123456789abcdef) (i64.const 63)) (i64.const 0x47ae147ae147add1))
+(assert_return (invoke "mul" (i64.const 0x123456789abcdef) (i64.const 64)) (i64.const 0x48d159e26af37bc0))
+(assert_return (invoke "mul" (i64.const 0x123456789abcdef) (i64.const 65)) (i64.const 0x49f49f49f49f49af))
+(assert_return (invoke "mul" (i64.const 0x123456789abcdef) (i64.const 0xfffffffffff5a5a)) (i64.const 0x77654320fedd6c06))
+(assert_return (invoke "mul" (i64.const 0xfedcba9876543210) (i64.const 0)) (i64.const 0))
+(assert_return (invoke "mul" (i64.const 0xfedcba9876543210) (i64.const 1)) (i64.const 0xfedcba9876543210))
+(assert_return (invoke "mul" (i64.const 0xfedcba9876543210) (i64.const -1)) (i64.const 0x123456789abcdf0))
+(assert_return (invoke "mul" (i64.const 0xfedcba9876543210) (i64.const 2)) (i64.const 0xfdb97530eca86420))
+(assert_return (invoke "mul" (i64.const 0xfedcba9876543210) (i64.const 3)) (i64.const 0xfc962fc962fc9630))
+(assert_return (invoke "mul" (i64.const 0xfedcba9876543210) (i64.const 0x7fffffffffffffff)) (i64.const 0x123456789abcdf0))
+(assert_return (invoke "mul" (i64.const 0xfedcba9876543210) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "mul" (i64.const 0xfedcba9876543210) (i64.const 0x8000000000000001)) (i64.const 0xfedcba9876543210))
+(assert_return (invoke "mul" (i64.const 0xfedcba9876543210) (i64.const 0x4000000000000000)) (i64.const 0))
+(assert_return (invoke "mul" (i64.const 0xfedcba9876543210) (i64.const 0x123456789abcdef)) (i64.const 0x2236d88fe5618cf0))
+(assert_return (invoke "mul" (i64.const 0xfedcba9876543210) (i64.const 0xfedcba9876543210)) (i64.const 0xdeec6cd7a44a4100))
+(assert_return (invoke "mul" (i64.const 0xfedcba9876543210) (i64.const 63)) (i64.const 0xb851eb851eb851f0))
+(assert_return (invoke "mul" (i64.const 0xfedcba9876543210) (i64.const 64)) (i64.const 0xb72ea61d950c8400))
+(assert_return (invoke "mul" (i64.const 0xfedcba9876543210) (i64.const 65)) (i64.const 0xb60b60b60b60b610))
+(assert_return (invoke "mul" (i64.const 0xfedcba9876543210) (i64.const 0xfffffffffff5a5a)) (i64.const 0x789abcdf012339a0))
+(assert_return (invoke "mul" (i64.const 63) (i64.const 0)) (i64.const 0))
+(assert_return (invoke "mul" (i64.const 63) (i64.const 1)) (i64.const 63))
+(assert_return (invoke "mul" (i64.const 63) (i64.const -1)) (i64.const -63))
+(assert_return (invoke "mul" (i64.const 63) (i64.const 2)) (i64.const 126))
+(assert_return (invoke "mul" (i64.const 63) (i64.const 3)) (i64.const 189))
+(assert_return (invoke "mul" (i64.const 63) (i64.const 0x7fffffffffffffff)) (i64.const 0x7fffffffffffffc1))
+(assert_return (invoke "mul" (i64.const 63) (i64.const 0x8000000000000000)) (i64.const 0x8000000000000000))
+(assert_return (invoke "mul" (i64.const 63) (i64.const 0x8000000000000001)) (i64.const 0x800000000000003f))
+(assert_return (invoke "mul" (i64.const 63) (i64.const 0x4000000000000000)) (i64.const 0xc000000000000000))
+(assert_return (invoke "mul" (i64.const 63) (i64.const 0x123456789abcdef)) (i64.const 0x47ae147ae147add1))
+(assert_return (invoke "mul" (i64.const 63) (i64.const 0xfedcba9876543210)) (i64.const 0xb851eb851eb851f0))
+(assert_return (invoke "mul" (i64.const 63) (i64.const 63)) (i64.const 3969))
+(assert_return (invoke "mul" (i64.const 63) (i64.const 64)) (i64.const 4032))
+(assert_return (invoke "mul" (i64.const 63) (i64.const 65)) (i64.const 4095))
+(assert_return (invoke "mul" (i64.const 63) (i64.const 0xfffffffffff5a5a)) (i64.const 0xefffffffffd73c26))
+(assert_return (invoke "mul" (i64.const 64) (i64.const 0)) (i64.const 0))
+(assert_return (invoke "mul" (i64.const 64) (i64.const 1)) (i64.const 64))
+(assert_return (invoke "mul" (i64.const 64) (i64.const -1)) (i64.const -64))
+(assert_return (invoke "mul" (i64.const 64) (i64.const 2)) (i64.const 128))
+(assert_return (invoke "mul" (i64.const 64) (i64.const 3)) (i64.const 192))
+(assert_return (invoke "mul" (i64.const 64) (i64.const 0x7fffffffffffffff)) (i64.const -64))
+(assert_return (invoke "mul" (i64.const 64) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "mul" (i64.const 64) (i64.const 0x8000000000000001)) (i64.const 64))
+(assert_return (invoke "mul" (i64.const 64) (i64.const 0x4000000000000000)) (i64.const 0))
+(assert_return (invoke "mul" (i64.const 64) (i64.const 0x123456789abcdef)) (i64.const 0x48d159e26af37bc0))
+(assert_return (invoke "mul" (i64.const 64) (i64.const 0xfedcba9876543210)) (i64.const 0xb72ea61d950c8400))
+(assert_return (invoke "mul" (i64.const 64) (i64.const 63)) (i64.const 4032))
+(assert_return (invoke "mul" (i64.const 64) (i64.const 64)) (i64.const 4096))
+(assert_return (invoke "mul" (i64.const 64) (i64.const 65)) (i64.const 4160))
+(assert_return (invoke "mul" (i64.const 64) (i64.const 0xfffffffffff5a5a)) (i64.const 0xffffffffffd69680))
+(assert_return (invoke "mul" (i64.const 65) (i64.const 0)) (i64.const 0))
+(assert_return (invoke "mul" (i64.const 65) (i64.const 1)) (i64.const 65))
+(assert_return (invoke "mul" (i64.const 65) (i64.const -1)) (i64.const -65))
+(assert_return (invoke "mul" (i64.const 65) (i64.const 2)) (i64.const 130))
+(assert_return (invoke "mul" (i64.const 65) (i64.const 3)) (i64.const 195))
+(assert_return (invoke "mul" (i64.const 65) (i64.const 0x7fffffffffffffff)) (i64.const 0x7fffffffffffffbf))
+(assert_return (invoke "mul" (i64.const 65) (i64.const 0x8000000000000000)) (i64.const 0x8000000000000000))
+(assert_return (invoke "mul" (i64.const 65) (i64.const 0x8000000000000001)) (i64.const 0x8000000000000041))
+(assert_return (invoke "mul" (i64.const 65) (i64.const 0x4000000000000000)) (i64.const 0x4000000000000000))
+(assert_return (invoke "mul" (i64.const 65) (i64.const 0x123456789abcdef)) (i64.const 0x49f49f49f49f49af))
+(assert_return (invoke "mul" (i64.const 65) (i64.const 0xfedcba9876543210)) (i64.const 0xb60b60b60b60b610))
+(assert_return (invoke "mul" (i64.const 65) (i64.const 63)) (i64.const 4095))
+(assert_return (invoke "mul" (i64.const 65) (i64.const 64)) (i64.const 4160))
+(assert_return (invoke "mul" (i64.const 65) (i64.const 65)) (i64.const 4225))
+(assert_return (invoke "mul" (i64.const 65) (i64.const 0xfffffffffff5a5a)) (i64.const 0xfffffffffd5f0da))
+(assert_return (invoke "mul" (i64.const 0xfffffffffff5a5a) (i64.const 0)) (i64.const 0))
+(assert_return (invoke "mul" (i64.const 0xfffffffffff5a5a) (i64.const 1)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "mul" (i64.const 0xfffffffffff5a5a) (i64.const -1)) (i64.const 0xf00000000000a5a6))
+(assert_return (invoke "mul" (i64.const 0xfffffffffff5a5a) (i64.const 2)) (i64.const 0x1ffffffffffeb4b4))
+(assert_return (invoke "mul" (i64.const 0xfffffffffff5a5a) (i64.const 3)) (i64.const 0x2ffffffffffe0f0e))
+(assert_return (invoke "mul" (i64.const 0xfffffffffff5a5a) (i64.const 0x7fffffffffffffff)) (i64.const 0xf00000000000a5a6))
+(assert_return (invoke "mul" (i64.const 0xfffffffffff5a5a) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "mul" (i64.const 0xfffffffffff5a5a) (i64.const 0x8000000000000001)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "mul" (i64.const 0xfffffffffff5a5a) (i64.const 0x4000000000000000)) (i64.const 0x8000000000000000))
+(assert_return (invoke "mul" (i64.const 0xfffffffffff5a5a) (i64.const 0x123456789abcdef)) (i64.const 0x77654320fedd6c06))
+(assert_return (invoke "mul" (i64.const 0xfffffffffff5a5a) (i64.const 0xfedcba9876543210)) (i64.const 0x789abcdf012339a0))
+(assert_return (invoke "mul" (i64.const 0xfffffffffff5a5a) (i64.const 63)) (i64.const 0xefffffffffd73c26))
+(assert_return (invoke "mul" (i64.const 0xfffffffffff5a5a) (i64.const 64)) (i64.const 0xffffffffffd69680))
+(assert_return (invoke "mul" (i64.const 0xfffffffffff5a5a) (i64.const 65)) (i64.const 0xfffffffffd5f0da))
+(assert_return (invoke "mul" (i64.const 0xfffffffffff5a5a) (i64.const 0xfffffffffff5a5a)) (i64.const 0x400000006b2f67a4))
+(assert_return (invoke "mul" (i64.const 0x7327f71e7caf611b) (i64.const 0xd7c1df12b3f4f843)) (i64.const 0x215f06b8f6b49211))
+(assert_return (invoke "mul" (i64.const 0xc61135d544dfc914) (i64.const 0xda31804c5c271367)) (i64.const 0xabf719362402630c))
+(assert_return (invoke "mul" (i64.const 0x4c36a4bd150ea3a5) (i64.const 0xaff3fc710a7ac271)) (i64.const 0xc710449ed21b45d5))
+(assert_return (invoke "mul" (i64.const 0xeaeedc0a425010b0) (i64.const 0xa949a0afd937836f)) (i64.const 0x61ee2e0a7f114c50))
+(assert_return (invoke "mul" (i64.const 0x7ffa048a0f731c2c) (i64.const 0x17a0c99b265ce3b0)) (i64.const 0x1922c8ce58ee6240))
+(assert_return (invoke "mul" (i64.const 0x35525e97664689fe) (i64.const 0xf0b5cdd1f7d91784)) (i64.const 0xd3a92f8e1d12f8f8))
+(assert_return (invoke "mul" (i64.const 0xa08871ff37bb01a5) (i64.const 0x4d7be8550b2e581f)) (i64.const 0x30d2a9856adbeafb))
+(assert_return (invoke "mul" (i64.const 0x725145d60c833f38) (i64.const 0x13c7928b581c2c75)) (i64.const 0x60b5747870f98498))
+(assert_return (invoke "mul" (i64.const 0x10af7a8c016bfe9e) (i64.const 0xf290fba3ababe9b4)) (i64.const 0x31abb68ce836d518))
+(assert_return (invoke "mul" (i64.const 0x30b540f40fe8632a) (i64.const 0x7ad4c9f2309d365c)) (i64.const 0xf7d1dee16d307f18))
+(assert_return (invoke "mul" (i64.const 0x1174419913995786) (i64.const 0xfc60fa28452795bc)) (i64.const 0x45d71eb617f74468))
+(assert_return (invoke "mul" (i64.const 0x9aea239f16a07f52) (i64.const 0x62e90d2e2a100613)) (i64.const 0xccce28fdda055f16))
+(assert_return (invoke "mul" (i64.const 0xe233d78c31a2174b) (i64.const 0x81b5a6143bba47ff)) (i64.const 0xdc8375689a6900b5))
+(assert_return (invoke "mul" (i64.const 0x7327c2c8a26d3002) (i64.const 0xefb326a35ea72c1f)) (i64.const 0x93dcfc577cc7283e))
+(assert_return (invoke "mul" (i64.const 0xa87026643510a4bf) (i64.const 0xfdbd19680318fa54)) (i64.const 0x232b34c9594094ac))
+(assert_return (invoke "mul" (i64.const 0xcdd0a679dd86a442) (i64.const 0x74a763fbda8ff030)) (i64.const 0x4b7f6f53b81aac60))
+
+(assert_trap (invoke "div_s" (i64.const 0) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "div_s" (i64.const 0) (i64.const 1)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 0) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 0) (i64.const 2)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 0) (i64.const 3)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 0) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 0) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 0) (i64.const 0x8000000000000001)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 0) (i64.const 0x4000000000000000)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 0) (i64.const 0x123456789abcdef)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 0) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 0) (i64.const 63)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 0) (i64.const 64)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 0) (i64.const 65)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 0) (i64.const 0xfffffffffff5a5a)) (i64.const 0))
+(assert_trap (invoke "div_s" (i64.const 1) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "div_s" (i64.const 1) (i64.const 1)) (i64.const 1))
+(assert_return (invoke "div_s" (i64.const 1) (i64.const -1)) (i64.const -1))
+(assert_return (invoke "div_s" (i64.const 1) (i64.const 2)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 1) (i64.const 3)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 1) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 1) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 1) (i64.const 0x8000000000000001)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 1) (i64.const 0x4000000000000000)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 1) (i64.const 0x123456789abcdef)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 1) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 1) (i64.const 63)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 1) (i64.const 64)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 1) (i64.const 65)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 1) (i64.const 0xfffffffffff5a5a)) (i64.const 0))
+(assert_trap (invoke "div_s" (i64.const -1) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "div_s" (i64.const -1) (i64.const 1)) (i64.const -1))
+(assert_return (invoke "div_s" (i64.const -1) (i64.const -1)) (i64.const 1))
+(assert_return (invoke "div_s" (i64.const -1) (i64.const 2)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const -1) (i64.const 3)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const -1) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const -1) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const -1) (i64.const 0x8000000000000001)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const -1) (i64.const 0x4000000000000000)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const -1) (i64.const 0x123456789abcdef)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const -1) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const -1) (i64.const 63)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const -1) (i64.const 64)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const -1) (i64.const 65)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const -1) (i64.const 0xfffffffffff5a5a)) (i64.const 0))
+(assert_trap (invoke "div_s" (i64.const 2) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "div_s" (i64.const 2) (i64.const 1)) (i64.const 2))
+(assert_return (invoke "div_s" (i64.const 2) (i64.const -1)) (i64.const -2))
+(assert_return (invoke "div_s" (i64.const 2) (i64.const 2)) (i64.const 1))
+(assert_return (invoke "div_s" (i64.const 2) (i64.const 3)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 2) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 2) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 2) (i64.const 0x8000000000000001)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 2) (i64.const 0x4000000000000000)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 2) (i64.const 0x123456789abcdef)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 2) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 2) (i64.const 63)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 2) (i64.const 64)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 2) (i64.const 65)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 2) (i64.const 0xfffffffffff5a5a)) (i64.const 0))
+(assert_trap (invoke "div_s" (i64.const 3) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "div_s" (i64.const 3) (i64.const 1)) (i64.const 3))
+(assert_return (invoke "div_s" (i64.const 3) (i64.const -1)) (i64.const -3))
+(assert_return (invoke "div_s" (i64.const 3) (i64.const 2)) (i64.const 1))
+(assert_return (invoke "div_s" (i64.const 3) (i64.const 3)) (i64.const 1))
+(assert_return (invoke "div_s" (i64.const 3) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 3) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 3) (i64.const 0x8000000000000001)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 3) (i64.const 0x4000000000000000)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 3) (i64.const 0x123456789abcdef)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 3) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 3) (i64.const 63)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 3) (i64.const 64)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 3) (i64.const 65)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 3) (i64.const 0xfffffffffff5a5a)) (i64.const 0))
+(assert_trap (invoke "div_s" (i64.const 0x7fffffffffffffff) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "div_s" (i64.const 0x7fffffffffffffff) (i64.const 1)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "div_s" (i64.const 0x7fffffffffffffff) (i64.const -1)) (i64.const 0x8000000000000001))
+(assert_return (invoke "div_s" (i64.const 0x7fffffffffffffff) (i64.const 2)) (i64.const 0x3fffffffffffffff))
+(assert_return (invoke "div_s" (i64.const 0x7fffffffffffffff) (i64.const 3)) (i64.const 0x2aaaaaaaaaaaaaaa))
+(assert_return (invoke "div_s" (i64.const 0x7fffffffffffffff) (i64.const 0x7fffffffffffffff)) (i64.const 1))
+(assert_return (invoke "div_s" (i64.const 0x7fffffffffffffff) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 0x7fffffffffffffff) (i64.const 0x8000000000000001)) (i64.const -1))
+(assert_return (invoke "div_s" (i64.const 0x7fffffffffffffff) (i64.const 0x4000000000000000)) (i64.const 1))
+(assert_return (invoke "div_s" (i64.const 0x7fffffffffffffff) (i64.const 0x123456789abcdef)) (i64.const 112))
+(assert_return (invoke "div_s" (i64.const 0x7fffffffffffffff) (i64.const 0xfedcba9876543210)) (i64.const -112))
+(assert_return (invoke "div_s" (i64.const 0x7fffffffffffffff) (i64.const 63)) (i64.const 0x208208208208208))
+(assert_return (invoke "div_s" (i64.const 0x7fffffffffffffff) (i64.const 64)) (i64.const 0x1ffffffffffffff))
+(assert_return (invoke "div_s" (i64.const 0x7fffffffffffffff) (i64.const 65)) (i64.const 0x1f81f81f81f81f8))
+(assert_return (invoke "div_s" (i64.const 0x7fffffffffffffff) (i64.const 0xfffffffffff5a5a)) (i64.const 8))
+(assert_trap (invoke "div_s" (i64.const 0x8000000000000000) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "div_s" (i64.const 0x8000000000000000) (i64.const 1)) (i64.const 0x8000000000000000))
+(assert_trap (invoke "div_s" (i64.const 0x8000000000000000) (i64.const -1)) "integer overflow")
+(assert_return (invoke "div_s" (i64.const 0x8000000000000000) (i64.const 2)) (i64.const 0xc000000000000000))
+(assert_return (invoke "div_s" (i64.const 0x8000000000000000) (i64.const 3)) (i64.const 0xd555555555555556))
+(assert_return (invoke "div_s" (i64.const 0x8000000000000000) (i64.const 0x7fffffffffffffff)) (i64.const -1))
+(assert_return (invoke "div_s" (i64.const 0x8000000000000000) (i64.const 0x8000000000000000)) (i64.const 1))
+(assert_return (invoke "div_s" (i64.const 0x8000000000000000) (i64.const 0x8000000000000001)) (i64.const 1))
+(assert_return (invoke "div_s" (i64.const 0x8000000000000000) (i64.const 0x4000000000000000)) (i64.const -2))
+(assert_return (invoke "div_s" (i64.const 0x8000000000000000) (i64.const 0x123456789abcdef)) (i64.const -112))
+(assert_return (invoke "div_s" (i64.const 0x8000000000000000) (i64.const 0xfedcba9876543210)) (i64.const 112))
+(assert_return (invoke "div_s" (i64.const 0x8000000000000000) (i64.const 63)) (i64.const 0xfdf7df7df7df7df8))
+(assert_return (invoke "div_s" (i64.const 0x8000000000000000) (i64.const 64)) (i64.const 0xfe00000000000000))
+(assert_return (invoke "div_s" (i64.const 0x8000000000000000) (i64.const 65)) (i64.const 0xfe07e07e07e07e08))
+(assert_return (invoke "div_s" (i64.const 0x8000000000000000) (i64.const 0xfffffffffff5a5a)) (i64.const -8))
+(assert_trap (invoke "div_s" (i64.const 0x8000000000000001) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "div_s" (i64.const 0x8000000000000001) (i64.const 1)) (i64.const 0x8000000000000001))
+(assert_return (invoke "div_s" (i64.const 0x8000000000000001) (i64.const -1)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "div_s" (i64.const 0x8000000000000001) (i64.const 2)) (i64.const 0xc000000000000001))
+(assert_return (invoke "div_s" (i64.const 0x8000000000000001) (i64.const 3)) (i64.const 0xd555555555555556))
+(assert_return (invoke "div_s" (i64.const 0x8000000000000001) (i64.const 0x7fffffffffffffff)) (i64.const -1))
+(assert_return (invoke "div_s" (i64.const 0x8000000000000001) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 0x8000000000000001) (i64.const 0x8000000000000001)) (i64.const 1))
+(assert_return (invoke "div_s" (i64.const 0x8000000000000001) (i64.const 0x4000000000000000)) (i64.const -1))
+(assert_return (invoke "div_s" (i64.const 0x8000000000000001) (i64.const 0x123456789abcdef)) (i64.const -112))
+(assert_return (invoke "div_s" (i64.const 0x8000000000000001) (i64.const 0xfedcba9876543210)) (i64.const 112))
+(assert_return (invoke "div_s" (i64.const 0x8000000000000001) (i64.const 63)) (i64.const 0xfdf7df7df7df7df8))
+(assert_return (invoke "div_s" (i64.const 0x8000000000000001) (i64.const 64)) (i64.const 0xfe00000000000001))
+(assert_return (invoke "div_s" (i64.const 0x8000000000000001) (i64.const 65)) (i64.const 0xfe07e07e07e07e08))
+(assert_return (invoke "div_s" (i64.const 0x8000000000000001) (i64.const 0xfffffffffff5a5a)) (i64.const -8))
+(assert_trap (invoke "div_s" (i64.const 0x4000000000000000) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "div_s" (i64.const 0x4000000000000000) (i64.const 1)) (i64.const 0x4000000000000000))
+(assert_return (invoke "div_s" (i64.const 0x4000000000000000) (i64.const -1)) (i64.const 0xc000000000000000))
+(assert_return (invoke "div_s" (i64.const 0x4000000000000000) (i64.const 2)) (i64.const 0x2000000000000000))
+(assert_return (invoke "div_s" (i64.const 0x4000000000000000) (i64.const 3)) (i64.const 0x1555555555555555))
+(assert_return (invoke "div_s" (i64.const 0x4000000000000000) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 0x4000000000000000) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 0x4000000000000000) (i64.const 0x8000000000000001)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 0x4000000000000000) (i64.const 0x4000000000000000)) (i64.const 1))
+(assert_return (invoke "div_s" (i64.const 0x4000000000000000) (i64.const 0x123456789abcdef)) (i64.const 56))
+(assert_return (invoke "div_s" (i64.const 0x4000000000000000) (i64.const 0xfedcba9876543210)) (i64.const -56))
+(assert_return (invoke "div_s" (i64.const 0x4000000000000000) (i64.const 63)) (i64.const 0x104104104104104))
+(assert_return (invoke "div_s" (i64.const 0x4000000000000000) (i64.const 64)) (i64.const 0x100000000000000))
+(assert_return (invoke "div_s" (i64.const 0x4000000000000000) (i64.const 65)) (i64.const 0xfc0fc0fc0fc0fc))
+(assert_return (invoke "div_s" (i64.const 0x4000000000000000) (i64.const 0xfffffffffff5a5a)) (i64.const 4))
+(assert_trap (invoke "div_s" (i64.const 0x123456789abcdef) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "div_s" (i64.const 0x123456789abcdef) (i64.const 1)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "div_s" (i64.const 0x123456789abcdef) (i64.const -1)) (i64.const 0xfedcba9876543211))
+(assert_return (invoke "div_s" (i64.const 0x123456789abcdef) (i64.const 2)) (i64.const 0x91a2b3c4d5e6f7))
+(assert_return (invoke "div_s" (i64.const 0x123456789abcdef) (i64.const 3)) (i64.const 0x611722833944a5))
+(assert_return (invoke "div_s" (i64.const 0x123456789abcdef) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 0x123456789abcdef) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 0x123456789abcdef) (i64.const 0x8000000000000001)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 0x123456789abcdef) (i64.const 0x4000000000000000)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 0x123456789abcdef) (i64.const 0x123456789abcdef)) (i64.const 1))
+(assert_return (invoke "div_s" (i64.const 0x123456789abcdef) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 0x123456789abcdef) (i64.const 63)) (i64.const 0x49f93edde27d7))
+(assert_return (invoke "div_s" (i64.const 0x123456789abcdef) (i64.const 64)) (i64.const 0x48d159e26af37))
+(assert_return (invoke "div_s" (i64.const 0x123456789abcdef) (i64.const 65)) (i64.const 0x47b28fa3db856))
+(assert_return (invoke "div_s" (i64.const 0x123456789abcdef) (i64.const 0xfffffffffff5a5a)) (i64.const 0))
+(assert_trap (invoke "div_s" (i64.const 0xfedcba9876543210) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "div_s" (i64.const 0xfedcba9876543210) (i64.const 1)) (i64.const 0xfedcba9876543210))
+(assert_return (invoke "div_s" (i64.const 0xfedcba9876543210) (i64.const -1)) (i64.const 0x123456789abcdf0))
+(assert_return (invoke "div_s" (i64.const 0xfedcba9876543210) (i64.const 2)) (i64.const 0xff6e5d4c3b2a1908))
+(assert_return (invoke "div_s" (i64.const 0xfedcba9876543210) (i64.const 3)) (i64.const 0xff9ee8dd7cc6bb5b))
+(assert_return (invoke "div_s" (i64.const 0xfedcba9876543210) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 0xfedcba9876543210) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 0xfedcba9876543210) (i64.const 0x8000000000000001)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 0xfedcba9876543210) (i64.const 0x4000000000000000)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 0xfedcba9876543210) (i64.const 0x123456789abcdef)) (i64.const -1))
+(assert_return (invoke "div_s" (i64.const 0xfedcba9876543210) (i64.const 0xfedcba9876543210)) (i64.const 1))
+(assert_return (invoke "div_s" (i64.const 0xfedcba9876543210) (i64.const 63)) (i64.const 0xfffb606c1221d829))
+(assert_return (invoke "div_s" (i64.const 0xfedcba9876543210) (i64.const 64)) (i64.const 0xfffb72ea61d950c9))
+(assert_return (invoke "div_s" (i64.const 0xfedcba9876543210) (i64.const 65)) (i64.const 0xfffb84d705c247aa))
+(assert_return (invoke "div_s" (i64.const 0xfedcba9876543210) (i64.const 0xfffffffffff5a5a)) (i64.const 0))
+(assert_trap (invoke "div_s" (i64.const 63) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "div_s" (i64.const 63) (i64.const 1)) (i64.const 63))
+(assert_return (invoke "div_s" (i64.const 63) (i64.const -1)) (i64.const -63))
+(assert_return (invoke "div_s" (i64.const 63) (i64.const 2)) (i64.const 31))
+(assert_return (invoke "div_s" (i64.const 63) (i64.const 3)) (i64.const 21))
+(assert_return (invoke "div_s" (i64.const 63) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 63) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 63) (i64.const 0x8000000000000001)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 63) (i64.const 0x4000000000000000)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 63) (i64.const 0x123456789abcdef)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 63) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 63) (i64.const 63)) (i64.const 1))
+(assert_return (invoke "div_s" (i64.const 63) (i64.const 64)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 63) (i64.const 65)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 63) (i64.const 0xfffffffffff5a5a)) (i64.const 0))
+(assert_trap (invoke "div_s" (i64.const 64) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "div_s" (i64.const 64) (i64.const 1)) (i64.const 64))
+(assert_return (invoke "div_s" (i64.const 64) (i64.const -1)) (i64.const -64))
+(assert_return (invoke "div_s" (i64.const 64) (i64.const 2)) (i64.const 32))
+(assert_return (invoke "div_s" (i64.const 64) (i64.const 3)) (i64.const 21))
+(assert_return (invoke "div_s" (i64.const 64) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 64) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 64) (i64.const 0x8000000000000001)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 64) (i64.const 0x4000000000000000)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 64) (i64.const 0x123456789abcdef)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 64) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 64) (i64.const 63)) (i64.const 1))
+(assert_return (invoke "div_s" (i64.const 64) (i64.const 64)) (i64.const 1))
+(assert_return (invoke "div_s" (i64.const 64) (i64.const 65)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 64) (i64.const 0xfffffffffff5a5a)) (i64.const 0))
+(assert_trap (invoke "div_s" (i64.const 65) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "div_s" (i64.const 65) (i64.const 1)) (i64.const 65))
+(assert_return (invoke "div_s" (i64.const 65) (i64.const -1)) (i64.const -65))
+(assert_return (invoke "div_s" (i64.const 65) (i64.const 2)) (i64.const 32))
+(assert_return (invoke "div_s" (i64.const 65) (i64.const 3)) (i64.const 21))
+(assert_return (invoke "div_s" (i64.const 65) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 65) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 65) (i64.const 0x8000000000000001)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 65) (i64.const 0x4000000000000000)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 65) (i64.const 0x123456789abcdef)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 65) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 65) (i64.const 63)) (i64.const 1))
+(assert_return (invoke "div_s" (i64.const 65) (i64.const 64)) (i64.const 1))
+(assert_return (invoke "div_s" (i64.const 65) (i64.const 65)) (i64.const 1))
+(assert_return (invoke "div_s" (i64.const 65) (i64.const 0xfffffffffff5a5a)) (i64.const 0))
+(assert_trap (invoke "div_s" (i64.const 0xfffffffffff5a5a) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "div_s" (i64.const 0xfffffffffff5a5a) (i64.const 1)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "div_s" (i64.const 0xfffffffffff5a5a) (i64.const -1)) (i64.const 0xf00000000000a5a6))
+(assert_return (invoke "div_s" (i64.const 0xfffffffffff5a5a) (i64.const 2)) (i64.const 0x7ffffffffffad2d))
+(assert_return (invoke "div_s" (i64.const 0xfffffffffff5a5a) (i64.const 3)) (i64.const 0x555555555551e1e))
+(assert_return (invoke "div_s" (i64.const 0xfffffffffff5a5a) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 0xfffffffffff5a5a) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 0xfffffffffff5a5a) (i64.const 0x8000000000000001)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 0xfffffffffff5a5a) (i64.const 0x4000000000000000)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 0xfffffffffff5a5a) (i64.const 0x123456789abcdef)) (i64.const 14))
+(assert_return (invoke "div_s" (i64.const 0xfffffffffff5a5a) (i64.const 0xfedcba9876543210)) (i64.const -14))
+(assert_return (invoke "div_s" (i64.const 0xfffffffffff5a5a) (i64.const 63)) (i64.const 0x41041041040d9f))
+(assert_return (invoke "div_s" (i64.const 0xfffffffffff5a5a) (i64.const 64)) (i64.const 0x3ffffffffffd69))
+(assert_return (invoke "div_s" (i64.const 0xfffffffffff5a5a) (i64.const 65)) (i64.const 0x3f03f03f03edb2))
+(assert_return (invoke "div_s" (i64.const 0xfffffffffff5a5a) (i64.const 0xfffffffffff5a5a)) (i64.const 1))
+(assert_return (invoke "div_s" (i64.const 0x4e8a754ff2b7ddfa) (i64.const 0x413dfb9b5a51d6f)) (i64.const 19))
+(assert_return (invoke "div_s" (i64.const 0x4cce85b56ec1157d) (i64.const 0x34b2633653fc9e61)) (i64.const 1))
+(assert_return (invoke "div_s" (i64.const 0xc2db5eec31e6adef) (i64.const 0x6ff0942070b56205)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 0xadde266d6b0fe809) (i64.const 0x719fca9bf00dcfe5)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 0x89fefe6c3342f9a4) (i64.const 0xbbaf2344b2f3520c)) (i64.const 1))
+(assert_return (invoke "div_s" (i64.const 0x4ee2f6d0bb127013) (i64.const 0xa168be84a889c5e6)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 0xaa46fdd09d078f0b) (i64.const 0x1274e78c0acd485e)) (i64.const -4))
+(assert_return (invoke "div_s" (i64.const 0x2f2c5b39259a57c1) (i64.const 0xd50f143784a9b922)) (i64.const -1))
+(assert_return (invoke "div_s" (i64.const 0x9d9d86f9a7cac1b) (i64.const 0xe467c939f664771d)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 0x2ecc2e99c9252275) (i64.const 0x98f84be2377cd066)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 0x4ed27b8b25ee570e) (i64.const 0x96a9ebd7c60bd54f)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 0xcf0ada8502c02091) (i64.const 0x936de58de973edd3)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 0x11e9af71163129ab) (i64.const 0x3a736e0f99573703)) (i64.const 0))
+(assert_return (invoke "div_s" (i64.const 0x2e758abbd87fc72c) (i64.const 0x22ffd92b1587e8b0)) (i64.const 1))
+(assert_return (invoke "div_s" (i64.const 0x711743f21a15697a) (i64.const 0x4cc909aca1b583f8)) (i64.const 1))
+(assert_return (invoke "div_s" (i64.const 0xc282bd4bb848f7db) (i64.const 0x5eaa92b502a31330)) (i64.const 0))
+
+(assert_trap (invoke "div_u" (i64.const 0) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "div_u" (i64.const 0) (i64.const 1)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 0) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 0) (i64.const 2)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 0) (i64.const 3)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 0) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 0) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 0) (i64.const 0x8000000000000001)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 0) (i64.const 0x4000000000000000)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 0) (i64.const 0x123456789abcdef)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 0) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 0) (i64.const 63)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 0) (i64.const 64)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 0) (i64.const 65)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 0) (i64.const 0xfffffffffff5a5a)) (i64.const 0))
+(assert_trap (invoke "div_u" (i64.const 1) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "div_u" (i64.const 1) (i64.const 1)) (i64.const 1))
+(assert_return (invoke "div_u" (i64.const 1) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 1) (i64.const 2)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 1) (i64.const 3)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 1) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 1) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 1) (i64.const 0x8000000000000001)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 1) (i64.const 0x4000000000000000)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 1) (i64.const 0x123456789abcdef)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 1) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 1) (i64.const 63)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 1) (i64.const 64)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 1) (i64.const 65)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 1) (i64.const 0xfffffffffff5a5a)) (i64.const 0))
+(assert_trap (invoke "div_u" (i64.const -1) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "div_u" (i64.const -1) (i64.const 1)) (i64.const -1))
+(assert_return (invoke "div_u" (i64.const -1) (i64.const -1)) (i64.const 1))
+(assert_return (invoke "div_u" (i64.const -1) (i64.const 2)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "div_u" (i64.const -1) (i64.const 3)) (i64.const 0x5555555555555555))
+(assert_return (invoke "div_u" (i64.const -1) (i64.const 0x7fffffffffffffff)) (i64.const 2))
+(assert_return (invoke "div_u" (i64.const -1) (i64.const 0x8000000000000000)) (i64.const 1))
+(assert_return (invoke "div_u" (i64.const -1) (i64.const 0x8000000000000001)) (i64.const 1))
+(assert_return (invoke "div_u" (i64.const -1) (i64.const 0x4000000000000000)) (i64.const 3))
+(assert_return (invoke "div_u" (i64.const -1) (i64.const 0x123456789abcdef)) (i64.const 225))
+(assert_return (invoke "div_u" (i64.const -1) (i64.const 0xfedcba9876543210)) (i64.const 1))
+(assert_return (invoke "div_u" (i64.const -1) (i64.const 63)) (i64.const 0x410410410410410))
+(assert_return (invoke "div_u" (i64.const -1) (i64.const 64)) (i64.const 0x3ffffffffffffff))
+(assert_return (invoke "div_u" (i64.const -1) (i64.const 65)) (i64.const 0x3f03f03f03f03f0))
+(assert_return (invoke "div_u" (i64.const -1) (i64.const 0xfffffffffff5a5a)) (i64.const 16))
+(assert_trap (invoke "div_u" (i64.const 2) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "div_u" (i64.const 2) (i64.const 1)) (i64.const 2))
+(assert_return (invoke "div_u" (i64.const 2) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 2) (i64.const 2)) (i64.const 1))
+(assert_return (invoke "div_u" (i64.const 2) (i64.const 3)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 2) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 2) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 2) (i64.const 0x8000000000000001)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 2) (i64.const 0x4000000000000000)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 2) (i64.const 0x123456789abcdef)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 2) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 2) (i64.const 63)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 2) (i64.const 64)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 2) (i64.const 65)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 2) (i64.const 0xfffffffffff5a5a)) (i64.const 0))
+(assert_trap (invoke "div_u" (i64.const 3) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "div_u" (i64.const 3) (i64.const 1)) (i64.const 3))
+(assert_return (invoke "div_u" (i64.const 3) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 3) (i64.const 2)) (i64.const 1))
+(assert_return (invoke "div_u" (i64.const 3) (i64.const 3)) (i64.const 1))
+(assert_return (invoke "div_u" (i64.const 3) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 3) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 3) (i64.const 0x8000000000000001)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 3) (i64.const 0x4000000000000000)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 3) (i64.const 0x123456789abcdef)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 3) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 3) (i64.const 63)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 3) (i64.const 64)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 3) (i64.const 65)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 3) (i64.const 0xfffffffffff5a5a)) (i64.const 0))
+(assert_trap (invoke "div_u" (i64.const 0x7fffffffffffffff) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "div_u" (i64.const 0x7fffffffffffffff) (i64.const 1)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "div_u" (i64.const 0x7fffffffffffffff) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 0x7fffffffffffffff) (i64.const 2)) (i64.const 0x3fffffffffffffff))
+(assert_return (invoke "div_u" (i64.const 0x7fffffffffffffff) (i64.const 3)) (i64.const 0x2aaaaaaaaaaaaaaa))
+(assert_return (invoke "div_u" (i64.const 0x7fffffffffffffff) (i64.const 0x7fffffffffffffff)) (i64.const 1))
+(assert_return (invoke "div_u" (i64.const 0x7fffffffffffffff) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 0x7fffffffffffffff) (i64.const 0x8000000000000001)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 0x7fffffffffffffff) (i64.const 0x4000000000000000)) (i64.const 1))
+(assert_return (invoke "div_u" (i64.const 0x7fffffffffffffff) (i64.const 0x123456789abcdef)) (i64.const 112))
+(assert_return (invoke "div_u" (i64.const 0x7fffffffffffffff) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 0x7fffffffffffffff) (i64.const 63)) (i64.const 0x208208208208208))
+(assert_return (invoke "div_u" (i64.const 0x7fffffffffffffff) (i64.const 64)) (i64.const 0x1ffffffffffffff))
+(assert_return (invoke "div_u" (i64.const 0x7fffffffffffffff) (i64.const 65)) (i64.const 0x1f81f81f81f81f8))
+(assert_return (invoke "div_u" (i64.const 0x7fffffffffffffff) (i64.const 0xfffffffffff5a5a)) (i64.const 8))
+(assert_trap (invoke "div_u" (i64.const 0x8000000000000000) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "div_u" (i64.const 0x8000000000000000) (i64.const 1)) (i64.const 0x8000000000000000))
+(assert_return (invoke "div_u" (i64.const 0x8000000000000000) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 0x8000000000000000) (i64.const 2)) (i64.const 0x4000000000000000))
+(assert_return (invoke "div_u" (i64.const 0x8000000000000000) (i64.const 3)) (i64.const 0x2aaaaaaaaaaaaaaa))
+(assert_return (invoke "div_u" (i64.const 0x8000000000000000) (i64.const 0x7fffffffffffffff)) (i64.const 1))
+(assert_return (invoke "div_u" (i64.const 0x8000000000000000) (i64.const 0x8000000000000000)) (i64.const 1))
+(assert_return (invoke "div_u" (i64.const 0x8000000000000000) (i64.const 0x8000000000000001)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 0x8000000000000000) (i64.const 0x4000000000000000)) (i64.const 2))
+(assert_return (invoke "div_u" (i64.const 0x8000000000000000) (i64.const 0x123456789abcdef)) (i64.const 112))
+(assert_return (invoke "div_u" (i64.const 0x8000000000000000) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 0x8000000000000000) (i64.const 63)) (i64.const 0x208208208208208))
+(assert_return (invoke "div_u" (i64.const 0x8000000000000000) (i64.const 64)) (i64.const 0x200000000000000))
+(assert_return (invoke "div_u" (i64.const 0x8000000000000000) (i64.const 65)) (i64.const 0x1f81f81f81f81f8))
+(assert_return (invoke "div_u" (i64.const 0x8000000000000000) (i64.const 0xfffffffffff5a5a)) (i64.const 8))
+(assert_trap (invoke "div_u" (i64.const 0x8000000000000001) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "div_u" (i64.const 0x8000000000000001) (i64.const 1)) (i64.const 0x8000000000000001))
+(assert_return (invoke "div_u" (i64.const 0x8000000000000001) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 0x8000000000000001) (i64.const 2)) (i64.const 0x4000000000000000))
+(assert_return (invoke "div_u" (i64.const 0x8000000000000001) (i64.const 3)) (i64.const 0x2aaaaaaaaaaaaaab))
+(assert_return (invoke "div_u" (i64.const 0x8000000000000001) (i64.const 0x7fffffffffffffff)) (i64.const 1))
+(assert_return (invoke "div_u" (i64.const 0x8000000000000001) (i64.const 0x8000000000000000)) (i64.const 1))
+(assert_return (invoke "div_u" (i64.const 0x8000000000000001) (i64.const 0x8000000000000001)) (i64.const 1))
+(assert_return (invoke "div_u" (i64.const 0x8000000000000001) (i64.const 0x4000000000000000)) (i64.const 2))
+(assert_return (invoke "div_u" (i64.const 0x8000000000000001) (i64.const 0x123456789abcdef)) (i64.const 112))
+(assert_return (invoke "div_u" (i64.const 0x8000000000000001) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 0x8000000000000001) (i64.const 63)) (i64.const 0x208208208208208))
+(assert_return (invoke "div_u" (i64.const 0x8000000000000001) (i64.const 64)) (i64.const 0x200000000000000))
+(assert_return (invoke "div_u" (i64.const 0x8000000000000001) (i64.const 65)) (i64.const 0x1f81f81f81f81f8))
+(assert_return (invoke "div_u" (i64.const 0x8000000000000001) (i64.const 0xfffffffffff5a5a)) (i64.const 8))
+(assert_trap (invoke "div_u" (i64.const 0x4000000000000000) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "div_u" (i64.const 0x4000000000000000) (i64.const 1)) (i64.const 0x4000000000000000))
+(assert_return (invoke "div_u" (i64.const 0x4000000000000000) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 0x4000000000000000) (i64.const 2)) (i64.const 0x2000000000000000))
+(assert_return (invoke "div_u" (i64.const 0x4000000000000000) (i64.const 3)) (i64.const 0x1555555555555555))
+(assert_return (invoke "div_u" (i64.const 0x4000000000000000) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 0x4000000000000000) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 0x4000000000000000) (i64.const 0x8000000000000001)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 0x4000000000000000) (i64.const 0x4000000000000000)) (i64.const 1))
+(assert_return (invoke "div_u" (i64.const 0x4000000000000000) (i64.const 0x123456789abcdef)) (i64.const 56))
+(assert_return (invoke "div_u" (i64.const 0x4000000000000000) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 0x4000000000000000) (i64.const 63)) (i64.const 0x104104104104104))
+(assert_return (invoke "div_u" (i64.const 0x4000000000000000) (i64.const 64)) (i64.const 0x100000000000000))
+(assert_return (invoke "div_u" (i64.const 0x4000000000000000) (i64.const 65)) (i64.const 0xfc0fc0fc0fc0fc))
+(assert_return (invoke "div_u" (i64.const 0x4000000000000000) (i64.const 0xfffffffffff5a5a)) (i64.const 4))
+(assert_trap (invoke "div_u" (i64.const 0x123456789abcdef) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "div_u" (i64.const 0x123456789abcdef) (i64.const 1)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "div_u" (i64.const 0x123456789abcdef) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 0x123456789abcdef) (i64.const 2)) (i64.const 0x91a2b3c4d5e6f7))
+(assert_return (invoke "div_u" (i64.const 0x123456789abcdef) (i64.const 3)) (i64.const 0x611722833944a5))
+(assert_return (invoke "div_u" (i64.const 0x123456789abcdef) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 0x123456789abcdef) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 0x123456789abcdef) (i64.const 0x8000000000000001)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 0x123456789abcdef) (i64.const 0x4000000000000000)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 0x123456789abcdef) (i64.const 0x123456789abcdef)) (i64.const 1))
+(assert_return (invoke "div_u" (i64.const 0x123456789abcdef) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 0x123456789abcdef) (i64.const 63)) (i64.const 0x49f93edde27d7))
+(assert_return (invoke "div_u" (i64.const 0x123456789abcdef) (i64.const 64)) (i64.const 0x48d159e26af37))
+(assert_return (invoke "div_u" (i64.const 0x123456789abcdef) (i64.const 65)) (i64.const 0x47b28fa3db856))
+(assert_return (invoke "div_u" (i64.const 0x123456789abcdef) (i64.const 0xfffffffffff5a5a)) (i64.const 0))
+(assert_trap (invoke "div_u" (i64.const 0xfedcba9876543210) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "div_u" (i64.const 0xfedcba9876543210) (i64.const 1)) (i64.const 0xfedcba9876543210))
+(assert_return (invoke "div_u" (i64.const 0xfedcba9876543210) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 0xfedcba9876543210) (i64.const 2)) (i64.const 0x7f6e5d4c3b2a1908))
+(assert_return (invoke "div_u" (i64.const 0xfedcba9876543210) (i64.const 3)) (i64.const 0x54f43e32d21c10b0))
+(assert_return (invoke "div_u" (i64.const 0xfedcba9876543210) (i64.const 0x7fffffffffffffff)) (i64.const 1))
+(assert_return (invoke "div_u" (i64.const 0xfedcba9876543210) (i64.const 0x8000000000000000)) (i64.const 1))
+(assert_return (invoke "div_u" (i64.const 0xfedcba9876543210) (i64.const 0x8000000000000001)) (i64.const 1))
+(assert_return (invoke "div_u" (i64.const 0xfedcba9876543210) (i64.const 0x4000000000000000)) (i64.const 3))
+(assert_return (invoke "div_u" (i64.const 0xfedcba9876543210) (i64.const 0x123456789abcdef)) (i64.const 224))
+(assert_return (invoke "div_u" (i64.const 0xfedcba9876543210) (i64.const 0xfedcba9876543210)) (i64.const 1))
+(assert_return (invoke "div_u" (i64.const 0xfedcba9876543210) (i64.const 63)) (i64.const 0x40ba1702262dc39))
+(assert_return (invoke "div_u" (i64.const 0xfedcba9876543210) (i64.const 64)) (i64.const 0x3fb72ea61d950c8))
+(assert_return (invoke "div_u" (i64.const 0xfedcba9876543210) (i64.const 65)) (i64.const 0x3ebc3daf6014b99))
+(assert_return (invoke "div_u" (i64.const 0xfedcba9876543210) (i64.const 0xfffffffffff5a5a)) (i64.const 15))
+(assert_trap (invoke "div_u" (i64.const 63) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "div_u" (i64.const 63) (i64.const 1)) (i64.const 63))
+(assert_return (invoke "div_u" (i64.const 63) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 63) (i64.const 2)) (i64.const 31))
+(assert_return (invoke "div_u" (i64.const 63) (i64.const 3)) (i64.const 21))
+(assert_return (invoke "div_u" (i64.const 63) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 63) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 63) (i64.const 0x8000000000000001)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 63) (i64.const 0x4000000000000000)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 63) (i64.const 0x123456789abcdef)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 63) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 63) (i64.const 63)) (i64.const 1))
+(assert_return (invoke "div_u" (i64.const 63) (i64.const 64)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 63) (i64.const 65)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 63) (i64.const 0xfffffffffff5a5a)) (i64.const 0))
+(assert_trap (invoke "div_u" (i64.const 64) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "div_u" (i64.const 64) (i64.const 1)) (i64.const 64))
+(assert_return (invoke "div_u" (i64.const 64) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 64) (i64.const 2)) (i64.const 32))
+(assert_return (invoke "div_u" (i64.const 64) (i64.const 3)) (i64.const 21))
+(assert_return (invoke "div_u" (i64.const 64) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 64) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 64) (i64.const 0x8000000000000001)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 64) (i64.const 0x4000000000000000)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 64) (i64.const 0x123456789abcdef)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 64) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 64) (i64.const 63)) (i64.const 1))
+(assert_return (invoke "div_u" (i64.const 64) (i64.const 64)) (i64.const 1))
+(assert_return (invoke "div_u" (i64.const 64) (i64.const 65)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 64) (i64.const 0xfffffffffff5a5a)) (i64.const 0))
+(assert_trap (invoke "div_u" (i64.const 65) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "div_u" (i64.const 65) (i64.const 1)) (i64.const 65))
+(assert_return (invoke "div_u" (i64.const 65) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 65) (i64.const 2)) (i64.const 32))
+(assert_return (invoke "div_u" (i64.const 65) (i64.const 3)) (i64.const 21))
+(assert_return (invoke "div_u" (i64.const 65) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 65) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 65) (i64.const 0x8000000000000001)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 65) (i64.const 0x4000000000000000)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 65) (i64.const 0x123456789abcdef)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 65) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 65) (i64.const 63)) (i64.const 1))
+(assert_return (invoke "div_u" (i64.const 65) (i64.const 64)) (i64.const 1))
+(assert_return (invoke "div_u" (i64.const 65) (i64.const 65)) (i64.const 1))
+(assert_return (invoke "div_u" (i64.const 65) (i64.const 0xfffffffffff5a5a)) (i64.const 0))
+(assert_trap (invoke "div_u" (i64.const 0xfffffffffff5a5a) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "div_u" (i64.const 0xfffffffffff5a5a) (i64.const 1)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "div_u" (i64.const 0xfffffffffff5a5a) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 0xfffffffffff5a5a) (i64.const 2)) (i64.const 0x7ffffffffffad2d))
+(assert_return (invoke "div_u" (i64.const 0xfffffffffff5a5a) (i64.const 3)) (i64.const 0x555555555551e1e))
+(assert_return (invoke "div_u" (i64.const 0xfffffffffff5a5a) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 0xfffffffffff5a5a) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 0xfffffffffff5a5a) (i64.const 0x8000000000000001)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 0xfffffffffff5a5a) (i64.const 0x4000000000000000)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 0xfffffffffff5a5a) (i64.const 0x123456789abcdef)) (i64.const 14))
+(assert_return (invoke "div_u" (i64.const 0xfffffffffff5a5a) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 0xfffffffffff5a5a) (i64.const 63)) (i64.const 0x41041041040d9f))
+(assert_return (invoke "div_u" (i64.const 0xfffffffffff5a5a) (i64.const 64)) (i64.const 0x3ffffffffffd69))
+(assert_return (invoke "div_u" (i64.const 0xfffffffffff5a5a) (i64.const 65)) (i64.const 0x3f03f03f03edb2))
+(assert_return (invoke "div_u" (i64.const 0xfffffffffff5a5a) (i64.const 0xfffffffffff5a5a)) (i64.const 1))
+(assert_return (invoke "div_u" (i64.const 0x9e73d23977ec5d4b) (i64.const 0xe3bfabc9cb3c918a)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 0x26557326c6a31c65) (i64.const 0x3d3b172764acab31)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 0x5670748902140704) (i64.const 0x765809c79e25aafa)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 0x6dc7fed68954446a) (i64.const 0xd6d53cda6c0469ac)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 0x490c2157989c5949) (i64.const 0xc66d498b9590e375)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 0xf2f4bbdc7b3645e4) (i64.const 0x39bc100b8ca186ca)) (i64.const 4))
+(assert_return (invoke "div_u" (i64.const 0x55d489818fc1fbb7) (i64.const 0x6bb9457c58cc9a9)) (i64.const 12))
+(assert_return (invoke "div_u" (i64.const 0x74cf1436ca8b44c1) (i64.const 0x6edbf7d105908a84)) (i64.const 1))
+(assert_return (invoke "div_u" (i64.const 0xe470f592c8caf7e6) (i64.const 0xc788f5e03e3af6a9)) (i64.const 1))
+(assert_return (invoke "div_u" (i64.const 0xc240890a6c0720a8) (i64.const 0xb2d0a6837acce13)) (i64.const 17))
+(assert_return (invoke "div_u" (i64.const 0xb09f08ce4eed6660) (i64.const 0x7b2864b65f20bb3)) (i64.const 22))
+(assert_return (invoke "div_u" (i64.const 0x1ed696a174357aba) (i64.const 0x5449b7c81b31055b)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 0x4ba34a964b4caae2) (i64.const 0xab562dcf74ebd41b)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 0x29be65962d5deee) (i64.const 0xf51af06287ae832c)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 0x1e025b411aad116) (i64.const 0xfd8e4cf58f0018a0)) (i64.const 0))
+(assert_return (invoke "div_u" (i64.const 0x7b0f41a1e7b9e997) (i64.const 0x7ed991e7bd29bf22)) (i64.const 0))
+
+(assert_trap (invoke "rem_s" (i64.const 0) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_s" (i64.const 0) (i64.const 1)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 0) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 0) (i64.const 2)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 0) (i64.const 3)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 0) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 0) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 0) (i64.const 0x8000000000000001)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 0) (i64.const 0x4000000000000000)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 0) (i64.const 0x123456789abcdef)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 0) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 0) (i64.const 63)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 0) (i64.const 64)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 0) (i64.const 65)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 0) (i64.const 0xfffffffffff5a5a)) (i64.const 0))
+(assert_trap (invoke "rem_s" (i64.const 1) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_s" (i64.const 1) (i64.const 1)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 1) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 1) (i64.const 2)) (i64.const 1))
+(assert_return (invoke "rem_s" (i64.const 1) (i64.const 3)) (i64.const 1))
+(assert_return (invoke "rem_s" (i64.const 1) (i64.const 0x7fffffffffffffff)) (i64.const 1))
+(assert_return (invoke "rem_s" (i64.const 1) (i64.const 0x8000000000000000)) (i64.const 1))
+(assert_return (invoke "rem_s" (i64.const 1) (i64.const 0x8000000000000001)) (i64.const 1))
+(assert_return (invoke "rem_s" (i64.const 1) (i64.const 0x4000000000000000)) (i64.const 1))
+(assert_return (invoke "rem_s" (i64.const 1) (i64.const 0x123456789abcdef)) (i64.const 1))
+(assert_return (invoke "rem_s" (i64.const 1) (i64.const 0xfedcba9876543210)) (i64.const 1))
+(assert_return (invoke "rem_s" (i64.const 1) (i64.const 63)) (i64.const 1))
+(assert_return (invoke "rem_s" (i64.const 1) (i64.const 64)) (i64.const 1))
+(assert_return (invoke "rem_s" (i64.const 1) (i64.const 65)) (i64.const 1))
+(assert_return (invoke "rem_s" (i64.const 1) (i64.const 0xfffffffffff5a5a)) (i64.const 1))
+(assert_trap (invoke "rem_s" (i64.const -1) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_s" (i64.const -1) (i64.const 1)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const -1) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const -1) (i64.const 2)) (i64.const -1))
+(assert_return (invoke "rem_s" (i64.const -1) (i64.const 3)) (i64.const -1))
+(assert_return (invoke "rem_s" (i64.const -1) (i64.const 0x7fffffffffffffff)) (i64.const -1))
+(assert_return (invoke "rem_s" (i64.const -1) (i64.const 0x8000000000000000)) (i64.const -1))
+(assert_return (invoke "rem_s" (i64.const -1) (i64.const 0x8000000000000001)) (i64.const -1))
+(assert_return (invoke "rem_s" (i64.const -1) (i64.const 0x4000000000000000)) (i64.const -1))
+(assert_return (invoke "rem_s" (i64.const -1) (i64.const 0x123456789abcdef)) (i64.const -1))
+(assert_return (invoke "rem_s" (i64.const -1) (i64.const 0xfedcba9876543210)) (i64.const -1))
+(assert_return (invoke "rem_s" (i64.const -1) (i64.const 63)) (i64.const -1))
+(assert_return (invoke "rem_s" (i64.const -1) (i64.const 64)) (i64.const -1))
+(assert_return (invoke "rem_s" (i64.const -1) (i64.const 65)) (i64.const -1))
+(assert_return (invoke "rem_s" (i64.const -1) (i64.const 0xfffffffffff5a5a)) (i64.const -1))
+(assert_trap (invoke "rem_s" (i64.const 2) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_s" (i64.const 2) (i64.const 1)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 2) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 2) (i64.const 2)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 2) (i64.const 3)) (i64.const 2))
+(assert_return (invoke "rem_s" (i64.const 2) (i64.const 0x7fffffffffffffff)) (i64.const 2))
+(assert_return (invoke "rem_s" (i64.const 2) (i64.const 0x8000000000000000)) (i64.const 2))
+(assert_return (invoke "rem_s" (i64.const 2) (i64.const 0x8000000000000001)) (i64.const 2))
+(assert_return (invoke "rem_s" (i64.const 2) (i64.const 0x4000000000000000)) (i64.const 2))
+(assert_return (invoke "rem_s" (i64.const 2) (i64.const 0x123456789abcdef)) (i64.const 2))
+(assert_return (invoke "rem_s" (i64.const 2) (i64.const 0xfedcba9876543210)) (i64.const 2))
+(assert_return (invoke "rem_s" (i64.const 2) (i64.const 63)) (i64.const 2))
+(assert_return (invoke "rem_s" (i64.const 2) (i64.const 64)) (i64.const 2))
+(assert_return (invoke "rem_s" (i64.const 2) (i64.const 65)) (i64.const 2))
+(assert_return (invoke "rem_s" (i64.const 2) (i64.const 0xfffffffffff5a5a)) (i64.const 2))
+(assert_trap (invoke "rem_s" (i64.const 3) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_s" (i64.const 3) (i64.const 1)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 3) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 3) (i64.const 2)) (i64.const 1))
+(assert_return (invoke "rem_s" (i64.const 3) (i64.const 3)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 3) (i64.const 0x7fffffffffffffff)) (i64.const 3))
+(assert_return (invoke "rem_s" (i64.const 3) (i64.const 0x8000000000000000)) (i64.const 3))
+(assert_return (invoke "rem_s" (i64.const 3) (i64.const 0x8000000000000001)) (i64.const 3))
+(assert_return (invoke "rem_s" (i64.const 3) (i64.const 0x4000000000000000)) (i64.const 3))
+(assert_return (invoke "rem_s" (i64.const 3) (i64.const 0x123456789abcdef)) (i64.const 3))
+(assert_return (invoke "rem_s" (i64.const 3) (i64.const 0xfedcba9876543210)) (i64.const 3))
+(assert_return (invoke "rem_s" (i64.const 3) (i64.const 63)) (i64.const 3))
+(assert_return (invoke "rem_s" (i64.const 3) (i64.const 64)) (i64.const 3))
+(assert_return (invoke "rem_s" (i64.const 3) (i64.const 65)) (i64.const 3))
+(assert_return (invoke "rem_s" (i64.const 3) (i64.const 0xfffffffffff5a5a)) (i64.const 3))
+(assert_trap (invoke "rem_s" (i64.const 0x7fffffffffffffff) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_s" (i64.const 0x7fffffffffffffff) (i64.const 1)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 0x7fffffffffffffff) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 0x7fffffffffffffff) (i64.const 2)) (i64.const 1))
+(assert_return (invoke "rem_s" (i64.const 0x7fffffffffffffff) (i64.const 3)) (i64.const 1))
+(assert_return (invoke "rem_s" (i64.const 0x7fffffffffffffff) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 0x7fffffffffffffff) (i64.const 0x8000000000000000)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "rem_s" (i64.const 0x7fffffffffffffff) (i64.const 0x8000000000000001)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 0x7fffffffffffffff) (i64.const 0x4000000000000000)) (i64.const 0x3fffffffffffffff))
+(assert_return (invoke "rem_s" (i64.const 0x7fffffffffffffff) (i64.const 0x123456789abcdef)) (i64.const 0x91a2b3c4d5e76f))
+(assert_return (invoke "rem_s" (i64.const 0x7fffffffffffffff) (i64.const 0xfedcba9876543210)) (i64.const 0x91a2b3c4d5e6ff))
+(assert_return (invoke "rem_s" (i64.const 0x7fffffffffffffff) (i64.const 63)) (i64.const 7))
+(assert_return (invoke "rem_s" (i64.const 0x7fffffffffffffff) (i64.const 64)) (i64.const 63))
+(assert_return (invoke "rem_s" (i64.const 0x7fffffffffffffff) (i64.const 65)) (i64.const 7))
+(assert_return (invoke "rem_s" (i64.const 0x7fffffffffffffff) (i64.const 0xfffffffffff5a5a)) (i64.const 0x52d2f))
+(assert_trap (invoke "rem_s" (i64.const 0x8000000000000000) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_s" (i64.const 0x8000000000000000) (i64.const 1)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 0x8000000000000000) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 0x8000000000000000) (i64.const 2)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 0x8000000000000000) (i64.const 3)) (i64.const -2))
+(assert_return (invoke "rem_s" (i64.const 0x8000000000000000) (i64.const 0x7fffffffffffffff)) (i64.const -1))
+(assert_return (invoke "rem_s" (i64.const 0x8000000000000000) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 0x8000000000000000) (i64.const 0x8000000000000001)) (i64.const -1))
+(assert_return (invoke "rem_s" (i64.const 0x8000000000000000) (i64.const 0x4000000000000000)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 0x8000000000000000) (i64.const 0x123456789abcdef)) (i64.const 0xff6e5d4c3b2a1890))
+(assert_return (invoke "rem_s" (i64.const 0x8000000000000000) (i64.const 0xfedcba9876543210)) (i64.const 0xff6e5d4c3b2a1900))
+(assert_return (invoke "rem_s" (i64.const 0x8000000000000000) (i64.const 63)) (i64.const -8))
+(assert_return (invoke "rem_s" (i64.const 0x8000000000000000) (i64.const 64)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 0x8000000000000000) (i64.const 65)) (i64.const -8))
+(assert_return (invoke "rem_s" (i64.const 0x8000000000000000) (i64.const 0xfffffffffff5a5a)) (i64.const 0xfffffffffffad2d0))
+(assert_trap (invoke "rem_s" (i64.const 0x8000000000000001) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_s" (i64.const 0x8000000000000001) (i64.const 1)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 0x8000000000000001) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 0x8000000000000001) (i64.const 2)) (i64.const -1))
+(assert_return (invoke "rem_s" (i64.const 0x8000000000000001) (i64.const 3)) (i64.const -1))
+(assert_return (invoke "rem_s" (i64.const 0x8000000000000001) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 0x8000000000000001) (i64.const 0x8000000000000000)) (i64.const 0x8000000000000001))
+(assert_return (invoke "rem_s" (i64.const 0x8000000000000001) (i64.const 0x8000000000000001)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 0x8000000000000001) (i64.const 0x4000000000000000)) (i64.const 0xc000000000000001))
+(assert_return (invoke "rem_s" (i64.const 0x8000000000000001) (i64.const 0x123456789abcdef)) (i64.const 0xff6e5d4c3b2a1891))
+(assert_return (invoke "rem_s" (i64.const 0x8000000000000001) (i64.const 0xfedcba9876543210)) (i64.const 0xff6e5d4c3b2a1901))
+(assert_return (invoke "rem_s" (i64.const 0x8000000000000001) (i64.const 63)) (i64.const -7))
+(assert_return (invoke "rem_s" (i64.const 0x8000000000000001) (i64.const 64)) (i64.const -63))
+(assert_return (invoke "rem_s" (i64.const 0x8000000000000001) (i64.const 65)) (i64.const -7))
+(assert_return (invoke "rem_s" (i64.const 0x8000000000000001) (i64.const 0xfffffffffff5a5a)) (i64.const 0xfffffffffffad2d1))
+(assert_trap (invoke "rem_s" (i64.const 0x4000000000000000) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_s" (i64.const 0x4000000000000000) (i64.const 1)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 0x4000000000000000) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 0x4000000000000000) (i64.const 2)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 0x4000000000000000) (i64.const 3)) (i64.const 1))
+(assert_return (invoke "rem_s" (i64.const 0x4000000000000000) (i64.const 0x7fffffffffffffff)) (i64.const 0x4000000000000000))
+(assert_return (invoke "rem_s" (i64.const 0x4000000000000000) (i64.const 0x8000000000000000)) (i64.const 0x4000000000000000))
+(assert_return (invoke "rem_s" (i64.const 0x4000000000000000) (i64.const 0x8000000000000001)) (i64.const 0x4000000000000000))
+(assert_return (invoke "rem_s" (i64.const 0x4000000000000000) (i64.const 0x4000000000000000)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 0x4000000000000000) (i64.const 0x123456789abcdef)) (i64.const 0x48d159e26af3b8))
+(assert_return (invoke "rem_s" (i64.const 0x4000000000000000) (i64.const 0xfedcba9876543210)) (i64.const 0x48d159e26af380))
+(assert_return (invoke "rem_s" (i64.const 0x4000000000000000) (i64.const 63)) (i64.const 4))
+(assert_return (invoke "rem_s" (i64.const 0x4000000000000000) (i64.const 64)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 0x4000000000000000) (i64.const 65)) (i64.const 4))
+(assert_return (invoke "rem_s" (i64.const 0x4000000000000000) (i64.const 0xfffffffffff5a5a)) (i64.const 0x29698))
+(assert_trap (invoke "rem_s" (i64.const 0x123456789abcdef) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_s" (i64.const 0x123456789abcdef) (i64.const 1)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 0x123456789abcdef) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 0x123456789abcdef) (i64.const 2)) (i64.const 1))
+(assert_return (invoke "rem_s" (i64.const 0x123456789abcdef) (i64.const 3)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 0x123456789abcdef) (i64.const 0x7fffffffffffffff)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "rem_s" (i64.const 0x123456789abcdef) (i64.const 0x8000000000000000)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "rem_s" (i64.const 0x123456789abcdef) (i64.const 0x8000000000000001)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "rem_s" (i64.const 0x123456789abcdef) (i64.const 0x4000000000000000)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "rem_s" (i64.const 0x123456789abcdef) (i64.const 0x123456789abcdef)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 0x123456789abcdef) (i64.const 0xfedcba9876543210)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "rem_s" (i64.const 0x123456789abcdef) (i64.const 63)) (i64.const 6))
+(assert_return (invoke "rem_s" (i64.const 0x123456789abcdef) (i64.const 64)) (i64.const 47))
+(assert_return (invoke "rem_s" (i64.const 0x123456789abcdef) (i64.const 65)) (i64.const 25))
+(assert_return (invoke "rem_s" (i64.const 0x123456789abcdef) (i64.const 0xfffffffffff5a5a)) (i64.const 0x123456789abcdef))
+(assert_trap (invoke "rem_s" (i64.const 0xfedcba9876543210) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_s" (i64.const 0xfedcba9876543210) (i64.const 1)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 0xfedcba9876543210) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 0xfedcba9876543210) (i64.const 2)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 0xfedcba9876543210) (i64.const 3)) (i64.const -1))
+(assert_return (invoke "rem_s" (i64.const 0xfedcba9876543210) (i64.const 0x7fffffffffffffff)) (i64.const 0xfedcba9876543210))
+(assert_return (invoke "rem_s" (i64.const 0xfedcba9876543210) (i64.const 0x8000000000000000)) (i64.const 0xfedcba9876543210))
+(assert_return (invoke "rem_s" (i64.const 0xfedcba9876543210) (i64.const 0x8000000000000001)) (i64.const 0xfedcba9876543210))
+(assert_return (invoke "rem_s" (i64.const 0xfedcba9876543210) (i64.const 0x4000000000000000)) (i64.const 0xfedcba9876543210))
+(assert_return (invoke "rem_s" (i64.const 0xfedcba9876543210) (i64.const 0x123456789abcdef)) (i64.const -1))
+(assert_return (invoke "rem_s" (i64.const 0xfedcba9876543210) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 0xfedcba9876543210) (i64.const 63)) (i64.const -7))
+(assert_return (invoke "rem_s" (i64.const 0xfedcba9876543210) (i64.const 64)) (i64.const -48))
+(assert_return (invoke "rem_s" (i64.const 0xfedcba9876543210) (i64.const 65)) (i64.const -26))
+(assert_return (invoke "rem_s" (i64.const 0xfedcba9876543210) (i64.const 0xfffffffffff5a5a)) (i64.const 0xfedcba9876543210))
+(assert_trap (invoke "rem_s" (i64.const 63) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_s" (i64.const 63) (i64.const 1)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 63) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 63) (i64.const 2)) (i64.const 1))
+(assert_return (invoke "rem_s" (i64.const 63) (i64.const 3)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 63) (i64.const 0x7fffffffffffffff)) (i64.const 63))
+(assert_return (invoke "rem_s" (i64.const 63) (i64.const 0x8000000000000000)) (i64.const 63))
+(assert_return (invoke "rem_s" (i64.const 63) (i64.const 0x8000000000000001)) (i64.const 63))
+(assert_return (invoke "rem_s" (i64.const 63) (i64.const 0x4000000000000000)) (i64.const 63))
+(assert_return (invoke "rem_s" (i64.const 63) (i64.const 0x123456789abcdef)) (i64.const 63))
+(assert_return (invoke "rem_s" (i64.const 63) (i64.const 0xfedcba9876543210)) (i64.const 63))
+(assert_return (invoke "rem_s" (i64.const 63) (i64.const 63)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 63) (i64.const 64)) (i64.const 63))
+(assert_return (invoke "rem_s" (i64.const 63) (i64.const 65)) (i64.const 63))
+(assert_return (invoke "rem_s" (i64.const 63) (i64.const 0xfffffffffff5a5a)) (i64.const 63))
+(assert_trap (invoke "rem_s" (i64.const 64) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_s" (i64.const 64) (i64.const 1)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 64) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 64) (i64.const 2)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 64) (i64.const 3)) (i64.const 1))
+(assert_return (invoke "rem_s" (i64.const 64) (i64.const 0x7fffffffffffffff)) (i64.const 64))
+(assert_return (invoke "rem_s" (i64.const 64) (i64.const 0x8000000000000000)) (i64.const 64))
+(assert_return (invoke "rem_s" (i64.const 64) (i64.const 0x8000000000000001)) (i64.const 64))
+(assert_return (invoke "rem_s" (i64.const 64) (i64.const 0x4000000000000000)) (i64.const 64))
+(assert_return (invoke "rem_s" (i64.const 64) (i64.const 0x123456789abcdef)) (i64.const 64))
+(assert_return (invoke "rem_s" (i64.const 64) (i64.const 0xfedcba9876543210)) (i64.const 64))
+(assert_return (invoke "rem_s" (i64.const 64) (i64.const 63)) (i64.const 1))
+(assert_return (invoke "rem_s" (i64.const 64) (i64.const 64)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 64) (i64.const 65)) (i64.const 64))
+(assert_return (invoke "rem_s" (i64.const 64) (i64.const 0xfffffffffff5a5a)) (i64.const 64))
+(assert_trap (invoke "rem_s" (i64.const 65) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_s" (i64.const 65) (i64.const 1)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 65) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 65) (i64.const 2)) (i64.const 1))
+(assert_return (invoke "rem_s" (i64.const 65) (i64.const 3)) (i64.const 2))
+(assert_return (invoke "rem_s" (i64.const 65) (i64.const 0x7fffffffffffffff)) (i64.const 65))
+(assert_return (invoke "rem_s" (i64.const 65) (i64.const 0x8000000000000000)) (i64.const 65))
+(assert_return (invoke "rem_s" (i64.const 65) (i64.const 0x8000000000000001)) (i64.const 65))
+(assert_return (invoke "rem_s" (i64.const 65) (i64.const 0x4000000000000000)) (i64.const 65))
+(assert_return (invoke "rem_s" (i64.const 65) (i64.const 0x123456789abcdef)) (i64.const 65))
+(assert_return (invoke "rem_s" (i64.const 65) (i64.const 0xfedcba9876543210)) (i64.const 65))
+(assert_return (invoke "rem_s" (i64.const 65) (i64.const 63)) (i64.const 2))
+(assert_return (invoke "rem_s" (i64.const 65) (i64.const 64)) (i64.const 1))
+(assert_return (invoke "rem_s" (i64.const 65) (i64.const 65)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 65) (i64.const 0xfffffffffff5a5a)) (i64.const 65))
+(assert_trap (invoke "rem_s" (i64.const 0xfffffffffff5a5a) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_s" (i64.const 0xfffffffffff5a5a) (i64.const 1)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 0xfffffffffff5a5a) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 0xfffffffffff5a5a) (i64.const 2)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 0xfffffffffff5a5a) (i64.const 3)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 0xfffffffffff5a5a) (i64.const 0x7fffffffffffffff)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "rem_s" (i64.const 0xfffffffffff5a5a) (i64.const 0x8000000000000000)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "rem_s" (i64.const 0xfffffffffff5a5a) (i64.const 0x8000000000000001)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "rem_s" (i64.const 0xfffffffffff5a5a) (i64.const 0x4000000000000000)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "rem_s" (i64.const 0xfffffffffff5a5a) (i64.const 0x123456789abcdef)) (i64.const 0x123456789a1748))
+(assert_return (invoke "rem_s" (i64.const 0xfffffffffff5a5a) (i64.const 0xfedcba9876543210)) (i64.const 0x123456789a173a))
+(assert_return (invoke "rem_s" (i64.const 0xfffffffffff5a5a) (i64.const 63)) (i64.const 57))
+(assert_return (invoke "rem_s" (i64.const 0xfffffffffff5a5a) (i64.const 64)) (i64.const 26))
+(assert_return (invoke "rem_s" (i64.const 0xfffffffffff5a5a) (i64.const 65)) (i64.const 40))
+(assert_return (invoke "rem_s" (i64.const 0xfffffffffff5a5a) (i64.const 0xfffffffffff5a5a)) (i64.const 0))
+(assert_return (invoke "rem_s" (i64.const 0xa73c42ca8eacbfed) (i64.const 0x80924065ce42ad23)) (i64.const 0xa73c42ca8eacbfed))
+(assert_return (invoke "rem_s" (i64.const 0x78caec07613db9e3) (i64.const 0x1d93d9772d441879)) (i64.const 0x27b862aac2d57ff))
+(assert_return (invoke "rem_s" (i64.const 0x701c6b919cc5ead1) (i64.const 0xa9a39e2d3e8dc02c)) (i64.const 0x19c009bedb53aafd))
+(assert_return (invoke "rem_s" (i64.const 0xd68529a045f32a6f) (i64.const 0x46d2fac1c4d2ebb2)) (i64.const 0xd68529a045f32a6f))
+(assert_return (invoke "rem_s" (i64.const 0x9fdae1ff826462c4) (i64.const 0xc4cfc402cb46167)) (i64.const 0xf5f5c7c0bb530c95))
+(assert_return (invoke "rem_s" (i64.const 0x701cd6d3378eef28) (i64.const 0x3892a41bbeae252)) (i64.const 0x280b8dd761d873a))
+(assert_return (invoke "rem_s" (i64.const 0xabbfa8daa671aea1) (i64.const 0xe87c9e93e605fb75)) (i64.const 0xf249cd1ef45fbc42))
+(assert_return (invoke "rem_s" (i64.const 0x35333a29bd2a0953) (i64.const 0xbf2804c548f3d961)) (i64.const 0x35333a29bd2a0953))
+(assert_return (invoke "rem_s" (i64.const 0x4c7ea245b3d6692f) (i64.const 0xe35184c6e1b7495e)) (i64.const 0x1321abd37744fbeb))
+(assert_return (invoke "rem_s" (i64.const 0x45cfa1eae1bc69e4) (i64.const 0xac40a3eae9c40c53)) (i64.const 0x45cfa1eae1bc69e4))
+(assert_return (invoke "rem_s" (i64.const 0x95961436251a7211) (i64.const 0xb022d9183fed635c)) (i64.const 0xe5733b1de52d0eb5))
+(assert_return (invoke "rem_s" (i64.const 0xc5ae33b92c4dd1a5) (i64.const 0xfdd5534dd0fd66cb)) (i64.const 0xfe03bdd1f2916107))
+(assert_return (invoke "rem_s" (i64.const 0xe6a9eea4b17270b1) (i64.const 0xde78fa0c8ed8e838)) (i64.const 0xe6a9eea4b17270b1))
+(assert_return (invoke "rem_s" (i64.const 0xabc504303246b0cb) (i64.const 0x91fdaaeb695629dc)) (i64.const 0xabc504303246b0cb))
+(assert_return (invoke "rem_s" (i64.const 0x304e8c22b0c131df) (i64.const 0x639233d708326154)) (i64.const 0x304e8c22b0c131df))
+(assert_return (invoke "rem_s" (i64.const 0x32fefaece40c16e9) (i64.const 0xf341929b43fd940)) (i64.const 0x562af6fc74c8b29))
+
+(assert_trap (invoke "rem_u" (i64.const 0) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_u" (i64.const 0) (i64.const 1)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const 0) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const 0) (i64.const 2)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const 0) (i64.const 3)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const 0) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const 0) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const 0) (i64.const 0x8000000000000001)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const 0) (i64.const 0x4000000000000000)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const 0) (i64.const 0x123456789abcdef)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const 0) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const 0) (i64.const 63)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const 0) (i64.const 64)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const 0) (i64.const 65)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const 0) (i64.const 0xfffffffffff5a5a)) (i64.const 0))
+(assert_trap (invoke "rem_u" (i64.const 1) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_u" (i64.const 1) (i64.const 1)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const 1) (i64.const -1)) (i64.const 1))
+(assert_return (invoke "rem_u" (i64.const 1) (i64.const 2)) (i64.const 1))
+(assert_return (invoke "rem_u" (i64.const 1) (i64.const 3)) (i64.const 1))
+(assert_return (invoke "rem_u" (i64.const 1) (i64.const 0x7fffffffffffffff)) (i64.const 1))
+(assert_return (invoke "rem_u" (i64.const 1) (i64.const 0x8000000000000000)) (i64.const 1))
+(assert_return (invoke "rem_u" (i64.const 1) (i64.const 0x8000000000000001)) (i64.const 1))
+(assert_return (invoke "rem_u" (i64.const 1) (i64.const 0x4000000000000000)) (i64.const 1))
+(assert_return (invoke "rem_u" (i64.const 1) (i64.const 0x123456789abcdef)) (i64.const 1))
+(assert_return (invoke "rem_u" (i64.const 1) (i64.const 0xfedcba9876543210)) (i64.const 1))
+(assert_return (invoke "rem_u" (i64.const 1) (i64.const 63)) (i64.const 1))
+(assert_return (invoke "rem_u" (i64.const 1) (i64.const 64)) (i64.const 1))
+(assert_return (invoke "rem_u" (i64.const 1) (i64.const 65)) (i64.const 1))
+(assert_return (invoke "rem_u" (i64.const 1) (i64.const 0xfffffffffff5a5a)) (i64.const 1))
+(assert_trap (invoke "rem_u" (i64.const -1) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_u" (i64.const -1) (i64.const 1)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const -1) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const -1) (i64.const 2)) (i64.const 1))
+(assert_return (invoke "rem_u" (i64.const -1) (i64.const 3)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const -1) (i64.const 0x7fffffffffffffff)) (i64.const 1))
+(assert_return (invoke "rem_u" (i64.const -1) (i64.const 0x8000000000000000)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "rem_u" (i64.const -1) (i64.const 0x8000000000000001)) (i64.const 0x7ffffffffffffffe))
+(assert_return (invoke "rem_u" (i64.const -1) (i64.const 0x4000000000000000)) (i64.const 0x3fffffffffffffff))
+(assert_return (invoke "rem_u" (i64.const -1) (i64.const 0x123456789abcdef)) (i64.const 240))
+(assert_return (invoke "rem_u" (i64.const -1) (i64.const 0xfedcba9876543210)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "rem_u" (i64.const -1) (i64.const 63)) (i64.const 15))
+(assert_return (invoke "rem_u" (i64.const -1) (i64.const 64)) (i64.const 63))
+(assert_return (invoke "rem_u" (i64.const -1) (i64.const 65)) (i64.const 15))
+(assert_return (invoke "rem_u" (i64.const -1) (i64.const 0xfffffffffff5a5a)) (i64.const 0xa5a5f))
+(assert_trap (invoke "rem_u" (i64.const 2) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_u" (i64.const 2) (i64.const 1)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const 2) (i64.const -1)) (i64.const 2))
+(assert_return (invoke "rem_u" (i64.const 2) (i64.const 2)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const 2) (i64.const 3)) (i64.const 2))
+(assert_return (invoke "rem_u" (i64.const 2) (i64.const 0x7fffffffffffffff)) (i64.const 2))
+(assert_return (invoke "rem_u" (i64.const 2) (i64.const 0x8000000000000000)) (i64.const 2))
+(assert_return (invoke "rem_u" (i64.const 2) (i64.const 0x8000000000000001)) (i64.const 2))
+(assert_return (invoke "rem_u" (i64.const 2) (i64.const 0x4000000000000000)) (i64.const 2))
+(assert_return (invoke "rem_u" (i64.const 2) (i64.const 0x123456789abcdef)) (i64.const 2))
+(assert_return (invoke "rem_u" (i64.const 2) (i64.const 0xfedcba9876543210)) (i64.const 2))
+(assert_return (invoke "rem_u" (i64.const 2) (i64.const 63)) (i64.const 2))
+(assert_return (invoke "rem_u" (i64.const 2) (i64.const 64)) (i64.const 2))
+(assert_return (invoke "rem_u" (i64.const 2) (i64.const 65)) (i64.const 2))
+(assert_return (invoke "rem_u" (i64.const 2) (i64.const 0xfffffffffff5a5a)) (i64.const 2))
+(assert_trap (invoke "rem_u" (i64.const 3) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_u" (i64.const 3) (i64.const 1)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const 3) (i64.const -1)) (i64.const 3))
+(assert_return (invoke "rem_u" (i64.const 3) (i64.const 2)) (i64.const 1))
+(assert_return (invoke "rem_u" (i64.const 3) (i64.const 3)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const 3) (i64.const 0x7fffffffffffffff)) (i64.const 3))
+(assert_return (invoke "rem_u" (i64.const 3) (i64.const 0x8000000000000000)) (i64.const 3))
+(assert_return (invoke "rem_u" (i64.const 3) (i64.const 0x8000000000000001)) (i64.const 3))
+(assert_return (invoke "rem_u" (i64.const 3) (i64.const 0x4000000000000000)) (i64.const 3))
+(assert_return (invoke "rem_u" (i64.const 3) (i64.const 0x123456789abcdef)) (i64.const 3))
+(assert_return (invoke "rem_u" (i64.const 3) (i64.const 0xfedcba9876543210)) (i64.const 3))
+(assert_return (invoke "rem_u" (i64.const 3) (i64.const 63)) (i64.const 3))
+(assert_return (invoke "rem_u" (i64.const 3) (i64.const 64)) (i64.const 3))
+(assert_return (invoke "rem_u" (i64.const 3) (i64.const 65)) (i64.const 3))
+(assert_return (invoke "rem_u" (i64.const 3) (i64.const 0xfffffffffff5a5a)) (i64.const 3))
+(assert_trap (invoke "rem_u" (i64.const 0x7fffffffffffffff) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_u" (i64.const 0x7fffffffffffffff) (i64.const 1)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const 0x7fffffffffffffff) (i64.const -1)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "rem_u" (i64.const 0x7fffffffffffffff) (i64.const 2)) (i64.const 1))
+(assert_return (invoke "rem_u" (i64.const 0x7fffffffffffffff) (i64.const 3)) (i64.const 1))
+(assert_return (invoke "rem_u" (i64.const 0x7fffffffffffffff) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const 0x7fffffffffffffff) (i64.const 0x8000000000000000)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "rem_u" (i64.const 0x7fffffffffffffff) (i64.const 0x8000000000000001)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "rem_u" (i64.const 0x7fffffffffffffff) (i64.const 0x4000000000000000)) (i64.const 0x3fffffffffffffff))
+(assert_return (invoke "rem_u" (i64.const 0x7fffffffffffffff) (i64.const 0x123456789abcdef)) (i64.const 0x91a2b3c4d5e76f))
+(assert_return (invoke "rem_u" (i64.const 0x7fffffffffffffff) (i64.const 0xfedcba9876543210)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "rem_u" (i64.const 0x7fffffffffffffff) (i64.const 63)) (i64.const 7))
+(assert_return (invoke "rem_u" (i64.const 0x7fffffffffffffff) (i64.const 64)) (i64.const 63))
+(assert_return (invoke "rem_u" (i64.const 0x7fffffffffffffff) (i64.const 65)) (i64.const 7))
+(assert_return (invoke "rem_u" (i64.const 0x7fffffffffffffff) (i64.const 0xfffffffffff5a5a)) (i64.const 0x52d2f))
+(assert_trap (invoke "rem_u" (i64.const 0x8000000000000000) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_u" (i64.const 0x8000000000000000) (i64.const 1)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const 0x8000000000000000) (i64.const -1)) (i64.const 0x8000000000000000))
+(assert_return (invoke "rem_u" (i64.const 0x8000000000000000) (i64.const 2)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const 0x8000000000000000) (i64.const 3)) (i64.const 2))
+(assert_return (invoke "rem_u" (i64.const 0x8000000000000000) (i64.const 0x7fffffffffffffff)) (i64.const 1))
+(assert_return (invoke "rem_u" (i64.const 0x8000000000000000) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const 0x8000000000000000) (i64.const 0x8000000000000001)) (i64.const 0x8000000000000000))
+(assert_return (invoke "rem_u" (i64.const 0x8000000000000000) (i64.const 0x4000000000000000)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const 0x8000000000000000) (i64.const 0x123456789abcdef)) (i64.const 0x91a2b3c4d5e770))
+(assert_return (invoke "rem_u" (i64.const 0x8000000000000000) (i64.const 0xfedcba9876543210)) (i64.const 0x8000000000000000))
+(assert_return (invoke "rem_u" (i64.const 0x8000000000000000) (i64.const 63)) (i64.const 8))
+(assert_return (invoke "rem_u" (i64.const 0x8000000000000000) (i64.const 64)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const 0x8000000000000000) (i64.const 65)) (i64.const 8))
+(assert_return (invoke "rem_u" (i64.const 0x8000000000000000) (i64.const 0xfffffffffff5a5a)) (i64.const 0x52d30))
+(assert_trap (invoke "rem_u" (i64.const 0x8000000000000001) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_u" (i64.const 0x8000000000000001) (i64.const 1)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const 0x8000000000000001) (i64.const -1)) (i64.const 0x8000000000000001))
+(assert_return (invoke "rem_u" (i64.const 0x8000000000000001) (i64.const 2)) (i64.const 1))
+(assert_return (invoke "rem_u" (i64.const 0x8000000000000001) (i64.const 3)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const 0x8000000000000001) (i64.const 0x7fffffffffffffff)) (i64.const 2))
+(assert_return (invoke "rem_u" (i64.const 0x8000000000000001) (i64.const 0x8000000000000000)) (i64.const 1))
+(assert_return (invoke "rem_u" (i64.const 0x8000000000000001) (i64.const 0x8000000000000001)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const 0x8000000000000001) (i64.const 0x4000000000000000)) (i64.const 1))
+(assert_return (invoke "rem_u" (i64.const 0x8000000000000001) (i64.const 0x123456789abcdef)) (i64.const 0x91a2b3c4d5e771))
+(assert_return (invoke "rem_u" (i64.const 0x8000000000000001) (i64.const 0xfedcba9876543210)) (i64.const 0x8000000000000001))
+(assert_return (invoke "rem_u" (i64.const 0x8000000000000001) (i64.const 63)) (i64.const 9))
+(assert_return (invoke "rem_u" (i64.const 0x8000000000000001) (i64.const 64)) (i64.const 1))
+(assert_return (invoke "rem_u" (i64.const 0x8000000000000001) (i64.const 65)) (i64.const 9))
+(assert_return (invoke "rem_u" (i64.const 0x8000000000000001) (i64.const 0xfffffffffff5a5a)) (i64.const 0x52d31))
+(assert_trap (invoke "rem_u" (i64.const 0x4000000000000000) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_u" (i64.const 0x4000000000000000) (i64.const 1)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const 0x4000000000000000) (i64.const -1)) (i64.const 0x4000000000000000))
+(assert_return (invoke "rem_u" (i64.const 0x4000000000000000) (i64.const 2)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const 0x4000000000000000) (i64.const 3)) (i64.const 1))
+(assert_return (invoke "rem_u" (i64.const 0x4000000000000000) (i64.const 0x7fffffffffffffff)) (i64.const 0x4000000000000000))
+(assert_return (invoke "rem_u" (i64.const 0x4000000000000000) (i64.const 0x8000000000000000)) (i64.const 0x4000000000000000))
+(assert_return (invoke "rem_u" (i64.const 0x4000000000000000) (i64.const 0x8000000000000001)) (i64.const 0x4000000000000000))
+(assert_return (invoke "rem_u" (i64.const 0x4000000000000000) (i64.const 0x4000000000000000)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const 0x4000000000000000) (i64.const 0x123456789abcdef)) (i64.const 0x48d159e26af3b8))
+(assert_return (invoke "rem_u" (i64.const 0x4000000000000000) (i64.const 0xfedcba9876543210)) (i64.const 0x4000000000000000))
+(assert_return (invoke "rem_u" (i64.const 0x4000000000000000) (i64.const 63)) (i64.const 4))
+(assert_return (invoke "rem_u" (i64.const 0x4000000000000000) (i64.const 64)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const 0x4000000000000000) (i64.const 65)) (i64.const 4))
+(assert_return (invoke "rem_u" (i64.const 0x4000000000000000) (i64.const 0xfffffffffff5a5a)) (i64.const 0x29698))
+(assert_trap (invoke "rem_u" (i64.const 0x123456789abcdef) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_u" (i64.const 0x123456789abcdef) (i64.const 1)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const 0x123456789abcdef) (i64.const -1)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "rem_u" (i64.const 0x123456789abcdef) (i64.const 2)) (i64.const 1))
+(assert_return (invoke "rem_u" (i64.const 0x123456789abcdef) (i64.const 3)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const 0x123456789abcdef) (i64.const 0x7fffffffffffffff)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "rem_u" (i64.const 0x123456789abcdef) (i64.const 0x8000000000000000)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "rem_u" (i64.const 0x123456789abcdef) (i64.const 0x8000000000000001)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "rem_u" (i64.const 0x123456789abcdef) (i64.const 0x4000000000000000)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "rem_u" (i64.const 0x123456789abcdef) (i64.const 0x123456789abcdef)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const 0x123456789abcdef) (i64.const 0xfedcba9876543210)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "rem_u" (i64.const 0x123456789abcdef) (i64.const 63)) (i64.const 6))
+(assert_return (invoke "rem_u" (i64.const 0x123456789abcdef) (i64.const 64)) (i64.const 47))
+(assert_return (invoke "rem_u" (i64.const 0x123456789abcdef) (i64.const 65)) (i64.const 25))
+(assert_return (invoke "rem_u" (i64.const 0x123456789abcdef) (i64.const 0xfffffffffff5a5a)) (i64.const 0x123456789abcdef))
+(assert_trap (invoke "rem_u" (i64.const 0xfedcba9876543210) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_u" (i64.const 0xfedcba9876543210) (i64.const 1)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const 0xfedcba9876543210) (i64.const -1)) (i64.const 0xfedcba9876543210))
+(assert_return (invoke "rem_u" (i64.const 0xfedcba9876543210) (i64.const 2)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const 0xfedcba9876543210) (i64.const 3)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const 0xfedcba9876543210) (i64.const 0x7fffffffffffffff)) (i64.const 0x7edcba9876543211))
+(assert_return (invoke "rem_u" (i64.const 0xfedcba9876543210) (i64.const 0x8000000000000000)) (i64.const 0x7edcba9876543210))
+(assert_return (invoke "rem_u" (i64.const 0xfedcba9876543210) (i64.const 0x8000000000000001)) (i64.const 0x7edcba987654320f))
+(assert_return (invoke "rem_u" (i64.const 0xfedcba9876543210) (i64.const 0x4000000000000000)) (i64.const 0x3edcba9876543210))
+(assert_return (invoke "rem_u" (i64.const 0xfedcba9876543210) (i64.const 0x123456789abcdef)) (i64.const 240))
+(assert_return (invoke "rem_u" (i64.const 0xfedcba9876543210) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const 0xfedcba9876543210) (i64.const 63)) (i64.const 9))
+(assert_return (invoke "rem_u" (i64.const 0xfedcba9876543210) (i64.const 64)) (i64.const 16))
+(assert_return (invoke "rem_u" (i64.const 0xfedcba9876543210) (i64.const 65)) (i64.const 55))
+(assert_return (invoke "rem_u" (i64.const 0xfedcba9876543210) (i64.const 0xfffffffffff5a5a)) (i64.const 0xedcba98765de6ca))
+(assert_trap (invoke "rem_u" (i64.const 63) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_u" (i64.const 63) (i64.const 1)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const 63) (i64.const -1)) (i64.const 63))
+(assert_return (invoke "rem_u" (i64.const 63) (i64.const 2)) (i64.const 1))
+(assert_return (invoke "rem_u" (i64.const 63) (i64.const 3)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const 63) (i64.const 0x7fffffffffffffff)) (i64.const 63))
+(assert_return (invoke "rem_u" (i64.const 63) (i64.const 0x8000000000000000)) (i64.const 63))
+(assert_return (invoke "rem_u" (i64.const 63) (i64.const 0x8000000000000001)) (i64.const 63))
+(assert_return (invoke "rem_u" (i64.const 63) (i64.const 0x4000000000000000)) (i64.const 63))
+(assert_return (invoke "rem_u" (i64.const 63) (i64.const 0x123456789abcdef)) (i64.const 63))
+(assert_return (invoke "rem_u" (i64.const 63) (i64.const 0xfedcba9876543210)) (i64.const 63))
+(assert_return (invoke "rem_u" (i64.const 63) (i64.const 63)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const 63) (i64.const 64)) (i64.const 63))
+(assert_return (invoke "rem_u" (i64.const 63) (i64.const 65)) (i64.const 63))
+(assert_return (invoke "rem_u" (i64.const 63) (i64.const 0xfffffffffff5a5a)) (i64.const 63))
+(assert_trap (invoke "rem_u" (i64.const 64) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_u" (i64.const 64) (i64.const 1)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const 64) (i64.const -1)) (i64.const 64))
+(assert_return (invoke "rem_u" (i64.const 64) (i64.const 2)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const 64) (i64.const 3)) (i64.const 1))
+(assert_return (invoke "rem_u" (i64.const 64) (i64.const 0x7fffffffffffffff)) (i64.const 64))
+(assert_return (invoke "rem_u" (i64.const 64) (i64.const 0x8000000000000000)) (i64.const 64))
+(assert_return (invoke "rem_u" (i64.const 64) (i64.const 0x8000000000000001)) (i64.const 64))
+(assert_return (invoke "rem_u" (i64.const 64) (i64.const 0x4000000000000000)) (i64.const 64))
+(assert_return (invoke "rem_u" (i64.const 64) (i64.const 0x123456789abcdef)) (i64.const 64))
+(assert_return (invoke "rem_u" (i64.const 64) (i64.const 0xfedcba9876543210)) (i64.const 64))
+(assert_return (invoke "rem_u" (i64.const 64) (i64.const 63)) (i64.const 1))
+(assert_return (invoke "rem_u" (i64.const 64) (i64.const 64)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const 64) (i64.const 65)) (i64.const 64))
+(assert_return (invoke "rem_u" (i64.const 64) (i64.const 0xfffffffffff5a5a)) (i64.const 64))
+(assert_trap (invoke "rem_u" (i64.const 65) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_u" (i64.const 65) (i64.const 1)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const 65) (i64.const -1)) (i64.const 65))
+(assert_return (invoke "rem_u" (i64.const 65) (i64.const 2)) (i64.const 1))
+(assert_return (invoke "rem_u" (i64.const 65) (i64.const 3)) (i64.const 2))
+(assert_return (invoke "rem_u" (i64.const 65) (i64.const 0x7fffffffffffffff)) (i64.const 65))
+(assert_return (invoke "rem_u" (i64.const 65) (i64.const 0x8000000000000000)) (i64.const 65))
+(assert_return (invoke "rem_u" (i64.const 65) (i64.const 0x8000000000000001)) (i64.const 65))
+(assert_return (invoke "rem_u" (i64.const 65) (i64.const 0x4000000000000000)) (i64.const 65))
+(assert_return (invoke "rem_u" (i64.const 65) (i64.const 0x123456789abcdef)) (i64.const 65))
+(assert_return (invoke "rem_u" (i64.const 65) (i64.const 0xfedcba9876543210)) (i64.const 65))
+(assert_return (invoke "rem_u" (i64.const 65) (i64.const 63)) (i64.const 2))
+(assert_return (invoke "rem_u" (i64.const 65) (i64.const 64)) (i64.const 1))
+(assert_return (invoke "rem_u" (i64.const 65) (i64.const 65)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const 65) (i64.const 0xfffffffffff5a5a)) (i64.const 65))
+(assert_trap (invoke "rem_u" (i64.const 0xfffffffffff5a5a) (i64.const 0)) "integer divide by zero")
+(assert_return (invoke "rem_u" (i64.const 0xfffffffffff5a5a) (i64.const 1)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const 0xfffffffffff5a5a) (i64.const -1)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "rem_u" (i64.const 0xfffffffffff5a5a) (i64.const 2)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const 0xfffffffffff5a5a) (i64.const 3)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const 0xfffffffffff5a5a) (i64.const 0x7fffffffffffffff)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "rem_u" (i64.const 0xfffffffffff5a5a) (i64.const 0x8000000000000000)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "rem_u" (i64.const 0xfffffffffff5a5a) (i64.const 0x8000000000000001)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "rem_u" (i64.const 0xfffffffffff5a5a) (i64.const 0x4000000000000000)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "rem_u" (i64.const 0xfffffffffff5a5a) (i64.const 0x123456789abcdef)) (i64.const 0x123456789a1748))
+(assert_return (invoke "rem_u" (i64.const 0xfffffffffff5a5a) (i64.const 0xfedcba9876543210)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "rem_u" (i64.const 0xfffffffffff5a5a) (i64.const 63)) (i64.const 57))
+(assert_return (invoke "rem_u" (i64.const 0xfffffffffff5a5a) (i64.const 64)) (i64.const 26))
+(assert_return (invoke "rem_u" (i64.const 0xfffffffffff5a5a) (i64.const 65)) (i64.const 40))
+(assert_return (invoke "rem_u" (i64.const 0xfffffffffff5a5a) (i64.const 0xfffffffffff5a5a)) (i64.const 0))
+(assert_return (invoke "rem_u" (i64.const 0x5d792e42e4ef2e9a) (i64.const 0x86541a94f2dc3f09)) (i64.const 0x5d792e42e4ef2e9a))
+(assert_return (invoke "rem_u" (i64.const 0xf9c0ef1a83f1e808) (i64.const 0x8ebfe9204d0c91de)) (i64.const 0x6b0105fa36e5562a))
+(assert_return (invoke "rem_u" (i64.const 0xca833e3b61348d3d) (i64.const 0xfa4f66247cdbda33)) (i64.const 0xca833e3b61348d3d))
+(assert_return (invoke "rem_u" (i64.const 0x4ad736809ae23d40) (i64.const 0x75a00bba461f9a70)) (i64.const 0x4ad736809ae23d40))
+(assert_return (invoke "rem_u" (i64.const 0xbf25d94e49dfd15e) (i64.const 0x7e2eaece83381a9b)) (i64.const 0x40f72a7fc6a7b6c3))
+(assert_return (invoke "rem_u" (i64.const 0xbc6e9b11bd4f5a82) (i64.const 0x228a39820393f6a7)) (i64.const 0xfbb7b87ab6b893f))
+(assert_return (invoke "rem_u" (i64.const 0x94507ff6e6cb8559) (i64.const 0x1f7ed4f2a6539572)) (i64.const 0x16552c2c4d7d2f91))
+(assert_return (invoke "rem_u" (i64.const 0x93f2a5c0805aa4fb) (i64.const 0xe821b1678e006b4f)) (i64.const 0x93f2a5c0805aa4fb))
+(assert_return (invoke "rem_u" (i64.const 0xb9f1b3d8d283d666) (i64.const 0x3e3267b06dd9c94e)) (i64.const 0x3d8ce477f6d043ca))
+(assert_return (invoke "rem_u" (i64.const 0x65e89f3c533cb707) (i64.const 0xaf5367c8172b3ba4)) (i64.const 0x65e89f3c533cb707))
+(assert_return (invoke "rem_u" (i64.const 0x8fafd2795591acab) (i64.const 0x7513cfbb0f8c0407)) (i64.const 0x1a9c02be4605a8a4))
+(assert_return (invoke "rem_u" (i64.const 0xcca9e9da4140d54e) (i64.const 0xac1ac008a42ec19d)) (i64.const 0x208f29d19d1213b1))
+(assert_return (invoke "rem_u" (i64.const 0x85b2d1c15b991ddb) (i64.const 0x561fa841e923fd31)) (i64.const 0x2f93297f727520aa))
+(assert_return (invoke "rem_u" (i64.const 0x258fd15f781d8d0d) (i64.const 0x649f5f77d601f5fa)) (i64.const 0x258fd15f781d8d0d))
+(assert_return (invoke "rem_u" (i64.const 0xdb814fb43521a5e6) (i64.const 0x5e9ea326fa52be36)) (i64.const 0x1e440966407c297a))
+(assert_return (invoke "rem_u" (i64.const 0xea3eb39dda328a7b) (i64.const 0x3bd889f9e7a5ca3e)) (i64.const 0x36b515b023412bc1))
+
+(assert_return (invoke "and" (i64.const 0) (i64.const 0)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0) (i64.const 1)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0) (i64.const 2)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0) (i64.const 3)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0) (i64.const 0x8000000000000001)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0) (i64.const 0x4000000000000000)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0) (i64.const 0x123456789abcdef)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0) (i64.const 63)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0) (i64.const 64)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0) (i64.const 65)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0) (i64.const 0xfffffffffff5a5a)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 1) (i64.const 0)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 1) (i64.const 1)) (i64.const 1))
+(assert_return (invoke "and" (i64.const 1) (i64.const -1)) (i64.const 1))
+(assert_return (invoke "and" (i64.const 1) (i64.const 2)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 1) (i64.const 3)) (i64.const 1))
+(assert_return (invoke "and" (i64.const 1) (i64.const 0x7fffffffffffffff)) (i64.const 1))
+(assert_return (invoke "and" (i64.const 1) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 1) (i64.const 0x8000000000000001)) (i64.const 1))
+(assert_return (invoke "and" (i64.const 1) (i64.const 0x4000000000000000)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 1) (i64.const 0x123456789abcdef)) (i64.const 1))
+(assert_return (invoke "and" (i64.const 1) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 1) (i64.const 63)) (i64.const 1))
+(assert_return (invoke "and" (i64.const 1) (i64.const 64)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 1) (i64.const 65)) (i64.const 1))
+(assert_return (invoke "and" (i64.const 1) (i64.const 0xfffffffffff5a5a)) (i64.const 0))
+(assert_return (invoke "and" (i64.const -1) (i64.const 0)) (i64.const 0))
+(assert_return (invoke "and" (i64.const -1) (i64.const 1)) (i64.const 1))
+(assert_return (invoke "and" (i64.const -1) (i64.const -1)) (i64.const -1))
+(assert_return (invoke "and" (i64.const -1) (i64.const 2)) (i64.const 2))
+(assert_return (invoke "and" (i64.const -1) (i64.const 3)) (i64.const 3))
+(assert_return (invoke "and" (i64.const -1) (i64.const 0x7fffffffffffffff)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "and" (i64.const -1) (i64.const 0x8000000000000000)) (i64.const 0x8000000000000000))
+(assert_return (invoke "and" (i64.const -1) (i64.const 0x8000000000000001)) (i64.const 0x8000000000000001))
+(assert_return (invoke "and" (i64.const -1) (i64.const 0x4000000000000000)) (i64.const 0x4000000000000000))
+(assert_return (invoke "and" (i64.const -1) (i64.const 0x123456789abcdef)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "and" (i64.const -1) (i64.const 0xfedcba9876543210)) (i64.const 0xfedcba9876543210))
+(assert_return (invoke "and" (i64.const -1) (i64.const 63)) (i64.const 63))
+(assert_return (invoke "and" (i64.const -1) (i64.const 64)) (i64.const 64))
+(assert_return (invoke "and" (i64.const -1) (i64.const 65)) (i64.const 65))
+(assert_return (invoke "and" (i64.const -1) (i64.const 0xfffffffffff5a5a)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "and" (i64.const 2) (i64.const 0)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 2) (i64.const 1)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 2) (i64.const -1)) (i64.const 2))
+(assert_return (invoke "and" (i64.const 2) (i64.const 2)) (i64.const 2))
+(assert_return (invoke "and" (i64.const 2) (i64.const 3)) (i64.const 2))
+(assert_return (invoke "and" (i64.const 2) (i64.const 0x7fffffffffffffff)) (i64.const 2))
+(assert_return (invoke "and" (i64.const 2) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 2) (i64.const 0x8000000000000001)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 2) (i64.const 0x4000000000000000)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 2) (i64.const 0x123456789abcdef)) (i64.const 2))
+(assert_return (invoke "and" (i64.const 2) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 2) (i64.const 63)) (i64.const 2))
+(assert_return (invoke "and" (i64.const 2) (i64.const 64)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 2) (i64.const 65)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 2) (i64.const 0xfffffffffff5a5a)) (i64.const 2))
+(assert_return (invoke "and" (i64.const 3) (i64.const 0)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 3) (i64.const 1)) (i64.const 1))
+(assert_return (invoke "and" (i64.const 3) (i64.const -1)) (i64.const 3))
+(assert_return (invoke "and" (i64.const 3) (i64.const 2)) (i64.const 2))
+(assert_return (invoke "and" (i64.const 3) (i64.const 3)) (i64.const 3))
+(assert_return (invoke "and" (i64.const 3) (i64.const 0x7fffffffffffffff)) (i64.const 3))
+(assert_return (invoke "and" (i64.const 3) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 3) (i64.const 0x8000000000000001)) (i64.const 1))
+(assert_return (invoke "and" (i64.const 3) (i64.const 0x4000000000000000)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 3) (i64.const 0x123456789abcdef)) (i64.const 3))
+(assert_return (invoke "and" (i64.const 3) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 3) (i64.const 63)) (i64.const 3))
+(assert_return (invoke "and" (i64.const 3) (i64.const 64)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 3) (i64.const 65)) (i64.const 1))
+(assert_return (invoke "and" (i64.const 3) (i64.const 0xfffffffffff5a5a)) (i64.const 2))
+(assert_return (invoke "and" (i64.const 0x7fffffffffffffff) (i64.const 0)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0x7fffffffffffffff) (i64.const 1)) (i64.const 1))
+(assert_return (invoke "and" (i64.const 0x7fffffffffffffff) (i64.const -1)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "and" (i64.const 0x7fffffffffffffff) (i64.const 2)) (i64.const 2))
+(assert_return (invoke "and" (i64.const 0x7fffffffffffffff) (i64.const 3)) (i64.const 3))
+(assert_return (invoke "and" (i64.const 0x7fffffffffffffff) (i64.const 0x7fffffffffffffff)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "and" (i64.const 0x7fffffffffffffff) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0x7fffffffffffffff) (i64.const 0x8000000000000001)) (i64.const 1))
+(assert_return (invoke "and" (i64.const 0x7fffffffffffffff) (i64.const 0x4000000000000000)) (i64.const 0x4000000000000000))
+(assert_return (invoke "and" (i64.const 0x7fffffffffffffff) (i64.const 0x123456789abcdef)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "and" (i64.const 0x7fffffffffffffff) (i64.const 0xfedcba9876543210)) (i64.const 0x7edcba9876543210))
+(assert_return (invoke "and" (i64.const 0x7fffffffffffffff) (i64.const 63)) (i64.const 63))
+(assert_return (invoke "and" (i64.const 0x7fffffffffffffff) (i64.const 64)) (i64.const 64))
+(assert_return (invoke "and" (i64.const 0x7fffffffffffffff) (i64.const 65)) (i64.const 65))
+(assert_return (invoke "and" (i64.const 0x7fffffffffffffff) (i64.const 0xfffffffffff5a5a)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "and" (i64.const 0x8000000000000000) (i64.const 0)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0x8000000000000000) (i64.const 1)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0x8000000000000000) (i64.const -1)) (i64.const 0x8000000000000000))
+(assert_return (invoke "and" (i64.const 0x8000000000000000) (i64.const 2)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0x8000000000000000) (i64.const 3)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0x8000000000000000) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0x8000000000000000) (i64.const 0x8000000000000000)) (i64.const 0x8000000000000000))
+(assert_return (invoke "and" (i64.const 0x8000000000000000) (i64.const 0x8000000000000001)) (i64.const 0x8000000000000000))
+(assert_return (invoke "and" (i64.const 0x8000000000000000) (i64.const 0x4000000000000000)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0x8000000000000000) (i64.const 0x123456789abcdef)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0x8000000000000000) (i64.const 0xfedcba9876543210)) (i64.const 0x8000000000000000))
+(assert_return (invoke "and" (i64.const 0x8000000000000000) (i64.const 63)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0x8000000000000000) (i64.const 64)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0x8000000000000000) (i64.const 65)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0x8000000000000000) (i64.const 0xfffffffffff5a5a)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0x8000000000000001) (i64.const 0)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0x8000000000000001) (i64.const 1)) (i64.const 1))
+(assert_return (invoke "and" (i64.const 0x8000000000000001) (i64.const -1)) (i64.const 0x8000000000000001))
+(assert_return (invoke "and" (i64.const 0x8000000000000001) (i64.const 2)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0x8000000000000001) (i64.const 3)) (i64.const 1))
+(assert_return (invoke "and" (i64.const 0x8000000000000001) (i64.const 0x7fffffffffffffff)) (i64.const 1))
+(assert_return (invoke "and" (i64.const 0x8000000000000001) (i64.const 0x8000000000000000)) (i64.const 0x8000000000000000))
+(assert_return (invoke "and" (i64.const 0x8000000000000001) (i64.const 0x8000000000000001)) (i64.const 0x8000000000000001))
+(assert_return (invoke "and" (i64.const 0x8000000000000001) (i64.const 0x4000000000000000)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0x8000000000000001) (i64.const 0x123456789abcdef)) (i64.const 1))
+(assert_return (invoke "and" (i64.const 0x8000000000000001) (i64.const 0xfedcba9876543210)) (i64.const 0x8000000000000000))
+(assert_return (invoke "and" (i64.const 0x8000000000000001) (i64.const 63)) (i64.const 1))
+(assert_return (invoke "and" (i64.const 0x8000000000000001) (i64.const 64)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0x8000000000000001) (i64.const 65)) (i64.const 1))
+(assert_return (invoke "and" (i64.const 0x8000000000000001) (i64.const 0xfffffffffff5a5a)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0x4000000000000000) (i64.const 0)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0x4000000000000000) (i64.const 1)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0x4000000000000000) (i64.const -1)) (i64.const 0x4000000000000000))
+(assert_return (invoke "and" (i64.const 0x4000000000000000) (i64.const 2)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0x4000000000000000) (i64.const 3)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0x4000000000000000) (i64.const 0x7fffffffffffffff)) (i64.const 0x4000000000000000))
+(assert_return (invoke "and" (i64.const 0x4000000000000000) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0x4000000000000000) (i64.const 0x8000000000000001)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0x4000000000000000) (i64.const 0x4000000000000000)) (i64.const 0x4000000000000000))
+(assert_return (invoke "and" (i64.const 0x4000000000000000) (i64.const 0x123456789abcdef)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0x4000000000000000) (i64.const 0xfedcba9876543210)) (i64.const 0x4000000000000000))
+(assert_return (invoke "and" (i64.const 0x4000000000000000) (i64.const 63)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0x4000000000000000) (i64.const 64)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0x4000000000000000) (i64.const 65)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0x4000000000000000) (i64.const 0xfffffffffff5a5a)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0x123456789abcdef) (i64.const 0)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0x123456789abcdef) (i64.const 1)) (i64.const 1))
+(assert_return (invoke "and" (i64.const 0x123456789abcdef) (i64.const -1)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "and" (i64.const 0x123456789abcdef) (i64.const 2)) (i64.const 2))
+(assert_return (invoke "and" (i64.const 0x123456789abcdef) (i64.const 3)) (i64.const 3))
+(assert_return (invoke "and" (i64.const 0x123456789abcdef) (i64.const 0x7fffffffffffffff)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "and" (i64.const 0x123456789abcdef) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0x123456789abcdef) (i64.const 0x8000000000000001)) (i64.const 1))
+(assert_return (invoke "and" (i64.const 0x123456789abcdef) (i64.const 0x4000000000000000)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0x123456789abcdef) (i64.const 0x123456789abcdef)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "and" (i64.const 0x123456789abcdef) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0x123456789abcdef) (i64.const 63)) (i64.const 47))
+(assert_return (invoke "and" (i64.const 0x123456789abcdef) (i64.const 64)) (i64.const 64))
+(assert_return (invoke "and" (i64.const 0x123456789abcdef) (i64.const 65)) (i64.const 65))
+(assert_return (invoke "and" (i64.const 0x123456789abcdef) (i64.const 0xfffffffffff5a5a)) (i64.const 0x123456789ab484a))
+(assert_return (invoke "and" (i64.const 0xfedcba9876543210) (i64.const 0)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0xfedcba9876543210) (i64.const 1)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0xfedcba9876543210) (i64.const -1)) (i64.const 0xfedcba9876543210))
+(assert_return (invoke "and" (i64.const 0xfedcba9876543210) (i64.const 2)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0xfedcba9876543210) (i64.const 3)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0xfedcba9876543210) (i64.const 0x7fffffffffffffff)) (i64.const 0x7edcba9876543210))
+(assert_return (invoke "and" (i64.const 0xfedcba9876543210) (i64.const 0x8000000000000000)) (i64.const 0x8000000000000000))
+(assert_return (invoke "and" (i64.const 0xfedcba9876543210) (i64.const 0x8000000000000001)) (i64.const 0x8000000000000000))
+(assert_return (invoke "and" (i64.const 0xfedcba9876543210) (i64.const 0x4000000000000000)) (i64.const 0x4000000000000000))
+(assert_return (invoke "and" (i64.const 0xfedcba9876543210) (i64.const 0x123456789abcdef)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0xfedcba9876543210) (i64.const 0xfedcba9876543210)) (i64.const 0xfedcba9876543210))
+(assert_return (invoke "and" (i64.const 0xfedcba9876543210) (i64.const 63)) (i64.const 16))
+(assert_return (invoke "and" (i64.const 0xfedcba9876543210) (i64.const 64)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0xfedcba9876543210) (i64.const 65)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0xfedcba9876543210) (i64.const 0xfffffffffff5a5a)) (i64.const 0xedcba9876541210))
+(assert_return (invoke "and" (i64.const 63) (i64.const 0)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 63) (i64.const 1)) (i64.const 1))
+(assert_return (invoke "and" (i64.const 63) (i64.const -1)) (i64.const 63))
+(assert_return (invoke "and" (i64.const 63) (i64.const 2)) (i64.const 2))
+(assert_return (invoke "and" (i64.const 63) (i64.const 3)) (i64.const 3))
+(assert_return (invoke "and" (i64.const 63) (i64.const 0x7fffffffffffffff)) (i64.const 63))
+(assert_return (invoke "and" (i64.const 63) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 63) (i64.const 0x8000000000000001)) (i64.const 1))
+(assert_return (invoke "and" (i64.const 63) (i64.const 0x4000000000000000)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 63) (i64.const 0x123456789abcdef)) (i64.const 47))
+(assert_return (invoke "and" (i64.const 63) (i64.const 0xfedcba9876543210)) (i64.const 16))
+(assert_return (invoke "and" (i64.const 63) (i64.const 63)) (i64.const 63))
+(assert_return (invoke "and" (i64.const 63) (i64.const 64)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 63) (i64.const 65)) (i64.const 1))
+(assert_return (invoke "and" (i64.const 63) (i64.const 0xfffffffffff5a5a)) (i64.const 26))
+(assert_return (invoke "and" (i64.const 64) (i64.const 0)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 64) (i64.const 1)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 64) (i64.const -1)) (i64.const 64))
+(assert_return (invoke "and" (i64.const 64) (i64.const 2)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 64) (i64.const 3)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 64) (i64.const 0x7fffffffffffffff)) (i64.const 64))
+(assert_return (invoke "and" (i64.const 64) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 64) (i64.const 0x8000000000000001)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 64) (i64.const 0x4000000000000000)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 64) (i64.const 0x123456789abcdef)) (i64.const 64))
+(assert_return (invoke "and" (i64.const 64) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 64) (i64.const 63)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 64) (i64.const 64)) (i64.const 64))
+(assert_return (invoke "and" (i64.const 64) (i64.const 65)) (i64.const 64))
+(assert_return (invoke "and" (i64.const 64) (i64.const 0xfffffffffff5a5a)) (i64.const 64))
+(assert_return (invoke "and" (i64.const 65) (i64.const 0)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 65) (i64.const 1)) (i64.const 1))
+(assert_return (invoke "and" (i64.const 65) (i64.const -1)) (i64.const 65))
+(assert_return (invoke "and" (i64.const 65) (i64.const 2)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 65) (i64.const 3)) (i64.const 1))
+(assert_return (invoke "and" (i64.const 65) (i64.const 0x7fffffffffffffff)) (i64.const 65))
+(assert_return (invoke "and" (i64.const 65) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 65) (i64.const 0x8000000000000001)) (i64.const 1))
+(assert_return (invoke "and" (i64.const 65) (i64.const 0x4000000000000000)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 65) (i64.const 0x123456789abcdef)) (i64.const 65))
+(assert_return (invoke "and" (i64.const 65) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 65) (i64.const 63)) (i64.const 1))
+(assert_return (invoke "and" (i64.const 65) (i64.const 64)) (i64.const 64))
+(assert_return (invoke "and" (i64.const 65) (i64.const 65)) (i64.const 65))
+(assert_return (invoke "and" (i64.const 65) (i64.const 0xfffffffffff5a5a)) (i64.const 64))
+(assert_return (invoke "and" (i64.const 0xfffffffffff5a5a) (i64.const 0)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0xfffffffffff5a5a) (i64.const 1)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0xfffffffffff5a5a) (i64.const -1)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "and" (i64.const 0xfffffffffff5a5a) (i64.const 2)) (i64.const 2))
+(assert_return (invoke "and" (i64.const 0xfffffffffff5a5a) (i64.const 3)) (i64.const 2))
+(assert_return (invoke "and" (i64.const 0xfffffffffff5a5a) (i64.const 0x7fffffffffffffff)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "and" (i64.const 0xfffffffffff5a5a) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0xfffffffffff5a5a) (i64.const 0x8000000000000001)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0xfffffffffff5a5a) (i64.const 0x4000000000000000)) (i64.const 0))
+(assert_return (invoke "and" (i64.const 0xfffffffffff5a5a) (i64.const 0x123456789abcdef)) (i64.const 0x123456789ab484a))
+(assert_return (invoke "and" (i64.const 0xfffffffffff5a5a) (i64.const 0xfedcba9876543210)) (i64.const 0xedcba9876541210))
+(assert_return (invoke "and" (i64.const 0xfffffffffff5a5a) (i64.const 63)) (i64.const 26))
+(assert_return (invoke "and" (i64.const 0xfffffffffff5a5a) (i64.const 64)) (i64.const 64))
+(assert_return (invoke "and" (i64.const 0xfffffffffff5a5a) (i64.const 65)) (i64.const 64))
+(assert_return (invoke "and" (i64.const 0xfffffffffff5a5a) (i64.const 0xfffffffffff5a5a)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "and" (i64.const 0xb13fc94fce373372) (i64.const 0x475cabea84c2e697)) (i64.const 0x11c894a84022212))
+(assert_return (invoke "and" (i64.const 0xd8162d5b279220ce) (i64.const 0xc6a7af1d7e60cad)) (i64.const 0x80228510782008c))
+(assert_return (invoke "and" (i64.const 0x7e8cbcbcf4e09c0f) (i64.const 0x1f02145f750abe38)) (i64.const 0x1e00141c74009c08))
+(assert_return (invoke "and" (i64.const 0xad29a06bc3fc5dc1) (i64.const 0xfcf81acfa0096643)) (i64.const 0xac28004b80084441))
+(assert_return (invoke "and" (i64.const 0xabc1980cc52aa1b0) (i64.const 0xe3b0819ae89684dc)) (i64.const 0xa3808008c0028090))
+(assert_return (invoke "and" (i64.const 0x86a053aba670b48d) (i64.const 0xcd08fff325047fd2)) (i64.const 0x840053a324003480))
+(assert_return (invoke "and" (i64.const 0x40baac31702c50b7) (i64.const 0xdca79d978ace4afb)) (i64.const 0x40a28c11000c40b3))
+(assert_return (invoke "and" (i64.const 0x74fa975939c9cc6f) (i64.const 0x3e9355beb4a96bf9)) (i64.const 0x3492151830894869))
+(assert_return (invoke "and" (i64.const 0x3f4a1c9e53cf2a55) (i64.const 0x44dbc263166da531)) (i64.const 0x44a0002124d2011))
+(assert_return (invoke "and" (i64.const 0xa3df9ca35bd0afbb) (i64.const 0x8f10f8bbc2754c3)) (i64.const 0xd10c8318000483))
+(assert_return (invoke "and" (i64.const 0xb2d5fd06a2b55bed) (i64.const 0xb8fbfff352eb0f6c)) (i64.const 0xb0d1fd0202a10b6c))
+(assert_return (invoke "and" (i64.const 0xa145ace16b502012) (i64.const 0xafb6e1d2b078b5dc)) (i64.const 0xa104a0c020502010))
+(assert_return (invoke "and" (i64.const 0x3a157e47d9a041a3) (i64.const 0xa63367a41b8df5d5)) (i64.const 0x2211660419804181))
+(assert_return (invoke "and" (i64.const 0xd723c3d0326253cc) (i64.const 0xb9a2a7fe69e322be)) (i64.const 0x912283d02062028c))
+(assert_return (invoke "and" (i64.const 0x8a7707fc37d51623) (i64.const 0xeafefada2e2fadbb)) (i64.const 0x8a7602d826050423))
+(assert_return (invoke "and" (i64.const 0xef8e6fd4d623d39) (i64.const 0x2b50fd892445717a)) (i64.const 0xa50e48904403138))
+
+(assert_return (invoke "or" (i64.const 0) (i64.const 0)) (i64.const 0))
+(assert_return (invoke "or" (i64.const 0) (i64.const 1)) (i64.const 1))
+(assert_return (invoke "or" (i64.const 0) (i64.const -1)) (i64.const -1))
+(assert_return (invoke "or" (i64.const 0) (i64.const 2)) (i64.const 2))
+(assert_return (invoke "or" (i64.const 0) (i64.const 3)) (i64.const 3))
+(assert_return (invoke "or" (i64.const 0) (i64.const 0x7fffffffffffffff)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "or" (i64.const 0) (i64.const 0x8000000000000000)) (i64.const 0x8000000000000000))
+(assert_return (invoke "or" (i64.const 0) (i64.const 0x8000000000000001)) (i64.const 0x8000000000000001))
+(assert_return (invoke "or" (i64.const 0) (i64.const 0x4000000000000000)) (i64.const 0x4000000000000000))
+(assert_return (invoke "or" (i64.const 0) (i64.const 0x123456789abcdef)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "or" (i64.const 0) (i64.const 0xfedcba9876543210)) (i64.const 0xfedcba9876543210))
+(assert_return (invoke "or" (i64.const 0) (i64.const 63)) (i64.const 63))
+(assert_return (invoke "or" (i64.const 0) (i64.const 64)) (i64.const 64))
+(assert_return (invoke "or" (i64.const 0) (i64.const 65)) (i64.const 65))
+(assert_return (invoke "or" (i64.const 0) (i64.const 0xfffffffffff5a5a)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "or" (i64.const 1) (i64.const 0)) (i64.const 1))
+(assert_return (invoke "or" (i64.const 1) (i64.const 1)) (i64.const 1))
+(assert_return (invoke "or" (i64.const 1) (i64.const -1)) (i64.const -1))
+(assert_return (invoke "or" (i64.const 1) (i64.const 2)) (i64.const 3))
+(assert_return (invoke "or" (i64.const 1) (i64.const 3)) (i64.const 3))
+(assert_return (invoke "or" (i64.const 1) (i64.const 0x7fffffffffffffff)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "or" (i64.const 1) (i64.const 0x8000000000000000)) (i64.const 0x8000000000000001))
+(assert_return (invoke "or" (i64.const 1) (i64.const 0x8000000000000001)) (i64.const 0x8000000000000001))
+(assert_return (invoke "or" (i64.const 1) (i64.const 0x4000000000000000)) (i64.const 0x4000000000000001))
+(assert_return (invoke "or" (i64.const 1) (i64.const 0x123456789abcdef)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "or" (i64.const 1) (i64.const 0xfedcba9876543210)) (i64.const 0xfedcba9876543211))
+(assert_return (invoke "or" (i64.const 1) (i64.const 63)) (i64.const 63))
+(assert_return (invoke "or" (i64.const 1) (i64.const 64)) (i64.const 65))
+(assert_return (invoke "or" (i64.const 1) (i64.const 65)) (i64.const 65))
+(assert_return (invoke "or" (i64.const 1) (i64.const 0xfffffffffff5a5a)) (i64.const 0xfffffffffff5a5b))
+(assert_return (invoke "or" (i64.const -1) (i64.const 0)) (i64.const -1))
+(assert_return (invoke "or" (i64.const -1) (i64.const 1)) (i64.const -1))
+(assert_return (invoke "or" (i64.const -1) (i64.const -1)) (i64.const -1))
+(assert_return (invoke "or" (i64.const -1) (i64.const 2)) (i64.const -1))
+(assert_return (invoke "or" (i64.const -1) (i64.const 3)) (i64.const -1))
+(assert_return (invoke "or" (i64.const -1) (i64.const 0x7fffffffffffffff)) (i64.const -1))
+(assert_return (invoke "or" (i64.const -1) (i64.const 0x8000000000000000)) (i64.const -1))
+(assert_return (invoke "or" (i64.const -1) (i64.const 0x8000000000000001)) (i64.const -1))
+(assert_return (invoke "or" (i64.const -1) (i64.const 0x4000000000000000)) (i64.const -1))
+(assert_return (invoke "or" (i64.const -1) (i64.const 0x123456789abcdef)) (i64.const -1))
+(assert_return (invoke "or" (i64.const -1) (i64.const 0xfedcba9876543210)) (i64.const -1))
+(assert_return (invoke "or" (i64.const -1) (i64.const 63)) (i64.const -1))
+(assert_return (invoke "or" (i64.const -1) (i64.const 64)) (i64.const -1))
+(assert_return (invoke "or" (i64.const -1) (i64.const 65)) (i64.const -1))
+(assert_return (invoke "or" (i64.const -1) (i64.const 0xfffffffffff5a5a)) (i64.const -1))
+(assert_return (invoke "or" (i64.const 2) (i64.const 0)) (i64.const 2))
+(assert_return (invoke "or" (i64.const 2) (i64.const 1)) (i64.const 3))
+(assert_return (invoke "or" (i64.const 2) (i64.const -1)) (i64.const -1))
+(assert_return (invoke "or" (i64.const 2) (i64.const 2)) (i64.const 2))
+(assert_return (invoke "or" (i64.const 2) (i64.const 3)) (i64.const 3))
+(assert_return (invoke "or" (i64.const 2) (i64.const 0x7fffffffffffffff)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "or" (i64.const 2) (i64.const 0x8000000000000000)) (i64.const 0x8000000000000002))
+(assert_return (invoke "or" (i64.const 2) (i64.const 0x8000000000000001)) (i64.const 0x8000000000000003))
+(assert_return (invoke "or" (i64.const 2) (i64.const 0x4000000000000000)) (i64.const 0x4000000000000002))
+(assert_return (invoke "or" (i64.const 2) (i64.const 0x123456789abcdef)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "or" (i64.const 2) (i64.const 0xfedcba9876543210)) (i64.const 0xfedcba9876543212))
+(assert_return (invoke "or" (i64.const 2) (i64.const 63)) (i64.const 63))
+(assert_return (invoke "or" (i64.const 2) (i64.const 64)) (i64.const 66))
+(assert_return (invoke "or" (i64.const 2) (i64.const 65)) (i64.const 67))
+(assert_return (invoke "or" (i64.const 2) (i64.const 0xfffffffffff5a5a)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "or" (i64.const 3) (i64.const 0)) (i64.const 3))
+(assert_return (invoke "or" (i64.const 3) (i64.const 1)) (i64.const 3))
+(assert_return (invoke "or" (i64.const 3) (i64.const -1)) (i64.const -1))
+(assert_return (invoke "or" (i64.const 3) (i64.const 2)) (i64.const 3))
+(assert_return (invoke "or" (i64.const 3) (i64.const 3)) (i64.const 3))
+(assert_return (invoke "or" (i64.const 3) (i64.const 0x7fffffffffffffff)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "or" (i64.const 3) (i64.const 0x8000000000000000)) (i64.const 0x8000000000000003))
+(assert_return (invoke "or" (i64.const 3) (i64.const 0x8000000000000001)) (i64.const 0x8000000000000003))
+(assert_return (invoke "or" (i64.const 3) (i64.const 0x4000000000000000)) (i64.const 0x4000000000000003))
+(assert_return (invoke "or" (i64.const 3) (i64.const 0x123456789abcdef)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "or" (i64.const 3) (i64.const 0xfedcba9876543210)) (i64.const 0xfedcba9876543213))
+(assert_return (invoke "or" (i64.const 3) (i64.const 63)) (i64.const 63))
+(assert_return (invoke "or" (i64.const 3) (i64.const 64)) (i64.const 67))
+(assert_return (invoke "or" (i64.const 3) (i64.const 65)) (i64.const 67))
+(assert_return (invoke "or" (i64.const 3) (i64.const 0xfffffffffff5a5a)) (i64.const 0xfffffffffff5a5b))
+(assert_return (invoke "or" (i64.const 0x7fffffffffffffff) (i64.const 0)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "or" (i64.const 0x7fffffffffffffff) (i64.const 1)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "or" (i64.const 0x7fffffffffffffff) (i64.const -1)) (i64.const -1))
+(assert_return (invoke "or" (i64.const 0x7fffffffffffffff) (i64.const 2)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "or" (i64.const 0x7fffffffffffffff) (i64.const 3)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "or" (i64.const 0x7fffffffffffffff) (i64.const 0x7fffffffffffffff)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "or" (i64.const 0x7fffffffffffffff) (i64.const 0x8000000000000000)) (i64.const -1))
+(assert_return (invoke "or" (i64.const 0x7fffffffffffffff) (i64.const 0x8000000000000001)) (i64.const -1))
+(assert_return (invoke "or" (i64.const 0x7fffffffffffffff) (i64.const 0x4000000000000000)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "or" (i64.const 0x7fffffffffffffff) (i64.const 0x123456789abcdef)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "or" (i64.const 0x7fffffffffffffff) (i64.const 0xfedcba9876543210)) (i64.const -1))
+(assert_return (invoke "or" (i64.const 0x7fffffffffffffff) (i64.const 63)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "or" (i64.const 0x7fffffffffffffff) (i64.const 64)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "or" (i64.const 0x7fffffffffffffff) (i64.const 65)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "or" (i64.const 0x7fffffffffffffff) (i64.const 0xfffffffffff5a5a)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "or" (i64.const 0x8000000000000000) (i64.const 0)) (i64.const 0x8000000000000000))
+(assert_return (invoke "or" (i64.const 0x8000000000000000) (i64.const 1)) (i64.const 0x8000000000000001))
+(assert_return (invoke "or" (i64.const 0x8000000000000000) (i64.const -1)) (i64.const -1))
+(assert_return (invoke "or" (i64.const 0x8000000000000000) (i64.const 2)) (i64.const 0x8000000000000002))
+(assert_return (invoke "or" (i64.const 0x8000000000000000) (i64.const 3)) (i64.const 0x8000000000000003))
+(assert_return (invoke "or" (i64.const 0x8000000000000000) (i64.const 0x7fffffffffffffff)) (i64.const -1))
+(assert_return (invoke "or" (i64.const 0x8000000000000000) (i64.const 0x8000000000000000)) (i64.const 0x8000000000000000))
+(assert_return (invoke "or" (i64.const 0x8000000000000000) (i64.const 0x8000000000000001)) (i64.const 0x8000000000000001))
+(assert_return (invoke "or" (i64.const 0x8000000000000000) (i64.const 0x4000000000000000)) (i64.const 0xc000000000000000))
+(assert_return (invoke "or" (i64.const 0x8000000000000000) (i64.const 0x123456789abcdef)) (i64.const 0x8123456789abcdef))
+(assert_return (invoke "or" (i64.const 0x8000000000000000) (i64.const 0xfedcba9876543210)) (i64.const 0xfedcba9876543210))
+(assert_return (invoke "or" (i64.const 0x8000000000000000) (i64.const 63)) (i64.const 0x800000000000003f))
+(assert_return (invoke "or" (i64.const 0x8000000000000000) (i64.const 64)) (i64.const 0x8000000000000040))
+(assert_return (invoke "or" (i64.const 0x8000000000000000) (i64.const 65)) (i64.const 0x8000000000000041))
+(assert_return (invoke "or" (i64.const 0x8000000000000000) (i64.const 0xfffffffffff5a5a)) (i64.const 0x8fffffffffff5a5a))
+(assert_return (invoke "or" (i64.const 0x8000000000000001) (i64.const 0)) (i64.const 0x8000000000000001))
+(assert_return (invoke "or" (i64.const 0x8000000000000001) (i64.const 1)) (i64.const 0x8000000000000001))
+(assert_return (invoke "or" (i64.const 0x8000000000000001) (i64.const -1)) (i64.const -1))
+(assert_return (invoke "or" (i64.const 0x8000000000000001) (i64.const 2)) (i64.const 0x8000000000000003))
+(assert_return (invoke "or" (i64.const 0x8000000000000001) (i64.const 3)) (i64.const 0x8000000000000003))
+(assert_return (invoke "or" (i64.const 0x8000000000000001) (i64.const 0x7fffffffffffffff)) (i64.const -1))
+(assert_return (invoke "or" (i64.const 0x8000000000000001) (i64.const 0x8000000000000000)) (i64.const 0x8000000000000001))
+(assert_return (invoke "or" (i64.const 0x8000000000000001) (i64.const 0x8000000000000001)) (i64.const 0x8000000000000001))
+(assert_return (invoke "or" (i64.const 0x8000000000000001) (i64.const 0x4000000000000000)) (i64.const 0xc000000000000001))
+(assert_return (invoke "or" (i64.const 0x8000000000000001) (i64.const 0x123456789abcdef)) (i64.const 0x8123456789abcdef))
+(assert_return (invoke "or" (i64.const 0x8000000000000001) (i64.const 0xfedcba9876543210)) (i64.const 0xfedcba9876543211))
+(assert_return (invoke "or" (i64.const 0x8000000000000001) (i64.const 63)) (i64.const 0x800000000000003f))
+(assert_return (invoke "or" (i64.const 0x8000000000000001) (i64.const 64)) (i64.const 0x8000000000000041))
+(assert_return (invoke "or" (i64.const 0x8000000000000001) (i64.const 65)) (i64.const 0x8000000000000041))
+(assert_return (invoke "or" (i64.const 0x8000000000000001) (i64.const 0xfffffffffff5a5a)) (i64.const 0x8fffffffffff5a5b))
+(assert_return (invoke "or" (i64.const 0x4000000000000000) (i64.const 0)) (i64.const 0x4000000000000000))
+(assert_return (invoke "or" (i64.const 0x4000000000000000) (i64.const 1)) (i64.const 0x4000000000000001))
+(assert_return (invoke "or" (i64.const 0x4000000000000000) (i64.const -1)) (i64.const -1))
+(assert_return (invoke "or" (i64.const 0x4000000000000000) (i64.const 2)) (i64.const 0x4000000000000002))
+(assert_return (invoke "or" (i64.const 0x4000000000000000) (i64.const 3)) (i64.const 0x4000000000000003))
+(assert_return (invoke "or" (i64.const 0x4000000000000000) (i64.const 0x7fffffffffffffff)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "or" (i64.const 0x4000000000000000) (i64.const 0x8000000000000000)) (i64.const 0xc000000000000000))
+(assert_return (invoke "or" (i64.const 0x4000000000000000) (i64.const 0x8000000000000001)) (i64.const 0xc000000000000001))
+(assert_return (invoke "or" (i64.const 0x4000000000000000) (i64.const 0x4000000000000000)) (i64.const 0x4000000000000000))
+(assert_return (invoke "or" (i64.const 0x4000000000000000) (i64.const 0x123456789abcdef)) (i64.const 0x4123456789abcdef))
+(assert_return (invoke "or" (i64.const 0x4000000000000000) (i64.const 0xfedcba9876543210)) (i64.const 0xfedcba9876543210))
+(assert_return (invoke "or" (i64.const 0x4000000000000000) (i64.const 63)) (i64.const 0x400000000000003f))
+(assert_return (invoke "or" (i64.const 0x4000000000000000) (i64.const 64)) (i64.const 0x4000000000000040))
+(assert_return (invoke "or" (i64.const 0x4000000000000000) (i64.const 65)) (i64.const 0x4000000000000041))
+(assert_return (invoke "or" (i64.const 0x4000000000000000) (i64.const 0xfffffffffff5a5a)) (i64.const 0x4fffffffffff5a5a))
+(assert_return (invoke "or" (i64.const 0x123456789abcdef) (i64.const 0)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "or" (i64.const 0x123456789abcdef) (i64.const 1)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "or" (i64.const 0x123456789abcdef) (i64.const -1)) (i64.const -1))
+(assert_return (invoke "or" (i64.const 0x123456789abcdef) (i64.const 2)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "or" (i64.const 0x123456789abcdef) (i64.const 3)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "or" (i64.const 0x123456789abcdef) (i64.const 0x7fffffffffffffff)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "or" (i64.const 0x123456789abcdef) (i64.const 0x8000000000000000)) (i64.const 0x8123456789abcdef))
+(assert_return (invoke "or" (i64.const 0x123456789abcdef) (i64.const 0x8000000000000001)) (i64.const 0x8123456789abcdef))
+(assert_return (invoke "or" (i64.const 0x123456789abcdef) (i64.const 0x4000000000000000)) (i64.const 0x4123456789abcdef))
+(assert_return (invoke "or" (i64.const 0x123456789abcdef) (i64.const 0x123456789abcdef)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "or" (i64.const 0x123456789abcdef) (i64.const 0xfedcba9876543210)) (i64.const -1))
+(assert_return (invoke "or" (i64.const 0x123456789abcdef) (i64.const 63)) (i64.const 0x123456789abcdff))
+(assert_return (invoke "or" (i64.const 0x123456789abcdef) (i64.const 64)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "or" (i64.const 0x123456789abcdef) (i64.const 65)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "or" (i64.const 0x123456789abcdef) (i64.const 0xfffffffffff5a5a)) (i64.const 0xfffffffffffdfff))
+(assert_return (invoke "or" (i64.const 0xfedcba9876543210) (i64.const 0)) (i64.const 0xfedcba9876543210))
+(assert_return (invoke "or" (i64.const 0xfedcba9876543210) (i64.const 1)) (i64.const 0xfedcba9876543211))
+(assert_return (invoke "or" (i64.const 0xfedcba9876543210) (i64.const -1)) (i64.const -1))
+(assert_return (invoke "or" (i64.const 0xfedcba9876543210) (i64.const 2)) (i64.const 0xfedcba9876543212))
+(assert_return (invoke "or" (i64.const 0xfedcba9876543210) (i64.const 3)) (i64.const 0xfedcba9876543213))
+(assert_return (invoke "or" (i64.const 0xfedcba9876543210) (i64.const 0x7fffffffffffffff)) (i64.const -1))
+(assert_return (invoke "or" (i64.const 0xfedcba9876543210) (i64.const 0x8000000000000000)) (i64.const 0xfedcba9876543210))
+(assert_return (invoke "or" (i64.const 0xfedcba9876543210) (i64.const 0x8000000000000001)) (i64.const 0xfedcba9876543211))
+(assert_return (invoke "or" (i64.const 0xfedcba9876543210) (i64.const 0x4000000000000000)) (i64.const 0xfedcba9876543210))
+(assert_return (invoke "or" (i64.const 0xfedcba9876543210) (i64.const 0x123456789abcdef)) (i64.const -1))
+(assert_return (invoke "or" (i64.const 0xfedcba9876543210) (i64.const 0xfedcba9876543210)) (i64.const 0xfedcba9876543210))
+(assert_return (invoke "or" (i64.const 0xfedcba9876543210) (i64.const 63)) (i64.const 0xfedcba987654323f))
+(assert_return (invoke "or" (i64.const 0xfedcba9876543210) (i64.const 64)) (i64.const 0xfedcba9876543250))
+(assert_return (invoke "or" (i64.const 0xfedcba9876543210) (i64.const 65)) (i64.const 0xfedcba9876543251))
+(assert_return (invoke "or" (i64.const 0xfedcba9876543210) (i64.const 0xfffffffffff5a5a)) (i64.const -34214))
+(assert_return (invoke "or" (i64.const 63) (i64.const 0)) (i64.const 63))
+(assert_return (invoke "or" (i64.const 63) (i64.const 1)) (i64.const 63))
+(assert_return (invoke "or" (i64.const 63) (i64.const -1)) (i64.const -1))
+(assert_return (invoke "or" (i64.const 63) (i64.const 2)) (i64.const 63))
+(assert_return (invoke "or" (i64.const 63) (i64.const 3)) (i64.const 63))
+(assert_return (invoke "or" (i64.const 63) (i64.const 0x7fffffffffffffff)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "or" (i64.const 63) (i64.const 0x8000000000000000)) (i64.const 0x800000000000003f))
+(assert_return (invoke "or" (i64.const 63) (i64.const 0x8000000000000001)) (i64.const 0x800000000000003f))
+(assert_return (invoke "or" (i64.const 63) (i64.const 0x4000000000000000)) (i64.const 0x400000000000003f))
+(assert_return (invoke "or" (i64.const 63) (i64.const 0x123456789abcdef)) (i64.const 0x123456789abcdff))
+(assert_return (invoke "or" (i64.const 63) (i64.const 0xfedcba9876543210)) (i64.const 0xfedcba987654323f))
+(assert_return (invoke "or" (i64.const 63) (i64.const 63)) (i64.const 63))
+(assert_return (invoke "or" (i64.const 63) (i64.const 64)) (i64.const 127))
+(assert_return (invoke "or" (i64.const 63) (i64.const 65)) (i64.const 127))
+(assert_return (invoke "or" (i64.const 63) (i64.const 0xfffffffffff5a5a)) (i64.const 0xfffffffffff5a7f))
+(assert_return (invoke "or" (i64.const 64) (i64.const 0)) (i64.const 64))
+(assert_return (invoke "or" (i64.const 64) (i64.const 1)) (i64.const 65))
+(assert_return (invoke "or" (i64.const 64) (i64.const -1)) (i64.const -1))
+(assert_return (invoke "or" (i64.const 64) (i64.const 2)) (i64.const 66))
+(assert_return (invoke "or" (i64.const 64) (i64.const 3)) (i64.const 67))
+(assert_return (invoke "or" (i64.const 64) (i64.const 0x7fffffffffffffff)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "or" (i64.const 64) (i64.const 0x8000000000000000)) (i64.const 0x8000000000000040))
+(assert_return (invoke "or" (i64.const 64) (i64.const 0x8000000000000001)) (i64.const 0x8000000000000041))
+(assert_return (invoke "or" (i64.const 64) (i64.const 0x4000000000000000)) (i64.const 0x4000000000000040))
+(assert_return (invoke "or" (i64.const 64) (i64.const 0x123456789abcdef)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "or" (i64.const 64) (i64.const 0xfedcba9876543210)) (i64.const 0xfedcba9876543250))
+(assert_return (invoke "or" (i64.const 64) (i64.const 63)) (i64.const 127))
+(assert_return (invoke "or" (i64.const 64) (i64.const 64)) (i64.const 64))
+(assert_return (invoke "or" (i64.const 64) (i64.const 65)) (i64.const 65))
+(assert_return (invoke "or" (i64.const 64) (i64.const 0xfffffffffff5a5a)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "or" (i64.const 65) (i64.const 0)) (i64.const 65))
+(assert_return (invoke "or" (i64.const 65) (i64.const 1)) (i64.const 65))
+(assert_return (invoke "or" (i64.const 65) (i64.const -1)) (i64.const -1))
+(assert_return (invoke "or" (i64.const 65) (i64.const 2)) (i64.const 67))
+(assert_return (invoke "or" (i64.const 65) (i64.const 3)) (i64.const 67))
+(assert_return (invoke "or" (i64.const 65) (i64.const 0x7fffffffffffffff)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "or" (i64.const 65) (i64.const 0x8000000000000000)) (i64.const 0x8000000000000041))
+(assert_return (invoke "or" (i64.const 65) (i64.const 0x8000000000000001)) (i64.const 0x8000000000000041))
+(assert_return (invoke "or" (i64.const 65) (i64.const 0x4000000000000000)) (i64.const 0x4000000000000041))
+(assert_return (invoke "or" (i64.const 65) (i64.const 0x123456789abcdef)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "or" (i64.const 65) (i64.const 0xfedcba9876543210)) (i64.const 0xfedcba9876543251))
+(assert_return (invoke "or" (i64.const 65) (i64.const 63)) (i64.const 127))
+(assert_return (invoke "or" (i64.const 65) (i64.const 64)) (i64.const 65))
+(assert_return (invoke "or" (i64.const 65) (i64.const 65)) (i64.const 65))
+(assert_return (invoke "or" (i64.const 65) (i64.const 0xfffffffffff5a5a)) (i64.const 0xfffffffffff5a5b))
+(assert_return (invoke "or" (i64.const 0xfffffffffff5a5a) (i64.const 0)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "or" (i64.const 0xfffffffffff5a5a) (i64.const 1)) (i64.const 0xfffffffffff5a5b))
+(assert_return (invoke "or" (i64.const 0xfffffffffff5a5a) (i64.const -1)) (i64.const -1))
+(assert_return (invoke "or" (i64.const 0xfffffffffff5a5a) (i64.const 2)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "or" (i64.const 0xfffffffffff5a5a) (i64.const 3)) (i64.const 0xfffffffffff5a5b))
+(assert_return (invoke "or" (i64.const 0xfffffffffff5a5a) (i64.const 0x7fffffffffffffff)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "or" (i64.const 0xfffffffffff5a5a) (i64.const 0x8000000000000000)) (i64.const 0x8fffffffffff5a5a))
+(assert_return (invoke "or" (i64.const 0xfffffffffff5a5a) (i64.const 0x8000000000000001)) (i64.const 0x8fffffffffff5a5b))
+(assert_return (invoke "or" (i64.const 0xfffffffffff5a5a) (i64.const 0x4000000000000000)) (i64.const 0x4fffffffffff5a5a))
+(assert_return (invoke "or" (i64.const 0xfffffffffff5a5a) (i64.const 0x123456789abcdef)) (i64.const 0xfffffffffffdfff))
+(assert_return (invoke "or" (i64.const 0xfffffffffff5a5a) (i64.const 0xfedcba9876543210)) (i64.const -34214))
+(assert_return (invoke "or" (i64.const 0xfffffffffff5a5a) (i64.const 63)) (i64.const 0xfffffffffff5a7f))
+(assert_return (invoke "or" (i64.const 0xfffffffffff5a5a) (i64.const 64)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "or" (i64.const 0xfffffffffff5a5a) (i64.const 65)) (i64.const 0xfffffffffff5a5b))
+(assert_return (invoke "or" (i64.const 0xfffffffffff5a5a) (i64.const 0xfffffffffff5a5a)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "or" (i64.const 0xb41806a2097305f1) (i64.const 0x795244856d474804)) (i64.const 0xfd5a46a76d774df5))
+(assert_return (invoke "or" (i64.const 0xf2e616f6fe3169b) (i64.const 0x6f2c27799df6f3e8)) (i64.const 0x6f2e677ffff7f7fb))
+(assert_return (invoke "or" (i64.const 0x55f3274cee65eb5f) (i64.const 0xe5eae894f0a24ec0)) (i64.const 0xf5fbefdcfee7efdf))
+(assert_return (invoke "or" (i64.const 0xb16b49f37a0227a1) (i64.const 0xabaee67b47d876f1)) (i64.const 0xbbefeffb7fda77f1))
+(assert_return (invoke "or" (i64.const 0xbba5cecb9e31de86) (i64.const 0x308ba9bb7194b07a)) (i64.const 0xbbafeffbffb5fefe))
+(assert_return (invoke "or" (i64.const 0xf684e8789762e4f7) (i64.const 0x11a1088ed944cb7)) (i64.const 0xf79ef8f8fff6ecf7))
+(assert_return (invoke "or" (i64.const 0xd2047fc5c266cf20) (i64.const 0xc3252d25fc8d3cca)) (i64.const 0xd3257fe5feefffea))
+(assert_return (invoke "or" (i64.const 0xc65a61e3cfc0c341) (i64.const 0xbdfa6974c420900d)) (i64.const 0xfffa69f7cfe0d34d))
+(assert_return (invoke "or" (i64.const 0x37e33a32fec12f46) (i64.const 0x3f2d6ac1bc85b9bd)) (i64.const 0x3fef7af3fec5bfff))
+(assert_return (invoke "or" (i64.const 0xc0de8d2bf6c5aa9) (i64.const 0xabb4f674ac1a97ab)) (i64.const 0xafbdfef6bf7edfab))
+(assert_return (invoke "or" (i64.const 0xf5346c6ef8049707) (i64.const 0x4b25876b881ebaa1)) (i64.const 0xff35ef6ff81ebfa7))
+(assert_return (invoke "or" (i64.const 0x3f6dd459640de5ea) (i64.const 0xfab647c59d1c3924)) (i64.const 0xffffd7ddfd1dfdee))
+(assert_return (invoke "or" (i64.const 0x7956ba9f30dd28fa) (i64.const 0xc2ae4ad4c987a3c5)) (i64.const 0xfbfefadff9dfabff))
+(assert_return (invoke "or" (i64.const 0x3d51ef7485c02af0) (i64.const 0x203c16455ba21b05)) (i64.const 0x3d7dff75dfe23bf5))
+(assert_return (invoke "or" (i64.const 0xc625e4edad39973f) (i64.const 0xe1adc97ab5aeda39)) (i64.const 0xe7adedffbdbfdf3f))
+(assert_return (invoke "or" (i64.const 0xbb32b0f59c987b34) (i64.const 0xdc13f4b9505bd892)) (i64.const 0xff33f4fddcdbfbb6))
+
+(assert_return (invoke "xor" (i64.const 0) (i64.const 0)) (i64.const 0))
+(assert_return (invoke "xor" (i64.const 0) (i64.const 1)) (i64.const 1))
+(assert_return (invoke "xor" (i64.const 0) (i64.const -1)) (i64.const -1))
+(assert_return (invoke "xor" (i64.const 0) (i64.const 2)) (i64.const 2))
+(assert_return (invoke "xor" (i64.const 0) (i64.const 3)) (i64.const 3))
+(assert_return (invoke "xor" (i64.const 0) (i64.const 0x7fffffffffffffff)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "xor" (i64.const 0) (i64.const 0x8000000000000000)) (i64.const 0x8000000000000000))
+(assert_return (invoke "xor" (i64.const 0) (i64.const 0x8000000000000001)) (i64.const 0x8000000000000001))
+(assert_return (invoke "xor" (i64.const 0) (i64.const 0x4000000000000000)) (i64.const 0x4000000000000000))
+(assert_return (invoke "xor" (i64.const 0) (i64.const 0x123456789abcdef)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "xor" (i64.const 0) (i64.const 0xfedcba9876543210)) (i64.const 0xfedcba9876543210))
+(assert_return (invoke "xor" (i64.const 0) (i64.const 63)) (i64.const 63))
+(assert_return (invoke "xor" (i64.const 0) (i64.const 64)) (i64.const 64))
+(assert_return (invoke "xor" (i64.const 0) (i64.const 65)) (i64.const 65))
+(assert_return (invoke "xor" (i64.const 0) (i64.const 0xfffffffffff5a5a)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "xor" (i64.const 1) (i64.const 0)) (i64.const 1))
+(assert_return (invoke "xor" (i64.const 1) (i64.const 1)) (i64.const 0))
+(assert_return (invoke "xor" (i64.const 1) (i64.const -1)) (i64.const -2))
+(assert_return (invoke "xor" (i64.const 1) (i64.const 2)) (i64.const 3))
+(assert_return (invoke "xor" (i64.const 1) (i64.const 3)) (i64.const 2))
+(assert_return (invoke "xor" (i64.const 1) (i64.const 0x7fffffffffffffff)) (i64.const 0x7ffffffffffffffe))
+(assert_return (invoke "xor" (i64.const 1) (i64.const 0x8000000000000000)) (i64.const 0x8000000000000001))
+(assert_return (invoke "xor" (i64.const 1) (i64.const 0x8000000000000001)) (i64.const 0x8000000000000000))
+(assert_return (invoke "xor" (i64.const 1) (i64.const 0x4000000000000000)) (i64.const 0x4000000000000001))
+(assert_return (invoke "xor" (i64.const 1) (i64.const 0x123456789abcdef)) (i64.const 0x123456789abcdee))
+(assert_return (invoke "xor" (i64.const 1) (i64.const 0xfedcba9876543210)) (i64.const 0xfedcba9876543211))
+(assert_return (invoke "xor" (i64.const 1) (i64.const 63)) (i64.const 62))
+(assert_return (invoke "xor" (i64.const 1) (i64.const 64)) (i64.const 65))
+(assert_return (invoke "xor" (i64.const 1) (i64.const 65)) (i64.const 64))
+(assert_return (invoke "xor" (i64.const 1) (i64.const 0xfffffffffff5a5a)) (i64.const 0xfffffffffff5a5b))
+(assert_return (invoke "xor" (i64.const -1) (i64.const 0)) (i64.const -1))
+(assert_return (invoke "xor" (i64.const -1) (i64.const 1)) (i64.const -2))
+(assert_return (invoke "xor" (i64.const -1) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "xor" (i64.const -1) (i64.const 2)) (i64.const -3))
+(assert_return (invoke "xor" (i64.const -1) (i64.const 3)) (i64.const -4))
+(assert_return (invoke "xor" (i64.const -1) (i64.const 0x7fffffffffffffff)) (i64.const 0x8000000000000000))
+(assert_return (invoke "xor" (i64.const -1) (i64.const 0x8000000000000000)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "xor" (i64.const -1) (i64.const 0x8000000000000001)) (i64.const 0x7ffffffffffffffe))
+(assert_return (invoke "xor" (i64.const -1) (i64.const 0x4000000000000000)) (i64.const 0xbfffffffffffffff))
+(assert_return (invoke "xor" (i64.const -1) (i64.const 0x123456789abcdef)) (i64.const 0xfedcba9876543210))
+(assert_return (invoke "xor" (i64.const -1) (i64.const 0xfedcba9876543210)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "xor" (i64.const -1) (i64.const 63)) (i64.const -64))
+(assert_return (invoke "xor" (i64.const -1) (i64.const 64)) (i64.const -65))
+(assert_return (invoke "xor" (i64.const -1) (i64.const 65)) (i64.const -66))
+(assert_return (invoke "xor" (i64.const -1) (i64.const 0xfffffffffff5a5a)) (i64.const 0xf00000000000a5a5))
+(assert_return (invoke "xor" (i64.const 2) (i64.const 0)) (i64.const 2))
+(assert_return (invoke "xor" (i64.const 2) (i64.const 1)) (i64.const 3))
+(assert_return (invoke "xor" (i64.const 2) (i64.const -1)) (i64.const -3))
+(assert_return (invoke "xor" (i64.const 2) (i64.const 2)) (i64.const 0))
+(assert_return (invoke "xor" (i64.const 2) (i64.const 3)) (i64.const 1))
+(assert_return (invoke "xor" (i64.const 2) (i64.const 0x7fffffffffffffff)) (i64.const 0x7ffffffffffffffd))
+(assert_return (invoke "xor" (i64.const 2) (i64.const 0x8000000000000000)) (i64.const 0x8000000000000002))
+(assert_return (invoke "xor" (i64.const 2) (i64.const 0x8000000000000001)) (i64.const 0x8000000000000003))
+(assert_return (invoke "xor" (i64.const 2) (i64.const 0x4000000000000000)) (i64.const 0x4000000000000002))
+(assert_return (invoke "xor" (i64.const 2) (i64.const 0x123456789abcdef)) (i64.const 0x123456789abcded))
+(assert_return (invoke "xor" (i64.const 2) (i64.const 0xfedcba9876543210)) (i64.const 0xfedcba9876543212))
+(assert_return (invoke "xor" (i64.const 2) (i64.const 63)) (i64.const 61))
+(assert_return (invoke "xor" (i64.const 2) (i64.const 64)) (i64.const 66))
+(assert_return (invoke "xor" (i64.const 2) (i64.const 65)) (i64.const 67))
+(assert_return (invoke "xor" (i64.const 2) (i64.const 0xfffffffffff5a5a)) (i64.const 0xfffffffffff5a58))
+(assert_return (invoke "xor" (i64.const 3) (i64.const 0)) (i64.const 3))
+(assert_return (invoke "xor" (i64.const 3) (i64.const 1)) (i64.const 2))
+(assert_return (invoke "xor" (i64.const 3) (i64.const -1)) (i64.const -4))
+(assert_return (invoke "xor" (i64.const 3) (i64.const 2)) (i64.const 1))
+(assert_return (invoke "xor" (i64.const 3) (i64.const 3)) (i64.const 0))
+(assert_return (invoke "xor" (i64.const 3) (i64.const 0x7fffffffffffffff)) (i64.const 0x7ffffffffffffffc))
+(assert_return (invoke "xor" (i64.const 3) (i64.const 0x8000000000000000)) (i64.const 0x8000000000000003))
+(assert_return (invoke "xor" (i64.const 3) (i64.const 0x8000000000000001)) (i64.const 0x8000000000000002))
+(assert_return (invoke "xor" (i64.const 3) (i64.const 0x4000000000000000)) (i64.const 0x4000000000000003))
+(assert_return (invoke "xor" (i64.const 3) (i64.const 0x123456789abcdef)) (i64.const 0x123456789abcdec))
+(assert_return (invoke "xor" (i64.const 3) (i64.const 0xfedcba9876543210)) (i64.const 0xfedcba9876543213))
+(assert_return (invoke "xor" (i64.const 3) (i64.const 63)) (i64.const 60))
+(assert_return (invoke "xor" (i64.const 3) (i64.const 64)) (i64.const 67))
+(assert_return (invoke "xor" (i64.const 3) (i64.const 65)) (i64.const 66))
+(assert_return (invoke "xor" (i64.const 3) (i64.const 0xfffffffffff5a5a)) (i64.const 0xfffffffffff5a59))
+(assert_return (invoke "xor" (i64.const 0x7fffffffffffffff) (i64.const 0)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "xor" (i64.const 0x7fffffffffffffff) (i64.const 1)) (i64.const 0x7ffffffffffffffe))
+(assert_return (invoke "xor" (i64.const 0x7fffffffffffffff) (i64.const -1)) (i64.const 0x8000000000000000))
+(assert_return (invoke "xor" (i64.const 0x7fffffffffffffff) (i64.const 2)) (i64.const 0x7ffffffffffffffd))
+(assert_return (invoke "xor" (i64.const 0x7fffffffffffffff) (i64.const 3)) (i64.const 0x7ffffffffffffffc))
+(assert_return (invoke "xor" (i64.const 0x7fffffffffffffff) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "xor" (i64.const 0x7fffffffffffffff) (i64.const 0x8000000000000000)) (i64.const -1))
+(assert_return (invoke "xor" (i64.const 0x7fffffffffffffff) (i64.const 0x8000000000000001)) (i64.const -2))
+(assert_return (invoke "xor" (i64.const 0x7fffffffffffffff) (i64.const 0x4000000000000000)) (i64.const 0x3fffffffffffffff))
+(assert_return (invoke "xor" (i64.const 0x7fffffffffffffff) (i64.const 0x123456789abcdef)) (i64.const 0x7edcba9876543210))
+(assert_return (invoke "xor" (i64.const 0x7fffffffffffffff) (i64.const 0xfedcba9876543210)) (i64.const 0x8123456789abcdef))
+(assert_return (invoke "xor" (i64.const 0x7fffffffffffffff) (i64.const 63)) (i64.const 0x7fffffffffffffc0))
+(assert_return (invoke "xor" (i64.const 0x7fffffffffffffff) (i64.const 64)) (i64.const 0x7fffffffffffffbf))
+(assert_return (invoke "xor" (i64.const 0x7fffffffffffffff) (i64.const 65)) (i64.const 0x7fffffffffffffbe))
+(assert_return (invoke "xor" (i64.const 0x7fffffffffffffff) (i64.const 0xfffffffffff5a5a)) (i64.const 0x700000000000a5a5))
+(assert_return (invoke "xor" (i64.const 0x8000000000000000) (i64.const 0)) (i64.const 0x8000000000000000))
+(assert_return (invoke "xor" (i64.const 0x8000000000000000) (i64.const 1)) (i64.const 0x8000000000000001))
+(assert_return (invoke "xor" (i64.const 0x8000000000000000) (i64.const -1)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "xor" (i64.const 0x8000000000000000) (i64.const 2)) (i64.const 0x8000000000000002))
+(assert_return (invoke "xor" (i64.const 0x8000000000000000) (i64.const 3)) (i64.const 0x8000000000000003))
+(assert_return (invoke "xor" (i64.const 0x8000000000000000) (i64.const 0x7fffffffffffffff)) (i64.const -1))
+(assert_return (invoke "xor" (i64.const 0x8000000000000000) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "xor" (i64.const 0x8000000000000000) (i64.const 0x8000000000000001)) (i64.const 1))
+(assert_return (invoke "xor" (i64.const 0x8000000000000000) (i64.const 0x4000000000000000)) (i64.const 0xc000000000000000))
+(assert_return (invoke "xor" (i64.const 0x8000000000000000) (i64.const 0x123456789abcdef)) (i64.const 0x8123456789abcdef))
+(assert_return (invoke "xor" (i64.const 0x8000000000000000) (i64.const 0xfedcba9876543210)) (i64.const 0x7edcba9876543210))
+(assert_return (invoke "xor" (i64.const 0x8000000000000000) (i64.const 63)) (i64.const 0x800000000000003f))
+(assert_return (invoke "xor" (i64.const 0x8000000000000000) (i64.const 64)) (i64.const 0x8000000000000040))
+(assert_return (invoke "xor" (i64.const 0x8000000000000000) (i64.const 65)) (i64.const 0x8000000000000041))
+(assert_return (invoke "xor" (i64.const 0x8000000000000000) (i64.const 0xfffffffffff5a5a)) (i64.const 0x8fffffffffff5a5a))
+(assert_return (invoke "xor" (i64.const 0x8000000000000001) (i64.const 0)) (i64.const 0x8000000000000001))
+(assert_return (invoke "xor" (i64.const 0x8000000000000001) (i64.const 1)) (i64.const 0x8000000000000000))
+(assert_return (invoke "xor" (i64.const 0x8000000000000001) (i64.const -1)) (i64.const 0x7ffffffffffffffe))
+(assert_return (invoke "xor" (i64.const 0x8000000000000001) (i64.const 2)) (i64.const 0x8000000000000003))
+(assert_return (invoke "xor" (i64.const 0x8000000000000001) (i64.const 3)) (i64.const 0x8000000000000002))
+(assert_return (invoke "xor" (i64.const 0x8000000000000001) (i64.const 0x7fffffffffffffff)) (i64.const -2))
+(assert_return (invoke "xor" (i64.const 0x8000000000000001) (i64.const 0x8000000000000000)) (i64.const 1))
+(assert_return (invoke "xor" (i64.const 0x8000000000000001) (i64.const 0x8000000000000001)) (i64.const 0))
+(assert_return (invoke "xor" (i64.const 0x8000000000000001) (i64.const 0x4000000000000000)) (i64.const 0xc000000000000001))
+(assert_return (invoke "xor" (i64.const 0x8000000000000001) (i64.const 0x123456789abcdef)) (i64.const 0x8123456789abcdee))
+(assert_return (invoke "xor" (i64.const 0x8000000000000001) (i64.const 0xfedcba9876543210)) (i64.const 0x7edcba9876543211))
+(assert_return (invoke "xor" (i64.const 0x8000000000000001) (i64.const 63)) (i64.const 0x800000000000003e))
+(assert_return (invoke "xor" (i64.const 0x8000000000000001) (i64.const 64)) (i64.const 0x8000000000000041))
+(assert_return (invoke "xor" (i64.const 0x8000000000000001) (i64.const 65)) (i64.const 0x8000000000000040))
+(assert_return (invoke "xor" (i64.const 0x8000000000000001) (i64.const 0xfffffffffff5a5a)) (i64.const 0x8fffffffffff5a5b))
+(assert_return (invoke "xor" (i64.const 0x4000000000000000) (i64.const 0)) (i64.const 0x4000000000000000))
+(assert_return (invoke "xor" (i64.const 0x4000000000000000) (i64.const 1)) (i64.const 0x4000000000000001))
+(assert_return (invoke "xor" (i64.const 0x4000000000000000) (i64.const -1)) (i64.const 0xbfffffffffffffff))
+(assert_return (invoke "xor" (i64.const 0x4000000000000000) (i64.const 2)) (i64.const 0x4000000000000002))
+(assert_return (invoke "xor" (i64.const 0x4000000000000000) (i64.const 3)) (i64.const 0x4000000000000003))
+(assert_return (invoke "xor" (i64.const 0x4000000000000000) (i64.const 0x7fffffffffffffff)) (i64.const 0x3fffffffffffffff))
+(assert_return (invoke "xor" (i64.const 0x4000000000000000) (i64.const 0x8000000000000000)) (i64.const 0xc000000000000000))
+(assert_return (invoke "xor" (i64.const 0x4000000000000000) (i64.const 0x8000000000000001)) (i64.const 0xc000000000000001))
+(assert_return (invoke "xor" (i64.const 0x4000000000000000) (i64.const 0x4000000000000000)) (i64.const 0))
+(assert_return (invoke "xor" (i64.const 0x4000000000000000) (i64.const 0x123456789abcdef)) (i64.const 0x4123456789abcdef))
+(assert_return (invoke "xor" (i64.const 0x4000000000000000) (i64.const 0xfedcba9876543210)) (i64.const 0xbedcba9876543210))
+(assert_return (invoke "xor" (i64.const 0x4000000000000000) (i64.const 63)) (i64.const 0x400000000000003f))
+(assert_return (invoke "xor" (i64.const 0x4000000000000000) (i64.const 64)) (i64.const 0x4000000000000040))
+(assert_return (invoke "xor" (i64.const 0x4000000000000000) (i64.const 65)) (i64.const 0x4000000000000041))
+(assert_return (invoke "xor" (i64.const 0x4000000000000000) (i64.const 0xfffffffffff5a5a)) (i64.const 0x4fffffffffff5a5a))
+(assert_return (invoke "xor" (i64.const 0x123456789abcdef) (i64.const 0)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "xor" (i64.const 0x123456789abcdef) (i64.const 1)) (i64.const 0x123456789abcdee))
+(assert_return (invoke "xor" (i64.const 0x123456789abcdef) (i64.const -1)) (i64.const 0xfedcba9876543210))
+(assert_return (invoke "xor" (i64.const 0x123456789abcdef) (i64.const 2)) (i64.const 0x123456789abcded))
+(assert_return (invoke "xor" (i64.const 0x123456789abcdef) (i64.const 3)) (i64.const 0x123456789abcdec))
+(assert_return (invoke "xor" (i64.const 0x123456789abcdef) (i64.const 0x7fffffffffffffff)) (i64.const 0x7edcba9876543210))
+(assert_return (invoke "xor" (i64.const 0x123456789abcdef) (i64.const 0x8000000000000000)) (i64.const 0x8123456789abcdef))
+(assert_return (invoke "xor" (i64.const 0x123456789abcdef) (i64.const 0x8000000000000001)) (i64.const 0x8123456789abcdee))
+(assert_return (invoke "xor" (i64.const 0x123456789abcdef) (i64.const 0x4000000000000000)) (i64.const 0x4123456789abcdef))
+(assert_return (invoke "xor" (i64.const 0x123456789abcdef) (i64.const 0x123456789abcdef)) (i64.const 0))
+(assert_return (invoke "xor" (i64.const 0x123456789abcdef) (i64.const 0xfedcba9876543210)) (i64.const -1))
+(assert_return (invoke "xor" (i64.const 0x123456789abcdef) (i64.const 63)) (i64.const 0x123456789abcdd0))
+(assert_return (invoke "xor" (i64.const 0x123456789abcdef) (i64.const 64)) (i64.const 0x123456789abcdaf))
+(assert_return (invoke "xor" (i64.const 0x123456789abcdef) (i64.const 65)) (i64.const 0x123456789abcdae))
+(assert_return (invoke "xor" (i64.const 0x123456789abcdef) (i64.const 0xfffffffffff5a5a)) (i64.const 0xedcba98765497b5))
+(assert_return (invoke "xor" (i64.const 0xfedcba9876543210) (i64.const 0)) (i64.const 0xfedcba9876543210))
+(assert_return (invoke "xor" (i64.const 0xfedcba9876543210) (i64.const 1)) (i64.const 0xfedcba9876543211))
+(assert_return (invoke "xor" (i64.const 0xfedcba9876543210) (i64.const -1)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "xor" (i64.const 0xfedcba9876543210) (i64.const 2)) (i64.const 0xfedcba9876543212))
+(assert_return (invoke "xor" (i64.const 0xfedcba9876543210) (i64.const 3)) (i64.const 0xfedcba9876543213))
+(assert_return (invoke "xor" (i64.const 0xfedcba9876543210) (i64.const 0x7fffffffffffffff)) (i64.const 0x8123456789abcdef))
+(assert_return (invoke "xor" (i64.const 0xfedcba9876543210) (i64.const 0x8000000000000000)) (i64.const 0x7edcba9876543210))
+(assert_return (invoke "xor" (i64.const 0xfedcba9876543210) (i64.const 0x8000000000000001)) (i64.const 0x7edcba9876543211))
+(assert_return (invoke "xor" (i64.const 0xfedcba9876543210) (i64.const 0x4000000000000000)) (i64.const 0xbedcba9876543210))
+(assert_return (invoke "xor" (i64.const 0xfedcba9876543210) (i64.const 0x123456789abcdef)) (i64.const -1))
+(assert_return (invoke "xor" (i64.const 0xfedcba9876543210) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "xor" (i64.const 0xfedcba9876543210) (i64.const 63)) (i64.const 0xfedcba987654322f))
+(assert_return (invoke "xor" (i64.const 0xfedcba9876543210) (i64.const 64)) (i64.const 0xfedcba9876543250))
+(assert_return (invoke "xor" (i64.const 0xfedcba9876543210) (i64.const 65)) (i64.const 0xfedcba9876543251))
+(assert_return (invoke "xor" (i64.const 0xfedcba9876543210) (i64.const 0xfffffffffff5a5a)) (i64.const 0xf123456789ab684a))
+(assert_return (invoke "xor" (i64.const 63) (i64.const 0)) (i64.const 63))
+(assert_return (invoke "xor" (i64.const 63) (i64.const 1)) (i64.const 62))
+(assert_return (invoke "xor" (i64.const 63) (i64.const -1)) (i64.const -64))
+(assert_return (invoke "xor" (i64.const 63) (i64.const 2)) (i64.const 61))
+(assert_return (invoke "xor" (i64.const 63) (i64.const 3)) (i64.const 60))
+(assert_return (invoke "xor" (i64.const 63) (i64.const 0x7fffffffffffffff)) (i64.const 0x7fffffffffffffc0))
+(assert_return (invoke "xor" (i64.const 63) (i64.const 0x8000000000000000)) (i64.const 0x800000000000003f))
+(assert_return (invoke "xor" (i64.const 63) (i64.const 0x8000000000000001)) (i64.const 0x800000000000003e))
+(assert_return (invoke "xor" (i64.const 63) (i64.const 0x4000000000000000)) (i64.const 0x400000000000003f))
+(assert_return (invoke "xor" (i64.const 63) (i64.const 0x123456789abcdef)) (i64.const 0x123456789abcdd0))
+(assert_return (invoke "xor" (i64.const 63) (i64.const 0xfedcba9876543210)) (i64.const 0xfedcba987654322f))
+(assert_return (invoke "xor" (i64.const 63) (i64.const 63)) (i64.const 0))
+(assert_return (invoke "xor" (i64.const 63) (i64.const 64)) (i64.const 127))
+(assert_return (invoke "xor" (i64.const 63) (i64.const 65)) (i64.const 126))
+(assert_return (invoke "xor" (i64.const 63) (i64.const 0xfffffffffff5a5a)) (i64.const 0xfffffffffff5a65))
+(assert_return (invoke "xor" (i64.const 64) (i64.const 0)) (i64.const 64))
+(assert_return (invoke "xor" (i64.const 64) (i64.const 1)) (i64.const 65))
+(assert_return (invoke "xor" (i64.const 64) (i64.const -1)) (i64.const -65))
+(assert_return (invoke "xor" (i64.const 64) (i64.const 2)) (i64.const 66))
+(assert_return (invoke "xor" (i64.const 64) (i64.const 3)) (i64.const 67))
+(assert_return (invoke "xor" (i64.const 64) (i64.const 0x7fffffffffffffff)) (i64.const 0x7fffffffffffffbf))
+(assert_return (invoke "xor" (i64.const 64) (i64.const 0x8000000000000000)) (i64.const 0x8000000000000040))
+(assert_return (invoke "xor" (i64.const 64) (i64.const 0x8000000000000001)) (i64.const 0x8000000000000041))
+(assert_return (invoke "xor" (i64.const 64) (i64.const 0x4000000000000000)) (i64.const 0x4000000000000040))
+(assert_return (invoke "xor" (i64.const 64) (i64.const 0x123456789abcdef)) (i64.const 0x123456789abcdaf))
+(assert_return (invoke "xor" (i64.const 64) (i64.const 0xfedcba9876543210)) (i64.const 0xfedcba9876543250))
+(assert_return (invoke "xor" (i64.const 64) (i64.const 63)) (i64.const 127))
+(assert_return (invoke "xor" (i64.const 64) (i64.const 64)) (i64.const 0))
+(assert_return (invoke "xor" (i64.const 64) (i64.const 65)) (i64.const 1))
+(assert_return (invoke "xor" (i64.const 64) (i64.const 0xfffffffffff5a5a)) (i64.const 0xfffffffffff5a1a))
+(assert_return (invoke "xor" (i64.const 65) (i64.const 0)) (i64.const 65))
+(assert_return (invoke "xor" (i64.const 65) (i64.const 1)) (i64.const 64))
+(assert_return (invoke "xor" (i64.const 65) (i64.const -1)) (i64.const -66))
+(assert_return (invoke "xor" (i64.const 65) (i64.const 2)) (i64.const 67))
+(assert_return (invoke "xor" (i64.const 65) (i64.const 3)) (i64.const 66))
+(assert_return (invoke "xor" (i64.const 65) (i64.const 0x7fffffffffffffff)) (i64.const 0x7fffffffffffffbe))
+(assert_return (invoke "xor" (i64.const 65) (i64.const 0x8000000000000000)) (i64.const 0x8000000000000041))
+(assert_return (invoke "xor" (i64.const 65) (i64.const 0x8000000000000001)) (i64.const 0x8000000000000040))
+(assert_return (invoke "xor" (i64.const 65) (i64.const 0x4000000000000000)) (i64.const 0x4000000000000041))
+(assert_return (invoke "xor" (i64.const 65) (i64.const 0x123456789abcdef)) (i64.const 0x123456789abcdae))
+(assert_return (invoke "xor" (i64.const 65) (i64.const 0xfedcba9876543210)) (i64.const 0xfedcba9876543251))
+(assert_return (invoke "xor" (i64.const 65) (i64.const 63)) (i64.const 126))
+(assert_return (invoke "xor" (i64.const 65) (i64.const 64)) (i64.const 1))
+(assert_return (invoke "xor" (i64.const 65) (i64.const 65)) (i64.const 0))
+(assert_return (invoke "xor" (i64.const 65) (i64.const 0xfffffffffff5a5a)) (i64.const 0xfffffffffff5a1b))
+(assert_return (invoke "xor" (i64.const 0xfffffffffff5a5a) (i64.const 0)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "xor" (i64.const 0xfffffffffff5a5a) (i64.const 1)) (i64.const 0xfffffffffff5a5b))
+(assert_return (invoke "xor" (i64.const 0xfffffffffff5a5a) (i64.const -1)) (i64.const 0xf00000000000a5a5))
+(assert_return (invoke "xor" (i64.const 0xfffffffffff5a5a) (i64.const 2)) (i64.const 0xfffffffffff5a58))
+(assert_return (invoke "xor" (i64.const 0xfffffffffff5a5a) (i64.const 3)) (i64.const 0xfffffffffff5a59))
+(assert_return (invoke "xor" (i64.const 0xfffffffffff5a5a) (i64.const 0x7fffffffffffffff)) (i64.const 0x700000000000a5a5))
+(assert_return (invoke "xor" (i64.const 0xfffffffffff5a5a) (i64.const 0x8000000000000000)) (i64.const 0x8fffffffffff5a5a))
+(assert_return (invoke "xor" (i64.const 0xfffffffffff5a5a) (i64.const 0x8000000000000001)) (i64.const 0x8fffffffffff5a5b))
+(assert_return (invoke "xor" (i64.const 0xfffffffffff5a5a) (i64.const 0x4000000000000000)) (i64.const 0x4fffffffffff5a5a))
+(assert_return (invoke "xor" (i64.const 0xfffffffffff5a5a) (i64.const 0x123456789abcdef)) (i64.const 0xedcba98765497b5))
+(assert_return (invoke "xor" (i64.const 0xfffffffffff5a5a) (i64.const 0xfedcba9876543210)) (i64.const 0xf123456789ab684a))
+(assert_return (invoke "xor" (i64.const 0xfffffffffff5a5a) (i64.const 63)) (i64.const 0xfffffffffff5a65))
+(assert_return (invoke "xor" (i64.const 0xfffffffffff5a5a) (i64.const 64)) (i64.const 0xfffffffffff5a1a))
+(assert_return (invoke "xor" (i64.const 0xfffffffffff5a5a) (i64.const 65)) (i64.const 0xfffffffffff5a1b))
+(assert_return (invoke "xor" (i64.const 0xfffffffffff5a5a) (i64.const 0xfffffffffff5a5a)) (i64.const 0))
+(assert_return (invoke "xor" (i64.const 0x1979461484e72f01) (i64.const 0x65b80dec031fdcc4)) (i64.const 0x7cc14bf887f8f3c5))
+(assert_return (invoke "xor" (i64.const 0x663f3b4b688a501b) (i64.const 0xac597b8605fe9d07)) (i64.const 0xca6640cd6d74cd1c))
+(assert_return (invoke "xor" (i64.const 0x18f617679866d450) (i64.const 0xdf61d29213e13238)) (i64.const 0xc797c5f58b87e668))
+(assert_return (invoke "xor" (i64.const 0xa161d6683b7284fc) (i64.const 0x13c46fffc7d27660)) (i64.const 0xb2a5b997fca0f29c))
+(assert_return (invoke "xor" (i64.const 0xacc5b783b4e2cee4) (i64.const 0xd46528538d3d7a8)) (i64.const 0xa183e5068c31194c))
+(assert_return (invoke "xor" (i64.const 0x39fad218077abc83) (i64.const 0x7b71c1c2f51307ef)) (i64.const 0x428b13daf269bb6c))
+(assert_return (invoke "xor" (i64.const 0xf892f3806e443d44) (i64.const 0xa4ba7960ed2e998)) (i64.const 0xf2d954166096d4dc))
+(assert_return (invoke "xor" (i64.const 0xdf26bf2c8648d173) (i64.const 0xef1519ad140a4d1)) (i64.const 0xd1d7eeb6570875a2))
+(assert_return (invoke "xor" (i64.const 0x338155f9812792a) (i64.const 0x33b5053d4a10fc4a)) (i64.const 0x308d1062d2028560))
+(assert_return (invoke "xor" (i64.const 0x3f20059b83b90112) (i64.const 0xa1329f85de30e6a)) (i64.const 0x35332c63de5a0f78))
+(assert_return (invoke "xor" (i64.const 0x48d1229bf2079bdf) (i64.const 0x52d3ad53cbd314c0)) (i64.const 0x1a028fc839d48f1f))
+(assert_return (invoke "xor" (i64.const 0xb6fbff2335415c4c) (i64.const 0xb61c73a59ca5e6e2)) (i64.const 0xe78c86a9e4baae))
+(assert_return (invoke "xor" (i64.const 0xdef382c2ce78ae5e) (i64.const 0x1e83dac2341c2f84)) (i64.const 0xc0705800fa6481da))
+(assert_return (invoke "xor" (i64.const 0xf0ee98432458246e) (i64.const 0x27d3880791bd91ae)) (i64.const 0xd73d1044b5e5b5c0))
+(assert_return (invoke "xor" (i64.const 0xb62ed676a3852e50) (i64.const 0x5e5766106c67fc14)) (i64.const 0xe879b066cfe2d244))
+(assert_return (invoke "xor" (i64.const 0xe59d46d58a6fe6c6) (i64.const 0x18592f6f992bd6a9)) (i64.const 0xfdc469ba1344306f))
+
+(assert_return (invoke "shl" (i64.const 0) (i64.const 0)) (i64.const 0))
+(assert_return (invoke "shl" (i64.const 0) (i64.const 1)) (i64.const 0))
+(assert_return (invoke "shl" (i64.const 0) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "shl" (i64.const 0) (i64.const 2)) (i64.const 0))
+(assert_return (invoke "shl" (i64.const 0) (i64.const 3)) (i64.const 0))
+(assert_return (invoke "shl" (i64.const 0) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "shl" (i64.const 0) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "shl" (i64.const 0) (i64.const 0x8000000000000001)) (i64.const 0))
+(assert_return (invoke "shl" (i64.const 0) (i64.const 0x4000000000000000)) (i64.const 0))
+(assert_return (invoke "shl" (i64.const 0) (i64.const 0x123456789abcdef)) (i64.const 0))
+(assert_return (invoke "shl" (i64.const 0) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "shl" (i64.const 0) (i64.const 63)) (i64.const 0))
+(assert_return (invoke "shl" (i64.const 0) (i64.const 64)) (i64.const 0))
+(assert_return (invoke "shl" (i64.const 0) (i64.const 65)) (i64.const 0))
+(assert_return (invoke "shl" (i64.const 0) (i64.const 0xfffffffffff5a5a)) (i64.const 0))
+(assert_return (invoke "shl" (i64.const 1) (i64.const 0)) (i64.const 1))
+(assert_return (invoke "shl" (i64.const 1) (i64.const 1)) (i64.const 2))
+(assert_return (invoke "shl" (i64.const 1) (i64.const -1)) (i64.const 0x8000000000000000))
+(assert_return (invoke "shl" (i64.const 1) (i64.const 2)) (i64.const 4))
+(assert_return (invoke "shl" (i64.const 1) (i64.const 3)) (i64.const 8))
+(assert_return (invoke "shl" (i64.const 1) (i64.const 0x7fffffffffffffff)) (i64.const 0x8000000000000000))
+(assert_return (invoke "shl" (i64.const 1) (i64.const 0x8000000000000000)) (i64.const 1))
+(assert_return (invoke "shl" (i64.const 1) (i64.const 0x8000000000000001)) (i64.const 2))
+(assert_return (invoke "shl" (i64.const 1) (i64.const 0x4000000000000000)) (i64.const 1))
+(assert_return (invoke "shl" (i64.const 1) (i64.const 0x123456789abcdef)) (i64.const 0x800000000000))
+(assert_return (invoke "shl" (i64.const 1) (i64.const 0xfedcba9876543210)) (i64.const 0x10000))
+(assert_return (invoke "shl" (i64.const 1) (i64.const 63)) (i64.const 0x8000000000000000))
+(assert_return (invoke "shl" (i64.const 1) (i64.const 64)) (i64.const 1))
+(assert_return (invoke "shl" (i64.const 1) (i64.const 65)) (i64.const 2))
+(assert_return (invoke "shl" (i64.const 1) (i64.const 0xfffffffffff5a5a)) (i64.const 0x4000000))
+(assert_return (invoke "shl" (i64.const -1) (i64.const 0)) (i64.const -1))
+(assert_return (invoke "shl" (i64.const -1) (i64.const 1)) (i64.const -2))
+(assert_return (invoke "shl" (i64.const -1) (i64.const -1)) (i64.const 0x8000000000000000))
+(assert_return (invoke "shl" (i64.const -1) (i64.const 2)) (i64.const -4))
+(assert_return (invoke "shl" (i64.const -1) (i64.const 3)) (i64.const -8))
+(assert_return (invoke "shl" (i64.const -1) (i64.const 0x7fffffffffffffff)) (i64.const 0x8000000000000000))
+(assert_return (invoke "shl" (i64.const -1) (i64.const 0x8000000000000000)) (i64.const -1))
+(assert_return (invoke "shl" (i64.const -1) (i64.const 0x8000000000000001)) (i64.const -2))
+(assert_return (invoke "shl" (i64.const -1) (i64.const 0x4000000000000000)) (i64.const -1))
+(assert_return (invoke "shl" (i64.const -1) (i64.const 0x123456789abcdef)) (i64.const 0xffff800000000000))
+(assert_return (invoke "shl" (i64.const -1) (i64.const 0xfedcba9876543210)) (i64.const 0xffffffffffff0000))
+(assert_return (invoke "shl" (i64.const -1) (i64.const 63)) (i64.const 0x8000000000000000))
+(assert_return (invoke "shl" (i64.const -1) (i64.const 64)) (i64.const -1))
+(assert_return (invoke "shl" (i64.const -1) (i64.const 65)) (i64.const -2))
+(assert_return (invoke "shl" (i64.const -1) (i64.const 0xfffffffffff5a5a)) (i64.const 0xfffffffffc000000))
+(assert_return (invoke "shl" (i64.const 2) (i64.const 0)) (i64.const 2))
+(assert_return (invoke "shl" (i64.const 2) (i64.const 1)) (i64.const 4))
+(assert_return (invoke "shl" (i64.const 2) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "shl" (i64.const 2) (i64.const 2)) (i64.const 8))
+(assert_return (invoke "shl" (i64.const 2) (i64.const 3)) (i64.const 16))
+(assert_return (invoke "shl" (i64.const 2) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "shl" (i64.const 2) (i64.const 0x8000000000000000)) (i64.const 2))
+(assert_return (invoke "shl" (i64.const 2) (i64.const 0x8000000000000001)) (i64.const 4))
+(assert_return (invoke "shl" (i64.const 2) (i64.const 0x4000000000000000)) (i64.const 2))
+(assert_return (invoke "shl" (i64.const 2) (i64.const 0x123456789abcdef)) (i64.const 0x1000000000000))
+(assert_return (invoke "shl" (i64.const 2) (i64.const 0xfedcba9876543210)) (i64.const 0x20000))
+(assert_return (invoke "shl" (i64.const 2) (i64.const 63)) (i64.const 0))
+(assert_return (invoke "shl" (i64.const 2) (i64.const 64)) (i64.const 2))
+(assert_return (invoke "shl" (i64.const 2) (i64.const 65)) (i64.const 4))
+(assert_return (invoke "shl" (i64.const 2) (i64.const 0xfffffffffff5a5a)) (i64.const 0x8000000))
+(assert_return (invoke "shl" (i64.const 3) (i64.const 0)) (i64.const 3))
+(assert_return (invoke "shl" (i64.const 3) (i64.const 1)) (i64.const 6))
+(assert_return (invoke "shl" (i64.const 3) (i64.const -1)) (i64.const 0x8000000000000000))
+(assert_return (invoke "shl" (i64.const 3) (i64.const 2)) (i64.const 12))
+(assert_return (invoke "shl" (i64.const 3) (i64.const 3)) (i64.const 24))
+(assert_return (invoke "shl" (i64.const 3) (i64.const 0x7fffffffffffffff)) (i64.const 0x8000000000000000))
+(assert_return (invoke "shl" (i64.const 3) (i64.const 0x8000000000000000)) (i64.const 3))
+(assert_return (invoke "shl" (i64.const 3) (i64.const 0x8000000000000001)) (i64.const 6))
+(assert_return (invoke "shl" (i64.const 3) (i64.const 0x4000000000000000)) (i64.const 3))
+(assert_return (invoke "shl" (i64.const 3) (i64.const 0x123456789abcdef)) (i64.const 0x1800000000000))
+(assert_return (invoke "shl" (i64.const 3) (i64.const 0xfedcba9876543210)) (i64.const 0x30000))
+(assert_return (invoke "shl" (i64.const 3) (i64.const 63)) (i64.const 0x8000000000000000))
+(assert_return (invoke "shl" (i64.const 3) (i64.const 64)) (i64.const 3))
+(assert_return (invoke "shl" (i64.const 3) (i64.const 65)) (i64.const 6))
+(assert_return (invoke "shl" (i64.const 3) (i64.const 0xfffffffffff5a5a)) (i64.const 0xc000000))
+(assert_return (invoke "shl" (i64.const 0x7fffffffffffffff) (i64.const 0)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "shl" (i64.const 0x7fffffffffffffff) (i64.const 1)) (i64.const -2))
+(assert_return (invoke "shl" (i64.const 0x7fffffffffffffff) (i64.const -1)) (i64.const 0x8000000000000000))
+(assert_return (invoke "shl" (i64.const 0x7fffffffffffffff) (i64.const 2)) (i64.const -4))
+(assert_return (invoke "shl" (i64.const 0x7fffffffffffffff) (i64.const 3)) (i64.const -8))
+(assert_return (invoke "shl" (i64.const 0x7fffffffffffffff) (i64.const 0x7fffffffffffffff)) (i64.const 0x8000000000000000))
+(assert_return (invoke "shl" (i64.const 0x7fffffffffffffff) (i64.const 0x8000000000000000)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "shl" (i64.const 0x7fffffffffffffff) (i64.const 0x8000000000000001)) (i64.const -2))
+(assert_return (invoke "shl" (i64.const 0x7fffffffffffffff) (i64.const 0x4000000000000000)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "shl" (i64.const 0x7fffffffffffffff) (i64.const 0x123456789abcdef)) (i64.const 0xffff800000000000))
+(assert_return (invoke "shl" (i64.const 0x7fffffffffffffff) (i64.const 0xfedcba9876543210)) (i64.const 0xffffffffffff0000))
+(assert_return (invoke "shl" (i64.const 0x7fffffffffffffff) (i64.const 63)) (i64.const 0x8000000000000000))
+(assert_return (invoke "shl" (i64.const 0x7fffffffffffffff) (i64.const 64)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "shl" (i64.const 0x7fffffffffffffff) (i64.const 65)) (i64.const -2))
+(assert_return (invoke "shl" (i64.const 0x7fffffffffffffff) (i64.const 0xfffffffffff5a5a)) (i64.const 0xfffffffffc000000))
+(assert_return (invoke "shl" (i64.const 0x8000000000000000) (i64.const 0)) (i64.const 0x8000000000000000))
+(assert_return (invoke "shl" (i64.const 0x8000000000000000) (i64.const 1)) (i64.const 0))
+(assert_return (invoke "shl" (i64.const 0x8000000000000000) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "shl" (i64.const 0x8000000000000000) (i64.const 2)) (i64.const 0))
+(assert_return (invoke "shl" (i64.const 0x8000000000000000) (i64.const 3)) (i64.const 0))
+(assert_return (invoke "shl" (i64.const 0x8000000000000000) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "shl" (i64.const 0x8000000000000000) (i64.const 0x8000000000000000)) (i64.const 0x8000000000000000))
+(assert_return (invoke "shl" (i64.const 0x8000000000000000) (i64.const 0x8000000000000001)) (i64.const 0))
+(assert_return (invoke "shl" (i64.const 0x8000000000000000) (i64.const 0x4000000000000000)) (i64.const 0x8000000000000000))
+(assert_return (invoke "shl" (i64.const 0x8000000000000000) (i64.const 0x123456789abcdef)) (i64.const 0))
+(assert_return (invoke "shl" (i64.const 0x8000000000000000) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "shl" (i64.const 0x8000000000000000) (i64.const 63)) (i64.const 0))
+(assert_return (invoke "shl" (i64.const 0x8000000000000000) (i64.const 64)) (i64.const 0x8000000000000000))
+(assert_return (invoke "shl" (i64.const 0x8000000000000000) (i64.const 65)) (i64.const 0))
+(assert_return (invoke "shl" (i64.const 0x8000000000000000) (i64.const 0xfffffffffff5a5a)) (i64.const 0))
+(assert_return (invoke "shl" (i64.const 0x8000000000000001) (i64.const 0)) (i64.const 0x8000000000000001))
+(assert_return (invoke "shl" (i64.const 0x8000000000000001) (i64.const 1)) (i64.const 2))
+(assert_return (invoke "shl" (i64.const 0x8000000000000001) (i64.const -1)) (i64.const 0x8000000000000000))
+(assert_return (invoke "shl" (i64.const 0x8000000000000001) (i64.const 2)) (i64.const 4))
+(assert_return (invoke "shl" (i64.const 0x8000000000000001) (i64.const 3)) (i64.const 8))
+(assert_return (invoke "shl" (i64.const 0x8000000000000001) (i64.const 0x7fffffffffffffff)) (i64.const 0x8000000000000000))
+(assert_return (invoke "shl" (i64.const 0x8000000000000001) (i64.const 0x8000000000000000)) (i64.const 0x8000000000000001))
+(assert_return (invoke "shl" (i64.const 0x8000000000000001) (i64.const 0x8000000000000001)) (i64.const 2))
+(assert_return (invoke "shl" (i64.const 0x8000000000000001) (i64.const 0x4000000000000000)) (i64.const 0x8000000000000001))
+(assert_return (invoke "shl" (i64.const 0x8000000000000001) (i64.const 0x123456789abcdef)) (i64.const 0x800000000000))
+(assert_return (invoke "shl" (i64.const 0x8000000000000001) (i64.const 0xfedcba9876543210)) (i64.const 0x10000))
+(assert_return (invoke "shl" (i64.const 0x8000000000000001) (i64.const 63)) (i64.const 0x8000000000000000))
+(assert_return (invoke "shl" (i64.const 0x8000000000000001) (i64.const 64)) (i64.const 0x8000000000000001))
+(assert_return (invoke "shl" (i64.const 0x8000000000000001) (i64.const 65)) (i64.const 2))
+(assert_return (invoke "shl" (i64.const 0x8000000000000001) (i64.const 0xfffffffffff5a5a)) (i64.const 0x4000000))
+(assert_return (invoke "shl" (i64.const 0x4000000000000000) (i64.const 0)) (i64.const 0x4000000000000000))
+(assert_return (invoke "shl" (i64.const 0x4000000000000000) (i64.const 1)) (i64.const 0x8000000000000000))
+(assert_return (invoke "shl" (i64.const 0x4000000000000000) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "shl" (i64.const 0x4000000000000000) (i64.const 2)) (i64.const 0))
+(assert_return (invoke "shl" (i64.const 0x4000000000000000) (i64.const 3)) (i64.const 0))
+(assert_return (invoke "shl" (i64.const 0x4000000000000000) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "shl" (i64.const 0x4000000000000000) (i64.const 0x8000000000000000)) (i64.const 0x4000000000000000))
+(assert_return (invoke "shl" (i64.const 0x4000000000000000) (i64.const 0x8000000000000001)) (i64.const 0x8000000000000000))
+(assert_return (invoke "shl" (i64.const 0x4000000000000000) (i64.const 0x4000000000000000)) (i64.const 0x4000000000000000))
+(assert_return (invoke "shl" (i64.const 0x4000000000000000) (i64.const 0x123456789abcdef)) (i64.const 0))
+(assert_return (invoke "shl" (i64.const 0x4000000000000000) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "shl" (i64.const 0x4000000000000000) (i64.const 63)) (i64.const 0))
+(assert_return (invoke "shl" (i64.const 0x4000000000000000) (i64.const 64)) (i64.const 0x4000000000000000))
+(assert_return (invoke "shl" (i64.const 0x4000000000000000) (i64.const 65)) (i64.const 0x8000000000000000))
+(assert_return (invoke "shl" (i64.const 0x4000000000000000) (i64.const 0xfffffffffff5a5a)) (i64.const 0))
+(assert_return (invoke "shl" (i64.const 0x123456789abcdef) (i64.const 0)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "shl" (i64.const 0x123456789abcdef) (i64.const 1)) (i64.const 0x2468acf13579bde))
+(assert_return (invoke "shl" (i64.const 0x123456789abcdef) (i64.const -1)) (i64.const 0x8000000000000000))
+(assert_return (invoke "shl" (i64.const 0x123456789abcdef) (i64.const 2)) (i64.const 0x48d159e26af37bc))
+(assert_return (invoke "shl" (i64.const 0x123456789abcdef) (i64.const 3)) (i64.const 0x91a2b3c4d5e6f78))
+(assert_return (invoke "shl" (i64.const 0x123456789abcdef) (i64.const 0x7fffffffffffffff)) (i64.const 0x8000000000000000))
+(assert_return (invoke "shl" (i64.const 0x123456789abcdef) (i64.const 0x8000000000000000)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "shl" (i64.const 0x123456789abcdef) (i64.const 0x8000000000000001)) (i64.const 0x2468acf13579bde))
+(assert_return (invoke "shl" (i64.const 0x123456789abcdef) (i64.const 0x4000000000000000)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "shl" (i64.const 0x123456789abcdef) (i64.const 0x123456789abcdef)) (i64.const 0xe6f7800000000000))
+(assert_return (invoke "shl" (i64.const 0x123456789abcdef) (i64.const 0xfedcba9876543210)) (i64.const 0x456789abcdef0000))
+(assert_return (invoke "shl" (i64.const 0x123456789abcdef) (i64.const 63)) (i64.const 0x8000000000000000))
+(assert_return (invoke "shl" (i64.const 0x123456789abcdef) (i64.const 64)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "shl" (i64.const 0x123456789abcdef) (i64.const 65)) (i64.const 0x2468acf13579bde))
+(assert_return (invoke "shl" (i64.const 0x123456789abcdef) (i64.const 0xfffffffffff5a5a)) (i64.const 0x9e26af37bc000000))
+(assert_return (invoke "shl" (i64.const 0xfedcba9876543210) (i64.const 0)) (i64.const 0xfedcba9876543210))
+(assert_return (invoke "shl" (i64.const 0xfedcba9876543210) (i64.const 1)) (i64.const 0xfdb97530eca86420))
+(assert_return (invoke "shl" (i64.const 0xfedcba9876543210) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "shl" (i64.const 0xfedcba9876543210) (i64.const 2)) (i64.const 0xfb72ea61d950c840))
+(assert_return (invoke "shl" (i64.const 0xfedcba9876543210) (i64.const 3)) (i64.const 0xf6e5d4c3b2a19080))
+(assert_return (invoke "shl" (i64.const 0xfedcba9876543210) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "shl" (i64.const 0xfedcba9876543210) (i64.const 0x8000000000000000)) (i64.const 0xfedcba9876543210))
+(assert_return (invoke "shl" (i64.const 0xfedcba9876543210) (i64.const 0x8000000000000001)) (i64.const 0xfdb97530eca86420))
+(assert_return (invoke "shl" (i64.const 0xfedcba9876543210) (i64.const 0x4000000000000000)) (i64.const 0xfedcba9876543210))
+(assert_return (invoke "shl" (i64.const 0xfedcba9876543210) (i64.const 0x123456789abcdef)) (i64.const 0x1908000000000000))
+(assert_return (invoke "shl" (i64.const 0xfedcba9876543210) (i64.const 0xfedcba9876543210)) (i64.const 0xba98765432100000))
+(assert_return (invoke "shl" (i64.const 0xfedcba9876543210) (i64.const 63)) (i64.const 0))
+(assert_return (invoke "shl" (i64.const 0xfedcba9876543210) (i64.const 64)) (i64.const 0xfedcba9876543210))
+(assert_return (invoke "shl" (i64.const 0xfedcba9876543210) (i64.const 65)) (i64.const 0xfdb97530eca86420))
+(assert_return (invoke "shl" (i64.const 0xfedcba9876543210) (i64.const 0xfffffffffff5a5a)) (i64.const 0x61d950c840000000))
+(assert_return (invoke "shl" (i64.const 63) (i64.const 0)) (i64.const 63))
+(assert_return (invoke "shl" (i64.const 63) (i64.const 1)) (i64.const 126))
+(assert_return (invoke "shl" (i64.const 63) (i64.const -1)) (i64.const 0x8000000000000000))
+(assert_return (invoke "shl" (i64.const 63) (i64.const 2)) (i64.const 252))
+(assert_return (invoke "shl" (i64.const 63) (i64.const 3)) (i64.const 504))
+(assert_return (invoke "shl" (i64.const 63) (i64.const 0x7fffffffffffffff)) (i64.const 0x8000000000000000))
+(assert_return (invoke "shl" (i64.const 63) (i64.const 0x8000000000000000)) (i64.const 63))
+(assert_return (invoke "shl" (i64.const 63) (i64.const 0x8000000000000001)) (i64.const 126))
+(assert_return (invoke "shl" (i64.const 63) (i64.const 0x4000000000000000)) (i64.const 63))
+(assert_return (invoke "shl" (i64.const 63) (i64.const 0x123456789abcdef)) (i64.const 0x1f800000000000))
+(assert_return (invoke "shl" (i64.const 63) (i64.const 0xfedcba9876543210)) (i64.const 0x3f0000))
+(assert_return (invoke "shl" (i64.const 63) (i64.const 63)) (i64.const 0x8000000000000000))
+(assert_return (invoke "shl" (i64.const 63) (i64.const 64)) (i64.const 63))
+(assert_return (invoke "shl" (i64.const 63) (i64.const 65)) (i64.const 126))
+(assert_return (invoke "shl" (i64.const 63) (i64.const 0xfffffffffff5a5a)) (i64.const 0xfc000000))
+(assert_return (invoke "shl" (i64.const 64) (i64.const 0)) (i64.const 64))
+(assert_return (invoke "shl" (i64.const 64) (i64.const 1)) (i64.const 128))
+(assert_return (invoke "shl" (i64.const 64) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "shl" (i64.const 64) (i64.const 2)) (i64.const 256))
+(assert_return (invoke "shl" (i64.const 64) (i64.const 3)) (i64.const 512))
+(assert_return (invoke "shl" (i64.const 64) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "shl" (i64.const 64) (i64.const 0x8000000000000000)) (i64.const 64))
+(assert_return (invoke "shl" (i64.const 64) (i64.const 0x8000000000000001)) (i64.const 128))
+(assert_return (invoke "shl" (i64.const 64) (i64.const 0x4000000000000000)) (i64.const 64))
+(assert_return (invoke "shl" (i64.const 64) (i64.const 0x123456789abcdef)) (i64.const 0x20000000000000))
+(assert_return (invoke "shl" (i64.const 64) (i64.const 0xfedcba9876543210)) (i64.const 0x400000))
+(assert_return (invoke "shl" (i64.const 64) (i64.const 63)) (i64.const 0))
+(assert_return (invoke "shl" (i64.const 64) (i64.const 64)) (i64.const 64))
+(assert_return (invoke "shl" (i64.const 64) (i64.const 65)) (i64.const 128))
+(assert_return (invoke "shl" (i64.const 64) (i64.const 0xfffffffffff5a5a)) (i64.const 0x100000000))
+(assert_return (invoke "shl" (i64.const 65) (i64.const 0)) (i64.const 65))
+(assert_return (invoke "shl" (i64.const 65) (i64.const 1)) (i64.const 130))
+(assert_return (invoke "shl" (i64.const 65) (i64.const -1)) (i64.const 0x8000000000000000))
+(assert_return (invoke "shl" (i64.const 65) (i64.const 2)) (i64.const 260))
+(assert_return (invoke "shl" (i64.const 65) (i64.const 3)) (i64.const 520))
+(assert_return (invoke "shl" (i64.const 65) (i64.const 0x7fffffffffffffff)) (i64.const 0x8000000000000000))
+(assert_return (invoke "shl" (i64.const 65) (i64.const 0x8000000000000000)) (i64.const 65))
+(assert_return (invoke "shl" (i64.const 65) (i64.const 0x8000000000000001)) (i64.const 130))
+(assert_return (invoke "shl" (i64.const 65) (i64.const 0x4000000000000000)) (i64.const 65))
+(assert_return (invoke "shl" (i64.const 65) (i64.const 0x123456789abcdef)) (i64.const 0x20800000000000))
+(assert_return (invoke "shl" (i64.const 65) (i64.const 0xfedcba9876543210)) (i64.const 0x410000))
+(assert_return (invoke "shl" (i64.const 65) (i64.const 63)) (i64.const 0x8000000000000000))
+(assert_return (invoke "shl" (i64.const 65) (i64.const 64)) (i64.const 65))
+(assert_return (invoke "shl" (i64.const 65) (i64.const 65)) (i64.const 130))
+(assert_return (invoke "shl" (i64.const 65) (i64.const 0xfffffffffff5a5a)) (i64.const 0x104000000))
+(assert_return (invoke "shl" (i64.const 0xfffffffffff5a5a) (i64.const 0)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "shl" (i64.const 0xfffffffffff5a5a) (i64.const 1)) (i64.const 0x1ffffffffffeb4b4))
+(assert_return (invoke "shl" (i64.const 0xfffffffffff5a5a) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "shl" (i64.const 0xfffffffffff5a5a) (i64.const 2)) (i64.const 0x3ffffffffffd6968))
+(assert_return (invoke "shl" (i64.const 0xfffffffffff5a5a) (i64.const 3)) (i64.const 0x7ffffffffffad2d0))
+(assert_return (invoke "shl" (i64.const 0xfffffffffff5a5a) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "shl" (i64.const 0xfffffffffff5a5a) (i64.const 0x8000000000000000)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "shl" (i64.const 0xfffffffffff5a5a) (i64.const 0x8000000000000001)) (i64.const 0x1ffffffffffeb4b4))
+(assert_return (invoke "shl" (i64.const 0xfffffffffff5a5a) (i64.const 0x4000000000000000)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "shl" (i64.const 0xfffffffffff5a5a) (i64.const 0x123456789abcdef)) (i64.const 0xad2d000000000000))
+(assert_return (invoke "shl" (i64.const 0xfffffffffff5a5a) (i64.const 0xfedcba9876543210)) (i64.const 0xffffffff5a5a0000))
+(assert_return (invoke "shl" (i64.const 0xfffffffffff5a5a) (i64.const 63)) (i64.const 0))
+(assert_return (invoke "shl" (i64.const 0xfffffffffff5a5a) (i64.const 64)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "shl" (i64.const 0xfffffffffff5a5a) (i64.const 65)) (i64.const 0x1ffffffffffeb4b4))
+(assert_return (invoke "shl" (i64.const 0xfffffffffff5a5a) (i64.const 0xfffffffffff5a5a)) (i64.const 0xfffffd6968000000))
+(assert_return (invoke "shl" (i64.const 0x346b12b0b283445e) (i64.const 0x3f45317419444556)) (i64.const 0xac2ca0d117800000))
+(assert_return (invoke "shl" (i64.const 0xc055ece256bc1604) (i64.const 0x47159eda25e088a7)) (i64.const 0x5e0b020000000000))
+(assert_return (invoke "shl" (i64.const 0x6a666e2a38e4ccb1) (i64.const 0x5cb52d82e9e3158f)) (i64.const 0x37151c7266588000))
+(assert_return (invoke "shl" (i64.const 0x6d1d8a959f47d9a3) (i64.const 0x94291360d556c2cf)) (i64.const 0xc54acfa3ecd18000))
+(assert_return (invoke "shl" (i64.const 0xfc0100996762f92c) (i64.const 0x40ffb576370f8bf0)) (i64.const 0xf92c000000000000))
+(assert_return (invoke "shl" (i64.const 0x8b859e4015ae357f) (i64.const 0x85fdb663c6650e7c)) (i64.const 0xf000000000000000))
+(assert_return (invoke "shl" (i64.const 0xe72d44fddc7cd27e) (i64.const 0x9ba71ae7bb4881fb)) (i64.const 0xf000000000000000))
+(assert_return (invoke "shl" (i64.const 0xb31b31ef38a68661) (i64.const 0x73a6fd5fc36e7aee)) (i64.const 0xa198400000000000))
+(assert_return (invoke "shl" (i64.const 0xfb08e0b7e4181a22) (i64.const 0x8ab5e21b4eb4a39a)) (i64.const 0xdf90606888000000))
+(assert_return (invoke "shl" (i64.const 0xb936c6447edbb677) (i64.const 0x23be6008fbe86f41)) (i64.const 0x726d8c88fdb76cee))
+(assert_return (invoke "shl" (i64.const 0x1df8991a33599cee) (i64.const 0xa7242b30a705a353)) (i64.const 0xc8d19acce7700000))
+(assert_return (invoke "shl" (i64.const 0xce063801c6d57c2d) (i64.const 0xeaa67e8910fa94d)) (i64.const 0xc70038daaf85a000))
+(assert_return (invoke "shl" (i64.const 0x6dea9eb7ea729f07) (i64.const 0x5b3b23dd8014398a)) (i64.const 0xaa7adfa9ca7c1c00))
+(assert_return (invoke "shl" (i64.const 0xa46eab4297988f7) (i64.const 0x37406a2c5c7f9ec0)) (i64.const 0xa46eab4297988f7))
+(assert_return (invoke "shl" (i64.const 0xbfb0d93f5088ca7d) (i64.const 0x1b79369a81fcb158)) (i64.const 0x3f5088ca7d000000))
+(assert_return (invoke "shl" (i64.const 0x42b711ad6011304f) (i64.const 0x2d2f4d48d4824593)) (i64.const 0x8d6b008982780000))
+
+(assert_return (invoke "shr_s" (i64.const 0) (i64.const 0)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 0) (i64.const 1)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 0) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 0) (i64.const 2)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 0) (i64.const 3)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 0) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 0) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 0) (i64.const 0x8000000000000001)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 0) (i64.const 0x4000000000000000)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 0) (i64.const 0x123456789abcdef)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 0) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 0) (i64.const 63)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 0) (i64.const 64)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 0) (i64.const 65)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 0) (i64.const 0xfffffffffff5a5a)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 1) (i64.const 0)) (i64.const 1))
+(assert_return (invoke "shr_s" (i64.const 1) (i64.const 1)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 1) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 1) (i64.const 2)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 1) (i64.const 3)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 1) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 1) (i64.const 0x8000000000000000)) (i64.const 1))
+(assert_return (invoke "shr_s" (i64.const 1) (i64.const 0x8000000000000001)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 1) (i64.const 0x4000000000000000)) (i64.const 1))
+(assert_return (invoke "shr_s" (i64.const 1) (i64.const 0x123456789abcdef)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 1) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 1) (i64.const 63)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 1) (i64.const 64)) (i64.const 1))
+(assert_return (invoke "shr_s" (i64.const 1) (i64.const 65)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 1) (i64.const 0xfffffffffff5a5a)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const -1) (i64.const 0)) (i64.const -1))
+(assert_return (invoke "shr_s" (i64.const -1) (i64.const 1)) (i64.const -1))
+(assert_return (invoke "shr_s" (i64.const -1) (i64.const -1)) (i64.const -1))
+(assert_return (invoke "shr_s" (i64.const -1) (i64.const 2)) (i64.const -1))
+(assert_return (invoke "shr_s" (i64.const -1) (i64.const 3)) (i64.const -1))
+(assert_return (invoke "shr_s" (i64.const -1) (i64.const 0x7fffffffffffffff)) (i64.const -1))
+(assert_return (invoke "shr_s" (i64.const -1) (i64.const 0x8000000000000000)) (i64.const -1))
+(assert_return (invoke "shr_s" (i64.const -1) (i64.const 0x8000000000000001)) (i64.const -1))
+(assert_return (invoke "shr_s" (i64.const -1) (i64.const 0x4000000000000000)) (i64.const -1))
+(assert_return (invoke "shr_s" (i64.const -1) (i64.const 0x123456789abcdef)) (i64.const -1))
+(assert_return (invoke "shr_s" (i64.const -1) (i64.const 0xfedcba9876543210)) (i64.const -1))
+(assert_return (invoke "shr_s" (i64.const -1) (i64.const 63)) (i64.const -1))
+(assert_return (invoke "shr_s" (i64.const -1) (i64.const 64)) (i64.const -1))
+(assert_return (invoke "shr_s" (i64.const -1) (i64.const 65)) (i64.const -1))
+(assert_return (invoke "shr_s" (i64.const -1) (i64.const 0xfffffffffff5a5a)) (i64.const -1))
+(assert_return (invoke "shr_s" (i64.const 2) (i64.const 0)) (i64.const 2))
+(assert_return (invoke "shr_s" (i64.const 2) (i64.const 1)) (i64.const 1))
+(assert_return (invoke "shr_s" (i64.const 2) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 2) (i64.const 2)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 2) (i64.const 3)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 2) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 2) (i64.const 0x8000000000000000)) (i64.const 2))
+(assert_return (invoke "shr_s" (i64.const 2) (i64.const 0x8000000000000001)) (i64.const 1))
+(assert_return (invoke "shr_s" (i64.const 2) (i64.const 0x4000000000000000)) (i64.const 2))
+(assert_return (invoke "shr_s" (i64.const 2) (i64.const 0x123456789abcdef)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 2) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 2) (i64.const 63)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 2) (i64.const 64)) (i64.const 2))
+(assert_return (invoke "shr_s" (i64.const 2) (i64.const 65)) (i64.const 1))
+(assert_return (invoke "shr_s" (i64.const 2) (i64.const 0xfffffffffff5a5a)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 3) (i64.const 0)) (i64.const 3))
+(assert_return (invoke "shr_s" (i64.const 3) (i64.const 1)) (i64.const 1))
+(assert_return (invoke "shr_s" (i64.const 3) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 3) (i64.const 2)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 3) (i64.const 3)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 3) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 3) (i64.const 0x8000000000000000)) (i64.const 3))
+(assert_return (invoke "shr_s" (i64.const 3) (i64.const 0x8000000000000001)) (i64.const 1))
+(assert_return (invoke "shr_s" (i64.const 3) (i64.const 0x4000000000000000)) (i64.const 3))
+(assert_return (invoke "shr_s" (i64.const 3) (i64.const 0x123456789abcdef)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 3) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 3) (i64.const 63)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 3) (i64.const 64)) (i64.const 3))
+(assert_return (invoke "shr_s" (i64.const 3) (i64.const 65)) (i64.const 1))
+(assert_return (invoke "shr_s" (i64.const 3) (i64.const 0xfffffffffff5a5a)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 0x7fffffffffffffff) (i64.const 0)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "shr_s" (i64.const 0x7fffffffffffffff) (i64.const 1)) (i64.const 0x3fffffffffffffff))
+(assert_return (invoke "shr_s" (i64.const 0x7fffffffffffffff) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 0x7fffffffffffffff) (i64.const 2)) (i64.const 0x1fffffffffffffff))
+(assert_return (invoke "shr_s" (i64.const 0x7fffffffffffffff) (i64.const 3)) (i64.const 0xfffffffffffffff))
+(assert_return (invoke "shr_s" (i64.const 0x7fffffffffffffff) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 0x7fffffffffffffff) (i64.const 0x8000000000000000)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "shr_s" (i64.const 0x7fffffffffffffff) (i64.const 0x8000000000000001)) (i64.const 0x3fffffffffffffff))
+(assert_return (invoke "shr_s" (i64.const 0x7fffffffffffffff) (i64.const 0x4000000000000000)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "shr_s" (i64.const 0x7fffffffffffffff) (i64.const 0x123456789abcdef)) (i64.const 65535))
+(assert_return (invoke "shr_s" (i64.const 0x7fffffffffffffff) (i64.const 0xfedcba9876543210)) (i64.const 0x7fffffffffff))
+(assert_return (invoke "shr_s" (i64.const 0x7fffffffffffffff) (i64.const 63)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 0x7fffffffffffffff) (i64.const 64)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "shr_s" (i64.const 0x7fffffffffffffff) (i64.const 65)) (i64.const 0x3fffffffffffffff))
+(assert_return (invoke "shr_s" (i64.const 0x7fffffffffffffff) (i64.const 0xfffffffffff5a5a)) (i64.const 0x1fffffffff))
+(assert_return (invoke "shr_s" (i64.const 0x8000000000000000) (i64.const 0)) (i64.const 0x8000000000000000))
+(assert_return (invoke "shr_s" (i64.const 0x8000000000000000) (i64.const 1)) (i64.const 0xc000000000000000))
+(assert_return (invoke "shr_s" (i64.const 0x8000000000000000) (i64.const -1)) (i64.const -1))
+(assert_return (invoke "shr_s" (i64.const 0x8000000000000000) (i64.const 2)) (i64.const 0xe000000000000000))
+(assert_return (invoke "shr_s" (i64.const 0x8000000000000000) (i64.const 3)) (i64.const 0xf000000000000000))
+(assert_return (invoke "shr_s" (i64.const 0x8000000000000000) (i64.const 0x7fffffffffffffff)) (i64.const -1))
+(assert_return (invoke "shr_s" (i64.const 0x8000000000000000) (i64.const 0x8000000000000000)) (i64.const 0x8000000000000000))
+(assert_return (invoke "shr_s" (i64.const 0x8000000000000000) (i64.const 0x8000000000000001)) (i64.const 0xc000000000000000))
+(assert_return (invoke "shr_s" (i64.const 0x8000000000000000) (i64.const 0x4000000000000000)) (i64.const 0x8000000000000000))
+(assert_return (invoke "shr_s" (i64.const 0x8000000000000000) (i64.const 0x123456789abcdef)) (i64.const 0xffffffffffff0000))
+(assert_return (invoke "shr_s" (i64.const 0x8000000000000000) (i64.const 0xfedcba9876543210)) (i64.const 0xffff800000000000))
+(assert_return (invoke "shr_s" (i64.const 0x8000000000000000) (i64.const 63)) (i64.const -1))
+(assert_return (invoke "shr_s" (i64.const 0x8000000000000000) (i64.const 64)) (i64.const 0x8000000000000000))
+(assert_return (invoke "shr_s" (i64.const 0x8000000000000000) (i64.const 65)) (i64.const 0xc000000000000000))
+(assert_return (invoke "shr_s" (i64.const 0x8000000000000000) (i64.const 0xfffffffffff5a5a)) (i64.const 0xffffffe000000000))
+(assert_return (invoke "shr_s" (i64.const 0x8000000000000001) (i64.const 0)) (i64.const 0x8000000000000001))
+(assert_return (invoke "shr_s" (i64.const 0x8000000000000001) (i64.const 1)) (i64.const 0xc000000000000000))
+(assert_return (invoke "shr_s" (i64.const 0x8000000000000001) (i64.const -1)) (i64.const -1))
+(assert_return (invoke "shr_s" (i64.const 0x8000000000000001) (i64.const 2)) (i64.const 0xe000000000000000))
+(assert_return (invoke "shr_s" (i64.const 0x8000000000000001) (i64.const 3)) (i64.const 0xf000000000000000))
+(assert_return (invoke "shr_s" (i64.const 0x8000000000000001) (i64.const 0x7fffffffffffffff)) (i64.const -1))
+(assert_return (invoke "shr_s" (i64.const 0x8000000000000001) (i64.const 0x8000000000000000)) (i64.const 0x8000000000000001))
+(assert_return (invoke "shr_s" (i64.const 0x8000000000000001) (i64.const 0x8000000000000001)) (i64.const 0xc000000000000000))
+(assert_return (invoke "shr_s" (i64.const 0x8000000000000001) (i64.const 0x4000000000000000)) (i64.const 0x8000000000000001))
+(assert_return (invoke "shr_s" (i64.const 0x8000000000000001) (i64.const 0x123456789abcdef)) (i64.const 0xffffffffffff0000))
+(assert_return (invoke "shr_s" (i64.const 0x8000000000000001) (i64.const 0xfedcba9876543210)) (i64.const 0xffff800000000000))
+(assert_return (invoke "shr_s" (i64.const 0x8000000000000001) (i64.const 63)) (i64.const -1))
+(assert_return (invoke "shr_s" (i64.const 0x8000000000000001) (i64.const 64)) (i64.const 0x8000000000000001))
+(assert_return (invoke "shr_s" (i64.const 0x8000000000000001) (i64.const 65)) (i64.const 0xc000000000000000))
+(assert_return (invoke "shr_s" (i64.const 0x8000000000000001) (i64.const 0xfffffffffff5a5a)) (i64.const 0xffffffe000000000))
+(assert_return (invoke "shr_s" (i64.const 0x4000000000000000) (i64.const 0)) (i64.const 0x4000000000000000))
+(assert_return (invoke "shr_s" (i64.const 0x4000000000000000) (i64.const 1)) (i64.const 0x2000000000000000))
+(assert_return (invoke "shr_s" (i64.const 0x4000000000000000) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 0x4000000000000000) (i64.const 2)) (i64.const 0x1000000000000000))
+(assert_return (invoke "shr_s" (i64.const 0x4000000000000000) (i64.const 3)) (i64.const 0x800000000000000))
+(assert_return (invoke "shr_s" (i64.const 0x4000000000000000) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 0x4000000000000000) (i64.const 0x8000000000000000)) (i64.const 0x4000000000000000))
+(assert_return (invoke "shr_s" (i64.const 0x4000000000000000) (i64.const 0x8000000000000001)) (i64.const 0x2000000000000000))
+(assert_return (invoke "shr_s" (i64.const 0x4000000000000000) (i64.const 0x4000000000000000)) (i64.const 0x4000000000000000))
+(assert_return (invoke "shr_s" (i64.const 0x4000000000000000) (i64.const 0x123456789abcdef)) (i64.const 32768))
+(assert_return (invoke "shr_s" (i64.const 0x4000000000000000) (i64.const 0xfedcba9876543210)) (i64.const 0x400000000000))
+(assert_return (invoke "shr_s" (i64.const 0x4000000000000000) (i64.const 63)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 0x4000000000000000) (i64.const 64)) (i64.const 0x4000000000000000))
+(assert_return (invoke "shr_s" (i64.const 0x4000000000000000) (i64.const 65)) (i64.const 0x2000000000000000))
+(assert_return (invoke "shr_s" (i64.const 0x4000000000000000) (i64.const 0xfffffffffff5a5a)) (i64.const 0x1000000000))
+(assert_return (invoke "shr_s" (i64.const 0x123456789abcdef) (i64.const 0)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "shr_s" (i64.const 0x123456789abcdef) (i64.const 1)) (i64.const 0x91a2b3c4d5e6f7))
+(assert_return (invoke "shr_s" (i64.const 0x123456789abcdef) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 0x123456789abcdef) (i64.const 2)) (i64.const 0x48d159e26af37b))
+(assert_return (invoke "shr_s" (i64.const 0x123456789abcdef) (i64.const 3)) (i64.const 0x2468acf13579bd))
+(assert_return (invoke "shr_s" (i64.const 0x123456789abcdef) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 0x123456789abcdef) (i64.const 0x8000000000000000)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "shr_s" (i64.const 0x123456789abcdef) (i64.const 0x8000000000000001)) (i64.const 0x91a2b3c4d5e6f7))
+(assert_return (invoke "shr_s" (i64.const 0x123456789abcdef) (i64.const 0x4000000000000000)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "shr_s" (i64.const 0x123456789abcdef) (i64.const 0x123456789abcdef)) (i64.const 582))
+(assert_return (invoke "shr_s" (i64.const 0x123456789abcdef) (i64.const 0xfedcba9876543210)) (i64.const 0x123456789ab))
+(assert_return (invoke "shr_s" (i64.const 0x123456789abcdef) (i64.const 63)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 0x123456789abcdef) (i64.const 64)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "shr_s" (i64.const 0x123456789abcdef) (i64.const 65)) (i64.const 0x91a2b3c4d5e6f7))
+(assert_return (invoke "shr_s" (i64.const 0x123456789abcdef) (i64.const 0xfffffffffff5a5a)) (i64.const 0x48d159e2))
+(assert_return (invoke "shr_s" (i64.const 0xfedcba9876543210) (i64.const 0)) (i64.const 0xfedcba9876543210))
+(assert_return (invoke "shr_s" (i64.const 0xfedcba9876543210) (i64.const 1)) (i64.const 0xff6e5d4c3b2a1908))
+(assert_return (invoke "shr_s" (i64.const 0xfedcba9876543210) (i64.const -1)) (i64.const -1))
+(assert_return (invoke "shr_s" (i64.const 0xfedcba9876543210) (i64.const 2)) (i64.const 0xffb72ea61d950c84))
+(assert_return (invoke "shr_s" (i64.const 0xfedcba9876543210) (i64.const 3)) (i64.const 0xffdb97530eca8642))
+(assert_return (invoke "shr_s" (i64.const 0xfedcba9876543210) (i64.const 0x7fffffffffffffff)) (i64.const -1))
+(assert_return (invoke "shr_s" (i64.const 0xfedcba9876543210) (i64.const 0x8000000000000000)) (i64.const 0xfedcba9876543210))
+(assert_return (invoke "shr_s" (i64.const 0xfedcba9876543210) (i64.const 0x8000000000000001)) (i64.const 0xff6e5d4c3b2a1908))
+(assert_return (invoke "shr_s" (i64.const 0xfedcba9876543210) (i64.const 0x4000000000000000)) (i64.const 0xfedcba9876543210))
+(assert_return (invoke "shr_s" (i64.const 0xfedcba9876543210) (i64.const 0x123456789abcdef)) (i64.const -583))
+(assert_return (invoke "shr_s" (i64.const 0xfedcba9876543210) (i64.const 0xfedcba9876543210)) (i64.const 0xfffffedcba987654))
+(assert_return (invoke "shr_s" (i64.const 0xfedcba9876543210) (i64.const 63)) (i64.const -1))
+(assert_return (invoke "shr_s" (i64.const 0xfedcba9876543210) (i64.const 64)) (i64.const 0xfedcba9876543210))
+(assert_return (invoke "shr_s" (i64.const 0xfedcba9876543210) (i64.const 65)) (i64.const 0xff6e5d4c3b2a1908))
+(assert_return (invoke "shr_s" (i64.const 0xfedcba9876543210) (i64.const 0xfffffffffff5a5a)) (i64.const 0xffffffffb72ea61d))
+(assert_return (invoke "shr_s" (i64.const 63) (i64.const 0)) (i64.const 63))
+(assert_return (invoke "shr_s" (i64.const 63) (i64.const 1)) (i64.const 31))
+(assert_return (invoke "shr_s" (i64.const 63) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 63) (i64.const 2)) (i64.const 15))
+(assert_return (invoke "shr_s" (i64.const 63) (i64.const 3)) (i64.const 7))
+(assert_return (invoke "shr_s" (i64.const 63) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 63) (i64.const 0x8000000000000000)) (i64.const 63))
+(assert_return (invoke "shr_s" (i64.const 63) (i64.const 0x8000000000000001)) (i64.const 31))
+(assert_return (invoke "shr_s" (i64.const 63) (i64.const 0x4000000000000000)) (i64.const 63))
+(assert_return (invoke "shr_s" (i64.const 63) (i64.const 0x123456789abcdef)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 63) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 63) (i64.const 63)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 63) (i64.const 64)) (i64.const 63))
+(assert_return (invoke "shr_s" (i64.const 63) (i64.const 65)) (i64.const 31))
+(assert_return (invoke "shr_s" (i64.const 63) (i64.const 0xfffffffffff5a5a)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 64) (i64.const 0)) (i64.const 64))
+(assert_return (invoke "shr_s" (i64.const 64) (i64.const 1)) (i64.const 32))
+(assert_return (invoke "shr_s" (i64.const 64) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 64) (i64.const 2)) (i64.const 16))
+(assert_return (invoke "shr_s" (i64.const 64) (i64.const 3)) (i64.const 8))
+(assert_return (invoke "shr_s" (i64.const 64) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 64) (i64.const 0x8000000000000000)) (i64.const 64))
+(assert_return (invoke "shr_s" (i64.const 64) (i64.const 0x8000000000000001)) (i64.const 32))
+(assert_return (invoke "shr_s" (i64.const 64) (i64.const 0x4000000000000000)) (i64.const 64))
+(assert_return (invoke "shr_s" (i64.const 64) (i64.const 0x123456789abcdef)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 64) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 64) (i64.const 63)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 64) (i64.const 64)) (i64.const 64))
+(assert_return (invoke "shr_s" (i64.const 64) (i64.const 65)) (i64.const 32))
+(assert_return (invoke "shr_s" (i64.const 64) (i64.const 0xfffffffffff5a5a)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 65) (i64.const 0)) (i64.const 65))
+(assert_return (invoke "shr_s" (i64.const 65) (i64.const 1)) (i64.const 32))
+(assert_return (invoke "shr_s" (i64.const 65) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 65) (i64.const 2)) (i64.const 16))
+(assert_return (invoke "shr_s" (i64.const 65) (i64.const 3)) (i64.const 8))
+(assert_return (invoke "shr_s" (i64.const 65) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 65) (i64.const 0x8000000000000000)) (i64.const 65))
+(assert_return (invoke "shr_s" (i64.const 65) (i64.const 0x8000000000000001)) (i64.const 32))
+(assert_return (invoke "shr_s" (i64.const 65) (i64.const 0x4000000000000000)) (i64.const 65))
+(assert_return (invoke "shr_s" (i64.const 65) (i64.const 0x123456789abcdef)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 65) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 65) (i64.const 63)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 65) (i64.const 64)) (i64.const 65))
+(assert_return (invoke "shr_s" (i64.const 65) (i64.const 65)) (i64.const 32))
+(assert_return (invoke "shr_s" (i64.const 65) (i64.const 0xfffffffffff5a5a)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 0xfffffffffff5a5a) (i64.const 0)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "shr_s" (i64.const 0xfffffffffff5a5a) (i64.const 1)) (i64.const 0x7ffffffffffad2d))
+(assert_return (invoke "shr_s" (i64.const 0xfffffffffff5a5a) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 0xfffffffffff5a5a) (i64.const 2)) (i64.const 0x3ffffffffffd696))
+(assert_return (invoke "shr_s" (i64.const 0xfffffffffff5a5a) (i64.const 3)) (i64.const 0x1ffffffffffeb4b))
+(assert_return (invoke "shr_s" (i64.const 0xfffffffffff5a5a) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 0xfffffffffff5a5a) (i64.const 0x8000000000000000)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "shr_s" (i64.const 0xfffffffffff5a5a) (i64.const 0x8000000000000001)) (i64.const 0x7ffffffffffad2d))
+(assert_return (invoke "shr_s" (i64.const 0xfffffffffff5a5a) (i64.const 0x4000000000000000)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "shr_s" (i64.const 0xfffffffffff5a5a) (i64.const 0x123456789abcdef)) (i64.const 8191))
+(assert_return (invoke "shr_s" (i64.const 0xfffffffffff5a5a) (i64.const 0xfedcba9876543210)) (i64.const 0xfffffffffff))
+(assert_return (invoke "shr_s" (i64.const 0xfffffffffff5a5a) (i64.const 63)) (i64.const 0))
+(assert_return (invoke "shr_s" (i64.const 0xfffffffffff5a5a) (i64.const 64)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "shr_s" (i64.const 0xfffffffffff5a5a) (i64.const 65)) (i64.const 0x7ffffffffffad2d))
+(assert_return (invoke "shr_s" (i64.const 0xfffffffffff5a5a) (i64.const 0xfffffffffff5a5a)) (i64.const 0x3ffffffff))
+(assert_return (invoke "shr_s" (i64.const 0xe27ac6a0a0299d4c) (i64.const 0x62fdd1eccca5f746)) (i64.const 0xff89eb1a8280a675))
+(assert_return (invoke "shr_s" (i64.const 0x51b3a5ac997f7601) (i64.const 0x74f69b15124063d7)) (i64.const 0xa3674b5932))
+(assert_return (invoke "shr_s" (i64.const 0xb74fc5a52dd477fb) (i64.const 0x5c38f5df1ffb91f7)) (i64.const -146))
+(assert_return (invoke "shr_s" (i64.const 0x82398d4cb72e12fc) (i64.const 0x81a869885c8d04fe)) (i64.const -2))
+(assert_return (invoke "shr_s" (i64.const 0xe29fe119d2d6c10b) (i64.const 0xa826acee436f17a)) (i64.const -8))
+(assert_return (invoke "shr_s" (i64.const 0x8cf65b61da33f93e) (i64.const 0x54bff0dea900612f)) (i64.const -58900))
+(assert_return (invoke "shr_s" (i64.const 0x331059683cb8238c) (i64.const 0x68070e1714e31d6f)) (i64.const 26144))
+(assert_return (invoke "shr_s" (i64.const 0x3bb07177e103504) (i64.const 0x56a65b0ccc442578)) (i64.const 3))
+(assert_return (invoke "shr_s" (i64.const 0xf1b570c2e18f6a6b) (i64.const 0x565d942513c23401)) (i64.const 0xf8dab86170c7b535))
+(assert_return (invoke "shr_s" (i64.const 0xfc8fe6510d430248) (i64.const 0xa88319546a4b858f)) (i64.const 0xfffff91fcca21a86))
+(assert_return (invoke "shr_s" (i64.const 0x3786e0d39b24a7f2) (i64.const 0x38426d6652a84a39)) (i64.const 27))
+(assert_return (invoke "shr_s" (i64.const 0x4f9f76adb0d7882d) (i64.const 0xe25467e5c220146c)) (i64.const 0x4f9f7))
+(assert_return (invoke "shr_s" (i64.const 0x886702c1d4b025e7) (i64.const 0x842bc998ed9bef4e)) (i64.const 0xfffe219c0b0752c0))
+(assert_return (invoke "shr_s" (i64.const 0x954e12fb2c2e8808) (i64.const 0xb2a3523df11ca7f7)) (i64.const -214))
+(assert_return (invoke "shr_s" (i64.const 0x8beff9d71194332d) (i64.const 0xfd020169be549f8)) (i64.const -117))
+(assert_return (invoke "shr_s" (i64.const 0xf9f6af85c678d08b) (i64.const 0x9d50f1541d8ec2b1)) (i64.const -773))
+
+(assert_return (invoke "shr_u" (i64.const 0) (i64.const 0)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 0) (i64.const 1)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 0) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 0) (i64.const 2)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 0) (i64.const 3)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 0) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 0) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 0) (i64.const 0x8000000000000001)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 0) (i64.const 0x4000000000000000)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 0) (i64.const 0x123456789abcdef)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 0) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 0) (i64.const 63)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 0) (i64.const 64)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 0) (i64.const 65)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 0) (i64.const 0xfffffffffff5a5a)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 1) (i64.const 0)) (i64.const 1))
+(assert_return (invoke "shr_u" (i64.const 1) (i64.const 1)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 1) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 1) (i64.const 2)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 1) (i64.const 3)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 1) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 1) (i64.const 0x8000000000000000)) (i64.const 1))
+(assert_return (invoke "shr_u" (i64.const 1) (i64.const 0x8000000000000001)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 1) (i64.const 0x4000000000000000)) (i64.const 1))
+(assert_return (invoke "shr_u" (i64.const 1) (i64.const 0x123456789abcdef)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 1) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 1) (i64.const 63)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 1) (i64.const 64)) (i64.const 1))
+(assert_return (invoke "shr_u" (i64.const 1) (i64.const 65)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 1) (i64.const 0xfffffffffff5a5a)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const -1) (i64.const 0)) (i64.const -1))
+(assert_return (invoke "shr_u" (i64.const -1) (i64.const 1)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "shr_u" (i64.const -1) (i64.const -1)) (i64.const 1))
+(assert_return (invoke "shr_u" (i64.const -1) (i64.const 2)) (i64.const 0x3fffffffffffffff))
+(assert_return (invoke "shr_u" (i64.const -1) (i64.const 3)) (i64.const 0x1fffffffffffffff))
+(assert_return (invoke "shr_u" (i64.const -1) (i64.const 0x7fffffffffffffff)) (i64.const 1))
+(assert_return (invoke "shr_u" (i64.const -1) (i64.const 0x8000000000000000)) (i64.const -1))
+(assert_return (invoke "shr_u" (i64.const -1) (i64.const 0x8000000000000001)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "shr_u" (i64.const -1) (i64.const 0x4000000000000000)) (i64.const -1))
+(assert_return (invoke "shr_u" (i64.const -1) (i64.const 0x123456789abcdef)) (i64.const 0x1ffff))
+(assert_return (invoke "shr_u" (i64.const -1) (i64.const 0xfedcba9876543210)) (i64.const 0xffffffffffff))
+(assert_return (invoke "shr_u" (i64.const -1) (i64.const 63)) (i64.const 1))
+(assert_return (invoke "shr_u" (i64.const -1) (i64.const 64)) (i64.const -1))
+(assert_return (invoke "shr_u" (i64.const -1) (i64.const 65)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "shr_u" (i64.const -1) (i64.const 0xfffffffffff5a5a)) (i64.const 0x3fffffffff))
+(assert_return (invoke "shr_u" (i64.const 2) (i64.const 0)) (i64.const 2))
+(assert_return (invoke "shr_u" (i64.const 2) (i64.const 1)) (i64.const 1))
+(assert_return (invoke "shr_u" (i64.const 2) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 2) (i64.const 2)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 2) (i64.const 3)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 2) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 2) (i64.const 0x8000000000000000)) (i64.const 2))
+(assert_return (invoke "shr_u" (i64.const 2) (i64.const 0x8000000000000001)) (i64.const 1))
+(assert_return (invoke "shr_u" (i64.const 2) (i64.const 0x4000000000000000)) (i64.const 2))
+(assert_return (invoke "shr_u" (i64.const 2) (i64.const 0x123456789abcdef)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 2) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 2) (i64.const 63)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 2) (i64.const 64)) (i64.const 2))
+(assert_return (invoke "shr_u" (i64.const 2) (i64.const 65)) (i64.const 1))
+(assert_return (invoke "shr_u" (i64.const 2) (i64.const 0xfffffffffff5a5a)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 3) (i64.const 0)) (i64.const 3))
+(assert_return (invoke "shr_u" (i64.const 3) (i64.const 1)) (i64.const 1))
+(assert_return (invoke "shr_u" (i64.const 3) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 3) (i64.const 2)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 3) (i64.const 3)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 3) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 3) (i64.const 0x8000000000000000)) (i64.const 3))
+(assert_return (invoke "shr_u" (i64.const 3) (i64.const 0x8000000000000001)) (i64.const 1))
+(assert_return (invoke "shr_u" (i64.const 3) (i64.const 0x4000000000000000)) (i64.const 3))
+(assert_return (invoke "shr_u" (i64.const 3) (i64.const 0x123456789abcdef)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 3) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 3) (i64.const 63)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 3) (i64.const 64)) (i64.const 3))
+(assert_return (invoke "shr_u" (i64.const 3) (i64.const 65)) (i64.const 1))
+(assert_return (invoke "shr_u" (i64.const 3) (i64.const 0xfffffffffff5a5a)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 0x7fffffffffffffff) (i64.const 0)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "shr_u" (i64.const 0x7fffffffffffffff) (i64.const 1)) (i64.const 0x3fffffffffffffff))
+(assert_return (invoke "shr_u" (i64.const 0x7fffffffffffffff) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 0x7fffffffffffffff) (i64.const 2)) (i64.const 0x1fffffffffffffff))
+(assert_return (invoke "shr_u" (i64.const 0x7fffffffffffffff) (i64.const 3)) (i64.const 0xfffffffffffffff))
+(assert_return (invoke "shr_u" (i64.const 0x7fffffffffffffff) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 0x7fffffffffffffff) (i64.const 0x8000000000000000)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "shr_u" (i64.const 0x7fffffffffffffff) (i64.const 0x8000000000000001)) (i64.const 0x3fffffffffffffff))
+(assert_return (invoke "shr_u" (i64.const 0x7fffffffffffffff) (i64.const 0x4000000000000000)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "shr_u" (i64.const 0x7fffffffffffffff) (i64.const 0x123456789abcdef)) (i64.const 65535))
+(assert_return (invoke "shr_u" (i64.const 0x7fffffffffffffff) (i64.const 0xfedcba9876543210)) (i64.const 0x7fffffffffff))
+(assert_return (invoke "shr_u" (i64.const 0x7fffffffffffffff) (i64.const 63)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 0x7fffffffffffffff) (i64.const 64)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "shr_u" (i64.const 0x7fffffffffffffff) (i64.const 65)) (i64.const 0x3fffffffffffffff))
+(assert_return (invoke "shr_u" (i64.const 0x7fffffffffffffff) (i64.const 0xfffffffffff5a5a)) (i64.const 0x1fffffffff))
+(assert_return (invoke "shr_u" (i64.const 0x8000000000000000) (i64.const 0)) (i64.const 0x8000000000000000))
+(assert_return (invoke "shr_u" (i64.const 0x8000000000000000) (i64.const 1)) (i64.const 0x4000000000000000))
+(assert_return (invoke "shr_u" (i64.const 0x8000000000000000) (i64.const -1)) (i64.const 1))
+(assert_return (invoke "shr_u" (i64.const 0x8000000000000000) (i64.const 2)) (i64.const 0x2000000000000000))
+(assert_return (invoke "shr_u" (i64.const 0x8000000000000000) (i64.const 3)) (i64.const 0x1000000000000000))
+(assert_return (invoke "shr_u" (i64.const 0x8000000000000000) (i64.const 0x7fffffffffffffff)) (i64.const 1))
+(assert_return (invoke "shr_u" (i64.const 0x8000000000000000) (i64.const 0x8000000000000000)) (i64.const 0x8000000000000000))
+(assert_return (invoke "shr_u" (i64.const 0x8000000000000000) (i64.const 0x8000000000000001)) (i64.const 0x4000000000000000))
+(assert_return (invoke "shr_u" (i64.const 0x8000000000000000) (i64.const 0x4000000000000000)) (i64.const 0x8000000000000000))
+(assert_return (invoke "shr_u" (i64.const 0x8000000000000000) (i64.const 0x123456789abcdef)) (i64.const 0x10000))
+(assert_return (invoke "shr_u" (i64.const 0x8000000000000000) (i64.const 0xfedcba9876543210)) (i64.const 0x800000000000))
+(assert_return (invoke "shr_u" (i64.const 0x8000000000000000) (i64.const 63)) (i64.const 1))
+(assert_return (invoke "shr_u" (i64.const 0x8000000000000000) (i64.const 64)) (i64.const 0x8000000000000000))
+(assert_return (invoke "shr_u" (i64.const 0x8000000000000000) (i64.const 65)) (i64.const 0x4000000000000000))
+(assert_return (invoke "shr_u" (i64.const 0x8000000000000000) (i64.const 0xfffffffffff5a5a)) (i64.const 0x2000000000))
+(assert_return (invoke "shr_u" (i64.const 0x8000000000000001) (i64.const 0)) (i64.const 0x8000000000000001))
+(assert_return (invoke "shr_u" (i64.const 0x8000000000000001) (i64.const 1)) (i64.const 0x4000000000000000))
+(assert_return (invoke "shr_u" (i64.const 0x8000000000000001) (i64.const -1)) (i64.const 1))
+(assert_return (invoke "shr_u" (i64.const 0x8000000000000001) (i64.const 2)) (i64.const 0x2000000000000000))
+(assert_return (invoke "shr_u" (i64.const 0x8000000000000001) (i64.const 3)) (i64.const 0x1000000000000000))
+(assert_return (invoke "shr_u" (i64.const 0x8000000000000001) (i64.const 0x7fffffffffffffff)) (i64.const 1))
+(assert_return (invoke "shr_u" (i64.const 0x8000000000000001) (i64.const 0x8000000000000000)) (i64.const 0x8000000000000001))
+(assert_return (invoke "shr_u" (i64.const 0x8000000000000001) (i64.const 0x8000000000000001)) (i64.const 0x4000000000000000))
+(assert_return (invoke "shr_u" (i64.const 0x8000000000000001) (i64.const 0x4000000000000000)) (i64.const 0x8000000000000001))
+(assert_return (invoke "shr_u" (i64.const 0x8000000000000001) (i64.const 0x123456789abcdef)) (i64.const 0x10000))
+(assert_return (invoke "shr_u" (i64.const 0x8000000000000001) (i64.const 0xfedcba9876543210)) (i64.const 0x800000000000))
+(assert_return (invoke "shr_u" (i64.const 0x8000000000000001) (i64.const 63)) (i64.const 1))
+(assert_return (invoke "shr_u" (i64.const 0x8000000000000001) (i64.const 64)) (i64.const 0x8000000000000001))
+(assert_return (invoke "shr_u" (i64.const 0x8000000000000001) (i64.const 65)) (i64.const 0x4000000000000000))
+(assert_return (invoke "shr_u" (i64.const 0x8000000000000001) (i64.const 0xfffffffffff5a5a)) (i64.const 0x2000000000))
+(assert_return (invoke "shr_u" (i64.const 0x4000000000000000) (i64.const 0)) (i64.const 0x4000000000000000))
+(assert_return (invoke "shr_u" (i64.const 0x4000000000000000) (i64.const 1)) (i64.const 0x2000000000000000))
+(assert_return (invoke "shr_u" (i64.const 0x4000000000000000) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 0x4000000000000000) (i64.const 2)) (i64.const 0x1000000000000000))
+(assert_return (invoke "shr_u" (i64.const 0x4000000000000000) (i64.const 3)) (i64.const 0x800000000000000))
+(assert_return (invoke "shr_u" (i64.const 0x4000000000000000) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 0x4000000000000000) (i64.const 0x8000000000000000)) (i64.const 0x4000000000000000))
+(assert_return (invoke "shr_u" (i64.const 0x4000000000000000) (i64.const 0x8000000000000001)) (i64.const 0x2000000000000000))
+(assert_return (invoke "shr_u" (i64.const 0x4000000000000000) (i64.const 0x4000000000000000)) (i64.const 0x4000000000000000))
+(assert_return (invoke "shr_u" (i64.const 0x4000000000000000) (i64.const 0x123456789abcdef)) (i64.const 32768))
+(assert_return (invoke "shr_u" (i64.const 0x4000000000000000) (i64.const 0xfedcba9876543210)) (i64.const 0x400000000000))
+(assert_return (invoke "shr_u" (i64.const 0x4000000000000000) (i64.const 63)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 0x4000000000000000) (i64.const 64)) (i64.const 0x4000000000000000))
+(assert_return (invoke "shr_u" (i64.const 0x4000000000000000) (i64.const 65)) (i64.const 0x2000000000000000))
+(assert_return (invoke "shr_u" (i64.const 0x4000000000000000) (i64.const 0xfffffffffff5a5a)) (i64.const 0x1000000000))
+(assert_return (invoke "shr_u" (i64.const 0x123456789abcdef) (i64.const 0)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "shr_u" (i64.const 0x123456789abcdef) (i64.const 1)) (i64.const 0x91a2b3c4d5e6f7))
+(assert_return (invoke "shr_u" (i64.const 0x123456789abcdef) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 0x123456789abcdef) (i64.const 2)) (i64.const 0x48d159e26af37b))
+(assert_return (invoke "shr_u" (i64.const 0x123456789abcdef) (i64.const 3)) (i64.const 0x2468acf13579bd))
+(assert_return (invoke "shr_u" (i64.const 0x123456789abcdef) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 0x123456789abcdef) (i64.const 0x8000000000000000)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "shr_u" (i64.const 0x123456789abcdef) (i64.const 0x8000000000000001)) (i64.const 0x91a2b3c4d5e6f7))
+(assert_return (invoke "shr_u" (i64.const 0x123456789abcdef) (i64.const 0x4000000000000000)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "shr_u" (i64.const 0x123456789abcdef) (i64.const 0x123456789abcdef)) (i64.const 582))
+(assert_return (invoke "shr_u" (i64.const 0x123456789abcdef) (i64.const 0xfedcba9876543210)) (i64.const 0x123456789ab))
+(assert_return (invoke "shr_u" (i64.const 0x123456789abcdef) (i64.const 63)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 0x123456789abcdef) (i64.const 64)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "shr_u" (i64.const 0x123456789abcdef) (i64.const 65)) (i64.const 0x91a2b3c4d5e6f7))
+(assert_return (invoke "shr_u" (i64.const 0x123456789abcdef) (i64.const 0xfffffffffff5a5a)) (i64.const 0x48d159e2))
+(assert_return (invoke "shr_u" (i64.const 0xfedcba9876543210) (i64.const 0)) (i64.const 0xfedcba9876543210))
+(assert_return (invoke "shr_u" (i64.const 0xfedcba9876543210) (i64.const 1)) (i64.const 0x7f6e5d4c3b2a1908))
+(assert_return (invoke "shr_u" (i64.const 0xfedcba9876543210) (i64.const -1)) (i64.const 1))
+(assert_return (invoke "shr_u" (i64.const 0xfedcba9876543210) (i64.const 2)) (i64.const 0x3fb72ea61d950c84))
+(assert_return (invoke "shr_u" (i64.const 0xfedcba9876543210) (i64.const 3)) (i64.const 0x1fdb97530eca8642))
+(assert_return (invoke "shr_u" (i64.const 0xfedcba9876543210) (i64.const 0x7fffffffffffffff)) (i64.const 1))
+(assert_return (invoke "shr_u" (i64.const 0xfedcba9876543210) (i64.const 0x8000000000000000)) (i64.const 0xfedcba9876543210))
+(assert_return (invoke "shr_u" (i64.const 0xfedcba9876543210) (i64.const 0x8000000000000001)) (i64.const 0x7f6e5d4c3b2a1908))
+(assert_return (invoke "shr_u" (i64.const 0xfedcba9876543210) (i64.const 0x4000000000000000)) (i64.const 0xfedcba9876543210))
+(assert_return (invoke "shr_u" (i64.const 0xfedcba9876543210) (i64.const 0x123456789abcdef)) (i64.const 0x1fdb9))
+(assert_return (invoke "shr_u" (i64.const 0xfedcba9876543210) (i64.const 0xfedcba9876543210)) (i64.const 0xfedcba987654))
+(assert_return (invoke "shr_u" (i64.const 0xfedcba9876543210) (i64.const 63)) (i64.const 1))
+(assert_return (invoke "shr_u" (i64.const 0xfedcba9876543210) (i64.const 64)) (i64.const 0xfedcba9876543210))
+(assert_return (invoke "shr_u" (i64.const 0xfedcba9876543210) (i64.const 65)) (i64.const 0x7f6e5d4c3b2a1908))
+(assert_return (invoke "shr_u" (i64.const 0xfedcba9876543210) (i64.const 0xfffffffffff5a5a)) (i64.const 0x3fb72ea61d))
+(assert_return (invoke "shr_u" (i64.const 63) (i64.const 0)) (i64.const 63))
+(assert_return (invoke "shr_u" (i64.const 63) (i64.const 1)) (i64.const 31))
+(assert_return (invoke "shr_u" (i64.const 63) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 63) (i64.const 2)) (i64.const 15))
+(assert_return (invoke "shr_u" (i64.const 63) (i64.const 3)) (i64.const 7))
+(assert_return (invoke "shr_u" (i64.const 63) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 63) (i64.const 0x8000000000000000)) (i64.const 63))
+(assert_return (invoke "shr_u" (i64.const 63) (i64.const 0x8000000000000001)) (i64.const 31))
+(assert_return (invoke "shr_u" (i64.const 63) (i64.const 0x4000000000000000)) (i64.const 63))
+(assert_return (invoke "shr_u" (i64.const 63) (i64.const 0x123456789abcdef)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 63) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 63) (i64.const 63)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 63) (i64.const 64)) (i64.const 63))
+(assert_return (invoke "shr_u" (i64.const 63) (i64.const 65)) (i64.const 31))
+(assert_return (invoke "shr_u" (i64.const 63) (i64.const 0xfffffffffff5a5a)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 64) (i64.const 0)) (i64.const 64))
+(assert_return (invoke "shr_u" (i64.const 64) (i64.const 1)) (i64.const 32))
+(assert_return (invoke "shr_u" (i64.const 64) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 64) (i64.const 2)) (i64.const 16))
+(assert_return (invoke "shr_u" (i64.const 64) (i64.const 3)) (i64.const 8))
+(assert_return (invoke "shr_u" (i64.const 64) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 64) (i64.const 0x8000000000000000)) (i64.const 64))
+(assert_return (invoke "shr_u" (i64.const 64) (i64.const 0x8000000000000001)) (i64.const 32))
+(assert_return (invoke "shr_u" (i64.const 64) (i64.const 0x4000000000000000)) (i64.const 64))
+(assert_return (invoke "shr_u" (i64.const 64) (i64.const 0x123456789abcdef)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 64) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 64) (i64.const 63)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 64) (i64.const 64)) (i64.const 64))
+(assert_return (invoke "shr_u" (i64.const 64) (i64.const 65)) (i64.const 32))
+(assert_return (invoke "shr_u" (i64.const 64) (i64.const 0xfffffffffff5a5a)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 65) (i64.const 0)) (i64.const 65))
+(assert_return (invoke "shr_u" (i64.const 65) (i64.const 1)) (i64.const 32))
+(assert_return (invoke "shr_u" (i64.const 65) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 65) (i64.const 2)) (i64.const 16))
+(assert_return (invoke "shr_u" (i64.const 65) (i64.const 3)) (i64.const 8))
+(assert_return (invoke "shr_u" (i64.const 65) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 65) (i64.const 0x8000000000000000)) (i64.const 65))
+(assert_return (invoke "shr_u" (i64.const 65) (i64.const 0x8000000000000001)) (i64.const 32))
+(assert_return (invoke "shr_u" (i64.const 65) (i64.const 0x4000000000000000)) (i64.const 65))
+(assert_return (invoke "shr_u" (i64.const 65) (i64.const 0x123456789abcdef)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 65) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 65) (i64.const 63)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 65) (i64.const 64)) (i64.const 65))
+(assert_return (invoke "shr_u" (i64.const 65) (i64.const 65)) (i64.const 32))
+(assert_return (invoke "shr_u" (i64.const 65) (i64.const 0xfffffffffff5a5a)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 0xfffffffffff5a5a) (i64.const 0)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "shr_u" (i64.const 0xfffffffffff5a5a) (i64.const 1)) (i64.const 0x7ffffffffffad2d))
+(assert_return (invoke "shr_u" (i64.const 0xfffffffffff5a5a) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 0xfffffffffff5a5a) (i64.const 2)) (i64.const 0x3ffffffffffd696))
+(assert_return (invoke "shr_u" (i64.const 0xfffffffffff5a5a) (i64.const 3)) (i64.const 0x1ffffffffffeb4b))
+(assert_return (invoke "shr_u" (i64.const 0xfffffffffff5a5a) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 0xfffffffffff5a5a) (i64.const 0x8000000000000000)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "shr_u" (i64.const 0xfffffffffff5a5a) (i64.const 0x8000000000000001)) (i64.const 0x7ffffffffffad2d))
+(assert_return (invoke "shr_u" (i64.const 0xfffffffffff5a5a) (i64.const 0x4000000000000000)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "shr_u" (i64.const 0xfffffffffff5a5a) (i64.const 0x123456789abcdef)) (i64.const 8191))
+(assert_return (invoke "shr_u" (i64.const 0xfffffffffff5a5a) (i64.const 0xfedcba9876543210)) (i64.const 0xfffffffffff))
+(assert_return (invoke "shr_u" (i64.const 0xfffffffffff5a5a) (i64.const 63)) (i64.const 0))
+(assert_return (invoke "shr_u" (i64.const 0xfffffffffff5a5a) (i64.const 64)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "shr_u" (i64.const 0xfffffffffff5a5a) (i64.const 65)) (i64.const 0x7ffffffffffad2d))
+(assert_return (invoke "shr_u" (i64.const 0xfffffffffff5a5a) (i64.const 0xfffffffffff5a5a)) (i64.const 0x3ffffffff))
+(assert_return (invoke "shr_u" (i64.const 0x25f221e3442011c7) (i64.const 0x151b6447af443722)) (i64.const 0x97c8878))
+(assert_return (invoke "shr_u" (i64.const 0x142f1a2e6d2580eb) (i64.const 0x2e63c80f1be53344)) (i64.const 0x142f1a2e6d2580e))
+(assert_return (invoke "shr_u" (i64.const 0x232aac0d0ce1440e) (i64.const 0x485cf4549b74b248)) (i64.const 0x232aac0d0ce144))
+(assert_return (invoke "shr_u" (i64.const 0x1ec94be42346a5d3) (i64.const 0x4facf42926bd9ef9)) (i64.const 15))
+(assert_return (invoke "shr_u" (i64.const 0x713cb603435ea88f) (i64.const 0xe705196489008c22)) (i64.const 0x1c4f2d80))
+(assert_return (invoke "shr_u" (i64.const 0xd09f047e14adc684) (i64.const 0x7614e7b554a57535)) (i64.const 1668))
+(assert_return (invoke "shr_u" (i64.const 0xa4b4fbfa48c2a1f2) (i64.const 0x6007a58d40a7f5b2)) (i64.const 10541))
+(assert_return (invoke "shr_u" (i64.const 0x8d5602c5bfc19146) (i64.const 0x2593302776e40362)) (i64.const 0x235580b1))
+(assert_return (invoke "shr_u" (i64.const 0x7c00934cc90eceb3) (i64.const 0x56f79389ee6ad212)) (i64.const 0x1f0024d33243))
+(assert_return (invoke "shr_u" (i64.const 0xd2b318f4f248b398) (i64.const 0xf8dba97b3cbae4cb)) (i64.const 0x1a56631e9e4916))
+(assert_return (invoke "shr_u" (i64.const 0xcb34f77cae011378) (i64.const 0xa9ca2c2323413fca)) (i64.const 0x32cd3ddf2b8044))
+(assert_return (invoke "shr_u" (i64.const 0xbb7aff97092738d5) (i64.const 0x4b5bc8d4a1d446d6)) (i64.const 0x2edebfe5c24))
+(assert_return (invoke "shr_u" (i64.const 0x5241ca878795f5e3) (i64.const 0x4fa9661029cd562a)) (i64.const 0x149072))
+(assert_return (invoke "shr_u" (i64.const 0xaeed47a14b73e4ad) (i64.const 0x305c8401b75e9ac7)) (i64.const 0x15dda8f4296e7c9))
+(assert_return (invoke "shr_u" (i64.const 0xeec463479c4158db) (i64.const 0xa194121c1c013fb9)) (i64.const 119))
+(assert_return (invoke "shr_u" (i64.const 0x6b02a5d6dbb3b945) (i64.const 0x7c303e2049ea714)) (i64.const 0x6b02a5d6dbb))
+
+(assert_return (invoke "rotl" (i64.const 0) (i64.const 0)) (i64.const 0))
+(assert_return (invoke "rotl" (i64.const 0) (i64.const 1)) (i64.const 0))
+(assert_return (invoke "rotl" (i64.const 0) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "rotl" (i64.const 0) (i64.const 2)) (i64.const 0))
+(assert_return (invoke "rotl" (i64.const 0) (i64.const 3)) (i64.const 0))
+(assert_return (invoke "rotl" (i64.const 0) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "rotl" (i64.const 0) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "rotl" (i64.const 0) (i64.const 0x8000000000000001)) (i64.const 0))
+(assert_return (invoke "rotl" (i64.const 0) (i64.const 0x4000000000000000)) (i64.const 0))
+(assert_return (invoke "rotl" (i64.const 0) (i64.const 0x123456789abcdef)) (i64.const 0))
+(assert_return (invoke "rotl" (i64.const 0) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "rotl" (i64.const 0) (i64.const 63)) (i64.const 0))
+(assert_return (invoke "rotl" (i64.const 0) (i64.const 64)) (i64.const 0))
+(assert_return (invoke "rotl" (i64.const 0) (i64.const 65)) (i64.const 0))
+(assert_return (invoke "rotl" (i64.const 0) (i64.const 0xfffffffffff5a5a)) (i64.const 0))
+(assert_return (invoke "rotl" (i64.const 1) (i64.const 0)) (i64.const 1))
+(assert_return (invoke "rotl" (i64.const 1) (i64.const 1)) (i64.const 2))
+(assert_return (invoke "rotl" (i64.const 1) (i64.const -1)) (i64.const 0x8000000000000000))
+(assert_return (invoke "rotl" (i64.const 1) (i64.const 2)) (i64.const 4))
+(assert_return (invoke "rotl" (i64.const 1) (i64.const 3)) (i64.const 8))
+(assert_return (invoke "rotl" (i64.const 1) (i64.const 0x7fffffffffffffff)) (i64.const 0x8000000000000000))
+(assert_return (invoke "rotl" (i64.const 1) (i64.const 0x8000000000000000)) (i64.const 1))
+(assert_return (invoke "rotl" (i64.const 1) (i64.const 0x8000000000000001)) (i64.const 2))
+(assert_return (invoke "rotl" (i64.const 1) (i64.const 0x4000000000000000)) (i64.const 1))
+(assert_return (invoke "rotl" (i64.const 1) (i64.const 0x123456789abcdef)) (i64.const 0x800000000000))
+(assert_return (invoke "rotl" (i64.const 1) (i64.const 0xfedcba9876543210)) (i64.const 0x10000))
+(assert_return (invoke "rotl" (i64.const 1) (i64.const 63)) (i64.const 0x8000000000000000))
+(assert_return (invoke "rotl" (i64.const 1) (i64.const 64)) (i64.const 1))
+(assert_return (invoke "rotl" (i64.const 1) (i64.const 65)) (i64.const 2))
+(assert_return (invoke "rotl" (i64.const 1) (i64.const 0xfffffffffff5a5a)) (i64.const 0x4000000))
+(assert_return (invoke "rotl" (i64.const -1) (i64.const 0)) (i64.const -1))
+(assert_return (invoke "rotl" (i64.const -1) (i64.const 1)) (i64.const -1))
+(assert_return (invoke "rotl" (i64.const -1) (i64.const -1)) (i64.const -1))
+(assert_return (invoke "rotl" (i64.const -1) (i64.const 2)) (i64.const -1))
+(assert_return (invoke "rotl" (i64.const -1) (i64.const 3)) (i64.const -1))
+(assert_return (invoke "rotl" (i64.const -1) (i64.const 0x7fffffffffffffff)) (i64.const -1))
+(assert_return (invoke "rotl" (i64.const -1) (i64.const 0x8000000000000000)) (i64.const -1))
+(assert_return (invoke "rotl" (i64.const -1) (i64.const 0x8000000000000001)) (i64.const -1))
+(assert_return (invoke "rotl" (i64.const -1) (i64.const 0x4000000000000000)) (i64.const -1))
+(assert_return (invoke "rotl" (i64.const -1) (i64.const 0x123456789abcdef)) (i64.const -1))
+(assert_return (invoke "rotl" (i64.const -1) (i64.const 0xfedcba9876543210)) (i64.const -1))
+(assert_return (invoke "rotl" (i64.const -1) (i64.const 63)) (i64.const -1))
+(assert_return (invoke "rotl" (i64.const -1) (i64.const 64)) (i64.const -1))
+(assert_return (invoke "rotl" (i64.const -1) (i64.const 65)) (i64.const -1))
+(assert_return (invoke "rotl" (i64.const -1) (i64.const 0xfffffffffff5a5a)) (i64.const -1))
+(assert_return (invoke "rotl" (i64.const 2) (i64.const 0)) (i64.const 2))
+(assert_return (invoke "rotl" (i64.const 2) (i64.const 1)) (i64.const 4))
+(assert_return (invoke "rotl" (i64.const 2) (i64.const -1)) (i64.const 1))
+(assert_return (invoke "rotl" (i64.const 2) (i64.const 2)) (i64.const 8))
+(assert_return (invoke "rotl" (i64.const 2) (i64.const 3)) (i64.const 16))
+(assert_return (invoke "rotl" (i64.const 2) (i64.const 0x7fffffffffffffff)) (i64.const 1))
+(assert_return (invoke "rotl" (i64.const 2) (i64.const 0x8000000000000000)) (i64.const 2))
+(assert_return (invoke "rotl" (i64.const 2) (i64.const 0x8000000000000001)) (i64.const 4))
+(assert_return (invoke "rotl" (i64.const 2) (i64.const 0x4000000000000000)) (i64.const 2))
+(assert_return (invoke "rotl" (i64.const 2) (i64.const 0x123456789abcdef)) (i64.const 0x1000000000000))
+(assert_return (invoke "rotl" (i64.const 2) (i64.const 0xfedcba9876543210)) (i64.const 0x20000))
+(assert_return (invoke "rotl" (i64.const 2) (i64.const 63)) (i64.const 1))
+(assert_return (invoke "rotl" (i64.const 2) (i64.const 64)) (i64.const 2))
+(assert_return (invoke "rotl" (i64.const 2) (i64.const 65)) (i64.const 4))
+(assert_return (invoke "rotl" (i64.const 2) (i64.const 0xfffffffffff5a5a)) (i64.const 0x8000000))
+(assert_return (invoke "rotl" (i64.const 3) (i64.const 0)) (i64.const 3))
+(assert_return (invoke "rotl" (i64.const 3) (i64.const 1)) (i64.const 6))
+(assert_return (invoke "rotl" (i64.const 3) (i64.const -1)) (i64.const 0x8000000000000001))
+(assert_return (invoke "rotl" (i64.const 3) (i64.const 2)) (i64.const 12))
+(assert_return (invoke "rotl" (i64.const 3) (i64.const 3)) (i64.const 24))
+(assert_return (invoke "rotl" (i64.const 3) (i64.const 0x7fffffffffffffff)) (i64.const 0x8000000000000001))
+(assert_return (invoke "rotl" (i64.const 3) (i64.const 0x8000000000000000)) (i64.const 3))
+(assert_return (invoke "rotl" (i64.const 3) (i64.const 0x8000000000000001)) (i64.const 6))
+(assert_return (invoke "rotl" (i64.const 3) (i64.const 0x4000000000000000)) (i64.const 3))
+(assert_return (invoke "rotl" (i64.const 3) (i64.const 0x123456789abcdef)) (i64.const 0x1800000000000))
+(assert_return (invoke "rotl" (i64.const 3) (i64.const 0xfedcba9876543210)) (i64.const 0x30000))
+(assert_return (invoke "rotl" (i64.const 3) (i64.const 63)) (i64.const 0x8000000000000001))
+(assert_return (invoke "rotl" (i64.const 3) (i64.const 64)) (i64.const 3))
+(assert_return (invoke "rotl" (i64.const 3) (i64.const 65)) (i64.const 6))
+(assert_return (invoke "rotl" (i64.const 3) (i64.const 0xfffffffffff5a5a)) (i64.const 0xc000000))
+(assert_return (invoke "rotl" (i64.const 0x7fffffffffffffff) (i64.const 0)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "rotl" (i64.const 0x7fffffffffffffff) (i64.const 1)) (i64.const -2))
+(assert_return (invoke "rotl" (i64.const 0x7fffffffffffffff) (i64.const -1)) (i64.const 0xbfffffffffffffff))
+(assert_return (invoke "rotl" (i64.const 0x7fffffffffffffff) (i64.const 2)) (i64.const -3))
+(assert_return (invoke "rotl" (i64.const 0x7fffffffffffffff) (i64.const 3)) (i64.const -5))
+(assert_return (invoke "rotl" (i64.const 0x7fffffffffffffff) (i64.const 0x7fffffffffffffff)) (i64.const 0xbfffffffffffffff))
+(assert_return (invoke "rotl" (i64.const 0x7fffffffffffffff) (i64.const 0x8000000000000000)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "rotl" (i64.const 0x7fffffffffffffff) (i64.const 0x8000000000000001)) (i64.const -2))
+(assert_return (invoke "rotl" (i64.const 0x7fffffffffffffff) (i64.const 0x4000000000000000)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "rotl" (i64.const 0x7fffffffffffffff) (i64.const 0x123456789abcdef)) (i64.const 0xffffbfffffffffff))
+(assert_return (invoke "rotl" (i64.const 0x7fffffffffffffff) (i64.const 0xfedcba9876543210)) (i64.const -32769))
+(assert_return (invoke "rotl" (i64.const 0x7fffffffffffffff) (i64.const 63)) (i64.const 0xbfffffffffffffff))
+(assert_return (invoke "rotl" (i64.const 0x7fffffffffffffff) (i64.const 64)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "rotl" (i64.const 0x7fffffffffffffff) (i64.const 65)) (i64.const -2))
+(assert_return (invoke "rotl" (i64.const 0x7fffffffffffffff) (i64.const 0xfffffffffff5a5a)) (i64.const 0xfffffffffdffffff))
+(assert_return (invoke "rotl" (i64.const 0x8000000000000000) (i64.const 0)) (i64.const 0x8000000000000000))
+(assert_return (invoke "rotl" (i64.const 0x8000000000000000) (i64.const 1)) (i64.const 1))
+(assert_return (invoke "rotl" (i64.const 0x8000000000000000) (i64.const -1)) (i64.const 0x4000000000000000))
+(assert_return (invoke "rotl" (i64.const 0x8000000000000000) (i64.const 2)) (i64.const 2))
+(assert_return (invoke "rotl" (i64.const 0x8000000000000000) (i64.const 3)) (i64.const 4))
+(assert_return (invoke "rotl" (i64.const 0x8000000000000000) (i64.const 0x7fffffffffffffff)) (i64.const 0x4000000000000000))
+(assert_return (invoke "rotl" (i64.const 0x8000000000000000) (i64.const 0x8000000000000000)) (i64.const 0x8000000000000000))
+(assert_return (invoke "rotl" (i64.const 0x8000000000000000) (i64.const 0x8000000000000001)) (i64.const 1))
+(assert_return (invoke "rotl" (i64.const 0x8000000000000000) (i64.const 0x4000000000000000)) (i64.const 0x8000000000000000))
+(assert_return (invoke "rotl" (i64.const 0x8000000000000000) (i64.const 0x123456789abcdef)) (i64.const 0x400000000000))
+(assert_return (invoke "rotl" (i64.const 0x8000000000000000) (i64.const 0xfedcba9876543210)) (i64.const 32768))
+(assert_return (invoke "rotl" (i64.const 0x8000000000000000) (i64.const 63)) (i64.const 0x4000000000000000))
+(assert_return (invoke "rotl" (i64.const 0x8000000000000000) (i64.const 64)) (i64.const 0x8000000000000000))
+(assert_return (invoke "rotl" (i64.const 0x8000000000000000) (i64.const 65)) (i64.const 1))
+(assert_return (invoke "rotl" (i64.const 0x8000000000000000) (i64.const 0xfffffffffff5a5a)) (i64.const 0x2000000))
+(assert_return (invoke "rotl" (i64.const 0x8000000000000001) (i64.const 0)) (i64.const 0x8000000000000001))
+(assert_return (invoke "rotl" (i64.const 0x8000000000000001) (i64.const 1)) (i64.const 3))
+(assert_return (invoke "rotl" (i64.const 0x8000000000000001) (i64.const -1)) (i64.const 0xc000000000000000))
+(assert_return (invoke "rotl" (i64.const 0x8000000000000001) (i64.const 2)) (i64.const 6))
+(assert_return (invoke "rotl" (i64.const 0x8000000000000001) (i64.const 3)) (i64.const 12))
+(assert_return (invoke "rotl" (i64.const 0x8000000000000001) (i64.const 0x7fffffffffffffff)) (i64.const 0xc000000000000000))
+(assert_return (invoke "rotl" (i64.const 0x8000000000000001) (i64.const 0x8000000000000000)) (i64.const 0x8000000000000001))
+(assert_return (invoke "rotl" (i64.const 0x8000000000000001) (i64.const 0x8000000000000001)) (i64.const 3))
+(assert_return (invoke "rotl" (i64.const 0x8000000000000001) (i64.const 0x4000000000000000)) (i64.const 0x8000000000000001))
+(assert_return (invoke "rotl" (i64.const 0x8000000000000001) (i64.const 0x123456789abcdef)) (i64.const 0xc00000000000))
+(assert_return (invoke "rotl" (i64.const 0x8000000000000001) (i64.const 0xfedcba9876543210)) (i64.const 0x18000))
+(assert_return (invoke "rotl" (i64.const 0x8000000000000001) (i64.const 63)) (i64.const 0xc000000000000000))
+(assert_return (invoke "rotl" (i64.const 0x8000000000000001) (i64.const 64)) (i64.const 0x8000000000000001))
+(assert_return (invoke "rotl" (i64.const 0x8000000000000001) (i64.const 65)) (i64.const 3))
+(assert_return (invoke "rotl" (i64.const 0x8000000000000001) (i64.const 0xfffffffffff5a5a)) (i64.const 0x6000000))
+(assert_return (invoke "rotl" (i64.const 0x4000000000000000) (i64.const 0)) (i64.const 0x4000000000000000))
+(assert_return (invoke "rotl" (i64.const 0x4000000000000000) (i64.const 1)) (i64.const 0x8000000000000000))
+(assert_return (invoke "rotl" (i64.const 0x4000000000000000) (i64.const -1)) (i64.const 0x2000000000000000))
+(assert_return (invoke "rotl" (i64.const 0x4000000000000000) (i64.const 2)) (i64.const 1))
+(assert_return (invoke "rotl" (i64.const 0x4000000000000000) (i64.const 3)) (i64.const 2))
+(assert_return (invoke "rotl" (i64.const 0x4000000000000000) (i64.const 0x7fffffffffffffff)) (i64.const 0x2000000000000000))
+(assert_return (invoke "rotl" (i64.const 0x4000000000000000) (i64.const 0x8000000000000000)) (i64.const 0x4000000000000000))
+(assert_return (invoke "rotl" (i64.const 0x4000000000000000) (i64.const 0x8000000000000001)) (i64.const 0x8000000000000000))
+(assert_return (invoke "rotl" (i64.const 0x4000000000000000) (i64.const 0x4000000000000000)) (i64.const 0x4000000000000000))
+(assert_return (invoke "rotl" (i64.const 0x4000000000000000) (i64.const 0x123456789abcdef)) (i64.const 0x200000000000))
+(assert_return (invoke "rotl" (i64.const 0x4000000000000000) (i64.const 0xfedcba9876543210)) (i64.const 16384))
+(assert_return (invoke "rotl" (i64.const 0x4000000000000000) (i64.const 63)) (i64.const 0x2000000000000000))
+(assert_return (invoke "rotl" (i64.const 0x4000000000000000) (i64.const 64)) (i64.const 0x4000000000000000))
+(assert_return (invoke "rotl" (i64.const 0x4000000000000000) (i64.const 65)) (i64.const 0x8000000000000000))
+(assert_return (invoke "rotl" (i64.const 0x4000000000000000) (i64.const 0xfffffffffff5a5a)) (i64.const 0x1000000))
+(assert_return (invoke "rotl" (i64.const 0x123456789abcdef) (i64.const 0)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "rotl" (i64.const 0x123456789abcdef) (i64.const 1)) (i64.const 0x2468acf13579bde))
+(assert_return (invoke "rotl" (i64.const 0x123456789abcdef) (i64.const -1)) (i64.const 0x8091a2b3c4d5e6f7))
+(assert_return (invoke "rotl" (i64.const 0x123456789abcdef) (i64.const 2)) (i64.const 0x48d159e26af37bc))
+(assert_return (invoke "rotl" (i64.const 0x123456789abcdef) (i64.const 3)) (i64.const 0x91a2b3c4d5e6f78))
+(assert_return (invoke "rotl" (i64.const 0x123456789abcdef) (i64.const 0x7fffffffffffffff)) (i64.const 0x8091a2b3c4d5e6f7))
+(assert_return (invoke "rotl" (i64.const 0x123456789abcdef) (i64.const 0x8000000000000000)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "rotl" (i64.const 0x123456789abcdef) (i64.const 0x8000000000000001)) (i64.const 0x2468acf13579bde))
+(assert_return (invoke "rotl" (i64.const 0x123456789abcdef) (i64.const 0x4000000000000000)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "rotl" (i64.const 0x123456789abcdef) (i64.const 0x123456789abcdef)) (i64.const 0xe6f78091a2b3c4d5))
+(assert_return (invoke "rotl" (i64.const 0x123456789abcdef) (i64.const 0xfedcba9876543210)) (i64.const 0x456789abcdef0123))
+(assert_return (invoke "rotl" (i64.const 0x123456789abcdef) (i64.const 63)) (i64.const 0x8091a2b3c4d5e6f7))
+(assert_return (invoke "rotl" (i64.const 0x123456789abcdef) (i64.const 64)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "rotl" (i64.const 0x123456789abcdef) (i64.const 65)) (i64.const 0x2468acf13579bde))
+(assert_return (invoke "rotl" (i64.const 0x123456789abcdef) (i64.const 0xfffffffffff5a5a)) (i64.const 0x9e26af37bc048d15))
+(assert_return (invoke "rotl" (i64.const 0xfedcba9876543210) (i64.const 0)) (i64.const 0xfedcba9876543210))
+(assert_return (invoke "rotl" (i64.const 0xfedcba9876543210) (i64.const 1)) (i64.const 0xfdb97530eca86421))
+(assert_return (invoke "rotl" (i64.const 0xfedcba9876543210) (i64.const -1)) (i64.const 0x7f6e5d4c3b2a1908))
+(assert_return (invoke "rotl" (i64.const 0xfedcba9876543210) (i64.const 2)) (i64.const 0xfb72ea61d950c843))
+(assert_return (invoke "rotl" (i64.const 0xfedcba9876543210) (i64.const 3)) (i64.const 0xf6e5d4c3b2a19087))
+(assert_return (invoke "rotl" (i64.const 0xfedcba9876543210) (i64.const 0x7fffffffffffffff)) (i64.const 0x7f6e5d4c3b2a1908))
+(assert_return (invoke "rotl" (i64.const 0xfedcba9876543210) (i64.const 0x8000000000000000)) (i64.const 0xfedcba9876543210))
+(assert_return (invoke "rotl" (i64.const 0xfedcba9876543210) (i64.const 0x8000000000000001)) (i64.const 0xfdb97530eca86421))
+(assert_return (invoke "rotl" (i64.const 0xfedcba9876543210) (i64.const 0x4000000000000000)) (i64.const 0xfedcba9876543210))
+(assert_return (invoke "rotl" (i64.const 0xfedcba9876543210) (i64.const 0x123456789abcdef)) (i64.const 0x19087f6e5d4c3b2a))
+(assert_return (invoke "rotl" (i64.const 0xfedcba9876543210) (i64.const 0xfedcba9876543210)) (i64.const 0xba9876543210fedc))
+(assert_return (invoke "rotl" (i64.const 0xfedcba9876543210) (i64.const 63)) (i64.const 0x7f6e5d4c3b2a1908))
+(assert_return (invoke "rotl" (i64.const 0xfedcba9876543210) (i64.const 64)) (i64.const 0xfedcba9876543210))
+(assert_return (invoke "rotl" (i64.const 0xfedcba9876543210) (i64.const 65)) (i64.const 0xfdb97530eca86421))
+(assert_return (invoke "rotl" (i64.const 0xfedcba9876543210) (i64.const 0xfffffffffff5a5a)) (i64.const 0x61d950c843fb72ea))
+(assert_return (invoke "rotl" (i64.const 63) (i64.const 0)) (i64.const 63))
+(assert_return (invoke "rotl" (i64.const 63) (i64.const 1)) (i64.const 126))
+(assert_return (invoke "rotl" (i64.const 63) (i64.const -1)) (i64.const 0x800000000000001f))
+(assert_return (invoke "rotl" (i64.const 63) (i64.const 2)) (i64.const 252))
+(assert_return (invoke "rotl" (i64.const 63) (i64.const 3)) (i64.const 504))
+(assert_return (invoke "rotl" (i64.const 63) (i64.const 0x7fffffffffffffff)) (i64.const 0x800000000000001f))
+(assert_return (invoke "rotl" (i64.const 63) (i64.const 0x8000000000000000)) (i64.const 63))
+(assert_return (invoke "rotl" (i64.const 63) (i64.const 0x8000000000000001)) (i64.const 126))
+(assert_return (invoke "rotl" (i64.const 63) (i64.const 0x4000000000000000)) (i64.const 63))
+(assert_return (invoke "rotl" (i64.const 63) (i64.const 0x123456789abcdef)) (i64.const 0x1f800000000000))
+(assert_return (invoke "rotl" (i64.const 63) (i64.const 0xfedcba9876543210)) (i64.const 0x3f0000))
+(assert_return (invoke "rotl" (i64.const 63) (i64.const 63)) (i64.const 0x800000000000001f))
+(assert_return (invoke "rotl" (i64.const 63) (i64.const 64)) (i64.const 63))
+(assert_return (invoke "rotl" (i64.const 63) (i64.const 65)) (i64.const 126))
+(assert_return (invoke "rotl" (i64.const 63) (i64.const 0xfffffffffff5a5a)) (i64.const 0xfc000000))
+(assert_return (invoke "rotl" (i64.const 64) (i64.const 0)) (i64.const 64))
+(assert_return (invoke "rotl" (i64.const 64) (i64.const 1)) (i64.const 128))
+(assert_return (invoke "rotl" (i64.const 64) (i64.const -1)) (i64.const 32))
+(assert_return (invoke "rotl" (i64.const 64) (i64.const 2)) (i64.const 256))
+(assert_return (invoke "rotl" (i64.const 64) (i64.const 3)) (i64.const 512))
+(assert_return (invoke "rotl" (i64.const 64) (i64.const 0x7fffffffffffffff)) (i64.const 32))
+(assert_return (invoke "rotl" (i64.const 64) (i64.const 0x8000000000000000)) (i64.const 64))
+(assert_return (invoke "rotl" (i64.const 64) (i64.const 0x8000000000000001)) (i64.const 128))
+(assert_return (invoke "rotl" (i64.const 64) (i64.const 0x4000000000000000)) (i64.const 64))
+(assert_return (invoke "rotl" (i64.const 64) (i64.const 0x123456789abcdef)) (i64.const 0x20000000000000))
+(assert_return (invoke "rotl" (i64.const 64) (i64.const 0xfedcba9876543210)) (i64.const 0x400000))
+(assert_return (invoke "rotl" (i64.const 64) (i64.const 63)) (i64.const 32))
+(assert_return (invoke "rotl" (i64.const 64) (i64.const 64)) (i64.const 64))
+(assert_return (invoke "rotl" (i64.const 64) (i64.const 65)) (i64.const 128))
+(assert_return (invoke "rotl" (i64.const 64) (i64.const 0xfffffffffff5a5a)) (i64.const 0x100000000))
+(assert_return (invoke "rotl" (i64.const 65) (i64.const 0)) (i64.const 65))
+(assert_return (invoke "rotl" (i64.const 65) (i64.const 1)) (i64.const 130))
+(assert_return (invoke "rotl" (i64.const 65) (i64.const -1)) (i64.const 0x8000000000000020))
+(assert_return (invoke "rotl" (i64.const 65) (i64.const 2)) (i64.const 260))
+(assert_return (invoke "rotl" (i64.const 65) (i64.const 3)) (i64.const 520))
+(assert_return (invoke "rotl" (i64.const 65) (i64.const 0x7fffffffffffffff)) (i64.const 0x8000000000000020))
+(assert_return (invoke "rotl" (i64.const 65) (i64.const 0x8000000000000000)) (i64.const 65))
+(assert_return (invoke "rotl" (i64.const 65) (i64.const 0x8000000000000001)) (i64.const 130))
+(assert_return (invoke "rotl" (i64.const 65) (i64.const 0x4000000000000000)) (i64.const 65))
+(assert_return (invoke "rotl" (i64.const 65) (i64.const 0x123456789abcdef)) (i64.const 0x20800000000000))
+(assert_return (invoke "rotl" (i64.const 65) (i64.const 0xfedcba9876543210)) (i64.const 0x410000))
+(assert_return (invoke "rotl" (i64.const 65) (i64.const 63)) (i64.const 0x8000000000000020))
+(assert_return (invoke "rotl" (i64.const 65) (i64.const 64)) (i64.const 65))
+(assert_return (invoke "rotl" (i64.const 65) (i64.const 65)) (i64.const 130))
+(assert_return (invoke "rotl" (i64.const 65) (i64.const 0xfffffffffff5a5a)) (i64.const 0x104000000))
+(assert_return (invoke "rotl" (i64.const 0xfffffffffff5a5a) (i64.const 0)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "rotl" (i64.const 0xfffffffffff5a5a) (i64.const 1)) (i64.const 0x1ffffffffffeb4b4))
+(assert_return (invoke "rotl" (i64.const 0xfffffffffff5a5a) (i64.const -1)) (i64.const 0x7ffffffffffad2d))
+(assert_return (invoke "rotl" (i64.const 0xfffffffffff5a5a) (i64.const 2)) (i64.const 0x3ffffffffffd6968))
+(assert_return (invoke "rotl" (i64.const 0xfffffffffff5a5a) (i64.const 3)) (i64.const 0x7ffffffffffad2d0))
+(assert_return (invoke "rotl" (i64.const 0xfffffffffff5a5a) (i64.const 0x7fffffffffffffff)) (i64.const 0x7ffffffffffad2d))
+(assert_return (invoke "rotl" (i64.const 0xfffffffffff5a5a) (i64.const 0x8000000000000000)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "rotl" (i64.const 0xfffffffffff5a5a) (i64.const 0x8000000000000001)) (i64.const 0x1ffffffffffeb4b4))
+(assert_return (invoke "rotl" (i64.const 0xfffffffffff5a5a) (i64.const 0x4000000000000000)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "rotl" (i64.const 0xfffffffffff5a5a) (i64.const 0x123456789abcdef)) (i64.const 0xad2d07ffffffffff))
+(assert_return (invoke "rotl" (i64.const 0xfffffffffff5a5a) (i64.const 0xfedcba9876543210)) (i64.const 0xffffffff5a5a0fff))
+(assert_return (invoke "rotl" (i64.const 0xfffffffffff5a5a) (i64.const 63)) (i64.const 0x7ffffffffffad2d))
+(assert_return (invoke "rotl" (i64.const 0xfffffffffff5a5a) (i64.const 64)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "rotl" (i64.const 0xfffffffffff5a5a) (i64.const 65)) (i64.const 0x1ffffffffffeb4b4))
+(assert_return (invoke "rotl" (i64.const 0xfffffffffff5a5a) (i64.const 0xfffffffffff5a5a)) (i64.const 0xfffffd69683fffff))
+(assert_return (invoke "rotl" (i64.const 0xad08fdd267a80e8f) (i64.const 0x32fbbc729c5a6f6e)) (i64.const 0x3a3eb423f7499ea))
+(assert_return (invoke "rotl" (i64.const 0x795776793d66e535) (i64.const 0xb2471c8128547d2a)) (i64.const 0x9b94d5e55dd9e4f5))
+(assert_return (invoke "rotl" (i64.const 0x45b6b44985ab30d9) (i64.const 0xdda663ede80504b5)) (i64.const 0x1b28b6d68930b566))
+(assert_return (invoke "rotl" (i64.const 0x333a33b728bcfb30) (i64.const 0x84fbc1291bd172a9)) (i64.const 0x79f6606674676e51))
+(assert_return (invoke "rotl" (i64.const 0x92312884ea6dd31) (i64.const 0x68e702e7f70046bb)) (i64.const 0x88491894427536e9))
+(assert_return (invoke "rotl" (i64.const 0x1ebc07b00b4a38f0) (i64.const 0x44bffb64984f6dd6)) (i64.const 0xec02d28e3c07af01))
+(assert_return (invoke "rotl" (i64.const 0xf1216aafa5df4199) (i64.const 0x3cfd31def2a06725)) (i64.const 0xbbe8333e242d55f4))
+(assert_return (invoke "rotl" (i64.const 0x55988d252240f60e) (i64.const 0x4e2c6805c00321e7)) (i64.const 0x207b072acc469291))
+(assert_return (invoke "rotl" (i64.const 0x52d2ec356d8c04dd) (i64.const 0x7758e171e50371f9)) (i64.const 0xbaa5a5d86adb1809))
+(assert_return (invoke "rotl" (i64.const 0x8b861607eeaf785b) (i64.const 0x5e3cca9dbf9400)) (i64.const 0x8b861607eeaf785b))
+(assert_return (invoke "rotl" (i64.const 0x71a2d874a0bec38a) (i64.const 0x5cd2e9181d91f5fd)) (i64.const 0x4e345b0e9417d871))
+(assert_return (invoke "rotl" (i64.const 0x63990f944f7aac11) (i64.const 0x379dffcde88af28b)) (i64.const 0xc87ca27bd5608b1c))
+(assert_return (invoke "rotl" (i64.const 0x2d660968a422de01) (i64.const 0xca0fc0984217a6fc)) (i64.const 0x12d660968a422de0))
+(assert_return (invoke "rotl" (i64.const 0xb3dd97131558255) (i64.const 0x65846af5d0f02501)) (i64.const 0x167bb2e262ab04aa))
+(assert_return (invoke "rotl" (i64.const 0xe109bddecab0dbc7) (i64.const 0xd49bce98a4c58045)) (i64.const 0x2137bbd9561b78fc))
+(assert_return (invoke "rotl" (i64.const 0x1739f63d080274c) (i64.const 0xbd24147f35011970)) (i64.const 0x274c01739f63d080))
+
+(assert_return (invoke "rotr" (i64.const 0) (i64.const 0)) (i64.const 0))
+(assert_return (invoke "rotr" (i64.const 0) (i64.const 1)) (i64.const 0))
+(assert_return (invoke "rotr" (i64.const 0) (i64.const -1)) (i64.const 0))
+(assert_return (invoke "rotr" (i64.const 0) (i64.const 2)) (i64.const 0))
+(assert_return (invoke "rotr" (i64.const 0) (i64.const 3)) (i64.const 0))
+(assert_return (invoke "rotr" (i64.const 0) (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "rotr" (i64.const 0) (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "rotr" (i64.const 0) (i64.const 0x8000000000000001)) (i64.const 0))
+(assert_return (invoke "rotr" (i64.const 0) (i64.const 0x4000000000000000)) (i64.const 0))
+(assert_return (invoke "rotr" (i64.const 0) (i64.const 0x123456789abcdef)) (i64.const 0))
+(assert_return (invoke "rotr" (i64.const 0) (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "rotr" (i64.const 0) (i64.const 63)) (i64.const 0))
+(assert_return (invoke "rotr" (i64.const 0) (i64.const 64)) (i64.const 0))
+(assert_return (invoke "rotr" (i64.const 0) (i64.const 65)) (i64.const 0))
+(assert_return (invoke "rotr" (i64.const 0) (i64.const 0xfffffffffff5a5a)) (i64.const 0))
+(assert_return (invoke "rotr" (i64.const 1) (i64.const 0)) (i64.const 1))
+(assert_return (invoke "rotr" (i64.const 1) (i64.const 1)) (i64.const 0x8000000000000000))
+(assert_return (invoke "rotr" (i64.const 1) (i64.const -1)) (i64.const 2))
+(assert_return (invoke "rotr" (i64.const 1) (i64.const 2)) (i64.const 0x4000000000000000))
+(assert_return (invoke "rotr" (i64.const 1) (i64.const 3)) (i64.const 0x2000000000000000))
+(assert_return (invoke "rotr" (i64.const 1) (i64.const 0x7fffffffffffffff)) (i64.const 2))
+(assert_return (invoke "rotr" (i64.const 1) (i64.const 0x8000000000000000)) (i64.const 1))
+(assert_return (invoke "rotr" (i64.const 1) (i64.const 0x8000000000000001)) (i64.const 0x8000000000000000))
+(assert_return (invoke "rotr" (i64.const 1) (i64.const 0x4000000000000000)) (i64.const 1))
+(assert_return (invoke "rotr" (i64.const 1) (i64.const 0x123456789abcdef)) (i64.const 0x20000))
+(assert_return (invoke "rotr" (i64.const 1) (i64.const 0xfedcba9876543210)) (i64.const 0x1000000000000))
+(assert_return (invoke "rotr" (i64.const 1) (i64.const 63)) (i64.const 2))
+(assert_return (invoke "rotr" (i64.const 1) (i64.const 64)) (i64.const 1))
+(assert_return (invoke "rotr" (i64.const 1) (i64.const 65)) (i64.const 0x8000000000000000))
+(assert_return (invoke "rotr" (i64.const 1) (i64.const 0xfffffffffff5a5a)) (i64.const 0x4000000000))
+(assert_return (invoke "rotr" (i64.const -1) (i64.const 0)) (i64.const -1))
+(assert_return (invoke "rotr" (i64.const -1) (i64.const 1)) (i64.const -1))
+(assert_return (invoke "rotr" (i64.const -1) (i64.const -1)) (i64.const -1))
+(assert_return (invoke "rotr" (i64.const -1) (i64.const 2)) (i64.const -1))
+(assert_return (invoke "rotr" (i64.const -1) (i64.const 3)) (i64.const -1))
+(assert_return (invoke "rotr" (i64.const -1) (i64.const 0x7fffffffffffffff)) (i64.const -1))
+(assert_return (invoke "rotr" (i64.const -1) (i64.const 0x8000000000000000)) (i64.const -1))
+(assert_return (invoke "rotr" (i64.const -1) (i64.const 0x8000000000000001)) (i64.const -1))
+(assert_return (invoke "rotr" (i64.const -1) (i64.const 0x4000000000000000)) (i64.const -1))
+(assert_return (invoke "rotr" (i64.const -1) (i64.const 0x123456789abcdef)) (i64.const -1))
+(assert_return (invoke "rotr" (i64.const -1) (i64.const 0xfedcba9876543210)) (i64.const -1))
+(assert_return (invoke "rotr" (i64.const -1) (i64.const 63)) (i64.const -1))
+(assert_return (invoke "rotr" (i64.const -1) (i64.const 64)) (i64.const -1))
+(assert_return (invoke "rotr" (i64.const -1) (i64.const 65)) (i64.const -1))
+(assert_return (invoke "rotr" (i64.const -1) (i64.const 0xfffffffffff5a5a)) (i64.const -1))
+(assert_return (invoke "rotr" (i64.const 2) (i64.const 0)) (i64.const 2))
+(assert_return (invoke "rotr" (i64.const 2) (i64.const 1)) (i64.const 1))
+(assert_return (invoke "rotr" (i64.const 2) (i64.const -1)) (i64.const 4))
+(assert_return (invoke "rotr" (i64.const 2) (i64.const 2)) (i64.const 0x8000000000000000))
+(assert_return (invoke "rotr" (i64.const 2) (i64.const 3)) (i64.const 0x4000000000000000))
+(assert_return (invoke "rotr" (i64.const 2) (i64.const 0x7fffffffffffffff)) (i64.const 4))
+(assert_return (invoke "rotr" (i64.const 2) (i64.const 0x8000000000000000)) (i64.const 2))
+(assert_return (invoke "rotr" (i64.const 2) (i64.const 0x8000000000000001)) (i64.const 1))
+(assert_return (invoke "rotr" (i64.const 2) (i64.const 0x4000000000000000)) (i64.const 2))
+(assert_return (invoke "rotr" (i64.const 2) (i64.const 0x123456789abcdef)) (i64.const 0x40000))
+(assert_return (invoke "rotr" (i64.const 2) (i64.const 0xfedcba9876543210)) (i64.const 0x2000000000000))
+(assert_return (invoke "rotr" (i64.const 2) (i64.const 63)) (i64.const 4))
+(assert_return (invoke "rotr" (i64.const 2) (i64.const 64)) (i64.const 2))
+(assert_return (invoke "rotr" (i64.const 2) (i64.const 65)) (i64.const 1))
+(assert_return (invoke "rotr" (i64.const 2) (i64.const 0xfffffffffff5a5a)) (i64.const 0x8000000000))
+(assert_return (invoke "rotr" (i64.const 3) (i64.const 0)) (i64.const 3))
+(assert_return (invoke "rotr" (i64.const 3) (i64.const 1)) (i64.const 0x8000000000000001))
+(assert_return (invoke "rotr" (i64.const 3) (i64.const -1)) (i64.const 6))
+(assert_return (invoke "rotr" (i64.const 3) (i64.const 2)) (i64.const 0xc000000000000000))
+(assert_return (invoke "rotr" (i64.const 3) (i64.const 3)) (i64.const 0x6000000000000000))
+(assert_return (invoke "rotr" (i64.const 3) (i64.const 0x7fffffffffffffff)) (i64.const 6))
+(assert_return (invoke "rotr" (i64.const 3) (i64.const 0x8000000000000000)) (i64.const 3))
+(assert_return (invoke "rotr" (i64.const 3) (i64.const 0x8000000000000001)) (i64.const 0x8000000000000001))
+(assert_return (invoke "rotr" (i64.const 3) (i64.const 0x4000000000000000)) (i64.const 3))
+(assert_return (invoke "rotr" (i64.const 3) (i64.const 0x123456789abcdef)) (i64.const 0x60000))
+(assert_return (invoke "rotr" (i64.const 3) (i64.const 0xfedcba9876543210)) (i64.const 0x3000000000000))
+(assert_return (invoke "rotr" (i64.const 3) (i64.const 63)) (i64.const 6))
+(assert_return (invoke "rotr" (i64.const 3) (i64.const 64)) (i64.const 3))
+(assert_return (invoke "rotr" (i64.const 3) (i64.const 65)) (i64.const 0x8000000000000001))
+(assert_return (invoke "rotr" (i64.const 3) (i64.const 0xfffffffffff5a5a)) (i64.const 0xc000000000))
+(assert_return (invoke "rotr" (i64.const 0x7fffffffffffffff) (i64.const 0)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "rotr" (i64.const 0x7fffffffffffffff) (i64.const 1)) (i64.const 0xbfffffffffffffff))
+(assert_return (invoke "rotr" (i64.const 0x7fffffffffffffff) (i64.const -1)) (i64.const -2))
+(assert_return (invoke "rotr" (i64.const 0x7fffffffffffffff) (i64.const 2)) (i64.const 0xdfffffffffffffff))
+(assert_return (invoke "rotr" (i64.const 0x7fffffffffffffff) (i64.const 3)) (i64.const 0xefffffffffffffff))
+(assert_return (invoke "rotr" (i64.const 0x7fffffffffffffff) (i64.const 0x7fffffffffffffff)) (i64.const -2))
+(assert_return (invoke "rotr" (i64.const 0x7fffffffffffffff) (i64.const 0x8000000000000000)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "rotr" (i64.const 0x7fffffffffffffff) (i64.const 0x8000000000000001)) (i64.const 0xbfffffffffffffff))
+(assert_return (invoke "rotr" (i64.const 0x7fffffffffffffff) (i64.const 0x4000000000000000)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "rotr" (i64.const 0x7fffffffffffffff) (i64.const 0x123456789abcdef)) (i64.const 0xfffffffffffeffff))
+(assert_return (invoke "rotr" (i64.const 0x7fffffffffffffff) (i64.const 0xfedcba9876543210)) (i64.const 0xffff7fffffffffff))
+(assert_return (invoke "rotr" (i64.const 0x7fffffffffffffff) (i64.const 63)) (i64.const -2))
+(assert_return (invoke "rotr" (i64.const 0x7fffffffffffffff) (i64.const 64)) (i64.const 0x7fffffffffffffff))
+(assert_return (invoke "rotr" (i64.const 0x7fffffffffffffff) (i64.const 65)) (i64.const 0xbfffffffffffffff))
+(assert_return (invoke "rotr" (i64.const 0x7fffffffffffffff) (i64.const 0xfffffffffff5a5a)) (i64.const 0xffffffdfffffffff))
+(assert_return (invoke "rotr" (i64.const 0x8000000000000000) (i64.const 0)) (i64.const 0x8000000000000000))
+(assert_return (invoke "rotr" (i64.const 0x8000000000000000) (i64.const 1)) (i64.const 0x4000000000000000))
+(assert_return (invoke "rotr" (i64.const 0x8000000000000000) (i64.const -1)) (i64.const 1))
+(assert_return (invoke "rotr" (i64.const 0x8000000000000000) (i64.const 2)) (i64.const 0x2000000000000000))
+(assert_return (invoke "rotr" (i64.const 0x8000000000000000) (i64.const 3)) (i64.const 0x1000000000000000))
+(assert_return (invoke "rotr" (i64.const 0x8000000000000000) (i64.const 0x7fffffffffffffff)) (i64.const 1))
+(assert_return (invoke "rotr" (i64.const 0x8000000000000000) (i64.const 0x8000000000000000)) (i64.const 0x8000000000000000))
+(assert_return (invoke "rotr" (i64.const 0x8000000000000000) (i64.const 0x8000000000000001)) (i64.const 0x4000000000000000))
+(assert_return (invoke "rotr" (i64.const 0x8000000000000000) (i64.const 0x4000000000000000)) (i64.const 0x8000000000000000))
+(assert_return (invoke "rotr" (i64.const 0x8000000000000000) (i64.const 0x123456789abcdef)) (i64.const 0x10000))
+(assert_return (invoke "rotr" (i64.const 0x8000000000000000) (i64.const 0xfedcba9876543210)) (i64.const 0x800000000000))
+(assert_return (invoke "rotr" (i64.const 0x8000000000000000) (i64.const 63)) (i64.const 1))
+(assert_return (invoke "rotr" (i64.const 0x8000000000000000) (i64.const 64)) (i64.const 0x8000000000000000))
+(assert_return (invoke "rotr" (i64.const 0x8000000000000000) (i64.const 65)) (i64.const 0x4000000000000000))
+(assert_return (invoke "rotr" (i64.const 0x8000000000000000) (i64.const 0xfffffffffff5a5a)) (i64.const 0x2000000000))
+(assert_return (invoke "rotr" (i64.const 0x8000000000000001) (i64.const 0)) (i64.const 0x8000000000000001))
+(assert_return (invoke "rotr" (i64.const 0x8000000000000001) (i64.const 1)) (i64.const 0xc000000000000000))
+(assert_return (invoke "rotr" (i64.const 0x8000000000000001) (i64.const -1)) (i64.const 3))
+(assert_return (invoke "rotr" (i64.const 0x8000000000000001) (i64.const 2)) (i64.const 0x6000000000000000))
+(assert_return (invoke "rotr" (i64.const 0x8000000000000001) (i64.const 3)) (i64.const 0x3000000000000000))
+(assert_return (invoke "rotr" (i64.const 0x8000000000000001) (i64.const 0x7fffffffffffffff)) (i64.const 3))
+(assert_return (invoke "rotr" (i64.const 0x8000000000000001) (i64.const 0x8000000000000000)) (i64.const 0x8000000000000001))
+(assert_return (invoke "rotr" (i64.const 0x8000000000000001) (i64.const 0x8000000000000001)) (i64.const 0xc000000000000000))
+(assert_return (invoke "rotr" (i64.const 0x8000000000000001) (i64.const 0x4000000000000000)) (i64.const 0x8000000000000001))
+(assert_return (invoke "rotr" (i64.const 0x8000000000000001) (i64.const 0x123456789abcdef)) (i64.const 0x30000))
+(assert_return (invoke "rotr" (i64.const 0x8000000000000001) (i64.const 0xfedcba9876543210)) (i64.const 0x1800000000000))
+(assert_return (invoke "rotr" (i64.const 0x8000000000000001) (i64.const 63)) (i64.const 3))
+(assert_return (invoke "rotr" (i64.const 0x8000000000000001) (i64.const 64)) (i64.const 0x8000000000000001))
+(assert_return (invoke "rotr" (i64.const 0x8000000000000001) (i64.const 65)) (i64.const 0xc000000000000000))
+(assert_return (invoke "rotr" (i64.const 0x8000000000000001) (i64.const 0xfffffffffff5a5a)) (i64.const 0x6000000000))
+(assert_return (invoke "rotr" (i64.const 0x4000000000000000) (i64.const 0)) (i64.const 0x4000000000000000))
+(assert_return (invoke "rotr" (i64.const 0x4000000000000000) (i64.const 1)) (i64.const 0x2000000000000000))
+(assert_return (invoke "rotr" (i64.const 0x4000000000000000) (i64.const -1)) (i64.const 0x8000000000000000))
+(assert_return (invoke "rotr" (i64.const 0x4000000000000000) (i64.const 2)) (i64.const 0x1000000000000000))
+(assert_return (invoke "rotr" (i64.const 0x4000000000000000) (i64.const 3)) (i64.const 0x800000000000000))
+(assert_return (invoke "rotr" (i64.const 0x4000000000000000) (i64.const 0x7fffffffffffffff)) (i64.const 0x8000000000000000))
+(assert_return (invoke "rotr" (i64.const 0x4000000000000000) (i64.const 0x8000000000000000)) (i64.const 0x4000000000000000))
+(assert_return (invoke "rotr" (i64.const 0x4000000000000000) (i64.const 0x8000000000000001)) (i64.const 0x2000000000000000))
+(assert_return (invoke "rotr" (i64.const 0x4000000000000000) (i64.const 0x4000000000000000)) (i64.const 0x4000000000000000))
+(assert_return (invoke "rotr" (i64.const 0x4000000000000000) (i64.const 0x123456789abcdef)) (i64.const 32768))
+(assert_return (invoke "rotr" (i64.const 0x4000000000000000) (i64.const 0xfedcba9876543210)) (i64.const 0x400000000000))
+(assert_return (invoke "rotr" (i64.const 0x4000000000000000) (i64.const 63)) (i64.const 0x8000000000000000))
+(assert_return (invoke "rotr" (i64.const 0x4000000000000000) (i64.const 64)) (i64.const 0x4000000000000000))
+(assert_return (invoke "rotr" (i64.const 0x4000000000000000) (i64.const 65)) (i64.const 0x2000000000000000))
+(assert_return (invoke "rotr" (i64.const 0x4000000000000000) (i64.const 0xfffffffffff5a5a)) (i64.const 0x1000000000))
+(assert_return (invoke "rotr" (i64.const 0x123456789abcdef) (i64.const 0)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "rotr" (i64.const 0x123456789abcdef) (i64.const 1)) (i64.const 0x8091a2b3c4d5e6f7))
+(assert_return (invoke "rotr" (i64.const 0x123456789abcdef) (i64.const -1)) (i64.const 0x2468acf13579bde))
+(assert_return (invoke "rotr" (i64.const 0x123456789abcdef) (i64.const 2)) (i64.const 0xc048d159e26af37b))
+(assert_return (invoke "rotr" (i64.const 0x123456789abcdef) (i64.const 3)) (i64.const 0xe02468acf13579bd))
+(assert_return (invoke "rotr" (i64.const 0x123456789abcdef) (i64.const 0x7fffffffffffffff)) (i64.const 0x2468acf13579bde))
+(assert_return (invoke "rotr" (i64.const 0x123456789abcdef) (i64.const 0x8000000000000000)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "rotr" (i64.const 0x123456789abcdef) (i64.const 0x8000000000000001)) (i64.const 0x8091a2b3c4d5e6f7))
+(assert_return (invoke "rotr" (i64.const 0x123456789abcdef) (i64.const 0x4000000000000000)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "rotr" (i64.const 0x123456789abcdef) (i64.const 0x123456789abcdef)) (i64.const 0x8acf13579bde0246))
+(assert_return (invoke "rotr" (i64.const 0x123456789abcdef) (i64.const 0xfedcba9876543210)) (i64.const 0xcdef0123456789ab))
+(assert_return (invoke "rotr" (i64.const 0x123456789abcdef) (i64.const 63)) (i64.const 0x2468acf13579bde))
+(assert_return (invoke "rotr" (i64.const 0x123456789abcdef) (i64.const 64)) (i64.const 0x123456789abcdef))
+(assert_return (invoke "rotr" (i64.const 0x123456789abcdef) (i64.const 65)) (i64.const 0x8091a2b3c4d5e6f7))
+(assert_return (invoke "rotr" (i64.const 0x123456789abcdef) (i64.const 0xfffffffffff5a5a)) (i64.const 0x6af37bc048d159e2))
+(assert_return (invoke "rotr" (i64.const 0xfedcba9876543210) (i64.const 0)) (i64.const 0xfedcba9876543210))
+(assert_return (invoke "rotr" (i64.const 0xfedcba9876543210) (i64.const 1)) (i64.const 0x7f6e5d4c3b2a1908))
+(assert_return (invoke "rotr" (i64.const 0xfedcba9876543210) (i64.const -1)) (i64.const 0xfdb97530eca86421))
+(assert_return (invoke "rotr" (i64.const 0xfedcba9876543210) (i64.const 2)) (i64.const 0x3fb72ea61d950c84))
+(assert_return (invoke "rotr" (i64.const 0xfedcba9876543210) (i64.const 3)) (i64.const 0x1fdb97530eca8642))
+(assert_return (invoke "rotr" (i64.const 0xfedcba9876543210) (i64.const 0x7fffffffffffffff)) (i64.const 0xfdb97530eca86421))
+(assert_return (invoke "rotr" (i64.const 0xfedcba9876543210) (i64.const 0x8000000000000000)) (i64.const 0xfedcba9876543210))
+(assert_return (invoke "rotr" (i64.const 0xfedcba9876543210) (i64.const 0x8000000000000001)) (i64.const 0x7f6e5d4c3b2a1908))
+(assert_return (invoke "rotr" (i64.const 0xfedcba9876543210) (i64.const 0x4000000000000000)) (i64.const 0xfedcba9876543210))
+(assert_return (invoke "rotr" (i64.const 0xfedcba9876543210) (i64.const 0x123456789abcdef)) (i64.const 0x7530eca86421fdb9))
+(assert_return (invoke "rotr" (i64.const 0xfedcba9876543210) (i64.const 0xfedcba9876543210)) (i64.const 0x3210fedcba987654))
+(assert_return (invoke "rotr" (i64.const 0xfedcba9876543210) (i64.const 63)) (i64.const 0xfdb97530eca86421))
+(assert_return (invoke "rotr" (i64.const 0xfedcba9876543210) (i64.const 64)) (i64.const 0xfedcba9876543210))
+(assert_return (invoke "rotr" (i64.const 0xfedcba9876543210) (i64.const 65)) (i64.const 0x7f6e5d4c3b2a1908))
+(assert_return (invoke "rotr" (i64.const 0xfedcba9876543210) (i64.const 0xfffffffffff5a5a)) (i64.const 0x950c843fb72ea61d))
+(assert_return (invoke "rotr" (i64.const 63) (i64.const 0)) (i64.const 63))
+(assert_return (invoke "rotr" (i64.const 63) (i64.const 1)) (i64.const 0x800000000000001f))
+(assert_return (invoke "rotr" (i64.const 63) (i64.const -1)) (i64.const 126))
+(assert_return (invoke "rotr" (i64.const 63) (i64.const 2)) (i64.const 0xc00000000000000f))
+(assert_return (invoke "rotr" (i64.const 63) (i64.const 3)) (i64.const 0xe000000000000007))
+(assert_return (invoke "rotr" (i64.const 63) (i64.const 0x7fffffffffffffff)) (i64.const 126))
+(assert_return (invoke "rotr" (i64.const 63) (i64.const 0x8000000000000000)) (i64.const 63))
+(assert_return (invoke "rotr" (i64.const 63) (i64.const 0x8000000000000001)) (i64.const 0x800000000000001f))
+(assert_return (invoke "rotr" (i64.const 63) (i64.const 0x4000000000000000)) (i64.const 63))
+(assert_return (invoke "rotr" (i64.const 63) (i64.const 0x123456789abcdef)) (i64.const 0x7e0000))
+(assert_return (invoke "rotr" (i64.const 63) (i64.const 0xfedcba9876543210)) (i64.const 0x3f000000000000))
+(assert_return (invoke "rotr" (i64.const 63) (i64.const 63)) (i64.const 126))
+(assert_return (invoke "rotr" (i64.const 63) (i64.const 64)) (i64.const 63))
+(assert_return (invoke "rotr" (i64.const 63) (i64.const 65)) (i64.const 0x800000000000001f))
+(assert_return (invoke "rotr" (i64.const 63) (i64.const 0xfffffffffff5a5a)) (i64.const 0xfc000000000))
+(assert_return (invoke "rotr" (i64.const 64) (i64.const 0)) (i64.const 64))
+(assert_return (invoke "rotr" (i64.const 64) (i64.const 1)) (i64.const 32))
+(assert_return (invoke "rotr" (i64.const 64) (i64.const -1)) (i64.const 128))
+(assert_return (invoke "rotr" (i64.const 64) (i64.const 2)) (i64.const 16))
+(assert_return (invoke "rotr" (i64.const 64) (i64.const 3)) (i64.const 8))
+(assert_return (invoke "rotr" (i64.const 64) (i64.const 0x7fffffffffffffff)) (i64.const 128))
+(assert_return (invoke "rotr" (i64.const 64) (i64.const 0x8000000000000000)) (i64.const 64))
+(assert_return (invoke "rotr" (i64.const 64) (i64.const 0x8000000000000001)) (i64.const 32))
+(assert_return (invoke "rotr" (i64.const 64) (i64.const 0x4000000000000000)) (i64.const 64))
+(assert_return (invoke "rotr" (i64.const 64) (i64.const 0x123456789abcdef)) (i64.const 0x800000))
+(assert_return (invoke "rotr" (i64.const 64) (i64.const 0xfedcba9876543210)) (i64.const 0x40000000000000))
+(assert_return (invoke "rotr" (i64.const 64) (i64.const 63)) (i64.const 128))
+(assert_return (invoke "rotr" (i64.const 64) (i64.const 64)) (i64.const 64))
+(assert_return (invoke "rotr" (i64.const 64) (i64.const 65)) (i64.const 32))
+(assert_return (invoke "rotr" (i64.const 64) (i64.const 0xfffffffffff5a5a)) (i64.const 0x100000000000))
+(assert_return (invoke "rotr" (i64.const 65) (i64.const 0)) (i64.const 65))
+(assert_return (invoke "rotr" (i64.const 65) (i64.const 1)) (i64.const 0x8000000000000020))
+(assert_return (invoke "rotr" (i64.const 65) (i64.const -1)) (i64.const 130))
+(assert_return (invoke "rotr" (i64.const 65) (i64.const 2)) (i64.const 0x4000000000000010))
+(assert_return (invoke "rotr" (i64.const 65) (i64.const 3)) (i64.const 0x2000000000000008))
+(assert_return (invoke "rotr" (i64.const 65) (i64.const 0x7fffffffffffffff)) (i64.const 130))
+(assert_return (invoke "rotr" (i64.const 65) (i64.const 0x8000000000000000)) (i64.const 65))
+(assert_return (invoke "rotr" (i64.const 65) (i64.const 0x8000000000000001)) (i64.const 0x8000000000000020))
+(assert_return (invoke "rotr" (i64.const 65) (i64.const 0x4000000000000000)) (i64.const 65))
+(assert_return (invoke "rotr" (i64.const 65) (i64.const 0x123456789abcdef)) (i64.const 0x820000))
+(assert_return (invoke "rotr" (i64.const 65) (i64.const 0xfedcba9876543210)) (i64.const 0x41000000000000))
+(assert_return (invoke "rotr" (i64.const 65) (i64.const 63)) (i64.const 130))
+(assert_return (invoke "rotr" (i64.const 65) (i64.const 64)) (i64.const 65))
+(assert_return (invoke "rotr" (i64.const 65) (i64.const 65)) (i64.const 0x8000000000000020))
+(assert_return (invoke "rotr" (i64.const 65) (i64.const 0xfffffffffff5a5a)) (i64.const 0x104000000000))
+(assert_return (invoke "rotr" (i64.const 0xfffffffffff5a5a) (i64.const 0)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "rotr" (i64.const 0xfffffffffff5a5a) (i64.const 1)) (i64.const 0x7ffffffffffad2d))
+(assert_return (invoke "rotr" (i64.const 0xfffffffffff5a5a) (i64.const -1)) (i64.const 0x1ffffffffffeb4b4))
+(assert_return (invoke "rotr" (i64.const 0xfffffffffff5a5a) (i64.const 2)) (i64.const 0x83ffffffffffd696))
+(assert_return (invoke "rotr" (i64.const 0xfffffffffff5a5a) (i64.const 3)) (i64.const 0x41ffffffffffeb4b))
+(assert_return (invoke "rotr" (i64.const 0xfffffffffff5a5a) (i64.const 0x7fffffffffffffff)) (i64.const 0x1ffffffffffeb4b4))
+(assert_return (invoke "rotr" (i64.const 0xfffffffffff5a5a) (i64.const 0x8000000000000000)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "rotr" (i64.const 0xfffffffffff5a5a) (i64.const 0x8000000000000001)) (i64.const 0x7ffffffffffad2d))
+(assert_return (invoke "rotr" (i64.const 0xfffffffffff5a5a) (i64.const 0x4000000000000000)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "rotr" (i64.const 0xfffffffffff5a5a) (i64.const 0x123456789abcdef)) (i64.const 0xfffffffeb4b41fff))
+(assert_return (invoke "rotr" (i64.const 0xfffffffffff5a5a) (i64.const 0xfedcba9876543210)) (i64.const 0x5a5a0fffffffffff))
+(assert_return (invoke "rotr" (i64.const 0xfffffffffff5a5a) (i64.const 63)) (i64.const 0x1ffffffffffeb4b4))
+(assert_return (invoke "rotr" (i64.const 0xfffffffffff5a5a) (i64.const 64)) (i64.const 0xfffffffffff5a5a))
+(assert_return (invoke "rotr" (i64.const 0xfffffffffff5a5a) (i64.const 65)) (i64.const 0x7ffffffffffad2d))
+(assert_return (invoke "rotr" (i64.const 0xfffffffffff5a5a) (i64.const 0xfffffffffff5a5a)) (i64.const 0xffd69683ffffffff))
+(assert_return (invoke "rotr" (i64.const 0xe515e1717c905e87) (i64.const 0xe8095cf2de866e1f)) (i64.const 0xf920bd0fca2bc2e2))
+(assert_return (invoke "rotr" (i64.const 0x402bdf05b94acbd7) (i64.const 0x88c7835a62f71b70)) (i64.const 0xdf05b94acbd7402b))
+(assert_return (invoke "rotr" (i64.const 0xd8642b47635c56ed) (i64.const 0x3e43c910dd67c902)) (i64.const 0x76190ad1d8d715bb))
+(assert_return (invoke "rotr" (i64.const 0xded780d7af93850b) (i64.const 0x1a355cff2f3d138c)) (i64.const 0x50bded780d7af938))
+(assert_return (invoke "rotr" (i64.const 0x31f9942356e28cb6) (i64.const 0xdb4493973f1e0904)) (i64.const 0x631f9942356e28cb))
+(assert_return (invoke "rotr" (i64.const 0x8f59f2ec5a037e49) (i64.const 0x86ec9876745871dd)) (i64.const 0xd01bf24c7acf9762))
+(assert_return (invoke "rotr" (i64.const 0xdff873cf06a163b9) (i64.const 0x3e720bd02586d79)) (i64.const 0xfc39e78350b1dcef))
+(assert_return (invoke "rotr" (i64.const 0xfe016aacbbe100e8) (i64.const 0x2316859f7001c9a9)) (i64.const 0x565df080747f00b5))
+(assert_return (invoke "rotr" (i64.const 0xf5c2145a032e653d) (i64.const 0xdb27546274d7c972)) (i64.const 0x851680cb994f7d70))
+(assert_return (invoke "rotr" (i64.const 0x58b392e0a4ea39bb) (i64.const 0x28176de5575c265f)) (i64.const 0x49d47376b16725c1))
+(assert_return (invoke "rotr" (i64.const 0x4a55e52b3472df3a) (i64.const 0x7dbb62d5df4b38f8)) (i64.const 0x55e52b3472df3a4a))
+(assert_return (invoke "rotr" (i64.const 0x4a4754acce85f9af) (i64.const 0xc68c847e80fde4cf)) (i64.const 0xf35e948ea9599d0b))
+(assert_return (invoke "rotr" (i64.const 0xf8626e5cabacd3eb) (i64.const 0xe4cea012abf6b636)) (i64.const 0x89b972aeb34fafe1))
+(assert_return (invoke "rotr" (i64.const 0x7c0b43a89257a971) (i64.const 0xd4c4b13a55adb6cc)) (i64.const 0x9717c0b43a89257a))
+(assert_return (invoke "rotr" (i64.const 0xaf8a13c36102eb1e) (i64.const 0x5d0017e23c40d4ca)) (i64.const 0xc7abe284f0d840ba))
+(assert_return (invoke "rotr" (i64.const 0xdbdeb72a5a40ed7c) (i64.const 0x352733a7145eccb2)) (i64.const 0xadca96903b5f36f7))
+
+(assert_return (invoke "clz" (i64.const 0)) (i64.const 64))
+(assert_return (invoke "clz" (i64.const 1)) (i64.const 63))
+(assert_return (invoke "clz" (i64.const -1)) (i64.const 0))
+(assert_return (invoke "clz" (i64.const 2)) (i64.const 62))
+(assert_return (invoke "clz" (i64.const 3)) (i64.const 62))
+(assert_return (invoke "clz" (i64.const 0x7fffffffffffffff)) (i64.const 1))
+(assert_return (invoke "clz" (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "clz" (i64.const 0x8000000000000001)) (i64.const 0))
+(assert_return (invoke "clz" (i64.const 0x4000000000000000)) (i64.const 1))
+(assert_return (invoke "clz" (i64.const 0x123456789abcdef)) (i64.const 7))
+(assert_return (invoke "clz" (i64.const 0xfedcba9876543210)) (i64.const 0))
+(assert_return (invoke "clz" (i64.const 63)) (i64.const 58))
+(assert_return (invoke "clz" (i64.const 64)) (i64.const 57))
+(assert_return (invoke "clz" (i64.const 65)) (i64.const 57))
+(assert_return (invoke "clz" (i64.const 0xfffffffffff5a5a)) (i64.const 4))
+(assert_return (invoke "clz" (i64.const 1)) (i64.const 63))
+(assert_return (invoke "clz" (i64.const 128)) (i64.const 56))
+(assert_return (invoke "clz" (i64.const 16384)) (i64.const 49))
+(assert_return (invoke "clz" (i64.const 0x200000)) (i64.const 42))
+(assert_return (invoke "clz" (i64.const 0x10000000)) (i64.const 35))
+(assert_return (invoke "clz" (i64.const 0x800000000)) (i64.const 28))
+(assert_return (invoke "clz" (i64.const 0x40000000000)) (i64.const 21))
+(assert_return (invoke "clz" (i64.const 0x2000000000000)) (i64.const 14))
+(assert_return (invoke "clz" (i64.const 0x100000000000000)) (i64.const 7))
+(assert_return (invoke "clz" (i64.const 0x8000000000000000)) (i64.const 0))
+(assert_return (invoke "clz" (i64.const -1)) (i64.const 0))
+(assert_return (invoke "clz" (i64.const 0x7ffffffffffffff)) (i64.const 5))
+(assert_return (invoke "clz" (i64.const 0x3fffffffffffff)) (i64.const 10))
+(assert_return (invoke "clz" (i64.const 0x1ffffffffffff)) (i64.const 15))
+(assert_return (invoke "clz" (i64.const 0xfffffffffff)) (i64.const 20))
+(assert_return (invoke "clz" (i64.const 0x7fffffffff)) (i64.const 25))
+(assert_return (invoke "clz" (i64.const 0x3ffffffff)) (i64.const 30))
+(assert_return (invoke "clz" (i64.const 0x1fffffff)) (i64.const 35))
+(assert_return (invoke "clz" (i64.const 0xffffff)) (i64.const 40))
+(assert_return (invoke "clz" (i64.const 0x7ffff)) (i64.const 45))
+(assert_return (invoke "clz" (i64.const 16383)) (i64.const 50))
+(assert_return (invoke "clz" (i64.const 511)) (i64.const 55))
+(assert_return (invoke "clz" (i64.const 15)) (i64.const 60))
+(assert_return (invoke "clz" (i64.const 0x31dbeaf3cbfe7642)) (i64.const 2))
+(assert_return (invoke "clz" (i64.const 0xb8901d894c79839b)) (i64.const 0))
+(assert_return (invoke "clz" (i64.const 0x602ab3e605b41da8)) (i64.const 1))
+(assert_return (invoke "clz" (i64.const 0xaf4684173464c473)) (i64.const 0))
+(assert_return (invoke "clz" (i64.const 0xeadbac98d072d47d)) (i64.const 0))
+(assert_return (invoke "clz" (i64.const 0x72ba7f9096a4659b)) (i64.const 1))
+(assert_return (invoke "clz" (i64.const 0xa6e9328e3319d64f)) (i64.const 0))
+(assert_return (invoke "clz" (i64.const 0x17d0f7810d8d1452)) (i64.const 3))
+
+(assert_return (invoke "ctz" (i64.const 0)) (i64.const 64))
+(assert_return (invoke "ctz" (i64.const 1)) (i64.const 0))
+(assert_return (invoke "ctz" (i64.const -1)) (i64.const 0))
+(assert_return (invoke "ctz" (i64.const 2)) (i64.const 1))
+(assert_return (invoke "ctz" (i64.const 3)) (i64.const 0))
+(assert_return (invoke "ctz" (i64.const 0x7fffffffffffffff)) (i64.const 0))
+(assert_return (invoke "ctz" (i64.const 0x8000000000000000)) (i64.const 63))
+(assert_return (invoke "ctz" (i64.const 0x8000000000000001)) (i64.const 0))
+(assert_return (invoke "ctz" (i64.const 0x4000000000000000)) (i64.const 62))
+(assert_return (invoke "ctz" (i64.const 0x123456789abcdef)) (i64.const 0))
+(assert_return (invoke "ctz" (i64.const 0xfedcba9876543210)) (i64.const 4))
+(assert_return (invoke "ctz" (i64.const 63)) (i64.const 0))
+(assert_return (invoke "ctz" (i64.const 64)) (i64.const 6))
+(assert_return (invoke "ctz" (i64.const 65)) (i64.const 0))
+(assert_return (invoke "ctz" (i64.const 0xfffffffffff5a5a)) (i64.const 1))
+(assert_return (invoke "ctz" (i64.const 1)) (i64.const 0))
+(assert_return (invoke "ctz" (i64.const 128)) (i64.const 7))
+(assert_return (invoke "ctz" (i64.const 16384)) (i64.const 14))
+(assert_return (invoke "ctz" (i64.const 0x200000)) (i64.const 21))
+(assert_return (invoke "ctz" (i64.const 0x10000000)) (i64.const 28))
+(assert_return (invoke "ctz" (i64.const 0x800000000)) (i64.const 35))
+(assert_return (invoke "ctz" (i64.const 0x40000000000)) (i64.const 42))
+(assert_return (invoke "ctz" (i64.const 0x2000000000000)) (i64.const 49))
+(assert_return (invoke "ctz" (i64.const 0x100000000000000)) (i64.const 56))
+(assert_return (invoke "ctz" (i64.const 0x8000000000000000)) (i64.const 63))
+(assert_return (invoke "ctz" (i64.const -1)) (i64.const 0))
+(assert_return (invoke "ctz" (i64.const 0x7ffffffffffffff)) (i64.const 0))
+(assert_return (invoke "ctz" (i64.const 0x3fffffffffffff)) (i64.const 0))
+(assert_return (invoke "ctz" (i64.const 0x1ffffffffffff)) (i64.const 0))
+(assert_return (invoke "ctz" (i64.const 0xfffffffffff)) (i64.const 0))
+(assert_return (invoke "ctz" (i64.const 0x7fffffffff)) (i64.const 0))
+(assert_return (invoke "ctz" (i64.const 0x3ffffffff)) (i64.const 0))
+(assert_return (invoke "ctz" (i64.const 0x1fffffff)) (i64.const 0))
+(assert_return (invoke "ctz" (i64.const 0xffffff)) (i64.const 0))
+(assert_return (invoke "ctz" (i64.const 0x7ffff)) (i64.const 0))
+(assert_return (invoke "ctz" (i64.const 16383)) (i64.const 0))
+(assert_return (invoke "ctz" (i64.const 511)) (i64.const 0))
+(assert_return (invoke "ctz" (i64.const 15)) (i64.const 0))
+(assert_return (invoke "ctz" (i64.const 0x31dbeaf3cbfe7642)) (i64.const 1))
+(assert_return (invoke "ctz" (i64.const 0xb8901d894c79839b)) (i64.const 0))
+(assert_return (invoke "ctz" (i64.const 0x602ab3e605b41da8)) (i64.const 3))
+(assert_return (invoke "ctz" (i64.const 0xaf4684173464c473)) (i64.const 0))
+(assert_return (invoke "ctz" (i64.const 0xeadbac98d072d47d)) (i64.const 0))
+(assert_return (invoke "ctz" (i64.const 0x72ba7f9096a4659b)) (i64.const 0))
+(assert_return (invoke "ctz" (i64.const 0xa6e9328e3319d64f)) (i64.const 0))
+(assert_return (invoke "ctz" (i64.const 0x17d0f7810d8d1452)) (i64.const 1))
+
+(assert_return (invoke "popcnt" (i64.const 0)) (i64.const 0))
+(assert_return (invoke "popcnt" (i64.const 1)) (i64.const 1))
+(assert_return (invoke "popcnt" (i64.const -1)) (i64.const 64))
+(assert_return (invoke "popcnt" (i64.const 2)) (i64.const 1))
+(assert_return (invoke "popcnt" (i64.const 3)) (i64.const 2))
+(assert_return (invoke "popcnt" (i64.const 0x7fffffffffffffff)) (i64.const 63))
+(assert_return (invoke "popcnt" (i64.const 0x8000000000000000)) (i64.const 1))
+(assert_return (invoke "popcnt" (i64.const 0x8000000000000001)) (i64.const 2))
+(assert_return (invoke "popcnt" (i64.const 0x4000000000000000)) (i64.const 1))
+(assert_return (invoke "popcnt" (i64.const 0x123456789abcdef)) (i64.const 32))
+(assert_return (invoke "popcnt" (i64.const 0xfedcba9876543210)) (i64.const 32))
+(assert_return (invoke "popcnt" (i64.const 63)) (i64.const 6))
+(assert_return (invoke "popcnt" (i64.const 64)) (i64.const 1))
+(assert_return (invoke "popcnt" (i64.const 65)) (i64.const 2))
+(assert_return (invoke "popcnt" (i64.const 0xfffffffffff5a5a)) (i64.const 52))
+(assert_return (invoke "popcnt" (i64.const 1)) (i64.const 1))
+(assert_return (invoke "popcnt" (i64.const 128)) (i64.const 1))
+(assert_return (invoke "popcnt" (i64.const 16384)) (i64.const 1))
+(assert_return (invoke "popcnt" (i64.const 0x200000)) (i64.const 1))
+(assert_return (invoke "popcnt" (i64.const 0x10000000)) (i64.const 1))
+(assert_return (invoke "popcnt" (i64.const 0x800000000)) (i64.const 1))
+(assert_return (invoke "popcnt" (i64.const 0x40000000000)) (i64.const 1))
+(assert_return (invoke "popcnt" (i64.const 0x2000000000000)) (i64.const 1))
+(assert_return (invoke "popcnt" (i64.const 0x100000000000000)) (i64.const 1))
+(assert_return (invoke "popcnt" (i64.const 0x8000000000000000)) (i64.const 1))
+(assert_return (invoke "popcnt" (i64.const -1)) (i64.const 64))
+(assert_return (invoke "popcnt" (i64.const 0x7ffffffffffffff)) (i64.const 59))
+(assert_return (invoke "popcnt" (i64.const 0x3fffffffffffff)) (i64.const 54))
+(assert_return (invoke "popcnt" (i64.const 0x1ffffffffffff)) (i64.const 49))
+(assert_return (invoke "popcnt" (i64.const 0xfffffffffff)) (i64.const 44))
+(assert_return (invoke "popcnt" (i64.const 0x7fffffffff)) (i64.const 39))
+(assert_return (invoke "popcnt" (i64.const 0x3ffffffff)) (i64.const 34))
+(assert_return (invoke "popcnt" (i64.const 0x1fffffff)) (i64.const 29))
+(assert_return (invoke "popcnt" (i64.const 0xffffff)) (i64.const 24))
+(assert_return (invoke "popcnt" (i64.const 0x7ffff)) (i64.const 19))
+(assert_return (invoke "popcnt" (i64.const 16383)) (i64.const 14))
+(assert_return (invoke "popcnt" (i64.const 511)) (i64.const 9))
+(assert_return (invoke "popcnt" (i64.const 15)) (i64.const 4))
+(assert_return (invoke "popcnt" (i64.const 0x31dbeaf3cbfe7642)) (i64.const 39))
+(assert_return (invoke "popcnt" (i64.const 0xb8901d894c79839b)) (i64.const 29))
+(assert_return (invoke "popcnt" (i64.const 0x602ab3e605b41da8)) (i64.const 28))
+(assert_return (invoke "popcnt" (i64.const 0xaf4684173464c473)) (i64.const 29))
+(assert_return (invoke "popcnt" (i64.const 0xeadbac98d072d47d)) (i64.const 35))
+(assert_return (invoke "popcnt" (i64.const 0x72ba7f9096a4659b)) (i64.const 34))
+(assert_return (invoke "popcnt" (i64.const 0xa6e9328e3319d64f)) (i64.const 33))
+(assert_return (invoke "popcnt" (i64.const 0x17d0f7810d8d1452)) (i64.const 28))
+
+(assert_return (invoke "eqz" (i64.const 0)) (i32.const 1))
+(assert_return (invoke "eqz" (i64.const 1)) (i32.const 0))
+(assert_return (invoke "eqz" (i64.const -1)) (i32.const 0))
+(assert_return (invoke "eqz" (i64.const 2)) (i32.const 0))
+(assert_return (invoke "eqz" (i64.const 3)) (i32.const 0))
+(assert_return (invoke "eqz" (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "eqz" (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "eqz" (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "eqz" (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "eqz" (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "eqz" (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "eqz" (i64.const 63)) (i32.const 0))
+(assert_return (invoke "eqz" (i64.const 64)) (i32.const 0))
+(assert_return (invoke "eqz" (i64.const 65)) (i32.const 0))
+(assert_return (invoke "eqz" (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "eqz" (i64.const 1)) (i32.const 0))
+(assert_return (invoke "eqz" (i64.const 128)) (i32.const 0))
+(assert_return (invoke "eqz" (i64.const 16384)) (i32.const 0))
+(assert_return (invoke "eqz" (i64.const 0x200000)) (i32.const 0))
+(assert_return (invoke "eqz" (i64.const 0x10000000)) (i32.const 0))
+(assert_return (invoke "eqz" (i64.const 0x800000000)) (i32.const 0))
+(assert_return (invoke "eqz" (i64.const 0x40000000000)) (i32.const 0))
+(assert_return (invoke "eqz" (i64.const 0x2000000000000)) (i32.const 0))
+(assert_return (invoke "eqz" (i64.const 0x100000000000000)) (i32.const 0))
+(assert_return (invoke "eqz" (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "eqz" (i64.const -1)) (i32.const 0))
+(assert_return (invoke "eqz" (i64.const 0x7ffffffffffffff)) (i32.const 0))
+(assert_return (invoke "eqz" (i64.const 0x3fffffffffffff)) (i32.const 0))
+(assert_return (invoke "eqz" (i64.const 0x1ffffffffffff)) (i32.const 0))
+(assert_return (invoke "eqz" (i64.const 0xfffffffffff)) (i32.const 0))
+(assert_return (invoke "eqz" (i64.const 0x7fffffffff)) (i32.const 0))
+(assert_return (invoke "eqz" (i64.const 0x3ffffffff)) (i32.const 0))
+(assert_return (invoke "eqz" (i64.const 0x1fffffff)) (i32.const 0))
+(assert_return (invoke "eqz" (i64.const 0xffffff)) (i32.const 0))
+(assert_return (invoke "eqz" (i64.const 0x7ffff)) (i32.const 0))
+(assert_return (invoke "eqz" (i64.const 16383)) (i32.const 0))
+(assert_return (invoke "eqz" (i64.const 511)) (i32.const 0))
+(assert_return (invoke "eqz" (i64.const 15)) (i32.const 0))
+(assert_return (invoke "eqz" (i64.const 0x31dbeaf3cbfe7642)) (i32.const 0))
+(assert_return (invoke "eqz" (i64.const 0xb8901d894c79839b)) (i32.const 0))
+(assert_return (invoke "eqz" (i64.const 0x602ab3e605b41da8)) (i32.const 0))
+(assert_return (invoke "eqz" (i64.const 0xaf4684173464c473)) (i32.const 0))
+(assert_return (invoke "eqz" (i64.const 0xeadbac98d072d47d)) (i32.const 0))
+(assert_return (invoke "eqz" (i64.const 0x72ba7f9096a4659b)) (i32.const 0))
+(assert_return (invoke "eqz" (i64.const 0xa6e9328e3319d64f)) (i32.const 0))
+(assert_return (invoke "eqz" (i64.const 0x17d0f7810d8d1452)) (i32.const 0))
+
+(assert_return (invoke "eq" (i64.const 0) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "eq" (i64.const 0) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 1) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 1) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "eq" (i64.const 1) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 1) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 1) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 1) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 1) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 1) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 1) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 1) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 1) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 1) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 1) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 1) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 1) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const -1) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const -1) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const -1) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "eq" (i64.const -1) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const -1) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const -1) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const -1) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const -1) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const -1) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const -1) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const -1) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const -1) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const -1) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const -1) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const -1) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 2) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 2) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 2) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 2) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "eq" (i64.const 2) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 2) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 2) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 2) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 2) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 2) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 2) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 2) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 2) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 2) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 2) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 3) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 3) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 3) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 3) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 3) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "eq" (i64.const 3) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 3) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 3) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 3) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 3) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 3) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 3) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 3) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 3) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 3) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x7fffffffffffffff) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x7fffffffffffffff) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x7fffffffffffffff) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x7fffffffffffffff) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x7fffffffffffffff) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x7fffffffffffffff) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "eq" (i64.const 0x7fffffffffffffff) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x7fffffffffffffff) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x7fffffffffffffff) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x7fffffffffffffff) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x7fffffffffffffff) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x7fffffffffffffff) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x7fffffffffffffff) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x7fffffffffffffff) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x7fffffffffffffff) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x8000000000000000) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x8000000000000000) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x8000000000000000) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x8000000000000000) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x8000000000000000) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x8000000000000000) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x8000000000000000) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "eq" (i64.const 0x8000000000000000) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x8000000000000000) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x8000000000000000) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x8000000000000000) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x8000000000000000) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x8000000000000000) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x8000000000000000) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x8000000000000000) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x8000000000000001) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x8000000000000001) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x8000000000000001) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x8000000000000001) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x8000000000000001) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x8000000000000001) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x8000000000000001) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x8000000000000001) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "eq" (i64.const 0x8000000000000001) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x8000000000000001) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x8000000000000001) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x8000000000000001) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x8000000000000001) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x8000000000000001) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x8000000000000001) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x4000000000000000) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x4000000000000000) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x4000000000000000) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x4000000000000000) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x4000000000000000) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x4000000000000000) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x4000000000000000) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x4000000000000000) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x4000000000000000) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "eq" (i64.const 0x4000000000000000) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x4000000000000000) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x4000000000000000) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x4000000000000000) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x4000000000000000) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x4000000000000000) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x123456789abcdef) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x123456789abcdef) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x123456789abcdef) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x123456789abcdef) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x123456789abcdef) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x123456789abcdef) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x123456789abcdef) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x123456789abcdef) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x123456789abcdef) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x123456789abcdef) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "eq" (i64.const 0x123456789abcdef) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x123456789abcdef) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x123456789abcdef) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x123456789abcdef) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0x123456789abcdef) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0xfedcba9876543210) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0xfedcba9876543210) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0xfedcba9876543210) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0xfedcba9876543210) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0xfedcba9876543210) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0xfedcba9876543210) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0xfedcba9876543210) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0xfedcba9876543210) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0xfedcba9876543210) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0xfedcba9876543210) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0xfedcba9876543210) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "eq" (i64.const 0xfedcba9876543210) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0xfedcba9876543210) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0xfedcba9876543210) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0xfedcba9876543210) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 63) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 63) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 63) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 63) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 63) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 63) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 63) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 63) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 63) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 63) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 63) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 63) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "eq" (i64.const 63) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 63) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 63) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 64) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 64) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 64) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 64) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 64) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 64) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 64) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 64) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 64) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 64) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 64) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 64) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 64) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "eq" (i64.const 64) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 64) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 65) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 65) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 65) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 65) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 65) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 65) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 65) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 65) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 65) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 65) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 65) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 65) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 65) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 65) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "eq" (i64.const 65) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0xfffffffffff5a5a) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0xfffffffffff5a5a) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0xfffffffffff5a5a) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0xfffffffffff5a5a) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0xfffffffffff5a5a) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0xfffffffffff5a5a) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0xfffffffffff5a5a) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0xfffffffffff5a5a) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0xfffffffffff5a5a) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0xfffffffffff5a5a) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0xfffffffffff5a5a) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0xfffffffffff5a5a) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0xfffffffffff5a5a) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0xfffffffffff5a5a) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "eq" (i64.const 0xfffffffffff5a5a) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+
+(assert_return (invoke "ne" (i64.const 0) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "ne" (i64.const 0) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 1) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 1) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "ne" (i64.const 1) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 1) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 1) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 1) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 1) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 1) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 1) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 1) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 1) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 1) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 1) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 1) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 1) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const -1) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const -1) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const -1) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "ne" (i64.const -1) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const -1) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const -1) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const -1) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const -1) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const -1) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const -1) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const -1) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const -1) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const -1) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const -1) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const -1) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 2) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 2) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 2) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 2) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "ne" (i64.const 2) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 2) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 2) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 2) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 2) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 2) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 2) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 2) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 2) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 2) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 2) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 3) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 3) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 3) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 3) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 3) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "ne" (i64.const 3) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 3) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 3) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 3) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 3) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 3) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 3) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 3) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 3) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 3) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x7fffffffffffffff) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x7fffffffffffffff) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x7fffffffffffffff) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x7fffffffffffffff) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x7fffffffffffffff) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x7fffffffffffffff) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "ne" (i64.const 0x7fffffffffffffff) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x7fffffffffffffff) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x7fffffffffffffff) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x7fffffffffffffff) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x7fffffffffffffff) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x7fffffffffffffff) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x7fffffffffffffff) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x7fffffffffffffff) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x7fffffffffffffff) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x8000000000000000) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x8000000000000000) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x8000000000000000) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x8000000000000000) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x8000000000000000) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x8000000000000000) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x8000000000000000) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "ne" (i64.const 0x8000000000000000) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x8000000000000000) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x8000000000000000) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x8000000000000000) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x8000000000000000) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x8000000000000000) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x8000000000000000) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x8000000000000000) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x8000000000000001) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x8000000000000001) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x8000000000000001) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x8000000000000001) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x8000000000000001) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x8000000000000001) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x8000000000000001) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x8000000000000001) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "ne" (i64.const 0x8000000000000001) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x8000000000000001) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x8000000000000001) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x8000000000000001) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x8000000000000001) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x8000000000000001) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x8000000000000001) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x4000000000000000) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x4000000000000000) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x4000000000000000) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x4000000000000000) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x4000000000000000) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x4000000000000000) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x4000000000000000) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x4000000000000000) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x4000000000000000) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "ne" (i64.const 0x4000000000000000) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x4000000000000000) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x4000000000000000) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x4000000000000000) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x4000000000000000) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x4000000000000000) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x123456789abcdef) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x123456789abcdef) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x123456789abcdef) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x123456789abcdef) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x123456789abcdef) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x123456789abcdef) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x123456789abcdef) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x123456789abcdef) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x123456789abcdef) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x123456789abcdef) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "ne" (i64.const 0x123456789abcdef) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x123456789abcdef) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x123456789abcdef) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x123456789abcdef) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0x123456789abcdef) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0xfedcba9876543210) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0xfedcba9876543210) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0xfedcba9876543210) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0xfedcba9876543210) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0xfedcba9876543210) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0xfedcba9876543210) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0xfedcba9876543210) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0xfedcba9876543210) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0xfedcba9876543210) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0xfedcba9876543210) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0xfedcba9876543210) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "ne" (i64.const 0xfedcba9876543210) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0xfedcba9876543210) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0xfedcba9876543210) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0xfedcba9876543210) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 63) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 63) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 63) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 63) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 63) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 63) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 63) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 63) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 63) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 63) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 63) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 63) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "ne" (i64.const 63) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 63) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 63) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 64) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 64) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 64) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 64) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 64) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 64) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 64) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 64) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 64) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 64) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 64) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 64) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 64) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "ne" (i64.const 64) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 64) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 65) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 65) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 65) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 65) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 65) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 65) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 65) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 65) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 65) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 65) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 65) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 65) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 65) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 65) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "ne" (i64.const 65) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0xfffffffffff5a5a) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0xfffffffffff5a5a) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0xfffffffffff5a5a) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0xfffffffffff5a5a) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0xfffffffffff5a5a) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0xfffffffffff5a5a) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0xfffffffffff5a5a) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0xfffffffffff5a5a) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0xfffffffffff5a5a) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0xfffffffffff5a5a) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0xfffffffffff5a5a) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0xfffffffffff5a5a) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0xfffffffffff5a5a) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0xfffffffffff5a5a) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "ne" (i64.const 0xfffffffffff5a5a) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+
+(assert_return (invoke "lt_s" (i64.const 0) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 1) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 1) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 1) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 1) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 1) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 1) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 1) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 1) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 1) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 1) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 1) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 1) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 1) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 1) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 1) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const -1) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const -1) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const -1) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const -1) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const -1) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const -1) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const -1) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const -1) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const -1) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const -1) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const -1) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const -1) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const -1) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const -1) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const -1) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 2) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 2) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 2) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 2) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 2) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 2) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 2) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 2) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 2) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 2) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 2) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 2) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 2) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 2) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 2) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 3) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 3) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 3) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 3) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 3) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 3) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 3) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 3) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 3) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 3) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 3) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 3) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 3) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 3) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 3) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0x7fffffffffffffff) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0x7fffffffffffffff) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0x7fffffffffffffff) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0x7fffffffffffffff) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0x7fffffffffffffff) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0x7fffffffffffffff) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0x7fffffffffffffff) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0x7fffffffffffffff) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0x7fffffffffffffff) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0x7fffffffffffffff) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0x7fffffffffffffff) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0x7fffffffffffffff) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0x7fffffffffffffff) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0x7fffffffffffffff) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0x7fffffffffffffff) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0x8000000000000000) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0x8000000000000000) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0x8000000000000000) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0x8000000000000000) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0x8000000000000000) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0x8000000000000000) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0x8000000000000000) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0x8000000000000000) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0x8000000000000000) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0x8000000000000000) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0x8000000000000000) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0x8000000000000000) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0x8000000000000000) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0x8000000000000000) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0x8000000000000000) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0x8000000000000001) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0x8000000000000001) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0x8000000000000001) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0x8000000000000001) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0x8000000000000001) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0x8000000000000001) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0x8000000000000001) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0x8000000000000001) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0x8000000000000001) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0x8000000000000001) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0x8000000000000001) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0x8000000000000001) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0x8000000000000001) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0x8000000000000001) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0x8000000000000001) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0x4000000000000000) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0x4000000000000000) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0x4000000000000000) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0x4000000000000000) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0x4000000000000000) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0x4000000000000000) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0x4000000000000000) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0x4000000000000000) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0x4000000000000000) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0x4000000000000000) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0x4000000000000000) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0x4000000000000000) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0x4000000000000000) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0x4000000000000000) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0x4000000000000000) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0x123456789abcdef) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0x123456789abcdef) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0x123456789abcdef) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0x123456789abcdef) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0x123456789abcdef) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0x123456789abcdef) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0x123456789abcdef) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0x123456789abcdef) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0x123456789abcdef) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0x123456789abcdef) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0x123456789abcdef) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0x123456789abcdef) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0x123456789abcdef) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0x123456789abcdef) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0x123456789abcdef) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0xfedcba9876543210) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0xfedcba9876543210) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0xfedcba9876543210) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0xfedcba9876543210) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0xfedcba9876543210) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0xfedcba9876543210) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0xfedcba9876543210) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0xfedcba9876543210) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0xfedcba9876543210) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0xfedcba9876543210) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0xfedcba9876543210) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0xfedcba9876543210) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0xfedcba9876543210) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0xfedcba9876543210) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0xfedcba9876543210) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 63) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 63) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 63) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 63) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 63) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 63) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 63) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 63) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 63) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 63) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 63) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 63) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 63) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 63) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 63) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 64) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 64) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 64) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 64) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 64) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 64) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 64) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 64) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 64) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 64) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 64) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 64) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 64) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 64) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 64) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 65) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 65) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 65) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 65) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 65) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 65) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 65) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 65) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 65) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 65) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 65) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 65) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 65) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 65) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 65) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0xfffffffffff5a5a) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0xfffffffffff5a5a) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0xfffffffffff5a5a) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0xfffffffffff5a5a) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0xfffffffffff5a5a) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0xfffffffffff5a5a) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0xfffffffffff5a5a) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0xfffffffffff5a5a) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0xfffffffffff5a5a) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "lt_s" (i64.const 0xfffffffffff5a5a) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0xfffffffffff5a5a) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0xfffffffffff5a5a) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0xfffffffffff5a5a) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0xfffffffffff5a5a) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "lt_s" (i64.const 0xfffffffffff5a5a) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+
+(assert_return (invoke "lt_u" (i64.const 0) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 0) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 0) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 0) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 0) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 0) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 0) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 0) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 0) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 0) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 0) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 0) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 0) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 0) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 1) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 1) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 1) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 1) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 1) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 1) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 1) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 1) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 1) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 1) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 1) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 1) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 1) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 1) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 1) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const -1) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const -1) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const -1) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const -1) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const -1) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const -1) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const -1) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const -1) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const -1) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const -1) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const -1) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const -1) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const -1) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const -1) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const -1) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 2) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 2) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 2) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 2) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 2) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 2) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 2) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 2) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 2) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 2) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 2) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 2) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 2) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 2) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 2) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 3) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 3) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 3) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 3) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 3) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 3) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 3) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 3) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 3) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 3) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 3) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 3) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 3) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 3) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 3) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 0x7fffffffffffffff) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x7fffffffffffffff) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x7fffffffffffffff) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 0x7fffffffffffffff) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x7fffffffffffffff) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x7fffffffffffffff) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x7fffffffffffffff) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 0x7fffffffffffffff) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 0x7fffffffffffffff) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x7fffffffffffffff) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x7fffffffffffffff) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 0x7fffffffffffffff) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x7fffffffffffffff) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x7fffffffffffffff) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x7fffffffffffffff) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x8000000000000000) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x8000000000000000) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x8000000000000000) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 0x8000000000000000) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x8000000000000000) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x8000000000000000) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x8000000000000000) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x8000000000000000) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 0x8000000000000000) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x8000000000000000) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x8000000000000000) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 0x8000000000000000) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x8000000000000000) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x8000000000000000) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x8000000000000000) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x8000000000000001) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x8000000000000001) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x8000000000000001) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 0x8000000000000001) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x8000000000000001) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x8000000000000001) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x8000000000000001) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x8000000000000001) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x8000000000000001) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x8000000000000001) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x8000000000000001) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 0x8000000000000001) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x8000000000000001) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x8000000000000001) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x8000000000000001) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x4000000000000000) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x4000000000000000) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x4000000000000000) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 0x4000000000000000) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x4000000000000000) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x4000000000000000) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 0x4000000000000000) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 0x4000000000000000) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 0x4000000000000000) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x4000000000000000) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x4000000000000000) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 0x4000000000000000) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x4000000000000000) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x4000000000000000) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x4000000000000000) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x123456789abcdef) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x123456789abcdef) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x123456789abcdef) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 0x123456789abcdef) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x123456789abcdef) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x123456789abcdef) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 0x123456789abcdef) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 0x123456789abcdef) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 0x123456789abcdef) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 0x123456789abcdef) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x123456789abcdef) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 0x123456789abcdef) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x123456789abcdef) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x123456789abcdef) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0x123456789abcdef) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 0xfedcba9876543210) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0xfedcba9876543210) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0xfedcba9876543210) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 0xfedcba9876543210) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0xfedcba9876543210) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0xfedcba9876543210) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0xfedcba9876543210) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0xfedcba9876543210) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0xfedcba9876543210) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0xfedcba9876543210) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0xfedcba9876543210) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0xfedcba9876543210) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0xfedcba9876543210) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0xfedcba9876543210) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0xfedcba9876543210) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 63) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 63) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 63) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 63) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 63) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 63) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 63) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 63) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 63) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 63) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 63) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 63) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 63) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 63) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 63) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 64) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 64) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 64) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 64) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 64) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 64) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 64) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 64) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 64) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 64) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 64) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 64) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 64) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 64) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 64) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 65) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 65) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 65) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 65) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 65) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 65) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 65) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 65) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 65) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 65) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 65) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 65) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 65) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 65) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 65) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 0xfffffffffff5a5a) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0xfffffffffff5a5a) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0xfffffffffff5a5a) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 0xfffffffffff5a5a) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0xfffffffffff5a5a) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0xfffffffffff5a5a) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 0xfffffffffff5a5a) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 0xfffffffffff5a5a) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 0xfffffffffff5a5a) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 0xfffffffffff5a5a) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0xfffffffffff5a5a) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "lt_u" (i64.const 0xfffffffffff5a5a) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0xfffffffffff5a5a) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0xfffffffffff5a5a) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "lt_u" (i64.const 0xfffffffffff5a5a) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+
+(assert_return (invoke "le_s" (i64.const 0) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 1) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 1) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 1) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 1) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 1) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 1) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 1) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 1) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 1) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 1) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 1) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 1) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 1) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 1) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 1) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const -1) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const -1) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const -1) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const -1) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const -1) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const -1) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const -1) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const -1) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const -1) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const -1) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const -1) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const -1) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const -1) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const -1) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const -1) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 2) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 2) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 2) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 2) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 2) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 2) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 2) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 2) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 2) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 2) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 2) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 2) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 2) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 2) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 2) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 3) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 3) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 3) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 3) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 3) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 3) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 3) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 3) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 3) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 3) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 3) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 3) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 3) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 3) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 3) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0x7fffffffffffffff) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0x7fffffffffffffff) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0x7fffffffffffffff) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0x7fffffffffffffff) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0x7fffffffffffffff) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0x7fffffffffffffff) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0x7fffffffffffffff) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0x7fffffffffffffff) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0x7fffffffffffffff) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0x7fffffffffffffff) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0x7fffffffffffffff) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0x7fffffffffffffff) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0x7fffffffffffffff) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0x7fffffffffffffff) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0x7fffffffffffffff) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0x8000000000000000) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0x8000000000000000) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0x8000000000000000) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0x8000000000000000) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0x8000000000000000) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0x8000000000000000) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0x8000000000000000) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0x8000000000000000) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0x8000000000000000) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0x8000000000000000) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0x8000000000000000) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0x8000000000000000) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0x8000000000000000) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0x8000000000000000) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0x8000000000000000) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0x8000000000000001) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0x8000000000000001) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0x8000000000000001) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0x8000000000000001) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0x8000000000000001) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0x8000000000000001) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0x8000000000000001) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0x8000000000000001) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0x8000000000000001) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0x8000000000000001) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0x8000000000000001) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0x8000000000000001) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0x8000000000000001) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0x8000000000000001) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0x8000000000000001) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0x4000000000000000) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0x4000000000000000) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0x4000000000000000) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0x4000000000000000) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0x4000000000000000) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0x4000000000000000) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0x4000000000000000) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0x4000000000000000) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0x4000000000000000) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0x4000000000000000) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0x4000000000000000) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0x4000000000000000) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0x4000000000000000) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0x4000000000000000) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0x4000000000000000) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0x123456789abcdef) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0x123456789abcdef) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0x123456789abcdef) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0x123456789abcdef) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0x123456789abcdef) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0x123456789abcdef) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0x123456789abcdef) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0x123456789abcdef) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0x123456789abcdef) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0x123456789abcdef) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0x123456789abcdef) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0x123456789abcdef) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0x123456789abcdef) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0x123456789abcdef) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0x123456789abcdef) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0xfedcba9876543210) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0xfedcba9876543210) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0xfedcba9876543210) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0xfedcba9876543210) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0xfedcba9876543210) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0xfedcba9876543210) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0xfedcba9876543210) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0xfedcba9876543210) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0xfedcba9876543210) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0xfedcba9876543210) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0xfedcba9876543210) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0xfedcba9876543210) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0xfedcba9876543210) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0xfedcba9876543210) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0xfedcba9876543210) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 63) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 63) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 63) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 63) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 63) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 63) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 63) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 63) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 63) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 63) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 63) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 63) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 63) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 63) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 63) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 64) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 64) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 64) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 64) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 64) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 64) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 64) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 64) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 64) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 64) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 64) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 64) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 64) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 64) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 64) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 65) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 65) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 65) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 65) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 65) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 65) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 65) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 65) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 65) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 65) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 65) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 65) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 65) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 65) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 65) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0xfffffffffff5a5a) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0xfffffffffff5a5a) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0xfffffffffff5a5a) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0xfffffffffff5a5a) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0xfffffffffff5a5a) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0xfffffffffff5a5a) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0xfffffffffff5a5a) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0xfffffffffff5a5a) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0xfffffffffff5a5a) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "le_s" (i64.const 0xfffffffffff5a5a) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0xfffffffffff5a5a) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0xfffffffffff5a5a) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0xfffffffffff5a5a) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0xfffffffffff5a5a) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "le_s" (i64.const 0xfffffffffff5a5a) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+
+(assert_return (invoke "le_u" (i64.const 0) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 0) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 0) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 0) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 0) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 0) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 0) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 0) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 0) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 0) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 0) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 0) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 0) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 0) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 0) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 1) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 1) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 1) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 1) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 1) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 1) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 1) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 1) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 1) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 1) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 1) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 1) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 1) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 1) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 1) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const -1) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const -1) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const -1) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const -1) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const -1) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const -1) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const -1) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const -1) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const -1) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const -1) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const -1) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const -1) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const -1) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const -1) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const -1) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 2) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 2) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 2) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 2) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 2) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 2) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 2) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 2) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 2) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 2) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 2) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 2) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 2) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 2) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 2) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 3) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 3) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 3) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 3) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 3) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 3) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 3) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 3) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 3) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 3) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 3) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 3) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 3) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 3) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 3) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 0x7fffffffffffffff) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0x7fffffffffffffff) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0x7fffffffffffffff) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 0x7fffffffffffffff) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0x7fffffffffffffff) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0x7fffffffffffffff) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 0x7fffffffffffffff) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 0x7fffffffffffffff) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 0x7fffffffffffffff) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0x7fffffffffffffff) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0x7fffffffffffffff) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 0x7fffffffffffffff) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0x7fffffffffffffff) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0x7fffffffffffffff) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0x7fffffffffffffff) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0x8000000000000000) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0x8000000000000000) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0x8000000000000000) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 0x8000000000000000) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0x8000000000000000) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0x8000000000000000) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0x8000000000000000) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 0x8000000000000000) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 0x8000000000000000) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0x8000000000000000) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0x8000000000000000) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 0x8000000000000000) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0x8000000000000000) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0x8000000000000000) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0x8000000000000000) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0x8000000000000001) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0x8000000000000001) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0x8000000000000001) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 0x8000000000000001) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0x8000000000000001) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0x8000000000000001) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0x8000000000000001) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0x8000000000000001) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 0x8000000000000001) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0x8000000000000001) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0x8000000000000001) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 0x8000000000000001) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0x8000000000000001) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0x8000000000000001) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0x8000000000000001) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0x4000000000000000) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0x4000000000000000) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0x4000000000000000) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 0x4000000000000000) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0x4000000000000000) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0x4000000000000000) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 0x4000000000000000) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 0x4000000000000000) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 0x4000000000000000) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 0x4000000000000000) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0x4000000000000000) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 0x4000000000000000) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0x4000000000000000) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0x4000000000000000) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0x4000000000000000) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0x123456789abcdef) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0x123456789abcdef) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0x123456789abcdef) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 0x123456789abcdef) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0x123456789abcdef) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0x123456789abcdef) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 0x123456789abcdef) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 0x123456789abcdef) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 0x123456789abcdef) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 0x123456789abcdef) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 0x123456789abcdef) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 0x123456789abcdef) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0x123456789abcdef) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0x123456789abcdef) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0x123456789abcdef) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 0xfedcba9876543210) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0xfedcba9876543210) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0xfedcba9876543210) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 0xfedcba9876543210) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0xfedcba9876543210) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0xfedcba9876543210) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0xfedcba9876543210) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0xfedcba9876543210) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0xfedcba9876543210) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0xfedcba9876543210) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0xfedcba9876543210) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 0xfedcba9876543210) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0xfedcba9876543210) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0xfedcba9876543210) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0xfedcba9876543210) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 63) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 63) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 63) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 63) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 63) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 63) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 63) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 63) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 63) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 63) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 63) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 63) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 63) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 63) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 63) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 64) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 64) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 64) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 64) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 64) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 64) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 64) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 64) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 64) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 64) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 64) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 64) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 64) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 64) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 64) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 65) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 65) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 65) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 65) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 65) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 65) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 65) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 65) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 65) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 65) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 65) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 65) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 65) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 65) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 65) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 0xfffffffffff5a5a) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0xfffffffffff5a5a) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0xfffffffffff5a5a) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 0xfffffffffff5a5a) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0xfffffffffff5a5a) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0xfffffffffff5a5a) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 0xfffffffffff5a5a) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 0xfffffffffff5a5a) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 0xfffffffffff5a5a) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 0xfffffffffff5a5a) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0xfffffffffff5a5a) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "le_u" (i64.const 0xfffffffffff5a5a) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0xfffffffffff5a5a) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0xfffffffffff5a5a) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "le_u" (i64.const 0xfffffffffff5a5a) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+
+(assert_return (invoke "gt_s" (i64.const 0) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 1) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 1) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 1) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 1) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 1) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 1) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 1) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 1) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 1) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 1) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 1) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 1) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 1) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 1) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 1) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const -1) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const -1) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const -1) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const -1) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const -1) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const -1) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const -1) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const -1) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const -1) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const -1) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const -1) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const -1) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const -1) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const -1) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const -1) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 2) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 2) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 2) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 2) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 2) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 2) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 2) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 2) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 2) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 2) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 2) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 2) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 2) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 2) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 2) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 3) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 3) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 3) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 3) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 3) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 3) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 3) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 3) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 3) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 3) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 3) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 3) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 3) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 3) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 3) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0x7fffffffffffffff) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0x7fffffffffffffff) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0x7fffffffffffffff) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0x7fffffffffffffff) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0x7fffffffffffffff) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0x7fffffffffffffff) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0x7fffffffffffffff) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0x7fffffffffffffff) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0x7fffffffffffffff) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0x7fffffffffffffff) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0x7fffffffffffffff) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0x7fffffffffffffff) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0x7fffffffffffffff) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0x7fffffffffffffff) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0x7fffffffffffffff) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0x8000000000000000) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0x8000000000000000) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0x8000000000000000) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0x8000000000000000) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0x8000000000000000) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0x8000000000000000) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0x8000000000000000) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0x8000000000000000) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0x8000000000000000) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0x8000000000000000) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0x8000000000000000) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0x8000000000000000) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0x8000000000000000) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0x8000000000000000) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0x8000000000000000) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0x8000000000000001) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0x8000000000000001) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0x8000000000000001) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0x8000000000000001) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0x8000000000000001) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0x8000000000000001) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0x8000000000000001) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0x8000000000000001) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0x8000000000000001) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0x8000000000000001) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0x8000000000000001) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0x8000000000000001) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0x8000000000000001) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0x8000000000000001) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0x8000000000000001) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0x4000000000000000) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0x4000000000000000) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0x4000000000000000) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0x4000000000000000) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0x4000000000000000) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0x4000000000000000) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0x4000000000000000) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0x4000000000000000) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0x4000000000000000) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0x4000000000000000) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0x4000000000000000) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0x4000000000000000) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0x4000000000000000) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0x4000000000000000) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0x4000000000000000) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0x123456789abcdef) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0x123456789abcdef) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0x123456789abcdef) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0x123456789abcdef) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0x123456789abcdef) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0x123456789abcdef) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0x123456789abcdef) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0x123456789abcdef) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0x123456789abcdef) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0x123456789abcdef) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0x123456789abcdef) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0x123456789abcdef) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0x123456789abcdef) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0x123456789abcdef) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0x123456789abcdef) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0xfedcba9876543210) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0xfedcba9876543210) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0xfedcba9876543210) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0xfedcba9876543210) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0xfedcba9876543210) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0xfedcba9876543210) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0xfedcba9876543210) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0xfedcba9876543210) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0xfedcba9876543210) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0xfedcba9876543210) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0xfedcba9876543210) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0xfedcba9876543210) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0xfedcba9876543210) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0xfedcba9876543210) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0xfedcba9876543210) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 63) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 63) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 63) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 63) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 63) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 63) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 63) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 63) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 63) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 63) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 63) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 63) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 63) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 63) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 63) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 64) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 64) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 64) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 64) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 64) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 64) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 64) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 64) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 64) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 64) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 64) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 64) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 64) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 64) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 64) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 65) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 65) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 65) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 65) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 65) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 65) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 65) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 65) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 65) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 65) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 65) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 65) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 65) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 65) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 65) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0xfffffffffff5a5a) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0xfffffffffff5a5a) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0xfffffffffff5a5a) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0xfffffffffff5a5a) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0xfffffffffff5a5a) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0xfffffffffff5a5a) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0xfffffffffff5a5a) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0xfffffffffff5a5a) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0xfffffffffff5a5a) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "gt_s" (i64.const 0xfffffffffff5a5a) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0xfffffffffff5a5a) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0xfffffffffff5a5a) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0xfffffffffff5a5a) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0xfffffffffff5a5a) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "gt_s" (i64.const 0xfffffffffff5a5a) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+
+(assert_return (invoke "gt_u" (i64.const 0) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 0) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 0) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 0) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 0) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 0) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 0) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 0) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 0) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 0) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 0) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 0) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 0) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 0) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 0) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 1) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 1) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 1) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 1) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 1) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 1) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 1) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 1) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 1) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 1) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 1) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 1) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 1) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 1) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 1) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const -1) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const -1) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const -1) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const -1) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const -1) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const -1) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const -1) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const -1) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const -1) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const -1) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const -1) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const -1) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const -1) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const -1) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const -1) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 2) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 2) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 2) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 2) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 2) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 2) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 2) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 2) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 2) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 2) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 2) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 2) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 2) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 2) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 2) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 3) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 3) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 3) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 3) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 3) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 3) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 3) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 3) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 3) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 3) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 3) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 3) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 3) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 3) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 3) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 0x7fffffffffffffff) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0x7fffffffffffffff) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0x7fffffffffffffff) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 0x7fffffffffffffff) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0x7fffffffffffffff) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0x7fffffffffffffff) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 0x7fffffffffffffff) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 0x7fffffffffffffff) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 0x7fffffffffffffff) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0x7fffffffffffffff) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0x7fffffffffffffff) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 0x7fffffffffffffff) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0x7fffffffffffffff) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0x7fffffffffffffff) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0x7fffffffffffffff) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0x8000000000000000) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0x8000000000000000) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0x8000000000000000) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 0x8000000000000000) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0x8000000000000000) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0x8000000000000000) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0x8000000000000000) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 0x8000000000000000) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 0x8000000000000000) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0x8000000000000000) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0x8000000000000000) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 0x8000000000000000) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0x8000000000000000) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0x8000000000000000) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0x8000000000000000) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0x8000000000000001) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0x8000000000000001) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0x8000000000000001) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 0x8000000000000001) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0x8000000000000001) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0x8000000000000001) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0x8000000000000001) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0x8000000000000001) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 0x8000000000000001) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0x8000000000000001) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0x8000000000000001) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 0x8000000000000001) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0x8000000000000001) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0x8000000000000001) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0x8000000000000001) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0x4000000000000000) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0x4000000000000000) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0x4000000000000000) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 0x4000000000000000) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0x4000000000000000) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0x4000000000000000) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 0x4000000000000000) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 0x4000000000000000) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 0x4000000000000000) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 0x4000000000000000) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0x4000000000000000) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 0x4000000000000000) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0x4000000000000000) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0x4000000000000000) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0x4000000000000000) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0x123456789abcdef) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0x123456789abcdef) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0x123456789abcdef) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 0x123456789abcdef) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0x123456789abcdef) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0x123456789abcdef) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 0x123456789abcdef) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 0x123456789abcdef) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 0x123456789abcdef) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 0x123456789abcdef) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 0x123456789abcdef) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 0x123456789abcdef) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0x123456789abcdef) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0x123456789abcdef) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0x123456789abcdef) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 0xfedcba9876543210) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0xfedcba9876543210) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0xfedcba9876543210) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 0xfedcba9876543210) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0xfedcba9876543210) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0xfedcba9876543210) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0xfedcba9876543210) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0xfedcba9876543210) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0xfedcba9876543210) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0xfedcba9876543210) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0xfedcba9876543210) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 0xfedcba9876543210) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0xfedcba9876543210) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0xfedcba9876543210) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0xfedcba9876543210) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 63) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 63) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 63) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 63) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 63) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 63) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 63) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 63) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 63) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 63) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 63) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 63) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 63) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 63) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 63) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 64) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 64) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 64) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 64) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 64) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 64) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 64) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 64) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 64) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 64) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 64) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 64) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 64) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 64) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 64) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 65) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 65) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 65) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 65) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 65) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 65) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 65) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 65) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 65) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 65) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 65) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 65) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 65) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 65) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 65) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 0xfffffffffff5a5a) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0xfffffffffff5a5a) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0xfffffffffff5a5a) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 0xfffffffffff5a5a) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0xfffffffffff5a5a) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0xfffffffffff5a5a) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 0xfffffffffff5a5a) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 0xfffffffffff5a5a) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 0xfffffffffff5a5a) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 0xfffffffffff5a5a) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0xfffffffffff5a5a) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "gt_u" (i64.const 0xfffffffffff5a5a) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0xfffffffffff5a5a) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0xfffffffffff5a5a) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "gt_u" (i64.const 0xfffffffffff5a5a) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+
+(assert_return (invoke "ge_s" (i64.const 0) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 1) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 1) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 1) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 1) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 1) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 1) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 1) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 1) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 1) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 1) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 1) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 1) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 1) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 1) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 1) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const -1) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const -1) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const -1) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const -1) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const -1) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const -1) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const -1) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const -1) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const -1) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const -1) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const -1) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const -1) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const -1) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const -1) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const -1) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 2) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 2) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 2) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 2) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 2) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 2) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 2) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 2) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 2) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 2) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 2) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 2) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 2) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 2) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 2) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 3) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 3) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 3) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 3) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 3) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 3) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 3) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 3) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 3) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 3) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 3) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 3) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 3) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 3) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 3) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0x7fffffffffffffff) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0x7fffffffffffffff) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0x7fffffffffffffff) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0x7fffffffffffffff) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0x7fffffffffffffff) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0x7fffffffffffffff) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0x7fffffffffffffff) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0x7fffffffffffffff) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0x7fffffffffffffff) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0x7fffffffffffffff) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0x7fffffffffffffff) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0x7fffffffffffffff) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0x7fffffffffffffff) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0x7fffffffffffffff) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0x7fffffffffffffff) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0x8000000000000000) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0x8000000000000000) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0x8000000000000000) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0x8000000000000000) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0x8000000000000000) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0x8000000000000000) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0x8000000000000000) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0x8000000000000000) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0x8000000000000000) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0x8000000000000000) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0x8000000000000000) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0x8000000000000000) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0x8000000000000000) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0x8000000000000000) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0x8000000000000000) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0x8000000000000001) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0x8000000000000001) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0x8000000000000001) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0x8000000000000001) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0x8000000000000001) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0x8000000000000001) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0x8000000000000001) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0x8000000000000001) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0x8000000000000001) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0x8000000000000001) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0x8000000000000001) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0x8000000000000001) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0x8000000000000001) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0x8000000000000001) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0x8000000000000001) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0x4000000000000000) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0x4000000000000000) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0x4000000000000000) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0x4000000000000000) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0x4000000000000000) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0x4000000000000000) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0x4000000000000000) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0x4000000000000000) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0x4000000000000000) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0x4000000000000000) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0x4000000000000000) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0x4000000000000000) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0x4000000000000000) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0x4000000000000000) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0x4000000000000000) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0x123456789abcdef) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0x123456789abcdef) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0x123456789abcdef) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0x123456789abcdef) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0x123456789abcdef) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0x123456789abcdef) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0x123456789abcdef) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0x123456789abcdef) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0x123456789abcdef) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0x123456789abcdef) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0x123456789abcdef) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0x123456789abcdef) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0x123456789abcdef) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0x123456789abcdef) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0x123456789abcdef) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0xfedcba9876543210) (i64.const 0)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0xfedcba9876543210) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0xfedcba9876543210) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0xfedcba9876543210) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0xfedcba9876543210) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0xfedcba9876543210) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0xfedcba9876543210) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0xfedcba9876543210) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0xfedcba9876543210) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0xfedcba9876543210) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0xfedcba9876543210) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0xfedcba9876543210) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0xfedcba9876543210) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0xfedcba9876543210) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0xfedcba9876543210) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 63) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 63) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 63) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 63) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 63) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 63) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 63) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 63) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 63) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 63) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 63) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 63) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 63) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 63) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 63) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 64) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 64) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 64) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 64) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 64) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 64) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 64) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 64) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 64) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 64) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 64) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 64) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 64) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 64) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 64) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 65) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 65) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 65) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 65) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 65) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 65) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 65) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 65) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 65) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 65) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 65) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 65) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 65) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 65) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 65) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0xfffffffffff5a5a) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0xfffffffffff5a5a) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0xfffffffffff5a5a) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0xfffffffffff5a5a) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0xfffffffffff5a5a) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0xfffffffffff5a5a) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0xfffffffffff5a5a) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0xfffffffffff5a5a) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0xfffffffffff5a5a) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "ge_s" (i64.const 0xfffffffffff5a5a) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0xfffffffffff5a5a) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0xfffffffffff5a5a) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0xfffffffffff5a5a) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0xfffffffffff5a5a) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "ge_s" (i64.const 0xfffffffffff5a5a) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+
+(assert_return (invoke "ge_u" (i64.const 0) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0) (i64.const 1)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 0) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 0) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 0) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 0) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 0) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 0) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 0) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 0) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 0) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 0) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 0) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 0) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 0) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 1) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 1) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 1) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 1) (i64.const 2)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 1) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 1) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 1) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 1) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 1) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 1) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 1) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 1) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 1) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 1) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 1) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const -1) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const -1) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const -1) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const -1) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const -1) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const -1) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const -1) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const -1) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const -1) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const -1) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const -1) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const -1) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const -1) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const -1) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const -1) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 2) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 2) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 2) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 2) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 2) (i64.const 3)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 2) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 2) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 2) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 2) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 2) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 2) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 2) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 2) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 2) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 2) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 3) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 3) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 3) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 3) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 3) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 3) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 3) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 3) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 3) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 3) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 3) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 3) (i64.const 63)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 3) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 3) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 3) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 0x7fffffffffffffff) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x7fffffffffffffff) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x7fffffffffffffff) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 0x7fffffffffffffff) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x7fffffffffffffff) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x7fffffffffffffff) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x7fffffffffffffff) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 0x7fffffffffffffff) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 0x7fffffffffffffff) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x7fffffffffffffff) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x7fffffffffffffff) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 0x7fffffffffffffff) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x7fffffffffffffff) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x7fffffffffffffff) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x7fffffffffffffff) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x8000000000000000) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x8000000000000000) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x8000000000000000) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 0x8000000000000000) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x8000000000000000) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x8000000000000000) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x8000000000000000) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x8000000000000000) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 0x8000000000000000) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x8000000000000000) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x8000000000000000) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 0x8000000000000000) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x8000000000000000) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x8000000000000000) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x8000000000000000) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x8000000000000001) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x8000000000000001) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x8000000000000001) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 0x8000000000000001) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x8000000000000001) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x8000000000000001) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x8000000000000001) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x8000000000000001) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x8000000000000001) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x8000000000000001) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x8000000000000001) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 0x8000000000000001) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x8000000000000001) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x8000000000000001) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x8000000000000001) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x4000000000000000) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x4000000000000000) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x4000000000000000) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 0x4000000000000000) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x4000000000000000) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x4000000000000000) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 0x4000000000000000) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 0x4000000000000000) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 0x4000000000000000) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x4000000000000000) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x4000000000000000) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 0x4000000000000000) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x4000000000000000) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x4000000000000000) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x4000000000000000) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x123456789abcdef) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x123456789abcdef) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x123456789abcdef) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 0x123456789abcdef) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x123456789abcdef) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x123456789abcdef) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 0x123456789abcdef) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 0x123456789abcdef) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 0x123456789abcdef) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 0x123456789abcdef) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x123456789abcdef) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 0x123456789abcdef) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x123456789abcdef) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x123456789abcdef) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0x123456789abcdef) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 0xfedcba9876543210) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0xfedcba9876543210) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0xfedcba9876543210) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 0xfedcba9876543210) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0xfedcba9876543210) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0xfedcba9876543210) (i64.const 0x7fffffffffffffff)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0xfedcba9876543210) (i64.const 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0xfedcba9876543210) (i64.const 0x8000000000000001)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0xfedcba9876543210) (i64.const 0x4000000000000000)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0xfedcba9876543210) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0xfedcba9876543210) (i64.const 0xfedcba9876543210)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0xfedcba9876543210) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0xfedcba9876543210) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0xfedcba9876543210) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0xfedcba9876543210) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 63) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 63) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 63) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 63) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 63) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 63) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 63) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 63) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 63) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 63) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 63) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 63) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 63) (i64.const 64)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 63) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 63) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 64) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 64) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 64) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 64) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 64) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 64) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 64) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 64) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 64) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 64) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 64) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 64) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 64) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 64) (i64.const 65)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 64) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 65) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 65) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 65) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 65) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 65) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 65) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 65) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 65) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 65) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 65) (i64.const 0x123456789abcdef)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 65) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 65) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 65) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 65) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 65) (i64.const 0xfffffffffff5a5a)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 0xfffffffffff5a5a) (i64.const 0)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0xfffffffffff5a5a) (i64.const 1)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0xfffffffffff5a5a) (i64.const -1)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 0xfffffffffff5a5a) (i64.const 2)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0xfffffffffff5a5a) (i64.const 3)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0xfffffffffff5a5a) (i64.const 0x7fffffffffffffff)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 0xfffffffffff5a5a) (i64.const 0x8000000000000000)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 0xfffffffffff5a5a) (i64.const 0x8000000000000001)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 0xfffffffffff5a5a) (i64.const 0x4000000000000000)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 0xfffffffffff5a5a) (i64.const 0x123456789abcdef)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0xfffffffffff5a5a) (i64.const 0xfedcba9876543210)) (i32.const 0))
+(assert_return (invoke "ge_u" (i64.const 0xfffffffffff5a5a) (i64.const 63)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0xfffffffffff5a5a) (i64.const 64)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0xfffffffffff5a5a) (i64.const 65)) (i32.const 1))
+(assert_return (invoke "ge_u" (i64.const 0xfffffffffff5a5a) (i64.const 0xfffffffffff5a5a)) (i32.const 1))
+
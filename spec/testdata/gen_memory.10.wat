@@ -0,0 +1 @@
+(module (func (f32.store (i32.const 0) (f32.const 0))))
@@ -0,0 +1 @@
+(module (func (drop (i32.load8_s (i32.const 0)))))
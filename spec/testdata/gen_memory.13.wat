@@ -0,0 +1 @@
+(module (func (drop (current_memory))))
@@ -0,0 +1 @@
+(module (func (drop (grow_memory (i32.const 0)))))
@@ -0,0 +1 @@
+(module (memory 0) (data (i64.const 0)))
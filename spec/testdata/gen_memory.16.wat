@@ -0,0 +1 @@
+(module (memory 1) (data (i32.ctz (i32.const 0))))
@@ -0,0 +1 @@
+(module (memory 65537))
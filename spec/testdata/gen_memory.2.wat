@@ -0,0 +1 @@
+(module (memory 1 256))
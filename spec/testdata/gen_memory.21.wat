@@ -0,0 +1 @@
+(module (memory 0) (func (drop (i64.load8_s align=2 (i32.const 0)))))
@@ -0,0 +1 @@
+(module (memory 0) (func (drop (i64.load align=16 (i32.const 0)))))
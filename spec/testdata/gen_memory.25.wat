@@ -0,0 +1 @@
+(module (memory 0) (func (drop (i32.load align=8 (i32.const 0)))))
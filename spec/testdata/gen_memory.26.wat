@@ -0,0 +1 @@
+(module (memory 0) (func (drop (f32.load align=8 (i32.const 0)))))
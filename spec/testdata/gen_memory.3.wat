@@ -0,0 +1 @@
+(module (memory 0 65536))
@@ -0,0 +1 @@
+(module (memory 0) (data (i32.const 0) "a"))
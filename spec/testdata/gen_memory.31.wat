@@ -0,0 +1 @@
+(module (memory 1 2) (data (i32.const 0) "a") (data (i32.const 98304) "b"))
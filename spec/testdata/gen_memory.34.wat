@@ -0,0 +1 @@
+(module (memory 2) (data (i32.const 0x1fffe) "abc"))
@@ -0,0 +1 @@
+(module (memory 1) (data (i32.const 0x1000) "x") (data (i32.const 0x2000)))
@@ -0,0 +1 @@
+(module (memory (data "")) (func (export "memsize") (result i32) (current_memory)))
@@ -0,0 +1,277 @@
+;; Memory declarations, data segments, and loads and stores of every width.
+(module (memory 0 0))
+(module (memory 0 1))
+(module (memory 1 256))
+(module (memory 0 65536))
+
+(module (memory (data)) (func (export "memsize") (result i32) (current_memory)))
+(assert_return (invoke "memsize") (i32.const 0))
+(module (memory (data "")) (func (export "memsize") (result i32) (current_memory)))
+(assert_return (invoke "memsize") (i32.const 0))
+(module (memory (data "x")) (func (export "memsize") (result i32) (current_memory)))
+(assert_return (invoke "memsize") (i32.const 1))
+
+(assert_invalid (module (data (i32.const 0))) "unknown memory")
+(assert_invalid (module (data (i32.const 0) "x")) "unknown memory")
+(assert_invalid (module (func (drop (f32.load (i32.const 0))))) "unknown memory")
+(assert_invalid (module (func (f32.store (i32.const 0) (f32.const 0)))) "unknown memory")
+(assert_invalid (module (func (drop (i32.load8_s (i32.const 0))))) "unknown memory")
+(assert_invalid (module (func (i32.store8 (i32.const 0) (i32.const 0)))) "unknown memory")
+(assert_invalid (module (func (drop (current_memory)))) "unknown memory")
+(assert_invalid (module (func (drop (grow_memory (i32.const 0))))) "unknown memory")
+(assert_invalid (module (memory 0) (data (i64.const 0))) "type mismatch")
+(assert_invalid (module (memory 1) (data (i32.ctz (i32.const 0)))) "constant expression required")
+(assert_invalid (module (memory 1) (data (nop))) "constant expression required")
+(assert_invalid (module (memory 1 0)) "size minimum must not be greater than maximum")
+(assert_invalid (module (memory 65537)) "memory size must be at most 65536 pages (4GiB)")
+(assert_invalid (module (memory 0 65537)) "memory size must be at most 65536 pages (4GiB)")
+(assert_invalid (module (memory 0) (func (drop (i64.load8_s align=2 (i32.const 0))))) "alignment must not be larger than natural")
+(assert_invalid (module (memory 0) (func (drop (i64.load16_u align=4 (i32.const 0))))) "alignment must not be larger than natural")
+(assert_invalid (module (memory 0) (func (drop (i64.load32_s align=8 (i32.const 0))))) "alignment must not be larger than natural")
+(assert_invalid (module (memory 0) (func (drop (i64.load align=16 (i32.const 0))))) "alignment must not be larger than natural")
+(assert_invalid (module (memory 0) (func (drop (i32.load align=8 (i32.const 0))))) "alignment must not be larger than natural")
+(assert_invalid (module (memory 0) (func (drop (f32.load align=8 (i32.const 0))))) "alignment must not be larger than natural")
+(assert_invalid (module (memory 0) (func (drop (f64.load align=16 (i32.const 0))))) "alignment must not be larger than natural")
+(assert_invalid (module (memory 0) (func (i32.store16 align=4 (i32.const 0) (i32.const 0)))) "alignment must not be larger than natural")
+(assert_invalid (module (memory 0) (func (i64.store align=16 (i32.const 0) (i64.const 0)))) "alignment must not be larger than natural")
+
+(assert_unlinkable (module (memory 0) (data (i32.const 0) "a")) "data segment does not fit")
+(assert_unlinkable (module (memory 1 2) (data (i32.const 0) "a") (data (i32.const 98304) "b")) "data segment does not fit")
+(assert_unlinkable (module (memory 1) (data (i32.const 65536) "a")) "data segment does not fit")
+(assert_unlinkable (module (memory 1) (data (i32.const -1) "a")) "data segment does not fit")
+(assert_unlinkable (module (memory 2) (data (i32.const 0x1fffe) "abc")) "data segment does not fit")
+(module (memory 0) (data (i32.const 0)))
+(module (memory 1) (data (i32.const 65536)))
+(module (memory 1) (data (i32.const 0x1000) "x") (data (i32.const 0x2000)))
+
+(module
+  (memory 1)
+  (data (i32.const 0) "ABC\a7D") (data (i32.const 20) "WASM")
+
+  (func (export "data") (result i32)
+    (i32.and
+      (i32.and
+        (i32.and
+          (i32.eq (i32.load8_u (i32.const 0)) (i32.const 65))
+          (i32.eq (i32.load8_u (i32.const 3)) (i32.const 167))
+        )
+        (i32.and
+          (i32.eq (i32.load8_u (i32.const 6)) (i32.const 0))
+          (i32.eq (i32.load8_u (i32.const 19)) (i32.const 0))
+        )
+      )
+      (i32.and
+        (i32.and
+          (i32.eq (i32.load8_u (i32.const 20)) (i32.const 87))
+          (i32.eq (i32.load8_u (i32.const 23)) (i32.const 77))
+        )
+        (i32.and
+          (i32.eq (i32.load8_u (i32.const 24)) (i32.const 0))
+          (i32.eq (i32.load8_u (i32.const 1023)) (i32.const 0))
+        )
+      )
+    )
+  )
+
+  (func (export "cast") (result f64)
+    (i64.store (i32.const 8) (i64.const -12345))
+    (if
+      (f64.eq
+        (f64.load (i32.const 8))
+        (f64.reinterpret/i64 (i64.const -12345))
+      )
+      (then (return (f64.const 0)))
+    )
+    (i64.store align=1 (i32.const 9) (i64.const 0))
+    (i32.store16 align=1 (i32.const 15) (i32.const 16453))
+    (f64.load align=1 (i32.const 9))
+  )
+
+  (func (export "unaligned") (param $a i32) (result i64)
+    (i64.store align=1 (get_local $a) (i64.const 0x0102030405060708))
+    (i64.add
+      (i64.load align=1 (get_local $a))
+      (i64.extend_u/i32 (i32.load16_u align=1 (i32.add (get_local $a) (i32.const 1))))
+    )
+  )
+
+  (func (export "i32_load8_s") (param $i i32) (result i32)
+    (i32.store8 (i32.const 8) (get_local $i))
+    (i32.load8_s (i32.const 8))
+  )
+  (func (export "i32_load8_u") (param $i i32) (result i32)
+    (i32.store8 (i32.const 8) (get_local $i))
+    (i32.load8_u (i32.const 8))
+  )
+  (func (export "i32_load16_s") (param $i i32) (result i32)
+    (i32.store16 (i32.const 8) (get_local $i))
+    (i32.load16_s (i32.const 8))
+  )
+  (func (export "i32_load16_u") (param $i i32) (result i32)
+    (i32.store16 (i32.const 8) (get_local $i))
+    (i32.load16_u (i32.const 8))
+  )
+  (func (export "i64_load8_s") (param $i i64) (result i64)
+    (i64.store8 (i32.const 8) (get_local $i))
+    (i64.load8_s (i32.const 8))
+  )
+  (func (export "i64_load8_u") (param $i i64) (result i64)
+    (i64.store8 (i32.const 8) (get_local $i))
+    (i64.load8_u (i32.const 8))
+  )
+  (func (export "i64_load16_s") (param $i i64) (result i64)
+    (i64.store16 (i32.const 8) (get_local $i))
+    (i64.load16_s (i32.const 8))
+  )
+  (func (export "i64_load16_u") (param $i i64) (result i64)
+    (i64.store16 (i32.const 8) (get_local $i))
+    (i64.load16_u (i32.const 8))
+  )
+  (func (export "i64_load32_s") (param $i i64) (result i64)
+    (i64.store32 (i32.const 8) (get_local $i))
+    (i64.load32_s (i32.const 8))
+  )
+  (func (export "i64_load32_u") (param $i i64) (result i64)
+    (i64.store32 (i32.const 8) (get_local $i))
+    (i64.load32_u (i32.const 8))
+  )
+  (func (export "f32_roundtrip") (param $x f32) (result i32)
+    (f32.store (i32.const 11) (get_local $x))
+    (i32.load align=1 (i32.const 11))
+  )
+  (func (export "f64_roundtrip") (param $x f64) (result i64)
+    (f64.store offset=3 (i32.const 10) (get_local $x))
+    (i64.load offset=5 align=2 (i32.const 8))
+  )
+)
+
+(assert_return (invoke "data") (i32.const 1))
+(assert_return (invoke "cast") (f64.const 0x1.5p+5))
+(assert_return (invoke "unaligned" (i32.const 0)) (i64.const 0x102030405060d0f))
+(assert_return (invoke "unaligned" (i32.const 1)) (i64.const 0x102030405060d0f))
+(assert_return (invoke "unaligned" (i32.const 3)) (i64.const 0x102030405060d0f))
+(assert_return (invoke "unaligned" (i32.const 7)) (i64.const 0x102030405060d0f))
+(assert_return (invoke "unaligned" (i32.const 65527)) (i64.const 0x102030405060d0f))
+(assert_return (invoke "unaligned" (i32.const 65528)) (i64.const 0x102030405060d0f))
+(assert_trap (invoke "unaligned" (i32.const 65529)) "out of bounds memory access")
+
+(assert_return (invoke "i32_load8_s" (i32.const -1)) (i32.const -1))
+(assert_return (invoke "i32_load8_s" (i32.const 100)) (i32.const 100))
+(assert_return (invoke "i32_load8_s" (i32.const 200)) (i32.const -56))
+(assert_return (invoke "i32_load8_s" (i32.const 20000)) (i32.const 32))
+(assert_return (invoke "i32_load8_s" (i32.const 40000)) (i32.const 64))
+(assert_return (invoke "i32_load8_s" (i32.const 0xfedc6543)) (i32.const 67))
+(assert_return (invoke "i32_load8_s" (i32.const 0x3456436f)) (i32.const 111))
+(assert_return (invoke "i32_load8_s" (i32.const 0x7fffffff)) (i32.const -1))
+(assert_return (invoke "i32_load8_s" (i32.const 0x56346543)) (i32.const 67))
+(assert_return (invoke "i32_load8_s" (i32.const 0x98bacdef)) (i32.const -17))
+
+(assert_return (invoke "i32_load8_u" (i32.const -1)) (i32.const 255))
+(assert_return (invoke "i32_load8_u" (i32.const 100)) (i32.const 100))
+(assert_return (invoke "i32_load8_u" (i32.const 200)) (i32.const 200))
+(assert_return (invoke "i32_load8_u" (i32.const 20000)) (i32.const 32))
+(assert_return (invoke "i32_load8_u" (i32.const 40000)) (i32.const 64))
+(assert_return (invoke "i32_load8_u" (i32.const 0xfedc6543)) (i32.const 67))
+(assert_return (invoke "i32_load8_u" (i32.const 0x3456436f)) (i32.const 111))
+(assert_return (invoke "i32_load8_u" (i32.const 0x7fffffff)) (i32.const 255))
+(assert_return (invoke "i32_load8_u" (i32.const 0x56346543)) (i32.const 67))
+(assert_return (invoke "i32_load8_u" (i32.const 0x98bacdef)) (i32.const 239))
+
+(assert_return (invoke "i32_load16_s" (i32.const -1)) (i32.const -1))
+(assert_return (invoke "i32_load16_s" (i32.const 100)) (i32.const 100))
+(assert_return (invoke "i32_load16_s" (i32.const 200)) (i32.const 200))
+(assert_return (invoke "i32_load16_s" (i32.const 20000)) (i32.const 20000))
+(assert_return (invoke "i32_load16_s" (i32.const 40000)) (i32.const -25536))
+(assert_return (invoke "i32_load16_s" (i32.const 0xfedc6543)) (i32.const 25923))
+(assert_return (invoke "i32_load16_s" (i32.const 0x3456436f)) (i32.const 17263))
+(assert_return (invoke "i32_load16_s" (i32.const 0x7fffffff)) (i32.const -1))
+(assert_return (invoke "i32_load16_s" (i32.const 0x56346543)) (i32.const 25923))
+(assert_return (invoke "i32_load16_s" (i32.const 0x98bacdef)) (i32.const -12817))
+
+(assert_return (invoke "i32_load16_u" (i32.const -1)) (i32.const 65535))
+(assert_return (invoke "i32_load16_u" (i32.const 100)) (i32.const 100))
+(assert_return (invoke "i32_load16_u" (i32.const 200)) (i32.const 200))
+(assert_return (invoke "i32_load16_u" (i32.const 20000)) (i32.const 20000))
+(assert_return (invoke "i32_load16_u" (i32.const 40000)) (i32.const 40000))
+(assert_return (invoke "i32_load16_u" (i32.const 0xfedc6543)) (i32.const 25923))
+(assert_return (invoke "i32_load16_u" (i32.const 0x3456436f)) (i32.const 17263))
+(assert_return (invoke "i32_load16_u" (i32.const 0x7fffffff)) (i32.const 65535))
+(assert_return (invoke "i32_load16_u" (i32.const 0x56346543)) (i32.const 25923))
+(assert_return (invoke "i32_load16_u" (i32.const 0x98bacdef)) (i32.const 52719))
+
+(assert_return (invoke "i64_load8_s" (i64.const -1)) (i64.const -1))
+(assert_return (invoke "i64_load8_s" (i64.const 100)) (i64.const 100))
+(assert_return (invoke "i64_load8_s" (i64.const 200)) (i64.const -56))
+(assert_return (invoke "i64_load8_s" (i64.const 20000)) (i64.const 32))
+(assert_return (invoke "i64_load8_s" (i64.const 40000)) (i64.const 64))
+(assert_return (invoke "i64_load8_s" (i64.const 0xfedc6543)) (i64.const 67))
+(assert_return (invoke "i64_load8_s" (i64.const 0x3456436f)) (i64.const 111))
+(assert_return (invoke "i64_load8_s" (i64.const 0x7fffffff)) (i64.const -1))
+(assert_return (invoke "i64_load8_s" (i64.const 0xfedcba9856346543)) (i64.const 67))
+(assert_return (invoke "i64_load8_s" (i64.const 0x3456436598bacdef)) (i64.const -17))
+
+(assert_return (invoke "i64_load8_u" (i64.const -1)) (i64.const 255))
+(assert_return (invoke "i64_load8_u" (i64.const 100)) (i64.const 100))
+(assert_return (invoke "i64_load8_u" (i64.const 200)) (i64.const 200))
+(assert_return (invoke "i64_load8_u" (i64.const 20000)) (i64.const 32))
+(assert_return (invoke "i64_load8_u" (i64.const 40000)) (i64.const 64))
+(assert_return (invoke "i64_load8_u" (i64.const 0xfedc6543)) (i64.const 67))
+(assert_return (invoke "i64_load8_u" (i64.const 0x3456436f)) (i64.const 111))
+(assert_return (invoke "i64_load8_u" (i64.const 0x7fffffff)) (i64.const 255))
+(assert_return (invoke "i64_load8_u" (i64.const 0xfedcba9856346543)) (i64.const 67))
+(assert_return (invoke "i64_load8_u" (i64.const 0x3456436598bacdef)) (i64.const 239))
+
+(assert_return (invoke "i64_load16_s" (i64.const -1)) (i64.const -1))
+(assert_return (invoke "i64_load16_s" (i64.const 100)) (i64.const 100))
+(assert_return (invoke "i64_load16_s" (i64.const 200)) (i64.const 200))
+(assert_return (invoke "i64_load16_s" (i64.const 20000)) (i64.const 20000))
+(assert_return (invoke "i64_load16_s" (i64.const 40000)) (i64.const -25536))
+(assert_return (invoke "i64_load16_s" (i64.const 0xfedc6543)) (i64.const 25923))
+(assert_return (invoke "i64_load16_s" (i64.const 0x3456436f)) (i64.const 17263))
+(assert_return (invoke "i64_load16_s" (i64.const 0x7fffffff)) (i64.const -1))
+(assert_return (invoke "i64_load16_s" (i64.const 0xfedcba9856346543)) (i64.const 25923))
+(assert_return (invoke "i64_load16_s" (i64.const 0x3456436598bacdef)) (i64.const -12817))
+
+(assert_return (invoke "i64_load16_u" (i64.const -1)) (i64.const 65535))
+(assert_return (invoke "i64_load16_u" (i64.const 100)) (i64.const 100))
+(assert_return (invoke "i64_load16_u" (i64.const 200)) (i64.const 200))
+(assert_return (invoke "i64_load16_u" (i64.const 20000)) (i64.const 20000))
+(assert_return (invoke "i64_load16_u" (i64.const 40000)) (i64.const 40000))
+(assert_return (invoke "i64_load16_u" (i64.const 0xfedc6543)) (i64.const 25923))
+(assert_return (invoke "i64_load16_u" (i64.const 0x3456436f)) (i64.const 17263))
+(assert_return (invoke "i64_load16_u" (i64.const 0x7fffffff)) (i64.const 65535))
+(assert_return (invoke "i64_load16_u" (i64.const 0xfedcba9856346543)) (i64.const 25923))
+(assert_return (invoke "i64_load16_u" (i64.const 0x3456436598bacdef)) (i64.const 52719))
+
+(assert_return (invoke "i64_load32_s" (i64.const -1)) (i64.const -1))
+(assert_return (invoke "i64_load32_s" (i64.const 100)) (i64.const 100))
+(assert_return (invoke "i64_load32_s" (i64.const 200)) (i64.const 200))
+(assert_return (invoke "i64_load32_s" (i64.const 20000)) (i64.const 20000))
+(assert_return (invoke "i64_load32_s" (i64.const 40000)) (i64.const 40000))
+(assert_return (invoke "i64_load32_s" (i64.const 0xfedc6543)) (i64.const 0xfffffffffedc6543))
+(assert_return (invoke "i64_load32_s" (i64.const 0x3456436f)) (i64.const 0x3456436f))
+(assert_return (invoke "i64_load32_s" (i64.const 0x7fffffff)) (i64.const 0x7fffffff))
+(assert_return (invoke "i64_load32_s" (i64.const 0xfedcba9856346543)) (i64.const 0x56346543))
+(assert_return (invoke "i64_load32_s" (i64.const 0x3456436598bacdef)) (i64.const 0xffffffff98bacdef))
+
+(assert_return (invoke "i64_load32_u" (i64.const -1)) (i64.const 0xffffffff))
+(assert_return (invoke "i64_load32_u" (i64.const 100)) (i64.const 100))
+(assert_return (invoke "i64_load32_u" (i64.const 200)) (i64.const 200))
+(assert_return (invoke "i64_load32_u" (i64.const 20000)) (i64.const 20000))
+(assert_return (invoke "i64_load32_u" (i64.const 40000)) (i64.const 40000))
+(assert_return (invoke "i64_load32_u" (i64.const 0xfedc6543)) (i64.const 0xfedc6543))
+(assert_return (invoke "i64_load32_u" (i64.const 0x3456436f)) (i64.const 0x3456436f))
+(assert_return (invoke "i64_load32_u" (i64.const 0x7fffffff)) (i64.const 0x7fffffff))
+(assert_return (invoke "i64_load32_u" (i64.const 0xfedcba9856346543)) (i64.const 0x56346543))
+(assert_return (invoke "i64_load32_u" (i64.const 0x3456436598bacdef)) (i64.const 0x98bacdef))
+
+(assert_return (invoke "f32_roundtrip" (f32.const 0x0p+0)) (i32.const 0))
+(assert_return (invoke "f32_roundtrip" (f32.const -0x0p+0)) (i32.const 0x80000000))
+(assert_return (invoke "f32_roundtrip" (f32.const 0x1p+0)) (i32.const 0x3f800000))
+(assert_return (invoke "f32_roundtrip" (f32.const nan:0x200001)) (i32.const 0x7fa00001))
+(assert_return (invoke "f32_roundtrip" (f32.const -nan)) (i32.const 0xffc00000))
+(assert_return (invoke "f64_roundtrip" (f64.const 0x0p+0)) (i64.const 0))
+(assert_return (invoke "f64_roundtrip" (f64.const -0x0p+0)) (i64.const 0x8000000000000000))
+(assert_return (invoke "f64_roundtrip" (f64.const 0x1p+0)) (i64.const 0x3ff0000000000000))
+(assert_return (invoke "f64_roundtrip" (f64.const nan:0x4000000000001)) (i64.const 0x7ff4000000000001))
+(assert_return (invoke "f64_roundtrip" (f64.const 0x1.3456789abcdefp-1005)) (i64.const 0x123456789abcdef))
@@ -0,0 +1,32 @@
+(module
+  (memory 1)
+  (data (i32.const 65528) "\01\02\03\04\05\06\07\08")
+
+  (func $addr_limit (result i32)
+    (i32.mul (current_memory) (i32.const 0x10000))
+  )
+
+  (func (export "store") (param $i i32) (param $v i32)
+    (i32.store (i32.add (call $addr_limit) (get_local $i)) (get_local $v))
+  )
+  (func (export "load") (param $i i32) (result i32)
+    (i32.load (i32.add (call $addr_limit) (get_local $i)))
+  )
+  (func (export "grow_memory") (param $d i32) (result i32)
+    (grow_memory (get_local $d))
+  )
+
+  (func (export "i32.load") (param $a i32) (result i32) (i32.load (get_local $a)))
+  (func (export "i64.load") (param $a i32) (result i64) (i64.load (get_local $a)))
+  (func (export "f32.load") (param $a i32) (result f32) (f32.load (get_local $a)))
+  (func (export "f64.load") (param $a i32) (result f64) (f64.load (get_local $a)))
+  (func (export "i32.load8_s") (param $a i32) (result i32) (i32.load8_s (get_local $a)))
+  (func (export "i32.load16_u") (param $a i32) (result i32) (i32.load16_u (get_local $a)))
+  (func (export "i64.load32_u") (param $a i32) (result i64) (i64.load32_u (get_local $a)))
+  (func (export "i32.load_offset") (param $a i32) (result i32) (i32.load offset=4294967295 (get_local $a)))
+  (func (export "i64.load_offset") (param $a i32) (result i64) (i64.load offset=65529 (get_local $a)))
+  (func (export "i32.store") (param $a i32) (param $v i32) (i32.store (get_local $a) (get_local $v)))
+  (func (export "i64.store") (param $a i32) (param $v i64) (i64.store (get_local $a) (get_local $v)))
+  (func (export "i32.store8") (param $a i32) (param $v i32) (i32.store8 (get_local $a) (get_local $v)))
+  (func (export "i64.store_offset") (param $a i32) (param $v i64) (i64.store offset=1 (get_local $a) (get_local $v)))
+)
@@ -0,0 +1,28 @@
+(module $M
+  (func (export "add") (param i32 i32) (result i32) (i32.add (get_local 0) (get_local 1)))
+  (global (export "g") i32 (i32.const 42))
+)
+(register "M" $M)
+
+(module
+  (import "M" "add" (func $add (param i32 i32) (result i32)))
+  (import "M" "g" (global $g i32))
+  (import "spectest" "print_i32" (func $print (param i32)))
+  (func (export "add-g") (param i32) (result i32)
+    (call $print (get_local 0))
+    (call $add (get_local 0) (get_global $g))
+  )
+)
+
+(assert_return (invoke "add-g" (i32.const 8)) (i32.const 50))
+(assert_return (invoke $M "add" (i32.const 1) (i32.const 2)) (i32.const 3))
+(assert_return (get $M "g") (i32.const 42))
+
+(assert_unlinkable
+  (module (import "spectest" "unknown" (func)))
+  "unknown import"
+)
+(assert_unlinkable
+  (module (import "M" "missing" (func)))
+  "unknown import"
+)
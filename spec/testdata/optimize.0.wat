@@ -0,0 +1,53 @@
+(module
+  (memory 1)
+  (func (export "i32.add-overflow") (result i32) (i32.add (i32.const 0x7fffffff) (i32.const 1)))
+  (func (export "i32.sub") (result i32) (i32.sub (i32.const 0) (i32.const 1)))
+  (func (export "i32.mul") (result i32) (i32.mul (i32.const 0x10001) (i32.const 0x10000)))
+  (func (export "i32.div_s") (result i32) (i32.div_s (i32.const -7) (i32.const 2)))
+  (func (export "i32.div_u") (result i32) (i32.div_u (i32.const -1) (i32.const 2)))
+  (func (export "i32.rem_s") (result i32) (i32.rem_s (i32.const -7) (i32.const 2)))
+  (func (export "i32.rem_s-min") (result i32) (i32.rem_s (i32.const 0x80000000) (i32.const -1)))
+  (func (export "i32.rem_u") (result i32) (i32.rem_u (i32.const -1) (i32.const 10)))
+  (func (export "i32.and") (result i32) (i32.and (i32.const 0xff00ff) (i32.const 0xffff)))
+  (func (export "i32.or") (result i32) (i32.or (i32.const 0xf0) (i32.const 0x0f)))
+  (func (export "i32.xor") (result i32) (i32.xor (i32.const -1) (i32.const 0x55)))
+  (func (export "i32.shl") (result i32) (i32.shl (i32.const 1) (i32.const 33)))
+  (func (export "i32.shr_s") (result i32) (i32.shr_s (i32.const 0x80000000) (i32.const 31)))
+  (func (export "i32.shr_u") (result i32) (i32.shr_u (i32.const 0x80000000) (i32.const 63)))
+  (func (export "i32.rotl") (result i32) (i32.rotl (i32.const 0x80000001) (i32.const 1)))
+  (func (export "i32.rotr") (result i32) (i32.rotr (i32.const 1) (i32.const 33)))
+  (func (export "i32.clz") (result i32) (i32.clz (i32.const 1)))
+  (func (export "i32.ctz") (result i32) (i32.ctz (i32.const 0)))
+  (func (export "i32.popcnt") (result i32) (i32.popcnt (i32.const -1)))
+  (func (export "i32.eqz") (result i32) (i32.eqz (i32.const 0)))
+  (func (export "i32.eq") (result i32) (i32.eq (i32.const -1) (i32.const 0xffffffff)))
+  (func (export "i32.ne") (result i32) (i32.ne (i32.const 1) (i32.const 1)))
+  (func (export "i32.lt_s") (result i32) (i32.lt_s (i32.const -1) (i32.const 0)))
+  (func (export "i32.lt_u") (result i32) (i32.lt_u (i32.const -1) (i32.const 0)))
+  (func (export "i32.le_s") (result i32) (i32.le_s (i32.const 0) (i32.const 0)))
+  (func (export "i32.gt_u") (result i32) (i32.gt_u (i32.const -1) (i32.const 0)))
+  (func (export "i32.ge_s") (result i32) (i32.ge_s (i32.const -1) (i32.const 0)))
+  (func (export "i32.ge_u") (result i32) (i32.ge_u (i32.const -1) (i32.const 0)))
+  (func (export "i64.add-overflow") (result i64) (i64.add (i64.const 0x7fffffffffffffff) (i64.const 1)))
+  (func (export "i64.mul") (result i64) (i64.mul (i64.const 0x100000000) (i64.const 0x100000001)))
+  (func (export "i64.div_s") (result i64) (i64.div_s (i64.const -7) (i64.const 2)))
+  (func (export "i64.rem_u") (result i64) (i64.rem_u (i64.const -1) (i64.const 10)))
+  (func (export "i64.shr_s") (result i64) (i64.shr_s (i64.const 0x8000000000000000) (i64.const 63)))
+  (func (export "i64.rotl") (result i64) (i64.rotl (i64.const 0x8000000000000001) (i64.const 65)))
+  (func (export "i64.clz") (result i64) (i64.clz (i64.const 1)))
+  (func (export "i64.eqz") (result i32) (i64.eqz (i64.const 0x100000000)))
+  (func (export "i64.lt_s") (result i32) (i64.lt_s (i64.const -1) (i64.const 0)))
+  (func (export "i64.ge_u") (result i32) (i64.ge_u (i64.const -1) (i64.const 0)))
+  (func (export "i32.wrap/i64") (result i32) (i32.wrap/i64 (i64.const 0x100000005)))
+  (func (export "i64.extend_s/i32") (result i64) (i64.extend_s/i32 (i32.const -1)))
+  (func (export "i64.extend_u/i32") (result i64) (i64.extend_u/i32 (i32.const -1)))
+  (func (export "chain") (result i32)
+    (i32.mul (i32.add (i32.const 2) (i32.const 3)) (i32.sub (i32.const 10) (i32.const 6))))
+  (func (export "mixed") (param i32) (result i32)
+    (i32.add (get_local 0) (i32.mul (i32.const 6) (i32.const 7))))
+  (func (export "i32.div_s-by-zero") (result i32) (i32.div_s (i32.const 1) (i32.const 0)))
+  (func (export "i32.div_s-overflow") (result i32) (i32.div_s (i32.const 0x80000000) (i32.const -1)))
+  (func (export "i32.rem_u-by-zero") (result i32) (i32.rem_u (i32.const 1) (i32.const 0)))
+  (func (export "i64.div_u-by-zero") (result i64) (i64.div_u (i64.const 1) (i64.const 0)))
+  (func (export "i64.div_s-overflow") (result i64) (i64.div_s (i64.const 0x8000000000000000) (i64.const -1)))
+)
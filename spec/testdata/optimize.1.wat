@@ -0,0 +1,46 @@
+(module
+  (func (export "if-true") (result i32)
+    (if (result i32) (i32.const 1) (then (i32.const 10)) (else (i32.const 20))))
+  (func (export "if-false") (result i32)
+    (if (result i32) (i32.const 0) (then (i32.const 10)) (else (i32.const 20))))
+  (func (export "if-folded") (result i32)
+    (if (result i32) (i32.lt_s (i32.const 1) (i32.const 2)) (then (i32.const 10)) (else (i32.const 20))))
+  (func (export "if-dead-else") (result i32)
+    (if (i32.const 2) (then (nop)) (else (unreachable)))
+    (i32.const 1))
+  (func (export "br_if-taken") (result i32)
+    (block (result i32) (drop (br_if 0 (i32.const 7) (i32.const 1))) (i32.const 8)))
+  (func (export "br_if-not-taken") (result i32)
+    (block (result i32) (drop (br_if 0 (i32.const 7) (i32.const 0))) (i32.const 8)))
+  (func (export "br_if-dead") (result i32)
+    (block (br_if 0 (i32.const -1)) (unreachable))
+    (i32.const 1))
+  (func (export "br_table") (result i32)
+    (block (block (block (br_table 0 1 2 (i32.const 1)))
+      (return (i32.const 100)))
+      (return (i32.const 101)))
+    (i32.const 102))
+  (func (export "br_table-default") (result i32)
+    (block (block (block (br_table 0 1 2 (i32.const 5)))
+      (return (i32.const 100)))
+      (return (i32.const 101)))
+    (i32.const 102))
+  (func (export "br_table-negative") (result i32)
+    (block (block (block (br_table 0 1 2 (i32.const -1)))
+      (return (i32.const 100)))
+      (return (i32.const 101)))
+    (i32.const 102))
+  (func (export "br_table-value") (result i32)
+    (block (result i32) (i32.const 50) (block (result i32) (br_table 1 0 (i32.const 7) (i32.const 0))) (i32.add)))
+  (func (export "br_table-same") (param i32) (result i32)
+    (block (br_table 0 0 0 (get_local 0)))
+    (i32.const 3))
+  (func (export "br_if-function") (param i32) (result i32)
+    (i32.const 3) (br_if 0 (i32.const 1)) (drop) (get_local 0))
+  (func (export "br_table-function") (result i32)
+    (loop (result i32) (br_table 1 1 (i32.const 4) (i32.const 0)) (i32.const 1)))
+  (func (export "loop-exit") (result i32)
+    (local i32)
+    (loop (set_local 0 (i32.add (get_local 0) (i32.const 1))) (br_if 0 (i32.const 0)))
+    (get_local 0))
+)
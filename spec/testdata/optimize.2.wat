@@ -0,0 +1,69 @@
+(module
+  (memory 1)
+  (func $five (result i32) (i32.const 5))
+  (func (export "set-get") (param i32) (result i32)
+    (local i32)
+    (set_local 1 (get_local 0))
+    (i32.add (get_local 1) (get_local 1)))
+  (func (export "get-get") (param i32) (result i32)
+    (i32.mul (get_local 0) (get_local 0)))
+  (func (export "tee") (result i32)
+    (local i32)
+    (i32.add (tee_local 0 (i32.const 5)) (get_local 0)))
+  (func (export "overwritten") (result i32)
+    (local i32)
+    (set_local 0 (i32.const 1))
+    (set_local 0 (i32.const 2))
+    (get_local 0))
+  (func (export "across-call") (param i32) (result i32)
+    (local i32)
+    (set_local 1 (i32.add (get_local 0) (i32.const 1)))
+    (drop (call $five))
+    (get_local 1))
+  (func (export "across-const") (param i32) (result i32)
+    (local i32)
+    (set_local 1 (get_local 0))
+    (drop (i32.const 99))
+    (get_local 1))
+  (func (export "across-blocks") (param i32) (result i32)
+    (local i32)
+    (set_local 1 (i32.mul (get_local 0) (i32.const 3)))
+    (block (br_if 0 (get_local 0)) (set_local 1 (i32.const 7)))
+    (get_local 1))
+  (func (export "yield") (result i32)
+    (local i32)
+    (block (result i32) (set_local 0 (i32.const 4)) (br 0 (get_local 0))))
+  (func (export "yield-function") (param i32) (result i32)
+    (local i32)
+    (set_local 1 (i32.const 9))
+    (get_local 1)
+    (br_if 0 (get_local 0))
+    (drop)
+    (i32.const 0))
+  (func (export "store") (result i32)
+    (local i32)
+    (set_local 0 (i32.const 42))
+    (i32.store (i32.const 8) (get_local 0))
+    (i32.load (i32.const 8)))
+  (func (export "sum") (param i32) (result i32)
+    (local i32)
+    (block (loop
+      (br_if 1 (i32.eqz (get_local 0)))
+      (set_local 1 (i32.add (get_local 1) (get_local 0)))
+      (set_local 0 (i32.sub (get_local 0) (i32.const 1)))
+      (br 0)))
+    (get_local 1))
+  (func (export "swap") (param i32 i32) (result i32)
+    (local i32)
+    (set_local 2 (get_local 0))
+    (set_local 0 (get_local 1))
+    (set_local 1 (get_local 2))
+    (i32.sub (get_local 0) (get_local 1)))
+  (func (export "read-in-later-block") (param i32) (result i32)
+    (local i32)
+    (set_local 1 (i32.add (get_local 0) (i32.const 1)))
+    (i32.add (get_local 1)
+      (block (result i32)
+        (drop (br_if 0 (i32.const 10) (get_local 0)))
+        (i32.const 20))))
+)
@@ -0,0 +1,32 @@
+(module
+  (memory 1 4)
+  (global $g (mut i32) (i32.const 0))
+  (func $inc (result i32)
+    (set_global $g (i32.add (get_global $g) (i32.const 1)))
+    (get_global $g))
+  (func (export "pure") (param i32) (result i32)
+    (drop (i32.add (get_local 0) (i32.const 1)))
+    (drop (select (get_local 0) (i32.const 1) (get_local 0)))
+    (drop (i64.mul (i64.const 3) (i64.extend_u/i32 (get_local 0))))
+    (get_local 0))
+  (func (export "call") (result i32)
+    (drop (call $inc))
+    (drop (call $inc))
+    (get_global $g))
+  (func (export "set_global") (result i32)
+    (set_global $g (i32.const 40))
+    (drop (get_global $g))
+    (get_global $g))
+  (func (export "grow_memory") (result i32)
+    (drop (grow_memory (i32.const 1)))
+    (current_memory))
+  (func (export "div-by-zero") (result i32)
+    (drop (i32.div_u (i32.const 1) (i32.const 0)))
+    (i32.const 0))
+  (func (export "load-out-of-bounds") (result i32)
+    (drop (i32.load (i32.const 0x7fffffff)))
+    (i32.const 0))
+  (func (export "unreachable") (result i32)
+    (drop (i32.const 1))
+    (unreachable))
+)
@@ -0,0 +1,295 @@
+;; Constant folding. Operations on constants are evaluated at compile time,
+;; except where they would trap.
+(module
+  (memory 1)
+  (func (export "i32.add-overflow") (result i32) (i32.add (i32.const 0x7fffffff) (i32.const 1)))
+  (func (export "i32.sub") (result i32) (i32.sub (i32.const 0) (i32.const 1)))
+  (func (export "i32.mul") (result i32) (i32.mul (i32.const 0x10001) (i32.const 0x10000)))
+  (func (export "i32.div_s") (result i32) (i32.div_s (i32.const -7) (i32.const 2)))
+  (func (export "i32.div_u") (result i32) (i32.div_u (i32.const -1) (i32.const 2)))
+  (func (export "i32.rem_s") (result i32) (i32.rem_s (i32.const -7) (i32.const 2)))
+  (func (export "i32.rem_s-min") (result i32) (i32.rem_s (i32.const 0x80000000) (i32.const -1)))
+  (func (export "i32.rem_u") (result i32) (i32.rem_u (i32.const -1) (i32.const 10)))
+  (func (export "i32.and") (result i32) (i32.and (i32.const 0xff00ff) (i32.const 0xffff)))
+  (func (export "i32.or") (result i32) (i32.or (i32.const 0xf0) (i32.const 0x0f)))
+  (func (export "i32.xor") (result i32) (i32.xor (i32.const -1) (i32.const 0x55)))
+  (func (export "i32.shl") (result i32) (i32.shl (i32.const 1) (i32.const 33)))
+  (func (export "i32.shr_s") (result i32) (i32.shr_s (i32.const 0x80000000) (i32.const 31)))
+  (func (export "i32.shr_u") (result i32) (i32.shr_u (i32.const 0x80000000) (i32.const 63)))
+  (func (export "i32.rotl") (result i32) (i32.rotl (i32.const 0x80000001) (i32.const 1)))
+  (func (export "i32.rotr") (result i32) (i32.rotr (i32.const 1) (i32.const 33)))
+  (func (export "i32.clz") (result i32) (i32.clz (i32.const 1)))
+  (func (export "i32.ctz") (result i32) (i32.ctz (i32.const 0)))
+  (func (export "i32.popcnt") (result i32) (i32.popcnt (i32.const -1)))
+  (func (export "i32.eqz") (result i32) (i32.eqz (i32.const 0)))
+  (func (export "i32.eq") (result i32) (i32.eq (i32.const -1) (i32.const 0xffffffff)))
+  (func (export "i32.ne") (result i32) (i32.ne (i32.const 1) (i32.const 1)))
+  (func (export "i32.lt_s") (result i32) (i32.lt_s (i32.const -1) (i32.const 0)))
+  (func (export "i32.lt_u") (result i32) (i32.lt_u (i32.const -1) (i32.const 0)))
+  (func (export "i32.le_s") (result i32) (i32.le_s (i32.const 0) (i32.const 0)))
+  (func (export "i32.gt_u") (result i32) (i32.gt_u (i32.const -1) (i32.const 0)))
+  (func (export "i32.ge_s") (result i32) (i32.ge_s (i32.const -1) (i32.const 0)))
+  (func (export "i32.ge_u") (result i32) (i32.ge_u (i32.const -1) (i32.const 0)))
+  (func (export "i64.add-overflow") (result i64) (i64.add (i64.const 0x7fffffffffffffff) (i64.const 1)))
+  (func (export "i64.mul") (result i64) (i64.mul (i64.const 0x100000000) (i64.const 0x100000001)))
+  (func (export "i64.div_s") (result i64) (i64.div_s (i64.const -7) (i64.const 2)))
+  (func (export "i64.rem_u") (result i64) (i64.rem_u (i64.const -1) (i64.const 10)))
+  (func (export "i64.shr_s") (result i64) (i64.shr_s (i64.const 0x8000000000000000) (i64.const 63)))
+  (func (export "i64.rotl") (result i64) (i64.rotl (i64.const 0x8000000000000001) (i64.const 65)))
+  (func (export "i64.clz") (result i64) (i64.clz (i64.const 1)))
+  (func (export "i64.eqz") (result i32) (i64.eqz (i64.const 0x100000000)))
+  (func (export "i64.lt_s") (result i32) (i64.lt_s (i64.const -1) (i64.const 0)))
+  (func (export "i64.ge_u") (result i32) (i64.ge_u (i64.const -1) (i64.const 0)))
+  (func (export "i32.wrap/i64") (result i32) (i32.wrap/i64 (i64.const 0x100000005)))
+  (func (export "i64.extend_s/i32") (result i64) (i64.extend_s/i32 (i32.const -1)))
+  (func (export "i64.extend_u/i32") (result i64) (i64.extend_u/i32 (i32.const -1)))
+  (func (export "chain") (result i32)
+    (i32.mul (i32.add (i32.const 2) (i32.const 3)) (i32.sub (i32.const 10) (i32.const 6))))
+  (func (export "mixed") (param i32) (result i32)
+    (i32.add (get_local 0) (i32.mul (i32.const 6) (i32.const 7))))
+  (func (export "i32.div_s-by-zero") (result i32) (i32.div_s (i32.const 1) (i32.const 0)))
+  (func (export "i32.div_s-overflow") (result i32) (i32.div_s (i32.const 0x80000000) (i32.const -1)))
+  (func (export "i32.rem_u-by-zero") (result i32) (i32.rem_u (i32.const 1) (i32.const 0)))
+  (func (export "i64.div_u-by-zero") (result i64) (i64.div_u (i64.const 1) (i64.const 0)))
+  (func (export "i64.div_s-overflow") (result i64) (i64.div_s (i64.const 0x8000000000000000) (i64.const -1)))
+)
+(assert_return (invoke "i32.add-overflow") (i32.const 2147483648))
+(assert_return (invoke "i32.sub") (i32.const 4294967295))
+(assert_return (invoke "i32.mul") (i32.const 65536))
+(assert_return (invoke "i32.div_s") (i32.const 4294967293))
+(assert_return (invoke "i32.div_u") (i32.const 2147483647))
+(assert_return (invoke "i32.rem_s") (i32.const 4294967295))
+(assert_return (invoke "i32.rem_s-min") (i32.const 0))
+(assert_return (invoke "i32.rem_u") (i32.const 5))
+(assert_return (invoke "i32.and") (i32.const 255))
+(assert_return (invoke "i32.or") (i32.const 255))
+(assert_return (invoke "i32.xor") (i32.const 4294967210))
+(assert_return (invoke "i32.shl") (i32.const 2))
+(assert_return (invoke "i32.shr_s") (i32.const 4294967295))
+(assert_return (invoke "i32.shr_u") (i32.const 1))
+(assert_return (invoke "i32.rotl") (i32.const 3))
+(assert_return (invoke "i32.rotr") (i32.const 2147483648))
+(assert_return (invoke "i32.clz") (i32.const 31))
+(assert_return (invoke "i32.ctz") (i32.const 32))
+(assert_return (invoke "i32.popcnt") (i32.const 32))
+(assert_return (invoke "i32.eqz") (i32.const 1))
+(assert_return (invoke "i32.eq") (i32.const 1))
+(assert_return (invoke "i32.ne") (i32.const 0))
+(assert_return (invoke "i32.lt_s") (i32.const 1))
+(assert_return (invoke "i32.lt_u") (i32.const 0))
+(assert_return (invoke "i32.le_s") (i32.const 1))
+(assert_return (invoke "i32.gt_u") (i32.const 1))
+(assert_return (invoke "i32.ge_s") (i32.const 0))
+(assert_return (invoke "i32.ge_u") (i32.const 1))
+(assert_return (invoke "i64.add-overflow") (i64.const 9223372036854775808))
+(assert_return (invoke "i64.mul") (i64.const 4294967296))
+(assert_return (invoke "i64.div_s") (i64.const 18446744073709551613))
+(assert_return (invoke "i64.rem_u") (i64.const 5))
+(assert_return (invoke "i64.shr_s") (i64.const 18446744073709551615))
+(assert_return (invoke "i64.rotl") (i64.const 3))
+(assert_return (invoke "i64.clz") (i64.const 63))
+(assert_return (invoke "i64.eqz") (i32.const 0))
+(assert_return (invoke "i64.lt_s") (i32.const 1))
+(assert_return (invoke "i64.ge_u") (i32.const 1))
+(assert_return (invoke "i32.wrap/i64") (i32.const 5))
+(assert_return (invoke "i64.extend_s/i32") (i64.const 18446744073709551615))
+(assert_return (invoke "i64.extend_u/i32") (i64.const 4294967295))
+(assert_return (invoke "chain") (i32.const 20))
+(assert_return (invoke "mixed" (i32.const 4294967294)) (i32.const 40))
+(assert_trap (invoke "i32.div_s-by-zero") "integer divide by zero")
+(assert_trap (invoke "i32.div_s-overflow") "integer overflow")
+(assert_trap (invoke "i32.rem_u-by-zero") "integer divide by zero")
+(assert_trap (invoke "i64.div_u-by-zero") "integer divide by zero")
+(assert_trap (invoke "i64.div_s-overflow") "integer overflow")
+;; Branch simplification and dead block elimination. Branches on constant
+;; conditions become unconditional, and the blocks they no longer reach go.
+(module
+  (func (export "if-true") (result i32)
+    (if (result i32) (i32.const 1) (then (i32.const 10)) (else (i32.const 20))))
+  (func (export "if-false") (result i32)
+    (if (result i32) (i32.const 0) (then (i32.const 10)) (else (i32.const 20))))
+  (func (export "if-folded") (result i32)
+    (if (result i32) (i32.lt_s (i32.const 1) (i32.const 2)) (then (i32.const 10)) (else (i32.const 20))))
+  (func (export "if-dead-else") (result i32)
+    (if (i32.const 2) (then (nop)) (else (unreachable)))
+    (i32.const 1))
+  (func (export "br_if-taken") (result i32)
+    (block (result i32) (drop (br_if 0 (i32.const 7) (i32.const 1))) (i32.const 8)))
+  (func (export "br_if-not-taken") (result i32)
+    (block (result i32) (drop (br_if 0 (i32.const 7) (i32.const 0))) (i32.const 8)))
+  (func (export "br_if-dead") (result i32)
+    (block (br_if 0 (i32.const -1)) (unreachable))
+    (i32.const 1))
+  (func (export "br_table") (result i32)
+    (block (block (block (br_table 0 1 2 (i32.const 1)))
+      (return (i32.const 100)))
+      (return (i32.const 101)))
+    (i32.const 102))
+  (func (export "br_table-default") (result i32)
+    (block (block (block (br_table 0 1 2 (i32.const 5)))
+      (return (i32.const 100)))
+      (return (i32.const 101)))
+    (i32.const 102))
+  (func (export "br_table-negative") (result i32)
+    (block (block (block (br_table 0 1 2 (i32.const -1)))
+      (return (i32.const 100)))
+      (return (i32.const 101)))
+    (i32.const 102))
+  (func (export "br_table-value") (result i32)
+    (block (result i32) (i32.const 50) (block (result i32) (br_table 1 0 (i32.const 7) (i32.const 0))) (i32.add)))
+  (func (export "br_table-same") (param i32) (result i32)
+    (block (br_table 0 0 0 (get_local 0)))
+    (i32.const 3))
+  (func (export "br_if-function") (param i32) (result i32)
+    (i32.const 3) (br_if 0 (i32.const 1)) (drop) (get_local 0))
+  (func (export "br_table-function") (result i32)
+    (loop (result i32) (br_table 1 1 (i32.const 4) (i32.const 0)) (i32.const 1)))
+  (func (export "loop-exit") (result i32)
+    (local i32)
+    (loop (set_local 0 (i32.add (get_local 0) (i32.const 1))) (br_if 0 (i32.const 0)))
+    (get_local 0))
+)
+(assert_return (invoke "if-true") (i32.const 10))
+(assert_return (invoke "if-false") (i32.const 20))
+(assert_return (invoke "if-folded") (i32.const 10))
+(assert_return (invoke "if-dead-else") (i32.const 1))
+(assert_return (invoke "br_if-taken") (i32.const 7))
+(assert_return (invoke "br_if-not-taken") (i32.const 8))
+(assert_return (invoke "br_if-dead") (i32.const 1))
+(assert_return (invoke "br_table") (i32.const 101))
+(assert_return (invoke "br_table-default") (i32.const 102))
+(assert_return (invoke "br_table-negative") (i32.const 102))
+(assert_return (invoke "br_table-value") (i32.const 7))
+(assert_return (invoke "br_table-same" (i32.const 1)) (i32.const 3))
+(assert_return (invoke "br_if-function" (i32.const 9)) (i32.const 3))
+(assert_return (invoke "br_table-function") (i32.const 4))
+(assert_return (invoke "loop-exit") (i32.const 1))
+;; Copy propagation. Values read back from locals in the same block are
+;; replaced with the value stored, including where they are read in later
+;; blocks.
+(module
+  (memory 1)
+  (func $five (result i32) (i32.const 5))
+  (func (export "set-get") (param i32) (result i32)
+    (local i32)
+    (set_local 1 (get_local 0))
+    (i32.add (get_local 1) (get_local 1)))
+  (func (export "get-get") (param i32) (result i32)
+    (i32.mul (get_local 0) (get_local 0)))
+  (func (export "tee") (result i32)
+    (local i32)
+    (i32.add (tee_local 0 (i32.const 5)) (get_local 0)))
+  (func (export "overwritten") (result i32)
+    (local i32)
+    (set_local 0 (i32.const 1))
+    (set_local 0 (i32.const 2))
+    (get_local 0))
+  (func (export "across-call") (param i32) (result i32)
+    (local i32)
+    (set_local 1 (i32.add (get_local 0) (i32.const 1)))
+    (drop (call $five))
+    (get_local 1))
+  (func (export "across-const") (param i32) (result i32)
+    (local i32)
+    (set_local 1 (get_local 0))
+    (drop (i32.const 99))
+    (get_local 1))
+  (func (export "across-blocks") (param i32) (result i32)
+    (local i32)
+    (set_local 1 (i32.mul (get_local 0) (i32.const 3)))
+    (block (br_if 0 (get_local 0)) (set_local 1 (i32.const 7)))
+    (get_local 1))
+  (func (export "yield") (result i32)
+    (local i32)
+    (block (result i32) (set_local 0 (i32.const 4)) (br 0 (get_local 0))))
+  (func (export "yield-function") (param i32) (result i32)
+    (local i32)
+    (set_local 1 (i32.const 9))
+    (get_local 1)
+    (br_if 0 (get_local 0))
+    (drop)
+    (i32.const 0))
+  (func (export "store") (result i32)
+    (local i32)
+    (set_local 0 (i32.const 42))
+    (i32.store (i32.const 8) (get_local 0))
+    (i32.load (i32.const 8)))
+  (func (export "sum") (param i32) (result i32)
+    (local i32)
+    (block (loop
+      (br_if 1 (i32.eqz (get_local 0)))
+      (set_local 1 (i32.add (get_local 1) (get_local 0)))
+      (set_local 0 (i32.sub (get_local 0) (i32.const 1)))
+      (br 0)))
+    (get_local 1))
+  (func (export "swap") (param i32 i32) (result i32)
+    (local i32)
+    (set_local 2 (get_local 0))
+    (set_local 0 (get_local 1))
+    (set_local 1 (get_local 2))
+    (i32.sub (get_local 0) (get_local 1)))
+  (func (export "read-in-later-block") (param i32) (result i32)
+    (local i32)
+    (set_local 1 (i32.add (get_local 0) (i32.const 1)))
+    (i32.add (get_local 1)
+      (block (result i32)
+        (drop (br_if 0 (i32.const 10) (get_local 0)))
+        (i32.const 20))))
+)
+(assert_return (invoke "set-get" (i32.const 21)) (i32.const 42))
+(assert_return (invoke "get-get" (i32.const 4294967293)) (i32.const 9))
+(assert_return (invoke "tee") (i32.const 10))
+(assert_return (invoke "overwritten") (i32.const 2))
+(assert_return (invoke "across-call" (i32.const 1)) (i32.const 2))
+(assert_return (invoke "across-const" (i32.const 6)) (i32.const 6))
+(assert_return (invoke "across-blocks" (i32.const 0)) (i32.const 7))
+(assert_return (invoke "across-blocks" (i32.const 2)) (i32.const 6))
+(assert_return (invoke "yield") (i32.const 4))
+(assert_return (invoke "yield-function" (i32.const 1)) (i32.const 9))
+(assert_return (invoke "yield-function" (i32.const 0)) (i32.const 0))
+(assert_return (invoke "store") (i32.const 42))
+(assert_return (invoke "sum" (i32.const 100)) (i32.const 5050))
+(assert_return (invoke "swap" (i32.const 10) (i32.const 3)) (i32.const 4294967289))
+(assert_return (invoke "read-in-later-block" (i32.const 5)) (i32.const 16))
+(assert_return (invoke "read-in-later-block" (i32.const 0)) (i32.const 21))
+;; Dead code elimination. Unused values are not computed, but operations with
+;; side effects, including traps, still happen.
+(module
+  (memory 1 4)
+  (global $g (mut i32) (i32.const 0))
+  (func $inc (result i32)
+    (set_global $g (i32.add (get_global $g) (i32.const 1)))
+    (get_global $g))
+  (func (export "pure") (param i32) (result i32)
+    (drop (i32.add (get_local 0) (i32.const 1)))
+    (drop (select (get_local 0) (i32.const 1) (get_local 0)))
+    (drop (i64.mul (i64.const 3) (i64.extend_u/i32 (get_local 0))))
+    (get_local 0))
+  (func (export "call") (result i32)
+    (drop (call $inc))
+    (drop (call $inc))
+    (get_global $g))
+  (func (export "set_global") (result i32)
+    (set_global $g (i32.const 40))
+    (drop (get_global $g))
+    (get_global $g))
+  (func (export "grow_memory") (result i32)
+    (drop (grow_memory (i32.const 1)))
+    (current_memory))
+  (func (export "div-by-zero") (result i32)
+    (drop (i32.div_u (i32.const 1) (i32.const 0)))
+    (i32.const 0))
+  (func (export "load-out-of-bounds") (result i32)
+    (drop (i32.load (i32.const 0x7fffffff)))
+    (i32.const 0))
+  (func (export "unreachable") (result i32)
+    (drop (i32.const 1))
+    (unreachable))
+)
+(assert_return (invoke "pure" (i32.const 5)) (i32.const 5))
+(assert_return (invoke "call") (i32.const 2))
+(assert_return (invoke "set_global") (i32.const 40))
+(assert_return (invoke "grow_memory") (i32.const 2))
+(assert_trap (invoke "div-by-zero") "integer divide by zero")
+(assert_trap (invoke "load-out-of-bounds") "out of bounds memory access")
+(assert_trap (invoke "unreachable") "unreachable")
@@ -0,0 +1,24 @@
+(module
+  (func (export "f32.add") (param f32 f32) (result f32) (f32.add (get_local 0) (get_local 1)))
+  (func (export "f32.sub") (param f32 f32) (result f32) (f32.sub (get_local 0) (get_local 1)))
+  (func (export "f32.mul") (param f32 f32) (result f32) (f32.mul (get_local 0) (get_local 1)))
+  (func (export "f32.div") (param f32 f32) (result f32) (f32.div (get_local 0) (get_local 1)))
+  (func (export "f32.min") (param f32 f32) (result f32) (f32.min (get_local 0) (get_local 1)))
+  (func (export "f32.max") (param f32 f32) (result f32) (f32.max (get_local 0) (get_local 1)))
+  (func (export "f32.sqrt") (param f32) (result f32) (f32.sqrt (get_local 0)))
+  (func (export "f32.ceil") (param f32) (result f32) (f32.ceil (get_local 0)))
+  (func (export "f32.floor") (param f32) (result f32) (f32.floor (get_local 0)))
+  (func (export "f32.trunc") (param f32) (result f32) (f32.trunc (get_local 0)))
+  (func (export "f32.nearest") (param f32) (result f32) (f32.nearest (get_local 0)))
+  (func (export "f64.add") (param f64 f64) (result f64) (f64.add (get_local 0) (get_local 1)))
+  (func (export "f64.sub") (param f64 f64) (result f64) (f64.sub (get_local 0) (get_local 1)))
+  (func (export "f64.mul") (param f64 f64) (result f64) (f64.mul (get_local 0) (get_local 1)))
+  (func (export "f64.div") (param f64 f64) (result f64) (f64.div (get_local 0) (get_local 1)))
+  (func (export "f64.min") (param f64 f64) (result f64) (f64.min (get_local 0) (get_local 1)))
+  (func (export "f64.max") (param f64 f64) (result f64) (f64.max (get_local 0) (get_local 1)))
+  (func (export "f64.sqrt") (param f64) (result f64) (f64.sqrt (get_local 0)))
+  (func (export "f64.ceil") (param f64) (result f64) (f64.ceil (get_local 0)))
+  (func (export "f64.floor") (param f64) (result f64) (f64.floor (get_local 0)))
+  (func (export "f64.trunc") (param f64) (result f64) (f64.trunc (get_local 0)))
+  (func (export "f64.nearest") (param f64) (result f64) (f64.nearest (get_local 0)))
+)
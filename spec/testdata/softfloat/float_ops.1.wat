@@ -0,0 +1,12 @@
+(module
+  (func (export "f32.demote") (param f64) (result f32) (f32.demote/f64 (get_local 0)))
+  (func (export "f64.promote") (param f32) (result f64) (f64.promote/f32 (get_local 0)))
+  (func (export "f32.convert_s/i32") (param i32) (result f32) (f32.convert_s/i32 (get_local 0)))
+  (func (export "f32.convert_u/i32") (param i32) (result f32) (f32.convert_u/i32 (get_local 0)))
+  (func (export "f32.convert_s/i64") (param i64) (result f32) (f32.convert_s/i64 (get_local 0)))
+  (func (export "f32.convert_u/i64") (param i64) (result f32) (f32.convert_u/i64 (get_local 0)))
+  (func (export "f64.convert_s/i32") (param i32) (result f64) (f64.convert_s/i32 (get_local 0)))
+  (func (export "f64.convert_u/i32") (param i32) (result f64) (f64.convert_u/i32 (get_local 0)))
+  (func (export "f64.convert_s/i64") (param i64) (result f64) (f64.convert_s/i64 (get_local 0)))
+  (func (export "f64.convert_u/i64") (param i64) (result f64) (f64.convert_u/i64 (get_local 0)))
+)
@@ -0,0 +1,10 @@
+(module
+  (func (export "i32.trunc_s/f32") (param f32) (result i32) (i32.trunc_s/f32 (get_local 0)))
+  (func (export "i32.trunc_s/f64") (param f64) (result i32) (i32.trunc_s/f64 (get_local 0)))
+  (func (export "i32.trunc_u/f32") (param f32) (result i32) (i32.trunc_u/f32 (get_local 0)))
+  (func (export "i32.trunc_u/f64") (param f64) (result i32) (i32.trunc_u/f64 (get_local 0)))
+  (func (export "i64.trunc_s/f32") (param f32) (result i64) (i64.trunc_s/f32 (get_local 0)))
+  (func (export "i64.trunc_s/f64") (param f64) (result i64) (i64.trunc_s/f64 (get_local 0)))
+  (func (export "i64.trunc_u/f32") (param f32) (result i64) (i64.trunc_u/f32 (get_local 0)))
+  (func (export "i64.trunc_u/f64") (param f64) (result i64) (i64.trunc_u/f64 (get_local 0)))
+)
@@ -0,0 +1,2847 @@
+;; Arithmetic. NaN results are the positive canonical NaN.
+(module
+  (func (export "f32.add") (param f32 f32) (result f32) (f32.add (get_local 0) (get_local 1)))
+  (func (export "f32.sub") (param f32 f32) (result f32) (f32.sub (get_local 0) (get_local 1)))
+  (func (export "f32.mul") (param f32 f32) (result f32) (f32.mul (get_local 0) (get_local 1)))
+  (func (export "f32.div") (param f32 f32) (result f32) (f32.div (get_local 0) (get_local 1)))
+  (func (export "f32.min") (param f32 f32) (result f32) (f32.min (get_local 0) (get_local 1)))
+  (func (export "f32.max") (param f32 f32) (result f32) (f32.max (get_local 0) (get_local 1)))
+  (func (export "f32.sqrt") (param f32) (result f32) (f32.sqrt (get_local 0)))
+  (func (export "f32.ceil") (param f32) (result f32) (f32.ceil (get_local 0)))
+  (func (export "f32.floor") (param f32) (result f32) (f32.floor (get_local 0)))
+  (func (export "f32.trunc") (param f32) (result f32) (f32.trunc (get_local 0)))
+  (func (export "f32.nearest") (param f32) (result f32) (f32.nearest (get_local 0)))
+  (func (export "f64.add") (param f64 f64) (result f64) (f64.add (get_local 0) (get_local 1)))
+  (func (export "f64.sub") (param f64 f64) (result f64) (f64.sub (get_local 0) (get_local 1)))
+  (func (export "f64.mul") (param f64 f64) (result f64) (f64.mul (get_local 0) (get_local 1)))
+  (func (export "f64.div") (param f64 f64) (result f64) (f64.div (get_local 0) (get_local 1)))
+  (func (export "f64.min") (param f64 f64) (result f64) (f64.min (get_local 0) (get_local 1)))
+  (func (export "f64.max") (param f64 f64) (result f64) (f64.max (get_local 0) (get_local 1)))
+  (func (export "f64.sqrt") (param f64) (result f64) (f64.sqrt (get_local 0)))
+  (func (export "f64.ceil") (param f64) (result f64) (f64.ceil (get_local 0)))
+  (func (export "f64.floor") (param f64) (result f64) (f64.floor (get_local 0)))
+  (func (export "f64.trunc") (param f64) (result f64) (f64.trunc (get_local 0)))
+  (func (export "f64.nearest") (param f64) (result f64) (f64.nearest (get_local 0)))
+)
+(assert_return (invoke "f32.add" (f32.const 0) (f32.const 1)) (f32.const 1))
+(assert_return (invoke "f32.add" (f32.const 0) (f32.const 1065353215)) (f32.const 1065353215))
+(assert_return (invoke "f32.add" (f32.const 0) (f32.const 2139095039)) (f32.const 2139095039))
+(assert_return (invoke "f32.add" (f32.const 0) (f32.const 2147483648)) (f32.const 0))
+(assert_return (invoke "f32.add" (f32.const 0) (f32.const 3212836865)) (f32.const 3212836865))
+(assert_return (invoke "f32.add" (f32.const 0) (f32.const 3226013659)) (f32.const 3226013659))
+(assert_return (invoke "f32.add" (f32.const 0) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.add" (f32.const 8388608) (f32.const 1)) (f32.const 8388609))
+(assert_return (invoke "f32.add" (f32.const 8388608) (f32.const 1065353215)) (f32.const 1065353215))
+(assert_return (invoke "f32.add" (f32.const 8388608) (f32.const 2139095039)) (f32.const 2139095039))
+(assert_return (invoke "f32.add" (f32.const 8388608) (f32.const 2147483648)) (f32.const 8388608))
+(assert_return (invoke "f32.add" (f32.const 8388608) (f32.const 3212836865)) (f32.const 3212836865))
+(assert_return (invoke "f32.add" (f32.const 8388608) (f32.const 3226013659)) (f32.const 3226013659))
+(assert_return (invoke "f32.add" (f32.const 8388608) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.add" (f32.const 1065353215) (f32.const 1)) (f32.const 1065353215))
+(assert_return (invoke "f32.add" (f32.const 1065353215) (f32.const 1065353215)) (f32.const 1073741823))
+(assert_return (invoke "f32.add" (f32.const 1065353215) (f32.const 2139095039)) (f32.const 2139095039))
+(assert_return (invoke "f32.add" (f32.const 1065353215) (f32.const 2147483648)) (f32.const 1065353215))
+(assert_return (invoke "f32.add" (f32.const 1065353215) (f32.const 3212836865)) (f32.const 3024093184))
+(assert_return (invoke "f32.add" (f32.const 1065353215) (f32.const 3226013659)) (f32.const 3221819355))
+(assert_return (invoke "f32.add" (f32.const 1065353215) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.add" (f32.const 1036831949) (f32.const 1)) (f32.const 1036831949))
+(assert_return (invoke "f32.add" (f32.const 1036831949) (f32.const 1065353215)) (f32.const 1066192076))
+(assert_return (invoke "f32.add" (f32.const 1036831949) (f32.const 2139095039)) (f32.const 2139095039))
+(assert_return (invoke "f32.add" (f32.const 1036831949) (f32.const 2147483648)) (f32.const 1036831949))
+(assert_return (invoke "f32.add" (f32.const 1036831949) (f32.const 3212836865)) (f32.const 3211159144))
+(assert_return (invoke "f32.add" (f32.const 1036831949) (f32.const 3226013659)) (f32.const 3225594229))
+(assert_return (invoke "f32.add" (f32.const 1036831949) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.add" (f32.const 2139095040) (f32.const 1)) (f32.const 2139095040))
+(assert_return (invoke "f32.add" (f32.const 2139095040) (f32.const 1065353215)) (f32.const 2139095040))
+(assert_return (invoke "f32.add" (f32.const 2139095040) (f32.const 2139095039)) (f32.const 2139095040))
+(assert_return (invoke "f32.add" (f32.const 2139095040) (f32.const 2147483648)) (f32.const 2139095040))
+(assert_return (invoke "f32.add" (f32.const 2139095040) (f32.const 3212836865)) (f32.const 2139095040))
+(assert_return (invoke "f32.add" (f32.const 2139095040) (f32.const 3226013659)) (f32.const 2139095040))
+(assert_return (invoke "f32.add" (f32.const 2139095040) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.add" (f32.const 2143289344) (f32.const 1)) (f32.const 2143289344))
+(assert_return (invoke "f32.add" (f32.const 2143289344) (f32.const 1065353215)) (f32.const 2143289344))
+(assert_return (invoke "f32.add" (f32.const 2143289344) (f32.const 2139095039)) (f32.const 2143289344))
+(assert_return (invoke "f32.add" (f32.const 2143289344) (f32.const 2147483648)) (f32.const 2143289344))
+(assert_return (invoke "f32.add" (f32.const 2143289344) (f32.const 3212836865)) (f32.const 2143289344))
+(assert_return (invoke "f32.add" (f32.const 2143289344) (f32.const 3226013659)) (f32.const 2143289344))
+(assert_return (invoke "f32.add" (f32.const 2143289344) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.add" (f32.const 2155872255) (f32.const 1)) (f32.const 2155872254))
+(assert_return (invoke "f32.add" (f32.const 2155872255) (f32.const 1065353215)) (f32.const 1065353215))
+(assert_return (invoke "f32.add" (f32.const 2155872255) (f32.const 2139095039)) (f32.const 2139095039))
+(assert_return (invoke "f32.add" (f32.const 2155872255) (f32.const 2147483648)) (f32.const 2155872255))
+(assert_return (invoke "f32.add" (f32.const 2155872255) (f32.const 3212836865)) (f32.const 3212836865))
+(assert_return (invoke "f32.add" (f32.const 2155872255) (f32.const 3226013659)) (f32.const 3226013659))
+(assert_return (invoke "f32.add" (f32.const 2155872255) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.add" (f32.const 3212836865) (f32.const 1)) (f32.const 3212836865))
+(assert_return (invoke "f32.add" (f32.const 3212836865) (f32.const 1065353215)) (f32.const 3024093184))
+(assert_return (invoke "f32.add" (f32.const 3212836865) (f32.const 2139095039)) (f32.const 2139095039))
+(assert_return (invoke "f32.add" (f32.const 3212836865) (f32.const 2147483648)) (f32.const 3212836865))
+(assert_return (invoke "f32.add" (f32.const 3212836865) (f32.const 3212836865)) (f32.const 3221225473))
+(assert_return (invoke "f32.add" (f32.const 3212836865) (f32.const 3226013659)) (f32.const 3229911022))
+(assert_return (invoke "f32.add" (f32.const 3212836865) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.add" (f32.const 3225419776) (f32.const 1)) (f32.const 3225419776))
+(assert_return (invoke "f32.add" (f32.const 3225419776) (f32.const 1065353215)) (f32.const 3221225472))
+(assert_return (invoke "f32.add" (f32.const 3225419776) (f32.const 2139095039)) (f32.const 2139095039))
+(assert_return (invoke "f32.add" (f32.const 3225419776) (f32.const 2147483648)) (f32.const 3225419776))
+(assert_return (invoke "f32.add" (f32.const 3225419776) (f32.const 3212836865)) (f32.const 3229614080))
+(assert_return (invoke "f32.add" (f32.const 3225419776) (f32.const 3226013659)) (f32.const 3234105326))
+(assert_return (invoke "f32.add" (f32.const 3225419776) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.add" (f32.const 4286578687) (f32.const 1)) (f32.const 4286578687))
+(assert_return (invoke "f32.add" (f32.const 4286578687) (f32.const 1065353215)) (f32.const 4286578687))
+(assert_return (invoke "f32.add" (f32.const 4286578687) (f32.const 2139095039)) (f32.const 0))
+(assert_return (invoke "f32.add" (f32.const 4286578687) (f32.const 2147483648)) (f32.const 4286578687))
+(assert_return (invoke "f32.add" (f32.const 4286578687) (f32.const 3212836865)) (f32.const 4286578687))
+(assert_return (invoke "f32.add" (f32.const 4286578687) (f32.const 3226013659)) (f32.const 4286578687))
+(assert_return (invoke "f32.add" (f32.const 4286578687) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.add" (f32.const 3414163457) (f32.const 1)) (f32.const 3414163457))
+(assert_return (invoke "f32.add" (f32.const 3414163457) (f32.const 1065353215)) (f32.const 3414163457))
+(assert_return (invoke "f32.add" (f32.const 3414163457) (f32.const 2139095039)) (f32.const 2139095039))
+(assert_return (invoke "f32.add" (f32.const 3414163457) (f32.const 2147483648)) (f32.const 3414163457))
+(assert_return (invoke "f32.add" (f32.const 3414163457) (f32.const 3212836865)) (f32.const 3414163458))
+(assert_return (invoke "f32.add" (f32.const 3414163457) (f32.const 3226013659)) (f32.const 3414163459))
+(assert_return (invoke "f32.add" (f32.const 3414163457) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.add" (f32.const 3180209412) (f32.const 2380937892)) (f32.const 3180209412))
+(assert_return (invoke "f32.add" (f32.const 213348186) (f32.const 3257085297)) (f32.const 3257085297))
+(assert_return (invoke "f32.add" (f32.const 662927108) (f32.const 194975212)) (f32.const 662927108))
+(assert_return (invoke "f32.add" (f32.const 2165862403) (f32.const 3441728053)) (f32.const 3441728053))
+(assert_return (invoke "f32.add" (f32.const 1718327528) (f32.const 1200373871)) (f32.const 1718327528))
+(assert_return (invoke "f32.add" (f32.const 1615168966) (f32.const 1539161566)) (f32.const 1615193253))
+(assert_return (invoke "f32.add" (f32.const 3178830433) (f32.const 224725870)) (f32.const 3178830433))
+(assert_return (invoke "f32.add" (f32.const 2954512592) (f32.const 2637544928)) (f32.const 2954512592))
+(assert_return (invoke "f32.add" (f32.const 3372770398) (f32.const 2322172020)) (f32.const 3372770398))
+(assert_return (invoke "f32.add" (f32.const 1367705436) (f32.const 1319747675)) (f32.const 1367879261))
+(assert_return (invoke "f32.add" (f32.const 3192805197) (f32.const 3015835169)) (f32.const 3192805203))
+(assert_return (invoke "f32.add" (f32.const 1628031967) (f32.const 3453509329)) (f32.const 1628031967))
+(assert_return (invoke "f32.add" (f32.const 2329116734) (f32.const 50011404)) (f32.const 2329116483))
+(assert_return (invoke "f32.add" (f32.const 3011022973) (f32.const 1303525892)) (f32.const 1303525892))
+(assert_return (invoke "f32.add" (f32.const 3333416599) (f32.const 250049403)) (f32.const 3333416599))
+(assert_return (invoke "f32.add" (f32.const 1408095620) (f32.const 2042151421)) (f32.const 2042151421))
+(assert_return (invoke "f32.add" (f32.const 1046203077) (f32.const 1122114895)) (f32.const 1122143028))
+(assert_return (invoke "f32.add" (f32.const 4151514195) (f32.const 1195326689)) (f32.const 4151514195))
+(assert_return (invoke "f32.add" (f32.const 2795779966) (f32.const 3322487527)) (f32.const 3322487527))
+(assert_return (invoke "f32.add" (f32.const 994722660) (f32.const 3142070914)) (f32.const 939800704))
+(assert_return (invoke "f32.add" (f32.const 2871954420) (f32.const 3154977680)) (f32.const 3154977680))
+(assert_return (invoke "f32.add" (f32.const 3108196017) (f32.const 1130425353)) (f32.const 1130425341))
+(assert_return (invoke "f32.add" (f32.const 1841124479) (f32.const 3375240463)) (f32.const 1841124479))
+(assert_return (invoke "f32.add" (f32.const 2904817113) (f32.const 2222149044)) (f32.const 2904817113))
+(assert_return (invoke "f32.add" (f32.const 1957625489) (f32.const 3289433958)) (f32.const 1957625489))
+(assert_return (invoke "f32.add" (f32.const 3895124781) (f32.const 556835346)) (f32.const 3895124781))
+(assert_return (invoke "f32.add" (f32.const 1618639480) (f32.const 1098080276)) (f32.const 1618639480))
+(assert_return (invoke "f32.add" (f32.const 1476156637) (f32.const 1345519101)) (f32.const 1476156995))
+(assert_return (invoke "f32.add" (f32.const 4078678066) (f32.const 3104113075)) (f32.const 4078678066))
+(assert_return (invoke "f32.add" (f32.const 2263242689) (f32.const 3134305758)) (f32.const 3134305758))
+(assert_return (invoke "f32.add" (f32.const 36017232) (f32.const 985555021)) (f32.const 985555021))
+(assert_return (invoke "f32.add" (f32.const 4241406877) (f32.const 3484179378)) (f32.const 4241406877))
+(assert_return (invoke "f32.add" (f32.const 4090257917) (f32.const 2959217358)) (f32.const 4090257917))
+(assert_return (invoke "f32.add" (f32.const 1110517152) (f32.const 1883576536)) (f32.const 1883576536))
+(assert_return (invoke "f32.add" (f32.const 1274075121) (f32.const 4119200188)) (f32.const 4119200188))
+(assert_return (invoke "f32.add" (f32.const 1025676878) (f32.const 956780767)) (f32.const 1025711519))
+(assert_return (invoke "f32.add" (f32.const 3687523622) (f32.const 1316985622)) (f32.const 3687523622))
+(assert_return (invoke "f32.add" (f32.const 920063790) (f32.const 1046069989)) (f32.const 1046070419))
+(assert_return (invoke "f32.add" (f32.const 1304490508) (f32.const 859633100)) (f32.const 1304490508))
+(assert_return (invoke "f32.add" (f32.const 1191855434) (f32.const 821293682)) (f32.const 1191855434))
+(assert_return (invoke "f32.sub" (f32.const 0) (f32.const 1)) (f32.const 2147483649))
+(assert_return (invoke "f32.sub" (f32.const 0) (f32.const 1065353215)) (f32.const 3212836863))
+(assert_return (invoke "f32.sub" (f32.const 0) (f32.const 2139095039)) (f32.const 4286578687))
+(assert_return (invoke "f32.sub" (f32.const 0) (f32.const 2147483648)) (f32.const 0))
+(assert_return (invoke "f32.sub" (f32.const 0) (f32.const 3212836865)) (f32.const 1065353217))
+(assert_return (invoke "f32.sub" (f32.const 0) (f32.const 3226013659)) (f32.const 1078530011))
+(assert_return (invoke "f32.sub" (f32.const 0) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.sub" (f32.const 8388608) (f32.const 1)) (f32.const 8388607))
+(assert_return (invoke "f32.sub" (f32.const 8388608) (f32.const 1065353215)) (f32.const 3212836863))
+(assert_return (invoke "f32.sub" (f32.const 8388608) (f32.const 2139095039)) (f32.const 4286578687))
+(assert_return (invoke "f32.sub" (f32.const 8388608) (f32.const 2147483648)) (f32.const 8388608))
+(assert_return (invoke "f32.sub" (f32.const 8388608) (f32.const 3212836865)) (f32.const 1065353217))
+(assert_return (invoke "f32.sub" (f32.const 8388608) (f32.const 3226013659)) (f32.const 1078530011))
+(assert_return (invoke "f32.sub" (f32.const 8388608) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.sub" (f32.const 1065353215) (f32.const 1)) (f32.const 1065353215))
+(assert_return (invoke "f32.sub" (f32.const 1065353215) (f32.const 1065353215)) (f32.const 0))
+(assert_return (invoke "f32.sub" (f32.const 1065353215) (f32.const 2139095039)) (f32.const 4286578687))
+(assert_return (invoke "f32.sub" (f32.const 1065353215) (f32.const 2147483648)) (f32.const 1065353215))
+(assert_return (invoke "f32.sub" (f32.const 1065353215) (f32.const 3212836865)) (f32.const 1073741824))
+(assert_return (invoke "f32.sub" (f32.const 1065353215) (f32.const 3226013659)) (f32.const 1082427373))
+(assert_return (invoke "f32.sub" (f32.const 1065353215) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.sub" (f32.const 1036831949) (f32.const 1)) (f32.const 1036831949))
+(assert_return (invoke "f32.sub" (f32.const 1036831949) (f32.const 1065353215)) (f32.const 3211159141))
+(assert_return (invoke "f32.sub" (f32.const 1036831949) (f32.const 2139095039)) (f32.const 4286578687))
+(assert_return (invoke "f32.sub" (f32.const 1036831949) (f32.const 2147483648)) (f32.const 1036831949))
+(assert_return (invoke "f32.sub" (f32.const 1036831949) (f32.const 3212836865)) (f32.const 1066192078))
+(assert_return (invoke "f32.sub" (f32.const 1036831949) (f32.const 3226013659)) (f32.const 1078949441))
+(assert_return (invoke "f32.sub" (f32.const 1036831949) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.sub" (f32.const 2139095040) (f32.const 1)) (f32.const 2139095040))
+(assert_return (invoke "f32.sub" (f32.const 2139095040) (f32.const 1065353215)) (f32.const 2139095040))
+(assert_return (invoke "f32.sub" (f32.const 2139095040) (f32.const 2139095039)) (f32.const 2139095040))
+(assert_return (invoke "f32.sub" (f32.const 2139095040) (f32.const 2147483648)) (f32.const 2139095040))
+(assert_return (invoke "f32.sub" (f32.const 2139095040) (f32.const 3212836865)) (f32.const 2139095040))
+(assert_return (invoke "f32.sub" (f32.const 2139095040) (f32.const 3226013659)) (f32.const 2139095040))
+(assert_return (invoke "f32.sub" (f32.const 2139095040) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.sub" (f32.const 2143289344) (f32.const 1)) (f32.const 2143289344))
+(assert_return (invoke "f32.sub" (f32.const 2143289344) (f32.const 1065353215)) (f32.const 2143289344))
+(assert_return (invoke "f32.sub" (f32.const 2143289344) (f32.const 2139095039)) (f32.const 2143289344))
+(assert_return (invoke "f32.sub" (f32.const 2143289344) (f32.const 2147483648)) (f32.const 2143289344))
+(assert_return (invoke "f32.sub" (f32.const 2143289344) (f32.const 3212836865)) (f32.const 2143289344))
+(assert_return (invoke "f32.sub" (f32.const 2143289344) (f32.const 3226013659)) (f32.const 2143289344))
+(assert_return (invoke "f32.sub" (f32.const 2143289344) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.sub" (f32.const 2155872255) (f32.const 1)) (f32.const 2155872256))
+(assert_return (invoke "f32.sub" (f32.const 2155872255) (f32.const 1065353215)) (f32.const 3212836863))
+(assert_return (invoke "f32.sub" (f32.const 2155872255) (f32.const 2139095039)) (f32.const 4286578687))
+(assert_return (invoke "f32.sub" (f32.const 2155872255) (f32.const 2147483648)) (f32.const 2155872255))
+(assert_return (invoke "f32.sub" (f32.const 2155872255) (f32.const 3212836865)) (f32.const 1065353217))
+(assert_return (invoke "f32.sub" (f32.const 2155872255) (f32.const 3226013659)) (f32.const 1078530011))
+(assert_return (invoke "f32.sub" (f32.const 2155872255) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.sub" (f32.const 3212836865) (f32.const 1)) (f32.const 3212836865))
+(assert_return (invoke "f32.sub" (f32.const 3212836865) (f32.const 1065353215)) (f32.const 3221225472))
+(assert_return (invoke "f32.sub" (f32.const 3212836865) (f32.const 2139095039)) (f32.const 4286578687))
+(assert_return (invoke "f32.sub" (f32.const 3212836865) (f32.const 2147483648)) (f32.const 3212836865))
+(assert_return (invoke "f32.sub" (f32.const 3212836865) (f32.const 3212836865)) (f32.const 0))
+(assert_return (invoke "f32.sub" (f32.const 3212836865) (f32.const 3226013659)) (f32.const 1074335706))
+(assert_return (invoke "f32.sub" (f32.const 3212836865) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.sub" (f32.const 3225419776) (f32.const 1)) (f32.const 3225419776))
+(assert_return (invoke "f32.sub" (f32.const 3225419776) (f32.const 1065353215)) (f32.const 3229614080))
+(assert_return (invoke "f32.sub" (f32.const 3225419776) (f32.const 2139095039)) (f32.const 4286578687))
+(assert_return (invoke "f32.sub" (f32.const 3225419776) (f32.const 2147483648)) (f32.const 3225419776))
+(assert_return (invoke "f32.sub" (f32.const 3225419776) (f32.const 3212836865)) (f32.const 3221225471))
+(assert_return (invoke "f32.sub" (f32.const 3225419776) (f32.const 3226013659)) (f32.const 1041300912))
+(assert_return (invoke "f32.sub" (f32.const 3225419776) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.sub" (f32.const 4286578687) (f32.const 1)) (f32.const 4286578687))
+(assert_return (invoke "f32.sub" (f32.const 4286578687) (f32.const 1065353215)) (f32.const 4286578687))
+(assert_return (invoke "f32.sub" (f32.const 4286578687) (f32.const 2139095039)) (f32.const 4286578688))
+(assert_return (invoke "f32.sub" (f32.const 4286578687) (f32.const 2147483648)) (f32.const 4286578687))
+(assert_return (invoke "f32.sub" (f32.const 4286578687) (f32.const 3212836865)) (f32.const 4286578687))
+(assert_return (invoke "f32.sub" (f32.const 4286578687) (f32.const 3226013659)) (f32.const 4286578687))
+(assert_return (invoke "f32.sub" (f32.const 4286578687) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.sub" (f32.const 3414163457) (f32.const 1)) (f32.const 3414163457))
+(assert_return (invoke "f32.sub" (f32.const 3414163457) (f32.const 1065353215)) (f32.const 3414163457))
+(assert_return (invoke "f32.sub" (f32.const 3414163457) (f32.const 2139095039)) (f32.const 4286578687))
+(assert_return (invoke "f32.sub" (f32.const 3414163457) (f32.const 2147483648)) (f32.const 3414163457))
+(assert_return (invoke "f32.sub" (f32.const 3414163457) (f32.const 3212836865)) (f32.const 3414163456))
+(assert_return (invoke "f32.sub" (f32.const 3414163457) (f32.const 3226013659)) (f32.const 3414163455))
+(assert_return (invoke "f32.sub" (f32.const 3414163457) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.sub" (f32.const 2752608319) (f32.const 1342435112)) (f32.const 3489918760))
+(assert_return (invoke "f32.sub" (f32.const 55218207) (f32.const 2837909257)) (f32.const 690425609))
+(assert_return (invoke "f32.sub" (f32.const 3035205184) (f32.const 1413523727)) (f32.const 3561007375))
+(assert_return (invoke "f32.sub" (f32.const 836568483) (f32.const 1458513145)) (f32.const 3605996793))
+(assert_return (invoke "f32.sub" (f32.const 1323475606) (f32.const 3912323483)) (f32.const 1764839835))
+(assert_return (invoke "f32.sub" (f32.const 1185788621) (f32.const 352971181)) (f32.const 1185788621))
+(assert_return (invoke "f32.sub" (f32.const 565205539) (f32.const 3659951477)) (f32.const 1512467829))
+(assert_return (invoke "f32.sub" (f32.const 4027084254) (f32.const 867970799)) (f32.const 4027084254))
+(assert_return (invoke "f32.sub" (f32.const 942311143) (f32.const 1349838361)) (f32.const 3497322009))
+(assert_return (invoke "f32.sub" (f32.const 848476199) (f32.const 242475043)) (f32.const 848476199))
+(assert_return (invoke "f32.sub" (f32.const 965423999) (f32.const 3041951074)) (f32.const 965450686))
+(assert_return (invoke "f32.sub" (f32.const 1801297503) (f32.const 3076889778)) (f32.const 1801297503))
+(assert_return (invoke "f32.sub" (f32.const 3202793647) (f32.const 67154416)) (f32.const 3202793647))
+(assert_return (invoke "f32.sub" (f32.const 2689603953) (f32.const 1088398690)) (f32.const 3235882338))
+(assert_return (invoke "f32.sub" (f32.const 1316186976) (f32.const 2491888849)) (f32.const 1316186976))
+(assert_return (invoke "f32.sub" (f32.const 3056232101) (f32.const 816481170)) (f32.const 3056237557))
+(assert_return (invoke "f32.sub" (f32.const 3901981120) (f32.const 1162588096)) (f32.const 3901981120))
+(assert_return (invoke "f32.sub" (f32.const 1151765755) (f32.const 1145873381)) (f32.const 1140880913))
+(assert_return (invoke "f32.sub" (f32.const 2079959114) (f32.const 976792451)) (f32.const 2079959114))
+(assert_return (invoke "f32.sub" (f32.const 1108924593) (f32.const 1779158630)) (f32.const 3926642278))
+(assert_return (invoke "f32.sub" (f32.const 2991559197) (f32.const 3464688003)) (f32.const 1317204355))
+(assert_return (invoke "f32.sub" (f32.const 718944222) (f32.const 3141720657)) (f32.const 994237009))
+(assert_return (invoke "f32.sub" (f32.const 3326909992) (f32.const 2131348650)) (f32.const 4278832298))
+(assert_return (invoke "f32.sub" (f32.const 2080641258) (f32.const 3345541423)) (f32.const 2080641258))
+(assert_return (invoke "f32.sub" (f32.const 785925323) (f32.const 191935179)) (f32.const 785925323))
+(assert_return (invoke "f32.sub" (f32.const 2713622322) (f32.const 2225749878)) (f32.const 2713622322))
+(assert_return (invoke "f32.sub" (f32.const 1511315407) (f32.const 1079149157)) (f32.const 1511315407))
+(assert_return (invoke "f32.sub" (f32.const 1218277329) (f32.const 3117976154)) (f32.const 1218277329))
+(assert_return (invoke "f32.sub" (f32.const 1739455461) (f32.const 60530332)) (f32.const 1739455461))
+(assert_return (invoke "f32.sub" (f32.const 2925495979) (f32.const 3087607641)) (f32.const 940123979))
+(assert_return (invoke "f32.sub" (f32.const 2847894020) (f32.const 819715299)) (f32.const 2967199713))
+(assert_return (invoke "f32.sub" (f32.const 816844829) (f32.const 790070328)) (f32.const 815603606))
+(assert_return (invoke "f32.sub" (f32.const 3333426579) (f32.const 2098371260)) (f32.const 4245854908))
+(assert_return (invoke "f32.sub" (f32.const 738399284) (f32.const 2183070993)) (f32.const 738399284))
+(assert_return (invoke "f32.sub" (f32.const 975195195) (f32.const 898993637)) (f32.const 975176052))
+(assert_return (invoke "f32.sub" (f32.const 3247711814) (f32.const 2821224052)) (f32.const 3247711814))
+(assert_return (invoke "f32.sub" (f32.const 4184991871) (f32.const 3013916952)) (f32.const 4184991871))
+(assert_return (invoke "f32.sub" (f32.const 3688732533) (f32.const 992852063)) (f32.const 3688732533))
+(assert_return (invoke "f32.sub" (f32.const 1538707715) (f32.const 1212453978)) (f32.const 1538707715))
+(assert_return (invoke "f32.sub" (f32.const 3341833759) (f32.const 3473197992)) (f32.const 1325714168))
+(assert_return (invoke "f32.mul" (f32.const 0) (f32.const 1)) (f32.const 0))
+(assert_return (invoke "f32.mul" (f32.const 0) (f32.const 1065353215)) (f32.const 0))
+(assert_return (invoke "f32.mul" (f32.const 0) (f32.const 2139095039)) (f32.const 0))
+(assert_return (invoke "f32.mul" (f32.const 0) (f32.const 2147483648)) (f32.const 2147483648))
+(assert_return (invoke "f32.mul" (f32.const 0) (f32.const 3212836865)) (f32.const 2147483648))
+(assert_return (invoke "f32.mul" (f32.const 0) (f32.const 3226013659)) (f32.const 2147483648))
+(assert_return (invoke "f32.mul" (f32.const 0) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.mul" (f32.const 8388608) (f32.const 1)) (f32.const 0))
+(assert_return (invoke "f32.mul" (f32.const 8388608) (f32.const 1065353215)) (f32.const 8388608))
+(assert_return (invoke "f32.mul" (f32.const 8388608) (f32.const 2139095039)) (f32.const 1082130431))
+(assert_return (invoke "f32.mul" (f32.const 8388608) (f32.const 2147483648)) (f32.const 2147483648))
+(assert_return (invoke "f32.mul" (f32.const 8388608) (f32.const 3212836865)) (f32.const 2155872257))
+(assert_return (invoke "f32.mul" (f32.const 8388608) (f32.const 3226013659)) (f32.const 2169049051))
+(assert_return (invoke "f32.mul" (f32.const 8388608) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.mul" (f32.const 1065353215) (f32.const 1)) (f32.const 1))
+(assert_return (invoke "f32.mul" (f32.const 1065353215) (f32.const 1065353215)) (f32.const 1065353214))
+(assert_return (invoke "f32.mul" (f32.const 1065353215) (f32.const 2139095039)) (f32.const 2139095038))
+(assert_return (invoke "f32.mul" (f32.const 1065353215) (f32.const 2147483648)) (f32.const 2147483648))
+(assert_return (invoke "f32.mul" (f32.const 1065353215) (f32.const 3212836865)) (f32.const 3212836864))
+(assert_return (invoke "f32.mul" (f32.const 1065353215) (f32.const 3226013659)) (f32.const 3226013658))
+(assert_return (invoke "f32.mul" (f32.const 1065353215) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.mul" (f32.const 1036831949) (f32.const 1)) (f32.const 0))
+(assert_return (invoke "f32.mul" (f32.const 1036831949) (f32.const 1065353215)) (f32.const 1036831948))
+(assert_return (invoke "f32.mul" (f32.const 1036831949) (f32.const 2139095039)) (f32.const 2110573772))
+(assert_return (invoke "f32.mul" (f32.const 1036831949) (f32.const 2147483648)) (f32.const 2147483648))
+(assert_return (invoke "f32.mul" (f32.const 1036831949) (f32.const 3212836865)) (f32.const 3184315599))
+(assert_return (invoke "f32.mul" (f32.const 1036831949) (f32.const 3226013659)) (f32.const 3198212476))
+(assert_return (invoke "f32.mul" (f32.const 1036831949) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.mul" (f32.const 2139095040) (f32.const 1)) (f32.const 2139095040))
+(assert_return (invoke "f32.mul" (f32.const 2139095040) (f32.const 1065353215)) (f32.const 2139095040))
+(assert_return (invoke "f32.mul" (f32.const 2139095040) (f32.const 2139095039)) (f32.const 2139095040))
+(assert_return (invoke "f32.mul" (f32.const 2139095040) (f32.const 2147483648)) (f32.const 2143289344))
+(assert_return (invoke "f32.mul" (f32.const 2139095040) (f32.const 3212836865)) (f32.const 4286578688))
+(assert_return (invoke "f32.mul" (f32.const 2139095040) (f32.const 3226013659)) (f32.const 4286578688))
+(assert_return (invoke "f32.mul" (f32.const 2139095040) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.mul" (f32.const 2143289344) (f32.const 1)) (f32.const 2143289344))
+(assert_return (invoke "f32.mul" (f32.const 2143289344) (f32.const 1065353215)) (f32.const 2143289344))
+(assert_return (invoke "f32.mul" (f32.const 2143289344) (f32.const 2139095039)) (f32.const 2143289344))
+(assert_return (invoke "f32.mul" (f32.const 2143289344) (f32.const 2147483648)) (f32.const 2143289344))
+(assert_return (invoke "f32.mul" (f32.const 2143289344) (f32.const 3212836865)) (f32.const 2143289344))
+(assert_return (invoke "f32.mul" (f32.const 2143289344) (f32.const 3226013659)) (f32.const 2143289344))
+(assert_return (invoke "f32.mul" (f32.const 2143289344) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.mul" (f32.const 2155872255) (f32.const 1)) (f32.const 2147483648))
+(assert_return (invoke "f32.mul" (f32.const 2155872255) (f32.const 1065353215)) (f32.const 2155872255))
+(assert_return (invoke "f32.mul" (f32.const 2155872255) (f32.const 2139095039)) (f32.const 3229614077))
+(assert_return (invoke "f32.mul" (f32.const 2155872255) (f32.const 2147483648)) (f32.const 0))
+(assert_return (invoke "f32.mul" (f32.const 2155872255) (f32.const 3212836865)) (f32.const 8388608))
+(assert_return (invoke "f32.mul" (f32.const 2155872255) (f32.const 3226013659)) (f32.const 21565401))
+(assert_return (invoke "f32.mul" (f32.const 2155872255) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.mul" (f32.const 3212836865) (f32.const 1)) (f32.const 2147483649))
+(assert_return (invoke "f32.mul" (f32.const 3212836865) (f32.const 1065353215)) (f32.const 3212836864))
+(assert_return (invoke "f32.mul" (f32.const 3212836865) (f32.const 2139095039)) (f32.const 4286578688))
+(assert_return (invoke "f32.mul" (f32.const 3212836865) (f32.const 2147483648)) (f32.const 0))
+(assert_return (invoke "f32.mul" (f32.const 3212836865) (f32.const 3212836865)) (f32.const 1065353218))
+(assert_return (invoke "f32.mul" (f32.const 3212836865) (f32.const 3226013659)) (f32.const 1078530013))
+(assert_return (invoke "f32.mul" (f32.const 3212836865) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.mul" (f32.const 3225419776) (f32.const 1)) (f32.const 2147483651))
+(assert_return (invoke "f32.mul" (f32.const 3225419776) (f32.const 1065353215)) (f32.const 3225419775))
+(assert_return (invoke "f32.mul" (f32.const 3225419776) (f32.const 2139095039)) (f32.const 4286578688))
+(assert_return (invoke "f32.mul" (f32.const 3225419776) (f32.const 2147483648)) (f32.const 0))
+(assert_return (invoke "f32.mul" (f32.const 3225419776) (f32.const 3212836865)) (f32.const 1077936130))
+(assert_return (invoke "f32.mul" (f32.const 3225419776) (f32.const 3226013659)) (f32.const 1092013028))
+(assert_return (invoke "f32.mul" (f32.const 3225419776) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.mul" (f32.const 4286578687) (f32.const 1)) (f32.const 3036676095))
+(assert_return (invoke "f32.mul" (f32.const 4286578687) (f32.const 1065353215)) (f32.const 4286578686))
+(assert_return (invoke "f32.mul" (f32.const 4286578687) (f32.const 2139095039)) (f32.const 4286578688))
+(assert_return (invoke "f32.mul" (f32.const 4286578687) (f32.const 2147483648)) (f32.const 0))
+(assert_return (invoke "f32.mul" (f32.const 4286578687) (f32.const 3212836865)) (f32.const 2139095040))
+(assert_return (invoke "f32.mul" (f32.const 4286578687) (f32.const 3226013659)) (f32.const 2139095040))
+(assert_return (invoke "f32.mul" (f32.const 4286578687) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.mul" (f32.const 3414163457) (f32.const 1)) (f32.const 2164260865))
+(assert_return (invoke "f32.mul" (f32.const 3414163457) (f32.const 1065353215)) (f32.const 3414163456))
+(assert_return (invoke "f32.mul" (f32.const 3414163457) (f32.const 2139095039)) (f32.const 4286578688))
+(assert_return (invoke "f32.mul" (f32.const 3414163457) (f32.const 2147483648)) (f32.const 0))
+(assert_return (invoke "f32.mul" (f32.const 3414163457) (f32.const 3212836865)) (f32.const 1266679810))
+(assert_return (invoke "f32.mul" (f32.const 3414163457) (f32.const 3226013659)) (f32.const 1279856605))
+(assert_return (invoke "f32.mul" (f32.const 3414163457) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.mul" (f32.const 3824166807) (f32.const 1942998681)) (f32.const 4286578688))
+(assert_return (invoke "f32.mul" (f32.const 2616525515) (f32.const 955103218)) (f32.const 2506326947))
+(assert_return (invoke "f32.mul" (f32.const 1186571149) (f32.const 2356055239)) (f32.const 2477587491))
+(assert_return (invoke "f32.mul" (f32.const 2562944890) (f32.const 3458805610)) (f32.const 662776010))
+(assert_return (invoke "f32.mul" (f32.const 3550462227) (f32.const 916797907)) (f32.const 3402512142))
+(assert_return (invoke "f32.mul" (f32.const 1447922885) (f32.const 1290521472)) (f32.const 1673352094))
+(assert_return (invoke "f32.mul" (f32.const 1885668864) (f32.const 893191063)) (f32.const 1713969316))
+(assert_return (invoke "f32.mul" (f32.const 3166138633) (f32.const 4154423524)) (f32.const 1961135018))
+(assert_return (invoke "f32.mul" (f32.const 952056016) (f32.const 3934532350)) (f32.const 3821371076))
+(assert_return (invoke "f32.mul" (f32.const 2664011849) (f32.const 1081520547)) (f32.const 2680308793))
+(assert_return (invoke "f32.mul" (f32.const 1223369923) (f32.const 3997454049)) (f32.const 4155789465))
+(assert_return (invoke "f32.mul" (f32.const 3144615014) (f32.const 3907680817)) (f32.const 1692068891))
+(assert_return (invoke "f32.mul" (f32.const 3597707057) (f32.const 1779896351)) (f32.const 4286578688))
+(assert_return (invoke "f32.mul" (f32.const 1847633827) (f32.const 883683290)) (f32.const 1666698494))
+(assert_return (invoke "f32.mul" (f32.const 4091021205) (f32.const 3422269026)) (f32.const 2139095040))
+(assert_return (invoke "f32.mul" (f32.const 828653127) (f32.const 3642677731)) (f32.const 3406667955))
+(assert_return (invoke "f32.mul" (f32.const 3182660201) (f32.const 1140282223)) (f32.const 3257758991))
+(assert_return (invoke "f32.mul" (f32.const 2913690632) (f32.const 746828216)) (f32.const 2595247703))
+(assert_return (invoke "f32.mul" (f32.const 1359947291) (f32.const 1022879042)) (f32.const 1317707254))
+(assert_return (invoke "f32.mul" (f32.const 2044020919) (f32.const 943428476)) (f32.const 1922844414))
+(assert_return (invoke "f32.mul" (f32.const 159072859) (f32.const 3421911247)) (f32.const 2515642757))
+(assert_return (invoke "f32.mul" (f32.const 3163034286) (f32.const 3035211666)) (f32.const 838362148))
+(assert_return (invoke "f32.mul" (f32.const 204098040) (f32.const 764653606)) (f32.const 1572))
+(assert_return (invoke "f32.mul" (f32.const 965868590) (f32.const 2977015936)) (f32.const 2877935230))
+(assert_return (invoke "f32.mul" (f32.const 2245567343) (f32.const 2423532023)) (f32.const 0))
+(assert_return (invoke "f32.mul" (f32.const 961536538) (f32.const 2993000449)) (f32.const 2889509425))
+(assert_return (invoke "f32.mul" (f32.const 2241724341) (f32.const 1177645825)) (f32.const 2354776461))
+(assert_return (invoke "f32.mul" (f32.const 3085855638) (f32.const 826847967)) (f32.const 2847599268))
+(assert_return (invoke "f32.mul" (f32.const 886242164) (f32.const 538676807)) (f32.const 360723329))
+(assert_return (invoke "f32.mul" (f32.const 1118468888) (f32.const 4193455479)) (f32.const 4246854607))
+(assert_return (invoke "f32.mul" (f32.const 1553229302) (f32.const 2993994010)) (f32.const 3482180731))
+(assert_return (invoke "f32.mul" (f32.const 1276966806) (f32.const 2415895053)) (f32.const 2627517947))
+(assert_return (invoke "f32.mul" (f32.const 3409702670) (f32.const 1974961427)) (f32.const 4286578688))
+(assert_return (invoke "f32.mul" (f32.const 1641876989) (f32.const 1294931425)) (f32.const 1872179060))
+(assert_return (invoke "f32.mul" (f32.const 386509806) (f32.const 4267015840)) (f32.const 3588595787))
+(assert_return (invoke "f32.mul" (f32.const 693838684) (f32.const 1172656196)) (f32.const 801393495))
+(assert_return (invoke "f32.mul" (f32.const 2988170247) (f32.const 2902186431)) (f32.const 530142541))
+(assert_return (invoke "f32.mul" (f32.const 849420278) (f32.const 381340096)) (f32.const 166404199))
+(assert_return (invoke "f32.mul" (f32.const 3053721437) (f32.const 503683731)) (f32.const 2492063690))
+(assert_return (invoke "f32.mul" (f32.const 2961669666) (f32.const 3825341889)) (f32.const 1426699042))
+(assert_return (invoke "f32.div" (f32.const 0) (f32.const 1)) (f32.const 0))
+(assert_return (invoke "f32.div" (f32.const 0) (f32.const 1065353215)) (f32.const 0))
+(assert_return (invoke "f32.div" (f32.const 0) (f32.const 2139095039)) (f32.const 0))
+(assert_return (invoke "f32.div" (f32.const 0) (f32.const 2147483648)) (f32.const 2143289344))
+(assert_return (invoke "f32.div" (f32.const 0) (f32.const 3212836865)) (f32.const 2147483648))
+(assert_return (invoke "f32.div" (f32.const 0) (f32.const 3226013659)) (f32.const 2147483648))
+(assert_return (invoke "f32.div" (f32.const 0) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.div" (f32.const 8388608) (f32.const 1)) (f32.const 1258291200))
+(assert_return (invoke "f32.div" (f32.const 8388608) (f32.const 1065353215)) (f32.const 8388609))
+(assert_return (invoke "f32.div" (f32.const 8388608) (f32.const 2139095039)) (f32.const 0))
+(assert_return (invoke "f32.div" (f32.const 8388608) (f32.const 2147483648)) (f32.const 4286578688))
+(assert_return (invoke "f32.div" (f32.const 8388608) (f32.const 3212836865)) (f32.const 2155872255))
+(assert_return (invoke "f32.div" (f32.const 8388608) (f32.const 3226013659)) (f32.const 2150153825))
+(assert_return (invoke "f32.div" (f32.const 8388608) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.div" (f32.const 1065353215) (f32.const 1)) (f32.const 2139095040))
+(assert_return (invoke "f32.div" (f32.const 1065353215) (f32.const 1065353215)) (f32.const 1065353216))
+(assert_return (invoke "f32.div" (f32.const 1065353215) (f32.const 2139095039)) (f32.const 2097152))
+(assert_return (invoke "f32.div" (f32.const 1065353215) (f32.const 2147483648)) (f32.const 4286578688))
+(assert_return (invoke "f32.div" (f32.const 1065353215) (f32.const 3212836865)) (f32.const 3212836861))
+(assert_return (invoke "f32.div" (f32.const 1065353215) (f32.const 3226013659)) (f32.const 3198351746))
+(assert_return (invoke "f32.div" (f32.const 1065353215) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.div" (f32.const 1036831949) (f32.const 1)) (f32.const 2139095040))
+(assert_return (invoke "f32.div" (f32.const 1036831949) (f32.const 1065353215)) (f32.const 1036831950))
+(assert_return (invoke "f32.div" (f32.const 1036831949) (f32.const 2139095039)) (f32.const 209715))
+(assert_return (invoke "f32.div" (f32.const 1036831949) (f32.const 2147483648)) (f32.const 4286578688))
+(assert_return (invoke "f32.div" (f32.const 1036831949) (f32.const 3212836865)) (f32.const 3184315595))
+(assert_return (invoke "f32.div" (f32.const 1036831949) (f32.const 3226013659)) (f32.const 3171049782))
+(assert_return (invoke "f32.div" (f32.const 1036831949) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.div" (f32.const 2139095040) (f32.const 1)) (f32.const 2139095040))
+(assert_return (invoke "f32.div" (f32.const 2139095040) (f32.const 1065353215)) (f32.const 2139095040))
+(assert_return (invoke "f32.div" (f32.const 2139095040) (f32.const 2139095039)) (f32.const 2139095040))
+(assert_return (invoke "f32.div" (f32.const 2139095040) (f32.const 2147483648)) (f32.const 4286578688))
+(assert_return (invoke "f32.div" (f32.const 2139095040) (f32.const 3212836865)) (f32.const 4286578688))
+(assert_return (invoke "f32.div" (f32.const 2139095040) (f32.const 3226013659)) (f32.const 4286578688))
+(assert_return (invoke "f32.div" (f32.const 2139095040) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.div" (f32.const 2143289344) (f32.const 1)) (f32.const 2143289344))
+(assert_return (invoke "f32.div" (f32.const 2143289344) (f32.const 1065353215)) (f32.const 2143289344))
+(assert_return (invoke "f32.div" (f32.const 2143289344) (f32.const 2139095039)) (f32.const 2143289344))
+(assert_return (invoke "f32.div" (f32.const 2143289344) (f32.const 2147483648)) (f32.const 2143289344))
+(assert_return (invoke "f32.div" (f32.const 2143289344) (f32.const 3212836865)) (f32.const 2143289344))
+(assert_return (invoke "f32.div" (f32.const 2143289344) (f32.const 3226013659)) (f32.const 2143289344))
+(assert_return (invoke "f32.div" (f32.const 2143289344) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.div" (f32.const 2155872255) (f32.const 1)) (f32.const 3405774846))
+(assert_return (invoke "f32.div" (f32.const 2155872255) (f32.const 1065353215)) (f32.const 2155872255))
+(assert_return (invoke "f32.div" (f32.const 2155872255) (f32.const 2139095039)) (f32.const 2147483648))
+(assert_return (invoke "f32.div" (f32.const 2155872255) (f32.const 2147483648)) (f32.const 2139095040))
+(assert_return (invoke "f32.div" (f32.const 2155872255) (f32.const 3212836865)) (f32.const 8388606))
+(assert_return (invoke "f32.div" (f32.const 2155872255) (f32.const 3226013659)) (f32.const 2670176))
+(assert_return (invoke "f32.div" (f32.const 2155872255) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.div" (f32.const 3212836865) (f32.const 1)) (f32.const 4286578688))
+(assert_return (invoke "f32.div" (f32.const 3212836865) (f32.const 1065353215)) (f32.const 3212836866))
+(assert_return (invoke "f32.div" (f32.const 3212836865) (f32.const 2139095039)) (f32.const 2149580800))
+(assert_return (invoke "f32.div" (f32.const 3212836865) (f32.const 2147483648)) (f32.const 2139095040))
+(assert_return (invoke "f32.div" (f32.const 3212836865) (f32.const 3212836865)) (f32.const 1065353216))
+(assert_return (invoke "f32.div" (f32.const 3212836865) (f32.const 3226013659)) (f32.const 1050868100))
+(assert_return (invoke "f32.div" (f32.const 3212836865) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.div" (f32.const 3225419776) (f32.const 1)) (f32.const 4286578688))
+(assert_return (invoke "f32.div" (f32.const 3225419776) (f32.const 1065353215)) (f32.const 3225419777))
+(assert_return (invoke "f32.div" (f32.const 3225419776) (f32.const 2139095039)) (f32.const 2153775104))
+(assert_return (invoke "f32.div" (f32.const 3225419776) (f32.const 2147483648)) (f32.const 2139095040))
+(assert_return (invoke "f32.div" (f32.const 3225419776) (f32.const 3212836865)) (f32.const 1077936127))
+(assert_return (invoke "f32.div" (f32.const 3225419776) (f32.const 3226013659)) (f32.const 1064597061))
+(assert_return (invoke "f32.div" (f32.const 3225419776) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.div" (f32.const 4286578687) (f32.const 1)) (f32.const 4286578688))
+(assert_return (invoke "f32.div" (f32.const 4286578687) (f32.const 1065353215)) (f32.const 4286578688))
+(assert_return (invoke "f32.div" (f32.const 4286578687) (f32.const 2139095039)) (f32.const 3212836864))
+(assert_return (invoke "f32.div" (f32.const 4286578687) (f32.const 2147483648)) (f32.const 2139095040))
+(assert_return (invoke "f32.div" (f32.const 4286578687) (f32.const 3212836865)) (f32.const 2139095037))
+(assert_return (invoke "f32.div" (f32.const 4286578687) (f32.const 3226013659)) (f32.const 2124609922))
+(assert_return (invoke "f32.div" (f32.const 4286578687) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.div" (f32.const 3414163457) (f32.const 1)) (f32.const 4286578688))
+(assert_return (invoke "f32.div" (f32.const 3414163457) (f32.const 1065353215)) (f32.const 3414163458))
+(assert_return (invoke "f32.div" (f32.const 3414163457) (f32.const 2139095039)) (f32.const 2340421634))
+(assert_return (invoke "f32.div" (f32.const 3414163457) (f32.const 2147483648)) (f32.const 2139095040))
+(assert_return (invoke "f32.div" (f32.const 3414163457) (f32.const 3212836865)) (f32.const 1266679808))
+(assert_return (invoke "f32.div" (f32.const 3414163457) (f32.const 3226013659)) (f32.const 1252194692))
+(assert_return (invoke "f32.div" (f32.const 3414163457) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.div" (f32.const 2427136499) (f32.const 1285618921)) (f32.const 2206734142))
+(assert_return (invoke "f32.div" (f32.const 991641885) (f32.const 3046368389)) (f32.const 3305529114))
+(assert_return (invoke "f32.div" (f32.const 3306620441) (f32.const 1887547494)) (f32.const 2484407953))
+(assert_return (invoke "f32.div" (f32.const 1865592226) (f32.const 3246778510)) (f32.const 3979004770))
+(assert_return (invoke "f32.div" (f32.const 2352493818) (f32.const 3690455357)) (f32.const 0))
+(assert_return (invoke "f32.div" (f32.const 1042758893) (f32.const 3004799557)) (f32.const 3398130901))
+(assert_return (invoke "f32.div" (f32.const 4156716223) (f32.const 2437647304)) (f32.const 2139095040))
+(assert_return (invoke "f32.div" (f32.const 2866750194) (f32.const 1902654028)) (f32.const 2147483894))
+(assert_return (invoke "f32.div" (f32.const 3773149875) (f32.const 1336729454)) (f32.const 3500806968))
+(assert_return (invoke "f32.div" (f32.const 926970704) (f32.const 1709614242)) (f32.const 282434499))
+(assert_return (invoke "f32.div" (f32.const 2997908839) (f32.const 4209594460)) (f32.const 24))
+(assert_return (invoke "f32.div" (f32.const 3400736618) (f32.const 1996768909)) (f32.const 2469221687))
+(assert_return (invoke "f32.div" (f32.const 3007666682) (f32.const 3031100021)) (f32.const 1041484861))
+(assert_return (invoke "f32.div" (f32.const 2379045821) (f32.const 1182590387)) (f32.const 2261770427))
+(assert_return (invoke "f32.div" (f32.const 3165713629) (f32.const 3022450478)) (f32.const 1208463177))
+(assert_return (invoke "f32.div" (f32.const 3248784118) (f32.const 1196833133)) (f32.const 3116668866))
+(assert_return (invoke "f32.div" (f32.const 2750524410) (f32.const 2221504385)) (f32.const 1594130330))
+(assert_return (invoke "f32.div" (f32.const 3428983303) (f32.const 1220927111)) (f32.const 3272755350))
+(assert_return (invoke "f32.div" (f32.const 3527641317) (f32.const 4050065374)) (f32.const 542677997))
+(assert_return (invoke "f32.div" (f32.const 1555293779) (f32.const 1096738147)) (f32.const 1523490354))
+(assert_return (invoke "f32.div" (f32.const 1628044112) (f32.const 864390960)) (f32.const 1828994304))
+(assert_return (invoke "f32.div" (f32.const 2977312057) (f32.const 2779072338)) (f32.const 1262397128))
+(assert_return (invoke "f32.div" (f32.const 3164192573) (f32.const 896151311)) (f32.const 3332903393))
+(assert_return (invoke "f32.div" (f32.const 1861735106) (f32.const 141667994)) (f32.const 2139095040))
+(assert_return (invoke "f32.div" (f32.const 3175616240) (f32.const 1184946696)) (f32.const 3055497987))
+(assert_return (invoke "f32.div" (f32.const 2813680936) (f32.const 3427887885)) (f32.const 450737307))
+(assert_return (invoke "f32.div" (f32.const 394052187) (f32.const 3450514460)) (f32.const 2302513194))
+(assert_return (invoke "f32.div" (f32.const 1237601414) (f32.const 3254672670)) (f32.const 3343224777))
+(assert_return (invoke "f32.div" (f32.const 3449153833) (f32.const 1241738381)) (f32.const 3272737103))
+(assert_return (invoke "f32.div" (f32.const 1129892574) (f32.const 3217404699)) (f32.const 3272367249))
+(assert_return (invoke "f32.div" (f32.const 2222546191) (f32.const 1935283484)) (f32.const 2147483648))
+(assert_return (invoke "f32.div" (f32.const 2004294784) (f32.const 3060633030)) (f32.const 4286578688))
+(assert_return (invoke "f32.div" (f32.const 327800329) (f32.const 3993668579)) (f32.const 2147483648))
+(assert_return (invoke "f32.div" (f32.const 3627001197) (f32.const 2670069971)) (f32.const 2022140144))
+(assert_return (invoke "f32.div" (f32.const 1223716056) (f32.const 1742532898)) (f32.const 545999250))
+(assert_return (invoke "f32.div" (f32.const 909956252) (f32.const 774650073)) (f32.const 1200379910))
+(assert_return (invoke "f32.div" (f32.const 3490799547) (f32.const 1718145552)) (f32.const 2837436647))
+(assert_return (invoke "f32.div" (f32.const 1733369708) (f32.const 2862099231)) (f32.const 4230998159))
+(assert_return (invoke "f32.div" (f32.const 4183533762) (f32.const 1436182399)) (f32.const 3811973258))
+(assert_return (invoke "f32.div" (f32.const 219749734) (f32.const 688528424)) (f32.const 596502542))
+(assert_return (invoke "f32.min" (f32.const 0) (f32.const 1)) (f32.const 0))
+(assert_return (invoke "f32.min" (f32.const 0) (f32.const 1065353215)) (f32.const 0))
+(assert_return (invoke "f32.min" (f32.const 0) (f32.const 2139095039)) (f32.const 0))
+(assert_return (invoke "f32.min" (f32.const 0) (f32.const 2147483648)) (f32.const 2147483648))
+(assert_return (invoke "f32.min" (f32.const 0) (f32.const 3212836865)) (f32.const 3212836865))
+(assert_return (invoke "f32.min" (f32.const 0) (f32.const 3226013659)) (f32.const 3226013659))
+(assert_return (invoke "f32.min" (f32.const 0) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.min" (f32.const 8388608) (f32.const 1)) (f32.const 1))
+(assert_return (invoke "f32.min" (f32.const 8388608) (f32.const 1065353215)) (f32.const 8388608))
+(assert_return (invoke "f32.min" (f32.const 8388608) (f32.const 2139095039)) (f32.const 8388608))
+(assert_return (invoke "f32.min" (f32.const 8388608) (f32.const 2147483648)) (f32.const 2147483648))
+(assert_return (invoke "f32.min" (f32.const 8388608) (f32.const 3212836865)) (f32.const 3212836865))
+(assert_return (invoke "f32.min" (f32.const 8388608) (f32.const 3226013659)) (f32.const 3226013659))
+(assert_return (invoke "f32.min" (f32.const 8388608) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.min" (f32.const 1065353215) (f32.const 1)) (f32.const 1))
+(assert_return (invoke "f32.min" (f32.const 1065353215) (f32.const 1065353215)) (f32.const 1065353215))
+(assert_return (invoke "f32.min" (f32.const 1065353215) (f32.const 2139095039)) (f32.const 1065353215))
+(assert_return (invoke "f32.min" (f32.const 1065353215) (f32.const 2147483648)) (f32.const 2147483648))
+(assert_return (invoke "f32.min" (f32.const 1065353215) (f32.const 3212836865)) (f32.const 3212836865))
+(assert_return (invoke "f32.min" (f32.const 1065353215) (f32.const 3226013659)) (f32.const 3226013659))
+(assert_return (invoke "f32.min" (f32.const 1065353215) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.min" (f32.const 1036831949) (f32.const 1)) (f32.const 1))
+(assert_return (invoke "f32.min" (f32.const 1036831949) (f32.const 1065353215)) (f32.const 1036831949))
+(assert_return (invoke "f32.min" (f32.const 1036831949) (f32.const 2139095039)) (f32.const 1036831949))
+(assert_return (invoke "f32.min" (f32.const 1036831949) (f32.const 2147483648)) (f32.const 2147483648))
+(assert_return (invoke "f32.min" (f32.const 1036831949) (f32.const 3212836865)) (f32.const 3212836865))
+(assert_return (invoke "f32.min" (f32.const 1036831949) (f32.const 3226013659)) (f32.const 3226013659))
+(assert_return (invoke "f32.min" (f32.const 1036831949) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.min" (f32.const 2139095040) (f32.const 1)) (f32.const 1))
+(assert_return (invoke "f32.min" (f32.const 2139095040) (f32.const 1065353215)) (f32.const 1065353215))
+(assert_return (invoke "f32.min" (f32.const 2139095040) (f32.const 2139095039)) (f32.const 2139095039))
+(assert_return (invoke "f32.min" (f32.const 2139095040) (f32.const 2147483648)) (f32.const 2147483648))
+(assert_return (invoke "f32.min" (f32.const 2139095040) (f32.const 3212836865)) (f32.const 3212836865))
+(assert_return (invoke "f32.min" (f32.const 2139095040) (f32.const 3226013659)) (f32.const 3226013659))
+(assert_return (invoke "f32.min" (f32.const 2139095040) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.min" (f32.const 2143289344) (f32.const 1)) (f32.const 2143289344))
+(assert_return (invoke "f32.min" (f32.const 2143289344) (f32.const 1065353215)) (f32.const 2143289344))
+(assert_return (invoke "f32.min" (f32.const 2143289344) (f32.const 2139095039)) (f32.const 2143289344))
+(assert_return (invoke "f32.min" (f32.const 2143289344) (f32.const 2147483648)) (f32.const 2143289344))
+(assert_return (invoke "f32.min" (f32.const 2143289344) (f32.const 3212836865)) (f32.const 2143289344))
+(assert_return (invoke "f32.min" (f32.const 2143289344) (f32.const 3226013659)) (f32.const 2143289344))
+(assert_return (invoke "f32.min" (f32.const 2143289344) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.min" (f32.const 2155872255) (f32.const 1)) (f32.const 2155872255))
+(assert_return (invoke "f32.min" (f32.const 2155872255) (f32.const 1065353215)) (f32.const 2155872255))
+(assert_return (invoke "f32.min" (f32.const 2155872255) (f32.const 2139095039)) (f32.const 2155872255))
+(assert_return (invoke "f32.min" (f32.const 2155872255) (f32.const 2147483648)) (f32.const 2155872255))
+(assert_return (invoke "f32.min" (f32.const 2155872255) (f32.const 3212836865)) (f32.const 3212836865))
+(assert_return (invoke "f32.min" (f32.const 2155872255) (f32.const 3226013659)) (f32.const 3226013659))
+(assert_return (invoke "f32.min" (f32.const 2155872255) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.min" (f32.const 3212836865) (f32.const 1)) (f32.const 3212836865))
+(assert_return (invoke "f32.min" (f32.const 3212836865) (f32.const 1065353215)) (f32.const 3212836865))
+(assert_return (invoke "f32.min" (f32.const 3212836865) (f32.const 2139095039)) (f32.const 3212836865))
+(assert_return (invoke "f32.min" (f32.const 3212836865) (f32.const 2147483648)) (f32.const 3212836865))
+(assert_return (invoke "f32.min" (f32.const 3212836865) (f32.const 3212836865)) (f32.const 3212836865))
+(assert_return (invoke "f32.min" (f32.const 3212836865) (f32.const 3226013659)) (f32.const 3226013659))
+(assert_return (invoke "f32.min" (f32.const 3212836865) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.min" (f32.const 3225419776) (f32.const 1)) (f32.const 3225419776))
+(assert_return (invoke "f32.min" (f32.const 3225419776) (f32.const 1065353215)) (f32.const 3225419776))
+(assert_return (invoke "f32.min" (f32.const 3225419776) (f32.const 2139095039)) (f32.const 3225419776))
+(assert_return (invoke "f32.min" (f32.const 3225419776) (f32.const 2147483648)) (f32.const 3225419776))
+(assert_return (invoke "f32.min" (f32.const 3225419776) (f32.const 3212836865)) (f32.const 3225419776))
+(assert_return (invoke "f32.min" (f32.const 3225419776) (f32.const 3226013659)) (f32.const 3226013659))
+(assert_return (invoke "f32.min" (f32.const 3225419776) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.min" (f32.const 4286578687) (f32.const 1)) (f32.const 4286578687))
+(assert_return (invoke "f32.min" (f32.const 4286578687) (f32.const 1065353215)) (f32.const 4286578687))
+(assert_return (invoke "f32.min" (f32.const 4286578687) (f32.const 2139095039)) (f32.const 4286578687))
+(assert_return (invoke "f32.min" (f32.const 4286578687) (f32.const 2147483648)) (f32.const 4286578687))
+(assert_return (invoke "f32.min" (f32.const 4286578687) (f32.const 3212836865)) (f32.const 4286578687))
+(assert_return (invoke "f32.min" (f32.const 4286578687) (f32.const 3226013659)) (f32.const 4286578687))
+(assert_return (invoke "f32.min" (f32.const 4286578687) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.min" (f32.const 3414163457) (f32.const 1)) (f32.const 3414163457))
+(assert_return (invoke "f32.min" (f32.const 3414163457) (f32.const 1065353215)) (f32.const 3414163457))
+(assert_return (invoke "f32.min" (f32.const 3414163457) (f32.const 2139095039)) (f32.const 3414163457))
+(assert_return (invoke "f32.min" (f32.const 3414163457) (f32.const 2147483648)) (f32.const 3414163457))
+(assert_return (invoke "f32.min" (f32.const 3414163457) (f32.const 3212836865)) (f32.const 3414163457))
+(assert_return (invoke "f32.min" (f32.const 3414163457) (f32.const 3226013659)) (f32.const 3414163457))
+(assert_return (invoke "f32.min" (f32.const 3414163457) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.min" (f32.const 680900594) (f32.const 4171662687)) (f32.const 4171662687))
+(assert_return (invoke "f32.min" (f32.const 705271417) (f32.const 418254703)) (f32.const 418254703))
+(assert_return (invoke "f32.min" (f32.const 2415460139) (f32.const 771546310)) (f32.const 2415460139))
+(assert_return (invoke "f32.min" (f32.const 1220818499) (f32.const 1011259890)) (f32.const 1011259890))
+(assert_return (invoke "f32.min" (f32.const 1105672583) (f32.const 3108934134)) (f32.const 3108934134))
+(assert_return (invoke "f32.min" (f32.const 2733415681) (f32.const 3286807080)) (f32.const 3286807080))
+(assert_return (invoke "f32.min" (f32.const 2322677663) (f32.const 110004208)) (f32.const 2322677663))
+(assert_return (invoke "f32.min" (f32.const 3225593563) (f32.const 590707196)) (f32.const 3225593563))
+(assert_return (invoke "f32.min" (f32.const 907500556) (f32.const 1144622503)) (f32.const 907500556))
+(assert_return (invoke "f32.min" (f32.const 4017889717) (f32.const 1167853017)) (f32.const 4017889717))
+(assert_return (invoke "f32.min" (f32.const 798159681) (f32.const 3166508290)) (f32.const 3166508290))
+(assert_return (invoke "f32.min" (f32.const 1043186459) (f32.const 3000410944)) (f32.const 3000410944))
+(assert_return (invoke "f32.min" (f32.const 2688881088) (f32.const 988820966)) (f32.const 2688881088))
+(assert_return (invoke "f32.min" (f32.const 3436018565) (f32.const 3077580968)) (f32.const 3436018565))
+(assert_return (invoke "f32.min" (f32.const 4100817496) (f32.const 2338355217)) (f32.const 4100817496))
+(assert_return (invoke "f32.min" (f32.const 935282864) (f32.const 1465652157)) (f32.const 935282864))
+(assert_return (invoke "f32.min" (f32.const 4026948710) (f32.const 1032874969)) (f32.const 4026948710))
+(assert_return (invoke "f32.min" (f32.const 1830300582) (f32.const 1909931926)) (f32.const 1830300582))
+(assert_return (invoke "f32.min" (f32.const 1377319453) (f32.const 1264513756)) (f32.const 1264513756))
+(assert_return (invoke "f32.min" (f32.const 3346947095) (f32.const 3822778500)) (f32.const 3822778500))
+(assert_return (invoke "f32.min" (f32.const 999373065) (f32.const 984661361)) (f32.const 984661361))
+(assert_return (invoke "f32.min" (f32.const 1811338251) (f32.const 3112344189)) (f32.const 3112344189))
+(assert_return (invoke "f32.min" (f32.const 1165068064) (f32.const 4124132390)) (f32.const 4124132390))
+(assert_return (invoke "f32.min" (f32.const 3072844392) (f32.const 2480015734)) (f32.const 3072844392))
+(assert_return (invoke "f32.min" (f32.const 4152014617) (f32.const 4270263951)) (f32.const 4270263951))
+(assert_return (invoke "f32.min" (f32.const 2230558058) (f32.const 3206559199)) (f32.const 3206559199))
+(assert_return (invoke "f32.min" (f32.const 966497675) (f32.const 3234265648)) (f32.const 3234265648))
+(assert_return (invoke "f32.min" (f32.const 2261336891) (f32.const 3537774875)) (f32.const 3537774875))
+(assert_return (invoke "f32.min" (f32.const 845964379) (f32.const 3754696903)) (f32.const 3754696903))
+(assert_return (invoke "f32.min" (f32.const 2930401734) (f32.const 3664539943)) (f32.const 3664539943))
+(assert_return (invoke "f32.min" (f32.const 3354989533) (f32.const 3308750451)) (f32.const 3354989533))
+(assert_return (invoke "f32.min" (f32.const 1146700400) (f32.const 2529402452)) (f32.const 2529402452))
+(assert_return (invoke "f32.min" (f32.const 1312437196) (f32.const 887418060)) (f32.const 887418060))
+(assert_return (invoke "f32.min" (f32.const 3983037509) (f32.const 1194588430)) (f32.const 3983037509))
+(assert_return (invoke "f32.min" (f32.const 3107824316) (f32.const 3284079721)) (f32.const 3284079721))
+(assert_return (invoke "f32.min" (f32.const 3099523473) (f32.const 3183487355)) (f32.const 3183487355))
+(assert_return (invoke "f32.min" (f32.const 2564865427) (f32.const 1215020862)) (f32.const 2564865427))
+(assert_return (invoke "f32.min" (f32.const 2790716851) (f32.const 895349828)) (f32.const 2790716851))
+(assert_return (invoke "f32.min" (f32.const 1474094426) (f32.const 3655158796)) (f32.const 3655158796))
+(assert_return (invoke "f32.min" (f32.const 39993021) (f32.const 2515020368)) (f32.const 2515020368))
+(assert_return (invoke "f32.max" (f32.const 0) (f32.const 1)) (f32.const 1))
+(assert_return (invoke "f32.max" (f32.const 0) (f32.const 1065353215)) (f32.const 1065353215))
+(assert_return (invoke "f32.max" (f32.const 0) (f32.const 2139095039)) (f32.const 2139095039))
+(assert_return (invoke "f32.max" (f32.const 0) (f32.const 2147483648)) (f32.const 0))
+(assert_return (invoke "f32.max" (f32.const 0) (f32.const 3212836865)) (f32.const 0))
+(assert_return (invoke "f32.max" (f32.const 0) (f32.const 3226013659)) (f32.const 0))
+(assert_return (invoke "f32.max" (f32.const 0) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.max" (f32.const 8388608) (f32.const 1)) (f32.const 8388608))
+(assert_return (invoke "f32.max" (f32.const 8388608) (f32.const 1065353215)) (f32.const 1065353215))
+(assert_return (invoke "f32.max" (f32.const 8388608) (f32.const 2139095039)) (f32.const 2139095039))
+(assert_return (invoke "f32.max" (f32.const 8388608) (f32.const 2147483648)) (f32.const 8388608))
+(assert_return (invoke "f32.max" (f32.const 8388608) (f32.const 3212836865)) (f32.const 8388608))
+(assert_return (invoke "f32.max" (f32.const 8388608) (f32.const 3226013659)) (f32.const 8388608))
+(assert_return (invoke "f32.max" (f32.const 8388608) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.max" (f32.const 1065353215) (f32.const 1)) (f32.const 1065353215))
+(assert_return (invoke "f32.max" (f32.const 1065353215) (f32.const 1065353215)) (f32.const 1065353215))
+(assert_return (invoke "f32.max" (f32.const 1065353215) (f32.const 2139095039)) (f32.const 2139095039))
+(assert_return (invoke "f32.max" (f32.const 1065353215) (f32.const 2147483648)) (f32.const 1065353215))
+(assert_return (invoke "f32.max" (f32.const 1065353215) (f32.const 3212836865)) (f32.const 1065353215))
+(assert_return (invoke "f32.max" (f32.const 1065353215) (f32.const 3226013659)) (f32.const 1065353215))
+(assert_return (invoke "f32.max" (f32.const 1065353215) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.max" (f32.const 1036831949) (f32.const 1)) (f32.const 1036831949))
+(assert_return (invoke "f32.max" (f32.const 1036831949) (f32.const 1065353215)) (f32.const 1065353215))
+(assert_return (invoke "f32.max" (f32.const 1036831949) (f32.const 2139095039)) (f32.const 2139095039))
+(assert_return (invoke "f32.max" (f32.const 1036831949) (f32.const 2147483648)) (f32.const 1036831949))
+(assert_return (invoke "f32.max" (f32.const 1036831949) (f32.const 3212836865)) (f32.const 1036831949))
+(assert_return (invoke "f32.max" (f32.const 1036831949) (f32.const 3226013659)) (f32.const 1036831949))
+(assert_return (invoke "f32.max" (f32.const 1036831949) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.max" (f32.const 2139095040) (f32.const 1)) (f32.const 2139095040))
+(assert_return (invoke "f32.max" (f32.const 2139095040) (f32.const 1065353215)) (f32.const 2139095040))
+(assert_return (invoke "f32.max" (f32.const 2139095040) (f32.const 2139095039)) (f32.const 2139095040))
+(assert_return (invoke "f32.max" (f32.const 2139095040) (f32.const 2147483648)) (f32.const 2139095040))
+(assert_return (invoke "f32.max" (f32.const 2139095040) (f32.const 3212836865)) (f32.const 2139095040))
+(assert_return (invoke "f32.max" (f32.const 2139095040) (f32.const 3226013659)) (f32.const 2139095040))
+(assert_return (invoke "f32.max" (f32.const 2139095040) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.max" (f32.const 2143289344) (f32.const 1)) (f32.const 2143289344))
+(assert_return (invoke "f32.max" (f32.const 2143289344) (f32.const 1065353215)) (f32.const 2143289344))
+(assert_return (invoke "f32.max" (f32.const 2143289344) (f32.const 2139095039)) (f32.const 2143289344))
+(assert_return (invoke "f32.max" (f32.const 2143289344) (f32.const 2147483648)) (f32.const 2143289344))
+(assert_return (invoke "f32.max" (f32.const 2143289344) (f32.const 3212836865)) (f32.const 2143289344))
+(assert_return (invoke "f32.max" (f32.const 2143289344) (f32.const 3226013659)) (f32.const 2143289344))
+(assert_return (invoke "f32.max" (f32.const 2143289344) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.max" (f32.const 2155872255) (f32.const 1)) (f32.const 1))
+(assert_return (invoke "f32.max" (f32.const 2155872255) (f32.const 1065353215)) (f32.const 1065353215))
+(assert_return (invoke "f32.max" (f32.const 2155872255) (f32.const 2139095039)) (f32.const 2139095039))
+(assert_return (invoke "f32.max" (f32.const 2155872255) (f32.const 2147483648)) (f32.const 2147483648))
+(assert_return (invoke "f32.max" (f32.const 2155872255) (f32.const 3212836865)) (f32.const 2155872255))
+(assert_return (invoke "f32.max" (f32.const 2155872255) (f32.const 3226013659)) (f32.const 2155872255))
+(assert_return (invoke "f32.max" (f32.const 2155872255) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.max" (f32.const 3212836865) (f32.const 1)) (f32.const 1))
+(assert_return (invoke "f32.max" (f32.const 3212836865) (f32.const 1065353215)) (f32.const 1065353215))
+(assert_return (invoke "f32.max" (f32.const 3212836865) (f32.const 2139095039)) (f32.const 2139095039))
+(assert_return (invoke "f32.max" (f32.const 3212836865) (f32.const 2147483648)) (f32.const 2147483648))
+(assert_return (invoke "f32.max" (f32.const 3212836865) (f32.const 3212836865)) (f32.const 3212836865))
+(assert_return (invoke "f32.max" (f32.const 3212836865) (f32.const 3226013659)) (f32.const 3212836865))
+(assert_return (invoke "f32.max" (f32.const 3212836865) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.max" (f32.const 3225419776) (f32.const 1)) (f32.const 1))
+(assert_return (invoke "f32.max" (f32.const 3225419776) (f32.const 1065353215)) (f32.const 1065353215))
+(assert_return (invoke "f32.max" (f32.const 3225419776) (f32.const 2139095039)) (f32.const 2139095039))
+(assert_return (invoke "f32.max" (f32.const 3225419776) (f32.const 2147483648)) (f32.const 2147483648))
+(assert_return (invoke "f32.max" (f32.const 3225419776) (f32.const 3212836865)) (f32.const 3212836865))
+(assert_return (invoke "f32.max" (f32.const 3225419776) (f32.const 3226013659)) (f32.const 3225419776))
+(assert_return (invoke "f32.max" (f32.const 3225419776) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.max" (f32.const 4286578687) (f32.const 1)) (f32.const 1))
+(assert_return (invoke "f32.max" (f32.const 4286578687) (f32.const 1065353215)) (f32.const 1065353215))
+(assert_return (invoke "f32.max" (f32.const 4286578687) (f32.const 2139095039)) (f32.const 2139095039))
+(assert_return (invoke "f32.max" (f32.const 4286578687) (f32.const 2147483648)) (f32.const 2147483648))
+(assert_return (invoke "f32.max" (f32.const 4286578687) (f32.const 3212836865)) (f32.const 3212836865))
+(assert_return (invoke "f32.max" (f32.const 4286578687) (f32.const 3226013659)) (f32.const 3226013659))
+(assert_return (invoke "f32.max" (f32.const 4286578687) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.max" (f32.const 3414163457) (f32.const 1)) (f32.const 1))
+(assert_return (invoke "f32.max" (f32.const 3414163457) (f32.const 1065353215)) (f32.const 1065353215))
+(assert_return (invoke "f32.max" (f32.const 3414163457) (f32.const 2139095039)) (f32.const 2139095039))
+(assert_return (invoke "f32.max" (f32.const 3414163457) (f32.const 2147483648)) (f32.const 2147483648))
+(assert_return (invoke "f32.max" (f32.const 3414163457) (f32.const 3212836865)) (f32.const 3212836865))
+(assert_return (invoke "f32.max" (f32.const 3414163457) (f32.const 3226013659)) (f32.const 3226013659))
+(assert_return (invoke "f32.max" (f32.const 3414163457) (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.max" (f32.const 3176163147) (f32.const 3365348561)) (f32.const 3176163147))
+(assert_return (invoke "f32.max" (f32.const 3593019699) (f32.const 3093647262)) (f32.const 3093647262))
+(assert_return (invoke "f32.max" (f32.const 3238225827) (f32.const 855136808)) (f32.const 855136808))
+(assert_return (invoke "f32.max" (f32.const 3473550758) (f32.const 318885404)) (f32.const 318885404))
+(assert_return (invoke "f32.max" (f32.const 3640755503) (f32.const 2981182200)) (f32.const 2981182200))
+(assert_return (invoke "f32.max" (f32.const 3388531926) (f32.const 2500676578)) (f32.const 2500676578))
+(assert_return (invoke "f32.max" (f32.const 1484510552) (f32.const 356692170)) (f32.const 1484510552))
+(assert_return (invoke "f32.max" (f32.const 3701488509) (f32.const 3936622755)) (f32.const 3701488509))
+(assert_return (invoke "f32.max" (f32.const 1135047101) (f32.const 2435924588)) (f32.const 1135047101))
+(assert_return (invoke "f32.max" (f32.const 3399265693) (f32.const 551609219)) (f32.const 551609219))
+(assert_return (invoke "f32.max" (f32.const 2115418473) (f32.const 2968025583)) (f32.const 2115418473))
+(assert_return (invoke "f32.max" (f32.const 1820401028) (f32.const 3350167384)) (f32.const 1820401028))
+(assert_return (invoke "f32.max" (f32.const 1822554541) (f32.const 2305850775)) (f32.const 1822554541))
+(assert_return (invoke "f32.max" (f32.const 1435644196) (f32.const 3409250492)) (f32.const 1435644196))
+(assert_return (invoke "f32.max" (f32.const 3110767652) (f32.const 1302816435)) (f32.const 1302816435))
+(assert_return (invoke "f32.max" (f32.const 354938184) (f32.const 240209660)) (f32.const 354938184))
+(assert_return (invoke "f32.max" (f32.const 1216956051) (f32.const 2580691956)) (f32.const 1216956051))
+(assert_return (invoke "f32.max" (f32.const 1235951684) (f32.const 3023069268)) (f32.const 1235951684))
+(assert_return (invoke "f32.max" (f32.const 3921484072) (f32.const 2298260886)) (f32.const 2298260886))
+(assert_return (invoke "f32.max" (f32.const 3366655287) (f32.const 134801491)) (f32.const 134801491))
+(assert_return (invoke "f32.max" (f32.const 1188969104) (f32.const 3736915342)) (f32.const 1188969104))
+(assert_return (invoke "f32.max" (f32.const 1052697556) (f32.const 941269500)) (f32.const 1052697556))
+(assert_return (invoke "f32.max" (f32.const 3299350530) (f32.const 4105957914)) (f32.const 3299350530))
+(assert_return (invoke "f32.max" (f32.const 1919768499) (f32.const 1048505585)) (f32.const 1919768499))
+(assert_return (invoke "f32.max" (f32.const 1211914440) (f32.const 1569319751)) (f32.const 1569319751))
+(assert_return (invoke "f32.max" (f32.const 2741886707) (f32.const 915554878)) (f32.const 915554878))
+(assert_return (invoke "f32.max" (f32.const 501059026) (f32.const 1356935320)) (f32.const 1356935320))
+(assert_return (invoke "f32.max" (f32.const 2307386166) (f32.const 623030202)) (f32.const 623030202))
+(assert_return (invoke "f32.max" (f32.const 268194640) (f32.const 263625722)) (f32.const 268194640))
+(assert_return (invoke "f32.max" (f32.const 3224622945) (f32.const 3052337471)) (f32.const 3052337471))
+(assert_return (invoke "f32.max" (f32.const 3020114755) (f32.const 3274985313)) (f32.const 3020114755))
+(assert_return (invoke "f32.max" (f32.const 3076144368) (f32.const 3533188725)) (f32.const 3076144368))
+(assert_return (invoke "f32.max" (f32.const 3226668940) (f32.const 2250449560)) (f32.const 2250449560))
+(assert_return (invoke "f32.max" (f32.const 1093848500) (f32.const 4026280321)) (f32.const 1093848500))
+(assert_return (invoke "f32.max" (f32.const 2902497169) (f32.const 627339183)) (f32.const 627339183))
+(assert_return (invoke "f32.max" (f32.const 1521045411) (f32.const 4003776455)) (f32.const 1521045411))
+(assert_return (invoke "f32.max" (f32.const 410104228) (f32.const 148250995)) (f32.const 410104228))
+(assert_return (invoke "f32.max" (f32.const 1027776511) (f32.const 945127510)) (f32.const 1027776511))
+(assert_return (invoke "f32.max" (f32.const 2814580434) (f32.const 1298617587)) (f32.const 1298617587))
+(assert_return (invoke "f32.max" (f32.const 375966247) (f32.const 2773714536)) (f32.const 375966247))
+(assert_return (invoke "f32.sqrt" (f32.const 0)) (f32.const 0))
+(assert_return (invoke "f32.sqrt" (f32.const 1)) (f32.const 439682291))
+(assert_return (invoke "f32.sqrt" (f32.const 8388607)) (f32.const 536870911))
+(assert_return (invoke "f32.sqrt" (f32.const 8388608)) (f32.const 536870912))
+(assert_return (invoke "f32.sqrt" (f32.const 1065353216)) (f32.const 1065353216))
+(assert_return (invoke "f32.sqrt" (f32.const 1065353217)) (f32.const 1065353216))
+(assert_return (invoke "f32.sqrt" (f32.const 1065353215)) (f32.const 1065353215))
+(assert_return (invoke "f32.sqrt" (f32.const 1056964608)) (f32.const 1060439283))
+(assert_return (invoke "f32.sqrt" (f32.const 1077936128)) (f32.const 1071494103))
+(assert_return (invoke "f32.sqrt" (f32.const 1036831949)) (f32.const 1050798235))
+(assert_return (invoke "f32.sqrt" (f32.const 1078530011)) (f32.const 1071833029))
+(assert_return (invoke "f32.sqrt" (f32.const 2139095039)) (f32.const 1602224127))
+(assert_return (invoke "f32.sqrt" (f32.const 2139095040)) (f32.const 2139095040))
+(assert_return (invoke "f32.sqrt" (f32.const 1258291201)) (f32.const 1161102580))
+(assert_return (invoke "f32.sqrt" (f32.const 1266679809)) (f32.const 1166016512))
+(assert_return (invoke "f32.sqrt" (f32.const 2143289344)) (f32.const 2143289344))
+(assert_return (invoke "f32.sqrt" (f32.const 2147483648)) (f32.const 2147483648))
+(assert_return (invoke "f32.sqrt" (f32.const 2147483649)) (f32.const 2143289344))
+(assert_return (invoke "f32.sqrt" (f32.const 2155872255)) (f32.const 2143289344))
+(assert_return (invoke "f32.sqrt" (f32.const 2155872256)) (f32.const 2143289344))
+(assert_return (invoke "f32.sqrt" (f32.const 3212836864)) (f32.const 2143289344))
+(assert_return (invoke "f32.sqrt" (f32.const 3212836865)) (f32.const 2143289344))
+(assert_return (invoke "f32.sqrt" (f32.const 3212836863)) (f32.const 2143289344))
+(assert_return (invoke "f32.sqrt" (f32.const 3204448256)) (f32.const 2143289344))
+(assert_return (invoke "f32.sqrt" (f32.const 3225419776)) (f32.const 2143289344))
+(assert_return (invoke "f32.sqrt" (f32.const 3184315597)) (f32.const 2143289344))
+(assert_return (invoke "f32.sqrt" (f32.const 3226013659)) (f32.const 2143289344))
+(assert_return (invoke "f32.sqrt" (f32.const 4286578687)) (f32.const 2143289344))
+(assert_return (invoke "f32.sqrt" (f32.const 4286578688)) (f32.const 2143289344))
+(assert_return (invoke "f32.sqrt" (f32.const 3405774849)) (f32.const 2143289344))
+(assert_return (invoke "f32.sqrt" (f32.const 3414163457)) (f32.const 2143289344))
+(assert_return (invoke "f32.sqrt" (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.sqrt" (f32.const 819276212)) (f32.const 941960808))
+(assert_return (invoke "f32.sqrt" (f32.const 481688137)) (f32.const 773365492))
+(assert_return (invoke "f32.sqrt" (f32.const 3220303843)) (f32.const 2143289344))
+(assert_return (invoke "f32.sqrt" (f32.const 524315544)) (f32.const 794685933))
+(assert_return (invoke "f32.sqrt" (f32.const 1837344406)) (f32.const 1451347970))
+(assert_return (invoke "f32.sqrt" (f32.const 1002826805)) (f32.const 1033841338))
+(assert_return (invoke "f32.sqrt" (f32.const 3003928516)) (f32.const 2143289344))
+(assert_return (invoke "f32.sqrt" (f32.const 1321354596)) (f32.const 1193128216))
+(assert_return (invoke "f32.sqrt" (f32.const 546972621)) (f32.const 806123154))
+(assert_return (invoke "f32.sqrt" (f32.const 1466930765)) (f32.const 1266133082))
+(assert_return (invoke "f32.sqrt" (f32.const 1151518436)) (f32.const 1108367434))
+(assert_return (invoke "f32.sqrt" (f32.const 1016529597)) (f32.const 1040910261))
+(assert_return (invoke "f32.sqrt" (f32.const 35321556)) (f32.const 549924069))
+(assert_return (invoke "f32.sqrt" (f32.const 118468678)) (f32.const 591383250))
+(assert_return (invoke "f32.sqrt" (f32.const 2016689995)) (f32.const 1540804438))
+(assert_return (invoke "f32.sqrt" (f32.const 1140377968)) (f32.const 1102213997))
+(assert_return (invoke "f32.sqrt" (f32.const 617764585)) (f32.const 841225580))
+(assert_return (invoke "f32.sqrt" (f32.const 741725082)) (f32.const 903331770))
+(assert_return (invoke "f32.sqrt" (f32.const 2906692726)) (f32.const 2143289344))
+(assert_return (invoke "f32.sqrt" (f32.const 887628201)) (f32.const 975986629))
+(assert_return (invoke "f32.sqrt" (f32.const 841348729)) (f32.const 953032538))
+(assert_return (invoke "f32.sqrt" (f32.const 3061071352)) (f32.const 2143289344))
+(assert_return (invoke "f32.sqrt" (f32.const 29405227)) (f32.const 547163212))
+(assert_return (invoke "f32.sqrt" (f32.const 1645629136)) (f32.const 1355032833))
+(assert_return (invoke "f32.sqrt" (f32.const 2145678696)) (f32.const 2143289344))
+(assert_return (invoke "f32.sqrt" (f32.const 2273089114)) (f32.const 2143289344))
+(assert_return (invoke "f32.sqrt" (f32.const 638045123)) (f32.const 851080015))
+(assert_return (invoke "f32.sqrt" (f32.const 2927360223)) (f32.const 2143289344))
+(assert_return (invoke "f32.sqrt" (f32.const 3347394806)) (f32.const 2143289344))
+(assert_return (invoke "f32.sqrt" (f32.const 1300653385)) (f32.const 1183000746))
+(assert_return (invoke "f32.ceil" (f32.const 0)) (f32.const 0))
+(assert_return (invoke "f32.ceil" (f32.const 1)) (f32.const 1065353216))
+(assert_return (invoke "f32.ceil" (f32.const 8388607)) (f32.const 1065353216))
+(assert_return (invoke "f32.ceil" (f32.const 8388608)) (f32.const 1065353216))
+(assert_return (invoke "f32.ceil" (f32.const 1065353216)) (f32.const 1065353216))
+(assert_return (invoke "f32.ceil" (f32.const 1065353217)) (f32.const 1073741824))
+(assert_return (invoke "f32.ceil" (f32.const 1065353215)) (f32.const 1065353216))
+(assert_return (invoke "f32.ceil" (f32.const 1056964608)) (f32.const 1065353216))
+(assert_return (invoke "f32.ceil" (f32.const 1077936128)) (f32.const 1077936128))
+(assert_return (invoke "f32.ceil" (f32.const 1036831949)) (f32.const 1065353216))
+(assert_return (invoke "f32.ceil" (f32.const 1078530011)) (f32.const 1082130432))
+(assert_return (invoke "f32.ceil" (f32.const 2139095039)) (f32.const 2139095039))
+(assert_return (invoke "f32.ceil" (f32.const 2139095040)) (f32.const 2139095040))
+(assert_return (invoke "f32.ceil" (f32.const 1258291201)) (f32.const 1258291201))
+(assert_return (invoke "f32.ceil" (f32.const 1266679809)) (f32.const 1266679809))
+(assert_return (invoke "f32.ceil" (f32.const 2143289344)) (f32.const 2143289344))
+(assert_return (invoke "f32.ceil" (f32.const 2147483648)) (f32.const 2147483648))
+(assert_return (invoke "f32.ceil" (f32.const 2147483649)) (f32.const 2147483648))
+(assert_return (invoke "f32.ceil" (f32.const 2155872255)) (f32.const 2147483648))
+(assert_return (invoke "f32.ceil" (f32.const 2155872256)) (f32.const 2147483648))
+(assert_return (invoke "f32.ceil" (f32.const 3212836864)) (f32.const 3212836864))
+(assert_return (invoke "f32.ceil" (f32.const 3212836865)) (f32.const 3212836864))
+(assert_return (invoke "f32.ceil" (f32.const 3212836863)) (f32.const 2147483648))
+(assert_return (invoke "f32.ceil" (f32.const 3204448256)) (f32.const 2147483648))
+(assert_return (invoke "f32.ceil" (f32.const 3225419776)) (f32.const 3225419776))
+(assert_return (invoke "f32.ceil" (f32.const 3184315597)) (f32.const 2147483648))
+(assert_return (invoke "f32.ceil" (f32.const 3226013659)) (f32.const 3225419776))
+(assert_return (invoke "f32.ceil" (f32.const 4286578687)) (f32.const 4286578687))
+(assert_return (invoke "f32.ceil" (f32.const 4286578688)) (f32.const 4286578688))
+(assert_return (invoke "f32.ceil" (f32.const 3405774849)) (f32.const 3405774849))
+(assert_return (invoke "f32.ceil" (f32.const 3414163457)) (f32.const 3414163457))
+(assert_return (invoke "f32.ceil" (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.ceil" (f32.const 4277901539)) (f32.const 4277901539))
+(assert_return (invoke "f32.ceil" (f32.const 261150861)) (f32.const 1065353216))
+(assert_return (invoke "f32.ceil" (f32.const 1271782653)) (f32.const 1271782653))
+(assert_return (invoke "f32.ceil" (f32.const 330079953)) (f32.const 1065353216))
+(assert_return (invoke "f32.ceil" (f32.const 866575630)) (f32.const 1065353216))
+(assert_return (invoke "f32.ceil" (f32.const 956145558)) (f32.const 1065353216))
+(assert_return (invoke "f32.ceil" (f32.const 3116252913)) (f32.const 2147483648))
+(assert_return (invoke "f32.ceil" (f32.const 3110947788)) (f32.const 2147483648))
+(assert_return (invoke "f32.ceil" (f32.const 3178709755)) (f32.const 2147483648))
+(assert_return (invoke "f32.ceil" (f32.const 4106733352)) (f32.const 4106733352))
+(assert_return (invoke "f32.ceil" (f32.const 1124381441)) (f32.const 1124401152))
+(assert_return (invoke "f32.ceil" (f32.const 3010850301)) (f32.const 2147483648))
+(assert_return (invoke "f32.ceil" (f32.const 2797327674)) (f32.const 2147483648))
+(assert_return (invoke "f32.ceil" (f32.const 833291986)) (f32.const 1065353216))
+(assert_return (invoke "f32.ceil" (f32.const 1043894719)) (f32.const 1065353216))
+(assert_return (invoke "f32.ceil" (f32.const 3337946216)) (f32.const 3337946112))
+(assert_return (invoke "f32.ceil" (f32.const 1260583174)) (f32.const 1260583174))
+(assert_return (invoke "f32.ceil" (f32.const 2009439218)) (f32.const 2009439218))
+(assert_return (invoke "f32.ceil" (f32.const 1264927996)) (f32.const 1264927996))
+(assert_return (invoke "f32.ceil" (f32.const 3217803372)) (f32.const 3212836864))
+(assert_return (invoke "f32.ceil" (f32.const 1597068460)) (f32.const 1597068460))
+(assert_return (invoke "f32.ceil" (f32.const 3239090042)) (f32.const 3239051264))
+(assert_return (invoke "f32.ceil" (f32.const 79204616)) (f32.const 1065353216))
+(assert_return (invoke "f32.ceil" (f32.const 3047711719)) (f32.const 2147483648))
+(assert_return (invoke "f32.ceil" (f32.const 2413720159)) (f32.const 2147483648))
+(assert_return (invoke "f32.ceil" (f32.const 3031008522)) (f32.const 2147483648))
+(assert_return (invoke "f32.ceil" (f32.const 1072444810)) (f32.const 1073741824))
+(assert_return (invoke "f32.ceil" (f32.const 1766692666)) (f32.const 1766692666))
+(assert_return (invoke "f32.ceil" (f32.const 1185237722)) (f32.const 1185238016))
+(assert_return (invoke "f32.ceil" (f32.const 114701214)) (f32.const 1065353216))
+(assert_return (invoke "f32.ceil" (f32.const 3227516928)) (f32.const 3225419776))
+(assert_return (invoke "f32.ceil" (f32.const 3225419776)) (f32.const 3225419776))
+(assert_return (invoke "f32.ceil" (f32.const 3223322624)) (f32.const 3221225472))
+(assert_return (invoke "f32.ceil" (f32.const 3221225472)) (f32.const 3221225472))
+(assert_return (invoke "f32.ceil" (f32.const 3217031168)) (f32.const 3212836864))
+(assert_return (invoke "f32.ceil" (f32.const 3212836864)) (f32.const 3212836864))
+(assert_return (invoke "f32.ceil" (f32.const 3204448256)) (f32.const 2147483648))
+(assert_return (invoke "f32.ceil" (f32.const 0)) (f32.const 0))
+(assert_return (invoke "f32.ceil" (f32.const 1056964608)) (f32.const 1065353216))
+(assert_return (invoke "f32.ceil" (f32.const 1065353216)) (f32.const 1065353216))
+(assert_return (invoke "f32.ceil" (f32.const 1069547520)) (f32.const 1073741824))
+(assert_return (invoke "f32.ceil" (f32.const 1073741824)) (f32.const 1073741824))
+(assert_return (invoke "f32.ceil" (f32.const 1075838976)) (f32.const 1077936128))
+(assert_return (invoke "f32.ceil" (f32.const 1077936128)) (f32.const 1077936128))
+(assert_return (invoke "f32.ceil" (f32.const 1080033280)) (f32.const 1082130432))
+(assert_return (invoke "f32.floor" (f32.const 0)) (f32.const 0))
+(assert_return (invoke "f32.floor" (f32.const 1)) (f32.const 0))
+(assert_return (invoke "f32.floor" (f32.const 8388607)) (f32.const 0))
+(assert_return (invoke "f32.floor" (f32.const 8388608)) (f32.const 0))
+(assert_return (invoke "f32.floor" (f32.const 1065353216)) (f32.const 1065353216))
+(assert_return (invoke "f32.floor" (f32.const 1065353217)) (f32.const 1065353216))
+(assert_return (invoke "f32.floor" (f32.const 1065353215)) (f32.const 0))
+(assert_return (invoke "f32.floor" (f32.const 1056964608)) (f32.const 0))
+(assert_return (invoke "f32.floor" (f32.const 1077936128)) (f32.const 1077936128))
+(assert_return (invoke "f32.floor" (f32.const 1036831949)) (f32.const 0))
+(assert_return (invoke "f32.floor" (f32.const 1078530011)) (f32.const 1077936128))
+(assert_return (invoke "f32.floor" (f32.const 2139095039)) (f32.const 2139095039))
+(assert_return (invoke "f32.floor" (f32.const 2139095040)) (f32.const 2139095040))
+(assert_return (invoke "f32.floor" (f32.const 1258291201)) (f32.const 1258291201))
+(assert_return (invoke "f32.floor" (f32.const 1266679809)) (f32.const 1266679809))
+(assert_return (invoke "f32.floor" (f32.const 2143289344)) (f32.const 2143289344))
+(assert_return (invoke "f32.floor" (f32.const 2147483648)) (f32.const 2147483648))
+(assert_return (invoke "f32.floor" (f32.const 2147483649)) (f32.const 3212836864))
+(assert_return (invoke "f32.floor" (f32.const 2155872255)) (f32.const 3212836864))
+(assert_return (invoke "f32.floor" (f32.const 2155872256)) (f32.const 3212836864))
+(assert_return (invoke "f32.floor" (f32.const 3212836864)) (f32.const 3212836864))
+(assert_return (invoke "f32.floor" (f32.const 3212836865)) (f32.const 3221225472))
+(assert_return (invoke "f32.floor" (f32.const 3212836863)) (f32.const 3212836864))
+(assert_return (invoke "f32.floor" (f32.const 3204448256)) (f32.const 3212836864))
+(assert_return (invoke "f32.floor" (f32.const 3225419776)) (f32.const 3225419776))
+(assert_return (invoke "f32.floor" (f32.const 3184315597)) (f32.const 3212836864))
+(assert_return (invoke "f32.floor" (f32.const 3226013659)) (f32.const 3229614080))
+(assert_return (invoke "f32.floor" (f32.const 4286578687)) (f32.const 4286578687))
+(assert_return (invoke "f32.floor" (f32.const 4286578688)) (f32.const 4286578688))
+(assert_return (invoke "f32.floor" (f32.const 3405774849)) (f32.const 3405774849))
+(assert_return (invoke "f32.floor" (f32.const 3414163457)) (f32.const 3414163457))
+(assert_return (invoke "f32.floor" (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.floor" (f32.const 1028695882)) (f32.const 0))
+(assert_return (invoke "f32.floor" (f32.const 1445450493)) (f32.const 1445450493))
+(assert_return (invoke "f32.floor" (f32.const 1269290917)) (f32.const 1269290917))
+(assert_return (invoke "f32.floor" (f32.const 2930970795)) (f32.const 3212836864))
+(assert_return (invoke "f32.floor" (f32.const 474038401)) (f32.const 0))
+(assert_return (invoke "f32.floor" (f32.const 3611995543)) (f32.const 3611995543))
+(assert_return (invoke "f32.floor" (f32.const 1092253380)) (f32.const 1091567616))
+(assert_return (invoke "f32.floor" (f32.const 2363123293)) (f32.const 3212836864))
+(assert_return (invoke "f32.floor" (f32.const 904352227)) (f32.const 0))
+(assert_return (invoke "f32.floor" (f32.const 700869752)) (f32.const 0))
+(assert_return (invoke "f32.floor" (f32.const 1854524855)) (f32.const 1854524855))
+(assert_return (invoke "f32.floor" (f32.const 2935546167)) (f32.const 3212836864))
+(assert_return (invoke "f32.floor" (f32.const 772373007)) (f32.const 0))
+(assert_return (invoke "f32.floor" (f32.const 1686079686)) (f32.const 1686079686))
+(assert_return (invoke "f32.floor" (f32.const 3365836686)) (f32.const 3365836704))
+(assert_return (invoke "f32.floor" (f32.const 4286020485)) (f32.const 4286020485))
+(assert_return (invoke "f32.floor" (f32.const 3277621798)) (f32.const 3277651968))
+(assert_return (invoke "f32.floor" (f32.const 4065122426)) (f32.const 4065122426))
+(assert_return (invoke "f32.floor" (f32.const 907590374)) (f32.const 0))
+(assert_return (invoke "f32.floor" (f32.const 2998516056)) (f32.const 3212836864))
+(assert_return (invoke "f32.floor" (f32.const 416765271)) (f32.const 0))
+(assert_return (invoke "f32.floor" (f32.const 1034379856)) (f32.const 0))
+(assert_return (invoke "f32.floor" (f32.const 1184084809)) (f32.const 1184084480))
+(assert_return (invoke "f32.floor" (f32.const 3205501779)) (f32.const 3212836864))
+(assert_return (invoke "f32.floor" (f32.const 1200356137)) (f32.const 1200356096))
+(assert_return (invoke "f32.floor" (f32.const 639022905)) (f32.const 0))
+(assert_return (invoke "f32.floor" (f32.const 2052267694)) (f32.const 2052267694))
+(assert_return (invoke "f32.floor" (f32.const 1297600189)) (f32.const 1297600189))
+(assert_return (invoke "f32.floor" (f32.const 2941422468)) (f32.const 3212836864))
+(assert_return (invoke "f32.floor" (f32.const 3037780085)) (f32.const 3212836864))
+(assert_return (invoke "f32.floor" (f32.const 3227516928)) (f32.const 3229614080))
+(assert_return (invoke "f32.floor" (f32.const 3225419776)) (f32.const 3225419776))
+(assert_return (invoke "f32.floor" (f32.const 3223322624)) (f32.const 3225419776))
+(assert_return (invoke "f32.floor" (f32.const 3221225472)) (f32.const 3221225472))
+(assert_return (invoke "f32.floor" (f32.const 3217031168)) (f32.const 3221225472))
+(assert_return (invoke "f32.floor" (f32.const 3212836864)) (f32.const 3212836864))
+(assert_return (invoke "f32.floor" (f32.const 3204448256)) (f32.const 3212836864))
+(assert_return (invoke "f32.floor" (f32.const 0)) (f32.const 0))
+(assert_return (invoke "f32.floor" (f32.const 1056964608)) (f32.const 0))
+(assert_return (invoke "f32.floor" (f32.const 1065353216)) (f32.const 1065353216))
+(assert_return (invoke "f32.floor" (f32.const 1069547520)) (f32.const 1065353216))
+(assert_return (invoke "f32.floor" (f32.const 1073741824)) (f32.const 1073741824))
+(assert_return (invoke "f32.floor" (f32.const 1075838976)) (f32.const 1073741824))
+(assert_return (invoke "f32.floor" (f32.const 1077936128)) (f32.const 1077936128))
+(assert_return (invoke "f32.floor" (f32.const 1080033280)) (f32.const 1077936128))
+(assert_return (invoke "f32.trunc" (f32.const 0)) (f32.const 0))
+(assert_return (invoke "f32.trunc" (f32.const 1)) (f32.const 0))
+(assert_return (invoke "f32.trunc" (f32.const 8388607)) (f32.const 0))
+(assert_return (invoke "f32.trunc" (f32.const 8388608)) (f32.const 0))
+(assert_return (invoke "f32.trunc" (f32.const 1065353216)) (f32.const 1065353216))
+(assert_return (invoke "f32.trunc" (f32.const 1065353217)) (f32.const 1065353216))
+(assert_return (invoke "f32.trunc" (f32.const 1065353215)) (f32.const 0))
+(assert_return (invoke "f32.trunc" (f32.const 1056964608)) (f32.const 0))
+(assert_return (invoke "f32.trunc" (f32.const 1077936128)) (f32.const 1077936128))
+(assert_return (invoke "f32.trunc" (f32.const 1036831949)) (f32.const 0))
+(assert_return (invoke "f32.trunc" (f32.const 1078530011)) (f32.const 1077936128))
+(assert_return (invoke "f32.trunc" (f32.const 2139095039)) (f32.const 2139095039))
+(assert_return (invoke "f32.trunc" (f32.const 2139095040)) (f32.const 2139095040))
+(assert_return (invoke "f32.trunc" (f32.const 1258291201)) (f32.const 1258291201))
+(assert_return (invoke "f32.trunc" (f32.const 1266679809)) (f32.const 1266679809))
+(assert_return (invoke "f32.trunc" (f32.const 2143289344)) (f32.const 2143289344))
+(assert_return (invoke "f32.trunc" (f32.const 2147483648)) (f32.const 2147483648))
+(assert_return (invoke "f32.trunc" (f32.const 2147483649)) (f32.const 2147483648))
+(assert_return (invoke "f32.trunc" (f32.const 2155872255)) (f32.const 2147483648))
+(assert_return (invoke "f32.trunc" (f32.const 2155872256)) (f32.const 2147483648))
+(assert_return (invoke "f32.trunc" (f32.const 3212836864)) (f32.const 3212836864))
+(assert_return (invoke "f32.trunc" (f32.const 3212836865)) (f32.const 3212836864))
+(assert_return (invoke "f32.trunc" (f32.const 3212836863)) (f32.const 2147483648))
+(assert_return (invoke "f32.trunc" (f32.const 3204448256)) (f32.const 2147483648))
+(assert_return (invoke "f32.trunc" (f32.const 3225419776)) (f32.const 3225419776))
+(assert_return (invoke "f32.trunc" (f32.const 3184315597)) (f32.const 2147483648))
+(assert_return (invoke "f32.trunc" (f32.const 3226013659)) (f32.const 3225419776))
+(assert_return (invoke "f32.trunc" (f32.const 4286578687)) (f32.const 4286578687))
+(assert_return (invoke "f32.trunc" (f32.const 4286578688)) (f32.const 4286578688))
+(assert_return (invoke "f32.trunc" (f32.const 3405774849)) (f32.const 3405774849))
+(assert_return (invoke "f32.trunc" (f32.const 3414163457)) (f32.const 3414163457))
+(assert_return (invoke "f32.trunc" (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.trunc" (f32.const 3005610840)) (f32.const 2147483648))
+(assert_return (invoke "f32.trunc" (f32.const 716787562)) (f32.const 0))
+(assert_return (invoke "f32.trunc" (f32.const 3475738131)) (f32.const 3475738131))
+(assert_return (invoke "f32.trunc" (f32.const 1201931522)) (f32.const 1201931520))
+(assert_return (invoke "f32.trunc" (f32.const 3069070005)) (f32.const 2147483648))
+(assert_return (invoke "f32.trunc" (f32.const 2130183225)) (f32.const 2130183225))
+(assert_return (invoke "f32.trunc" (f32.const 2053272997)) (f32.const 2053272997))
+(assert_return (invoke "f32.trunc" (f32.const 598790699)) (f32.const 0))
+(assert_return (invoke "f32.trunc" (f32.const 3907876422)) (f32.const 3907876422))
+(assert_return (invoke "f32.trunc" (f32.const 1320805799)) (f32.const 1320805799))
+(assert_return (invoke "f32.trunc" (f32.const 956211589)) (f32.const 0))
+(assert_return (invoke "f32.trunc" (f32.const 1433480804)) (f32.const 1433480804))
+(assert_return (invoke "f32.trunc" (f32.const 3075210311)) (f32.const 2147483648))
+(assert_return (invoke "f32.trunc" (f32.const 880222295)) (f32.const 0))
+(assert_return (invoke "f32.trunc" (f32.const 1314628322)) (f32.const 1314628322))
+(assert_return (invoke "f32.trunc" (f32.const 3298200020)) (f32.const 3298197504))
+(assert_return (invoke "f32.trunc" (f32.const 2835651201)) (f32.const 2147483648))
+(assert_return (invoke "f32.trunc" (f32.const 3272254061)) (f32.const 3272212480))
+(assert_return (invoke "f32.trunc" (f32.const 2247758942)) (f32.const 2147483648))
+(assert_return (invoke "f32.trunc" (f32.const 3215040197)) (f32.const 3212836864))
+(assert_return (invoke "f32.trunc" (f32.const 3457745775)) (f32.const 3457745775))
+(assert_return (invoke "f32.trunc" (f32.const 1598540856)) (f32.const 1598540856))
+(assert_return (invoke "f32.trunc" (f32.const 1251953513)) (f32.const 1251953512))
+(assert_return (invoke "f32.trunc" (f32.const 862838850)) (f32.const 0))
+(assert_return (invoke "f32.trunc" (f32.const 1016803457)) (f32.const 0))
+(assert_return (invoke "f32.trunc" (f32.const 3834074411)) (f32.const 3834074411))
+(assert_return (invoke "f32.trunc" (f32.const 3335323418)) (f32.const 3335323136))
+(assert_return (invoke "f32.trunc" (f32.const 2410173077)) (f32.const 2147483648))
+(assert_return (invoke "f32.trunc" (f32.const 4050833210)) (f32.const 4050833210))
+(assert_return (invoke "f32.trunc" (f32.const 1505187207)) (f32.const 1505187207))
+(assert_return (invoke "f32.trunc" (f32.const 3227516928)) (f32.const 3225419776))
+(assert_return (invoke "f32.trunc" (f32.const 3225419776)) (f32.const 3225419776))
+(assert_return (invoke "f32.trunc" (f32.const 3223322624)) (f32.const 3221225472))
+(assert_return (invoke "f32.trunc" (f32.const 3221225472)) (f32.const 3221225472))
+(assert_return (invoke "f32.trunc" (f32.const 3217031168)) (f32.const 3212836864))
+(assert_return (invoke "f32.trunc" (f32.const 3212836864)) (f32.const 3212836864))
+(assert_return (invoke "f32.trunc" (f32.const 3204448256)) (f32.const 2147483648))
+(assert_return (invoke "f32.trunc" (f32.const 0)) (f32.const 0))
+(assert_return (invoke "f32.trunc" (f32.const 1056964608)) (f32.const 0))
+(assert_return (invoke "f32.trunc" (f32.const 1065353216)) (f32.const 1065353216))
+(assert_return (invoke "f32.trunc" (f32.const 1069547520)) (f32.const 1065353216))
+(assert_return (invoke "f32.trunc" (f32.const 1073741824)) (f32.const 1073741824))
+(assert_return (invoke "f32.trunc" (f32.const 1075838976)) (f32.const 1073741824))
+(assert_return (invoke "f32.trunc" (f32.const 1077936128)) (f32.const 1077936128))
+(assert_return (invoke "f32.trunc" (f32.const 1080033280)) (f32.const 1077936128))
+(assert_return (invoke "f32.nearest" (f32.const 0)) (f32.const 0))
+(assert_return (invoke "f32.nearest" (f32.const 1)) (f32.const 0))
+(assert_return (invoke "f32.nearest" (f32.const 8388607)) (f32.const 0))
+(assert_return (invoke "f32.nearest" (f32.const 8388608)) (f32.const 0))
+(assert_return (invoke "f32.nearest" (f32.const 1065353216)) (f32.const 1065353216))
+(assert_return (invoke "f32.nearest" (f32.const 1065353217)) (f32.const 1065353216))
+(assert_return (invoke "f32.nearest" (f32.const 1065353215)) (f32.const 1065353216))
+(assert_return (invoke "f32.nearest" (f32.const 1056964608)) (f32.const 0))
+(assert_return (invoke "f32.nearest" (f32.const 1077936128)) (f32.const 1077936128))
+(assert_return (invoke "f32.nearest" (f32.const 1036831949)) (f32.const 0))
+(assert_return (invoke "f32.nearest" (f32.const 1078530011)) (f32.const 1077936128))
+(assert_return (invoke "f32.nearest" (f32.const 2139095039)) (f32.const 2139095039))
+(assert_return (invoke "f32.nearest" (f32.const 2139095040)) (f32.const 2139095040))
+(assert_return (invoke "f32.nearest" (f32.const 1258291201)) (f32.const 1258291201))
+(assert_return (invoke "f32.nearest" (f32.const 1266679809)) (f32.const 1266679809))
+(assert_return (invoke "f32.nearest" (f32.const 2143289344)) (f32.const 2143289344))
+(assert_return (invoke "f32.nearest" (f32.const 2147483648)) (f32.const 2147483648))
+(assert_return (invoke "f32.nearest" (f32.const 2147483649)) (f32.const 2147483648))
+(assert_return (invoke "f32.nearest" (f32.const 2155872255)) (f32.const 2147483648))
+(assert_return (invoke "f32.nearest" (f32.const 2155872256)) (f32.const 2147483648))
+(assert_return (invoke "f32.nearest" (f32.const 3212836864)) (f32.const 3212836864))
+(assert_return (invoke "f32.nearest" (f32.const 3212836865)) (f32.const 3212836864))
+(assert_return (invoke "f32.nearest" (f32.const 3212836863)) (f32.const 3212836864))
+(assert_return (invoke "f32.nearest" (f32.const 3204448256)) (f32.const 2147483648))
+(assert_return (invoke "f32.nearest" (f32.const 3225419776)) (f32.const 3225419776))
+(assert_return (invoke "f32.nearest" (f32.const 3184315597)) (f32.const 2147483648))
+(assert_return (invoke "f32.nearest" (f32.const 3226013659)) (f32.const 3225419776))
+(assert_return (invoke "f32.nearest" (f32.const 4286578687)) (f32.const 4286578687))
+(assert_return (invoke "f32.nearest" (f32.const 4286578688)) (f32.const 4286578688))
+(assert_return (invoke "f32.nearest" (f32.const 3405774849)) (f32.const 3405774849))
+(assert_return (invoke "f32.nearest" (f32.const 3414163457)) (f32.const 3414163457))
+(assert_return (invoke "f32.nearest" (f32.const 4290772992)) (f32.const 2143289344))
+(assert_return (invoke "f32.nearest" (f32.const 2770536733)) (f32.const 2147483648))
+(assert_return (invoke "f32.nearest" (f32.const 2718142037)) (f32.const 2147483648))
+(assert_return (invoke "f32.nearest" (f32.const 1401794742)) (f32.const 1401794742))
+(assert_return (invoke "f32.nearest" (f32.const 383902837)) (f32.const 0))
+(assert_return (invoke "f32.nearest" (f32.const 1235400846)) (f32.const 1235400848))
+(assert_return (invoke "f32.nearest" (f32.const 3001872893)) (f32.const 2147483648))
+(assert_return (invoke "f32.nearest" (f32.const 1309967354)) (f32.const 1309967354))
+(assert_return (invoke "f32.nearest" (f32.const 1627938087)) (f32.const 1627938087))
+(assert_return (invoke "f32.nearest" (f32.const 4189305371)) (f32.const 4189305371))
+(assert_return (invoke "f32.nearest" (f32.const 2214718570)) (f32.const 2147483648))
+(assert_return (invoke "f32.nearest" (f32.const 121046684)) (f32.const 0))
+(assert_return (invoke "f32.nearest" (f32.const 3664476916)) (f32.const 3664476916))
+(assert_return (invoke "f32.nearest" (f32.const 981436489)) (f32.const 0))
+(assert_return (invoke "f32.nearest" (f32.const 1640888951)) (f32.const 1640888951))
+(assert_return (invoke "f32.nearest" (f32.const 3326735912)) (f32.const 3326736384))
+(assert_return (invoke "f32.nearest" (f32.const 3692922025)) (f32.const 3692922025))
+(assert_return (invoke "f32.nearest" (f32.const 1768568840)) (f32.const 1768568840))
+(assert_return (invoke "f32.nearest" (f32.const 4080890071)) (f32.const 4080890071))
+(assert_return (invoke "f32.nearest" (f32.const 802822337)) (f32.const 0))
+(assert_return (invoke "f32.nearest" (f32.const 3447995014)) (f32.const 3447995014))
+(assert_return (invoke "f32.nearest" (f32.const 3599397866)) (f32.const 3599397866))
+(assert_return (invoke "f32.nearest" (f32.const 3425424685)) (f32.const 3425424685))
+(assert_return (invoke "f32.nearest" (f32.const 1266513447)) (f32.const 1266513447))
+(assert_return (invoke "f32.nearest" (f32.const 3409054558)) (f32.const 3409054558))
+(assert_return (invoke "f32.nearest" (f32.const 939840762)) (f32.const 0))
+(assert_return (invoke "f32.nearest" (f32.const 3192345102)) (f32.const 2147483648))
+(assert_return (invoke "f32.nearest" (f32.const 1235850414)) (f32.const 1235850416))
+(assert_return (invoke "f32.nearest" (f32.const 2531177477)) (f32.const 2147483648))
+(assert_return (invoke "f32.nearest" (f32.const 594861909)) (f32.const 0))
+(assert_return (invoke "f32.nearest" (f32.const 3142343971)) (f32.const 2147483648))
+(assert_return (invoke "f32.nearest" (f32.const 3227516928)) (f32.const 3229614080))
+(assert_return (invoke "f32.nearest" (f32.const 3225419776)) (f32.const 3225419776))
+(assert_return (invoke "f32.nearest" (f32.const 3223322624)) (f32.const 3221225472))
+(assert_return (invoke "f32.nearest" (f32.const 3221225472)) (f32.const 3221225472))
+(assert_return (invoke "f32.nearest" (f32.const 3217031168)) (f32.const 3221225472))
+(assert_return (invoke "f32.nearest" (f32.const 3212836864)) (f32.const 3212836864))
+(assert_return (invoke "f32.nearest" (f32.const 3204448256)) (f32.const 2147483648))
+(assert_return (invoke "f32.nearest" (f32.const 0)) (f32.const 0))
+(assert_return (invoke "f32.nearest" (f32.const 1056964608)) (f32.const 0))
+(assert_return (invoke "f32.nearest" (f32.const 1065353216)) (f32.const 1065353216))
+(assert_return (invoke "f32.nearest" (f32.const 1069547520)) (f32.const 1073741824))
+(assert_return (invoke "f32.nearest" (f32.const 1073741824)) (f32.const 1073741824))
+(assert_return (invoke "f32.nearest" (f32.const 1075838976)) (f32.const 1073741824))
+(assert_return (invoke "f32.nearest" (f32.const 1077936128)) (f32.const 1077936128))
+(assert_return (invoke "f32.nearest" (f32.const 1080033280)) (f32.const 1082130432))
+(assert_return (invoke "f64.add" (f64.const 0) (f64.const 1)) (f64.const 1))
+(assert_return (invoke "f64.add" (f64.const 0) (f64.const 4607182418800017407)) (f64.const 4607182418800017407))
+(assert_return (invoke "f64.add" (f64.const 0) (f64.const 9218868437227405311)) (f64.const 9218868437227405311))
+(assert_return (invoke "f64.add" (f64.const 0) (f64.const 9223372036854775808)) (f64.const 0))
+(assert_return (invoke "f64.add" (f64.const 0) (f64.const 13830554455654793217)) (f64.const 13830554455654793217))
+(assert_return (invoke "f64.add" (f64.const 0) (f64.const 13837628693406821649)) (f64.const 13837628693406821649))
+(assert_return (invoke "f64.add" (f64.const 0) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.add" (f64.const 4503599627370496) (f64.const 1)) (f64.const 4503599627370497))
+(assert_return (invoke "f64.add" (f64.const 4503599627370496) (f64.const 4607182418800017407)) (f64.const 4607182418800017407))
+(assert_return (invoke "f64.add" (f64.const 4503599627370496) (f64.const 9218868437227405311)) (f64.const 9218868437227405311))
+(assert_return (invoke "f64.add" (f64.const 4503599627370496) (f64.const 9223372036854775808)) (f64.const 4503599627370496))
+(assert_return (invoke "f64.add" (f64.const 4503599627370496) (f64.const 13830554455654793217)) (f64.const 13830554455654793217))
+(assert_return (invoke "f64.add" (f64.const 4503599627370496) (f64.const 13837628693406821649)) (f64.const 13837628693406821649))
+(assert_return (invoke "f64.add" (f64.const 4503599627370496) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.add" (f64.const 4607182418800017407) (f64.const 1)) (f64.const 4607182418800017407))
+(assert_return (invoke "f64.add" (f64.const 4607182418800017407) (f64.const 4607182418800017407)) (f64.const 4611686018427387903))
+(assert_return (invoke "f64.add" (f64.const 4607182418800017407) (f64.const 9218868437227405311)) (f64.const 9218868437227405311))
+(assert_return (invoke "f64.add" (f64.const 4607182418800017407) (f64.const 9223372036854775808)) (f64.const 4607182418800017407))
+(assert_return (invoke "f64.add" (f64.const 4607182418800017407) (f64.const 13830554455654793217)) (f64.const 13598619074845212672))
+(assert_return (invoke "f64.add" (f64.const 4607182418800017407) (f64.const 13837628693406821649)) (f64.const 13835376893593136401))
+(assert_return (invoke "f64.add" (f64.const 4607182418800017407) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.add" (f64.const 4591870180066957722) (f64.const 1)) (f64.const 4591870180066957722))
+(assert_return (invoke "f64.add" (f64.const 4591870180066957722) (f64.const 4607182418800017407)) (f64.const 4607632778762754457))
+(assert_return (invoke "f64.add" (f64.const 4591870180066957722) (f64.const 9218868437227405311)) (f64.const 9218868437227405311))
+(assert_return (invoke "f64.add" (f64.const 4591870180066957722) (f64.const 9223372036854775808)) (f64.const 4591870180066957722))
+(assert_return (invoke "f64.add" (f64.const 4591870180066957722) (f64.const 13830554455654793217)) (f64.const 13829653735729319119))
+(assert_return (invoke "f64.add" (f64.const 4591870180066957722) (f64.const 13837628693406821649)) (f64.const 13837403513425453124))
+(assert_return (invoke "f64.add" (f64.const 4591870180066957722) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.add" (f64.const 9218868437227405312) (f64.const 1)) (f64.const 9218868437227405312))
+(assert_return (invoke "f64.add" (f64.const 9218868437227405312) (f64.const 4607182418800017407)) (f64.const 9218868437227405312))
+(assert_return (invoke "f64.add" (f64.const 9218868437227405312) (f64.const 9218868437227405311)) (f64.const 9218868437227405312))
+(assert_return (invoke "f64.add" (f64.const 9218868437227405312) (f64.const 9223372036854775808)) (f64.const 9218868437227405312))
+(assert_return (invoke "f64.add" (f64.const 9218868437227405312) (f64.const 13830554455654793217)) (f64.const 9218868437227405312))
+(assert_return (invoke "f64.add" (f64.const 9218868437227405312) (f64.const 13837628693406821649)) (f64.const 9218868437227405312))
+(assert_return (invoke "f64.add" (f64.const 9218868437227405312) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.add" (f64.const 9221120237041090560) (f64.const 1)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.add" (f64.const 9221120237041090560) (f64.const 4607182418800017407)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.add" (f64.const 9221120237041090560) (f64.const 9218868437227405311)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.add" (f64.const 9221120237041090560) (f64.const 9223372036854775808)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.add" (f64.const 9221120237041090560) (f64.const 13830554455654793217)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.add" (f64.const 9221120237041090560) (f64.const 13837628693406821649)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.add" (f64.const 9221120237041090560) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.add" (f64.const 9227875636482146303) (f64.const 1)) (f64.const 9227875636482146302))
+(assert_return (invoke "f64.add" (f64.const 9227875636482146303) (f64.const 4607182418800017407)) (f64.const 4607182418800017407))
+(assert_return (invoke "f64.add" (f64.const 9227875636482146303) (f64.const 9218868437227405311)) (f64.const 9218868437227405311))
+(assert_return (invoke "f64.add" (f64.const 9227875636482146303) (f64.const 9223372036854775808)) (f64.const 9227875636482146303))
+(assert_return (invoke "f64.add" (f64.const 9227875636482146303) (f64.const 13830554455654793217)) (f64.const 13830554455654793217))
+(assert_return (invoke "f64.add" (f64.const 9227875636482146303) (f64.const 13837628693406821649)) (f64.const 13837628693406821649))
+(assert_return (invoke "f64.add" (f64.const 9227875636482146303) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.add" (f64.const 13830554455654793217) (f64.const 1)) (f64.const 13830554455654793217))
+(assert_return (invoke "f64.add" (f64.const 13830554455654793217) (f64.const 4607182418800017407)) (f64.const 13598619074845212672))
+(assert_return (invoke "f64.add" (f64.const 13830554455654793217) (f64.const 9218868437227405311)) (f64.const 9218868437227405311))
+(assert_return (invoke "f64.add" (f64.const 13830554455654793217) (f64.const 9223372036854775808)) (f64.const 13830554455654793217))
+(assert_return (invoke "f64.add" (f64.const 13830554455654793217) (f64.const 13830554455654793217)) (f64.const 13835058055282163713))
+(assert_return (invoke "f64.add" (f64.const 13830554455654793217) (f64.const 13837628693406821649)) (f64.const 13839721074065020553))
+(assert_return (invoke "f64.add" (f64.const 13830554455654793217) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.add" (f64.const 13837309855095848960) (f64.const 1)) (f64.const 13837309855095848960))
+(assert_return (invoke "f64.add" (f64.const 13837309855095848960) (f64.const 4607182418800017407)) (f64.const 13835058055282163712))
+(assert_return (invoke "f64.add" (f64.const 13837309855095848960) (f64.const 9218868437227405311)) (f64.const 9218868437227405311))
+(assert_return (invoke "f64.add" (f64.const 13837309855095848960) (f64.const 9223372036854775808)) (f64.const 13837309855095848960))
+(assert_return (invoke "f64.add" (f64.const 13837309855095848960) (f64.const 13830554455654793217)) (f64.const 13839561654909534208))
+(assert_return (invoke "f64.add" (f64.const 13837309855095848960) (f64.const 13837628693406821649)) (f64.const 13841972873878705800))
+(assert_return (invoke "f64.add" (f64.const 13837309855095848960) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.add" (f64.const 18442240474082181119) (f64.const 1)) (f64.const 18442240474082181119))
+(assert_return (invoke "f64.add" (f64.const 18442240474082181119) (f64.const 4607182418800017407)) (f64.const 18442240474082181119))
+(assert_return (invoke "f64.add" (f64.const 18442240474082181119) (f64.const 9218868437227405311)) (f64.const 0))
+(assert_return (invoke "f64.add" (f64.const 18442240474082181119) (f64.const 9223372036854775808)) (f64.const 18442240474082181119))
+(assert_return (invoke "f64.add" (f64.const 18442240474082181119) (f64.const 13830554455654793217)) (f64.const 18442240474082181119))
+(assert_return (invoke "f64.add" (f64.const 18442240474082181119) (f64.const 13837628693406821649)) (f64.const 18442240474082181119))
+(assert_return (invoke "f64.add" (f64.const 18442240474082181119) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.add" (f64.const 14069245235905429505) (f64.const 1)) (f64.const 14069245235905429505))
+(assert_return (invoke "f64.add" (f64.const 14069245235905429505) (f64.const 4607182418800017407)) (f64.const 14069245235905429505))
+(assert_return (invoke "f64.add" (f64.const 14069245235905429505) (f64.const 9218868437227405311)) (f64.const 9218868437227405311))
+(assert_return (invoke "f64.add" (f64.const 14069245235905429505) (f64.const 9223372036854775808)) (f64.const 14069245235905429505))
+(assert_return (invoke "f64.add" (f64.const 14069245235905429505) (f64.const 13830554455654793217)) (f64.const 14069245235905429506))
+(assert_return (invoke "f64.add" (f64.const 14069245235905429505) (f64.const 13837628693406821649)) (f64.const 14069245235905429507))
+(assert_return (invoke "f64.add" (f64.const 14069245235905429505) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.add" (f64.const 17681519826900058529) (f64.const 10165453501645915854)) (f64.const 17681519826900058529))
+(assert_return (invoke "f64.add" (f64.const 9351787235920478466) (f64.const 13703485738760050526)) (f64.const 13703485738760050526))
+(assert_return (invoke "f64.add" (f64.const 17424407197062724956) (f64.const 8104997790492122947)) (f64.const 17424407193474210399))
+(assert_return (invoke "f64.add" (f64.const 1083049635498052487) (f64.const 8989913044594873511)) (f64.const 8989913044594873511))
+(assert_return (invoke "f64.add" (f64.const 4610226637350486442) (f64.const 4673522066975591529)) (f64.const 4673522527657853360))
+(assert_return (invoke "f64.add" (f64.const 10009997999978612785) (f64.const 8937128078443304435)) (f64.const 8937128078443304435))
+(assert_return (invoke "f64.add" (f64.const 11607130504160983651) (f64.const 18111407659136069346)) (f64.const 18111407659136069346))
+(assert_return (invoke "f64.add" (f64.const 4807384761205522425) (f64.const 3139908462525976503)) (f64.const 4807384761205522425))
+(assert_return (invoke "f64.add" (f64.const 14602001061947669687) (f64.const 4571505375029641608)) (f64.const 14602001061947669687))
+(assert_return (invoke "f64.add" (f64.const 3816801177569362140) (f64.const 13820173663798174598)) (f64.const 13820173663798174598))
+(assert_return (invoke "f64.add" (f64.const 9415014815883462742) (f64.const 4680527942396312239)) (f64.const 4680527942396312239))
+(assert_return (invoke "f64.add" (f64.const 5530895856499723638) (f64.const 7926233383015058577)) (f64.const 7926233383015058577))
+(assert_return (invoke "f64.add" (f64.const 15130106058242443553) (f64.const 6567544320082610364)) (f64.const 6567544320082610364))
+(assert_return (invoke "f64.add" (f64.const 8834910603761739820) (f64.const 13929003043112302839)) (f64.const 8834910603761739820))
+(assert_return (invoke "f64.add" (f64.const 7306547310640692653) (f64.const 14616106876744895751)) (f64.const 7306547310640692653))
+(assert_return (invoke "f64.add" (f64.const 10961251843506243505) (f64.const 4721360298547829538)) (f64.const 4721360298547829538))
+(assert_return (invoke "f64.add" (f64.const 13723921809527591962) (f64.const 14430160917818834081)) (f64.const 14430160917818834081))
+(assert_return (invoke "f64.add" (f64.const 13814434450053091400) (f64.const 4688243378857515335)) (f64.const 4688243375806714756))
+(assert_return (invoke "f64.add" (f64.const 4437159392437180540) (f64.const 13714471649103045541)) (f64.const 13714470277677482891))
+(assert_return (invoke "f64.add" (f64.const 17649663841058566060) (f64.const 6419564948802019587)) (f64.const 17649663841058566060))
+(assert_return (invoke "f64.add" (f64.const 605167573107921491) (f64.const 10884941270619440203)) (f64.const 10884941270619440203))
+(assert_return (invoke "f64.add" (f64.const 306987079021629240) (f64.const 9032377919613775015)) (f64.const 9032377919613775015))
+(assert_return (invoke "f64.add" (f64.const 13952737925114545120) (f64.const 14761674924635622538)) (f64.const 14761674924635622538))
+(assert_return (invoke "f64.add" (f64.const 7582039930942311395) (f64.const 4723124936603300288)) (f64.const 7582039930942311395))
+(assert_return (invoke "f64.add" (f64.const 15765706832506326009) (f64.const 18252617533151110208)) (f64.const 18252617533151110208))
+(assert_return (invoke "f64.add" (f64.const 1134165886830815021) (f64.const 9954701535628332238)) (f64.const 1134165886830815021))
+(assert_return (invoke "f64.add" (f64.const 9923626352199630155) (f64.const 13812855066027619856)) (f64.const 13812855066027619856))
+(assert_return (invoke "f64.add" (f64.const 12576394531136050764) (f64.const 18095591117014499732)) (f64.const 18095591117014499732))
+(assert_return (invoke "f64.add" (f64.const 12144820357845912049) (f64.const 4988467177225386762)) (f64.const 4988467177225386762))
+(assert_return (invoke "f64.add" (f64.const 8450762055402379276) (f64.const 10509053381718462159)) (f64.const 8450762055402379276))
+(assert_return (invoke "f64.add" (f64.const 13916948076000172763) (f64.const 5305870981909756168)) (f64.const 5305870981909756168))
+(assert_return (invoke "f64.add" (f64.const 1658899984624009928) (f64.const 4506082072843586765)) (f64.const 4506082072843586765))
+(assert_return (invoke "f64.add" (f64.const 12217992861593803268) (f64.const 4531500264080802853)) (f64.const 4531500264080802853))
+(assert_return (invoke "f64.add" (f64.const 4485973929692208762) (f64.const 13928802714232529300)) (f64.const 13928802714232529283))
+(assert_return (invoke "f64.add" (f64.const 3072301377222901817) (f64.const 17319774432204039070)) (f64.const 17319774432204039070))
+(assert_return (invoke "f64.add" (f64.const 10865174146379803821) (f64.const 11773061172166026387)) (f64.const 11773061172166026387))
+(assert_return (invoke "f64.add" (f64.const 6514003811034530881) (f64.const 13756514414251485508)) (f64.const 6514003811034530881))
+(assert_return (invoke "f64.add" (f64.const 9159617642205633542) (f64.const 1723825743751665181)) (f64.const 9159617642205633542))
+(assert_return (invoke "f64.add" (f64.const 11487823725384864690) (f64.const 5737024550954663771)) (f64.const 5737024550954663771))
+(assert_return (invoke "f64.add" (f64.const 253136339955845510) (f64.const 11463594281736532912)) (f64.const 11463594281736532912))
+(assert_return (invoke "f64.sub" (f64.const 0) (f64.const 1)) (f64.const 9223372036854775809))
+(assert_return (invoke "f64.sub" (f64.const 0) (f64.const 4607182418800017407)) (f64.const 13830554455654793215))
+(assert_return (invoke "f64.sub" (f64.const 0) (f64.const 9218868437227405311)) (f64.const 18442240474082181119))
+(assert_return (invoke "f64.sub" (f64.const 0) (f64.const 9223372036854775808)) (f64.const 0))
+(assert_return (invoke "f64.sub" (f64.const 0) (f64.const 13830554455654793217)) (f64.const 4607182418800017409))
+(assert_return (invoke "f64.sub" (f64.const 0) (f64.const 13837628693406821649)) (f64.const 4614256656552045841))
+(assert_return (invoke "f64.sub" (f64.const 0) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sub" (f64.const 4503599627370496) (f64.const 1)) (f64.const 4503599627370495))
+(assert_return (invoke "f64.sub" (f64.const 4503599627370496) (f64.const 4607182418800017407)) (f64.const 13830554455654793215))
+(assert_return (invoke "f64.sub" (f64.const 4503599627370496) (f64.const 9218868437227405311)) (f64.const 18442240474082181119))
+(assert_return (invoke "f64.sub" (f64.const 4503599627370496) (f64.const 9223372036854775808)) (f64.const 4503599627370496))
+(assert_return (invoke "f64.sub" (f64.const 4503599627370496) (f64.const 13830554455654793217)) (f64.const 4607182418800017409))
+(assert_return (invoke "f64.sub" (f64.const 4503599627370496) (f64.const 13837628693406821649)) (f64.const 4614256656552045841))
+(assert_return (invoke "f64.sub" (f64.const 4503599627370496) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sub" (f64.const 4607182418800017407) (f64.const 1)) (f64.const 4607182418800017407))
+(assert_return (invoke "f64.sub" (f64.const 4607182418800017407) (f64.const 4607182418800017407)) (f64.const 0))
+(assert_return (invoke "f64.sub" (f64.const 4607182418800017407) (f64.const 9218868437227405311)) (f64.const 18442240474082181119))
+(assert_return (invoke "f64.sub" (f64.const 4607182418800017407) (f64.const 9223372036854775808)) (f64.const 4607182418800017407))
+(assert_return (invoke "f64.sub" (f64.const 4607182418800017407) (f64.const 13830554455654793217)) (f64.const 4611686018427387904))
+(assert_return (invoke "f64.sub" (f64.const 4607182418800017407) (f64.const 13837628693406821649)) (f64.const 4616349037210244744))
+(assert_return (invoke "f64.sub" (f64.const 4607182418800017407) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sub" (f64.const 4591870180066957722) (f64.const 1)) (f64.const 4591870180066957722))
+(assert_return (invoke "f64.sub" (f64.const 4591870180066957722) (f64.const 4607182418800017407)) (f64.const 13829653735729319116))
+(assert_return (invoke "f64.sub" (f64.const 4591870180066957722) (f64.const 9218868437227405311)) (f64.const 18442240474082181119))
+(assert_return (invoke "f64.sub" (f64.const 4591870180066957722) (f64.const 9223372036854775808)) (f64.const 4591870180066957722))
+(assert_return (invoke "f64.sub" (f64.const 4591870180066957722) (f64.const 13830554455654793217)) (f64.const 4607632778762754459))
+(assert_return (invoke "f64.sub" (f64.const 4591870180066957722) (f64.const 13837628693406821649)) (f64.const 4614481836533414366))
+(assert_return (invoke "f64.sub" (f64.const 4591870180066957722) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sub" (f64.const 9218868437227405312) (f64.const 1)) (f64.const 9218868437227405312))
+(assert_return (invoke "f64.sub" (f64.const 9218868437227405312) (f64.const 4607182418800017407)) (f64.const 9218868437227405312))
+(assert_return (invoke "f64.sub" (f64.const 9218868437227405312) (f64.const 9218868437227405311)) (f64.const 9218868437227405312))
+(assert_return (invoke "f64.sub" (f64.const 9218868437227405312) (f64.const 9223372036854775808)) (f64.const 9218868437227405312))
+(assert_return (invoke "f64.sub" (f64.const 9218868437227405312) (f64.const 13830554455654793217)) (f64.const 9218868437227405312))
+(assert_return (invoke "f64.sub" (f64.const 9218868437227405312) (f64.const 13837628693406821649)) (f64.const 9218868437227405312))
+(assert_return (invoke "f64.sub" (f64.const 9218868437227405312) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sub" (f64.const 9221120237041090560) (f64.const 1)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sub" (f64.const 9221120237041090560) (f64.const 4607182418800017407)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sub" (f64.const 9221120237041090560) (f64.const 9218868437227405311)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sub" (f64.const 9221120237041090560) (f64.const 9223372036854775808)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sub" (f64.const 9221120237041090560) (f64.const 13830554455654793217)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sub" (f64.const 9221120237041090560) (f64.const 13837628693406821649)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sub" (f64.const 9221120237041090560) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sub" (f64.const 9227875636482146303) (f64.const 1)) (f64.const 9227875636482146304))
+(assert_return (invoke "f64.sub" (f64.const 9227875636482146303) (f64.const 4607182418800017407)) (f64.const 13830554455654793215))
+(assert_return (invoke "f64.sub" (f64.const 9227875636482146303) (f64.const 9218868437227405311)) (f64.const 18442240474082181119))
+(assert_return (invoke "f64.sub" (f64.const 9227875636482146303) (f64.const 9223372036854775808)) (f64.const 9227875636482146303))
+(assert_return (invoke "f64.sub" (f64.const 9227875636482146303) (f64.const 13830554455654793217)) (f64.const 4607182418800017409))
+(assert_return (invoke "f64.sub" (f64.const 9227875636482146303) (f64.const 13837628693406821649)) (f64.const 4614256656552045841))
+(assert_return (invoke "f64.sub" (f64.const 9227875636482146303) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sub" (f64.const 13830554455654793217) (f64.const 1)) (f64.const 13830554455654793217))
+(assert_return (invoke "f64.sub" (f64.const 13830554455654793217) (f64.const 4607182418800017407)) (f64.const 13835058055282163712))
+(assert_return (invoke "f64.sub" (f64.const 13830554455654793217) (f64.const 9218868437227405311)) (f64.const 18442240474082181119))
+(assert_return (invoke "f64.sub" (f64.const 13830554455654793217) (f64.const 9223372036854775808)) (f64.const 13830554455654793217))
+(assert_return (invoke "f64.sub" (f64.const 13830554455654793217) (f64.const 13830554455654793217)) (f64.const 0))
+(assert_return (invoke "f64.sub" (f64.const 13830554455654793217) (f64.const 13837628693406821649)) (f64.const 4612004856738360592))
+(assert_return (invoke "f64.sub" (f64.const 13830554455654793217) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sub" (f64.const 13837309855095848960) (f64.const 1)) (f64.const 13837309855095848960))
+(assert_return (invoke "f64.sub" (f64.const 13837309855095848960) (f64.const 4607182418800017407)) (f64.const 13839561654909534208))
+(assert_return (invoke "f64.sub" (f64.const 13837309855095848960) (f64.const 9218868437227405311)) (f64.const 18442240474082181119))
+(assert_return (invoke "f64.sub" (f64.const 13837309855095848960) (f64.const 9223372036854775808)) (f64.const 13837309855095848960))
+(assert_return (invoke "f64.sub" (f64.const 13837309855095848960) (f64.const 13830554455654793217)) (f64.const 13835058055282163711))
+(assert_return (invoke "f64.sub" (f64.const 13837309855095848960) (f64.const 13837628693406821649)) (f64.const 4594269433266098448))
+(assert_return (invoke "f64.sub" (f64.const 13837309855095848960) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sub" (f64.const 18442240474082181119) (f64.const 1)) (f64.const 18442240474082181119))
+(assert_return (invoke "f64.sub" (f64.const 18442240474082181119) (f64.const 4607182418800017407)) (f64.const 18442240474082181119))
+(assert_return (invoke "f64.sub" (f64.const 18442240474082181119) (f64.const 9218868437227405311)) (f64.const 18442240474082181120))
+(assert_return (invoke "f64.sub" (f64.const 18442240474082181119) (f64.const 9223372036854775808)) (f64.const 18442240474082181119))
+(assert_return (invoke "f64.sub" (f64.const 18442240474082181119) (f64.const 13830554455654793217)) (f64.const 18442240474082181119))
+(assert_return (invoke "f64.sub" (f64.const 18442240474082181119) (f64.const 13837628693406821649)) (f64.const 18442240474082181119))
+(assert_return (invoke "f64.sub" (f64.const 18442240474082181119) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sub" (f64.const 14069245235905429505) (f64.const 1)) (f64.const 14069245235905429505))
+(assert_return (invoke "f64.sub" (f64.const 14069245235905429505) (f64.const 4607182418800017407)) (f64.const 14069245235905429505))
+(assert_return (invoke "f64.sub" (f64.const 14069245235905429505) (f64.const 9218868437227405311)) (f64.const 18442240474082181119))
+(assert_return (invoke "f64.sub" (f64.const 14069245235905429505) (f64.const 9223372036854775808)) (f64.const 14069245235905429505))
+(assert_return (invoke "f64.sub" (f64.const 14069245235905429505) (f64.const 13830554455654793217)) (f64.const 14069245235905429504))
+(assert_return (invoke "f64.sub" (f64.const 14069245235905429505) (f64.const 13837628693406821649)) (f64.const 14069245235905429503))
+(assert_return (invoke "f64.sub" (f64.const 14069245235905429505) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sub" (f64.const 13297169824527799557) (f64.const 2357486602345480972)) (f64.const 13297169824527799557))
+(assert_return (invoke "f64.sub" (f64.const 5133225621687885677) (f64.const 529349652413897829)) (f64.const 5133225621687885677))
+(assert_return (invoke "f64.sub" (f64.const 17440548470093676828) (f64.const 4643000407319524022)) (f64.const 17440548470093676828))
+(assert_return (invoke "f64.sub" (f64.const 13792055230816080999) (f64.const 13496166085121570191)) (f64.const 13792055230816080999))
+(assert_return (invoke "f64.sub" (f64.const 1368818327305495552) (f64.const 12278736800602394752)) (f64.const 3055364763747618944))
+(assert_return (invoke "f64.sub" (f64.const 13999697401635893421) (f64.const 7106832200333604535)) (f64.const 16330204237188380343))
+(assert_return (invoke "f64.sub" (f64.const 9397876506098158104) (f64.const 4404153177878997716)) (f64.const 13627525214733773524))
+(assert_return (invoke "f64.sub" (f64.const 17233280661252169413) (f64.const 2500187820899852022)) (f64.const 17233280661252169413))
+(assert_return (invoke "f64.sub" (f64.const 10364575937583747783) (f64.const 13748738328465179925)) (f64.const 4525366291610404117))
+(assert_return (invoke "f64.sub" (f64.const 8135083032635037849) (f64.const 2012579609372056905)) (f64.const 8135083032635037849))
+(assert_return (invoke "f64.sub" (f64.const 12794839111621795428) (f64.const 1716987913073080240)) (f64.const 12794839111621795428))
+(assert_return (invoke "f64.sub" (f64.const 838292871441649413) (f64.const 17650876236985602358)) (f64.const 8427504200130826550))
+(assert_return (invoke "f64.sub" (f64.const 12674390458369731175) (f64.const 13877250596710849978)) (f64.const 4653878559856074170))
+(assert_return (invoke "f64.sub" (f64.const 14258039564924460615) (f64.const 12347105283006712353)) (f64.const 14258039564924460615))
+(assert_return (invoke "f64.sub" (f64.const 4667015900244594077) (f64.const 4530929469440823128)) (f64.const 4667015900240112698))
+(assert_return (invoke "f64.sub" (f64.const 13793783257106555215) (f64.const 3805302407188043567)) (f64.const 13793783257106555215))
+(assert_return (invoke "f64.sub" (f64.const 13843310648880084583) (f64.const 11110772234976855610)) (f64.const 13843310648880084583))
+(assert_return (invoke "f64.sub" (f64.const 11219465724835926014) (f64.const 12576798430076207154)) (f64.const 3353426393221431346))
+(assert_return (invoke "f64.sub" (f64.const 8535273890006781443) (f64.const 10902365451205826108)) (f64.const 8535273890006781443))
+(assert_return (invoke "f64.sub" (f64.const 15635870107479204054) (f64.const 17472738022649069391)) (f64.const 8249365985794293583))
+(assert_return (invoke "f64.sub" (f64.const 13945205085823440219) (f64.const 13055452493373827610)) (f64.const 13945205085823440219))
+(assert_return (invoke "f64.sub" (f64.const 6112714651771139858) (f64.const 16999316559454833772)) (f64.const 7775944522600057964))
+(assert_return (invoke "f64.sub" (f64.const 13937900515077406882) (f64.const 2450219008670310946)) (f64.const 13937900515077406882))
+(assert_return (invoke "f64.sub" (f64.const 15822044859368632482) (f64.const 3688524964668623097)) (f64.const 15822044859368632482))
+(assert_return (invoke "f64.sub" (f64.const 4586794909876465060) (f64.const 17154192383850064539)) (f64.const 7930820346995288731))
+(assert_return (invoke "f64.sub" (f64.const 12141676287922335065) (f64.const 2296141024791758339)) (f64.const 12141676287922335065))
+(assert_return (invoke "f64.sub" (f64.const 13837570425816992229) (f64.const 2225076075149853213)) (f64.const 13837570425816992229))
+(assert_return (invoke "f64.sub" (f64.const 641940461712312168) (f64.const 4504110461480119816)) (f64.const 13727482498334895624))
+(assert_return (invoke "f64.sub" (f64.const 13724624037436948852) (f64.const 4543058454390926676)) (f64.const 13766443498223175957))
+(assert_return (invoke "f64.sub" (f64.const 5447180674009797117) (f64.const 4593531082077615311)) (f64.const 5447180674009797117))
+(assert_return (invoke "f64.sub" (f64.const 11867669200323710255) (f64.const 17433736880573420965)) (f64.const 8210364843718645157))
+(assert_return (invoke "f64.sub" (f64.const 13746362524162972906) (f64.const 6038849642055513929)) (f64.const 15262221678910289737))
+(assert_return (invoke "f64.sub" (f64.const 13742557211060358527) (f64.const 13817486572764587056)) (f64.const 4594114485721002194))
+(assert_return (invoke "f64.sub" (f64.const 13885515155096171835) (f64.const 4216530535260016085)) (f64.const 13885515155096171835))
+(assert_return (invoke "f64.sub" (f64.const 7927589831228911512) (f64.const 13959422807894705444)) (f64.const 7927589831228911512))
+(assert_return (invoke "f64.sub" (f64.const 4597803928329309861) (f64.const 8117375976858260507)) (f64.const 17340748013713036315))
+(assert_return (invoke "f64.sub" (f64.const 12807829393160993031) (f64.const 4423806166621160740)) (f64.const 13647178203475936548))
+(assert_return (invoke "f64.sub" (f64.const 4584404147312328219) (f64.const 4705190244210783094)) (f64.const 13928562281000389226))
+(assert_return (invoke "f64.sub" (f64.const 10255910149685987795) (f64.const 10207669618207441819)) (f64.const 10255906723679047723))
+(assert_return (invoke "f64.sub" (f64.const 1835931888774675465) (f64.const 17752825418913007420)) (f64.const 8529453382058231612))
+(assert_return (invoke "f64.mul" (f64.const 0) (f64.const 1)) (f64.const 0))
+(assert_return (invoke "f64.mul" (f64.const 0) (f64.const 4607182418800017407)) (f64.const 0))
+(assert_return (invoke "f64.mul" (f64.const 0) (f64.const 9218868437227405311)) (f64.const 0))
+(assert_return (invoke "f64.mul" (f64.const 0) (f64.const 9223372036854775808)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.mul" (f64.const 0) (f64.const 13830554455654793217)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.mul" (f64.const 0) (f64.const 13837628693406821649)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.mul" (f64.const 0) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.mul" (f64.const 4503599627370496) (f64.const 1)) (f64.const 0))
+(assert_return (invoke "f64.mul" (f64.const 4503599627370496) (f64.const 4607182418800017407)) (f64.const 4503599627370496))
+(assert_return (invoke "f64.mul" (f64.const 4503599627370496) (f64.const 9218868437227405311)) (f64.const 4616189618054758399))
+(assert_return (invoke "f64.mul" (f64.const 4503599627370496) (f64.const 9223372036854775808)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.mul" (f64.const 4503599627370496) (f64.const 13830554455654793217)) (f64.const 9227875636482146305))
+(assert_return (invoke "f64.mul" (f64.const 4503599627370496) (f64.const 13837628693406821649)) (f64.const 9234949874234174737))
+(assert_return (invoke "f64.mul" (f64.const 4503599627370496) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.mul" (f64.const 4607182418800017407) (f64.const 1)) (f64.const 1))
+(assert_return (invoke "f64.mul" (f64.const 4607182418800017407) (f64.const 4607182418800017407)) (f64.const 4607182418800017406))
+(assert_return (invoke "f64.mul" (f64.const 4607182418800017407) (f64.const 9218868437227405311)) (f64.const 9218868437227405310))
+(assert_return (invoke "f64.mul" (f64.const 4607182418800017407) (f64.const 9223372036854775808)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.mul" (f64.const 4607182418800017407) (f64.const 13830554455654793217)) (f64.const 13830554455654793216))
+(assert_return (invoke "f64.mul" (f64.const 4607182418800017407) (f64.const 13837628693406821649)) (f64.const 13837628693406821648))
+(assert_return (invoke "f64.mul" (f64.const 4607182418800017407) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.mul" (f64.const 4591870180066957722) (f64.const 1)) (f64.const 0))
+(assert_return (invoke "f64.mul" (f64.const 4591870180066957722) (f64.const 4607182418800017407)) (f64.const 4591870180066957721))
+(assert_return (invoke "f64.mul" (f64.const 4591870180066957722) (f64.const 9218868437227405311)) (f64.const 9203556198494345625))
+(assert_return (invoke "f64.mul" (f64.const 4591870180066957722) (f64.const 9223372036854775808)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.mul" (f64.const 4591870180066957722) (f64.const 13830554455654793217)) (f64.const 13815242216921733532))
+(assert_return (invoke "f64.mul" (f64.const 4591870180066957722) (f64.const 13837628693406821649)) (f64.const 13822703046974304475))
+(assert_return (invoke "f64.mul" (f64.const 4591870180066957722) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.mul" (f64.const 9218868437227405312) (f64.const 1)) (f64.const 9218868437227405312))
+(assert_return (invoke "f64.mul" (f64.const 9218868437227405312) (f64.const 4607182418800017407)) (f64.const 9218868437227405312))
+(assert_return (invoke "f64.mul" (f64.const 9218868437227405312) (f64.const 9218868437227405311)) (f64.const 9218868437227405312))
+(assert_return (invoke "f64.mul" (f64.const 9218868437227405312) (f64.const 9223372036854775808)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.mul" (f64.const 9218868437227405312) (f64.const 13830554455654793217)) (f64.const 18442240474082181120))
+(assert_return (invoke "f64.mul" (f64.const 9218868437227405312) (f64.const 13837628693406821649)) (f64.const 18442240474082181120))
+(assert_return (invoke "f64.mul" (f64.const 9218868437227405312) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.mul" (f64.const 9221120237041090560) (f64.const 1)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.mul" (f64.const 9221120237041090560) (f64.const 4607182418800017407)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.mul" (f64.const 9221120237041090560) (f64.const 9218868437227405311)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.mul" (f64.const 9221120237041090560) (f64.const 9223372036854775808)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.mul" (f64.const 9221120237041090560) (f64.const 13830554455654793217)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.mul" (f64.const 9221120237041090560) (f64.const 13837628693406821649)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.mul" (f64.const 9221120237041090560) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.mul" (f64.const 9227875636482146303) (f64.const 1)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.mul" (f64.const 9227875636482146303) (f64.const 4607182418800017407)) (f64.const 9227875636482146303))
+(assert_return (invoke "f64.mul" (f64.const 9227875636482146303) (f64.const 9218868437227405311)) (f64.const 13839561654909534205))
+(assert_return (invoke "f64.mul" (f64.const 9227875636482146303) (f64.const 9223372036854775808)) (f64.const 0))
+(assert_return (invoke "f64.mul" (f64.const 9227875636482146303) (f64.const 13830554455654793217)) (f64.const 4503599627370496))
+(assert_return (invoke "f64.mul" (f64.const 9227875636482146303) (f64.const 13837628693406821649)) (f64.const 11577837379398927))
+(assert_return (invoke "f64.mul" (f64.const 9227875636482146303) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.mul" (f64.const 13830554455654793217) (f64.const 1)) (f64.const 9223372036854775809))
+(assert_return (invoke "f64.mul" (f64.const 13830554455654793217) (f64.const 4607182418800017407)) (f64.const 13830554455654793216))
+(assert_return (invoke "f64.mul" (f64.const 13830554455654793217) (f64.const 9218868437227405311)) (f64.const 18442240474082181120))
+(assert_return (invoke "f64.mul" (f64.const 13830554455654793217) (f64.const 9223372036854775808)) (f64.const 0))
+(assert_return (invoke "f64.mul" (f64.const 13830554455654793217) (f64.const 13830554455654793217)) (f64.const 4607182418800017410))
+(assert_return (invoke "f64.mul" (f64.const 13830554455654793217) (f64.const 13837628693406821649)) (f64.const 4614256656552045843))
+(assert_return (invoke "f64.mul" (f64.const 13830554455654793217) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.mul" (f64.const 13837309855095848960) (f64.const 1)) (f64.const 9223372036854775811))
+(assert_return (invoke "f64.mul" (f64.const 13837309855095848960) (f64.const 4607182418800017407)) (f64.const 13837309855095848959))
+(assert_return (invoke "f64.mul" (f64.const 13837309855095848960) (f64.const 9218868437227405311)) (f64.const 18442240474082181120))
+(assert_return (invoke "f64.mul" (f64.const 13837309855095848960) (f64.const 9223372036854775808)) (f64.const 0))
+(assert_return (invoke "f64.mul" (f64.const 13837309855095848960) (f64.const 13830554455654793217)) (f64.const 4613937818241073154))
+(assert_return (invoke "f64.mul" (f64.const 13837309855095848960) (f64.const 13837628693406821649)) (f64.const 4621495296368779725))
+(assert_return (invoke "f64.mul" (f64.const 13837309855095848960) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.mul" (f64.const 18442240474082181119) (f64.const 1)) (f64.const 13605374474286268415))
+(assert_return (invoke "f64.mul" (f64.const 18442240474082181119) (f64.const 4607182418800017407)) (f64.const 18442240474082181118))
+(assert_return (invoke "f64.mul" (f64.const 18442240474082181119) (f64.const 9218868437227405311)) (f64.const 18442240474082181120))
+(assert_return (invoke "f64.mul" (f64.const 18442240474082181119) (f64.const 9223372036854775808)) (f64.const 0))
+(assert_return (invoke "f64.mul" (f64.const 18442240474082181119) (f64.const 13830554455654793217)) (f64.const 9218868437227405312))
+(assert_return (invoke "f64.mul" (f64.const 18442240474082181119) (f64.const 13837628693406821649)) (f64.const 9218868437227405312))
+(assert_return (invoke "f64.mul" (f64.const 18442240474082181119) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.mul" (f64.const 14069245235905429505) (f64.const 1)) (f64.const 9232379236109516801))
+(assert_return (invoke "f64.mul" (f64.const 14069245235905429505) (f64.const 4607182418800017407)) (f64.const 14069245235905429504))
+(assert_return (invoke "f64.mul" (f64.const 14069245235905429505) (f64.const 9218868437227405311)) (f64.const 18442240474082181120))
+(assert_return (invoke "f64.mul" (f64.const 14069245235905429505) (f64.const 9223372036854775808)) (f64.const 0))
+(assert_return (invoke "f64.mul" (f64.const 14069245235905429505) (f64.const 13830554455654793217)) (f64.const 4845873199050653698))
+(assert_return (invoke "f64.mul" (f64.const 14069245235905429505) (f64.const 13837628693406821649)) (f64.const 4852947436802682131))
+(assert_return (invoke "f64.mul" (f64.const 14069245235905429505) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.mul" (f64.const 6621712805837979251) (f64.const 1067363531089440983)) (f64.const 3081897206531502466))
+(assert_return (invoke "f64.mul" (f64.const 4584685977253034180) (f64.const 4597579469209203191)) (f64.const 4575101732678521007))
+(assert_return (invoke "f64.mul" (f64.const 8658886737209338219) (f64.const 3466504468488819404)) (f64.const 7518424798461317826))
+(assert_return (invoke "f64.mul" (f64.const 7370350556171224921) (f64.const 4597225169894238711)) (f64.const 7360608694254985834))
+(assert_return (invoke "f64.mul" (f64.const 4675983369780194918) (f64.const 17273143959906293625)) (f64.const 17342454200857415813))
+(assert_return (invoke "f64.mul" (f64.const 16354079598977009882) (f64.const 9202911420002814938)) (f64.const 18442240474082181120))
+(assert_return (invoke "f64.mul" (f64.const 13953635270495769090) (f64.const 7930600615072694978)) (f64.const 17277133374707725104))
+(assert_return (invoke "f64.mul" (f64.const 555734919926837938) (f64.const 10578203667990116829)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.mul" (f64.const 17256939796060324684) (f64.const 13889801922408771635)) (f64.const 8093175783027698214))
+(assert_return (invoke "f64.mul" (f64.const 13777896335952203768) (f64.const 12212399393687170497)) (f64.const 2936841072029492871))
+(assert_return (invoke "f64.mul" (f64.const 3086468103038911062) (f64.const 17041005406025359378)) (f64.const 15520496205742308038))
+(assert_return (invoke "f64.mul" (f64.const 13839323927299821903) (f64.const 15929283283845775112)) (f64.const 6714729407168180584))
+(assert_return (invoke "f64.mul" (f64.const 17058690818114530032) (f64.const 8557639624777060389)) (f64.const 18442240474082181120))
+(assert_return (invoke "f64.mul" (f64.const 17928888442769127363) (f64.const 2932546725279805570)) (f64.const 16254261858579898858))
+(assert_return (invoke "f64.mul" (f64.const 12431048179526385358) (f64.const 12883925257057514024)) (f64.const 2261375653415517741))
+(assert_return (invoke "f64.mul" (f64.const 4645726504940839724) (f64.const 499390579243646566)) (f64.const 538047020962324908))
+(assert_return (invoke "f64.mul" (f64.const 14668002155131132995) (f64.const 13758352934102022890)) (f64.const 5372432141400963803))
+(assert_return (invoke "f64.mul" (f64.const 7452073920116279048) (f64.const 4735313087531973063)) (f64.const 7580584540605089179))
+(assert_return (invoke "f64.mul" (f64.const 13907844094770759310) (f64.const 10579324509452896275)) (f64.const 1433301794033061729))
+(assert_return (invoke "f64.mul" (f64.const 10901227184362770365) (f64.const 13332751717856538665)) (f64.const 1180583485485824482))
+(assert_return (invoke "f64.mul" (f64.const 14802555966546168556) (f64.const 7007599310642203121)) (f64.const 17202973005673056192))
+(assert_return (invoke "f64.mul" (f64.const 2027543028764181055) (f64.const 4619809742945240787)) (f64.const 2040521538185522502))
+(assert_return (invoke "f64.mul" (f64.const 13912751577815187819) (f64.const 3574980863217255486)) (f64.const 12880878361437764517))
+(assert_return (invoke "f64.mul" (f64.const 13801175223986902297) (f64.const 17920583359901541047)) (f64.const 8668194442359715049))
+(assert_return (invoke "f64.mul" (f64.const 11427136677627467416) (f64.const 11080446447684185554)) (f64.const 0))
+(assert_return (invoke "f64.mul" (f64.const 15437530754373031418) (f64.const 4479675111771377255)) (f64.const 15310149740394943348))
+(assert_return (invoke "f64.mul" (f64.const 10179484101828685843) (f64.const 1736378512678515422)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.mul" (f64.const 8194817231608983268) (f64.const 4702661734435475044)) (f64.const 8290852296166716990))
+(assert_return (invoke "f64.mul" (f64.const 12613363290536398191) (f64.const 3784391932822010336)) (f64.const 11790997223883681475))
+(assert_return (invoke "f64.mul" (f64.const 7055661025323462832) (f64.const 13832712592846016357)) (f64.const 16281576482792712547))
+(assert_return (invoke "f64.mul" (f64.const 11677578969154483395) (f64.const 4549232150185648696)) (f64.const 11619739253738540014))
+(assert_return (invoke "f64.mul" (f64.const 7520123886101259606) (f64.const 13918778935746860416)) (f64.const 16831902440966043370))
+(assert_return (invoke "f64.mul" (f64.const 6659498532882510306) (f64.const 10059066935332833694)) (f64.const 12111673596075697141))
+(assert_return (invoke "f64.mul" (f64.const 2777282218558194562) (f64.const 584063257527272980)) (f64.const 0))
+(assert_return (invoke "f64.mul" (f64.const 11574998635625813118) (f64.const 4706074138817836804)) (f64.const 11673968531466250107))
+(assert_return (invoke "f64.mul" (f64.const 13768964381986204891) (f64.const 7252822968190851917)) (f64.const 16415262383368394390))
+(assert_return (invoke "f64.mul" (f64.const 8877990867077098427) (f64.const 2542204788253953119)) (f64.const 6813687306823269768))
+(assert_return (invoke "f64.mul" (f64.const 5447125825250292583) (f64.const 16919037550547110369)) (f64.const 17759225102147475083))
+(assert_return (invoke "f64.mul" (f64.const 10607844244570492595) (f64.const 9887580853034958059)) (f64.const 0))
+(assert_return (invoke "f64.mul" (f64.const 13779627630995111384) (f64.const 14740874566154228028)) (f64.const 5466986034983045526))
+(assert_return (invoke "f64.div" (f64.const 0) (f64.const 1)) (f64.const 0))
+(assert_return (invoke "f64.div" (f64.const 0) (f64.const 4607182418800017407)) (f64.const 0))
+(assert_return (invoke "f64.div" (f64.const 0) (f64.const 9218868437227405311)) (f64.const 0))
+(assert_return (invoke "f64.div" (f64.const 0) (f64.const 9223372036854775808)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.div" (f64.const 0) (f64.const 13830554455654793217)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.div" (f64.const 0) (f64.const 13837628693406821649)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.div" (f64.const 0) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.div" (f64.const 4503599627370496) (f64.const 1)) (f64.const 4841369599423283200))
+(assert_return (invoke "f64.div" (f64.const 4503599627370496) (f64.const 4607182418800017407)) (f64.const 4503599627370497))
+(assert_return (invoke "f64.div" (f64.const 4503599627370496) (f64.const 9218868437227405311)) (f64.const 0))
+(assert_return (invoke "f64.div" (f64.const 4503599627370496) (f64.const 9223372036854775808)) (f64.const 18442240474082181120))
+(assert_return (invoke "f64.div" (f64.const 4503599627370496) (f64.const 13830554455654793217)) (f64.const 9227875636482146303))
+(assert_return (invoke "f64.div" (f64.const 4503599627370496) (f64.const 13837628693406821649)) (f64.const 9224805577139581474))
+(assert_return (invoke "f64.div" (f64.const 4503599627370496) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.div" (f64.const 4607182418800017407) (f64.const 1)) (f64.const 9218868437227405312))
+(assert_return (invoke "f64.div" (f64.const 4607182418800017407) (f64.const 4607182418800017407)) (f64.const 4607182418800017408))
+(assert_return (invoke "f64.div" (f64.const 4607182418800017407) (f64.const 9218868437227405311)) (f64.const 1125899906842624))
+(assert_return (invoke "f64.div" (f64.const 4607182418800017407) (f64.const 9223372036854775808)) (f64.const 18442240474082181120))
+(assert_return (invoke "f64.div" (f64.const 4607182418800017407) (f64.const 13830554455654793217)) (f64.const 13830554455654793213))
+(assert_return (invoke "f64.div" (f64.const 4607182418800017407) (f64.const 13837628693406821649)) (f64.const 13822777817911904392))
+(assert_return (invoke "f64.div" (f64.const 4607182418800017407) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.div" (f64.const 4591870180066957722) (f64.const 1)) (f64.const 9218868437227405312))
+(assert_return (invoke "f64.div" (f64.const 4591870180066957722) (f64.const 4607182418800017407)) (f64.const 4591870180066957723))
+(assert_return (invoke "f64.div" (f64.const 4591870180066957722) (f64.const 9218868437227405311)) (f64.const 112589990684262))
+(assert_return (invoke "f64.div" (f64.const 4591870180066957722) (f64.const 9223372036854775808)) (f64.const 18442240474082181120))
+(assert_return (invoke "f64.div" (f64.const 4591870180066957722) (f64.const 13830554455654793217)) (f64.const 13815242216921733528))
+(assert_return (invoke "f64.div" (f64.const 4591870180066957722) (f64.const 13837628693406821649)) (f64.const 13808120186801948372))
+(assert_return (invoke "f64.div" (f64.const 4591870180066957722) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.div" (f64.const 9218868437227405312) (f64.const 1)) (f64.const 9218868437227405312))
+(assert_return (invoke "f64.div" (f64.const 9218868437227405312) (f64.const 4607182418800017407)) (f64.const 9218868437227405312))
+(assert_return (invoke "f64.div" (f64.const 9218868437227405312) (f64.const 9218868437227405311)) (f64.const 9218868437227405312))
+(assert_return (invoke "f64.div" (f64.const 9218868437227405312) (f64.const 9223372036854775808)) (f64.const 18442240474082181120))
+(assert_return (invoke "f64.div" (f64.const 9218868437227405312) (f64.const 13830554455654793217)) (f64.const 18442240474082181120))
+(assert_return (invoke "f64.div" (f64.const 9218868437227405312) (f64.const 13837628693406821649)) (f64.const 18442240474082181120))
+(assert_return (invoke "f64.div" (f64.const 9218868437227405312) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.div" (f64.const 9221120237041090560) (f64.const 1)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.div" (f64.const 9221120237041090560) (f64.const 4607182418800017407)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.div" (f64.const 9221120237041090560) (f64.const 9218868437227405311)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.div" (f64.const 9221120237041090560) (f64.const 9223372036854775808)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.div" (f64.const 9221120237041090560) (f64.const 13830554455654793217)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.div" (f64.const 9221120237041090560) (f64.const 13837628693406821649)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.div" (f64.const 9221120237041090560) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.div" (f64.const 9227875636482146303) (f64.const 1)) (f64.const 14064741636278059006))
+(assert_return (invoke "f64.div" (f64.const 9227875636482146303) (f64.const 4607182418800017407)) (f64.const 9227875636482146303))
+(assert_return (invoke "f64.div" (f64.const 9227875636482146303) (f64.const 9218868437227405311)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.div" (f64.const 9227875636482146303) (f64.const 9223372036854775808)) (f64.const 9218868437227405312))
+(assert_return (invoke "f64.div" (f64.const 9227875636482146303) (f64.const 13830554455654793217)) (f64.const 4503599627370494))
+(assert_return (invoke "f64.div" (f64.const 9227875636482146303) (f64.const 13837628693406821649)) (f64.const 1433540284805666))
+(assert_return (invoke "f64.div" (f64.const 9227875636482146303) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.div" (f64.const 13830554455654793217) (f64.const 1)) (f64.const 18442240474082181120))
+(assert_return (invoke "f64.div" (f64.const 13830554455654793217) (f64.const 4607182418800017407)) (f64.const 13830554455654793218))
+(assert_return (invoke "f64.div" (f64.const 13830554455654793217) (f64.const 9218868437227405311)) (f64.const 9224497936761618432))
+(assert_return (invoke "f64.div" (f64.const 13830554455654793217) (f64.const 9223372036854775808)) (f64.const 9218868437227405312))
+(assert_return (invoke "f64.div" (f64.const 13830554455654793217) (f64.const 13830554455654793217)) (f64.const 4607182418800017408))
+(assert_return (invoke "f64.div" (f64.const 13830554455654793217) (f64.const 13837628693406821649)) (f64.const 4599405781057128586))
+(assert_return (invoke "f64.div" (f64.const 13830554455654793217) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.div" (f64.const 13837309855095848960) (f64.const 1)) (f64.const 18442240474082181120))
+(assert_return (invoke "f64.div" (f64.const 13837309855095848960) (f64.const 4607182418800017407)) (f64.const 13837309855095848961))
+(assert_return (invoke "f64.div" (f64.const 13837309855095848960) (f64.const 9218868437227405311)) (f64.const 9226749736575303680))
+(assert_return (invoke "f64.div" (f64.const 13837309855095848960) (f64.const 9223372036854775808)) (f64.const 9218868437227405312))
+(assert_return (invoke "f64.div" (f64.const 13837309855095848960) (f64.const 13830554455654793217)) (f64.const 4613937818241073151))
+(assert_return (invoke "f64.div" (f64.const 13837309855095848960) (f64.const 13837628693406821649)) (f64.const 4606776461254110413))
+(assert_return (invoke "f64.div" (f64.const 13837309855095848960) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.div" (f64.const 18442240474082181119) (f64.const 1)) (f64.const 18442240474082181120))
+(assert_return (invoke "f64.div" (f64.const 18442240474082181119) (f64.const 4607182418800017407)) (f64.const 18442240474082181120))
+(assert_return (invoke "f64.div" (f64.const 18442240474082181119) (f64.const 9218868437227405311)) (f64.const 13830554455654793216))
+(assert_return (invoke "f64.div" (f64.const 18442240474082181119) (f64.const 9223372036854775808)) (f64.const 9218868437227405312))
+(assert_return (invoke "f64.div" (f64.const 18442240474082181119) (f64.const 13830554455654793217)) (f64.const 9218868437227405309))
+(assert_return (invoke "f64.div" (f64.const 18442240474082181119) (f64.const 13837628693406821649)) (f64.const 9211091799484516488))
+(assert_return (invoke "f64.div" (f64.const 18442240474082181119) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.div" (f64.const 14069245235905429505) (f64.const 1)) (f64.const 18442240474082181120))
+(assert_return (invoke "f64.div" (f64.const 14069245235905429505) (f64.const 4607182418800017407)) (f64.const 14069245235905429506))
+(assert_return (invoke "f64.div" (f64.const 14069245235905429505) (f64.const 9218868437227405311)) (f64.const 9457559217478041602))
+(assert_return (invoke "f64.div" (f64.const 14069245235905429505) (f64.const 9223372036854775808)) (f64.const 9218868437227405312))
+(assert_return (invoke "f64.div" (f64.const 14069245235905429505) (f64.const 13830554455654793217)) (f64.const 4845873199050653696))
+(assert_return (invoke "f64.div" (f64.const 14069245235905429505) (f64.const 13837628693406821649)) (f64.const 4838096561307764874))
+(assert_return (invoke "f64.div" (f64.const 14069245235905429505) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.div" (f64.const 6915614874503662365) (f64.const 12653765840660606966)) (f64.const 17315675984054115232))
+(assert_return (invoke "f64.div" (f64.const 6172797744413911390) (f64.const 7161796764750517689)) (f64.const 3617838332194471550))
+(assert_return (invoke "f64.div" (f64.const 6709194666592592317) (f64.const 10589454989171408130)) (f64.const 18442240474082181120))
+(assert_return (invoke "f64.div" (f64.const 13944437518644856264) (f64.const 6445999097109322745)) (f64.const 12105591203483535032))
+(assert_return (invoke "f64.div" (f64.const 13959209042517991058) (f64.const 6880597858257586220)) (f64.const 11685676984671415574))
+(assert_return (invoke "f64.div" (f64.const 13889637795431168533) (f64.const 4740946171709580359)) (f64.const 13755413947978613164))
+(assert_return (invoke "f64.div" (f64.const 3825864899866406391) (f64.const 4488973809649223955)) (f64.const 3943920958294567917))
+(assert_return (invoke "f64.div" (f64.const 13775283347986273976) (f64.const 23815705043988204)) (f64.const 18358207602953789195))
+(assert_return (invoke "f64.div" (f64.const 11316655204323229127) (f64.const 13787453954993307305)) (f64.const 2135879453353670110))
+(assert_return (invoke "f64.div" (f64.const 18174851998340506255) (f64.const 12720320487429720966)) (f64.const 9218868437227405312))
+(assert_return (invoke "f64.div" (f64.const 13837001959949903227) (f64.const 2524481261904057265)) (f64.const 15919550583064512383))
+(assert_return (invoke "f64.div" (f64.const 12566666124641306375) (f64.const 17381729295175204425)) (f64.const 29))
+(assert_return (invoke "f64.div" (f64.const 7379155026652841270) (f64.const 13833337570943180829)) (f64.const 16599620159576403201))
+(assert_return (invoke "f64.div" (f64.const 13910906821174991161) (f64.const 4897168858406024775)) (f64.const 13620349459549506783))
+(assert_return (invoke "f64.div" (f64.const 3784232222022338869) (f64.const 5474207867843626001)) (f64.const 2916849625931855357))
+(assert_return (invoke "f64.div" (f64.const 4662025654273349517) (f64.const 13835331640603350633)) (f64.const 13880590356570778198))
+(assert_return (invoke "f64.div" (f64.const 13966210674743322226) (f64.const 8109691935003455965)) (f64.const 10463255383409329757))
+(assert_return (invoke "f64.div" (f64.const 4410941547150262609) (f64.const 4607282160629517264)) (f64.const 4410802419209699497))
+(assert_return (invoke "f64.div" (f64.const 16609795291498482233) (f64.const 9228985231179131602)) (f64.const 9218868437227405312))
+(assert_return (invoke "f64.div" (f64.const 4685102985094834106) (f64.const 13718069586585005408)) (f64.const 14020931282456471273))
+(assert_return (invoke "f64.div" (f64.const 15529189550659770335) (f64.const 18294502296147527008)) (f64.const 1841800651928225139))
+(assert_return (invoke "f64.div" (f64.const 2932862369081355967) (f64.const 6691282008993517232)) (f64.const 848437896761655650))
+(assert_return (invoke "f64.div" (f64.const 15331419758122365261) (f64.const 13928132019676647597)) (f64.const 6010102962623832370))
+(assert_return (invoke "f64.div" (f64.const 13810825556457907065) (f64.const 6185082449187499298)) (f64.const 12232618801334842734))
+(assert_return (invoke "f64.div" (f64.const 9929696932617042871) (f64.const 1755424923483432454)) (f64.const 12781349637325384447))
+(assert_return (invoke "f64.div" (f64.const 5213431794623471276) (f64.const 13937029438078279635)) (f64.const 14330301577819581619))
+(assert_return (invoke "f64.div" (f64.const 17002234231855359025) (f64.const 13732067115467304067)) (f64.const 7877285211212534020))
+(assert_return (invoke "f64.div" (f64.const 499814911891147437) (f64.const 13802461654436893601)) (f64.const 9750853054492946782))
+(assert_return (invoke "f64.div" (f64.const 5829480156208016588) (f64.const 10873742188928693311)) (f64.const 18009578311554824959))
+(assert_return (invoke "f64.div" (f64.const 13864713929261346869) (f64.const 8052033573120591247)) (f64.const 10419794153704979067))
+(assert_return (invoke "f64.div" (f64.const 12893951582157467255) (f64.const 13838256569093934256)) (f64.const 3662360172596640616))
+(assert_return (invoke "f64.div" (f64.const 4547849496321390690) (f64.const 13986580935059725726)) (f64.const 13614876178313785825))
+(assert_return (invoke "f64.div" (f64.const 12792147150426943572) (f64.const 4527927479479677694)) (f64.const 12871369308109573865))
+(assert_return (invoke "f64.div" (f64.const 3921889687778664427) (f64.const 11723391638337366880)) (f64.const 15252088513923116348))
+(assert_return (invoke "f64.div" (f64.const 6115594616024831651) (f64.const 4889834702954971800)) (f64.const 5832604805669691992))
+(assert_return (invoke "f64.div" (f64.const 11324787276456069215) (f64.const 14691338186970073681)) (f64.const 1240381139029889329))
+(assert_return (invoke "f64.div" (f64.const 10099409129216311055) (f64.const 17353438114766382686)) (f64.const 0))
+(assert_return (invoke "f64.div" (f64.const 2882715243897740513) (f64.const 2920896259326087850)) (f64.const 4568410642296924940))
+(assert_return (invoke "f64.div" (f64.const 6801567479884222057) (f64.const 16624088865735260940)) (f64.const 13231311056321085098))
+(assert_return (invoke "f64.div" (f64.const 4714221293407502065) (f64.const 8106388881270628754)) (f64.const 1215005122813229421))
+(assert_return (invoke "f64.min" (f64.const 0) (f64.const 1)) (f64.const 0))
+(assert_return (invoke "f64.min" (f64.const 0) (f64.const 4607182418800017407)) (f64.const 0))
+(assert_return (invoke "f64.min" (f64.const 0) (f64.const 9218868437227405311)) (f64.const 0))
+(assert_return (invoke "f64.min" (f64.const 0) (f64.const 9223372036854775808)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.min" (f64.const 0) (f64.const 13830554455654793217)) (f64.const 13830554455654793217))
+(assert_return (invoke "f64.min" (f64.const 0) (f64.const 13837628693406821649)) (f64.const 13837628693406821649))
+(assert_return (invoke "f64.min" (f64.const 0) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.min" (f64.const 4503599627370496) (f64.const 1)) (f64.const 1))
+(assert_return (invoke "f64.min" (f64.const 4503599627370496) (f64.const 4607182418800017407)) (f64.const 4503599627370496))
+(assert_return (invoke "f64.min" (f64.const 4503599627370496) (f64.const 9218868437227405311)) (f64.const 4503599627370496))
+(assert_return (invoke "f64.min" (f64.const 4503599627370496) (f64.const 9223372036854775808)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.min" (f64.const 4503599627370496) (f64.const 13830554455654793217)) (f64.const 13830554455654793217))
+(assert_return (invoke "f64.min" (f64.const 4503599627370496) (f64.const 13837628693406821649)) (f64.const 13837628693406821649))
+(assert_return (invoke "f64.min" (f64.const 4503599627370496) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.min" (f64.const 4607182418800017407) (f64.const 1)) (f64.const 1))
+(assert_return (invoke "f64.min" (f64.const 4607182418800017407) (f64.const 4607182418800017407)) (f64.const 4607182418800017407))
+(assert_return (invoke "f64.min" (f64.const 4607182418800017407) (f64.const 9218868437227405311)) (f64.const 4607182418800017407))
+(assert_return (invoke "f64.min" (f64.const 4607182418800017407) (f64.const 9223372036854775808)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.min" (f64.const 4607182418800017407) (f64.const 13830554455654793217)) (f64.const 13830554455654793217))
+(assert_return (invoke "f64.min" (f64.const 4607182418800017407) (f64.const 13837628693406821649)) (f64.const 13837628693406821649))
+(assert_return (invoke "f64.min" (f64.const 4607182418800017407) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.min" (f64.const 4591870180066957722) (f64.const 1)) (f64.const 1))
+(assert_return (invoke "f64.min" (f64.const 4591870180066957722) (f64.const 4607182418800017407)) (f64.const 4591870180066957722))
+(assert_return (invoke "f64.min" (f64.const 4591870180066957722) (f64.const 9218868437227405311)) (f64.const 4591870180066957722))
+(assert_return (invoke "f64.min" (f64.const 4591870180066957722) (f64.const 9223372036854775808)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.min" (f64.const 4591870180066957722) (f64.const 13830554455654793217)) (f64.const 13830554455654793217))
+(assert_return (invoke "f64.min" (f64.const 4591870180066957722) (f64.const 13837628693406821649)) (f64.const 13837628693406821649))
+(assert_return (invoke "f64.min" (f64.const 4591870180066957722) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.min" (f64.const 9218868437227405312) (f64.const 1)) (f64.const 1))
+(assert_return (invoke "f64.min" (f64.const 9218868437227405312) (f64.const 4607182418800017407)) (f64.const 4607182418800017407))
+(assert_return (invoke "f64.min" (f64.const 9218868437227405312) (f64.const 9218868437227405311)) (f64.const 9218868437227405311))
+(assert_return (invoke "f64.min" (f64.const 9218868437227405312) (f64.const 9223372036854775808)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.min" (f64.const 9218868437227405312) (f64.const 13830554455654793217)) (f64.const 13830554455654793217))
+(assert_return (invoke "f64.min" (f64.const 9218868437227405312) (f64.const 13837628693406821649)) (f64.const 13837628693406821649))
+(assert_return (invoke "f64.min" (f64.const 9218868437227405312) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.min" (f64.const 9221120237041090560) (f64.const 1)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.min" (f64.const 9221120237041090560) (f64.const 4607182418800017407)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.min" (f64.const 9221120237041090560) (f64.const 9218868437227405311)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.min" (f64.const 9221120237041090560) (f64.const 9223372036854775808)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.min" (f64.const 9221120237041090560) (f64.const 13830554455654793217)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.min" (f64.const 9221120237041090560) (f64.const 13837628693406821649)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.min" (f64.const 9221120237041090560) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.min" (f64.const 9227875636482146303) (f64.const 1)) (f64.const 9227875636482146303))
+(assert_return (invoke "f64.min" (f64.const 9227875636482146303) (f64.const 4607182418800017407)) (f64.const 9227875636482146303))
+(assert_return (invoke "f64.min" (f64.const 9227875636482146303) (f64.const 9218868437227405311)) (f64.const 9227875636482146303))
+(assert_return (invoke "f64.min" (f64.const 9227875636482146303) (f64.const 9223372036854775808)) (f64.const 9227875636482146303))
+(assert_return (invoke "f64.min" (f64.const 9227875636482146303) (f64.const 13830554455654793217)) (f64.const 13830554455654793217))
+(assert_return (invoke "f64.min" (f64.const 9227875636482146303) (f64.const 13837628693406821649)) (f64.const 13837628693406821649))
+(assert_return (invoke "f64.min" (f64.const 9227875636482146303) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.min" (f64.const 13830554455654793217) (f64.const 1)) (f64.const 13830554455654793217))
+(assert_return (invoke "f64.min" (f64.const 13830554455654793217) (f64.const 4607182418800017407)) (f64.const 13830554455654793217))
+(assert_return (invoke "f64.min" (f64.const 13830554455654793217) (f64.const 9218868437227405311)) (f64.const 13830554455654793217))
+(assert_return (invoke "f64.min" (f64.const 13830554455654793217) (f64.const 9223372036854775808)) (f64.const 13830554455654793217))
+(assert_return (invoke "f64.min" (f64.const 13830554455654793217) (f64.const 13830554455654793217)) (f64.const 13830554455654793217))
+(assert_return (invoke "f64.min" (f64.const 13830554455654793217) (f64.const 13837628693406821649)) (f64.const 13837628693406821649))
+(assert_return (invoke "f64.min" (f64.const 13830554455654793217) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.min" (f64.const 13837309855095848960) (f64.const 1)) (f64.const 13837309855095848960))
+(assert_return (invoke "f64.min" (f64.const 13837309855095848960) (f64.const 4607182418800017407)) (f64.const 13837309855095848960))
+(assert_return (invoke "f64.min" (f64.const 13837309855095848960) (f64.const 9218868437227405311)) (f64.const 13837309855095848960))
+(assert_return (invoke "f64.min" (f64.const 13837309855095848960) (f64.const 9223372036854775808)) (f64.const 13837309855095848960))
+(assert_return (invoke "f64.min" (f64.const 13837309855095848960) (f64.const 13830554455654793217)) (f64.const 13837309855095848960))
+(assert_return (invoke "f64.min" (f64.const 13837309855095848960) (f64.const 13837628693406821649)) (f64.const 13837628693406821649))
+(assert_return (invoke "f64.min" (f64.const 13837309855095848960) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.min" (f64.const 18442240474082181119) (f64.const 1)) (f64.const 18442240474082181119))
+(assert_return (invoke "f64.min" (f64.const 18442240474082181119) (f64.const 4607182418800017407)) (f64.const 18442240474082181119))
+(assert_return (invoke "f64.min" (f64.const 18442240474082181119) (f64.const 9218868437227405311)) (f64.const 18442240474082181119))
+(assert_return (invoke "f64.min" (f64.const 18442240474082181119) (f64.const 9223372036854775808)) (f64.const 18442240474082181119))
+(assert_return (invoke "f64.min" (f64.const 18442240474082181119) (f64.const 13830554455654793217)) (f64.const 18442240474082181119))
+(assert_return (invoke "f64.min" (f64.const 18442240474082181119) (f64.const 13837628693406821649)) (f64.const 18442240474082181119))
+(assert_return (invoke "f64.min" (f64.const 18442240474082181119) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.min" (f64.const 14069245235905429505) (f64.const 1)) (f64.const 14069245235905429505))
+(assert_return (invoke "f64.min" (f64.const 14069245235905429505) (f64.const 4607182418800017407)) (f64.const 14069245235905429505))
+(assert_return (invoke "f64.min" (f64.const 14069245235905429505) (f64.const 9218868437227405311)) (f64.const 14069245235905429505))
+(assert_return (invoke "f64.min" (f64.const 14069245235905429505) (f64.const 9223372036854775808)) (f64.const 14069245235905429505))
+(assert_return (invoke "f64.min" (f64.const 14069245235905429505) (f64.const 13830554455654793217)) (f64.const 14069245235905429505))
+(assert_return (invoke "f64.min" (f64.const 14069245235905429505) (f64.const 13837628693406821649)) (f64.const 14069245235905429505))
+(assert_return (invoke "f64.min" (f64.const 14069245235905429505) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.min" (f64.const 2988885818618487670) (f64.const 8664619048196943923)) (f64.const 2988885818618487670))
+(assert_return (invoke "f64.min" (f64.const 18332533252144482129) (f64.const 4746448367053075162)) (f64.const 18332533252144482129))
+(assert_return (invoke "f64.min" (f64.const 2419008035860699114) (f64.const 4553427670029853444)) (f64.const 2419008035860699114))
+(assert_return (invoke "f64.min" (f64.const 4505218626769532763) (f64.const 17576353203876561758)) (f64.const 17576353203876561758))
+(assert_return (invoke "f64.min" (f64.const 9203581631662855271) (f64.const 4532107088755228150)) (f64.const 4532107088755228150))
+(assert_return (invoke "f64.min" (f64.const 13712084374655196744) (f64.const 16556382047123038125)) (f64.const 16556382047123038125))
+(assert_return (invoke "f64.min" (f64.const 18081637031482105802) (f64.const 15034944495980660185)) (f64.const 18081637031482105802))
+(assert_return (invoke "f64.min" (f64.const 8611279788130981651) (f64.const 3975711105140799150)) (f64.const 3975711105140799150))
+(assert_return (invoke "f64.min" (f64.const 8421871798726518710) (f64.const 4655304742940849021)) (f64.const 4655304742940849021))
+(assert_return (invoke "f64.min" (f64.const 14927718306176484795) (f64.const 16503723367821549997)) (f64.const 16503723367821549997))
+(assert_return (invoke "f64.min" (f64.const 7783154812405029304) (f64.const 23012913754454721)) (f64.const 23012913754454721))
+(assert_return (invoke "f64.min" (f64.const 6764921632059518568) (f64.const 10782247287103535477)) (f64.const 10782247287103535477))
+(assert_return (invoke "f64.min" (f64.const 4669072299255550502) (f64.const 5555406242694897990)) (f64.const 4669072299255550502))
+(assert_return (invoke "f64.min" (f64.const 4809876228203717008) (f64.const 4472858610872400827)) (f64.const 4472858610872400827))
+(assert_return (invoke "f64.min" (f64.const 13965469193574765126) (f64.const 190876476431846514)) (f64.const 13965469193574765126))
+(assert_return (invoke "f64.min" (f64.const 3605323279073671659) (f64.const 16334092792439300775)) (f64.const 16334092792439300775))
+(assert_return (invoke "f64.min" (f64.const 4631358262098596507) (f64.const 15234652631168042321)) (f64.const 15234652631168042321))
+(assert_return (invoke "f64.min" (f64.const 14435991612071991624) (f64.const 4722028996575372558)) (f64.const 14435991612071991624))
+(assert_return (invoke "f64.min" (f64.const 15979550095170006187) (f64.const 4689679215689692460)) (f64.const 15979550095170006187))
+(assert_return (invoke "f64.min" (f64.const 15702820395352114490) (f64.const 13801709737971282276)) (f64.const 15702820395352114490))
+(assert_return (invoke "f64.min" (f64.const 9065262781227537125) (f64.const 4621615420077091635)) (f64.const 4621615420077091635))
+(assert_return (invoke "f64.min" (f64.const 13931159711989553303) (f64.const 13763921202535417750)) (f64.const 13931159711989553303))
+(assert_return (invoke "f64.min" (f64.const 13706416219261481575) (f64.const 17315581157626748644)) (f64.const 17315581157626748644))
+(assert_return (invoke "f64.min" (f64.const 16663613137253701815) (f64.const 17128252613354819272)) (f64.const 17128252613354819272))
+(assert_return (invoke "f64.min" (f64.const 13804264402426353180) (f64.const 7850584296160599159)) (f64.const 13804264402426353180))
+(assert_return (invoke "f64.min" (f64.const 15346861778743748283) (f64.const 443155966628316759)) (f64.const 15346861778743748283))
+(assert_return (invoke "f64.min" (f64.const 3589929706239771066) (f64.const 4540098649826284925)) (f64.const 3589929706239771066))
+(assert_return (invoke "f64.min" (f64.const 5818414440041948814) (f64.const 13821131283786881874)) (f64.const 13821131283786881874))
+(assert_return (invoke "f64.min" (f64.const 11677935914042392255) (f64.const 11409531549049237315)) (f64.const 11677935914042392255))
+(assert_return (invoke "f64.min" (f64.const 12473194092666787524) (f64.const 15933673727958153121)) (f64.const 15933673727958153121))
+(assert_return (invoke "f64.min" (f64.const 16345165715054121735) (f64.const 9824575932635002738)) (f64.const 16345165715054121735))
+(assert_return (invoke "f64.min" (f64.const 4689492272070846873) (f64.const 15864960474348210507)) (f64.const 15864960474348210507))
+(assert_return (invoke "f64.min" (f64.const 7937145618580957309) (f64.const 6442911747934214547)) (f64.const 6442911747934214547))
+(assert_return (invoke "f64.min" (f64.const 8052898082821059169) (f64.const 16854624928845486395)) (f64.const 16854624928845486395))
+(assert_return (invoke "f64.min" (f64.const 3225498274553454881) (f64.const 4644538121241704662)) (f64.const 3225498274553454881))
+(assert_return (invoke "f64.min" (f64.const 5570858044718250350) (f64.const 269918750838536926)) (f64.const 269918750838536926))
+(assert_return (invoke "f64.min" (f64.const 13624992469886707144) (f64.const 4397101084685401825)) (f64.const 13624992469886707144))
+(assert_return (invoke "f64.min" (f64.const 12289089711635173247) (f64.const 16040445866965085180)) (f64.const 16040445866965085180))
+(assert_return (invoke "f64.min" (f64.const 4685190344445043072) (f64.const 13875813292172862523)) (f64.const 13875813292172862523))
+(assert_return (invoke "f64.min" (f64.const 2826729997476913450) (f64.const 4521810375063668801)) (f64.const 2826729997476913450))
+(assert_return (invoke "f64.max" (f64.const 0) (f64.const 1)) (f64.const 1))
+(assert_return (invoke "f64.max" (f64.const 0) (f64.const 4607182418800017407)) (f64.const 4607182418800017407))
+(assert_return (invoke "f64.max" (f64.const 0) (f64.const 9218868437227405311)) (f64.const 9218868437227405311))
+(assert_return (invoke "f64.max" (f64.const 0) (f64.const 9223372036854775808)) (f64.const 0))
+(assert_return (invoke "f64.max" (f64.const 0) (f64.const 13830554455654793217)) (f64.const 0))
+(assert_return (invoke "f64.max" (f64.const 0) (f64.const 13837628693406821649)) (f64.const 0))
+(assert_return (invoke "f64.max" (f64.const 0) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.max" (f64.const 4503599627370496) (f64.const 1)) (f64.const 4503599627370496))
+(assert_return (invoke "f64.max" (f64.const 4503599627370496) (f64.const 4607182418800017407)) (f64.const 4607182418800017407))
+(assert_return (invoke "f64.max" (f64.const 4503599627370496) (f64.const 9218868437227405311)) (f64.const 9218868437227405311))
+(assert_return (invoke "f64.max" (f64.const 4503599627370496) (f64.const 9223372036854775808)) (f64.const 4503599627370496))
+(assert_return (invoke "f64.max" (f64.const 4503599627370496) (f64.const 13830554455654793217)) (f64.const 4503599627370496))
+(assert_return (invoke "f64.max" (f64.const 4503599627370496) (f64.const 13837628693406821649)) (f64.const 4503599627370496))
+(assert_return (invoke "f64.max" (f64.const 4503599627370496) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.max" (f64.const 4607182418800017407) (f64.const 1)) (f64.const 4607182418800017407))
+(assert_return (invoke "f64.max" (f64.const 4607182418800017407) (f64.const 4607182418800017407)) (f64.const 4607182418800017407))
+(assert_return (invoke "f64.max" (f64.const 4607182418800017407) (f64.const 9218868437227405311)) (f64.const 9218868437227405311))
+(assert_return (invoke "f64.max" (f64.const 4607182418800017407) (f64.const 9223372036854775808)) (f64.const 4607182418800017407))
+(assert_return (invoke "f64.max" (f64.const 4607182418800017407) (f64.const 13830554455654793217)) (f64.const 4607182418800017407))
+(assert_return (invoke "f64.max" (f64.const 4607182418800017407) (f64.const 13837628693406821649)) (f64.const 4607182418800017407))
+(assert_return (invoke "f64.max" (f64.const 4607182418800017407) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.max" (f64.const 4591870180066957722) (f64.const 1)) (f64.const 4591870180066957722))
+(assert_return (invoke "f64.max" (f64.const 4591870180066957722) (f64.const 4607182418800017407)) (f64.const 4607182418800017407))
+(assert_return (invoke "f64.max" (f64.const 4591870180066957722) (f64.const 9218868437227405311)) (f64.const 9218868437227405311))
+(assert_return (invoke "f64.max" (f64.const 4591870180066957722) (f64.const 9223372036854775808)) (f64.const 4591870180066957722))
+(assert_return (invoke "f64.max" (f64.const 4591870180066957722) (f64.const 13830554455654793217)) (f64.const 4591870180066957722))
+(assert_return (invoke "f64.max" (f64.const 4591870180066957722) (f64.const 13837628693406821649)) (f64.const 4591870180066957722))
+(assert_return (invoke "f64.max" (f64.const 4591870180066957722) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.max" (f64.const 9218868437227405312) (f64.const 1)) (f64.const 9218868437227405312))
+(assert_return (invoke "f64.max" (f64.const 9218868437227405312) (f64.const 4607182418800017407)) (f64.const 9218868437227405312))
+(assert_return (invoke "f64.max" (f64.const 9218868437227405312) (f64.const 9218868437227405311)) (f64.const 9218868437227405312))
+(assert_return (invoke "f64.max" (f64.const 9218868437227405312) (f64.const 9223372036854775808)) (f64.const 9218868437227405312))
+(assert_return (invoke "f64.max" (f64.const 9218868437227405312) (f64.const 13830554455654793217)) (f64.const 9218868437227405312))
+(assert_return (invoke "f64.max" (f64.const 9218868437227405312) (f64.const 13837628693406821649)) (f64.const 9218868437227405312))
+(assert_return (invoke "f64.max" (f64.const 9218868437227405312) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.max" (f64.const 9221120237041090560) (f64.const 1)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.max" (f64.const 9221120237041090560) (f64.const 4607182418800017407)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.max" (f64.const 9221120237041090560) (f64.const 9218868437227405311)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.max" (f64.const 9221120237041090560) (f64.const 9223372036854775808)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.max" (f64.const 9221120237041090560) (f64.const 13830554455654793217)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.max" (f64.const 9221120237041090560) (f64.const 13837628693406821649)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.max" (f64.const 9221120237041090560) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.max" (f64.const 9227875636482146303) (f64.const 1)) (f64.const 1))
+(assert_return (invoke "f64.max" (f64.const 9227875636482146303) (f64.const 4607182418800017407)) (f64.const 4607182418800017407))
+(assert_return (invoke "f64.max" (f64.const 9227875636482146303) (f64.const 9218868437227405311)) (f64.const 9218868437227405311))
+(assert_return (invoke "f64.max" (f64.const 9227875636482146303) (f64.const 9223372036854775808)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.max" (f64.const 9227875636482146303) (f64.const 13830554455654793217)) (f64.const 9227875636482146303))
+(assert_return (invoke "f64.max" (f64.const 9227875636482146303) (f64.const 13837628693406821649)) (f64.const 9227875636482146303))
+(assert_return (invoke "f64.max" (f64.const 9227875636482146303) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.max" (f64.const 13830554455654793217) (f64.const 1)) (f64.const 1))
+(assert_return (invoke "f64.max" (f64.const 13830554455654793217) (f64.const 4607182418800017407)) (f64.const 4607182418800017407))
+(assert_return (invoke "f64.max" (f64.const 13830554455654793217) (f64.const 9218868437227405311)) (f64.const 9218868437227405311))
+(assert_return (invoke "f64.max" (f64.const 13830554455654793217) (f64.const 9223372036854775808)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.max" (f64.const 13830554455654793217) (f64.const 13830554455654793217)) (f64.const 13830554455654793217))
+(assert_return (invoke "f64.max" (f64.const 13830554455654793217) (f64.const 13837628693406821649)) (f64.const 13830554455654793217))
+(assert_return (invoke "f64.max" (f64.const 13830554455654793217) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.max" (f64.const 13837309855095848960) (f64.const 1)) (f64.const 1))
+(assert_return (invoke "f64.max" (f64.const 13837309855095848960) (f64.const 4607182418800017407)) (f64.const 4607182418800017407))
+(assert_return (invoke "f64.max" (f64.const 13837309855095848960) (f64.const 9218868437227405311)) (f64.const 9218868437227405311))
+(assert_return (invoke "f64.max" (f64.const 13837309855095848960) (f64.const 9223372036854775808)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.max" (f64.const 13837309855095848960) (f64.const 13830554455654793217)) (f64.const 13830554455654793217))
+(assert_return (invoke "f64.max" (f64.const 13837309855095848960) (f64.const 13837628693406821649)) (f64.const 13837309855095848960))
+(assert_return (invoke "f64.max" (f64.const 13837309855095848960) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.max" (f64.const 18442240474082181119) (f64.const 1)) (f64.const 1))
+(assert_return (invoke "f64.max" (f64.const 18442240474082181119) (f64.const 4607182418800017407)) (f64.const 4607182418800017407))
+(assert_return (invoke "f64.max" (f64.const 18442240474082181119) (f64.const 9218868437227405311)) (f64.const 9218868437227405311))
+(assert_return (invoke "f64.max" (f64.const 18442240474082181119) (f64.const 9223372036854775808)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.max" (f64.const 18442240474082181119) (f64.const 13830554455654793217)) (f64.const 13830554455654793217))
+(assert_return (invoke "f64.max" (f64.const 18442240474082181119) (f64.const 13837628693406821649)) (f64.const 13837628693406821649))
+(assert_return (invoke "f64.max" (f64.const 18442240474082181119) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.max" (f64.const 14069245235905429505) (f64.const 1)) (f64.const 1))
+(assert_return (invoke "f64.max" (f64.const 14069245235905429505) (f64.const 4607182418800017407)) (f64.const 4607182418800017407))
+(assert_return (invoke "f64.max" (f64.const 14069245235905429505) (f64.const 9218868437227405311)) (f64.const 9218868437227405311))
+(assert_return (invoke "f64.max" (f64.const 14069245235905429505) (f64.const 9223372036854775808)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.max" (f64.const 14069245235905429505) (f64.const 13830554455654793217)) (f64.const 13830554455654793217))
+(assert_return (invoke "f64.max" (f64.const 14069245235905429505) (f64.const 13837628693406821649)) (f64.const 13837628693406821649))
+(assert_return (invoke "f64.max" (f64.const 14069245235905429505) (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.max" (f64.const 4479442542068456390) (f64.const 4658326062132264775)) (f64.const 4658326062132264775))
+(assert_return (invoke "f64.max" (f64.const 12789138748867747919) (f64.const 84189585912099670)) (f64.const 84189585912099670))
+(assert_return (invoke "f64.max" (f64.const 7319905468405780365) (f64.const 6314007941807505665)) (f64.const 7319905468405780365))
+(assert_return (invoke "f64.max" (f64.const 9175341423141691645) (f64.const 1762166336557257871)) (f64.const 9175341423141691645))
+(assert_return (invoke "f64.max" (f64.const 17688102207158847131) (f64.const 13797357179980777486)) (f64.const 13797357179980777486))
+(assert_return (invoke "f64.max" (f64.const 10643790192858946159) (f64.const 17516564666673764628)) (f64.const 10643790192858946159))
+(assert_return (invoke "f64.max" (f64.const 4508384780023730116) (f64.const 12534043220389949321)) (f64.const 4508384780023730116))
+(assert_return (invoke "f64.max" (f64.const 7419581769995069410) (f64.const 17862020071737935853)) (f64.const 7419581769995069410))
+(assert_return (invoke "f64.max" (f64.const 6587269363342319439) (f64.const 13842376579473097412)) (f64.const 6587269363342319439))
+(assert_return (invoke "f64.max" (f64.const 13714893377981412563) (f64.const 16658439752596931608)) (f64.const 13714893377981412563))
+(assert_return (invoke "f64.max" (f64.const 344673617961295971) (f64.const 9376321132128445974)) (f64.const 344673617961295971))
+(assert_return (invoke "f64.max" (f64.const 4645506654730675864) (f64.const 13842372179913320356)) (f64.const 4645506654730675864))
+(assert_return (invoke "f64.max" (f64.const 6494005308659063551) (f64.const 10183156467563743662)) (f64.const 6494005308659063551))
+(assert_return (invoke "f64.max" (f64.const 6668990645855216620) (f64.const 13711829099230795964)) (f64.const 6668990645855216620))
+(assert_return (invoke "f64.max" (f64.const 13969331337310759470) (f64.const 11639097084295030993)) (f64.const 11639097084295030993))
+(assert_return (invoke "f64.max" (f64.const 5594924609678757984) (f64.const 12011128534783439102)) (f64.const 5594924609678757984))
+(assert_return (invoke "f64.max" (f64.const 1559378542713853588) (f64.const 12260051113399746746)) (f64.const 1559378542713853588))
+(assert_return (invoke "f64.max" (f64.const 15264250016393821145) (f64.const 14455110828961816811)) (f64.const 14455110828961816811))
+(assert_return (invoke "f64.max" (f64.const 17600321776266805078) (f64.const 12709112557383099794)) (f64.const 12709112557383099794))
+(assert_return (invoke "f64.max" (f64.const 3257691883711469068) (f64.const 16446527998639110595)) (f64.const 3257691883711469068))
+(assert_return (invoke "f64.max" (f64.const 4696638442755682683) (f64.const 1493543706615523411)) (f64.const 4696638442755682683))
+(assert_return (invoke "f64.max" (f64.const 3279813860005924700) (f64.const 2668387779522759248)) (f64.const 3279813860005924700))
+(assert_return (invoke "f64.max" (f64.const 6889825848244259614) (f64.const 13967974584941100263)) (f64.const 6889825848244259614))
+(assert_return (invoke "f64.max" (f64.const 6385706582412119432) (f64.const 4686401042529574076)) (f64.const 6385706582412119432))
+(assert_return (invoke "f64.max" (f64.const 13757707586193454707) (f64.const 13549073872275796254)) (f64.const 13549073872275796254))
+(assert_return (invoke "f64.max" (f64.const 13839991116631993162) (f64.const 16137621417303203859)) (f64.const 13839991116631993162))
+(assert_return (invoke "f64.max" (f64.const 13954168540169185678) (f64.const 13600978127698900165)) (f64.const 13600978127698900165))
+(assert_return (invoke "f64.max" (f64.const 12415317109887488399) (f64.const 5211059622938370867)) (f64.const 5211059622938370867))
+(assert_return (invoke "f64.max" (f64.const 4673818131806798903) (f64.const 13728089297753836529)) (f64.const 4673818131806798903))
+(assert_return (invoke "f64.max" (f64.const 15008396893266747808) (f64.const 5983130654513236086)) (f64.const 5983130654513236086))
+(assert_return (invoke "f64.max" (f64.const 4381090884840699438) (f64.const 16581577427439653938)) (f64.const 4381090884840699438))
+(assert_return (invoke "f64.max" (f64.const 250199588944786404) (f64.const 4209253637560982088)) (f64.const 4209253637560982088))
+(assert_return (invoke "f64.max" (f64.const 9317820557184063514) (f64.const 9614260359399963872)) (f64.const 9317820557184063514))
+(assert_return (invoke "f64.max" (f64.const 437632797237140534) (f64.const 13943686417967778919)) (f64.const 437632797237140534))
+(assert_return (invoke "f64.max" (f64.const 13881575554942453080) (f64.const 13947441051024614846)) (f64.const 13881575554942453080))
+(assert_return (invoke "f64.max" (f64.const 13708978474778613494) (f64.const 7909456153798955085)) (f64.const 7909456153798955085))
+(assert_return (invoke "f64.max" (f64.const 6885280714859199995) (f64.const 9671411682277301759)) (f64.const 6885280714859199995))
+(assert_return (invoke "f64.max" (f64.const 15735833712668068504) (f64.const 1648298795280361256)) (f64.const 1648298795280361256))
+(assert_return (invoke "f64.max" (f64.const 7482450267180925473) (f64.const 7440824681933366093)) (f64.const 7482450267180925473))
+(assert_return (invoke "f64.max" (f64.const 18180914292047519237) (f64.const 14664450635093637955)) (f64.const 14664450635093637955))
+(assert_return (invoke "f64.sqrt" (f64.const 0)) (f64.const 0))
+(assert_return (invoke "f64.sqrt" (f64.const 1)) (f64.const 2188749418902061056))
+(assert_return (invoke "f64.sqrt" (f64.const 4503599627370495)) (f64.const 2305843009213693951))
+(assert_return (invoke "f64.sqrt" (f64.const 4503599627370496)) (f64.const 2305843009213693952))
+(assert_return (invoke "f64.sqrt" (f64.const 4607182418800017408)) (f64.const 4607182418800017408))
+(assert_return (invoke "f64.sqrt" (f64.const 4607182418800017409)) (f64.const 4607182418800017408))
+(assert_return (invoke "f64.sqrt" (f64.const 4607182418800017407)) (f64.const 4607182418800017407))
+(assert_return (invoke "f64.sqrt" (f64.const 4602678819172646912)) (f64.const 4604544271217802189))
+(assert_return (invoke "f64.sqrt" (f64.const 4613937818241073152)) (f64.const 4610479282544200874))
+(assert_return (invoke "f64.sqrt" (f64.const 4591870180066957722)) (f64.const 4599368272914696463))
+(assert_return (invoke "f64.sqrt" (f64.const 4614256656552045841)) (f64.const 4610661241675116391))
+(assert_return (invoke "f64.sqrt" (f64.const 9218868437227405311)) (f64.const 6913025428013711359))
+(assert_return (invoke "f64.sqrt" (f64.const 9218868437227405312)) (f64.const 9218868437227405312))
+(assert_return (invoke "f64.sqrt" (f64.const 4841369599423283201)) (f64.const 4724276009111650304))
+(assert_return (invoke "f64.sqrt" (f64.const 4845873199050653697)) (f64.const 4726141461156805581))
+(assert_return (invoke "f64.sqrt" (f64.const 9221120237041090560)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sqrt" (f64.const 9223372036854775808)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.sqrt" (f64.const 9223372036854775809)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sqrt" (f64.const 9227875636482146303)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sqrt" (f64.const 9227875636482146304)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sqrt" (f64.const 13830554455654793216)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sqrt" (f64.const 13830554455654793217)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sqrt" (f64.const 13830554455654793215)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sqrt" (f64.const 13826050856027422720)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sqrt" (f64.const 13837309855095848960)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sqrt" (f64.const 13815242216921733530)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sqrt" (f64.const 13837628693406821649)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sqrt" (f64.const 18442240474082181119)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sqrt" (f64.const 18442240474082181120)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sqrt" (f64.const 14064741636278059009)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sqrt" (f64.const 14069245235905429505)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sqrt" (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sqrt" (f64.const 12972287372605628434)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sqrt" (f64.const 4571414285553183438)) (f64.const 4589296518985813673))
+(assert_return (invoke "f64.sqrt" (f64.const 4493595893465242335)) (f64.const 4550138422782030181))
+(assert_return (invoke "f64.sqrt" (f64.const 3248157794226945867)) (f64.const 3927642002623386276))
+(assert_return (invoke "f64.sqrt" (f64.const 13919708460636019594)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sqrt" (f64.const 3057418528014117530)) (f64.const 3832296523192613448))
+(assert_return (invoke "f64.sqrt" (f64.const 13837750372162133533)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sqrt" (f64.const 4503701071319558751)) (f64.const 4555076007566739999))
+(assert_return (invoke "f64.sqrt" (f64.const 5825107414827136010)) (f64.const 5216057205690719365))
+(assert_return (invoke "f64.sqrt" (f64.const 2080760650508172131)) (f64.const 3343605035119605737))
+(assert_return (invoke "f64.sqrt" (f64.const 11892974757667500880)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sqrt" (f64.const 4746299366455547177)) (f64.const 4676424514086011818))
+(assert_return (invoke "f64.sqrt" (f64.const 4694506066714129972)) (f64.const 4650719527925027537))
+(assert_return (invoke "f64.sqrt" (f64.const 1147693412202748412)) (f64.const 2877430322626114138))
+(assert_return (invoke "f64.sqrt" (f64.const 11877341035894667054)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sqrt" (f64.const 3510507737504720720)) (f64.const 4058735723845873394))
+(assert_return (invoke "f64.sqrt" (f64.const 1175588425919528485)) (f64.const 2891384816765553215))
+(assert_return (invoke "f64.sqrt" (f64.const 11183018813359658203)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sqrt" (f64.const 4642787735809643139)) (f64.const 4624982528243660457))
+(assert_return (invoke "f64.sqrt" (f64.const 2794010090152847191)) (f64.const 3700473829276603132))
+(assert_return (invoke "f64.sqrt" (f64.const 16284147016930906363)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sqrt" (f64.const 13015785644886096056)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sqrt" (f64.const 12531571989080944914)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sqrt" (f64.const 3596520987316549935)) (f64.const 4101798283828228089))
+(assert_return (invoke "f64.sqrt" (f64.const 13881505992509535277)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sqrt" (f64.const 719554566229596560)) (f64.const 2663120859351620229))
+(assert_return (invoke "f64.sqrt" (f64.const 12151944803962084479)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.sqrt" (f64.const 4171260012895481004)) (f64.const 4388996171250239708))
+(assert_return (invoke "f64.sqrt" (f64.const 8096187132563561985)) (f64.const 6351469137480523844))
+(assert_return (invoke "f64.sqrt" (f64.const 13918056961288196626)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.ceil" (f64.const 0)) (f64.const 0))
+(assert_return (invoke "f64.ceil" (f64.const 1)) (f64.const 4607182418800017408))
+(assert_return (invoke "f64.ceil" (f64.const 4503599627370495)) (f64.const 4607182418800017408))
+(assert_return (invoke "f64.ceil" (f64.const 4503599627370496)) (f64.const 4607182418800017408))
+(assert_return (invoke "f64.ceil" (f64.const 4607182418800017408)) (f64.const 4607182418800017408))
+(assert_return (invoke "f64.ceil" (f64.const 4607182418800017409)) (f64.const 4611686018427387904))
+(assert_return (invoke "f64.ceil" (f64.const 4607182418800017407)) (f64.const 4607182418800017408))
+(assert_return (invoke "f64.ceil" (f64.const 4602678819172646912)) (f64.const 4607182418800017408))
+(assert_return (invoke "f64.ceil" (f64.const 4613937818241073152)) (f64.const 4613937818241073152))
+(assert_return (invoke "f64.ceil" (f64.const 4591870180066957722)) (f64.const 4607182418800017408))
+(assert_return (invoke "f64.ceil" (f64.const 4614256656552045841)) (f64.const 4616189618054758400))
+(assert_return (invoke "f64.ceil" (f64.const 9218868437227405311)) (f64.const 9218868437227405311))
+(assert_return (invoke "f64.ceil" (f64.const 9218868437227405312)) (f64.const 9218868437227405312))
+(assert_return (invoke "f64.ceil" (f64.const 4841369599423283201)) (f64.const 4841369599423283201))
+(assert_return (invoke "f64.ceil" (f64.const 4845873199050653697)) (f64.const 4845873199050653697))
+(assert_return (invoke "f64.ceil" (f64.const 9221120237041090560)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.ceil" (f64.const 9223372036854775808)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.ceil" (f64.const 9223372036854775809)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.ceil" (f64.const 9227875636482146303)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.ceil" (f64.const 9227875636482146304)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.ceil" (f64.const 13830554455654793216)) (f64.const 13830554455654793216))
+(assert_return (invoke "f64.ceil" (f64.const 13830554455654793217)) (f64.const 13830554455654793216))
+(assert_return (invoke "f64.ceil" (f64.const 13830554455654793215)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.ceil" (f64.const 13826050856027422720)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.ceil" (f64.const 13837309855095848960)) (f64.const 13837309855095848960))
+(assert_return (invoke "f64.ceil" (f64.const 13815242216921733530)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.ceil" (f64.const 13837628693406821649)) (f64.const 13837309855095848960))
+(assert_return (invoke "f64.ceil" (f64.const 18442240474082181119)) (f64.const 18442240474082181119))
+(assert_return (invoke "f64.ceil" (f64.const 18442240474082181120)) (f64.const 18442240474082181120))
+(assert_return (invoke "f64.ceil" (f64.const 14064741636278059009)) (f64.const 14064741636278059009))
+(assert_return (invoke "f64.ceil" (f64.const 14069245235905429505)) (f64.const 14069245235905429505))
+(assert_return (invoke "f64.ceil" (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.ceil" (f64.const 10955592867097600763)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.ceil" (f64.const 5822960701037050354)) (f64.const 5822960701037050354))
+(assert_return (invoke "f64.ceil" (f64.const 10591145753932779113)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.ceil" (f64.const 14624641008859551406)) (f64.const 14624641008859551406))
+(assert_return (invoke "f64.ceil" (f64.const 16053150555663881637)) (f64.const 16053150555663881637))
+(assert_return (invoke "f64.ceil" (f64.const 4742340094188302011)) (f64.const 4742340094189371392))
+(assert_return (invoke "f64.ceil" (f64.const 11902251449656665179)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.ceil" (f64.const 13785422869407593193)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.ceil" (f64.const 4103288003735917668)) (f64.const 4607182418800017408))
+(assert_return (invoke "f64.ceil" (f64.const 14682760129675013127)) (f64.const 14682760129675013127))
+(assert_return (invoke "f64.ceil" (f64.const 4694692383634671127)) (f64.const 4694692386045952000))
+(assert_return (invoke "f64.ceil" (f64.const 4451155715148797144)) (f64.const 4607182418800017408))
+(assert_return (invoke "f64.ceil" (f64.const 8686796595539790989)) (f64.const 8686796595539790989))
+(assert_return (invoke "f64.ceil" (f64.const 17403309994476998492)) (f64.const 17403309994476998492))
+(assert_return (invoke "f64.ceil" (f64.const 4656629013950062832)) (f64.const 4656629655724359680))
+(assert_return (invoke "f64.ceil" (f64.const 4729366627704626051)) (f64.const 4729366627722723328))
+(assert_return (invoke "f64.ceil" (f64.const 4561311300239415106)) (f64.const 4607182418800017408))
+(assert_return (invoke "f64.ceil" (f64.const 13754425173702300332)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.ceil" (f64.const 4501236825169443859)) (f64.const 4607182418800017408))
+(assert_return (invoke "f64.ceil" (f64.const 18202519064296110051)) (f64.const 18202519064296110051))
+(assert_return (invoke "f64.ceil" (f64.const 13306274240033235663)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.ceil" (f64.const 4474077531479704440)) (f64.const 4607182418800017408))
+(assert_return (invoke "f64.ceil" (f64.const 9678691591367098144)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.ceil" (f64.const 5546208365251981784)) (f64.const 5546208365251981784))
+(assert_return (invoke "f64.ceil" (f64.const 3482806572351058147)) (f64.const 4607182418800017408))
+(assert_return (invoke "f64.ceil" (f64.const 16940640954361892513)) (f64.const 16940640954361892513))
+(assert_return (invoke "f64.ceil" (f64.const 2747041091435109765)) (f64.const 4607182418800017408))
+(assert_return (invoke "f64.ceil" (f64.const 14494646574546363952)) (f64.const 14494646574546363952))
+(assert_return (invoke "f64.ceil" (f64.const 2483770343833917853)) (f64.const 4607182418800017408))
+(assert_return (invoke "f64.ceil" (f64.const 13787417426219030094)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.ceil" (f64.const 13838435755002691584)) (f64.const 13837309855095848960))
+(assert_return (invoke "f64.ceil" (f64.const 13837309855095848960)) (f64.const 13837309855095848960))
+(assert_return (invoke "f64.ceil" (f64.const 13836183955189006336)) (f64.const 13835058055282163712))
+(assert_return (invoke "f64.ceil" (f64.const 13835058055282163712)) (f64.const 13835058055282163712))
+(assert_return (invoke "f64.ceil" (f64.const 13832806255468478464)) (f64.const 13830554455654793216))
+(assert_return (invoke "f64.ceil" (f64.const 13830554455654793216)) (f64.const 13830554455654793216))
+(assert_return (invoke "f64.ceil" (f64.const 13826050856027422720)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.ceil" (f64.const 0)) (f64.const 0))
+(assert_return (invoke "f64.ceil" (f64.const 4602678819172646912)) (f64.const 4607182418800017408))
+(assert_return (invoke "f64.ceil" (f64.const 4607182418800017408)) (f64.const 4607182418800017408))
+(assert_return (invoke "f64.ceil" (f64.const 4609434218613702656)) (f64.const 4611686018427387904))
+(assert_return (invoke "f64.ceil" (f64.const 4611686018427387904)) (f64.const 4611686018427387904))
+(assert_return (invoke "f64.ceil" (f64.const 4612811918334230528)) (f64.const 4613937818241073152))
+(assert_return (invoke "f64.ceil" (f64.const 4613937818241073152)) (f64.const 4613937818241073152))
+(assert_return (invoke "f64.ceil" (f64.const 4615063718147915776)) (f64.const 4616189618054758400))
+(assert_return (invoke "f64.floor" (f64.const 0)) (f64.const 0))
+(assert_return (invoke "f64.floor" (f64.const 1)) (f64.const 0))
+(assert_return (invoke "f64.floor" (f64.const 4503599627370495)) (f64.const 0))
+(assert_return (invoke "f64.floor" (f64.const 4503599627370496)) (f64.const 0))
+(assert_return (invoke "f64.floor" (f64.const 4607182418800017408)) (f64.const 4607182418800017408))
+(assert_return (invoke "f64.floor" (f64.const 4607182418800017409)) (f64.const 4607182418800017408))
+(assert_return (invoke "f64.floor" (f64.const 4607182418800017407)) (f64.const 0))
+(assert_return (invoke "f64.floor" (f64.const 4602678819172646912)) (f64.const 0))
+(assert_return (invoke "f64.floor" (f64.const 4613937818241073152)) (f64.const 4613937818241073152))
+(assert_return (invoke "f64.floor" (f64.const 4591870180066957722)) (f64.const 0))
+(assert_return (invoke "f64.floor" (f64.const 4614256656552045841)) (f64.const 4613937818241073152))
+(assert_return (invoke "f64.floor" (f64.const 9218868437227405311)) (f64.const 9218868437227405311))
+(assert_return (invoke "f64.floor" (f64.const 9218868437227405312)) (f64.const 9218868437227405312))
+(assert_return (invoke "f64.floor" (f64.const 4841369599423283201)) (f64.const 4841369599423283201))
+(assert_return (invoke "f64.floor" (f64.const 4845873199050653697)) (f64.const 4845873199050653697))
+(assert_return (invoke "f64.floor" (f64.const 9221120237041090560)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.floor" (f64.const 9223372036854775808)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.floor" (f64.const 9223372036854775809)) (f64.const 13830554455654793216))
+(assert_return (invoke "f64.floor" (f64.const 9227875636482146303)) (f64.const 13830554455654793216))
+(assert_return (invoke "f64.floor" (f64.const 9227875636482146304)) (f64.const 13830554455654793216))
+(assert_return (invoke "f64.floor" (f64.const 13830554455654793216)) (f64.const 13830554455654793216))
+(assert_return (invoke "f64.floor" (f64.const 13830554455654793217)) (f64.const 13835058055282163712))
+(assert_return (invoke "f64.floor" (f64.const 13830554455654793215)) (f64.const 13830554455654793216))
+(assert_return (invoke "f64.floor" (f64.const 13826050856027422720)) (f64.const 13830554455654793216))
+(assert_return (invoke "f64.floor" (f64.const 13837309855095848960)) (f64.const 13837309855095848960))
+(assert_return (invoke "f64.floor" (f64.const 13815242216921733530)) (f64.const 13830554455654793216))
+(assert_return (invoke "f64.floor" (f64.const 13837628693406821649)) (f64.const 13839561654909534208))
+(assert_return (invoke "f64.floor" (f64.const 18442240474082181119)) (f64.const 18442240474082181119))
+(assert_return (invoke "f64.floor" (f64.const 18442240474082181120)) (f64.const 18442240474082181120))
+(assert_return (invoke "f64.floor" (f64.const 14064741636278059009)) (f64.const 14064741636278059009))
+(assert_return (invoke "f64.floor" (f64.const 14069245235905429505)) (f64.const 14069245235905429505))
+(assert_return (invoke "f64.floor" (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.floor" (f64.const 13766742000566220395)) (f64.const 13830554455654793216))
+(assert_return (invoke "f64.floor" (f64.const 7919201752501745186)) (f64.const 7919201752501745186))
+(assert_return (invoke "f64.floor" (f64.const 17881464372604722571)) (f64.const 17881464372604722571))
+(assert_return (invoke "f64.floor" (f64.const 1144605092278865790)) (f64.const 0))
+(assert_return (invoke "f64.floor" (f64.const 15329406325099151421)) (f64.const 15329406325099151421))
+(assert_return (invoke "f64.floor" (f64.const 12270924496021474412)) (f64.const 13830554455654793216))
+(assert_return (invoke "f64.floor" (f64.const 13781521412942571045)) (f64.const 13830554455654793216))
+(assert_return (invoke "f64.floor" (f64.const 9319110366686685262)) (f64.const 13830554455654793216))
+(assert_return (invoke "f64.floor" (f64.const 16798076037677622887)) (f64.const 16798076037677622887))
+(assert_return (invoke "f64.floor" (f64.const 14302660896278480401)) (f64.const 14302660896278480401))
+(assert_return (invoke "f64.floor" (f64.const 1891565132935046644)) (f64.const 0))
+(assert_return (invoke "f64.floor" (f64.const 490475771563923477)) (f64.const 0))
+(assert_return (invoke "f64.floor" (f64.const 219077115002024578)) (f64.const 0))
+(assert_return (invoke "f64.floor" (f64.const 3948469197968413810)) (f64.const 0))
+(assert_return (invoke "f64.floor" (f64.const 8511863101272841983)) (f64.const 8511863101272841983))
+(assert_return (invoke "f64.floor" (f64.const 17112825263571990598)) (f64.const 17112825263571990598))
+(assert_return (invoke "f64.floor" (f64.const 13814938647209779989)) (f64.const 13830554455654793216))
+(assert_return (invoke "f64.floor" (f64.const 4600256086649863141)) (f64.const 0))
+(assert_return (invoke "f64.floor" (f64.const 7227544853619976732)) (f64.const 7227544853619976732))
+(assert_return (invoke "f64.floor" (f64.const 4500158508612085470)) (f64.const 0))
+(assert_return (invoke "f64.floor" (f64.const 3456051567499895488)) (f64.const 0))
+(assert_return (invoke "f64.floor" (f64.const 17229306309081352307)) (f64.const 17229306309081352307))
+(assert_return (invoke "f64.floor" (f64.const 3520580766152898254)) (f64.const 0))
+(assert_return (invoke "f64.floor" (f64.const 13825912830453015067)) (f64.const 13830554455654793216))
+(assert_return (invoke "f64.floor" (f64.const 4591555478734527450)) (f64.const 0))
+(assert_return (invoke "f64.floor" (f64.const 13714502088393368126)) (f64.const 13830554455654793216))
+(assert_return (invoke "f64.floor" (f64.const 14788548516396258416)) (f64.const 14788548516396258416))
+(assert_return (invoke "f64.floor" (f64.const 14359131361818114641)) (f64.const 14359131361818114641))
+(assert_return (invoke "f64.floor" (f64.const 14276105768008357120)) (f64.const 14276105768008357120))
+(assert_return (invoke "f64.floor" (f64.const 9867741857917550257)) (f64.const 13830554455654793216))
+(assert_return (invoke "f64.floor" (f64.const 13838435755002691584)) (f64.const 13839561654909534208))
+(assert_return (invoke "f64.floor" (f64.const 13837309855095848960)) (f64.const 13837309855095848960))
+(assert_return (invoke "f64.floor" (f64.const 13836183955189006336)) (f64.const 13837309855095848960))
+(assert_return (invoke "f64.floor" (f64.const 13835058055282163712)) (f64.const 13835058055282163712))
+(assert_return (invoke "f64.floor" (f64.const 13832806255468478464)) (f64.const 13835058055282163712))
+(assert_return (invoke "f64.floor" (f64.const 13830554455654793216)) (f64.const 13830554455654793216))
+(assert_return (invoke "f64.floor" (f64.const 13826050856027422720)) (f64.const 13830554455654793216))
+(assert_return (invoke "f64.floor" (f64.const 0)) (f64.const 0))
+(assert_return (invoke "f64.floor" (f64.const 4602678819172646912)) (f64.const 0))
+(assert_return (invoke "f64.floor" (f64.const 4607182418800017408)) (f64.const 4607182418800017408))
+(assert_return (invoke "f64.floor" (f64.const 4609434218613702656)) (f64.const 4607182418800017408))
+(assert_return (invoke "f64.floor" (f64.const 4611686018427387904)) (f64.const 4611686018427387904))
+(assert_return (invoke "f64.floor" (f64.const 4612811918334230528)) (f64.const 4611686018427387904))
+(assert_return (invoke "f64.floor" (f64.const 4613937818241073152)) (f64.const 4613937818241073152))
+(assert_return (invoke "f64.floor" (f64.const 4615063718147915776)) (f64.const 4613937818241073152))
+(assert_return (invoke "f64.trunc" (f64.const 0)) (f64.const 0))
+(assert_return (invoke "f64.trunc" (f64.const 1)) (f64.const 0))
+(assert_return (invoke "f64.trunc" (f64.const 4503599627370495)) (f64.const 0))
+(assert_return (invoke "f64.trunc" (f64.const 4503599627370496)) (f64.const 0))
+(assert_return (invoke "f64.trunc" (f64.const 4607182418800017408)) (f64.const 4607182418800017408))
+(assert_return (invoke "f64.trunc" (f64.const 4607182418800017409)) (f64.const 4607182418800017408))
+(assert_return (invoke "f64.trunc" (f64.const 4607182418800017407)) (f64.const 0))
+(assert_return (invoke "f64.trunc" (f64.const 4602678819172646912)) (f64.const 0))
+(assert_return (invoke "f64.trunc" (f64.const 4613937818241073152)) (f64.const 4613937818241073152))
+(assert_return (invoke "f64.trunc" (f64.const 4591870180066957722)) (f64.const 0))
+(assert_return (invoke "f64.trunc" (f64.const 4614256656552045841)) (f64.const 4613937818241073152))
+(assert_return (invoke "f64.trunc" (f64.const 9218868437227405311)) (f64.const 9218868437227405311))
+(assert_return (invoke "f64.trunc" (f64.const 9218868437227405312)) (f64.const 9218868437227405312))
+(assert_return (invoke "f64.trunc" (f64.const 4841369599423283201)) (f64.const 4841369599423283201))
+(assert_return (invoke "f64.trunc" (f64.const 4845873199050653697)) (f64.const 4845873199050653697))
+(assert_return (invoke "f64.trunc" (f64.const 9221120237041090560)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.trunc" (f64.const 9223372036854775808)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.trunc" (f64.const 9223372036854775809)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.trunc" (f64.const 9227875636482146303)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.trunc" (f64.const 9227875636482146304)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.trunc" (f64.const 13830554455654793216)) (f64.const 13830554455654793216))
+(assert_return (invoke "f64.trunc" (f64.const 13830554455654793217)) (f64.const 13830554455654793216))
+(assert_return (invoke "f64.trunc" (f64.const 13830554455654793215)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.trunc" (f64.const 13826050856027422720)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.trunc" (f64.const 13837309855095848960)) (f64.const 13837309855095848960))
+(assert_return (invoke "f64.trunc" (f64.const 13815242216921733530)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.trunc" (f64.const 13837628693406821649)) (f64.const 13837309855095848960))
+(assert_return (invoke "f64.trunc" (f64.const 18442240474082181119)) (f64.const 18442240474082181119))
+(assert_return (invoke "f64.trunc" (f64.const 18442240474082181120)) (f64.const 18442240474082181120))
+(assert_return (invoke "f64.trunc" (f64.const 14064741636278059009)) (f64.const 14064741636278059009))
+(assert_return (invoke "f64.trunc" (f64.const 14069245235905429505)) (f64.const 14069245235905429505))
+(assert_return (invoke "f64.trunc" (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.trunc" (f64.const 13963074558380657978)) (f64.const 13963074558379425792))
+(assert_return (invoke "f64.trunc" (f64.const 10850675930045733225)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.trunc" (f64.const 14808824334500757581)) (f64.const 14808824334500757581))
+(assert_return (invoke "f64.trunc" (f64.const 4595311517951922001)) (f64.const 0))
+(assert_return (invoke "f64.trunc" (f64.const 8129372407450198067)) (f64.const 8129372407450198067))
+(assert_return (invoke "f64.trunc" (f64.const 3420824307514774625)) (f64.const 0))
+(assert_return (invoke "f64.trunc" (f64.const 14794021580403249492)) (f64.const 14794021580403249492))
+(assert_return (invoke "f64.trunc" (f64.const 3099240534333250041)) (f64.const 0))
+(assert_return (invoke "f64.trunc" (f64.const 4536385945001931999)) (f64.const 0))
+(assert_return (invoke "f64.trunc" (f64.const 9196159110675824752)) (f64.const 9196159110675824752))
+(assert_return (invoke "f64.trunc" (f64.const 13761339613067544729)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.trunc" (f64.const 9458622893864689182)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.trunc" (f64.const 9748188863476026694)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.trunc" (f64.const 11840729244752974162)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.trunc" (f64.const 6290308844395691812)) (f64.const 6290308844395691812))
+(assert_return (invoke "f64.trunc" (f64.const 4581207254433360497)) (f64.const 0))
+(assert_return (invoke "f64.trunc" (f64.const 13869701086757235433)) (f64.const 13869697069603618816))
+(assert_return (invoke "f64.trunc" (f64.const 5568798981485826808)) (f64.const 5568798981485826808))
+(assert_return (invoke "f64.trunc" (f64.const 13930209123186129975)) (f64.const 13930209122608742400))
+(assert_return (invoke "f64.trunc" (f64.const 13946263087424408932)) (f64.const 13946263087322497024))
+(assert_return (invoke "f64.trunc" (f64.const 5886510416413098299)) (f64.const 5886510416413098299))
+(assert_return (invoke "f64.trunc" (f64.const 2510444323044815603)) (f64.const 0))
+(assert_return (invoke "f64.trunc" (f64.const 752033146444293760)) (f64.const 0))
+(assert_return (invoke "f64.trunc" (f64.const 17703588716598091307)) (f64.const 17703588716598091307))
+(assert_return (invoke "f64.trunc" (f64.const 14861190739069747638)) (f64.const 14861190739069747638))
+(assert_return (invoke "f64.trunc" (f64.const 16189892375584404203)) (f64.const 16189892375584404203))
+(assert_return (invoke "f64.trunc" (f64.const 10701535026842456806)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.trunc" (f64.const 4573424432215175370)) (f64.const 0))
+(assert_return (invoke "f64.trunc" (f64.const 11318696861027152044)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.trunc" (f64.const 999035835545618601)) (f64.const 0))
+(assert_return (invoke "f64.trunc" (f64.const 13838435755002691584)) (f64.const 13837309855095848960))
+(assert_return (invoke "f64.trunc" (f64.const 13837309855095848960)) (f64.const 13837309855095848960))
+(assert_return (invoke "f64.trunc" (f64.const 13836183955189006336)) (f64.const 13835058055282163712))
+(assert_return (invoke "f64.trunc" (f64.const 13835058055282163712)) (f64.const 13835058055282163712))
+(assert_return (invoke "f64.trunc" (f64.const 13832806255468478464)) (f64.const 13830554455654793216))
+(assert_return (invoke "f64.trunc" (f64.const 13830554455654793216)) (f64.const 13830554455654793216))
+(assert_return (invoke "f64.trunc" (f64.const 13826050856027422720)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.trunc" (f64.const 0)) (f64.const 0))
+(assert_return (invoke "f64.trunc" (f64.const 4602678819172646912)) (f64.const 0))
+(assert_return (invoke "f64.trunc" (f64.const 4607182418800017408)) (f64.const 4607182418800017408))
+(assert_return (invoke "f64.trunc" (f64.const 4609434218613702656)) (f64.const 4607182418800017408))
+(assert_return (invoke "f64.trunc" (f64.const 4611686018427387904)) (f64.const 4611686018427387904))
+(assert_return (invoke "f64.trunc" (f64.const 4612811918334230528)) (f64.const 4611686018427387904))
+(assert_return (invoke "f64.trunc" (f64.const 4613937818241073152)) (f64.const 4613937818241073152))
+(assert_return (invoke "f64.trunc" (f64.const 4615063718147915776)) (f64.const 4613937818241073152))
+(assert_return (invoke "f64.nearest" (f64.const 0)) (f64.const 0))
+(assert_return (invoke "f64.nearest" (f64.const 1)) (f64.const 0))
+(assert_return (invoke "f64.nearest" (f64.const 4503599627370495)) (f64.const 0))
+(assert_return (invoke "f64.nearest" (f64.const 4503599627370496)) (f64.const 0))
+(assert_return (invoke "f64.nearest" (f64.const 4607182418800017408)) (f64.const 4607182418800017408))
+(assert_return (invoke "f64.nearest" (f64.const 4607182418800017409)) (f64.const 4607182418800017408))
+(assert_return (invoke "f64.nearest" (f64.const 4607182418800017407)) (f64.const 4607182418800017408))
+(assert_return (invoke "f64.nearest" (f64.const 4602678819172646912)) (f64.const 0))
+(assert_return (invoke "f64.nearest" (f64.const 4613937818241073152)) (f64.const 4613937818241073152))
+(assert_return (invoke "f64.nearest" (f64.const 4591870180066957722)) (f64.const 0))
+(assert_return (invoke "f64.nearest" (f64.const 4614256656552045841)) (f64.const 4613937818241073152))
+(assert_return (invoke "f64.nearest" (f64.const 9218868437227405311)) (f64.const 9218868437227405311))
+(assert_return (invoke "f64.nearest" (f64.const 9218868437227405312)) (f64.const 9218868437227405312))
+(assert_return (invoke "f64.nearest" (f64.const 4841369599423283201)) (f64.const 4841369599423283201))
+(assert_return (invoke "f64.nearest" (f64.const 4845873199050653697)) (f64.const 4845873199050653697))
+(assert_return (invoke "f64.nearest" (f64.const 9221120237041090560)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.nearest" (f64.const 9223372036854775808)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.nearest" (f64.const 9223372036854775809)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.nearest" (f64.const 9227875636482146303)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.nearest" (f64.const 9227875636482146304)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.nearest" (f64.const 13830554455654793216)) (f64.const 13830554455654793216))
+(assert_return (invoke "f64.nearest" (f64.const 13830554455654793217)) (f64.const 13830554455654793216))
+(assert_return (invoke "f64.nearest" (f64.const 13830554455654793215)) (f64.const 13830554455654793216))
+(assert_return (invoke "f64.nearest" (f64.const 13826050856027422720)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.nearest" (f64.const 13837309855095848960)) (f64.const 13837309855095848960))
+(assert_return (invoke "f64.nearest" (f64.const 13815242216921733530)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.nearest" (f64.const 13837628693406821649)) (f64.const 13837309855095848960))
+(assert_return (invoke "f64.nearest" (f64.const 18442240474082181119)) (f64.const 18442240474082181119))
+(assert_return (invoke "f64.nearest" (f64.const 18442240474082181120)) (f64.const 18442240474082181120))
+(assert_return (invoke "f64.nearest" (f64.const 14064741636278059009)) (f64.const 14064741636278059009))
+(assert_return (invoke "f64.nearest" (f64.const 14069245235905429505)) (f64.const 14069245235905429505))
+(assert_return (invoke "f64.nearest" (f64.const 18444492273895866368)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.nearest" (f64.const 11516131057235801908)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.nearest" (f64.const 13742095386053674564)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.nearest" (f64.const 10167118860634725520)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.nearest" (f64.const 7635663727008066664)) (f64.const 7635663727008066664))
+(assert_return (invoke "f64.nearest" (f64.const 6218650840826491136)) (f64.const 6218650840826491136))
+(assert_return (invoke "f64.nearest" (f64.const 3360368998104007527)) (f64.const 0))
+(assert_return (invoke "f64.nearest" (f64.const 4586641425205595403)) (f64.const 0))
+(assert_return (invoke "f64.nearest" (f64.const 11521736815818829064)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.nearest" (f64.const 10776117022297593365)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.nearest" (f64.const 13322987515217668877)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.nearest" (f64.const 8445800480637635024)) (f64.const 8445800480637635024))
+(assert_return (invoke "f64.nearest" (f64.const 13712764270249981410)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.nearest" (f64.const 11985343217951377493)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.nearest" (f64.const 178941687180614871)) (f64.const 0))
+(assert_return (invoke "f64.nearest" (f64.const 1976791164094945222)) (f64.const 0))
+(assert_return (invoke "f64.nearest" (f64.const 14174985676237619404)) (f64.const 14174985676237619404))
+(assert_return (invoke "f64.nearest" (f64.const 11829902152157170800)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.nearest" (f64.const 7885816532998663629)) (f64.const 7885816532998663629))
+(assert_return (invoke "f64.nearest" (f64.const 13706235074699905882)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.nearest" (f64.const 14936400575631935090)) (f64.const 14936400575631935090))
+(assert_return (invoke "f64.nearest" (f64.const 4620691725759413007)) (f64.const 4620693217682128896))
+(assert_return (invoke "f64.nearest" (f64.const 4658368778608743214)) (f64.const 4658369083119501312))
+(assert_return (invoke "f64.nearest" (f64.const 4610184639050828798)) (f64.const 4611686018427387904))
+(assert_return (invoke "f64.nearest" (f64.const 4483081585889434835)) (f64.const 0))
+(assert_return (invoke "f64.nearest" (f64.const 8726054519137664778)) (f64.const 8726054519137664778))
+(assert_return (invoke "f64.nearest" (f64.const 9502025636962922818)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.nearest" (f64.const 16020575055812708467)) (f64.const 16020575055812708467))
+(assert_return (invoke "f64.nearest" (f64.const 14834319966083786906)) (f64.const 14834319966083786906))
+(assert_return (invoke "f64.nearest" (f64.const 1470325685417413018)) (f64.const 0))
+(assert_return (invoke "f64.nearest" (f64.const 11399355354455729278)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.nearest" (f64.const 13838435755002691584)) (f64.const 13839561654909534208))
+(assert_return (invoke "f64.nearest" (f64.const 13837309855095848960)) (f64.const 13837309855095848960))
+(assert_return (invoke "f64.nearest" (f64.const 13836183955189006336)) (f64.const 13835058055282163712))
+(assert_return (invoke "f64.nearest" (f64.const 13835058055282163712)) (f64.const 13835058055282163712))
+(assert_return (invoke "f64.nearest" (f64.const 13832806255468478464)) (f64.const 13835058055282163712))
+(assert_return (invoke "f64.nearest" (f64.const 13830554455654793216)) (f64.const 13830554455654793216))
+(assert_return (invoke "f64.nearest" (f64.const 13826050856027422720)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.nearest" (f64.const 0)) (f64.const 0))
+(assert_return (invoke "f64.nearest" (f64.const 4602678819172646912)) (f64.const 0))
+(assert_return (invoke "f64.nearest" (f64.const 4607182418800017408)) (f64.const 4607182418800017408))
+(assert_return (invoke "f64.nearest" (f64.const 4609434218613702656)) (f64.const 4611686018427387904))
+(assert_return (invoke "f64.nearest" (f64.const 4611686018427387904)) (f64.const 4611686018427387904))
+(assert_return (invoke "f64.nearest" (f64.const 4612811918334230528)) (f64.const 4611686018427387904))
+(assert_return (invoke "f64.nearest" (f64.const 4613937818241073152)) (f64.const 4613937818241073152))
+(assert_return (invoke "f64.nearest" (f64.const 4615063718147915776)) (f64.const 4616189618054758400))
+;; Conversions between formats, and from integers.
+(module
+  (func (export "f32.demote") (param f64) (result f32) (f32.demote/f64 (get_local 0)))
+  (func (export "f64.promote") (param f32) (result f64) (f64.promote/f32 (get_local 0)))
+  (func (export "f32.convert_s/i32") (param i32) (result f32) (f32.convert_s/i32 (get_local 0)))
+  (func (export "f32.convert_u/i32") (param i32) (result f32) (f32.convert_u/i32 (get_local 0)))
+  (func (export "f32.convert_s/i64") (param i64) (result f32) (f32.convert_s/i64 (get_local 0)))
+  (func (export "f32.convert_u/i64") (param i64) (result f32) (f32.convert_u/i64 (get_local 0)))
+  (func (export "f64.convert_s/i32") (param i32) (result f64) (f64.convert_s/i32 (get_local 0)))
+  (func (export "f64.convert_u/i32") (param i32) (result f64) (f64.convert_u/i32 (get_local 0)))
+  (func (export "f64.convert_s/i64") (param i64) (result f64) (f64.convert_s/i64 (get_local 0)))
+  (func (export "f64.convert_u/i64") (param i64) (result f64) (f64.convert_u/i64 (get_local 0)))
+)
+(assert_return (invoke "f32.demote" (f64.const 0)) (f32.const 0))
+(assert_return (invoke "f32.demote" (f64.const 1)) (f32.const 0))
+(assert_return (invoke "f32.demote" (f64.const 4503599627370495)) (f32.const 0))
+(assert_return (invoke "f32.demote" (f64.const 4503599627370496)) (f32.const 0))
+(assert_return (invoke "f32.demote" (f64.const 4607182418800017408)) (f32.const 1065353216))
+(assert_return (invoke "f32.demote" (f64.const 4607182418800017409)) (f32.const 1065353216))
+(assert_return (invoke "f32.demote" (f64.const 4607182418800017407)) (f32.const 1065353216))
+(assert_return (invoke "f32.demote" (f64.const 4602678819172646912)) (f32.const 1056964608))
+(assert_return (invoke "f32.demote" (f64.const 4613937818241073152)) (f32.const 1077936128))
+(assert_return (invoke "f32.demote" (f64.const 4591870180066957722)) (f32.const 1036831949))
+(assert_return (invoke "f32.demote" (f64.const 4614256656552045841)) (f32.const 1078530011))
+(assert_return (invoke "f32.demote" (f64.const 9218868437227405311)) (f32.const 2139095040))
+(assert_return (invoke "f32.demote" (f64.const 9218868437227405312)) (f32.const 2139095040))
+(assert_return (invoke "f32.demote" (f64.const 4841369599423283201)) (f32.const 1501560832))
+(assert_return (invoke "f32.demote" (f64.const 4845873199050653697)) (f32.const 1509949440))
+(assert_return (invoke "f32.demote" (f64.const 9221120237041090560)) (f32.const 2143289344))
+(assert_return (invoke "f32.demote" (f64.const 9223372036854775808)) (f32.const 2147483648))
+(assert_return (invoke "f32.demote" (f64.const 9223372036854775809)) (f32.const 2147483648))
+(assert_return (invoke "f32.demote" (f64.const 9227875636482146303)) (f32.const 2147483648))
+(assert_return (invoke "f32.demote" (f64.const 9227875636482146304)) (f32.const 2147483648))
+(assert_return (invoke "f32.demote" (f64.const 13830554455654793216)) (f32.const 3212836864))
+(assert_return (invoke "f32.demote" (f64.const 13830554455654793217)) (f32.const 3212836864))
+(assert_return (invoke "f32.demote" (f64.const 13830554455654793215)) (f32.const 3212836864))
+(assert_return (invoke "f32.demote" (f64.const 13826050856027422720)) (f32.const 3204448256))
+(assert_return (invoke "f32.demote" (f64.const 13837309855095848960)) (f32.const 3225419776))
+(assert_return (invoke "f32.demote" (f64.const 13815242216921733530)) (f32.const 3184315597))
+(assert_return (invoke "f32.demote" (f64.const 13837628693406821649)) (f32.const 3226013659))
+(assert_return (invoke "f32.demote" (f64.const 18442240474082181119)) (f32.const 4286578688))
+(assert_return (invoke "f32.demote" (f64.const 18442240474082181120)) (f32.const 4286578688))
+(assert_return (invoke "f32.demote" (f64.const 14064741636278059009)) (f32.const 3649044480))
+(assert_return (invoke "f32.demote" (f64.const 14069245235905429505)) (f32.const 3657433088))
+(assert_return (invoke "f32.demote" (f64.const 18444492273895866368)) (f32.const 2143289344))
+(assert_return (invoke "f32.demote" (f64.const 3886064302858078048)) (f32.const 0))
+(assert_return (invoke "f32.demote" (f64.const 4506305373657632904)) (f32.const 877455077))
+(assert_return (invoke "f32.demote" (f64.const 13920778619408039494)) (f32.const 3380892465))
+(assert_return (invoke "f32.demote" (f64.const 10547652294901124599)) (f32.const 2147483648))
+(assert_return (invoke "f32.demote" (f64.const 3878492090548434245)) (f32.const 0))
+(assert_return (invoke "f32.demote" (f64.const 6178973293619746248)) (f32.const 2139095040))
+(assert_return (invoke "f32.demote" (f64.const 4513218644400837140)) (f32.const 890332047))
+(assert_return (invoke "f32.demote" (f64.const 13264618642074839245)) (f32.const 2158699266))
+(assert_return (invoke "f32.demote" (f64.const 13778532330994783127)) (f32.const 3115938106))
+(assert_return (invoke "f32.demote" (f64.const 16858427221904993698)) (f32.const 4286578688))
+(assert_return (invoke "f32.demote" (f64.const 4589328147649513673)) (f32.const 1032097044))
+(assert_return (invoke "f32.demote" (f64.const 14683436224983610909)) (f32.const 4286578688))
+(assert_return (invoke "f32.demote" (f64.const 13193264583504167648)) (f32.const 2147483839))
+(assert_return (invoke "f32.demote" (f64.const 8073740360832185172)) (f32.const 2139095040))
+(assert_return (invoke "f32.demote" (f64.const 6274132626974155046)) (f32.const 2139095040))
+(assert_return (invoke "f32.demote" (f64.const 10970639940383724998)) (f32.const 2147483648))
+(assert_return (invoke "f32.demote" (f64.const 3454921955161113673)) (f32.const 0))
+(assert_return (invoke "f32.demote" (f64.const 7251181801613909426)) (f32.const 2139095040))
+(assert_return (invoke "f32.demote" (f64.const 8246046288540794316)) (f32.const 2139095040))
+(assert_return (invoke "f32.demote" (f64.const 17852963450891655206)) (f32.const 4286578688))
+(assert_return (invoke "f32.demote" (f64.const 13951650296288205622)) (f32.const 3438395444))
+(assert_return (invoke "f32.demote" (f64.const 1917849414884248948)) (f32.const 0))
+(assert_return (invoke "f32.demote" (f64.const 4484042516356925342)) (f32.const 835987274))
+(assert_return (invoke "f32.demote" (f64.const 13904953569663227939)) (f32.const 3351416013))
+(assert_return (invoke "f32.demote" (f64.const 4545969601224750718)) (f32.const 951335458))
+(assert_return (invoke "f32.demote" (f64.const 2784035145180313992)) (f32.const 0))
+(assert_return (invoke "f32.demote" (f64.const 569822314792916338)) (f32.const 0))
+(assert_return (invoke "f32.demote" (f64.const 4687523089287330883)) (f32.const 1214999376))
+(assert_return (invoke "f32.demote" (f64.const 17712708223537760776)) (f32.const 4286578688))
+(assert_return (invoke "f32.demote" (f64.const 181188779855335395)) (f32.const 0))
+(assert_return (invoke "f32.demote" (f64.const 4607182419068452864)) (f32.const 1065353216))
+(assert_return (invoke "f32.demote" (f64.const 4607182419068452865)) (f32.const 1065353217))
+(assert_return (invoke "f32.demote" (f64.const 4607182419605323776)) (f32.const 1065353218))
+(assert_return (invoke "f32.demote" (f64.const 5183643170566569984)) (f32.const 2139095039))
+(assert_return (invoke "f32.demote" (f64.const 5183643170566569983)) (f32.const 2139095039))
+(assert_return (invoke "f32.demote" (f64.const 3936146074321813504)) (f32.const 1))
+(assert_return (invoke "f32.demote" (f64.const 3936146074321813505)) (f32.const 1))
+(assert_return (invoke "f32.demote" (f64.const 3931642474694443008)) (f32.const 0))
+(assert_return (invoke "f32.demote" (f64.const 4039728865214464000)) (f32.const 8388608))
+(assert_return (invoke "f64.promote" (f32.const 0)) (f64.const 0))
+(assert_return (invoke "f64.promote" (f32.const 1)) (f64.const 3936146074321813504))
+(assert_return (invoke "f64.promote" (f32.const 8388607)) (f64.const 4039728864677593088))
+(assert_return (invoke "f64.promote" (f32.const 8388608)) (f64.const 4039728865751334912))
+(assert_return (invoke "f64.promote" (f32.const 1065353216)) (f64.const 4607182418800017408))
+(assert_return (invoke "f64.promote" (f32.const 1065353217)) (f64.const 4607182419336888320))
+(assert_return (invoke "f64.promote" (f32.const 1065353215)) (f64.const 4607182418263146496))
+(assert_return (invoke "f64.promote" (f32.const 1056964608)) (f64.const 4602678819172646912))
+(assert_return (invoke "f64.promote" (f32.const 1077936128)) (f64.const 4613937818241073152))
+(assert_return (invoke "f64.promote" (f32.const 1036831949)) (f64.const 4591870180174331904))
+(assert_return (invoke "f64.promote" (f32.const 1078530011)) (f64.const 4614256656748904448))
+(assert_return (invoke "f64.promote" (f32.const 2139095039)) (f64.const 5183643170566569984))
+(assert_return (invoke "f64.promote" (f32.const 2139095040)) (f64.const 9218868437227405312))
+(assert_return (invoke "f64.promote" (f32.const 1258291201)) (f64.const 4710765210766409728))
+(assert_return (invoke "f64.promote" (f32.const 1266679809)) (f64.const 4715268810393780224))
+(assert_return (invoke "f64.promote" (f32.const 2143289344)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.promote" (f32.const 2147483648)) (f64.const 9223372036854775808))
+(assert_return (invoke "f64.promote" (f32.const 2147483649)) (f64.const 13159518111176589312))
+(assert_return (invoke "f64.promote" (f32.const 2155872255)) (f64.const 13263100901532368896))
+(assert_return (invoke "f64.promote" (f32.const 2155872256)) (f64.const 13263100902606110720))
+(assert_return (invoke "f64.promote" (f32.const 3212836864)) (f64.const 13830554455654793216))
+(assert_return (invoke "f64.promote" (f32.const 3212836865)) (f64.const 13830554456191664128))
+(assert_return (invoke "f64.promote" (f32.const 3212836863)) (f64.const 13830554455117922304))
+(assert_return (invoke "f64.promote" (f32.const 3204448256)) (f64.const 13826050856027422720))
+(assert_return (invoke "f64.promote" (f32.const 3225419776)) (f64.const 13837309855095848960))
+(assert_return (invoke "f64.promote" (f32.const 3184315597)) (f64.const 13815242217029107712))
+(assert_return (invoke "f64.promote" (f32.const 3226013659)) (f64.const 13837628693603680256))
+(assert_return (invoke "f64.promote" (f32.const 4286578687)) (f64.const 14407015207421345792))
+(assert_return (invoke "f64.promote" (f32.const 4286578688)) (f64.const 18442240474082181120))
+(assert_return (invoke "f64.promote" (f32.const 3405774849)) (f64.const 13934137247621185536))
+(assert_return (invoke "f64.promote" (f32.const 3414163457)) (f64.const 13938640847248556032))
+(assert_return (invoke "f64.promote" (f32.const 4290772992)) (f64.const 9221120237041090560))
+(assert_return (invoke "f64.promote" (f32.const 3698413104)) (f64.const 14091246214469124096))
+(assert_return (invoke "f64.promote" (f32.const 1254279611)) (f64.const 4708611504784539648))
+(assert_return (invoke "f64.promote" (f32.const 2421946579)) (f64.const 13405948467054903296))
+(assert_return (invoke "f64.promote" (f32.const 1239049753)) (f64.const 4700435037030449152))
+(assert_return (invoke "f64.promote" (f32.const 1090873669)) (f64.const 4620883607676780544))
+(assert_return (invoke "f64.promote" (f32.const 2089791822)) (f64.const 5157173707491246080))
+(assert_return (invoke "f64.promote" (f32.const 2494146794)) (f64.const 13444710662328549376))
+(assert_return (invoke "f64.promote" (f32.const 4184256158)) (f64.const 14352081217958969344))
+(assert_return (invoke "f64.promote" (f32.const 1113187405)) (f64.const 4632863203473227776))
+(assert_return (invoke "f64.promote" (f32.const 3414726773)) (f64.const 13938943275223220224))
+(assert_return (invoke "f64.promote" (f32.const 3756341580)) (f64.const 14122346328210014208))
+(assert_return (invoke "f64.promote" (f32.const 3294598507)) (f64.const 13874449903498821632))
+(assert_return (invoke "f64.promote" (f32.const 3181292702)) (f64.const 13813619312633577472))
+(assert_return (invoke "f64.promote" (f32.const 1937701970)) (f64.const 5075521089942061056))
+(assert_return (invoke "f64.promote" (f32.const 353252455)) (f64.const 4224876233806053376))
+(assert_return (invoke "f64.promote" (f32.const 2873611252)) (f64.const 13648434091966595072))
+(assert_return (invoke "f64.promote" (f32.const 1647323943)) (f64.const 4919625573761810432))
+(assert_return (invoke "f64.promote" (f32.const 166502312)) (f64.const 4124615514217512960))
+(assert_return (invoke "f64.promote" (f32.const 1128361729)) (f64.const 4641009856638091264))
+(assert_return (invoke "f64.promote" (f32.const 2911019940)) (f64.const 13668517728409878528))
+(assert_return (invoke "f32.convert_s/i32" (i32.const 0)) (f32.const 0))
+(assert_return (invoke "f32.convert_u/i32" (i32.const 0)) (f32.const 0))
+(assert_return (invoke "f32.convert_s/i32" (i32.const 1)) (f32.const 1065353216))
+(assert_return (invoke "f32.convert_u/i32" (i32.const 1)) (f32.const 1065353216))
+(assert_return (invoke "f32.convert_s/i32" (i32.const 4294967295)) (f32.const 3212836864))
+(assert_return (invoke "f32.convert_u/i32" (i32.const 4294967295)) (f32.const 1333788672))
+(assert_return (invoke "f32.convert_s/i32" (i32.const 16777217)) (f32.const 1266679808))
+(assert_return (invoke "f32.convert_u/i32" (i32.const 16777217)) (f32.const 1266679808))
+(assert_return (invoke "f32.convert_s/i32" (i32.const 16777219)) (f32.const 1266679810))
+(assert_return (invoke "f32.convert_u/i32" (i32.const 16777219)) (f32.const 1266679810))
+(assert_return (invoke "f32.convert_s/i32" (i32.const 2147483647)) (f32.const 1325400064))
+(assert_return (invoke "f32.convert_u/i32" (i32.const 2147483647)) (f32.const 1325400064))
+(assert_return (invoke "f32.convert_s/i32" (i32.const 2147483648)) (f32.const 3472883712))
+(assert_return (invoke "f32.convert_u/i32" (i32.const 2147483648)) (f32.const 1325400064))
+(assert_return (invoke "f32.convert_s/i32" (i32.const 33554434)) (f32.const 1275068416))
+(assert_return (invoke "f32.convert_u/i32" (i32.const 33554434)) (f32.const 1275068416))
+(assert_return (invoke "f32.convert_s/i32" (i32.const 3436107884)) (f32.const 3461137566))
+(assert_return (invoke "f32.convert_u/i32" (i32.const 3436107884)) (f32.const 1330433752))
+(assert_return (invoke "f32.convert_s/i32" (i32.const 1884767329)) (f32.const 1323347593))
+(assert_return (invoke "f32.convert_u/i32" (i32.const 1884767329)) (f32.const 1323347593))
+(assert_return (invoke "f32.convert_s/i32" (i32.const 1113563999)) (f32.const 1317322567))
+(assert_return (invoke "f32.convert_u/i32" (i32.const 1113563999)) (f32.const 1317322567))
+(assert_return (invoke "f32.convert_s/i32" (i32.const 534544478)) (f32.const 1308550147))
+(assert_return (invoke "f32.convert_u/i32" (i32.const 534544478)) (f32.const 1308550147))
+(assert_return (invoke "f32.convert_s/i32" (i32.const 1159946522)) (f32.const 1317684930))
+(assert_return (invoke "f32.convert_u/i32" (i32.const 1159946522)) (f32.const 1317684930))
+(assert_return (invoke "f32.convert_s/i32" (i32.const 3658371179)) (f32.const 3457664702))
+(assert_return (invoke "f32.convert_u/i32" (i32.const 3658371179)) (f32.const 1331301968))
+(assert_return (invoke "f32.convert_s/i32" (i32.const 1326460503)) (f32.const 1318985821))
+(assert_return (invoke "f32.convert_u/i32" (i32.const 1326460503)) (f32.const 1318985821))
+(assert_return (invoke "f32.convert_s/i32" (i32.const 4200549188)) (f32.const 3434354328))
+(assert_return (invoke "f32.convert_u/i32" (i32.const 4200549188)) (f32.const 1333419851))
+(assert_return (invoke "f32.convert_s/i32" (i32.const 810839438)) (f32.const 1312903606))
+(assert_return (invoke "f32.convert_u/i32" (i32.const 810839438)) (f32.const 1312903606))
+(assert_return (invoke "f32.convert_s/i32" (i32.const 989731185)) (f32.const 1315698790))
+(assert_return (invoke "f32.convert_u/i32" (i32.const 989731185)) (f32.const 1315698790))
+(assert_return (invoke "f32.convert_s/i64" (i64.const 0)) (f32.const 0))
+(assert_return (invoke "f32.convert_u/i64" (i64.const 0)) (f32.const 0))
+(assert_return (invoke "f32.convert_s/i64" (i64.const 1)) (f32.const 1065353216))
+(assert_return (invoke "f32.convert_u/i64" (i64.const 1)) (f32.const 1065353216))
+(assert_return (invoke "f32.convert_s/i64" (i64.const 18446744073709551615)) (f32.const 3212836864))
+(assert_return (invoke "f32.convert_u/i64" (i64.const 18446744073709551615)) (f32.const 1602224128))
+(assert_return (invoke "f32.convert_s/i64" (i64.const 9007199254740993)) (f32.const 1509949440))
+(assert_return (invoke "f32.convert_u/i64" (i64.const 9007199254740993)) (f32.const 1509949440))
+(assert_return (invoke "f32.convert_s/i64" (i64.const 9007199254740995)) (f32.const 1509949440))
+(assert_return (invoke "f32.convert_u/i64" (i64.const 9007199254740995)) (f32.const 1509949440))
+(assert_return (invoke "f32.convert_s/i64" (i64.const 9223372036854775807)) (f32.const 1593835520))
+(assert_return (invoke "f32.convert_u/i64" (i64.const 9223372036854775807)) (f32.const 1593835520))
+(assert_return (invoke "f32.convert_s/i64" (i64.const 9223372036854775808)) (f32.const 3741319168))
+(assert_return (invoke "f32.convert_u/i64" (i64.const 9223372036854775808)) (f32.const 1593835520))
+(assert_return (invoke "f32.convert_s/i64" (i64.const 9223372036854774784)) (f32.const 1593835520))
+(assert_return (invoke "f32.convert_u/i64" (i64.const 9223372036854774784)) (f32.const 1593835520))
+(assert_return (invoke "f32.convert_s/i64" (i64.const 9223372036854774783)) (f32.const 1593835520))
+(assert_return (invoke "f32.convert_u/i64" (i64.const 9223372036854774783)) (f32.const 1593835520))
+(assert_return (invoke "f32.convert_s/i64" (i64.const 16777217)) (f32.const 1266679808))
+(assert_return (invoke "f32.convert_u/i64" (i64.const 16777217)) (f32.const 1266679808))
+(assert_return (invoke "f32.convert_s/i64" (i64.const 9007199791611905)) (f32.const 1509949441))
+(assert_return (invoke "f32.convert_u/i64" (i64.const 9007199791611905)) (f32.const 1509949441))
+(assert_return (invoke "f32.convert_s/i64" (i64.const 12371241291110035735)) (f32.const 3735593227))
+(assert_return (invoke "f32.convert_u/i64" (i64.const 12371241291110035735)) (f32.const 1596698490))
+(assert_return (invoke "f32.convert_s/i64" (i64.const 14831282199436652211)) (f32.const 3729306318))
+(assert_return (invoke "f32.convert_u/i64" (i64.const 14831282199436652211)) (f32.const 1598935885))
+(assert_return (invoke "f32.convert_s/i64" (i64.const 418030722528680758)) (f32.const 1555670167))
+(assert_return (invoke "f32.convert_u/i64" (i64.const 418030722528680758)) (f32.const 1555670167))
+(assert_return (invoke "f32.convert_s/i64" (i64.const 7296710900441449736)) (f32.const 1590330944))
+(assert_return (invoke "f32.convert_u/i64" (i64.const 7296710900441449736)) (f32.const 1590330944))
+(assert_return (invoke "f32.convert_s/i64" (i64.const 14536031007154166823)) (f32.const 3730380435))
+(assert_return (invoke "f32.convert_u/i64" (i64.const 14536031007154166823)) (f32.const 1598667355))
+(assert_return (invoke "f32.convert_s/i64" (i64.const 15050931935180340705)) (f32.const 3728507237))
+(assert_return (invoke "f32.convert_u/i64" (i64.const 15050931935180340705)) (f32.const 1599135655))
+(assert_return (invoke "f32.convert_s/i64" (i64.const 13776394389033069406)) (f32.const 3733037269))
+(assert_return (invoke "f32.convert_u/i64" (i64.const 13776394389033069406)) (f32.const 1597976470))
+(assert_return (invoke "f32.convert_s/i64" (i64.const 6578368694739214398)) (f32.const 1589024287))
+(assert_return (invoke "f32.convert_u/i64" (i64.const 6578368694739214398)) (f32.const 1589024287))
+(assert_return (invoke "f32.convert_s/i64" (i64.const 2744442144205433932)) (f32.const 1578653918))
+(assert_return (invoke "f32.convert_u/i64" (i64.const 2744442144205433932)) (f32.const 1578653918))
+(assert_return (invoke "f32.convert_s/i64" (i64.const 3404235070359780278)) (f32.const 1581054231))
+(assert_return (invoke "f32.convert_u/i64" (i64.const 3404235070359780278)) (f32.const 1581054231))
+(assert_return (invoke "f64.convert_s/i32" (i32.const 0)) (f64.const 0))
+(assert_return (invoke "f64.convert_u/i32" (i32.const 0)) (f64.const 0))
+(assert_return (invoke "f64.convert_s/i32" (i32.const 1)) (f64.const 4607182418800017408))
+(assert_return (invoke "f64.convert_u/i32" (i32.const 1)) (f64.const 4607182418800017408))
+(assert_return (invoke "f64.convert_s/i32" (i32.const 4294967295)) (f64.const 13830554455654793216))
+(assert_return (invoke "f64.convert_u/i32" (i32.const 4294967295)) (f64.const 4751297606873776128))
+(assert_return (invoke "f64.convert_s/i32" (i32.const 16777217)) (f64.const 4715268810125344768))
+(assert_return (invoke "f64.convert_u/i32" (i32.const 16777217)) (f64.const 4715268810125344768))
+(assert_return (invoke "f64.convert_s/i32" (i32.const 16777219)) (f64.const 4715268810662215680))
+(assert_return (invoke "f64.convert_u/i32" (i32.const 16777219)) (f64.const 4715268810662215680))
+(assert_return (invoke "f64.convert_s/i32" (i32.const 2147483647)) (f64.const 4746794007244308480))
+(assert_return (invoke "f64.convert_u/i32" (i32.const 2147483647)) (f64.const 4746794007244308480))
+(assert_return (invoke "f64.convert_s/i32" (i32.const 2147483648)) (f64.const 13970166044103278592))
+(assert_return (invoke "f64.convert_u/i32" (i32.const 2147483648)) (f64.const 4746794007248502784))
+(assert_return (invoke "f64.convert_s/i32" (i32.const 33554434)) (f64.const 4719772409752715264))
+(assert_return (invoke "f64.convert_u/i32" (i32.const 33554434)) (f64.const 4719772409752715264))
+(assert_return (invoke "f64.convert_s/i32" (i32.const 3436107884)) (f64.const 13963859880155545600))
+(assert_return (invoke "f64.convert_u/i32" (i32.const 3436107884)) (f64.const 4749496448142278656))
+(assert_return (invoke "f64.convert_s/i32" (i32.const 1884767329)) (f64.const 4745692095140855808))
+(assert_return (invoke "f64.convert_u/i32" (i32.const 1884767329)) (f64.const 4745692095140855808))
+(assert_return (invoke "f64.convert_s/i32" (i32.const 1113563999)) (f64.const 4742457433929023488))
+(assert_return (invoke "f64.convert_u/i32" (i32.const 1113563999)) (f64.const 4742457433929023488))
+(assert_return (invoke "f64.convert_s/i32" (i32.const 534544478)) (f64.const 4737747776908034048))
+(assert_return (invoke "f64.convert_u/i32" (i32.const 534544478)) (f64.const 4737747776908034048))
+(assert_return (invoke "f64.convert_s/i32" (i32.const 1159946522)) (f64.const 4742651976330772480))
+(assert_return (invoke "f64.convert_u/i32" (i32.const 1159946522)) (f64.const 4742651976330772480))
+(assert_return (invoke "f64.convert_s/i32" (i32.const 3658371179)) (f64.const 13961995400501002240))
+(assert_return (invoke "f64.convert_u/i32" (i32.const 3658371179)) (f64.const 4749962568055914496))
+(assert_return (invoke "f64.convert_s/i32" (i32.const 1326460503)) (f64.const 4743350386587336704))
+(assert_return (invoke "f64.convert_u/i32" (i32.const 1326460503)) (f64.const 4743350386587336704))
+(assert_return (invoke "f64.convert_s/i32" (i32.const 4200549188)) (f64.const 13949480738307964928))
+(assert_return (invoke "f64.convert_u/i32" (i32.const 4200549188)) (f64.const 4751099597751844864))
+(assert_return (invoke "f64.convert_s/i32" (i32.const 810839438)) (f64.const 4740085022562713600))
+(assert_return (invoke "f64.convert_u/i32" (i32.const 810839438)) (f64.const 4740085022562713600))
+(assert_return (invoke "f64.convert_s/i32" (i32.const 989731185)) (f64.const 4741585675302731776))
+(assert_return (invoke "f64.convert_u/i32" (i32.const 989731185)) (f64.const 4741585675302731776))
+(assert_return (invoke "f64.convert_s/i64" (i64.const 0)) (f64.const 0))
+(assert_return (invoke "f64.convert_u/i64" (i64.const 0)) (f64.const 0))
+(assert_return (invoke "f64.convert_s/i64" (i64.const 1)) (f64.const 4607182418800017408))
+(assert_return (invoke "f64.convert_u/i64" (i64.const 1)) (f64.const 4607182418800017408))
+(assert_return (invoke "f64.convert_s/i64" (i64.const 18446744073709551615)) (f64.const 13830554455654793216))
+(assert_return (invoke "f64.convert_u/i64" (i64.const 18446744073709551615)) (f64.const 4895412794951729152))
+(assert_return (invoke "f64.convert_s/i64" (i64.const 9007199254740993)) (f64.const 4845873199050653696))
+(assert_return (invoke "f64.convert_u/i64" (i64.const 9007199254740993)) (f64.const 4845873199050653696))
+(assert_return (invoke "f64.convert_s/i64" (i64.const 9007199254740995)) (f64.const 4845873199050653698))
+(assert_return (invoke "f64.convert_u/i64" (i64.const 9007199254740995)) (f64.const 4845873199050653698))
+(assert_return (invoke "f64.convert_s/i64" (i64.const 9223372036854775807)) (f64.const 4890909195324358656))
+(assert_return (invoke "f64.convert_u/i64" (i64.const 9223372036854775807)) (f64.const 4890909195324358656))
+(assert_return (invoke "f64.convert_s/i64" (i64.const 9223372036854775808)) (f64.const 14114281232179134464))
+(assert_return (invoke "f64.convert_u/i64" (i64.const 9223372036854775808)) (f64.const 4890909195324358656))
+(assert_return (invoke "f64.convert_s/i64" (i64.const 9223372036854774784)) (f64.const 4890909195324358655))
+(assert_return (invoke "f64.convert_u/i64" (i64.const 9223372036854774784)) (f64.const 4890909195324358655))
+(assert_return (invoke "f64.convert_s/i64" (i64.const 9223372036854774783)) (f64.const 4890909195324358655))
+(assert_return (invoke "f64.convert_u/i64" (i64.const 9223372036854774783)) (f64.const 4890909195324358655))
+(assert_return (invoke "f64.convert_s/i64" (i64.const 16777217)) (f64.const 4715268810125344768))
+(assert_return (invoke "f64.convert_u/i64" (i64.const 16777217)) (f64.const 4715268810125344768))
+(assert_return (invoke "f64.convert_s/i64" (i64.const 9007199791611905)) (f64.const 4845873199319089152))
+(assert_return (invoke "f64.convert_u/i64" (i64.const 9007199791611905)) (f64.const 4845873199319089152))
+(assert_return (invoke "f64.convert_s/i64" (i64.const 12371241291110035735)) (f64.const 14111207141110525812))
+(assert_return (invoke "f64.convert_u/i64" (i64.const 12371241291110035735)) (f64.const 4892446240858662982))
+(assert_return (invoke "f64.convert_s/i64" (i64.const 14831282199436652211)) (f64.const 14107831882270212233))
+(assert_return (invoke "f64.convert_u/i64" (i64.const 14831282199436652211)) (f64.const 4893647432708431838))
+(assert_return (invoke "f64.convert_s/i64" (i64.const 418030722528680758)) (f64.const 4870419327599646317))
+(assert_return (invoke "f64.convert_u/i64" (i64.const 418030722528680758)) (f64.const 4870419327599646317))
+(assert_return (invoke "f64.convert_s/i64" (i64.const 7296710900441449736)) (f64.const 4889027690308330017))
+(assert_return (invoke "f64.convert_u/i64" (i64.const 7296710900441449736)) (f64.const 4889027690308330017))
+(assert_return (invoke "f64.convert_s/i64" (i64.const 14536031007154166823)) (f64.const 14108408544755138962))
+(assert_return (invoke "f64.convert_u/i64" (i64.const 14536031007154166823)) (f64.const 4893503267087200156))
+(assert_return (invoke "f64.convert_s/i64" (i64.const 15050931935180340705)) (f64.const 14107402878880087841))
+(assert_return (invoke "f64.convert_u/i64" (i64.const 15050931935180340705)) (f64.const 4893754683555962936))
+(assert_return (invoke "f64.convert_s/i64" (i64.const 13776394389033069406)) (f64.const 14109834921288335349))
+(assert_return (invoke "f64.convert_u/i64" (i64.const 13776394389033069406)) (f64.const 4893132350769758213))
+(assert_return (invoke "f64.convert_s/i64" (i64.const 6578368694739214398)) (f64.const 4888326184248073928))
+(assert_return (invoke "f64.convert_u/i64" (i64.const 6578368694739214398)) (f64.const 4888326184248073928))
+(assert_return (invoke "f64.convert_s/i64" (i64.const 2744442144205433932)) (f64.const 4882758635005148406))
+(assert_return (invoke "f64.convert_u/i64" (i64.const 2744442144205433932)) (f64.const 4882758635005148406))
+(assert_return (invoke "f64.convert_s/i64" (i64.const 3404235070359780278)) (f64.const 4884047293064043614))
+(assert_return (invoke "f64.convert_u/i64" (i64.const 3404235070359780278)) (f64.const 4884047293064043614))
+;; Conversions to integers round toward zero, and trap on NaNs and on values
+;; out of range.
+(module
+  (func (export "i32.trunc_s/f32") (param f32) (result i32) (i32.trunc_s/f32 (get_local 0)))
+  (func (export "i32.trunc_s/f64") (param f64) (result i32) (i32.trunc_s/f64 (get_local 0)))
+  (func (export "i32.trunc_u/f32") (param f32) (result i32) (i32.trunc_u/f32 (get_local 0)))
+  (func (export "i32.trunc_u/f64") (param f64) (result i32) (i32.trunc_u/f64 (get_local 0)))
+  (func (export "i64.trunc_s/f32") (param f32) (result i64) (i64.trunc_s/f32 (get_local 0)))
+  (func (export "i64.trunc_s/f64") (param f64) (result i64) (i64.trunc_s/f64 (get_local 0)))
+  (func (export "i64.trunc_u/f32") (param f32) (result i64) (i64.trunc_u/f32 (get_local 0)))
+  (func (export "i64.trunc_u/f64") (param f64) (result i64) (i64.trunc_u/f64 (get_local 0)))
+)
+(assert_return (invoke "i32.trunc_s/f32" (f32.const 0)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s/f32" (f32.const 1)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s/f32" (f32.const 8388607)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s/f32" (f32.const 8388608)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s/f32" (f32.const 39539701)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s/f32" (f32.const 582818074)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s/f32" (f32.const 617247476)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s/f32" (f32.const 1036831949)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s/f32" (f32.const 1056964608)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s/f32" (f32.const 1065353215)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s/f32" (f32.const 1065353216)) (i32.const 1))
+(assert_return (invoke "i32.trunc_s/f32" (f32.const 1065353217)) (i32.const 1))
+(assert_return (invoke "i32.trunc_s/f32" (f32.const 1077936128)) (i32.const 3))
+(assert_return (invoke "i32.trunc_s/f32" (f32.const 1078530011)) (i32.const 3))
+(assert_return (invoke "i32.trunc_s/f32" (f32.const 1213092988)) (i32.const 211281))
+(assert_return (invoke "i32.trunc_s/f32" (f32.const 1258291201)) (i32.const 8388609))
+(assert_return (invoke "i32.trunc_s/f32" (f32.const 1266679809)) (i32.const 16777218))
+(assert_trap (invoke "i32.trunc_s/f32" (f32.const 1325400064)) "integer overflow")
+(assert_trap (invoke "i32.trunc_s/f32" (f32.const 1616677163)) "integer overflow")
+(assert_trap (invoke "i32.trunc_s/f32" (f32.const 2139095039)) "integer overflow")
+(assert_trap (invoke "i32.trunc_s/f32" (f32.const 2139095040)) "integer overflow")
+(assert_trap (invoke "i32.trunc_s/f32" (f32.const 2143289344)) "invalid conversion to integer")
+(assert_return (invoke "i32.trunc_s/f32" (f32.const 2147483648)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s/f32" (f32.const 2147483649)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s/f32" (f32.const 2155872255)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s/f32" (f32.const 2155872256)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s/f32" (f32.const 2962698175)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s/f32" (f32.const 3039443044)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s/f32" (f32.const 3057031514)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s/f32" (f32.const 3075824725)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s/f32" (f32.const 3112425768)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s/f32" (f32.const 3184315597)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s/f32" (f32.const 3204448256)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s/f32" (f32.const 3212836863)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s/f32" (f32.const 3212836864)) (i32.const 4294967295))
+(assert_return (invoke "i32.trunc_s/f32" (f32.const 3212836865)) (i32.const 4294967295))
+(assert_return (invoke "i32.trunc_s/f32" (f32.const 3225419776)) (i32.const 4294967293))
+(assert_return (invoke "i32.trunc_s/f32" (f32.const 3226013659)) (i32.const 4294967293))
+(assert_return (invoke "i32.trunc_s/f32" (f32.const 3405774849)) (i32.const 4286578687))
+(assert_return (invoke "i32.trunc_s/f32" (f32.const 3414163457)) (i32.const 4278190078))
+(assert_return (invoke "i32.trunc_s/f32" (f32.const 3472883712)) (i32.const 2147483648))
+(assert_trap (invoke "i32.trunc_s/f32" (f32.const 4286578687)) "integer overflow")
+(assert_trap (invoke "i32.trunc_s/f32" (f32.const 4286578688)) "integer overflow")
+(assert_trap (invoke "i32.trunc_s/f32" (f32.const 4290772992)) "invalid conversion to integer")
+(assert_return (invoke "i32.trunc_s/f64" (f64.const 0)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s/f64" (f64.const 1)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s/f64" (f64.const 4503599627370495)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s/f64" (f64.const 4503599627370496)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s/f64" (f64.const 343596246000644291)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s/f64" (f64.const 547314099778161581)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s/f64" (f64.const 840613479038038555)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s/f64" (f64.const 1054125226889654840)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s/f64" (f64.const 4591870180066957722)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s/f64" (f64.const 4602678819172646912)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s/f64" (f64.const 4607182418800017407)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s/f64" (f64.const 4607182418800017408)) (i32.const 1))
+(assert_return (invoke "i32.trunc_s/f64" (f64.const 4607182418800017409)) (i32.const 1))
+(assert_return (invoke "i32.trunc_s/f64" (f64.const 4613937818241073152)) (i32.const 3))
+(assert_return (invoke "i32.trunc_s/f64" (f64.const 4614256656552045841)) (i32.const 3))
+(assert_return (invoke "i32.trunc_s/f64" (f64.const 4678349641410832903)) (i32.const 59057))
+(assert_return (invoke "i32.trunc_s/f64" (f64.const 4746794007240114176)) (i32.const 2147483646))
+(assert_return (invoke "i32.trunc_s/f64" (f64.const 4746794007242211328)) (i32.const 2147483646))
+(assert_return (invoke "i32.trunc_s/f64" (f64.const 4746794007244308480)) (i32.const 2147483647))
+(assert_return (invoke "i32.trunc_s/f64" (f64.const 4746794007246405632)) (i32.const 2147483647))
+(assert_trap (invoke "i32.trunc_s/f64" (f64.const 4746794007248502784)) "integer overflow")
+(assert_trap (invoke "i32.trunc_s/f64" (f64.const 4746794007249551360)) "integer overflow")
+(assert_trap (invoke "i32.trunc_s/f64" (f64.const 4746794007250599936)) "integer overflow")
+(assert_trap (invoke "i32.trunc_s/f64" (f64.const 4841369599423283201)) "integer overflow")
+(assert_trap (invoke "i32.trunc_s/f64" (f64.const 4845873199050653697)) "integer overflow")
+(assert_trap (invoke "i32.trunc_s/f64" (f64.const 6803932721246501605)) "integer overflow")
+(assert_trap (invoke "i32.trunc_s/f64" (f64.const 9218868437227405311)) "integer overflow")
+(assert_trap (invoke "i32.trunc_s/f64" (f64.const 9218868437227405312)) "integer overflow")
+(assert_trap (invoke "i32.trunc_s/f64" (f64.const 9221120237041090560)) "invalid conversion to integer")
+(assert_return (invoke "i32.trunc_s/f64" (f64.const 9223372036854775808)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s/f64" (f64.const 9223372036854775809)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s/f64" (f64.const 9227875636482146303)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s/f64" (f64.const 9227875636482146304)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s/f64" (f64.const 9346296426904901505)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s/f64" (f64.const 13815242216921733530)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s/f64" (f64.const 13826050856027422720)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s/f64" (f64.const 13830554455654793215)) (i32.const 0))
+(assert_return (invoke "i32.trunc_s/f64" (f64.const 13830554455654793216)) (i32.const 4294967295))
+(assert_return (invoke "i32.trunc_s/f64" (f64.const 13830554455654793217)) (i32.const 4294967295))
+(assert_return (invoke "i32.trunc_s/f64" (f64.const 13837309855095848960)) (i32.const 4294967293))
+(assert_return (invoke "i32.trunc_s/f64" (f64.const 13837628693406821649)) (i32.const 4294967293))
+(assert_return (invoke "i32.trunc_s/f64" (f64.const 13934481784084732627)) (i32.const 4285936938))
+(assert_return (invoke "i32.trunc_s/f64" (f64.const 13970166044099084288)) (i32.const 2147483649))
+(assert_return (invoke "i32.trunc_s/f64" (f64.const 13970166044101181440)) (i32.const 2147483649))
+(assert_return (invoke "i32.trunc_s/f64" (f64.const 13970166044103278592)) (i32.const 2147483648))
+(assert_return (invoke "i32.trunc_s/f64" (f64.const 13970166044104327168)) (i32.const 2147483648))
+(assert_trap (invoke "i32.trunc_s/f64" (f64.const 13970166044105375744)) "integer overflow")
+(assert_trap (invoke "i32.trunc_s/f64" (f64.const 13970166044106424320)) "integer overflow")
+(assert_trap (invoke "i32.trunc_s/f64" (f64.const 13970166044107472896)) "integer overflow")
+(assert_trap (invoke "i32.trunc_s/f64" (f64.const 14064741636278059009)) "integer overflow")
+(assert_trap (invoke "i32.trunc_s/f64" (f64.const 14069245235905429505)) "integer overflow")
+(assert_trap (invoke "i32.trunc_s/f64" (f64.const 16613956911788232457)) "integer overflow")
+(assert_trap (invoke "i32.trunc_s/f64" (f64.const 18250158213986169674)) "integer overflow")
+(assert_trap (invoke "i32.trunc_s/f64" (f64.const 18442240474082181119)) "integer overflow")
+(assert_trap (invoke "i32.trunc_s/f64" (f64.const 18442240474082181120)) "integer overflow")
+(assert_trap (invoke "i32.trunc_s/f64" (f64.const 18444492273895866368)) "invalid conversion to integer")
+(assert_return (invoke "i32.trunc_u/f32" (f32.const 0)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u/f32" (f32.const 1)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u/f32" (f32.const 8388607)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u/f32" (f32.const 8388608)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u/f32" (f32.const 311627200)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u/f32" (f32.const 826646327)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u/f32" (f32.const 853667320)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u/f32" (f32.const 869533559)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u/f32" (f32.const 1036831949)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u/f32" (f32.const 1056964608)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u/f32" (f32.const 1065353215)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u/f32" (f32.const 1065353216)) (i32.const 1))
+(assert_return (invoke "i32.trunc_u/f32" (f32.const 1065353217)) (i32.const 1))
+(assert_return (invoke "i32.trunc_u/f32" (f32.const 1077936128)) (i32.const 3))
+(assert_return (invoke "i32.trunc_u/f32" (f32.const 1078530011)) (i32.const 3))
+(assert_return (invoke "i32.trunc_u/f32" (f32.const 1135920424)) (i32.const 361))
+(assert_return (invoke "i32.trunc_u/f32" (f32.const 1232028801)) (i32.const 980040))
+(assert_return (invoke "i32.trunc_u/f32" (f32.const 1258291201)) (i32.const 8388609))
+(assert_return (invoke "i32.trunc_u/f32" (f32.const 1266679809)) (i32.const 16777218))
+(assert_trap (invoke "i32.trunc_u/f32" (f32.const 1333788672)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u/f32" (f32.const 2139095039)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u/f32" (f32.const 2139095040)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u/f32" (f32.const 2143289344)) "invalid conversion to integer")
+(assert_return (invoke "i32.trunc_u/f32" (f32.const 2147483648)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u/f32" (f32.const 2147483649)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u/f32" (f32.const 2155872255)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u/f32" (f32.const 2155872256)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u/f32" (f32.const 2170094902)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u/f32" (f32.const 3095133610)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u/f32" (f32.const 3184315597)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u/f32" (f32.const 3204448256)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u/f32" (f32.const 3212836863)) (i32.const 0))
+(assert_trap (invoke "i32.trunc_u/f32" (f32.const 3212836864)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u/f32" (f32.const 3212836865)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u/f32" (f32.const 3217031168)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u/f32" (f32.const 3221225472)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u/f32" (f32.const 3225419776)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u/f32" (f32.const 3226013659)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u/f32" (f32.const 3245415026)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u/f32" (f32.const 3405774849)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u/f32" (f32.const 3414163457)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u/f32" (f32.const 3544035157)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u/f32" (f32.const 4286578687)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u/f32" (f32.const 4286578688)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u/f32" (f32.const 4290772992)) "invalid conversion to integer")
+(assert_return (invoke "i32.trunc_u/f64" (f64.const 0)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u/f64" (f64.const 1)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u/f64" (f64.const 4503599627370495)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u/f64" (f64.const 4503599627370496)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u/f64" (f64.const 4591870180066957722)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u/f64" (f64.const 4602678819172646912)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u/f64" (f64.const 4607182418800017407)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u/f64" (f64.const 4607182418800017408)) (i32.const 1))
+(assert_return (invoke "i32.trunc_u/f64" (f64.const 4607182418800017409)) (i32.const 1))
+(assert_return (invoke "i32.trunc_u/f64" (f64.const 4613937818241073152)) (i32.const 3))
+(assert_return (invoke "i32.trunc_u/f64" (f64.const 4614256656552045841)) (i32.const 3))
+(assert_return (invoke "i32.trunc_u/f64" (f64.const 4664280862081387670)) (i32.const 6874))
+(assert_return (invoke "i32.trunc_u/f64" (f64.const 4751297606871678976)) (i32.const 4294967294))
+(assert_return (invoke "i32.trunc_u/f64" (f64.const 4751297606872727552)) (i32.const 4294967294))
+(assert_return (invoke "i32.trunc_u/f64" (f64.const 4751297606873776128)) (i32.const 4294967295))
+(assert_return (invoke "i32.trunc_u/f64" (f64.const 4751297606874824704)) (i32.const 4294967295))
+(assert_trap (invoke "i32.trunc_u/f64" (f64.const 4751297606875873280)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u/f64" (f64.const 4751297606876397568)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u/f64" (f64.const 4751297606876921856)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u/f64" (f64.const 4841369599423283201)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u/f64" (f64.const 4845873199050653697)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u/f64" (f64.const 6563356261510869394)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u/f64" (f64.const 7645847347646923703)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u/f64" (f64.const 8450725852054810468)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u/f64" (f64.const 9218868437227405311)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u/f64" (f64.const 9218868437227405312)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u/f64" (f64.const 9221120237041090560)) "invalid conversion to integer")
+(assert_return (invoke "i32.trunc_u/f64" (f64.const 9223372036854775808)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u/f64" (f64.const 9223372036854775809)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u/f64" (f64.const 9227875636482146303)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u/f64" (f64.const 9227875636482146304)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u/f64" (f64.const 12413326027529609164)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u/f64" (f64.const 12469735547291182947)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u/f64" (f64.const 13753821087426471712)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u/f64" (f64.const 13815242216921733530)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u/f64" (f64.const 13826050856027422720)) (i32.const 0))
+(assert_return (invoke "i32.trunc_u/f64" (f64.const 13830554455654793215)) (i32.const 0))
+(assert_trap (invoke "i32.trunc_u/f64" (f64.const 13830554455654793216)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u/f64" (f64.const 13830554455654793217)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u/f64" (f64.const 13832806255468478464)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u/f64" (f64.const 13835058055282163712)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u/f64" (f64.const 13837309855095848960)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u/f64" (f64.const 13837628693406821649)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u/f64" (f64.const 13855599609120227197)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u/f64" (f64.const 14064741636278059009)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u/f64" (f64.const 14069245235905429505)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u/f64" (f64.const 14902658576054909116)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u/f64" (f64.const 15085946916428706967)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u/f64" (f64.const 18442240474082181119)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u/f64" (f64.const 18442240474082181120)) "integer overflow")
+(assert_trap (invoke "i32.trunc_u/f64" (f64.const 18444492273895866368)) "invalid conversion to integer")
+(assert_return (invoke "i64.trunc_s/f32" (f32.const 0)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s/f32" (f32.const 1)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s/f32" (f32.const 8388607)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s/f32" (f32.const 8388608)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s/f32" (f32.const 259824949)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s/f32" (f32.const 750226180)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s/f32" (f32.const 1036831949)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s/f32" (f32.const 1042217333)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s/f32" (f32.const 1056964608)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s/f32" (f32.const 1065353215)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s/f32" (f32.const 1065353216)) (i64.const 1))
+(assert_return (invoke "i64.trunc_s/f32" (f32.const 1065353217)) (i64.const 1))
+(assert_return (invoke "i64.trunc_s/f32" (f32.const 1077936128)) (i64.const 3))
+(assert_return (invoke "i64.trunc_s/f32" (f32.const 1078530011)) (i64.const 3))
+(assert_return (invoke "i64.trunc_s/f32" (f32.const 1091662068)) (i64.const 9))
+(assert_return (invoke "i64.trunc_s/f32" (f32.const 1258291201)) (i64.const 8388609))
+(assert_return (invoke "i64.trunc_s/f32" (f32.const 1266679809)) (i64.const 16777218))
+(assert_return (invoke "i64.trunc_s/f32" (f32.const 1471582024)) (i64.const 401453009076224))
+(assert_trap (invoke "i64.trunc_s/f32" (f32.const 1593835520)) "integer overflow")
+(assert_trap (invoke "i64.trunc_s/f32" (f32.const 2139095039)) "integer overflow")
+(assert_trap (invoke "i64.trunc_s/f32" (f32.const 2139095040)) "integer overflow")
+(assert_trap (invoke "i64.trunc_s/f32" (f32.const 2143289344)) "invalid conversion to integer")
+(assert_return (invoke "i64.trunc_s/f32" (f32.const 2147483648)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s/f32" (f32.const 2147483649)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s/f32" (f32.const 2155872255)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s/f32" (f32.const 2155872256)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s/f32" (f32.const 2268758901)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s/f32" (f32.const 2457338061)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s/f32" (f32.const 2913011599)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s/f32" (f32.const 3184315597)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s/f32" (f32.const 3204448256)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s/f32" (f32.const 3212836863)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s/f32" (f32.const 3212836864)) (i64.const 18446744073709551615))
+(assert_return (invoke "i64.trunc_s/f32" (f32.const 3212836865)) (i64.const 18446744073709551615))
+(assert_return (invoke "i64.trunc_s/f32" (f32.const 3225419776)) (i64.const 18446744073709551613))
+(assert_return (invoke "i64.trunc_s/f32" (f32.const 3226013659)) (i64.const 18446744073709551613))
+(assert_return (invoke "i64.trunc_s/f32" (f32.const 3287182386)) (i64.const 18446744073709551140))
+(assert_return (invoke "i64.trunc_s/f32" (f32.const 3405774849)) (i64.const 18446744073701163007))
+(assert_return (invoke "i64.trunc_s/f32" (f32.const 3414163457)) (i64.const 18446744073692774398))
+(assert_return (invoke "i64.trunc_s/f32" (f32.const 3741319168)) (i64.const 9223372036854775808))
+(assert_trap (invoke "i64.trunc_s/f32" (f32.const 3903589341)) "integer overflow")
+(assert_trap (invoke "i64.trunc_s/f32" (f32.const 4286578687)) "integer overflow")
+(assert_trap (invoke "i64.trunc_s/f32" (f32.const 4286578688)) "integer overflow")
+(assert_trap (invoke "i64.trunc_s/f32" (f32.const 4290772992)) "invalid conversion to integer")
+(assert_return (invoke "i64.trunc_s/f64" (f64.const 0)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s/f64" (f64.const 1)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s/f64" (f64.const 4503599627370495)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s/f64" (f64.const 4503599627370496)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s/f64" (f64.const 2887752785081066347)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s/f64" (f64.const 4591870180066957722)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s/f64" (f64.const 4597129579542836134)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s/f64" (f64.const 4602678819172646912)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s/f64" (f64.const 4607182418800017407)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s/f64" (f64.const 4607182418800017408)) (i64.const 1))
+(assert_return (invoke "i64.trunc_s/f64" (f64.const 4607182418800017409)) (i64.const 1))
+(assert_return (invoke "i64.trunc_s/f64" (f64.const 4613937818241073152)) (i64.const 3))
+(assert_return (invoke "i64.trunc_s/f64" (f64.const 4614256656552045841)) (i64.const 3))
+(assert_return (invoke "i64.trunc_s/f64" (f64.const 4841369599423283201)) (i64.const 4503599627370497))
+(assert_return (invoke "i64.trunc_s/f64" (f64.const 4845873199050653697)) (i64.const 9007199254740994))
+(assert_trap (invoke "i64.trunc_s/f64" (f64.const 4890909195324358656)) "integer overflow")
+(assert_trap (invoke "i64.trunc_s/f64" (f64.const 6663125719476418123)) "integer overflow")
+(assert_trap (invoke "i64.trunc_s/f64" (f64.const 6825222721590153577)) "integer overflow")
+(assert_trap (invoke "i64.trunc_s/f64" (f64.const 8738787080094230120)) "integer overflow")
+(assert_trap (invoke "i64.trunc_s/f64" (f64.const 9218868437227405311)) "integer overflow")
+(assert_trap (invoke "i64.trunc_s/f64" (f64.const 9218868437227405312)) "integer overflow")
+(assert_trap (invoke "i64.trunc_s/f64" (f64.const 9221120237041090560)) "invalid conversion to integer")
+(assert_return (invoke "i64.trunc_s/f64" (f64.const 9223372036854775808)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s/f64" (f64.const 9223372036854775809)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s/f64" (f64.const 9226657310799886230)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s/f64" (f64.const 9227875636482146303)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s/f64" (f64.const 9227875636482146304)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s/f64" (f64.const 13798002400248703893)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s/f64" (f64.const 13806888461875037789)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s/f64" (f64.const 13815242216921733530)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s/f64" (f64.const 13826050856027422720)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s/f64" (f64.const 13830554455654793215)) (i64.const 0))
+(assert_return (invoke "i64.trunc_s/f64" (f64.const 13830554455654793216)) (i64.const 18446744073709551615))
+(assert_return (invoke "i64.trunc_s/f64" (f64.const 13830554455654793217)) (i64.const 18446744073709551615))
+(assert_return (invoke "i64.trunc_s/f64" (f64.const 13837309855095848960)) (i64.const 18446744073709551613))
+(assert_return (invoke "i64.trunc_s/f64" (f64.const 13837628693406821649)) (i64.const 18446744073709551613))
+(assert_return (invoke "i64.trunc_s/f64" (f64.const 14064741636278059009)) (i64.const 18442240474082181119))
+(assert_return (invoke "i64.trunc_s/f64" (f64.const 14069245235905429505)) (i64.const 18437736874454810622))
+(assert_return (invoke "i64.trunc_s/f64" (f64.const 14114281232179134464)) (i64.const 9223372036854775808))
+(assert_trap (invoke "i64.trunc_s/f64" (f64.const 16620643688439122785)) "integer overflow")
+(assert_trap (invoke "i64.trunc_s/f64" (f64.const 17562022595977525984)) "integer overflow")
+(assert_trap (invoke "i64.trunc_s/f64" (f64.const 18442240474082181119)) "integer overflow")
+(assert_trap (invoke "i64.trunc_s/f64" (f64.const 18442240474082181120)) "integer overflow")
+(assert_trap (invoke "i64.trunc_s/f64" (f64.const 18444492273895866368)) "invalid conversion to integer")
+(assert_return (invoke "i64.trunc_u/f32" (f32.const 0)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u/f32" (f32.const 1)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u/f32" (f32.const 8388607)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u/f32" (f32.const 8388608)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u/f32" (f32.const 64735797)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u/f32" (f32.const 620045333)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u/f32" (f32.const 1036831949)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u/f32" (f32.const 1056964608)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u/f32" (f32.const 1065353215)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u/f32" (f32.const 1065353216)) (i64.const 1))
+(assert_return (invoke "i64.trunc_u/f32" (f32.const 1065353217)) (i64.const 1))
+(assert_return (invoke "i64.trunc_u/f32" (f32.const 1077936128)) (i64.const 3))
+(assert_return (invoke "i64.trunc_u/f32" (f32.const 1078530011)) (i64.const 3))
+(assert_return (invoke "i64.trunc_u/f32" (f32.const 1258291201)) (i64.const 8388609))
+(assert_return (invoke "i64.trunc_u/f32" (f32.const 1266679809)) (i64.const 16777218))
+(assert_return (invoke "i64.trunc_u/f32" (f32.const 1558252016)) (i64.const 506742369253588992))
+(assert_trap (invoke "i64.trunc_u/f32" (f32.const 1602224128)) "integer overflow")
+(assert_trap (invoke "i64.trunc_u/f32" (f32.const 2101518406)) "integer overflow")
+(assert_trap (invoke "i64.trunc_u/f32" (f32.const 2139095039)) "integer overflow")
+(assert_trap (invoke "i64.trunc_u/f32" (f32.const 2139095040)) "integer overflow")
+(assert_trap (invoke "i64.trunc_u/f32" (f32.const 2143289344)) "invalid conversion to integer")
+(assert_return (invoke "i64.trunc_u/f32" (f32.const 2147483648)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u/f32" (f32.const 2147483649)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u/f32" (f32.const 2155872255)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u/f32" (f32.const 2155872256)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u/f32" (f32.const 3184315597)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u/f32" (f32.const 3185524429)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u/f32" (f32.const 3198709594)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u/f32" (f32.const 3204448256)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u/f32" (f32.const 3212836863)) (i64.const 0))
+(assert_trap (invoke "i64.trunc_u/f32" (f32.const 3212836864)) "integer overflow")
+(assert_trap (invoke "i64.trunc_u/f32" (f32.const 3212836865)) "integer overflow")
+(assert_trap (invoke "i64.trunc_u/f32" (f32.const 3217031168)) "integer overflow")
+(assert_trap (invoke "i64.trunc_u/f32" (f32.const 3221225472)) "integer overflow")
+(assert_trap (invoke "i64.trunc_u/f32" (f32.const 3225419776)) "integer overflow")
+(assert_trap (invoke "i64.trunc_u/f32" (f32.const 3226013659)) "integer overflow")
+(assert_trap (invoke "i64.trunc_u/f32" (f32.const 3373299379)) "integer overflow")
+(assert_trap (invoke "i64.trunc_u/f32" (f32.const 3405774849)) "integer overflow")
+(assert_trap (invoke "i64.trunc_u/f32" (f32.const 3407765327)) "integer overflow")
+(assert_trap (invoke "i64.trunc_u/f32" (f32.const 3414163457)) "integer overflow")
+(assert_trap (invoke "i64.trunc_u/f32" (f32.const 3455734551)) "integer overflow")
+(assert_trap (invoke "i64.trunc_u/f32" (f32.const 4077582935)) "integer overflow")
+(assert_trap (invoke "i64.trunc_u/f32" (f32.const 4286578687)) "integer overflow")
+(assert_trap (invoke "i64.trunc_u/f32" (f32.const 4286578688)) "integer overflow")
+(assert_trap (invoke "i64.trunc_u/f32" (f32.const 4290772992)) "invalid conversion to integer")
+(assert_return (invoke "i64.trunc_u/f64" (f64.const 0)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u/f64" (f64.const 1)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u/f64" (f64.const 4503599627370495)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u/f64" (f64.const 4503599627370496)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u/f64" (f64.const 1288554559852430409)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u/f64" (f64.const 2349916719751032255)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u/f64" (f64.const 3461771878374661903)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u/f64" (f64.const 4486629954239226531)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u/f64" (f64.const 4591870180066957722)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u/f64" (f64.const 4602678819172646912)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u/f64" (f64.const 4607182418800017407)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u/f64" (f64.const 4607182418800017408)) (i64.const 1))
+(assert_return (invoke "i64.trunc_u/f64" (f64.const 4607182418800017409)) (i64.const 1))
+(assert_return (invoke "i64.trunc_u/f64" (f64.const 4613937818241073152)) (i64.const 3))
+(assert_return (invoke "i64.trunc_u/f64" (f64.const 4614256656552045841)) (i64.const 3))
+(assert_return (invoke "i64.trunc_u/f64" (f64.const 4841369599423283201)) (i64.const 4503599627370497))
+(assert_return (invoke "i64.trunc_u/f64" (f64.const 4845873199050653697)) (i64.const 9007199254740994))
+(assert_trap (invoke "i64.trunc_u/f64" (f64.const 4895412794951729152)) "integer overflow")
+(assert_trap (invoke "i64.trunc_u/f64" (f64.const 7363240063423200573)) "integer overflow")
+(assert_trap (invoke "i64.trunc_u/f64" (f64.const 7659868016352006022)) "integer overflow")
+(assert_trap (invoke "i64.trunc_u/f64" (f64.const 9218868437227405311)) "integer overflow")
+(assert_trap (invoke "i64.trunc_u/f64" (f64.const 9218868437227405312)) "integer overflow")
+(assert_trap (invoke "i64.trunc_u/f64" (f64.const 9221120237041090560)) "invalid conversion to integer")
+(assert_return (invoke "i64.trunc_u/f64" (f64.const 9223372036854775808)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u/f64" (f64.const 9223372036854775809)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u/f64" (f64.const 9227875636482146303)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u/f64" (f64.const 9227875636482146304)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u/f64" (f64.const 9617157300193186725)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u/f64" (f64.const 13144139411816338738)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u/f64" (f64.const 13815242216921733530)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u/f64" (f64.const 13826050856027422720)) (i64.const 0))
+(assert_return (invoke "i64.trunc_u/f64" (f64.const 13830554455654793215)) (i64.const 0))
+(assert_trap (invoke "i64.trunc_u/f64" (f64.const 13830554455654793216)) "integer overflow")
+(assert_trap (invoke "i64.trunc_u/f64" (f64.const 13830554455654793217)) "integer overflow")
+(assert_trap (invoke "i64.trunc_u/f64" (f64.const 13832806255468478464)) "integer overflow")
+(assert_trap (invoke "i64.trunc_u/f64" (f64.const 13835058055282163712)) "integer overflow")
+(assert_trap (invoke "i64.trunc_u/f64" (f64.const 13837309855095848960)) "integer overflow")
+(assert_trap (invoke "i64.trunc_u/f64" (f64.const 13837628693406821649)) "integer overflow")
+(assert_trap (invoke "i64.trunc_u/f64" (f64.const 14064741636278059009)) "integer overflow")
+(assert_trap (invoke "i64.trunc_u/f64" (f64.const 14069245235905429505)) "integer overflow")
+(assert_trap (invoke "i64.trunc_u/f64" (f64.const 14462619837605073026)) "integer overflow")
+(assert_trap (invoke "i64.trunc_u/f64" (f64.const 15341923991099759253)) "integer overflow")
+(assert_trap (invoke "i64.trunc_u/f64" (f64.const 18442240474082181119)) "integer overflow")
+(assert_trap (invoke "i64.trunc_u/f64" (f64.const 18442240474082181120)) "integer overflow")
+(assert_trap (invoke "i64.trunc_u/f64" (f64.const 18444492273895866368)) "invalid conversion to integer")
@@ -0,0 +1,6 @@
+(module
+  (func (export "add") (param $a i32) (param $b i32) (result i32)
+    (i32.add (get_local $a) (get_local $b)))
+  (global $g (export "g") i32 (i32.const 40))
+  (memory (export "mem") (data "\01\02\03\04"))
+)
@@ -0,0 +1,81 @@
+(module
+  (type $binary (func (param i32 i32) (result i32)))
+  (type $unary (func (param i32) (result i32)))
+  (import "lib" "add" (func $add (type $binary)))
+  (func $sub (import "lib" "add") (param i32 i32) (result i32))
+  (import "lib" "g" (global $imported i32))
+  (import "lib" "mem" (memory 1))
+
+  (global $counter (mut i64) (i64.const -1))
+  (global $bits f32 (f32.const -0x1.8p1))
+
+  (table anyfunc (elem $double $square))
+  (data (i32.const 16) "life" "\u{1F600}")
+
+  (func $double (param i32) (result i32) (i32.shl (get_local 0) (i32.const 1)))
+  (func $square (param $x i32) (result i32) (i32.mul (get_local $x) (get_local $x)))
+
+  (func (export "call-import") (result i32)
+    (call $add (i32.const 2) (i32.const 3)))
+  (func (export "call-indirect") (param $i i32) (param $x i32) (result i32)
+    (i32.add
+      (call_indirect (type $unary) (get_local $x) (i32.const 0))
+      (call_indirect (param i32) (result i32) (get_local $x) (get_local $i))))
+
+  (func (export "plain-loop") (param $n i32) (result i32)
+    (local $acc i32)
+    block $done
+      loop $next
+        get_local $n
+        i32.eqz
+        br_if $done
+        get_local $acc
+        get_local $n
+        i32.add
+        set_local $acc
+        get_local $n
+        i32.const 1
+        i32.sub
+        set_local $n
+        br $next
+      end $next
+    end $done
+    get_local $acc)
+
+  (func (export "folded-if") (param i32) (result i32)
+    (if (result i32) (get_local 0)
+      (then (i32.const 1))
+      (else (i32.const -1))))
+  (func (export "plain-if") (param i32) (result i32)
+    get_local 0
+    if $l (result i32)
+      i32.const 10
+    else $l
+      i32.const 20
+    end $l)
+
+  (func (export "br-table") (param i32) (result i32)
+    (block $c (block $b (block $a
+      (br_table $a $b $c (get_local 0)))
+      (return (i32.const 100)))
+      (return (i32.const 101)))
+    (i32.const 102))
+
+  (func (export "counter") (result i64)
+    (set_global $counter (i64.add (get_global $counter) (i64.const 1)))
+    (get_global $counter))
+  (func (export "imported-global") (result i32) (get_global $imported))
+  (func (export "f32-bits") (result i32) (i32.reinterpret/f32 (get_global $bits)))
+
+  (func (export "load") (param i32) (result i32) (i32.load8_u offset=16 (get_local 0)))
+  (func (export "load-aligned") (result i32) (i32.load align=1 (i32.const 16)))
+
+  (func (export "new-names") (param i64) (result i32)
+    (local.set 0 (i64.add (local.get 0) (i64.const 0x1_0000_0000)))
+    (i32.wrap_i64 (i64.shr_u (local.get 0) (i64.const 32))))
+  (func (export "memory-size") (result i32) (memory.size))
+
+  (func (export "hex-float") (result f64) (f64.const 0x1p-2))
+  (func (export "nan-payload") (result f32) (f32.const -nan:0x200000))
+  (func (export "trap") (unreachable))
+)
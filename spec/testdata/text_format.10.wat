@@ -0,0 +1 @@
+(func (call $missing))
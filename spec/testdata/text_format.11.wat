@@ -0,0 +1 @@
+(func block $a end $b)
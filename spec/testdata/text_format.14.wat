@@ -0,0 +1 @@
+(func (local $x i32) (local $x i64))
@@ -0,0 +1 @@
+(type $t (func)) (func (type $t) (param i32))
@@ -0,0 +1 @@
+(func (nop)
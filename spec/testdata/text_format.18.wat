@@ -0,0 +1 @@
+(module (func (result i32) (i64.const 0)))
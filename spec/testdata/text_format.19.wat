@@ -0,0 +1 @@
+(module (func (get_local 1)))
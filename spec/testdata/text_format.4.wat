@@ -0,0 +1 @@
+(func (i64.const -9223372036854775809) drop)
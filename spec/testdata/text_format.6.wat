@@ -0,0 +1 @@
+(func (i32.load align=3 (i32.const 0)) drop) (memory 1)
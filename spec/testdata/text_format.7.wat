@@ -0,0 +1 @@
+(func) (import "" "" (func))
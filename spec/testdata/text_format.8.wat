@@ -0,0 +1 @@
+(func $f) (func $f)
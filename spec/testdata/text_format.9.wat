@@ -0,0 +1 @@
+(func (br $missing))
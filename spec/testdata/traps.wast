@@ -0,0 +1,22 @@
+(module
+  (func $runaway (export "runaway") (call $runaway))
+  (func $countdown (export "countdown") (param i32) (result i32)
+    (if (result i32) (i32.eqz (get_local 0))
+      (then (i32.const 1))
+      (else (call $countdown (i32.sub (get_local 0) (i32.const 1))))
+    )
+  )
+)
+
+(assert_exhaustion (invoke "runaway") "call stack exhausted")
+(assert_exhaustion (invoke "countdown" (i32.const 100000)) "call stack exhausted")
+(assert_return (invoke "countdown" (i32.const 100)) (i32.const 1))
+
+(assert_trap
+  (module (func $main (unreachable)) (start $main))
+  "unreachable"
+)
+(assert_unlinkable
+  (module (memory 1) (data (i32.const 65536) "a"))
+  "data segment does not fit"
+)
@@ -0,0 +1 @@
+(module (func (i32.const 0x)))
\ No newline at end of file
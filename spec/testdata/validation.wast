@@ -0,0 +1,124 @@
+(assert_invalid
+  (module binary "\00\61\73\6d\01\00\00\00\01\05\01\60\00\01\7f\03" "\02\01\00\0a\06\01\04\00\42\00\0b")
+  "type mismatch"
+)
+(assert_invalid
+  (module binary "\00\61\73\6d\01\00\00\00\01\05\01\60\00\01\7f\03" "\02\01\00\0a\05\01\03\00\6a\0b")
+  "type mismatch"
+)
+(assert_invalid
+  (module binary "\00\61\73\6d\01\00\00\00\01\04\01\60\00\00\03\02" "\01\00\0a\06\01\04\00\41\00\0b")
+  "type mismatch"
+)
+(assert_invalid
+  (module binary "\00\61\73\6d\01\00\00\00\01\04\01\60\00\00\03\02" "\01\00\0a\0c\01\0a\00\41\00\04\7f\41\00\0b\1a\0b")
+  "type mismatch"
+)
+(assert_invalid
+  (module binary "\00\61\73\6d\01\00\00\00\01\04\01\60\00\00\03\02" "\01\00\0a\10\01\0e\00\02\7f\41\00\41\00\0e\01\00" "\01\0b\1a\0b")
+  "type mismatch"
+)
+(assert_invalid
+  (module binary "\00\61\73\6d\01\00\00\00\01\04\01\60\00\00\03\02" "\01\00\0a\0c\01\0a\00\41\01\42\02\41\00\1b\1a\0b")
+  "type mismatch"
+)
+(assert_invalid
+  (module binary "\00\61\73\6d\01\00\00\00\01\04\01\60\00\00\03\02" "\01\00\0a\06\01\04\00\0c\01\0b")
+  "unknown label"
+)
+(assert_invalid
+  (module binary "\00\61\73\6d\01\00\00\00\01\04\01\60\00\00\03\02" "\01\00\0a\07\01\05\00\20\00\1a\0b")
+  "unknown local"
+)
+(assert_invalid
+  (module binary "\00\61\73\6d\01\00\00\00\01\04\01\60\00\00\03\02" "\01\00\0a\06\01\04\00\10\05\0b")
+  "unknown function"
+)
+(assert_invalid
+  (module binary "\00\61\73\6d\01\00\00\00\01\04\01\60\00\00\03\02" "\01\00\0a\0a\01\08\00\41\00\28\02\00\1a\0b")
+  "unknown memory"
+)
+(assert_invalid
+  (module binary "\00\61\73\6d\01\00\00\00\01\04\01\60\00\00\03\02" "\01\00\05\03\01\00\01\0a\0a\01\08\00\41\00\28\03" "\00\1a\0b")
+  "alignment must not be larger than natural"
+)
+(assert_invalid
+  (module binary "\00\61\73\6d\01\00\00\00\01\04\01\60\00\00\03\02" "\01\00\06\06\01\7f\00\41\00\0b\0a\08\01\06\00\41" "\00\24\00\0b")
+  "global is immutable"
+)
+(assert_invalid
+  (module binary "\00\61\73\6d\01\00\00\00\01\04\01\60\00\00\03\02" "\01\00\06\06\01\7f\00\42\00\0b\0a\04\01\02\00\0b")
+  "type mismatch"
+)
+(assert_invalid
+  (module binary "\00\61\73\6d\01\00\00\00\01\04\01\60\00\00\03\02" "\01\00\06\09\01\7f\00\41\00\41\00\6a\0b\0a\04\01" "\02\00\0b")
+  "constant expression required"
+)
+(assert_invalid
+  (module binary "\00\61\73\6d\01\00\00\00\01\04\01\60\00\00\03\02" "\01\00\05\05\02\00\01\00\01\0a\04\01\02\00\0b")
+  "multiple memories"
+)
+(assert_invalid
+  (module binary "\00\61\73\6d\01\00\00\00\01\04\01\60\00\00\03\02" "\01\00\05\04\01\01\02\01\0a\04\01\02\00\0b")
+  "size minimum must not be greater than maximum"
+)
+(assert_invalid
+  (module binary "\00\61\73\6d\01\00\00\00\01\04\01\60\00\00\03\02" "\01\00\05\05\01\00\81\80\04\0a\04\01\02\00\0b")
+  "memory size must be at most 65536 pages (4GiB)"
+)
+(assert_invalid
+  (module binary "\00\61\73\6d\01\00\00\00\01\05\01\60\01\7f\00\03" "\02\01\00\08\01\00\0a\05\01\03\00\1a\0b")
+  "start function"
+)
+(assert_invalid
+  (module binary "\00\61\73\6d\01\00\00\00\01\04\01\60\00\00\03\02" "\01\00\07\05\01\01\66\00\03\0a\04\01\02\00\0b")
+  "unknown function"
+)
+(assert_invalid
+  (module binary "\00\61\73\6d\01\00\00\00\01\04\01\60\00\00\03\02" "\01\00\07\09\02\01\66\00\00\01\66\00\00\0a\04\01" "\02\00\0b")
+  "duplicate export name"
+)
+(assert_invalid
+  (module binary "\00\61\73\6d\01\00\00\00\01\04\01\60\00\00\03\02" "\01\00\09\07\01\00\41\00\0b\01\00\0a\04\01\02\00" "\0b")
+  "unknown table"
+)
+(assert_invalid
+  (module binary "\00\61\73\6d\01\00\00\00\01\04\01\60\00\00\03\02" "\01\00\0a\04\01\02\00\0b\0b\07\01\00\41\00\0b\01" "\78")
+  "unknown memory"
+)
+(assert_invalid
+  (module binary "\00\61\73\6d\01\00\00\00\01\06\01\60\00\02\7f\7f")
+  "invalid result arity"
+)
+(assert_malformed
+  (module binary "\00\61\73\6e\01\00\00\00")
+  "magic header not detected"
+)
+(assert_malformed
+  (module binary "\00\61\73\6d\02\00\00\00")
+  "unknown binary version"
+)
+(assert_malformed
+  (module binary "\00\61\73\6d\01\00\00\00\01")
+  "unexpected end"
+)
+(assert_malformed
+  (module binary "\00\61\73\6d\01\00\00\00\01\04\01\60\00\00\03\02" "\01\00\0a\0a\01\08\01\ff\ff\ff\ff\0f\7f\0b")
+  "too many locals"
+)
+(assert_malformed
+  (module binary "\00\61\73\6d\01\00\00\00\01\04\01\60\00\00\03\02" "\01\00\05\03\01\00\01\0a\07\01\05\00\3f\01\1a\0b")
+  "zero flag expected"
+)
+(assert_malformed
+  (module binary "\00\61\73\6d\01\00\00\00\01\04\01\60\00\00\03\02" "\01\00\0a\05\01\03\00\ff\0b")
+  "illegal opcode"
+)
+(assert_malformed
+  (module binary "\00\61\73\6d\01\00\00\00\01\04\01\60\00\00\03\02" "\01\00")
+  "function and code section have inconsistent lengths"
+)
+(assert_malformed
+  (module quote "(func (i32.const 0x))")
+  "unknown operator"
+)
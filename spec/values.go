@@ -0,0 +1,91 @@
+package spec
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+const (
+	canonicalNaN  = "nan:canonical"
+	arithmeticNaN = "nan:arithmetic"
+)
+
+// parseValue returns the bit pattern of a value as the VM stores it.
+func parseValue(v Value) (int64, error) {
+	switch v.Type {
+	case "i32", "f32":
+		bits, err := strconv.ParseUint(v.Value, 10, 32)
+		if err != nil {
+			return 0, fmt.Errorf("invalid %s value %q", v.Type, v.Value)
+		}
+		return int64(bits), nil
+	case "i64", "f64":
+		bits, err := strconv.ParseUint(v.Value, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid %s value %q", v.Type, v.Value)
+		}
+		return int64(bits), nil
+	default:
+		return 0, fmt.Errorf("unsupported value type %q", v.Type)
+	}
+}
+
+// checkValue compares a result with its expectation. Floats are compared by
+// bit pattern so that the sign of zeros and NaN payloads are checked.
+func checkValue(expected Value, got int64) error {
+	switch expected.Value {
+	case canonicalNaN, arithmeticNaN:
+		if !isNaN(expected.Type, got, expected.Value == canonicalNaN) {
+			return fmt.Errorf("got %s, expected %s", formatValue(expected.Type, got), expected.Value)
+		}
+		return nil
+	}
+
+	want, err := parseValue(expected)
+	if err != nil {
+		return err
+	}
+	if expected.Type == "i32" || expected.Type == "f32" {
+		got = int64(uint32(got))
+	}
+	if got != want {
+		return fmt.Errorf("got %s, expected %s", formatValue(expected.Type, got), formatValue(expected.Type, want))
+	}
+	return nil
+}
+
+// isNaN reports whether bits is a NaN of the given type. A canonical NaN has
+// only the most significant bit of the payload set; an arithmetic NaN has at
+// least that bit set.
+func isNaN(typ string, bits int64, canonical bool) bool {
+	switch typ {
+	case "f32":
+		payload := uint32(bits) & 0x7fffffff
+		if canonical {
+			return payload == 0x7fc00000
+		}
+		return payload&0x7fc00000 == 0x7fc00000
+	case "f64":
+		payload := uint64(bits) & 0x7fffffffffffffff
+		if canonical {
+			return payload == 0x7ff8000000000000
+		}
+		return payload&0x7ff8000000000000 == 0x7ff8000000000000
+	}
+	return false
+}
+
+func formatValue(typ string, bits int64) string {
+	switch typ {
+	case "i32":
+		return fmt.Sprintf("i32:%d", int32(bits))
+	case "i64":
+		return fmt.Sprintf("i64:%d", bits)
+	case "f32":
+		return fmt.Sprintf("f32:%v (0x%08x)", math.Float32frombits(uint32(bits)), uint32(bits))
+	case "f64":
+		return fmt.Sprintf("f64:%v (0x%016x)", math.Float64frombits(uint64(bits)), uint64(bits))
+	}
+	return fmt.Sprintf("%s:%d", typ, bits)
+}
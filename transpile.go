@@ -0,0 +1,88 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	"github.com/perlin-network/life/compiler"
+	"github.com/perlin-network/life/transpile"
+	"github.com/perlin-network/life/wat"
+)
+
+// transpileMain implements `life transpile [flags] -o dir module.wasm`, which
+// translates a module into a Go package.
+func transpileMain(args []string) {
+	fs := flag.NewFlagSet("transpile", flag.ExitOnError)
+	outFlag := fs.String("o", "", "directory to write the package to")
+	packageFlag := fs.String("package", "", "package name (default the base name of the directory)")
+	gasFlag := fs.Bool("gas", false, "insert gas counters")
+	noFloatFlag := fs.Bool("no-fp", false, "disable floating point")
+	detFloatFlag := fs.Bool("deterministic-fp", false, "canonicalize NaNs and trap on invalid float to integer conversions")
+	optFlag := fs.Int("O", 0, fmt.Sprintf("optimization level, 0 to %d", compiler.MaxOptLevel))
+	fs.Parse(args)
+
+	if fs.NArg() != 1 || *outFlag == "" || *optFlag < 0 || *optFlag > compiler.MaxOptLevel {
+		fmt.Fprintln(os.Stderr, "usage: life transpile [-package name] [-gas] [-no-fp] [-deterministic-fp] [-O level] -o dir module.wasm")
+		os.Exit(2)
+	}
+
+	input, err := ioutil.ReadFile(fs.Arg(0))
+	if err != nil {
+		panic(err)
+	}
+	if wat.IsText(input) {
+		if input, err = wat.Parse(input); err != nil {
+			panic(err)
+		}
+	}
+	m, err := compiler.LoadModule(input)
+	if err != nil {
+		panic(err)
+	}
+	m.DisableFloatingPoint = *noFloatFlag
+	m.DeterministicFloatingPoint = *detFloatFlag
+	m.OptLevel = *optFlag
+
+	config := transpile.Config{Package: *packageFlag}
+	if config.Package == "" {
+		config.Package = packageName(*outFlag)
+	}
+	if *gasFlag {
+		config.GasPolicy = &compiler.SimpleGasPolicy{GasPerInstruction: 1}
+	}
+
+	src, err := transpile.Transpile(m, config)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "life transpile: %v\n", err)
+		os.Exit(1)
+	}
+	if err := os.MkdirAll(*outFlag, 0755); err != nil {
+		panic(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(*outFlag, "module.go"), src, 0644); err != nil {
+		panic(err)
+	}
+}
+
+// packageName derives a package name from the name of a directory.
+func packageName(dir string) string {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		panic(err)
+	}
+	name := strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, filepath.Base(abs))
+	if name == "" || !unicode.IsLetter([]rune(name)[0]) {
+		return "module"
+	}
+	return name
+}
@@ -0,0 +1,71 @@
+# Generates ops.go from the instruction handlers in Execute, in ../exec/vm.go,
+# and the instruction encodings in ../compiler/serialize.go.
+import re
+import subprocess
+
+# SSA ops and the number of immediate words preceding the values in their
+# encoding, by opcode.
+ser = open('../compiler/serialize.go').read()
+ser = ser[ser.index('\t\tswitch ins.Op {'):]
+ssa_ops = {}
+for c in re.split(r'\n(?=\t\tcase ")', ser)[1:]:
+    names = re.findall(r'"([^"]+)"', c.split('\n')[0])
+    opcode = re.search(r'opcodes\.(\w+)', c).group(1)
+    imms = len(re.findall(r'ins\.Immediates\[', c))
+    ssa_ops.setdefault(opcode, []).extend((n, imms) for n in names)
+
+# Instructions the transpiler implements itself, and superinstructions, which
+# only appear after the SSA stage.
+skip = {'Nop', 'I32Const', 'I64Const', 'Jmp', 'JmpEither', 'JmpIf', 'JmpTable', 'ReturnValue', 'ReturnVoid',
+        'GetLocal', 'SetLocal', 'GetGlobal', 'SetGlobal', 'Call', 'CallIndirect', 'InvokeImport', 'CurrentMemory',
+        'GrowMemory', 'Phi', 'AddGas', 'CoverBlock', 'I32AddLocalImm', 'I32EqLocalImm', 'JmpIfI32GtU',
+        'JmpIfI32GeU', 'JmpIfI32Or', 'JmpIfI64Ne', 'JmpIfI64EqZ', 'I32AddImm', 'I32EqImm', 'I64AddImm',
+        'I64ShrUImm', 'I64ShlImm', 'I32LoadAddImm', 'I64LoadAddImm'}
+
+src = open('../exec/vm.go').read()
+sw = src[src.index('\t\tswitch ins {'):]
+sw = sw[:sw.index('\t\tdefault:\n\t\t\tpanic("unknown instruction")')]
+out = []
+for c in re.split(r'\n(?=\t\tcase )', sw)[1:]:
+    lines = c.split('\n')
+    opcodes = re.findall(r'opcodes\.(\w+)', lines[0])
+    if any('Soft' in n for n in opcodes):
+        # Transpiled code does not depend on package softfloat.
+        continue
+    if any(n in skip for n in opcodes):
+        assert all(n in skip for n in opcodes), opcodes
+        continue
+    body = [l for l in lines[1:] if not re.match(r'^\s*ip(\s*\+=.*|\+\+)$', l) and not re.match(r'^\s*// fmt\.', l)]
+    while body and body[-1].strip() == '':
+        body.pop()
+    while body and body[0].strip() == '':
+        body.pop(0)
+    text = re.sub(r'\n\n\n+', '\n\n', '\n'.join(l[3:] if l.startswith('\t\t\t') else l for l in body))
+    text = re.sub(r'code\[ip\]', 'w0', text)
+    text = re.sub(r'code\[ip\+(\d+)\]', lambda m: 'w' + m.group(1), text)
+    text = text.replace('regs[valueID]', '$t').replace('vm.Memory', 'm.Memory').replace('LE.', 'le.')
+    for opcode in opcodes:
+        for name, imms in ssa_ops[opcode]:
+            def value(m):
+                assert int(m.group(1)) >= imms, (name, text)
+                return '$v%d' % (int(m.group(1)) - imms)
+
+            def immediate(m):
+                assert int(m.group(1)) < imms, (name, text)
+                return '$i%d' % int(m.group(1))
+            t = re.sub(r'regs\[w(\d+)\]', value, text)
+            t = re.sub(r'\bw(\d+)\b', immediate, t)
+            assert 'regs' not in t and 'vm.' not in t and 'frame' not in t and 'code' not in t, (name, t)
+            out.append('\t"%s": `%s`,' % (name, t))
+hdr = """// Code generated by gen_ops.py from exec/vm.go; DO NOT EDIT.
+
+package transpile
+
+// opTemplates holds the Go code of the instructions that are transpiled the
+// way the interpreter executes them, keyed by SSA op. In the code, $t stands
+// for the target value, $vN for the N-th value operand and $iN for the N-th
+// immediate, as a uint32.
+var opTemplates = map[string]string{
+"""
+open('ops.go', 'w').write(hdr + '\n'.join(out) + '\n}\n')
+subprocess.check_call(['gofmt', '-w', 'ops.go'])
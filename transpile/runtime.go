@@ -0,0 +1,80 @@
+package transpile
+
+// runtimeHeader starts every generated package, after the package clause.
+const runtimeHeader = `import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/bits"
+)
+
+var (
+	_ = math.Float64bits
+	_ = bits.LeadingZeros64
+)
+
+var le = binary.LittleEndian
+
+const (
+	pageSize      = 65536
+	callStackSize = 512 // maximum call depth if MaxCallStackDepth is not set
+)
+
+`
+
+// runtimeFooter ends every generated package. It implements the parts of
+// the interpreter that transpiled functions share.
+const runtimeFooter = `// enter counts a call the way the interpreter counts its frames.
+func (m *Module) enter() {
+	depth := m.MaxCallStackDepth
+	if depth == 0 {
+		depth = callStackSize
+	}
+	if m.depth >= depth {
+		panic("max call stack depth exceeded")
+	}
+	m.depth++
+}
+
+func (m *Module) addGas(delta uint64) {
+	gas := m.Gas + delta
+	if gas < m.Gas {
+		panic("gas overflow")
+	}
+	if m.GasLimit != 0 && gas > m.GasLimit {
+		panic("gas limit exceeded")
+	}
+	m.Gas = gas
+}
+
+func (m *Module) growMemory(pages int64) int64 {
+	n := int(uint32(pages))
+	current := len(m.Memory) / pageSize
+	if m.MaxMemoryPages == 0 || (current+n >= current && current+n <= m.MaxMemoryPages) {
+		m.Memory = append(m.Memory, make([]byte, n*pageSize)...)
+		return int64(current)
+	}
+	return -1
+}
+
+// newTable returns a table of n elements referencing no function.
+func newTable(n int) []uint32 {
+	table := make([]uint32, n)
+	for i := range table {
+		table[i] = 0xffffffff
+	}
+	return table
+}
+
+// catchTrap turns a trap into an error.
+func (m *Module) catchTrap(err *error) {
+	if r := recover(); r != nil {
+		m.depth = 0
+		if e, ok := r.(error); ok {
+			*err = e
+		} else {
+			*err = fmt.Errorf("%+v", r)
+		}
+	}
+}
+`
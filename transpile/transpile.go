@@ -0,0 +1,805 @@
+// Package transpile translates WebAssembly modules into Go source code.
+//
+// The generated package implements each function of the module as a method
+// of a Module type, compiled from the control flow graph the compiler builds
+// for the interpreter, so that it behaves like the interpreter does: linear
+// memory is a []byte, globals are fields, and traps are the same panics,
+// returned as errors by the exported functions. Imported functions are
+// methods of an Imports interface implemented by the embedder.
+//
+// All values are passed as int64, floating point numbers by their bits, as
+// the interpreter does.
+package transpile
+
+//go:generate python3 gen_ops.py
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"go/format"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/go-interpreter/wagon/wasm"
+	"github.com/go-interpreter/wagon/wasm/leb128"
+	ops "github.com/go-interpreter/wagon/wasm/operators"
+	"github.com/perlin-network/life/compiler"
+	"github.com/perlin-network/life/utils"
+)
+
+// Config controls how a module is transpiled.
+type Config struct {
+	// Package is the name of the generated package.
+	Package string
+
+	// GasPolicy, if not nil, is used to insert gas counters into the
+	// generated code, the way the interpreter does.
+	GasPolicy compiler.GasPolicy
+}
+
+// function is a function in the function index space of a module.
+type function struct {
+	name     string // of the Imports method, for imports
+	field    string // module and field name, for imports
+	sig      *wasm.FunctionSig
+	imported bool
+}
+
+type transpiler struct {
+	m      *compiler.Module
+	config Config
+	buf    bytes.Buffer
+
+	functions    []function
+	globals      []string     // init expression of each global, as Go code
+	importNames  []string     // of the Imports methods other than functions
+	importTypes  []string     // of the values they return
+	importFields []string     // module and field names of these imports
+	memory       string       // Go expression of the initial memory
+	table        string       // Go expression of the initial table
+	indirect     map[int]bool // types used by call_indirect
+	tableFuncs   []int        // functions referenced by the table
+
+	// State of the function being translated.
+	numParams  int
+	read       map[compiler.TyValueID]bool // values that are read
+	readLocals map[int64]bool              // locals that are read
+}
+
+// Transpile translates a module into the source of a Go package.
+func Transpile(m *compiler.Module, config Config) (_ []byte, retErr error) {
+	defer utils.CatchPanic(&retErr)
+
+	if m.SoftFloat && !m.DisableFloatingPoint {
+		return nil, fmt.Errorf("soft float is not supported by transpiled code")
+	}
+	if config.Package == "" {
+		config.Package = "module"
+	}
+	t := &transpiler{m: m, config: config, indirect: make(map[int]bool)}
+	t.resolve()
+
+	t.printf("// Code generated by life transpile; DO NOT EDIT.\n\n")
+	t.printf("package %s\n\n", config.Package)
+	t.printf("%s", runtimeHeader)
+	t.printImports()
+	t.printModule()
+	for id := range t.functions {
+		if t.functions[id].imported {
+			t.printImportCall(id)
+		} else {
+			t.printFunction(id)
+		}
+	}
+	t.printCallIndirect()
+	t.printExports()
+	t.printf("%s", runtimeFooter)
+
+	src, err := format.Source(t.buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("generated code does not parse: %v", err)
+	}
+	return src, nil
+}
+
+func (t *transpiler) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&t.buf, format, args...)
+}
+
+// resolve collects the functions and globals of the module.
+func (t *transpiler) resolve() {
+	base := t.m.Base
+	names := newNameSet()
+	if base.Import != nil {
+		for _, e := range base.Import.Entries {
+			name := names.add(goName(e.ModuleName + "_" + e.FieldName))
+			field := e.ModuleName + "." + e.FieldName
+			if e.Type.Kind() != wasm.ExternalFunction {
+				t.importFields = append(t.importFields, field)
+			}
+			switch e.Type.Kind() {
+			case wasm.ExternalFunction:
+				t.functions = append(t.functions, function{
+					name:     name,
+					field:    field,
+					sig:      &base.Types.Entries[e.Type.(wasm.FuncImport).Type],
+					imported: true,
+				})
+			case wasm.ExternalGlobal:
+				t.globals = append(t.globals, fmt.Sprintf("imports.%s()", name))
+				t.importNames = append(t.importNames, name)
+				t.importTypes = append(t.importTypes, "int64")
+			case wasm.ExternalMemory:
+				t.memory = fmt.Sprintf("imports.%s()", name)
+				t.importNames = append(t.importNames, name)
+				t.importTypes = append(t.importTypes, "[]byte")
+			case wasm.ExternalTable:
+				t.table = fmt.Sprintf("imports.%s()", name)
+				t.importNames = append(t.importNames, name)
+				t.importTypes = append(t.importTypes, "[]uint32")
+			default:
+				panic(fmt.Errorf("import kind not supported: %d", e.Type.Kind()))
+			}
+		}
+	}
+	for _, f := range base.FunctionIndexSpace {
+		t.functions = append(t.functions, function{sig: f.Sig})
+	}
+	for _, g := range base.GlobalIndexSpace {
+		t.globals = append(t.globals, initExpr(g.Init))
+	}
+	if base.Memory != nil && len(base.Memory.Entries) > 0 {
+		if t.memory != "" {
+			panic("cannot import another memory while we already have one")
+		}
+		t.memory = fmt.Sprintf("make([]byte, %d*pageSize)", base.Memory.Entries[0].Limits.Initial)
+	}
+	if base.Table != nil && len(base.Table.Entries) > 0 {
+		if t.table != "" {
+			panic("cannot import another table while we already have one")
+		}
+		t.table = fmt.Sprintf("newTable(%d)", base.Table.Entries[0].Limits.Initial)
+	}
+
+	if base.Elements != nil {
+		seen := make(map[int]bool)
+		for _, e := range base.Elements.Entries {
+			for _, id := range e.Elems {
+				if !seen[int(id)] && int(id) < len(t.functions) {
+					seen[int(id)] = true
+					t.tableFuncs = append(t.tableFuncs, int(id))
+				}
+			}
+		}
+		sort.Ints(t.tableFuncs)
+	}
+}
+
+func (t *transpiler) printImports() {
+	t.printf("// Imports are the functions and globals imported by the module.\n")
+	t.printf("type Imports interface {\n")
+	for _, f := range t.functions {
+		if f.imported {
+			t.printf("\t%s(m *Module%s)%s // %s\n", f.name, params(len(f.sig.ParamTypes), ", "), results(f.sig), f.field)
+		}
+	}
+	for i, name := range t.importNames {
+		t.printf("\t%s() %s // %s\n", name, t.importTypes[i], t.importFields[i])
+	}
+	t.printf("}\n\n")
+}
+
+func (t *transpiler) printModule() {
+	base := t.m.Base
+	t.printf("// Module is an instance of the module.\n")
+	t.printf("type Module struct {\n")
+	t.printf("\tMemory []byte\n")
+	t.printf("\tTable  []uint32\n")
+	for i := range t.globals {
+		t.printf("\tGlobal%d int64\n", i)
+	}
+	t.printf("\n")
+	t.printf("\t// Gas is the gas used so far, and GasLimit the gas at which execution\n")
+	t.printf("\t// traps, if not zero.\n")
+	t.printf("\tGas      uint64\n")
+	t.printf("\tGasLimit uint64\n\n")
+	t.printf("\t// MaxMemoryPages limits the growth of memory, if not zero.\n")
+	t.printf("\tMaxMemoryPages int\n\n")
+	t.printf("\t// MaxCallStackDepth limits the call depth; 0 for a depth of 512.\n")
+	t.printf("\tMaxCallStackDepth int\n\n")
+	t.printf("\timports Imports\n")
+	t.printf("\tdepth   int\n")
+	t.printf("}\n\n")
+
+	t.printf("// New instantiates the module.\n")
+	t.printf("func New(imports Imports) (_ *Module, err error) {\n")
+	t.printf("\tm := &Module{imports: imports}\n")
+	t.printf("\tdefer m.catchTrap(&err)\n\n")
+	for i, g := range t.globals {
+		t.printf("\tm.Global%d = %s\n", i, g)
+	}
+	if t.memory != "" {
+		t.printf("\tm.Memory = %s\n", t.memory)
+		if base.Data != nil {
+			for _, e := range base.Data.Entries {
+				t.printf("\tcopy(m.Memory[int(%s):], %s)\n", initExpr(e.Offset), strconv.Quote(string(e.Data)))
+			}
+		}
+	}
+	if t.table != "" {
+		t.printf("\tm.Table = %s\n", t.table)
+		if base.Elements != nil {
+			for _, e := range base.Elements.Entries {
+				elems := make([]string, len(e.Elems))
+				for i, id := range e.Elems {
+					elems[i] = strconv.Itoa(int(id))
+				}
+				t.printf("\tcopy(m.Table[int(%s):], []uint32{%s})\n", initExpr(e.Offset), strings.Join(elems, ", "))
+			}
+		}
+	}
+	if base.Start != nil {
+		t.printf("\tm.f%d()\n", base.Start.Index)
+	}
+	t.printf("\treturn m, nil\n")
+	t.printf("}\n\n")
+
+	t.printf("// functionTypes holds the number of parameters and results of each function.\n")
+	t.printf("var functionTypes = [...][2]int{")
+	for i, f := range t.functions {
+		if i > 0 {
+			t.printf(", ")
+		}
+		t.printf("{%d, %d}", len(f.sig.ParamTypes), len(f.sig.ReturnTypes))
+	}
+	t.printf("}\n\n")
+}
+
+func (t *transpiler) printImportCall(id int) {
+	f := &t.functions[id]
+	t.printf("func (m *Module) f%d(%s)%s {\n", id, params(len(f.sig.ParamTypes), ""), results(f.sig))
+	t.printf("\tm.enter()\n")
+	call := fmt.Sprintf("m.imports.%s(m%s)", f.name, args(len(f.sig.ParamTypes), ", "))
+	if len(f.sig.ReturnTypes) == 0 {
+		t.printf("\t%s\n\tm.depth--\n", call)
+	} else {
+		t.printf("\tret := %s\n\tm.depth--\n\treturn ret\n", call)
+	}
+	t.printf("}\n\n")
+}
+
+// printFunction translates a function defined by the module. Blocks of the
+// control flow graph become labels, and the values of the SSA form local
+// variables.
+func (t *transpiler) printFunction(id int) {
+	f := &t.functions[id]
+	stages, err := t.m.CompileFunctionStages(id, t.config.GasPolicy)
+	if err != nil {
+		panic(err)
+	}
+	blocks := stages.CFG.Blocks
+
+	// Code following an instruction that always traps is dropped, along with
+	// the jump ending its block.
+	traps := make([]bool, len(blocks))
+	for i := range blocks {
+		for j, ins := range blocks[i].Code {
+			if ins.Op == "unreachable" || ins.Op == "fp_disabled_error" {
+				blocks[i].Code = blocks[i].Code[:j+1]
+				traps[i] = true
+				break
+			}
+		}
+	}
+
+	// Find the blocks reachable from the entry, in order.
+	reachable := make([]bool, len(blocks))
+	stack := []int{0}
+	reachable[0] = true
+	for len(stack) > 0 {
+		b := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if traps[b] {
+			continue
+		}
+		for _, target := range blocks[b].JmpTargets {
+			if !reachable[target] {
+				reachable[target] = true
+				stack = append(stack, target)
+			}
+		}
+	}
+	var order []int
+	for i := range blocks {
+		if reachable[i] {
+			order = append(order, i)
+		}
+	}
+
+	numParams := len(f.sig.ParamTypes)
+	t.numParams = numParams
+
+	// Only the values and locals that are read are declared. Value 0 stands
+	// for no value, and reads as 0.
+	readLocals := make(map[int64]bool)
+	hasPhi := false
+	for _, i := range order {
+		for _, ins := range blocks[i].Code {
+			switch ins.Op {
+			case "get_local":
+				readLocals[ins.Immediates[0]] = true
+			case "phi":
+				hasPhi = true
+			}
+		}
+	}
+	read := make(map[compiler.TyValueID]bool)
+	t.read, t.readLocals = read, readLocals
+	for _, i := range order {
+		bb := &blocks[i]
+		for _, ins := range bb.Code {
+			if ins.Op == "set_local" && !t.localDeclared(ins.Immediates[0]) {
+				continue
+			}
+			for _, v := range ins.Values {
+				read[v] = true
+			}
+		}
+		if traps[i] {
+			continue
+		}
+		switch bb.JmpKind {
+		case compiler.JmpEither:
+			if bb.JmpTargets[0] != bb.JmpTargets[1] {
+				read[bb.JmpCond] = true
+			}
+		case compiler.JmpTable:
+			read[bb.JmpCond] = true
+		}
+		if bb.JmpKind == compiler.JmpReturn && len(f.sig.ReturnTypes) != 0 || bb.JmpKind != compiler.JmpReturn && hasPhi {
+			read[bb.YieldValue] = true
+		}
+	}
+	delete(read, 0)
+	numLocals := 0
+	for _, l := range t.m.Base.FunctionIndexSpace[id-t.numImportedFunctions()].Body.Locals {
+		numLocals += int(l.Count)
+	}
+
+	t.printf("// %s\n", t.m.FunctionName(id))
+	t.printf("func (m *Module) f%d(%s)%s {\n", id, params(numParams, ""), results(f.sig))
+	t.printf("\tm.enter()\n")
+	var locals []string
+	for i := numParams; i < numParams+numLocals; i++ {
+		if readLocals[int64(i)] {
+			locals = append(locals, fmt.Sprintf("l%d", i))
+		}
+	}
+	if len(locals) > 0 {
+		t.printf("\tvar %s int64\n", strings.Join(locals, ", "))
+	}
+	values := make([]int, 0, len(read))
+	for v := range read {
+		values = append(values, int(v))
+	}
+	sort.Ints(values)
+	for i := 0; i < len(values); i += 16 {
+		end := i + 16
+		if end > len(values) {
+			end = len(values)
+		}
+		names := make([]string, end-i)
+		for j, v := range values[i:end] {
+			names[j] = fmt.Sprintf("v%d", v)
+		}
+		t.printf("\tvar %s int64\n", strings.Join(names, ", "))
+	}
+	if hasPhi {
+		t.printf("\tvar yielded int64\n")
+	}
+
+	target := func(v compiler.TyValueID) string {
+		if read[v] {
+			return fmt.Sprintf("v%d", v)
+		}
+		return "_"
+	}
+
+	// Blocks are labeled only if some jump does not fall through to them.
+	labeled := make([]bool, len(blocks))
+	body := make([]bytes.Buffer, len(blocks))
+	for k, i := range order {
+		bb := &blocks[i]
+		w := &body[i]
+		for _, ins := range bb.Code {
+			t.printInstr(w, ins, target(ins.Target))
+		}
+		if traps[i] {
+			continue
+		}
+
+		next := -1
+		if k+1 < len(order) {
+			next = order[k+1]
+		}
+		jump := func(target int) {
+			if target != next {
+				labeled[target] = true
+				fmt.Fprintf(w, "\tgoto b%d\n", target)
+			}
+		}
+		if read[bb.YieldValue] && bb.JmpKind != compiler.JmpReturn {
+			fmt.Fprintf(w, "\tyielded = v%d\n", bb.YieldValue)
+		}
+		switch bb.JmpKind {
+		case compiler.JmpUncond:
+			jump(bb.JmpTargets[0])
+		case compiler.JmpEither:
+			taken, notTaken := bb.JmpTargets[0], bb.JmpTargets[1]
+			switch {
+			case taken == notTaken:
+				jump(taken)
+			case taken == next:
+				labeled[notTaken] = true
+				fmt.Fprintf(w, "\tif %s == 0 {\n\t\tgoto b%d\n\t}\n", t.value(bb.JmpCond), notTaken)
+			default:
+				labeled[taken] = true
+				fmt.Fprintf(w, "\tif %s != 0 {\n\t\tgoto b%d\n\t}\n", t.value(bb.JmpCond), taken)
+				jump(notTaken)
+			}
+		case compiler.JmpTable:
+			fmt.Fprintf(w, "\tswitch int(%s) {\n", t.value(bb.JmpCond))
+			targets := bb.JmpTargets[:len(bb.JmpTargets)-1]
+			for j, target := range targets {
+				labeled[target] = true
+				fmt.Fprintf(w, "\tcase %d:\n\t\tgoto b%d\n", j, target)
+			}
+			labeled[bb.JmpTargets[len(targets)]] = true
+			fmt.Fprintf(w, "\tdefault:\n\t\tgoto b%d\n\t}\n", bb.JmpTargets[len(targets)])
+		case compiler.JmpReturn:
+			fmt.Fprintf(w, "\tm.depth--\n")
+			if len(f.sig.ReturnTypes) == 0 {
+				fmt.Fprintf(w, "\treturn\n")
+			} else {
+				fmt.Fprintf(w, "\treturn %s\n", t.value(bb.YieldValue))
+			}
+		default:
+			panic(fmt.Errorf("block %d of function %d has no jump", i, id))
+		}
+	}
+	for _, i := range order {
+		if labeled[i] {
+			t.printf("b%d:\n", i)
+		}
+		t.buf.Write(body[i].Bytes())
+	}
+	t.printf("}\n\n")
+}
+
+// printInstr translates an instruction, other than a jump, into Go code
+// writing its result to the variable named target.
+func (t *transpiler) printInstr(w *bytes.Buffer, ins compiler.Instr, target string) {
+	value := func(i int) string {
+		return t.value(ins.Values[i])
+	}
+
+	switch ins.Op {
+	case "i32.const", "f32.const":
+		fmt.Fprintf(w, "\t%s = %d\n", target, int64(uint32(ins.Immediates[0])))
+	case "i64.const", "f64.const":
+		fmt.Fprintf(w, "\t%s = %d\n", target, ins.Immediates[0])
+	case "get_local":
+		fmt.Fprintf(w, "\t%s = l%d\n", target, ins.Immediates[0])
+	case "set_local":
+		if t.localDeclared(ins.Immediates[0]) {
+			fmt.Fprintf(w, "\tl%d = %s\n", ins.Immediates[0], value(0))
+		}
+	case "get_global":
+		fmt.Fprintf(w, "\t%s = m.Global%d\n", target, ins.Immediates[0])
+	case "set_global":
+		fmt.Fprintf(w, "\tm.Global%d = %s\n", ins.Immediates[0], value(0))
+	case "phi":
+		fmt.Fprintf(w, "\t%s = yielded\n", target)
+	case "i32.reinterpret/f32", "i64.reinterpret/f64", "f32.reinterpret/i32", "f64.reinterpret/i64":
+		fmt.Fprintf(w, "\t%s = %s\n", target, value(0))
+	case "current_memory":
+		fmt.Fprintf(w, "\t%s = int64(len(m.Memory) / pageSize)\n", target)
+	case "grow_memory":
+		fmt.Fprintf(w, "\t%s = m.growMemory(%s)\n", target, value(0))
+	case "add_gas":
+		fmt.Fprintf(w, "\tm.addGas(%d)\n", uint64(ins.Immediates[0]))
+	case "call":
+		id := int(ins.Immediates[0])
+		t.printCall(w, fmt.Sprintf("m.f%d", id), "", ins.Values, target, len(t.functions[id].sig.ReturnTypes) != 0)
+	case "call_indirect":
+		typeID := int(ins.Immediates[0])
+		t.indirect[typeID] = true
+		n := len(ins.Values) - 1
+		t.printCall(w, fmt.Sprintf("m.callIndirect%d", typeID), value(n), ins.Values[:n], target, len(t.m.Base.Types.Entries[typeID].ReturnTypes) != 0)
+	case "cover_block":
+		// Coverage is not supported by transpiled code.
+	default:
+		tmpl, ok := opTemplates[ins.Op]
+		if !ok {
+			panic(fmt.Errorf("unsupported instruction: %s", ins.Op))
+		}
+		pairs := []string{"$t", target}
+		for i := range ins.Values {
+			pairs = append(pairs, fmt.Sprintf("$v%d", i), value(i))
+		}
+		for i, imm := range ins.Immediates {
+			pairs = append(pairs, fmt.Sprintf("$i%d", i), fmt.Sprintf("uint32(%d)", uint32(imm)))
+		}
+		code := strings.NewReplacer(pairs...).Replace(tmpl)
+		if strings.Contains(code, ":=") {
+			fmt.Fprintf(w, "\t{\n%s\n\t}\n", code)
+		} else {
+			fmt.Fprintf(w, "\t%s\n", code)
+		}
+	}
+}
+
+// value returns the Go expression reading an SSA value.
+func (t *transpiler) value(v compiler.TyValueID) string {
+	if v == 0 {
+		return "0"
+	}
+	return fmt.Sprintf("v%d", v)
+}
+
+// localDeclared reports whether a local of the function being translated is
+// declared: parameters always are, other locals only if they are read.
+func (t *transpiler) localDeclared(index int64) bool {
+	return int(index) < t.numParams || t.readLocals[index]
+}
+
+func (t *transpiler) printCall(w *bytes.Buffer, callee string, first string, values []compiler.TyValueID, target string, hasResult bool) {
+	argList := make([]string, 0, len(values)+1)
+	if first != "" {
+		argList = append(argList, first)
+	}
+	for _, v := range values {
+		argList = append(argList, t.value(v))
+	}
+	call := fmt.Sprintf("%s(%s)", callee, strings.Join(argList, ", "))
+	if hasResult && target != "_" {
+		fmt.Fprintf(w, "\t%s = %s\n", target, call)
+	} else {
+		fmt.Fprintf(w, "\t%s\n", call)
+	}
+}
+
+// printCallIndirect prints a function dispatching call_indirect for each
+// type it is used with, among the functions of the table.
+func (t *transpiler) printCallIndirect() {
+	typeIDs := make([]int, 0, len(t.indirect))
+	for typeID := range t.indirect {
+		typeIDs = append(typeIDs, typeID)
+	}
+	sort.Ints(typeIDs)
+	for _, typeID := range typeIDs {
+		sig := &t.m.Base.Types.Entries[typeID]
+		numParams, numResults := len(sig.ParamTypes), len(sig.ReturnTypes)
+		t.printf("func (m *Module) callIndirect%d(i int64%s)%s {\n", typeID, params(numParams, ", "), results(sig))
+		t.printf("\tid := m.Table[i]\n")
+		t.printf("\tif callee := functionTypes[id]; callee[0] != %d || callee[1] != %d {\n", numParams, numResults)
+		t.printf("\t\tpanic(\"type mismatch\")\n\t}\n")
+		t.printf("\tswitch id {\n")
+		for _, id := range t.tableFuncs {
+			f := &t.functions[id]
+			if len(f.sig.ParamTypes) != numParams || len(f.sig.ReturnTypes) != numResults {
+				continue
+			}
+			call := fmt.Sprintf("m.f%d(%s)", id, args(numParams, ""))
+			if numResults == 0 {
+				t.printf("\tcase %d:\n\t\t%s\n\t\treturn\n", id, call)
+			} else {
+				t.printf("\tcase %d:\n\t\treturn %s\n", id, call)
+			}
+		}
+		t.printf("\t}\n")
+		t.printf("\tpanic(\"unreachable\")\n")
+		t.printf("}\n\n")
+	}
+}
+
+// printExports prints an exported method for each exported function, and
+// Call, which calls them by name.
+func (t *transpiler) printExports() {
+	base := t.m.Base
+	if base.Export == nil {
+		return
+	}
+	var exports []wasm.ExportEntry
+	for _, e := range base.Export.Entries {
+		if e.Kind == wasm.ExternalFunction {
+			exports = append(exports, e)
+		}
+	}
+	sort.Slice(exports, func(i, j int) bool { return exports[i].FieldStr < exports[j].FieldStr })
+
+	names := newNameSet()
+	for _, name := range []string{"Memory", "Table", "Gas", "GasLimit", "MaxMemoryPages", "MaxCallStackDepth", "Call"} {
+		names.add(name)
+	}
+	for i := range t.globals {
+		names.add(fmt.Sprintf("Global%d", i))
+	}
+
+	for _, e := range exports {
+		id := int(e.Index)
+		sig := t.functions[id].sig
+		numParams := len(sig.ParamTypes)
+		call := fmt.Sprintf("m.f%d(%s)", id, args(numParams, ""))
+		name := names.add(goName(e.FieldStr))
+		t.printf("// %s calls the exported function %q.\n", name, e.FieldStr)
+		if len(sig.ReturnTypes) == 0 {
+			t.printf("func (m *Module) %s(%s) (err error) {\n", name, params(numParams, ""))
+			t.printf("\tdefer m.catchTrap(&err)\n\t%s\n\treturn nil\n}\n\n", call)
+		} else {
+			t.printf("func (m *Module) %s(%s) (_ int64, err error) {\n", name, params(numParams, ""))
+			t.printf("\tdefer m.catchTrap(&err)\n\treturn %s, nil\n}\n\n", call)
+		}
+	}
+
+	t.printf("// Call calls an exported function by name. It returns 0 for functions\n")
+	t.printf("// without results.\n")
+	t.printf("func (m *Module) Call(name string, params ...int64) (_ int64, err error) {\n")
+	t.printf("\tdefer m.catchTrap(&err)\n\n")
+	t.printf("\tswitch name {\n")
+	for _, e := range exports {
+		id := int(e.Index)
+		sig := t.functions[id].sig
+		numParams := len(sig.ParamTypes)
+		argList := make([]string, numParams)
+		for i := range argList {
+			argList[i] = fmt.Sprintf("params[%d]", i)
+		}
+		call := fmt.Sprintf("m.f%d(%s)", id, strings.Join(argList, ", "))
+		t.printf("\tcase %q:\n", e.FieldStr)
+		t.printf("\t\tif len(params) != %d {\n\t\t\treturn 0, fmt.Errorf(\"%%s takes %d parameters, got %%d\", name, len(params))\n\t\t}\n", numParams, numParams)
+		if len(sig.ReturnTypes) == 0 {
+			t.printf("\t\t%s\n\t\treturn 0, nil\n", call)
+		} else {
+			t.printf("\t\treturn %s, nil\n", call)
+		}
+	}
+	t.printf("\t}\n")
+	t.printf("\treturn 0, fmt.Errorf(\"function not exported: %%s\", name)\n")
+	t.printf("}\n\n")
+}
+
+func (t *transpiler) numImportedFunctions() int {
+	n := 0
+	for _, f := range t.functions {
+		if f.imported {
+			n++
+		}
+	}
+	return n
+}
+
+// initExpr translates an init expression into Go code.
+func initExpr(expr []byte) string {
+	r := bytes.NewReader(expr)
+	ret := ""
+	for {
+		b, err := r.ReadByte()
+		if err != nil {
+			break
+		}
+		switch b {
+		case ops.I32Const:
+			v, err := leb128.ReadVarint32(r)
+			if err != nil {
+				panic(err)
+			}
+			ret = fmt.Sprintf("int64(%d)", v)
+		case ops.I64Const:
+			v, err := leb128.ReadVarint64(r)
+			if err != nil {
+				panic(err)
+			}
+			ret = fmt.Sprintf("int64(%d)", v)
+		case ops.F32Const:
+			var buf [4]byte
+			if _, err := r.Read(buf[:]); err != nil {
+				panic(err)
+			}
+			ret = fmt.Sprintf("int64(%d)", binary.LittleEndian.Uint32(buf[:]))
+		case ops.F64Const:
+			var buf [8]byte
+			if _, err := r.Read(buf[:]); err != nil {
+				panic(err)
+			}
+			ret = fmt.Sprintf("int64(%d)", int64(binary.LittleEndian.Uint64(buf[:])))
+		case ops.GetGlobal:
+			index, err := leb128.ReadVarUint32(r)
+			if err != nil {
+				panic(err)
+			}
+			ret = fmt.Sprintf("m.Global%d", index)
+		case ops.End:
+		default:
+			panic("invalid opcode in init expr")
+		}
+	}
+	if ret == "" {
+		panic("empty init expr")
+	}
+	return ret
+}
+
+// params returns a parameter list of n int64 values, preceded by sep if not
+// empty.
+func params(n int, sep string) string {
+	if n == 0 {
+		return ""
+	}
+	names := make([]string, n)
+	for i := range names {
+		names[i] = fmt.Sprintf("l%d", i)
+	}
+	return sep + strings.Join(names, ", ") + " int64"
+}
+
+// args is like params, but for arguments.
+func args(n int, sep string) string {
+	if n == 0 {
+		return ""
+	}
+	names := make([]string, n)
+	for i := range names {
+		names[i] = fmt.Sprintf("l%d", i)
+	}
+	return sep + strings.Join(names, ", ")
+}
+
+func results(sig *wasm.FunctionSig) string {
+	if len(sig.ReturnTypes) == 0 {
+		return ""
+	}
+	return " int64"
+}
+
+// goName turns a wasm name into an exported Go identifier.
+func goName(name string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	if b.Len() == 0 || !unicode.IsLetter([]rune(b.String())[0]) {
+		return "X" + b.String()
+	}
+	return b.String()
+}
+
+// nameSet makes names unique by numbering them.
+type nameSet struct {
+	used map[string]bool
+}
+
+func newNameSet() *nameSet {
+	return &nameSet{used: make(map[string]bool)}
+}
+
+func (s *nameSet) add(name string) string {
+	unique := name
+	for i := 2; s.used[unique]; i++ {
+		unique = fmt.Sprintf("%s%d", name, i)
+	}
+	s.used[unique] = true
+	return unique
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/perlin-network/life/compiler"
+	"github.com/perlin-network/life/wat"
+)
+
+// validateResult is the outcome of validating one module.
+type validateResult struct {
+	File  string `json:"file"`
+	Valid bool   `json:"valid"`
+	Error string `json:"error,omitempty"`
+
+	// Location of a validation error.
+	Section       string `json:"section,omitempty"`
+	FunctionIndex *int   `json:"function_index,omitempty"`
+	Offset        *int   `json:"offset,omitempty"`
+
+	// Floating point instructions of a module rejected by -no-fp.
+	FloatingPoint []floatingPointUse `json:"floating_point,omitempty"`
+}
+
+type floatingPointUse struct {
+	FunctionIndex int    `json:"function_index"`
+	Name          string `json:"name"`
+	Offset        int    `json:"offset"`
+	Op            string `json:"op"`
+}
+
+// validateMain implements `life validate [-json] [-no-fp] module.wasm...`. It
+// exits with status 1 if any of the modules is invalid.
+func validateMain(args []string) {
+	fs := flag.NewFlagSet("validate", flag.ExitOnError)
+	jsonFlag := fs.Bool("json", false, "print the results as JSON")
+	noFloatFlag := fs.Bool("no-fp", false, "reject modules using floating point, listing each instruction")
+	fs.Parse(args)
+
+	if fs.NArg() < 1 {
+		fmt.Fprintln(os.Stderr, "usage: life validate [-json] [-no-fp] module.wasm...")
+		os.Exit(2)
+	}
+
+	status := 0
+	results := make([]validateResult, 0, fs.NArg())
+	for _, path := range fs.Args() {
+		r := validate(path, *noFloatFlag)
+		if !r.Valid {
+			status = 1
+		}
+		results = append(results, r)
+	}
+
+	if *jsonFlag {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(results); err != nil {
+			panic(err)
+		}
+	} else {
+		for _, r := range results {
+			if r.Valid {
+				fmt.Printf("%s: ok\n", r.File)
+			} else {
+				fmt.Printf("%s: %s\n", r.File, r.Error)
+				for _, u := range r.FloatingPoint {
+					fmt.Printf("  function %d (%s) at 0x%x: %s\n", u.FunctionIndex, u.Name, u.Offset, u.Op)
+				}
+			}
+		}
+	}
+	os.Exit(status)
+}
+
+func validate(path string, noFloat bool) validateResult {
+	r := validateResult{File: path}
+
+	input, err := ioutil.ReadFile(path)
+	if err == nil && wat.IsText(input) {
+		input, err = wat.Parse(input)
+	}
+	var m *compiler.Module
+	if err == nil {
+		m, err = compiler.LoadModule(input)
+	}
+	if err == nil && noFloat {
+		err = m.CheckNoFloatingPoint()
+	}
+	if err == nil {
+		r.Valid = true
+		return r
+	}
+
+	r.Error = err.Error()
+	if verr, ok := err.(*compiler.ValidationError); ok {
+		r.Section = verr.Section.String()
+		if verr.FunctionIndex >= 0 {
+			r.FunctionIndex = &verr.FunctionIndex
+		}
+		if verr.Offset >= 0 {
+			r.Offset = &verr.Offset
+		}
+	}
+	if ferr, ok := err.(*compiler.FloatingPointError); ok {
+		for _, u := range ferr.Uses {
+			r.FloatingPoint = append(r.FloatingPoint, floatingPointUse(u))
+		}
+	}
+	return r
+}
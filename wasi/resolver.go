@@ -0,0 +1,285 @@
+// Package wasi resolves the imports of modules targeting the WebAssembly System
+// Interface, as defined by the wasi_snapshot_preview1 and wasi_unstable
+// modules.
+//
+// Only the standard streams are available: there are no preopened
+// directories, so modules cannot open files. Functions that are not
+// implemented return ENOSYS.
+package wasi
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/perlin-network/life/exec"
+)
+
+var _ exec.ImportResolver = (*Resolver)(nil)
+
+// Error numbers returned by WASI functions.
+const (
+	ErrnoSuccess = 0
+	ErrnoBadf    = 8
+	ErrnoInval   = 28
+	ErrnoIO      = 29
+	ErrnoNosys   = 52
+	ErrnoSpipe   = 70
+)
+
+// Clock IDs accepted by clock_time_get and clock_res_get.
+const (
+	clockRealtime = iota
+	clockMonotonic
+	clockProcessCPUTime
+	clockThreadCPUTime
+)
+
+const filetypeCharacterDevice = 2
+
+const (
+	rightFdRead  = 1 << 1
+	rightFdWrite = 1 << 6
+)
+
+// ExitError is raised by proc_exit to terminate the module. It reaches the
+// caller of the VM as the error of the resulting exec.Trap.
+type ExitError struct {
+	Code int
+}
+
+func (e *ExitError) Error() string {
+	return fmt.Sprintf("exit status %d", e.Code)
+}
+
+// Resolver implements exec.ImportResolver for WASI modules.
+type Resolver struct {
+	Args   []string // command line arguments, including the program name
+	Env    []string // environment variables in the form key=value
+	Stdin  io.Reader
+	Stdout io.Writer
+	Stderr io.Writer
+
+	origin time.Time
+}
+
+// NewResolver returns a resolver that gives modules the standard streams of
+// the process and the given arguments and environment.
+func NewResolver(args []string, env []string) *Resolver {
+	return &Resolver{
+		Args:   args,
+		Env:    env,
+		Stdin:  os.Stdin,
+		Stdout: os.Stdout,
+		Stderr: os.Stderr,
+		origin: time.Now(),
+	}
+}
+
+func (r *Resolver) Reset() {
+	r.origin = time.Now()
+}
+
+func (r *Resolver) Clone() exec.ImportResolver {
+	clone := *r
+	clone.origin = time.Now()
+	return &clone
+}
+
+func (r *Resolver) ResolveFunc(module, field string) exec.FunctionImport {
+	switch module {
+	case "wasi_snapshot_preview1", "wasi_unstable":
+	default:
+		panic(fmt.Errorf("unknown module: %s", module))
+	}
+
+	switch field {
+	case "args_get":
+		return func(vm *exec.VirtualMachine) int64 {
+			return writeStrings(vm, r.Args, param(vm, 0), param(vm, 1))
+		}
+	case "args_sizes_get":
+		return func(vm *exec.VirtualMachine) int64 {
+			return writeStringSizes(vm, r.Args, param(vm, 0), param(vm, 1))
+		}
+	case "environ_get":
+		return func(vm *exec.VirtualMachine) int64 {
+			return writeStrings(vm, r.Env, param(vm, 0), param(vm, 1))
+		}
+	case "environ_sizes_get":
+		return func(vm *exec.VirtualMachine) int64 {
+			return writeStringSizes(vm, r.Env, param(vm, 0), param(vm, 1))
+		}
+	case "clock_res_get":
+		return func(vm *exec.VirtualMachine) int64 {
+			if param(vm, 0) > clockThreadCPUTime {
+				return ErrnoInval
+			}
+			binary.LittleEndian.PutUint64(vm.Memory[param(vm, 1):], 1)
+			return ErrnoSuccess
+		}
+	case "clock_time_get":
+		return func(vm *exec.VirtualMachine) int64 {
+			var t int64
+			switch param(vm, 0) {
+			case clockRealtime:
+				t = time.Now().UnixNano()
+			case clockMonotonic, clockProcessCPUTime, clockThreadCPUTime:
+				t = time.Since(r.origin).Nanoseconds()
+			default:
+				return ErrnoInval
+			}
+			// The second parameter is the requested precision.
+			binary.LittleEndian.PutUint64(vm.Memory[param(vm, 2):], uint64(t))
+			return ErrnoSuccess
+		}
+	case "fd_write":
+		return func(vm *exec.VirtualMachine) int64 {
+			var w io.Writer
+			switch param(vm, 0) {
+			case 1:
+				w = r.Stdout
+			case 2:
+				w = r.Stderr
+			default:
+				return ErrnoBadf
+			}
+			n := 0
+			for _, buf := range iovecs(vm, param(vm, 1), param(vm, 2)) {
+				written, err := w.Write(buf)
+				n += written
+				if err != nil {
+					return ErrnoIO
+				}
+			}
+			binary.LittleEndian.PutUint32(vm.Memory[param(vm, 3):], uint32(n))
+			return ErrnoSuccess
+		}
+	case "fd_read":
+		return func(vm *exec.VirtualMachine) int64 {
+			if param(vm, 0) != 0 {
+				return ErrnoBadf
+			}
+			n := 0
+			for _, buf := range iovecs(vm, param(vm, 1), param(vm, 2)) {
+				read, err := r.Stdin.Read(buf)
+				n += read
+				if err != nil && err != io.EOF {
+					return ErrnoIO
+				}
+				if err == io.EOF || read < len(buf) {
+					break
+				}
+			}
+			binary.LittleEndian.PutUint32(vm.Memory[param(vm, 3):], uint32(n))
+			return ErrnoSuccess
+		}
+	case "fd_close":
+		return func(vm *exec.VirtualMachine) int64 {
+			if param(vm, 0) > 2 {
+				return ErrnoBadf
+			}
+			return ErrnoSuccess
+		}
+	case "fd_seek":
+		return func(vm *exec.VirtualMachine) int64 {
+			if param(vm, 0) > 2 {
+				return ErrnoBadf
+			}
+			return ErrnoSpipe
+		}
+	case "fd_fdstat_get":
+		return func(vm *exec.VirtualMachine) int64 {
+			fd := param(vm, 0)
+			if fd > 2 {
+				return ErrnoBadf
+			}
+			rights := uint64(rightFdWrite)
+			if fd == 0 {
+				rights = rightFdRead
+			}
+			stat := vm.Memory[param(vm, 1):][:24]
+			for i := range stat {
+				stat[i] = 0
+			}
+			stat[0] = filetypeCharacterDevice
+			binary.LittleEndian.PutUint64(stat[8:], rights)
+			return ErrnoSuccess
+		}
+	case "fd_prestat_get", "fd_prestat_dir_name":
+		// There are no preopened directories.
+		return func(vm *exec.VirtualMachine) int64 {
+			return ErrnoBadf
+		}
+	case "proc_exit":
+		return func(vm *exec.VirtualMachine) int64 {
+			panic(&ExitError{Code: int(int32(param(vm, 0)))})
+		}
+	case "random_get":
+		return func(vm *exec.VirtualMachine) int64 {
+			buf := vm.Memory[param(vm, 0):][:param(vm, 1)]
+			if _, err := rand.Read(buf); err != nil {
+				return ErrnoIO
+			}
+			return ErrnoSuccess
+		}
+	case "sched_yield":
+		return func(vm *exec.VirtualMachine) int64 {
+			return ErrnoSuccess
+		}
+	default:
+		return func(vm *exec.VirtualMachine) int64 {
+			return ErrnoNosys
+		}
+	}
+}
+
+func (r *Resolver) ResolveGlobal(module, field string) int64 {
+	panic(fmt.Errorf("unknown global: %s.%s", module, field))
+}
+
+// param returns the i-th parameter of the import call as an unsigned 32-bit
+// value, which is how WASI passes pointers, sizes and file descriptors.
+func param(vm *exec.VirtualMachine, i int) uint32 {
+	return uint32(vm.GetCurrentFrame().Locals[i])
+}
+
+// iovecs returns the buffers described by an array of count iovec structs
+// starting at ptr.
+func iovecs(vm *exec.VirtualMachine, ptr uint32, count uint32) [][]byte {
+	ret := make([][]byte, count)
+	for i := range ret {
+		iov := vm.Memory[ptr+uint32(i)*8:][:8]
+		base := binary.LittleEndian.Uint32(iov)
+		size := binary.LittleEndian.Uint32(iov[4:])
+		ret[i] = vm.Memory[base:][:size]
+	}
+	return ret
+}
+
+// writeStrings stores strings as null-terminated strings into buf and their
+// addresses into the array at ptrs, as args_get and environ_get do.
+func writeStrings(vm *exec.VirtualMachine, strings []string, ptrs uint32, buf uint32) int64 {
+	for i, s := range strings {
+		binary.LittleEndian.PutUint32(vm.Memory[ptrs+uint32(i)*4:], buf)
+		copy(vm.Memory[buf:][:len(s)], s)
+		vm.Memory[buf+uint32(len(s))] = 0
+		buf += uint32(len(s)) + 1
+	}
+	return ErrnoSuccess
+}
+
+// writeStringSizes stores the number of strings and the size of the buffer
+// writeStrings needs for them.
+func writeStringSizes(vm *exec.VirtualMachine, strings []string, countPtr uint32, sizePtr uint32) int64 {
+	size := 0
+	for _, s := range strings {
+		size += len(s) + 1
+	}
+	binary.LittleEndian.PutUint32(vm.Memory[countPtr:], uint32(len(strings)))
+	binary.LittleEndian.PutUint32(vm.Memory[sizePtr:], uint32(size))
+	return ErrnoSuccess
+}
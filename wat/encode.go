@@ -0,0 +1,325 @@
+package wat
+
+import "unicode/utf8"
+
+const (
+	sectionCustom   = 0
+	sectionType     = 1
+	sectionImport   = 2
+	sectionFunction = 3
+	sectionTable    = 4
+	sectionMemory   = 5
+	sectionGlobal   = 6
+	sectionExport   = 7
+	sectionStart    = 8
+	sectionElement  = 9
+	sectionCode     = 10
+	sectionData     = 11
+)
+
+const (
+	externalFunction = 0
+	externalTable    = 1
+	externalMemory   = 2
+	externalGlobal   = 3
+)
+
+const (
+	typeI32     = 0x7f
+	typeI64     = 0x7e
+	typeF32     = 0x7d
+	typeF64     = 0x7c
+	typeAnyFunc = 0x70
+	typeFunc    = 0x60
+	blockEmpty  = 0x40
+)
+
+type buffer []byte
+
+func (b *buffer) byte(c byte) {
+	*b = append(*b, c)
+}
+
+func (b *buffer) bytes(p []byte) {
+	*b = append(*b, p...)
+}
+
+func (b *buffer) u32(v uint32) {
+	for {
+		c := byte(v & 0x7f)
+		v >>= 7
+		if v != 0 {
+			c |= 0x80
+		}
+		b.byte(c)
+		if v == 0 {
+			return
+		}
+	}
+}
+
+func (b *buffer) s64(v int64) {
+	for {
+		c := byte(v & 0x7f)
+		v >>= 7
+		if (v == 0 && c&0x40 == 0) || (v == -1 && c&0x40 != 0) {
+			b.byte(c)
+			return
+		}
+		b.byte(c | 0x80)
+	}
+}
+
+func (b *buffer) name(s string) {
+	b.u32(uint32(len(s)))
+	*b = append(*b, s...)
+}
+
+func (b *buffer) limits(l limits) {
+	if l.hasMax {
+		b.byte(1)
+		b.u32(l.min)
+		b.u32(l.max)
+	} else {
+		b.byte(0)
+		b.u32(l.min)
+	}
+}
+
+func (b *buffer) section(id byte, content buffer) {
+	b.byte(id)
+	b.u32(uint32(len(content)))
+	b.bytes(content)
+}
+
+// encode writes the module in the binary format.
+func (m *module) encode() []byte {
+	out := buffer("\x00asm\x01\x00\x00\x00")
+
+	if len(m.types) > 0 {
+		var s buffer
+		s.u32(uint32(len(m.types)))
+		for _, t := range m.types {
+			s.byte(typeFunc)
+			s.u32(uint32(len(t.params)))
+			s.bytes(t.params)
+			s.u32(uint32(len(t.results)))
+			s.bytes(t.results)
+		}
+		out.section(sectionType, s)
+	}
+
+	if len(m.imports) > 0 {
+		var s buffer
+		s.u32(uint32(len(m.imports)))
+		for _, imp := range m.imports {
+			s.name(imp.module)
+			s.name(imp.field)
+			s.byte(imp.kind)
+			switch imp.kind {
+			case externalFunction:
+				s.u32(imp.typeIndex)
+			case externalTable:
+				s.byte(typeAnyFunc)
+				s.limits(imp.limits)
+			case externalMemory:
+				s.limits(imp.limits)
+			case externalGlobal:
+				s.byte(imp.global.valueType)
+				s.byte(boolByte(imp.global.mutable))
+			}
+		}
+		out.section(sectionImport, s)
+	}
+
+	if len(m.funcs) > 0 {
+		var s buffer
+		s.u32(uint32(len(m.funcs)))
+		for _, f := range m.funcs {
+			s.u32(f.typeIndex)
+		}
+		out.section(sectionFunction, s)
+	}
+
+	if len(m.tables) > 0 {
+		var s buffer
+		s.u32(uint32(len(m.tables)))
+		for _, t := range m.tables {
+			s.byte(typeAnyFunc)
+			s.limits(t)
+		}
+		out.section(sectionTable, s)
+	}
+
+	if len(m.memories) > 0 {
+		var s buffer
+		s.u32(uint32(len(m.memories)))
+		for _, l := range m.memories {
+			s.limits(l)
+		}
+		out.section(sectionMemory, s)
+	}
+
+	if len(m.globals) > 0 {
+		var s buffer
+		s.u32(uint32(len(m.globals)))
+		for _, g := range m.globals {
+			s.byte(g.typ.valueType)
+			s.byte(boolByte(g.typ.mutable))
+			s.bytes(g.init)
+		}
+		out.section(sectionGlobal, s)
+	}
+
+	if len(m.exports) > 0 {
+		var s buffer
+		s.u32(uint32(len(m.exports)))
+		for _, e := range m.exports {
+			s.name(e.name)
+			s.byte(e.kind)
+			s.u32(e.index)
+		}
+		out.section(sectionExport, s)
+	}
+
+	if m.start != nil {
+		var s buffer
+		s.u32(*m.start)
+		out.section(sectionStart, s)
+	}
+
+	if len(m.elems) > 0 {
+		var s buffer
+		s.u32(uint32(len(m.elems)))
+		for _, e := range m.elems {
+			s.u32(e.index)
+			s.bytes(e.offset)
+			s.u32(uint32(len(e.funcs)))
+			for _, f := range e.funcs {
+				s.u32(f)
+			}
+		}
+		out.section(sectionElement, s)
+	}
+
+	if len(m.funcs) > 0 {
+		var s buffer
+		s.u32(uint32(len(m.funcs)))
+		for _, f := range m.funcs {
+			var body buffer
+
+			// Consecutive locals of the same type share an entry.
+			var groups int
+			for i := range f.locals {
+				if i == 0 || f.locals[i] != f.locals[i-1] {
+					groups++
+				}
+			}
+			body.u32(uint32(groups))
+			for i := 0; i < len(f.locals); {
+				j := i
+				for j < len(f.locals) && f.locals[j] == f.locals[i] {
+					j++
+				}
+				body.u32(uint32(j - i))
+				body.byte(f.locals[i])
+				i = j
+			}
+			body.bytes(f.code)
+
+			s.u32(uint32(len(body)))
+			s.bytes(body)
+		}
+		out.section(sectionCode, s)
+	}
+
+	if len(m.data) > 0 {
+		var s buffer
+		s.u32(uint32(len(m.data)))
+		for _, d := range m.data {
+			s.u32(d.index)
+			s.bytes(d.offset)
+			s.u32(uint32(len(d.data)))
+			s.bytes(d.data)
+		}
+		out.section(sectionData, s)
+	}
+
+	if names := m.encodeNames(); names != nil {
+		out.section(sectionCustom, names)
+	}
+
+	return out
+}
+
+// encodeNames returns the name section describing the symbolic identifiers
+// of the module, its functions and their locals, or nil if there are none.
+func (m *module) encodeNames() buffer {
+	var funcNames, localNames buffer
+	var numFuncNames, numLocalNames int
+
+	for i, name := range m.funcNames {
+		if name == "" {
+			continue
+		}
+		funcNames.u32(uint32(i))
+		funcNames.name(name)
+		numFuncNames++
+	}
+
+	for i, f := range m.funcs {
+		var entries buffer
+		var count int
+		for j, name := range f.localNames {
+			if name == "" {
+				continue
+			}
+			entries.u32(uint32(j))
+			entries.name(name)
+			count++
+		}
+		if count == 0 {
+			continue
+		}
+		localNames.u32(uint32(m.numFuncImports + i))
+		localNames.u32(uint32(count))
+		localNames.bytes(entries)
+		numLocalNames++
+	}
+
+	if m.moduleName == "" && numFuncNames == 0 && numLocalNames == 0 {
+		return nil
+	}
+
+	s := buffer{}
+	s.name("name")
+	if m.moduleName != "" {
+		var sub buffer
+		sub.name(m.moduleName)
+		s.section(0, sub)
+	}
+	if numFuncNames > 0 {
+		var sub buffer
+		sub.u32(uint32(numFuncNames))
+		sub.bytes(funcNames)
+		s.section(1, sub)
+	}
+	if numLocalNames > 0 {
+		var sub buffer
+		sub.u32(uint32(numLocalNames))
+		sub.bytes(localNames)
+		s.section(2, sub)
+	}
+	return s
+}
+
+func boolByte(b bool) byte {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func validName(s string) bool {
+	return utf8.ValidString(s)
+}
@@ -0,0 +1,359 @@
+package wat
+
+import (
+	"encoding/binary"
+	"math/bits"
+	"strings"
+)
+
+type label struct {
+	name string
+	op   byte // opcode of the block; else after the else branch started
+}
+
+// funcCompiler encodes the instructions of a function body or of an
+// initializer expression.
+type funcCompiler struct {
+	m        *module
+	localIDs map[string]uint32
+	labels   []label
+	code     buffer
+}
+
+func newFuncCompiler(m *module, f *function) *funcCompiler {
+	c := &funcCompiler{m: m, localIDs: make(map[string]uint32)}
+	if f != nil {
+		for i, name := range f.localNames {
+			if name == "" {
+				continue
+			}
+			if _, ok := c.localIDs[name]; ok {
+				f.pos.errorf("duplicate local %s", name)
+			}
+			c.localIDs[name] = uint32(i)
+		}
+		// The name section does not keep the $ sigil.
+		for i, name := range f.localNames {
+			f.localNames[i] = strings.TrimPrefix(name, "$")
+		}
+	}
+	return c
+}
+
+func lookupOp(n *node) *opInfo {
+	if !n.isAtom() {
+		n.pos.errorf("expected instruction, found %s", n)
+	}
+	op, ok := opsByName[n.tok.text]
+	if !ok {
+		n.pos.errorf("unknown operator %s", n.tok.text)
+	}
+	return op
+}
+
+// instrs compiles a sequence of plain and folded instructions.
+func (c *funcCompiler) instrs(nodes []*node) {
+	for i := 0; i < len(nodes); {
+		if nodes[i].list {
+			c.folded(nodes[i])
+			i++
+			continue
+		}
+		i = c.plain(nodes, i)
+	}
+}
+
+// plain compiles the plain instruction at nodes[i] and returns the index of
+// the node following it.
+func (c *funcCompiler) plain(nodes []*node, i int) int {
+	n := nodes[i]
+	op := lookupOp(n)
+	i++
+
+	switch op.code {
+	case 0x02, 0x03, 0x04: // block, loop, if
+		var name string
+		var blockType byte
+		name, blockType, i = c.blockHeader(nodes, i)
+		c.code.byte(op.code)
+		c.code.byte(blockType)
+		c.labels = append(c.labels, label{name, op.code})
+	case 0x05: // else
+		if len(c.labels) == 0 || c.labels[len(c.labels)-1].op != 0x04 {
+			n.pos.errorf("unexpected else")
+		}
+		i = c.closingLabel(nodes, i)
+		c.labels[len(c.labels)-1].op = 0x05
+		c.code.byte(0x05)
+	case 0x0b: // end
+		if len(c.labels) == 0 {
+			n.pos.errorf("unexpected end")
+		}
+		i = c.closingLabel(nodes, i)
+		c.labels = c.labels[:len(c.labels)-1]
+		c.code.byte(0x0b)
+	default:
+		var imm buffer
+		imm, i = c.immediates(op, n, nodes, i)
+		c.code.byte(op.code)
+		c.code.bytes(imm)
+	}
+	return i
+}
+
+// folded compiles a folded instruction.
+func (c *funcCompiler) folded(n *node) {
+	if len(n.children) == 0 {
+		n.pos.errorf("expected instruction")
+	}
+	op := lookupOp(n.children[0])
+	args := n.children
+	i := 1
+
+	switch op.code {
+	case 0x02, 0x03: // block, loop
+		var name string
+		var blockType byte
+		name, blockType, i = c.blockHeader(args, i)
+		c.code.byte(op.code)
+		c.code.byte(blockType)
+		c.labels = append(c.labels, label{name, op.code})
+		c.instrs(args[i:])
+		c.labels = c.labels[:len(c.labels)-1]
+		c.code.byte(0x0b)
+	case 0x04: // if
+		var name string
+		var blockType byte
+		name, blockType, i = c.blockHeader(args, i)
+		for i < len(args) && args[i].head() != "then" {
+			if !args[i].list {
+				args[i].pos.errorf("unexpected token %s, expected then", args[i])
+			}
+			c.folded(args[i])
+			i++
+		}
+		if i == len(args) {
+			n.pos.errorf("expected then")
+		}
+		c.code.byte(0x04)
+		c.code.byte(blockType)
+		c.labels = append(c.labels, label{name, 0x04})
+		c.instrs(args[i].children[1:])
+		i++
+		if i < len(args) && args[i].head() == "else" {
+			// An empty else branch is left out, as it would be in the binary format.
+			if len(args[i].children) > 1 {
+				c.code.byte(0x05)
+				c.instrs(args[i].children[1:])
+			}
+			i++
+		}
+		if i < len(args) {
+			args[i].pos.errorf("unexpected token %s", args[i])
+		}
+		c.labels = c.labels[:len(c.labels)-1]
+		c.code.byte(0x0b)
+	case 0x05, 0x0b:
+		n.pos.errorf("unexpected %s", op.name)
+	default:
+		var imm buffer
+		imm, i = c.immediates(op, n.children[0], args, i)
+		for ; i < len(args); i++ {
+			if !args[i].list {
+				args[i].pos.errorf("unexpected token %s", args[i])
+			}
+			c.folded(args[i])
+		}
+		c.code.byte(op.code)
+		c.code.bytes(imm)
+	}
+}
+
+// blockHeader reads the optional label and result type of a block.
+func (c *funcCompiler) blockHeader(nodes []*node, i int) (string, byte, int) {
+	var name string
+	if i < len(nodes) && nodes[i].isAtom() && strings.HasPrefix(nodes[i].tok.text, "$") {
+		name = nodes[i].tok.text
+		i++
+	}
+
+	var results []byte
+	for i < len(nodes) && nodes[i].head() == "result" {
+		for _, t := range nodes[i].children[1:] {
+			results = append(results, parseValueType(t))
+		}
+		i++
+	}
+	switch len(results) {
+	case 0:
+		return name, blockEmpty, i
+	case 1:
+		return name, results[0], i
+	}
+	nodes[i-1].pos.errorf("blocks may have at most one result")
+	return "", 0, i
+}
+
+// closingLabel checks the optional label repeated after else and end.
+func (c *funcCompiler) closingLabel(nodes []*node, i int) int {
+	if i < len(nodes) && nodes[i].isAtom() && strings.HasPrefix(nodes[i].tok.text, "$") {
+		if nodes[i].tok.text != c.labels[len(c.labels)-1].name {
+			nodes[i].pos.errorf("mismatching label %s", nodes[i].tok.text)
+		}
+		i++
+	}
+	return i
+}
+
+func (c *funcCompiler) labelIndex(n *node) uint32 {
+	if !isIndex(n) {
+		n.pos.errorf("expected label, found %s", n)
+	}
+	if n.tok.text[0] == '$' {
+		for i := len(c.labels) - 1; i >= 0; i-- {
+			if c.labels[i].name == n.tok.text {
+				return uint32(len(c.labels) - 1 - i)
+			}
+		}
+		n.pos.errorf("unknown label %s", n.tok.text)
+	}
+	depth, ok := parseUint32(n.tok.text)
+	if !ok {
+		n.pos.errorf("malformed label %s", n.tok.text)
+	}
+	return depth
+}
+
+func (c *funcCompiler) localIndex(n *node) uint32 {
+	if !isIndex(n) {
+		n.pos.errorf("expected local, found %s", n)
+	}
+	if n.tok.text[0] == '$' {
+		index, ok := c.localIDs[n.tok.text]
+		if !ok {
+			n.pos.errorf("unknown local %s", n.tok.text)
+		}
+		return index
+	}
+	index, ok := parseUint32(n.tok.text)
+	if !ok {
+		n.pos.errorf("malformed local %s", n.tok.text)
+	}
+	return index
+}
+
+// immediates reads the immediate arguments of an instruction starting at
+// nodes[i] and returns their encoding and the index of the following node.
+func (c *funcCompiler) immediates(op *opInfo, opNode *node, nodes []*node, i int) (buffer, int) {
+	var imm buffer
+
+	arg := func(what string) *node {
+		if i >= len(nodes) || !nodes[i].isAtom() {
+			p := opNode.pos
+			if i < len(nodes) {
+				p = nodes[i].pos
+			}
+			p.errorf("%s expects %s", op.name, what)
+		}
+		i++
+		return nodes[i-1]
+	}
+
+	switch op.imm {
+	case immLabel:
+		imm.u32(c.labelIndex(arg("a label")))
+	case immBrTable:
+		var targets []uint32
+		for i < len(nodes) && isIndex(nodes[i]) {
+			targets = append(targets, c.labelIndex(nodes[i]))
+			i++
+		}
+		if len(targets) == 0 {
+			opNode.pos.errorf("br_table expects a label")
+		}
+		imm.u32(uint32(len(targets) - 1))
+		for _, t := range targets {
+			imm.u32(t)
+		}
+	case immFunc:
+		imm.u32(c.m.index(externalFunction, arg("a function")))
+	case immCallIndirect:
+		// Older scripts write the type index directly: call_indirect $sig.
+		if i < len(nodes) && isIndex(nodes[i]) {
+			imm.u32(c.m.typeIndex(nodes[i]))
+			imm.byte(0)
+			i++
+			break
+		}
+		sub := &cursor{parent: opNode, nodes: nodes, i: i}
+		typeIndex, names := c.m.typeUse(sub)
+		for _, name := range names {
+			if name != "" {
+				opNode.pos.errorf("call_indirect parameters cannot be named")
+			}
+		}
+		i = sub.i
+		imm.u32(typeIndex)
+		imm.byte(0)
+	case immLocal:
+		imm.u32(c.localIndex(arg("a local")))
+	case immGlobal:
+		imm.u32(c.m.index(externalGlobal, arg("a global")))
+	case immMemory:
+		var offset uint32
+		align := op.align
+		if i < len(nodes) && nodes[i].isAtom() && strings.HasPrefix(nodes[i].tok.text, "offset=") {
+			v, ok := parseUint32(strings.TrimPrefix(nodes[i].tok.text, "offset="))
+			if !ok {
+				nodes[i].pos.errorf("malformed offset %s", nodes[i].tok.text)
+			}
+			offset = v
+			i++
+		}
+		if i < len(nodes) && nodes[i].isAtom() && strings.HasPrefix(nodes[i].tok.text, "align=") {
+			v, ok := parseUint32(strings.TrimPrefix(nodes[i].tok.text, "align="))
+			if !ok || v == 0 || v&(v-1) != 0 {
+				nodes[i].pos.errorf("alignment must be a power of two")
+			}
+			align = uint32(bits.TrailingZeros32(v))
+			i++
+		}
+		imm.u32(align)
+		imm.u32(offset)
+	case immReserved:
+		imm.byte(0)
+	case immI32:
+		n := arg("an i32 literal")
+		v, ok := parseInt(n.tok.text, 32)
+		if !ok {
+			n.pos.errorf("constant out of range: %s", n.tok.text)
+		}
+		imm.s64(int64(int32(uint32(v))))
+	case immI64:
+		n := arg("an i64 literal")
+		v, ok := parseInt(n.tok.text, 64)
+		if !ok {
+			n.pos.errorf("constant out of range: %s", n.tok.text)
+		}
+		imm.s64(int64(v))
+	case immF32:
+		n := arg("an f32 literal")
+		v, ok := parseFloat(n.tok.text, 32)
+		if !ok {
+			n.pos.errorf("constant out of range: %s", n.tok.text)
+		}
+		var b [4]byte
+		binary.LittleEndian.PutUint32(b[:], uint32(v))
+		imm.bytes(b[:])
+	case immF64:
+		n := arg("an f64 literal")
+		v, ok := parseFloat(n.tok.text, 64)
+		if !ok {
+			n.pos.errorf("constant out of range: %s", n.tok.text)
+		}
+		var b [8]byte
+		binary.LittleEndian.PutUint64(b[:], v)
+		imm.bytes(b[:])
+	}
+	return imm, i
+}
@@ -0,0 +1,308 @@
+package wat
+
+import (
+	"fmt"
+	"strconv"
+	"unicode/utf8"
+)
+
+// Error is a syntax or semantic error in a text module.
+type Error struct {
+	Line   int
+	Column int
+	Msg    string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%d:%d: %s", e.Line, e.Column, e.Msg)
+}
+
+type pos struct {
+	line, col int
+}
+
+func (p pos) errorf(format string, args ...interface{}) {
+	panic(&Error{Line: p.line, Column: p.col, Msg: fmt.Sprintf(format, args...)})
+}
+
+type tokenKind int
+
+const (
+	tokLParen tokenKind = iota
+	tokRParen
+	tokAtom   // keywords, identifiers, numbers and other reserved tokens
+	tokString // text holds the decoded bytes
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  pos
+}
+
+// node is an S-expression: either a list or an atom.
+type node struct {
+	list     bool
+	children []*node
+	tok      token
+	pos      pos
+}
+
+func (n *node) isAtom() bool {
+	return !n.list && n.tok.kind == tokAtom
+}
+
+func (n *node) isString() bool {
+	return !n.list && n.tok.kind == tokString
+}
+
+// head returns the keyword a list starts with, or "".
+func (n *node) head() string {
+	if !n.list || len(n.children) == 0 || !n.children[0].isAtom() {
+		return ""
+	}
+	return n.children[0].tok.text
+}
+
+func (n *node) String() string {
+	if n.list {
+		return "(" + n.head() + " ...)"
+	}
+	if n.tok.kind == tokString {
+		return strconv.Quote(n.tok.text)
+	}
+	return n.tok.text
+}
+
+type lexer struct {
+	src  []byte
+	off  int
+	line int
+	col  int
+}
+
+func newLexer(src []byte) *lexer {
+	return &lexer{src: src, line: 1, col: 1}
+}
+
+func (l *lexer) pos() pos {
+	return pos{l.line, l.col}
+}
+
+func (l *lexer) advance(n int) {
+	for i := 0; i < n; i++ {
+		if l.src[l.off] == '\n' {
+			l.line++
+			l.col = 1
+		} else {
+			l.col++
+		}
+		l.off++
+	}
+}
+
+func (l *lexer) peekByte(i int) byte {
+	if l.off+i < len(l.src) {
+		return l.src[l.off+i]
+	}
+	return 0
+}
+
+// skipSpace skips white space, line comments and nested block comments.
+func (l *lexer) skipSpace() {
+	for l.off < len(l.src) {
+		c := l.src[l.off]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			l.advance(1)
+		case c == ';' && l.peekByte(1) == ';':
+			for l.off < len(l.src) && l.src[l.off] != '\n' {
+				l.advance(1)
+			}
+		case c == '(' && l.peekByte(1) == ';':
+			start := l.pos()
+			depth := 0
+			for {
+				if l.off >= len(l.src) {
+					start.errorf("unclosed block comment")
+				}
+				if l.src[l.off] == '(' && l.peekByte(1) == ';' {
+					depth++
+					l.advance(2)
+				} else if l.src[l.off] == ';' && l.peekByte(1) == ')' {
+					depth--
+					l.advance(2)
+					if depth == 0 {
+						break
+					}
+				} else {
+					l.advance(1)
+				}
+			}
+		default:
+			return
+		}
+	}
+}
+
+func isIDChar(c byte) bool {
+	switch {
+	case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		return true
+	}
+	switch c {
+	case '!', '#', '$', '%', '&', '\'', '*', '+', '-', '.', '/', ':', '<', '=', '>', '?', '@', '\\', '^', '_', '`', '|', '~':
+		return true
+	}
+	return false
+}
+
+// next returns the next token, or false at the end of the input.
+func (l *lexer) next() (token, bool) {
+	l.skipSpace()
+	if l.off >= len(l.src) {
+		return token{}, false
+	}
+
+	p := l.pos()
+	c := l.src[l.off]
+	switch {
+	case c == '(':
+		l.advance(1)
+		return token{kind: tokLParen, pos: p}, true
+	case c == ')':
+		l.advance(1)
+		return token{kind: tokRParen, pos: p}, true
+	case c == '"':
+		return token{kind: tokString, text: l.readString(), pos: p}, true
+	case isIDChar(c):
+		start := l.off
+		for l.off < len(l.src) && isIDChar(l.src[l.off]) {
+			l.advance(1)
+		}
+		return token{kind: tokAtom, text: string(l.src[start:l.off]), pos: p}, true
+	}
+	p.errorf("unexpected character %q", c)
+	return token{}, false
+}
+
+func hexValue(c byte) int {
+	switch {
+	case c >= '0' && c <= '9':
+		return int(c - '0')
+	case c >= 'a' && c <= 'f':
+		return int(c-'a') + 10
+	case c >= 'A' && c <= 'F':
+		return int(c-'A') + 10
+	}
+	return -1
+}
+
+func (l *lexer) readString() string {
+	start := l.pos()
+	l.advance(1)
+
+	var buf []byte
+	for {
+		if l.off >= len(l.src) || l.src[l.off] == '\n' {
+			start.errorf("unterminated string")
+		}
+		c := l.src[l.off]
+		if c == '"' {
+			l.advance(1)
+			return string(buf)
+		}
+		if c != '\\' {
+			buf = append(buf, c)
+			l.advance(1)
+			continue
+		}
+
+		p := l.pos()
+		l.advance(1)
+		if l.off >= len(l.src) {
+			start.errorf("unterminated string")
+		}
+		e := l.src[l.off]
+		switch e {
+		case 'n':
+			buf = append(buf, '\n')
+		case 't':
+			buf = append(buf, '\t')
+		case 'r':
+			buf = append(buf, '\r')
+		case '\\', '\'', '"':
+			buf = append(buf, e)
+		case 'u':
+			if l.peekByte(1) != '{' {
+				p.errorf("malformed unicode escape")
+			}
+			l.advance(2)
+			r := 0
+			digits := 0
+			for l.off < len(l.src) && l.src[l.off] != '}' {
+				v := hexValue(l.src[l.off])
+				if v < 0 || r > utf8.MaxRune {
+					p.errorf("malformed unicode escape")
+				}
+				r = r*16 + v
+				digits++
+				l.advance(1)
+			}
+			if digits == 0 || l.off >= len(l.src) || !utf8.ValidRune(rune(r)) {
+				p.errorf("malformed unicode escape")
+			}
+			buf = append(buf, string(rune(r))...)
+		default:
+			hi, lo := hexValue(e), hexValue(l.peekByte(1))
+			if hi < 0 || lo < 0 {
+				p.errorf("illegal escape")
+			}
+			buf = append(buf, byte(hi*16+lo))
+			l.advance(1)
+		}
+		l.advance(1)
+	}
+}
+
+// parseSExprs reads all S-expressions of the input.
+func parseSExprs(src []byte) []*node {
+	l := newLexer(src)
+	var stack []*node
+	var top []*node
+
+	for {
+		tok, ok := l.next()
+		if !ok {
+			break
+		}
+		switch tok.kind {
+		case tokLParen:
+			stack = append(stack, &node{list: true, pos: tok.pos})
+		case tokRParen:
+			if len(stack) == 0 {
+				tok.pos.errorf("unexpected )")
+			}
+			n := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if len(stack) == 0 {
+				top = append(top, n)
+			} else {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, n)
+			}
+		default:
+			n := &node{tok: tok, pos: tok.pos}
+			if len(stack) == 0 {
+				top = append(top, n)
+			} else {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, n)
+			}
+		}
+	}
+	if len(stack) != 0 {
+		stack[len(stack)-1].pos.errorf("unclosed (")
+	}
+	return top
+}
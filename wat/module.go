@@ -0,0 +1,798 @@
+package wat
+
+import (
+	"bytes"
+	"strings"
+)
+
+type funcType struct {
+	params  []byte
+	results []byte
+}
+
+func (t funcType) equal(other funcType) bool {
+	return bytes.Equal(t.params, other.params) && bytes.Equal(t.results, other.results)
+}
+
+type limits struct {
+	min, max uint32
+	hasMax   bool
+}
+
+type globalType struct {
+	valueType byte
+	mutable   bool
+}
+
+type importEntry struct {
+	module, field string
+	kind          byte
+	typeIndex     uint32
+	limits        limits
+	global        globalType
+}
+
+type function struct {
+	pos        pos
+	typeIndex  uint32
+	locals     []byte   // declared locals, excluding parameters
+	localNames []string // names of parameters and locals
+	body       []*node
+	code       []byte
+}
+
+type global struct {
+	typ  globalType
+	init []byte
+}
+
+type export struct {
+	name  string
+	kind  byte
+	index uint32
+}
+
+type segment struct {
+	index  uint32
+	offset []byte
+	funcs  []uint32
+	data   []byte
+}
+
+// indexSpace tracks the number of entities of one kind and their identifiers.
+type indexSpace struct {
+	count uint32
+	ids   map[string]uint32
+}
+
+var kindNames = [...]string{
+	externalFunction: "function",
+	externalTable:    "table",
+	externalMemory:   "memory",
+	externalGlobal:   "global",
+}
+
+type module struct {
+	moduleName string
+	types      []funcType
+	typeIDs    map[string]uint32
+	imports    []importEntry
+	funcs      []*function
+	tables     []limits
+	memories   []limits
+	globals    []*global
+	exports    []export
+	start      *uint32
+	elems      []*segment
+	data       []*segment
+
+	spaces         [4]indexSpace
+	funcNames      []string
+	numFuncImports int
+
+	// firstDefinition is the kind of the first function, table, memory or
+	// global defined in the module; imports may not follow it.
+	firstDefinition string
+
+	// later holds the work that needs every identifier to be declared.
+	later []func()
+}
+
+// parseModule builds a module from the fields of a module form.
+func parseModule(fields []*node) *module {
+	m := &module{typeIDs: make(map[string]uint32)}
+	for i := range m.spaces {
+		m.spaces[i].ids = make(map[string]uint32)
+	}
+
+	// Explicit types come first so that function types can be resolved as
+	// fields are declared.
+	for _, f := range fields {
+		if !f.list {
+			f.pos.errorf("unexpected token %s, expected module field", f)
+		}
+		if f.head() == "type" {
+			m.parseType(f)
+		}
+	}
+
+	for _, f := range fields {
+		switch f.head() {
+		case "type":
+		case "import":
+			m.parseImport(f)
+		case "func":
+			m.parseFunc(f)
+		case "table":
+			m.parseTable(f)
+		case "memory":
+			m.parseMemory(f)
+		case "global":
+			m.parseGlobal(f)
+		case "export":
+			m.parseExport(f)
+		case "start":
+			m.parseStart(f)
+		case "elem":
+			m.parseElem(f)
+		case "data":
+			m.parseData(f)
+		default:
+			f.pos.errorf("unknown module field %s", f)
+		}
+	}
+
+	for _, fn := range m.later {
+		fn()
+	}
+
+	for _, f := range m.funcs {
+		c := newFuncCompiler(m, f)
+		c.instrs(f.body)
+		if len(c.labels) != 0 {
+			f.pos.errorf("unclosed block")
+		}
+		c.code.byte(0x0b)
+		f.code = c.code
+	}
+	return m
+}
+
+// cursor walks the children of a list.
+type cursor struct {
+	parent *node
+	nodes  []*node
+	i      int
+}
+
+func newCursor(n *node) *cursor {
+	return &cursor{parent: n, nodes: n.children, i: 1}
+}
+
+func (c *cursor) done() bool {
+	return c.i >= len(c.nodes)
+}
+
+func (c *cursor) peek() *node {
+	if c.done() {
+		return nil
+	}
+	return c.nodes[c.i]
+}
+
+// pos returns the position of the next node, or of the parent at the end.
+func (c *cursor) pos() pos {
+	if c.done() {
+		return c.parent.pos
+	}
+	return c.nodes[c.i].pos
+}
+
+func (c *cursor) rest() []*node {
+	ret := c.nodes[c.i:]
+	c.i = len(c.nodes)
+	return ret
+}
+
+// peekList reports whether the next node is a list starting with keyword.
+func (c *cursor) peekList(keyword string) bool {
+	n := c.peek()
+	return n != nil && n.head() == keyword
+}
+
+func (c *cursor) atom(what string) *node {
+	n := c.peek()
+	if n == nil || !n.isAtom() {
+		c.pos().errorf("expected %s", what)
+	}
+	c.i++
+	return n
+}
+
+func (c *cursor) str(what string) string {
+	n := c.peek()
+	if n == nil || !n.isString() {
+		c.pos().errorf("expected %s", what)
+	}
+	c.i++
+	return n.tok.text
+}
+
+// id consumes an optional symbolic identifier.
+func (c *cursor) id() string {
+	n := c.peek()
+	if n != nil && n.isAtom() && strings.HasPrefix(n.tok.text, "$") {
+		c.i++
+		return n.tok.text
+	}
+	return ""
+}
+
+func (c *cursor) end() {
+	if !c.done() {
+		c.pos().errorf("unexpected token %s", c.peek())
+	}
+}
+
+func isIndex(n *node) bool {
+	if n == nil || !n.isAtom() {
+		return false
+	}
+	c := n.tok.text[0]
+	return c == '$' || (c >= '0' && c <= '9')
+}
+
+func parseValueType(n *node) byte {
+	if n.isAtom() {
+		switch n.tok.text {
+		case "i32":
+			return typeI32
+		case "i64":
+			return typeI64
+		case "f32":
+			return typeF32
+		case "f64":
+			return typeF64
+		}
+	}
+	n.pos.errorf("unknown value type %s", n)
+	return 0
+}
+
+func (m *module) declare(kind byte, id string, p pos) uint32 {
+	space := &m.spaces[kind]
+	index := space.count
+	if id != "" {
+		if _, ok := space.ids[id]; ok {
+			p.errorf("duplicate %s %s", kindNames[kind], id)
+		}
+		space.ids[id] = index
+	}
+	space.count++
+	if kind == externalFunction {
+		m.funcNames = append(m.funcNames, strings.TrimPrefix(id, "$"))
+	}
+	return index
+}
+
+// index resolves a numeric or symbolic index in the index space of kind.
+func (m *module) index(kind byte, n *node) uint32 {
+	if !isIndex(n) {
+		n.pos.errorf("expected %s index, found %s", kindNames[kind], n)
+	}
+	if n.tok.text[0] == '$' {
+		index, ok := m.spaces[kind].ids[n.tok.text]
+		if !ok {
+			n.pos.errorf("unknown %s %s", kindNames[kind], n.tok.text)
+		}
+		return index
+	}
+	index, ok := parseUint32(n.tok.text)
+	if !ok {
+		n.pos.errorf("malformed index %s", n.tok.text)
+	}
+	return index
+}
+
+func (m *module) typeIndex(n *node) uint32 {
+	if isIndex(n) && n.tok.text[0] == '$' {
+		index, ok := m.typeIDs[n.tok.text]
+		if !ok {
+			n.pos.errorf("unknown type %s", n.tok.text)
+		}
+		return index
+	}
+	if !isIndex(n) {
+		n.pos.errorf("expected type index, found %s", n)
+	}
+	index, ok := parseUint32(n.tok.text)
+	if !ok {
+		n.pos.errorf("malformed index %s", n.tok.text)
+	}
+	if int(index) >= len(m.types) {
+		n.pos.errorf("unknown type %d", index)
+	}
+	return index
+}
+
+// define records the definition of an entity of the given kind, after
+// which imports are no longer allowed.
+func (m *module) define(kind byte) {
+	if m.firstDefinition == "" {
+		m.firstDefinition = kindNames[kind]
+	}
+}
+
+func (m *module) checkImportOrder(p pos) {
+	if m.firstDefinition != "" {
+		p.errorf("import after %s", m.firstDefinition)
+	}
+}
+
+// parseParams reads (param ...) lists and returns their types and names.
+func parseParams(c *cursor) ([]byte, []string) {
+	var types []byte
+	var names []string
+	for c.peekList("param") {
+		pc := newCursor(c.peek())
+		c.i++
+		if id := pc.id(); id != "" {
+			types = append(types, parseValueType(pc.atom("value type")))
+			names = append(names, id)
+			pc.end()
+			continue
+		}
+		for !pc.done() {
+			types = append(types, parseValueType(pc.peek()))
+			names = append(names, "")
+			pc.i++
+		}
+	}
+	return types, names
+}
+
+func parseResults(c *cursor) []byte {
+	var types []byte
+	for c.peekList("result") {
+		rc := newCursor(c.peek())
+		c.i++
+		for !rc.done() {
+			types = append(types, parseValueType(rc.peek()))
+			rc.i++
+		}
+	}
+	return types
+}
+
+func (m *module) parseType(n *node) {
+	c := newCursor(n)
+	id := c.id()
+	if !c.peekList("func") {
+		c.pos().errorf("expected function type")
+	}
+	fc := newCursor(c.peek())
+	c.i++
+	c.end()
+
+	params, _ := parseParams(fc)
+	results := parseResults(fc)
+	fc.end()
+
+	if id != "" {
+		if _, ok := m.typeIDs[id]; ok {
+			n.pos.errorf("duplicate type %s", id)
+		}
+		m.typeIDs[id] = uint32(len(m.types))
+	}
+	m.types = append(m.types, funcType{params, results})
+}
+
+// typeUse reads a type use and returns the type index and parameter names.
+// Function types written inline that match no existing type are added to
+// the module.
+func (m *module) typeUse(c *cursor) (uint32, []string) {
+	start := c.pos()
+	explicit := -1
+	if c.peekList("type") {
+		tc := newCursor(c.peek())
+		c.i++
+		explicit = int(m.typeIndex(tc.atom("type index")))
+		tc.end()
+	}
+
+	hasInline := c.peekList("param") || c.peekList("result")
+	params, names := parseParams(c)
+	results := parseResults(c)
+	inline := funcType{params, results}
+
+	if explicit >= 0 {
+		t := m.types[explicit]
+		if !hasInline {
+			return uint32(explicit), make([]string, len(t.params))
+		}
+		if !t.equal(inline) {
+			start.errorf("inline function type does not match type %d", explicit)
+		}
+		return uint32(explicit), names
+	}
+
+	for i, t := range m.types {
+		if t.equal(inline) {
+			return uint32(i), names
+		}
+	}
+	m.types = append(m.types, inline)
+	return uint32(len(m.types) - 1), names
+}
+
+func parseLimits(c *cursor) limits {
+	var l limits
+	n := c.atom("limits")
+	min, ok := parseUint32(n.tok.text)
+	if !ok {
+		n.pos.errorf("malformed limit %s", n)
+	}
+	l.min = min
+	if next := c.peek(); next != nil && next.isAtom() && isIndex(next) {
+		c.i++
+		max, ok := parseUint32(next.tok.text)
+		if !ok {
+			next.pos.errorf("malformed limit %s", next)
+		}
+		l.max, l.hasMax = max, true
+	}
+	return l
+}
+
+func parseElemType(c *cursor) {
+	n := c.atom("element type")
+	if n.tok.text != "anyfunc" && n.tok.text != "funcref" {
+		n.pos.errorf("unknown element type %s", n)
+	}
+}
+
+func parseGlobalType(c *cursor) globalType {
+	if c.peekList("mut") {
+		mc := newCursor(c.peek())
+		c.i++
+		t := globalType{valueType: parseValueType(mc.atom("value type")), mutable: true}
+		mc.end()
+		return t
+	}
+	n := c.peek()
+	if n == nil {
+		c.pos().errorf("expected global type")
+	}
+	c.i++
+	return globalType{valueType: parseValueType(n)}
+}
+
+// parseInlineExports reads the (export "name") abbreviations of a definition.
+func (m *module) parseInlineExports(c *cursor, kind byte, index uint32) {
+	for c.peekList("export") {
+		ec := newCursor(c.peek())
+		c.i++
+		name := m.name(ec, "export name")
+		ec.end()
+		m.exports = append(m.exports, export{name: name, kind: kind, index: index})
+	}
+}
+
+// parseInlineImport reads the (import "module" "field") abbreviation of a
+// definition, if present.
+func (m *module) parseInlineImport(c *cursor) (string, string, bool) {
+	if !c.peekList("import") {
+		return "", "", false
+	}
+	ic := newCursor(c.peek())
+	c.i++
+	module := m.name(ic, "module name")
+	field := m.name(ic, "field name")
+	ic.end()
+	return module, field, true
+}
+
+func (m *module) name(c *cursor, what string) string {
+	p := c.pos()
+	s := c.str(what)
+	if !validName(s) {
+		p.errorf("malformed UTF-8 encoding")
+	}
+	return s
+}
+
+func (m *module) addImport(p pos, imp importEntry) {
+	m.checkImportOrder(p)
+	m.imports = append(m.imports, imp)
+	if imp.kind == externalFunction {
+		m.numFuncImports++
+	}
+}
+
+func (m *module) parseImport(n *node) {
+	c := newCursor(n)
+	imp := importEntry{
+		module: m.name(c, "module name"),
+		field:  m.name(c, "field name"),
+	}
+	desc := c.peek()
+	if desc == nil || !desc.list {
+		c.pos().errorf("expected import description")
+	}
+	c.i++
+	c.end()
+
+	dc := newCursor(desc)
+	switch desc.head() {
+	case "func":
+		imp.kind = externalFunction
+		m.declare(externalFunction, dc.id(), desc.pos)
+		imp.typeIndex, _ = m.typeUse(dc)
+	case "table":
+		imp.kind = externalTable
+		m.declare(externalTable, dc.id(), desc.pos)
+		imp.limits = parseLimits(dc)
+		parseElemType(dc)
+	case "memory":
+		imp.kind = externalMemory
+		m.declare(externalMemory, dc.id(), desc.pos)
+		imp.limits = parseLimits(dc)
+	case "global":
+		imp.kind = externalGlobal
+		m.declare(externalGlobal, dc.id(), desc.pos)
+		imp.global = parseGlobalType(dc)
+	default:
+		desc.pos.errorf("unknown import kind %s", desc)
+	}
+	dc.end()
+	m.addImport(n.pos, imp)
+}
+
+func (m *module) parseFunc(n *node) {
+	c := newCursor(n)
+	index := m.declare(externalFunction, c.id(), n.pos)
+	m.parseInlineExports(c, externalFunction, index)
+
+	if module, field, ok := m.parseInlineImport(c); ok {
+		typeIndex, _ := m.typeUse(c)
+		c.end()
+		m.addImport(n.pos, importEntry{module: module, field: field, kind: externalFunction, typeIndex: typeIndex})
+		return
+	}
+
+	m.define(externalFunction)
+	f := &function{pos: n.pos}
+	f.typeIndex, f.localNames = m.typeUse(c)
+	for c.peekList("local") {
+		lc := newCursor(c.peek())
+		c.i++
+		if id := lc.id(); id != "" {
+			f.locals = append(f.locals, parseValueType(lc.atom("value type")))
+			f.localNames = append(f.localNames, id)
+			lc.end()
+			continue
+		}
+		for !lc.done() {
+			f.locals = append(f.locals, parseValueType(lc.peek()))
+			f.localNames = append(f.localNames, "")
+			lc.i++
+		}
+	}
+	f.body = c.rest()
+	m.funcs = append(m.funcs, f)
+}
+
+func (m *module) parseTable(n *node) {
+	c := newCursor(n)
+	index := m.declare(externalTable, c.id(), n.pos)
+	m.parseInlineExports(c, externalTable, index)
+
+	if module, field, ok := m.parseInlineImport(c); ok {
+		l := parseLimits(c)
+		parseElemType(c)
+		c.end()
+		m.addImport(n.pos, importEntry{module: module, field: field, kind: externalTable, limits: l})
+		return
+	}
+
+	m.define(externalTable)
+	if next := c.peek(); next != nil && next.isAtom() && !isIndex(next) {
+		// (table anyfunc (elem ...)) sizes the table to fit its elements.
+		parseElemType(c)
+		if !c.peekList("elem") {
+			c.pos().errorf("expected elem")
+		}
+		ec := newCursor(c.peek())
+		c.i++
+		c.end()
+		funcs := ec.rest()
+		size := uint32(len(funcs))
+		m.tables = append(m.tables, limits{min: size, max: size, hasMax: true})
+
+		seg := &segment{index: index, offset: []byte{0x41, 0x00, 0x0b}}
+		m.elems = append(m.elems, seg)
+		m.later = append(m.later, func() {
+			for _, f := range funcs {
+				seg.funcs = append(seg.funcs, m.index(externalFunction, f))
+			}
+		})
+		return
+	}
+
+	l := parseLimits(c)
+	parseElemType(c)
+	c.end()
+	m.tables = append(m.tables, l)
+}
+
+func (m *module) parseMemory(n *node) {
+	c := newCursor(n)
+	index := m.declare(externalMemory, c.id(), n.pos)
+	m.parseInlineExports(c, externalMemory, index)
+
+	if module, field, ok := m.parseInlineImport(c); ok {
+		l := parseLimits(c)
+		c.end()
+		m.addImport(n.pos, importEntry{module: module, field: field, kind: externalMemory, limits: l})
+		return
+	}
+
+	m.define(externalMemory)
+	if c.peekList("data") {
+		// (memory (data ...)) sizes the memory to fit its data.
+		dc := newCursor(c.peek())
+		c.i++
+		c.end()
+		var data []byte
+		for !dc.done() {
+			data = append(data, dc.str("data string")...)
+		}
+		pages := uint32((len(data) + 65535) / 65536)
+		m.memories = append(m.memories, limits{min: pages, max: pages, hasMax: true})
+		m.data = append(m.data, &segment{index: index, offset: []byte{0x41, 0x00, 0x0b}, data: data})
+		return
+	}
+
+	l := parseLimits(c)
+	c.end()
+	m.memories = append(m.memories, l)
+}
+
+func (m *module) parseGlobal(n *node) {
+	c := newCursor(n)
+	index := m.declare(externalGlobal, c.id(), n.pos)
+	m.parseInlineExports(c, externalGlobal, index)
+
+	if module, field, ok := m.parseInlineImport(c); ok {
+		t := parseGlobalType(c)
+		c.end()
+		m.addImport(n.pos, importEntry{module: module, field: field, kind: externalGlobal, global: t})
+		return
+	}
+
+	m.define(externalGlobal)
+	g := &global{typ: parseGlobalType(c)}
+	m.globals = append(m.globals, g)
+	expr := c.rest()
+	m.later = append(m.later, func() {
+		g.init = m.constExpr(expr)
+	})
+}
+
+// constExpr compiles an initializer expression, including its end.
+func (m *module) constExpr(nodes []*node) []byte {
+	c := newFuncCompiler(m, nil)
+	c.instrs(nodes)
+	if len(c.labels) != 0 {
+		nodes[len(nodes)-1].pos.errorf("unclosed block")
+	}
+	c.code.byte(0x0b)
+	return c.code
+}
+
+func parseExternalKind(n *node) byte {
+	switch n.head() {
+	case "func":
+		return externalFunction
+	case "table":
+		return externalTable
+	case "memory":
+		return externalMemory
+	case "global":
+		return externalGlobal
+	}
+	n.pos.errorf("unknown export kind %s", n)
+	return 0
+}
+
+func (m *module) parseExport(n *node) {
+	c := newCursor(n)
+	name := m.name(c, "export name")
+	desc := c.peek()
+	if desc == nil || !desc.list {
+		c.pos().errorf("expected export description")
+	}
+	c.i++
+	c.end()
+
+	kind := parseExternalKind(desc)
+	dc := newCursor(desc)
+	target := dc.atom("index")
+	dc.end()
+
+	slot := len(m.exports)
+	m.exports = append(m.exports, export{name: name, kind: kind})
+	m.later = append(m.later, func() {
+		m.exports[slot].index = m.index(kind, target)
+	})
+}
+
+func (m *module) parseStart(n *node) {
+	if m.start != nil {
+		n.pos.errorf("multiple start sections")
+	}
+	c := newCursor(n)
+	target := c.atom("function index")
+	c.end()
+	m.start = new(uint32)
+	m.later = append(m.later, func() {
+		*m.start = m.index(externalFunction, target)
+	})
+}
+
+// parseOffset reads the offset of a segment, either (offset instr*) or a
+// single folded instruction.
+func (m *module) parseOffset(c *cursor) []*node {
+	n := c.peek()
+	if n == nil || !n.list {
+		c.pos().errorf("expected offset expression")
+	}
+	c.i++
+	if n.head() == "offset" {
+		return n.children[1:]
+	}
+	return []*node{n}
+}
+
+func (m *module) parseElem(n *node) {
+	c := newCursor(n)
+	var target *node
+	if isIndex(c.peek()) {
+		target = c.atom("table index")
+	}
+	offset := m.parseOffset(c)
+	funcs := c.rest()
+
+	seg := &segment{}
+	m.elems = append(m.elems, seg)
+	m.later = append(m.later, func() {
+		if target != nil {
+			seg.index = m.index(externalTable, target)
+		}
+		seg.offset = m.constExpr(offset)
+		for _, f := range funcs {
+			seg.funcs = append(seg.funcs, m.index(externalFunction, f))
+		}
+	})
+}
+
+func (m *module) parseData(n *node) {
+	c := newCursor(n)
+	var target *node
+	if isIndex(c.peek()) {
+		target = c.atom("memory index")
+	}
+	offset := m.parseOffset(c)
+
+	seg := &segment{}
+	for !c.done() {
+		seg.data = append(seg.data, c.str("data string")...)
+	}
+	m.data = append(m.data, seg)
+	m.later = append(m.later, func() {
+		if target != nil {
+			seg.index = m.index(externalMemory, target)
+		}
+		seg.offset = m.constExpr(offset)
+	})
+}
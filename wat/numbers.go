@@ -0,0 +1,182 @@
+package wat
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// digits removes the underscores separating the digits of a number and
+// reports whether s is a well-formed sequence of digits in the given base.
+func digits(s string, hex bool) (string, bool) {
+	if s == "" {
+		return "", false
+	}
+	var b strings.Builder
+	prevDigit := false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == '_' {
+			if !prevDigit || i+1 == len(s) {
+				return "", false
+			}
+			prevDigit = false
+			continue
+		}
+		v := hexValue(c)
+		if v < 0 || (!hex && v > 9) {
+			return "", false
+		}
+		prevDigit = true
+		b.WriteByte(c)
+	}
+	return b.String(), true
+}
+
+// parseInt parses an integer literal of the given width. Both signed and
+// unsigned interpretations are accepted; the result is truncated to bits.
+func parseInt(s string, bits uint) (uint64, bool) {
+	neg := false
+	if strings.HasPrefix(s, "+") || strings.HasPrefix(s, "-") {
+		neg = s[0] == '-'
+		s = s[1:]
+	}
+	hex := strings.HasPrefix(s, "0x")
+	if hex {
+		s = s[2:]
+	}
+	d, ok := digits(s, hex)
+	if !ok {
+		return 0, false
+	}
+	base := 10
+	if hex {
+		base = 16
+	}
+	v, err := strconv.ParseUint(d, base, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	mask := uint64(math.MaxUint64)
+	if bits < 64 {
+		mask = 1<<bits - 1
+	}
+	if neg {
+		if v > 1<<(bits-1) {
+			return 0, false
+		}
+		v = -v
+	} else if v > mask {
+		return 0, false
+	}
+	return v & mask, true
+}
+
+// parseUint32 parses an unsigned index or limit.
+func parseUint32(s string) (uint32, bool) {
+	if strings.HasPrefix(s, "+") || strings.HasPrefix(s, "-") {
+		return 0, false
+	}
+	v, ok := parseInt(s, 32)
+	return uint32(v), ok
+}
+
+// parseFloat parses a floating point literal of the given width and returns
+// its bit pattern.
+func parseFloat(s string, bits int) (uint64, bool) {
+	var signBit, expBits, mantBits uint64
+	if bits == 32 {
+		signBit, expBits, mantBits = 1<<31, 0xff<<23, 23
+	} else {
+		signBit, expBits, mantBits = 1<<63, 0x7ff<<52, 52
+	}
+
+	var sign uint64
+	if strings.HasPrefix(s, "+") || strings.HasPrefix(s, "-") {
+		if s[0] == '-' {
+			sign = signBit
+		}
+		s = s[1:]
+	}
+
+	switch {
+	case s == "inf":
+		return sign | expBits, true
+	case s == "nan":
+		return sign | expBits | 1<<(mantBits-1), true
+	case strings.HasPrefix(s, "nan:0x"):
+		d, ok := digits(s[len("nan:0x"):], true)
+		if !ok {
+			return 0, false
+		}
+		payload, err := strconv.ParseUint(d, 16, 64)
+		if err != nil || payload == 0 || payload >= 1<<mantBits {
+			return 0, false
+		}
+		return sign | expBits | payload, true
+	}
+
+	hex := strings.HasPrefix(s, "0x")
+	body := s
+	if hex {
+		body = s[2:]
+	}
+
+	// Split off the exponent and the fraction so each part can be checked
+	// on its own.
+	expMarker := "eE"
+	if hex {
+		expMarker = "pP"
+	}
+	mantissa, exponent := body, ""
+	if i := strings.IndexAny(body, expMarker); i >= 0 {
+		mantissa, exponent = body[:i], body[i+1:]
+	}
+	intPart, fracPart := mantissa, ""
+	hasDot := false
+	if i := strings.IndexByte(mantissa, '.'); i >= 0 {
+		intPart, fracPart, hasDot = mantissa[:i], mantissa[i+1:], true
+	}
+
+	intDigits, ok := digits(intPart, hex)
+	if !ok {
+		return 0, false
+	}
+	text := intDigits
+	if hasDot {
+		text += "."
+		if fracPart != "" {
+			fracDigits, ok := digits(fracPart, hex)
+			if !ok {
+				return 0, false
+			}
+			text += fracDigits
+		}
+	}
+	if hex {
+		text = "0x" + text
+	}
+	if exponent != "" || strings.ContainsAny(body, expMarker) {
+		expSign := ""
+		if strings.HasPrefix(exponent, "+") || strings.HasPrefix(exponent, "-") {
+			expSign, exponent = exponent[:1], exponent[1:]
+		}
+		expDigits, ok := digits(exponent, false)
+		if !ok {
+			return 0, false
+		}
+		text += expMarker[:1] + expSign + expDigits
+	} else if hex {
+		text += "p0"
+	}
+
+	f, err := strconv.ParseFloat(text, bits)
+	if err != nil {
+		return 0, false
+	}
+	if bits == 32 {
+		return sign | uint64(math.Float32bits(float32(f))), true
+	}
+	return sign | math.Float64bits(f), true
+}
@@ -0,0 +1,249 @@
+package wat
+
+import "strings"
+
+type immKind int
+
+const (
+	immNone immKind = iota
+	immBlock
+	immLabel
+	immBrTable
+	immFunc
+	immCallIndirect
+	immLocal
+	immGlobal
+	immMemory   // memarg
+	immReserved // reserved memory index byte
+	immI32
+	immI64
+	immF32
+	immF64
+)
+
+type opInfo struct {
+	code  byte
+	name  string
+	imm   immKind
+	align uint32 // natural alignment of memory accesses, as log2 of the access size
+}
+
+// opcodeTable lists the MVP instructions with the names used in this repository.
+var opcodeTable = []opInfo{
+	{0x00, "unreachable", immNone, 0},
+	{0x01, "nop", immNone, 0},
+	{0x02, "block", immBlock, 0},
+	{0x03, "loop", immBlock, 0},
+	{0x04, "if", immBlock, 0},
+	{0x05, "else", immNone, 0},
+	{0x0b, "end", immNone, 0},
+	{0x0c, "br", immLabel, 0},
+	{0x0d, "br_if", immLabel, 0},
+	{0x0e, "br_table", immBrTable, 0},
+	{0x0f, "return", immNone, 0},
+	{0x10, "call", immFunc, 0},
+	{0x11, "call_indirect", immCallIndirect, 0},
+	{0x1a, "drop", immNone, 0},
+	{0x1b, "select", immNone, 0},
+	{0x20, "get_local", immLocal, 0},
+	{0x21, "set_local", immLocal, 0},
+	{0x22, "tee_local", immLocal, 0},
+	{0x23, "get_global", immGlobal, 0},
+	{0x24, "set_global", immGlobal, 0},
+	{0x28, "i32.load", immMemory, 2},
+	{0x29, "i64.load", immMemory, 3},
+	{0x2a, "f32.load", immMemory, 2},
+	{0x2b, "f64.load", immMemory, 3},
+	{0x2c, "i32.load8_s", immMemory, 0},
+	{0x2d, "i32.load8_u", immMemory, 0},
+	{0x2e, "i32.load16_s", immMemory, 1},
+	{0x2f, "i32.load16_u", immMemory, 1},
+	{0x30, "i64.load8_s", immMemory, 0},
+	{0x31, "i64.load8_u", immMemory, 0},
+	{0x32, "i64.load16_s", immMemory, 1},
+	{0x33, "i64.load16_u", immMemory, 1},
+	{0x34, "i64.load32_s", immMemory, 2},
+	{0x35, "i64.load32_u", immMemory, 2},
+	{0x36, "i32.store", immMemory, 2},
+	{0x37, "i64.store", immMemory, 3},
+	{0x38, "f32.store", immMemory, 2},
+	{0x39, "f64.store", immMemory, 3},
+	{0x3a, "i32.store8", immMemory, 0},
+	{0x3b, "i32.store16", immMemory, 1},
+	{0x3c, "i64.store8", immMemory, 0},
+	{0x3d, "i64.store16", immMemory, 1},
+	{0x3e, "i64.store32", immMemory, 2},
+	{0x3f, "current_memory", immReserved, 0},
+	{0x40, "grow_memory", immReserved, 0},
+	{0x41, "i32.const", immI32, 0},
+	{0x42, "i64.const", immI64, 0},
+	{0x43, "f32.const", immF32, 0},
+	{0x44, "f64.const", immF64, 0},
+	{0x45, "i32.eqz", immNone, 0},
+	{0x46, "i32.eq", immNone, 0},
+	{0x47, "i32.ne", immNone, 0},
+	{0x48, "i32.lt_s", immNone, 0},
+	{0x49, "i32.lt_u", immNone, 0},
+	{0x4a, "i32.gt_s", immNone, 0},
+	{0x4b, "i32.gt_u", immNone, 0},
+	{0x4c, "i32.le_s", immNone, 0},
+	{0x4d, "i32.le_u", immNone, 0},
+	{0x4e, "i32.ge_s", immNone, 0},
+	{0x4f, "i32.ge_u", immNone, 0},
+	{0x50, "i64.eqz", immNone, 0},
+	{0x51, "i64.eq", immNone, 0},
+	{0x52, "i64.ne", immNone, 0},
+	{0x53, "i64.lt_s", immNone, 0},
+	{0x54, "i64.lt_u", immNone, 0},
+	{0x55, "i64.gt_s", immNone, 0},
+	{0x56, "i64.gt_u", immNone, 0},
+	{0x57, "i64.le_s", immNone, 0},
+	{0x58, "i64.le_u", immNone, 0},
+	{0x59, "i64.ge_s", immNone, 0},
+	{0x5a, "i64.ge_u", immNone, 0},
+	{0x5b, "f32.eq", immNone, 0},
+	{0x5c, "f32.ne", immNone, 0},
+	{0x5d, "f32.lt", immNone, 0},
+	{0x5e, "f32.gt", immNone, 0},
+	{0x5f, "f32.le", immNone, 0},
+	{0x60, "f32.ge", immNone, 0},
+	{0x61, "f64.eq", immNone, 0},
+	{0x62, "f64.ne", immNone, 0},
+	{0x63, "f64.lt", immNone, 0},
+	{0x64, "f64.gt", immNone, 0},
+	{0x65, "f64.le", immNone, 0},
+	{0x66, "f64.ge", immNone, 0},
+	{0x67, "i32.clz", immNone, 0},
+	{0x68, "i32.ctz", immNone, 0},
+	{0x69, "i32.popcnt", immNone, 0},
+	{0x6a, "i32.add", immNone, 0},
+	{0x6b, "i32.sub", immNone, 0},
+	{0x6c, "i32.mul", immNone, 0},
+	{0x6d, "i32.div_s", immNone, 0},
+	{0x6e, "i32.div_u", immNone, 0},
+	{0x6f, "i32.rem_s", immNone, 0},
+	{0x70, "i32.rem_u", immNone, 0},
+	{0x71, "i32.and", immNone, 0},
+	{0x72, "i32.or", immNone, 0},
+	{0x73, "i32.xor", immNone, 0},
+	{0x74, "i32.shl", immNone, 0},
+	{0x75, "i32.shr_s", immNone, 0},
+	{0x76, "i32.shr_u", immNone, 0},
+	{0x77, "i32.rotl", immNone, 0},
+	{0x78, "i32.rotr", immNone, 0},
+	{0x79, "i64.clz", immNone, 0},
+	{0x7a, "i64.ctz", immNone, 0},
+	{0x7b, "i64.popcnt", immNone, 0},
+	{0x7c, "i64.add", immNone, 0},
+	{0x7d, "i64.sub", immNone, 0},
+	{0x7e, "i64.mul", immNone, 0},
+	{0x7f, "i64.div_s", immNone, 0},
+	{0x80, "i64.div_u", immNone, 0},
+	{0x81, "i64.rem_s", immNone, 0},
+	{0x82, "i64.rem_u", immNone, 0},
+	{0x83, "i64.and", immNone, 0},
+	{0x84, "i64.or", immNone, 0},
+	{0x85, "i64.xor", immNone, 0},
+	{0x86, "i64.shl", immNone, 0},
+	{0x87, "i64.shr_s", immNone, 0},
+	{0x88, "i64.shr_u", immNone, 0},
+	{0x89, "i64.rotl", immNone, 0},
+	{0x8a, "i64.rotr", immNone, 0},
+	{0x8b, "f32.abs", immNone, 0},
+	{0x8c, "f32.neg", immNone, 0},
+	{0x8d, "f32.ceil", immNone, 0},
+	{0x8e, "f32.floor", immNone, 0},
+	{0x8f, "f32.trunc", immNone, 0},
+	{0x90, "f32.nearest", immNone, 0},
+	{0x91, "f32.sqrt", immNone, 0},
+	{0x92, "f32.add", immNone, 0},
+	{0x93, "f32.sub", immNone, 0},
+	{0x94, "f32.mul", immNone, 0},
+	{0x95, "f32.div", immNone, 0},
+	{0x96, "f32.min", immNone, 0},
+	{0x97, "f32.max", immNone, 0},
+	{0x98, "f32.copysign", immNone, 0},
+	{0x99, "f64.abs", immNone, 0},
+	{0x9a, "f64.neg", immNone, 0},
+	{0x9b, "f64.ceil", immNone, 0},
+	{0x9c, "f64.floor", immNone, 0},
+	{0x9d, "f64.trunc", immNone, 0},
+	{0x9e, "f64.nearest", immNone, 0},
+	{0x9f, "f64.sqrt", immNone, 0},
+	{0xa0, "f64.add", immNone, 0},
+	{0xa1, "f64.sub", immNone, 0},
+	{0xa2, "f64.mul", immNone, 0},
+	{0xa3, "f64.div", immNone, 0},
+	{0xa4, "f64.min", immNone, 0},
+	{0xa5, "f64.max", immNone, 0},
+	{0xa6, "f64.copysign", immNone, 0},
+	{0xa7, "i32.wrap/i64", immNone, 0},
+	{0xa8, "i32.trunc_s/f32", immNone, 0},
+	{0xa9, "i32.trunc_u/f32", immNone, 0},
+	{0xaa, "i32.trunc_s/f64", immNone, 0},
+	{0xab, "i32.trunc_u/f64", immNone, 0},
+	{0xac, "i64.extend_s/i32", immNone, 0},
+	{0xad, "i64.extend_u/i32", immNone, 0},
+	{0xae, "i64.trunc_s/f32", immNone, 0},
+	{0xaf, "i64.trunc_u/f32", immNone, 0},
+	{0xb0, "i64.trunc_s/f64", immNone, 0},
+	{0xb1, "i64.trunc_u/f64", immNone, 0},
+	{0xb2, "f32.convert_s/i32", immNone, 0},
+	{0xb3, "f32.convert_u/i32", immNone, 0},
+	{0xb4, "f32.convert_s/i64", immNone, 0},
+	{0xb5, "f32.convert_u/i64", immNone, 0},
+	{0xb6, "f32.demote/f64", immNone, 0},
+	{0xb7, "f64.convert_s/i32", immNone, 0},
+	{0xb8, "f64.convert_u/i32", immNone, 0},
+	{0xb9, "f64.convert_s/i64", immNone, 0},
+	{0xba, "f64.convert_u/i64", immNone, 0},
+	{0xbb, "f64.promote/f32", immNone, 0},
+	{0xbc, "i32.reinterpret/f32", immNone, 0},
+	{0xbd, "i64.reinterpret/f64", immNone, 0},
+	{0xbe, "f32.reinterpret/i32", immNone, 0},
+	{0xbf, "f64.reinterpret/i64", immNone, 0},
+}
+
+var (
+	opsByName = make(map[string]*opInfo)
+	opsByCode [256]*opInfo
+)
+
+func init() {
+	for i := range opcodeTable {
+		op := &opcodeTable[i]
+		opsByCode[op.code] = op
+		opsByName[op.name] = op
+		for _, alias := range aliases(op.name) {
+			opsByName[alias] = op
+		}
+	}
+}
+
+// aliases returns the names given to an instruction by later versions of the
+// text format, such as local.get for get_local and i32.wrap_i64 for i32.wrap/i64.
+func aliases(name string) []string {
+	switch name {
+	case "get_local", "set_local", "tee_local", "get_global", "set_global":
+		parts := strings.SplitN(name, "_", 2)
+		return []string{parts[1] + "." + parts[0]}
+	case "current_memory":
+		return []string{"memory.size"}
+	case "grow_memory":
+		return []string{"memory.grow"}
+	}
+	if i := strings.IndexByte(name, '/'); i >= 0 {
+		op := name[:i]
+		// i32.trunc_s/f32 -> i32.trunc_f32_s
+		for _, suffix := range []string{"_s", "_u"} {
+			if strings.HasSuffix(op, suffix) {
+				return []string{
+					strings.TrimSuffix(op, suffix) + "_" + name[i+1:] + suffix,
+					op + "_" + name[i+1:],
+				}
+			}
+		}
+		return []string{op + "_" + name[i+1:]}
+	}
+	return nil
+}
@@ -0,0 +1,567 @@
+package wat
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-interpreter/wagon/wasm"
+	"github.com/perlin-network/life/compiler"
+	"github.com/perlin-network/life/utils"
+)
+
+// PrintConfig controls the output of Print.
+type PrintConfig struct {
+	// MaxDataBytes limits the number of bytes printed for each data segment.
+	// Longer segments are cut short and summarized in a comment. Zero prints
+	// segments in full, so that the output parses back to the same module.
+	MaxDataBytes int
+}
+
+// Print writes a module in the text format. Functions and locals are named
+// after the name section of the module where it has one.
+func Print(w io.Writer, m *compiler.Module, config PrintConfig) (retErr error) {
+	defer utils.CatchPanic(&retErr)
+
+	p := &printer{m: m, config: config}
+	p.module()
+	_, err := w.Write(p.buf.Bytes())
+	return err
+}
+
+type printer struct {
+	m      *compiler.Module
+	config PrintConfig
+	buf    bytes.Buffer
+
+	numFuncImports int
+	funcIDs        []string // by function index; "" if unnamed
+}
+
+func (p *printer) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&p.buf, format, args...)
+}
+
+// identifier turns a name into a valid symbolic identifier, or returns "".
+func identifier(name string) string {
+	if name == "" {
+		return ""
+	}
+	b := []byte(name)
+	for i, c := range b {
+		if !isIDChar(c) {
+			b[i] = '_'
+		}
+	}
+	return "$" + string(b)
+}
+
+// uniqueIDs turns names into identifiers, renaming duplicates.
+func uniqueIDs(names []string) []string {
+	ids := make([]string, len(names))
+	used := make(map[string]bool)
+	for i, name := range names {
+		id := identifier(name)
+		if id == "" {
+			continue
+		}
+		if used[id] {
+			id += "." + strconv.Itoa(i)
+		}
+		used[id] = true
+		ids[i] = id
+	}
+	return ids
+}
+
+func (p *printer) module() {
+	base := p.m.Base
+
+	var names []string
+	if base.Import != nil {
+		for _, e := range base.Import.Entries {
+			if e.Type.Kind() == wasm.ExternalFunction {
+				names = append(names, p.m.FunctionNames[len(names)])
+				p.numFuncImports++
+			}
+		}
+	}
+	if base.Function != nil {
+		for range base.Function.Types {
+			names = append(names, p.m.FunctionNames[len(names)])
+		}
+	}
+	p.funcIDs = uniqueIDs(names)
+
+	p.printf("(module")
+	if id := identifier(p.m.ModuleName); id != "" {
+		p.printf(" %s", id)
+	}
+	p.printf("\n")
+
+	if base.Types != nil {
+		for i, sig := range base.Types.Entries {
+			p.printf("  (type (;%d;) (func", i)
+			p.signature(sig, nil)
+			p.printf("))\n")
+		}
+	}
+
+	p.imports()
+	p.functions()
+
+	numTables, numMemories, numGlobals := 0, 0, 0
+	if base.Import != nil {
+		for _, e := range base.Import.Entries {
+			switch e.Type.Kind() {
+			case wasm.ExternalTable:
+				numTables++
+			case wasm.ExternalMemory:
+				numMemories++
+			case wasm.ExternalGlobal:
+				numGlobals++
+			}
+		}
+	}
+	if base.Table != nil {
+		for i, t := range base.Table.Entries {
+			p.printf("  (table (;%d;) %s anyfunc)\n", numTables+i, formatLimits(t.Limits))
+		}
+	}
+	if base.Memory != nil {
+		for i, mem := range base.Memory.Entries {
+			p.printf("  (memory (;%d;) %s)\n", numMemories+i, formatLimits(mem.Limits))
+		}
+	}
+	if base.Global != nil {
+		for i, g := range base.Global.Globals {
+			p.printf("  (global (;%d;) %s %s)\n", numGlobals+i, formatGlobalType(g.Type), p.constExpr(g.Init))
+		}
+	}
+
+	p.exports()
+
+	if base.Start != nil {
+		p.printf("  (start %s)\n", p.funcRef(base.Start.Index))
+	}
+
+	if base.Elements != nil {
+		for i, e := range base.Elements.Entries {
+			p.printf("  (elem (;%d;)", i)
+			if e.Index != 0 {
+				p.printf(" %d", e.Index)
+			}
+			p.printf(" %s", p.constExpr(e.Offset))
+			for _, f := range e.Elems {
+				p.printf(" %s", p.funcRef(f))
+			}
+			p.printf(")\n")
+		}
+	}
+
+	if base.Data != nil {
+		for i, d := range base.Data.Entries {
+			p.printf("  (data (;%d;)", i)
+			if d.Index != 0 {
+				p.printf(" %d", d.Index)
+			}
+			p.printf(" %s ", p.constExpr(d.Offset))
+			data := d.Data
+			if p.config.MaxDataBytes > 0 && len(data) > p.config.MaxDataBytes {
+				data = data[:p.config.MaxDataBytes]
+			}
+			p.printf("%s", quote(data))
+			if len(data) < len(d.Data) {
+				p.printf(" ;; %d bytes, %d not shown\n  ", len(d.Data), len(d.Data)-len(data))
+			}
+			p.printf(")\n")
+		}
+	}
+
+	for _, c := range base.Customs {
+		p.printf("  ;; custom section %q, %d bytes\n", c.Name, len(c.Data))
+	}
+
+	p.printf(")\n")
+}
+
+func formatLimits(l wasm.ResizableLimits) string {
+	if l.Flags&1 != 0 {
+		return fmt.Sprintf("%d %d", l.Initial, l.Maximum)
+	}
+	return strconv.FormatUint(uint64(l.Initial), 10)
+}
+
+func formatGlobalType(g wasm.GlobalVar) string {
+	if g.Mutable {
+		return "(mut " + g.Type.String() + ")"
+	}
+	return g.Type.String()
+}
+
+// quote returns a string literal holding data.
+func quote(data []byte) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, c := range data {
+		if c >= 0x20 && c < 0x7f && c != '"' && c != '\\' {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "\\%02x", c)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// signature prints the parameters and results of a function type, naming
+// parameters after ids where given.
+func (p *printer) signature(sig wasm.FunctionSig, ids []string) {
+	p.valueTypes("param", sig.ParamTypes, ids)
+	if len(sig.ReturnTypes) > 0 {
+		p.printf(" (result")
+		for _, t := range sig.ReturnTypes {
+			p.printf(" %s", t)
+		}
+		p.printf(")")
+	}
+}
+
+// valueTypes prints param or local declarations. Named entries get their own
+// declaration; runs of unnamed ones are grouped.
+func (p *printer) valueTypes(keyword string, types []wasm.ValueType, ids []string) {
+	open := false
+	for i, t := range types {
+		id := ""
+		if i < len(ids) {
+			id = ids[i]
+		}
+		if id != "" {
+			if open {
+				p.printf(")")
+				open = false
+			}
+			p.printf(" (%s %s %s)", keyword, id, t)
+			continue
+		}
+		if !open {
+			p.printf(" (%s", keyword)
+			open = true
+		}
+		p.printf(" %s", t)
+	}
+	if open {
+		p.printf(")")
+	}
+}
+
+func (p *printer) funcRef(index uint32) string {
+	if int(index) < len(p.funcIDs) && p.funcIDs[index] != "" {
+		return p.funcIDs[index]
+	}
+	return strconv.FormatUint(uint64(index), 10)
+}
+
+func (p *printer) funcHeader(index int) {
+	p.printf("(func")
+	if p.funcIDs[index] != "" {
+		p.printf(" %s", p.funcIDs[index])
+	} else {
+		p.printf(" (;%d;)", index)
+	}
+}
+
+func (p *printer) imports() {
+	base := p.m.Base
+	if base.Import == nil {
+		return
+	}
+
+	var funcIndex, tableIndex, memIndex, globalIndex int
+	for _, e := range base.Import.Entries {
+		p.printf("  (import %s %s ", quote([]byte(e.ModuleName)), quote([]byte(e.FieldName)))
+		switch t := e.Type.(type) {
+		case wasm.FuncImport:
+			p.funcHeader(funcIndex)
+			p.printf(" (type %d)", t.Type)
+			if int(t.Type) < len(base.Types.Entries) {
+				p.signature(base.Types.Entries[t.Type], nil)
+			}
+			p.printf(")")
+			funcIndex++
+		case wasm.TableImport:
+			p.printf("(table (;%d;) %s anyfunc)", tableIndex, formatLimits(t.Type.Limits))
+			tableIndex++
+		case wasm.MemoryImport:
+			p.printf("(memory (;%d;) %s)", memIndex, formatLimits(t.Type.Limits))
+			memIndex++
+		case wasm.GlobalVarImport:
+			p.printf("(global (;%d;) %s)", globalIndex, formatGlobalType(t.Type))
+			globalIndex++
+		}
+		p.printf(")\n")
+	}
+}
+
+func (p *printer) functions() {
+	base := p.m.Base
+	if base.Function == nil || base.Code == nil {
+		return
+	}
+
+	for i, typeIndex := range base.Function.Types {
+		index := p.numFuncImports + i
+		sig := base.Types.Entries[typeIndex]
+		body := base.Code.Bodies[i]
+
+		var locals []wasm.ValueType
+		for _, l := range body.Locals {
+			for j := uint32(0); j < l.Count; j++ {
+				locals = append(locals, l.Type)
+			}
+		}
+
+		localNames := make([]string, len(sig.ParamTypes)+len(locals))
+		for j, name := range p.m.LocalNames[index] {
+			if j >= 0 && j < len(localNames) {
+				localNames[j] = name
+			}
+		}
+		localIDs := uniqueIDs(localNames)
+
+		p.printf("  ")
+		p.funcHeader(index)
+		p.printf(" (type %d)", typeIndex)
+		p.signature(sig, localIDs)
+		p.printf("\n")
+		if len(locals) > 0 {
+			p.printf("   ")
+			p.valueTypes("local", locals, localIDs[len(sig.ParamTypes):])
+			p.printf("\n")
+		}
+		p.body(body.Code, localIDs)
+		p.printf("  )\n")
+	}
+}
+
+func (p *printer) exports() {
+	base := p.m.Base
+	if base.Export == nil {
+		return
+	}
+
+	// wagon keeps exports in a map; print them in index order.
+	entries := make([]wasm.ExportEntry, 0, len(base.Export.Entries))
+	for _, e := range base.Export.Entries {
+		entries = append(entries, e)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		if a.Index != b.Index {
+			return a.Index < b.Index
+		}
+		return a.FieldStr < b.FieldStr
+	})
+
+	for _, e := range entries {
+		p.printf("  (export %s ", quote([]byte(e.FieldStr)))
+		switch e.Kind {
+		case wasm.ExternalFunction:
+			p.printf("(func %s)", p.funcRef(e.Index))
+		case wasm.ExternalTable:
+			p.printf("(table %d)", e.Index)
+		case wasm.ExternalMemory:
+			p.printf("(memory %d)", e.Index)
+		case wasm.ExternalGlobal:
+			p.printf("(global %d)", e.Index)
+		}
+		p.printf(")\n")
+	}
+}
+
+// codeReader decodes instructions and their immediates.
+type codeReader struct {
+	code []byte
+	pos  int
+}
+
+func (r *codeReader) byte() byte {
+	if r.pos >= len(r.code) {
+		panic(fmt.Errorf("unexpected end of code at offset %d", r.pos))
+	}
+	r.pos++
+	return r.code[r.pos-1]
+}
+
+func (r *codeReader) u32() uint32 {
+	var v uint32
+	for shift := uint(0); ; shift += 7 {
+		c := r.byte()
+		v |= uint32(c&0x7f) << shift
+		if c&0x80 == 0 {
+			return v
+		}
+	}
+}
+
+func (r *codeReader) s64() int64 {
+	var v int64
+	var shift uint
+	for {
+		c := r.byte()
+		v |= int64(c&0x7f) << shift
+		shift += 7
+		if c&0x80 == 0 {
+			if shift < 64 && c&0x40 != 0 {
+				v |= -1 << shift
+			}
+			return v
+		}
+	}
+}
+
+func (r *codeReader) fixed(n int) []byte {
+	if r.pos+n > len(r.code) {
+		panic(fmt.Errorf("unexpected end of code at offset %d", r.pos))
+	}
+	r.pos += n
+	return r.code[r.pos-n : r.pos]
+}
+
+func formatFloat(bits uint64, size int) string {
+	var f float64
+	var mantBits uint
+	if size == 32 {
+		f = float64(math.Float32frombits(uint32(bits)))
+		mantBits = 23
+	} else {
+		f = math.Float64frombits(bits)
+		mantBits = 52
+	}
+
+	sign := ""
+	if bits>>(uint(size)-1) != 0 {
+		sign = "-"
+	}
+	switch {
+	case math.IsInf(f, 0):
+		return sign + "inf"
+	case math.IsNaN(f):
+		payload := bits & (1<<mantBits - 1)
+		if payload == 1<<(mantBits-1) {
+			return sign + "nan"
+		}
+		return fmt.Sprintf("%snan:0x%x", sign, payload)
+	}
+	return strconv.FormatFloat(f, 'g', -1, size)
+}
+
+// instr decodes one instruction and returns its text, without the block
+// type of structured instructions.
+func (p *printer) instr(r *codeReader, localIDs []string) (*opInfo, string) {
+	start := r.pos
+	code := r.byte()
+	op := opsByCode[code]
+	if op == nil {
+		panic(fmt.Errorf("unknown opcode 0x%02x at offset %d", code, start))
+	}
+
+	text := op.name
+	switch op.imm {
+	case immBlock:
+		switch t := r.byte(); t {
+		case blockEmpty:
+		case typeI32, typeI64, typeF32, typeF64:
+			// Value types are encoded as negative 7-bit integers.
+			text += " (result " + wasm.ValueType(int8(t<<1)>>1).String() + ")"
+		default:
+			panic(fmt.Errorf("invalid block type 0x%02x at offset %d", t, start+1))
+		}
+	case immLabel:
+		text += " " + strconv.FormatUint(uint64(r.u32()), 10)
+	case immBrTable:
+		n := r.u32()
+		for i := uint32(0); i <= n; i++ {
+			text += " " + strconv.FormatUint(uint64(r.u32()), 10)
+		}
+	case immFunc:
+		text += " " + p.funcRef(r.u32())
+	case immCallIndirect:
+		text += fmt.Sprintf(" (type %d)", r.u32())
+		r.byte()
+	case immLocal:
+		index := r.u32()
+		if int(index) < len(localIDs) && localIDs[index] != "" {
+			text += " " + localIDs[index]
+		} else {
+			text += " " + strconv.FormatUint(uint64(index), 10)
+		}
+	case immGlobal:
+		text += " " + strconv.FormatUint(uint64(r.u32()), 10)
+	case immMemory:
+		align := r.u32()
+		offset := r.u32()
+		if offset != 0 {
+			text += " offset=" + strconv.FormatUint(uint64(offset), 10)
+		}
+		if align != op.align && align < 32 {
+			text += " align=" + strconv.FormatUint(1<<align, 10)
+		}
+	case immReserved:
+		r.byte()
+	case immI32:
+		text += " " + strconv.FormatInt(int64(int32(r.s64())), 10)
+	case immI64:
+		text += " " + strconv.FormatInt(r.s64(), 10)
+	case immF32:
+		text += " " + formatFloat(uint64(binary.LittleEndian.Uint32(r.fixed(4))), 32)
+	case immF64:
+		text += " " + formatFloat(binary.LittleEndian.Uint64(r.fixed(8)), 64)
+	}
+	return op, text
+}
+
+// constExpr formats an initializer expression in folded form.
+func (p *printer) constExpr(code []byte) string {
+	r := &codeReader{code: code}
+	var parts []string
+	for r.pos < len(code) {
+		op, text := p.instr(r, nil)
+		if op.code == 0x0b {
+			break
+		}
+		parts = append(parts, "("+text+")")
+	}
+	return strings.Join(parts, " ")
+}
+
+// body prints the instructions of a function body, one per line.
+func (p *printer) body(code []byte, localIDs []string) {
+	r := &codeReader{code: code}
+	depth := 0
+	for r.pos < len(code) {
+		op, text := p.instr(r, localIDs)
+		switch op.code {
+		case 0x05: // else
+			depth--
+		case 0x0b: // end
+			depth--
+		}
+		if depth < 0 {
+			depth = 0
+		}
+		p.printf("    %s%s\n", strings.Repeat("  ", depth), text)
+		switch op.code {
+		case 0x02, 0x03, 0x04, 0x05:
+			depth++
+		}
+	}
+}
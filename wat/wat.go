@@ -0,0 +1,83 @@
+// Package wat implements the WebAssembly text format.
+//
+// Parse accepts modules written with folded or plain instructions, symbolic
+// identifiers and labels, and the inline import, export, elem and data
+// abbreviations, and encodes them in the binary format. Both the MVP names of
+// instructions (get_local, i32.wrap/i64) and their later names (local.get,
+// i32.wrap_i64) are understood.
+package wat
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/perlin-network/life/utils"
+)
+
+// IsText reports whether code looks like a module in the text format rather
+// than in the binary format.
+func IsText(code []byte) bool {
+	for _, c := range code {
+		switch c {
+		case ' ', '\t', '\n', '\r':
+			continue
+		case '(', ';':
+			return true
+		}
+		return false
+	}
+	return false
+}
+
+// Parse parses a module in the text format and returns its binary encoding.
+// The input may be a (module ...) form or a bare list of module fields.
+// Errors in the text are reported as *Error.
+func Parse(src []byte) (_ []byte, retErr error) {
+	defer utils.CatchPanic(&retErr)
+
+	nodes := parseSExprs(src)
+	if len(nodes) == 0 {
+		return nil, errors.New("empty module")
+	}
+	if nodes[0].head() != "module" {
+		return parseModule(nodes).encode(), nil
+	}
+	if len(nodes) > 1 {
+		nodes[1].pos.errorf("unexpected token %s after module", nodes[1])
+	}
+	return parseModuleForm(nodes[0]), nil
+}
+
+// parseModuleForm encodes a (module $id? ...) form, including the
+// (module binary ...) and (module quote ...) forms used by spec scripts.
+func parseModuleForm(n *node) []byte {
+	c := newCursor(n)
+	id := c.id()
+
+	if next := c.peek(); next != nil && next.isAtom() {
+		switch next.tok.text {
+		case "binary":
+			c.i++
+			var ret []byte
+			for !c.done() {
+				ret = append(ret, c.str("string")...)
+			}
+			return ret
+		case "quote":
+			c.i++
+			var text []byte
+			for !c.done() {
+				text = append(text, c.str("string")...)
+				text = append(text, ' ')
+			}
+			inner := parseSExprs(text)
+			if len(inner) == 1 && inner[0].head() == "module" {
+				return parseModuleForm(inner[0])
+			}
+			return parseModule(inner).encode()
+		}
+	}
+	m := parseModule(c.rest())
+	m.moduleName = strings.TrimPrefix(id, "$")
+	return m.encode()
+}